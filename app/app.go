@@ -10,8 +10,18 @@ import (
 
 	_ "github.com/provenance-io/provenance/client/docs/statik" // registers swagger-ui files with statik
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/archive"
+	"github.com/provenance-io/provenance/internal/eventstream"
+	"github.com/provenance-io/provenance/internal/gasstats"
+	"github.com/provenance-io/provenance/internal/health"
+	"github.com/provenance-io/provenance/internal/nodeconfig"
+	"github.com/provenance-io/provenance/internal/skipupgrade"
 	"github.com/provenance-io/provenance/internal/statesync"
+	"github.com/provenance-io/provenance/internal/storestream"
+	"github.com/provenance-io/provenance/internal/testnetfaucet"
+	"github.com/provenance-io/provenance/internal/txpriority"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -271,6 +281,19 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// publishes typed events to the opt-in event stream service, nil when disabled
+	eventStreamer *eventstream.Streamer
+	// publishes the committed changes of the opted in stores for the opt-in store streaming, nil when disabled
+	storeStreamer *storestream.Streamer
+	gasTracker    *gasstats.Tracker
+	// serves the opt-in health and readiness endpoints, nil when disabled
+	healthService *health.Service
+	testnetFaucet *testnetfaucet.Faucet
+	// verifies the stored heights on boot when started with --archive-verify, nil otherwise
+	archiveVerifier *archive.Verifier
+	// the upgrades skipped with --unsafe-skip-upgrades, each only skipped when its plan has the given name
+	skipUpgrades []skipupgrade.Upgrade
 }
 
 func init() {
@@ -295,6 +318,12 @@ func New(
 	legacyAmino := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 
+	// Stream the changes committed to the stores opted into the store streaming.
+	storeStreamer := storestream.NewStreamer(appOpts)
+	if storeStreamer != nil {
+		baseAppOptions = append([]func(*baseapp.BaseApp){storeStreamer.BaseAppOption(db)}, baseAppOptions...)
+	}
+
 	bApp := baseapp.NewBaseApp("provenanced", logger, db, encodingConfig.TxConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
@@ -329,11 +358,47 @@ func New(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		storeStreamer:     storeStreamer,
 	}
 
 	// Register helpers for state-sync status.
 	statesync.RegisterSyncStatus()
 
+	// Register the opt-in node configuration query.
+	nodeconfig.RegisterNodeConfig(appOpts)
+
+	// Collect typed events for the opt-in event stream service.
+	app.eventStreamer = eventstream.NewStreamer(appOpts)
+
+	if app.storeStreamer != nil {
+		if err := app.storeStreamer.Listen(keys); err != nil {
+			panic(err)
+		}
+	}
+
+	// Track the gas used by each module for the opt-in gas stats query.
+	app.gasTracker = gasstats.NewTracker(appOpts, encodingConfig.TxConfig.TxDecoder())
+
+	// Report the readiness of the node on the opt-in health endpoints.
+	app.healthService = health.NewService(appOpts)
+
+	// Create and fund accounts from the opt-in faucet endpoint of a testnet api server.
+	testnetFaucet, err := testnetfaucet.NewFaucet(appOpts, AccountAddressPrefix == AccountAddressPrefixTestNet)
+	if err != nil {
+		panic(err)
+	}
+	app.testnetFaucet = testnetFaucet
+
+	// Verify the stored heights of an archive node when requested on start.
+	app.archiveVerifier = archive.NewVerifier(appOpts, db)
+
+	// Only skip the upgrades whose plans have the names given with the skipped heights.
+	skipUpgrades, err := skipupgrade.FromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.skipUpgrades = skipUpgrades
+
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	// set the BaseApp's parameter store
@@ -358,6 +423,9 @@ func New(
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
+	// Sends between accounts made on behalf of users are checked against the deposit control of marker accounts.
+	// NOTE: the marker keeper is passed by reference since it is created once the modules it depends on are.
+	depositBankKeeper := markerkeeper.NewDepositControlBankKeeper(app.BankKeeper, &app.MarkerKeeper)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -389,16 +457,8 @@ func New(
 		keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter(),
 	)
 
-	app.MetadataKeeper = metadatakeeper.NewKeeper(
-		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper,
-	)
-
-	app.MarkerKeeper = markerkeeper.NewKeeper(
-		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper,
-	)
-
 	app.NameKeeper = namekeeper.NewKeeper(
-		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName),
+		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName), app.DistrKeeper,
 	)
 
 	app.AttributeKeeper = attributekeeper.NewKeeper(
@@ -414,7 +474,19 @@ func New(
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+		app.AccountKeeper, depositBankKeeper, scopedTransferKeeper,
+	)
+
+	markerKeeper := markerkeeper.NewKeeper(
+		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper,
+		app.TransferKeeper,
+	)
+	// Modules that veto restricted coin transfers, e.g. for sanctions lists, register their checkers here.
+	app.MarkerKeeper = *markerKeeper.SetRestrictionChecker(markertypes.NewRestrictionCheckers())
+
+	app.MetadataKeeper = metadatakeeper.NewKeeper(
+		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper,
+		app.MarkerKeeper, app.NameKeeper, app.DistrKeeper, app.AttributeKeeper,
 	)
 
 	// Init CosmWasm module
@@ -422,7 +494,7 @@ func New(
 	wasmDir := filepath.Join(homePath, "data", "wasm")
 
 	wasmWrap := WasmWrapper{Wasm: wasm.DefaultWasmConfig()}
-	err := viper.Unmarshal(&wasmWrap)
+	err = viper.Unmarshal(&wasmWrap)
 	if err != nil {
 		panic("error while reading wasm config: " + err.Error())
 	}
@@ -451,7 +523,7 @@ func New(
 		keys[wasm.StoreKey],
 		app.GetSubspace(wasm.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper,
+		depositBankKeeper,
 		app.StakingKeeper,
 		app.DistrKeeper,
 		app.IBCKeeper.ChannelKeeper,
@@ -477,7 +549,8 @@ func New(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, wasm.EnableAllProposals)).
 		AddRoute(nametypes.ModuleName, name.NewProposalHandler(app.NameKeeper)).
-		AddRoute(markertypes.ModuleName, marker.NewProposalHandler(app.MarkerKeeper))
+		AddRoute(markertypes.ModuleName, marker.NewProposalHandler(app.MarkerKeeper)).
+		AddRoute(metadatatypes.ModuleName, metadata.NewProposalHandler(app.MetadataKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -514,7 +587,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newDepositControlBankModule(appCodec, depositBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -554,12 +627,15 @@ func New(
 		stakingtypes.ModuleName,
 		ibchost.ModuleName,
 		markertypes.ModuleName,
+		nametypes.ModuleName,
+		metadatatypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		markertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -598,10 +674,12 @@ func New(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	gasstats.RegisterNodeServer(app.GRPCQueryRouter(), app.gasTracker)
+	app.registerHealthChecks(homePath)
 
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newDepositControlBankModule(appCodec, depositBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -633,13 +711,35 @@ func New(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
+	// Reject low priority transactions from a congested mempool when opted into.
+	txPriority, err := txpriority.NewPrioritizer(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	// Pad the gas estimated by simulating msgs whose gas varies between the simulation and the block.
+	simGasPadding, err := antewrapper.NewSimGasPaddingDecorator(appOpts)
+	if err != nil {
+		panic(err)
+	}
 	anteHandler, err := antewrapper.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		antewrapper.HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			RateLimitSubspace:     app.GetSubspace(antewrapper.RateLimitParamSpace),
+			MsgGasLimitSubspace:   app.GetSubspace(antewrapper.MsgGasLimitParamSpace),
+			AttributeGateSubspace: app.GetSubspace(antewrapper.AttributeGateParamSpace),
+			AttributeChecker:      app.AttributeKeeper,
+			TxPriority:            txPriority,
+			SimGasPadding:         simGasPadding,
+			DepositChecker:        app.MarkerKeeper,
+			TransferMemoChecker:   app.MarkerKeeper,
+			FeeConverter:          app.MarkerKeeper,
+			FeeDenom:              DefaultFeeDenom,
 		})
 	if err != nil {
 		panic(err)
@@ -690,13 +790,69 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
+// BeginBlock implements the ABCI BeginBlock method, recording the block's typed events for the event stream and
+// starting the block's gas stats.
+func (app *App) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.BaseApp.BeginBlock(req)
+	if app.eventStreamer != nil {
+		app.eventStreamer.ListenBeginBlock(req, res)
+	}
+	if app.gasTracker != nil {
+		app.gasTracker.ListenBeginBlock(req)
+	}
+	return res
+}
+
+// DeliverTx implements the ABCI DeliverTx method, recording the transaction's typed events for the event stream and
+// its gas used for the gas stats.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if app.eventStreamer != nil {
+		app.eventStreamer.ListenDeliverTx(req, res)
+	}
+	if app.gasTracker != nil {
+		app.gasTracker.ListenDeliverTx(req, res)
+	}
+	return res
+}
+
+// EndBlock implements the ABCI EndBlock method, recording the block's typed events for the event stream.
+func (app *App) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if app.eventStreamer != nil {
+		app.eventStreamer.ListenEndBlock(req, res)
+	}
+	return res
+}
+
+// Commit implements the ABCI Commit method, publishing the committed block's typed events to the event stream and
+// its store changes to the store streaming, and adding its gas used to the gas stats.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.storeStreamer != nil {
+		if err := app.storeStreamer.ListenCommit(app.LastBlockHeight()); err != nil {
+			app.Logger().Error("failed to stream the committed store changes", "height", app.LastBlockHeight(), "err", err)
+		}
+	}
+	if app.eventStreamer != nil {
+		app.eventStreamer.ListenCommit()
+	}
+	if app.gasTracker != nil {
+		app.gasTracker.ListenCommit()
+	}
+	return res
+}
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.checkSkippedUpgrade(ctx)
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker application updates every end block
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// Expedited proposals are closed out before the gov module tallies the regular voting periods.
+	app.EndBlockExpeditedProposals(ctx)
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -796,12 +952,31 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the opt-in testnet faucet endpoint.
+	if app.testnetFaucet != nil {
+		if err := app.testnetFaucet.RegisterRoutes(clientCtx, apiSvr.Router); err != nil {
+			panic(err)
+		}
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
 }
 
+// RegisterGRPCServer implements the Application.RegisterGRPCServer method, adding the event stream and store stream
+// services when they have been enabled in app.toml.
+func (app *App) RegisterGRPCServer(clientCtx client.Context, server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(clientCtx, server)
+	if app.eventStreamer != nil {
+		eventstream.RegisterEventStreamServer(server, app.eventStreamer)
+	}
+	if app.storeStreamer != nil && app.storeStreamer.Serving() {
+		storestream.RegisterStoreStreamServer(server, app.storeStreamer)
+	}
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
@@ -850,6 +1025,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(nametypes.ModuleName)
 	paramsKeeper.Subspace(attributetypes.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
+	paramsKeeper.Subspace(antewrapper.RateLimitParamSpace).WithKeyTable(antewrapper.RateLimitParamKeyTable())
+	paramsKeeper.Subspace(antewrapper.MsgGasLimitParamSpace).WithKeyTable(antewrapper.MsgGasLimitParamKeyTable())
+	paramsKeeper.Subspace(antewrapper.AttributeGateParamSpace).WithKeyTable(antewrapper.AttributeGateParamKeyTable())
 
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
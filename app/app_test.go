@@ -2,14 +2,20 @@ package app
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/internal/storestream"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -51,3 +57,52 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestStoreStreaming(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	fileDir := t.TempDir()
+	appOpts := viper.New()
+	appOpts.Set(storestream.FlagStores, []string{"params", "marker"})
+	appOpts.Set(storestream.FlagFileDir, fileDir)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, appOpts)
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(encCfg.Marshaler), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	bz, err := ioutil.ReadFile(filepath.Join(fileDir, storestream.FileName(1)))
+	require.NoError(t, err)
+	var block storestream.BlockChangeSet
+	require.NoError(t, block.Unmarshal(bz))
+	require.Equal(t, int64(1), block.Height)
+	require.NotEmpty(t, block.Changes, "the genesis params are committed in the first block")
+	ctx := app.NewContext(true, tmproto.Header{})
+	for _, change := range block.Changes {
+		require.Contains(t, []string{"params", "marker"}, change.StoreKey, "only the opted in stores are streamed")
+		require.Equal(t, change.Value, ctx.KVStore(app.keys[change.StoreKey]).Get(change.Key), "committed value")
+	}
+
+	// Only the writes of the block are streamed, not those of the mempool checks.
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	app.NewContext(true, tmproto.Header{}).KVStore(app.keys["marker"]).Set([]byte("checked"), []byte("value"))
+	deliverCtx := app.NewContext(false, tmproto.Header{})
+	deliverCtx.KVStore(app.keys["marker"]).Set([]byte("delivered"), []byte("value"))
+	deliverCtx.KVStore(app.keys["name"]).Set([]byte("delivered"), []byte("value"))
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	bz, err = ioutil.ReadFile(filepath.Join(fileDir, storestream.FileName(2)))
+	require.NoError(t, err)
+	block = storestream.BlockChangeSet{}
+	require.NoError(t, block.Unmarshal(bz))
+	require.Equal(t, int64(2), block.Height)
+	var markerChanges []string
+	for _, change := range block.Changes {
+		if change.StoreKey == "marker" {
+			markerChanges = append(markerChanges, string(change.Key))
+		}
+	}
+	require.Equal(t, []string{"delivered"}, markerChanges)
+	require.Equal(t, []byte("value"), app.NewContext(true, tmproto.Header{}).KVStore(app.keys["marker"]).Get([]byte("delivered")))
+}
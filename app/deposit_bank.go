@@ -0,0 +1,48 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+)
+
+// depositControlBankModule is the bank module with its msgs handled through the deposit control bank keeper, so bank
+// sends to marker accounts that restrict deposits are rejected however they are executed (e.g. through authz or wasm).
+type depositControlBankModule struct {
+	bank.AppModule
+
+	baseKeeper    bankkeeper.BaseKeeper
+	depositKeeper markerkeeper.DepositControlBankKeeper
+}
+
+// newDepositControlBankModule creates the bank module handling its msgs through the deposit control bank keeper.
+func newDepositControlBankModule(
+	cdc codec.Codec, depositKeeper markerkeeper.DepositControlBankKeeper, accountKeeper banktypes.AccountKeeper,
+) depositControlBankModule {
+	return depositControlBankModule{
+		AppModule:     bank.NewAppModule(cdc, depositKeeper, accountKeeper),
+		baseKeeper:    depositKeeper.Keeper.(bankkeeper.BaseKeeper),
+		depositKeeper: depositKeeper,
+	}
+}
+
+// Route returns the legacy message route of the bank module.
+func (am depositControlBankModule) Route() sdk.Route {
+	return sdk.NewRoute(banktypes.RouterKey, bank.NewHandler(am.depositKeeper))
+}
+
+// RegisterServices registers the bank services, the migrations are registered with the base keeper they require.
+func (am depositControlBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.depositKeeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.baseKeeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// expeditedProposalTypes are the governance proposal types (by route and type) that may pass early on the
+// expedited track.  These are reserved for emergencies such as responding to a compromised marker admin key.
+var expeditedProposalTypes = map[string]map[string]bool{
+	markertypes.RouterKey: {
+		markertypes.ProposalTypeChangeStatus: true,
+	},
+}
+
+// IsExpeditedProposal returns true if the proposal content is eligible for the expedited track.
+func IsExpeditedProposal(content govtypes.Content) bool {
+	return expeditedProposalTypes[content.ProposalRoute()][content.ProposalType()]
+}
+
+// EndBlockExpeditedProposals tallies the proposals on the expedited track whose expedited voting period has elapsed.
+// A proposal that passes the regular tally with a turnout of at least the expedited quorum is executed and closed
+// immediately, any other proposal is left for the gov module to tally at the end of its regular voting period.
+func (app *App) EndBlockExpeditedProposals(ctx sdk.Context) {
+	period := app.MarkerKeeper.GetExpeditedVotingPeriod(ctx)
+	if period == 0 {
+		return
+	}
+	quorum := app.MarkerKeeper.GetExpeditedQuorum(ctx)
+	if quorum.IsNil() {
+		quorum = sdk.ZeroDec()
+	}
+
+	// Only the proposals in their voting period are eligible, so just the active proposal queue is checked.
+	var proposals []govtypes.Proposal
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(app.keys[govtypes.StoreKey]), govtypes.ActiveProposalQueuePrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := govtypes.SplitActiveProposalQueueKey(iterator.Key())
+		proposal, found := app.GovKeeper.GetProposal(ctx, proposalID)
+		if found && IsExpeditedProposal(proposal.GetContent()) &&
+			!ctx.BlockTime().Before(proposal.VotingStartTime.Add(period)) {
+			proposals = append(proposals, proposal)
+		}
+	}
+	iterator.Close()
+
+	for _, proposal := range proposals {
+		app.tallyExpeditedProposal(ctx, proposal, quorum)
+	}
+}
+
+// tallyExpeditedProposal closes out an expedited proposal that has passed with the expedited quorum.
+func (app *App) tallyExpeditedProposal(ctx sdk.Context, proposal govtypes.Proposal, quorum sdk.Dec) {
+	logger := app.GovKeeper.Logger(ctx)
+
+	// Tallying removes the votes, so it is done against a cached context that is only written when the
+	// proposal passes on the expedited track.
+	tallyCtx, writeTally := ctx.CacheContext()
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(tallyCtx, proposal)
+	if !passes {
+		return
+	}
+	totalBonded := app.StakingKeeper.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return
+	}
+	voted := tallyResults.Yes.Add(tallyResults.Abstain).Add(tallyResults.No).Add(tallyResults.NoWithVeto)
+	if voted.ToDec().Quo(totalBonded.ToDec()).LT(quorum) {
+		return
+	}
+	writeTally()
+
+	if burnDeposits {
+		app.GovKeeper.DeleteDeposits(ctx, proposal.ProposalId)
+	} else {
+		app.GovKeeper.RefundDeposits(ctx, proposal.ProposalId)
+	}
+
+	var tagValue, logMsg string
+	handler := app.GovKeeper.Router().GetRoute(proposal.ProposalRoute())
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := handler(cacheCtx, proposal.GetContent()); err == nil {
+		proposal.Status = govtypes.StatusPassed
+		tagValue = govtypes.AttributeValueProposalPassed
+		logMsg = "passed"
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	} else {
+		proposal.Status = govtypes.StatusFailed
+		tagValue = govtypes.AttributeValueProposalFailed
+		logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
+	}
+
+	proposal.FinalTallyResult = tallyResults
+	app.GovKeeper.SetProposal(ctx, proposal)
+	app.GovKeeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	app.GovKeeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
+
+	logger.Info(
+		"expedited proposal tallied",
+		"proposal", proposal.ProposalId,
+		"title", proposal.GetTitle(),
+		"result", logMsg,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeActiveProposal,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalResult, tagValue),
+		),
+	)
+}
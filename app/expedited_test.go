@@ -0,0 +1,87 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestEndBlockExpeditedProposals(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	voterKey := secp256k1.GenPrivKey()
+	voter := authtypes.NewBaseAccount(voterKey.PubKey().Address().Bytes(), voterKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: voter.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{voter}, balance)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	for _, denom := range []string{"expedited", "regular"} {
+		marker := markertypes.NewEmptyMarkerAccount(denom, voter.GetAddress().String(), []markertypes.AccessGrant{})
+		marker.AllowGovernanceControl = true
+		marker.Supply = sdk.NewInt(1000)
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, marker))
+	}
+
+	submit := func(content govtypes.Content) uint64 {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, voter.GetAddress(),
+			govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+		return proposal.ProposalId
+	}
+	expeditedID := submit(markertypes.NewChangeStatusProposal("title", "description", "expedited", markertypes.StatusFinalized))
+	// text proposals are not eligible for the expedited track.
+	regularID := submit(govtypes.NewTextProposal("title", "description"))
+
+	require.True(t, IsExpeditedProposal(markertypes.NewChangeStatusProposal("title", "description", "expedited", markertypes.StatusFinalized)))
+	require.False(t, IsExpeditedProposal(govtypes.NewTextProposal("title", "description")))
+
+	proposalStatus := func(id uint64) govtypes.ProposalStatus {
+		proposal, found := app.GovKeeper.GetProposal(ctx, id)
+		require.True(t, found)
+		return proposal.Status
+	}
+
+	// nothing happens before the expedited voting period has elapsed.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(markertypes.DefaultExpeditedVotingPeriod - time.Minute))
+	app.EndBlockExpeditedProposals(ctx)
+	require.Equal(t, govtypes.StatusVotingPeriod, proposalStatus(expeditedID))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	app.EndBlockExpeditedProposals(ctx)
+	require.Equal(t, govtypes.StatusPassed, proposalStatus(expeditedID))
+	require.Equal(t, govtypes.StatusVotingPeriod, proposalStatus(regularID))
+
+	marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "expedited")
+	require.NoError(t, err)
+	require.Equal(t, markertypes.StatusFinalized, marker.GetStatus())
+
+	// proposals without the expedited quorum wait for the regular voting period.
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.ExpeditedQuorum = sdk.OneDec()
+	app.MarkerKeeper.SetParams(ctx, params)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, markertypes.NewChangeStatusProposal("title", "description", "regular", markertypes.StatusFinalized))
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(markertypes.DefaultExpeditedVotingPeriod))
+	app.EndBlockExpeditedProposals(ctx)
+	require.Equal(t, govtypes.StatusVotingPeriod, proposalStatus(proposal.ProposalId))
+}
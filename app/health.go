@@ -0,0 +1,102 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/provenance-io/provenance/internal/archive"
+	"github.com/provenance-io/provenance/internal/skipupgrade"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// markerInvariantsPath is the gRPC query path of the marker module invariants.
+	markerInvariantsPath = "/provenance.marker.v1.Query/Invariants"
+	// metadataInvariantsPath is the gRPC query path of the metadata module invariants.
+	metadataInvariantsPath = "/provenance.metadata.v1.Query/Invariants"
+)
+
+// registerHealthChecks adds the readiness checks of the provenance modules to the health service, and the upgrades the
+// node has been started to skip as warnings.
+func (app *App) registerHealthChecks(homePath string) {
+	if app.healthService == nil {
+		return
+	}
+	app.healthService.AddCheck(markertypes.ModuleName, func() ([]string, error) {
+		var res markertypes.QueryInvariantsResponse
+		if err := app.queryLatest(markerInvariantsPath, &markertypes.QueryInvariantsRequest{}, &res); err != nil {
+			return nil, err
+		}
+		var problems []string
+		for _, inv := range res.Invariants {
+			if inv.Broken {
+				problems = append(problems, brokenInvariant(inv.Name, inv.Message))
+			}
+		}
+		return problems, nil
+	})
+	app.healthService.AddCheck(metadatatypes.ModuleName, func() ([]string, error) {
+		var res metadatatypes.InvariantsResponse
+		if err := app.queryLatest(metadataInvariantsPath, &metadatatypes.InvariantsRequest{}, &res); err != nil {
+			return nil, err
+		}
+		var problems []string
+		for _, inv := range res.Invariants {
+			if inv.Broken {
+				problems = append(problems, brokenInvariant(inv.Name, inv.Message))
+			}
+		}
+		return problems, nil
+	})
+	if app.archiveVerifier != nil {
+		app.healthService.AddTask(archive.Task, app.archiveVerifier.Progress)
+	}
+	app.healthService.AddWarning(skipupgrade.Warning, func() []string {
+		return skipupgrade.Warnings(homePath)
+	})
+}
+
+// StartHealthServer starts serving the health and readiness endpoints when they have been enabled in app.toml.
+func (app *App) StartHealthServer(logger log.Logger) error {
+	if app.healthService == nil {
+		return nil
+	}
+	return app.healthService.Start(logger)
+}
+
+// StartArchiveVerification verifies the stored heights in the background when requested with the archive verify flag.
+func (app *App) StartArchiveVerification(logger log.Logger) {
+	if app.archiveVerifier == nil {
+		return
+	}
+	go app.archiveVerifier.Run(logger.With("module", "archive"))
+}
+
+// queryLatest runs a gRPC query against the latest committed state.
+func (app *App) queryLatest(path string, req, res codec.ProtoMarshaler) error {
+	bz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	resp := app.Query(abci.RequestQuery{Path: path, Data: bz})
+	if !resp.IsOK() {
+		return errors.New(resp.Log)
+	}
+	return res.Unmarshal(resp.Value)
+}
+
+// brokenInvariant describes a broken invariant by its name and the first line of its message.
+func brokenInvariant(name, message string) string {
+	message = strings.TrimSpace(message)
+	if i := strings.Index(message, "\n"); i >= 0 {
+		message = message[:i]
+	}
+	return fmt.Sprintf("%s: %s", name, message)
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// AuthorityFields are the names of msg fields holding an address that must have authorized the msg.
+var AuthorityFields = []string{"administrator", "authority", "from_address", "granter", "owner", "sender", "signers"}
+
+// MsgSignerAudit is the result of checking the signers of a registered Msg type.
+type MsgSignerAudit struct {
+	// TypeURL is the type url of the msg, e.g. /provenance.marker.v1.MsgAddAccessRequest
+	TypeURL string `json:"type_url" yaml:"type_url"`
+	// Signers are the msg fields whose addresses are returned by GetSigners.
+	Signers []string `json:"signers" yaml:"signers"`
+	// Uncovered are the authority fields whose addresses are not returned by GetSigners.
+	Uncovered []string `json:"uncovered,omitempty" yaml:"uncovered,omitempty"`
+	// Error is set when GetSigners fails or returns no signers.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// IsProvenance returns true if the msg is defined by one of the provenance modules.
+func (a MsgSignerAudit) IsProvenance() bool {
+	return strings.HasPrefix(a.TypeURL, "/provenance.")
+}
+
+// AuditMsgSigners checks GetSigners of every Msg type registered with the interface registry.  Each address field of
+// the msg (including those of nested messages) is set to a distinct address so the fields returned as signers can be
+// identified.
+func AuditMsgSigners(registry codectypes.InterfaceRegistry) []MsgSignerAudit {
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+	audits := make([]MsgSignerAudit, 0, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		audits = append(audits, auditMsgSigners(registry, typeURL))
+	}
+	return audits
+}
+
+func auditMsgSigners(registry codectypes.InterfaceRegistry, typeURL string) (audit MsgSignerAudit) {
+	audit.TypeURL = typeURL
+	resolved, err := registry.Resolve(typeURL)
+	if err != nil {
+		audit.Error = err.Error()
+		return
+	}
+	msg, ok := resolved.(sdk.Msg)
+	if !ok {
+		audit.Error = fmt.Sprintf("%T is not an sdk.Msg", resolved)
+		return
+	}
+
+	// fields maps the proto path of each address field to the bytes of the address it was given.
+	fields := make(map[string]string)
+	fillAddressFields(reflect.ValueOf(msg).Elem(), "", fields, 0)
+
+	defer func() {
+		if r := recover(); r != nil {
+			audit.Error = fmt.Sprintf("GetSigners panicked: %v", r)
+		}
+	}()
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		audit.Error = "GetSigners returned no signers"
+		return
+	}
+	signed := make(map[string]bool)
+	for _, signer := range signers {
+		signed[string(signer)] = true
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		switch {
+		case signed[fields[name]]:
+			audit.Signers = append(audit.Signers, name)
+		case isAuthorityField(name):
+			audit.Uncovered = append(audit.Uncovered, name)
+		}
+	}
+	return
+}
+
+// fillAddressFields sets each string field of the struct (and those of nested structs) to a distinct address, recording
+// the address bytes used for each field by its proto field path, e.g. parent.address.  String list fields get one address.
+func fillAddressFields(v reflect.Value, prefix string, fields map[string]string, depth int) {
+	if depth > 3 || v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		name := protoFieldName(v.Type().Field(i))
+		if len(name) == 0 || !field.CanSet() {
+			continue
+		}
+		path := prefix + name
+		switch {
+		case field.Kind() == reflect.String:
+			addr := auditAddress(path)
+			field.SetString(auditBech32(path, addr))
+			fields[path] = string(addr)
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+			addr := auditAddress(path)
+			field.Set(reflect.ValueOf([]string{auditBech32(path, addr)}).Convert(field.Type()))
+			fields[path] = string(addr)
+		case field.Kind() == reflect.Struct:
+			fillAddressFields(field, path+".", fields, depth+1)
+		case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct:
+			if _, isMsg := field.Interface().(proto.Message); !isMsg || field.Type() == reflect.TypeOf(&codectypes.Any{}) {
+				continue
+			}
+			field.Set(reflect.New(field.Type().Elem()))
+			fillAddressFields(field.Elem(), path+".", fields, depth+1)
+		}
+	}
+}
+
+// auditAddress returns the address to use for the field at the given path.
+func auditAddress(path string) []byte {
+	return address.Module("msg-signer-audit", []byte(path))
+}
+
+// auditBech32 returns the bech32 string of the address for the field at the given path.  Validator fields get an
+// operator address.
+func auditBech32(path string, addr []byte) string {
+	if strings.Contains(path, "validator") {
+		return sdk.ValAddress(addr).String()
+	}
+	return sdk.AccAddress(addr).String()
+}
+
+// protoFieldName returns the proto name of a generated struct field, or "" if it isn't a proto field.
+func protoFieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
+// isAuthorityField returns true if the last part of the field path is one of the AuthorityFields.
+func isAuthorityField(path string) bool {
+	name := path[strings.LastIndex(path, ".")+1:]
+	for _, f := range AuthorityFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
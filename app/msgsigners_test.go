@@ -0,0 +1,37 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMsgSigners(t *testing.T) {
+	// Msgs whose GetSigners does not return signers for a single populated msg by design.
+	noSigners := map[string]bool{
+		"/cosmos.bank.v1beta1.MsgMultiSend":    true, // signers come from the repeated inputs
+		"/cosmwasm.wasm.v1.MsgIBCCloseChannel": true, // only sent by contracts
+		"/cosmwasm.wasm.v1.MsgIBCSend":         true, // only sent by contracts
+	}
+	// Provenance msgs with authority fields that are intentionally not signers.
+	uncovered := map[string][]string{
+		// the administrator with transfer access moves coin out of the from account.
+		"/provenance.marker.v1.MsgTransferRequest":         {"from_address"},
+		"/provenance.marker.v1.MsgScheduleTransferRequest": {"from_address"},
+	}
+
+	audits := AuditMsgSigners(MakeEncodingConfig().InterfaceRegistry)
+	require.NotEmpty(t, audits, "audits")
+	for _, audit := range audits {
+		if noSigners[audit.TypeURL] {
+			assert.Equal(t, "GetSigners returned no signers", audit.Error, audit.TypeURL)
+			continue
+		}
+		assert.Empty(t, audit.Error, "%s error", audit.TypeURL)
+		assert.NotEmpty(t, audit.Signers, "%s signers", audit.TypeURL)
+		if audit.IsProvenance() {
+			assert.Equal(t, uncovered[audit.TypeURL], audit.Uncovered, "%s uncovered authority fields", audit.TypeURL)
+		}
+	}
+}
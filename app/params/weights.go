@@ -36,6 +36,7 @@ const (
 	DefaultWeightRemoveAdministratorProposalContent int = 5
 	DefaultWeightChangeStatusProposalContent        int = 5
 	DefaultWeightSetDenomMetadataProposalContent    int = 5
+	DefaultWeightUpdateMarkerFlagsProposalContent   int = 5
 	DefaultWeightMsgAddMarker                       int = 100
 	DefaultWeightMsgChangeStatus                    int = 10
 	DefaultWeightMsgAddAccess                       int = 10
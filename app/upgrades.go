@@ -1,14 +1,18 @@
 package app
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
 
+	"github.com/provenance-io/provenance/internal/skipupgrade"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
@@ -19,6 +23,19 @@ var (
 	}
 )
 
+const (
+	// EventTypeUpgradeParamChange is the type of the event emitted for each param changed by an upgrade.
+	EventTypeUpgradeParamChange = "upgrade_param_change"
+	// AttributeKeyPlan is the name of the upgrade plan that changed the param.
+	AttributeKeyPlan = "plan"
+	// AttributeKeyParam is the changed param as "<subspace>/<key>".
+	AttributeKeyParam = "param"
+	// AttributeKeyOldValue is the JSON value of the param before the upgrade, empty if it was not set.
+	AttributeKeyOldValue = "old_value"
+	// AttributeKeyNewValue is the JSON value of the param after the upgrade, empty if it was removed.
+	AttributeKeyNewValue = "new_value"
+)
+
 type appUpgradeHandler = func(*App, sdk.Context, upgradetypes.Plan) (module.VersionMap, error)
 
 type appUpgrade struct {
@@ -68,7 +85,8 @@ var handlers = map[string]appUpgrade{
 			})
 			// Force default unrestricted denom for markers to limit min length of 8 and allow ['.','-'] as separators.
 			app.MarkerKeeper.SetParams(ctx, markertypes.Params{
-				UnrestrictedDenomRegex: `[a-zA-Z][a-zA-Z0-9\-\.]{7,64}`,
+				UnrestrictedDenomRegex:      `[a-zA-Z][a-zA-Z0-9\-\.]{7,64}`,
+				DistributionHoldersPerBlock: markertypes.DefaultDistributionHoldersPerBlock,
 			})
 			return app.UpgradeKeeper.GetModuleVersionMap(ctx), nil
 		},
@@ -134,7 +152,7 @@ func InstallCustomUpgradeHandlers(app *App) {
 				return ref.Handler(app, ctx, plan)
 			}
 		}
-		app.UpgradeKeeper.SetUpgradeHandler(name, handler)
+		app.UpgradeKeeper.SetUpgradeHandler(name, withParamDiff(app, handler))
 	}
 }
 
@@ -176,6 +194,85 @@ func CustomUpgradeStoreLoader(app *App, info storetypes.UpgradeInfo) baseapp.Sto
 	return nil
 }
 
+// checkSkippedUpgrade halts the node instead of skipping an upgrade plan that is due at one of the skipped heights but
+// does not have the name given for that height, so a mis-typed height cannot skip an unintended upgrade.
+func (app *App) checkSkippedUpgrade(ctx sdk.Context) {
+	plan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	if !found || !plan.ShouldExecute(ctx) || !app.UpgradeKeeper.IsSkipHeight(plan.Height) {
+		return
+	}
+	if err := skipupgrade.CheckPlan(app.skipUpgrades, plan); err != nil {
+		ctx.Logger().Error("UNSAFE: skipped upgrade does not match", "plan", plan.Name, "height", plan.Height, "err", err)
+		panic(err)
+	}
+}
+
 func isEmptyUpgrade(upgrades storetypes.StoreUpgrades) bool {
 	return len(upgrades.Renamed) == 0 && len(upgrades.Deleted) == 0 && len(upgrades.Added) == 0
 }
+
+// paramChange is a param whose value was changed by an upgrade.
+type paramChange struct {
+	// Param is the param as "<subspace>/<key>".
+	Param    string
+	OldValue string
+	NewValue string
+}
+
+// withParamDiff wraps an upgrade handler so the params of every module are snapshot before it runs and any changes
+// are logged and emitted as events afterward.
+func withParamDiff(app *App, handler upgradetypes.UpgradeHandler) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, versionMap module.VersionMap) (module.VersionMap, error) {
+		before := snapshotParams(app, ctx)
+		newVM, err := handler(ctx, plan, versionMap)
+		if err != nil {
+			return newVM, err
+		}
+		changes := diffParams(before, snapshotParams(app, ctx))
+		ctx.Logger().Info("Upgrade param changes", "plan", plan.Name, "count", len(changes))
+		for _, change := range changes {
+			ctx.Logger().Info("Upgrade changed param",
+				"plan", plan.Name,
+				"param", change.Param,
+				"old", change.OldValue,
+				"new", change.NewValue,
+			)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				EventTypeUpgradeParamChange,
+				sdk.NewAttribute(AttributeKeyPlan, plan.Name),
+				sdk.NewAttribute(AttributeKeyParam, change.Param),
+				sdk.NewAttribute(AttributeKeyOldValue, change.OldValue),
+				sdk.NewAttribute(AttributeKeyNewValue, change.NewValue),
+			))
+		}
+		return newVM, nil
+	}
+}
+
+// snapshotParams returns the JSON value of every param of every module keyed by "<subspace>/<key>".
+func snapshotParams(app *App, ctx sdk.Context) map[string]string {
+	snapshot := make(map[string]string)
+	it := ctx.KVStore(app.keys[paramstypes.StoreKey]).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		snapshot[string(it.Key())] = string(it.Value())
+	}
+	return snapshot
+}
+
+// diffParams returns the params that were added, removed, or changed between the snapshots, sorted by param.
+func diffParams(before, after map[string]string) []paramChange {
+	var changes []paramChange
+	for param, oldValue := range before {
+		if newValue, found := after[param]; !found || newValue != oldValue {
+			changes = append(changes, paramChange{Param: param, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for param, newValue := range after {
+		if _, found := before[param]; !found {
+			changes = append(changes, paramChange{Param: param, NewValue: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Param < changes[j].Param })
+	return changes
+}
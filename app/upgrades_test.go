@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestUpgradeParamDiff(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	handler := withParamDiff(app, func(ctx sdk.Context, plan upgradetypes.Plan, versionMap module.VersionMap) (module.VersionMap, error) {
+		return handlers["bluetiful"].Handler(app, ctx, plan)
+	})
+	_, err := handler(ctx, upgradetypes.Plan{Name: "bluetiful"}, module.VersionMap{})
+	require.NoError(t, err)
+
+	var changed []string
+	for _, event := range ctx.EventManager().Events() {
+		require.Equal(t, EventTypeUpgradeParamChange, event.Type)
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		require.Equal(t, "bluetiful", attrs[AttributeKeyPlan])
+		require.NotEqual(t, attrs[AttributeKeyOldValue], attrs[AttributeKeyNewValue])
+		changed = append(changed, attrs[AttributeKeyParam])
+	}
+	require.Contains(t, changed, "marker/UnrestrictedDenomRegex")
+	require.Contains(t, changed, "marker/MaxTotalSupply", "params cleared by the handler")
+}
+
+func TestDiffParams(t *testing.T) {
+	before := map[string]string{"a/kept": `"1"`, "a/changed": `"1"`, "b/removed": `"1"`}
+	after := map[string]string{"a/kept": `"1"`, "a/changed": `"2"`, "c/added": `"3"`}
+	require.Equal(t, []paramChange{
+		{Param: "a/changed", OldValue: `"1"`, NewValue: `"2"`},
+		{Param: "b/removed", OldValue: `"1"`},
+		{Param: "c/added", NewValue: `"3"`},
+	}, diffParams(before, after))
+	require.Empty(t, diffParams(before, before))
+}
@@ -1,17 +1,23 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
+	"github.com/provenance-io/provenance/internal/nodeconfig"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+	tmjsonrpc "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // Cmd returns a CLI command to interactively create an application CLI
@@ -23,6 +29,501 @@ func ClientConfigCmd() *cobra.Command {
 		RunE:  runClientConfigCmd,
 		Args:  cobra.RangeArgs(0, 2),
 	}
+	cmd.AddCommand(
+		NodeConfigGetCmd(),
+		ConfigChangedCmd(),
+		ConfigSchemaCmd(),
+		ConfigSetCmd(),
+		ConfigAddPeerCmd(),
+		ConfigRemovePeerCmd(),
+		ConfigRotateSeedsCmd(),
+		ConfigHomeCmd(),
+		ConfigInitClientCmd(),
+	)
+	return cmd
+}
+
+// ConfigSetCmd returns a CLI command to set a client config value or one of the node settings that can be changed
+// without editing the config files.
+func ConfigSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Set a client configuration value or a node log setting",
+		Long: fmt.Sprintf(`Set a client configuration value, or the %[1]s of the node in app.toml.
+The %[1]s is a JSON object of module names to the log level to use for that module instead of the log_level.`,
+			config.FlagLogLevelOverrides),
+		Example: fmt.Sprintf(`$ %[1]s config set chain-id pio-testnet-1
+$ %[1]s config set %[2]s '{"x/marker":"debug","x/metadata":"error"}'`, version.AppName, config.FlagLogLevelOverrides),
+		Args: cobra.ExactArgs(2),
+		RunE: runConfigSetCmd,
+	}
+	return cmd
+}
+
+func runConfigSetCmd(cmd *cobra.Command, args []string) error {
+	if args[0] != config.FlagLogLevelOverrides {
+		return runClientConfigCmd(cmd, args)
+	}
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	appCfgFile := filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+	if err := config.WriteLogLevelOverrides(appCfgFile, args[1]); err != nil {
+		return fmt.Errorf("could not set %s: %v", args[0], err)
+	}
+	return nil
+}
+
+// FlagSeeds is the flag for changing the p2p seeds instead of the persistent peers.
+const FlagSeeds = "seeds"
+
+// ConfigAddPeerCmd returns a CLI command to add peers to the persistent peers or seeds of the node.
+func ConfigAddPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-peer <nodeID@host:port> [<nodeID@host:port> ...]",
+		Short: "Add peers to the persistent peers (or seeds) in config.toml",
+		Long: fmt.Sprintf(`Add peers to the p2p.%[1]s (or p2p.%[2]s with --%[3]s) in config.toml.
+Each peer must be in nodeID@host:port format.  A peer with the same node id as an existing entry replaces it.`,
+			config.KeyPersistentPeers, config.KeySeeds, FlagSeeds),
+		Example: fmt.Sprintf(`$ %[1]s config add-peer 3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:26656
+$ %[1]s config add-peer 3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@seed.example.com:26656 --%[2]s`, version.AppName, FlagSeeds),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updatePeers(cmd, func(peers []string) ([]string, error) {
+				return config.AddPeers(peers, args...)
+			})
+		},
+	}
+	cmd.Flags().Bool(FlagSeeds, false, "Change the seeds instead of the persistent peers")
+	return cmd
+}
+
+// ConfigRemovePeerCmd returns a CLI command to remove peers from the persistent peers or seeds of the node.
+func ConfigRemovePeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-peer <nodeID|nodeID@host:port> [<nodeID|nodeID@host:port> ...]",
+		Short: "Remove peers from the persistent peers (or seeds) in config.toml",
+		Long: fmt.Sprintf(`Remove peers from the p2p.%[1]s (or p2p.%[2]s with --%[3]s) in config.toml.
+Peers are matched by their node id.`,
+			config.KeyPersistentPeers, config.KeySeeds, FlagSeeds),
+		Example: fmt.Sprintf(`$ %[1]s config remove-peer 3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c
+$ %[1]s config remove-peer 3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c --%[2]s`, version.AppName, FlagSeeds),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updatePeers(cmd, func(peers []string) ([]string, error) {
+				return config.RemovePeers(peers, args...)
+			})
+		},
+	}
+	cmd.Flags().Bool(FlagSeeds, false, "Change the seeds instead of the persistent peers")
+	return cmd
+}
+
+// ConfigRotateSeedsCmd returns a CLI command to replace all of the seeds of the node.
+func ConfigRotateSeedsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-seeds <nodeID@host:port> [<nodeID@host:port> ...]",
+		Short: "Replace the seeds in config.toml",
+		Long: fmt.Sprintf(`Replace all of the p2p.%s in config.toml with the given seeds.
+Each seed must be in nodeID@host:port format, duplicate node ids are removed.`, config.KeySeeds),
+		Example: fmt.Sprintf(`$ %[1]s config rotate-seeds 3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@seed-1.example.com:26656 4b9f2c5edf1a7c2f2cadcb1f1d9f6a4b5f4a3c2d@seed-2.example.com:26656`,
+			version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updatePeers(cmd, func([]string) ([]string, error) {
+				return config.AddPeers(nil, args...)
+			})
+		},
+	}
+	return cmd
+}
+
+// updatePeers applies the update to the persistent peers, or the seeds if the seeds flag is set or the command has no
+// such flag, in config.toml and outputs the resulting list.
+func updatePeers(cmd *cobra.Command, update func(peers []string) ([]string, error)) error {
+	key := config.KeySeeds
+	if seeds, err := cmd.Flags().GetBool(FlagSeeds); err == nil && !seeds {
+		key = config.KeyPersistentPeers
+	}
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	tmCfgFile := filepath.Join(clientCtx.HomeDir, "config", "config.toml")
+	peers, err := config.ReadPeers(tmCfgFile, key)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %v", key, err)
+	}
+	if peers, err = update(peers); err != nil {
+		return err
+	}
+	if err = config.WritePeers(tmCfgFile, key, peers); err != nil {
+		return fmt.Errorf("could not set %s: %v", key, err)
+	}
+	cmd.Println(strings.Join(peers, ","))
+	return nil
+}
+
+// ConfigHomeCmd returns a CLI command to manage the named home directory profiles.
+func ConfigHomeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "home",
+		Short: "Manage named home directory profiles",
+		Long: fmt.Sprintf(`Manage named home directory profiles, e.g. validator, testnet and localnet, kept in the user-level %[1]s.
+Commands run without --home (or $PIO_HOME) use the home of the active profile, along with its chain-id and node in
+place of the ones in that home's client.toml.`, config.HomesFileName),
+		RunE: client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		ConfigHomeListCmd(),
+		ConfigHomeAddCmd(),
+		ConfigHomeUseCmd(),
+		ConfigHomeRemoveCmd(),
+	)
+	return cmd
+}
+
+// ConfigHomeListCmd returns a CLI command to list the home directory profiles.
+func ConfigHomeListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the home directory profiles, marking the active one with a *",
+		Example: fmt.Sprintf(`$ %s config home list`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateHomeProfiles(cmd, func(profiles *config.HomeProfiles) (bool, error) {
+				for _, name := range profiles.Names() {
+					profile := profiles.Profiles[name]
+					active := " "
+					if name == profiles.Active {
+						active = "*"
+					}
+					cmd.Printf("%s %s\t%s\tchain-id=%q node=%q\n", active, name, profile.Home, profile.ChainID, profile.Node)
+				}
+				return false, nil
+			})
+		},
+	}
+	return cmd
+}
+
+// ConfigHomeAddCmd returns a CLI command to add or replace a home directory profile.
+func ConfigHomeAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <name> <home>",
+		Short: "Add or replace a home directory profile",
+		Long: `Add or replace a home directory profile.
+The profile's chain-id and node, when given, are used in place of the ones in the home's client.toml.`,
+		Example: fmt.Sprintf(`$ %[1]s config home add validator /opt/provenance --%[2]s pio-mainnet-1
+$ %[1]s config home add testnet ~/.provenance-testnet --%[2]s pio-testnet-1 --%[3]s tcp://rpc.test.example.com:26657`,
+			version.AppName, flags.FlagChainID, flags.FlagNode),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			node, err := cmd.Flags().GetString(flags.FlagNode)
+			if err != nil {
+				return err
+			}
+			return updateHomeProfiles(cmd, func(profiles *config.HomeProfiles) (bool, error) {
+				return true, profiles.Add(args[0], config.HomeProfile{Home: args[1], ChainID: chainID, Node: node})
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID to use with the home")
+	cmd.Flags().String(flags.FlagNode, "", "<host>:<port> to Tendermint RPC interface to use with the home")
+	return cmd
+}
+
+// ConfigHomeUseCmd returns a CLI command to change the active home directory profile.
+func ConfigHomeUseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Use the home directory profile for the commands run without --home",
+		Long: `Use the home directory profile for the commands run without --home.
+An empty name stops using profiles, so that the default home is used again.`,
+		Example: fmt.Sprintf(`$ %[1]s config home use testnet
+$ %[1]s config home use ""`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateHomeProfiles(cmd, func(profiles *config.HomeProfiles) (bool, error) {
+				return true, profiles.Use(args[0])
+			})
+		},
+	}
+	return cmd
+}
+
+// ConfigHomeRemoveCmd returns a CLI command to remove a home directory profile.
+func ConfigHomeRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Short:   "Remove a home directory profile, the home directory itself is left as it is",
+		Example: fmt.Sprintf(`$ %s config home remove localnet`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateHomeProfiles(cmd, func(profiles *config.HomeProfiles) (bool, error) {
+				return true, profiles.Remove(args[0])
+			})
+		},
+	}
+	return cmd
+}
+
+// updateHomeProfiles reads the user's home directory profiles, applies the update, and writes them back if the update
+// changed them.
+func updateHomeProfiles(cmd *cobra.Command, update func(profiles *config.HomeProfiles) (bool, error)) error {
+	homesFile, err := config.DefaultHomesFile()
+	if err != nil {
+		return err
+	}
+	profiles, err := config.ReadHomeProfiles(homesFile)
+	if err != nil {
+		return fmt.Errorf("could not read home profiles: %v", err)
+	}
+	changed, err := update(profiles)
+	if err != nil || !changed {
+		return err
+	}
+	if err = config.WriteHomeProfiles(homesFile, profiles); err != nil {
+		return fmt.Errorf("could not write home profiles: %v", err)
+	}
+	return nil
+}
+
+const (
+	// FlagChain is the flag for the name of the network to set up the client config for.
+	FlagChain = "chain"
+	// FlagSkipVerify is the flag for writing the client config without checking that the node is reachable.
+	FlagSkipVerify = "skip-verify"
+
+	// initClientTimeout is how long to wait for the nodes of a network to respond.
+	initClientTimeout = 15 * time.Second
+)
+
+// ConfigInitClientCmd returns a CLI command to set up client.toml for one of the public networks.
+func ConfigInitClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-client",
+		Short: "Set up client.toml to use one of the public networks",
+		Long: fmt.Sprintf(`Set up the chain-id, node and broadcast-mode in client.toml to use one of the public networks (%[1]s).
+The first of the network's nodes (or the --%[2]s given) that is reachable and on the network's chain is used.
+Use --%[3]s to write the config without checking the nodes.`,
+			strings.Join(config.ClientNetworkNames(), ", "), flags.FlagNode, FlagSkipVerify),
+		Example: fmt.Sprintf(`$ %[1]s config init-client --%[2]s mainnet
+$ %[1]s config init-client --%[2]s testnet --%[3]s tcp://localhost:26657`,
+			version.AppName, FlagChain, flags.FlagNode),
+		Args: cobra.NoArgs,
+		RunE: runConfigInitClientCmd,
+	}
+	cmd.Flags().String(FlagChain, "", fmt.Sprintf("The network to use, one of: %s", strings.Join(config.ClientNetworkNames(), ", ")))
+	cmd.Flags().String(flags.FlagNode, "", "<host>:<port> to Tendermint RPC interface to use instead of the network's public nodes")
+	cmd.Flags().String(flags.FlagBroadcastMode, flags.BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+	cmd.Flags().Bool(FlagSkipVerify, false, "Write the config without checking that the node is reachable")
+	if err := cmd.MarkFlagRequired(FlagChain); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func runConfigInitClientCmd(cmd *cobra.Command, args []string) error {
+	chain, err := cmd.Flags().GetString(FlagChain)
+	if err != nil {
+		return err
+	}
+	network, err := config.GetClientNetwork(chain)
+	if err != nil {
+		return err
+	}
+	node, err := cmd.Flags().GetString(flags.FlagNode)
+	if err != nil {
+		return err
+	}
+	nodes := network.Nodes
+	if len(node) > 0 {
+		nodes = []string{node}
+	}
+	broadcastMode, err := cmd.Flags().GetString(flags.FlagBroadcastMode)
+	if err != nil {
+		return err
+	}
+	switch broadcastMode {
+	case flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+	default:
+		return fmt.Errorf("invalid broadcast mode %q, must be one of: sync, async, block", broadcastMode)
+	}
+	skipVerify, err := cmd.Flags().GetBool(FlagSkipVerify)
+	if err != nil {
+		return err
+	}
+
+	node = nodes[0]
+	if !skipVerify {
+		ctx, cancel := context.WithTimeout(cmd.Context(), initClientTimeout)
+		defer cancel()
+		if node, err = config.FindNode(ctx, network.ChainID, nodes); err != nil {
+			return err
+		}
+	}
+
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	configPath := filepath.Join(clientCtx.HomeDir, "config")
+	conf, err := config.GetClientConfig(configPath, clientCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("couldn't get client config: %v", err)
+	}
+	conf.SetChainID(network.ChainID)
+	conf.SetNode(node)
+	conf.SetBroadcastMode(broadcastMode)
+	if err = config.WriteConfigToFile(filepath.Join(configPath, "client.toml"), conf); err != nil {
+		return fmt.Errorf("could not write client config to the file: %v", err)
+	}
+	cmd.Printf("client.toml set up for %s (%s) using node %s\n", chain, network.ChainID, node)
+	return nil
+}
+
+const (
+	// FlagAuthToken is the flag for the token required by a node to query its configuration.
+	FlagAuthToken = "auth-token"
+	// FlagLayer is the flag for also showing the config file layer each value came from.
+	FlagLayer = "layer"
+)
+
+// NodeConfigGetCmd returns a CLI command to query the effective configuration of a running node.
+func NodeConfigGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [key]",
+		Short: "Query the effective configuration of a running node",
+		Long: fmt.Sprintf(`Query the effective configuration of a running node over its Tendermint RPC interface.
+The node must have %s enabled in its app.toml and the provided auth token must match its %s.
+With --%s, each value is shown with the config file layer it came from: config.toml, app.toml, a %s/ fragment, or %s.`,
+			nodeconfig.FlagEnable, nodeconfig.FlagAuthToken, FlagLayer, nodeconfig.LayerDir, nodeconfig.DefaultLayer),
+		Example: fmt.Sprintf(`$ %[1]s config get --node tcp://localhost:26657 --%[2]s <token>
+$ %[1]s config get minimum-gas-prices --node tcp://localhost:26657 --%[2]s <token>
+$ %[1]s config get minimum-gas-prices --node tcp://localhost:26657 --%[2]s <token> --%[3]s`,
+			version.AppName, FlagAuthToken, FlagLayer),
+		Args: cobra.MaximumNArgs(1),
+		RunE: runNodeConfigGetCmd,
+	}
+	cmd.Flags().String(flags.FlagNode, "", "<host>:<port> to Tendermint RPC interface of the node (defaults to the client config node)")
+	cmd.Flags().String(FlagAuthToken, "", "The auth token configured on the node for configuration queries")
+	cmd.Flags().Bool(FlagLayer, false, "Also show the config file layer each value came from")
+	return cmd
+}
+
+func runNodeConfigGetCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	node, err := cmd.Flags().GetString(flags.FlagNode)
+	if err != nil {
+		return err
+	}
+	if len(node) == 0 {
+		node = clientCtx.NodeURI
+	}
+	token, err := cmd.Flags().GetString(FlagAuthToken)
+	if err != nil {
+		return err
+	}
+	showLayer, err := cmd.Flags().GetBool(FlagLayer)
+	if err != nil {
+		return err
+	}
+
+	rpcClient, err := tmjsonrpc.New(node)
+	if err != nil {
+		return fmt.Errorf("couldn't get client from nodeURI: %v", err)
+	}
+	result := new(nodeconfig.ResultNodeConfig)
+	if _, err = rpcClient.Call(cmd.Context(), nodeconfig.Route, map[string]interface{}{"token": token}, result); err != nil {
+		return fmt.Errorf("couldn't query node config: %v", err)
+	}
+
+	layer := func(key string) string {
+		if source, found := result.Sources[key]; found {
+			return source
+		}
+		return nodeconfig.DefaultLayer
+	}
+	if len(args) == 0 {
+		var out interface{} = result.Config
+		if showLayer {
+			layered := make(map[string]layeredValue, len(result.Config))
+			for key, value := range result.Config {
+				layered[key] = layeredValue{Value: value, Layer: layer(key)}
+			}
+			out = layered
+		}
+		s, err := json.MarshalIndent(out, "", "\t")
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(s))
+		return nil
+	}
+	value, found := result.Config[args[0]]
+	if !found {
+		return errUnknownConfigKey(args[0])
+	}
+	if showLayer {
+		cmd.Printf("%s (%s)\n", value, layer(args[0]))
+		return nil
+	}
+	cmd.Println(value)
+	return nil
+}
+
+// layeredValue is a node setting along with the config file layer it came from.
+type layeredValue struct {
+	Value string `json:"value"`
+	Layer string `json:"layer"`
+}
+
+// ConfigChangedCmd returns a CLI command to list the node settings that differ from the defaults.
+func ConfigChangedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changed",
+		Short: "List the node settings that differ from the defaults",
+		Long: fmt.Sprintf(`List the settings of config.toml, app.toml and the %[1]s/ fragments of the node that differ from the defaults.
+The fragments in %[1]s/ are merged over config.toml and app.toml in lexical order of their file names, so later files
+take precedence. Each setting is shown with the config file layer its value came from.`, nodeconfig.LayerDir),
+		Example: fmt.Sprintf(`$ %s config changed`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			changed, err := config.ChangedSettings(filepath.Join(clientCtx.HomeDir, "config"))
+			if err != nil {
+				return fmt.Errorf("could not read node config: %v", err)
+			}
+			for _, setting := range changed {
+				cmd.Println(setting.String())
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// ConfigSchemaCmd returns a CLI command to output a JSON schema of the app, tendermint and client config settings.
+func ConfigSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Output a JSON schema of all the configuration settings",
+		Long: fmt.Sprintf(`Output a JSON schema of the settings of app.toml (%[1]q), config.toml (%[2]q) and client.toml (%[3]q).
+Each setting is keyed by its dotted name and has its type, the default written for a new node, the description from
+the config file and the section (TOML table) it is in.`,
+			config.SchemaConfigApp, config.SchemaConfigTendermint, config.SchemaConfigClient),
+		Example: fmt.Sprintf(`$ %s config schema > config-schema.json`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := config.GetConfigSchema()
+			if err != nil {
+				return fmt.Errorf("could not build config schema: %v", err)
+			}
+			s, err := json.MarshalIndent(schema, "", "\t")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(s))
+			return nil
+		},
+	}
 	return cmd
 }
 
@@ -3,7 +3,13 @@ package cmd_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -50,6 +56,24 @@ func TestClientConfigList(t *testing.T) {
 	require.Equal(t, "{\n\t\"chain-id\": \"\",\n\t\"keyring-backend\": \"test\",\n\t\"output\": \"text\",\n\t\"node\": \"tcp://localhost:26657\",\n\t\"broadcast-mode\": \"block\"\n}", outStr)
 }
 
+func TestConfigSchemaCmd(t *testing.T) {
+	command := cmd.ConfigSchemaCmd()
+	b := bytes.NewBufferString("")
+	command.SetOut(b)
+	command.SetArgs([]string{})
+	require.NoError(t, command.ExecuteContext(context.Background()))
+
+	var schema config.Schema
+	require.NoError(t, json.Unmarshal(b.Bytes(), &schema))
+	require.Equal(t, "http://json-schema.org/draft-07/schema#", schema.Schema)
+	require.Contains(t, schema.Properties, config.SchemaConfigApp)
+	require.Contains(t, schema.Properties, config.SchemaConfigTendermint)
+	field, found := schema.Properties[config.SchemaConfigClient].Properties["chain-id"]
+	require.True(t, found, "client chain-id")
+	require.Equal(t, "string", field.Type)
+	require.Equal(t, "The network chain ID", field.Description)
+}
+
 func TestClientConfigCmdSet(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -139,3 +163,151 @@ func TestClientConfigCmdSet(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigPeerCmds(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithHomeDir(home)
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	run := func(args ...string) (string, error) {
+		command := cmd.ClientConfigCmd()
+		command.SetArgs(args)
+		b := bytes.NewBufferString("")
+		command.SetOut(b)
+		command.SetErr(b)
+		err := command.ExecuteContext(ctx)
+		return strings.TrimSpace(b.String()), err
+	}
+	peer1 := "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:26656"
+	peer2 := "4b9f2c5edf1a7c2f2cadcb1f1d9f6a4b5f4a3c2d@10.0.0.2:26656"
+	seed := "5c0a3d6fe02b8d3a3dbedc2a2e0a7b5c6a5b4d3e@seed.example.com:26656"
+
+	out, err := run("add-peer", peer1, peer2, peer1)
+	require.NoError(t, err)
+	require.Equal(t, peer1+","+peer2, out)
+
+	out, err = run("remove-peer", "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c")
+	require.NoError(t, err)
+	require.Equal(t, peer2, out)
+
+	_, err = run("add-peer", "10.0.0.3:26656")
+	require.Error(t, err)
+
+	out, err = run("add-peer", "--seeds", seed)
+	require.NoError(t, err)
+	require.Equal(t, seed, out)
+
+	out, err = run("rotate-seeds", peer1, peer2)
+	require.NoError(t, err)
+	require.Equal(t, peer1+","+peer2, out)
+
+	bz, err := ioutil.ReadFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), fmt.Sprintf("persistent_peers = %q", peer2))
+	require.Contains(t, string(bz), fmt.Sprintf("seeds = %q", peer1+","+peer2))
+}
+
+func TestConfigHomeCmds(t *testing.T) {
+	configDir := t.TempDir()
+	origConfigDir, hadConfigDir := os.LookupEnv("XDG_CONFIG_HOME")
+	require.NoError(t, os.Setenv("XDG_CONFIG_HOME", configDir))
+	t.Cleanup(func() {
+		if hadConfigDir {
+			os.Setenv("XDG_CONFIG_HOME", origConfigDir)
+		} else {
+			os.Unsetenv("XDG_CONFIG_HOME")
+		}
+	})
+
+	run := func(args ...string) (string, error) {
+		command := cmd.ClientConfigCmd()
+		command.SetArgs(append([]string{"home"}, args...))
+		b := bytes.NewBufferString("")
+		command.SetOut(b)
+		command.SetErr(b)
+		err := command.ExecuteContext(context.Background())
+		return strings.TrimSpace(b.String()), err
+	}
+
+	_, err := run("add", "validator", "/opt/provenance", "--chain-id", "pio-mainnet-1")
+	require.NoError(t, err)
+	_, err = run("add", "testnet", "/opt/testnet", "--node", "tcp://10.0.0.1:26657")
+	require.NoError(t, err)
+	_, err = run("use", "testnet")
+	require.NoError(t, err)
+	_, err = run("use", "localnet")
+	require.EqualError(t, err, `unknown home profile "localnet"`)
+
+	out, err := run("list")
+	require.NoError(t, err)
+	require.Equal(t, "* testnet\t/opt/testnet\tchain-id=\"\" node=\"tcp://10.0.0.1:26657\"\n"+
+		"  validator\t/opt/provenance\tchain-id=\"pio-mainnet-1\" node=\"\"", out)
+
+	_, err = run("remove", "testnet")
+	require.NoError(t, err)
+	profiles, err := config.ReadHomeProfiles(filepath.Join(configDir, "provenanced", config.HomesFileName))
+	require.NoError(t, err)
+	require.Empty(t, profiles.Active)
+	require.Equal(t, []string{"validator"}, profiles.Names())
+}
+
+func TestConfigInitClientCmd(t *testing.T) {
+	home := t.TempDir()
+	clientCtx := client.Context{}.WithHomeDir(home).WithViper("")
+	clientCtx, err := config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// a node that answers status queries as a node of the testnet
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"node_info":{"network":"pio-testnet-1"}}}`, req.ID)
+	}))
+	defer rpc.Close()
+
+	run := func(args ...string) (string, error) {
+		command := cmd.ClientConfigCmd()
+		command.SetArgs(append([]string{"init-client"}, args...))
+		b := bytes.NewBufferString("")
+		command.SetOut(b)
+		command.SetErr(b)
+		err := command.ExecuteContext(ctx)
+		return strings.TrimSpace(b.String()), err
+	}
+	readConfig := func() *config.ClientConfig {
+		conf, err := config.GetClientConfig(filepath.Join(home, "config"), viper.New())
+		require.NoError(t, err)
+		return conf
+	}
+
+	_, err = run("--chain", "devnet")
+	require.EqualError(t, err, `unknown network "devnet", must be one of: mainnet, testnet`)
+	_, err = run("--chain", "mainnet", "--node", rpc.URL)
+	require.EqualError(t, err, fmt.Sprintf(`no reachable node for chain "pio-mainnet-1": node %s is on chain "pio-testnet-1", not "pio-mainnet-1"`, rpc.URL))
+	require.Equal(t, "", readConfig().ChainID, "config is not changed when the node cannot be verified")
+
+	out, err := run("--chain", "testnet", "--node", rpc.URL)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("client.toml set up for testnet (pio-testnet-1) using node %s", rpc.URL), out)
+	conf := readConfig()
+	require.Equal(t, "pio-testnet-1", conf.ChainID)
+	require.Equal(t, rpc.URL, conf.Node)
+	require.Equal(t, "sync", conf.BroadcastMode)
+	require.Equal(t, "test", conf.KeyringBackend, "other settings are left as they are")
+
+	_, err = run("--chain", "mainnet", "--skip-verify", "--broadcast-mode", "block")
+	require.NoError(t, err)
+	conf = readConfig()
+	require.Equal(t, "pio-mainnet-1", conf.ChainID)
+	require.Equal(t, config.ClientNetworks["mainnet"].Nodes[0], conf.Node)
+	require.Equal(t, "block", conf.BroadcastMode)
+}
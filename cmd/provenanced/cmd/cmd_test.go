@@ -1,14 +1,33 @@
 package cmd_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
+	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 func TestInitCmd(t *testing.T) {
@@ -22,3 +41,141 @@ func TestInitCmd(t *testing.T) {
 	err := cmd.Execute(rootCmd)
 	require.NoError(t, err)
 }
+
+func TestDebugMsgSignersCmd(t *testing.T) {
+	msgSignersCmd := cmd.MsgSignersCmd()
+	var out bytes.Buffer
+	msgSignersCmd.SetOut(&out)
+	msgSignersCmd.SetArgs([]string{})
+
+	err := msgSignersCmd.Execute()
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "/provenance.marker.v1.MsgTransferRequest\n  signers: administrator\n  uncovered: from_address\n")
+	require.NotContains(t, out.String(), "/cosmos.bank.v1beta1.MsgSend")
+}
+
+func TestDebugModuleHashesCmd(t *testing.T) {
+	home := t.TempDir()
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	ms := rootmulti.NewStore(db)
+	key := storetypes.NewKVStoreKey("marker")
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(key).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+	commitHash := ms.GetCommitKVStore(key).LastCommitID().Hash
+	require.NoError(t, db.Close())
+
+	moduleHashes := func(args ...string) (string, error) {
+		moduleHashesCmd := cmd.ModuleHashesCmd()
+		var out bytes.Buffer
+		moduleHashesCmd.SetOut(&out)
+		moduleHashesCmd.SetErr(&out)
+		moduleHashesCmd.SetArgs(args)
+		clientCtx := client.Context{}.WithHomeDir(home)
+		err := moduleHashesCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+		return out.String(), err
+	}
+
+	out, err := moduleHashes()
+	require.NoError(t, err)
+	require.Contains(t, out, "height: 1\n")
+	require.Regexp(t, fmt.Sprintf(`marker +1 +[0-9A-F]{64} +%X\n`, commitHash), out)
+
+	_, err = moduleHashes("--height", "2")
+	require.EqualError(t, err, "no commit found for height 2")
+}
+
+func TestSignBatchFileCmd(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	home := t.TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil)
+	require.NoError(t, err)
+	info, _, err := kr.NewMnemonic("admin", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	admin := info.GetAddress()
+	holder := sdk.AccAddress("holder______________")
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithHomeDir(home).
+		WithKeyring(kr).
+		WithChainID("test-chain")
+
+	writeMsgs := func(name string, msgs ...sdk.Msg) string {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+		}
+		bz, err := encCfg.Marshaler.MarshalJSON(&txtypes.TxBody{Messages: anys})
+		require.NoError(t, err)
+		file := filepath.Join(home, name)
+		require.NoError(t, ioutil.WriteFile(file, bz, 0644))
+		return file
+	}
+	signBatchFile := func(args ...string) (string, error) {
+		signCmd := cmd.GetSignBatchFileCmd()
+		var out bytes.Buffer
+		signCmd.SetOut(&out)
+		signCmd.SetErr(&out)
+		signCmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "admin"),
+			fmt.Sprintf("--%s", flags.FlagOffline),
+			fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, 3),
+			fmt.Sprintf("--%s=%d", flags.FlagSequence, 7),
+		))
+		ctx := clientCtx
+		err := signCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &ctx))
+		return out.String(), err
+	}
+
+	coin := sdk.NewInt64Coin("batchcoin", 10)
+	file := writeMsgs("distribution.json",
+		markertypes.NewMsgTransferRequest(admin, admin, holder, coin),
+		markertypes.NewMsgWithdrawRequest(admin, holder, coin.Denom, sdk.NewCoins(coin)),
+		markertypes.NewMsgTransferRequest(admin, holder, admin, coin),
+	)
+
+	t.Run("single tx", func(t *testing.T) {
+		out, err := signBatchFile(file)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		require.Len(t, lines, 1)
+		signedTx, err := encCfg.TxConfig.TxJSONDecoder()([]byte(lines[0]))
+		require.NoError(t, err)
+		require.Len(t, signedTx.GetMsgs(), 3)
+	})
+
+	t.Run("series of txs with incremented sequences", func(t *testing.T) {
+		out, err := signBatchFile(file, "--msgs-per-tx=2")
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		require.Len(t, lines, 2)
+		for i, line := range lines {
+			signedTx, err := encCfg.TxConfig.TxJSONDecoder()([]byte(line))
+			require.NoError(t, err)
+			require.Len(t, signedTx.GetMsgs(), 2-i)
+			sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.Equal(t, uint64(7+i), sigs[0].Sequence)
+		}
+	})
+
+	t.Run("msg that is not a marker distribution", func(t *testing.T) {
+		other := writeMsgs("other.json", banktypes.NewMsgSend(admin, holder, sdk.NewCoins(coin)))
+		_, err := signBatchFile(other)
+		require.EqualError(t, err, "message 0: *types.MsgSend is not a marker transfer or withdraw msg")
+	})
+
+	t.Run("msg administered by another account", func(t *testing.T) {
+		other := writeMsgs("other.json", markertypes.NewMsgTransferRequest(holder, holder, admin, coin))
+		_, err := signBatchFile(other)
+		require.EqualError(t, err, fmt.Sprintf("message 0: must be signed by %s only", admin))
+	})
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/internal/txmemo"
+)
+
+// hexRegexp matches an input that is hex encoded rather than base64 encoded.
+var hexRegexp = regexp.MustCompile(`^(0x)?([0-9a-fA-F]{2})+$`)
+
+// DecodedTxResult is the readable form of the result of a transaction.
+type DecodedTxResult struct {
+	Code         uint32               `json:"code"`
+	Codespace    string               `json:"codespace,omitempty"`
+	Log          string               `json:"log,omitempty"`
+	GasWanted    int64                `json:"gas_wanted"`
+	GasUsed      int64                `json:"gas_used"`
+	MsgResponses []DecodedMsgResponse `json:"msg_responses,omitempty"`
+	Events       []DecodedEvent       `json:"events,omitempty"`
+}
+
+// DecodedMsgResponse is the response of one of the msgs of a transaction.  The response is left base64 encoded in
+// Data when its type is not known.
+type DecodedMsgResponse struct {
+	MsgType  string          `json:"msg_type"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Data     []byte          `json:"data,omitempty"`
+}
+
+// DecodedEvent is an event emitted by a transaction.  Typed events are decoded into their proto message, the
+// attributes of all other events are given as strings.
+type DecodedEvent struct {
+	Type       string             `json:"type"`
+	TypedEvent json.RawMessage    `json:"typed_event,omitempty"`
+	Attributes []DecodedAttribute `json:"attributes,omitempty"`
+}
+
+// DecodedAttribute is an attribute of an event that is not a typed event.
+type DecodedAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DecodeRawTxCmd returns the command that decodes a binary encoded transaction or transaction result.
+func DecodeRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-raw <base64-or-hex>",
+		Short: "Decode a binary encoded transaction or transaction result into readable JSON or YAML",
+		Long: `Decode a binary encoded transaction or transaction result into readable JSON or YAML.
+
+The input can be base64 or hex encoded, and is either a transaction or the ABCI result of delivering one.
+Transactions are decoded with all of the provenance msg types so that every msg is shown field by field, and a
+memo holding a JSON object (e.g. from --memo-json) is also given decoded as memo_json.
+For a transaction result, the response of each msg and the typed events (e.g. provenance.marker.v1.EventMarkerAdd)
+are decoded into their proto messages, and the attributes of other events are shown as strings.`,
+		Example: fmt.Sprintf(`$ %[1]s tx decode-raw CpIBCo8BCiQvcHJvdmVuYW5jZS5tYXJrZXIu...
+$ %[1]s tx decode-raw 0a02080012... --output text`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadPersistentCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			out, err := DecodeRaw(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if clientCtx.OutputFormat == "text" {
+				if out, err = jsonToYAML(out); err != nil {
+					return err
+				}
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json)")
+	return cmd
+}
+
+// DecodeRaw decodes the base64 or hex encoded bytes of a transaction or transaction result into JSON.
+func DecodeRaw(clientCtx client.Context, input string) ([]byte, error) {
+	bz, err := decodeRawInput(input)
+	if err != nil {
+		return nil, err
+	}
+	tx, txErr := clientCtx.TxConfig.TxDecoder()(bz)
+	if txErr == nil {
+		var out []byte
+		if out, err = clientCtx.TxConfig.TxJSONEncoder()(tx); err != nil {
+			return nil, err
+		}
+		if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+			return addMemoJSON(out, memoTx.GetMemo())
+		}
+		return out, nil
+	}
+	var result abci.ResponseDeliverTx
+	if err = result.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("input is neither a transaction (%v) nor a transaction result (%v)", txErr, err)
+	}
+	decoded, err := DecodeTxResult(clientCtx.Codec, result)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(decoded)
+}
+
+// DecodeTxResult decodes the msg responses and events of the result of a transaction.
+func DecodeTxResult(cdc codec.Codec, result abci.ResponseDeliverTx) (*DecodedTxResult, error) {
+	decoded := &DecodedTxResult{
+		Code:      result.Code,
+		Codespace: result.Codespace,
+		Log:       result.Log,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+	}
+	if len(result.Data) > 0 {
+		var msgData sdk.TxMsgData
+		if err := msgData.Unmarshal(result.Data); err != nil {
+			return nil, fmt.Errorf("invalid transaction result data: %w", err)
+		}
+		for _, data := range msgData.Data {
+			decoded.MsgResponses = append(decoded.MsgResponses, decodeMsgResponse(cdc, data))
+		}
+	}
+	for _, event := range result.Events {
+		decoded.Events = append(decoded.Events, decodeEvent(cdc, event))
+	}
+	return decoded, nil
+}
+
+// decodeRawInput decodes the hex (optionally 0x prefixed) or base64 encoded input.
+func decodeRawInput(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if hexRegexp.MatchString(input) {
+		return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	}
+	bz, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return nil, fmt.Errorf("input is neither hex nor base64 encoded: %w", err)
+	}
+	return bz, nil
+}
+
+// addMemoJSON adds the decoded memo to the JSON of a tx when the memo is a JSON object.
+func addMemoJSON(txJSON []byte, memo string) ([]byte, error) {
+	memoJSON, ok := txmemo.DecodeMemo(memo)
+	if !ok {
+		return txJSON, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(txJSON, &fields); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(memoJSON)
+	if err != nil {
+		return nil, err
+	}
+	fields["memo_json"] = bz
+	return json.Marshal(fields)
+}
+
+// jsonToYAML re-encodes JSON as YAML the same way the client context does for the text output format.
+func jsonToYAML(bz []byte) ([]byte, error) {
+	var j interface{}
+	if err := json.Unmarshal(bz, &j); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(j)
+}
+
+// decodeMsgResponse decodes the response of a msg using the response type named after the msg, e.g.
+// MsgAddMarkerResponse for MsgAddMarkerRequest and MsgSendResponse for MsgSend.
+func decodeMsgResponse(cdc codec.Codec, data *sdk.MsgData) DecodedMsgResponse {
+	decoded := DecodedMsgResponse{MsgType: data.MsgType}
+	name := strings.TrimPrefix(data.MsgType, "/")
+	if strings.HasSuffix(name, "Request") {
+		name = strings.TrimSuffix(name, "Request") + "Response"
+	} else {
+		name += "Response"
+	}
+	if response, ok := newProtoMessage(name); ok {
+		if err := cdc.Unmarshal(data.Data, response); err == nil {
+			if bz, err := cdc.MarshalJSON(response); err == nil {
+				decoded.Response = bz
+				return decoded
+			}
+		}
+	}
+	decoded.Data = data.Data
+	return decoded
+}
+
+// decodeEvent decodes a typed event into its proto message, or gives the attributes of any other event as strings.
+func decodeEvent(cdc codec.Codec, event abci.Event) DecodedEvent {
+	decoded := DecodedEvent{Type: event.Type}
+	if msg, err := sdk.ParseTypedEvent(event); err == nil {
+		if protoMsg, ok := msg.(codec.ProtoMarshaler); ok {
+			if bz, err := cdc.MarshalJSON(protoMsg); err == nil {
+				decoded.TypedEvent = bz
+				return decoded
+			}
+		}
+	}
+	for _, attr := range event.Attributes {
+		decoded.Attributes = append(decoded.Attributes, DecodedAttribute{Key: string(attr.Key), Value: string(attr.Value)})
+	}
+	return decoded
+}
+
+// newProtoMessage returns a new instance of the registered proto message with the given name.
+func newProtoMessage(name string) (codec.ProtoMarshaler, bool) {
+	msgType := proto.MessageType(name)
+	if msgType == nil || msgType.Kind() != reflect.Ptr {
+		return nil, false
+	}
+	msg, ok := reflect.New(msgType.Elem()).Interface().(codec.ProtoMarshaler)
+	return msg, ok
+}
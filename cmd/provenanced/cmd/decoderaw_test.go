@@ -0,0 +1,112 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestDecodeRawTxCmd(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+	admin := sdk.AccAddress("admin_______________")
+	holder := sdk.AccAddress("holder______________")
+	coin := sdk.NewInt64Coin("decodecoin", 10)
+
+	decodeRaw := func(args ...string) (string, error) {
+		decodeCmd := cmd.DecodeRawTxCmd()
+		var out bytes.Buffer
+		decodeCmd.SetOut(&out)
+		decodeCmd.SetErr(&out)
+		decodeCmd.SetArgs(args)
+		ctx := clientCtx.WithOutput(&out)
+		err := decodeCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &ctx))
+		return out.String(), err
+	}
+
+	builder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(markertypes.NewMsgTransferRequest(admin, admin, holder, coin)))
+	builder.SetMemo("decode me")
+	txBytes, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	t.Run("base64 tx", func(t *testing.T) {
+		out, err := decodeRaw(base64.StdEncoding.EncodeToString(txBytes))
+		require.NoError(t, err)
+		require.Contains(t, out, `"@type":"/provenance.marker.v1.MsgTransferRequest"`)
+		require.Contains(t, out, `"to_address":"`+holder.String()+`"`)
+		require.Contains(t, out, `"memo":"decode me"`)
+	})
+
+	t.Run("hex tx as yaml", func(t *testing.T) {
+		out, err := decodeRaw("0x"+hex.EncodeToString(txBytes), "--output", "text")
+		require.NoError(t, err)
+		require.Contains(t, out, "'@type': /provenance.marker.v1.MsgTransferRequest")
+	})
+
+	t.Run("tx result", func(t *testing.T) {
+		typedEvent, err := sdk.TypedEventToEvent(markertypes.NewEventMarkerTransfer(coin.Amount.String(), coin.Denom,
+			admin.String(), holder.String(), admin.String()))
+		require.NoError(t, err)
+		msgData, err := (&sdk.TxMsgData{Data: []*sdk.MsgData{
+			{MsgType: sdk.MsgTypeURL(&markertypes.MsgTransferRequest{}), Data: []byte{}},
+			{MsgType: "/unknown.v1.MsgSomething", Data: []byte{0x01}},
+		}}).Marshal()
+		require.NoError(t, err)
+		result := abci.ResponseDeliverTx{
+			Data:    msgData,
+			GasUsed: 1000,
+			Events: []abci.Event{
+				abci.Event(sdk.NewEvent("message", sdk.NewAttribute("module", "marker"))),
+				abci.Event(typedEvent),
+			},
+		}
+		bz, err := result.Marshal()
+		require.NoError(t, err)
+
+		out, err := decodeRaw(base64.StdEncoding.EncodeToString(bz))
+		require.NoError(t, err)
+		var decoded cmd.DecodedTxResult
+		require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+		require.Equal(t, int64(1000), decoded.GasUsed)
+		require.Len(t, decoded.MsgResponses, 2)
+		require.JSONEq(t, `{}`, string(decoded.MsgResponses[0].Response), "known msg response")
+		require.Equal(t, []byte{0x01}, decoded.MsgResponses[1].Data, "unknown msg response")
+		require.Len(t, decoded.Events, 2)
+		require.Equal(t, []cmd.DecodedAttribute{{Key: "module", Value: "marker"}}, decoded.Events[0].Attributes)
+		require.Equal(t, "provenance.marker.v1.EventMarkerTransfer", decoded.Events[1].Type)
+		require.JSONEq(t, `{"amount":"10","denom":"decodecoin","administrator":"`+admin.String()+`","to_address":"`+
+			holder.String()+`","from_address":"`+admin.String()+`"}`, string(decoded.Events[1].TypedEvent))
+	})
+
+	t.Run("tx with json memo", func(t *testing.T) {
+		builder.SetMemo(`{"invoice":"1234","lines":2}`)
+		memoTxBytes, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		out, err := decodeRaw(base64.StdEncoding.EncodeToString(memoTxBytes))
+		require.NoError(t, err)
+		require.Contains(t, out, `"memo_json":{"invoice":"1234","lines":2}`)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := decodeRaw("not base64!")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "input is neither hex nor base64 encoded")
+	})
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,6 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
+	attributecli "github.com/provenance-io/provenance/x/attribute/client/cli"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -255,8 +258,12 @@ func AddRootDomainAccountCmd(defaultNodeHome string) *cobra.Command {
 
 			nameGenState := nametypes.GetGenesisStateFromAppState(cdc, appState)
 
+			name := strings.ToLower(strings.TrimSpace(args[1]))
+			if len(name) == 0 {
+				return errors.New("root name cannot be empty")
+			}
 			for _, nr := range nameGenState.Bindings {
-				if nr.Name == strings.ToLower(strings.TrimSpace(args[1])) {
+				if nr.Name == name {
 					return fmt.Errorf("cannot add name already exists: %s", args[1])
 				}
 			}
@@ -265,7 +272,7 @@ func AddRootDomainAccountCmd(defaultNodeHome string) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			nameGenState.Bindings = append(nameGenState.Bindings, nametypes.NewNameRecord(args[1], addr, isRestricted))
+			nameGenState.Bindings = append(nameGenState.Bindings, nametypes.NewNameRecord(name, addr, isRestricted))
 
 			nameGenStateBz, err := cdc.MarshalJSON(nameGenState)
 			if err != nil {
@@ -292,6 +299,110 @@ func AddRootDomainAccountCmd(defaultNodeHome string) *cobra.Command {
 	return cmd
 }
 
+// AddGenesisAttributeCmd returns add-genesis-attribute cobra command.
+func AddGenesisAttributeCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-attribute [name] [address_or_key_name] [type] [value]",
+		Short: "Add an account attribute to genesis.json",
+		Long: `Add an account attribute to genesis.json. The attribute name must already be bound in the name
+	module genesis state (see add-genesis-root-name). The account may be given as an address or a key name,
+	if a key name is given the address will be looked up in the local Keybase. Bytes, proto, and attestation
+	values are given as base64 strings.
+	`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			depCdc := clientCtx.JSONCodec
+			cdc := depCdc.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			addr, parseErr := sdk.AccAddressFromBech32(args[1])
+			if parseErr != nil {
+				inBuf := bufio.NewReader(cmd.InOrStdin())
+				keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+				if err != nil {
+					return err
+				}
+
+				// attempt to lookup address from Keybase if no address was provided
+				kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf)
+				if err != nil {
+					return err
+				}
+
+				info, err := kb.Key(args[1])
+				if err != nil {
+					return fmt.Errorf("failed to get address from Keybase: %w, could use use address as bech32 string: %s", err, parseErr.Error())
+				}
+
+				addr = info.GetAddress()
+			}
+
+			attributeType, err := attributetypes.AttributeTypeFromString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("account attribute type is invalid: %w", err)
+			}
+			value, err := attributecli.EncodeAttributeValue(strings.TrimSpace(args[3]), attributeType)
+			if err != nil {
+				return fmt.Errorf("error encoding value %s to type %s : %v", args[3], attributeType.String(), err)
+			}
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+			attr := attributetypes.NewAttribute(name, addr, attributeType, value)
+			if err = attr.ValidateBasic(); err != nil {
+				return fmt.Errorf("failed to validate new genesis attribute: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			nameGenState := nametypes.GetGenesisStateFromAppState(cdc, appState)
+			if !nametypes.NameRecords(nameGenState.Bindings).Contains(name) {
+				return fmt.Errorf("attribute name %s is not bound in the name genesis state", name)
+			}
+
+			attrGenState := attributetypes.GetGenesisStateFromAppState(cdc, appState)
+			if uint32(len(attr.Value)) > attrGenState.Params.MaxValueLength {
+				return fmt.Errorf("attribute value length of %d exceeds max length %d", len(attr.Value), attrGenState.Params.MaxValueLength)
+			}
+			for _, a := range attrGenState.Attributes {
+				if a.Name == attr.Name && a.Address == attr.Address && bytes.Equal(a.Value, attr.Value) {
+					return fmt.Errorf("cannot add attribute already exists: %s", attr.String())
+				}
+			}
+			attrGenState.Attributes = append(attrGenState.Attributes, attr)
+
+			attrGenStateBz, err := cdc.MarshalJSON(attrGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal attribute genesis state: %w", err)
+			}
+
+			appState[attributetypes.ModuleName] = attrGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // AddGenesisMarkerCmd configures a marker account and adds it to the list of genesis accounts
 func AddGenesisMarkerCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -410,7 +521,7 @@ enforced immediately.  An optional type flag can be provided or the default of C
 
 			markerType := markertypes.MarkerType_value["MARKER_TYPE_"+strings.ToUpper(markerFlagType)]
 			if markerType == int32(markertypes.MarkerType_Unknown) {
-				panic(fmt.Sprintf("unknown marker type %s", markerFlagType))
+				return fmt.Errorf("unknown marker type %s", markerFlagType)
 			}
 
 			genAccount := markertypes.NewMarkerAccount(
@@ -448,7 +559,7 @@ enforced immediately.  An optional type flag can be provided or the default of C
 
 				bankGenStateBz, bankMarshalError := cdc.MarshalJSON(bankGenState)
 				if bankMarshalError != nil {
-					return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+					return fmt.Errorf("failed to marshal bank genesis state: %w", bankMarshalError)
 				}
 				appState[banktypes.ModuleName] = bankGenStateBz
 			}
@@ -17,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+
+	"github.com/provenance-io/provenance/app"
 	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
 )
 
@@ -83,3 +85,202 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 		})
 	}
 }
+
+// genesisCmdContext initializes a genesis file in home and returns a context to execute genesis commands with.
+func genesisCmdContext(t *testing.T, home string) context.Context {
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	appCodec := app.MakeEncodingConfig().Marshaler
+	err = genutiltest.ExecInitCmd(testMbm, home, appCodec)
+	require.NoError(t, err)
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithCodec(appCodec).WithJSONCodec(appCodec).WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	return context.WithValue(ctx, server.ServerContextKey, serverCtx)
+}
+
+func TestAddGenesisRootNameCmd(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	tests := []struct {
+		name      string
+		args      [][]string
+		expectErr bool
+	}{
+		{
+			name:      "invalid address",
+			args:      [][]string{{"", "pio"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty name",
+			args:      [][]string{{addr1.String(), " "}},
+			expectErr: true,
+		},
+		{
+			name:      "valid name",
+			args:      [][]string{{addr1.String(), "pio"}},
+			expectErr: false,
+		},
+		{
+			name:      "duplicate name",
+			args:      [][]string{{addr1.String(), "pio"}, {addr1.String(), "PIO"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			ctx := genesisCmdContext(t, home)
+
+			var err error
+			for _, args := range tc.args {
+				cmd := provenancecmd.AddRootDomainAccountCmd(home)
+				cmd.SetArgs(append(args, fmt.Sprintf("--%s=home", flags.FlagHome)))
+				if err = cmd.ExecuteContext(ctx); err != nil {
+					break
+				}
+			}
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddGenesisMarkerCmd(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name:      "invalid coin",
+			args:      []string{"notacoin"},
+			expectErr: true,
+		},
+		{
+			name:      "proposed marker with manager access",
+			args:      []string{"1000hotdog", "--manager", addr1.String(), "--access", "mint,burn,admin"},
+			expectErr: false,
+		},
+		{
+			name:      "access without manager",
+			args:      []string{"1000hotdog", "--access", "mint"},
+			expectErr: true,
+		},
+		{
+			name:      "finalized marker without manager",
+			args:      []string{"1000hotdog", "--finalize"},
+			expectErr: true,
+		},
+		{
+			name:      "unknown marker type",
+			args:      []string{"1000hotdog", "--type", "BOGUS"},
+			expectErr: true,
+		},
+		{
+			name:      "active restricted marker with escrow",
+			args:      []string{"1000hotdog", "--activate", "--type", "RESTRICTED", "--escrow", "1000hotdog"},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			ctx := genesisCmdContext(t, home)
+
+			cmd := provenancecmd.AddGenesisMarkerCmd(home)
+			cmd.SetArgs(append(tc.args, fmt.Sprintf("--%s=home", flags.FlagHome)))
+
+			if tc.expectErr {
+				require.Error(t, cmd.ExecuteContext(ctx))
+			} else {
+				require.NoError(t, cmd.ExecuteContext(ctx))
+			}
+		})
+	}
+}
+
+func TestAddGenesisAttributeCmd(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	tests := []struct {
+		name      string
+		args      [][]string
+		expectErr bool
+	}{
+		{
+			name:      "unbound name",
+			args:      [][]string{{"unbound", addr1.String(), "string", "value"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid address",
+			args:      [][]string{{"pio", "", "string", "value"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid type",
+			args:      [][]string{{"pio", addr1.String(), "bogus", "value"}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid value for type",
+			args:      [][]string{{"pio", addr1.String(), "int", "notanint"}},
+			expectErr: true,
+		},
+		{
+			name:      "valid attribute",
+			args:      [][]string{{"PIO", addr1.String(), "string", "value"}},
+			expectErr: false,
+		},
+		{
+			name:      "distinct values",
+			args:      [][]string{{"pio", addr1.String(), "string", "value"}, {"pio", addr1.String(), "int", "7"}},
+			expectErr: false,
+		},
+		{
+			name:      "duplicate attribute",
+			args:      [][]string{{"pio", addr1.String(), "string", "value"}, {"pio", addr1.String(), "string", "value"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			ctx := genesisCmdContext(t, home)
+
+			nameCmd := provenancecmd.AddRootDomainAccountCmd(home)
+			nameCmd.SetArgs([]string{addr1.String(), "pio", fmt.Sprintf("--%s=home", flags.FlagHome)})
+			require.NoError(t, nameCmd.ExecuteContext(ctx))
+
+			var err error
+			for _, args := range tc.args {
+				cmd := provenancecmd.AddGenesisAttributeCmd(home)
+				cmd.SetArgs(append(args, fmt.Sprintf("--%s=home", flags.FlagHome)))
+				if err = cmd.ExecuteContext(ctx); err != nil {
+					break
+				}
+			}
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
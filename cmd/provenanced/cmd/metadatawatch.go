@@ -0,0 +1,377 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/internal/eventstream"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// FlagFilter is the flag for limiting the metadata events that are posted.
+	FlagFilter = "filter"
+	// FlagPost is the flag for the webhook url that the metadata events are posted to.
+	FlagPost = "post"
+	// FlagGRPCAddr is the flag for the gRPC address of the node serving the event stream.
+	FlagGRPCAddr = "grpc-addr"
+	// FlagRetries is the flag for the number of times a failed webhook post is retried.
+	FlagRetries = "retries"
+
+	// WatchFilterType limits the posted events to a full event type name.
+	WatchFilterType = "type"
+	// WatchFilterScope limits the posted events to those of a scope.
+	WatchFilterScope = "scope"
+	// WatchFilterScopeSpec limits the posted events to those of a scope specification and the scopes using it.
+	WatchFilterScopeSpec = "scope-spec"
+
+	// metadataModule is the module name of the metadata events in the event stream.
+	metadataModule = "metadata"
+	// webhookTimeout is how long to wait for the webhook to respond to a post.
+	webhookTimeout = 30 * time.Second
+)
+
+// webhookRetryDelay is the delay before the first retry of a failed webhook post, doubled for each further retry.
+var webhookRetryDelay = time.Second
+
+// MetadataWatchCmd returns a CLI command that posts the typed metadata events of a node to a webhook.
+func MetadataWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata-watch",
+		Short: "Post the metadata events of a node to a webhook as they are committed",
+		Long: fmt.Sprintf(`Subscribe to the typed metadata events of a node and post each one to a webhook as a JSON object with the
+height, tx_hash, type and event.  The node must serve the event stream on its gRPC server (%[1]s in app.toml).
+
+Filters are given as key=value, with these keys:
+  %[2]s        a full event type name, e.g. provenance.metadata.v1.EventScopeCreated
+  %[3]s       a scope address or uuid
+  %[4]s  a scope specification address or uuid; matches the events of the specification and of the scopes using it
+Events must match one of the filters with each key given.  Posts that fail are retried, then written to stderr.`,
+			eventstream.FlagEnable, WatchFilterType, WatchFilterScope, WatchFilterScopeSpec),
+		Example: fmt.Sprintf(`$ %[1]s metadata-watch --%[2]s http://localhost:8080/metadata
+$ %[1]s metadata-watch --%[3]s %[4]s=scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m --%[2]s http://localhost:8080/metadata`,
+			version.AppName, FlagPost, FlagFilter, WatchFilterScopeSpec),
+		Args: cobra.NoArgs,
+		RunE: runMetadataWatchCmd,
+	}
+	cmd.Flags().StringArray(FlagFilter, nil, "A key=value filter of the events to post, may be repeated")
+	cmd.Flags().String(FlagPost, "", "The webhook url to post the events to")
+	cmd.Flags().String(FlagGRPCAddr, "localhost:9090", "The gRPC address of the node serving the event stream")
+	cmd.Flags().Int(FlagRetries, 3, "The number of times a failed post is retried")
+	if err := cmd.MarkFlagRequired(FlagPost); err != nil {
+		panic(err)
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func runMetadataWatchCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	filterArgs, err := cmd.Flags().GetStringArray(FlagFilter)
+	if err != nil {
+		return err
+	}
+	filter, err := ParseMetadataWatchFilter(filterArgs)
+	if err != nil {
+		return err
+	}
+	postURL, err := cmd.Flags().GetString(FlagPost)
+	if err != nil {
+		return err
+	}
+	grpcAddr, err := cmd.Flags().GetString(FlagGRPCAddr)
+	if err != nil {
+		return err
+	}
+	retries, err := cmd.Flags().GetInt(FlagRetries)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	conn, err := grpc.DialContext(ctx, grpcAddr, grpc.WithInsecure())
+	if err != nil {
+		return fmt.Errorf("couldn't connect to %s: %v", grpcAddr, err)
+	}
+	defer conn.Close()
+	stream, err := eventstream.NewEventStreamClient(conn).Subscribe(ctx,
+		&eventstream.SubscribeRequest{Modules: []string{metadataModule}, EventTypes: filter.EventTypes})
+	if err != nil {
+		return fmt.Errorf("couldn't subscribe to the event stream of %s: %v", grpcAddr, err)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	watcher := NewMetadataWatcher(filter, postURL, retries, func(ctx context.Context, scopeAddr string) (string, error) {
+		res, err := queryClient.Scope(ctx, &types.ScopeRequest{ScopeId: scopeAddr})
+		if err != nil {
+			return "", err
+		}
+		if res.Scope == nil || res.Scope.Scope == nil {
+			return "", fmt.Errorf("scope %s not found", scopeAddr)
+		}
+		return res.Scope.Scope.SpecificationId.String(), nil
+	})
+	for {
+		block, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("event stream of %s closed: %v", grpcAddr, err)
+		}
+		for _, event := range block.Events {
+			payload, found, err := watcher.Payload(ctx, block.Height, event)
+			if err != nil {
+				cmd.PrintErrf("skipping %s event at height %d: %v\n", event.Type, block.Height, err)
+				continue
+			}
+			if !found {
+				continue
+			}
+			if err = watcher.Post(ctx, payload); err != nil {
+				cmd.PrintErrf("%v: %s\n", err, payload)
+			}
+		}
+	}
+}
+
+// MetadataWatchFilter limits the metadata events that are posted.  An event must match one of the values of each
+// part of the filter that has any values.
+type MetadataWatchFilter struct {
+	// EventTypes are the full event type names of the events to post.
+	EventTypes []string
+	// Scopes are the bech32 addresses of the scopes to post the events of.
+	Scopes map[string]bool
+	// ScopeSpecs are the bech32 addresses of the scope specifications to post the events of.
+	ScopeSpecs map[string]bool
+}
+
+// ParseMetadataWatchFilter parses key=value filters into a MetadataWatchFilter.  Scopes and scope specifications are
+// given as either a bech32 address or a uuid.
+func ParseMetadataWatchFilter(args []string) (MetadataWatchFilter, error) {
+	filter := MetadataWatchFilter{Scopes: make(map[string]bool), ScopeSpecs: make(map[string]bool)}
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || len(parts[1]) == 0 {
+			return filter, fmt.Errorf("invalid filter %q, must be key=value", arg)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case WatchFilterType:
+			filter.EventTypes = append(filter.EventTypes, value)
+		case WatchFilterScope:
+			addr, err := parseWatchAddress(value, types.ScopeMetadataAddress, types.MetadataAddress.IsScopeAddress)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s filter %q: %v", key, value, err)
+			}
+			filter.Scopes[addr.String()] = true
+		case WatchFilterScopeSpec:
+			addr, err := parseWatchAddress(value, types.ScopeSpecMetadataAddress, types.MetadataAddress.IsScopeSpecificationAddress)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s filter %q: %v", key, value, err)
+			}
+			filter.ScopeSpecs[addr.String()] = true
+		default:
+			return filter, fmt.Errorf("unknown filter key %q, must be one of: %s, %s, %s",
+				key, WatchFilterType, WatchFilterScope, WatchFilterScopeSpec)
+		}
+	}
+	return filter, nil
+}
+
+// parseWatchAddress parses a bech32 metadata address of the expected kind, or a uuid into one.
+func parseWatchAddress(value string, fromUUID func(uuid.UUID) types.MetadataAddress, isKind func(types.MetadataAddress) bool) (types.MetadataAddress, error) {
+	if id, err := uuid.Parse(value); err == nil {
+		return fromUUID(id), nil
+	}
+	addr, err := types.MetadataAddressFromBech32(value)
+	if err != nil {
+		return nil, err
+	}
+	if !isKind(addr) {
+		return nil, fmt.Errorf("wrong kind of metadata address")
+	}
+	return addr, nil
+}
+
+// MetadataWatchPayload is the JSON object posted to the webhook for each event.
+type MetadataWatchPayload struct {
+	Height int64           `json:"height"`
+	TxHash string          `json:"tx_hash,omitempty"`
+	Type   string          `json:"type"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// MetadataWatcher turns the metadata events of the event stream into webhook posts.
+type MetadataWatcher struct {
+	filter     MetadataWatchFilter
+	postURL    string
+	retries    int
+	httpClient *http.Client
+	// scopeSpecOf looks up the scope specification address of a scope address.
+	scopeSpecOf func(ctx context.Context, scopeAddr string) (string, error)
+	// scopeSpecs caches the scope specifications of the scopes seen, so that the events of a deleted scope still match.
+	scopeSpecs map[string]string
+}
+
+// NewMetadataWatcher creates a new MetadataWatcher.
+func NewMetadataWatcher(
+	filter MetadataWatchFilter,
+	postURL string,
+	retries int,
+	scopeSpecOf func(ctx context.Context, scopeAddr string) (string, error),
+) *MetadataWatcher {
+	return &MetadataWatcher{
+		filter:      filter,
+		postURL:     postURL,
+		retries:     retries,
+		httpClient:  &http.Client{Timeout: webhookTimeout},
+		scopeSpecOf: scopeSpecOf,
+		scopeSpecs:  make(map[string]string),
+	}
+}
+
+// Payload decodes an event into the JSON payload to post, returning false if the event does not match the filter.
+func (w *MetadataWatcher) Payload(ctx context.Context, height int64, event eventstream.TypedEvent) ([]byte, bool, error) {
+	msg, err := decodeTypedEvent(event)
+	if err != nil {
+		return nil, false, err
+	}
+	if !w.matches(ctx, event.Type, msg) {
+		return nil, false, nil
+	}
+	eventJSON, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	payload, err := json.Marshal(MetadataWatchPayload{Height: height, TxHash: event.TxHash, Type: event.Type, Event: eventJSON})
+	if err != nil {
+		return nil, false, err
+	}
+	return payload, true, nil
+}
+
+// matches determines if a decoded event matches the filter.
+func (w *MetadataWatcher) matches(ctx context.Context, eventType string, msg proto.Message) bool {
+	if len(w.filter.EventTypes) > 0 && !containsString(w.filter.EventTypes, eventType) {
+		return false
+	}
+	var scopeAddr, scopeSpecAddr string
+	if e, ok := msg.(interface{ GetScopeAddr() string }); ok {
+		scopeAddr = e.GetScopeAddr()
+	}
+	if e, ok := msg.(interface{ GetScopeSpecificationAddr() string }); ok {
+		scopeSpecAddr = e.GetScopeSpecificationAddr()
+	}
+	if len(w.filter.Scopes) > 0 && !w.filter.Scopes[scopeAddr] {
+		return false
+	}
+	if len(w.filter.ScopeSpecs) == 0 {
+		return true
+	}
+	if len(scopeAddr) > 0 {
+		scopeSpecAddr = w.scopeSpec(ctx, scopeAddr, eventType == proto.MessageName(&types.EventScopeUpdated{}))
+	}
+	return w.filter.ScopeSpecs[scopeSpecAddr]
+}
+
+// scopeSpec returns the scope specification address of a scope, looking it up when it is not known or may have
+// changed.  An empty string is returned when the scope can not be found.
+func (w *MetadataWatcher) scopeSpec(ctx context.Context, scopeAddr string, changed bool) string {
+	if spec, found := w.scopeSpecs[scopeAddr]; found && !changed {
+		return spec
+	}
+	spec, err := w.scopeSpecOf(ctx, scopeAddr)
+	if err != nil {
+		return w.scopeSpecs[scopeAddr]
+	}
+	w.scopeSpecs[scopeAddr] = spec
+	return spec
+}
+
+// Post posts a payload to the webhook, retrying with a growing delay when the post fails.
+func (w *MetadataWatcher) Post(ctx context.Context, payload []byte) error {
+	delay := webhookRetryDelay
+	var err error
+	for attempt := 0; attempt <= w.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			delay *= 2
+		}
+		if err = w.post(ctx, payload); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("couldn't post to %s: %v", w.postURL, err)
+}
+
+// post makes a single post of a payload to the webhook.
+func (w *MetadataWatcher) post(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.postURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+	return nil
+}
+
+// decodeTypedEvent decodes the typed event of the event stream into its proto message.
+func decodeTypedEvent(event eventstream.TypedEvent) (proto.Message, error) {
+	if event.Event == nil {
+		return nil, fmt.Errorf("no event")
+	}
+	msgType := proto.MessageType(event.Type)
+	if msgType == nil {
+		return nil, fmt.Errorf("unknown event type %s", event.Type)
+	}
+	msg, ok := reflect.New(msgType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a proto message", event.Type)
+	}
+	if err := proto.Unmarshal(event.Event.Value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// containsString returns true if the string is in the list.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/internal/eventstream"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestParseMetadataWatchFilter(t *testing.T) {
+	specUUID := uuid.New()
+	spec := types.ScopeSpecMetadataAddress(specUUID)
+	scope := types.ScopeMetadataAddress(uuid.New())
+
+	filter, err := cmd.ParseMetadataWatchFilter([]string{
+		"type=provenance.metadata.v1.EventScopeCreated",
+		"scope=" + scope.String(),
+		"scope-spec=" + specUUID.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"provenance.metadata.v1.EventScopeCreated"}, filter.EventTypes)
+	require.Equal(t, map[string]bool{scope.String(): true}, filter.Scopes)
+	require.Equal(t, map[string]bool{spec.String(): true}, filter.ScopeSpecs, "a uuid is converted to an address")
+
+	_, err = cmd.ParseMetadataWatchFilter([]string{"scope-spec"})
+	require.EqualError(t, err, `invalid filter "scope-spec", must be key=value`)
+	_, err = cmd.ParseMetadataWatchFilter([]string{"owner=pb1"})
+	require.EqualError(t, err, `unknown filter key "owner", must be one of: type, scope, scope-spec`)
+	_, err = cmd.ParseMetadataWatchFilter([]string{"scope-spec=" + scope.String()})
+	require.EqualError(t, err, fmt.Sprintf(`invalid scope-spec filter %q: wrong kind of metadata address`, scope.String()))
+}
+
+func TestMetadataWatcher(t *testing.T) {
+	spec := types.ScopeSpecMetadataAddress(uuid.New()).String()
+	otherSpec := types.ScopeSpecMetadataAddress(uuid.New()).String()
+	scope := types.ScopeMetadataAddress(uuid.New()).String()
+	otherScope := types.ScopeMetadataAddress(uuid.New()).String()
+	scopeSpecs := map[string]string{scope: spec, otherScope: otherSpec}
+
+	var posted [][]byte
+	failures := 1
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		posted = append(posted, body)
+	}))
+	defer webhook.Close()
+
+	filter, err := cmd.ParseMetadataWatchFilter([]string{"scope-spec=" + spec})
+	require.NoError(t, err)
+	watcher := cmd.NewMetadataWatcher(filter, webhook.URL, 1, func(_ context.Context, scopeAddr string) (string, error) {
+		if spec, found := scopeSpecs[scopeAddr]; found {
+			return spec, nil
+		}
+		return "", fmt.Errorf("scope %s not found", scopeAddr)
+	})
+	typedEvent := func(msg proto.Message) eventstream.TypedEvent {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return eventstream.TypedEvent{Type: proto.MessageName(msg), TxHash: "ABCD", Event: any}
+	}
+	ctx := context.Background()
+
+	payload, found, err := watcher.Payload(ctx, 10, typedEvent(&types.EventScopeCreated{ScopeAddr: scope}))
+	require.NoError(t, err)
+	require.True(t, found, "scope using the spec")
+	var decoded cmd.MetadataWatchPayload
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	require.Equal(t, int64(10), decoded.Height)
+	require.Equal(t, "ABCD", decoded.TxHash)
+	require.Equal(t, "provenance.metadata.v1.EventScopeCreated", decoded.Type)
+	require.JSONEq(t, fmt.Sprintf(`{"scope_addr":%q}`, scope), string(decoded.Event))
+
+	_, found, err = watcher.Payload(ctx, 10, typedEvent(&types.EventScopeCreated{ScopeAddr: otherScope}))
+	require.NoError(t, err)
+	require.False(t, found, "scope using another spec")
+	_, found, err = watcher.Payload(ctx, 10, typedEvent(&types.EventScopeSpecificationUpdated{ScopeSpecificationAddr: spec}))
+	require.NoError(t, err)
+	require.True(t, found, "the spec itself")
+
+	// the events of a deleted scope still match once the scope is gone
+	delete(scopeSpecs, scope)
+	_, found, err = watcher.Payload(ctx, 11, typedEvent(&types.EventScopeDeleted{ScopeAddr: scope}))
+	require.NoError(t, err)
+	require.True(t, found, "deleted scope using the spec")
+
+	require.NoError(t, watcher.Post(ctx, payload), "post is retried")
+	require.Equal(t, [][]byte{payload}, posted)
+}
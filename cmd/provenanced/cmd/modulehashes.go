@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/internal/statehash"
+)
+
+// flagHeight is the flag with the committed height whose module store hashes are printed.
+const flagHeight = "height"
+
+// ModuleHashesCmd returns the command that prints a hash of the contents of each module store at a committed height.
+func ModuleHashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-hashes",
+		Short: "Print a hash of the contents of each module store at a committed height",
+		Long: `Print a hash of the contents of each module store at a committed height of the node's app db.
+
+The hash of a store is the sha256 of each of its keys and values in key order, so it only depends on the state
+of the module.  When nodes disagree on an app hash, comparing the output of each node at the height finds the
+modules whose state has diverged.  The commit hash is the hash of the store that was committed into the app hash.
+
+The node must be stopped while the app db is read.  The latest committed height is used when no height is given.`,
+		Example: fmt.Sprintf("$ %s debug module-hashes --%s 1000", version.AppName, flagHeight),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			db, err := sdk.NewLevelDB("application", filepath.Join(clientCtx.HomeDir, "data"))
+			if err != nil {
+				return fmt.Errorf("could not open the app db, is the node stopped? %w", err)
+			}
+			defer db.Close()
+
+			height, hashes, err := statehash.ModuleHashes(db, height)
+			if err != nil {
+				return err
+			}
+			out := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintf(out, "height: %d\n", height)
+			fmt.Fprintln(out, "STORE\tENTRIES\tHASH\tCOMMIT HASH")
+			for _, h := range hashes {
+				fmt.Fprintf(out, "%s\t%d\t%X\t%X\n", h.Store, h.Entries, h.Hash, h.CommitHash)
+			}
+			return out.Flush()
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "the committed height to hash the module stores at (default latest)")
+	return cmd
+}
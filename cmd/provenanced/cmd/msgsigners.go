@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+// flagAll is the flag to include the msgs of non-provenance modules in the msg-signers output.
+const flagAll = "all"
+
+// DebugCmd returns the sdk debug command with the provenance debug commands added.
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(MsgSignersCmd(), ModuleHashesCmd())
+	return cmd
+}
+
+// MsgSignersCmd returns the command that reports the signers of each registered Msg type.
+func MsgSignersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-signers",
+		Short: "Report the fields used as signers by each registered Msg type",
+		Long: `Report the fields used as signers by each registered Msg type.
+
+Each address field of a msg is given a distinct address so the fields returned by GetSigners can be identified.
+Authority fields (` + strings.Join(app.AuthorityFields, ", ") + `) that are not signers are listed as uncovered.
+By default only provenance msgs are reported, use --all to include msgs from all modules.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for _, audit := range app.AuditMsgSigners(app.MakeEncodingConfig().InterfaceRegistry) {
+				if !all && !audit.IsProvenance() {
+					continue
+				}
+				fmt.Fprintf(out, "%s\n", audit.TypeURL)
+				if len(audit.Error) > 0 {
+					fmt.Fprintf(out, "  error: %s\n", audit.Error)
+					continue
+				}
+				fmt.Fprintf(out, "  signers: %s\n", strings.Join(audit.Signers, ", "))
+				if len(audit.Uncovered) > 0 {
+					fmt.Fprintf(out, "  uncovered: %s\n", strings.Join(audit.Uncovered, ", "))
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagAll, false, "include the msgs of non-provenance modules")
+	return cmd
+}
@@ -6,12 +6,16 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 
 	"github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
+	"github.com/provenance-io/provenance/internal/archive"
+	"github.com/provenance-io/provenance/internal/configcheck"
+	"github.com/provenance-io/provenance/internal/skipupgrade"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
@@ -24,7 +28,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -74,12 +77,24 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 			cmd.SetOut(cmd.OutOrStdout())
 			cmd.SetErr(cmd.ErrOrStderr())
 
+			homesFile, err := config.DefaultHomesFile()
+			if err != nil {
+				return err
+			}
+			profile, err := config.UseHomeProfile(cmd, homesFile)
+			if err != nil {
+				return err
+			}
 			initClientCtx = client.ReadHomeFlag(initClientCtx, cmd)
 
-			var err error
 			if initClientCtx, err = config.ReadFromClientConfig(initClientCtx); err != nil {
 				return err
 			}
+			if profile != nil {
+				if initClientCtx, err = profile.ApplyClientConfig(initClientCtx); err != nil {
+					return err
+				}
+			}
 			if err = client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -132,12 +147,16 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		AddRootDomainAccountCmd(app.DefaultNodeHome),
+		AddGenesisAttributeCmd(app.DefaultNodeHome),
 		AddGenesisMarkerCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		DebugCmd(),
 		ClientConfigCmd(),
 		AddMetaAddressCmd(),
+		MetadataWatchCmd(),
+		UpgradeInfoCmd(),
+		PrepareUpgradeCmd(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createAppAndExport, addModuleInitFlags)
@@ -156,6 +175,11 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	archive.AddFlags(startCmd)
+	skipupgrade.AddFlags(startCmd)
+	configcheck.AddFlags(startCmd)
+	// Refuse to start with settings that are known to not work together.
+	startCmd.PreRunE = configcheck.WrapPreRunE(startCmd.PreRunE)
 }
 
 func queryCommand() *cobra.Command {
@@ -194,12 +218,14 @@ func txCommand() *cobra.Command {
 	cmd.AddCommand(
 		authcmd.GetSignCommand(),
 		authcmd.GetSignBatchCommand(),
+		GetSignBatchFileCmd(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		flags.LineBreak,
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		DecodeRawTxCmd(),
 		flags.LineBreak,
 		vestingcli.GetTxCmd(),
 	)
@@ -217,10 +243,16 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		cache = store.NewCommitKVStoreCacheManager()
 	}
 
-	skipUpgradeHeights := make(map[int64]bool)
-	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
-		skipUpgradeHeights[int64(h)] = true
+	// Skipping an upgrade requires the plan name with each height, is logged loudly, and is recorded in the home dir.
+	skipUpgrades, err := skipupgrade.FromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	skipupgrade.Warn(logger, skipUpgrades)
+	if err = skipupgrade.RecordMarker(cast.ToString(appOpts.Get(flags.FlagHome)), skipUpgrades, time.Now()); err != nil {
+		panic(err)
 	}
+	skipUpgradeHeights := skipupgrade.Heights(skipUpgrades)
 
 	pruningOpts, err := server.GetPruningOptionsFromFlags(appOpts)
 	if err != nil {
@@ -266,7 +298,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		}
 	}
 
-	return app.New(
+	provApp := app.New(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
@@ -284,6 +316,11 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 	)
+	if err := provApp.StartHealthServer(logger); err != nil {
+		panic(err)
+	}
+	provApp.StartArchiveVerification(logger)
+	return provApp
 }
 
 func createAppAndExport(
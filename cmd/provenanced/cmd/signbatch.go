@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// flagMsgsPerTx is the flag for the maximum number of msgs signed in each tx of a batch.
+const flagMsgsPerTx = "msgs-per-tx"
+
+// GetSignBatchFileCmd returns the command that signs a file of marker transfer and withdraw msgs.
+func GetSignBatchFileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-batch-file [unsigned-msgs-file]",
+		Short: "Sign a batch of marker transfer and withdraw msgs",
+		Long: `Sign a batch of marker transfer and withdraw msgs read from a file.
+
+The file contains a JSON object with a "messages" list of msgs, or a transaction generated with --generate-only.
+Each msg must be a marker transfer or withdraw msg with the --from account as its administrator.
+
+By default all of the msgs are signed in a single tx.  With --msgs-per-tx the msgs are split into a series of txs that
+are signed with consecutive sequence numbers, starting at the account's sequence.  The --gas, --fees, and --note flags
+apply to each tx.  The signed txs are printed one per line, ready for the broadcast command.
+
+The --offline flag makes sure that the client will not reach out to a full node.  As a result, the account and
+sequence number queries will not be performed and it is required to set such parameters manually.
+`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+				_ = cmd.MarkFlagRequired(flags.FlagAccountNumber)
+				_ = cmd.MarkFlagRequired(flags.FlagSequence)
+			}
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msgsPerTx, err := cmd.Flags().GetUint(flagMsgsPerTx)
+			if err != nil {
+				return err
+			}
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			msgs, err := readBatchMsgs(clientCtx, bz)
+			if err != nil {
+				return fmt.Errorf("could not read msgs from %s: %w", args[0], err)
+			}
+			if err = validateBatchMsgs(msgs, clientCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			// The account is only looked up once so the sequence can be incremented for each tx of the batch.
+			if !clientCtx.Offline && (txf.AccountNumber() == 0 || txf.Sequence() == 0) {
+				num, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+				if err != nil {
+					return err
+				}
+				if txf.AccountNumber() == 0 {
+					txf = txf.WithAccountNumber(num)
+				}
+				if txf.Sequence() == 0 {
+					txf = txf.WithSequence(seq)
+				}
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if len(outputDoc) > 0 {
+				fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+				if err != nil {
+					return err
+				}
+				defer fp.Close()
+				cmd.SetOut(fp)
+			}
+
+			sequence := txf.Sequence()
+			for _, batch := range splitBatchMsgs(msgs, int(msgsPerTx)) {
+				txf = txf.WithSequence(sequence)
+				txBuilder, err := tx.BuildUnsignedTx(txf, batch...)
+				if err != nil {
+					return err
+				}
+				if err = authclient.SignTx(txf, clientCtx, clientCtx.GetFromName(), txBuilder, true, true); err != nil {
+					return err
+				}
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%s\n", json)
+				sequence++
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint(flagMsgsPerTx, 0, "The maximum number of msgs signed in each tx, zero signs all msgs in a single tx")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed txs will be written to the given file instead of STDOUT")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBatchMsgs returns the msgs of a transaction, or of a JSON object with a list of messages.
+func readBatchMsgs(clientCtx client.Context, bz []byte) ([]sdk.Msg, error) {
+	if unsignedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz); err == nil {
+		return unsignedTx.GetMsgs(), nil
+	}
+	var body txtypes.TxBody
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &body); err != nil {
+		return nil, err
+	}
+	msgs := make([]sdk.Msg, len(body.Messages))
+	for i, msgAny := range body.Messages {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("message %d: %s is not a msg", i, msgAny.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// validateBatchMsgs checks that there are msgs to sign and that each is a valid marker transfer or withdraw msg signed
+// by the given address.
+func validateBatchMsgs(msgs []sdk.Msg, signer sdk.AccAddress) error {
+	if len(msgs) == 0 {
+		return fmt.Errorf("no msgs to sign")
+	}
+	for i, msg := range msgs {
+		switch msg.(type) {
+		case *markertypes.MsgTransferRequest, *markertypes.MsgWithdrawRequest:
+		default:
+			return fmt.Errorf("message %d: %T is not a marker transfer or withdraw msg", i, msg)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(signer) {
+			return fmt.Errorf("message %d: must be signed by %s only", i, signer)
+		}
+	}
+	return nil
+}
+
+// splitBatchMsgs splits the msgs into batches of at most size msgs, or a single batch if size is zero.
+func splitBatchMsgs(msgs []sdk.Msg, size int) [][]sdk.Msg {
+	if size <= 0 || size >= len(msgs) {
+		return [][]sdk.Msg{msgs}
+	}
+	var batches [][]sdk.Msg
+	for start := 0; start < len(msgs); start += size {
+		end := start + size
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		batches = append(batches, msgs[start:end])
+	}
+	return batches
+}
@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/provenance-io/provenance/internal/cosmovisor"
+)
+
+const (
+	// FlagDownload is the flag for downloading and staging the binary of the pending upgrade plan.
+	FlagDownload = "download"
+	// FlagCosmovisorHome is the flag for the directory holding the cosmovisor folder, overriding DAEMON_HOME.
+	FlagCosmovisorHome = "cosmovisor-home"
+	// FlagDaemonName is the flag for the name of the daemon binary, overriding DAEMON_NAME.
+	FlagDaemonName = "daemon-name"
+
+	// downloadTimeout is how long fetching the plan info and downloading the upgrade binary may take.
+	downloadTimeout = 30 * time.Minute
+)
+
+// UpgradeInfoCmd returns the command for inspecting the pending upgrade plan of the chain.
+func UpgradeInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-info",
+		Short: "Inspect the pending upgrade plan and its binaries",
+	}
+	cmd.AddCommand(UpgradeInfoPreviewCmd())
+	return cmd
+}
+
+// UpgradeInfoPreviewCmd returns the command that prints the pending upgrade plan and the binary this node would stage.
+func UpgradeInfoPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Print the pending upgrade plan and the binary it lists for this platform",
+		Long: fmt.Sprintf(`Query the pending upgrade plan and print its name and height along with the binary url and checksum the
+plan info lists for this platform, and whether it has been staged in the cosmovisor directory yet.
+
+The plan info must be the json cosmovisor uses, or a url of it:
+{"binaries":{"linux/amd64":"https://.../provenanced.zip?checksum=sha256:<hex>"}}
+
+The cosmovisor directory is read from $%[1]s and $%[2]s, falling back to the node home and %[3]s.`,
+			cosmovisor.EnvHome, cosmovisor.EnvName, cosmovisor.DefaultName),
+		Example: fmt.Sprintf("$ %s upgrade-info preview --node tcp://localhost:26657", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			cfg, err := cosmovisorConfig(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			plan, err := queryPendingPlan(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), downloadTimeout)
+			defer cancel()
+			return WriteUpgradePreview(ctx, cmd.OutOrStdout(), http.DefaultClient, cfg, plan)
+		},
+	}
+	addCosmovisorFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PrepareUpgradeCmd returns the command that stages the binary of the pending upgrade plan for cosmovisor.
+func PrepareUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepare-upgrade <name>",
+		Short: "Verify and stage the binary of the pending upgrade plan in the cosmovisor directory",
+		Long: fmt.Sprintf(`Check that the pending upgrade plan has the given name and that its plan info lists a binary with a
+checksum for this platform.  With --%[1]s the binary is downloaded, its checksum verified, and it is staged as
+cosmovisor/upgrades/<name>/bin/<daemon name> so cosmovisor switches to it at the upgrade height.  Zip and tar.gz
+archives are extracted into cosmovisor/upgrades/<name> and must contain bin/<daemon name>.  Nothing is staged when
+the checksum does not match.
+
+Without --%[1]s only the checks are run and the staging that would be done is printed.  A binary that has
+already been staged is verified against the checksum again instead.
+
+The cosmovisor directory is read from $%[2]s and $%[3]s, falling back to the node home and %[4]s.`,
+			FlagDownload, cosmovisor.EnvHome, cosmovisor.EnvName, cosmovisor.DefaultName),
+		Example: fmt.Sprintf("$ %s prepare-upgrade v2 --%s --node tcp://localhost:26657", version.AppName, FlagDownload),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			cfg, err := cosmovisorConfig(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			download, err := cmd.Flags().GetBool(FlagDownload)
+			if err != nil {
+				return err
+			}
+			plan, err := queryPendingPlan(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), downloadTimeout)
+			defer cancel()
+			return PrepareUpgrade(ctx, cmd.OutOrStdout(), http.DefaultClient, cfg, plan, args[0], download)
+		},
+	}
+	cmd.Flags().Bool(FlagDownload, false, "download, verify and stage the binary instead of only checking the plan")
+	addCosmovisorFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func addCosmovisorFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagCosmovisorHome, "", fmt.Sprintf("the directory holding the cosmovisor folder (default $%s or the node home)", cosmovisor.EnvHome))
+	cmd.Flags().String(FlagDaemonName, "", fmt.Sprintf("the name of the daemon binary (default $%s or %s)", cosmovisor.EnvName, cosmovisor.DefaultName))
+}
+
+// cosmovisorConfig returns the cosmovisor config from the environment and the flags of the command.
+func cosmovisorConfig(cmd *cobra.Command, clientCtx client.Context) (cosmovisor.Config, error) {
+	cfg := cosmovisor.ConfigFromEnv(clientCtx.HomeDir)
+	home, err := cmd.Flags().GetString(FlagCosmovisorHome)
+	if err != nil {
+		return cfg, err
+	}
+	if len(home) > 0 {
+		cfg.Home = home
+	}
+	name, err := cmd.Flags().GetString(FlagDaemonName)
+	if err != nil {
+		return cfg, err
+	}
+	if len(name) > 0 {
+		cfg.Name = name
+	}
+	return cfg, nil
+}
+
+// queryPendingPlan returns the pending upgrade plan of the chain, or nil if there is none.
+func queryPendingPlan(ctx context.Context, clientCtx client.Context) (*upgradetypes.Plan, error) {
+	res, err := upgradetypes.NewQueryClient(clientCtx).CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not query the pending upgrade plan: %w", err)
+	}
+	return res.Plan, nil
+}
+
+// WriteUpgradePreview writes the pending upgrade plan along with the binary its plan info lists for this platform and
+// whether it has been staged.  Problems with the plan info are written instead of returned so the plan is still shown.
+func WriteUpgradePreview(ctx context.Context, w io.Writer, httpClient *http.Client, cfg cosmovisor.Config, plan *upgradetypes.Plan) error {
+	if plan == nil {
+		_, err := fmt.Fprintln(w, "there is no pending upgrade plan")
+		return err
+	}
+	out := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(out, "name:\t%s\n", plan.Name)
+	fmt.Fprintf(out, "height:\t%d\n", plan.Height)
+	fmt.Fprintf(out, "platform:\t%s\n", cosmovisor.Platform())
+	bin, err := planBinary(ctx, httpClient, plan)
+	if err != nil {
+		fmt.Fprintf(out, "binary:\t%v\n", err)
+	} else {
+		fmt.Fprintf(out, "binary:\t%s\n", bin.URL)
+		fmt.Fprintf(out, "checksum:\t%s\n", bin.ChecksumString())
+	}
+	fmt.Fprintf(out, "staged:\t%s\n", stagedStatus(cfg, plan.Name, bin, err == nil))
+	return out.Flush()
+}
+
+// PrepareUpgrade checks that the pending upgrade plan has the given name and lists a binary for this platform, then
+// downloads, verifies and stages it for cosmovisor if download is true, otherwise writes what would be staged.
+func PrepareUpgrade(
+	ctx context.Context,
+	w io.Writer,
+	httpClient *http.Client,
+	cfg cosmovisor.Config,
+	plan *upgradetypes.Plan,
+	name string,
+	download bool,
+) error {
+	if plan == nil {
+		return errors.New("there is no pending upgrade plan")
+	}
+	if plan.Name != name {
+		return fmt.Errorf("the pending upgrade plan is %q at height %d, not %q", plan.Name, plan.Height, name)
+	}
+	bin, err := planBinary(ctx, httpClient, plan)
+	if err != nil {
+		return err
+	}
+	if cfg.IsStaged(name) {
+		if !bin.IsArchive() {
+			if err = bin.VerifyFile(cfg.UpgradeBin(name)); err != nil {
+				return fmt.Errorf("staged binary of upgrade %s does not match the plan: %w", name, err)
+			}
+		}
+		_, err = fmt.Fprintf(w, "upgrade %s is already staged: %s\n", name, stagedStatus(cfg, name, bin, true))
+		return err
+	}
+	if !download {
+		_, err = fmt.Fprintf(w, "upgrade %s at height %d would stage %s (%s) as %s\nrun again with --%s to stage it\n",
+			name, plan.Height, bin.URL, bin.ChecksumString(), cfg.UpgradeBin(name), FlagDownload)
+		return err
+	}
+	staged, err := cosmovisor.Stage(ctx, httpClient, cfg, name, bin)
+	if err != nil {
+		return fmt.Errorf("could not stage upgrade %s: %w", name, err)
+	}
+	_, err = fmt.Fprintf(w, "upgrade %s at height %d staged as %s, %s verified\n", name, plan.Height, staged, bin.ChecksumString())
+	return err
+}
+
+// planBinary returns the binary the plan info of the plan lists for this platform.
+func planBinary(ctx context.Context, httpClient *http.Client, plan *upgradetypes.Plan) (cosmovisor.Binary, error) {
+	info, err := cosmovisor.ResolvePlanInfo(ctx, httpClient, plan.Info)
+	if err != nil {
+		return cosmovisor.Binary{}, err
+	}
+	return info.Binary(cosmovisor.Platform())
+}
+
+// stagedStatus describes whether the binary of the named upgrade has been staged and matches the checksum.
+func stagedStatus(cfg cosmovisor.Config, name string, bin cosmovisor.Binary, haveBinary bool) string {
+	path := cfg.UpgradeBin(name)
+	switch {
+	case !cfg.IsStaged(name):
+		return fmt.Sprintf("no (%s)", path)
+	case !haveBinary:
+		return fmt.Sprintf("yes (%s), not verified", path)
+	case bin.IsArchive():
+		return fmt.Sprintf("yes (%s), extracted from an archive so its checksum cannot be verified", path)
+	case bin.VerifyFile(path) != nil:
+		return fmt.Sprintf("yes (%s), checksum mismatch", path)
+	default:
+		return fmt.Sprintf("yes (%s), checksum verified", path)
+	}
+}
@@ -0,0 +1,82 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/internal/cosmovisor"
+)
+
+func TestPrepareUpgrade(t *testing.T) {
+	binary := []byte("#!/bin/sh\necho v2\n")
+	sum := sha256.Sum256(binary)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	binURL := srv.URL + "/provenanced"
+	checksum := "sha256:" + hex.EncodeToString(sum[:])
+	plan := &upgradetypes.Plan{
+		Name:   "v2",
+		Height: 1000,
+		Info:   fmt.Sprintf(`{"binaries":{"%s":"%s?checksum=%s"}}`, cosmovisor.Platform(), binURL, checksum),
+	}
+	cfg := cosmovisor.Config{Home: t.TempDir(), Name: cosmovisor.DefaultName}
+	binPath := cfg.UpgradeBin("v2")
+	ctx := context.Background()
+	var out bytes.Buffer
+
+	require.NoError(t, cmd.WriteUpgradePreview(ctx, &out, srv.Client(), cfg, nil))
+	require.Equal(t, "there is no pending upgrade plan\n", out.String())
+
+	out.Reset()
+	require.NoError(t, cmd.WriteUpgradePreview(ctx, &out, srv.Client(), cfg, plan))
+	require.Contains(t, out.String(), "name:      v2\n")
+	require.Contains(t, out.String(), "height:    1000\n")
+	require.Contains(t, out.String(), "binary:    "+binURL+"\n")
+	require.Contains(t, out.String(), "checksum:  "+checksum+"\n")
+	require.Contains(t, out.String(), "staged:    no ("+binPath+")\n")
+
+	require.EqualError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, nil, "v2", true),
+		"there is no pending upgrade plan")
+	require.EqualError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, plan, "v3", true),
+		`the pending upgrade plan is "v2" at height 1000, not "v3"`)
+	noChecksum := *plan
+	noChecksum.Info = fmt.Sprintf(`{"binaries":{"any":"%s"}}`, binURL)
+	require.EqualError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, &noChecksum, "v2", true),
+		fmt.Sprintf("binary url %q does not have a checksum", binURL))
+
+	out.Reset()
+	require.NoError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, plan, "v2", false))
+	require.Contains(t, out.String(), "would stage "+binURL)
+	require.NoFileExists(t, binPath, "nothing is staged without download")
+
+	out.Reset()
+	require.NoError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, plan, "v2", true))
+	require.Equal(t, fmt.Sprintf("upgrade v2 at height 1000 staged as %s, %s verified\n", binPath, checksum), out.String())
+	staged, err := os.ReadFile(binPath)
+	require.NoError(t, err)
+	require.Equal(t, binary, staged)
+
+	out.Reset()
+	require.NoError(t, cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, plan, "v2", true))
+	require.Equal(t, fmt.Sprintf("upgrade v2 is already staged: yes (%s), checksum verified\n", binPath), out.String())
+
+	require.NoError(t, os.WriteFile(binPath, []byte("tampered"), 0o755))
+	err = cmd.PrepareUpgrade(ctx, &out, srv.Client(), cfg, plan, "v2", true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "staged binary of upgrade v2 does not match the plan")
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// HomesFileName is the name of the user-level file with the home directory profiles, kept in the provenanced
+// directory of the user's config directory.
+const HomesFileName = "homes.toml"
+
+// profileNameRegex is the pattern of a valid profile name.  Profile names are TOML keys that are read case-insensitively
+// so they are limited to lowercase letters, digits, dashes and underscores.
+var profileNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+const homesTemplate = `# This is a TOML config file.
+# For more information, see https://github.com/toml-lang/toml
+
+# The home directory profile used by the commands that are not given a --home
+active = "{{ .Active }}"
+{{ range $name, $profile := .Profiles }}
+[profiles.{{ $name }}]
+# The home directory of the node
+home = "{{ $profile.Home }}"
+# The network chain ID used instead of the one in the home's client.toml
+chain-id = "{{ $profile.ChainID }}"
+# <host>:<port> to Tendermint RPC interface used instead of the one in the home's client.toml
+node = "{{ $profile.Node }}"
+{{ end }}`
+
+// HomeProfile is a named home directory with the client settings to use with it.
+type HomeProfile struct {
+	Home    string `mapstructure:"home" json:"home"`
+	ChainID string `mapstructure:"chain-id" json:"chain-id"`
+	Node    string `mapstructure:"node" json:"node"`
+}
+
+// HomeProfiles are the home directory profiles of the user along with the one in use.
+type HomeProfiles struct {
+	Active   string                 `mapstructure:"active" json:"active"`
+	Profiles map[string]HomeProfile `mapstructure:"profiles" json:"profiles"`
+}
+
+// DefaultHomesFile returns the path of the user's home directory profiles file.
+func DefaultHomesFile() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "provenanced", HomesFileName), nil
+}
+
+// ReadHomeProfiles reads the home directory profiles from the given file.  No profiles are returned if the file does
+// not exist.
+func ReadHomeProfiles(homesFilePath string) (*HomeProfiles, error) {
+	profiles := &HomeProfiles{Profiles: make(map[string]HomeProfile)}
+	if _, err := os.Stat(homesFilePath); os.IsNotExist(err) {
+		return profiles, nil
+	}
+	v := viper.New()
+	v.SetConfigFile(homesFilePath)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	if err := v.Unmarshal(profiles); err != nil {
+		return nil, err
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = make(map[string]HomeProfile)
+	}
+	return profiles, nil
+}
+
+// WriteHomeProfiles writes the home directory profiles to the given file, creating its directory if needed.
+func WriteHomeProfiles(homesFilePath string, profiles *HomeProfiles) error {
+	tmpl, err := template.New("homesFileTemplate").Parse(homesTemplate)
+	if err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	if err = tmpl.Execute(&buffer, profiles); err != nil {
+		return err
+	}
+	if err = ensureConfigPath(filepath.Dir(homesFilePath)); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(homesFilePath, buffer.Bytes(), 0600)
+}
+
+// Names returns the names of the profiles in sorted order.
+func (p *HomeProfiles) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Add adds a profile, replacing any existing profile with the same name.  The home directory is made absolute so that
+// the profile can be used from any working directory.
+func (p *HomeProfiles) Add(name string, profile HomeProfile) error {
+	if !profileNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q, must be lowercase letters, digits, dashes and underscores", name)
+	}
+	if len(profile.Home) == 0 {
+		return fmt.Errorf("profile %q has no home directory", name)
+	}
+	home, err := filepath.Abs(profile.Home)
+	if err != nil {
+		return err
+	}
+	profile.Home = home
+	p.Profiles[name] = profile
+	return nil
+}
+
+// Use makes the named profile the active one.  An empty name stops using profiles.
+func (p *HomeProfiles) Use(name string) error {
+	if _, found := p.Profiles[name]; !found && len(name) > 0 {
+		return fmt.Errorf("unknown home profile %q", name)
+	}
+	p.Active = name
+	return nil
+}
+
+// Remove removes the named profile, no longer using it if it was active.
+func (p *HomeProfiles) Remove(name string) error {
+	if _, found := p.Profiles[name]; !found {
+		return fmt.Errorf("unknown home profile %q", name)
+	}
+	delete(p.Profiles, name)
+	if p.Active == name {
+		p.Active = ""
+	}
+	return nil
+}
+
+// ActiveProfile returns the profile in use, or nil if no profile is in use.
+func (p *HomeProfiles) ActiveProfile() *HomeProfile {
+	profile, found := p.Profiles[p.Active]
+	if !found {
+		return nil
+	}
+	return &profile
+}
+
+// UseHomeProfile sets the --home flag of the command to the home of the active profile in the given file.  Nothing is
+// changed and nil is returned when the command was given a --home, the home comes from the environment, or no profile
+// is in use.
+func UseHomeProfile(cmd *cobra.Command, homesFilePath string) (*HomeProfile, error) {
+	homeFlag := cmd.Flags().Lookup(flags.FlagHome)
+	if homeFlag == nil || homeFlag.Changed || len(os.Getenv("PIO_HOME")) > 0 {
+		return nil, nil
+	}
+	profiles, err := ReadHomeProfiles(homesFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read home profiles: %v", err)
+	}
+	profile := profiles.ActiveProfile()
+	if profile == nil {
+		return nil, nil
+	}
+	if err = cmd.Flags().Set(flags.FlagHome, profile.Home); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// ApplyClientConfig updates the client context with the chain id and node of the profile, in place of the ones read
+// from the home's client.toml.  The settings that are empty in the profile are left as they are.
+func (p *HomeProfile) ApplyClientConfig(ctx client.Context) (client.Context, error) {
+	if len(p.ChainID) > 0 {
+		ctx = ctx.WithChainID(p.ChainID)
+	}
+	if len(p.Node) > 0 {
+		rpcClient, err := client.NewClientFromNode(p.Node)
+		if err != nil {
+			return ctx, fmt.Errorf("couldn't get client from nodeURI: %v", err)
+		}
+		ctx = ctx.WithNodeURI(p.Node).WithClient(rpcClient)
+	}
+	return ctx, nil
+}
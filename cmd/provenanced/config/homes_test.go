@@ -0,0 +1,85 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestHomeProfiles(t *testing.T) {
+	dir := t.TempDir()
+	homesFile := filepath.Join(dir, "provenanced", HomesFileName)
+
+	profiles, err := ReadHomeProfiles(homesFile)
+	require.NoError(t, err)
+	require.Empty(t, profiles.Names(), "no profiles without a file")
+	require.Nil(t, profiles.ActiveProfile())
+
+	require.NoError(t, profiles.Add("validator", HomeProfile{Home: "/opt/provenance", ChainID: "pio-mainnet-1"}))
+	require.NoError(t, profiles.Add("localnet", HomeProfile{Home: "build/node0", Node: "tcp://localhost:26657"}))
+	require.EqualError(t, profiles.Add("Testnet", HomeProfile{Home: "/tmp"}),
+		`invalid profile name "Testnet", must be lowercase letters, digits, dashes and underscores`)
+	require.EqualError(t, profiles.Add("testnet", HomeProfile{}), `profile "testnet" has no home directory`)
+	require.True(t, filepath.IsAbs(profiles.Profiles["localnet"].Home), "relative homes are made absolute")
+
+	require.EqualError(t, profiles.Use("testnet"), `unknown home profile "testnet"`)
+	require.NoError(t, profiles.Use("validator"))
+	require.NoError(t, WriteHomeProfiles(homesFile, profiles))
+
+	read, err := ReadHomeProfiles(homesFile)
+	require.NoError(t, err)
+	require.Equal(t, profiles, read)
+	require.Equal(t, []string{"localnet", "validator"}, read.Names())
+	require.Equal(t, &HomeProfile{Home: "/opt/provenance", ChainID: "pio-mainnet-1"}, read.ActiveProfile())
+
+	require.NoError(t, read.Remove("validator"))
+	require.Empty(t, read.Active, "removing the active profile stops using profiles")
+	require.EqualError(t, read.Remove("validator"), `unknown home profile "validator"`)
+}
+
+func TestUseHomeProfile(t *testing.T) {
+	homesFile := filepath.Join(t.TempDir(), HomesFileName)
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String(flags.FlagHome, "/default", "")
+		require.NoError(t, cmd.ParseFlags(args))
+		return cmd
+	}
+
+	cmd := newCmd()
+	profile, err := UseHomeProfile(cmd, homesFile)
+	require.NoError(t, err)
+	require.Nil(t, profile, "no profiles")
+
+	profiles := &HomeProfiles{Profiles: make(map[string]HomeProfile)}
+	require.NoError(t, profiles.Add("testnet", HomeProfile{Home: "/testnet", ChainID: "pio-testnet-1", Node: "tcp://10.0.0.1:26657"}))
+	require.NoError(t, profiles.Use("testnet"))
+	require.NoError(t, WriteHomeProfiles(homesFile, profiles))
+
+	cmd = newCmd()
+	profile, err = UseHomeProfile(cmd, homesFile)
+	require.NoError(t, err)
+	require.NotNil(t, profile)
+	home, err := cmd.Flags().GetString(flags.FlagHome)
+	require.NoError(t, err)
+	require.Equal(t, "/testnet", home)
+
+	ctx, err := profile.ApplyClientConfig(client.Context{}.WithChainID("pio-mainnet-1").WithNodeURI("tcp://localhost:26657"))
+	require.NoError(t, err)
+	require.Equal(t, "pio-testnet-1", ctx.ChainID)
+	require.Equal(t, "tcp://10.0.0.1:26657", ctx.NodeURI)
+	require.NotNil(t, ctx.Client)
+
+	cmd = newCmd("--home", "/elsewhere")
+	profile, err = UseHomeProfile(cmd, homesFile)
+	require.NoError(t, err)
+	require.Nil(t, profile, "an explicit --home wins")
+	home, err = cmd.Flags().GetString(flags.FlagHome)
+	require.NoError(t, err)
+	require.Equal(t, "/elsewhere", home)
+}
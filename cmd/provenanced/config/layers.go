@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/provenance-io/provenance/internal/nodeconfig"
+)
+
+// ChangedSetting is a setting of the config files that differs from the default configuration.
+type ChangedSetting struct {
+	// Key is the dotted name of the setting, e.g. "api.enable".
+	Key string
+	// Value is the value of the setting once all the layers have been merged.
+	Value string
+	// Default is the default value of the setting, empty when it has no default.
+	Default string
+	// HasDefault is true if the setting is part of the default configuration.
+	HasDefault bool
+	// Layer is the config file the value came from, e.g. "app.toml" or "config.d/10-fleet.toml".
+	Layer string
+}
+
+// String returns the setting as a key=value line annotated with the layer and default.
+func (s ChangedSetting) String() string {
+	def := "none"
+	if s.HasDefault {
+		def = s.Default
+	}
+	return fmt.Sprintf("%s=%s (%s, default: %s)", s.Key, s.Value, s.Layer, def)
+}
+
+// ChangedSettings returns the settings of the layered config files in the config directory whose values differ from
+// the default configuration, sorted by key.
+func ChangedSettings(configPath string) ([]ChangedSetting, error) {
+	layers, err := nodeconfig.LoadLayers(configPath)
+	if err != nil {
+		return nil, err
+	}
+	values, sources, err := layers.Values()
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := defaultValues()
+	if err != nil {
+		return nil, err
+	}
+	var changed []ChangedSetting
+	for key, value := range values {
+		def, hasDefault := defaults[key]
+		if hasDefault && def == value {
+			continue
+		}
+		changed = append(changed, ChangedSetting{
+			Key:        key,
+			Value:      value,
+			Default:    def,
+			HasDefault: hasDefault,
+			Layer:      sources[key],
+		})
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Key < changed[j].Key })
+	return changed, nil
+}
+
+// defaultValues returns the settings of the config.toml and app.toml that would be written for a new node.
+func defaultValues() (map[string]string, error) {
+	dir, err := ioutil.TempDir("", "provenanced-config")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	writeDefaultConfigFiles(dir)
+	layers, err := nodeconfig.LoadLayers(dir)
+	if err != nil {
+		return nil, err
+	}
+	values, _, err := layers.Values()
+	return values, err
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestConfigLayers(t *testing.T) {
+	home := t.TempDir()
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	_, err := interceptConfigs(v, "", nil)
+	require.NoError(t, err)
+
+	configPath := filepath.Join(home, "config")
+	changed, err := ChangedSettings(configPath)
+	require.NoError(t, err)
+	for _, setting := range changed {
+		require.NotEqual(t, "api.enable", setting.Key)
+	}
+
+	require.NoError(t, os.Mkdir(filepath.Join(configPath, "config.d"), 0o755))
+	fragment := "[api]\nenable = true\n[p2p]\nseeds = \"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:26656\"\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(configPath, "config.d", "10-fleet.toml"), []byte(fragment), 0o644))
+
+	v = viper.New()
+	v.Set(flags.FlagHome, home)
+	conf, err := interceptConfigs(v, "", nil)
+	require.NoError(t, err)
+	require.True(t, v.GetBool("api.enable"))
+	require.Equal(t, "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:26656", conf.P2P.Seeds)
+	require.Equal(t, home, conf.RootDir)
+
+	changed, err = ChangedSettings(configPath)
+	require.NoError(t, err)
+	found := false
+	for _, setting := range changed {
+		if setting.Key == "api.enable" {
+			found = true
+			require.Equal(t, "api.enable=true (config.d/10-fleet.toml, default: false)", setting.String())
+		}
+	}
+	require.True(t, found, "api.enable changed")
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// FlagLogLevelOverrides is the app.toml setting with a JSON object of module names (e.g. "x/marker") to the log
+// level to use for that module instead of the log_level.
+const FlagLogLevelOverrides = "log_level_overrides"
+
+var logLevelOverridesLine = regexp.MustCompile(`(?m)^` + FlagLogLevelOverrides + `\s*=.*$`)
+
+// ParseLogLevelOverrides parses the JSON object of module names to log levels.
+func ParseLogLevelOverrides(overrides string) (map[string]zerolog.Level, error) {
+	levels := map[string]zerolog.Level{}
+	if len(strings.TrimSpace(overrides)) == 0 {
+		return levels, nil
+	}
+	names := map[string]string{}
+	if err := json.Unmarshal([]byte(overrides), &names); err != nil {
+		return nil, fmt.Errorf("invalid %s, expected a JSON object of module names to log levels: %w", FlagLogLevelOverrides, err)
+	}
+	for module, name := range names {
+		level, err := zerolog.ParseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log level (%s) for module %s: %w", name, module, err)
+		}
+		levels[module] = level
+	}
+	return levels, nil
+}
+
+// NewModuleLevelLogger returns a logger that writes entries at or above the default level, except for loggers of the
+// modules with an override level (identified by their "module" key) which use that level instead.
+func NewModuleLevelLogger(logger zerolog.Logger, level zerolog.Level, overrides map[string]zerolog.Level) tmlog.Logger {
+	if len(overrides) == 0 {
+		return server.ZeroLogWrapper{Logger: logger.Level(level)}
+	}
+	// The underlying logger must allow the most verbose level in use; each module logger applies its own level.
+	min := level
+	for _, l := range overrides {
+		if l < min {
+			min = l
+		}
+	}
+	return moduleLevelLogger{
+		logger:    server.ZeroLogWrapper{Logger: logger.Level(min)},
+		level:     level,
+		overrides: overrides,
+	}
+}
+
+// moduleLevelLogger is a tendermint logger that only passes entries at or above its level to the underlying logger.
+type moduleLevelLogger struct {
+	logger    tmlog.Logger
+	level     zerolog.Level
+	overrides map[string]zerolog.Level
+}
+
+var _ tmlog.Logger = moduleLevelLogger{}
+
+func (l moduleLevelLogger) Debug(msg string, keyVals ...interface{}) {
+	if l.level <= zerolog.DebugLevel {
+		l.logger.Debug(msg, keyVals...)
+	}
+}
+
+func (l moduleLevelLogger) Info(msg string, keyVals ...interface{}) {
+	if l.level <= zerolog.InfoLevel {
+		l.logger.Info(msg, keyVals...)
+	}
+}
+
+func (l moduleLevelLogger) Error(msg string, keyVals ...interface{}) {
+	if l.level <= zerolog.ErrorLevel {
+		l.logger.Error(msg, keyVals...)
+	}
+}
+
+// With returns a logger with the additional key values, using the override level of the module if one is provided.
+func (l moduleLevelLogger) With(keyVals ...interface{}) tmlog.Logger {
+	level := l.level
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if key, ok := keyVals[i].(string); ok && key == "module" {
+			if override, found := l.overrides[fmt.Sprint(keyVals[i+1])]; found {
+				level = override
+			}
+		}
+	}
+	return moduleLevelLogger{
+		logger:    l.logger.With(keyVals...),
+		level:     level,
+		overrides: l.overrides,
+	}
+}
+
+// WriteLogLevelOverrides validates and sets the log_level_overrides in the app.toml file, leaving the rest of the file
+// unchanged.  An empty value removes the overrides.
+func WriteLogLevelOverrides(appCfgFilePath string, overrides string) error {
+	if _, err := ParseLogLevelOverrides(overrides); err != nil {
+		return err
+	}
+	bz, err := ioutil.ReadFile(appCfgFilePath)
+	if err != nil {
+		return err
+	}
+	contents := string(bz)
+	line := fmt.Sprintf("%s = %q", FlagLogLevelOverrides, strings.TrimSpace(overrides))
+	switch {
+	case logLevelOverridesLine.MatchString(contents):
+		contents = logLevelOverridesLine.ReplaceAllLiteralString(contents, line)
+	default:
+		// Top level keys must come before the first table.
+		entry := fmt.Sprintf("# Log levels for specific modules, e.g. '{\"x/marker\":\"debug\"}'\n%s\n\n", line)
+		if loc := regexp.MustCompile(`(?m)^\[`).FindStringIndex(contents); loc != nil {
+			contents = contents[:loc[0]] + entry + contents[loc[0]:]
+		} else {
+			contents += "\n" + entry
+		}
+	}
+	info, err := os.Stat(appCfgFilePath)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(appCfgFilePath, []byte(contents), info.Mode())
+}
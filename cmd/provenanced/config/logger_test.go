@@ -0,0 +1,80 @@
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLevelOverrides(t *testing.T) {
+	levels, err := ParseLogLevelOverrides("")
+	require.NoError(t, err)
+	require.Empty(t, levels)
+
+	levels, err = ParseLogLevelOverrides(`{"x/marker":"debug","x/name":"error"}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]zerolog.Level{"x/marker": zerolog.DebugLevel, "x/name": zerolog.ErrorLevel}, levels)
+
+	_, err = ParseLogLevelOverrides(`x/marker=debug`)
+	require.Error(t, err)
+	_, err = ParseLogLevelOverrides(`{"x/marker":"loud"}`)
+	require.EqualError(t, err, "failed to parse log level (loud) for module x/marker: Unknown Level String: 'loud', defaulting to NoLevel")
+}
+
+func TestModuleLevelLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewModuleLevelLogger(zerolog.New(&out), zerolog.InfoLevel, map[string]zerolog.Level{
+		"x/marker":   zerolog.DebugLevel,
+		"x/metadata": zerolog.ErrorLevel,
+	})
+
+	logger.Debug("default debug")
+	logger.Info("default info")
+	marker := logger.With("module", "x/marker")
+	marker.Debug("marker debug")
+	marker.With("denom", "nhash").Debug("marker denom debug")
+	metadata := logger.With("module", "x/metadata")
+	metadata.Info("metadata info")
+	metadata.Error("metadata error")
+	logger.With("module", "x/name").Debug("name debug")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4, out.String())
+	require.Contains(t, lines[0], `"message":"default info"`)
+	require.Contains(t, lines[1], `"module":"x/marker","message":"marker debug"`)
+	require.Contains(t, lines[2], `"denom":"nhash","message":"marker denom debug"`)
+	require.Contains(t, lines[3], `"module":"x/metadata","message":"metadata error"`)
+}
+
+func TestWriteLogLevelOverrides(t *testing.T) {
+	appCfgFile := filepath.Join(t.TempDir(), "app.toml")
+	require.NoError(t, ioutil.WriteFile(appCfgFile, []byte("minimum-gas-prices = \"1905nhash\"\n\n[api]\nenable = false\n"), 0644))
+
+	require.Error(t, WriteLogLevelOverrides(appCfgFile, `{"x/marker":"loud"}`))
+
+	read := func() *viper.Viper {
+		v := viper.New()
+		v.SetConfigFile(appCfgFile)
+		require.NoError(t, v.ReadInConfig())
+		return v
+	}
+
+	require.NoError(t, WriteLogLevelOverrides(appCfgFile, `{"x/marker":"debug"}`))
+	v := read()
+	require.Equal(t, `{"x/marker":"debug"}`, v.GetString(FlagLogLevelOverrides))
+	require.Equal(t, "1905nhash", v.GetString("minimum-gas-prices"))
+	require.False(t, v.GetBool("api.enable"))
+
+	require.NoError(t, WriteLogLevelOverrides(appCfgFile, `{"x/attribute":"error"}`))
+	v = read()
+	require.Equal(t, `{"x/attribute":"error"}`, v.GetString(FlagLogLevelOverrides))
+	bz, err := ioutil.ReadFile(appCfgFile)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(bz), FlagLogLevelOverrides+" ="))
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ClientNetwork is a public network that a client config can be set up for.
+type ClientNetwork struct {
+	// ChainID is the chain id of the network.
+	ChainID string
+	// Nodes are the public Tendermint RPC endpoints of the network in order of preference.
+	Nodes []string
+}
+
+// ClientNetworks are the public networks that a client config can be set up for, by name.
+var ClientNetworks = map[string]ClientNetwork{
+	"mainnet": {
+		ChainID: "pio-mainnet-1",
+		Nodes:   []string{"https://rpc.provenance.io:443"},
+	},
+	"testnet": {
+		ChainID: "pio-testnet-1",
+		Nodes:   []string{"https://rpc.test.provenance.io:443"},
+	},
+}
+
+// ClientNetworkNames returns the names of the known networks in sorted order.
+func ClientNetworkNames() []string {
+	names := make([]string, 0, len(ClientNetworks))
+	for name := range ClientNetworks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetClientNetwork returns the known network with the given name.
+func GetClientNetwork(name string) (ClientNetwork, error) {
+	network, found := ClientNetworks[name]
+	if !found {
+		return ClientNetwork{}, fmt.Errorf("unknown network %q, must be one of: %s", name, strings.Join(ClientNetworkNames(), ", "))
+	}
+	return network, nil
+}
+
+// VerifyNode checks that the node is reachable and on the given chain.
+func VerifyNode(ctx context.Context, node string, chainID string) error {
+	rpcClient, err := client.NewClientFromNode(node)
+	if err != nil {
+		return fmt.Errorf("couldn't get client from nodeURI %s: %v", node, err)
+	}
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't get status of node %s: %v", node, err)
+	}
+	if status.NodeInfo.Network != chainID {
+		return fmt.Errorf("node %s is on chain %q, not %q", node, status.NodeInfo.Network, chainID)
+	}
+	return nil
+}
+
+// FindNode returns the first of the nodes that is reachable and on the given chain.
+func FindNode(ctx context.Context, chainID string, nodes []string) (string, error) {
+	errs := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		err := VerifyNode(ctx, node, chainID)
+		if err == nil {
+			return node, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return "", fmt.Errorf("no reachable node for chain %q: %s", chainID, strings.Join(errs, "; "))
+}
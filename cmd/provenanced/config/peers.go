@@ -0,0 +1,135 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+const (
+	// KeyPersistentPeers is the config.toml setting with the comma separated peers to maintain connections with.
+	KeyPersistentPeers = "persistent_peers"
+	// KeySeeds is the config.toml setting with the comma separated seed nodes used to discover peers.
+	KeySeeds = "seeds"
+)
+
+// ParsePeer validates a peer in nodeID@host:port format and returns its node id.
+func ParsePeer(peer string) (p2p.ID, error) {
+	parts := strings.Split(peer, "@")
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid peer %q, expected nodeID@host:port", peer)
+	}
+	id := strings.ToLower(parts[0])
+	if bz, err := hex.DecodeString(id); err != nil || len(bz) != p2p.IDByteLength {
+		return "", fmt.Errorf("invalid peer %q, node id must be %d hex characters", peer, p2p.IDByteLength*2)
+	}
+	host, port, err := net.SplitHostPort(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid peer %q: %w", peer, err)
+	}
+	if len(host) == 0 {
+		return "", fmt.Errorf("invalid peer %q, host is empty", peer)
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return "", fmt.Errorf("invalid peer %q, port must be between 1 and 65535", peer)
+	}
+	return p2p.ID(id), nil
+}
+
+// ParsePeerList splits a comma separated list of peers, ignoring empty entries.
+func ParsePeerList(list string) []string {
+	var peers []string
+	for _, peer := range strings.Split(list, ",") {
+		if peer = strings.TrimSpace(peer); len(peer) > 0 {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// AddPeers returns the peers with each of the new peers added.  A new peer with the same node id as an existing peer
+// replaces it so that a node's address can be updated.
+func AddPeers(peers []string, newPeers ...string) ([]string, error) {
+	result := append([]string{}, peers...)
+	for _, peer := range newPeers {
+		id, err := ParsePeer(peer)
+		if err != nil {
+			return nil, err
+		}
+		if i := indexOfPeer(result, id); i >= 0 {
+			result[i] = peer
+		} else {
+			result = append(result, peer)
+		}
+	}
+	return result, nil
+}
+
+// RemovePeers returns the peers without those matching each of the given node ids or peers.  It is an error for one
+// of them to not be in the list.
+func RemovePeers(peers []string, removed ...string) ([]string, error) {
+	result := append([]string{}, peers...)
+	for _, peer := range removed {
+		id := p2p.ID(strings.ToLower(strings.SplitN(peer, "@", 2)[0]))
+		i := indexOfPeer(result, id)
+		if i < 0 {
+			return nil, fmt.Errorf("peer %s not found", peer)
+		}
+		result = append(result[:i], result[i+1:]...)
+	}
+	return result, nil
+}
+
+// indexOfPeer returns the index of the peer with the given node id, or -1 if it isn't in the list.
+func indexOfPeer(peers []string, id p2p.ID) int {
+	for i, peer := range peers {
+		if p2p.ID(strings.ToLower(strings.SplitN(peer, "@", 2)[0])) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReadPeers returns the list of peers of the given setting in the config.toml file.
+func ReadPeers(tmCfgFilePath string, key string) ([]string, error) {
+	bz, err := ioutil.ReadFile(tmCfgFilePath)
+	if err != nil {
+		return nil, err
+	}
+	match := peersLine(key).FindStringSubmatch(string(bz))
+	if match == nil {
+		return nil, fmt.Errorf("%s not found in %s", key, tmCfgFilePath)
+	}
+	return ParsePeerList(match[1]), nil
+}
+
+// WritePeers sets the list of peers of the given setting in the config.toml file, leaving the rest of the file
+// unchanged.
+func WritePeers(tmCfgFilePath string, key string, peers []string) error {
+	bz, err := ioutil.ReadFile(tmCfgFilePath)
+	if err != nil {
+		return err
+	}
+	line := peersLine(key)
+	if !line.Match(bz) {
+		return fmt.Errorf("%s not found in %s", key, tmCfgFilePath)
+	}
+	contents := line.ReplaceAllLiteralString(string(bz), fmt.Sprintf("%s = %q", key, strings.Join(peers, ",")))
+	info, err := os.Stat(tmCfgFilePath)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(tmCfgFilePath, []byte(contents), info.Mode())
+}
+
+// peersLine returns the expression matching the line of a peer list setting, capturing its value.
+func peersLine(key string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(key) + `[ \t]*=[ \t]*"([^"]*)"[ \t]*$`)
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+)
+
+const (
+	peer1 = "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:26656"
+	peer2 = "4b9f2c5edf1a7c2f2cadcb1f1d9f6a4b5f4a3c2d@seed.example.com:26656"
+)
+
+func TestParsePeer(t *testing.T) {
+	id, err := ParsePeer(peer1)
+	require.NoError(t, err)
+	require.Equal(t, "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c", string(id))
+
+	for _, peer := range []string{
+		"10.0.0.1:26656",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a@10.0.0.1:26656",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2bxx@10.0.0.1:26656",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@:26656",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:0",
+		"3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.1:70000",
+	} {
+		_, err = ParsePeer(peer)
+		require.Error(t, err, peer)
+	}
+}
+
+func TestAddRemovePeers(t *testing.T) {
+	require.Equal(t, []string{peer1, peer2}, ParsePeerList(" "+peer1+",,"+peer2+" "))
+	require.Empty(t, ParsePeerList(""))
+
+	peers, err := AddPeers(nil, peer1, peer2, peer1)
+	require.NoError(t, err)
+	require.Equal(t, []string{peer1, peer2}, peers, "duplicates are removed")
+
+	moved := "3a8e1b4dce0f6b1e1b9cba0e0c8e5f3a4e3f2b1c@10.0.0.2:26656"
+	peers, err = AddPeers(peers, moved)
+	require.NoError(t, err)
+	require.Equal(t, []string{moved, peer2}, peers, "a new address replaces the old one")
+
+	_, err = AddPeers(peers, "bad")
+	require.Error(t, err)
+
+	peers, err = RemovePeers(peers, "3A8E1B4DCE0F6B1E1B9CBA0E0C8E5F3A4E3F2B1C")
+	require.NoError(t, err)
+	require.Equal(t, []string{peer2}, peers)
+	_, err = RemovePeers(peers, peer1)
+	require.EqualError(t, err, "peer "+peer1+" not found")
+}
+
+func TestReadWritePeers(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.toml")
+	tmcfg.WriteConfigFile(cfgFile, tmcfg.DefaultConfig())
+	before, err := ioutil.ReadFile(cfgFile)
+	require.NoError(t, err)
+
+	peers, err := ReadPeers(cfgFile, KeySeeds)
+	require.NoError(t, err)
+	require.Empty(t, peers)
+
+	require.NoError(t, WritePeers(cfgFile, KeySeeds, []string{peer1, peer2}))
+	peers, err = ReadPeers(cfgFile, KeySeeds)
+	require.NoError(t, err)
+	require.Equal(t, []string{peer1, peer2}, peers)
+	peers, err = ReadPeers(cfgFile, KeyPersistentPeers)
+	require.NoError(t, err)
+	require.Empty(t, peers, "only the seeds were changed")
+
+	require.NoError(t, WritePeers(cfgFile, KeySeeds, nil))
+	after, err := ioutil.ReadFile(cfgFile)
+	require.NoError(t, err)
+	require.Equal(t, string(before), string(after))
+
+	_, err = ReadPeers(cfgFile, "unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const (
+	// SchemaConfigApp identifies the settings of app.toml in a config schema.
+	SchemaConfigApp = "app"
+	// SchemaConfigTendermint identifies the settings of config.toml in a config schema.
+	SchemaConfigTendermint = "tendermint"
+	// SchemaConfigClient identifies the settings of client.toml in a config schema.
+	SchemaConfigClient = "client"
+
+	// jsonSchemaVersion is the JSON schema draft the config schema conforms to.
+	jsonSchemaVersion = "http://json-schema.org/draft-07/schema#"
+)
+
+// schemaFiles are the config files described by the config schema, keyed by the name of their part of the schema.
+var schemaFiles = map[string]string{
+	SchemaConfigApp:        "app.toml",
+	SchemaConfigTendermint: "config.toml",
+	SchemaConfigClient:     "client.toml",
+}
+
+// SchemaField describes a single setting of a config file.
+type SchemaField struct {
+	// Key is the dotted name of the setting, e.g. "api.enable".
+	Key string `json:"-"`
+	// Type is the JSON schema type of the setting: string, boolean, integer, number, array or object.
+	Type string `json:"type"`
+	// Default is the value of the setting in the config file written for a new node.
+	Default interface{} `json:"default"`
+	// Description is the comment above the setting in the config file.
+	Description string `json:"description,omitempty"`
+	// Section is the TOML table holding the setting, empty for settings at the top of the file.
+	Section string `json:"section"`
+}
+
+// ConfigSchema is a JSON schema of the settings of one config file.
+type ConfigSchema struct {
+	Type       string                 `json:"type"`
+	Title      string                 `json:"title"`
+	Properties map[string]SchemaField `json:"properties"`
+}
+
+// Schema is a JSON schema of the app, tendermint and client config files.
+type Schema struct {
+	Schema     string                  `json:"$schema"`
+	Title      string                  `json:"title"`
+	Type       string                  `json:"type"`
+	Properties map[string]ConfigSchema `json:"properties"`
+}
+
+// GetConfigSchema returns a JSON schema of every setting of the app.toml, config.toml and client.toml written for a
+// new node, with the type and default of each setting and its description taken from the comment above it.
+func GetConfigSchema() (*Schema, error) {
+	dir, err := ioutil.TempDir("", "provenanced-config")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	writeDefaultConfigFiles(dir)
+	if err = WriteConfigToFile(filepath.Join(dir, schemaFiles[SchemaConfigClient]), defaultClientConfig()); err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{
+		Schema:     jsonSchemaVersion,
+		Title:      "provenanced configuration",
+		Type:       "object",
+		Properties: make(map[string]ConfigSchema, len(schemaFiles)),
+	}
+	for name, file := range schemaFiles {
+		fields, err := readSchemaFields(filepath.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+		properties := make(map[string]SchemaField, len(fields))
+		for _, field := range fields {
+			properties[field.Key] = field
+		}
+		schema.Properties[name] = ConfigSchema{Type: "object", Title: file, Properties: properties}
+	}
+	return schema, nil
+}
+
+// readSchemaFields reads the settings of a config file in the order they appear in it.
+func readSchemaFields(path string) ([]SchemaField, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var fields []SchemaField
+	var section string
+	var comments []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case len(line) == 0:
+			comments = nil
+		case strings.HasPrefix(line, "#"):
+			comment := strings.TrimSpace(strings.TrimLeft(line, "#"))
+			if len(comment) > 0 {
+				comments = append(comments, comment)
+			}
+		case strings.HasPrefix(line, "["):
+			section = strings.Trim(line, "[] ")
+			comments = nil
+		default:
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			key := strings.TrimSpace(parts[0])
+			if len(section) > 0 {
+				key = section + "." + key
+			}
+			value := v.Get(key)
+			fields = append(fields, SchemaField{
+				Key:         key,
+				Type:        schemaType(value),
+				Default:     value,
+				Description: strings.Join(comments, " "),
+				Section:     section,
+			})
+			comments = nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// schemaType returns the JSON schema type of a setting value read from a TOML file.
+func schemaType(value interface{}) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "integer"
+	case float32, float64:
+		return "number"
+	case []interface{}, []string, []map[string]interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// writeDefaultConfigFiles writes the config.toml and app.toml that would be written for a new node to the directory.
+func writeDefaultConfigFiles(dir string) {
+	tmConf := tmcfg.DefaultConfig()
+	setTmConfigDefaults(tmConf)
+	tmcfg.WriteConfigFile(filepath.Join(dir, "config.toml"), tmConf)
+	serverconfig.WriteConfigFile(filepath.Join(dir, "app.toml"), serverconfig.DefaultConfig())
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfigSchema(t *testing.T) {
+	schema, err := GetConfigSchema()
+	require.NoError(t, err)
+	require.Len(t, schema.Properties, 3)
+
+	app := schema.Properties[SchemaConfigApp].Properties
+	require.Equal(t, SchemaField{
+		Key:         "api.enable",
+		Type:        "boolean",
+		Default:     false,
+		Description: "Enable defines if the API server should be enabled.",
+		Section:     "api",
+	}, app["api.enable"])
+	require.Equal(t, "string", app["minimum-gas-prices"].Type)
+	require.Equal(t, "", app["minimum-gas-prices"].Section)
+
+	tm := schema.Properties[SchemaConfigTendermint].Properties
+	require.Equal(t, "integer", tm["mempool.size"].Type)
+	require.EqualValues(t, 5000, tm["mempool.size"].Default)
+	require.Equal(t, "mempool", tm["mempool.size"].Section)
+	require.Equal(t, "4s", tm["consensus.timeout_commit"].Default, "provenance default")
+
+	client := schema.Properties[SchemaConfigClient].Properties
+	require.Len(t, client, 5)
+	require.Equal(t, SchemaField{
+		Key:         "node",
+		Type:        "string",
+		Default:     node,
+		Description: "<host>:<port> to Tendermint RPC interface for this chain",
+		Section:     "",
+	}, client["node"])
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/provenance-io/provenance/internal/nodeconfig"
+
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -70,7 +72,12 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
 	}
 
-	serverCtx.Logger = server.ZeroLogWrapper{Logger: zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	logLvlOverrides, err := ParseLogLevelOverrides(serverCtx.Viper.GetString(FlagLogLevelOverrides))
+	if err != nil {
+		return err
+	}
+
+	serverCtx.Logger = NewModuleLevelLogger(zerolog.New(logWriter).With().Timestamp().Logger(), logLvl, logLvlOverrides)
 
 	return server.SetCmdServerContext(cmd, serverCtx)
 }
@@ -95,8 +102,7 @@ func interceptConfigs(rootViper *viper.Viper, customAppTemplate string, customCo
 			return nil, fmt.Errorf("error in config file: %v", err)
 		}
 
-		conf.RPC.PprofListenAddress = "localhost:6060"
-		conf.Consensus.TimeoutCommit = 4 * time.Second
+		setTmConfigDefaults(conf)
 		tmcfg.WriteConfigFile(tmCfgFile, conf)
 
 	case err != nil:
@@ -149,9 +155,31 @@ func interceptConfigs(rootViper *viper.Viper, customAppTemplate string, customCo
 		return nil, fmt.Errorf("failed to merge configuration: %w", err)
 	}
 
+	// Merge the fragments of the config.d directory over both config files. They can override tendermint settings
+	// too, so the tendermint configuration is read again once they have been merged.
+	fragments, err := nodeconfig.LoadFragments(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(fragments) > 0 {
+		if err = fragments.MergeInto(rootViper); err != nil {
+			return nil, err
+		}
+		if err = rootViper.Unmarshal(conf); err != nil {
+			return nil, err
+		}
+		conf.SetRoot(rootDir)
+	}
+
 	return conf, nil
 }
 
+// setTmConfigDefaults changes the tendermint defaults to the ones written to a new config.toml.
+func setTmConfigDefaults(conf *tmcfg.Config) {
+	conf.RPC.PprofListenAddress = "localhost:6060"
+	conf.Consensus.TimeoutCommit = 4 * time.Second
+}
+
 // Binds viper flags using the PIO ENV prefix.
 func bindFlags(cmd *cobra.Command, v *viper.Viper) (err error) {
 	defer func() {
@@ -0,0 +1,156 @@
+package antewrapper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// AttributeGateParamSpace is the name of the params subspace holding the attributes required to submit specific msgs.
+const AttributeGateParamSpace = "attributegate"
+
+// ParamStoreKeyAttributeGates is the list of msg type urls with the attribute the fee payer must hold to submit them.
+var ParamStoreKeyAttributeGates = []byte("AttributeGates")
+
+// AttributeChecker defines the attribute functionality needed to check that an account holds an attribute.
+type AttributeChecker interface {
+	AccountHasAttribute(ctx sdk.Context, acc sdk.AccAddress, name string) bool
+}
+
+// AttributeGate is the attribute the fee payer of a transaction containing a msg of the given type must hold.
+type AttributeGate struct {
+	// msg type url, e.g. /provenance.attribute.v1.MsgAddAttributeRequest
+	MsgTypeURL string `json:"msg_type_url" yaml:"msg_type_url"`
+	// the name of the attribute the fee payer must hold, e.g. validator.provenance.io
+	AttributeName string `json:"attribute_name" yaml:"attribute_name"`
+}
+
+// AttributeGateParams defines the governance controlled attributes required to submit specific msg types.
+type AttributeGateParams struct {
+	// attributes required of the fee payer of the transactions containing each of the gated msg types
+	AttributeGates []AttributeGate `json:"attribute_gates" yaml:"attribute_gates"`
+}
+
+var _ paramtypes.ParamSet = &AttributeGateParams{}
+
+// DefaultAttributeGateParams returns the default attribute gate settings which do not gate any msgs.
+func DefaultAttributeGateParams() AttributeGateParams {
+	return AttributeGateParams{AttributeGates: []AttributeGate{}}
+}
+
+// AttributeGateParamKeyTable returns the key table for the attribute gate params subspace.
+func AttributeGateParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&AttributeGateParams{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *AttributeGateParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyAttributeGates, &p.AttributeGates, validateAttributeGates),
+	}
+}
+
+// RequiredAttribute returns the attribute required to submit the msg type, false if the msg type is not gated.
+func (p AttributeGateParams) RequiredAttribute(msgTypeURL string) (string, bool) {
+	for _, gate := range p.AttributeGates {
+		if gate.MsgTypeURL == msgTypeURL {
+			return gate.AttributeName, true
+		}
+	}
+	return "", false
+}
+
+func validateAttributeGates(i interface{}) error {
+	v, ok := i.([]AttributeGate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, gate := range v {
+		if !strings.HasPrefix(gate.MsgTypeURL, "/") || len(strings.TrimSpace(gate.MsgTypeURL)) < 2 {
+			return fmt.Errorf("invalid gated msg type url %q", gate.MsgTypeURL)
+		}
+		if seen[gate.MsgTypeURL] {
+			return fmt.Errorf("duplicate attribute gate for msg type url %q", gate.MsgTypeURL)
+		}
+		seen[gate.MsgTypeURL] = true
+		name := gate.AttributeName
+		if len(name) == 0 || name != strings.ToLower(strings.TrimSpace(name)) || strings.ContainsAny(name, " \t\n") {
+			return fmt.Errorf("invalid attribute name %q for msg type url %q", name, gate.MsgTypeURL)
+		}
+	}
+	return nil
+}
+
+// AttributeGateDecorator is an AnteDecorator that rejects a transaction containing a msg type gated by governance when
+// the fee payer does not hold the attribute required for it, permitting operations such as oracle submissions only to
+// the accounts that have been given the attribute.  The msgs executed through authz are gated the same as the msgs of
+// the transaction.
+type AttributeGateDecorator struct {
+	paramSpace paramtypes.Subspace
+	checker    AttributeChecker
+}
+
+// NewAttributeGateDecorator creates a new AttributeGateDecorator reading its settings from the given params subspace.
+func NewAttributeGateDecorator(paramSpace paramtypes.Subspace, checker AttributeChecker) AttributeGateDecorator {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(AttributeGateParamKeyTable())
+	}
+	return AttributeGateDecorator{paramSpace: paramSpace, checker: checker}
+}
+
+var _ sdk.AnteDecorator = AttributeGateDecorator{}
+
+// GetParams returns the current attribute gate settings, any that have not been set use the default value.
+func (d AttributeGateDecorator) GetParams(ctx sdk.Context) AttributeGateParams {
+	params := DefaultAttributeGateParams()
+	d.paramSpace.GetIfExists(ctx, ParamStoreKeyAttributeGates, &params.AttributeGates)
+	return params
+}
+
+// SetParams sets the attribute gate settings.
+func (d AttributeGateDecorator) SetParams(ctx sdk.Context, params AttributeGateParams) {
+	d.paramSpace.SetParamSet(ctx, &params)
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d AttributeGateDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	params := d.GetParams(ctx)
+	if len(params.AttributeGates) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	if err = d.checkMsgs(ctx, params, tx.GetMsgs(), feeTx.FeePayer()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks that the fee payer holds the attribute required by each of the gated msgs.
+func (d AttributeGateDecorator) checkMsgs(ctx sdk.Context, params AttributeGateParams, msgs []sdk.Msg, payer sdk.AccAddress) error {
+	for _, msg := range msgs {
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err = d.checkMsgs(ctx, params, execMsgs, payer); err != nil {
+				return err
+			}
+			continue
+		}
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if name, gated := params.RequiredAttribute(msgTypeURL); gated && !d.checker.AccountHasAttribute(ctx, payer, name) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+				"fee payer %s must hold the %s attribute to submit a %s msg", payer, name, msgTypeURL)
+		}
+	}
+	return nil
+}
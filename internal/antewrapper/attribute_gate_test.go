@@ -0,0 +1,79 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// attributeHolders is an AttributeChecker of the attribute names held by each account.
+type attributeHolders map[string][]string
+
+func (h attributeHolders) AccountHasAttribute(_ sdk.Context, acc sdk.AccAddress, name string) bool {
+	for _, held := range h[acc.String()] {
+		if held == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAttributeGateDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	validator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holders := attributeHolders{validator.String(): {"validator.provenance.io"}}
+	decorator := antewrapper.NewAttributeGateDecorator(pioApp.GetSubspace(antewrapper.AttributeGateParamSpace), holders)
+	require.Equal(t, antewrapper.DefaultAttributeGateParams(), decorator.GetParams(ctx))
+
+	addAttr := attributetypes.NewMsgAddAttributeRequest(other, other, "example.pb", attributetypes.AttributeType_String, []byte("value"))
+	send := banktypes.NewMsgSend(other, other, sdk.NewCoins())
+	exec := authz.NewMsgExec(other, []sdk.Msg{addAttr})
+
+	accepted := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	run := func(payer sdk.AccAddress, msgs ...sdk.Msg) error {
+		_, err := decorator.AnteHandle(ctx, feeTx{testTx: testTx{msgs}, payer: payer}, false, accepted)
+		return err
+	}
+
+	// not gated with the default params
+	require.NoError(t, run(other, addAttr))
+
+	decorator.SetParams(ctx, antewrapper.AttributeGateParams{AttributeGates: []antewrapper.AttributeGate{
+		{MsgTypeURL: sdk.MsgTypeURL(addAttr), AttributeName: "validator.provenance.io"},
+	}})
+
+	require.NoError(t, run(validator, addAttr))
+	require.NoError(t, run(other, send), "msg type without a gate")
+	err := run(other, send, addAttr)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.EqualError(t, err, "fee payer "+other.String()+" must hold the validator.provenance.io attribute to submit a "+
+		"/provenance.attribute.v1.MsgAddAttributeRequest msg: unauthorized")
+	require.ErrorIs(t, run(other, &exec), sdkerrors.ErrUnauthorized, "msg executed through authz")
+	require.NoError(t, run(validator, &exec), "fee payer holds the attribute for the msg executed through authz")
+
+	require.Panics(t, func() {
+		decorator.SetParams(ctx, antewrapper.AttributeGateParams{AttributeGates: []antewrapper.AttributeGate{
+			{MsgTypeURL: sdk.MsgTypeURL(addAttr), AttributeName: "Validator.provenance.io"},
+		}})
+	}, "attribute name must be normalized")
+	require.Panics(t, func() {
+		decorator.SetParams(ctx, antewrapper.AttributeGateParams{AttributeGates: []antewrapper.AttributeGate{
+			{MsgTypeURL: sdk.MsgTypeURL(addAttr), AttributeName: "a"},
+			{MsgTypeURL: sdk.MsgTypeURL(addAttr), AttributeName: "b"},
+		}})
+	}, "duplicate msg type")
+}
@@ -0,0 +1,112 @@
+package antewrapper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// markerMsgTypeURLPrefix is the start of the type url of every marker msg.
+const markerMsgTypeURLPrefix = "/provenance.marker.v1."
+
+// FeegrantKeeper defines the fee grant functionality needed to charge fees to the granter of an authz grant.
+type FeegrantKeeper interface {
+	ante.FeegrantKeeper
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// AuthzFeePayerDecorator deducts the fees of a tx the same as the DeductFeeDecorator, except that a tx of marker msgs
+// executed through authz on behalf of a single granter has its fees charged to that granter when the granter has also
+// given the grantee a fee allowance.  The fee granter does not need to be set on the tx in that case.
+type AuthzFeePayerDecorator struct {
+	ak         ante.AccountKeeper
+	bankKeeper authtypes.BankKeeper
+	fk         FeegrantKeeper
+
+	deductFee ante.DeductFeeDecorator
+}
+
+// NewAuthzFeePayerDecorator creates a new AuthzFeePayerDecorator
+func NewAuthzFeePayerDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk FeegrantKeeper) AuthzFeePayerDecorator {
+	return AuthzFeePayerDecorator{
+		ak:         ak,
+		bankKeeper: bk,
+		fk:         fk,
+		deductFee:  ante.NewDeductFeeDecorator(ak, bk, fk),
+	}
+}
+
+var _ sdk.AnteDecorator = AuthzFeePayerDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d AuthzFeePayerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() != nil {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	granter := d.AuthzFeeGranter(ctx, feeTx)
+	if granter == nil {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feePayer := feeTx.FeePayer()
+	fee := feeTx.GetFee()
+	if err = d.fk.UseGrantedFees(ctx, granter, feePayer, fee, tx.GetMsgs()); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", granter, feePayer)
+	}
+	granterAcc := d.ak.GetAccount(ctx, granter)
+	if granterAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", granter)
+	}
+	if !fee.IsZero() {
+		if err = ante.DeductFees(d.bankKeeper, ctx, granterAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// AuthzFeeGranter returns the account that should pay the fees of a tx that only executes marker msgs through authz.
+// This is the granter of those msgs when they all have the same granter and it has given the fee payer of the tx (the
+// grantee) a fee allowance, otherwise nil.
+func (d AuthzFeePayerDecorator) AuthzFeeGranter(ctx sdk.Context, feeTx sdk.FeeTx) sdk.AccAddress {
+	feePayer := feeTx.FeePayer()
+	msgs := feeTx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil
+	}
+	var granter sdk.AccAddress
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok || exec.Grantee != feePayer.String() {
+			return nil
+		}
+		execMsgs, err := exec.GetMessages()
+		if err != nil || len(execMsgs) == 0 {
+			return nil
+		}
+		for _, execMsg := range execMsgs {
+			if !strings.HasPrefix(sdk.MsgTypeURL(execMsg), markerMsgTypeURLPrefix) {
+				return nil
+			}
+			signers := execMsg.GetSigners()
+			if len(signers) != 1 || (granter != nil && !granter.Equals(signers[0])) {
+				return nil
+			}
+			granter = signers[0]
+		}
+	}
+	if granter.Equals(feePayer) {
+		return nil
+	}
+	if allowance, err := d.fk.GetAllowance(ctx, granter, feePayer); err != nil || allowance == nil {
+		return nil
+	}
+	return granter
+}
@@ -0,0 +1,99 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAuthzFeePayerDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := app.MakeEncodingConfig().TxConfig
+
+	decorator := antewrapper.NewAuthzFeePayerDecorator(pioApp.AccountKeeper, pioApp.BankKeeper, pioApp.FeeGrantKeeper)
+
+	newAccount := func() sdk.AccAddress {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		pioApp.AccountKeeper.SetAccount(ctx, pioApp.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		require.NoError(t, app.FundAccount(pioApp, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+		return addr
+	}
+	admin := newAccount()
+	grantee := newAccount()
+	other := newAccount()
+	unlinked := newAccount()
+	require.NoError(t, pioApp.FeeGrantKeeper.GrantAllowance(ctx, admin, grantee, &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 250))}))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	newTx := func(feeGranter sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(fee)
+		builder.SetGasLimit(100000)
+		builder.SetFeeGranter(feeGranter)
+		return builder.GetTx()
+	}
+	exec := func(grantee sdk.AccAddress, msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+	transfer := func(admin sdk.AccAddress) sdk.Msg {
+		return markertypes.NewMsgTransferRequest(admin, admin, other, sdk.NewInt64Coin("restricted", 1))
+	}
+	withdraw := func(admin sdk.AccAddress) sdk.Msg {
+		return markertypes.NewMsgWithdrawRequest(admin, other, "restricted", sdk.NewCoins(sdk.NewInt64Coin("restricted", 1)))
+	}
+	send := banktypes.NewMsgSend(admin, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	cases := []struct {
+		name   string
+		tx     sdk.Tx
+		payer  sdk.AccAddress
+		errMsg string
+	}{
+		{"marker transfer via exec is paid by the granter", newTx(nil, exec(grantee, transfer(admin))), admin, ""},
+		{"multiple marker msgs from the same granter", newTx(nil, exec(grantee, transfer(admin), withdraw(admin)), exec(grantee, transfer(admin))), admin, ""},
+		{"granter allowance exceeded", newTx(nil, exec(grantee, transfer(admin))), nil, "fee limit exceeded"},
+		{"marker transfer without exec is paid by the signer", newTx(nil, transfer(grantee)), grantee, ""},
+		{"exec of a msg that is not a marker msg", newTx(nil, exec(grantee, send)), grantee, ""},
+		{"exec of marker msgs from different granters", newTx(nil, exec(grantee, transfer(admin), transfer(other))), grantee, ""},
+		{"exec mixed with other msgs", newTx(nil, exec(grantee, transfer(admin)), transfer(grantee)), grantee, ""},
+		{"granter has not given a fee allowance", newTx(nil, exec(unlinked, transfer(admin))), unlinked, ""},
+		{"explicit fee granter is used", newTx(unlinked, exec(grantee, transfer(admin))), nil, "fee-grant not found"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := map[string]sdk.Int{}
+			for _, addr := range []sdk.AccAddress{admin, grantee, other, unlinked} {
+				before[addr.String()] = pioApp.BankKeeper.GetBalance(ctx, addr, "stake").Amount
+			}
+			_, err := decorator.AnteHandle(ctx, tc.tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil })
+			if len(tc.errMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			for _, addr := range []sdk.AccAddress{admin, grantee, other, unlinked} {
+				expected := before[addr.String()]
+				if addr.Equals(tc.payer) {
+					expected = expected.Sub(fee.AmountOf("stake"))
+				}
+				require.Equal(t, expected, pioApp.BankKeeper.GetBalance(ctx, addr, "stake").Amount, "balance of %s", addr)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package antewrapper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DepositChecker defines the marker functionality needed to restrict the addresses allowed to send coins to a marker.
+type DepositChecker interface {
+	CheckDeposit(ctx sdk.Context, from, to sdk.AccAddress) error
+}
+
+// MarkerDepositDecorator is an AnteDecorator that rejects a tx with a bank send to a marker account that restricts
+// deposits when the sender does not hold the deposit access on the marker.  Bank sends executed through authz are
+// checked against the granter they are sent from.  The deposit is enforced when the coins are sent, this only rejects
+// the tx early in CheckTx so it is not included in a block.
+type MarkerDepositDecorator struct {
+	checker DepositChecker
+}
+
+// NewMarkerDepositDecorator creates a new MarkerDepositDecorator
+func NewMarkerDepositDecorator(checker DepositChecker) MarkerDepositDecorator {
+	return MarkerDepositDecorator{checker: checker}
+}
+
+var _ sdk.AnteDecorator = MarkerDepositDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d MarkerDepositDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	if err = d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the deposits made by each of the bank sends in the msgs.
+func (d MarkerDepositDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *banktypes.MsgSend:
+			if err := d.checkSend(ctx, []string{m.FromAddress}, []string{m.ToAddress}); err != nil {
+				return err
+			}
+		case *banktypes.MsgMultiSend:
+			from := make([]string, len(m.Inputs))
+			for i, input := range m.Inputs {
+				from[i] = input.Address
+			}
+			to := make([]string, len(m.Outputs))
+			for i, output := range m.Outputs {
+				to[i] = output.Address
+			}
+			if err := d.checkSend(ctx, from, to); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			execMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err = d.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkSend checks that every sender may deposit into every recipient.  Invalid addresses are left to the
+// validation of the msg.
+func (d MarkerDepositDecorator) checkSend(ctx sdk.Context, from []string, to []string) error {
+	for _, toAddr := range to {
+		recipient, err := sdk.AccAddressFromBech32(toAddr)
+		if err != nil {
+			continue
+		}
+		for _, fromAddr := range from {
+			sender, err := sdk.AccAddressFromBech32(fromAddr)
+			if err != nil {
+				continue
+			}
+			if err = d.checker.CheckDeposit(ctx, sender, recipient); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMarkerDepositDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(true, tmproto.Header{})
+
+	depositor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	marker := markertypes.NewEmptyMarkerAccount("depositcoin", depositor.String(), []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(depositor, []markertypes.Access{markertypes.Access_Deposit}),
+	})
+	pioApp.MarkerKeeper.SetMarker(ctx, marker)
+
+	decorator := antewrapper.NewMarkerDepositDecorator(pioApp.MarkerKeeper)
+	accepted := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	run := func(msgs ...sdk.Msg) error {
+		_, err := decorator.AnteHandle(ctx, testTx{msgs}, false, accepted)
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	sendToMarker := func(from sdk.AccAddress) sdk.Msg { return banktypes.NewMsgSend(from, marker.GetAddress(), coins) }
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(other, coins.Add(coins...))},
+		[]banktypes.Output{banktypes.NewOutput(depositor, coins), banktypes.NewOutput(marker.GetAddress(), coins)},
+	)
+	exec := authz.NewMsgExec(depositor, []sdk.Msg{sendToMarker(other)})
+
+	// deposits are not restricted until the marker opts in
+	require.NoError(t, run(sendToMarker(other)))
+	require.NoError(t, run(multiSend))
+
+	marker.SetRestrictDeposits(true)
+	pioApp.MarkerKeeper.SetMarker(ctx, marker)
+
+	require.NoError(t, run(sendToMarker(depositor)), "sender with deposit access")
+	require.NoError(t, run(banktypes.NewMsgSend(other, depositor, coins)), "send to an account that is not a marker")
+	require.ErrorIs(t, run(sendToMarker(other)), markertypes.ErrNoDepositGrant)
+	require.ErrorIs(t, run(sendToMarker(depositor), sendToMarker(other)), markertypes.ErrNoDepositGrant, "any msg of the tx")
+	require.ErrorIs(t, run(multiSend), markertypes.ErrNoDepositGrant)
+	require.ErrorIs(t, run(&exec), markertypes.ErrNoDepositGrant, "send executed through authz")
+
+	// the deposit is checked again when the coins are sent
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(false), testTx{[]sdk.Msg{sendToMarker(other)}}, false, accepted)
+	require.NoError(t, err, "not checked in DeliverTx")
+}
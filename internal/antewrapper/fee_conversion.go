@@ -0,0 +1,73 @@
+package antewrapper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeConverter defines the marker functionality needed to accept the coin of a marker for the fees of a tx.
+type FeeConverter interface {
+	ConvertedFee(ctx sdk.Context, fee sdk.Coins, feeDenom string) (sdk.Coins, bool)
+	ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, feeDenom string) (sdk.Coins, error)
+}
+
+// FeeConversionDecorator wraps a fee decorator so the fees of a tx can be given in the coin of a marker that governance
+// has enabled a fee conversion for.  The wrapped decorator sees a tx whose fee is the amount of the fee denom those
+// coins are worth, the rest of the ante handler sees the tx as it is.  When swapping, the fee payer's marker coins are
+// first exchanged for the fee denom held by the conversion pool so that the wrapped decorator can deduct them.
+type FeeConversionDecorator struct {
+	converter FeeConverter
+	feeDenom  string
+	inner     sdk.AnteDecorator
+	swap      bool
+}
+
+// NewFeeConversionCheckDecorator creates a new FeeConversionDecorator that only converts the fee seen by the wrapped
+// decorator, e.g. for checking the fee against the minimum gas prices.
+func NewFeeConversionCheckDecorator(converter FeeConverter, feeDenom string, inner sdk.AnteDecorator) FeeConversionDecorator {
+	return FeeConversionDecorator{converter: converter, feeDenom: feeDenom, inner: inner}
+}
+
+// NewFeeConversionDeductDecorator creates a new FeeConversionDecorator that swaps the marker coins of the fee for the
+// fee denom before the wrapped decorator deducts the fee.
+func NewFeeConversionDeductDecorator(converter FeeConverter, feeDenom string, inner sdk.AnteDecorator) FeeConversionDecorator {
+	return FeeConversionDecorator{converter: converter, feeDenom: feeDenom, inner: inner, swap: true}
+}
+
+var _ sdk.AnteDecorator = FeeConversionDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d FeeConversionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+	fee, converted := d.converter.ConvertedFee(ctx, feeTx.GetFee(), d.feeDenom)
+	if !converted {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+	if d.swap {
+		// a fee granter's allowance is in the fee denom, it cannot be spent on a swap of the fee payer's coins.
+		if feeTx.FeeGranter() != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fees paid in a marker's coin cannot be paid by a fee granter")
+		}
+		if fee, err = d.converter.ConvertFee(ctx, feeTx.FeePayer(), feeTx.GetFee(), d.feeDenom); err != nil {
+			return ctx, err
+		}
+	}
+	return d.inner.AnteHandle(ctx, convertedFeeTx{FeeTx: feeTx, fee: fee}, simulate,
+		func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+			return next(ctx, tx, simulate)
+		})
+}
+
+// convertedFeeTx is a tx with its fee replaced by the converted fee.
+type convertedFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+// GetFee returns the converted fee of the tx.
+func (tx convertedFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
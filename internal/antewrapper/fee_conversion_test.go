@@ -0,0 +1,101 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// feeTx is a testTx with a fee.
+type feeTx struct {
+	testTx
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx feeTx) GetGas() uint64             { return 100000 }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func TestFeeConversionDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	pool := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	marker := markertypes.NewEmptyMarkerAccount("gascoin", admin.String(), []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(admin, []markertypes.Access{markertypes.Access_Mint, markertypes.Access_Admin, markertypes.Access_Withdraw}),
+	})
+	marker.MarkerType = markertypes.MarkerType_RestrictedCoin
+	marker.AllowGovernanceControl = true
+	require.NoError(t, marker.SetManager(admin))
+	require.NoError(t, marker.SetSupply(sdk.NewInt64Coin("gascoin", 1000)))
+	require.NoError(t, pioApp.MarkerKeeper.AddMarkerAccount(ctx, marker))
+	require.NoError(t, pioApp.MarkerKeeper.FinalizeMarker(ctx, admin, "gascoin"))
+	require.NoError(t, pioApp.MarkerKeeper.ActivateMarker(ctx, admin, "gascoin"))
+	require.NoError(t, pioApp.MarkerKeeper.WithdrawCoins(ctx, admin, payer, "gascoin", sdk.NewCoins(sdk.NewInt64Coin("gascoin", 100))))
+	require.NoError(t, app.FundAccount(pioApp, ctx, pool, sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))))
+	require.NoError(t, markerkeeper.HandleSetFeeConversionProposal(ctx, pioApp.MarkerKeeper,
+		markertypes.NewSetFeeConversionProposal("title", "description", "gascoin", true, pool, sdk.MustNewDecFromStr("2.5"), admin)))
+
+	var seenFee sdk.Coins
+	recordFee := antewrapper.NewFeeConversionCheckDecorator(pioApp.MarkerKeeper, "nhash",
+		feeRecorder{fee: &seenFee})
+	deductFee := antewrapper.NewFeeConversionDeductDecorator(pioApp.MarkerKeeper, "nhash",
+		ante.NewDeductFeeDecorator(pioApp.AccountKeeper, pioApp.BankKeeper, nil))
+	var nextTx sdk.Tx
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextTx = tx
+		return ctx, nil
+	}
+
+	gasTx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("gascoin", 10)), payer: payer}
+	_, err := recordFee.AnteHandle(ctx, gasTx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 25)), seenFee, "the wrapped decorator sees the converted fee")
+	require.Equal(t, gasTx, nextTx, "the rest of the ante handler sees the tx as it is")
+	require.Equal(t, "100gascoin", pioApp.BankKeeper.GetAllBalances(ctx, payer).String(), "nothing is swapped by a check")
+
+	hashTx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)), payer: payer}
+	_, err = recordFee.AnteHandle(ctx, hashTx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, hashTx.fee, seenFee, "fees in the fee denom are not converted")
+
+	_, err = deductFee.AnteHandle(ctx, gasTx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, gasTx, nextTx)
+	require.Equal(t, "90gascoin", pioApp.BankKeeper.GetAllBalances(ctx, payer).String())
+	require.Equal(t, "10gascoin,75nhash", pioApp.BankKeeper.GetAllBalances(ctx, pool).String())
+	feeCollector := pioApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, "25nhash", pioApp.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+
+	_, err = deductFee.AnteHandle(ctx, feeTx{fee: gasTx.fee, payer: payer, granter: admin}, false, next)
+	require.EqualError(t, err, "fees paid in a marker's coin cannot be paid by a fee granter: invalid request")
+	_, err = deductFee.AnteHandle(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("gascoin", 40)), payer: payer}, false, next)
+	require.Error(t, err, "the pool does not hold enough of the fee denom")
+	require.Contains(t, err.Error(), "gascoin fee conversion pool cannot pay 100nhash")
+}
+
+// feeRecorder is an AnteDecorator that records the fee of the tx it sees.
+type feeRecorder struct {
+	fee *sdk.Coins
+}
+
+func (r feeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.fee = tx.(sdk.FeeTx).GetFee()
+	return next(ctx, tx, simulate)
+}
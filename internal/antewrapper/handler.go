@@ -4,9 +4,42 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/provenance-io/provenance/internal/txpriority"
 )
 
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// HandlerOptions are the options required for constructing the provenance AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// RateLimitSubspace is the params subspace of the optional per account rate limit, it is skipped when not set.
+	RateLimitSubspace paramtypes.Subspace
+	// MsgGasLimitSubspace is the params subspace of the optional max gas of txs with specific msgs, it is skipped when
+	// not set.
+	MsgGasLimitSubspace paramtypes.Subspace
+	// AttributeGateSubspace is the params subspace of the optional attributes required of the fee payer of txs with
+	// specific msgs, it is skipped when not set or when there is no AttributeChecker.
+	AttributeGateSubspace paramtypes.Subspace
+	// AttributeChecker checks that the fee payer of a tx with a gated msg holds the attribute required for it.
+	AttributeChecker AttributeChecker
+	// TxPriority is the optional decorator rejecting low priority transactions under load, it is skipped when nil.
+	TxPriority *txpriority.Prioritizer
+	// SimGasPadding is the optional decorator padding the gas of simulated transactions, it is skipped when nil.
+	SimGasPadding *SimGasPaddingDecorator
+	// DepositChecker restricts the bank sends to marker accounts that restrict deposits, it is skipped when nil.
+	DepositChecker DepositChecker
+	// TransferMemoChecker requires the memo of restricted marker transfers to match the marker's pattern, it is skipped
+	// when nil.
+	TransferMemoChecker TransferMemoChecker
+	// FeeConverter lets the fees of a tx be paid in the coin of a marker with a fee conversion, swapped for the
+	// FeeDenom, it is skipped when nil.
+	FeeConverter FeeConverter
+	// FeeDenom is the denom that the fees paid in a marker's coin are converted to.
+	FeeDenom string
+}
+
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -24,22 +57,65 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	var deductFeeDecorator sdk.AnteDecorator = ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)
+	// marker msgs executed through authz can have their fees charged to the granter when fee grants are enabled.
+	if feegrantKeeper, ok := options.FeegrantKeeper.(FeegrantKeeper); ok {
+		deductFeeDecorator = NewAuthzFeePayerDecorator(options.AccountKeeper, options.BankKeeper, feegrantKeeper)
+	}
+
 	decorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewGasTracerContextDecorator(),  // gas meter tracer must follow initial context setup
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+	}
+	// the padding wraps the gas meter of a simulation so the gas it reports includes the gas used by the msgs.
+	if options.SimGasPadding != nil {
+		decorators = append(decorators, options.SimGasPadding)
+	}
+	// low priority transactions are turned away before any signature checks while the mempool is congested.
+	if options.TxPriority != nil {
+		decorators = append(decorators, options.TxPriority)
+	}
+	var mempoolFeeDecorator sdk.AnteDecorator = ante.NewMempoolFeeDecorator()
+	// fees given in a marker's coin are checked against the min gas prices, and deducted, as the fee denom they swap for.
+	if options.FeeConverter != nil {
+		mempoolFeeDecorator = NewFeeConversionCheckDecorator(options.FeeConverter, options.FeeDenom, mempoolFeeDecorator)
+		deductFeeDecorator = NewFeeConversionDeductDecorator(options.FeeConverter, options.FeeDenom, deductFeeDecorator)
+	}
+	decorators = append(decorators,
+		mempoolFeeDecorator,
 		ante.NewValidateBasicDecorator(),
+	)
+	// txs asking for more gas than their msgs are allowed are turned away before any fees are charged.
+	if len(options.MsgGasLimitSubspace.Name()) > 0 {
+		decorators = append(decorators, NewMsgGasLimitDecorator(options.MsgGasLimitSubspace))
+	}
+	// txs with msgs gated by an attribute the fee payer does not hold are also turned away before any fees are charged.
+	if len(options.AttributeGateSubspace.Name()) > 0 && options.AttributeChecker != nil {
+		decorators = append(decorators, NewAttributeGateDecorator(options.AttributeGateSubspace, options.AttributeChecker))
+	}
+	decorators = append(decorators,
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		deductFeeDecorator,
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	)
+	// bank sends to marker accounts are checked once the signatures of their senders are verified.
+	if options.DepositChecker != nil {
+		decorators = append(decorators, NewMarkerDepositDecorator(options.DepositChecker))
+	}
+	if options.TransferMemoChecker != nil {
+		decorators = append(decorators, NewMarkerTransferMemoDecorator(options.TransferMemoChecker))
+	}
+	// rate limits are applied once the signatures are verified so only the actual signer is counted.
+	if len(options.RateLimitSubspace.Name()) > 0 {
+		decorators = append(decorators, NewRateLimitDecorator(options.RateLimitSubspace))
 	}
+	decorators = append(decorators, ante.NewIncrementSequenceDecorator(options.AccountKeeper))
 
 	return sdk.ChainAnteDecorators(decorators...), nil
 }
@@ -0,0 +1,155 @@
+package antewrapper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MsgGasLimitParamSpace is the name of the params subspace holding the max gas of the transactions with specific msgs.
+const MsgGasLimitParamSpace = "msggaslimit"
+
+// ParamStoreKeyMsgGasLimits is the list of msg type urls with the max gas of a transaction containing them.
+var ParamStoreKeyMsgGasLimits = []byte("MsgGasLimits")
+
+// MsgGasLimit is the max gas of a transaction containing a msg of the given type.
+type MsgGasLimit struct {
+	// msg type url, e.g. /provenance.attribute.v1.MsgAddAttributeRequest
+	MsgTypeURL string `json:"msg_type_url" yaml:"msg_type_url"`
+	// the most gas a transaction containing the msg type may have
+	MaxGas uint64 `json:"max_gas" yaml:"max_gas"`
+}
+
+// MsgGasLimitParams defines the governance controlled max gas of the transactions with specific msg types.
+type MsgGasLimitParams struct {
+	// max gas of the transactions containing each of the limited msg types
+	MsgGasLimits []MsgGasLimit `json:"msg_gas_limits" yaml:"msg_gas_limits"`
+}
+
+var _ paramtypes.ParamSet = &MsgGasLimitParams{}
+
+// DefaultMsgGasLimitParams returns the default msg gas limit settings which do not limit any transactions.
+func DefaultMsgGasLimitParams() MsgGasLimitParams {
+	return MsgGasLimitParams{MsgGasLimits: []MsgGasLimit{}}
+}
+
+// MsgGasLimitParamKeyTable returns the key table for the msg gas limit params subspace.
+func MsgGasLimitParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&MsgGasLimitParams{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *MsgGasLimitParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMsgGasLimits, &p.MsgGasLimits, validateMsgGasLimits),
+	}
+}
+
+// MaxGas returns the max gas of a transaction containing the msg type, false if the msg type is not limited.
+func (p MsgGasLimitParams) MaxGas(msgTypeURL string) (uint64, bool) {
+	for _, limit := range p.MsgGasLimits {
+		if limit.MsgTypeURL == msgTypeURL {
+			return limit.MaxGas, true
+		}
+	}
+	return 0, false
+}
+
+func validateMsgGasLimits(i interface{}) error {
+	v, ok := i.([]MsgGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, limit := range v {
+		if !strings.HasPrefix(limit.MsgTypeURL, "/") || len(strings.TrimSpace(limit.MsgTypeURL)) < 2 {
+			return fmt.Errorf("invalid limited msg type url %q", limit.MsgTypeURL)
+		}
+		if seen[limit.MsgTypeURL] {
+			return fmt.Errorf("duplicate max gas for msg type url %q", limit.MsgTypeURL)
+		}
+		seen[limit.MsgTypeURL] = true
+		if limit.MaxGas == 0 {
+			return fmt.Errorf("max gas for msg type url %q must be positive", limit.MsgTypeURL)
+		}
+	}
+	return nil
+}
+
+// gasTx is a transaction with a gas limit.
+type gasTx interface {
+	sdk.Tx
+	GetGas() uint64
+}
+
+// MsgGasLimitDecorator is an AnteDecorator that rejects a transaction whose gas limit is more than the governance
+// defined max gas of one of its msg types, bounding the worst case execution of msgs known to be expensive.  The msgs
+// executed through authz are limited the same as the msgs of the transaction.  Simulations are not limited so that
+// the gas needed can still be estimated.
+type MsgGasLimitDecorator struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewMsgGasLimitDecorator creates a new MsgGasLimitDecorator reading its settings from the given params subspace.
+func NewMsgGasLimitDecorator(paramSpace paramtypes.Subspace) MsgGasLimitDecorator {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(MsgGasLimitParamKeyTable())
+	}
+	return MsgGasLimitDecorator{paramSpace: paramSpace}
+}
+
+var _ sdk.AnteDecorator = MsgGasLimitDecorator{}
+
+// GetParams returns the current msg gas limit settings, any that have not been set use the default value.
+func (d MsgGasLimitDecorator) GetParams(ctx sdk.Context) MsgGasLimitParams {
+	params := DefaultMsgGasLimitParams()
+	d.paramSpace.GetIfExists(ctx, ParamStoreKeyMsgGasLimits, &params.MsgGasLimits)
+	return params
+}
+
+// SetParams sets the msg gas limit settings.
+func (d MsgGasLimitDecorator) SetParams(ctx sdk.Context, params MsgGasLimitParams) {
+	d.paramSpace.SetParamSet(ctx, &params)
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d MsgGasLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	limitedTx, ok := tx.(gasTx)
+	if simulate || !ok {
+		return next(ctx, tx, simulate)
+	}
+	params := d.GetParams(ctx)
+	if len(params.MsgGasLimits) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	if err = checkMsgGasLimits(params, tx.GetMsgs(), limitedTx.GetGas()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgGasLimits checks the gas limit of a transaction against the max gas of each of the msgs.
+func checkMsgGasLimits(params MsgGasLimitParams, msgs []sdk.Msg, gas uint64) error {
+	for _, msg := range msgs {
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err = checkMsgGasLimits(params, execMsgs, gas); err != nil {
+				return err
+			}
+			continue
+		}
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if maxGas, limited := params.MaxGas(msgTypeURL); limited && gas > maxGas {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"tx gas limit %d exceeds the max gas of %d for a tx with a %s msg", gas, maxGas, msgTypeURL)
+		}
+	}
+	return nil
+}
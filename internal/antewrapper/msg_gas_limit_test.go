@@ -0,0 +1,67 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// gasTx is a testTx with a gas limit.
+type gasTx struct {
+	testTx
+	gas uint64
+}
+
+func (tx gasTx) GetGas() uint64 { return tx.gas }
+
+func TestMsgGasLimitDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	decorator := antewrapper.NewMsgGasLimitDecorator(pioApp.GetSubspace(antewrapper.MsgGasLimitParamSpace))
+	require.Equal(t, antewrapper.DefaultMsgGasLimitParams(), decorator.GetParams(ctx))
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addAttr := attributetypes.NewMsgAddAttributeRequest(owner, owner, "example.pb", attributetypes.AttributeType_String, []byte("value"))
+	send := banktypes.NewMsgSend(owner, owner, sdk.NewCoins())
+	exec := authz.NewMsgExec(owner, []sdk.Msg{addAttr})
+
+	accepted := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	run := func(gas uint64, simulate bool, msgs ...sdk.Msg) error {
+		_, err := decorator.AnteHandle(ctx, gasTx{testTx{msgs}, gas}, simulate, accepted)
+		return err
+	}
+
+	// not limited with the default params
+	require.NoError(t, run(10_000_000, false, addAttr))
+
+	decorator.SetParams(ctx, antewrapper.MsgGasLimitParams{MsgGasLimits: []antewrapper.MsgGasLimit{
+		{MsgTypeURL: sdk.MsgTypeURL(addAttr), MaxGas: 500_000},
+	}})
+
+	require.NoError(t, run(500_000, false, addAttr))
+	require.NoError(t, run(10_000_000, false, send), "msg type without a max gas")
+	require.NoError(t, run(10_000_000, true, addAttr), "simulations are not limited")
+	err := run(500_001, false, send, addAttr)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.EqualError(t, err, "tx gas limit 500001 exceeds the max gas of 500000 for a tx with a "+
+		"/provenance.attribute.v1.MsgAddAttributeRequest msg: invalid request")
+	require.ErrorIs(t, run(500_001, false, &exec), sdkerrors.ErrInvalidRequest, "msg executed through authz")
+
+	require.Panics(t, func() {
+		decorator.SetParams(ctx, antewrapper.MsgGasLimitParams{MsgGasLimits: []antewrapper.MsgGasLimit{
+			{MsgTypeURL: sdk.MsgTypeURL(addAttr), MaxGas: 0},
+		}})
+	}, "max gas must be positive")
+}
@@ -0,0 +1,223 @@
+package antewrapper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// RateLimitParamSpace is the name of the params subspace holding the transaction rate limit settings.
+const RateLimitParamSpace = "ratelimit"
+
+var (
+	// ParamStoreKeyMaxTxsPerWindow is the number of limited transactions an account may submit per window (0 disables).
+	ParamStoreKeyMaxTxsPerWindow = []byte("MaxTxsPerWindow")
+	// ParamStoreKeyWindowBlocks is the number of blocks in each rate limit window.
+	ParamStoreKeyWindowBlocks = []byte("WindowBlocks")
+	// ParamStoreKeyLimitedMsgTypes is the list of msg type urls that are counted against the rate limit.
+	ParamStoreKeyLimitedMsgTypes = []byte("LimitedMsgTypes")
+)
+
+// RateLimitParams defines the governance controlled settings of the transaction rate limit.
+type RateLimitParams struct {
+	// number of transactions with a limited msg an account may have accepted by CheckTx in a window, 0 is unlimited
+	MaxTxsPerWindow uint64 `json:"max_txs_per_window" yaml:"max_txs_per_window"`
+	// number of blocks in a window
+	WindowBlocks uint64 `json:"window_blocks" yaml:"window_blocks"`
+	// msg type urls (e.g. /provenance.attribute.v1.MsgAddAttributeRequest) that are counted against the limit
+	LimitedMsgTypes []string `json:"limited_msg_types" yaml:"limited_msg_types"`
+}
+
+var _ paramtypes.ParamSet = &RateLimitParams{}
+
+// DefaultRateLimitParams returns the default rate limit settings which do not limit any transactions.
+func DefaultRateLimitParams() RateLimitParams {
+	return RateLimitParams{
+		MaxTxsPerWindow: 0,
+		WindowBlocks:    1,
+		LimitedMsgTypes: []string{},
+	}
+}
+
+// RateLimitParamKeyTable returns the key table for the rate limit params subspace.
+func RateLimitParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&RateLimitParams{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *RateLimitParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxTxsPerWindow, &p.MaxTxsPerWindow, validateMaxTxsPerWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeyWindowBlocks, &p.WindowBlocks, validateWindowBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyLimitedMsgTypes, &p.LimitedMsgTypes, validateLimitedMsgTypes),
+	}
+}
+
+func validateMaxTxsPerWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateWindowBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("rate limit window must be at least one block")
+	}
+	return nil
+}
+
+func validateLimitedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, t := range v {
+		if !strings.HasPrefix(t, "/") || len(strings.TrimSpace(t)) < 2 {
+			return fmt.Errorf("invalid limited msg type url %q", t)
+		}
+	}
+	return nil
+}
+
+// RateLimitDecorator is an AnteDecorator that rejects transactions from an account during CheckTx once the account
+// has had the governance defined number of transactions with limited msg types accepted within the current window
+// of blocks.  Transactions are never rejected while delivering a block, only while being added to the mempool.
+type RateLimitDecorator struct {
+	paramSpace paramtypes.Subspace
+
+	mtx    sync.Mutex
+	window int64
+	counts map[string]uint64
+}
+
+// NewRateLimitDecorator creates a new RateLimitDecorator reading its settings from the given params subspace.
+func NewRateLimitDecorator(paramSpace paramtypes.Subspace) *RateLimitDecorator {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(RateLimitParamKeyTable())
+	}
+	return &RateLimitDecorator{
+		paramSpace: paramSpace,
+		counts:     make(map[string]uint64),
+	}
+}
+
+var _ sdk.AnteDecorator = &RateLimitDecorator{}
+
+// GetParams returns the current rate limit settings, any that have not been set use the default value.
+func (d *RateLimitDecorator) GetParams(ctx sdk.Context) RateLimitParams {
+	params := DefaultRateLimitParams()
+	d.paramSpace.GetIfExists(ctx, ParamStoreKeyMaxTxsPerWindow, &params.MaxTxsPerWindow)
+	d.paramSpace.GetIfExists(ctx, ParamStoreKeyWindowBlocks, &params.WindowBlocks)
+	d.paramSpace.GetIfExists(ctx, ParamStoreKeyLimitedMsgTypes, &params.LimitedMsgTypes)
+	return params
+}
+
+// SetParams sets the rate limit settings.
+func (d *RateLimitDecorator) SetParams(ctx sdk.Context, params RateLimitParams) {
+	d.paramSpace.SetParamSet(ctx, &params)
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d *RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Transactions already in the mempool are rechecked after each block and must not be counted twice.
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.GetParams(ctx)
+	if params.MaxTxsPerWindow == 0 || len(params.LimitedMsgTypes) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	accounts, err := limitedSigners(tx, params.LimitedMsgTypes)
+	if err != nil {
+		return ctx, err
+	}
+	if len(accounts) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if window := ctx.BlockHeight() / int64(params.WindowBlocks); window != d.window {
+		d.window = window
+		d.counts = make(map[string]uint64)
+	}
+	for _, acc := range accounts {
+		if d.counts[acc] >= params.MaxTxsPerWindow {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"account %s has reached the limit of %d transactions per %d blocks", acc, params.MaxTxsPerWindow, params.WindowBlocks)
+		}
+	}
+
+	// Only transactions accepted by the rest of the ante handler count against the limit.
+	if newCtx, err = next(ctx, tx, simulate); err != nil {
+		return newCtx, err
+	}
+	for _, acc := range accounts {
+		d.counts[acc]++
+	}
+	return newCtx, nil
+}
+
+// limitedSigners returns the distinct signers of the msgs in the tx with one of the limited msg types.  A limited msg
+// executed through an authz MsgExec counts against both its own signers and the grantee executing it.
+func limitedSigners(tx sdk.Tx, limitedMsgTypes []string) ([]string, error) {
+	var signers []string
+	seen := make(map[string]bool)
+	add := func(msg sdk.Msg) {
+		for _, signer := range msg.GetSigners() {
+			if addr := signer.String(); !seen[addr] {
+				seen[addr] = true
+				signers = append(signers, addr)
+			}
+		}
+	}
+	// addMsgs adds the signers of the limited msgs, returning whether there were any.
+	var addMsgs func(msgs []sdk.Msg) (bool, error)
+	addMsgs = func(msgs []sdk.Msg) (bool, error) {
+		var limited bool
+		for _, msg := range msgs {
+			isLimited := containsString(limitedMsgTypes, sdk.MsgTypeURL(msg))
+			if exec, ok := msg.(*authz.MsgExec); ok {
+				execMsgs, err := exec.GetMessages()
+				if err != nil {
+					return false, err
+				}
+				execLimited, err := addMsgs(execMsgs)
+				if err != nil {
+					return false, err
+				}
+				isLimited = isLimited || execLimited
+			}
+			if isLimited {
+				limited = true
+				add(msg)
+			}
+		}
+		return limited, nil
+	}
+	if _, err := addMsgs(tx.GetMsgs()); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func TestRateLimitDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(true, tmproto.Header{}).WithBlockHeight(10)
+
+	decorator := antewrapper.NewRateLimitDecorator(pioApp.GetSubspace(antewrapper.RateLimitParamSpace))
+	require.Equal(t, antewrapper.DefaultRateLimitParams(), decorator.GetParams(ctx))
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	attrTx := func(owner sdk.AccAddress) sdk.Tx {
+		return testTx{[]sdk.Msg{attributetypes.NewMsgAddAttributeRequest(owner, owner, "example.pb", attributetypes.AttributeType_String, []byte("value"))}}
+	}
+	sendTx := testTx{[]sdk.Msg{banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins())}}
+
+	accepted := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	run := func(ctx sdk.Context, tx sdk.Tx) error {
+		_, err := decorator.AnteHandle(ctx, tx, false, accepted)
+		return err
+	}
+
+	// not limited with the default params
+	for i := 0; i < 5; i++ {
+		require.NoError(t, run(ctx, attrTx(addr1)))
+	}
+
+	decorator.SetParams(ctx, antewrapper.RateLimitParams{
+		MaxTxsPerWindow: 2,
+		WindowBlocks:    5,
+		LimitedMsgTypes: []string{sdk.MsgTypeURL(&attributetypes.MsgAddAttributeRequest{})},
+	})
+
+	require.NoError(t, run(ctx, attrTx(addr1)))
+	require.NoError(t, run(ctx, attrTx(addr1)))
+	require.Error(t, run(ctx, attrTx(addr1)), "third limited tx in the window")
+	require.NoError(t, run(ctx, attrTx(addr2)), "limit is per account")
+	require.NoError(t, run(ctx, sendTx), "msg types that are not limited are not counted")
+
+	// rechecks, simulations, and block delivery are never limited
+	require.NoError(t, run(ctx.WithIsReCheckTx(true), attrTx(addr1)))
+	_, err := decorator.AnteHandle(ctx, attrTx(addr1), true, accepted)
+	require.NoError(t, err)
+	require.NoError(t, run(ctx.WithIsCheckTx(false), attrTx(addr1)))
+
+	// still in the same window
+	require.Error(t, run(ctx.WithBlockHeight(14), attrTx(addr1)))
+	// a new window resets the counts
+	require.NoError(t, run(ctx.WithBlockHeight(15), attrTx(addr1)))
+
+	// transactions rejected later in the ante handler are not counted
+	rejected := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, sdkerrors.ErrUnauthorized
+	}
+	ctx = ctx.WithBlockHeight(20)
+	for i := 0; i < 3; i++ {
+		_, err = decorator.AnteHandle(ctx, attrTx(addr2), false, rejected)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+	require.NoError(t, run(ctx, attrTx(addr2)))
+
+	// limited msgs executed through authz count against the granter and the grantee
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	execTx := func(grantee sdk.AccAddress, tx sdk.Tx) sdk.Tx {
+		exec := authz.NewMsgExec(grantee, tx.GetMsgs())
+		return testTx{[]sdk.Msg{&exec}}
+	}
+	ctx = ctx.WithBlockHeight(25)
+	require.NoError(t, run(ctx, execTx(addr3, attrTx(addr1))))
+	require.NoError(t, run(ctx, execTx(addr3, attrTx(addr1))))
+	require.Error(t, run(ctx, attrTx(addr1)), "granter limited by executed msgs")
+	require.Error(t, run(ctx, execTx(addr3, attrTx(addr2))), "grantee limited by executed msgs")
+	require.NoError(t, run(ctx, execTx(addr2, sendTx)), "executed msg types that are not limited are not counted")
+}
+
+func TestRateLimitParamsValidation(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(true, tmproto.Header{})
+	subspace := pioApp.GetSubspace(antewrapper.RateLimitParamSpace)
+
+	require.NoError(t, subspace.Update(ctx, antewrapper.ParamStoreKeyMaxTxsPerWindow, []byte(`"3"`)))
+	require.Error(t, subspace.Update(ctx, antewrapper.ParamStoreKeyWindowBlocks, []byte(`"0"`)))
+	require.NoError(t, subspace.Update(ctx, antewrapper.ParamStoreKeyLimitedMsgTypes, []byte(`["/provenance.name.v1.MsgBindNameRequest"]`)))
+	require.Error(t, subspace.Update(ctx, antewrapper.ParamStoreKeyLimitedMsgTypes, []byte(`["MsgBindNameRequest"]`)))
+}
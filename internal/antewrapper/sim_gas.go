@@ -0,0 +1,112 @@
+package antewrapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdkgas "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlagSimGasPadding is the app.toml setting with the "msg type url=percent" entries that pad the gas estimated by
+// simulating a tx with those msgs.  An empty list turns the padding off.
+const FlagSimGasPadding = "sim-gas-padding.msg-type-percents"
+
+// DefaultSimGasPadding are the simulated gas paddings used when none are configured.  The gas used by metadata writes
+// grows with the index entries of the state they replace and the gas used by marker transfers depends on the send
+// restrictions of the coin, so the gas actually used by these msgs once in a block often exceeds an estimate made
+// against a slightly different state.
+var DefaultSimGasPadding = []string{
+	"/provenance.metadata.v1.MsgWriteScopeRequest=25",
+	"/provenance.metadata.v1.MsgWriteSessionRequest=25",
+	"/provenance.metadata.v1.MsgWriteRecordRequest=25",
+	"/provenance.metadata.v1.MsgWriteSessionAndRecordsRequest=25",
+	"/provenance.metadata.v1.MsgWriteScopeSpecificationRequest=25",
+	"/provenance.metadata.v1.MsgWriteContractSpecificationRequest=25",
+	"/provenance.metadata.v1.MsgWriteRecordSpecificationRequest=25",
+	"/provenance.metadata.v1.MsgWriteSpecificationBundleRequest=25",
+	"/provenance.marker.v1.MsgTransferRequest=15",
+	"/provenance.marker.v1.MsgScheduleTransferRequest=15",
+}
+
+// SimGasPaddingDecorator is an AnteDecorator that pads the gas used by a simulated tx with msgs whose gas varies
+// between the simulation and the block, so a `--gas auto` estimate leaves room for that variance.  The gas reported
+// by the simulation is increased by the largest padding percent of the msgs of the tx.  Txs that are not simulated
+// are never changed.
+type SimGasPaddingDecorator struct {
+	percents map[string]uint64
+}
+
+var _ sdk.AnteDecorator = &SimGasPaddingDecorator{}
+
+// NewSimGasPaddingDecorator returns a new SimGasPaddingDecorator with the paddings of the app options, or nil when
+// the padding has been turned off.
+func NewSimGasPaddingDecorator(appOpts servertypes.AppOptions) (*SimGasPaddingDecorator, error) {
+	value := appOpts.Get(FlagSimGasPadding)
+	entries := cast.ToStringSlice(value)
+	if value == nil {
+		entries = DefaultSimGasPadding
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	percents := make(map[string]uint64, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+			return nil, fmt.Errorf("invalid %s entry %q, expected msg type url=percent", FlagSimGasPadding, entry)
+		}
+		p, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil || p == 0 {
+			return nil, fmt.Errorf("invalid %s entry %q, percent must be a positive integer", FlagSimGasPadding, entry)
+		}
+		percents[strings.TrimSpace(parts[0])] = p
+	}
+	return &SimGasPaddingDecorator{percents: percents}, nil
+}
+
+// Padding returns the percent the simulated gas of the tx is padded by, the largest padding of its msgs.
+func (d *SimGasPaddingDecorator) Padding(tx sdk.Tx) uint64 {
+	var padding uint64
+	for _, msg := range tx.GetMsgs() {
+		if p := d.percents[sdk.MsgTypeURL(msg)]; p > padding {
+			padding = p
+		}
+	}
+	return padding
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d *SimGasPaddingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !simulate {
+		return next(ctx, tx, simulate)
+	}
+	padding := d.Padding(tx)
+	if padding == 0 {
+		return next(ctx, tx, simulate)
+	}
+	return next(ctx.WithGasMeter(&paddedGasMeter{GasMeter: ctx.GasMeter(), percent: padding}), tx, simulate)
+}
+
+// paddedGasMeter reports the gas consumed on the wrapped gas meter increased by a percent of it.
+type paddedGasMeter struct {
+	sdkgas.GasMeter
+	percent uint64
+}
+
+var _ sdkgas.GasMeter = &paddedGasMeter{}
+
+// GasConsumed returns the gas consumed on the wrapped meter plus the padding.
+func (g *paddedGasMeter) GasConsumed() sdkgas.Gas {
+	consumed := g.GasMeter.GasConsumed()
+	return consumed + consumed*g.percent/100
+}
+
+// String implements stringer interface
+func (g *paddedGasMeter) String() string {
+	return fmt.Sprintf("PaddedGasMeter:\n  padding: %d%%\n  %s", g.percent, g.GasMeter.String())
+}
@@ -0,0 +1,81 @@
+package antewrapper
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func newSimTx(msgs ...sdk.Msg) sdk.Tx {
+	return legacytx.NewStdTx(msgs, legacytx.NewStdFee(0, nil), nil, "")
+}
+
+func TestNewSimGasPaddingDecorator(t *testing.T) {
+	d, err := NewSimGasPaddingDecorator(viper.New())
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	require.Len(t, d.percents, len(DefaultSimGasPadding))
+
+	v := viper.New()
+	v.Set(FlagSimGasPadding, []string{})
+	d, err = NewSimGasPaddingDecorator(v)
+	require.NoError(t, err)
+	require.Nil(t, d, "padding turned off")
+
+	v.Set(FlagSimGasPadding, []string{"/cosmos.bank.v1beta1.MsgSend = 10"})
+	d, err = NewSimGasPaddingDecorator(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"/cosmos.bank.v1beta1.MsgSend": 10}, d.percents)
+
+	for _, entries := range [][]string{{"/cosmos.bank.v1beta1.MsgSend"}, {"=10"}, {"/cosmos.bank.v1beta1.MsgSend=0"}, {"/cosmos.bank.v1beta1.MsgSend=-5"}} {
+		v.Set(FlagSimGasPadding, entries)
+		_, err = NewSimGasPaddingDecorator(v)
+		require.Error(t, err, "entries %v", entries)
+	}
+}
+
+func TestSimGasPaddingAnteHandle(t *testing.T) {
+	d, err := NewSimGasPaddingDecorator(viper.New())
+	require.NoError(t, err)
+
+	send := &banktypes.MsgSend{}
+	transfer := &markertypes.MsgTransferRequest{}
+	writeScope := &metadatatypes.MsgWriteScopeRequest{}
+	require.Equal(t, uint64(0), d.Padding(newSimTx(send)))
+	require.Equal(t, uint64(15), d.Padding(newSimTx(send, transfer)))
+	require.Equal(t, uint64(25), d.Padding(newSimTx(transfer, writeScope)))
+
+	// consumeGas stands in for the msgs run with the context returned by the ante handler.
+	consumeGas := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx.GasMeter().ConsumeGas(1000, "msgs")
+		return ctx, nil
+	}
+	tests := []struct {
+		name     string
+		tx       sdk.Tx
+		simulate bool
+		gas      uint64
+	}{
+		{"simulated metadata write", newSimTx(writeScope), true, 1250},
+		{"simulated marker transfer", newSimTx(transfer), true, 1150},
+		{"simulated send", newSimTx(send), true, 1000},
+		{"delivered metadata write", newSimTx(writeScope), false, 1000},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			newCtx, err := d.AnteHandle(ctx, tc.tx, tc.simulate, consumeGas)
+			require.NoError(t, err)
+			require.Equal(t, tc.gas, newCtx.GasMeter().GasConsumed())
+			require.Equal(t, uint64(1000), ctx.GasMeter().GasConsumed(), "gas actually consumed")
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package antewrapper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// TransferMemoChecker defines the marker functionality needed to require a memo on transfers of restricted coins.
+type TransferMemoChecker interface {
+	CheckTransferMemo(ctx sdk.Context, denom string, memo string) error
+}
+
+// MarkerTransferMemoDecorator is an AnteDecorator that records the memo of the tx on the context for the marker keeper
+// to check the transfers of restricted coins against, and rejects a tx transferring the coin of a restricted marker
+// that requires a transfer memo early in CheckTx when the memo does not match the marker's pattern.  Transfers executed
+// through authz or wasm are checked against the memo of the tx that executes them.
+type MarkerTransferMemoDecorator struct {
+	checker TransferMemoChecker
+}
+
+// NewMarkerTransferMemoDecorator creates a new MarkerTransferMemoDecorator
+func NewMarkerTransferMemoDecorator(checker TransferMemoChecker) MarkerTransferMemoDecorator {
+	return MarkerTransferMemoDecorator{checker: checker}
+}
+
+var _ sdk.AnteDecorator = MarkerTransferMemoDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d MarkerTransferMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	var memo string
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		memo = memoTx.GetMemo()
+	}
+	ctx = markertypes.WithTxMemo(ctx, memo)
+	if ctx.IsCheckTx() {
+		if err = d.checkMsgs(ctx, tx.GetMsgs(), memo); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the memo against each of the marker transfers in the msgs.
+func (d MarkerTransferMemoDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg, memo string) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *markertypes.MsgTransferRequest:
+			if err := d.checker.CheckTransferMemo(ctx, m.Amount.Denom, memo); err != nil {
+				return err
+			}
+		case *markertypes.MsgScheduleTransferRequest:
+			if err := d.checker.CheckTransferMemo(ctx, m.Amount.Denom, memo); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			execMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err = d.checkMsgs(ctx, execMsgs, memo); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
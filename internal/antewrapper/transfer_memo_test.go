@@ -0,0 +1,70 @@
+package antewrapper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// memoTx is a testTx with a memo.
+type memoTx struct {
+	testTx
+	memo string
+}
+
+func (tx memoTx) GetMemo() string { return tx.memo }
+
+func TestMarkerTransferMemoDecorator(t *testing.T) {
+	pioApp := app.Setup(false)
+	ctx := pioApp.BaseApp.NewContext(true, tmproto.Header{})
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	marker := markertypes.NewEmptyMarkerAccount("memocoin", admin.String(), []markertypes.AccessGrant{
+		*markertypes.NewAccessGrant(admin, []markertypes.Access{markertypes.Access_Admin, markertypes.Access_Transfer}),
+	})
+	marker.MarkerType = markertypes.MarkerType_RestrictedCoin
+	pioApp.MarkerKeeper.SetMarker(ctx, marker)
+
+	decorator := antewrapper.NewMarkerTransferMemoDecorator(pioApp.MarkerKeeper)
+	accepted := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	run := func(memo string, msgs ...sdk.Msg) error {
+		_, err := decorator.AnteHandle(ctx, memoTx{testTx{msgs}, memo}, false, accepted)
+		return err
+	}
+	transfer := markertypes.NewMsgTransferRequest(admin, admin, holder, sdk.NewInt64Coin("memocoin", 1))
+	otherTransfer := markertypes.NewMsgTransferRequest(admin, admin, holder, sdk.NewInt64Coin("othercoin", 1))
+	exec := authz.NewMsgExec(holder, []sdk.Msg{transfer})
+
+	// a memo is not required until the marker sets a pattern
+	require.NoError(t, run("", transfer))
+
+	require.NoError(t, pioApp.MarkerKeeper.SetTransferMemoRequirement(ctx, admin, "memocoin", `TR-[0-9]{6}`))
+
+	require.NoError(t, run("TR-123456", transfer))
+	require.NoError(t, run("", otherTransfer), "transfer of a coin without a memo requirement")
+	require.ErrorIs(t, run("", transfer), markertypes.ErrTransferMemoRequired)
+	require.ErrorIs(t, run("ref TR-123456", transfer), markertypes.ErrTransferMemoRequired, "the whole memo must match")
+	require.ErrorIs(t, run("", otherTransfer, transfer), markertypes.ErrTransferMemoRequired, "any msg of the tx")
+	require.ErrorIs(t, run("", &exec), markertypes.ErrTransferMemoRequired, "transfer executed through authz")
+	require.NoError(t, run("TR-654321", &exec))
+
+	// the memo is recorded for the marker keeper to check when the transfer is made
+	var recorded string
+	record := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		recorded = markertypes.TxMemo(ctx)
+		return ctx, nil
+	}
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(false), memoTx{testTx{[]sdk.Msg{transfer}}, "ref"}, false, record)
+	require.NoError(t, err, "not checked in DeliverTx")
+	require.Equal(t, "ref", recorded)
+}
@@ -0,0 +1,283 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpccore "github.com/tendermint/tendermint/rpc/core"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	dbm "github.com/tendermint/tm-db"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/provenance-io/provenance/internal/health"
+)
+
+const (
+	// FlagVerify is the start flag that verifies the integrity of the stored heights of an archive node on boot.
+	FlagVerify = "archive-verify"
+	// FlagVerifySamples is the start flag with the number of heights whose app hash is checked against the block headers.
+	FlagVerifySamples = "archive-verify-samples"
+
+	// DefaultSamples is the number of heights whose app hash is checked when no number of samples is given.
+	DefaultSamples = 1000
+
+	// Task is the name of the verification in the readiness report of the health endpoints.
+	Task = "archive-verify"
+	// StageWaiting is the stage of the verification while the node is starting.
+	StageWaiting = "waiting for node"
+	// StageHeights is the stage of the verification that checks every height has been committed.
+	StageHeights = "store heights"
+	// StageAppHashes is the stage of the verification that checks the app hash of the sampled heights.
+	StageAppHashes = "app hashes"
+
+	// commitInfoKeyFmt is the key of the commit info of each height in the app db, see the rootmulti store.
+	commitInfoKeyFmt = "s/%d"
+	// maxProblems is the number of problems reported before the rest are only counted.
+	maxProblems = 100
+	// progressEvery is the number of heights checked between updates of the reported progress.
+	progressEvery = 1000
+)
+
+// AddFlags adds the archive verification flags to the start command.
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagVerify, false, "Verify on boot that the store heights are contiguous and the app hash chain matches the block headers")
+	startCmd.Flags().Int(FlagVerifySamples, DefaultSamples, "The number of heights whose app hash is checked against the block headers by --"+FlagVerify)
+}
+
+// BlockRangeFunc returns the earliest and latest heights of the blocks stored by the node.
+type BlockRangeFunc func() (earliest, latest int64, err error)
+
+// AppHashFunc returns the app hash in the header of the block at the height.
+type AppHashFunc func(height int64) ([]byte, error)
+
+// Verifier checks that the app db has a commit for every height of the stored blocks, and that the app hash of each
+// commit in a sample of them matches the app hash in the header of the next block.
+type Verifier struct {
+	db      dbm.DB
+	samples int64
+
+	blockRange BlockRangeFunc
+	appHash    AppHashFunc
+	retry      time.Duration
+
+	mtx        sync.Mutex
+	progress   health.TaskReport
+	suppressed int
+}
+
+// NewVerifier returns a new Verifier of the app db when the verification has been requested, otherwise nil.
+func NewVerifier(appOpts servertypes.AppOptions, db dbm.DB) *Verifier {
+	if !cast.ToBool(appOpts.Get(FlagVerify)) {
+		return nil
+	}
+	samples := cast.ToInt64(appOpts.Get(FlagVerifySamples))
+	if samples <= 0 {
+		samples = DefaultSamples
+	}
+	return &Verifier{
+		db:         db,
+		samples:    samples,
+		blockRange: tendermintBlockRange,
+		appHash:    tendermintAppHash,
+		retry:      time.Second,
+		progress:   health.TaskReport{Task: Task, Stage: StageWaiting},
+	}
+}
+
+// Progress returns the progress of the verification and the problems found so far.
+func (v *Verifier) Progress() health.TaskReport {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	report := v.progress
+	report.Problems = append([]string(nil), v.progress.Problems...)
+	if v.suppressed > 0 {
+		report.Problems = append(report.Problems, fmt.Sprintf("%d more problems not shown", v.suppressed))
+	}
+	return report
+}
+
+// Run waits for the node to start and then verifies the stored heights, logging the problems found.
+func (v *Verifier) Run(logger log.Logger) {
+	earliest, latest := v.waitForBlocks()
+	// The app hash of a height is in the header of the next block, so the latest block only bounds the heights.
+	last := latest - 1
+	if last < earliest {
+		v.finish(logger, earliest, last)
+		return
+	}
+	samples := sampleHeights(earliest, last, v.samples)
+	logger.Info("verifying archive store heights", "from", earliest, "to", last, "samples", len(samples))
+	v.update(func(p *health.TaskReport) {
+		p.Stage = StageHeights
+		p.Total = last - earliest + 1 + int64(len(samples))
+	})
+
+	missingFrom := int64(0)
+	for height := earliest; height <= last; height++ {
+		found, err := v.db.Has([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+		if err != nil {
+			v.problem(logger, fmt.Sprintf("could not read commit of height %d: %s", height, err))
+		}
+		switch {
+		case !found && missingFrom == 0:
+			missingFrom = height
+		case found && missingFrom != 0:
+			v.problem(logger, missingHeights(missingFrom, height-1))
+			missingFrom = 0
+		}
+		if (height-earliest+1)%progressEvery == 0 {
+			v.update(func(p *health.TaskReport) { p.Completed = height - earliest + 1 })
+		}
+	}
+	if missingFrom != 0 {
+		v.problem(logger, missingHeights(missingFrom, last))
+	}
+
+	v.update(func(p *health.TaskReport) {
+		p.Stage = StageAppHashes
+		p.Completed = last - earliest + 1
+	})
+	for _, height := range samples {
+		if problem := v.verifyAppHash(height); len(problem) > 0 {
+			v.problem(logger, problem)
+		}
+		v.update(func(p *health.TaskReport) { p.Completed++ })
+	}
+	v.finish(logger, earliest, last)
+}
+
+// verifyAppHash returns a description of the problem when the app hash of the commit at the height does not match the
+// app hash in the header of the next block, otherwise an empty string.
+func (v *Verifier) verifyAppHash(height int64) string {
+	bz, err := v.db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+	if err != nil {
+		return fmt.Sprintf("could not read commit of height %d: %s", height, err)
+	}
+	if bz == nil {
+		// Already reported as a missing height.
+		return ""
+	}
+	var commitInfo storetypes.CommitInfo
+	if err = commitInfo.Unmarshal(bz); err != nil {
+		return fmt.Sprintf("could not decode commit of height %d: %s", height, err)
+	}
+	expected, err := v.appHash(height + 1)
+	if err != nil {
+		return fmt.Sprintf("could not get header of block %d: %s", height+1, err)
+	}
+	if actual := commitInfo.Hash(); !bytes.Equal(actual, expected) {
+		return fmt.Sprintf("app hash of height %d is %X but the header of block %d has %X", height, actual, height+1, expected)
+	}
+	return ""
+}
+
+// waitForBlocks returns the range of stored blocks once the node has started.
+func (v *Verifier) waitForBlocks() (earliest, latest int64) {
+	for {
+		earliest, latest, err := v.blockRange()
+		if err == nil {
+			return earliest, latest
+		}
+		time.Sleep(v.retry)
+	}
+}
+
+// problem records and logs a problem found by the verification.
+func (v *Verifier) problem(logger log.Logger, problem string) {
+	logger.Error("archive verification problem", "problem", problem)
+	v.update(func(p *health.TaskReport) {
+		if len(p.Problems) < maxProblems {
+			p.Problems = append(p.Problems, problem)
+		} else {
+			v.suppressed++
+		}
+	})
+}
+
+// finish marks the verification as done and logs its result.
+func (v *Verifier) finish(logger log.Logger, from, to int64) {
+	v.update(func(p *health.TaskReport) {
+		p.Stage = ""
+		p.Completed = p.Total
+		p.Done = true
+	})
+	progress := v.Progress()
+	if len(progress.Problems) == 0 {
+		logger.Info("archive verification passed", "from", from, "to", to)
+	} else {
+		logger.Error("archive verification failed", "from", from, "to", to, "problems", len(progress.Problems))
+	}
+}
+
+// update changes the reported progress.
+func (v *Verifier) update(change func(p *health.TaskReport)) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	change(&v.progress)
+}
+
+// sampleHeights returns up to count heights spread evenly from the first to the last height, both included.
+func sampleHeights(first, last, count int64) []int64 {
+	span := last - first + 1
+	if span <= 0 || count <= 0 {
+		return nil
+	}
+	if count >= span {
+		count = span
+	}
+	if count == 1 {
+		return []int64{last}
+	}
+	heights := make([]int64, count)
+	for i := int64(0); i < count; i++ {
+		heights[i] = first + i*(span-1)/(count-1)
+	}
+	return heights
+}
+
+// missingHeights describes a range of heights without a commit in the app db.
+func missingHeights(from, to int64) string {
+	if from == to {
+		return fmt.Sprintf("store height %d is missing", from)
+	}
+	return fmt.Sprintf("store heights %d to %d are missing", from, to)
+}
+
+// tendermintBlockRange returns the range of blocks stored by the tendermint node of this process.
+func tendermintBlockRange() (earliest, latest int64, err error) {
+	defer func() {
+		// The tendermint rpc environment is not set up until the node has started.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("node status is not available: %v", r)
+		}
+	}()
+	res, err := tmrpccore.Status(&tmrpctypes.Context{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.SyncInfo.EarliestBlockHeight, res.SyncInfo.LatestBlockHeight, nil
+}
+
+// tendermintAppHash returns the app hash in the header of a block stored by the tendermint node of this process.
+func tendermintAppHash(height int64) (appHash []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("block is not available: %v", r)
+		}
+	}()
+	res, err := tmrpccore.Block(&tmrpctypes.Context{}, &height)
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return res.Block.AppHash, nil
+}
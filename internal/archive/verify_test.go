@@ -0,0 +1,127 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestNewVerifier(t *testing.T) {
+	v := viper.New()
+	db := dbm.NewMemDB()
+	require.Nil(t, NewVerifier(v, db), "verification not requested")
+
+	v.Set(FlagVerify, true)
+	verifier := NewVerifier(v, db)
+	require.NotNil(t, verifier)
+	require.Equal(t, int64(DefaultSamples), verifier.samples)
+	require.Equal(t, StageWaiting, verifier.Progress().Stage)
+	require.False(t, verifier.Progress().Done)
+
+	v.Set(FlagVerifySamples, 25)
+	require.Equal(t, int64(25), NewVerifier(v, db).samples)
+}
+
+func TestSampleHeights(t *testing.T) {
+	require.Nil(t, sampleHeights(5, 4, 10))
+	require.Equal(t, []int64{7}, sampleHeights(7, 7, 10))
+	require.Equal(t, []int64{1, 2, 3}, sampleHeights(1, 3, 10))
+	require.Equal(t, []int64{1, 5, 10}, sampleHeights(1, 10, 3))
+	require.Equal(t, []int64{100}, sampleHeights(1, 100, 1))
+}
+
+// commitInfo returns the commit info of a height whose single store has a hash unique to the height.
+func commitInfo(height int64) storetypes.CommitInfo {
+	return storetypes.CommitInfo{
+		Version: height,
+		StoreInfos: []storetypes.StoreInfo{
+			{Name: "marker", CommitId: storetypes.CommitID{Version: height, Hash: []byte(fmt.Sprintf("hash-%d", height))}},
+		},
+	}
+}
+
+// setCommitInfo stores the commit info of the height the way the rootmulti store does.
+func setCommitInfo(t *testing.T, db dbm.DB, height int64) {
+	info := commitInfo(height)
+	bz, err := info.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte(fmt.Sprintf(commitInfoKeyFmt, height)), bz))
+}
+
+func TestRun(t *testing.T) {
+	db := dbm.NewMemDB()
+	for height := int64(1); height <= 20; height++ {
+		if height >= 8 && height <= 10 || height == 15 {
+			continue
+		}
+		setCommitInfo(t, db, height)
+	}
+
+	v := viper.New()
+	v.Set(FlagVerify, true)
+	v.Set(FlagVerifySamples, 100)
+	verifier := NewVerifier(v, db)
+
+	available := false
+	verifier.retry = 0
+	verifier.blockRange = func() (int64, int64, error) {
+		if !available {
+			available = true
+			return 0, 0, errors.New("node status is not available")
+		}
+		return 1, 21, nil
+	}
+	verifier.appHash = func(height int64) ([]byte, error) {
+		switch height {
+		case 5:
+			return []byte("not the app hash"), nil
+		case 12:
+			return nil, errors.New("block not found")
+		}
+		return commitInfo(height - 1).Hash(), nil
+	}
+
+	verifier.Run(log.NewNopLogger())
+	progress := verifier.Progress()
+	require.True(t, progress.Done)
+	require.Empty(t, progress.Stage)
+	require.Equal(t, int64(40), progress.Total)
+	require.Equal(t, progress.Total, progress.Completed)
+	require.Equal(t, []string{
+		"store heights 8 to 10 are missing",
+		"store height 15 is missing",
+		fmt.Sprintf("app hash of height 4 is %X but the header of block 5 has %X", commitInfo(4).Hash(), []byte("not the app hash")),
+		"could not get header of block 12: block not found",
+	}, progress.Problems)
+}
+
+func TestRunNoProblems(t *testing.T) {
+	db := dbm.NewMemDB()
+	for height := int64(1); height <= 10; height++ {
+		setCommitInfo(t, db, height)
+	}
+	v := viper.New()
+	v.Set(FlagVerify, true)
+	v.Set(FlagVerifySamples, 3)
+	verifier := NewVerifier(v, db)
+	verifier.blockRange = func() (int64, int64, error) { return 1, 11, nil }
+	checked := []int64{}
+	verifier.appHash = func(height int64) ([]byte, error) {
+		checked = append(checked, height)
+		return commitInfo(height - 1).Hash(), nil
+	}
+
+	verifier.Run(log.NewNopLogger())
+	progress := verifier.Progress()
+	require.True(t, progress.Done)
+	require.Empty(t, progress.Problems)
+	require.Equal(t, int64(13), progress.Total)
+	require.Equal(t, []int64{2, 6, 11}, checked)
+}
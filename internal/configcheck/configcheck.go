@@ -0,0 +1,139 @@
+package configcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagForce is the start flag that starts the node despite config problems, logging them instead.
+	FlagForce = "force"
+
+	// MainnetChainID is the chain id of the Provenance mainnet.
+	MainnetChainID = "pio-mainnet-1"
+
+	// flagAPIEnable and flagAPIUnsafeCORS are the app.toml settings of the api server.
+	flagAPIEnable     = "api.enable"
+	flagAPIUnsafeCORS = "api.enabled-unsafe-cors"
+)
+
+// Problem is a known-bad combination of config settings.
+type Problem struct {
+	// Settings are the settings involved, e.g. "pruning".
+	Settings []string
+	// Message describes the problem and how to fix it.
+	Message string
+	// Fatal problems stop the node from starting unless --force is given, others are only logged.
+	Fatal bool
+}
+
+// String returns the settings and message of the problem.
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s", strings.Join(p.Settings, ", "), p.Message)
+}
+
+// AddFlags adds the config check flags to the start command.
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagForce, false, "Start the node even when the config check finds settings that are known to not work together")
+}
+
+// WrapPreRunE returns a start command pre-run that runs the given pre-run followed by the config check.
+func WrapPreRunE(preRunE func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if preRunE != nil {
+			if err := preRunE(cmd, args); err != nil {
+				return err
+			}
+		}
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		chainID := GenesisChainID(serverCtx.Config.GenesisFile())
+		return Run(serverCtx.Logger, serverCtx.Viper, chainID)
+	}
+}
+
+// Run checks the config and returns an error listing the fatal problems.  With --force, or for problems that are not
+// fatal, the problems are logged instead.
+func Run(logger log.Logger, appOpts servertypes.AppOptions, chainID string) error {
+	force := cast.ToBool(appOpts.Get(FlagForce))
+	var fatal []string
+	for _, p := range Check(appOpts, chainID) {
+		switch {
+		case !p.Fatal:
+			logger.Info("config check: "+p.Message, "settings", strings.Join(p.Settings, ","))
+		case force:
+			logger.Error("config check: "+p.Message, "settings", strings.Join(p.Settings, ","), "flag", FlagForce)
+		default:
+			fatal = append(fatal, p.String())
+		}
+	}
+	if len(fatal) > 0 {
+		return fmt.Errorf("refusing to start with the following config problems (use --%s to start anyway):\n  %s",
+			FlagForce, strings.Join(fatal, "\n  "))
+	}
+	return nil
+}
+
+// Check returns the problems with the combination of settings in the app options on the chain with the given id.
+func Check(appOpts servertypes.AppOptions, chainID string) []Problem {
+	var problems []Problem
+
+	// A node that prunes everything no longer has the state of the heights its snapshots are taken from.
+	snapshotInterval := cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))
+	if cast.ToString(appOpts.Get(server.FlagPruning)) == "everything" && snapshotInterval > 0 {
+		problems = append(problems, Problem{
+			Settings: []string{server.FlagPruning, server.FlagStateSyncSnapshotInterval},
+			Message: fmt.Sprintf("pruning \"everything\" removes the state that state-sync snapshots are taken from every "+
+				"%d blocks: set pruning to \"default\" or \"custom\", or set state-sync.snapshot-interval to 0", snapshotInterval),
+			Fatal: true,
+		})
+	}
+
+	// Without CORS headers, browser apps on other origins cannot use the api.
+	if cast.ToBool(appOpts.Get(flagAPIEnable)) && !cast.ToBool(appOpts.Get(flagAPIUnsafeCORS)) {
+		problems = append(problems, Problem{
+			Settings: []string{flagAPIEnable, flagAPIUnsafeCORS},
+			Message: "the api is enabled without CORS, so browser apps served from other origins cannot call it: " +
+				"set api.enabled-unsafe-cors to true if the api serves browser apps, or add the CORS headers in a proxy",
+		})
+	}
+
+	// A mainnet node without a minimum gas price fills its mempool with txs that do not pay fees.
+	if chainID == MainnetChainID {
+		minGasPrices := cast.ToString(appOpts.Get(server.FlagMinGasPrices))
+		if prices, err := sdk.ParseDecCoins(minGasPrices); err == nil && prices.IsZero() {
+			problems = append(problems, Problem{
+				Settings: []string{server.FlagMinGasPrices},
+				Message: fmt.Sprintf("the minimum gas price %q is zero on %s, so the node accepts txs that do not pay fees: "+
+					"set minimum-gas-prices to at least 1905nhash", minGasPrices, MainnetChainID),
+				Fatal: true,
+			})
+		}
+	}
+
+	return problems
+}
+
+// GenesisChainID returns the chain id in the genesis file, or an empty string when it cannot be read.
+func GenesisChainID(genesisFile string) string {
+	bz, err := ioutil.ReadFile(genesisFile)
+	if err != nil {
+		return ""
+	}
+	var genesis struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err = json.Unmarshal(bz, &genesis); err != nil {
+		return ""
+	}
+	return genesis.ChainID
+}
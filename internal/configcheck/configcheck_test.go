@@ -0,0 +1,66 @@
+package configcheck
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestCheck(t *testing.T) {
+	v := viper.New()
+	v.Set(server.FlagPruning, "default")
+	v.Set(server.FlagStateSyncSnapshotInterval, 1000)
+	v.Set(server.FlagMinGasPrices, "0nhash")
+	require.Empty(t, Check(v, "pio-testnet-1"), "zero gas price on testnet")
+
+	problems := Check(v, MainnetChainID)
+	require.Len(t, problems, 1)
+	require.Equal(t, []string{server.FlagMinGasPrices}, problems[0].Settings)
+	require.True(t, problems[0].Fatal)
+	v.Set(server.FlagMinGasPrices, "1905nhash")
+	require.Empty(t, Check(v, MainnetChainID))
+
+	v.Set(server.FlagPruning, "everything")
+	problems = Check(v, MainnetChainID)
+	require.Len(t, problems, 1)
+	require.Equal(t, []string{server.FlagPruning, server.FlagStateSyncSnapshotInterval}, problems[0].Settings)
+	require.True(t, problems[0].Fatal)
+	v.Set(server.FlagStateSyncSnapshotInterval, 0)
+	require.Empty(t, Check(v, MainnetChainID), "pruning everything without snapshots")
+
+	v.Set(flagAPIEnable, true)
+	problems = Check(v, MainnetChainID)
+	require.Len(t, problems, 1)
+	require.False(t, problems[0].Fatal, "api without CORS")
+	v.Set(flagAPIUnsafeCORS, true)
+	require.Empty(t, Check(v, MainnetChainID))
+}
+
+func TestRun(t *testing.T) {
+	v := viper.New()
+	v.Set(server.FlagPruning, "everything")
+	v.Set(server.FlagStateSyncSnapshotInterval, 1000)
+	v.Set(server.FlagMinGasPrices, "")
+	err := Run(log.NewNopLogger(), v, MainnetChainID)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "use --force to start anyway")
+	require.Contains(t, err.Error(), "pruning, state-sync.snapshot-interval: ")
+	require.Contains(t, err.Error(), "minimum-gas-prices: ")
+
+	v.Set(FlagForce, true)
+	require.NoError(t, Run(log.NewNopLogger(), v, MainnetChainID))
+}
+
+func TestGenesisChainID(t *testing.T) {
+	dir := t.TempDir()
+	genesisFile := filepath.Join(dir, "genesis.json")
+	require.Equal(t, "", GenesisChainID(genesisFile), "missing file")
+	require.NoError(t, ioutil.WriteFile(genesisFile, []byte(`{"chain_id":"pio-mainnet-1","app_state":{}}`), 0644))
+	require.Equal(t, MainnetChainID, GenesisChainID(genesisFile))
+}
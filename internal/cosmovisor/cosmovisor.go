@@ -0,0 +1,63 @@
+// Package cosmovisor helps validators stage the binary of a pending upgrade plan in the directory layout cosmovisor
+// expects, so the node restarts with a verified binary at the upgrade height.
+package cosmovisor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvHome is the environment variable cosmovisor reads its home directory from.
+	EnvHome = "DAEMON_HOME"
+	// EnvName is the environment variable cosmovisor reads the name of the daemon binary from.
+	EnvName = "DAEMON_NAME"
+	// DefaultName is the name of the daemon binary when none is configured.
+	DefaultName = "provenanced"
+)
+
+// Config is the location of the cosmovisor directory layout that upgrade binaries are staged in.
+type Config struct {
+	// Home is the directory holding the cosmovisor folder, usually the node home.
+	Home string
+	// Name is the name of the daemon binary.
+	Name string
+}
+
+// ConfigFromEnv reads the cosmovisor config from the environment the same way cosmovisor does, falling back to the
+// given home and the default daemon name when they are not set.
+func ConfigFromEnv(defaultHome string) Config {
+	cfg := Config{
+		Home: strings.TrimSpace(os.Getenv(EnvHome)),
+		Name: strings.TrimSpace(os.Getenv(EnvName)),
+	}
+	if len(cfg.Home) == 0 {
+		cfg.Home = defaultHome
+	}
+	if len(cfg.Name) == 0 {
+		cfg.Name = DefaultName
+	}
+	return cfg
+}
+
+// Root returns the cosmovisor directory.
+func (c Config) Root() string {
+	return filepath.Join(c.Home, "cosmovisor")
+}
+
+// UpgradeDir returns the directory the binary of the named upgrade is staged in.
+func (c Config) UpgradeDir(upgradeName string) string {
+	return filepath.Join(c.Root(), "upgrades", upgradeName)
+}
+
+// UpgradeBin returns the path of the daemon binary of the named upgrade.
+func (c Config) UpgradeBin(upgradeName string) string {
+	return filepath.Join(c.UpgradeDir(upgradeName), "bin", c.Name)
+}
+
+// IsStaged returns true if a daemon binary has already been staged for the named upgrade.
+func (c Config) IsStaged(upgradeName string) bool {
+	info, err := os.Stat(c.UpgradeBin(upgradeName))
+	return err == nil && info.Mode().IsRegular()
+}
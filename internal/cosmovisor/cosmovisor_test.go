@@ -0,0 +1,217 @@
+package cosmovisor
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func checksum(bz []byte) string {
+	sum := sha256.Sum256(bz)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	for _, key := range []string{EnvHome, EnvName} {
+		if prev, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, prev)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+	os.Unsetenv(EnvHome)
+	os.Unsetenv(EnvName)
+	cfg := ConfigFromEnv("/node")
+	require.Equal(t, Config{Home: "/node", Name: DefaultName}, cfg)
+	require.Equal(t, filepath.FromSlash("/node/cosmovisor/upgrades/v2/bin/provenanced"), cfg.UpgradeBin("v2"))
+
+	os.Setenv(EnvHome, "/cosmovisor")
+	os.Setenv(EnvName, "pio")
+	require.Equal(t, Config{Home: "/cosmovisor", Name: "pio"}, ConfigFromEnv("/node"))
+}
+
+func TestParsePlanInfo(t *testing.T) {
+	sum := checksum([]byte("binary"))
+	info := fmt.Sprintf(`{"binaries":{"linux/amd64":"https://example.com/provenanced?checksum=%s","any":"https://example.com/provenanced.zip?checksum=%s"}}`, sum, sum)
+
+	planInfo, err := ParsePlanInfo(info)
+	require.NoError(t, err)
+	require.Equal(t, []string{"any", "linux/amd64"}, planInfo.Platforms())
+
+	bin, err := planInfo.Binary("linux/amd64")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/provenanced", bin.URL)
+	require.Equal(t, sum, bin.ChecksumString())
+	require.False(t, bin.IsArchive())
+
+	bin, err = planInfo.Binary("darwin/arm64")
+	require.NoError(t, err, "falls back to any platform")
+	require.Equal(t, "https://example.com/provenanced.zip", bin.URL)
+	require.True(t, bin.IsArchive())
+
+	delete(planInfo.Binaries, AnyPlatform)
+	_, err = planInfo.Binary("darwin/arm64")
+	require.EqualError(t, err, "plan info has no binary for darwin/arm64, it has binaries for: linux/amd64")
+
+	_, err = ParsePlanInfo("")
+	require.EqualError(t, err, "plan info is empty")
+	_, err = ParsePlanInfo(`{"binaries":{}}`)
+	require.EqualError(t, err, "plan info does not list any binaries")
+	_, err = ParsePlanInfo("upgrade at noon")
+	require.Error(t, err)
+}
+
+func TestParseBinaryURL(t *testing.T) {
+	_, err := ParseBinaryURL("https://example.com/provenanced")
+	require.EqualError(t, err, `binary url "https://example.com/provenanced" does not have a checksum`)
+	_, err = ParseBinaryURL("ftp://example.com/provenanced?checksum=sha256:00")
+	require.EqualError(t, err, `invalid binary url "ftp://example.com/provenanced?checksum=sha256:00": scheme must be http or https`)
+	_, err = ParseBinaryURL("https://example.com/provenanced?checksum=md5:00")
+	require.EqualError(t, err, `unsupported checksum type "md5": must be sha256 or sha512`)
+	_, err = ParseBinaryURL("https://example.com/provenanced?checksum=sha256:abcd")
+	require.EqualError(t, err, `invalid sha256 checksum "abcd" of binary url "https://example.com/provenanced?checksum=sha256:abcd"`)
+	_, err = ParseBinaryURL("https://example.com/provenanced?checksum=abcd")
+	require.Error(t, err)
+
+	bin, err := ParseBinaryURL("https://example.com/provenanced.tar.gz?token=a&checksum=" + checksum(nil))
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/provenanced.tar.gz?token=a", bin.URL)
+	require.True(t, bin.IsArchive())
+}
+
+func TestResolvePlanInfo(t *testing.T) {
+	info := `{"binaries":{"any":"https://example.com/provenanced?checksum=` + checksum(nil) + `"}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/info.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, info)
+	}))
+	defer srv.Close()
+
+	planInfo, err := ResolvePlanInfo(context.Background(), srv.Client(), srv.URL+"/info.json")
+	require.NoError(t, err)
+	require.Equal(t, []string{AnyPlatform}, planInfo.Platforms())
+
+	planInfo, err = ResolvePlanInfo(context.Background(), srv.Client(), info)
+	require.NoError(t, err)
+	require.Equal(t, []string{AnyPlatform}, planInfo.Platforms())
+
+	_, err = ResolvePlanInfo(context.Background(), srv.Client(), srv.URL+"/missing.json")
+	require.EqualError(t, err, "could not fetch plan info from "+srv.URL+"/missing.json: 404 Not Found")
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestStage(t *testing.T) {
+	files := map[string][]byte{
+		"/provenanced":        []byte("#!/bin/sh\necho v2\n"),
+		"/provenanced.zip":    zipArchive(t, map[string]string{"bin/provenanced": "zipped", "lib/libwasmvm.so": "lib"}),
+		"/provenanced.tar.gz": tarGzArchive(t, map[string]string{"bin/provenanced": "tarred"}),
+		"/empty.zip":          zipArchive(t, map[string]string{"README": "no binary"}),
+		"/escape.tar.gz":      tarGzArchive(t, map[string]string{"../../bin/provenanced": "escaped"}),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(bz) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	binary := func(name string) Binary {
+		bin, err := ParseBinaryURL(srv.URL + name + "?checksum=" + checksum(files[name]))
+		require.NoError(t, err)
+		return bin
+	}
+	cfg := Config{Home: t.TempDir(), Name: DefaultName}
+	ctx := context.Background()
+
+	staged, err := Stage(ctx, srv.Client(), cfg, "plain", binary("/provenanced"))
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("plain"), staged)
+	require.True(t, cfg.IsStaged("plain"))
+	require.NoError(t, binary("/provenanced").VerifyFile(staged))
+	info, err := os.Stat(staged)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	_, err = Stage(ctx, srv.Client(), cfg, "plain", binary("/provenanced"))
+	require.EqualError(t, err, fmt.Sprintf("upgrade directory %s already exists", cfg.UpgradeDir("plain")))
+
+	staged, err = Stage(ctx, srv.Client(), cfg, "zipped", binary("/provenanced.zip"))
+	require.NoError(t, err)
+	bz, err := os.ReadFile(staged)
+	require.NoError(t, err)
+	require.Equal(t, "zipped", string(bz))
+	require.FileExists(t, filepath.Join(cfg.UpgradeDir("zipped"), "lib", "libwasmvm.so"))
+	require.NoFileExists(t, filepath.Join(cfg.UpgradeDir("zipped"), ".download"))
+
+	staged, err = Stage(ctx, srv.Client(), cfg, "tarred", binary("/provenanced.tar.gz"))
+	require.NoError(t, err)
+	bz, err = os.ReadFile(staged)
+	require.NoError(t, err)
+	require.Equal(t, "tarred", string(bz))
+
+	bad := binary("/provenanced")
+	bad.Checksum = make([]byte, sha256.Size)
+	_, err = Stage(ctx, srv.Client(), cfg, "bad", bad)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sha256 checksum mismatch")
+	require.NoDirExists(t, cfg.UpgradeDir("bad"))
+
+	_, err = Stage(ctx, srv.Client(), cfg, "empty", binary("/empty.zip"))
+	require.EqualError(t, err, "downloaded archive does not contain bin/provenanced")
+	require.NoDirExists(t, cfg.UpgradeDir("empty"))
+
+	_, err = Stage(ctx, srv.Client(), cfg, "escape", binary("/escape.tar.gz"))
+	require.EqualError(t, err, `invalid archive entry "../../bin/provenanced"`)
+	require.NoDirExists(t, cfg.UpgradeDir("escape"))
+
+	_, err = Stage(ctx, srv.Client(), cfg, "../plain", binary("/provenanced"))
+	require.EqualError(t, err, `invalid upgrade name "../plain"`)
+
+	entries, err := os.ReadDir(filepath.Join(cfg.Root(), "upgrades"))
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "no temporary directories are left behind")
+}
@@ -0,0 +1,161 @@
+package cosmovisor
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// AnyPlatform is the platform key of a binary that runs on any os and architecture.
+const AnyPlatform = "any"
+
+// maxPlanInfoSize is the largest plan info document that will be fetched from a url.
+const maxPlanInfoSize = 1 << 20
+
+// PlanInfo is the upgrade info of a plan in the format cosmovisor uses to download upgrade binaries.
+type PlanInfo struct {
+	// Binaries maps a platform, e.g. "linux/amd64", to the url of its binary, which must carry a checksum.
+	Binaries map[string]string `json:"binaries"`
+}
+
+// Platform returns the platform key of the running binary, e.g. "linux/amd64".
+func Platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// ParsePlanInfo parses the json upgrade info of a plan.
+func ParsePlanInfo(info string) (PlanInfo, error) {
+	var planInfo PlanInfo
+	if len(strings.TrimSpace(info)) == 0 {
+		return planInfo, errors.New("plan info is empty")
+	}
+	if err := json.Unmarshal([]byte(info), &planInfo); err != nil {
+		return planInfo, fmt.Errorf("invalid plan info: %w", err)
+	}
+	if len(planInfo.Binaries) == 0 {
+		return planInfo, errors.New("plan info does not list any binaries")
+	}
+	return planInfo, nil
+}
+
+// ResolvePlanInfo parses the upgrade info of a plan, fetching it first when the info is a url of the json document.
+func ResolvePlanInfo(ctx context.Context, client *http.Client, info string) (PlanInfo, error) {
+	info = strings.TrimSpace(info)
+	if !isHTTPURL(info) {
+		return ParsePlanInfo(info)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, info, nil)
+	if err != nil {
+		return PlanInfo{}, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return PlanInfo{}, fmt.Errorf("could not fetch plan info from %s: %w", info, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return PlanInfo{}, fmt.Errorf("could not fetch plan info from %s: %s", info, resp.Status)
+	}
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, maxPlanInfoSize))
+	if err != nil {
+		return PlanInfo{}, fmt.Errorf("could not read plan info from %s: %w", info, err)
+	}
+	return ParsePlanInfo(string(bz))
+}
+
+// Platforms returns the sorted platforms the plan info lists binaries for.
+func (p PlanInfo) Platforms() []string {
+	platforms := make([]string, 0, len(p.Binaries))
+	for platform := range p.Binaries {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	return platforms
+}
+
+// Binary returns the binary of the given platform, or the binary for any platform when there is none specific to it.
+func (p PlanInfo) Binary(platform string) (Binary, error) {
+	raw, ok := p.Binaries[platform]
+	if !ok {
+		if raw, ok = p.Binaries[AnyPlatform]; !ok {
+			return Binary{}, fmt.Errorf("plan info has no binary for %s, it has binaries for: %s",
+				platform, strings.Join(p.Platforms(), ", "))
+		}
+	}
+	return ParseBinaryURL(raw)
+}
+
+// Binary is the download location of an upgrade binary and the checksum it must match.
+type Binary struct {
+	// URL is the location to download the binary from, without its checksum parameter.
+	URL string
+	// ChecksumType is the hash algorithm of the checksum, e.g. "sha256".
+	ChecksumType string
+	// Checksum is the expected hash of the downloaded file.
+	Checksum []byte
+}
+
+// ParseBinaryURL parses a binary url of the plan info, which must carry a checksum parameter,
+// e.g. "https://example.com/provenanced.zip?checksum=sha256:<hex>".
+func ParseBinaryURL(raw string) (Binary, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return Binary{}, fmt.Errorf("invalid binary url %q: %w", raw, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return Binary{}, fmt.Errorf("invalid binary url %q: scheme must be http or https", raw)
+	}
+	query := u.Query()
+	checksum := query.Get("checksum")
+	if len(checksum) == 0 {
+		return Binary{}, fmt.Errorf("binary url %q does not have a checksum", raw)
+	}
+	query.Del("checksum")
+	u.RawQuery = query.Encode()
+
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return Binary{}, fmt.Errorf("invalid checksum %q of binary url %q: expected <type>:<hex>", checksum, raw)
+	}
+	bin := Binary{URL: u.String(), ChecksumType: strings.ToLower(parts[0])}
+	h, err := bin.newHash()
+	if err != nil {
+		return Binary{}, err
+	}
+	if bin.Checksum, err = hex.DecodeString(parts[1]); err != nil || len(bin.Checksum) != h.Size() {
+		return Binary{}, fmt.Errorf("invalid %s checksum %q of binary url %q", bin.ChecksumType, parts[1], raw)
+	}
+	return bin, nil
+}
+
+// ChecksumString returns the checksum in the <type>:<hex> form used in binary urls.
+func (b Binary) ChecksumString() string {
+	return b.ChecksumType + ":" + hex.EncodeToString(b.Checksum)
+}
+
+// newHash returns a new hash of the checksum type of the binary.
+func (b Binary) newHash() (hash.Hash, error) {
+	switch b.ChecksumType {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum type %q: must be sha256 or sha512", b.ChecksumType)
+	}
+}
+
+func isHTTPURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
@@ -0,0 +1,246 @@
+package cosmovisor
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IsArchive returns true if the binary url is of a zip or gzipped tar archive holding a bin/<daemon name> file instead
+// of the binary itself.
+func (b Binary) IsArchive() bool {
+	return archiveType(b.URL) != ""
+}
+
+// VerifyFile checks that the file at the given path matches the checksum of the binary.
+func (b Binary) VerifyFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h, err := b.newHash()
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+	if sum := h.Sum(nil); !bytes.Equal(sum, b.Checksum) {
+		return fmt.Errorf("%s checksum mismatch of %s: expected %x, got %x", b.ChecksumType, name, b.Checksum, sum)
+	}
+	return nil
+}
+
+// Stage downloads the binary, verifies its checksum, and places it in the cosmovisor directory of the named upgrade,
+// extracting it first when it is an archive.  Nothing is staged unless the download matches the checksum.
+// It returns the path of the staged daemon binary.
+func Stage(ctx context.Context, client *http.Client, cfg Config, upgradeName string, bin Binary) (string, error) {
+	if len(strings.TrimSpace(upgradeName)) == 0 || upgradeName != filepath.Base(upgradeName) {
+		return "", fmt.Errorf("invalid upgrade name %q", upgradeName)
+	}
+	upgradeDir := cfg.UpgradeDir(upgradeName)
+	if _, err := os.Stat(upgradeDir); err == nil {
+		return "", fmt.Errorf("upgrade directory %s already exists", upgradeDir)
+	}
+	upgradesDir := filepath.Dir(upgradeDir)
+	if err := os.MkdirAll(upgradesDir, 0o755); err != nil {
+		return "", err
+	}
+	// Everything is staged in a temporary directory next to the final one, so a failed download or extraction never
+	// leaves a partial upgrade directory for cosmovisor to find.
+	tmpDir, err := os.MkdirTemp(upgradesDir, "."+upgradeName+"-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	download := filepath.Join(tmpDir, ".download")
+	if err = downloadFile(ctx, client, bin.URL, download); err != nil {
+		return "", err
+	}
+	if err = bin.VerifyFile(download); err != nil {
+		return "", err
+	}
+
+	binPath := filepath.Join(tmpDir, "bin", cfg.Name)
+	switch archiveType(bin.URL) {
+	case ".zip":
+		err = extractZip(download, tmpDir)
+	case ".tar.gz", ".tgz":
+		err = extractTarGz(download, tmpDir)
+	default:
+		if err = os.MkdirAll(filepath.Dir(binPath), 0o755); err == nil {
+			err = os.Rename(download, binPath)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if err = os.RemoveAll(download); err != nil {
+		return "", err
+	}
+	if info, statErr := os.Stat(binPath); statErr != nil || !info.Mode().IsRegular() {
+		return "", fmt.Errorf("downloaded archive does not contain bin/%s", cfg.Name)
+	}
+	if err = os.Chmod(binPath, 0o755); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmpDir, upgradeDir); err != nil {
+		return "", err
+	}
+	return cfg.UpgradeBin(upgradeName), nil
+}
+
+// downloadFile writes the content at the url to the named file.
+func downloadFile(ctx context.Context, client *http.Client, rawURL, name string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not download %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not download %s: %s", rawURL, resp.Status)
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return fmt.Errorf("could not download %s: %w", rawURL, err)
+	}
+	return f.Close()
+}
+
+// archiveType returns the archive extension of the path of the url, or an empty string when it is not an archive.
+func archiveType(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	p := strings.ToLower(u.Path)
+	for _, ext := range []string{".zip", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(p, ext) {
+			return ext
+		}
+	}
+	return ""
+}
+
+// safeJoin joins an archive entry name to the destination directory, refusing names that would escape it.
+func safeJoin(dest, name string) (string, error) {
+	slashed := strings.ReplaceAll(name, "\\", "/")
+	if path.IsAbs(slashed) || path.Clean("/"+slashed) == "/" {
+		return "", fmt.Errorf("invalid archive entry %q", name)
+	}
+	for _, part := range strings.Split(slashed, "/") {
+		if part == ".." {
+			return "", fmt.Errorf("invalid archive entry %q", name)
+		}
+	}
+	return filepath.Join(dest, filepath.FromSlash(path.Clean(slashed))), nil
+}
+
+// writeEntry writes an archive entry to the named file.
+func writeEntry(name string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode.Perm()|0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// extractZip extracts the directories and regular files of a zip archive into the destination directory.
+func extractZip(archive, dest string) error {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("invalid zip archive: %w", err)
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		target, err := safeJoin(dest, f.Name)
+		if err != nil {
+			return err
+		}
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			err = os.MkdirAll(target, 0o755)
+		case mode.IsRegular():
+			var rc io.ReadCloser
+			if rc, err = f.Open(); err != nil {
+				return err
+			}
+			err = writeEntry(target, rc, mode)
+			rc.Close()
+		default:
+			err = fmt.Errorf("unsupported zip archive entry %q of type %s", f.Name, mode.Type())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractTarGz extracts the directories and regular files of a gzipped tar archive into the destination directory.
+func extractTarGz(archive, dest string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("invalid gzip archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+		target, err := safeJoin(dest, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0o755)
+		case tar.TypeReg, tar.TypeRegA:
+			err = writeEntry(target, tr, hdr.FileInfo().Mode())
+		default:
+			err = fmt.Errorf("unsupported tar archive entry %q of type %q", hdr.Name, string(hdr.Typeflag))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package eventstream
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagEnable is the app.toml setting that opts a node into serving the typed event stream on its gRPC server.
+	FlagEnable = "event-stream.enable"
+	// FlagBufferSize is the app.toml setting with the number of blocks buffered for each subscriber before a
+	// subscriber that is not keeping up is disconnected.
+	FlagBufferSize = "event-stream.buffer-size"
+
+	// DefaultBufferSize is the number of blocks buffered for each subscriber when no buffer size is configured.
+	DefaultBufferSize = 100
+
+	// eventPrefix is the prefix of the type name of every provenance typed event.
+	eventPrefix = "provenance."
+)
+
+// Modules are the modules whose typed events are streamed.
+var Modules = []string{"attribute", "marker", "metadata", "name"}
+
+// Streamer collects the provenance typed events emitted while a block is processed and publishes them to the
+// subscribers of the EventStream service once the block has been committed.
+type Streamer struct {
+	bufferSize int
+
+	mtx         sync.Mutex
+	height      int64
+	pending     []TypedEvent
+	subscribers map[*subscriber]struct{}
+}
+
+// subscriber is a single EventStream/Subscribe call.
+type subscriber struct {
+	filter  func(TypedEvent) bool
+	blocks  chan *BlockEvents
+	dropped chan struct{}
+}
+
+var _ EventStreamServer = &Streamer{}
+
+// NewStreamer returns a new Streamer when the event stream has been enabled in the app options, otherwise nil.
+func NewStreamer(appOpts servertypes.AppOptions) *Streamer {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil
+	}
+	bufferSize := cast.ToInt(appOpts.Get(FlagBufferSize))
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Streamer{
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// ListenBeginBlock records the typed events emitted by the begin blockers.
+func (s *Streamer) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.height = req.Header.Height
+	s.pending = nil
+	s.collect("", res.Events)
+}
+
+// ListenDeliverTx records the typed events emitted by a transaction.
+func (s *Streamer) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	// Events of failed transactions are discarded by the state machine and were never emitted.
+	if !res.IsOK() {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.collect(fmt.Sprintf("%X", tmhash.Sum(req.Tx)), res.Events)
+}
+
+// ListenEndBlock records the typed events emitted by the end blockers.
+func (s *Streamer) ListenEndBlock(_ abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.collect("", res.Events)
+}
+
+// ListenCommit publishes the typed events of the committed block to each subscriber.  Subscribers that have fallen
+// more than the buffer size behind are disconnected so that they can not slow down the node.
+func (s *Streamer) ListenCommit() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	events := s.pending
+	s.pending = nil
+	for sub := range s.subscribers {
+		block := &BlockEvents{Height: s.height, Events: make([]TypedEvent, 0)}
+		for _, event := range events {
+			if sub.filter(event) {
+				block.Events = append(block.Events, event)
+			}
+		}
+		select {
+		case sub.blocks <- block:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.dropped)
+		}
+	}
+}
+
+// Subscribe implements the EventStream/Subscribe RPC method.
+func (s *Streamer) Subscribe(req *SubscribeRequest, stream EventStream_SubscribeServer) error {
+	filter, err := newFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	sub := &subscriber{
+		filter:  filter,
+		blocks:  make(chan *BlockEvents, s.bufferSize),
+		dropped: make(chan struct{}),
+	}
+	s.mtx.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mtx.Unlock()
+	defer s.unsubscribe(sub)
+
+	for {
+		select {
+		case block := <-sub.blocks:
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-sub.dropped:
+			return status.Errorf(codes.ResourceExhausted, "subscriber fell more than %d blocks behind", s.bufferSize)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// unsubscribe removes the subscriber if it has not already been dropped.
+func (s *Streamer) unsubscribe(sub *subscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// collect decodes the provenance typed events and adds them to the pending events of the current block.
+// Must be called while holding the lock.
+func (s *Streamer) collect(txHash string, events []abci.Event) {
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, eventPrefix) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			continue
+		}
+		s.pending = append(s.pending, TypedEvent{Type: event.Type, TxHash: txHash, Event: any})
+	}
+}
+
+// newFilter returns a function that determines if an event matches the modules and event types of the request.
+func newFilter(req *SubscribeRequest) (func(TypedEvent) bool, error) {
+	modules := make(map[string]bool)
+	for _, module := range req.Modules {
+		if !containsString(Modules, module) {
+			return nil, fmt.Errorf("unknown module %q, must be one of %s", module, strings.Join(Modules, ", "))
+		}
+		modules[module] = true
+	}
+	eventTypes := make(map[string]bool)
+	for _, eventType := range req.EventTypes {
+		eventTypes[eventType] = true
+	}
+	return func(event TypedEvent) bool {
+		if len(modules) > 0 && !modules[eventModule(event.Type)] {
+			return false
+		}
+		return len(eventTypes) == 0 || eventTypes[event.Type]
+	}, nil
+}
+
+// eventModule returns the module of a provenance typed event name, e.g. marker for provenance.marker.v1.EventMarkerAdd
+func eventModule(eventType string) string {
+	parts := strings.SplitN(strings.TrimPrefix(eventType, eventPrefix), ".", 2)
+	return parts[0]
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
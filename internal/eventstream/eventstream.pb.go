@@ -0,0 +1,1012 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/eventstream/v1/eventstream.proto
+
+package eventstream
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the EventStream/Subscribe RPC method.
+type SubscribeRequest struct {
+	// modules limits the events to those emitted by the given modules (attribute, marker, metadata, name), all when empty
+	Modules []string `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	// event_types limits the events to the given full event type names, e.g. provenance.marker.v1.EventMarkerAdd
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a125da1d3142670d, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetModules() []string {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+// BlockEvents is the response type for the EventStream/Subscribe RPC method.
+type BlockEvents struct {
+	// height of the committed block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// events are the matching typed events in the order they were emitted in the block
+	Events []TypedEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+}
+
+func (m *BlockEvents) Reset()         { *m = BlockEvents{} }
+func (m *BlockEvents) String() string { return proto.CompactTextString(m) }
+func (*BlockEvents) ProtoMessage()    {}
+func (*BlockEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a125da1d3142670d, []int{1}
+}
+func (m *BlockEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockEvents.Merge(m, src)
+}
+func (m *BlockEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockEvents proto.InternalMessageInfo
+
+func (m *BlockEvents) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockEvents) GetEvents() []TypedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// TypedEvent is a decoded typed event along with the transaction that emitted it.
+type TypedEvent struct {
+	// type is the full proto name of the event
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// tx_hash is the hex encoded hash of the transaction that emitted the event, empty for begin and end block events
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// event is the decoded typed event
+	Event *types.Any `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (m *TypedEvent) Reset()         { *m = TypedEvent{} }
+func (m *TypedEvent) String() string { return proto.CompactTextString(m) }
+func (*TypedEvent) ProtoMessage()    {}
+func (*TypedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a125da1d3142670d, []int{2}
+}
+func (m *TypedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedEvent.Merge(m, src)
+}
+func (m *TypedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedEvent proto.InternalMessageInfo
+
+func (m *TypedEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TypedEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TypedEvent) GetEvent() *types.Any {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "provenance.eventstream.v1.SubscribeRequest")
+	proto.RegisterType((*BlockEvents)(nil), "provenance.eventstream.v1.BlockEvents")
+	proto.RegisterType((*TypedEvent)(nil), "provenance.eventstream.v1.TypedEvent")
+}
+
+func init() {
+	proto.RegisterFile("provenance/eventstream/v1/eventstream.proto", fileDescriptor_a125da1d3142670d)
+}
+
+var fileDescriptor_a125da1d3142670d = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x4e, 0xc2, 0x40,
+	0x14, 0xec, 0x02, 0x96, 0xf0, 0x7a, 0x31, 0x1b, 0xa2, 0x85, 0x98, 0x42, 0x48, 0x34, 0x44, 0xe2,
+	0x56, 0xf0, 0xe2, 0x55, 0x8c, 0x89, 0x17, 0x13, 0x53, 0x3c, 0x79, 0x21, 0x6d, 0x59, 0xdb, 0x6a,
+	0xe9, 0x42, 0xbb, 0x6d, 0xe0, 0x2f, 0xfc, 0x2c, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x3f, 0x62, 0xba,
+	0x05, 0x69, 0x4c, 0xe0, 0xb6, 0xf3, 0x66, 0x76, 0xde, 0xec, 0x64, 0xa1, 0x33, 0x09, 0x59, 0x42,
+	0x03, 0x33, 0xb0, 0xa9, 0x4e, 0x13, 0x1a, 0xf0, 0x88, 0x87, 0xd4, 0x1c, 0xeb, 0x49, 0x37, 0x0f,
+	0xc9, 0x24, 0x64, 0x9c, 0xe1, 0xda, 0x4e, 0x4c, 0xf2, 0x6c, 0xd2, 0xad, 0x57, 0x1d, 0xe6, 0x30,
+	0xa1, 0xd2, 0xd3, 0x53, 0x76, 0xa1, 0x5e, 0x73, 0x18, 0x73, 0x7c, 0xaa, 0x0b, 0x64, 0xc5, 0x6f,
+	0xba, 0x19, 0xcc, 0x33, 0xaa, 0xf5, 0x04, 0xc7, 0x83, 0xd8, 0x8a, 0xec, 0xd0, 0xb3, 0xa8, 0x41,
+	0xa7, 0x31, 0x8d, 0x38, 0x56, 0xa1, 0x3c, 0x66, 0xa3, 0xd8, 0xa7, 0x91, 0x8a, 0x9a, 0xc5, 0x76,
+	0xc5, 0xd8, 0x42, 0xdc, 0x00, 0x45, 0x2c, 0x1c, 0xf2, 0xf9, 0x84, 0x46, 0x6a, 0x41, 0xb0, 0x20,
+	0x46, 0x2f, 0xe9, 0xa4, 0xf5, 0x0e, 0x4a, 0xdf, 0x67, 0xf6, 0xc7, 0x83, 0x88, 0x85, 0x4f, 0x40,
+	0x76, 0xa9, 0xe7, 0xb8, 0x5c, 0x45, 0x4d, 0xd4, 0x2e, 0x1a, 0x1b, 0x84, 0xef, 0x41, 0xce, 0x82,
+	0x0b, 0x0b, 0xa5, 0x77, 0x4e, 0xf6, 0x3e, 0x89, 0xa4, 0xc6, 0x23, 0xe1, 0xd7, 0x2f, 0x2d, 0xbe,
+	0x1b, 0x92, 0xb1, 0xb9, 0xda, 0xa2, 0x00, 0x3b, 0x0e, 0x63, 0x28, 0xa5, 0xa1, 0xc4, 0xa2, 0x8a,
+	0x21, 0xce, 0xf8, 0x14, 0xca, 0x7c, 0x36, 0x74, 0xcd, 0xc8, 0x55, 0x0b, 0x62, 0x2c, 0xf3, 0xd9,
+	0xa3, 0x19, 0xb9, 0xf8, 0x12, 0x8e, 0x84, 0x89, 0x5a, 0x6c, 0xa2, 0xb6, 0xd2, 0xab, 0x92, 0xac,
+	0x20, 0xb2, 0x2d, 0x88, 0xdc, 0x05, 0x73, 0x23, 0x93, 0xf4, 0xa6, 0xa0, 0x88, 0x0d, 0x03, 0x91,
+	0x08, 0x5b, 0x50, 0xf9, 0x2b, 0x0c, 0x77, 0x0e, 0xe4, 0xfe, 0x5f, 0x6b, 0xfd, 0xe2, 0x80, 0x38,
+	0x57, 0xda, 0x35, 0xea, 0x87, 0x8b, 0x95, 0x86, 0x96, 0x2b, 0x0d, 0xfd, 0xac, 0x34, 0xf4, 0xb9,
+	0xd6, 0xa4, 0xe5, 0x5a, 0x93, 0xbe, 0xd6, 0x9a, 0x04, 0x67, 0x1e, 0xdb, 0xef, 0xf2, 0x8c, 0x5e,
+	0x6f, 0x1d, 0x8f, 0xbb, 0xb1, 0x45, 0x6c, 0x36, 0xd6, 0x77, 0xba, 0x2b, 0x8f, 0xe5, 0x90, 0xee,
+	0x05, 0x9c, 0x86, 0x81, 0xe9, 0xe7, 0xbf, 0x96, 0x25, 0x8b, 0xb7, 0xdf, 0xfc, 0x06, 0x00, 0x00,
+	0xff, 0xff, 0x5c, 0xb8, 0x20, 0x8c, 0x8a, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventStreamClient is the client API for EventStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventStreamClient interface {
+	// Subscribe streams the provenance typed events of every block committed after the subscription starts.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error)
+}
+
+type eventStreamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewEventStreamClient(cc grpc1.ClientConn) EventStreamClient {
+	return &eventStreamClient{cc}
+}
+
+func (c *eventStreamClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventStream_serviceDesc.Streams[0], "/provenance.eventstream.v1.EventStream/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventStreamSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventStream_SubscribeClient interface {
+	Recv() (*BlockEvents, error)
+	grpc.ClientStream
+}
+
+type eventStreamSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventStreamSubscribeClient) Recv() (*BlockEvents, error) {
+	m := new(BlockEvents)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventStreamServer is the server API for EventStream service.
+type EventStreamServer interface {
+	// Subscribe streams the provenance typed events of every block committed after the subscription starts.
+	Subscribe(*SubscribeRequest, EventStream_SubscribeServer) error
+}
+
+// UnimplementedEventStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedEventStreamServer struct {
+}
+
+func (*UnimplementedEventStreamServer) Subscribe(req *SubscribeRequest, srv EventStream_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterEventStreamServer(s grpc1.Server, srv EventStreamServer) {
+	s.RegisterService(&_EventStream_serviceDesc, srv)
+}
+
+func _EventStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventStreamServer).Subscribe(m, &eventStreamSubscribeServer{stream})
+}
+
+type EventStream_SubscribeServer interface {
+	Send(*BlockEvents) error
+	grpc.ServerStream
+}
+
+type eventStreamSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventStreamSubscribeServer) Send(m *BlockEvents) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EventStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.eventstream.v1.EventStream",
+	HandlerType: (*EventStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _EventStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "provenance/eventstream/v1/eventstream.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintEventstream(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Modules[iNdEx])
+			copy(dAtA[i:], m.Modules[iNdEx])
+			i = encodeVarintEventstream(dAtA, i, uint64(len(m.Modules[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventstream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintEventstream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TypedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEventstream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEventstream(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEventstream(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventstream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventstream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for _, s := range m.Modules {
+			l = len(s)
+			n += 1 + l + sovEventstream(uint64(l))
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEventstream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEventstream(uint64(m.Height))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEventstream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TypedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEventstream(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEventstream(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovEventstream(uint64(l))
+	}
+	return n
+}
+
+func sovEventstream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventstream(x uint64) (n int) {
+	return sovEventstream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, TypedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TypedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventstream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventstream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventstream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventstream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventstream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventstream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventstream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventstream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventstream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,143 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// testStream is an EventStream_SubscribeServer that hands each sent block to the test.
+type testStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks chan *BlockEvents
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+func (s *testStream) Send(block *BlockEvents) error {
+	s.blocks <- block
+	return nil
+}
+
+func typedEvent(t *testing.T, msg proto.Message) abci.Event {
+	event, err := sdk.TypedEventToEvent(msg)
+	require.NoError(t, err)
+	return abci.Event(event)
+}
+
+func TestNewStreamer(t *testing.T) {
+	v := viper.New()
+	require.Nil(t, NewStreamer(v), "disabled by default")
+
+	v.Set(FlagEnable, true)
+	streamer := NewStreamer(v)
+	require.NotNil(t, streamer)
+	require.Equal(t, DefaultBufferSize, streamer.bufferSize)
+
+	v.Set(FlagBufferSize, 5)
+	require.Equal(t, 5, NewStreamer(v).bufferSize)
+}
+
+func TestSubscribe(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	v.Set(FlagBufferSize, 1)
+	streamer := NewStreamer(v)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscribe := func(req *SubscribeRequest) (*testStream, chan error) {
+		stream := &testStream{ctx: ctx, blocks: make(chan *BlockEvents)}
+		done := make(chan error, 1)
+		go func() { done <- streamer.Subscribe(req, stream) }()
+		return stream, done
+	}
+	waitForSubscribers := func(count int) {
+		require.Eventually(t, func() bool {
+			streamer.mtx.Lock()
+			defer streamer.mtx.Unlock()
+			return len(streamer.subscribers) == count
+		}, time.Second, time.Millisecond)
+	}
+
+	_, done := subscribe(&SubscribeRequest{Modules: []string{"bank"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(<-done))
+
+	all, _ := subscribe(&SubscribeRequest{})
+	markers, _ := subscribe(&SubscribeRequest{Modules: []string{"marker"}})
+	binds, _ := subscribe(&SubscribeRequest{EventTypes: []string{"provenance.name.v1.EventNameBound"}})
+	waitForSubscribers(3)
+
+	markerAdd := typedEvent(t, markertypes.NewEventMarkerAdd("hotdog", "100", "proposed", "manager", "MARKER_TYPE_COIN"))
+	nameBound := typedEvent(t, nametypes.NewEventNameBound("address", "example.pb"))
+	nameUnbound := typedEvent(t, nametypes.NewEventNameUnbound("address", "example.pb"))
+	other := abci.Event{Type: "transfer"}
+
+	streamer.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 7}}, abci.ResponseBeginBlock{Events: []abci.Event{other}})
+	streamer.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("tx1")}, abci.ResponseDeliverTx{Events: []abci.Event{markerAdd, other, nameBound}})
+	streamer.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("tx2")}, abci.ResponseDeliverTx{Code: 1, Events: []abci.Event{markerAdd}})
+	streamer.ListenEndBlock(abci.RequestEndBlock{}, abci.ResponseEndBlock{Events: []abci.Event{nameUnbound}})
+	streamer.ListenCommit()
+
+	block := <-all.blocks
+	require.Equal(t, int64(7), block.Height)
+	require.Len(t, block.Events, 3)
+	require.Equal(t, "provenance.marker.v1.EventMarkerAdd", block.Events[0].Type)
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum([]byte("tx1"))), block.Events[0].TxHash)
+	require.Equal(t, block.Events[0].TxHash, block.Events[1].TxHash)
+	require.Equal(t, "provenance.name.v1.EventNameUnbound", block.Events[2].Type)
+	require.Empty(t, block.Events[2].TxHash, "end block events have no tx")
+
+	var decoded markertypes.EventMarkerAdd
+	require.NoError(t, decoded.Unmarshal(block.Events[0].Event.Value))
+	require.Equal(t, "hotdog", decoded.Denom)
+
+	block = <-markers.blocks
+	require.Len(t, block.Events, 1)
+	require.Equal(t, "provenance.marker.v1.EventMarkerAdd", block.Events[0].Type)
+
+	block = <-binds.blocks
+	require.Len(t, block.Events, 1)
+	require.Equal(t, "provenance.name.v1.EventNameBound", block.Events[0].Type)
+
+	cancel()
+	waitForSubscribers(0)
+}
+
+func TestSlowSubscriberDropped(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	v.Set(FlagBufferSize, 1)
+	streamer := NewStreamer(v)
+
+	sub := &subscriber{
+		filter:  func(TypedEvent) bool { return true },
+		blocks:  make(chan *BlockEvents, 1),
+		dropped: make(chan struct{}),
+	}
+	streamer.subscribers[sub] = struct{}{}
+
+	streamer.ListenCommit()
+	require.Len(t, streamer.subscribers, 1, "first block is buffered")
+	streamer.ListenCommit()
+	require.Empty(t, streamer.subscribers, "second block overflows the buffer")
+	_, open := <-sub.dropped
+	require.False(t, open)
+}
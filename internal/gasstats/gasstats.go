@@ -0,0 +1,181 @@
+package gasstats
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+const (
+	// FlagEnable is the app.toml setting that opts a node into tracking the gas used by each module.
+	FlagEnable = "gas-stats.enable"
+	// FlagBlocks is the app.toml setting with the number of recent blocks whose gas usage is kept in memory.
+	FlagBlocks = "gas-stats.blocks"
+
+	// DefaultBlocks is the number of blocks kept when no number of blocks is configured.
+	DefaultBlocks = 100
+)
+
+// Tracker keeps the gas used by the msgs of each module in a ring buffer of the most recent blocks.
+type Tracker struct {
+	txDecoder sdk.TxDecoder
+
+	mtx     sync.Mutex
+	height  int64
+	pending map[string]*ModuleGas
+	// blocks is the ring buffer of committed blocks, next is the index the next committed block is written to.
+	blocks []blockGas
+	next   int
+	filled int
+}
+
+// blockGas is the gas used by each module in a committed block.
+type blockGas struct {
+	height  int64
+	modules map[string]*ModuleGas
+}
+
+var _ NodeServer = &Tracker{}
+
+// NewTracker returns a new Tracker when gas stats have been enabled in the app options, otherwise nil.
+func NewTracker(appOpts servertypes.AppOptions, txDecoder sdk.TxDecoder) *Tracker {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil
+	}
+	blocks := cast.ToInt(appOpts.Get(FlagBlocks))
+	if blocks <= 0 {
+		blocks = DefaultBlocks
+	}
+	return &Tracker{
+		txDecoder: txDecoder,
+		pending:   make(map[string]*ModuleGas),
+		blocks:    make([]blockGas, blocks),
+	}
+}
+
+// ListenBeginBlock starts the gas totals of a new block.
+func (t *Tracker) ListenBeginBlock(req abci.RequestBeginBlock) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.height = req.Header.Height
+	t.pending = make(map[string]*ModuleGas)
+}
+
+// ListenDeliverTx adds the gas used by a transaction to the modules of its msgs.  The gas is split evenly between the
+// msgs, with any remainder going to the first msg.  Failed transactions are included since their gas was still used.
+func (t *Tracker) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	if res.GasUsed <= 0 {
+		return
+	}
+	tx, err := t.txDecoder(req.Tx)
+	if err != nil {
+		return
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return
+	}
+	gasUsed := uint64(res.GasUsed)
+	share := gasUsed / uint64(len(msgs))
+	remainder := gasUsed % uint64(len(msgs))
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for i, msg := range msgs {
+		gas := share
+		if i == 0 {
+			gas += remainder
+		}
+		module := MsgModule(msg)
+		total, found := t.pending[module]
+		if !found {
+			total = &ModuleGas{Module: module}
+			t.pending[module] = total
+		}
+		total.GasUsed += gas
+		total.MsgCount++
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "gas_used"},
+			float32(gas),
+			[]metrics.Label{telemetry.NewLabel("module", module)},
+		)
+	}
+}
+
+// ListenCommit adds the gas totals of the committed block to the ring buffer, replacing the oldest block once full.
+func (t *Tracker) ListenCommit() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.blocks[t.next] = blockGas{height: t.height, modules: t.pending}
+	t.next = (t.next + 1) % len(t.blocks)
+	if t.filled < len(t.blocks) {
+		t.filled++
+	}
+	t.pending = make(map[string]*ModuleGas)
+}
+
+// GasByModule implements the Node/GasByModule RPC method.
+func (t *Tracker) GasByModule(_ context.Context, req *QueryGasByModuleRequest) (*QueryGasByModuleResponse, error) {
+	if t == nil {
+		return nil, status.Errorf(codes.Unavailable, "gas stats are not enabled, see %s in app.toml", FlagEnable)
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	count := t.filled
+	if req.LastNBlocks > 0 && int(req.LastNBlocks) < count {
+		count = int(req.LastNBlocks)
+	}
+	res := &QueryGasByModuleResponse{Blocks: uint32(count), Modules: []ModuleGas{}}
+	totals := make(map[string]*ModuleGas)
+	// Walk back from the most recently committed block.
+	for i := 1; i <= count; i++ {
+		block := t.blocks[(t.next-i+len(t.blocks))%len(t.blocks)]
+		if i == 1 {
+			res.ToHeight = block.height
+		}
+		res.FromHeight = block.height
+		for module, gas := range block.modules {
+			total, found := totals[module]
+			if !found {
+				total = &ModuleGas{Module: module}
+				totals[module] = total
+			}
+			total.GasUsed += gas.GasUsed
+			total.MsgCount += gas.MsgCount
+		}
+	}
+	for _, total := range totals {
+		res.Modules = append(res.Modules, *total)
+	}
+	sort.Slice(res.Modules, func(i, j int) bool { return res.Modules[i].Module < res.Modules[j].Module })
+	return res, nil
+}
+
+// MsgModule returns the name of the module that handles the msg.  This is the route of legacy msgs, otherwise the
+// second part of the msg's proto package, e.g. bank for /cosmos.bank.v1beta1.MsgSend
+func MsgModule(msg sdk.Msg) string {
+	if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok && len(legacyMsg.Route()) > 0 {
+		return legacyMsg.Route()
+	}
+	parts := strings.Split(strings.TrimPrefix(sdk.MsgTypeURL(msg), "/"), ".")
+	if len(parts) < 2 {
+		return "unknown"
+	}
+	return parts[1]
+}
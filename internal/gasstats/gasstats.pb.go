@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/gasstats/v1/gasstats.proto
+
+package gasstats
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryGasByModuleRequest is the request type for the Node/GasByModule RPC method.
+type QueryGasByModuleRequest struct {
+	// last_n_blocks limits the totals to the given number of most recent blocks, all buffered blocks when zero
+	LastNBlocks uint32 `protobuf:"varint,1,opt,name=last_n_blocks,json=lastNBlocks,proto3" json:"last_n_blocks,omitempty"`
+}
+
+func (m *QueryGasByModuleRequest) Reset()         { *m = QueryGasByModuleRequest{} }
+func (m *QueryGasByModuleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasByModuleRequest) ProtoMessage()    {}
+func (*QueryGasByModuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31bbcd30b6a0f1d3, []int{0}
+}
+func (m *QueryGasByModuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasByModuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasByModuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasByModuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasByModuleRequest.Merge(m, src)
+}
+func (m *QueryGasByModuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasByModuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasByModuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasByModuleRequest proto.InternalMessageInfo
+
+func (m *QueryGasByModuleRequest) GetLastNBlocks() uint32 {
+	if m != nil {
+		return m.LastNBlocks
+	}
+	return 0
+}
+
+// QueryGasByModuleResponse is the response type for the Node/GasByModule RPC method.
+type QueryGasByModuleResponse struct {
+	// from_height is the height of the first block included in the totals
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the height of the last block included in the totals
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// blocks is the number of blocks included in the totals
+	Blocks uint32 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// modules are the gas totals of each module with msgs in the blocks, ordered by module name
+	Modules []ModuleGas `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules"`
+}
+
+func (m *QueryGasByModuleResponse) Reset()         { *m = QueryGasByModuleResponse{} }
+func (m *QueryGasByModuleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasByModuleResponse) ProtoMessage()    {}
+func (*QueryGasByModuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31bbcd30b6a0f1d3, []int{1}
+}
+func (m *QueryGasByModuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasByModuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasByModuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasByModuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasByModuleResponse.Merge(m, src)
+}
+func (m *QueryGasByModuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasByModuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasByModuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasByModuleResponse proto.InternalMessageInfo
+
+func (m *QueryGasByModuleResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryGasByModuleResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryGasByModuleResponse) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryGasByModuleResponse) GetModules() []ModuleGas {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+// ModuleGas is the gas used by the msgs of a module.
+type ModuleGas struct {
+	// module is the name of the module that handles the msgs, e.g. marker
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// gas_used is the gas used by the transactions of the msgs, split evenly between the msgs of each transaction
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// msg_count is the number of msgs
+	MsgCount uint64 `protobuf:"varint,3,opt,name=msg_count,json=msgCount,proto3" json:"msg_count,omitempty"`
+}
+
+func (m *ModuleGas) Reset()         { *m = ModuleGas{} }
+func (m *ModuleGas) String() string { return proto.CompactTextString(m) }
+func (*ModuleGas) ProtoMessage()    {}
+func (*ModuleGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31bbcd30b6a0f1d3, []int{2}
+}
+func (m *ModuleGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleGas.Merge(m, src)
+}
+func (m *ModuleGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleGas proto.InternalMessageInfo
+
+func (m *ModuleGas) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleGas) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ModuleGas) GetMsgCount() uint64 {
+	if m != nil {
+		return m.MsgCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryGasByModuleRequest)(nil), "provenance.gasstats.v1.QueryGasByModuleRequest")
+	proto.RegisterType((*QueryGasByModuleResponse)(nil), "provenance.gasstats.v1.QueryGasByModuleResponse")
+	proto.RegisterType((*ModuleGas)(nil), "provenance.gasstats.v1.ModuleGas")
+}
+
+func init() {
+	proto.RegisterFile("provenance/gasstats/v1/gasstats.proto", fileDescriptor_31bbcd30b6a0f1d3)
+}
+
+var fileDescriptor_31bbcd30b6a0f1d3 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0x6d, 0xa5, 0xe1, 0x67, 0x1a, 0x36, 0x13, 0x83, 0x80, 0x49, 0xc1, 0x26, 0x26, 0x6c, 0x6c,
+	0x05, 0xe3, 0xd2, 0x85, 0xb8, 0xd0, 0x8d, 0x44, 0x9b, 0xb8, 0xd1, 0x45, 0x33, 0xb4, 0xe3, 0xd0,
+	0xd8, 0xf6, 0xd6, 0xde, 0x29, 0x91, 0xb7, 0xf0, 0x5d, 0x7c, 0x09, 0x96, 0x2c, 0x5d, 0x19, 0x03,
+	0x2f, 0x62, 0x3a, 0x2d, 0x85, 0xc4, 0x8f, 0xe4, 0xdb, 0xcd, 0x3d, 0x3f, 0x3d, 0xa7, 0x37, 0x97,
+	0x3c, 0xcd, 0x72, 0xd8, 0xf2, 0x94, 0xa5, 0x01, 0x77, 0x05, 0x43, 0x94, 0x4c, 0xa2, 0xbb, 0x9d,
+	0x37, 0x6f, 0x27, 0xcb, 0x41, 0x02, 0x1d, 0x5c, 0x64, 0x4e, 0x43, 0x6d, 0xe7, 0xe3, 0x87, 0x02,
+	0x04, 0x28, 0x89, 0x5b, 0xbe, 0x2a, 0xb5, 0xfd, 0x8a, 0x3c, 0xfa, 0x58, 0xf0, 0x7c, 0xf7, 0x96,
+	0xe1, 0x72, 0xf7, 0x1e, 0xc2, 0x22, 0xe6, 0x1e, 0xff, 0x5e, 0x70, 0x94, 0xd4, 0x26, 0xfd, 0x98,
+	0xa1, 0xf4, 0x53, 0x7f, 0x1d, 0x43, 0xf0, 0x0d, 0x87, 0xfa, 0x54, 0x9f, 0xf5, 0x3d, 0xb3, 0x04,
+	0x57, 0x4b, 0x05, 0xd9, 0xbf, 0x74, 0x32, 0xfc, 0xdf, 0x8f, 0x19, 0xa4, 0xc8, 0xe9, 0x84, 0x98,
+	0x5f, 0x73, 0x48, 0xfc, 0x0d, 0x8f, 0xc4, 0x46, 0x2a, 0x7b, 0xcb, 0x23, 0x25, 0xf4, 0x4e, 0x21,
+	0xf4, 0x31, 0xe9, 0x49, 0x38, 0xd3, 0x0f, 0x14, 0xdd, 0x95, 0x50, 0x93, 0x03, 0xd2, 0xae, 0x73,
+	0x5b, 0x2a, 0xb7, 0x9e, 0xe8, 0x6b, 0xd2, 0x49, 0x54, 0x0e, 0x0e, 0x8d, 0x69, 0x6b, 0x66, 0x2e,
+	0x9e, 0x38, 0x77, 0xff, 0xb1, 0x53, 0xd5, 0x29, 0x9b, 0x19, 0xfb, 0x3f, 0x13, 0xcd, 0x3b, 0xfb,
+	0xec, 0x2f, 0xa4, 0xd7, 0x70, 0x65, 0x4e, 0x85, 0xab, 0x82, 0x3d, 0xaf, 0x9e, 0xe8, 0x88, 0x74,
+	0x05, 0x43, 0xbf, 0x40, 0x1e, 0xaa, 0x6e, 0x86, 0xd7, 0x11, 0x0c, 0x3f, 0x21, 0x0f, 0xcb, 0xde,
+	0x09, 0x0a, 0x3f, 0x80, 0x22, 0x95, 0xaa, 0x9d, 0xe1, 0x75, 0x13, 0x14, 0x6f, 0xca, 0x79, 0xf1,
+	0x83, 0x18, 0x2b, 0x08, 0x39, 0xcd, 0x88, 0x79, 0xb5, 0x14, 0xea, 0xde, 0x6a, 0x79, 0x63, 0xfd,
+	0xe3, 0xe7, 0xf7, 0x37, 0x54, 0xfb, 0x5e, 0xc6, 0xfb, 0xa3, 0xa5, 0x1f, 0x8e, 0x96, 0xfe, 0xf7,
+	0x68, 0xe9, 0x3f, 0x4f, 0x96, 0x76, 0x38, 0x59, 0xda, 0xef, 0x93, 0xa5, 0x91, 0x51, 0x04, 0x37,
+	0xbe, 0xf6, 0x41, 0xff, 0xfc, 0x52, 0x44, 0x72, 0x53, 0xac, 0x9d, 0x00, 0x12, 0xf7, 0x22, 0x7a,
+	0x16, 0xc1, 0xd5, 0xe4, 0x46, 0xa9, 0xe4, 0x79, 0xca, 0xe2, 0xe6, 0xda, 0xd6, 0x6d, 0x75, 0x40,
+	0x2f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xbc, 0xf9, 0xb4, 0x97, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	// GasByModule returns the gas used by the msgs of each module over the most recent blocks buffered by the node.
+	GasByModule(ctx context.Context, in *QueryGasByModuleRequest, opts ...grpc.CallOption) (*QueryGasByModuleResponse, error)
+}
+
+type nodeClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewNodeClient(cc grpc1.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) GasByModule(ctx context.Context, in *QueryGasByModuleRequest, opts ...grpc.CallOption) (*QueryGasByModuleResponse, error) {
+	out := new(QueryGasByModuleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.gasstats.v1.Node/GasByModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	// GasByModule returns the gas used by the msgs of each module over the most recent blocks buffered by the node.
+	GasByModule(context.Context, *QueryGasByModuleRequest) (*QueryGasByModuleResponse, error)
+}
+
+// UnimplementedNodeServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeServer struct {
+}
+
+func (*UnimplementedNodeServer) GasByModule(ctx context.Context, req *QueryGasByModuleRequest) (*QueryGasByModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasByModule not implemented")
+}
+
+func RegisterNodeServer(s grpc1.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_GasByModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasByModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GasByModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.gasstats.v1.Node/GasByModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GasByModule(ctx, req.(*QueryGasByModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.gasstats.v1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GasByModule",
+			Handler:    _Node_GasByModule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/gasstats/v1/gasstats.proto",
+}
+
+func (m *QueryGasByModuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasByModuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasByModuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastNBlocks != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.LastNBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasByModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasByModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasByModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasstats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgCount != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.MsgCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintGasstats(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGasstats(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasstats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasstats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGasByModuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastNBlocks != 0 {
+		n += 1 + sovGasstats(uint64(m.LastNBlocks))
+	}
+	return n
+}
+
+func (m *QueryGasByModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovGasstats(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovGasstats(uint64(m.ToHeight))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovGasstats(uint64(m.Blocks))
+	}
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovGasstats(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGasstats(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGasstats(uint64(m.GasUsed))
+	}
+	if m.MsgCount != 0 {
+		n += 1 + sovGasstats(uint64(m.MsgCount))
+	}
+	return n
+}
+
+func sovGasstats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasstats(x uint64) (n int) {
+	return sovGasstats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGasByModuleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasstats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasByModuleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasByModuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNBlocks", wireType)
+			}
+			m.LastNBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasstats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasByModuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasstats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasByModuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasByModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, ModuleGas{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasstats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasstats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCount", wireType)
+			}
+			m.MsgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasstats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasstats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGasstats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGasstats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasstats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGasstats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGasstats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGasstats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGasstats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGasstats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGasstats = fmt.Errorf("proto: unexpected end of group")
+)
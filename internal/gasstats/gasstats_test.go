@@ -0,0 +1,121 @@
+package gasstats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func testTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	markertypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+func encodeTx(t *testing.T, txConfig client.TxConfig, msgs ...sdk.Msg) []byte {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func TestNewTracker(t *testing.T) {
+	txConfig := testTxConfig()
+	v := viper.New()
+	require.Nil(t, NewTracker(v, txConfig.TxDecoder()), "disabled by default")
+
+	v.Set(FlagEnable, true)
+	tracker := NewTracker(v, txConfig.TxDecoder())
+	require.NotNil(t, tracker)
+	require.Len(t, tracker.blocks, DefaultBlocks)
+
+	v.Set(FlagBlocks, 5)
+	require.Len(t, NewTracker(v, txConfig.TxDecoder()).blocks, 5)
+}
+
+func TestGasByModule(t *testing.T) {
+	txConfig := testTxConfig()
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	v.Set(FlagBlocks, 2)
+	tracker := NewTracker(v, txConfig.TxDecoder())
+
+	addr := sdk.AccAddress("address_____________")
+	coin := sdk.NewInt64Coin("testcoin", 1)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(coin))
+	transfer := markertypes.NewMsgTransferRequest(addr, addr, addr, coin)
+	withdraw := markertypes.NewMsgWithdrawRequest(addr, addr, coin.Denom, sdk.NewCoins(coin))
+
+	block := func(height int64, txs ...abci.RequestDeliverTx) {
+		tracker.ListenBeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		for i, tx := range txs {
+			tracker.ListenDeliverTx(tx, abci.ResponseDeliverTx{GasUsed: int64(1000 * (i + 1))})
+		}
+		tracker.ListenCommit()
+	}
+	gasByModule := func(lastNBlocks uint32) *QueryGasByModuleResponse {
+		res, err := tracker.GasByModule(context.Background(), &QueryGasByModuleRequest{LastNBlocks: lastNBlocks})
+		require.NoError(t, err)
+		return res
+	}
+
+	require.Equal(t, &QueryGasByModuleResponse{Modules: []ModuleGas{}}, gasByModule(0), "no blocks")
+
+	block(1, abci.RequestDeliverTx{Tx: encodeTx(t, txConfig, send)})
+	block(2,
+		abci.RequestDeliverTx{Tx: encodeTx(t, txConfig, transfer, withdraw, send)},
+		abci.RequestDeliverTx{Tx: []byte("not a tx")},
+	)
+	require.Equal(t, &QueryGasByModuleResponse{
+		FromHeight: 1,
+		ToHeight:   2,
+		Blocks:     2,
+		Modules: []ModuleGas{
+			{Module: "bank", GasUsed: 1333, MsgCount: 2},
+			{Module: "marker", GasUsed: 667, MsgCount: 2},
+		},
+	}, gasByModule(0), "gas split evenly between msgs with the remainder to the first")
+	require.Equal(t, &QueryGasByModuleResponse{
+		FromHeight: 2,
+		ToHeight:   2,
+		Blocks:     1,
+		Modules: []ModuleGas{
+			{Module: "bank", GasUsed: 333, MsgCount: 1},
+			{Module: "marker", GasUsed: 667, MsgCount: 2},
+		},
+	}, gasByModule(1), "last block")
+
+	block(3, abci.RequestDeliverTx{Tx: encodeTx(t, txConfig, withdraw)})
+	require.Equal(t, &QueryGasByModuleResponse{
+		FromHeight: 2,
+		ToHeight:   3,
+		Blocks:     2,
+		Modules: []ModuleGas{
+			{Module: "bank", GasUsed: 333, MsgCount: 1},
+			{Module: "marker", GasUsed: 1667, MsgCount: 3},
+		},
+	}, gasByModule(10), "oldest block replaced")
+}
+
+func TestGasByModuleDisabled(t *testing.T) {
+	var tracker *Tracker
+	_, err := tracker.GasByModule(context.Background(), &QueryGasByModuleRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,298 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpccore "github.com/tendermint/tendermint/rpc/core"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// FlagEnable is the app.toml setting that opts a node into serving the health and readiness endpoints.
+	FlagEnable = "health.enable"
+	// FlagAddress is the app.toml setting with the address the health endpoints listen on.
+	FlagAddress = "health.address"
+	// FlagMaxBlockAge is the app.toml setting with the age of the latest block after which the node is not ready.
+	FlagMaxBlockAge = "health.max-block-age"
+	// FlagCheckInterval is the app.toml setting with how often the module checks are run against the latest state.
+	FlagCheckInterval = "health.module-check-interval"
+
+	// DefaultAddress is the address the health endpoints listen on when no address is configured.
+	DefaultAddress = "tcp://0.0.0.0:1320"
+	// DefaultMaxBlockAge is the maximum age of the latest block when no maximum is configured.
+	DefaultMaxBlockAge = time.Minute
+	// DefaultCheckInterval is how often the module checks are run when no interval is configured.
+	DefaultCheckInterval = 5 * time.Minute
+
+	// LivenessPath is the path of the endpoint that reports the process is up.
+	LivenessPath = "/health"
+	// ReadinessPath is the path of the endpoint that reports whether the node is ready to serve requests.
+	ReadinessPath = "/ready"
+)
+
+// Check inspects the latest committed state of a module and returns a description of each problem found.
+type Check func() ([]string, error)
+
+// Progress returns the progress of a background task, such as a verification started on boot.
+type Progress func() TaskReport
+
+// Warning returns conditions of the node an operator should confirm, which do not affect its readiness.
+type Warning func() []string
+
+// StatusFunc returns the sync status of the node.
+type StatusFunc func() (NodeStatus, error)
+
+// NodeStatus is the sync status of the node.
+type NodeStatus struct {
+	CatchingUp        bool
+	LatestBlockHeight int64
+	LatestBlockTime   time.Time
+}
+
+// Service reports the health of the node and the results of the module checks.
+type Service struct {
+	address       string
+	maxBlockAge   time.Duration
+	checkInterval time.Duration
+
+	status StatusFunc
+	now    func() time.Time
+
+	names  []string
+	checks map[string]Check
+
+	taskNames []string
+	tasks     map[string]Progress
+
+	warningNames []string
+	warnings     map[string]Warning
+
+	mtx     sync.Mutex
+	checked time.Time
+	results []ModuleReport
+}
+
+// Report is the body of the readiness endpoint.
+type Report struct {
+	Ready             bool           `json:"ready"`
+	CatchingUp        bool           `json:"catching_up"`
+	LatestBlockHeight int64          `json:"latest_block_height"`
+	LatestBlockTime   time.Time      `json:"latest_block_time"`
+	LatestBlockAge    string         `json:"latest_block_age"`
+	Modules           []ModuleReport `json:"modules"`
+	Tasks             []TaskReport   `json:"tasks,omitempty"`
+	Errors            []string       `json:"errors,omitempty"`
+	Warnings          []string       `json:"warnings,omitempty"`
+}
+
+// ModuleReport is the result of the check of a single module.
+type ModuleReport struct {
+	Module    string    `json:"module"`
+	Healthy   bool      `json:"healthy"`
+	Problems  []string  `json:"problems,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// TaskReport is the progress of a single background task.
+type TaskReport struct {
+	Task      string   `json:"task"`
+	Stage     string   `json:"stage,omitempty"`
+	Completed int64    `json:"completed"`
+	Total     int64    `json:"total"`
+	Done      bool     `json:"done"`
+	Problems  []string `json:"problems,omitempty"`
+}
+
+// NewService returns a new Service when the health endpoints have been enabled in the app options, otherwise nil.
+func NewService(appOpts servertypes.AppOptions) *Service {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil
+	}
+	address := cast.ToString(appOpts.Get(FlagAddress))
+	if len(address) == 0 {
+		address = DefaultAddress
+	}
+	maxBlockAge := cast.ToDuration(appOpts.Get(FlagMaxBlockAge))
+	if maxBlockAge <= 0 {
+		maxBlockAge = DefaultMaxBlockAge
+	}
+	checkInterval := cast.ToDuration(appOpts.Get(FlagCheckInterval))
+	if checkInterval <= 0 {
+		checkInterval = DefaultCheckInterval
+	}
+	return &Service{
+		address:       address,
+		maxBlockAge:   maxBlockAge,
+		checkInterval: checkInterval,
+		status:        tendermintStatus,
+		now:           time.Now,
+		checks:        make(map[string]Check),
+		tasks:         make(map[string]Progress),
+		warnings:      make(map[string]Warning),
+	}
+}
+
+// AddCheck adds the check of a module to the readiness report.
+func (s *Service) AddCheck(module string, check Check) {
+	if _, found := s.checks[module]; !found {
+		s.names = append(s.names, module)
+	}
+	s.checks[module] = check
+}
+
+// AddTask adds the progress of a background task to the readiness report.
+func (s *Service) AddTask(task string, progress Progress) {
+	if _, found := s.tasks[task]; !found {
+		s.taskNames = append(s.taskNames, task)
+	}
+	s.tasks[task] = progress
+}
+
+// AddWarning adds conditions of the node an operator should confirm to the readiness report.
+func (s *Service) AddWarning(name string, warning Warning) {
+	if _, found := s.warnings[name]; !found {
+		s.warningNames = append(s.warningNames, name)
+	}
+	s.warnings[name] = warning
+}
+
+// Start listens on the configured address and serves the health endpoints until the process exits.
+func (s *Service) Start(logger log.Logger) error {
+	listener, err := net.Listen("tcp", strings.TrimPrefix(s.address, "tcp://"))
+	if err != nil {
+		return fmt.Errorf("could not start health server: %w", err)
+	}
+	logger.Info("starting health server", "address", s.address)
+	go func() {
+		if err := http.Serve(listener, s.Handler()); err != nil {
+			logger.Error("health server stopped", "err", err)
+		}
+	}()
+	return nil
+}
+
+// Handler returns the http handler of the health endpoints.
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(LivenessPath, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc(ReadinessPath, func(w http.ResponseWriter, _ *http.Request) {
+		report := s.Readiness()
+		code := http.StatusOK
+		if !report.Ready {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	})
+	return mux
+}
+
+// Readiness returns the readiness report of the node. The node is ready when it is not catching up, its latest block
+// is no older than the maximum block age, every module check passed, and no background task found a problem.  Warnings
+// are reported without affecting the readiness.
+func (s *Service) Readiness() Report {
+	report := Report{Ready: true}
+	status, err := s.status()
+	if err != nil {
+		report.Ready = false
+		report.Errors = append(report.Errors, err.Error())
+	} else {
+		age := s.now().Sub(status.LatestBlockTime)
+		report.CatchingUp = status.CatchingUp
+		report.LatestBlockHeight = status.LatestBlockHeight
+		report.LatestBlockTime = status.LatestBlockTime
+		report.LatestBlockAge = age.Round(time.Second).String()
+		if status.CatchingUp {
+			report.Ready = false
+			report.Errors = append(report.Errors, "node is catching up")
+		}
+		if age > s.maxBlockAge {
+			report.Ready = false
+			report.Errors = append(report.Errors, fmt.Sprintf("latest block is older than %s", s.maxBlockAge))
+		}
+	}
+
+	modules, err := s.moduleReports()
+	if err != nil {
+		report.Ready = false
+		report.Errors = append(report.Errors, err.Error())
+	}
+	report.Modules = modules
+	for _, module := range modules {
+		if !module.Healthy {
+			report.Ready = false
+		}
+	}
+	for _, name := range s.taskNames {
+		task := s.tasks[name]()
+		task.Task = name
+		if len(task.Problems) > 0 {
+			report.Ready = false
+		}
+		report.Tasks = append(report.Tasks, task)
+	}
+	for _, name := range s.warningNames {
+		for _, warning := range s.warnings[name]() {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s: %s", name, warning))
+		}
+	}
+	return report
+}
+
+// moduleReports returns the results of the module checks, running them again once the check interval has passed.
+func (s *Service) moduleReports() ([]ModuleReport, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	now := s.now()
+	if s.results != nil && now.Sub(s.checked) < s.checkInterval {
+		return s.results, nil
+	}
+	results := make([]ModuleReport, 0, len(s.names))
+	for _, name := range s.names {
+		problems, err := s.checks[name]()
+		if err != nil {
+			return s.results, fmt.Errorf("could not check module %s: %w", name, err)
+		}
+		results = append(results, ModuleReport{Module: name, Healthy: len(problems) == 0, Problems: problems, CheckedAt: now})
+	}
+	s.checked = now
+	s.results = results
+	return results, nil
+}
+
+// tendermintStatus returns the sync status reported by the tendermint node of this process.
+func tendermintStatus() (status NodeStatus, err error) {
+	defer func() {
+		// The tendermint rpc environment is not set up until the node has started.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("node status is not available: %v", r)
+		}
+	}()
+	res, err := tmrpccore.Status(&tmrpctypes.Context{})
+	if err != nil {
+		return status, err
+	}
+	return NodeStatus{
+		CatchingUp:        res.SyncInfo.CatchingUp,
+		LatestBlockHeight: res.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:   res.SyncInfo.LatestBlockTime,
+	}, nil
+}
+
+// writeJSON writes the value as the JSON body of the response.
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,143 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewService(t *testing.T) {
+	v := viper.New()
+	require.Nil(t, NewService(v), "disabled service")
+
+	v.Set(FlagEnable, true)
+	s := NewService(v)
+	require.NotNil(t, s)
+	require.Equal(t, DefaultAddress, s.address)
+	require.Equal(t, DefaultMaxBlockAge, s.maxBlockAge)
+	require.Equal(t, DefaultCheckInterval, s.checkInterval)
+
+	v.Set(FlagAddress, "tcp://127.0.0.1:9999")
+	v.Set(FlagMaxBlockAge, "30s")
+	v.Set(FlagCheckInterval, "1m")
+	s = NewService(v)
+	require.Equal(t, "tcp://127.0.0.1:9999", s.address)
+	require.Equal(t, 30*time.Second, s.maxBlockAge)
+	require.Equal(t, time.Minute, s.checkInterval)
+}
+
+func TestReadiness(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	s := NewService(v)
+
+	now := time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	status := NodeStatus{LatestBlockHeight: 10, LatestBlockTime: now.Add(-5 * time.Second)}
+	var statusErr error
+	s.status = func() (NodeStatus, error) { return status, statusErr }
+
+	markerRuns := 0
+	var markerProblems []string
+	s.AddCheck("marker", func() ([]string, error) {
+		markerRuns++
+		return markerProblems, nil
+	})
+	s.AddCheck("metadata", func() ([]string, error) { return nil, nil })
+
+	report := s.Readiness()
+	require.True(t, report.Ready, "ready: %v", report.Errors)
+	require.Equal(t, int64(10), report.LatestBlockHeight)
+	require.Equal(t, "5s", report.LatestBlockAge)
+	require.Len(t, report.Modules, 2)
+	require.Equal(t, "marker", report.Modules[0].Module)
+	require.Equal(t, 1, markerRuns)
+
+	// Module results are reused until the check interval has passed.
+	markerProblems = []string{"required-marker-supply: supply mismatch"}
+	report = s.Readiness()
+	require.True(t, report.Ready)
+	require.Equal(t, 1, markerRuns)
+
+	now = now.Add(DefaultCheckInterval)
+	status.LatestBlockTime = now
+	report = s.Readiness()
+	require.False(t, report.Ready)
+	require.Equal(t, 2, markerRuns)
+	require.False(t, report.Modules[0].Healthy)
+	require.Equal(t, markerProblems, report.Modules[0].Problems)
+	require.True(t, report.Modules[1].Healthy)
+
+	markerProblems = nil
+	now = now.Add(DefaultCheckInterval)
+	status.LatestBlockTime = now.Add(-2 * time.Minute)
+	status.CatchingUp = true
+	report = s.Readiness()
+	require.False(t, report.Ready)
+	require.Equal(t, []string{"node is catching up", "latest block is older than 1m0s"}, report.Errors)
+
+	status.CatchingUp = false
+	status.LatestBlockTime = now
+	task := TaskReport{Stage: "store heights", Completed: 10, Total: 100}
+	s.AddTask("archive-verify", func() TaskReport { return task })
+	report = s.Readiness()
+	require.True(t, report.Ready, "ready while the task is running: %v", report.Errors)
+	require.Equal(t, []TaskReport{{Task: "archive-verify", Stage: "store heights", Completed: 10, Total: 100}}, report.Tasks)
+
+	task.Problems = []string{"store height 15 is missing"}
+	report = s.Readiness()
+	require.False(t, report.Ready)
+	require.Equal(t, task.Problems, report.Tasks[0].Problems)
+
+	task.Problems = nil
+	s.AddWarning("unsafe-skip-upgrades", func() []string { return []string{"skipped upgrade"} })
+	report = s.Readiness()
+	require.True(t, report.Ready, "warnings do not affect readiness: %v", report.Errors)
+	require.Equal(t, []string{"unsafe-skip-upgrades: skipped upgrade"}, report.Warnings)
+
+	statusErr = errors.New("node status is not available")
+	report = s.Readiness()
+	require.False(t, report.Ready)
+	require.Equal(t, []string{"node status is not available"}, report.Errors)
+}
+
+func TestHandler(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	s := NewService(v)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	catchingUp := false
+	s.status = func() (NodeStatus, error) {
+		return NodeStatus{CatchingUp: catchingUp, LatestBlockHeight: 3, LatestBlockTime: now}, nil
+	}
+	handler := s.Handler()
+
+	get := func(path string) (int, map[string]interface{}) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return rec.Code, body
+	}
+
+	code, body := get(LivenessPath)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", body["status"])
+
+	code, body = get(ReadinessPath)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, true, body["ready"])
+
+	catchingUp = true
+	code, body = get(ReadinessPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, false, body["ready"])
+	require.Equal(t, true, body["catching_up"])
+}
@@ -0,0 +1,147 @@
+package nodeconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// LayerDir is the directory, within the config directory, holding the TOML fragments that are merged over
+	// config.toml and app.toml in lexical order of their file names.
+	LayerDir = "config.d"
+	// DefaultLayer is the layer reported for settings that are not set in any of the config files.
+	DefaultLayer = "default"
+)
+
+// baseFiles are the config files, in order of precedence, that the fragments in the layer directory are merged over.
+var baseFiles = []string{"config.toml", "app.toml"}
+
+// Layer is a single config file and the settings read from it.
+type Layer struct {
+	// Name identifies the layer by its path relative to the config directory, e.g. "config.d/10-fleet.toml".
+	Name string
+	// Settings are the settings of the file keyed the same way as viper's AllSettings.
+	Settings map[string]interface{}
+}
+
+// Layers are config files in order of precedence, each layer overriding the settings of the layers before it.
+type Layers []Layer
+
+// LoadLayers reads config.toml and app.toml followed by the fragments of the layer directory from the config
+// directory. Files that do not exist are skipped.
+func LoadLayers(configPath string) (Layers, error) {
+	var layers Layers
+	for _, name := range baseFiles {
+		path := filepath.Join(configPath, name)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		layer, err := readLayer(path, name)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer)
+	}
+	fragments, err := LoadFragments(configPath)
+	if err != nil {
+		return nil, err
+	}
+	return append(layers, fragments...), nil
+}
+
+// LoadFragments reads the *.toml fragments of the layer directory in the config directory in lexical order of their
+// file names. It returns no layers when the directory does not exist.
+func LoadFragments(configPath string) (Layers, error) {
+	dir := filepath.Join(configPath, LayerDir)
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", dir, err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".toml" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	layers := make(Layers, 0, len(names))
+	for _, name := range names {
+		layer, err := readLayer(filepath.Join(dir, name), filepath.ToSlash(filepath.Join(LayerDir, name)))
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, layer)
+	}
+	return layers, nil
+}
+
+// readLayer reads the settings of a single TOML config file.
+func readLayer(path, name string) (Layer, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return Layer{}, fmt.Errorf("failed to read in %s: %w", path, err)
+	}
+	return Layer{Name: name, Settings: v.AllSettings()}, nil
+}
+
+// MergeInto merges the settings of each layer, in order, over the settings of the viper instance.
+func (l Layers) MergeInto(v *viper.Viper) error {
+	for _, layer := range l {
+		if err := v.MergeConfigMap(layer.Settings); err != nil {
+			return fmt.Errorf("failed to merge %s: %w", layer.Name, err)
+		}
+	}
+	return nil
+}
+
+// Source returns the name of the last layer that sets the dotted setting, or DefaultLayer if none of them do.
+func (l Layers) Source(key string) string {
+	path := strings.Split(strings.ToLower(key), ".")
+	for i := len(l) - 1; i >= 0; i-- {
+		if isSet(l[i].Settings, path) {
+			return l[i].Name
+		}
+	}
+	return DefaultLayer
+}
+
+// Values returns the merged value of each setting keyed by its dotted name, along with the layer each came from.
+func (l Layers) Values() (values map[string]string, sources map[string]string, err error) {
+	v := viper.New()
+	if err = l.MergeInto(v); err != nil {
+		return nil, nil, err
+	}
+	values = make(map[string]string)
+	if err = flatten(values, "", v.AllSettings()); err != nil {
+		return nil, nil, err
+	}
+	sources = make(map[string]string, len(values))
+	for key := range values {
+		sources[key] = l.Source(key)
+	}
+	return values, sources, nil
+}
+
+// isSet returns true if the nested settings have a value at the path.
+func isSet(settings map[string]interface{}, path []string) bool {
+	value, found := settings[path[0]]
+	if !found {
+		return false
+	}
+	if len(path) == 1 {
+		return true
+	}
+	nested, ok := value.(map[string]interface{})
+	return ok && isSet(nested, path[1:])
+}
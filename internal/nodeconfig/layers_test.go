@@ -0,0 +1,58 @@
+package nodeconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLayers(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	write("config.toml", "moniker = \"node\"\n[p2p]\nseeds = \"\"\n")
+	write("app.toml", "minimum-gas-prices = \"1905nhash\"\n[api]\nenable = false\n")
+
+	layers, err := LoadLayers(dir)
+	require.NoError(t, err)
+	require.Len(t, layers, 2)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, LayerDir), 0o755))
+	write(filepath.Join(LayerDir, "20-node.toml"), "[api]\nenable = true\n")
+	write(filepath.Join(LayerDir, "10-fleet.toml"), "[api]\nenable = false\nswagger = true\n[p2p]\nseeds = \"seed\"\n")
+	write(filepath.Join(LayerDir, "README.md"), "not a fragment")
+
+	layers, err = LoadLayers(dir)
+	require.NoError(t, err)
+	names := make([]string, len(layers))
+	for i, layer := range layers {
+		names[i] = layer.Name
+	}
+	require.Equal(t, []string{"config.toml", "app.toml", "config.d/10-fleet.toml", "config.d/20-node.toml"}, names)
+
+	require.Equal(t, "config.toml", layers.Source("moniker"))
+	require.Equal(t, "app.toml", layers.Source("minimum-gas-prices"))
+	require.Equal(t, "config.d/20-node.toml", layers.Source("api.enable"))
+	require.Equal(t, "config.d/10-fleet.toml", layers.Source("api.swagger"))
+	require.Equal(t, "config.d/10-fleet.toml", layers.Source("p2p.seeds"))
+	require.Equal(t, DefaultLayer, layers.Source("api.address"))
+
+	values, sources, err := layers.Values()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"moniker":            "node",
+		"p2p.seeds":          "seed",
+		"minimum-gas-prices": "1905nhash",
+		"api.enable":         "true",
+		"api.swagger":        "true",
+	}, values)
+	require.Equal(t, "config.d/20-node.toml", sources["api.enable"])
+
+	write(filepath.Join(LayerDir, "30-bad.toml"), "[api\n")
+	_, err = LoadLayers(dir)
+	require.Error(t, err)
+}
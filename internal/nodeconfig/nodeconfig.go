@@ -0,0 +1,113 @@
+package nodeconfig
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	tmrpccore "github.com/tendermint/tendermint/rpc/core"
+	tmrpc "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// Route is the Tendermint RPC route that returns the effective configuration of the node.
+	Route = "node_config"
+
+	// FlagEnable is the app.toml setting that opts a node into serving its configuration over RPC.
+	FlagEnable = "config-rpc.enable"
+	// FlagAuthToken is the app.toml setting with the token callers must provide to read the configuration.
+	FlagAuthToken = "config-rpc.auth-token"
+
+	// redacted replaces the value of settings that must not be returned to callers.
+	redacted = "[redacted]"
+)
+
+// settingsProvider is implemented by the viper instance the server provides as the app options.
+type settingsProvider interface {
+	AllSettings() map[string]interface{}
+}
+
+// ResultNodeConfig is the result of the node_config RPC route.
+type ResultNodeConfig struct {
+	// Config maps each setting (keyed by its dotted name, e.g. "api.enable") to its value.
+	Config map[string]string `json:"config"`
+	// Sources maps each setting to the config file layer it came from, e.g. "app.toml" or "config.d/10-fleet.toml".
+	// Settings not set in any of the files have the default layer. Values overridden by flags or environment
+	// variables are still attributed to the file layer.
+	Sources map[string]string `json:"sources,omitempty"`
+}
+
+// RegisterNodeConfig registers the node_config RPC route when it has been enabled in the app options.
+func RegisterNodeConfig(appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return
+	}
+	handler := NewNodeConfigHandler(appOpts)
+	tmrpccore.Routes[Route] = tmrpc.NewRPCFunc(handler, "token")
+}
+
+// NewNodeConfigHandler returns the RPC function that provides the effective node configuration to callers with the
+// configured auth token.
+func NewNodeConfigHandler(appOpts servertypes.AppOptions) func(*tmrpctypes.Context, string) (*ResultNodeConfig, error) {
+	return func(_ *tmrpctypes.Context, token string) (*ResultNodeConfig, error) {
+		authToken := cast.ToString(appOpts.Get(FlagAuthToken))
+		if len(authToken) == 0 {
+			return nil, errors.New("node config rpc has no auth token configured")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) != 1 {
+			return nil, errors.New("invalid auth token")
+		}
+		provider, ok := appOpts.(settingsProvider)
+		if !ok {
+			return nil, errors.New("node configuration is not available")
+		}
+		config := make(map[string]string)
+		if err := flatten(config, "", provider.AllSettings()); err != nil {
+			return nil, err
+		}
+		config[FlagAuthToken] = redacted
+		result := &ResultNodeConfig{Config: config}
+		if home := cast.ToString(appOpts.Get(flags.FlagHome)); len(home) > 0 {
+			layers, err := LoadLayers(filepath.Join(home, "config"))
+			if err != nil {
+				return nil, err
+			}
+			result.Sources = make(map[string]string, len(config))
+			for key := range config {
+				result.Sources[key] = layers.Source(key)
+			}
+		}
+		return result, nil
+	}
+}
+
+// flatten adds each of the settings to the config map keyed by its dotted name.
+func flatten(config map[string]string, prefix string, settings map[string]interface{}) error {
+	for key, value := range settings {
+		if len(prefix) > 0 {
+			key = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := flatten(config, key, v); err != nil {
+				return err
+			}
+		case string:
+			config[key] = v
+		default:
+			bz, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("could not encode setting %s: %w", key, err)
+			}
+			config[key] = string(bz)
+		}
+	}
+	return nil
+}
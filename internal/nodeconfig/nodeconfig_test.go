@@ -0,0 +1,54 @@
+package nodeconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestNodeConfigHandler(t *testing.T) {
+	v := viper.New()
+	v.Set("minimum-gas-prices", "1905nhash")
+	v.Set("api.enable", true)
+	v.Set("state-sync.snapshot-interval", 1000)
+	v.Set("index-events", []string{"tx.height", "message.sender"})
+	v.Set(FlagEnable, true)
+
+	handler := NewNodeConfigHandler(v)
+
+	_, err := handler(nil, "")
+	require.EqualError(t, err, "node config rpc has no auth token configured")
+
+	v.Set(FlagAuthToken, "secret")
+	_, err = handler(nil, "")
+	require.EqualError(t, err, "invalid auth token")
+	_, err = handler(nil, "not-the-secret")
+	require.EqualError(t, err, "invalid auth token")
+
+	result, err := handler(nil, "secret")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"minimum-gas-prices":           "1905nhash",
+		"api.enable":                   "true",
+		"state-sync.snapshot-interval": "1000",
+		"index-events":                 `["tx.height","message.sender"]`,
+		FlagEnable:                     "true",
+		FlagAuthToken:                  redacted,
+	}, result.Config)
+	require.Nil(t, result.Sources, "sources without a home directory")
+
+	home := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(home, "config", "app.toml"), []byte("minimum-gas-prices = \"1905nhash\"\n"), 0o644))
+	v.Set(flags.FlagHome, home)
+	result, err = handler(nil, "secret")
+	require.NoError(t, err)
+	require.Equal(t, "app.toml", result.Sources["minimum-gas-prices"])
+	require.Equal(t, DefaultLayer, result.Sources["api.enable"])
+}
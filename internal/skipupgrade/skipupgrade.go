@@ -0,0 +1,164 @@
+package skipupgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// FlagNames is the start flag with the name of the upgrade plan skipped at each of the --unsafe-skip-upgrades heights.
+	FlagNames = "unsafe-skip-upgrade-names"
+
+	// MarkerFile is the file in the home dir recording each upgrade the node has been started to skip.
+	MarkerFile = "unsafe-skip-upgrades.json"
+
+	// Warning is the name of the skipped upgrades in the readiness report of the health endpoints.
+	Warning = "unsafe-skip-upgrades"
+)
+
+// AddFlags adds the skip upgrade flags to the start command.
+func AddFlags(startCmd *cobra.Command) {
+	startCmd.Flags().StringSlice(FlagNames, []string{}, "The name of the upgrade plan to skip at each of the --"+
+		server.FlagUnsafeSkipUpgrades+" heights, in the same order, required when skipping upgrades")
+}
+
+// Upgrade is an upgrade plan the node skips.
+type Upgrade struct {
+	Height int64  `json:"height"`
+	Name   string `json:"name"`
+	// Recorded is when the node was first started to skip the upgrade.
+	Recorded time.Time `json:"recorded"`
+}
+
+// FromAppOptions returns the upgrades to skip, pairing each --unsafe-skip-upgrades height with the plan name at the
+// same position of the --unsafe-skip-upgrade-names.  A height without a name is an error so that a mis-typed height
+// cannot skip an unintended upgrade.
+func FromAppOptions(appOpts servertypes.AppOptions) ([]Upgrade, error) {
+	heights := cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades))
+	names := cast.ToStringSlice(appOpts.Get(FlagNames))
+	if len(heights) != len(names) {
+		return nil, fmt.Errorf("--%s requires the plan name of each height in --%s: got %d heights and %d names",
+			server.FlagUnsafeSkipUpgrades, FlagNames, len(heights), len(names))
+	}
+	upgrades := make([]Upgrade, 0, len(heights))
+	seen := make(map[int64]bool)
+	for i, h := range heights {
+		height := int64(h)
+		if height <= 0 {
+			return nil, fmt.Errorf("invalid skip upgrade height %d: must be positive", height)
+		}
+		if len(names[i]) == 0 {
+			return nil, fmt.Errorf("invalid skip upgrade at height %d: plan name cannot be empty", height)
+		}
+		if seen[height] {
+			return nil, fmt.Errorf("invalid skip upgrade at height %d: duplicate height", height)
+		}
+		seen[height] = true
+		upgrades = append(upgrades, Upgrade{Height: height, Name: names[i]})
+	}
+	return upgrades, nil
+}
+
+// Heights returns the heights of the upgrades keyed for the upgrade keeper.
+func Heights(upgrades []Upgrade) map[int64]bool {
+	heights := make(map[int64]bool, len(upgrades))
+	for _, u := range upgrades {
+		heights[u.Height] = true
+	}
+	return heights
+}
+
+// CheckPlan returns an error when the plan is being skipped but its name is not the name given for its height.
+func CheckPlan(upgrades []Upgrade, plan upgradetypes.Plan) error {
+	for _, u := range upgrades {
+		if u.Height == plan.Height && u.Name != plan.Name {
+			return fmt.Errorf("refusing to skip upgrade %q at height %d: the plan name given with --%s is %q",
+				plan.Name, plan.Height, FlagNames, u.Name)
+		}
+	}
+	return nil
+}
+
+// Warn logs a prominent warning for each upgrade that will be skipped.
+func Warn(logger log.Logger, upgrades []Upgrade) {
+	for _, u := range upgrades {
+		logger.Error("UNSAFE: this node will SKIP an upgrade, it will fork from the network if the upgrade is not "+
+			"also skipped by 2/3 of the voting power",
+			"plan", u.Name,
+			"height", u.Height,
+			"flag", server.FlagUnsafeSkipUpgrades,
+		)
+	}
+}
+
+// RecordMarker adds the upgrades to the marker file in the home dir, keeping those recorded by earlier starts.  The
+// file is left for the operator to remove once the skipped upgrades have been confirmed.
+func RecordMarker(home string, upgrades []Upgrade, now time.Time) error {
+	if len(upgrades) == 0 {
+		return nil
+	}
+	recorded, err := ReadMarker(home)
+	if err != nil {
+		return err
+	}
+	for _, u := range upgrades {
+		found := false
+		for _, r := range recorded {
+			if r.Height == u.Height && r.Name == u.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			u.Recorded = now.UTC()
+			recorded = append(recorded, u)
+		}
+	}
+	bz, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(home, MarkerFile), bz, 0644)
+}
+
+// ReadMarker returns the upgrades recorded in the marker file in the home dir, none when there is no file.
+func ReadMarker(home string) ([]Upgrade, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(home, MarkerFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var upgrades []Upgrade
+	if err = json.Unmarshal(bz, &upgrades); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", MarkerFile, err)
+	}
+	return upgrades, nil
+}
+
+// Warnings returns a description of each upgrade recorded in the marker file in the home dir.
+func Warnings(home string) []string {
+	upgrades, err := ReadMarker(home)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	warnings := make([]string, 0, len(upgrades))
+	for _, u := range upgrades {
+		warnings = append(warnings, fmt.Sprintf("node started to skip upgrade %q at height %d on %s",
+			u.Name, u.Height, u.Recorded.Format(time.RFC3339)))
+	}
+	return warnings
+}
@@ -0,0 +1,69 @@
+package skipupgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestFromAppOptions(t *testing.T) {
+	v := viper.New()
+	upgrades, err := FromAppOptions(v)
+	require.NoError(t, err)
+	require.Empty(t, upgrades, "no upgrades skipped")
+
+	v.Set(server.FlagUnsafeSkipUpgrades, []int{100, 200})
+	_, err = FromAppOptions(v)
+	require.EqualError(t, err, "--unsafe-skip-upgrades requires the plan name of each height in --unsafe-skip-upgrade-names: got 2 heights and 0 names")
+
+	v.Set(FlagNames, []string{"citrine", "desert"})
+	upgrades, err = FromAppOptions(v)
+	require.NoError(t, err)
+	require.Equal(t, []Upgrade{{Height: 100, Name: "citrine"}, {Height: 200, Name: "desert"}}, upgrades)
+	require.Equal(t, map[int64]bool{100: true, 200: true}, Heights(upgrades))
+
+	v.Set(FlagNames, []string{"citrine", ""})
+	_, err = FromAppOptions(v)
+	require.EqualError(t, err, "invalid skip upgrade at height 200: plan name cannot be empty")
+
+	v.Set(server.FlagUnsafeSkipUpgrades, []int{100, 100})
+	v.Set(FlagNames, []string{"citrine", "desert"})
+	_, err = FromAppOptions(v)
+	require.EqualError(t, err, "invalid skip upgrade at height 100: duplicate height")
+}
+
+func TestCheckPlan(t *testing.T) {
+	upgrades := []Upgrade{{Height: 100, Name: "citrine"}}
+	require.NoError(t, CheckPlan(upgrades, upgradetypes.Plan{Name: "citrine", Height: 100}))
+	require.NoError(t, CheckPlan(upgrades, upgradetypes.Plan{Name: "desert", Height: 200}), "plan is not skipped")
+	require.EqualError(t, CheckPlan(upgrades, upgradetypes.Plan{Name: "desert", Height: 100}),
+		`refusing to skip upgrade "desert" at height 100: the plan name given with --unsafe-skip-upgrade-names is "citrine"`)
+}
+
+func TestRecordMarker(t *testing.T) {
+	home := t.TempDir()
+	recorded, err := ReadMarker(home)
+	require.NoError(t, err)
+	require.Empty(t, recorded, "no marker file")
+	require.Empty(t, Warnings(home))
+
+	first := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, RecordMarker(home, []Upgrade{{Height: 100, Name: "citrine"}}, first))
+	require.NoError(t, RecordMarker(home, []Upgrade{{Height: 100, Name: "citrine"}, {Height: 200, Name: "desert"}},
+		first.Add(time.Hour)))
+	recorded, err = ReadMarker(home)
+	require.NoError(t, err)
+	require.Equal(t, []Upgrade{
+		{Height: 100, Name: "citrine", Recorded: first},
+		{Height: 200, Name: "desert", Recorded: first.Add(time.Hour)},
+	}, recorded, "first record of each upgrade kept")
+	require.Equal(t, []string{
+		`node started to skip upgrade "citrine" at height 100 on 2030-01-01T00:00:00Z`,
+		`node started to skip upgrade "desert" at height 200 on 2030-01-01T01:00:00Z`,
+	}, Warnings(home))
+}
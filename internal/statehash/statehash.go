@@ -0,0 +1,114 @@
+package statehash
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitInfoKeyFmt is the key of the commit info of each height in the app db, see the rootmulti store.
+const commitInfoKeyFmt = "s/%d"
+
+// ModuleHash is the hash of the contents of a module store at a height.
+type ModuleHash struct {
+	// Store is the name of the module store.
+	Store string
+	// Entries is the number of keys in the store.
+	Entries int64
+	// Hash is the sha256 of every key and value of the store in key order.
+	Hash []byte
+	// CommitHash is the hash of the store that was committed into the app hash.
+	CommitHash []byte
+}
+
+// ModuleHashes returns the hash of the contents of each module store, in order of store name, at the committed height
+// of the app db or at the latest committed height when the height is zero, along with the height used.  The hashes only depend on the keys and
+// values in each store, so the store that differs between two nodes with different app hashes can be found by
+// comparing them.  The app db must not be open in a running node.
+func ModuleHashes(db dbm.DB, height int64) (int64, []ModuleHash, error) {
+	if height < 0 {
+		return 0, nil, fmt.Errorf("invalid height %d", height)
+	}
+	if height == 0 {
+		latest := rootmulti.NewStore(db)
+		if err := latest.LoadLatestVersion(); err != nil {
+			return 0, nil, err
+		}
+		height = latest.LastCommitID().Version
+		if height == 0 {
+			return 0, nil, fmt.Errorf("no committed height found")
+		}
+	}
+
+	info, err := getCommitInfo(db, height)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	ms := rootmulti.NewStore(db)
+	keys := make(map[string]storetypes.StoreKey, len(info.StoreInfos))
+	for _, si := range info.StoreInfos {
+		keys[si.Name] = storetypes.NewKVStoreKey(si.Name)
+		ms.MountStoreWithDB(keys[si.Name], storetypes.StoreTypeIAVL, nil)
+	}
+	if err = ms.LoadLatestVersion(); err != nil {
+		return 0, nil, err
+	}
+	cms, err := ms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return 0, nil, fmt.Errorf("could not load height %d: %w", height, err)
+	}
+
+	hashes := make([]ModuleHash, 0, len(info.StoreInfos))
+	for _, si := range info.StoreInfos {
+		entries, hash := hashStore(cms.GetKVStore(keys[si.Name]))
+		hashes = append(hashes, ModuleHash{
+			Store:      si.Name,
+			Entries:    entries,
+			Hash:       hash,
+			CommitHash: si.CommitId.Hash,
+		})
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i].Store < hashes[j].Store })
+	return height, hashes, nil
+}
+
+// hashStore returns the number of keys in the store and the sha256 of each length prefixed key and value in order.
+func hashStore(store storetypes.KVStore) (int64, []byte) {
+	var entries int64
+	hasher := sha256.New()
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		for _, bz := range [][]byte{iterator.Key(), iterator.Value()} {
+			n := binary.PutUvarint(lenBuf, uint64(len(bz)))
+			hasher.Write(lenBuf[:n])
+			hasher.Write(bz)
+		}
+		entries++
+	}
+	return entries, hasher.Sum(nil)
+}
+
+// getCommitInfo reads the commit info of the height from the app db.
+func getCommitInfo(db dbm.DB, height int64) (*storetypes.CommitInfo, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no commit found for height %d", height)
+	}
+	info := &storetypes.CommitInfo{}
+	if err = info.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("invalid commit info for height %d: %w", height, err)
+	}
+	return info, nil
+}
@@ -0,0 +1,75 @@
+package statehash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitStores writes each set of changes to the acc and bank stores of the app db as a height.
+func commitStores(t *testing.T, db dbm.DB, heights ...map[string]map[string]string) {
+	ms := rootmulti.NewStore(db)
+	keys := map[string]storetypes.StoreKey{}
+	for _, name := range []string{"acc", "bank"} {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		ms.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+	for _, changes := range heights {
+		for name, kvs := range changes {
+			store := ms.GetKVStore(keys[name])
+			for k, v := range kvs {
+				store.Set([]byte(k), []byte(v))
+			}
+		}
+		ms.Commit()
+	}
+}
+
+func TestModuleHashes(t *testing.T) {
+	_, _, err := ModuleHashes(dbm.NewMemDB(), 0)
+	require.EqualError(t, err, "no committed height found")
+
+	db := dbm.NewMemDB()
+	commitStores(t, db,
+		map[string]map[string]string{"acc": {"a": "1", "b": "2"}, "bank": {"c": "3"}},
+		map[string]map[string]string{"bank": {"d": "4"}},
+	)
+
+	height, latest, err := ModuleHashes(db, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+	require.Len(t, latest, 2)
+	require.Equal(t, "acc", latest[0].Store)
+	require.Equal(t, int64(2), latest[0].Entries)
+	require.Equal(t, "bank", latest[1].Store)
+	require.Equal(t, int64(2), latest[1].Entries)
+	require.NotEmpty(t, latest[1].CommitHash)
+
+	height, first, err := ModuleHashes(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, latest[0].Hash, first[0].Hash, "acc store unchanged")
+	require.Equal(t, int64(1), first[1].Entries)
+	require.NotEqual(t, latest[1].Hash, first[1].Hash, "bank store changed")
+
+	// The same contents written in a different order have the same hashes.
+	other := dbm.NewMemDB()
+	commitStores(t, other,
+		map[string]map[string]string{"bank": {"d": "4"}},
+		map[string]map[string]string{"acc": {"b": "2", "a": "1"}, "bank": {"c": "3"}},
+	)
+	_, otherLatest, err := ModuleHashes(other, 2)
+	require.NoError(t, err)
+	require.Equal(t, latest[0].Hash, otherLatest[0].Hash)
+	require.Equal(t, latest[1].Hash, otherLatest[1].Hash)
+
+	_, _, err = ModuleHashes(db, 3)
+	require.EqualError(t, err, "no commit found for height 3")
+	_, _, err = ModuleHashes(db, -1)
+	require.EqualError(t, err, "invalid height -1")
+}
@@ -0,0 +1,115 @@
+package storestream
+
+import (
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitMultiStore is a root multi-store that reports the writes to the streamed stores.
+//
+// The listeners of the SDK's multi-stores are added on top of a cache of each store, so the writes they see are
+// never written to the committed store.  Instead, the branches of this store have a listening store directly on top
+// of each streamed committed store.  The writes of transactions and of the mempool checks stay in their own branches
+// until the block's branch is written when it is committed, so the listener only sees the final changes of each
+// committed block.
+type commitMultiStore struct {
+	*rootmulti.Store
+	db       dbm.DB
+	mounted  []storetypes.StoreKey
+	streamed map[storetypes.StoreKey][]storetypes.WriteListener
+}
+
+var _ storetypes.CommitMultiStore = &commitMultiStore{}
+
+// newCommitMultiStore creates a root multi-store for the given database whose branches report the writes to the
+// streamed stores.
+func newCommitMultiStore(db dbm.DB) *commitMultiStore {
+	return &commitMultiStore{
+		Store:    rootmulti.NewStore(db),
+		db:       db,
+		streamed: make(map[storetypes.StoreKey][]storetypes.WriteListener),
+	}
+}
+
+// MountStoreWithDB implements the CommitMultiStore.MountStoreWithDB method, remembering the key of the store.
+func (s *commitMultiStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	s.Store.MountStoreWithDB(key, typ, db)
+	s.mounted = append(s.mounted, key)
+}
+
+// AddListeners implements the CommitMultiStore.AddListeners method, adding listeners for the writes to a store.
+func (s *commitMultiStore) AddListeners(key storetypes.StoreKey, listeners []storetypes.WriteListener) {
+	s.streamed[key] = append(s.streamed[key], listeners...)
+}
+
+// ListeningEnabled implements the CommitMultiStore.ListeningEnabled method.
+func (s *commitMultiStore) ListeningEnabled(key storetypes.StoreKey) bool {
+	return len(s.streamed[key]) > 0
+}
+
+// CacheMultiStore implements the MultiStore.CacheMultiStore method, branching the multi-store with the writes to the
+// streamed stores reported to their listeners.  Tracing, when enabled, is done by the committed stores.
+func (s *commitMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(s.mounted))
+	keysByName := make(map[string]storetypes.StoreKey, len(s.mounted))
+	for _, key := range s.mounted {
+		store := s.Store.GetKVStore(key)
+		if listeners := s.streamed[key]; len(listeners) > 0 {
+			store = &listenStore{KVStore: store, key: key, listeners: listeners}
+		}
+		stores[key] = store
+		keysByName[key.Name()] = key
+	}
+	return cachemulti.NewStore(s.db, stores, keysByName, nil, nil, nil)
+}
+
+// listenStore is a KV store that reports its writes to listeners.  Unlike the SDK's listening store, it can be
+// branched, which is what the multi-store branches are made of.
+type listenStore struct {
+	storetypes.KVStore
+	key       storetypes.StoreKey
+	listeners []storetypes.WriteListener
+}
+
+var _ storetypes.KVStore = &listenStore{}
+
+// Set implements the KVStore.Set method, reporting the write to the listeners.
+func (s *listenStore) Set(key []byte, value []byte) {
+	s.KVStore.Set(key, value)
+	s.onWrite(key, value, false)
+}
+
+// Delete implements the KVStore.Delete method, reporting the write to the listeners.
+func (s *listenStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.onWrite(key, nil, true)
+}
+
+// CacheWrap implements the CacheWrapper.CacheWrap method.
+func (s *listenStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper.CacheWrapWithTrace method.
+func (s *listenStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements the CacheWrapper.CacheWrapWithListeners method.
+func (s *listenStore) CacheWrapWithListeners(_ storetypes.StoreKey, _ []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *listenStore) onWrite(key []byte, value []byte, delete bool) {
+	for _, l := range s.listeners {
+		//nolint:errcheck // the listeners of the streamed stores do not fail.
+		l.OnWrite(s.key, key, value, delete)
+	}
+}
@@ -0,0 +1,234 @@
+package storestream
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagStores is the app.toml setting with the names of the KV stores, e.g. marker and metadata, whose committed
+	// changes are streamed.  No stores are streamed unless they are opted in here.
+	FlagStores = "store-stream.stores"
+	// FlagFileDir is the app.toml setting with the directory, absolute or relative to the node's home, that the change
+	// set of each committed block is written to.  No files are written when it is empty.
+	FlagFileDir = "store-stream.file-dir"
+	// FlagGRPC is the app.toml setting that opts a node into serving the change sets on its gRPC server.
+	FlagGRPC = "store-stream.grpc"
+	// FlagBufferSize is the app.toml setting with the number of blocks buffered for each gRPC subscriber before a
+	// subscriber that is not keeping up is disconnected.
+	FlagBufferSize = "store-stream.buffer-size"
+
+	// DefaultBufferSize is the number of blocks buffered for each subscriber when no buffer size is configured.
+	DefaultBufferSize = 100
+)
+
+// Streamer listens to the writes to the streamed KV stores and publishes the changes made by each committed block to
+// the configured file directory and the subscribers of the StoreStream service.
+type Streamer struct {
+	stores     []string
+	fileDir    string
+	serve      bool
+	bufferSize int
+	snapshots  bool
+	cms        *commitMultiStore
+
+	mtx         sync.Mutex
+	pending     []storetypes.StoreKVPair
+	subscribers map[*subscriber]struct{}
+}
+
+// subscriber is a single StoreStream/Subscribe call.
+type subscriber struct {
+	stores  map[string]bool
+	blocks  chan *BlockChangeSet
+	dropped chan struct{}
+}
+
+var (
+	_ storetypes.WriteListener = &Streamer{}
+	_ StoreStreamServer        = &Streamer{}
+)
+
+// NewStreamer returns a new Streamer when stores have been opted into streaming and a file directory or the gRPC
+// service has been configured in the app options, otherwise nil.
+func NewStreamer(appOpts servertypes.AppOptions) *Streamer {
+	stores := cast.ToStringSlice(appOpts.Get(FlagStores))
+	fileDir := cast.ToString(appOpts.Get(FlagFileDir))
+	serve := cast.ToBool(appOpts.Get(FlagGRPC))
+	if len(stores) == 0 || (len(fileDir) == 0 && !serve) {
+		return nil
+	}
+	if len(fileDir) > 0 && !filepath.IsAbs(fileDir) {
+		fileDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), fileDir)
+	}
+	bufferSize := cast.ToInt(appOpts.Get(FlagBufferSize))
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Streamer{
+		stores:      stores,
+		fileDir:     fileDir,
+		serve:       serve,
+		bufferSize:  bufferSize,
+		snapshots:   cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)) > 0,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// BaseAppOption returns the option that gives the BaseApp a commit multi-store that reports the writes to the
+// streamed stores.  It must come before the other options so that they configure the store that is used.
+func (s *Streamer) BaseAppOption(db dbm.DB) func(*baseapp.BaseApp) {
+	return func(app *baseapp.BaseApp) {
+		s.cms = newCommitMultiStore(db)
+		app.SetCMS(s.cms)
+	}
+}
+
+// Listen adds the streamer as a listener of each of the streamed stores and creates the file directory.
+func (s *Streamer) Listen(keys map[string]*sdk.KVStoreKey) error {
+	if s.snapshots {
+		return fmt.Errorf("%s can not be used with state sync snapshots (%s)", FlagStores, server.FlagStateSyncSnapshotInterval)
+	}
+	for _, name := range s.stores {
+		key, ok := keys[name]
+		if !ok {
+			return fmt.Errorf("unknown store %q in %s", name, FlagStores)
+		}
+		s.cms.AddListeners(key, []storetypes.WriteListener{s})
+	}
+	if len(s.fileDir) > 0 {
+		return os.MkdirAll(s.fileDir, 0o755)
+	}
+	return nil
+}
+
+// Serving returns true if the change sets are streamed by the StoreStream gRPC service.
+func (s *Streamer) Serving() bool {
+	return s.serve
+}
+
+// OnWrite implements the storetypes.WriteListener.OnWrite method, recording a change made by the block being committed.
+func (s *Streamer) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.pending = append(s.pending, storetypes.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte{}, key...),
+		Value:    append([]byte{}, value...),
+	})
+	return nil
+}
+
+// ListenCommit publishes the change set of the committed block.  The change set is written to the file directory and
+// sent to each subscriber.  Subscribers that have fallen more than the buffer size behind are disconnected so that they
+// can not slow down the node.
+func (s *Streamer) ListenCommit(height int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	changes := s.pending
+	s.pending = nil
+
+	for sub := range s.subscribers {
+		block := &BlockChangeSet{Height: height, Changes: make([]storetypes.StoreKVPair, 0)}
+		for _, change := range changes {
+			if len(sub.stores) == 0 || sub.stores[change.StoreKey] {
+				block.Changes = append(block.Changes, change)
+			}
+		}
+		select {
+		case sub.blocks <- block:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.dropped)
+		}
+	}
+	if len(s.fileDir) == 0 {
+		return nil
+	}
+	return s.writeFile(&BlockChangeSet{Height: height, Changes: changes})
+}
+
+// Subscribe implements the StoreStream/Subscribe RPC method.
+func (s *Streamer) Subscribe(req *SubscribeRequest, stream StoreStream_SubscribeServer) error {
+	sub := &subscriber{
+		stores:  make(map[string]bool),
+		blocks:  make(chan *BlockChangeSet, s.bufferSize),
+		dropped: make(chan struct{}),
+	}
+	for _, name := range req.StoreKeys {
+		if !containsString(s.stores, name) {
+			return status.Errorf(codes.InvalidArgument, "store %q is not streamed, must be one of %s",
+				name, strings.Join(s.stores, ", "))
+		}
+		sub.stores[name] = true
+	}
+	s.mtx.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mtx.Unlock()
+	defer s.unsubscribe(sub)
+
+	for {
+		select {
+		case block := <-sub.blocks:
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-sub.dropped:
+			return status.Errorf(codes.ResourceExhausted, "subscriber fell more than %d blocks behind", s.bufferSize)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// unsubscribe removes the subscriber if it has not already been dropped.
+func (s *Streamer) unsubscribe(sub *subscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// writeFile writes the encoded change set to the block-<height>.changeset file of the file directory.  The file is
+// written under a temporary name first so that readers never see a partial change set.
+func (s *Streamer) writeFile(block *BlockChangeSet) error {
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.fileDir, FileName(block.Height))
+	if err = ioutil.WriteFile(path+".tmp", bz, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// FileName returns the name of the file the change set of the block at the given height is written to.
+func FileName(height int64) string {
+	return fmt.Sprintf("block-%d.changeset", height)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
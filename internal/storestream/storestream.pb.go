@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/storestream/v1/storestream.proto
+
+package storestream
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the StoreStream/Subscribe RPC method.
+type SubscribeRequest struct {
+	// store_keys limits the changes to those of the given streamed stores, e.g. marker, all streamed stores when empty
+	StoreKeys []string `protobuf:"bytes,1,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_007e29df6fb419f3, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+// BlockChangeSet is the response type for the StoreStream/Subscribe RPC method.
+type BlockChangeSet struct {
+	// height of the committed block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// changes are the keys set and deleted in the streamed stores, in the order they were committed
+	Changes []types.StoreKVPair `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *BlockChangeSet) Reset()         { *m = BlockChangeSet{} }
+func (m *BlockChangeSet) String() string { return proto.CompactTextString(m) }
+func (*BlockChangeSet) ProtoMessage()    {}
+func (*BlockChangeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_007e29df6fb419f3, []int{1}
+}
+func (m *BlockChangeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockChangeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockChangeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockChangeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockChangeSet.Merge(m, src)
+}
+func (m *BlockChangeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockChangeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockChangeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockChangeSet proto.InternalMessageInfo
+
+func (m *BlockChangeSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockChangeSet) GetChanges() []types.StoreKVPair {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "provenance.storestream.v1.SubscribeRequest")
+	proto.RegisterType((*BlockChangeSet)(nil), "provenance.storestream.v1.BlockChangeSet")
+}
+
+func init() {
+	proto.RegisterFile("provenance/storestream/v1/storestream.proto", fileDescriptor_007e29df6fb419f3)
+}
+
+var fileDescriptor_007e29df6fb419f3 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0x33, 0x31,
+	0x14, 0x85, 0x27, 0x7f, 0x7f, 0x2a, 0x4d, 0x41, 0x64, 0x10, 0xa9, 0x45, 0xc7, 0xd2, 0x85, 0xb4,
+	0x14, 0x13, 0xa7, 0x6e, 0x5c, 0x57, 0x70, 0xe3, 0xa6, 0xcc, 0x80, 0x0b, 0x37, 0x92, 0x19, 0x2e,
+	0x99, 0xd0, 0x36, 0xa9, 0x49, 0x3a, 0xd0, 0xb7, 0xf0, 0xb1, 0xba, 0xec, 0xd2, 0x95, 0x48, 0xfb,
+	0x22, 0xd2, 0x4c, 0xb5, 0xa3, 0x50, 0x77, 0xb9, 0xb9, 0xdf, 0xb9, 0x39, 0x27, 0x17, 0xf7, 0xa6,
+	0x5a, 0xe5, 0x20, 0x99, 0x4c, 0x81, 0x1a, 0xab, 0x34, 0x18, 0xab, 0x81, 0x4d, 0x68, 0x1e, 0x96,
+	0x4b, 0x32, 0xd5, 0xca, 0x2a, 0xff, 0x74, 0x07, 0x93, 0x72, 0x37, 0x0f, 0x9b, 0xc7, 0x5c, 0x71,
+	0xe5, 0x28, 0xba, 0x39, 0x15, 0x82, 0x66, 0x37, 0x55, 0x66, 0xa2, 0x0c, 0x4d, 0x98, 0xd9, 0x8e,
+	0xa7, 0x79, 0x98, 0x80, 0x65, 0x21, 0x1d, 0x0b, 0x63, 0x41, 0x0a, 0xc9, 0x0b, 0xb4, 0x1d, 0xe2,
+	0xa3, 0x78, 0x96, 0x98, 0x54, 0x8b, 0x04, 0x22, 0x78, 0x99, 0x81, 0xb1, 0xfe, 0x39, 0xc6, 0x4e,
+	0xf4, 0x3c, 0x82, 0xb9, 0x69, 0xa0, 0x56, 0xa5, 0x53, 0x8b, 0x6a, 0xee, 0xe6, 0x01, 0xe6, 0xa6,
+	0x3d, 0xc5, 0x87, 0x83, 0xb1, 0x4a, 0x47, 0x77, 0x19, 0x93, 0x1c, 0x62, 0xb0, 0xfe, 0x09, 0xae,
+	0x66, 0x20, 0x78, 0x66, 0x1b, 0xa8, 0x85, 0x3a, 0x95, 0x68, 0x5b, 0xf9, 0xf7, 0xf8, 0x20, 0x75,
+	0x90, 0x69, 0xfc, 0x6b, 0x55, 0x3a, 0xf5, 0xfe, 0x25, 0x29, 0x9c, 0x91, 0x8d, 0xb3, 0x22, 0x0b,
+	0xd9, 0x3a, 0x23, 0xb1, 0x7b, 0xe0, 0x71, 0xc8, 0x84, 0x1e, 0xfc, 0x5f, 0xbc, 0x5f, 0x78, 0xd1,
+	0x97, 0xb8, 0x6f, 0x71, 0xdd, 0x75, 0x63, 0x97, 0xdb, 0x07, 0x5c, 0xfb, 0xf6, 0xec, 0xf7, 0xc8,
+	0xde, 0xdf, 0x21, 0xbf, 0x93, 0x35, 0xbb, 0x7f, 0xc0, 0x3f, 0x33, 0x5d, 0xa3, 0x81, 0x5e, 0xac,
+	0x02, 0xb4, 0x5c, 0x05, 0xe8, 0x63, 0x15, 0xa0, 0xd7, 0x75, 0xe0, 0x2d, 0xd7, 0x81, 0xf7, 0xb6,
+	0x0e, 0x3c, 0x7c, 0x26, 0xd4, 0xfe, 0x41, 0x43, 0xf4, 0x74, 0xcb, 0x85, 0xcd, 0x66, 0x09, 0x49,
+	0xd5, 0x84, 0xee, 0xb8, 0x2b, 0xa1, 0x4a, 0x15, 0x15, 0xd2, 0x82, 0x96, 0x6c, 0x5c, 0x5e, 0x78,
+	0x52, 0x75, 0x5b, 0xb9, 0xf9, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x76, 0x88, 0xb2, 0xda, 0x20, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StoreStreamClient is the client API for StoreStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StoreStreamClient interface {
+	// Subscribe streams the change set of every block committed after the subscription starts.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StoreStream_SubscribeClient, error)
+}
+
+type storeStreamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStoreStreamClient(cc grpc1.ClientConn) StoreStreamClient {
+	return &storeStreamClient{cc}
+}
+
+func (c *storeStreamClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StoreStream_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StoreStream_serviceDesc.Streams[0], "/provenance.storestream.v1.StoreStream/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeStreamSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StoreStream_SubscribeClient interface {
+	Recv() (*BlockChangeSet, error)
+	grpc.ClientStream
+}
+
+type storeStreamSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeStreamSubscribeClient) Recv() (*BlockChangeSet, error) {
+	m := new(BlockChangeSet)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StoreStreamServer is the server API for StoreStream service.
+type StoreStreamServer interface {
+	// Subscribe streams the change set of every block committed after the subscription starts.
+	Subscribe(*SubscribeRequest, StoreStream_SubscribeServer) error
+}
+
+// UnimplementedStoreStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStoreStreamServer struct {
+}
+
+func (*UnimplementedStoreStreamServer) Subscribe(req *SubscribeRequest, srv StoreStream_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStoreStreamServer(s grpc1.Server, srv StoreStreamServer) {
+	s.RegisterService(&_StoreStream_serviceDesc, srv)
+}
+
+func _StoreStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StoreStreamServer).Subscribe(m, &storeStreamSubscribeServer{stream})
+}
+
+type StoreStream_SubscribeServer interface {
+	Send(*BlockChangeSet) error
+	grpc.ServerStream
+}
+
+type storeStreamSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeStreamSubscribeServer) Send(m *BlockChangeSet) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StoreStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.storestream.v1.StoreStream",
+	HandlerType: (*StoreStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _StoreStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "provenance/storestream/v1/storestream.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintStorestream(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockChangeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockChangeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockChangeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorestream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStorestream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorestream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorestream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovStorestream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockChangeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStorestream(uint64(m.Height))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovStorestream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStorestream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorestream(x uint64) (n int) {
+	return sovStorestream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorestream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorestream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorestream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockChangeSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorestream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockChangeSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockChangeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorestream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorestream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, types.StoreKVPair{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorestream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorestream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStorestream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStorestream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorestream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorestream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStorestream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStorestream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStorestream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStorestream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStorestream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStorestream = fmt.Errorf("proto: unexpected end of group")
+)
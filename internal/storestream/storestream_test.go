@@ -0,0 +1,169 @@
+package storestream
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testStream is a StoreStream_SubscribeServer that hands each sent block to the test.
+type testStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks chan *BlockChangeSet
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+func (s *testStream) Send(block *BlockChangeSet) error {
+	s.blocks <- block
+	return nil
+}
+
+func TestNewStreamer(t *testing.T) {
+	v := viper.New()
+	v.Set(flags.FlagHome, "/pio")
+	require.Nil(t, NewStreamer(v), "disabled by default")
+
+	v.Set(FlagStores, []string{"marker", "metadata"})
+	require.Nil(t, NewStreamer(v), "disabled without a file directory or the grpc service")
+
+	v.Set(FlagFileDir, "data/changesets")
+	streamer := NewStreamer(v)
+	require.NotNil(t, streamer)
+	require.Equal(t, []string{"marker", "metadata"}, streamer.stores)
+	require.Equal(t, "/pio/data/changesets", streamer.fileDir, "relative to the home directory")
+	require.False(t, streamer.Serving())
+	require.Equal(t, DefaultBufferSize, streamer.bufferSize)
+
+	v.Set(FlagFileDir, "")
+	v.Set(FlagGRPC, true)
+	v.Set(FlagBufferSize, 5)
+	streamer = NewStreamer(v)
+	require.True(t, streamer.Serving())
+	require.Empty(t, streamer.fileDir)
+	require.Equal(t, 5, streamer.bufferSize)
+}
+
+func TestListen(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagStores, []string{"marker"})
+	v.Set(FlagGRPC, true)
+	keys := sdk.NewKVStoreKeys("marker", "metadata")
+
+	streamer := NewStreamer(v)
+	streamer.cms = newCommitMultiStore(nil)
+	require.NoError(t, streamer.Listen(keys))
+	require.True(t, streamer.cms.ListeningEnabled(keys["marker"]))
+	require.False(t, streamer.cms.ListeningEnabled(keys["metadata"]))
+
+	v.Set(FlagStores, []string{"bank"})
+	streamer = NewStreamer(v)
+	streamer.cms = newCommitMultiStore(nil)
+	require.EqualError(t, streamer.Listen(keys), `unknown store "bank" in store-stream.stores`)
+
+	v.Set(FlagStores, []string{"marker"})
+	v.Set(server.FlagStateSyncSnapshotInterval, 1000)
+	streamer = NewStreamer(v)
+	streamer.cms = newCommitMultiStore(nil)
+	require.EqualError(t, streamer.Listen(keys),
+		"store-stream.stores can not be used with state sync snapshots (state-sync.snapshot-interval)")
+}
+
+func TestSubscribe(t *testing.T) {
+	fileDir := t.TempDir()
+	v := viper.New()
+	v.Set(FlagStores, []string{"marker", "metadata"})
+	v.Set(FlagFileDir, fileDir)
+	v.Set(FlagGRPC, true)
+	v.Set(FlagBufferSize, 1)
+	streamer := NewStreamer(v)
+	keys := sdk.NewKVStoreKeys("marker", "metadata")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscribe := func(req *SubscribeRequest) (*testStream, chan error) {
+		stream := &testStream{ctx: ctx, blocks: make(chan *BlockChangeSet)}
+		done := make(chan error, 1)
+		go func() { done <- streamer.Subscribe(req, stream) }()
+		return stream, done
+	}
+	waitForSubscribers := func(count int) {
+		require.Eventually(t, func() bool {
+			streamer.mtx.Lock()
+			defer streamer.mtx.Unlock()
+			return len(streamer.subscribers) == count
+		}, time.Second, time.Millisecond)
+	}
+
+	_, done := subscribe(&SubscribeRequest{StoreKeys: []string{"bank"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(<-done))
+
+	all, _ := subscribe(&SubscribeRequest{})
+	metadata, _ := subscribe(&SubscribeRequest{StoreKeys: []string{"metadata"}})
+	waitForSubscribers(2)
+
+	require.NoError(t, streamer.OnWrite(keys["marker"], []byte("denom"), []byte("marker"), false))
+	require.NoError(t, streamer.OnWrite(keys["metadata"], []byte("scope"), nil, true))
+	require.NoError(t, streamer.ListenCommit(7))
+
+	expected := []storetypes.StoreKVPair{
+		{StoreKey: "marker", Key: []byte("denom"), Value: []byte("marker")},
+		{StoreKey: "metadata", Delete: true, Key: []byte("scope"), Value: []byte{}},
+	}
+	block := <-all.blocks
+	require.Equal(t, int64(7), block.Height)
+	require.Equal(t, expected, block.Changes)
+
+	block = <-metadata.blocks
+	require.Equal(t, expected[1:], block.Changes)
+
+	bz, err := ioutil.ReadFile(filepath.Join(fileDir, "block-7.changeset"))
+	require.NoError(t, err)
+	var written BlockChangeSet
+	require.NoError(t, written.Unmarshal(bz))
+	require.Equal(t, int64(7), written.Height)
+	require.Len(t, written.Changes, 2)
+
+	require.NoError(t, streamer.ListenCommit(8))
+	block = <-all.blocks
+	require.Empty(t, block.Changes, "the changes are only published with the block that made them")
+	require.Empty(t, (<-metadata.blocks).Changes)
+
+	cancel()
+	waitForSubscribers(0)
+}
+
+func TestSlowSubscriberDropped(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagStores, []string{"marker"})
+	v.Set(FlagGRPC, true)
+	v.Set(FlagBufferSize, 1)
+	streamer := NewStreamer(v)
+
+	sub := &subscriber{
+		blocks:  make(chan *BlockChangeSet, 1),
+		dropped: make(chan struct{}),
+	}
+	streamer.subscribers[sub] = struct{}{}
+
+	require.NoError(t, streamer.ListenCommit(1))
+	require.Len(t, streamer.subscribers, 1, "first block is buffered")
+	require.NoError(t, streamer.ListenCommit(2))
+	require.Empty(t, streamer.subscribers, "second block overflows the buffer")
+	_, open := <-sub.dropped
+	require.False(t, open)
+}
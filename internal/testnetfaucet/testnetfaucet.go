@@ -0,0 +1,262 @@
+package testnetfaucet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// FlagEnable is the app.toml setting that opts a testnet node into serving the faucet on its api server.
+	FlagEnable = "testnet-faucet.enable"
+	// FlagKeyName is the app.toml setting with the name of the key of the account the faucet funds accounts from.
+	FlagKeyName = "testnet-faucet.key-name"
+	// FlagKeyringBackend is the app.toml setting with the backend of the keyring holding the faucet key.
+	FlagKeyringBackend = "testnet-faucet.keyring-backend"
+	// FlagKeyringDir is the app.toml setting with the directory of the keyring holding the faucet key.
+	FlagKeyringDir = "testnet-faucet.keyring-dir"
+	// FlagAmount is the app.toml setting with the coins sent to each funded account.
+	FlagAmount = "testnet-faucet.amount"
+	// FlagGasPrices is the app.toml setting with the gas prices paid for the faucet txs.
+	FlagGasPrices = "testnet-faucet.gas-prices"
+	// FlagInterval is the app.toml setting with how long an address waits before it can be funded again.
+	FlagInterval = "testnet-faucet.interval"
+	// FlagHourlyLimit is the app.toml setting with the number of accounts that can be funded in an hour.
+	FlagHourlyLimit = "testnet-faucet.hourly-limit"
+
+	// DefaultKeyringBackend is the keyring backend used when no backend is configured.
+	DefaultKeyringBackend = keyring.BackendTest
+	// DefaultAmount is the amount sent to each funded account when no amount is configured.
+	DefaultAmount = "1000000000nhash"
+	// DefaultGasPrices are the gas prices paid when no gas prices are configured.
+	DefaultGasPrices = "1905nhash"
+	// DefaultInterval is how long an address waits before it can be funded again when no interval is configured.
+	DefaultInterval = 24 * time.Hour
+	// DefaultHourlyLimit is the number of accounts that can be funded in an hour when no limit is configured.
+	DefaultHourlyLimit = 60
+
+	// Gas is the gas limit of the faucet txs.
+	Gas = 100000
+	// Route is the path of the faucet endpoint on the api server.
+	Route = "/provenance/testnet/fund"
+	// MainnetChainID is the chain id the faucet refuses to run on.
+	MainnetChainID = "pio-mainnet-1"
+)
+
+// SendFunc sends the coins from the faucet account to an address and returns the hash of the tx.
+type SendFunc func(clientCtx client.Context, to sdk.AccAddress, amount sdk.Coins) (string, error)
+
+// Faucet creates and funds accounts on a testnet, rate limited per address and per hour.
+type Faucet struct {
+	keyName     string
+	keyring     keyring.Keyring
+	amount      sdk.Coins
+	gasPrices   string
+	interval    time.Duration
+	hourlyLimit int
+
+	send SendFunc
+	now  func() time.Time
+
+	mtx sync.Mutex
+	// funded is when each address was last funded, recent are the times of the fundings in the last hour.
+	funded map[string]time.Time
+	recent []time.Time
+}
+
+// FundRequest is the body of a request to the faucet endpoint.
+type FundRequest struct {
+	Address string `json:"address"`
+}
+
+// FundResponse is the body of the response of the faucet endpoint.
+type FundResponse struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+	TxHash  string `json:"txhash"`
+}
+
+// NewFaucet returns a new Faucet when the faucet has been enabled in the app options, otherwise nil.  An error is
+// returned when the faucet is enabled on a node that is not running as a testnet or it is not configured correctly.
+func NewFaucet(appOpts servertypes.AppOptions, testnet bool) (*Faucet, error) {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil, nil
+	}
+	if !testnet {
+		return nil, fmt.Errorf("%s can only be set on a testnet node", FlagEnable)
+	}
+	keyName := cast.ToString(appOpts.Get(FlagKeyName))
+	if len(keyName) == 0 {
+		return nil, fmt.Errorf("%s is required when %s is set", FlagKeyName, FlagEnable)
+	}
+	backend := cast.ToString(appOpts.Get(FlagKeyringBackend))
+	if len(backend) == 0 {
+		backend = DefaultKeyringBackend
+	}
+	dir := cast.ToString(appOpts.Get(FlagKeyringDir))
+	if len(dir) == 0 {
+		dir = cast.ToString(appOpts.Get(flags.FlagHome))
+	}
+	kr, err := keyring.New(sdk.KeyringServiceName(), backend, filepath.Clean(dir), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the %s keyring: %w", FlagKeyringBackend, err)
+	}
+	if _, err = kr.Key(keyName); err != nil {
+		return nil, fmt.Errorf("could not find faucet key %q: %w", keyName, err)
+	}
+	amountStr := cast.ToString(appOpts.Get(FlagAmount))
+	if len(amountStr) == 0 {
+		amountStr = DefaultAmount
+	}
+	amount, err := sdk.ParseCoinsNormalized(amountStr)
+	if err != nil || amount.Empty() {
+		return nil, fmt.Errorf("invalid %s %q: %v", FlagAmount, amountStr, err)
+	}
+	gasPrices := cast.ToString(appOpts.Get(FlagGasPrices))
+	if len(gasPrices) == 0 {
+		gasPrices = DefaultGasPrices
+	}
+	interval := cast.ToDuration(appOpts.Get(FlagInterval))
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	hourlyLimit := cast.ToInt(appOpts.Get(FlagHourlyLimit))
+	if hourlyLimit <= 0 {
+		hourlyLimit = DefaultHourlyLimit
+	}
+	f := &Faucet{
+		keyName:     keyName,
+		keyring:     kr,
+		amount:      amount,
+		gasPrices:   gasPrices,
+		interval:    interval,
+		hourlyLimit: hourlyLimit,
+		now:         time.Now,
+		funded:      make(map[string]time.Time),
+	}
+	f.send = f.sendTx
+	return f, nil
+}
+
+// RegisterRoutes adds the faucet endpoint to the api server router.  The faucet refuses to run on mainnet.
+func (f *Faucet) RegisterRoutes(clientCtx client.Context, r *mux.Router) error {
+	if clientCtx.ChainID == MainnetChainID {
+		return fmt.Errorf("%s cannot be set on %s", FlagEnable, MainnetChainID)
+	}
+	r.HandleFunc(Route, f.fundHandler(clientCtx)).Methods(http.MethodPost)
+	return nil
+}
+
+// fundHandler returns the handler of the faucet endpoint, funding the address of the request.
+func (f *Faucet) fundHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req FundRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid address %q: %v", req.Address, err))
+			return
+		}
+		res, status, err := f.Fund(clientCtx, addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, status, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// Fund sends the faucet amount to the address unless the address was funded within the interval or the hourly limit
+// has been reached.  The http status of the failure is returned with any error.
+func (f *Faucet) Fund(clientCtx client.Context, addr sdk.AccAddress) (*FundResponse, int, error) {
+	// Fundings are serialized so that each tx is signed with the sequence left by the previous one.
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	now := f.now()
+	if last, found := f.funded[addr.String()]; found && now.Sub(last) < f.interval {
+		return nil, http.StatusTooManyRequests, fmt.Errorf("%s was funded at %s, try again after %s",
+			addr, last.UTC().Format(time.RFC3339), last.Add(f.interval).UTC().Format(time.RFC3339))
+	}
+	hourAgo := now.Add(-time.Hour)
+	for len(f.recent) > 0 && !f.recent[0].After(hourAgo) {
+		f.recent = f.recent[1:]
+	}
+	if len(f.recent) >= f.hourlyLimit {
+		return nil, http.StatusTooManyRequests, fmt.Errorf("the faucet has funded %d accounts in the last hour, try again after %s",
+			len(f.recent), f.recent[0].Add(time.Hour).UTC().Format(time.RFC3339))
+	}
+
+	txHash, err := f.send(clientCtx, addr, f.amount)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("could not fund %s: %w", addr, err)
+	}
+	f.funded[addr.String()] = now
+	f.recent = append(f.recent, now)
+	return &FundResponse{Address: addr.String(), Amount: f.amount.String(), TxHash: txHash}, http.StatusOK, nil
+}
+
+// sendTx signs a bank send of the amount from the faucet key to the address and broadcasts it in block mode, so that
+// the next tx is signed with the committed sequence of the faucet account.
+func (f *Faucet) sendTx(clientCtx client.Context, to sdk.AccAddress, amount sdk.Coins) (string, error) {
+	info, err := f.keyring.Key(f.keyName)
+	if err != nil {
+		return "", err
+	}
+	from := info.GetAddress()
+	clientCtx = clientCtx.WithFromAddress(from).WithFromName(f.keyName).WithBroadcastMode(flags.BroadcastBlock)
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, from)
+	if err != nil {
+		return "", err
+	}
+	txf := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(f.keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq).
+		WithGas(Gas).
+		WithGasPrices(f.gasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	txb, err := tx.BuildUnsignedTx(txf, banktypes.NewMsgSend(from, to, amount))
+	if err != nil {
+		return "", err
+	}
+	if err = tx.Sign(txf, f.keyName, txb, true); err != nil {
+		return "", err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return "", err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return "", err
+	}
+	if res.Code != 0 {
+		return res.TxHash, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res.TxHash, nil
+}
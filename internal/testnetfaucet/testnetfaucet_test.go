@@ -0,0 +1,126 @@
+package testnetfaucet
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newTestFaucet(t *testing.T) *Faucet {
+	home := t.TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("faucet", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	v.Set(FlagEnable, true)
+	v.Set(FlagKeyName, "faucet")
+	f, err := NewFaucet(v, true)
+	require.NoError(t, err)
+	return f
+}
+
+func TestNewFaucet(t *testing.T) {
+	v := viper.New()
+	f, err := NewFaucet(v, true)
+	require.NoError(t, err)
+	require.Nil(t, f, "disabled faucet")
+
+	v.Set(FlagEnable, true)
+	_, err = NewFaucet(v, false)
+	require.EqualError(t, err, "testnet-faucet.enable can only be set on a testnet node")
+	_, err = NewFaucet(v, true)
+	require.EqualError(t, err, "testnet-faucet.key-name is required when testnet-faucet.enable is set")
+
+	f = newTestFaucet(t)
+	require.Equal(t, DefaultAmount, f.amount.String())
+	require.Equal(t, DefaultGasPrices, f.gasPrices)
+	require.Equal(t, DefaultInterval, f.interval)
+	require.Equal(t, DefaultHourlyLimit, f.hourlyLimit)
+}
+
+func TestFund(t *testing.T) {
+	f := newTestFaucet(t)
+	f.hourlyLimit = 2
+	now := time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+	var sent []sdk.AccAddress
+	var sendErr error
+	f.send = func(_ client.Context, to sdk.AccAddress, amount sdk.Coins) (string, error) {
+		if sendErr != nil {
+			return "", sendErr
+		}
+		sent = append(sent, to)
+		return "ABCD", nil
+	}
+
+	first := sdk.AccAddress("first_______________")
+	res, status, err := f.Fund(client.Context{}, first)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, &FundResponse{Address: first.String(), Amount: DefaultAmount, TxHash: "ABCD"}, res)
+
+	_, status, err = f.Fund(client.Context{}, first)
+	require.Error(t, err, "funded within the interval")
+	require.Equal(t, http.StatusTooManyRequests, status)
+
+	sendErr = errors.New("out of gas")
+	second := sdk.AccAddress("second______________")
+	_, status, err = f.Fund(client.Context{}, second)
+	require.Error(t, err)
+	require.Equal(t, http.StatusInternalServerError, status)
+	sendErr = nil
+	_, _, err = f.Fund(client.Context{}, second)
+	require.NoError(t, err, "failed funding is not rate limited")
+
+	third := sdk.AccAddress("third_______________")
+	_, status, err = f.Fund(client.Context{}, third)
+	require.Error(t, err, "hourly limit")
+	require.Equal(t, http.StatusTooManyRequests, status)
+
+	now = now.Add(time.Hour)
+	_, _, err = f.Fund(client.Context{}, third)
+	require.NoError(t, err, "after an hour")
+	now = now.Add(DefaultInterval)
+	_, _, err = f.Fund(client.Context{}, first)
+	require.NoError(t, err, "after the interval")
+	require.Equal(t, []sdk.AccAddress{first, second, third, first}, sent)
+}
+
+func TestFundHandler(t *testing.T) {
+	f := newTestFaucet(t)
+	f.send = func(client.Context, sdk.AccAddress, sdk.Coins) (string, error) { return "ABCD", nil }
+
+	r := mux.NewRouter()
+	require.EqualError(t, f.RegisterRoutes(client.Context{ChainID: MainnetChainID}, r),
+		"testnet-faucet.enable cannot be set on pio-mainnet-1")
+	require.NoError(t, f.RegisterRoutes(client.Context{ChainID: "pio-testnet-1"}, r))
+
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, Route, strings.NewReader(body)))
+		return w
+	}
+	addr := sdk.AccAddress("recipient___________").String()
+	w := post(`{"address":"` + addr + `"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Contains(t, w.Body.String(), `"txhash":"ABCD"`)
+	require.Equal(t, http.StatusTooManyRequests, post(`{"address":"`+addr+`"}`).Code)
+	require.Equal(t, http.StatusBadRequest, post(`{"address":"invalid"}`).Code)
+	require.Equal(t, http.StatusBadRequest, post(`not json`).Code)
+}
@@ -0,0 +1,226 @@
+package txbroadcast
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/pflag"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/provenance-io/provenance/internal/txmemo"
+)
+
+const (
+	// FlagBroadcastRetries is the flag for the number of times a tx is broadcast again when the node's mempool is full
+	// or the broadcast times out.
+	FlagBroadcastRetries = "broadcast-retries"
+	// FlagWait is the flag for waiting until a tx is included in a block and printing its result.
+	FlagWait = "wait"
+
+	// eventTypePrefix is the prefix of the type of the typed events emitted by the Provenance modules.
+	eventTypePrefix = "provenance."
+)
+
+var (
+	// retryDelay is the time to wait before broadcasting a tx again, doubled for each further retry.
+	retryDelay = time.Second
+	// pollInterval is the time between looking for a tx in a block.
+	pollInterval = time.Second
+	// waitTimeout is the longest time to wait for a tx to be included in a block.
+	waitTimeout = time.Minute
+)
+
+// AddFlags adds the broadcast retry, wait, and memo json flags to the given flag set.  Added to the persistent flags of a module's
+// tx command, they are available to each of its sub-commands.
+func AddFlags(flagSet *pflag.FlagSet) {
+	flagSet.Uint(FlagBroadcastRetries, 0,
+		"Number of times to broadcast the tx again when the node's mempool is full or the broadcast times out")
+	flagSet.Bool(FlagWait, false,
+		"Broadcast the tx in sync mode, wait until it is in a block, and print the result with its decoded Provenance events")
+	txmemo.AddFlags(flagSet)
+}
+
+// GenerateOrBroadcastTxCLI either generates and prints an unsigned tx or signs and broadcasts it the same way as
+// tx.GenerateOrBroadcastTxCLI, retrying the broadcast and waiting for the tx to be included in a block as requested by
+// the broadcast retry and wait flags, with the memo given by the memo json flag.  Commands without these flags behave
+// exactly like tx.GenerateOrBroadcastTxCLI.
+func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) error {
+	if err := txmemo.ApplyFlags(flagSet); err != nil {
+		return err
+	}
+	retries, _ := flagSet.GetUint(FlagBroadcastRetries)
+	wait, _ := flagSet.GetBool(FlagWait)
+	if clientCtx.GenerateOnly || clientCtx.Offline || (retries == 0 && !wait) {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msgs...)
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	b := &broadcaster{Client: node, retries: retries}
+	clientCtx = clientCtx.WithClient(b)
+	if !wait {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msgs...)
+	}
+
+	// The tx is broadcast in sync mode and only the final result is printed, unless the tx never made it into the
+	// mempool, in which case the broadcast response is all there is.
+	var output io.Writer = os.Stdout
+	if clientCtx.Output != nil {
+		output = clientCtx.Output
+	}
+	var buf bytes.Buffer
+	err = tx.GenerateOrBroadcastTxCLI(clientCtx.WithBroadcastMode(flags.BroadcastSync).WithOutput(&buf), flagSet, msgs...)
+	if err != nil || b.result == nil || b.result.Code != abci.CodeTypeOK {
+		_, _ = output.Write(buf.Bytes())
+		return err
+	}
+
+	resTx, err := waitForTx(context.Background(), node, b.result.Hash)
+	if err != nil {
+		return err
+	}
+	res, err := authtx.QueryTx(clientCtx, resTx.Hash.String())
+	if err != nil {
+		return err
+	}
+	clientCtx = clientCtx.WithOutput(output)
+	if err = clientCtx.PrintProto(res); err != nil {
+		return err
+	}
+	for _, event := range DecodeEvents(resTx.TxResult.Events) {
+		if err = clientCtx.PrintProto(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeEvents returns the typed Provenance events of the given events.  Events that are not typed, or do not come
+// from a Provenance module, are skipped.
+func DecodeEvents(events []abci.Event) []proto.Message {
+	var decoded []proto.Message
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, eventTypePrefix) {
+			continue
+		}
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			decoded = append(decoded, msg)
+		}
+	}
+	return decoded
+}
+
+// broadcaster is an rpc client that broadcasts a tx again when the node's mempool is full or the broadcast times out.
+type broadcaster struct {
+	rpcclient.Client
+	retries uint
+	// result is the response of the last sync or async broadcast.
+	result *coretypes.ResultBroadcastTx
+}
+
+var _ rpcclient.Client = &broadcaster{}
+
+// BroadcastTxSync implements the rpcclient.Client.BroadcastTxSync method.
+func (b *broadcaster) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := b.broadcastTx(ctx, tx, b.Client.BroadcastTxSync)
+	b.result = res
+	return res, err
+}
+
+// BroadcastTxAsync implements the rpcclient.Client.BroadcastTxAsync method.
+func (b *broadcaster) BroadcastTxAsync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := b.broadcastTx(ctx, tx, b.Client.BroadcastTxAsync)
+	b.result = res
+	return res, err
+}
+
+// BroadcastTxCommit implements the rpcclient.Client.BroadcastTxCommit method.  When the node stops waiting for the tx
+// to be included in a block, the tx has already been accepted so it is looked for instead of being broadcast again.
+func (b *broadcaster) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	for attempt := uint(0); ; attempt++ {
+		res, err := b.Client.BroadcastTxCommit(ctx, tx)
+		switch {
+		case err == nil:
+			return res, nil
+		case isTimeout(err):
+			resTx, waitErr := waitForTx(ctx, b.Client, tx.Hash())
+			if waitErr != nil {
+				return res, err
+			}
+			return &coretypes.ResultBroadcastTxCommit{DeliverTx: resTx.TxResult, Hash: resTx.Hash, Height: resTx.Height}, nil
+		case !isMempoolFull(err) || attempt >= b.retries:
+			return res, err
+		}
+		time.Sleep(retryDelay << attempt)
+	}
+}
+
+// broadcastTx broadcasts the tx until it is accepted by the node, the error cannot be fixed by trying again, or the
+// retries are used up.
+func (b *broadcaster) broadcastTx(
+	ctx context.Context,
+	tx tmtypes.Tx,
+	broadcast func(context.Context, tmtypes.Tx) (*coretypes.ResultBroadcastTx, error),
+) (*coretypes.ResultBroadcastTx, error) {
+	for attempt := uint(0); ; attempt++ {
+		res, err := broadcast(ctx, tx)
+		switch {
+		case err == nil:
+			return res, nil
+		case attempt > 0 && isInCache(err):
+			// An earlier broadcast that timed out got the tx into the mempool.
+			return &coretypes.ResultBroadcastTx{Code: abci.CodeTypeOK, Hash: tx.Hash()}, nil
+		case !isMempoolFull(err) && !isTimeout(err) || attempt >= b.retries:
+			return res, err
+		}
+		time.Sleep(retryDelay << attempt)
+	}
+}
+
+// waitForTx looks for the tx with the given hash until it is found in a block or the wait times out.
+func waitForTx(ctx context.Context, node rpcclient.Client, hash []byte) (*coretypes.ResultTx, error) {
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		res, err := node.Tx(ctx, hash, false)
+		if err == nil {
+			return res, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("tx %X was not included in a block within %s: %w", hash, waitTimeout, err)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// The broadcast errors are only available as strings from the node, see client.CheckTendermintError.
+
+func isMempoolFull(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "mempool is full")
+}
+
+func isInCache(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), strings.ToLower(mempool.ErrTxInCache.Error()))
+}
+
+func isTimeout(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "timed out") || strings.Contains(errStr, "deadline exceeded")
+}
@@ -0,0 +1,130 @@
+package txbroadcast
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// fakeNode is an rpc client that fails the first broadcasts with the given errors and finds a tx after a number of
+// lookups.
+type fakeNode struct {
+	rpcclient.Client
+	errs       []error
+	broadcasts int
+	foundAfter int
+	lookups    int
+}
+
+func (n *fakeNode) nextErr() error {
+	n.broadcasts++
+	if n.broadcasts <= len(n.errs) {
+		return n.errs[n.broadcasts-1]
+	}
+	return nil
+}
+
+func (n *fakeNode) BroadcastTxSync(_ context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	if err := n.nextErr(); err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *fakeNode) BroadcastTxCommit(_ context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	if err := n.nextErr(); err != nil {
+		return &coretypes.ResultBroadcastTxCommit{}, err
+	}
+	return &coretypes.ResultBroadcastTxCommit{Hash: tx.Hash(), Height: 1}, nil
+}
+
+func (n *fakeNode) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	n.lookups++
+	if n.lookups < n.foundAfter {
+		return nil, errors.New("tx not found")
+	}
+	return &coretypes.ResultTx{Hash: hash, Height: 5, TxResult: abci.ResponseDeliverTx{Log: "delivered"}}, nil
+}
+
+func setShortDelays(t *testing.T) {
+	origRetryDelay, origPollInterval := retryDelay, pollInterval
+	retryDelay, pollInterval = 0, 0
+	t.Cleanup(func() {
+		retryDelay, pollInterval = origRetryDelay, origPollInterval
+	})
+}
+
+func TestBroadcastTxSync(t *testing.T) {
+	setShortDelays(t)
+	tx := tmtypes.Tx("tx")
+	mempoolFull := errors.New("RPC error -32603 - Internal error: mempool is full: number of txs 5000 (max: 5000)")
+	timedOut := errors.New("post failed: Post \"http://localhost:26657\": context deadline exceeded")
+
+	tests := []struct {
+		name       string
+		errs       []error
+		retries    uint
+		err        error
+		broadcasts int
+	}{
+		{name: "accepted", broadcasts: 1},
+		{name: "mempool full until accepted", errs: []error{mempoolFull, mempoolFull}, retries: 2, broadcasts: 3},
+		{name: "mempool full beyond retries", errs: []error{mempoolFull, mempoolFull}, retries: 1, err: mempoolFull, broadcasts: 2},
+		{name: "timed out then in cache", errs: []error{timedOut, errors.New("tx already exists in cache")}, retries: 1, broadcasts: 2},
+		{name: "not retried", errs: []error{errors.New("tx too large")}, retries: 3, err: errors.New("tx too large"), broadcasts: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &fakeNode{errs: tc.errs}
+			b := &broadcaster{Client: node, retries: tc.retries}
+			res, err := b.BroadcastTxSync(context.Background(), tx)
+			require.Equal(t, tc.broadcasts, node.broadcasts, "number of broadcasts")
+			if tc.err != nil {
+				require.EqualError(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tx.Hash(), []byte(res.Hash))
+			require.Equal(t, res, b.result)
+		})
+	}
+}
+
+func TestBroadcastTxCommitTimeout(t *testing.T) {
+	setShortDelays(t)
+	tx := tmtypes.Tx("tx")
+	node := &fakeNode{errs: []error{errors.New("timed out waiting for tx to be included in a block")}, foundAfter: 3}
+	b := &broadcaster{Client: node}
+
+	res, err := b.BroadcastTxCommit(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, 1, node.broadcasts, "a tx accepted by the node is not broadcast again")
+	require.Equal(t, 3, node.lookups)
+	require.Equal(t, int64(5), res.Height)
+	require.Equal(t, "delivered", res.DeliverTx.Log)
+}
+
+func TestDecodeEvents(t *testing.T) {
+	markerAdd := &markertypes.EventMarkerAdd{Denom: "hotdog", Amount: "100", Status: "proposed", Manager: "manager", MarkerType: "coin"}
+	typed, err := sdk.TypedEventToEvent(markerAdd)
+	require.NoError(t, err)
+	events := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("100hotdog")}}},
+		abci.Event(typed),
+	}
+
+	decoded := DecodeEvents(events)
+	require.Len(t, decoded, 1)
+	require.Equal(t, markerAdd, decoded[0])
+}
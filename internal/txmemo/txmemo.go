@@ -0,0 +1,92 @@
+package txmemo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FlagMemoJSON is the flag for a JSON object that is validated and canonically encoded into the memo of a tx.
+const FlagMemoJSON = "memo-json"
+
+// MaxMemoLength is the longest memo accepted, matching the default max memo characters param of the auth module.
+var MaxMemoLength = int(authtypes.DefaultMaxMemoCharacters)
+
+// AddFlags adds the memo json flag to the given flag set.
+func AddFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(FlagMemoJSON, "",
+		`A JSON object to canonically encode into the memo of the tx, e.g. '{"invoice":"1234"}' (cannot be used with --note)`)
+}
+
+// ApplyFlags encodes the JSON object given with the memo json flag and sets it as the note of the tx.  Nothing is
+// changed when the flag is not set.
+func ApplyFlags(flagSet *pflag.FlagSet) error {
+	input, _ := flagSet.GetString(FlagMemoJSON)
+	if len(input) == 0 {
+		return nil
+	}
+	if flagSet.Changed(flags.FlagNote) {
+		return fmt.Errorf("only one of --%s and --%s can be provided", flags.FlagNote, FlagMemoJSON)
+	}
+	memo, err := EncodeMemo(input)
+	if err != nil {
+		return err
+	}
+	return flagSet.Set(flags.FlagNote, memo)
+}
+
+// EncodeMemo validates that the input is a non-empty JSON object and returns its canonical encoding: object keys
+// sorted, no insignificant whitespace, and numbers kept exactly as given.
+func EncodeMemo(input string) (string, error) {
+	obj, err := parseObject(input)
+	if err != nil {
+		return "", fmt.Errorf("invalid memo json: %w", err)
+	}
+	if len(obj) == 0 {
+		return "", errors.New("invalid memo json: object cannot be empty")
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err = enc.Encode(obj); err != nil {
+		return "", err
+	}
+	memo := string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	if len(memo) > MaxMemoLength {
+		return "", fmt.Errorf("memo json is %d characters, more than the max of %d", len(memo), MaxMemoLength)
+	}
+	return memo, nil
+}
+
+// DecodeMemo returns the JSON object of a structured memo.  False is returned when the memo is not a JSON object.
+func DecodeMemo(memo string) (map[string]interface{}, bool) {
+	obj, err := parseObject(memo)
+	if err != nil || len(obj) == 0 {
+		return nil, false
+	}
+	return obj, true
+}
+
+// parseObject parses the input as a single JSON object.
+func parseObject(input string) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(input)))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.New("not a JSON object")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after JSON object")
+	}
+	return obj, nil
+}
@@ -0,0 +1,78 @@
+package txmemo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestEncodeMemo(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		memo   string
+		errMsg string
+	}{
+		{"sorted and compacted", `{ "b": 1, "a": {"d": [1, 2], "c": "x"} }`, `{"a":{"c":"x","d":[1,2]},"b":1}`, ""},
+		{"numbers kept exactly", `{"amount": 1.50, "big": 123456789012345678901234567890}`,
+			`{"amount":1.50,"big":123456789012345678901234567890}`, ""},
+		{"html not escaped", `{"note":"a<b&c"}`, `{"note":"a<b&c"}`, ""},
+		{"not json", `invoice 1234`, "", "invalid memo json: invalid character 'i' looking for beginning of value"},
+		{"not an object", `["invoice"]`, "", "invalid memo json: json: cannot unmarshal array into Go value of type map[string]interface {}"},
+		{"null", `null`, "", "invalid memo json: not a JSON object"},
+		{"empty object", `{}`, "", "invalid memo json: object cannot be empty"},
+		{"trailing data", `{"a":1} {"b":2}`, "", "invalid memo json: unexpected data after JSON object"},
+		{"too long", `{"a":"` + strings.Repeat("x", MaxMemoLength) + `"}`, "", "memo json is 264 characters, more than the max of 256"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := EncodeMemo(tc.input)
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.memo, memo)
+		})
+	}
+}
+
+func TestDecodeMemo(t *testing.T) {
+	obj, ok := DecodeMemo(`{"invoice":"1234"}`)
+	require.True(t, ok)
+	require.Equal(t, map[string]interface{}{"invoice": "1234"}, obj)
+
+	for _, memo := range []string{"", "plain text memo", `"quoted"`, `{}`, `[1]`} {
+		_, ok = DecodeMemo(memo)
+		require.False(t, ok, memo)
+	}
+}
+
+func TestApplyFlags(t *testing.T) {
+	newFlagSet := func(args ...string) *pflag.FlagSet {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flagSet.String(flags.FlagNote, "", "")
+		AddFlags(flagSet)
+		require.NoError(t, flagSet.Parse(args))
+		return flagSet
+	}
+
+	flagSet := newFlagSet("--memo-json", `{"b":2,"a":1}`)
+	require.NoError(t, ApplyFlags(flagSet))
+	note, _ := flagSet.GetString(flags.FlagNote)
+	require.Equal(t, `{"a":1,"b":2}`, note)
+
+	flagSet = newFlagSet("--note", "plain")
+	require.NoError(t, ApplyFlags(flagSet))
+	note, _ = flagSet.GetString(flags.FlagNote)
+	require.Equal(t, "plain", note)
+
+	require.EqualError(t, ApplyFlags(newFlagSet("--note", "plain", "--memo-json", `{"a":1}`)),
+		"only one of --note and --memo-json can be provided")
+	require.EqualError(t, ApplyFlags(newFlagSet("--memo-json", `[]`)),
+		"invalid memo json: json: cannot unmarshal array into Go value of type map[string]interface {}")
+}
@@ -0,0 +1,203 @@
+package txpriority
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/armon/go-metrics"
+	"github.com/spf13/cast"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmrpccore "github.com/tendermint/tendermint/rpc/core"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FlagEnable is the app.toml setting that opts a node into rejecting low priority transactions under load.
+	FlagEnable = "tx-priority.enable"
+	// FlagFeeDenomWeights is the app.toml setting with the "denom=weight" entries that weigh a tx by its fee denom.
+	FlagFeeDenomWeights = "tx-priority.fee-denom-weights"
+	// FlagMsgTypeWeights is the app.toml setting with the "msg type url=weight" entries that weigh a tx by its msgs.
+	FlagMsgTypeWeights = "tx-priority.msg-type-weights"
+	// FlagDefaultMsgWeight is the app.toml setting with the weight of the msg types without a configured weight.
+	FlagDefaultMsgWeight = "tx-priority.default-msg-weight"
+	// FlagCongestionThreshold is the app.toml setting with the fraction of the mempool size above which it is congested.
+	FlagCongestionThreshold = "tx-priority.congestion-threshold"
+	// FlagMinPriority is the app.toml setting with the priority a new tx needs to enter a congested mempool.
+	FlagMinPriority = "tx-priority.min-priority"
+
+	// flagMempoolSize is the config.toml setting with the maximum number of transactions in the mempool.
+	flagMempoolSize = "mempool.size"
+
+	// DefaultMsgWeight is the weight of msg types without a configured weight when none is given.
+	DefaultMsgWeight = 10
+	// DefaultCongestionThreshold is the fraction of the mempool size above which it is congested when none is given.
+	DefaultCongestionThreshold = 0.8
+	// DefaultMinPriority is the priority needed to enter a congested mempool when none is given.
+	DefaultMinPriority = 100
+)
+
+// DefaultFeeDenomWeights are the fee denom weights used when none are configured.  Paying fees in nhash puts a tx
+// ahead of one paying in any other denom.
+var DefaultFeeDenomWeights = []string{"nhash=10"}
+
+// DefaultMsgTypeWeights are the msg type weights used when none are configured.  Governance msgs come first and
+// attribute msgs, the cheapest way to fill the mempool, last.
+var DefaultMsgTypeWeights = []string{
+	"/cosmos.gov.v1beta1.MsgSubmitProposal=100",
+	"/cosmos.gov.v1beta1.MsgDeposit=100",
+	"/cosmos.gov.v1beta1.MsgVote=100",
+	"/cosmos.gov.v1beta1.MsgVoteWeighted=100",
+	"/provenance.attribute.v1.MsgAddAttributeRequest=1",
+	"/provenance.attribute.v1.MsgUpdateAttributeRequest=1",
+	"/provenance.attribute.v1.MsgDeleteAttributeRequest=1",
+	"/provenance.attribute.v1.MsgDeleteDistinctAttributeRequest=1",
+	"/provenance.attribute.v1.MsgSetAttributesBatchRequest=1",
+}
+
+// MempoolFunc returns the number of transactions in the mempool.
+type MempoolFunc func() (int, error)
+
+// Prioritizer is an AnteDecorator that computes the priority of a transaction from the weight of its fee denom and
+// the weight of its msgs.  Tendermint's mempool does not order transactions by priority, so the priority is applied
+// when a transaction is first checked: once the mempool is congested only transactions with at least the minimum
+// priority are accepted.  Transactions are never rejected while rechecking the mempool or delivering a block.
+type Prioritizer struct {
+	feeDenomWeights  map[string]int64
+	msgTypeWeights   map[string]int64
+	defaultMsgWeight int64
+
+	// congestedSize is the number of transactions in the mempool from which it is congested.
+	congestedSize int
+	minPriority   int64
+	mempoolSize   MempoolFunc
+}
+
+var _ sdk.AnteDecorator = &Prioritizer{}
+
+// NewPrioritizer returns a new Prioritizer when tx priorities have been enabled in the app options, otherwise nil.
+func NewPrioritizer(appOpts servertypes.AppOptions) (*Prioritizer, error) {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil, nil
+	}
+	feeDenomWeights, err := parseWeights(appOpts.Get(FlagFeeDenomWeights), DefaultFeeDenomWeights, FlagFeeDenomWeights)
+	if err != nil {
+		return nil, err
+	}
+	msgTypeWeights, err := parseWeights(appOpts.Get(FlagMsgTypeWeights), DefaultMsgTypeWeights, FlagMsgTypeWeights)
+	if err != nil {
+		return nil, err
+	}
+	defaultMsgWeight := cast.ToInt64(appOpts.Get(FlagDefaultMsgWeight))
+	if defaultMsgWeight <= 0 {
+		defaultMsgWeight = DefaultMsgWeight
+	}
+	threshold := cast.ToFloat64(appOpts.Get(FlagCongestionThreshold))
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultCongestionThreshold
+	}
+	minPriority := cast.ToInt64(appOpts.Get(FlagMinPriority))
+	if minPriority <= 0 {
+		minPriority = DefaultMinPriority
+	}
+	mempoolSize := cast.ToInt(appOpts.Get(flagMempoolSize))
+	if mempoolSize <= 0 {
+		mempoolSize = tmcfg.DefaultMempoolConfig().Size
+	}
+	return &Prioritizer{
+		feeDenomWeights:  feeDenomWeights,
+		msgTypeWeights:   msgTypeWeights,
+		defaultMsgWeight: defaultMsgWeight,
+		congestedSize:    int(float64(mempoolSize) * threshold),
+		minPriority:      minPriority,
+		mempoolSize:      tendermintMempoolSize,
+	}, nil
+}
+
+// Priority returns the priority of the transaction, the weight of its fee denom times the weight of its msgs.  A fee
+// paid in several denoms uses the highest weight of them, and a fee denom without a configured weight (or no fee)
+// weighs 1.  A transaction is only as important as its least important msg, so its msgs weigh the lowest weight of
+// them, keeping a governance msg from carrying other msgs into a congested mempool.
+func (p *Prioritizer) Priority(tx sdk.Tx) int64 {
+	feeWeight := int64(1)
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		for _, coin := range feeTx.GetFee() {
+			if w, found := p.feeDenomWeights[coin.Denom]; found && coin.IsPositive() && w > feeWeight {
+				feeWeight = w
+			}
+		}
+	}
+	msgWeight := int64(0)
+	for _, msg := range tx.GetMsgs() {
+		w, found := p.msgTypeWeights[sdk.MsgTypeURL(msg)]
+		if !found {
+			w = p.defaultMsgWeight
+		}
+		if msgWeight == 0 || w < msgWeight {
+			msgWeight = w
+		}
+	}
+	if msgWeight == 0 {
+		msgWeight = p.defaultMsgWeight
+	}
+	return feeWeight * msgWeight
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (p *Prioritizer) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+	size, err := p.mempoolSize()
+	if err != nil || size < p.congestedSize {
+		return next(ctx, tx, simulate)
+	}
+	if priority := p.Priority(tx); priority < p.minPriority {
+		telemetry.IncrCounterWithLabels([]string{"tx", "priority", "rejected"}, 1,
+			[]metrics.Label{telemetry.NewLabel("priority", strconv.FormatInt(priority, 10))})
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull,
+			"mempool is congested with %d txs, tx priority %d is below the minimum of %d", size, priority, p.minPriority)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// parseWeights parses the "key=weight" entries of a setting, using the defaults when the setting is not given.
+func parseWeights(value interface{}, defaults []string, setting string) (map[string]int64, error) {
+	entries := cast.ToStringSlice(value)
+	if value == nil {
+		entries = defaults
+	}
+	weights := make(map[string]int64, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+			return nil, fmt.Errorf("invalid %s entry %q, expected key=weight", setting, entry)
+		}
+		w, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil || w <= 0 {
+			return nil, fmt.Errorf("invalid %s entry %q, weight must be a positive integer", setting, entry)
+		}
+		weights[strings.TrimSpace(parts[0])] = w
+	}
+	return weights, nil
+}
+
+// tendermintMempoolSize returns the number of transactions in the mempool of the tendermint node of this process.
+func tendermintMempoolSize() (size int, err error) {
+	defer func() {
+		// The tendermint rpc environment is not set up until the node has started.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("mempool is not available: %v", r)
+		}
+	}()
+	res, err := tmrpccore.NumUnconfirmedTxs(&tmrpctypes.Context{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Total, nil
+}
@@ -0,0 +1,130 @@
+package txpriority
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+var (
+	sendMsg  = &banktypes.MsgSend{}
+	voteMsg  = &govtypes.MsgVote{}
+	attrMsg  = &attributetypes.MsgAddAttributeRequest{}
+	nhashFee = sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	stakeFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+)
+
+func newTx(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+	return legacytx.NewStdTx(msgs, legacytx.NewStdFee(100000, fee), nil, "")
+}
+
+func newPrioritizer(t *testing.T, settings map[string]interface{}) *Prioritizer {
+	v := viper.New()
+	v.Set(FlagEnable, true)
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	p, err := NewPrioritizer(v)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	return p
+}
+
+func TestNewPrioritizer(t *testing.T) {
+	p, err := NewPrioritizer(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, p, "priorities not enabled")
+
+	p = newPrioritizer(t, nil)
+	require.Equal(t, map[string]int64{"nhash": 10}, p.feeDenomWeights)
+	require.Len(t, p.msgTypeWeights, len(DefaultMsgTypeWeights))
+	require.Equal(t, int64(DefaultMsgWeight), p.defaultMsgWeight)
+	require.Equal(t, int64(DefaultMinPriority), p.minPriority)
+	require.Equal(t, 4000, p.congestedSize, "default mempool size of 5000")
+
+	p = newPrioritizer(t, map[string]interface{}{
+		FlagFeeDenomWeights:     []string{"nhash=5", "stake = 2"},
+		FlagMsgTypeWeights:      []string{},
+		FlagDefaultMsgWeight:    3,
+		FlagCongestionThreshold: 0.5,
+		FlagMinPriority:         7,
+		flagMempoolSize:         100,
+	})
+	require.Equal(t, map[string]int64{"nhash": 5, "stake": 2}, p.feeDenomWeights)
+	require.Empty(t, p.msgTypeWeights)
+	require.Equal(t, int64(3), p.defaultMsgWeight)
+	require.Equal(t, int64(7), p.minPriority)
+	require.Equal(t, 50, p.congestedSize)
+
+	for _, weights := range [][]string{{"nhash"}, {"=5"}, {"nhash=0"}, {"nhash=five"}} {
+		v := viper.New()
+		v.Set(FlagEnable, true)
+		v.Set(FlagFeeDenomWeights, weights)
+		_, err = NewPrioritizer(v)
+		require.Error(t, err, "fee denom weights %v", weights)
+	}
+}
+
+func TestPriority(t *testing.T) {
+	p := newPrioritizer(t, nil)
+	tests := []struct {
+		name     string
+		tx       sdk.Tx
+		priority int64
+	}{
+		{"nhash send", newTx(nhashFee, sendMsg), 100},
+		{"other denom send", newTx(stakeFee, sendMsg), 10},
+		{"no fee send", newTx(nil, sendMsg), 10},
+		{"nhash vote", newTx(nhashFee, voteMsg), 1000},
+		{"nhash attribute", newTx(nhashFee, attrMsg), 10},
+		{"other denom attribute", newTx(stakeFee, attrMsg), 1},
+		{"both fee denoms", newTx(stakeFee.Add(nhashFee...), sendMsg), 100},
+		{"vote with attributes", newTx(nhashFee, voteMsg, attrMsg), 10},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.priority, p.Priority(tc.tx))
+		})
+	}
+}
+
+func TestAnteHandle(t *testing.T) {
+	p := newPrioritizer(t, map[string]interface{}{flagMempoolSize: 10})
+	size := 0
+	p.mempoolSize = func() (int, error) { return size, nil }
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	checkCtx := sdk.Context{}.WithIsCheckTx(true)
+	spam := newTx(stakeFee, attrMsg)
+
+	_, err := p.AnteHandle(checkCtx, spam, false, next)
+	require.NoError(t, err, "empty mempool")
+
+	size = 8
+	_, err = p.AnteHandle(checkCtx, spam, false, next)
+	require.Error(t, err, "congested mempool")
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+	require.EqualError(t, err, "mempool is congested with 8 txs, tx priority 1 is below the minimum of 100: mempool is full")
+
+	_, err = p.AnteHandle(checkCtx, newTx(nhashFee, voteMsg), false, next)
+	require.NoError(t, err, "high priority tx in congested mempool")
+	_, err = p.AnteHandle(checkCtx.WithIsReCheckTx(true), spam, false, next)
+	require.NoError(t, err, "recheck")
+	_, err = p.AnteHandle(checkCtx, spam, true, next)
+	require.NoError(t, err, "simulate")
+	_, err = p.AnteHandle(sdk.Context{}, spam, false, next)
+	require.NoError(t, err, "deliver")
+
+	p.mempoolSize = func() (int, error) { return 0, errors.New("mempool is not available") }
+	_, err = p.AnteHandle(checkCtx, spam, false, next)
+	require.NoError(t, err, "mempool not available")
+}
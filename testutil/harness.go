@@ -0,0 +1,262 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+const (
+	// DefaultTxGas is the gas limit of the txs broadcast with Network.BroadcastTx.
+	DefaultTxGas = 400000
+	// DefaultMarkerHolding is the amount of each genesis marker's coin held by each genesis account.
+	DefaultMarkerHolding = 1000000
+)
+
+// NetworkConfig configures the network started by NewNetwork.
+type NetworkConfig struct {
+	network.Config
+
+	// NumAccounts is the number of funded genesis accounts, with keys in the network keyring.
+	NumAccounts int
+	// MarkerDenoms are the denoms of the active coin markers in genesis, managed by the first account.  Every account
+	// holds DefaultMarkerHolding of each.
+	MarkerDenoms []string
+	// RootNames are the root names in genesis, bound unrestricted to the first account so that every account can bind
+	// names under them.
+	RootNames []string
+	// NumScopes is the number of sample scopes in genesis, owned by the accounts in turn.
+	NumScopes int
+}
+
+// DefaultNetworkConfig returns the config of a single validator network with the Provenance sample genesis.
+func DefaultNetworkConfig() NetworkConfig {
+	cfg := DefaultTestNetworkConfig()
+	cfg.NumValidators = 1
+	return NetworkConfig{
+		Config:       cfg,
+		NumAccounts:  3,
+		MarkerDenoms: []string{"testcoin"},
+		RootNames:    []string{"pb", "pio", "provenance"},
+		NumScopes:    2,
+	}
+}
+
+// Network is an in-process Provenance network for end-to-end tests.  Its genesis has funded accounts, markers, root
+// names and sample scopes, see NetworkConfig.
+type Network struct {
+	*network.Network
+
+	// Keyring holds the keys of the Accounts.
+	Keyring keyring.Keyring
+	// Accounts are the funded genesis accounts.
+	Accounts []keyring.Info
+	// Markers are the markers in genesis.
+	Markers []markertypes.MarkerAccount
+	// RootNames are the root name records in genesis.
+	RootNames []nametypes.NameRecord
+	// ScopeSpec and ContractSpec are the specifications of the Scopes in genesis.
+	ScopeSpec    metadatatypes.ScopeSpecification
+	ContractSpec metadatatypes.ContractSpecification
+	Scopes       []metadatatypes.Scope
+}
+
+// NewNetwork starts an in-process network with the Provenance sample genesis and waits for its first block.  The
+// network must be stopped with Cleanup, and only one network can run at a time.
+func NewNetwork(t *testing.T, cfg NetworkConfig) *Network {
+	n := &Network{Keyring: keyring.NewInMemory()}
+	path := hd.CreateHDPath(sdk.CoinType, 0, 0).String()
+	for i := 0; i < cfg.NumAccounts; i++ {
+		info, _, err := n.Keyring.NewMnemonic(fmt.Sprintf("account%d", i), keyring.English, path,
+			keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		if err != nil {
+			t.Fatalf("could not create account key: %v", err)
+		}
+		n.Accounts = append(n.Accounts, info)
+	}
+	if err := n.buildGenesis(&cfg); err != nil {
+		t.Fatalf("could not build genesis: %v", err)
+	}
+
+	n.Network = network.New(t, cfg.Config)
+	if _, err := n.WaitForHeight(1); err != nil {
+		t.Fatalf("network did not produce a block: %v", err)
+	}
+	return n
+}
+
+// buildGenesis adds the accounts, markers, root names and sample scopes to the genesis state of the config.
+func (n *Network) buildGenesis(cfg *NetworkConfig) error {
+	genesisState := cfg.GenesisState
+	cdc := cfg.Codec
+	if len(n.Accounts) == 0 && (len(cfg.MarkerDenoms) > 0 || len(cfg.RootNames) > 0 || cfg.NumScopes > 0) {
+		return fmt.Errorf("markers, root names and sample scopes need at least one account")
+	}
+	var owner sdk.AccAddress
+	if len(n.Accounts) > 0 {
+		owner = n.Accounts[0].GetAddress()
+	}
+
+	var genAccounts []authtypes.GenesisAccount
+	var balances []banktypes.Balance
+	for _, account := range n.Accounts {
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(account.GetAddress(), nil, 0, 0))
+		coins := sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, cfg.StakingTokens))
+		for _, denom := range cfg.MarkerDenoms {
+			coins = coins.Add(sdk.NewInt64Coin(denom, DefaultMarkerHolding))
+		}
+		balances = append(balances, banktypes.Balance{Address: account.GetAddress().String(), Coins: coins})
+	}
+	packed, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState)
+	authGenState.Accounts = append(authGenState.Accounts, packed...)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = append(bankGenState.Balances, balances...)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var markerGenState markertypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[markertypes.ModuleName], &markerGenState)
+	for _, denom := range cfg.MarkerDenoms {
+		marker := markertypes.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, int64(DefaultMarkerHolding*len(n.Accounts))),
+			nil,
+			[]markertypes.AccessGrant{*markertypes.NewAccessGrant(owner, []markertypes.Access{
+				markertypes.Access_Mint, markertypes.Access_Burn, markertypes.Access_Deposit,
+				markertypes.Access_Withdraw, markertypes.Access_Admin,
+			})},
+			markertypes.StatusActive,
+			markertypes.MarkerType_Coin,
+		)
+		if err = marker.Validate(); err != nil {
+			return fmt.Errorf("invalid marker %s: %w", denom, err)
+		}
+		n.Markers = append(n.Markers, *marker)
+	}
+	markerGenState.Markers = append(markerGenState.Markers, n.Markers...)
+	genesisState[markertypes.ModuleName] = cdc.MustMarshalJSON(&markerGenState)
+
+	var nameGenState nametypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[nametypes.ModuleName], &nameGenState)
+	for _, name := range cfg.RootNames {
+		n.RootNames = append(n.RootNames, nametypes.NewNameRecord(name, owner, false))
+	}
+	nameGenState.Bindings = append(nameGenState.Bindings, n.RootNames...)
+	genesisState[nametypes.ModuleName] = cdc.MustMarshalJSON(&nameGenState)
+
+	if cfg.NumScopes > 0 {
+		n.ContractSpec = *metadatatypes.NewContractSpecification(
+			metadatatypes.ContractSpecMetadataAddress(uuid.New()),
+			metadatatypes.NewDescription("sample contract", "contract specification of the sample scopes", "", ""),
+			[]string{owner.String()},
+			[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+			metadatatypes.NewContractSpecificationSourceHash("samplecontracthash"),
+			"io.provenance.SampleContract",
+		)
+		n.ScopeSpec = *metadatatypes.NewScopeSpecification(
+			metadatatypes.ScopeSpecMetadataAddress(uuid.New()),
+			metadatatypes.NewDescription("sample scope", "scope specification of the sample scopes", "", ""),
+			[]string{owner.String()},
+			[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+			[]metadatatypes.MetadataAddress{n.ContractSpec.SpecificationId},
+		)
+		for i := 0; i < cfg.NumScopes; i++ {
+			scopeOwner := n.Accounts[i%len(n.Accounts)].GetAddress().String()
+			n.Scopes = append(n.Scopes, *metadatatypes.NewScope(
+				metadatatypes.ScopeMetadataAddress(uuid.New()),
+				n.ScopeSpec.SpecificationId,
+				[]metadatatypes.Party{{Address: scopeOwner, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+				[]string{scopeOwner},
+				scopeOwner,
+			))
+		}
+		var metadataGenState metadatatypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[metadatatypes.ModuleName], &metadataGenState)
+		metadataGenState.ContractSpecifications = append(metadataGenState.ContractSpecifications, n.ContractSpec)
+		metadataGenState.ScopeSpecifications = append(metadataGenState.ScopeSpecifications, n.ScopeSpec)
+		metadataGenState.Scopes = append(metadataGenState.Scopes, n.Scopes...)
+		genesisState[metadatatypes.ModuleName] = cdc.MustMarshalJSON(&metadataGenState)
+	}
+
+	return nil
+}
+
+// ClientCtx returns the client context of the first validator with the network keyring.  It can also be used as the
+// connection of module query clients, e.g. markertypes.NewQueryClient(n.ClientCtx()).
+func (n *Network) ClientCtx() client.Context {
+	return n.Validators[0].ClientCtx.WithKeyring(n.Keyring)
+}
+
+// BroadcastTx signs the msgs with the key of the from account in the network keyring and broadcasts them in a tx,
+// waiting for the tx to be included in a block.  An error is returned when the tx fails.
+func (n *Network) BroadcastTx(from sdk.AccAddress, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	info, err := n.Keyring.KeyByAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	clientCtx := n.ClientCtx().WithFromAddress(from).WithFromName(info.GetName()).WithBroadcastMode(flags.BroadcastBlock)
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, from)
+	if err != nil {
+		return nil, err
+	}
+	txf := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(n.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq).
+		WithGas(DefaultTxGas).
+		WithGasPrices(n.Config.MinGasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(txf, info.GetName(), txb, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
+
+// ExecCLI runs a CLI command against the network with the network keyring, returning its output.
+func (n *Network) ExecCLI(cmd *cobra.Command, args ...string) (sdktestutil.BufferWriter, error) {
+	return clitestutil.ExecTestCLICmd(n.ClientCtx(), cmd, args)
+}
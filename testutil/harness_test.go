@@ -0,0 +1,82 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatacli "github.com/provenance-io/provenance/x/metadata/client/cli"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+type NetworkTestSuite struct {
+	suite.Suite
+
+	network *Network
+}
+
+func TestNetworkTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkTestSuite))
+}
+
+func (s *NetworkTestSuite) SetupSuite() {
+	s.network = NewNetwork(s.T(), DefaultNetworkConfig())
+}
+
+func (s *NetworkTestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+func (s *NetworkTestSuite) TestGenesis() {
+	ctx := context.Background()
+	clientCtx := s.network.ClientCtx()
+
+	marker, err := markertypes.NewQueryClient(clientCtx).Marker(ctx, &markertypes.QueryMarkerRequest{Id: "testcoin"})
+	s.Require().NoError(err, "marker query")
+	var account markertypes.MarkerAccountI
+	s.Require().NoError(clientCtx.InterfaceRegistry.UnpackAny(marker.Marker, &account))
+	s.Assert().Equal(markertypes.StatusActive, account.GetStatus())
+	s.Assert().Equal(sdk.NewInt(3*DefaultMarkerHolding), account.GetSupply().Amount)
+
+	owner := s.network.Accounts[0].GetAddress()
+	resolved, err := nametypes.NewQueryClient(clientCtx).Resolve(ctx, &nametypes.QueryResolveRequest{Name: "pb"})
+	s.Require().NoError(err, "resolve query")
+	s.Assert().Equal(owner.String(), resolved.Address)
+
+	scope := s.network.Scopes[1]
+	out, err := s.network.ExecCLI(metadatacli.GetMetadataScopeCmd(), scope.ScopeId.String(), "--output=json")
+	s.Require().NoError(err, "scope command")
+	s.Assert().Contains(out.String(), s.network.Accounts[1].GetAddress().String())
+}
+
+func (s *NetworkTestSuite) TestBroadcastTx() {
+	from := s.network.Accounts[1].GetAddress()
+	to := s.network.Accounts[2].GetAddress()
+
+	res, err := s.network.BroadcastTx(from, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))))
+	s.Require().NoError(err, "send")
+	s.Assert().NotEmpty(res.TxHash)
+	balance, err := banktypes.NewQueryClient(s.network.ClientCtx()).Balance(context.Background(),
+		&banktypes.QueryBalanceRequest{Address: to.String(), Denom: "testcoin"})
+	s.Require().NoError(err, "balance query")
+	s.Assert().Equal(sdk.NewInt64Coin("testcoin", DefaultMarkerHolding+10), *balance.Balance)
+
+	// The root names are unrestricted, so the signer binding a name under one is the parent address of the msg.
+	bind := nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("harness", from, false), nametypes.NewNameRecord("pb", from, false))
+	_, err = s.network.BroadcastTx(from, bind)
+	s.Require().NoError(err, "bind name under a root name")
+	resolved, err := nametypes.NewQueryClient(s.network.ClientCtx()).Resolve(context.Background(), &nametypes.QueryResolveRequest{Name: "harness.pb"})
+	s.Require().NoError(err, "resolve query")
+	s.Assert().Equal(from.String(), resolved.Address)
+
+	scope := s.network.Scopes[0]
+	_, err = s.network.BroadcastTx(from, metadatatypes.NewMsgDeleteScopeRequest(scope.ScopeId, []string{from.String()}))
+	s.Require().Error(err, "delete a scope of another account")
+	s.Assert().Contains(err.Error(), "failed with code")
+}
@@ -462,12 +462,12 @@ func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_value_length\":128}",
+			"{\"max_value_length\":128,\"max_encrypted_value_length\":0}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			"max_value_length: 128",
+			"max_encrypted_value_length: 0\nmax_value_length: 128",
 		},
 	}
 
@@ -485,6 +485,51 @@ func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetNormalizeAttributeNameCmd() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{
+			"json output",
+			[]string{" Example.Attribute ", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"{\"name\":\"example.attribute\"}",
+		},
+		{
+			"text output",
+			[]string{"EXAMPLE.attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			false,
+			"name: example.attribute",
+		},
+		{
+			"invalid name",
+			[]string{"example..attribute"},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetNormalizeAttributeNameCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestAttributeTxCommands() {
 
 	testCases := []struct {
@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountAttributeCmd(),
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
+		GetAttributeValuesCmd(),
+		GetNormalizeAttributeNameCmd(),
 	)
 
 	return queryCmd
@@ -211,6 +213,89 @@ $ %s query attribute scan pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk name.suffix
 	return cmd
 }
 
+// GetAttributeValuesCmd gets the distinct values stored under an attribute name across all accounts.
+func GetAttributeValuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "values [name]",
+		Short: "Get the distinct values of an attribute name across all accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the distinct values stored under an attribute name and the number of accounts each is stored on:
+
+Example:
+$ %s query attribute values attrib.name
+$ %s query attribute values attrib.name --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryAttributeValuesResponse
+			if response, err = queryClient.AttributeValues(
+				context.Background(),
+				&types.QueryAttributeValuesRequest{Name: name, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query attribute values for name \"%s\": %v\n", name, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "values")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetNormalizeAttributeNameCmd gets an attribute name in the normalized format it is stored under.
+func GetNormalizeAttributeNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "normalize [name]",
+		Short: "Get an attribute name in normalized format",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the normalized format an attribute name is stored under:
+
+Example:
+$ %s query attribute normalize " Attrib.Name "
+`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryNormalizeResponse
+			if response, err = queryClient.Normalize(
+				context.Background(),
+				&types.QueryNormalizeRequest{Name: args[0]},
+			); err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
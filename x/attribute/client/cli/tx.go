@@ -2,19 +2,25 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/provenance-io/provenance/internal/txbroadcast"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+// FlagRecipients is the flag for the other accounts an encrypted attribute value is encrypted for.
+const FlagRecipients = "recipients"
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -30,7 +36,12 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateAccountAttributeCmd(),
 		NewDeleteDistinctAccountAttributeCmd(),
 		NewDeleteAccountAttributeCmd(),
+		NewAddAccountAttestationCmd(),
+		NewSignAttestationCmd(),
+		NewAddAccountEncryptedAttributeCmd(),
+		NewSetAccountAttributesBatchCmd(),
 	)
+	txbroadcast.AddFlags(txCmd.PersistentFlags())
 	return txCmd
 }
 
@@ -57,7 +68,7 @@ func NewAddAccountAttributeCmd() *cobra.Command {
 				return fmt.Errorf("account attribute type is invalid: %w", err)
 			}
 			valueString := strings.TrimSpace(args[3])
-			value, err := encodeAttributeValue(valueString, attributeType)
+			value, err := EncodeAttributeValue(valueString, attributeType)
 			if err != nil {
 				return fmt.Errorf("error encoding value %s to type %s : %v", valueString, attributeType.String(), err)
 			}
@@ -69,7 +80,7 @@ func NewAddAccountAttributeCmd() *cobra.Command {
 				attributeType,
 				value,
 			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -105,12 +116,12 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 				return fmt.Errorf("account attribute type is invalid: %w", err)
 			}
 			origValArg := strings.TrimSpace(args[3])
-			origValue, err := encodeAttributeValue(origValArg, origAttributeType)
+			origValue, err := EncodeAttributeValue(origValArg, origAttributeType)
 			if err != nil {
 				return fmt.Errorf("error encoding value %s to type %s : %v", origValArg, origAttributeType.String(), err)
 			}
 			updateValArg := strings.TrimSpace(args[5])
-			updateValue, err := encodeAttributeValue(updateValArg, updateAttributeType)
+			updateValue, err := EncodeAttributeValue(updateValArg, updateAttributeType)
 			if err != nil {
 				return fmt.Errorf("error encoding value %s to type %s : %v", updateValArg, updateAttributeType.String(), err)
 			}
@@ -124,18 +135,205 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 				origAttributeType,
 				updateAttributeType,
 			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddAccountAttestationCmd creates a command for adding an attestation attribute signed by the name owner.
+func NewAddAccountAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-attestation [name] [address] [type] [value] [signature]",
+		Aliases: []string{"aa"},
+		Short:   "Add an account attestation attribute signed by the name owner to the provenance blockchain",
+		Long: strings.TrimSpace(`Add an account attestation attribute to the provenance blockchain.  The base64 encoded
+signature must be made by the owner of the attribute name (see sign-attestation), the transaction itself
+may be signed by any account.`),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, valueType, value, err := parseAttestationArgs(args)
+			if err != nil {
+				return err
+			}
+			signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(args[4]))
+			if err != nil {
+				return fmt.Errorf("attestation signature must be base64 encoded: %w", err)
+			}
+			attestation := types.NewAttestation(valueType, value, signature)
+			bz, err := attestation.Marshal()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAttributeRequest(
+				account,
+				clientCtx.GetFromAddress(),
+				args[0],
+				types.AttributeType_Attestation,
+				bz,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSignAttestationCmd creates a command for signing an attestation value with the name owner key.
+func NewSignAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sign-attestation [name] [address] [type] [value]",
+		Aliases: []string{"sa"},
+		Short:   "Sign an account attestation value with the name owner key and print the base64 encoded signature",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, valueType, value, err := parseAttestationArgs(args)
+			if err != nil {
+				return err
+			}
+			signBytes := types.AttestationSignBytes(strings.ToLower(strings.TrimSpace(args[0])), account.String(), valueType, value)
+			signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(base64.StdEncoding.EncodeToString(signature) + "\n")
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddAccountEncryptedAttributeCmd creates a command for adding an account attribute with a value encrypted for the
+// account.
+func NewAddAccountEncryptedAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-encrypted [name] [address] [type] [value]",
+		Aliases: []string{"ae"},
+		Short:   "Add an account attribute with a value encrypted for the account to the provenance blockchain",
+		Long: strings.TrimSpace(`Add an account attribute with a value that only the account can decrypt to the provenance blockchain.
+The value is encrypted for the public key of the account fetched from the chain, so the account must have signed
+a transaction before.  Use --recipients to also encrypt the value for other accounts, e.g. the name owner.`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+			valueType, err := types.AttributeTypeFromString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("account attribute type is invalid: %w", err)
+			}
+			if valueType == types.AttributeType_Encrypted {
+				return fmt.Errorf("encrypted value type cannot be %s", valueType)
+			}
+			valueString := strings.TrimSpace(args[3])
+			value, err := EncodeAttributeValue(valueString, valueType)
+			if err != nil {
+				return fmt.Errorf("error encoding value %s to type %s : %v", valueString, valueType.String(), err)
+			}
+			recipientAddrs, err := cmd.Flags().GetStringSlice(FlagRecipients)
+			if err != nil {
+				return err
+			}
+			recipients := make([]cryptotypes.PubKey, 0, len(recipientAddrs)+1)
+			for _, addr := range append([]string{account.String()}, recipientAddrs...) {
+				pubKey, err := getAccountPubKey(clientCtx, addr)
+				if err != nil {
+					return err
+				}
+				recipients = append(recipients, pubKey)
+			}
+			envelope, err := types.SealEncryptedEnvelope(valueType, value, recipients)
+			if err != nil {
+				return err
+			}
+			bz, err := envelope.Marshal()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAttributeRequest(
+				account,
+				clientCtx.GetFromAddress(),
+				args[0],
+				types.AttributeType_Encrypted,
+				bz,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagRecipients, []string{}, "Addresses of other accounts that can decrypt the value")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
+// getAccountPubKey fetches the public key of an account from the chain.
+func getAccountPubKey(clientCtx client.Context, address string) (cryptotypes.PubKey, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address must be a Bech32 string: %w", err)
+	}
+	acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get account %s: %w", address, err)
+	}
+	if acc.GetPubKey() == nil {
+		return nil, fmt.Errorf("no public key found for account %s", address)
+	}
+	return acc.GetPubKey(), nil
+}
+
+// parseAttestationArgs parses the address, value type, and value arguments shared by the attestation commands.
+func parseAttestationArgs(args []string) (sdk.AccAddress, types.AttributeType, []byte, error) {
+	account, err := sdk.AccAddressFromBech32(args[1])
+	if err != nil {
+		return nil, types.AttributeType_Unspecified, nil, fmt.Errorf("account address must be a Bech32 string: %w", err)
+	}
+	valueType, err := types.AttributeTypeFromString(strings.TrimSpace(args[2]))
+	if err != nil {
+		return nil, types.AttributeType_Unspecified, nil, fmt.Errorf("account attribute type is invalid: %w", err)
+	}
+	if valueType == types.AttributeType_Attestation {
+		return nil, types.AttributeType_Unspecified, nil, fmt.Errorf("attestation value type cannot be %s", valueType)
+	}
+	valueString := strings.TrimSpace(args[3])
+	value, err := EncodeAttributeValue(valueString, valueType)
+	if err != nil {
+		return nil, types.AttributeType_Unspecified, nil, fmt.Errorf("error encoding value %s to type %s : %v", valueString, valueType.String(), err)
+	}
+	return account, valueType, value, nil
+}
+
+// EncodeAttributeValue converts a command line value to the bytes stored for the given attribute type.
+func EncodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
-	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
+	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto || attrType == types.AttributeType_Attestation ||
+		attrType == types.AttributeType_Encrypted {
 		var err error
 		if encodedValue, err = base64.StdEncoding.DecodeString(value); err != nil {
 			return nil, err
@@ -167,12 +365,12 @@ func NewDeleteDistinctAccountAttributeCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("account attribute type is invalid: %w", err)
 			}
-			deleteValue, err := encodeAttributeValue(strings.TrimSpace(args[3]), attributeType)
+			deleteValue, err := EncodeAttributeValue(strings.TrimSpace(args[3]), attributeType)
 			if err != nil {
 				return fmt.Errorf("error encoding value %s to type %s : %v", deleteValue, attributeType.String(), err)
 			}
 			msg := types.NewMsgDeleteDistinctAttributeRequest(account, clientCtx.GetFromAddress(), args[0], deleteValue)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -203,7 +401,73 @@ func NewDeleteAccountAttributeCmd() *cobra.Command {
 				clientCtx.GetFromAddress(),
 				args[0],
 			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// batchFileEntry is an entry of the file read by the set-batch command.
+type batchFileEntry struct {
+	Account string `json:"account"`
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Delete  bool   `json:"delete,omitempty"`
+}
+
+// NewSetAccountAttributesBatchCmd creates a command for setting or deleting attributes on many accounts at once.
+func NewSetAccountAttributesBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-batch [file]",
+		Aliases: []string{"sb"},
+		Short:   "Set or delete account attributes on many accounts in a single transaction",
+		Long: fmt.Sprintf(`Set or delete account attributes on many accounts in a single transaction.
+The file contains a JSON list of at most %d entries, each with an account, name, type and value.
+A set entry replaces any existing values of the name on the account.
+An entry with "delete": true removes all values of the name from the account.`, types.MaxAttributesBatchSize),
+		Example: `[
+  {"account": "pb1...", "name": "kyc.provider.pb", "type": "string", "value": "approved"},
+  {"account": "pb1...", "name": "kyc.provider.pb", "delete": true}
+]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var fileEntries []batchFileEntry
+			if err = json.Unmarshal(bz, &fileEntries); err != nil {
+				return fmt.Errorf("invalid batch file %s: %w", args[0], err)
+			}
+			entries := make([]types.AttributeBatchEntry, len(fileEntries))
+			for i, fe := range fileEntries {
+				if _, err = sdk.AccAddressFromBech32(fe.Account); err != nil {
+					return fmt.Errorf("entry %d account address must be a Bech32 string: %w", i, err)
+				}
+				entries[i] = types.AttributeBatchEntry{Account: fe.Account, Name: fe.Name, Delete: fe.Delete}
+				if fe.Delete {
+					continue
+				}
+				if entries[i].AttributeType, err = types.AttributeTypeFromString(strings.TrimSpace(fe.Type)); err != nil {
+					return fmt.Errorf("entry %d account attribute type is invalid: %w", i, err)
+				}
+				valueString := strings.TrimSpace(fe.Value)
+				if entries[i].Value, err = EncodeAttributeValue(valueString, entries[i].AttributeType); err != nil {
+					return fmt.Errorf("entry %d error encoding value %s to type %s : %v", i, valueString, entries[i].AttributeType.String(), err)
+				}
+			}
+
+			msg := types.NewMsgSetAttributesBatchRequest(clientCtx.GetFromAddress(), entries)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
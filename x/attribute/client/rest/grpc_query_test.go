@@ -119,7 +119,7 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 			},
 			false,
 			&attributetypes.QueryParamsResponse{},
-			&attributetypes.QueryParamsResponse{Params: attributetypes.NewParams(32)},
+			&attributetypes.QueryParamsResponse{Params: attributetypes.NewParams(32, 0)},
 		},
 		{
 			"get account attributes",
@@ -201,3 +201,50 @@ func (s HandlerTestSuite) TestMsgDeleteAttributeRequest() {
 	}
 	s.runTests(cases)
 }
+
+func (s HandlerTestSuite) TestMsgSetAttributesBatchRequest() {
+	user2Addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	testAttr := types.Attribute{
+		Address:       s.user1,
+		Name:          "example.name",
+		Value:         []byte("value"),
+		AttributeType: types.AttributeType_String,
+	}
+	var attrData types.GenesisState
+	attrData.Attributes = append(attrData.Attributes, testAttr)
+	attrData.Params.MaxValueLength = 100
+	s.app.AttributeKeeper.InitGenesis(s.ctx, &attrData)
+
+	msg := types.NewMsgSetAttributesBatchRequest(s.user1Addr, []types.AttributeBatchEntry{
+		{Account: s.user1, Name: "example.name", Value: []byte("replaced"), AttributeType: types.AttributeType_String},
+		{Account: user2Addr.String(), Name: "example.name", Value: []byte("new"), AttributeType: types.AttributeType_String},
+		{Account: user2Addr.String(), Name: "unknown.name", Value: []byte("new"), AttributeType: types.AttributeType_String},
+		{Account: user2Addr.String(), Name: "name", Delete: true},
+	})
+	result, err := s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	var response types.MsgSetAttributesBatchResponse
+	s.Require().NoError(response.Unmarshal(result.Data))
+	s.Require().Len(response.Results, 4)
+	s.True(response.Results[0].Success, "replace existing attribute")
+	s.True(response.Results[1].Success, "add attribute to new account")
+	s.False(response.Results[2].Success, "unbound name")
+	s.Equal("no address bound to name", response.Results[2].Error)
+	s.False(response.Results[3].Success, "delete missing attribute")
+	s.Equal("no keys deleted with name name", response.Results[3].Error)
+
+	s.True(s.containsMessage(result, types.NewEventAttributeDelete("example.name", s.user1, s.user1)))
+	s.True(s.containsMessage(result, types.NewEventAttributeAdd(types.Attribute{
+		Address: s.user1, Name: "example.name", Value: []byte("replaced"), AttributeType: types.AttributeType_String,
+	}, s.user1)))
+
+	attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1Addr, "example.name")
+	s.Require().NoError(err)
+	s.Require().Len(attrs, 1)
+	s.Equal([]byte("replaced"), attrs[0].Value)
+	attrs, err = s.app.AttributeKeeper.GetAttributes(s.ctx, user2Addr, "example.name")
+	s.Require().NoError(err)
+	s.Require().Len(attrs, 1)
+	s.Equal([]byte("new"), attrs[0].Value)
+}
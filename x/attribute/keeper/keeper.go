@@ -34,6 +34,9 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// Notified after the attributes of an account change, nil when there are none.
+	hooks types.AttributeHooks
 }
 
 // NewKeeper returns an attribute keeper. It handles:
@@ -59,6 +62,15 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the hooks notified after the attributes of an account change.
+func (k *Keeper) SetHooks(hooks types.AttributeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set attribute hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -76,14 +88,28 @@ func (k Keeper) GetAllAttributes(ctx sdk.Context, acc sdk.AccAddress) ([]types.A
 func (k Keeper) GetAttributes(ctx sdk.Context, acc sdk.AccAddress, name string) ([]types.Attribute, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "get")
 
-	name = strings.ToLower(strings.TrimSpace(name))
-	if _, err := k.nameKeeper.GetRecordByName(ctx, name); err != nil { // Ensure name exists (ie was bound to an address)
+	name, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
 		return nil, err
 	}
-	pred := func(s string) bool { return strings.EqualFold(s, name) }
+	if _, err = k.nameKeeper.GetRecordByName(ctx, name); err != nil { // Ensure name exists (ie was bound to an address)
+		return nil, err
+	}
+	pred := func(s string) bool { return s == name }
 	return k.prefixScan(ctx, types.AccountAttributesNameKeyPrefix(acc, name), pred)
 }
 
+// AccountHasAttribute returns true if the account holds at least one attribute with the given name.
+func (k Keeper) AccountHasAttribute(ctx sdk.Context, acc sdk.AccAddress, name string) bool {
+	name, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return false
+	}
+	attrs, err := k.prefixScan(ctx, types.AccountAttributesNameKeyPrefix(acc, name),
+		func(s string) bool { return s == name })
+	return err == nil && len(attrs) > 0
+}
+
 // IterateRecords iterates over all the stored attribute records and passes them to a callback function.
 func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a attribute record iterator
@@ -126,6 +152,9 @@ func (k Keeper) SetAttribute(
 	if int(maxLength) < len(attr.Value) {
 		return fmt.Errorf("attribute value length of %v exceeds max length %v", len(attr.Value), maxLength)
 	}
+	if err := k.checkEncryptedValueLength(ctx, attr); err != nil {
+		return err
+	}
 
 	normalizedName, err := k.nameKeeper.Normalize(ctx, attr.Name)
 	if err != nil {
@@ -136,8 +165,13 @@ func (k Keeper) SetAttribute(
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
 	}
-	// Verify name resolves to owner
-	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
+	if attr.AttributeType == types.AttributeType_Attestation {
+		// Attestations are signed by the name owner so they may be submitted by anyone.
+		if err = k.verifyAttestation(ctx, attr); err != nil {
+			return err
+		}
+	} else if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
+		// Verify name resolves to owner
 		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", attr.Name, owner.String())
 	}
 	// Store the sanitized account attribute
@@ -153,13 +187,83 @@ func (k Keeper) SetAttribute(
 	key := types.AccountAttributeKey(addr, attr)
 
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		k.incrementAttributeValueCount(ctx, attr)
+	}
 	store.Set(key, bz)
 
 	attributeAddEvent := types.NewEventAttributeAdd(attr, owner.String())
 	if err := ctx.EventManager().EmitTypedEvent(attributeAddEvent); err != nil {
 		return err
 	}
+	if k.hooks != nil {
+		k.hooks.AfterAttributeAdded(ctx, attr)
+	}
+
+	return nil
+}
+
+// ReplaceAttribute stores an attribute under the given account in place of any existing attributes with the same name.
+// The attribute name must resolve to the given owner address.
+func (k Keeper) ReplaceAttribute(ctx sdk.Context, attr types.Attribute, owner sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "replace")
+
+	acc, err := sdk.AccAddressFromBech32(attr.Address)
+	if err != nil {
+		return err
+	}
+	normalizedName, err := k.nameKeeper.Normalize(ctx, attr.Name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", attr.Name, err)
+	}
+	existing, err := k.GetAttributes(ctx, acc, normalizedName)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		if err = k.DeleteAttribute(ctx, acc, normalizedName, nil, owner); err != nil {
+			return err
+		}
+	}
+	return k.SetAttribute(ctx, attr, owner)
+}
+
+// verifyAttestation ensures the attestation attribute value was signed by the current owner of the attribute name
+// using the public key recorded on the owner account.
+func (k Keeper) verifyAttestation(ctx sdk.Context, attr types.Attribute) error {
+	attestation, err := types.ParseAttestation(attr.Value)
+	if err != nil {
+		return err
+	}
+	record, err := k.nameKeeper.GetRecordByName(ctx, attr.Name)
+	if err != nil {
+		return err
+	}
+	nameOwner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	ownerAcc := k.authKeeper.GetAccount(ctx, nameOwner)
+	if ownerAcc == nil || ownerAcc.GetPubKey() == nil {
+		return fmt.Errorf("no public key found for owner \"%s\" of name \"%s\"", record.Address, attr.Name)
+	}
+	signBytes := types.AttestationSignBytes(attr.Name, attr.Address, attestation.ValueType, attestation.Value)
+	if !ownerAcc.GetPubKey().VerifySignature(signBytes, attestation.Signature) {
+		return fmt.Errorf("invalid attestation signature for name \"%s\"", attr.Name)
+	}
+	return nil
+}
 
+// checkEncryptedValueLength ensures an encrypted envelope attribute value does not exceed the max encrypted value
+// length.
+func (k Keeper) checkEncryptedValueLength(ctx sdk.Context, attr types.Attribute) error {
+	if attr.AttributeType != types.AttributeType_Encrypted {
+		return nil
+	}
+	maxLength := k.GetMaxEncryptedValueLength(ctx)
+	if int(maxLength) < len(attr.Value) {
+		return fmt.Errorf("encrypted attribute value length of %v exceeds max length %v", len(attr.Value), maxLength)
+	}
 	return nil
 }
 
@@ -184,6 +288,9 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	if int(maxLength) < len(updateAttribute.Value) {
 		return fmt.Errorf("update attribute value length of %v exceeds max length %v", len(updateAttribute.Value), maxLength)
 	}
+	if err = k.checkEncryptedValueLength(ctx, updateAttribute); err != nil {
+		return err
+	}
 
 	normalizedName, err := k.nameKeeper.Normalize(ctx, updateAttribute.Name)
 	if err != nil {
@@ -209,6 +316,12 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", updateAttribute.Name, owner.String())
 	}
 
+	if updateAttribute.AttributeType == types.AttributeType_Attestation {
+		if err = k.verifyAttestation(ctx, updateAttribute); err != nil {
+			return err
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	it := sdk.KVStorePrefixIterator(store, types.AccountAttributesNameKeyPrefix(accountAddress, normalizedOrigName))
 	var found bool
@@ -221,18 +334,25 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 		if attr.Name == updateAttribute.Name && bytes.Equal(attr.Value, originalAttribute.Value) && attr.AttributeType == originalAttribute.AttributeType {
 			found = true
 			store.Delete(it.Key())
+			k.decrementAttributeValueCount(ctx, attr)
 
 			bz, err := k.cdc.Marshal(&updateAttribute)
 			if err != nil {
 				return err
 			}
 			updatedKey := types.AccountAttributeKey(accountAddress, updateAttribute)
+			if !store.Has(updatedKey) {
+				k.incrementAttributeValueCount(ctx, updateAttribute)
+			}
 			store.Set(updatedKey, bz)
 
 			attributeUpdateEvent := types.NewEventAttributeUpdate(originalAttribute, updateAttribute, owner.String())
 			if err := ctx.EventManager().EmitTypedEvent(attributeUpdateEvent); err != nil {
 				return err
 			}
+			if k.hooks != nil {
+				k.hooks.AfterAttributeUpdated(ctx, attr, updateAttribute)
+			}
 			break
 		}
 	}
@@ -253,6 +373,12 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 		deleteDistinct = true
 	}
 
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", name, err)
+	}
+	name = normalizedName
+
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
 	}
@@ -276,6 +402,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 		if attr.Name == name && (!deleteDistinct || bytes.Equal(*value, attr.Value)) {
 			count++
 			store.Delete(it.Key())
+			k.decrementAttributeValueCount(ctx, attr)
 
 			if !deleteDistinct {
 				deleteEvent := types.NewEventAttributeDelete(name, acc.String(), owner.String())
@@ -288,6 +415,9 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 					return err
 				}
 			}
+			if k.hooks != nil {
+				k.hooks.AfterAttributeDeleted(ctx, attr)
+			}
 		}
 	}
 	errm := "no keys deleted"
@@ -346,6 +476,62 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 	}
 	key := types.AccountAttributeKey(acc, attr)
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		k.incrementAttributeValueCount(ctx, attr)
+	}
 	store.Set(key, bz)
 	return nil
 }
+
+// NormalizeAttributeNames rewrites every stored attribute whose name is not in the name module's normalized format
+// under its normalized name.  An attribute that would duplicate one already stored under the normalized name is
+// dropped, the dropped attributes are logged and returned.  Attributes with names that cannot be normalized are left
+// as they are.
+func (k Keeper) NormalizeAttributeNames(ctx sdk.Context) ([]types.Attribute, error) {
+	type storedAttribute struct {
+		key  []byte
+		attr types.Attribute
+	}
+	var toNormalize []storedAttribute
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AttributeKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		attr := types.Attribute{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &attr); err != nil {
+			iterator.Close()
+			return nil, err
+		}
+		normalizedName, err := k.nameKeeper.Normalize(ctx, attr.Name)
+		if err != nil {
+			k.Logger(ctx).Error("unable to normalize attribute name", "name", attr.Name, "account", attr.Address, "error", err)
+			continue
+		}
+		if normalizedName != attr.Name {
+			attr.Name = normalizedName
+			toNormalize = append(toNormalize, storedAttribute{key: append([]byte{}, iterator.Key()...), attr: attr})
+		}
+	}
+	iterator.Close()
+
+	var dropped []types.Attribute
+	for _, stored := range toNormalize {
+		addr, err := sdk.AccAddressFromBech32(stored.attr.Address)
+		if err != nil {
+			return nil, err
+		}
+		key := types.AccountAttributeKey(addr, stored.attr)
+		store.Delete(stored.key)
+		if store.Has(key) {
+			k.Logger(ctx).Info("dropped duplicate attribute", "name", stored.attr.Name, "account", stored.attr.Address)
+			dropped = append(dropped, stored.attr)
+			continue
+		}
+		bz, err := k.cdc.Marshal(&stored.attr)
+		if err != nil {
+			return nil, err
+		}
+		store.Set(key, bz)
+	}
+	k.Logger(ctx).Info("normalized attribute names", "normalized", len(toNormalize)-len(dropped), "duplicates_dropped", len(dropped))
+	return dropped, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -11,9 +12,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -158,6 +162,108 @@ func (s *KeeperTestSuite) TestSetAttribute() {
 
 }
 
+func (s *KeeperTestSuite) TestSetAttestationAttribute() {
+	ownerKey := secp256k1.GenPrivKey()
+	ownerAddr := sdk.AccAddress(ownerKey.PubKey().Address())
+	ownerAcc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, ownerAddr)
+	s.Require().NoError(ownerAcc.SetPubKey(ownerKey.PubKey()))
+	s.app.AccountKeeper.SetAccount(s.ctx, ownerAcc)
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "signed.attribute", ownerAddr, false))
+
+	// The user2 account has not been created so it has no public key.
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "nokey.attribute", s.user2Addr, false))
+
+	attestation := func(name string, key *secp256k1.PrivKey, value string) []byte {
+		signBytes := types.AttestationSignBytes(name, s.user2, types.AttributeType_String, []byte(value))
+		sig, err := key.Sign(signBytes)
+		s.Require().NoError(err)
+		a := types.NewAttestation(types.AttributeType_String, []byte(value), sig)
+		bz, err := a.Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 1000
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	cases := map[string]struct {
+		attr     types.Attribute
+		errorMsg string
+	}{
+		"should add attestation submitted by a non owner": {
+			attr:     types.NewAttribute("signed.attribute", s.user2Addr, types.AttributeType_Attestation, attestation("signed.attribute", ownerKey, "verified")),
+			errorMsg: "",
+		},
+		"should fail when signed by another key": {
+			attr:     types.NewAttribute("signed.attribute", s.user2Addr, types.AttributeType_Attestation, attestation("signed.attribute", secp256k1.GenPrivKey(), "verified")),
+			errorMsg: "invalid attestation signature for name \"signed.attribute\"",
+		},
+		"should fail when signed for another name": {
+			attr:     types.NewAttribute("signed.attribute", s.user2Addr, types.AttributeType_Attestation, attestation("example.attribute", ownerKey, "verified")),
+			errorMsg: "invalid attestation signature for name \"signed.attribute\"",
+		},
+		"should fail when name owner has no public key": {
+			attr:     types.NewAttribute("nokey.attribute", s.user2Addr, types.AttributeType_Attestation, attestation("nokey.attribute", ownerKey, "verified")),
+			errorMsg: fmt.Sprintf("no public key found for owner \"%s\" of name \"nokey.attribute\"", s.user2),
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			err := s.app.AttributeKeeper.SetAttribute(s.ctx, tc.attr, s.user1Addr)
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg)
+			} else {
+				s.Assert().NoError(err)
+			}
+		})
+	}
+
+	attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user2Addr, "signed.attribute")
+	s.Require().NoError(err)
+	s.Require().Len(attrs, 1)
+	s.Assert().Equal(types.AttributeType_Attestation, attrs[0].AttributeType)
+}
+
+func (s *KeeperTestSuite) TestSetEncryptedAttribute() {
+	recipient := secp256k1.GenPrivKey()
+	envelope := func(value string) []byte {
+		e, err := types.SealEncryptedEnvelope(types.AttributeType_String, []byte(value), []cryptotypes.PubKey{recipient.PubKey()})
+		s.Require().NoError(err)
+		bz, err := e.Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+	small, large := envelope("private"), envelope(strings.Repeat("private", 20))
+
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 1000
+	params.MaxEncryptedValueLength = uint32(len(small))
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	err := s.app.AttributeKeeper.SetAttribute(s.ctx, types.NewAttribute("example.attribute", s.user2Addr, types.AttributeType_Encrypted, large), s.user1Addr)
+	s.Assert().EqualError(err, fmt.Sprintf("encrypted attribute value length of %d exceeds max length %d", len(large), len(small)))
+
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, types.NewAttribute("example.attribute", s.user2Addr, types.AttributeType_Encrypted, []byte("not an envelope")), s.user1Addr)
+	s.Assert().EqualError(err, "invalid attribute value for assigned type: ATTRIBUTE_TYPE_ENCRYPTED")
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, types.NewAttribute("example.attribute", s.user2Addr, types.AttributeType_Encrypted, small), s.user1Addr))
+	attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user2Addr, "example.attribute")
+	s.Require().NoError(err)
+	s.Require().Len(attrs, 1)
+	stored, err := types.ParseEncryptedEnvelope(attrs[0].Value)
+	s.Require().NoError(err)
+	value, err := types.OpenEncryptedEnvelope(*stored, recipient)
+	s.Require().NoError(err)
+	s.Assert().Equal("private", string(value))
+
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, attrs[0], types.NewAttribute("example.attribute", s.user2Addr, types.AttributeType_Encrypted, large), s.user1Addr)
+	s.Assert().EqualError(err, fmt.Sprintf("encrypted attribute value length of %d exceeds max length %d", len(large), len(small)))
+}
+
 func (s *KeeperTestSuite) TestUpdateAttribute() {
 
 	attr := types.Attribute{
@@ -542,6 +648,30 @@ func (s *KeeperTestSuite) TestGetAttributesByName() {
 	s.Equal(attr.Name, attributes[0].Name)
 	s.Equal(attr.Address, attributes[0].Address)
 	s.Equal(attr.Value, attributes[0].Value)
+	attributes, err = s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1Addr, " Example.ATTRIBUTE")
+	s.NoError(err)
+	s.Equal(1, len(attributes), "name is normalized")
+	_, err = s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1Addr, "x.attribute")
+	s.EqualError(err, nametypes.ErrNameSegmentTooShort.Error())
+}
+
+func (s *KeeperTestSuite) TestDeleteAttributeNormalizesName() {
+	attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("0123456789"))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr))
+	s.NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1Addr, "Example.Attribute ", nil, s.user1Addr))
+	s.False(s.app.AttributeKeeper.AccountHasAttribute(s.ctx, s.user1Addr, "example.attribute"))
+}
+
+func (s *KeeperTestSuite) TestAccountHasAttribute() {
+	k := s.app.AttributeKeeper
+	s.False(k.AccountHasAttribute(s.ctx, s.user1Addr, "example.attribute"))
+	s.False(k.AccountHasAttribute(s.ctx, s.user1Addr, "blah"), "unbound name")
+
+	attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("0123456789"))
+	s.Require().NoError(k.SetAttribute(s.ctx, attr, s.user1Addr))
+	s.True(k.AccountHasAttribute(s.ctx, s.user1Addr, "example.attribute"))
+	s.True(k.AccountHasAttribute(s.ctx, s.user1Addr, " Example.Attribute "), "name is normalized")
+	s.False(k.AccountHasAttribute(s.ctx, s.user2Addr, "example.attribute"), "other account")
 }
 
 func (s *KeeperTestSuite) TestInitGenesisAddingAttributes() {
@@ -587,3 +717,157 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 	})
 
 }
+
+// recordingHooks records the attribute changes it is notified of.
+type recordingHooks struct {
+	calls []string
+}
+
+var _ types.AttributeHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterAttributeAdded(_ sdk.Context, attr types.Attribute) {
+	h.calls = append(h.calls, fmt.Sprintf("added %s=%s", attr.Name, attr.Value))
+}
+
+func (h *recordingHooks) AfterAttributeUpdated(_ sdk.Context, original types.Attribute, updated types.Attribute) {
+	h.calls = append(h.calls, fmt.Sprintf("updated %s=%s to %s", original.Name, original.Value, updated.Value))
+}
+
+func (h *recordingHooks) AfterAttributeDeleted(_ sdk.Context, attr types.Attribute) {
+	h.calls = append(h.calls, fmt.Sprintf("deleted %s=%s", attr.Name, attr.Value))
+}
+
+func (s *KeeperTestSuite) TestAttributeHooks() {
+	first, second := &recordingHooks{}, &recordingHooks{}
+	k := s.app.AttributeKeeper
+	k.SetHooks(types.NewMultiAttributeHooks(first, second))
+	s.Require().Panics(func() { k.SetHooks(first) }, "setting hooks twice")
+
+	attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("first"))
+	s.Require().NoError(k.SetAttribute(s.ctx, attr, s.user1Addr))
+	s.Require().NoError(k.SetAttribute(s.ctx, types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("second")), s.user1Addr))
+	updated := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("updated"))
+	s.Require().NoError(k.UpdateAttribute(s.ctx, attr, updated, s.user1Addr))
+	s.Require().Error(k.SetAttribute(s.ctx, types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("way too long")), s.user1Addr))
+	s.Require().NoError(k.DeleteAttribute(s.ctx, s.user1Addr, "example.attribute", nil, s.user1Addr))
+
+	expected := []string{
+		"added example.attribute=first",
+		"added example.attribute=second",
+		"updated example.attribute=first to updated",
+		"deleted example.attribute=second",
+		"deleted example.attribute=updated",
+	}
+	s.Assert().Equal(expected, first.calls, "first hooks")
+	s.Assert().Equal(expected, second.calls, "second hooks")
+}
+
+func (s *KeeperTestSuite) TestAttributeValues() {
+	valueCounts := func() map[string]uint64 {
+		res, err := s.app.AttributeKeeper.AttributeValues(sdk.WrapSDKContext(s.ctx), &types.QueryAttributeValuesRequest{Name: "Example.Attribute"})
+		s.Require().NoError(err)
+		s.Equal("example.attribute", res.Name)
+		counts := make(map[string]uint64, len(res.Values))
+		for _, v := range res.Values {
+			counts[string(v.Value)] = v.Count
+		}
+		return counts
+	}
+	newAttr := func(value string, address sdk.AccAddress) types.Attribute {
+		return types.NewAttribute("example.attribute", address, types.AttributeType_String, []byte(value))
+	}
+
+	s.Empty(valueCounts())
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("a", s.user1Addr), s.user1Addr))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("a", s.user2Addr), s.user1Addr))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("b", s.user1Addr), s.user1Addr))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("b", s.user1Addr), s.user1Addr), "setting the same attribute again")
+	s.Equal(map[string]uint64{"a": 2, "b": 1}, valueCounts())
+
+	s.NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, newAttr("a", s.user2Addr), newAttr("b", s.user2Addr), s.user1Addr))
+	s.Equal(map[string]uint64{"a": 1, "b": 2}, valueCounts())
+	s.Equal(uint64(2), s.app.AttributeKeeper.GetAttributeValueCount(s.ctx, "example.attribute", []byte("b")))
+
+	value := []byte("a")
+	s.NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1Addr, "example.attribute", &value, s.user1Addr))
+	s.Equal(map[string]uint64{"b": 2}, valueCounts())
+
+	s.NoError(s.app.AttributeKeeper.RebuildAttributeValueIndex(s.ctx))
+	s.Equal(map[string]uint64{"b": 2}, valueCounts())
+
+	s.NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user2Addr, "example.attribute", nil, s.user1Addr))
+	s.Equal(map[string]uint64{"b": 1}, valueCounts())
+
+	_, err := s.app.AttributeKeeper.AttributeValues(sdk.WrapSDKContext(s.ctx), &types.QueryAttributeValuesRequest{Name: " "})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = empty attribute name")
+}
+
+func (s *KeeperTestSuite) TestNormalizeQuery() {
+	res, err := s.app.AttributeKeeper.Normalize(sdk.WrapSDKContext(s.ctx), &types.QueryNormalizeRequest{Name: " Example . Attribute"})
+	s.Require().NoError(err)
+	s.Equal("example.attribute", res.Name)
+
+	_, err = s.app.AttributeKeeper.Normalize(sdk.WrapSDKContext(s.ctx), &types.QueryNormalizeRequest{Name: " "})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = empty attribute name")
+	_, err = s.app.AttributeKeeper.Normalize(sdk.WrapSDKContext(s.ctx), &types.QueryNormalizeRequest{Name: "x.attribute"})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid attribute name: segment of name is too short")
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	storeRaw := func(name string, address sdk.AccAddress, value string) {
+		attr := types.NewAttribute(name, address, types.AttributeType_String, []byte(value))
+		store.Set(types.AccountAttributeKey(address, attr), s.app.AppCodec().MustMarshal(&attr))
+	}
+	storedCount := func(address sdk.AccAddress) int {
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, address)
+		s.Require().NoError(err)
+		return len(attrs)
+	}
+	// Spacing within the name changes its key, so each of these is stored under a different key.
+	storeRaw("example.attribute", s.user1Addr, "a")
+	storeRaw("Example. Attribute", s.user1Addr, "a")
+	storeRaw("example .ATTRIBUTE", s.user1Addr, "a")
+	storeRaw("EXAMPLE . attribute", s.user1Addr, "b")
+	storeRaw("Example. Attribute", s.user2Addr, "a")
+	storeRaw("x.Attribute", s.user2Addr, "c")
+	s.Require().Equal(4, storedCount(s.user1Addr), "attributes stored under distinct keys")
+
+	dropped, err := s.app.AttributeKeeper.NormalizeAttributeNames(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(dropped, 2, "dropped duplicates")
+	for _, attr := range dropped {
+		s.Equal("example.attribute", attr.Name)
+		s.Equal(s.user1, attr.Address)
+		s.Equal("a", string(attr.Value))
+	}
+
+	names := func(address sdk.AccAddress) []string {
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, address)
+		s.Require().NoError(err)
+		var res []string
+		for _, attr := range attrs {
+			res = append(res, fmt.Sprintf("%s=%s", attr.Name, attr.Value))
+		}
+		return res
+	}
+	s.ElementsMatch([]string{"example.attribute=a", "example.attribute=b"}, names(s.user1Addr), "exactly one of the duplicates is kept")
+	s.ElementsMatch([]string{"example.attribute=a", "x.Attribute=c"}, names(s.user2Addr), "names that cannot be normalized are kept")
+
+	migrator := keeper.NewMigrator(s.app.AttributeKeeper)
+	s.Require().NoError(migrator.Migrate3to4(s.ctx))
+	s.ElementsMatch([]string{"example.attribute=a", "example.attribute=b"}, names(s.user1Addr), "migrating again")
+	s.Equal(uint64(2), s.app.AttributeKeeper.GetAttributeValueCount(s.ctx, "example.attribute", []byte("a")))
+	s.Equal(uint64(1), s.app.AttributeKeeper.GetAttributeValueCount(s.ctx, "example.attribute", []byte("b")))
+}
+
+func (s *KeeperTestSuite) TestParamsQueryWithUnsetParams() {
+	// Params added by an upgrade are not in the param store until they are set.
+	paramStore := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.ParamStoreKeyMaxEncryptedValueLength)
+
+	res, err := s.app.AttributeKeeper.Params(sdk.WrapSDKContext(s.ctx), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Equal(uint32(10), res.Params.MaxValueLength)
+	s.Equal(uint32(types.DefaultMaxEncryptedValueLength), res.Params.MaxEncryptedValueLength)
+}
@@ -19,3 +19,17 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v042.MigrateAddressLength(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by indexing the distinct values of the existing attributes.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.RebuildAttributeValueIndex(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4 by storing all attributes under their normalized names and re-indexing
+// their distinct values.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	if _, err := m.keeper.NormalizeAttributeNames(ctx); err != nil {
+		return err
+	}
+	return m.keeper.RebuildAttributeValueIndex(ctx)
+}
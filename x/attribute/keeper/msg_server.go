@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -202,3 +203,63 @@ func (k msgServer) DeleteDistinctAttribute(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgDeleteDistinctAttributeResponse{}, nil
 }
+
+func (k msgServer) SetAttributesBatch(goCtx context.Context, msg *types.MsgSetAttributesBatchRequest) (*types.MsgSetAttributesBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.AttributeBatchResult, len(msg.Entries))
+	applied := 0
+	for i, entry := range msg.Entries {
+		results[i] = types.AttributeBatchResult{Account: entry.Account, Name: entry.Name}
+
+		// Each entry is applied in its own cache so a failed entry leaves no partial state or events behind.
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		eventType, err := k.applyBatchEntry(cacheCtx, entry, ownerAddr)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyNameAttribute, entry.Name),
+				sdk.NewAttribute(types.AttributeKeyAccountAddress, entry.Account),
+			),
+		)
+		results[i].Success = true
+		applied++
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyBatch},
+			float32(applied),
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelOwner, msg.Owner),
+				telemetry.NewLabel(types.EventTelemetryLabelSize, strconv.Itoa(len(msg.Entries))),
+			},
+		)
+	}()
+
+	return &types.MsgSetAttributesBatchResponse{Results: results}, nil
+}
+
+// applyBatchEntry sets or deletes the attribute of a batch entry and returns the type of event to emit for it.
+func (k msgServer) applyBatchEntry(ctx sdk.Context, entry types.AttributeBatchEntry, owner sdk.AccAddress) (string, error) {
+	if entry.Delete {
+		accountAddr, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return "", err
+		}
+		return types.EventTypeAttributeDeleted, k.Keeper.DeleteAttribute(ctx, accountAddr, entry.Name, nil, owner)
+	}
+	return types.EventTypeAttributeAdded, k.Keeper.ReplaceAttribute(ctx, entry.Attribute(), owner)
+}
@@ -9,7 +9,8 @@ import (
 // GetParams returns the total set of account parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		MaxValueLength: k.GetMaxValueLength(ctx),
+		MaxValueLength:          k.GetMaxValueLength(ctx),
+		MaxEncryptedValueLength: k.GetMaxEncryptedValueLength(ctx),
 	}
 }
 
@@ -26,3 +27,12 @@ func (k Keeper) GetMaxValueLength(ctx sdk.Context) (maxValueLength uint32) {
 	}
 	return
 }
+
+// GetMaxEncryptedValueLength returns the current max length of an encrypted envelope attribute value (or default if unset)
+func (k Keeper) GetMaxEncryptedValueLength(ctx sdk.Context) (maxEncryptedValueLength uint32) {
+	maxEncryptedValueLength = types.DefaultMaxEncryptedValueLength
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxEncryptedValueLength) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxEncryptedValueLength, &maxEncryptedValueLength)
+	}
+	return
+}
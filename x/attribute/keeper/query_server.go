@@ -19,10 +19,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of attribute module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
-
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
 
 // Attribute queries for a specific attribute
@@ -43,7 +40,12 @@ func (k Keeper) Attribute(c context.Context, req *types.QueryAttributeRequest) (
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address")
 	}
-	attributeStore := prefix.NewStore(store, types.AccountAttributesNameKeyPrefix(accAddr, req.Name))
+	// A name that cannot be normalized cannot have attributes stored under it, so it is queried as given.
+	name, err := k.nameKeeper.Normalize(ctx, req.Name)
+	if err != nil {
+		name = req.Name
+	}
+	attributeStore := prefix.NewStore(store, types.AccountAttributesNameKeyPrefix(accAddr, name))
 	pageRes, err := query.Paginate(attributeStore, req.Pagination, func(key []byte, value []byte) error {
 		var result types.Attribute
 		err = k.cdc.Unmarshal(value, &result)
@@ -134,3 +136,48 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 
 	return &types.QueryScanResponse{Account: accAddr.String(), Attributes: attributes, Pagination: pageRes}, nil
 }
+
+// AttributeValues queries for the distinct values stored under an attribute name across all accounts
+func (k Keeper) AttributeValues(c context.Context, req *types.QueryAttributeValuesRequest) (*types.QueryAttributeValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name: %s", err)
+	}
+	values := make([]types.AttributeValueCount, 0)
+	valueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttributeValuesKeyPrefix(name))
+	pageRes, err := query.Paginate(valueStore, req.Pagination, func(key []byte, value []byte) error {
+		var result types.AttributeValueCount
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+		values = append(values, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttributeValuesResponse{Name: name, Values: values, Pagination: pageRes}, nil
+}
+
+// Normalize returns an attribute name in the normalized format it is stored under
+func (k Keeper) Normalize(c context.Context, req *types.QueryNormalizeRequest) (*types.QueryNormalizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name: %s", err)
+	}
+	return &types.QueryNormalizeResponse{Name: name}, nil
+}
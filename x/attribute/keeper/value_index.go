@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetAttributeValueCount returns the number of accounts with an attribute of the given name and value.
+func (k Keeper) GetAttributeValueCount(ctx sdk.Context, name string, value []byte) uint64 {
+	count, _ := k.getAttributeValueCount(ctx, types.AttributeValueKey(types.Attribute{Name: name, Value: value}))
+	return count.Count
+}
+
+// RebuildAttributeValueIndex replaces the distinct values stored under each attribute name and their counts with
+// those computed from the stored attributes.
+func (k Keeper) RebuildAttributeValueIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AttributeValueKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return k.IterateRecords(ctx, types.AttributeKeyPrefix, func(attr types.Attribute) error {
+		k.incrementAttributeValueCount(ctx, attr)
+		return nil
+	})
+}
+
+// incrementAttributeValueCount adds an account to the count of the name and value of the attribute.
+func (k Keeper) incrementAttributeValueCount(ctx sdk.Context, attr types.Attribute) {
+	key := types.AttributeValueKey(attr)
+	count, _ := k.getAttributeValueCount(ctx, key)
+	count.Value = attr.Value
+	count.Count++
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&count))
+}
+
+// decrementAttributeValueCount removes an account from the count of the name and value of the attribute, the value is
+// removed once no account has it.
+func (k Keeper) decrementAttributeValueCount(ctx sdk.Context, attr types.Attribute) {
+	key := types.AttributeValueKey(attr)
+	count, found := k.getAttributeValueCount(ctx, key)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	if count.Count <= 1 {
+		store.Delete(key)
+		return
+	}
+	count.Count--
+	store.Set(key, k.cdc.MustMarshal(&count))
+}
+
+func (k Keeper) getAttributeValueCount(ctx sdk.Context, key []byte) (types.AttributeValueCount, bool) {
+	var count types.AttributeValueCount
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return count, false
+	}
+	k.cdc.MustUnmarshal(bz, &count)
+	return count, true
+}
@@ -32,7 +32,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	attributeGenesis := types.GenesisState{
 		Params: types.Params{
-			MaxValueLength: maxValueLength,
+			MaxValueLength:          maxValueLength,
+			MaxEncryptedValueLength: types.DefaultMaxEncryptedValueLength,
 		},
 		Attributes: []types.Attribute{},
 	}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAttestation creates a new attestation over a typed value with the given name owner signature.
+func NewAttestation(valueType AttributeType, value []byte, signature []byte) Attestation {
+	return Attestation{
+		Value:     value,
+		ValueType: valueType,
+		Signature: signature,
+	}
+}
+
+// ParseAttestation decodes an attestation from an attribute value.
+func ParseAttestation(value []byte) (*Attestation, error) {
+	attestation := Attestation{}
+	if err := attestation.Unmarshal(value); err != nil {
+		return nil, fmt.Errorf("invalid attestation: %w", err)
+	}
+	return &attestation, nil
+}
+
+// ValidateBasic ensures an attestation holds a valid non-attestation value and a signature.
+func (a Attestation) ValidateBasic() error {
+	if a.ValueType == AttributeType_Attestation || !ValidAttributeType(a.ValueType) {
+		return fmt.Errorf("invalid attestation value type: %s", a.ValueType)
+	}
+	if !isValidValueForType(a.ValueType, a.Value) {
+		return fmt.Errorf("invalid attestation value for assigned type: %s", a.ValueType)
+	}
+	if len(a.Signature) == 0 {
+		return fmt.Errorf("invalid attestation signature: empty")
+	}
+	return nil
+}
+
+// AttestationSignBytes returns the canonical bytes the name owner signs to attest to a value of the named attribute
+// on the given account.
+func AttestationSignBytes(name string, account string, valueType AttributeType, value []byte) []byte {
+	bz, err := json.Marshal(struct {
+		Name      string `json:"name"`
+		Account   string `json:"account"`
+		ValueType string `json:"value_type"`
+		Value     []byte `json:"value"`
+	}{name, account, valueType.String(), value})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
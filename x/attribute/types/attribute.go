@@ -16,7 +16,8 @@ import (
 // NewAttribute creates a new instance of an Attribute
 func NewAttribute(name string, account sdk.AccAddress, attrType AttributeType, value []byte) Attribute { // nolint:interfacer
 	// Ensure string type values are trimmed.
-	if attrType != AttributeType_Bytes && attrType != AttributeType_Proto {
+	if attrType != AttributeType_Bytes && attrType != AttributeType_Proto && attrType != AttributeType_Attestation &&
+		attrType != AttributeType_Encrypted {
 		trimmed := strings.TrimSpace(string(value))
 		value = []byte(trimmed)
 	}
@@ -82,6 +83,10 @@ func isValidValueForType(attrType AttributeType, value []byte) bool {
 		return true // Treat proto as just a special tag for bytes
 	case AttributeType_Bytes:
 		return true
+	case AttributeType_Attestation:
+		return isValidAttestation(value)
+	case AttributeType_Encrypted:
+		return isValidEncryptedEnvelope(value)
 	default:
 		return false
 	}
@@ -123,6 +128,24 @@ func isValidFloat(value []byte) bool {
 	return ok
 }
 
+// Ensure a byte array is an attestation over a valid value.
+func isValidAttestation(value []byte) bool {
+	attestation, err := ParseAttestation(value)
+	if err != nil {
+		return false
+	}
+	return attestation.ValidateBasic() == nil
+}
+
+// Ensure a byte array is an encrypted envelope for at least one recipient.
+func isValidEncryptedEnvelope(value []byte) bool {
+	envelope, err := ParseEncryptedEnvelope(value)
+	if err != nil {
+		return false
+	}
+	return envelope.ValidateBasic() == nil
+}
+
 // AttributeTypeFromString returns a AttributeType from a string. It returns an error
 // if the string is invalid.
 func AttributeTypeFromString(str string) (AttributeType, error) {
@@ -143,7 +166,9 @@ func ValidAttributeType(attributeType AttributeType) bool {
 		attributeType == AttributeType_Int ||
 		attributeType == AttributeType_Float ||
 		attributeType == AttributeType_Proto ||
-		attributeType == AttributeType_Bytes {
+		attributeType == AttributeType_Bytes ||
+		attributeType == AttributeType_Attestation ||
+		attributeType == AttributeType_Encrypted {
 		return true
 	}
 	return false
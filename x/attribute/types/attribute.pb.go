@@ -45,18 +45,24 @@ const (
 	AttributeType_Proto AttributeType = 7
 	// ATTRIBUTE_TYPE_BYTES defines an attribute value that contains an untyped array of bytes
 	AttributeType_Bytes AttributeType = 8
+	// ATTRIBUTE_TYPE_ATTESTATION defines an attribute value that contains a serialized Attestation signed by the name owner
+	AttributeType_Attestation AttributeType = 9
+	// ATTRIBUTE_TYPE_ENCRYPTED defines an attribute value that contains a serialized EncryptedEnvelope
+	AttributeType_Encrypted AttributeType = 10
 )
 
 var AttributeType_name = map[int32]string{
-	0: "ATTRIBUTE_TYPE_UNSPECIFIED",
-	1: "ATTRIBUTE_TYPE_UUID",
-	2: "ATTRIBUTE_TYPE_JSON",
-	3: "ATTRIBUTE_TYPE_STRING",
-	4: "ATTRIBUTE_TYPE_URI",
-	5: "ATTRIBUTE_TYPE_INT",
-	6: "ATTRIBUTE_TYPE_FLOAT",
-	7: "ATTRIBUTE_TYPE_PROTO",
-	8: "ATTRIBUTE_TYPE_BYTES",
+	0:  "ATTRIBUTE_TYPE_UNSPECIFIED",
+	1:  "ATTRIBUTE_TYPE_UUID",
+	2:  "ATTRIBUTE_TYPE_JSON",
+	3:  "ATTRIBUTE_TYPE_STRING",
+	4:  "ATTRIBUTE_TYPE_URI",
+	5:  "ATTRIBUTE_TYPE_INT",
+	6:  "ATTRIBUTE_TYPE_FLOAT",
+	7:  "ATTRIBUTE_TYPE_PROTO",
+	8:  "ATTRIBUTE_TYPE_BYTES",
+	9:  "ATTRIBUTE_TYPE_ATTESTATION",
+	10: "ATTRIBUTE_TYPE_ENCRYPTED",
 }
 
 var AttributeType_value = map[string]int32{
@@ -69,6 +75,8 @@ var AttributeType_value = map[string]int32{
 	"ATTRIBUTE_TYPE_FLOAT":       6,
 	"ATTRIBUTE_TYPE_PROTO":       7,
 	"ATTRIBUTE_TYPE_BYTES":       8,
+	"ATTRIBUTE_TYPE_ATTESTATION": 9,
+	"ATTRIBUTE_TYPE_ENCRYPTED":   10,
 }
 
 func (x AttributeType) String() string {
@@ -83,6 +91,8 @@ func (AttributeType) EnumDescriptor() ([]byte, []int) {
 type Params struct {
 	// maximum length of data to allow in an attribute value
 	MaxValueLength uint32 `protobuf:"varint,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// maximum length of an encrypted envelope to allow in an ATTRIBUTE_TYPE_ENCRYPTED attribute value
+	MaxEncryptedValueLength uint32 `protobuf:"varint,2,opt,name=max_encrypted_value_length,json=maxEncryptedValueLength,proto3" json:"max_encrypted_value_length,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -124,6 +134,13 @@ func (m *Params) GetMaxValueLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxEncryptedValueLength() uint32 {
+	if m != nil {
+		return m.MaxEncryptedValueLength
+	}
+	return 0
+}
+
 // Attribute holds a typed key/value structure for data associated with an account
 type Attribute struct {
 	// The attribute name.
@@ -196,6 +213,266 @@ func (m *Attribute) GetAddress() string {
 	return ""
 }
 
+// AttributeValueCount is a distinct value stored under an attribute name and the number of accounts it is stored on
+type AttributeValueCount struct {
+	// The attribute value.
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// The number of accounts with an attribute of the name and value.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *AttributeValueCount) Reset()         { *m = AttributeValueCount{} }
+func (m *AttributeValueCount) String() string { return proto.CompactTextString(m) }
+func (*AttributeValueCount) ProtoMessage()    {}
+func (*AttributeValueCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+}
+func (m *AttributeValueCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeValueCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeValueCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeValueCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeValueCount.Merge(m, src)
+}
+func (m *AttributeValueCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeValueCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeValueCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeValueCount proto.InternalMessageInfo
+
+func (m *AttributeValueCount) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AttributeValueCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Attestation is the value of an ATTRIBUTE_TYPE_ATTESTATION attribute.  It wraps a typed value with a detached
+// signature from the owner of the attribute name so the attribute can be verified without trusting the submitter.
+type Attestation struct {
+	// The attested value.
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// The type of the attested value, this cannot be ATTRIBUTE_TYPE_ATTESTATION.
+	ValueType AttributeType `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=provenance.attribute.v1.AttributeType" json:"value_type,omitempty"`
+	// Signature of the name owner over the attestation sign bytes (attribute name, account, value type, and value).
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Attestation) GetValueType() AttributeType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *Attestation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// EncryptedEnvelope is the value of an ATTRIBUTE_TYPE_ENCRYPTED attribute.  The typed value is encrypted with a random
+// content key using AES-256-GCM, and the content key is wrapped for each recipient so that only the holders of the
+// recipient private keys can read the value.
+type EncryptedEnvelope struct {
+	// The type of the encrypted value, this cannot be ATTRIBUTE_TYPE_ENCRYPTED.
+	ValueType AttributeType `protobuf:"varint,1,opt,name=value_type,json=valueType,proto3,enum=provenance.attribute.v1.AttributeType" json:"value_type,omitempty"`
+	// The content key wrapped for each of the recipients of the value.
+	Recipients []EncryptedRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+	// The AES-256-GCM nonce used to encrypt the value.
+	Nonce []byte `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The encrypted value.
+	Ciphertext []byte `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+}
+
+func (m *EncryptedEnvelope) Reset()         { *m = EncryptedEnvelope{} }
+func (m *EncryptedEnvelope) String() string { return proto.CompactTextString(m) }
+func (*EncryptedEnvelope) ProtoMessage()    {}
+func (*EncryptedEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+}
+func (m *EncryptedEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedEnvelope.Merge(m, src)
+}
+func (m *EncryptedEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedEnvelope proto.InternalMessageInfo
+
+func (m *EncryptedEnvelope) GetValueType() AttributeType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *EncryptedEnvelope) GetRecipients() []EncryptedRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *EncryptedEnvelope) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *EncryptedEnvelope) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+// EncryptedRecipient is the content key of an EncryptedEnvelope wrapped for one recipient.  The wrapping key is derived
+// from the ECDH shared secret of an ephemeral secp256k1 key and the recipient public key.
+type EncryptedRecipient struct {
+	// The compressed secp256k1 public key of the recipient.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The compressed secp256k1 public key of the ephemeral key used to wrap the content key.
+	EphemeralPublicKey []byte `protobuf:"bytes,2,opt,name=ephemeral_public_key,json=ephemeralPublicKey,proto3" json:"ephemeral_public_key,omitempty"`
+	// The AES-256-GCM nonce followed by the wrapped content key.
+	EncryptedKey []byte `protobuf:"bytes,3,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+}
+
+func (m *EncryptedRecipient) Reset()         { *m = EncryptedRecipient{} }
+func (m *EncryptedRecipient) String() string { return proto.CompactTextString(m) }
+func (*EncryptedRecipient) ProtoMessage()    {}
+func (*EncryptedRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+}
+func (m *EncryptedRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedRecipient.Merge(m, src)
+}
+func (m *EncryptedRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedRecipient proto.InternalMessageInfo
+
+func (m *EncryptedRecipient) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *EncryptedRecipient) GetEphemeralPublicKey() []byte {
+	if m != nil {
+		return m.EphemeralPublicKey
+	}
+	return nil
+}
+
+func (m *EncryptedRecipient) GetEncryptedKey() []byte {
+	if m != nil {
+		return m.EncryptedKey
+	}
+	return nil
+}
+
 // EventAttributeAdd event emitted when attribute is added
 type EventAttributeAdd struct {
 	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -209,7 +486,7 @@ func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
 func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeAdd) ProtoMessage()    {}
 func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
 func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +565,7 @@ func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
 func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeUpdate) ProtoMessage()    {}
 func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
 }
 func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +654,7 @@ func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
 func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDelete) ProtoMessage()    {}
 func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
 func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +717,7 @@ func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDist
 func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDistinctDelete) ProtoMessage()    {}
 func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
 }
 func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +785,10 @@ func init() {
 	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
 	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
 	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttributeValueCount)(nil), "provenance.attribute.v1.AttributeValueCount")
+	proto.RegisterType((*Attestation)(nil), "provenance.attribute.v1.Attestation")
+	proto.RegisterType((*EncryptedEnvelope)(nil), "provenance.attribute.v1.EncryptedEnvelope")
+	proto.RegisterType((*EncryptedRecipient)(nil), "provenance.attribute.v1.EncryptedRecipient")
 	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
 	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
 	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
@@ -519,48 +800,65 @@ func init() {
 }
 
 var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0xf8, 0x59, 0x5e, 0x0b, 0xae, 0x23, 0x46, 0xb2, 0x31, 0x74, 0xdb, 0xa6, 0x4a, 0x4c,
-	0x84, 0x54, 0x63, 0x62, 0xbc, 0x81, 0xa5, 0x66, 0x4d, 0x05, 0xb2, 0x2c, 0x26, 0xed, 0x65, 0x33,
-	0x85, 0x91, 0x6e, 0x02, 0x3b, 0x64, 0x77, 0xc0, 0xf6, 0xe8, 0x95, 0x93, 0x47, 0x2f, 0x44, 0x0f,
-	0xfe, 0x31, 0x1e, 0x7b, 0xf4, 0x64, 0x4c, 0x7b, 0xf3, 0xaf, 0x30, 0xcc, 0x14, 0xd8, 0xae, 0xac,
-	0xc6, 0xdb, 0xfb, 0xde, 0x7e, 0xfb, 0x7d, 0xdf, 0x9b, 0x99, 0x3c, 0x78, 0x38, 0x74, 0xd9, 0x98,
-	0x3a, 0xc4, 0xe9, 0xd0, 0x32, 0xe1, 0xdc, 0xb5, 0x4f, 0x46, 0x9c, 0x96, 0xc7, 0x7b, 0x4b, 0x50,
-	0x1a, 0xba, 0x8c, 0x33, 0x7c, 0x6f, 0x49, 0x2c, 0x2d, 0xbf, 0x8d, 0xf7, 0xd4, 0x5c, 0x8f, 0xf5,
-	0x98, 0xe0, 0x94, 0x67, 0x95, 0xa4, 0x6f, 0x3f, 0x87, 0x64, 0x93, 0xb8, 0x64, 0xe0, 0xe1, 0x22,
-	0x28, 0x03, 0x72, 0x66, 0x8d, 0x49, 0x7f, 0x44, 0xad, 0x3e, 0x75, 0x7a, 0xfc, 0x34, 0x8f, 0x34,
-	0x54, 0xcc, 0x18, 0xd9, 0x01, 0x39, 0x7b, 0x3b, 0x6b, 0x1f, 0x8a, 0xee, 0x8b, 0xf8, 0xa7, 0x2f,
-	0x9b, 0x91, 0xed, 0xaf, 0x08, 0xd2, 0x95, 0xb9, 0x01, 0xc6, 0x10, 0x77, 0xc8, 0x80, 0x8a, 0x3f,
-	0xd2, 0x86, 0xa8, 0x71, 0x0e, 0x12, 0x42, 0x2d, 0x1f, 0xd5, 0x50, 0x71, 0xc3, 0x90, 0x00, 0xbf,
-	0x81, 0xec, 0x22, 0x97, 0xc5, 0xcf, 0x87, 0x34, 0x1f, 0xd3, 0x50, 0x31, 0xfb, 0xe4, 0x41, 0x29,
-	0x24, 0x79, 0x69, 0xe1, 0x62, 0x9e, 0x0f, 0xa9, 0x91, 0x21, 0x7e, 0x88, 0xf3, 0x90, 0x22, 0xdd,
-	0xae, 0x4b, 0x3d, 0x2f, 0x1f, 0x17, 0xde, 0x73, 0x78, 0x1d, 0xf3, 0x03, 0x82, 0xdb, 0xb5, 0x31,
-	0x75, 0xf8, 0x42, 0xa5, 0xd2, 0xed, 0xfe, 0x3b, 0x6e, 0x7a, 0x1e, 0x17, 0x43, 0x7c, 0x11, 0x32,
-	0x6d, 0x88, 0x5a, 0x78, 0x76, 0x3a, 0x6c, 0xe4, 0xf0, 0x85, 0xa7, 0x84, 0x33, 0x0d, 0xf6, 0xde,
-	0xa1, 0x6e, 0x3e, 0x21, 0x35, 0x04, 0xd8, 0xfe, 0x85, 0x20, 0x77, 0x33, 0x43, 0x7b, 0xd8, 0x25,
-	0x21, 0xa7, 0xb6, 0x0b, 0x59, 0xe6, 0xda, 0x3d, 0xdb, 0x21, 0x7d, 0xcb, 0x9f, 0x27, 0x33, 0xef,
-	0x8a, 0xab, 0xc0, 0x3b, 0xb0, 0x68, 0x58, 0xbe, 0x80, 0x1b, 0xf3, 0xa6, 0x38, 0x9c, 0x2d, 0xd8,
-	0x18, 0x09, 0xa7, 0x6b, 0x25, 0x99, 0x76, 0x5d, 0xf6, 0xa4, 0xce, 0x26, 0x5c, 0x43, 0xa9, 0x22,
-	0x73, 0x83, 0x6c, 0x99, 0x81, 0x61, 0x93, 0x21, 0xc3, 0xa6, 0xfc, 0xc3, 0x1e, 0x07, 0x67, 0xdd,
-	0xa7, 0x7d, 0x1a, 0x32, 0xab, 0x4f, 0x3b, 0x1a, 0xa2, 0x1d, 0xf3, 0x6b, 0x7f, 0x46, 0x70, 0x3f,
-	0x20, 0x6e, 0x7b, 0xdc, 0x76, 0x3a, 0xfc, 0x2f, 0x26, 0xab, 0xef, 0x75, 0x77, 0xe5, 0x33, 0x4c,
-	0xaf, 0x7a, 0x5e, 0xff, 0x71, 0xd5, 0x8f, 0x7e, 0x44, 0x21, 0x73, 0xe3, 0xbd, 0xe2, 0x32, 0xa8,
-	0x15, 0xd3, 0x34, 0xf4, 0x6a, 0xdb, 0xac, 0x59, 0xe6, 0x51, 0xb3, 0x66, 0xb5, 0xeb, 0xad, 0x66,
-	0xed, 0xa5, 0x7e, 0xa0, 0xd7, 0xf6, 0x95, 0x88, 0x7a, 0x6b, 0x32, 0xd5, 0xd6, 0xdb, 0x8e, 0x37,
-	0xa4, 0x1d, 0xfb, 0x9d, 0x4d, 0xbb, 0x78, 0x0b, 0xee, 0x04, 0x7f, 0x68, 0xeb, 0xfb, 0x0a, 0x52,
-	0xd7, 0x26, 0x53, 0x2d, 0x3e, 0xab, 0x57, 0x50, 0x5e, 0xb7, 0x1a, 0x75, 0x25, 0x2a, 0x29, 0xb3,
-	0x1a, 0xef, 0xc2, 0xdd, 0x00, 0xa5, 0x65, 0x1a, 0x7a, 0xfd, 0x95, 0x12, 0x53, 0x61, 0x32, 0xd5,
-	0x92, 0x2d, 0xee, 0xda, 0x4e, 0x0f, 0x6f, 0x02, 0x0e, 0x9a, 0x19, 0xba, 0x12, 0x57, 0x53, 0x93,
-	0xa9, 0x16, 0x6b, 0xbb, 0xf6, 0x0a, 0x82, 0x5e, 0x37, 0x95, 0x84, 0x24, 0xe8, 0x0e, 0xc7, 0x3b,
-	0x90, 0x0b, 0x10, 0x0e, 0x0e, 0x1b, 0x15, 0x53, 0x49, 0xaa, 0xe9, 0xc9, 0x54, 0x4b, 0x1c, 0xf4,
-	0x19, 0x59, 0x45, 0x6a, 0x1a, 0x0d, 0xb3, 0xa1, 0xa4, 0x24, 0xa9, 0x29, 0x56, 0xd7, 0x9f, 0xa4,
-	0xea, 0x91, 0x59, 0x6b, 0x29, 0x6b, 0x92, 0x54, 0x3d, 0xe7, 0xd4, 0xab, 0x0e, 0xbe, 0x5d, 0x16,
-	0xd0, 0xc5, 0x65, 0x01, 0xfd, 0xbc, 0x2c, 0xa0, 0x8f, 0x57, 0x85, 0xc8, 0xc5, 0x55, 0x21, 0xf2,
-	0xfd, 0xaa, 0x10, 0x01, 0xd5, 0x66, 0x61, 0x2b, 0xa4, 0x89, 0x8e, 0x9f, 0xf5, 0x6c, 0x7e, 0x3a,
-	0x3a, 0x29, 0x75, 0xd8, 0xa0, 0xbc, 0x64, 0x3d, 0xb6, 0x99, 0x0f, 0x95, 0xcf, 0x7c, 0xbb, 0x75,
-	0xf6, 0x26, 0xbc, 0x93, 0xa4, 0x58, 0x93, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x6c,
-	0x88, 0xaf, 0x80, 0x05, 0x00, 0x00,
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xf3, 0xaf, 0xeb, 0xd7, 0xa4, 0x98, 0xd9, 0xa0, 0x8d, 0xac, 0x25, 0xf5, 0x66, 0x55,
+	0xa8, 0x58, 0x91, 0xb0, 0x8b, 0xb8, 0xc0, 0x29, 0x69, 0x5d, 0x64, 0x76, 0x49, 0x82, 0xe3, 0x20,
+	0x75, 0x2f, 0x91, 0xeb, 0x0c, 0xe9, 0x88, 0x64, 0x6c, 0xd9, 0x93, 0x90, 0x1c, 0xb9, 0xa1, 0x9c,
+	0x38, 0x72, 0x89, 0xe0, 0xc0, 0xc7, 0xe0, 0x03, 0xec, 0x71, 0x8f, 0x9c, 0x10, 0x6a, 0x6f, 0x7c,
+	0x0a, 0xe4, 0x99, 0xda, 0x71, 0xbd, 0x09, 0xa8, 0x7b, 0x9b, 0xf7, 0xe6, 0xf7, 0x7e, 0xef, 0xfd,
+	0xde, 0xbc, 0x19, 0x1b, 0x3e, 0xf4, 0x7c, 0x77, 0x8e, 0xa9, 0x4d, 0x1d, 0xdc, 0xb4, 0x19, 0xf3,
+	0xc9, 0xc5, 0x8c, 0xe1, 0xe6, 0xfc, 0xe9, 0xc6, 0x68, 0x78, 0xbe, 0xcb, 0x5c, 0xf4, 0x60, 0x03,
+	0x6c, 0x6c, 0xf6, 0xe6, 0x4f, 0xd5, 0xca, 0xd8, 0x1d, 0xbb, 0x1c, 0xd3, 0x0c, 0x57, 0x02, 0x5e,
+	0x9f, 0x41, 0xb1, 0x67, 0xfb, 0xf6, 0x34, 0x40, 0xc7, 0xa0, 0x4c, 0xed, 0xc5, 0x70, 0x6e, 0x4f,
+	0x66, 0x78, 0x38, 0xc1, 0x74, 0xcc, 0x2e, 0xab, 0x92, 0x26, 0x1d, 0x97, 0xcd, 0x83, 0xa9, 0xbd,
+	0xf8, 0x36, 0x74, 0xbf, 0xe0, 0x5e, 0xf4, 0x05, 0xa8, 0x21, 0x12, 0x53, 0xc7, 0x5f, 0x7a, 0x0c,
+	0x8f, 0x6e, 0xc7, 0x64, 0x79, 0xcc, 0x83, 0xa9, 0xbd, 0xd0, 0x23, 0x40, 0x22, 0xf8, 0xf3, 0xfc,
+	0x2f, 0xbf, 0x1d, 0x66, 0xea, 0xbf, 0x4b, 0x20, 0xb7, 0xa2, 0xea, 0x10, 0x82, 0x3c, 0xb5, 0xa7,
+	0x98, 0xa7, 0x93, 0x4d, 0xbe, 0x46, 0x15, 0x28, 0x70, 0x5a, 0xce, 0x57, 0x32, 0x85, 0x81, 0xbe,
+	0x86, 0x83, 0x58, 0xd4, 0x90, 0x2d, 0x3d, 0x5c, 0xcd, 0x69, 0xd2, 0xf1, 0xc1, 0xb3, 0x0f, 0x1a,
+	0x3b, 0x64, 0x37, 0xe2, 0x2c, 0xd6, 0xd2, 0xc3, 0x66, 0xd9, 0x4e, 0x9a, 0xa8, 0x0a, 0x7b, 0xf6,
+	0x68, 0xe4, 0xe3, 0x20, 0xa8, 0xe6, 0x79, 0xee, 0xc8, 0xbc, 0x29, 0xb3, 0x05, 0xf7, 0xe3, 0x78,
+	0x2e, 0xe2, 0xc4, 0x9d, 0x51, 0xb6, 0xa9, 0x4d, 0x4a, 0xd6, 0x56, 0x81, 0x82, 0x13, 0x6e, 0xf3,
+	0x8a, 0xf3, 0xa6, 0x30, 0xea, 0x3f, 0x49, 0xb0, 0xdf, 0x62, 0x0c, 0x07, 0xcc, 0x66, 0xc4, 0xa5,
+	0x3b, 0x62, 0x75, 0x00, 0xd1, 0x44, 0xae, 0x29, 0x7b, 0x27, 0x4d, 0x32, 0x8f, 0xe4, 0x7a, 0x1e,
+	0x82, 0x1c, 0x90, 0x31, 0xb5, 0xd9, 0xcc, 0x17, 0x9d, 0x29, 0x99, 0x1b, 0x47, 0xfd, 0x5a, 0x82,
+	0x77, 0xe3, 0x33, 0xd1, 0xe9, 0x1c, 0x4f, 0x5c, 0x2f, 0x9d, 0x5a, 0x7a, 0xdb, 0xd4, 0xdf, 0x00,
+	0xf8, 0xd8, 0x21, 0x1e, 0xc1, 0x94, 0x05, 0xd5, 0xac, 0x96, 0x3b, 0xde, 0x7f, 0xf6, 0x64, 0x27,
+	0x4d, 0x5c, 0x86, 0x19, 0xc5, 0xb4, 0xf3, 0xaf, 0xfe, 0x3a, 0xcc, 0x98, 0x09, 0x92, 0xb0, 0x55,
+	0xd4, 0xa5, 0x4e, 0xa4, 0x44, 0x18, 0xa8, 0x06, 0xe0, 0x10, 0xef, 0x12, 0xfb, 0x0c, 0x2f, 0x18,
+	0x3f, 0xb6, 0x92, 0x99, 0xf0, 0xd4, 0x57, 0x12, 0xa0, 0x37, 0xe9, 0xd1, 0xfb, 0x00, 0xde, 0xec,
+	0x62, 0x42, 0x9c, 0xe1, 0xf7, 0x78, 0x79, 0xd3, 0x7c, 0x59, 0x78, 0x9e, 0xe3, 0x25, 0xfa, 0x04,
+	0x2a, 0xd8, 0xbb, 0xc4, 0x53, 0xec, 0xdb, 0x93, 0x61, 0x02, 0x28, 0xa6, 0x0f, 0xc5, 0x7b, 0xbd,
+	0x38, 0xe2, 0x31, 0x94, 0x37, 0x37, 0x20, 0x84, 0x8a, 0x2a, 0x4b, 0xb1, 0xf3, 0x39, 0x5e, 0xd6,
+	0x7f, 0x0c, 0x5b, 0x3e, 0xc7, 0x94, 0xc5, 0x7d, 0x6b, 0x8d, 0x46, 0xff, 0x3f, 0xef, 0x72, 0x34,
+	0x17, 0x08, 0xf2, 0xf1, 0x94, 0xcb, 0x26, 0x5f, 0xf3, 0xa1, 0x75, 0xc4, 0xa4, 0x45, 0x43, 0x2b,
+	0xcc, 0x90, 0xc3, 0xfd, 0x81, 0x62, 0xbf, 0x5a, 0x10, 0x1c, 0xdc, 0xa8, 0xff, 0x23, 0x41, 0xe5,
+	0x76, 0x0d, 0x03, 0x6f, 0x64, 0xef, 0xb8, 0x76, 0x47, 0x70, 0xe0, 0xfa, 0x64, 0x4c, 0xa8, 0x3d,
+	0x19, 0x26, 0xeb, 0x29, 0x47, 0x5e, 0x7e, 0x0d, 0x42, 0xf1, 0x31, 0x2c, 0x51, 0x60, 0x29, 0x72,
+	0xf2, 0x91, 0x78, 0x04, 0xa5, 0x19, 0xcf, 0x74, 0xc3, 0x24, 0xaa, 0xdd, 0x17, 0x3e, 0xc1, 0x73,
+	0x08, 0x37, 0xa6, 0x60, 0x11, 0x75, 0x83, 0x70, 0x59, 0x29, 0xb1, 0xc5, 0x1d, 0x62, 0xf7, 0x92,
+	0x62, 0x5f, 0xa6, 0xb5, 0x9e, 0xe2, 0x09, 0xde, 0xa1, 0x35, 0xc1, 0x9d, 0xdd, 0xc1, 0x9d, 0x4b,
+	0x72, 0xff, 0x2a, 0xc1, 0xc3, 0x14, 0x39, 0x09, 0x18, 0xa1, 0x0e, 0xfb, 0x8f, 0x24, 0xdb, 0xcf,
+	0xf5, 0x68, 0xeb, 0x3b, 0x26, 0x6f, 0x7b, 0x9f, 0xee, 0x70, 0xd4, 0x1f, 0xfd, 0x91, 0x83, 0xf2,
+	0xad, 0x1b, 0x8a, 0x9a, 0xa0, 0xb6, 0x2c, 0xcb, 0x34, 0xda, 0x03, 0x4b, 0x1f, 0x5a, 0xe7, 0x3d,
+	0x7d, 0x38, 0xe8, 0xf4, 0x7b, 0xfa, 0x89, 0x71, 0x66, 0xe8, 0xa7, 0x4a, 0x46, 0x7d, 0x67, 0xb5,
+	0xd6, 0xf6, 0x07, 0x34, 0xf0, 0xb0, 0x43, 0xbe, 0x23, 0x78, 0x84, 0x1e, 0xc1, 0xfd, 0x74, 0xc0,
+	0xc0, 0x38, 0x55, 0x24, 0xf5, 0xde, 0x6a, 0xad, 0xe5, 0xc3, 0xf5, 0x16, 0xc8, 0x57, 0xfd, 0x6e,
+	0x47, 0xc9, 0x0a, 0x48, 0xb8, 0x46, 0x47, 0xf0, 0x5e, 0x0a, 0xd2, 0xb7, 0x4c, 0xa3, 0xf3, 0xa5,
+	0x92, 0x53, 0x61, 0xb5, 0xd6, 0x8a, 0x7d, 0xe6, 0x13, 0x3a, 0x46, 0x87, 0x80, 0xd2, 0xc9, 0x4c,
+	0x43, 0xc9, 0xab, 0x7b, 0xab, 0xb5, 0x96, 0x1b, 0xf8, 0x64, 0x0b, 0xc0, 0xe8, 0x58, 0x4a, 0x41,
+	0x00, 0x0c, 0xca, 0xd0, 0x63, 0xa8, 0xa4, 0x00, 0x67, 0x2f, 0xba, 0x2d, 0x4b, 0x29, 0xaa, 0xf2,
+	0x6a, 0xad, 0x15, 0xce, 0x26, 0xae, 0xbd, 0x0d, 0xd4, 0x33, 0xbb, 0x56, 0x57, 0xd9, 0x13, 0xa0,
+	0x1e, 0xff, 0x70, 0xbe, 0x09, 0x6a, 0x9f, 0x5b, 0x7a, 0x5f, 0xb9, 0x27, 0x40, 0xed, 0x25, 0xc3,
+	0xc1, 0x96, 0x76, 0xb6, 0x2c, 0x4b, 0xef, 0x5b, 0x2d, 0xcb, 0xe8, 0x76, 0x14, 0x59, 0xb4, 0x33,
+	0xf9, 0xdc, 0x3f, 0x81, 0x6a, 0x2a, 0x40, 0xef, 0x9c, 0x98, 0xe7, 0x3d, 0x4b, 0x3f, 0x55, 0x40,
+	0x2d, 0xaf, 0xd6, 0x9a, 0x1c, 0x3f, 0x56, 0xed, 0xe9, 0xab, 0xab, 0x9a, 0xf4, 0xfa, 0xaa, 0x26,
+	0xfd, 0x7d, 0x55, 0x93, 0x7e, 0xbe, 0xae, 0x65, 0x5e, 0x5f, 0xd7, 0x32, 0x7f, 0x5e, 0xd7, 0x32,
+	0xa0, 0x12, 0x77, 0xd7, 0x5b, 0xda, 0x93, 0x5e, 0x7e, 0x36, 0x26, 0xec, 0x72, 0x76, 0xd1, 0x70,
+	0xdc, 0x69, 0x73, 0x83, 0xfa, 0x98, 0xb8, 0x09, 0xab, 0xb9, 0x48, 0xfc, 0x37, 0x84, 0x13, 0x17,
+	0x5c, 0x14, 0xf9, 0x2f, 0xc0, 0xa7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x95, 0x34, 0xe2,
+	0x5c, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -583,6 +881,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEncryptedValueLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxEncryptedValueLength))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxValueLength != 0 {
 		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
 		i--
@@ -640,7 +943,7 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+func (m *AttributeValueCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -650,55 +953,32 @@ func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttributeValueCount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttributeValueCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+	if m.Count != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -708,69 +988,39 @@ func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.UpdateType) > 0 {
-		i -= len(m.UpdateType)
-		copy(dAtA[i:], m.UpdateType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UpdateValue) > 0 {
-		i -= len(m.UpdateValue)
-		copy(dAtA[i:], m.UpdateValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OriginalType) > 0 {
-		i -= len(m.OriginalType)
-		copy(dAtA[i:], m.OriginalType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Signature)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.OriginalValue) > 0 {
-		i -= len(m.OriginalValue)
-		copy(dAtA[i:], m.OriginalValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
+	if m.ValueType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ValueType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeDelete) Marshal() (dAtA []byte, err error) {
+func (m *EncryptedEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -780,34 +1030,264 @@ func (m *EventAttributeDelete) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeDelete) MarshalTo(dAtA []byte) (int, error) {
+func (m *EncryptedEnvelope) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EncryptedEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Ciphertext) > 0 {
+		i -= len(m.Ciphertext)
+		copy(dAtA[i:], m.Ciphertext)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Ciphertext)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Nonce)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedKey) > 0 {
+		i -= len(m.EncryptedKey)
+		copy(dAtA[i:], m.EncryptedKey)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.EncryptedKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EphemeralPublicKey) > 0 {
+		i -= len(m.EphemeralPublicKey)
+		copy(dAtA[i:], m.EphemeralPublicKey)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.EphemeralPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UpdateType) > 0 {
+		i -= len(m.UpdateType)
+		copy(dAtA[i:], m.UpdateType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UpdateValue) > 0 {
+		i -= len(m.UpdateValue)
+		copy(dAtA[i:], m.UpdateValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OriginalType) > 0 {
+		i -= len(m.OriginalType)
+		copy(dAtA[i:], m.OriginalType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalValue) > 0 {
+		i -= len(m.OriginalValue)
+		copy(dAtA[i:], m.OriginalValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -892,6 +1372,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxValueLength != 0 {
 		n += 1 + sovAttribute(uint64(m.MaxValueLength))
 	}
+	if m.MaxEncryptedValueLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxEncryptedValueLength))
+	}
 	return n
 }
 
@@ -919,94 +1402,90 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
-func (m *EventAttributeAdd) Size() (n int) {
+func (m *AttributeValueCount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
 	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovAttribute(uint64(m.Count))
 	}
 	return n
 }
 
-func (m *EventAttributeUpdate) Size() (n int) {
+func (m *Attestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.ValueType != 0 {
+		n += 1 + sovAttribute(uint64(m.ValueType))
 	}
-	l = len(m.OriginalType)
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.UpdateValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return n
+}
+
+func (m *EncryptedEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.UpdateType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	var l int
+	_ = l
+	if m.ValueType != 0 {
+		n += 1 + sovAttribute(uint64(m.ValueType))
 	}
-	l = len(m.Account)
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	l = len(m.Nonce)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Owner)
+	l = len(m.Ciphertext)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
 	return n
 }
 
-func (m *EventAttributeDelete) Size() (n int) {
+func (m *EncryptedRecipient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.PublicKey)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Account)
+	l = len(m.EphemeralPublicKey)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.Owner)
+	l = len(m.EncryptedKey)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
 	return n
 }
 
-func (m *EventAttributeDistinctDelete) Size() (n int) {
+func (m *EventAttributeAdd) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1020,7 +1499,94 @@ func (m *EventAttributeDistinctDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
-	l = len(m.AttributeType)
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDistinctDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
@@ -1089,6 +1655,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedValueLength", wireType)
+			}
+			m.MaxEncryptedValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEncryptedValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -1277,6 +1862,569 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttributeValueCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeValueCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeValueCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, EncryptedRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphertext = append(m.Ciphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ciphertext == nil {
+				m.Ciphertext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralPublicKey = append(m.EphemeralPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPublicKey == nil {
+				m.EphemeralPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = append(m.EncryptedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedKey == nil {
+				m.EncryptedKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 type AttributeTestSuite struct {
@@ -180,6 +184,26 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 			false,
 			"",
 		},
+		"should fail to validate basic attribute invalid value for type attestation": {
+			Attribute{
+				Name:          "attestation",
+				Value:         []byte("not an attestation"),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Attestation,
+			},
+			true,
+			"invalid attribute value for assigned type: ATTRIBUTE_TYPE_ATTESTATION",
+		},
+		"should succeed to validate basic attribute for type attestation": {
+			Attribute{
+				Name:          "attestation",
+				Value:         mustMarshalAttestation(NewAttestation(AttributeType_String, []byte("attested"), []byte("signature"))),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Attestation,
+			},
+			false,
+			"",
+		},
 		"should succeed to validate basic attribute for type bytes": {
 			Attribute{
 				Name:          "bytes",
@@ -207,3 +231,156 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 		})
 	}
 }
+
+func (s *AttributeTestSuite) TestAttestationValidateBasic() {
+	cases := map[string]struct {
+		attestation Attestation
+		errValue    string
+	}{
+		"valid attestation": {
+			NewAttestation(AttributeType_Int, []byte("406"), []byte("signature")),
+			"",
+		},
+		"missing signature": {
+			NewAttestation(AttributeType_Int, []byte("406"), nil),
+			"invalid attestation signature: empty",
+		},
+		"invalid value for type": {
+			NewAttestation(AttributeType_Int, []byte("not an int"), []byte("signature")),
+			"invalid attestation value for assigned type: ATTRIBUTE_TYPE_INT",
+		},
+		"unspecified value type": {
+			NewAttestation(AttributeType_Unspecified, []byte("406"), []byte("signature")),
+			"invalid attestation value type: ATTRIBUTE_TYPE_UNSPECIFIED",
+		},
+		"nested attestation": {
+			NewAttestation(AttributeType_Attestation, []byte("406"), []byte("signature")),
+			"invalid attestation value type: ATTRIBUTE_TYPE_ATTESTATION",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			err := tc.attestation.ValidateBasic()
+			if len(tc.errValue) > 0 {
+				s.EqualError(err, tc.errValue)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *AttributeTestSuite) TestAttestationSignBytes() {
+	bz := AttestationSignBytes("example.attribute", "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", AttributeType_String, []byte("attested"))
+	s.Equal(`{"account":"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h","name":"example.attribute","value":"YXR0ZXN0ZWQ=","value_type":"ATTRIBUTE_TYPE_STRING"}`, string(bz))
+}
+
+func (s *AttributeTestSuite) TestEncryptedEnvelope() {
+	key1, key2, other := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	envelope, err := SealEncryptedEnvelope(AttributeType_String, []byte("private"), []cryptotypes.PubKey{key1.PubKey(), key2.PubKey()})
+	s.Require().NoError(err)
+	s.Require().Len(envelope.Recipients, 2)
+	s.NotContains(string(envelope.Ciphertext), "private")
+
+	for _, key := range []*secp256k1.PrivKey{key1, key2} {
+		value, err := OpenEncryptedEnvelope(*envelope, key)
+		s.Require().NoError(err)
+		s.Equal("private", string(value))
+	}
+	_, err = OpenEncryptedEnvelope(*envelope, other)
+	s.EqualError(err, "private key is not a recipient of the encrypted envelope")
+
+	tampered := *envelope
+	tampered.Ciphertext = append([]byte{}, envelope.Ciphertext...)
+	tampered.Ciphertext[0] ^= 0xff
+	_, err = OpenEncryptedEnvelope(tampered, key1)
+	s.Error(err)
+
+	s.True(isValidValueForType(AttributeType_Encrypted, mustMarshalEnvelope(*envelope)))
+	s.False(isValidValueForType(AttributeType_Encrypted, []byte("not an envelope")))
+
+	_, err = SealEncryptedEnvelope(AttributeType_String, []byte("private"), []cryptotypes.PubKey{ed25519.GenPrivKey().PubKey()})
+	s.Error(err, "ed25519 recipient")
+	_, err = SealEncryptedEnvelope(AttributeType_String, []byte("private"), nil)
+	s.EqualError(err, "invalid encrypted envelope: no recipients")
+	_, err = SealEncryptedEnvelope(AttributeType_String, []byte("private"), []cryptotypes.PubKey{key1.PubKey(), key1.PubKey()})
+	s.Error(err, "duplicate recipient")
+}
+
+func (s *AttributeTestSuite) TestEncryptedEnvelopeValidateBasic() {
+	envelope, err := SealEncryptedEnvelope(AttributeType_Int, []byte("406"), []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()})
+	s.Require().NoError(err)
+
+	cases := map[string]struct {
+		modify   func(e *EncryptedEnvelope)
+		errValue string
+	}{
+		"valid envelope": {
+			func(e *EncryptedEnvelope) {},
+			"",
+		},
+		"nested encrypted value": {
+			func(e *EncryptedEnvelope) { e.ValueType = AttributeType_Encrypted },
+			"invalid encrypted envelope value type: ATTRIBUTE_TYPE_ENCRYPTED",
+		},
+		"unspecified value type": {
+			func(e *EncryptedEnvelope) { e.ValueType = AttributeType_Unspecified },
+			"invalid encrypted envelope value type: ATTRIBUTE_TYPE_UNSPECIFIED",
+		},
+		"no recipients": {
+			func(e *EncryptedEnvelope) { e.Recipients = nil },
+			"invalid encrypted envelope: no recipients",
+		},
+		"invalid recipient public key": {
+			func(e *EncryptedEnvelope) { e.Recipients[0].PublicKey = []byte{0x02, 0x01} },
+			"invalid encrypted envelope recipient public key 0201",
+		},
+		"invalid encrypted key": {
+			func(e *EncryptedEnvelope) { e.Recipients[0].EncryptedKey = []byte{0x01} },
+			"invalid encrypted envelope encrypted key length 1",
+		},
+		"invalid nonce": {
+			func(e *EncryptedEnvelope) { e.Nonce = nil },
+			"invalid encrypted envelope nonce length 0",
+		},
+		"missing ciphertext": {
+			func(e *EncryptedEnvelope) { e.Ciphertext = nil },
+			"invalid encrypted envelope ciphertext length 0",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			e := *envelope
+			e.Recipients = []EncryptedRecipient{envelope.Recipients[0]}
+			tc.modify(&e)
+			err := e.ValidateBasic()
+			if len(tc.errValue) > 0 {
+				s.EqualError(err, tc.errValue)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func mustMarshalEnvelope(envelope EncryptedEnvelope) []byte {
+	bz, err := envelope.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func mustMarshalAttestation(attestation Attestation) []byte {
+	bz, err := attestation.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
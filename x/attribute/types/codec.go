@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateAttributeRequest{}, "provenance/attribute/MsgUpdateAttributeRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteAttributeRequest{}, "provenance/attribute/MsgDeleteAttributeRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteDistinctAttributeRequest{}, "provenance/attribute/MsgDeleteDistinctAttributeRequest", nil)
+	cdc.RegisterConcrete(&MsgSetAttributesBatchRequest{}, "provenance/attribute/MsgSetAttributesBatchRequest", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +24,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateAttributeRequest{},
 		&MsgDeleteAttributeRequest{},
 		&MsgDeleteDistinctAttributeRequest{},
+		&MsgSetAttributesBatchRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,200 @@
+package types
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	fmt "fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// encryptionKeySize is the size of the AES-256 content and wrapping keys.
+	encryptionKeySize = 32
+	// encryptionNonceSize is the size of the AES-GCM nonces.
+	encryptionNonceSize = 12
+	// encryptionTagSize is the size of the AES-GCM authentication tag appended to each ciphertext.
+	encryptionTagSize = 16
+)
+
+// SealEncryptedEnvelope encrypts a typed value so that it can only be read with the private key of one of the
+// recipients.  Each recipient must have a secp256k1 public key.
+func SealEncryptedEnvelope(valueType AttributeType, value []byte, recipients []cryptotypes.PubKey) (*EncryptedEnvelope, error) {
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("invalid encrypted envelope: no recipients")
+	}
+	contentKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(contentKey); err != nil {
+		return nil, err
+	}
+	nonce, ciphertext, err := aesGCMSeal(contentKey, value)
+	if err != nil {
+		return nil, err
+	}
+	envelope := &EncryptedEnvelope{
+		ValueType:  valueType,
+		Recipients: make([]EncryptedRecipient, len(recipients)),
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}
+	for i, recipient := range recipients {
+		if envelope.Recipients[i], err = wrapContentKey(contentKey, recipient); err != nil {
+			return nil, err
+		}
+	}
+	return envelope, envelope.ValidateBasic()
+}
+
+// OpenEncryptedEnvelope decrypts the value of an envelope with the private key of one of its recipients.
+func OpenEncryptedEnvelope(envelope EncryptedEnvelope, privKey cryptotypes.PrivKey) ([]byte, error) {
+	key, ok := privKey.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T, only secp256k1 keys can decrypt", privKey)
+	}
+	pubKey := key.PubKey().Bytes()
+	for _, recipient := range envelope.Recipients {
+		if !bytes.Equal(recipient.PublicKey, pubKey) {
+			continue
+		}
+		ephemeral, err := btcec.ParsePubKey(recipient.EphemeralPublicKey, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid ephemeral public key: %w", err)
+		}
+		priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), key.Key)
+		wrappingKey := deriveWrappingKey(btcec.GenerateSharedSecret(priv, ephemeral), recipient)
+		if len(recipient.EncryptedKey) < encryptionNonceSize {
+			return nil, fmt.Errorf("invalid encrypted key length %d", len(recipient.EncryptedKey))
+		}
+		contentKey, err := aesGCMOpen(wrappingKey, recipient.EncryptedKey[:encryptionNonceSize], recipient.EncryptedKey[encryptionNonceSize:])
+		if err != nil {
+			return nil, fmt.Errorf("unable to unwrap content key: %w", err)
+		}
+		return aesGCMOpen(contentKey, envelope.Nonce, envelope.Ciphertext)
+	}
+	return nil, fmt.Errorf("private key is not a recipient of the encrypted envelope")
+}
+
+// ParseEncryptedEnvelope decodes an encrypted envelope from an attribute value.
+func ParseEncryptedEnvelope(value []byte) (*EncryptedEnvelope, error) {
+	envelope := EncryptedEnvelope{}
+	if err := envelope.Unmarshal(value); err != nil {
+		return nil, fmt.Errorf("invalid encrypted envelope: %w", err)
+	}
+	return &envelope, nil
+}
+
+// ValidateBasic ensures an encrypted envelope has a valid non-encrypted value type, distinct recipients with
+// secp256k1 public keys, and a ciphertext.  The encrypted value itself cannot be checked.
+func (e EncryptedEnvelope) ValidateBasic() error {
+	if e.ValueType == AttributeType_Encrypted || !ValidAttributeType(e.ValueType) {
+		return fmt.Errorf("invalid encrypted envelope value type: %s", e.ValueType)
+	}
+	if len(e.Recipients) == 0 {
+		return fmt.Errorf("invalid encrypted envelope: no recipients")
+	}
+	seen := make(map[string]bool)
+	for _, recipient := range e.Recipients {
+		if err := recipient.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[string(recipient.PublicKey)] {
+			return fmt.Errorf("invalid encrypted envelope: duplicate recipient %X", recipient.PublicKey)
+		}
+		seen[string(recipient.PublicKey)] = true
+	}
+	if len(e.Nonce) != encryptionNonceSize {
+		return fmt.Errorf("invalid encrypted envelope nonce length %d", len(e.Nonce))
+	}
+	if len(e.Ciphertext) < encryptionTagSize {
+		return fmt.Errorf("invalid encrypted envelope ciphertext length %d", len(e.Ciphertext))
+	}
+	return nil
+}
+
+// ValidateBasic ensures a recipient has valid public keys and a wrapped content key.
+func (r EncryptedRecipient) ValidateBasic() error {
+	if _, err := btcec.ParsePubKey(r.PublicKey, btcec.S256()); err != nil || len(r.PublicKey) != btcec.PubKeyBytesLenCompressed {
+		return fmt.Errorf("invalid encrypted envelope recipient public key %X", r.PublicKey)
+	}
+	if _, err := btcec.ParsePubKey(r.EphemeralPublicKey, btcec.S256()); err != nil || len(r.EphemeralPublicKey) != btcec.PubKeyBytesLenCompressed {
+		return fmt.Errorf("invalid encrypted envelope ephemeral public key %X", r.EphemeralPublicKey)
+	}
+	if len(r.EncryptedKey) != encryptionNonceSize+encryptionKeySize+encryptionTagSize {
+		return fmt.Errorf("invalid encrypted envelope encrypted key length %d", len(r.EncryptedKey))
+	}
+	return nil
+}
+
+// wrapContentKey encrypts the content key for a recipient with a key derived from a new ephemeral key.
+func wrapContentKey(contentKey []byte, recipient cryptotypes.PubKey) (EncryptedRecipient, error) {
+	if _, ok := recipient.(*secp256k1.PubKey); !ok {
+		return EncryptedRecipient{}, fmt.Errorf("unsupported recipient public key type %T, only secp256k1 keys can be encrypted for", recipient)
+	}
+	pub, err := btcec.ParsePubKey(recipient.Bytes(), btcec.S256())
+	if err != nil {
+		return EncryptedRecipient{}, fmt.Errorf("invalid recipient public key: %w", err)
+	}
+	ephemeral, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return EncryptedRecipient{}, err
+	}
+	r := EncryptedRecipient{
+		PublicKey:          pub.SerializeCompressed(),
+		EphemeralPublicKey: ephemeral.PubKey().SerializeCompressed(),
+	}
+	nonce, wrapped, err := aesGCMSeal(deriveWrappingKey(btcec.GenerateSharedSecret(ephemeral, pub), r), contentKey)
+	if err != nil {
+		return EncryptedRecipient{}, err
+	}
+	r.EncryptedKey = append(nonce, wrapped...)
+	return r, nil
+}
+
+// deriveWrappingKey derives the key wrapping the content key for a recipient from the ECDH shared secret, binding it
+// to both public keys.
+func deriveWrappingKey(sharedSecret []byte, r EncryptedRecipient) []byte {
+	h := sha256.New()
+	h.Write(sharedSecret)
+	h.Write(r.EphemeralPublicKey)
+	h.Write(r.PublicKey)
+	return h.Sum(nil)
+}
+
+// aesGCMSeal encrypts the plaintext with a random nonce, returning the nonce and ciphertext.
+func aesGCMSeal(key []byte, plaintext []byte) ([]byte, []byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, encryptionNonceSize)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, gcm.Seal(nil, nonce, plaintext, nil), nil
+}
+
+// aesGCMOpen decrypts and authenticates the ciphertext.
+func aesGCMOpen(key []byte, nonce []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != encryptionNonceSize {
+		return nil, fmt.Errorf("invalid nonce length %d", len(nonce))
+	}
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
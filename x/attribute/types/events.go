@@ -24,6 +24,8 @@ const (
 	EventTelemetryKeyDelete string = "delete"
 	// EventTelemetryKeyDistinctDelete delete telemetry metrics key
 	EventTelemetryKeyDistinctDelete string = "distinct_delete"
+	// EventTelemetryKeyBatch batch telemetry metrics key
+	EventTelemetryKeyBatch string = "batch"
 	// EventTelemetryLabelName name telemetry metrics label
 	EventTelemetryLabelName string = "name"
 	// EventTelemetryLabelName name telemetry metrics label
@@ -19,3 +19,14 @@ type NameKeeper interface {
 	GetRecordByName(ctx sdk.Context, name string) (record *nametypes.NameRecord, err error)
 	NameExists(ctx sdk.Context, name string) bool
 }
+
+// AttributeHooks are notified by the attribute keeper after the attributes of an account change, e.g. so that another
+// module can keep a cache of the attributes it checks.  Attributes imported in genesis are not passed to the hooks.
+type AttributeHooks interface {
+	// AfterAttributeAdded is called after an attribute is added to an account.
+	AfterAttributeAdded(ctx sdk.Context, attr Attribute)
+	// AfterAttributeUpdated is called after the value or type of an attribute of an account is updated.
+	AfterAttributeUpdated(ctx sdk.Context, original Attribute, updated Attribute)
+	// AfterAttributeDeleted is called once for each attribute deleted from an account.
+	AfterAttributeDeleted(ctx sdk.Context, attr Attribute)
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
 	return &GenesisState{
@@ -25,3 +31,14 @@ func DefaultGenesisState() *GenesisState {
 		Attributes: []Attribute{},
 	}
 }
+
+// GetGenesisStateFromAppState returns x/attribute GenesisState given raw application genesis state.  The default
+// genesis state is returned when the application genesis state does not contain the attribute module.
+func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) *GenesisState {
+	genesisState := DefaultGenesisState()
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], genesisState)
+	}
+
+	return genesisState
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple attribute hooks, all hook functions are run in array sequence
+var _ AttributeHooks = &MultiAttributeHooks{}
+
+// MultiAttributeHooks runs each of its hooks in order.
+type MultiAttributeHooks []AttributeHooks
+
+// NewMultiAttributeHooks combines the hooks into one that runs each of them in the order given.
+func NewMultiAttributeHooks(hooks ...AttributeHooks) MultiAttributeHooks {
+	return hooks
+}
+
+// AfterAttributeAdded runs the AfterAttributeAdded hook of each of the hooks.
+func (h MultiAttributeHooks) AfterAttributeAdded(ctx sdk.Context, attr Attribute) {
+	for i := range h {
+		h[i].AfterAttributeAdded(ctx, attr)
+	}
+}
+
+// AfterAttributeUpdated runs the AfterAttributeUpdated hook of each of the hooks.
+func (h MultiAttributeHooks) AfterAttributeUpdated(ctx sdk.Context, original Attribute, updated Attribute) {
+	for i := range h {
+		h[i].AfterAttributeUpdated(ctx, original, updated)
+	}
+}
+
+// AfterAttributeDeleted runs the AfterAttributeDeleted hook of each of the hooks.
+func (h MultiAttributeHooks) AfterAttributeDeleted(ctx sdk.Context, attr Attribute) {
+	for i := range h {
+		h[i].AfterAttributeDeleted(ctx, attr)
+	}
+}
@@ -27,6 +27,8 @@ var (
 	// Legacy amino encoded objects use this key prefix
 	AttributeKeyPrefixAmino = []byte{0x00}
 	AttributeKeyPrefix      = []byte{0x02}
+	// AttributeValueKeyPrefix prefix for the distinct values stored under each attribute name and their counts
+	AttributeValueKeyPrefix = []byte{0x03}
 )
 
 // AccountAttributeKey creates a key for an account attribute
@@ -47,6 +49,16 @@ func AccountAttributesNameKeyPrefix(acc sdk.AccAddress, attributeName string) []
 	return append(key, GetNameKeyBytes(attributeName)...)
 }
 
+// AttributeValuesKeyPrefix returns a prefix key for the distinct values stored under an attribute name
+func AttributeValuesKeyPrefix(attributeName string) []byte {
+	return append(append([]byte{}, AttributeValueKeyPrefix...), GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeValueKey creates a key for the count of accounts with an attribute of the given name and value
+func AttributeValueKey(attr Attribute) []byte {
+	return append(AttributeValuesKeyPrefix(attr.Name), attr.Hash()...)
+}
+
 // GetNameKeyBytes returns a set of bytes that uniquely identifies the given name
 func GetNameKeyBytes(name string) []byte {
 	attrName := strings.ToLower(strings.TrimSpace(name))
@@ -13,6 +13,10 @@ const (
 	TypeMsgUpdateAttribute         = "update_attribute"
 	TypeMsgDeleteAttribute         = "delete_attribute"
 	TypeMsgDeleteDistinctAttribute = "delete_distinct_attribute"
+	TypeMsgSetAttributesBatch      = "set_attributes_batch"
+
+	// MaxAttributesBatchSize is the maximum number of entries allowed in a MsgSetAttributesBatchRequest.
+	MaxAttributesBatchSize = 100
 )
 
 // Compile time interface checks.
@@ -21,6 +25,7 @@ var (
 	_ sdk.Msg = &MsgUpdateAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteDistinctAttributeRequest{}
+	_ sdk.Msg = &MsgSetAttributesBatchRequest{}
 )
 
 // NewMsgAddAttributeRequest creates a new add attribute message
@@ -252,3 +257,91 @@ func (msg MsgDeleteDistinctAttributeRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetAttributesBatchRequest creates a new set attributes batch message
+func NewMsgSetAttributesBatchRequest(owner sdk.AccAddress, entries []AttributeBatchEntry) *MsgSetAttributesBatchRequest { // nolint:interfacer
+	for i := range entries {
+		entries[i].Name = strings.ToLower(strings.TrimSpace(entries[i].Name))
+	}
+	return &MsgSetAttributesBatchRequest{Owner: owner.String(), Entries: entries}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetAttributesBatchRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgSetAttributesBatchRequest) Type() string { return TypeMsgSetAttributesBatch }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributesBatchRequest) ValidateBasic() error {
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if len(msg.Entries) == 0 {
+		return fmt.Errorf("no attribute entries")
+	}
+	if len(msg.Entries) > MaxAttributesBatchSize {
+		return fmt.Errorf("too many attribute entries: %d > %d", len(msg.Entries), MaxAttributesBatchSize)
+	}
+	for i, entry := range msg.Entries {
+		if err := entry.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid attribute entry %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetAttributesBatchRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgSetAttributesBatchRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// String implements stringer interface
+func (msg MsgSetAttributesBatchRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// ValidateBasic runs stateless validation checks on a batch entry.
+func (e AttributeBatchEntry) ValidateBasic() error {
+	if len(e.Account) == 0 {
+		return fmt.Errorf("empty account address")
+	}
+	accAddr, err := sdk.AccAddressFromBech32(e.Account)
+	if err != nil {
+		return err
+	}
+	if e.Delete {
+		if strings.TrimSpace(e.Name) == "" {
+			return fmt.Errorf("empty name")
+		}
+		return nil
+	}
+	a := NewAttribute(e.Name, accAddr, e.AttributeType, e.Value)
+	return a.ValidateBasic()
+}
+
+// Attribute returns the attribute an entry sets on its account.
+func (e AttributeBatchEntry) Attribute() Attribute {
+	return Attribute{
+		Address:       e.Account,
+		Name:          e.Name,
+		AttributeType: e.AttributeType,
+		Value:         e.Value,
+	}
+}
@@ -103,3 +103,39 @@ func TestMsgDeleteDistinctAttribute(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for TestMsgSetAttributesBatch
+func TestMsgSetAttributesBatch(t *testing.T) {
+	validEntry := AttributeBatchEntry{Account: addrs[0].String(), Name: "test", Value: []byte("value"), AttributeType: AttributeType_String}
+	tooMany := make([]AttributeBatchEntry, MaxAttributesBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = validEntry
+	}
+	tests := []struct {
+		name    string
+		owner   sdk.AccAddress
+		entries []AttributeBatchEntry
+		errMsg  string
+	}{
+		{"valid set", addrs[1], []AttributeBatchEntry{validEntry}, ""},
+		{"valid delete", addrs[1], []AttributeBatchEntry{{Account: addrs[0].String(), Name: "test", Delete: true}}, ""},
+		{"nil owner", nil, []AttributeBatchEntry{validEntry}, "empty owner address"},
+		{"no entries", addrs[1], nil, "no attribute entries"},
+		{"too many entries", addrs[1], tooMany, "too many attribute entries: 101 > 100"},
+		{"empty account", addrs[1], []AttributeBatchEntry{validEntry, {Name: "test", Delete: true}}, "invalid attribute entry 1: empty account address"},
+		{"delete without name", addrs[1], []AttributeBatchEntry{{Account: addrs[0].String(), Delete: true}}, "invalid attribute entry 0: empty name"},
+		{"set without type", addrs[1], []AttributeBatchEntry{{Account: addrs[0].String(), Name: "test", Value: []byte("value")}}, "invalid attribute entry 0: invalid attribute type"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgSetAttributesBatchRequest(tc.owner, tc.entries)
+			err := msg.ValidateBasic()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
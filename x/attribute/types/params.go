@@ -10,11 +10,14 @@ import (
 // Default parameter namespace
 const (
 	DefaultMaxValueLength = 10000
+	// DefaultMaxEncryptedValueLength is the default max length of an encrypted envelope attribute value.
+	DefaultMaxEncryptedValueLength = 5000
 )
 
 // Parameter store keys
 var (
-	ParamStoreKeyMaxValueLength = []byte("MaxValueLength")
+	ParamStoreKeyMaxValueLength          = []byte("MaxValueLength")
+	ParamStoreKeyMaxEncryptedValueLength = []byte("MaxEncryptedValueLength")
 )
 
 // String implements stringer interface
@@ -31,9 +34,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams create a new Params object
 func NewParams(
 	maxValueLength uint32,
+	maxEncryptedValueLength uint32,
 ) Params {
 	return Params{
-		MaxValueLength: maxValueLength,
+		MaxValueLength:          maxValueLength,
+		MaxEncryptedValueLength: maxEncryptedValueLength,
 	}
 }
 
@@ -41,6 +46,7 @@ func NewParams(
 func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxValueLength, &params.MaxValueLength, validateMaxValueLength),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxEncryptedValueLength, &params.MaxEncryptedValueLength, validateMaxValueLength),
 	}
 }
 
@@ -48,6 +54,7 @@ func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxValueLength,
+		DefaultMaxEncryptedValueLength,
 	)
 }
 
@@ -432,6 +432,203 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAttributeValuesRequest is the request type for the Query/AttributeValues method.
+type QueryAttributeValuesRequest struct {
+	// name is the attribute name to query for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeValuesRequest) Reset()         { *m = QueryAttributeValuesRequest{} }
+func (m *QueryAttributeValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeValuesRequest) ProtoMessage()    {}
+func (*QueryAttributeValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *QueryAttributeValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeValuesRequest.Merge(m, src)
+}
+func (m *QueryAttributeValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeValuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeValuesRequest proto.InternalMessageInfo
+
+// QueryAttributeValuesResponse is the response type for the Query/AttributeValues method.
+type QueryAttributeValuesResponse struct {
+	// the attribute name the values are stored under
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// a list of the distinct values and the number of accounts each is stored on
+	Values []AttributeValueCount `protobuf:"bytes,2,rep,name=values,proto3" json:"values"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeValuesResponse) Reset()         { *m = QueryAttributeValuesResponse{} }
+func (m *QueryAttributeValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeValuesResponse) ProtoMessage()    {}
+func (*QueryAttributeValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryAttributeValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeValuesResponse.Merge(m, src)
+}
+func (m *QueryAttributeValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeValuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeValuesResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeValuesResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryAttributeValuesResponse) GetValues() []AttributeValueCount {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *QueryAttributeValuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNormalizeRequest is the request type for the Query/Normalize method.
+type QueryNormalizeRequest struct {
+	// name is the attribute name to normalize
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryNormalizeRequest) Reset()         { *m = QueryNormalizeRequest{} }
+func (m *QueryNormalizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNormalizeRequest) ProtoMessage()    {}
+func (*QueryNormalizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *QueryNormalizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNormalizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNormalizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNormalizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNormalizeRequest.Merge(m, src)
+}
+func (m *QueryNormalizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNormalizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNormalizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNormalizeRequest proto.InternalMessageInfo
+
+func (m *QueryNormalizeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryNormalizeResponse is the response type for the Query/Normalize method.
+type QueryNormalizeResponse struct {
+	// the attribute name in normalized format
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryNormalizeResponse) Reset()         { *m = QueryNormalizeResponse{} }
+func (m *QueryNormalizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNormalizeResponse) ProtoMessage()    {}
+func (*QueryNormalizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
+}
+func (m *QueryNormalizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNormalizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNormalizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNormalizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNormalizeResponse.Merge(m, src)
+}
+func (m *QueryNormalizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNormalizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNormalizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNormalizeResponse proto.InternalMessageInfo
+
+func (m *QueryNormalizeResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -441,6 +638,10 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryAttributeValuesRequest)(nil), "provenance.attribute.v1.QueryAttributeValuesRequest")
+	proto.RegisterType((*QueryAttributeValuesResponse)(nil), "provenance.attribute.v1.QueryAttributeValuesResponse")
+	proto.RegisterType((*QueryNormalizeRequest)(nil), "provenance.attribute.v1.QueryNormalizeRequest")
+	proto.RegisterType((*QueryNormalizeResponse)(nil), "provenance.attribute.v1.QueryNormalizeResponse")
 }
 
 func init() {
@@ -448,46 +649,55 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0x69, 0x9a, 0xdf, 0xaf, 0x4f, 0x17, 0x38, 0x4a, 0x1b, 0x59, 0xc8, 0x29, 0x46,
-	0x22, 0xa5, 0x50, 0x1f, 0x49, 0x95, 0xa5, 0xc0, 0x40, 0x06, 0x60, 0x0c, 0x81, 0x89, 0xed, 0x62,
-	0x5d, 0x8d, 0x25, 0xe2, 0x73, 0x73, 0xe7, 0xa8, 0x55, 0x94, 0x05, 0x31, 0x30, 0x20, 0x81, 0x84,
-	0x04, 0x6b, 0x59, 0x90, 0x78, 0x17, 0x2c, 0xa0, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x18, 0x78,
-	0x19, 0x28, 0xe7, 0x4b, 0xe2, 0x36, 0xb8, 0x09, 0x12, 0x0c, 0xdd, 0xce, 0x97, 0xe7, 0xcf, 0xe7,
-	0xfb, 0xbd, 0xbb, 0x27, 0x70, 0x29, 0x6c, 0xf1, 0x36, 0x0b, 0x68, 0xe0, 0x32, 0x42, 0xa5, 0x6c,
-	0xf9, 0x8d, 0x48, 0x32, 0xd2, 0x2e, 0x91, 0x9d, 0x88, 0xb5, 0xf6, 0x9c, 0xb0, 0xc5, 0x25, 0xc7,
-	0x2b, 0xe3, 0x20, 0x67, 0x14, 0xe4, 0xb4, 0x4b, 0xe6, 0xba, 0xcb, 0x45, 0x93, 0x0b, 0xd2, 0xa0,
-	0x82, 0xc5, 0x19, 0xa4, 0x5d, 0x6a, 0x30, 0x49, 0x4b, 0x24, 0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3,
-	0x20, 0x2e, 0x62, 0x2e, 0x79, 0xdc, 0xe3, 0x6a, 0x49, 0x06, 0x2b, 0xbd, 0x7b, 0xc1, 0xe3, 0xdc,
-	0x7b, 0xc2, 0x08, 0x0d, 0x7d, 0x42, 0x83, 0x80, 0x4b, 0x95, 0x22, 0xf4, 0xaf, 0xc5, 0x34, 0xba,
-	0x31, 0x85, 0x0a, 0xb4, 0x97, 0x00, 0xdf, 0x1f, 0xb4, 0xaf, 0xd1, 0x16, 0x6d, 0x8a, 0x3a, 0xdb,
-	0x89, 0x98, 0x90, 0xf6, 0x43, 0x38, 0x77, 0x64, 0x57, 0x84, 0x3c, 0x10, 0x0c, 0xdf, 0x82, 0x5c,
-	0xa8, 0x76, 0xf2, 0x68, 0x15, 0xad, 0x2d, 0x96, 0x0b, 0x4e, 0x8a, 0x3e, 0x27, 0x4e, 0xac, 0x66,
-	0x0f, 0xbe, 0x15, 0x8c, 0xba, 0x4e, 0xb2, 0xdf, 0x22, 0x38, 0xaf, 0xca, 0xde, 0x1e, 0x86, 0xea,
-	0x7e, 0x38, 0x0f, 0xff, 0x51, 0xd7, 0xe5, 0x51, 0x20, 0x55, 0xe5, 0x85, 0xfa, 0xf0, 0x13, 0x63,
-	0xc8, 0x06, 0xb4, 0xc9, 0xf2, 0x19, 0xb5, 0xad, 0xd6, 0xf8, 0x0e, 0xc0, 0xd8, 0xa4, 0xfc, 0x9c,
-	0x42, 0xb9, 0xec, 0xc4, 0x8e, 0x3a, 0x03, 0x47, 0x9d, 0xf8, 0x0c, 0xb4, 0xa3, 0x4e, 0x8d, 0x7a,
-	0xc3, 0x4e, 0xf5, 0x44, 0xe6, 0xd6, 0xff, 0xcf, 0xf7, 0x0b, 0xc6, 0xcf, 0xfd, 0x82, 0x61, 0x7f,
-	0x42, 0xb0, 0x7c, 0x9c, 0x4c, 0x6b, 0x4e, 0x47, 0xbb, 0x07, 0x30, 0xd2, 0x2c, 0xf2, 0x99, 0xd5,
-	0xb9, 0xb5, 0xc5, 0xb2, 0x9d, 0xea, 0xc8, 0xa8, 0xb2, 0x36, 0x25, 0x91, 0x8b, 0xef, 0xfe, 0x46,
-	0x50, 0x71, 0xaa, 0xa0, 0x18, 0x30, 0xa9, 0xc8, 0x7e, 0x36, 0xa1, 0x43, 0x4c, 0xb7, 0xf8, 0xa8,
-	0x9d, 0x99, 0xbf, 0x60, 0xe7, 0x67, 0x04, 0x2b, 0x13, 0x18, 0xa7, 0xd1, 0xcf, 0x37, 0x08, 0xce,
-	0x28, 0x21, 0x0f, 0x5c, 0x1a, 0x4c, 0x77, 0x72, 0x19, 0x72, 0x22, 0xda, 0xde, 0xf6, 0x77, 0xf5,
-	0x75, 0xd5, 0x5f, 0xff, 0xe0, 0xc2, 0x7e, 0x44, 0x70, 0x36, 0x01, 0x76, 0x0a, 0xbd, 0x2d, 0xbf,
-	0x9c, 0x87, 0x79, 0x25, 0x01, 0xbf, 0x40, 0x90, 0x8b, 0x07, 0x06, 0xbe, 0x9a, 0xca, 0x34, 0x39,
-	0xa5, 0xcc, 0x6b, 0xb3, 0x05, 0xc7, 0xbd, 0xed, 0xe2, 0xd3, 0x2f, 0x3f, 0x5e, 0x67, 0x2e, 0xe2,
-	0x02, 0x49, 0x9b, 0x8d, 0xf1, 0x98, 0xc2, 0x1f, 0x10, 0x2c, 0x8c, 0x1c, 0xc0, 0xce, 0xc9, 0x4d,
-	0x8e, 0x8f, 0x32, 0x93, 0xcc, 0x1c, 0xaf, 0xb9, 0x6e, 0x28, 0xae, 0x0a, 0xde, 0x24, 0x53, 0x67,
-	0x36, 0xe9, 0xe8, 0xf3, 0xec, 0x92, 0xce, 0x60, 0x12, 0x76, 0xf1, 0x7b, 0x04, 0x30, 0x7e, 0x64,
-	0x78, 0xd6, 0xe6, 0x23, 0x0b, 0xaf, 0xcf, 0x9e, 0xa0, 0x71, 0x2b, 0x0a, 0x97, 0xe0, 0x8d, 0xe9,
-	0xb8, 0x62, 0xcc, 0x8b, 0xdf, 0x21, 0xc8, 0x0e, 0xee, 0x2a, 0xbe, 0x72, 0x72, 0xc7, 0xc4, 0x43,
-	0x33, 0xd7, 0x67, 0x09, 0xd5, 0x58, 0x55, 0x85, 0x75, 0x13, 0x6f, 0xfd, 0x91, 0x8b, 0xc2, 0xa5,
-	0x01, 0xe9, 0xc4, 0xaf, 0xb4, 0x5b, 0x6d, 0x1e, 0xf4, 0x2c, 0x74, 0xd8, 0xb3, 0xd0, 0xf7, 0x9e,
-	0x85, 0x5e, 0xf5, 0x2d, 0xe3, 0xb0, 0x6f, 0x19, 0x5f, 0xfb, 0x96, 0x01, 0xa6, 0xcf, 0xd3, 0x58,
-	0x6a, 0xe8, 0x51, 0xc5, 0xf3, 0xe5, 0xe3, 0xa8, 0xe1, 0xb8, 0xbc, 0x99, 0xe8, 0xbe, 0xe1, 0xf3,
-	0x24, 0xcb, 0x6e, 0x02, 0x40, 0xee, 0x85, 0x4c, 0x34, 0x72, 0xea, 0x1f, 0x78, 0xf3, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x59, 0xc9, 0xa6, 0x4a, 0x08, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc7, 0x73, 0x69, 0xdf, 0xf4, 0xed, 0xd3, 0xe1, 0x7d, 0xdf, 0x7b, 0x4b, 0x1b, 0x99, 0x2a,
+	0x29, 0x46, 0xea, 0xef, 0xfa, 0x48, 0x4b, 0x96, 0x02, 0x03, 0x45, 0x02, 0xc4, 0x80, 0x4a, 0x40,
+	0x0c, 0x6c, 0x17, 0xeb, 0x6a, 0x2c, 0x35, 0x3e, 0x37, 0x67, 0x47, 0x2d, 0x55, 0x17, 0x60, 0x60,
+	0x60, 0x40, 0x42, 0x82, 0x91, 0xb2, 0x20, 0xb1, 0xf0, 0x37, 0xb0, 0x00, 0x1d, 0x2b, 0xb1, 0x30,
+	0x21, 0x94, 0x32, 0xf0, 0x67, 0xa0, 0x9c, 0xcf, 0x89, 0x93, 0xd6, 0xb5, 0x2b, 0x95, 0xa1, 0x9b,
+	0x7d, 0x79, 0x9e, 0x7b, 0x3e, 0xdf, 0xaf, 0xef, 0x79, 0x2e, 0x70, 0xde, 0xad, 0xf3, 0x06, 0x73,
+	0xa8, 0x63, 0x32, 0x42, 0x3d, 0xaf, 0x6e, 0x57, 0x7d, 0x8f, 0x91, 0x46, 0x89, 0xac, 0xfb, 0xac,
+	0xbe, 0x69, 0xb8, 0x75, 0xee, 0x71, 0x3c, 0xda, 0x09, 0x32, 0xda, 0x41, 0x46, 0xa3, 0xa4, 0xcd,
+	0x98, 0x5c, 0xd4, 0xb8, 0x20, 0x55, 0x2a, 0x58, 0x90, 0x41, 0x1a, 0xa5, 0x2a, 0xf3, 0x68, 0x89,
+	0xb8, 0xd4, 0xb2, 0x1d, 0xea, 0xd9, 0xdc, 0x09, 0x36, 0xd1, 0x86, 0x2d, 0x6e, 0x71, 0xf9, 0x48,
+	0x5a, 0x4f, 0x6a, 0x75, 0xcc, 0xe2, 0xdc, 0x5a, 0x63, 0x84, 0xba, 0x36, 0xa1, 0x8e, 0xc3, 0x3d,
+	0x99, 0x22, 0xd4, 0xaf, 0x93, 0x71, 0x74, 0x1d, 0x0a, 0x19, 0xa8, 0x0f, 0x03, 0xbe, 0xd3, 0x2a,
+	0xbf, 0x42, 0xeb, 0xb4, 0x26, 0x2a, 0x6c, 0xdd, 0x67, 0xc2, 0xd3, 0xef, 0xc1, 0xff, 0x5d, 0xab,
+	0xc2, 0xe5, 0x8e, 0x60, 0xf8, 0x0a, 0xe4, 0x5c, 0xb9, 0x92, 0x47, 0xe3, 0x68, 0x6a, 0x68, 0xa1,
+	0x68, 0xc4, 0xe8, 0x33, 0x82, 0xc4, 0xe5, 0xfe, 0xdd, 0xef, 0xc5, 0x4c, 0x45, 0x25, 0xe9, 0xaf,
+	0x11, 0x9c, 0x91, 0xdb, 0x5e, 0x0d, 0x43, 0x55, 0x3d, 0x9c, 0x87, 0x01, 0x6a, 0x9a, 0xdc, 0x77,
+	0x3c, 0xb9, 0xf3, 0x60, 0x25, 0x7c, 0xc5, 0x18, 0xfa, 0x1d, 0x5a, 0x63, 0xf9, 0xac, 0x5c, 0x96,
+	0xcf, 0xf8, 0x3a, 0x40, 0xc7, 0xa4, 0x7c, 0x9f, 0x44, 0x99, 0x30, 0x02, 0x47, 0x8d, 0x96, 0xa3,
+	0x46, 0xf0, 0x0d, 0x94, 0xa3, 0xc6, 0x0a, 0xb5, 0xc2, 0x4a, 0x95, 0x48, 0xe6, 0xd2, 0xdf, 0xcf,
+	0x76, 0x8a, 0x99, 0x5f, 0x3b, 0xc5, 0x8c, 0xfe, 0x09, 0xc1, 0x48, 0x2f, 0x99, 0xd2, 0x1c, 0x8f,
+	0x76, 0x13, 0xa0, 0xad, 0x59, 0xe4, 0xb3, 0xe3, 0x7d, 0x53, 0x43, 0x0b, 0x7a, 0xac, 0x23, 0xed,
+	0x9d, 0x95, 0x29, 0x91, 0x5c, 0x7c, 0xe3, 0x10, 0x41, 0x93, 0x89, 0x82, 0x02, 0xc0, 0xa8, 0x22,
+	0xfd, 0xe9, 0x01, 0x1d, 0x22, 0xd9, 0xe2, 0x6e, 0x3b, 0xb3, 0x27, 0x60, 0xe7, 0x67, 0x04, 0xa3,
+	0x07, 0x30, 0x4e, 0xa3, 0x9f, 0xaf, 0x10, 0xfc, 0x2b, 0x85, 0xdc, 0x35, 0xa9, 0x93, 0xec, 0xe4,
+	0x08, 0xe4, 0x84, 0xbf, 0xba, 0x6a, 0x6f, 0xa8, 0xe3, 0xaa, 0xde, 0xfe, 0xc0, 0x81, 0xfd, 0x88,
+	0xe0, 0xbf, 0x08, 0xd8, 0x69, 0xf4, 0xf6, 0x09, 0x82, 0xb3, 0xdd, 0x87, 0xe4, 0x3e, 0x5d, 0xf3,
+	0x3b, 0x07, 0x36, 0xec, 0x7c, 0x14, 0xdb, 0xf9, 0x27, 0x71, 0x54, 0xbf, 0x20, 0x18, 0x3b, 0x9c,
+	0x42, 0x79, 0x7a, 0x18, 0xc6, 0x2d, 0xc8, 0x35, 0x64, 0x94, 0x72, 0x72, 0x2e, 0xd9, 0x49, 0xb9,
+	0xeb, 0xb5, 0xd6, 0xb7, 0x08, 0x87, 0x62, 0xb0, 0xc3, 0xc9, 0xf9, 0x39, 0xab, 0x86, 0xeb, 0x6d,
+	0x5e, 0xaf, 0xd1, 0x35, 0xfb, 0x11, 0x3b, 0xc2, 0x48, 0x7d, 0x0e, 0x46, 0x7a, 0x83, 0xe3, 0xf5,
+	0x2e, 0x34, 0x07, 0xe0, 0x2f, 0x19, 0x8e, 0x9f, 0x23, 0xc8, 0x05, 0xb3, 0x1d, 0xcf, 0xc6, 0x8a,
+	0x3e, 0x78, 0xa1, 0x68, 0x73, 0xe9, 0x82, 0x03, 0x06, 0x7d, 0xf2, 0xf1, 0xd7, 0x9f, 0x2f, 0xb3,
+	0xe7, 0x70, 0x91, 0xc4, 0x5d, 0x63, 0xc1, 0x8d, 0x82, 0xdf, 0x23, 0x18, 0x6c, 0x5b, 0x8c, 0x8d,
+	0xa3, 0x8b, 0xf4, 0xde, 0x3a, 0x1a, 0x49, 0x1d, 0xaf, 0xb8, 0x2e, 0x49, 0xae, 0x32, 0x5e, 0x24,
+	0x89, 0xd7, 0x2b, 0xd9, 0x52, 0xad, 0xb7, 0x4d, 0xb6, 0x5a, 0x1e, 0x6e, 0xe3, 0x77, 0x08, 0xa0,
+	0x33, 0x0f, 0x71, 0xda, 0xe2, 0x6d, 0x0b, 0x2f, 0xa4, 0x4f, 0x50, 0xb8, 0x65, 0x89, 0x4b, 0xf0,
+	0x7c, 0x32, 0xae, 0xe8, 0xf0, 0xe2, 0xb7, 0x08, 0xfa, 0x5b, 0x63, 0x05, 0x4f, 0x1f, 0x5d, 0x31,
+	0x32, 0x13, 0xb5, 0x99, 0x34, 0xa1, 0x0a, 0x6b, 0x59, 0x62, 0x5d, 0xc6, 0x4b, 0xc7, 0x72, 0x51,
+	0x98, 0xd4, 0x21, 0x5b, 0xc1, 0x40, 0xdd, 0xc6, 0x1f, 0x10, 0xfc, 0xd3, 0xd3, 0xb1, 0xf8, 0x62,
+	0x4a, 0x83, 0xba, 0xc6, 0x8c, 0x56, 0x3e, 0x66, 0x96, 0x12, 0x61, 0x48, 0x11, 0x53, 0x78, 0x22,
+	0x56, 0x44, 0xd0, 0xdf, 0xe1, 0xd7, 0x7f, 0x83, 0x60, 0xb0, 0xdd, 0x6c, 0x49, 0x27, 0xb5, 0xb7,
+	0x85, 0x35, 0x92, 0x3a, 0x5e, 0xe1, 0x95, 0x24, 0xde, 0x2c, 0x9e, 0x8e, 0xc5, 0x73, 0xc2, 0x1c,
+	0x45, 0xb8, 0x5c, 0xdb, 0x6d, 0x16, 0xd0, 0x5e, 0xb3, 0x80, 0x7e, 0x34, 0x0b, 0xe8, 0xc5, 0x7e,
+	0x21, 0xb3, 0xb7, 0x5f, 0xc8, 0x7c, 0xdb, 0x2f, 0x64, 0x40, 0xb3, 0x79, 0x5c, 0xfd, 0x15, 0xf4,
+	0xa0, 0x6c, 0xd9, 0xde, 0x43, 0xbf, 0x6a, 0x98, 0xbc, 0x16, 0x29, 0x36, 0x6f, 0xf3, 0x68, 0xe9,
+	0x8d, 0x48, 0x71, 0x6f, 0xd3, 0x65, 0xa2, 0x9a, 0x93, 0xff, 0x3f, 0x17, 0x7f, 0x0f, 0x00, 0xe7,
+	0xd6, 0x67, 0x24, 0x48, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +720,11 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AttributeValues queries the distinct values stored under an attribute name across all accounts and the number of
+	// accounts each value is stored on
+	AttributeValues(ctx context.Context, in *QueryAttributeValuesRequest, opts ...grpc.CallOption) (*QueryAttributeValuesResponse, error)
+	// Normalize returns an attribute name in the normalized format it is stored under
+	Normalize(ctx context.Context, in *QueryNormalizeRequest, opts ...grpc.CallOption) (*QueryNormalizeResponse, error)
 }
 
 type queryClient struct {
@@ -556,6 +771,24 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) AttributeValues(ctx context.Context, in *QueryAttributeValuesRequest, opts ...grpc.CallOption) (*QueryAttributeValuesResponse, error) {
+	out := new(QueryAttributeValuesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Normalize(ctx context.Context, in *QueryNormalizeRequest, opts ...grpc.CallOption) (*QueryNormalizeResponse, error) {
+	out := new(QueryNormalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/Normalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -566,6 +799,11 @@ type QueryServer interface {
 	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AttributeValues queries the distinct values stored under an attribute name across all accounts and the number of
+	// accounts each value is stored on
+	AttributeValues(context.Context, *QueryAttributeValuesRequest) (*QueryAttributeValuesResponse, error)
+	// Normalize returns an attribute name in the normalized format it is stored under
+	Normalize(context.Context, *QueryNormalizeRequest) (*QueryNormalizeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -584,6 +822,12 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) AttributeValues(ctx context.Context, req *QueryAttributeValuesRequest) (*QueryAttributeValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeValues not implemented")
+}
+func (*UnimplementedQueryServer) Normalize(ctx context.Context, req *QueryNormalizeRequest) (*QueryNormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -661,6 +905,42 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeValues(ctx, req.(*QueryAttributeValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNormalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Normalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/Normalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Normalize(ctx, req.(*QueryNormalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -681,6 +961,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Query_Scan_Handler,
 		},
+		{
+			MethodName: "AttributeValues",
+			Handler:    _Query_AttributeValues_Handler,
+		},
+		{
+			MethodName: "Normalize",
+			Handler:    _Query_Normalize_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1050,76 +1338,234 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttributeValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAttributeValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttributeValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttributeValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNormalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNormalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNormalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNormalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNormalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNormalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -1209,6 +1655,72 @@ func (m *QueryScanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttributeValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNormalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNormalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1296,7 +1808,424 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2252,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +2280,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +2303,10 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1411,9 +2343,9 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1423,23 +2355,25 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1471,7 +2405,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1498,7 +2432,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,10 +2455,10 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1561,9 +2495,9 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1573,25 +2507,23 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1623,7 +2555,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1650,7 +2582,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,10 +2605,10 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1712,6 +2644,40 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1741,7 +2707,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1768,7 +2734,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +2757,15 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,43 +2793,9 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1893,7 +2825,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1920,7 +2852,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,15 +2875,15 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1979,13 +2911,13 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1995,23 +2927,25 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.Values = append(m.Values, AttributeValueCount{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2043,7 +2977,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2070,7 +3004,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNormalizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,15 +3027,15 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNormalizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNormalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2129,47 +3063,63 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNormalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNormalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNormalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2179,27 +3129,23 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -362,6 +362,248 @@ func (m *MsgDeleteDistinctAttributeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteDistinctAttributeResponse proto.InternalMessageInfo
 
+// MsgSetAttributesBatchRequest defines a message to set or delete attributes on many accounts in a single transaction.
+// Each entry is applied on its own, a failed entry is reported in the response and does not prevent the others.
+type MsgSetAttributesBatchRequest struct {
+	// The address that the attribute names must resolve to.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The attributes to set or delete, at most 100 entries.
+	Entries []AttributeBatchEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MsgSetAttributesBatchRequest) Reset()      { *m = MsgSetAttributesBatchRequest{} }
+func (*MsgSetAttributesBatchRequest) ProtoMessage() {}
+func (*MsgSetAttributesBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{8}
+}
+func (m *MsgSetAttributesBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributesBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributesBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributesBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributesBatchRequest.Merge(m, src)
+}
+func (m *MsgSetAttributesBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributesBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributesBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributesBatchRequest proto.InternalMessageInfo
+
+// AttributeBatchEntry is a single attribute change in a MsgSetAttributesBatchRequest.
+type AttributeBatchEntry struct {
+	// The account to set the attribute on.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The attribute name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The attribute value, replacing any existing values of the name on the account.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The attribute value type.
+	AttributeType AttributeType `protobuf:"varint,4,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty"`
+	// When true all attributes with the name are removed from the account, value and attribute_type are ignored.
+	Delete bool `protobuf:"varint,5,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *AttributeBatchEntry) Reset()         { *m = AttributeBatchEntry{} }
+func (m *AttributeBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*AttributeBatchEntry) ProtoMessage()    {}
+func (*AttributeBatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{9}
+}
+func (m *AttributeBatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeBatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeBatchEntry.Merge(m, src)
+}
+func (m *AttributeBatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeBatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeBatchEntry proto.InternalMessageInfo
+
+func (m *AttributeBatchEntry) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AttributeBatchEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeBatchEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AttributeBatchEntry) GetAttributeType() AttributeType {
+	if m != nil {
+		return m.AttributeType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *AttributeBatchEntry) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+// MsgSetAttributesBatchResponse defines the Msg/SetAttributesBatch response type.
+type MsgSetAttributesBatchResponse struct {
+	// The result of each entry, in request order.
+	Results []AttributeBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgSetAttributesBatchResponse) Reset()         { *m = MsgSetAttributesBatchResponse{} }
+func (m *MsgSetAttributesBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributesBatchResponse) ProtoMessage()    {}
+func (*MsgSetAttributesBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{10}
+}
+func (m *MsgSetAttributesBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributesBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributesBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributesBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributesBatchResponse.Merge(m, src)
+}
+func (m *MsgSetAttributesBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributesBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributesBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributesBatchResponse proto.InternalMessageInfo
+
+func (m *MsgSetAttributesBatchResponse) GetResults() []AttributeBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// AttributeBatchResult is the outcome of a single AttributeBatchEntry.
+type AttributeBatchResult struct {
+	// The account of the entry.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The attribute name of the entry.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the entry was applied.
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// The reason the entry was not applied.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AttributeBatchResult) Reset()         { *m = AttributeBatchResult{} }
+func (m *AttributeBatchResult) String() string { return proto.CompactTextString(m) }
+func (*AttributeBatchResult) ProtoMessage()    {}
+func (*AttributeBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{11}
+}
+func (m *AttributeBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeBatchResult.Merge(m, src)
+}
+func (m *AttributeBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeBatchResult proto.InternalMessageInfo
+
+func (m *AttributeBatchResult) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AttributeBatchResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeBatchResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *AttributeBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
 	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
@@ -371,47 +613,62 @@ func init() {
 	proto.RegisterType((*MsgDeleteAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeResponse")
 	proto.RegisterType((*MsgDeleteDistinctAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeRequest")
 	proto.RegisterType((*MsgDeleteDistinctAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeResponse")
+	proto.RegisterType((*MsgSetAttributesBatchRequest)(nil), "provenance.attribute.v1.MsgSetAttributesBatchRequest")
+	proto.RegisterType((*AttributeBatchEntry)(nil), "provenance.attribute.v1.AttributeBatchEntry")
+	proto.RegisterType((*MsgSetAttributesBatchResponse)(nil), "provenance.attribute.v1.MsgSetAttributesBatchResponse")
+	proto.RegisterType((*AttributeBatchResult)(nil), "provenance.attribute.v1.AttributeBatchResult")
 }
 
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x4e, 0x2b, 0x3e, 0xd2, 0x80, 0x8e, 0x96, 0xb8, 0x16, 0x72, 0xdc, 0x88, 0x1f,
-	0x59, 0xb0, 0x69, 0x2a, 0x96, 0x32, 0x15, 0x75, 0x8d, 0x84, 0x22, 0x60, 0xe8, 0x40, 0xe5, 0x38,
-	0x27, 0x63, 0x29, 0xf1, 0x39, 0xf6, 0x39, 0xb4, 0x4c, 0x2c, 0x48, 0x6c, 0x20, 0x26, 0xc6, 0xfc,
-	0x39, 0x8c, 0x95, 0x58, 0x18, 0x18, 0x50, 0xb2, 0xf0, 0x1f, 0xb0, 0xa2, 0x9c, 0x13, 0xc7, 0x75,
-	0x73, 0x26, 0x81, 0xcd, 0xdf, 0xf9, 0xdd, 0x7b, 0xef, 0xbe, 0x77, 0x9f, 0x0d, 0xba, 0x1f, 0xd0,
-	0x21, 0xf1, 0x2c, 0xcf, 0x26, 0xa6, 0xc5, 0x58, 0xe0, 0x76, 0x22, 0x46, 0xcc, 0xe1, 0xbe, 0xc9,
-	0xce, 0x0c, 0x3f, 0xa0, 0x8c, 0xe2, 0xea, 0x02, 0x61, 0x24, 0x08, 0x63, 0xb8, 0xaf, 0x6e, 0x3b,
-	0xd4, 0xa1, 0x1c, 0x63, 0x4e, 0x9f, 0x62, 0xb8, 0xfa, 0x40, 0x44, 0xb8, 0xd8, 0xcb, 0x81, 0xf5,
-	0x6f, 0x08, 0x6e, 0xb7, 0x42, 0xe7, 0xa8, 0xdb, 0x3d, 0x9a, 0xbf, 0x69, 0x93, 0x41, 0x44, 0x42,
-	0x86, 0x31, 0xc8, 0x9e, 0xd5, 0x27, 0x0a, 0xd2, 0x51, 0xe3, 0x5a, 0x9b, 0x3f, 0xe3, 0x6d, 0x28,
-	0x0d, 0xad, 0x5e, 0x44, 0x94, 0x82, 0x8e, 0x1a, 0xe5, 0x76, 0x5c, 0xe0, 0x16, 0x54, 0x12, 0xde,
-	0x53, 0x76, 0xee, 0x13, 0xa5, 0xa8, 0xa3, 0x46, 0xa5, 0x79, 0xdf, 0x10, 0xb8, 0x36, 0x12, 0xb1,
-	0xe7, 0xe7, 0x3e, 0x69, 0x6f, 0x59, 0xe9, 0x12, 0x2b, 0xb0, 0x69, 0xd9, 0x36, 0x8d, 0x3c, 0xa6,
-	0xc8, 0x5c, 0x7b, 0x5e, 0x4e, 0xe5, 0xe9, 0x1b, 0x8f, 0x04, 0x4a, 0x89, 0xaf, 0xc7, 0xc5, 0xe1,
-	0xcd, 0x0f, 0xa3, 0x9a, 0xf4, 0x65, 0x54, 0x93, 0x7e, 0x8d, 0x6a, 0xd2, 0xbb, 0x1f, 0xba, 0x54,
-	0xdf, 0x85, 0xea, 0x95, 0x43, 0x85, 0x3e, 0xf5, 0x42, 0x52, 0xff, 0x5d, 0x80, 0xdd, 0x56, 0xe8,
-	0xbc, 0xf0, 0xbb, 0x16, 0x23, 0x2b, 0x9d, 0xf9, 0x1e, 0x54, 0x68, 0xe0, 0x3a, 0xae, 0x67, 0xf5,
-	0x4e, 0xd3, 0x87, 0xdf, 0x9a, 0xaf, 0xbe, 0xe4, 0x4d, 0xd8, 0x83, 0x72, 0xc4, 0x49, 0x67, 0xa0,
-	0x22, 0x07, 0x5d, 0x8f, 0xd7, 0x62, 0xc8, 0x2b, 0xa8, 0x26, 0x4c, 0x99, 0x86, 0xc9, 0x6b, 0x35,
-	0x6c, 0x67, 0x4e, 0x73, 0x69, 0x19, 0x9f, 0xc0, 0xce, 0xcc, 0x42, 0x86, 0xbd, 0xb4, 0x16, 0xfb,
-	0xad, 0xe8, 0x72, 0x73, 0xb2, 0xa1, 0x6c, 0x08, 0x42, 0xd9, 0xcc, 0x0f, 0xe5, 0x0e, 0xa8, 0xcb,
-	0x1a, 0x3f, 0xcb, 0x65, 0xc0, 0x63, 0x39, 0x26, 0x3d, 0xb2, 0x62, 0x2c, 0x29, 0x43, 0x05, 0x81,
-	0xa1, 0xe2, 0x2a, 0x86, 0xae, 0x48, 0xce, 0x0c, 0x7d, 0x44, 0xb0, 0x97, 0xbc, 0x3e, 0x76, 0x43,
-	0xe6, 0x7a, 0x36, 0xfb, 0x8f, 0x21, 0x49, 0xf9, 0x2d, 0x0a, 0xfc, 0xca, 0xf9, 0x7e, 0xef, 0x42,
-	0x3d, 0xcf, 0x50, 0xec, 0xbb, 0xf9, 0x5e, 0x86, 0x62, 0x2b, 0x74, 0xf0, 0x00, 0xca, 0xe9, 0x01,
-	0xc0, 0xa6, 0x30, 0xfd, 0xe5, 0xf3, 0xaf, 0x3e, 0x5a, 0x7d, 0x43, 0x2c, 0x8d, 0xdf, 0xc2, 0x8d,
-	0x4c, 0xbc, 0xb8, 0x99, 0x47, 0xb2, 0x7c, 0x08, 0xd5, 0x83, 0xb5, 0xf6, 0x2c, 0xb4, 0x33, 0x49,
-	0xe6, 0x6b, 0x2f, 0xbf, 0x69, 0xf9, 0xda, 0x82, 0xab, 0x82, 0x3f, 0x23, 0xa8, 0x0a, 0x62, 0xc1,
-	0x87, 0x7f, 0x27, 0x14, 0x5d, 0x2e, 0xf5, 0xc9, 0x3f, 0xed, 0x8d, 0x4d, 0x3d, 0xed, 0x7f, 0x1d,
-	0x6b, 0xe8, 0x62, 0xac, 0xa1, 0x9f, 0x63, 0x0d, 0x7d, 0x9a, 0x68, 0xd2, 0xc5, 0x44, 0x93, 0xbe,
-	0x4f, 0x34, 0x09, 0x54, 0x97, 0x8a, 0x88, 0x9f, 0xa1, 0x93, 0xc7, 0x8e, 0xcb, 0x5e, 0x47, 0x1d,
-	0xc3, 0xa6, 0x7d, 0x73, 0x81, 0x7a, 0xe8, 0xd2, 0x54, 0x65, 0x9e, 0xa5, 0xfe, 0x2a, 0xd3, 0x2f,
-	0x4c, 0xd8, 0xd9, 0xe0, 0xff, 0x93, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0xf7, 0xbe,
-	0xa7, 0xcb, 0x06, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3b, 0x6f, 0x13, 0x4d,
+	0x14, 0xdd, 0xf1, 0x23, 0xce, 0x77, 0xf3, 0xf8, 0xd0, 0xe4, 0xe1, 0xcd, 0x2a, 0xd8, 0x8e, 0xc5,
+	0xc3, 0x05, 0xf1, 0x12, 0x47, 0xa1, 0x08, 0x55, 0xa2, 0xd0, 0x61, 0x09, 0x2d, 0x8f, 0x22, 0x05,
+	0xd1, 0x66, 0x3d, 0xda, 0xac, 0xe4, 0xec, 0x38, 0x3b, 0xb3, 0x26, 0xa1, 0x42, 0xa2, 0x41, 0xa2,
+	0x00, 0x21, 0x0a, 0xca, 0xfc, 0x14, 0xca, 0x94, 0x91, 0x68, 0x28, 0x10, 0x42, 0x49, 0xc3, 0x3f,
+	0xa0, 0x45, 0x9e, 0x7d, 0x78, 0xe3, 0xec, 0x6e, 0x6c, 0xd2, 0xf9, 0xce, 0xdc, 0x7b, 0xee, 0x99,
+	0x73, 0x67, 0x8e, 0x17, 0x2a, 0x1d, 0x87, 0x76, 0x89, 0xad, 0xdb, 0x06, 0x51, 0x75, 0xce, 0x1d,
+	0x6b, 0xd7, 0xe5, 0x44, 0xed, 0xae, 0xa8, 0xfc, 0xb0, 0xde, 0x71, 0x28, 0xa7, 0xb8, 0xd8, 0xcf,
+	0xa8, 0x87, 0x19, 0xf5, 0xee, 0x8a, 0x32, 0x6b, 0x52, 0x93, 0x8a, 0x1c, 0xb5, 0xf7, 0xcb, 0x4b,
+	0x57, 0xee, 0x26, 0x01, 0xf6, 0x6b, 0x45, 0x62, 0xf5, 0x1b, 0x82, 0xf9, 0x26, 0x33, 0x37, 0x5a,
+	0xad, 0x8d, 0x60, 0x47, 0x23, 0x07, 0x2e, 0x61, 0x1c, 0x63, 0xc8, 0xd9, 0xfa, 0x3e, 0x91, 0x51,
+	0x05, 0xd5, 0xfe, 0xd3, 0xc4, 0x6f, 0x3c, 0x0b, 0xf9, 0xae, 0xde, 0x76, 0x89, 0x9c, 0xa9, 0xa0,
+	0xda, 0xa4, 0xe6, 0x05, 0xb8, 0x09, 0xd3, 0x21, 0xee, 0x0e, 0x3f, 0xea, 0x10, 0x39, 0x5b, 0x41,
+	0xb5, 0xe9, 0xc6, 0x9d, 0x7a, 0x02, 0xeb, 0x7a, 0xd8, 0xec, 0xd9, 0x51, 0x87, 0x68, 0x53, 0x7a,
+	0x34, 0xc4, 0x32, 0x14, 0x74, 0xc3, 0xa0, 0xae, 0xcd, 0xe5, 0x9c, 0xe8, 0x1d, 0x84, 0xbd, 0xf6,
+	0xf4, 0x95, 0x4d, 0x1c, 0x39, 0x2f, 0xd6, 0xbd, 0x60, 0xfd, 0xc6, 0xbb, 0xe3, 0xb2, 0xf4, 0xe5,
+	0xb8, 0x2c, 0xfd, 0x3e, 0x2e, 0x4b, 0x6f, 0x7e, 0x54, 0xa4, 0xea, 0x02, 0x14, 0x2f, 0x1d, 0x8a,
+	0x75, 0xa8, 0xcd, 0x48, 0xf5, 0x4f, 0x06, 0x16, 0x9a, 0xcc, 0x7c, 0xde, 0x69, 0xe9, 0x9c, 0x0c,
+	0x75, 0xe6, 0xdb, 0x30, 0x4d, 0x1d, 0xcb, 0xb4, 0x6c, 0xbd, 0xbd, 0x13, 0x3d, 0xfc, 0x54, 0xb0,
+	0xfa, 0x42, 0x88, 0xb0, 0x04, 0x93, 0xae, 0x00, 0xf5, 0x93, 0xb2, 0x22, 0x69, 0xc2, 0x5b, 0xf3,
+	0x52, 0x5e, 0x42, 0x31, 0x44, 0x1a, 0x10, 0x2c, 0x37, 0x92, 0x60, 0x73, 0x01, 0xcc, 0x85, 0x65,
+	0xbc, 0x0d, 0x73, 0x3e, 0x85, 0x01, 0xf4, 0xfc, 0x48, 0xe8, 0x33, 0xee, 0x45, 0x71, 0x06, 0x87,
+	0x32, 0x96, 0x30, 0x94, 0x42, 0xfa, 0x50, 0x16, 0x41, 0x89, 0x13, 0xde, 0x9f, 0xcb, 0x81, 0x18,
+	0xcb, 0x16, 0x69, 0x93, 0x21, 0xc7, 0x12, 0x21, 0x94, 0x49, 0x20, 0x94, 0x1d, 0x86, 0xd0, 0xa5,
+	0x96, 0x3e, 0xa1, 0x0f, 0x08, 0x96, 0xc2, 0xed, 0x2d, 0x8b, 0x71, 0xcb, 0x36, 0xf8, 0x35, 0x1e,
+	0x49, 0x84, 0x6f, 0x36, 0x81, 0x6f, 0x2e, 0x9d, 0xef, 0x2d, 0xa8, 0xa6, 0x11, 0xf2, 0x79, 0x7f,
+	0x46, 0xb0, 0xd8, 0x64, 0xe6, 0x53, 0xd2, 0xdf, 0x63, 0x9b, 0x3a, 0x37, 0xf6, 0x02, 0xca, 0x61,
+	0x3b, 0x14, 0x69, 0x87, 0x1f, 0x43, 0x81, 0xd8, 0xdc, 0xb1, 0x08, 0x93, 0x33, 0x95, 0x6c, 0x6d,
+	0xa2, 0x71, 0xef, 0xea, 0xdb, 0x22, 0x60, 0x1f, 0xd9, 0xdc, 0x39, 0xda, 0xcc, 0x9d, 0xfc, 0x2c,
+	0x4b, 0x5a, 0x00, 0x11, 0x43, 0xfe, 0x2b, 0x82, 0x99, 0x98, 0xc2, 0xa8, 0x2c, 0xe8, 0xa2, 0x2c,
+	0x81, 0xb4, 0x99, 0x38, 0x69, 0xb3, 0xe9, 0xfe, 0x93, 0xbb, 0x8e, 0xff, 0xcc, 0xc3, 0x58, 0x4b,
+	0x88, 0x2c, 0xde, 0xcd, 0xb8, 0xe6, 0x47, 0x55, 0x1b, 0x6e, 0x26, 0x08, 0xeb, 0x49, 0x8f, 0x9b,
+	0x50, 0x70, 0x08, 0x73, 0xdb, 0x9c, 0xc9, 0x48, 0x68, 0xb8, 0x3c, 0xa4, 0x86, 0x9a, 0xa8, 0x0a,
+	0x44, 0xf4, 0x31, 0xaa, 0x1c, 0x66, 0xe3, 0xd2, 0x46, 0x94, 0x4c, 0x86, 0x02, 0x73, 0x0d, 0x83,
+	0x30, 0x26, 0x44, 0x1b, 0xd7, 0x82, 0xb0, 0x27, 0x26, 0x71, 0x1c, 0x1a, 0xde, 0x3b, 0x11, 0x34,
+	0xde, 0xe7, 0x21, 0xdb, 0x64, 0x26, 0x3e, 0x80, 0xc9, 0xa8, 0x81, 0x62, 0x35, 0xf1, 0x2c, 0xf1,
+	0xff, 0x1f, 0xca, 0xfd, 0xe1, 0x0b, 0x7c, 0xfd, 0x5e, 0xc3, 0xff, 0x03, 0xf6, 0x80, 0x1b, 0x69,
+	0x20, 0xf1, 0x26, 0xae, 0xac, 0x8e, 0x54, 0xd3, 0xef, 0x3d, 0xe0, 0x04, 0xe9, 0xbd, 0xe3, 0x9d,
+	0x2a, 0xbd, 0x77, 0x82, 0xd5, 0xe0, 0x4f, 0x08, 0x8a, 0x09, 0xcf, 0x1a, 0xaf, 0x5f, 0x0d, 0x98,
+	0x64, 0x4e, 0xca, 0xc3, 0x7f, 0xaa, 0xf5, 0x49, 0xbd, 0x45, 0x80, 0x2f, 0xdf, 0x75, 0xbc, 0x96,
+	0x86, 0x99, 0x68, 0x3a, 0xca, 0x83, 0x51, 0xcb, 0x3c, 0x16, 0x9b, 0xfb, 0x27, 0x67, 0x25, 0x74,
+	0x7a, 0x56, 0x42, 0xbf, 0xce, 0x4a, 0xe8, 0xe3, 0x79, 0x49, 0x3a, 0x3d, 0x2f, 0x49, 0xdf, 0xcf,
+	0x4b, 0x12, 0x28, 0x16, 0x4d, 0xc2, 0x7c, 0x82, 0xb6, 0xd7, 0x4c, 0x8b, 0xef, 0xb9, 0xbb, 0x75,
+	0x83, 0xee, 0xab, 0xfd, 0xac, 0x65, 0x8b, 0x46, 0x22, 0xf5, 0x30, 0xf2, 0x6d, 0xd4, 0xb3, 0x0d,
+	0xb6, 0x3b, 0x26, 0xbe, 0x8a, 0x56, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x03, 0x16, 0x5a, 0x5c,
+	0x91, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -434,6 +691,8 @@ type MsgClient interface {
 	DeleteAttribute(ctx context.Context, in *MsgDeleteAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDistinctAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteDistinctAttributeResponse, error)
+	// SetAttributesBatch defines a method for a name owner to set or delete attributes on many accounts at once.
+	SetAttributesBatch(ctx context.Context, in *MsgSetAttributesBatchRequest, opts ...grpc.CallOption) (*MsgSetAttributesBatchResponse, error)
 }
 
 type msgClient struct {
@@ -480,6 +739,15 @@ func (c *msgClient) DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDi
 	return out, nil
 }
 
+func (c *msgClient) SetAttributesBatch(ctx context.Context, in *MsgSetAttributesBatchRequest, opts ...grpc.CallOption) (*MsgSetAttributesBatchResponse, error) {
+	out := new(MsgSetAttributesBatchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -490,6 +758,8 @@ type MsgServer interface {
 	DeleteAttribute(context.Context, *MsgDeleteAttributeRequest) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(context.Context, *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error)
+	// SetAttributesBatch defines a method for a name owner to set or delete attributes on many accounts at once.
+	SetAttributesBatch(context.Context, *MsgSetAttributesBatchRequest) (*MsgSetAttributesBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -508,6 +778,9 @@ func (*UnimplementedMsgServer) DeleteAttribute(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) DeleteDistinctAttribute(ctx context.Context, req *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDistinctAttribute not implemented")
 }
+func (*UnimplementedMsgServer) SetAttributesBatch(ctx context.Context, req *MsgSetAttributesBatchRequest) (*MsgSetAttributesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributesBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -585,6 +858,24 @@ func _Msg_DeleteDistinctAttribute_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAttributesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributesBatch(ctx, req.(*MsgSetAttributesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -605,6 +896,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDistinctAttribute",
 			Handler:    _Msg_DeleteDistinctAttribute_Handler,
 		},
+		{
+			MethodName: "SetAttributesBatch",
+			Handler:    _Msg_SetAttributesBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -921,79 +1216,273 @@ func (m *MsgDeleteDistinctAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAttributesBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAttributesBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributesBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovTx(uint64(m.AttributeType))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttributeBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AttributeBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AttributeType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.UpdateValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OriginalAttributeType != 0 {
-		n += 1 + sovTx(uint64(m.OriginalAttributeType))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdateAttributeType != 0 {
-		n += 1 + sovTx(uint64(m.UpdateAttributeType))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Account)
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttributesBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributesBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributesBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OriginalAttributeType != 0 {
+		n += 1 + sovTx(uint64(m.OriginalAttributeType))
+	}
+	if m.UpdateAttributeType != 0 {
+		n += 1 + sovTx(uint64(m.UpdateAttributeType))
+	}
+	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1077,6 +1566,91 @@ func (m *MsgDeleteDistinctAttributeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAttributesBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttributeBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	if m.Delete {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAttributesBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttributeBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2060,6 +2634,559 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAttributesBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttributesBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttributesBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AttributeBatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeBatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeBatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAttributesBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttributesBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttributesBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AttributeBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
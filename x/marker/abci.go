@@ -30,7 +30,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 				ctx.Logger().Error(
 					fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
 						record.GetDenom(), currentSupply))
-				err = k.AdjustCirculation(ctx, record, requiredSupply)
+				err = k.AdjustCirculation(ctx, record, requiredSupply, nil)
 			}
 			// else supply is equal, nothing to do here.
 		}
@@ -52,4 +52,21 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 	if err != nil {
 		panic(err)
 	}
+	// Make the emissions of the emission schedules due at this height.
+	k.ProcessEmissionSchedules(ctx)
+	// Release the scheduled transfers that have reached their release height and time.
+	k.ReleaseScheduledTransfers(ctx)
+	// Remove the pending withdrawals that were not approved before they expired.
+	k.ExpirePendingWithdrawals(ctx)
+	// Remove the supply changes that are no longer within the supply history window.
+	k.PruneSupplyHistory(ctx)
+	// Remove the access changes that are no longer within the access log window.
+	k.PruneAccessLog(ctx)
+}
+
+// EndBlocker returns the end blocker for the marker module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Pay the holders of escrow distributions, a limited number per block.
+	k.PayEscrowDistributions(ctx)
 }
@@ -51,7 +51,7 @@ func TestBeginBlocker(t *testing.T) {
 
 	// Cancel marker and zero out supply
 	testmint.Status = types.StatusDestroyed
-	require.NoError(t, app.MarkerKeeper.AdjustCirculation(ctx, testmint, sdk.NewCoin(testmint.Denom, sdk.ZeroInt())))
+	require.NoError(t, app.MarkerKeeper.AdjustCirculation(ctx, testmint, sdk.NewCoin(testmint.Denom, sdk.ZeroInt()), nil))
 	app.MarkerKeeper.SetMarker(ctx, testmint)
 
 	// Marker should still exist.
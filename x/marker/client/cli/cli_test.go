@@ -104,6 +104,17 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	var bankGenState banktypes.GenesisState
 	bankGenState.Params = banktypes.DefaultParams()
 	bankGenState.Balances = genBalances
+	bankGenState.DenomMetadata = []banktypes.Metadata{
+		{
+			Description: "the testcoin marker coin",
+			Base:        "testcoin",
+			Display:     "ktestcoin",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "testcoin", Exponent: 0},
+				{Denom: "ktestcoin", Exponent: 3},
+			},
+		},
+	}
 	bankDataBz, err := cfg.Codec.MarshalJSON(&bankGenState)
 	s.Require().NoError(err)
 	genesisState[banktypes.ModuleName] = bankDataBz
@@ -114,6 +125,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	var markerData markertypes.GenesisState
 	markerData.Params.EnableGovernance = true
 	markerData.Params.MaxTotalSupply = 1000000
+	markerData.Params.DistributionHoldersPerBlock = markertypes.DefaultDistributionHoldersPerBlock
+	markerData.Params.AccessRoles = []markertypes.AccessRole{
+		markertypes.NewAccessRole("issuer", markertypes.AccessListByNames("mint,burn,withdraw,deposit")),
+	}
 	markerData.Markers = []markertypes.MarkerAccount{
 		{
 			BaseAccount: &authtypes.BaseAccount{
@@ -353,7 +368,24 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":""}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,64}","expedited_voting_period":"0s","expedited_quorum":"0.000000000000000000","access_roles":[{"name":"issuer","permissions":["ACCESS_MINT","ACCESS_BURN","ACCESS_WITHDRAW","ACCESS_DEPOSIT"]}],"max_distribution_holders":0,"distribution_holders_per_block":100,"max_emission_per_block":"0","supply_history_window":"0","enable_faucet":false,"access_log_window":"0","default_display_exponent":0}`,
+		},
+		{
+			"get marker params canonical json",
+			markercli.QueryParamsCmd(),
+			[]string{
+				fmt.Sprintf("--%s=%s", markercli.FlagOutputFormat, markercli.OutputFormatCanonicalJSON),
+			},
+			`{"access_log_window":"0","access_roles":[{"name":"issuer","permissions":["ACCESS_MINT","ACCESS_BURN","ACCESS_WITHDRAW","ACCESS_DEPOSIT"]}],"default_display_exponent":0,"distribution_holders_per_block":100,"enable_faucet":false,"enable_governance":true,"expedited_quorum":"0.000000000000000000","expedited_voting_period":"0s","max_distribution_holders":0,"max_emission_per_block":"0","max_total_supply":"1000000","supply_history_window":"0","unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,64}"}`,
+		},
+		{
+			"get testcoin marker canonical json",
+			markercli.MarkerCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagOutputFormat, markercli.OutputFormatCanonicalJSON),
+			},
+			`{"denom_trace":null,"marker":{"@type":"/provenance.marker.v1.MarkerAccount","access_control":[],"allow_governance_control":false,"base_account":{"account_number":"11","address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"sequence":"0"},"denom":"testcoin","display_exponent":0,"manager":"","marker_type":"MARKER_TYPE_COIN","paused":false,"restrict_deposits":false,"status":"MARKER_STATUS_ACTIVE","supply":"1000","supply_fixed":true,"transfer_memo_pattern":"","withdraw_approval_policy":null}}`,
 		},
 		{
 			"get testcoin marker json",
@@ -362,7 +394,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"paused":false,"restrict_deposits":false,"transfer_memo_pattern":"","withdraw_approval_policy":null,"display_exponent":0},"denom_trace":null}`,
 		},
 		{
 			"get testcoin marker test",
@@ -371,7 +403,8 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=text", tmcli.OutputFlag),
 			},
-			`marker:
+			`denom_trace: null
+marker:
   '@type': /provenance.marker.v1.MarkerAccount
   access_control: []
   allow_governance_control: false
@@ -381,11 +414,16 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
     pub_key: null
     sequence: "0"
   denom: testcoin
+  display_exponent: 0
   manager: ""
   marker_type: MARKER_TYPE_COIN
+  paused: false
+  restrict_deposits: false
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
-  supply_fixed: true`,
+  supply_fixed: true
+  transfer_memo_pattern: ""
+  withdraw_approval_policy: null`,
 		},
 		{
 			"query non existent marker",
@@ -402,7 +440,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"paused":false,"restrict_deposits":false,"transfer_memo_pattern":"","withdraw_approval_policy":null,"display_exponent":0},"denom_trace":null}`,
 		},
 		{
 			"query access",
@@ -428,6 +466,61 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			fmt.Sprintf("amount:\n  amount: \"%s\"\n  denom: %s", s.cfg.BondedTokens.Mul(sdk.NewInt(int64(s.cfg.NumValidators))), s.cfg.BondDenom),
 		},
+		{
+			"query supply display",
+			markercli.MarkerSupplyCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s", markercli.FlagDisplay),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"amount":{"amount":"1","denom":"ktestcoin"}}`,
+		},
+		{
+			"query display amount",
+			markercli.MarkerDisplayAmountCmd(),
+			[]string{
+				"1500testcoin,5lockedcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"amounts":[{"base":{"denom":"lockedcoin","amount":"5"},"display_amount":"5","display_denom":"lockedcoin","exponent":0},{"base":{"denom":"testcoin","amount":"1500"},"display_amount":"1.5","display_denom":"ktestcoin","exponent":3}]}`,
+		},
+		{
+			"query scheduled transfers",
+			markercli.MarkerScheduledTransfersCmd(),
+			[]string{
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"transfers":[]}`,
+		},
+		{
+			"query supply history",
+			markercli.MarkerSupplyHistoryCmd(),
+			[]string{
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"changes":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query access log",
+			markercli.MarkerAccessLogCmd(),
+			[]string{
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"changes":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query permissions of an address without access",
+			markercli.MarkerAccountPermissionsCmd(),
+			[]string{
+				sdk.AccAddress("no_marker_access____").String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"permissions":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -539,6 +632,34 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"add access role",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				"hotdog",
+				"@issuer",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"add undefined access role",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				"hotdog",
+				"@registrar",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
 		{
 			"mint supply",
 			markercli.GetCmdMint(),
@@ -563,6 +684,33 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"update flags, incorrect supply fixed value",
+			markercli.GetCmdUpdateFlags(),
+			[]string{
+				"cat-scratch-fever.bobcat",
+				fmt.Sprintf("--%s=%s", markercli.FlagSupplyFixed, "wrong"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"update flags",
+			markercli.GetCmdUpdateFlags(),
+			[]string{
+				"cat-scratch-fever.bobcat",
+				fmt.Sprintf("--%s=%s", markercli.FlagSupplyFixed, "false"),
+				fmt.Sprintf("--%s=%s", markercli.FlagAllowGovernanceControl, "true"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 		{
 			"finalize",
 			markercli.GetCmdFinalize(),
@@ -614,6 +762,111 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			true, &sdk.TxResponse{}, 0,
 		},
+		{
+			"distribute, fail to parse coins",
+			markercli.GetCmdDistributeEscrow(),
+			[]string{
+				"hotdog",
+				"incorrect-denom-blah",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"add emission, fail to parse interval",
+			markercli.GetCmdAddEmissionSchedule(),
+			[]string{
+				"100hotdog",
+				"often",
+				"10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set faucet, fail to parse drip amount",
+			markercli.GetCmdSetFaucet(),
+			[]string{
+				"hotdog",
+				"lots",
+				"24h",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set faucet, fail without period",
+			markercli.GetCmdSetFaucet(),
+			[]string{
+				"hotdog",
+				"100",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"cancel emission, fail to parse schedule id",
+			markercli.GetCmdCancelEmissionSchedule(),
+			[]string{
+				"first",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"schedule transfer, fail to parse release time",
+			markercli.GetCmdScheduleTransfer(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				s.testnet.Validators[0].Address.String(),
+				"100hotdog",
+				fmt.Sprintf("--%s=%s", markercli.FlagReleaseTime, "tomorrow"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"cancel scheduled transfer, fail to parse transfer id",
+			markercli.GetCmdCancelScheduledTransfer(),
+			[]string{
+				"first",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"claim scheduled transfer, unknown transfer",
+			markercli.GetCmdClaimScheduledTransfer(),
+			[]string{
+				"99",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
 		{
 			"withdraw, successful withdraw to a recipient",
 			markercli.GetCmdWithdrawCoins(),
@@ -745,6 +998,67 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMarkerTxInteractive() {
+	addr := s.testnet.Validators[0].Address.String()
+	testCases := []struct {
+		name         string
+		input        string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"create a marker with access grants",
+			fmt.Sprintf("wizardcoin\n1000\nRESTRICTED\ny\nn\n%s\nmint,burn\n\n", addr),
+			[]string{},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"coin argument provides defaults",
+			"\n\n\nn\nn\n\n",
+			[]string{"500wizarddefault"},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid denom is prompted for again until input ends",
+			"1\n",
+			[]string{},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid access grant address is skipped",
+			"wizardskip\n1000\nCOIN\nn\nn\nnotanaddress\n\n",
+			[]string{},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx.WithInput(strings.NewReader(tc.input))
+			args := append(tc.args,
+				fmt.Sprintf("--%s", markercli.FlagInteractive),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.GetCmdAddMarker(), args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 	testCases := []struct {
 		name         string
@@ -808,7 +1122,7 @@ func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, &sdk.TxResponse{}, 5,
+			false, &sdk.TxResponse{}, 11,
 		},
 		{
 			"marker transfer failed, account 1 not granted rights by account 2",
@@ -822,7 +1136,7 @@ func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, &sdk.TxResponse{}, 1,
+			false, &sdk.TxResponse{}, 10,
 		},
 		{
 			"grantee successful transfer, removed from auth for reaching transfer limit",
@@ -863,7 +1177,7 @@ func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, &sdk.TxResponse{}, 1,
+			false, &sdk.TxResponse{}, 7,
 		},
 	}
 
@@ -1003,7 +1317,7 @@ func (s *IntegrationTestSuite) TestMarkerGetTxCmd() {
 	s.Run("marker cli tx commands not nil", func() {
 		tx := markercli.NewTxCmd()
 		s.Require().NotNil(tx)
-		s.Require().Equal(len(tx.Commands()), 14)
+		s.Require().Equal(len(tx.Commands()), 33)
 		s.Require().Equal(tx.Use, markertypes.ModuleName)
 		s.Require().Equal(tx.Short, "Transaction commands for the marker module")
 	})
@@ -1076,5 +1390,50 @@ func (s *IntegrationTestSuite) TestPaginationWithPageKey() {
 		}
 	})
 
-	// TODO: Add pagination test of AllHoldersCmd [denom] once marker/keeper/query_server.go#Holding is fixed: https://github.com/provenance-io/provenance/issues/400
+	s.T().Run("AllHoldersCmd", func(t *testing.T) {
+		clientCtx := s.testnet.Validators[0].ClientCtx
+		cout, cerr := clitestutil.ExecTestCLICmd(clientCtx, markercli.AllHoldersCmd(), []string{s.cfg.BondDenom, limitArg(1), asJson})
+		require.NoError(t, cerr, "count holders cmd error")
+		var cresult markertypes.QueryHoldingResponse
+		require.NoError(t, s.cfg.Codec.UnmarshalJSON(cout.Bytes(), &cresult), "count holders unmarshal error")
+		expectedCount := int(cresult.Pagination.Total)
+		require.Greater(t, expectedCount, 1, "holders of the bond denom")
+
+		results := make([]string, 0, expectedCount)
+		var nextKey string
+		for page := 1; page <= expectedCount; page++ {
+			args := []string{s.cfg.BondDenom, limitArg(1), asJson}
+			if page != 1 {
+				args = append(args, pageKeyArg(nextKey))
+			}
+			iterID := fmt.Sprintf("page %d/%d, args: %v", page, expectedCount, args)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.AllHoldersCmd(), args)
+			require.NoErrorf(t, err, "cmd error %s", iterID)
+			var result markertypes.QueryHoldingResponse
+			require.NoErrorf(t, s.cfg.Codec.UnmarshalJSON(out.Bytes(), &result), "unmarshal error %s", iterID)
+			require.Lenf(t, result.Balances, 1, "page result count %s", iterID)
+			if page != expectedCount {
+				require.NotEmptyf(t, result.Pagination.NextKey, "pagination next key %s", iterID)
+			} else {
+				require.Emptyf(t, result.Pagination.NextKey, "pagination next key %s", iterID)
+			}
+			results = append(results, result.Balances[0].Address)
+			nextKey = base64.StdEncoding.EncodeToString(result.Pagination.NextKey)
+		}
+
+		// All of the holders are written as CSV, following the next keys.
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.AllHoldersCmd(),
+			[]string{s.cfg.BondDenom, limitArg(1), fmt.Sprintf("--%s=%s", tmcli.OutputFlag, markercli.OutputFormatCSV)})
+		require.NoError(t, err, "csv cmd error")
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Equal(t, "address,denom,amount", lines[0], "csv header")
+		csvHolders := make([]string, 0, len(lines)-1)
+		for _, line := range lines[1:] {
+			fields := strings.Split(line, ",")
+			require.Len(t, fields, 3, "csv line %q", line)
+			require.Equal(t, s.cfg.BondDenom, fields[1], "csv denom")
+			csvHolders = append(csvHolders, fields[0])
+		}
+		require.Equal(t, results, csvHolders, "csv holders")
+	})
 }
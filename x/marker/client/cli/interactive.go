@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/provenance-io/provenance/internal/txbroadcast"
+	"github.com/provenance-io/provenance/x/marker/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/spf13/cobra"
+)
+
+// runAddMarkerWizard prompts for each of the marker creation settings, validates the denom against the
+// unrestricted denom expression configured on the node, and submits the resulting marker and access grant
+// messages as a single transaction.
+func runAddMarkerWizard(cmd *cobra.Command, clientCtx client.Context, args []string) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to query marker params: %w", err)
+	}
+	// An unset expression falls back to the default, matching the keeper's own validation.
+	exp := res.Params.UnrestrictedDenomRegex
+	if len(exp) == 0 {
+		exp = types.DefaultUnrestrictedDenomRegex
+	}
+	denomRegex, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
+	if err != nil {
+		return fmt.Errorf("invalid unrestricted denom expression %s: %w", exp, err)
+	}
+
+	in := clientCtx.Input
+	if in == nil {
+		in = cmd.InOrStdin()
+	}
+	buf := bufio.NewReader(in)
+
+	// Any coin given as an argument provides the defaults for the denom and supply prompts.
+	defaultDenom, defaultSupply := "", ""
+	if len(args) > 0 {
+		coin, err := sdk.ParseCoinNormalized(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid coin %s", args[0])
+		}
+		defaultDenom, defaultSupply = coin.Denom, coin.Amount.String()
+	}
+
+	denom, err := promptUntilValid(buf, "Marker denom", defaultDenom, func(value string) error {
+		if err := sdk.ValidateDenom(value); err != nil {
+			return err
+		}
+		if !denomRegex.MatchString(value) {
+			return fmt.Errorf("invalid denom [%s] (fails unrestricted marker denom validation %s)", value, exp)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var supply sdk.Int
+	if _, err = promptUntilValid(buf, "Total supply", defaultSupply, func(value string) error {
+		amount, ok := sdk.NewIntFromString(value)
+		if !ok || amount.IsNegative() {
+			return fmt.Errorf("invalid supply amount %s", value)
+		}
+		supply = amount
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	defaultType, err := cmd.Flags().GetString(FlagType)
+	if err != nil {
+		return fmt.Errorf("invalid marker type: %w", err)
+	}
+	markerType := types.MarkerType_Coin
+	if _, err = promptUntilValid(buf, "Marker type (COIN|RESTRICTED)", defaultType, func(value string) error {
+		markerType = types.MarkerType(types.MarkerType_value["MARKER_TYPE_"+strings.ToUpper(value)])
+		// basket markers need a reserve composition and are only created with the basket reserve flag
+		if markerType < 1 || markerType == types.MarkerType_Basket {
+			return fmt.Errorf("invalid marker type: %s; expected COIN|RESTRICTED", value)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	supplyFixed, err := input.GetConfirmation("Is the supply fixed?", buf, os.Stderr)
+	if err != nil {
+		return err
+	}
+	allowGovernanceControl, err := input.GetConfirmation("Allow governance control?", buf, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	callerAddr := clientCtx.GetFromAddress()
+	msgs := []sdk.Msg{
+		types.NewMsgAddMarkerRequest(denom, supply, callerAddr, callerAddr, markerType, supplyFixed, allowGovernanceControl),
+	}
+
+	for {
+		address, err := input.GetString("Grant access to address (leave blank when finished)", buf)
+		if err != nil {
+			return err
+		}
+		if len(address) == 0 {
+			break
+		}
+		targetAddr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "grant for invalid address %s: %s\n", address, err)
+			continue
+		}
+		permissions, err := input.GetString(
+			"Permissions for the address, comma separated [mint, burn, deposit, withdraw, delete, admin, transfer]", buf)
+		if err != nil {
+			return err
+		}
+		grant := types.NewAccessGrant(targetAddr, types.AccessListByNames(permissions))
+		if err = grant.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid access grant permission %s: %s\n", permissions, err)
+			continue
+		}
+		msgs = append(msgs, types.NewMsgAddAccessRequest(denom, callerAddr, *grant))
+	}
+
+	for _, msg := range msgs {
+		if err = msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if !clientCtx.GenerateOnly {
+		txBuilder := clientCtx.TxConfig.NewTxBuilder()
+		if err = txBuilder.SetMsgs(msgs...); err != nil {
+			return err
+		}
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%s\n\n", out)
+		if !clientCtx.SkipConfirm {
+			ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+			if err != nil || !ok {
+				fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+				return err
+			}
+			// The composed transaction has already been confirmed above.
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+		}
+	}
+
+	return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+}
+
+// promptUntilValid reads a value (or the provided default when left blank) until it passes the validate function.
+func promptUntilValid(buf *bufio.Reader, prompt, defaultValue string, validate func(string) error) (string, error) {
+	if len(defaultValue) > 0 {
+		prompt = fmt.Sprintf("%s [%s]", prompt, defaultValue)
+	}
+	for {
+		value, err := input.GetString(prompt, buf)
+		if err != nil {
+			return "", err
+		}
+		if len(value) == 0 {
+			value = defaultValue
+		}
+		if err = validate(value); err != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", err)
+			continue
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+const (
+	// FlagOutputFormat is the flag for printing query results in a format other than the --output format.
+	FlagOutputFormat = "output-format"
+	// OutputFormatCanonicalJSON prints the proto3 JSON of a query result with object keys sorted and no insignificant
+	// whitespace, so the same state always prints as the same bytes.
+	OutputFormatCanonicalJSON = "canonical-json"
+	// OutputFormatCSV is the --output format for writing all of the holders of a marker as CSV.
+	OutputFormatCSV = "csv"
+	// FlagDisplay is the flag for printing the coin amounts of a query result in the display denoms of their metadata.
+	FlagDisplay = "display"
+)
+
+// addQueryFlagsToCmd adds the standard query flags and the output format flag to a marker query command.
+func addQueryFlagsToCmd(cmd *cobra.Command) {
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOutputFormat, "",
+		fmt.Sprintf("Print the result as %s for hashing or signing (overrides --%s)", OutputFormatCanonicalJSON, tmcli.OutputFlag))
+}
+
+// addDisplayFlagToCmd adds the flag for printing coin amounts in their display denoms to a marker query command.
+func addDisplayFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagDisplay, false, "Print coin amounts in the display denom of their bank metadata, e.g. 1.5hash for 1500000000nhash")
+}
+
+// printProto prints a query result in the requested output format, with coin amounts in their display denoms when
+// the command has a --display flag that is set.
+func printProto(cmd *cobra.Command, clientCtx client.Context, toPrint proto.Message) error {
+	format, err := cmd.Flags().GetString(FlagOutputFormat)
+	if err != nil {
+		return err
+	}
+	display := false
+	if cmd.Flags().Lookup(FlagDisplay) != nil {
+		if display, err = cmd.Flags().GetBool(FlagDisplay); err != nil {
+			return err
+		}
+	}
+	if format != "" && format != OutputFormatCanonicalJSON {
+		return fmt.Errorf("unknown --%s %q, expected %s", FlagOutputFormat, format, OutputFormatCanonicalJSON)
+	}
+	if format == "" && !display {
+		return clientCtx.PrintProto(toPrint)
+	}
+
+	out, err := clientCtx.Codec.MarshalJSON(toPrint)
+	if err != nil {
+		return err
+	}
+	if display {
+		if out, err = displayJSON(clientCtx, out); err != nil {
+			return err
+		}
+	}
+	if format == OutputFormatCanonicalJSON {
+		if out, err = canonicalJSON(out); err != nil {
+			return err
+		}
+		return clientCtx.PrintBytes(append(out, '\n'))
+	}
+	if clientCtx.OutputFormat == "text" {
+		var value interface{}
+		if err = json.Unmarshal(out, &value); err != nil {
+			return err
+		}
+		if out, err = yaml.Marshal(value); err != nil {
+			return err
+		}
+		return clientCtx.PrintBytes(out)
+	}
+	return clientCtx.PrintBytes(append(out, '\n'))
+}
+
+// displayJSON replaces the denom and amount of each coin in the JSON with the display denom and decimal amount of the
+// denom's bank metadata, as converted by the DisplayAmount query.
+func displayJSON(clientCtx client.Context, bz []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var coins []map[string]interface{}
+	walkJSONCoins(value, func(coin map[string]interface{}) { coins = append(coins, coin) })
+	if len(coins) == 0 {
+		return bz, nil
+	}
+	denoms := make(map[string]bool)
+	var units []string
+	for _, coin := range coins {
+		if denom := coin["denom"].(string); !denoms[denom] {
+			denoms[denom] = true
+			units = append(units, "1"+denom)
+		}
+	}
+	res, err := types.NewQueryClient(clientCtx).DisplayAmount(
+		context.Background(),
+		&types.QueryDisplayAmountRequest{Amount: strings.Join(units, ",")},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert to display denoms: %w", err)
+	}
+	displays := make(map[string]types.DisplayAmount, len(res.Amounts))
+	for _, a := range res.Amounts {
+		displays[a.Base.Denom] = a
+	}
+	for _, coin := range coins {
+		d, found := displays[coin["denom"].(string)]
+		amount, ok := sdk.NewIntFromString(coin["amount"].(string))
+		if !found || !ok {
+			continue
+		}
+		coin["denom"] = d.DisplayDenom
+		coin["amount"] = types.FormatDisplayAmount(amount, d.Exponent)
+	}
+	return json.Marshal(value)
+}
+
+// walkJSONCoins calls the handler with each object in the JSON value that is a coin, i.e. has just a denom and amount.
+func walkJSONCoins(value interface{}, handler func(map[string]interface{})) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		_, isDenom := v["denom"].(string)
+		_, isAmount := v["amount"].(string)
+		if len(v) == 2 && isDenom && isAmount {
+			handler(v)
+			return
+		}
+		for _, field := range v {
+			walkJSONCoins(field, handler)
+		}
+	case []interface{}:
+		for _, item := range v {
+			walkJSONCoins(item, handler)
+		}
+	}
+}
+
+// canonicalJSON re-encodes JSON with object keys sorted, no insignificant whitespace, and numbers and strings kept
+// exactly as they were.
+func canonicalJSON(bz []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
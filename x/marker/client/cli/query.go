@@ -3,14 +3,21 @@ package cli
 import (
 	"context"
 	"encoding/base64"
+	"encoding/csv"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -29,10 +36,25 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		AllMarkersCmd(),
 		AllHoldersCmd(),
+		AccountHoldingCmd(),
 		MarkerCmd(),
 		MarkerAccessCmd(),
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
+		MarkerInvariantsCmd(),
+		MarkerBasketCmd(),
+		MarkerTotalsCmd(),
+		MarkerEmissionSchedulesCmd(),
+		MarkerScheduledTransfersCmd(),
+		MarkerCanSendCmd(),
+		MarkerSupplyHistoryCmd(),
+		MarkerTransferMemoRequirementCmd(),
+		MarkerPendingWithdrawalsCmd(),
+		MarkerFaucetCmd(),
+		MarkerAccessLogCmd(),
+		MarkerFeeConversionCmd(),
+		MarkerAccountPermissionsCmd(),
+		MarkerDisplayAmountCmd(),
 	)
 	return queryCmd
 }
@@ -62,11 +84,11 @@ $ %s query marker params
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			return printProto(cmd, clientCtx, &res.Params)
 		},
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -106,7 +128,7 @@ func AllMarkersCmd() *cobra.Command {
 				fmt.Printf("failed to query markers: %s\n", err.Error())
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
 
@@ -115,7 +137,7 @@ func AllMarkersCmd() *cobra.Command {
 	if err != nil {
 		panic(err.Error())
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -123,9 +145,16 @@ func AllMarkersCmd() *cobra.Command {
 func AllHoldersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "holding [denom]",
-		Aliases: []string{"hold", "holder"},
+		Aliases: []string{"hold", "holder", "holders"},
 		Short:   "List all accounts holding the given marker on the Provenance Blockchain",
-		Args:    cobra.ExactArgs(1),
+		Long: fmt.Sprintf(`List all accounts holding the given marker on the Provenance Blockchain.
+With --%[1]s %[2]s, all of the holders from the --%[3]s on are written as CSV, one page at a time, all at the height of
+the first page (or the --%[4]s given, which requires an archive node for old heights).  If the query fails part way,
+the error has the --%[3]s to continue from.`, tmcli.OutputFlag, OutputFormatCSV, flags.FlagPageKey, flags.FlagHeight),
+		Example: fmt.Sprintf(`$ %[1]s query marker holding nhash
+$ %[1]s query marker holders nhash --%[2]s 1500000 --%[3]s %[4]s > nhash-holders.csv`,
+			version.AppName, flags.FlagHeight, tmcli.OutputFlag, OutputFormatCSV),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -137,6 +166,9 @@ func AllHoldersCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if clientCtx.OutputFormat == OutputFormatCSV {
+				return writeHoldersCSV(cmd, clientCtx, id, pageReq)
+			}
 			var response *types.QueryHoldingResponse
 			if response, err = queryClient.Holding(
 				context.Background(),
@@ -148,13 +180,96 @@ func AllHoldersCmd() *cobra.Command {
 				fmt.Printf("failed to query blockchain balances for \"%s\": %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
 
-	cmd.Flags().Uint32(flags.FlagPage, 1, "Query a specific page of paginated results")
-	cmd.Flags().Uint32(flags.FlagLimit, 200, "Query number of results per page returned")
-	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	err := cmd.Flags().Lookup(flags.FlagLimit).Value.Set("200")
+	if err != nil {
+		panic(err.Error())
+	}
+	addQueryFlagsToCmd(cmd)
+	addDisplayFlagToCmd(cmd)
+	return cmd
+}
+
+// writeHoldersCSV writes the holders of a marker as CSV, following the pagination next keys until all of the holders
+// from the page request on have been written.  Every page is queried at the height of the first one so that the
+// holders are a consistent snapshot.
+func writeHoldersCSV(cmd *cobra.Command, clientCtx client.Context, id string, pageReq *query.PageRequest) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	if err := w.Write([]string{"address", "denom", "amount"}); err != nil {
+		return err
+	}
+	for {
+		var header metadata.MD
+		response, err := types.NewQueryClient(clientCtx).Holding(
+			context.Background(),
+			&types.QueryHoldingRequest{Id: id, Pagination: pageReq},
+			grpc.Header(&header),
+		)
+		if err != nil {
+			w.Flush()
+			return fmt.Errorf("failed to query holders of %q at height %d, continue with --%s %q: %w",
+				id, clientCtx.Height, flags.FlagPageKey, base64.StdEncoding.EncodeToString(pageReq.Key), err)
+		}
+		if clientCtx.Height == 0 {
+			if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+				height, err := strconv.ParseInt(heights[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				clientCtx = clientCtx.WithHeight(height)
+			}
+		}
+		for _, balance := range response.Balances {
+			for _, coin := range balance.Coins {
+				if err = w.Write([]string{balance.Address, coin.Denom, coin.Amount.String()}); err != nil {
+					return err
+				}
+			}
+		}
+		w.Flush()
+		if err = w.Error(); err != nil {
+			return err
+		}
+		if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+			return nil
+		}
+		pageReq = &query.PageRequest{Key: response.Pagination.NextKey, Limit: pageReq.Limit}
+	}
+}
+
+// AccountHoldingCmd is the CLI command for querying the marker coins held by a single account.
+func AccountHoldingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-holding [address|denom] [account]",
+		Aliases: []string{"ah"},
+		Short:   "Get the spendable, locked, and escrowed amounts of the given marker held by an account",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			address := strings.TrimSpace(args[1])
+
+			var response *types.QueryAccountHoldingResponse
+			if response, err = queryClient.AccountHolding(
+				context.Background(),
+				&types.QueryAccountHoldingRequest{Id: id, Address: address},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" holding for \"%s\": %v\n", id, address, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	addDisplayFlagToCmd(cmd)
 	return cmd
 }
 
@@ -180,10 +295,10 @@ func MarkerCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" details: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -209,10 +324,10 @@ func MarkerAccessCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for access control list: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -238,10 +353,11 @@ func MarkerEscrowCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for escrow balances: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
+	addDisplayFlagToCmd(cmd)
 	return cmd
 }
 
@@ -267,10 +383,339 @@ func MarkerSupplyCmd() *cobra.Command {
 				fmt.Printf("failed to query marker \"%s\" for total supply configuration: %v\n", id, err)
 				return nil
 			}
-			return clientCtx.PrintProto(response)
+			return printProto(cmd, clientCtx, response)
 		},
 	}
-	flags.AddQueryFlagsToCmd(cmd)
+	addQueryFlagsToCmd(cmd)
+	addDisplayFlagToCmd(cmd)
+	return cmd
+}
+
+// MarkerInvariantsCmd is the CLI command for checking the marker module invariants without halting the chain.
+func MarkerInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the marker module invariants against the current state",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check the marker module invariants against the current state.
+This is a dry run of the invariants checked by the crisis module: broken invariants are reported but do not halt the chain.
+
+$ %s query marker invariants
+`,
+				version.AppName,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryInvariantsResponse
+			if response, err = queryClient.Invariants(
+				context.Background(),
+				&types.QueryInvariantsRequest{},
+			); err != nil {
+				fmt.Printf("failed to check marker invariants: %v\n", err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerBasketCmd is the CLI command for querying the reserve of a basket marker.
+func MarkerBasketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basket [address|denom]",
+		Short: "Get the reserve composition, supply, and reserve holdings of a basket marker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryBasketResponse
+			if response, err = queryClient.Basket(
+				context.Background(),
+				&types.QueryBasketRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for basket reserve: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerTotalsCmd is the CLI command for querying the totals of markers grouped by type and status.
+func MarkerTotalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "totals",
+		Short: "Get the number, supply, and escrow of markers grouped by marker type and status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryTotalsResponse
+			if response, err = queryClient.Totals(
+				context.Background(),
+				&types.QueryTotalsRequest{},
+			); err != nil {
+				fmt.Printf("failed to query marker totals: %v\n", err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerEmissionSchedulesCmd is the CLI command for querying the emission schedules of a marker.
+func MarkerEmissionSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission [address|denom]",
+		Short: "Get the emission schedules of a marker and the amount each has left to emit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryEmissionSchedulesResponse
+			if response, err = queryClient.EmissionSchedules(
+				context.Background(),
+				&types.QueryEmissionSchedulesRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for emission schedules: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagAddress is the flag limiting the scheduled transfers to those from or to an address.
+const FlagAddress = "address"
+
+// MarkerScheduledTransfersCmd is the CLI command for querying the scheduled transfers of a marker.
+func MarkerScheduledTransfersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-transfers [address|denom]",
+		Short: "Get the scheduled transfers of a marker that have not been released",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			address, _ := cmd.Flags().GetString(FlagAddress)
+
+			var response *types.QueryScheduledTransfersResponse
+			if response, err = queryClient.ScheduledTransfers(
+				context.Background(),
+				&types.QueryScheduledTransfersRequest{Id: id, Address: address},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for scheduled transfers: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().String(FlagAddress, "", "Only the transfers from or to this address")
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagAdministrator is the flag for the address brokering a restricted coin transfer.
+const FlagAdministrator = "administrator"
+
+// MarkerCanSendCmd is the CLI command for checking whether a restricted coin transfer would be allowed.
+func MarkerCanSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-send [from] [to] [coin]",
+		Short: "Check whether a transfer of a restricted coin would be allowed",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check whether a transfer of a restricted coin would be allowed against the current state.
+Each reason the transfer would be denied is reported with its reason code, e.g. TRANSFER_DENY_REASON_NO_TRANSFER_GRANT.
+The transfer is brokered by the from address unless an --%[2]s is provided.
+
+$ %[1]s query marker can-send <from-address> <to-address> 10restrictedcoin
+$ %[1]s query marker can-send <from-address> <to-address> 10restrictedcoin --%[2]s <administrator-address>
+`,
+				version.AppName, FlagAdministrator,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			admin, err := cmd.Flags().GetString(FlagAdministrator)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryCanSendResponse
+			if response, err = queryClient.CanSend(
+				context.Background(),
+				&types.QueryCanSendRequest{FromAddress: args[0], ToAddress: args[1], Amount: args[2], Administrator: admin},
+			); err != nil {
+				fmt.Printf("failed to check marker transfer: %v\n", err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().String(FlagAdministrator, "", "The address brokering the transfer (defaults to the from address)")
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerSupplyHistoryCmd is the CLI command for querying the changes to the supply of a marker's coin.
+func MarkerSupplyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-history [address|denom]",
+		Short: "Get the changes to the supply of a marker's coin within the supply history window, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QuerySupplyHistoryResponse
+			if response, err = queryClient.SupplyHistory(
+				context.Background(),
+				&types.QuerySupplyHistoryRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for supply history: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "supply changes")
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerTransferMemoRequirementCmd is the CLI command for querying the memo required for transfers of a restricted
+// marker's coin.
+func MarkerTransferMemoRequirementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memo-requirement [address|denom]",
+		Short: "Get the pattern the memo of a transfer of a restricted marker's coin must match",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryTransferMemoRequirementResponse
+			if response, err = queryClient.TransferMemoRequirement(
+				context.Background(),
+				&types.QueryTransferMemoRequirementRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for transfer memo requirement: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerPendingWithdrawalsCmd is the CLI command for querying the withdrawals from a marker waiting for approval.
+func MarkerPendingWithdrawalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-withdrawals [address|denom]",
+		Short: "Get the withdrawals from a marker that are waiting for approval",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryPendingWithdrawalsResponse
+			if response, err = queryClient.PendingWithdrawals(
+				context.Background(),
+				&types.QueryPendingWithdrawalsRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for pending withdrawals: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerFaucetCmd is the CLI command for querying the faucet of a marker.
+func MarkerFaucetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "faucet [address|denom]",
+		Short: "Get the faucet of a marker and when an address can next claim from it",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %[1]s query marker faucet hotdogcoin
+$ %[1]s query marker faucet hotdogcoin --%[2]s <claimer-address>`, version.AppName, FlagAddress),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, _ := cmd.Flags().GetString(FlagAddress)
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryFaucetResponse
+			if response, err = queryClient.Faucet(
+				context.Background(),
+				&types.QueryFaucetRequest{Id: id, Address: address},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for faucet: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	cmd.Flags().String(FlagAddress, "", "The address to get the next claim time of")
+	addQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -287,3 +732,137 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	_ = flagSet.Set(flags.FlagPageKey, string(raw))
 	return flagSet
 }
+
+// MarkerAccessLogCmd is the CLI command for querying the grants and revocations of access to a marker.
+func MarkerAccessLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-log [address|denom]",
+		Short: "Get the grants and revocations of access to a marker within the access log window, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryAccessLogResponse
+			if response, err = queryClient.AccessLog(
+				context.Background(),
+				&types.QueryAccessLogRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for access log: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "access changes")
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerFeeConversionCmd is the CLI command for querying the fee conversion letting the fees of a tx be paid in a
+// marker's coin.
+func MarkerFeeConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-conversion [address|denom]",
+		Short:   "Get the fee conversion letting the fees of a tx be paid in a marker's coin",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query marker fee-conversion hotdogcoin`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryFeeConversionResponse
+			if response, err = queryClient.FeeConversion(
+				context.Background(),
+				&types.QueryFeeConversionRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker \"%s\" for fee conversion: %v\n", id, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerAccountPermissionsCmd is the CLI command for querying every marker an address has been granted access to and
+// the permissions it has on each.
+func MarkerAccountPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "permissions [address]",
+		Short:   "Get every marker an address has been granted access to and the permissions it has on each",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query marker permissions pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			address := strings.TrimSpace(args[0])
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryAccountPermissionsResponse
+			if response, err = queryClient.AccountPermissions(
+				context.Background(),
+				&types.QueryAccountPermissionsRequest{Address: address, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker permissions of \"%s\": %v\n", address, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "marker permissions")
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerDisplayAmountCmd is the CLI command for converting amounts of base denoms to their display denoms.
+func MarkerDisplayAmountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "display [coins]",
+		Short: "Convert amounts of base denoms to the display denoms of their bank metadata",
+		Long: fmt.Sprintf(`Convert amounts of base denoms to the display denoms of their bank metadata.
+The amount of a denom without metadata, or whose metadata has no unit for its display denom, is not converted.
+The --%s flag of the holding, account-holding, escrow and supply queries prints their amounts the same way.`, FlagDisplay),
+		Example: fmt.Sprintf(`$ %s query marker display 1500000000nhash,10mycoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			amount := strings.TrimSpace(args[0])
+
+			var response *types.QueryDisplayAmountResponse
+			if response, err = queryClient.DisplayAmount(
+				context.Background(),
+				&types.QueryDisplayAmountRequest{Amount: amount},
+			); err != nil {
+				fmt.Printf("failed to convert \"%s\" to display denoms: %v\n", amount, err)
+				return nil
+			}
+			return printProto(cmd, clientCtx, response)
+		},
+	}
+	addQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,16 +3,17 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"io/ioutil"
 
+	"github.com/provenance-io/provenance/internal/txbroadcast"
 	"github.com/provenance-io/provenance/x/marker/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -28,6 +29,15 @@ const (
 	FlagAllowGovernanceControl = "allowGovernanceControl"
 	FlagTransferLimit          = "transfer-limit"
 	FlagExpiration             = "expiration"
+	FlagInteractive            = "interactive"
+	FlagBasketReserve          = "basketReserve"
+	FlagRecipient              = "recipient"
+	FlagStartHeight            = "start-height"
+	FlagReleaseHeight          = "release-height"
+	FlagReleaseTime            = "release-time"
+	FlagMint                   = "mint"
+	FlagExclude                = "exclude"
+	FlagDisplayExponent        = "display-exponent"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -49,12 +59,32 @@ func NewTxCmd() *cobra.Command {
 		GetCmdAddAccess(),
 		GetCmdDeleteAccess(),
 		GetCmdWithdrawCoins(),
+		GetCmdDistributeEscrow(),
+		GetCmdSweepEscrow(),
+		GetCmdAddEmissionSchedule(),
+		GetCmdCancelEmissionSchedule(),
+		GetCmdScheduleTransfer(),
+		GetCmdCancelScheduledTransfer(),
+		GetCmdClaimScheduledTransfer(),
+		GetCmdPauseMarker(),
+		GetCmdResumeMarker(),
+		GetCmdSetDepositControl(),
+		GetCmdSetTransferMemoRequirement(),
+		GetCmdSetWithdrawApprovalPolicy(),
+		GetCmdApproveWithdraw(),
+		GetCmdSetFaucet(),
+		GetCmdClaimFaucet(),
+		GetCmdSetFeeConversionRate(),
 		GetNewTransferCmd(),
 		GetCmdAddMarker(),
+		GetCmdUpdateFlags(),
+		GetCmdDepositAndMint(),
+		GetCmdBurnAndRedeem(),
 		GetCmdMarkerProposal(),
 		GetCmdGrantAuthorization(),
 		GetCmdRevokeAuthorization(),
 	)
+	txbroadcast.AddFlags(txCmd.PersistentFlags())
 	return txCmd
 }
 
@@ -111,6 +141,26 @@ Valid Proposal Types (and associated parameters):
 	"amount": "100coin"
 	"target_address": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"
 
+- UpdateMarkerFlags
+	"supply_fixed": true,
+	"allow_governance_control": true
+
+- PauseMarker
+	"paused": true
+
+- AdoptDenom
+	"manager": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk",
+	"marker_type": "COIN", // COIN, RESTRICTED
+	"access_list": [ {"address":"pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk", "permissions": [1,2,3]} ],
+	"supply_fixed": true,
+	"allow_governance_control": true
+
+- SetFeeConversion
+	"enabled": true,
+	"pool": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk",
+	"rate": "0.5", // the amount of the fee denom given for one unit of the marker's coin
+	"oracle": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"
+
 - SetDenomMetadata
 	"metadata": {
 		"description": "description text",
@@ -157,6 +207,14 @@ Valid Proposal Types (and associated parameters):
 				proposal = &types.WithdrawEscrowProposal{}
 			case types.ProposalTypeSetDenomMetadata:
 				proposal = &types.SetDenomMetadataProposal{}
+			case types.ProposalTypeUpdateMarkerFlags:
+				proposal = &types.UpdateMarkerFlagsProposal{}
+			case types.ProposalTypePauseMarker:
+				proposal = &types.PauseMarkerProposal{}
+			case types.ProposalTypeAdoptDenom:
+				proposal = &types.AdoptDenomProposal{}
+			case types.ProposalTypeSetFeeConversion:
+				proposal = &types.SetFeeConversionProposal{}
 			default:
 				return fmt.Errorf("unknown proposal type %s", args[0])
 			}
@@ -175,7 +233,7 @@ Valid Proposal Types (and associated parameters):
 			if err != nil {
 				return fmt.Errorf("invalid governance proposal. Error: %s", err)
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -187,20 +245,46 @@ func GetCmdAddMarker() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "new [coin]",
 		Aliases: []string{"n"},
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.RangeArgs(0, 1),
 		Short:   "Create a new marker",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Creates a new marker in the Proposed state managed by the from address
 with the given supply amount and denomination provided in the coin argument
 
+With --%s the denom, supply, type, fixed supply, governance control, and any access grants
+are prompted for instead.  The denom is checked against the unrestricted denom expression of
+the node and the composed transaction is printed before signing.
+
+A BASKET type marker is created with a zero supply and the reserve held for each unit of
+supply given with --%s.  Its supply is only minted and burned through deposit-and-mint
+and burn-and-redeem.
+
+When the marker is activated without denom metadata, metadata with a display unit of the
+--%s is set for its denom, e.g. hash for nhash with 9 or kcoin for coin with 3.
+The default display exponent param of the module is used when not given.
+
 Example:
 $ %s tx marker new 1000hotdogcoin --%s=false --%s=false --from=mykey
-`, FlagType, FlagSupplyFixed, FlagAllowGovernanceControl)),
+$ %s tx marker new 0fruitbasket --%s=BASKET --%s=2apple,1banana --from=mykey
+$ %s tx marker new 1000000000nhotdog --%s=9 --from=mykey
+$ %s tx marker new --%s --from=mykey
+`, FlagInteractive, FlagBasketReserve, FlagDisplayExponent, version.AppName, FlagSupplyFixed, FlagAllowGovernanceControl,
+				version.AppName, FlagType, FlagBasketReserve, version.AppName, FlagDisplayExponent, version.AppName, FlagInteractive)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			interactive, err := cmd.Flags().GetBool(FlagInteractive)
+			if err != nil {
+				return err
+			}
+			if interactive {
+				return runAddMarkerWizard(cmd, clientCtx, args)
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("accepts 1 arg(s), received %d", len(args))
+			}
 			markerType := ""
 			coin, err := sdk.ParseCoinNormalized(args[0])
 			if err != nil {
@@ -215,7 +299,7 @@ $ %s tx marker new 1000hotdogcoin --%s=false --%s=false --from=mykey
 			if len(markerType) > 0 {
 				typeValue = types.MarkerType(types.MarkerType_value["MARKER_TYPE_"+markerType])
 				if typeValue < 1 {
-					return fmt.Errorf("invalid marker type: %s; expected COIN|RESTRICTED", markerType)
+					return fmt.Errorf("invalid marker type: %s; expected COIN|RESTRICTED|BASKET", markerType)
 				}
 			}
 			supplyFixed, err := cmd.Flags().GetBool(FlagSupplyFixed)
@@ -227,11 +311,66 @@ $ %s tx marker new 1000hotdogcoin --%s=false --%s=false --from=mykey
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagAllowGovernanceControl, err)
 			}
 			msg := types.NewMsgAddMarkerRequest(coin.Denom, coin.Amount, callerAddr, callerAddr, typeValue, supplyFixed, allowGovernanceControl)
+			basketReserve, err := cmd.Flags().GetString(FlagBasketReserve)
+			if err != nil {
+				return err
+			}
+			if len(basketReserve) > 0 {
+				if msg.BasketReservePerUnit, err = sdk.ParseCoinsNormalized(basketReserve); err != nil {
+					return fmt.Errorf("invalid basket reserve %s: %w", basketReserve, err)
+				}
+			}
+			if msg.DisplayExponent, err = cmd.Flags().GetUint32(FlagDisplayExponent); err != nil {
+				return err
+			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().String(FlagType, "COIN", "a marker type to assign (default is COIN)")
+	cmd.Flags().String(FlagBasketReserve, "", "the reserve coins held for each unit of supply of a BASKET marker")
+	cmd.Flags().Uint32(FlagDisplayExponent, 0, "the exponent of the display unit of the denom metadata set on activation when there is none (default is the module param)")
+	cmd.Flags().Bool(FlagSupplyFixed, false, "a true or false value to denote if a supply is fixed (default is false)")
+	cmd.Flags().Bool(FlagAllowGovernanceControl, false, "a true or false value to denote if marker is allowed governance control (default is false)")
+	cmd.Flags().Bool(FlagInteractive, false, "prompt for the marker settings and access grants")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateFlags implements the update marker flags command.
+func GetCmdUpdateFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-flags [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the fixed supply and governance control flags of a marker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the fixed supply and governance control flags of a marker identified by the given
+denomination to the values of the --%s and --%s flags.  Flags that are not provided are set to false.
+Only the manager or an account with admin access may update the flags and only while the marker is
+in the Proposed or Finalized status.  The flags of an active marker can only be changed through an
+UpdateMarkerFlags governance proposal when the marker allows governance control.
+
+Example:
+$ %s tx marker update-flags hotdogcoin --%s=true --%s=false --from mykey
+`, FlagSupplyFixed, FlagAllowGovernanceControl, version.AppName, FlagSupplyFixed, FlagAllowGovernanceControl)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			supplyFixed, err := cmd.Flags().GetBool(FlagSupplyFixed)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagSupplyFixed, err)
+			}
+			allowGovernanceControl, err := cmd.Flags().GetBool(FlagAllowGovernanceControl)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagAllowGovernanceControl, err)
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdateMarkerFlagsRequest(args[0], supplyFixed, allowGovernanceControl, callerAddr)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 	cmd.Flags().Bool(FlagSupplyFixed, false, "a true or false value to denote if a supply is fixed (default is false)")
 	cmd.Flags().Bool(FlagAllowGovernanceControl, false, "a true or false value to denote if marker is allowed governance control (default is false)")
 	flags.AddTxFlagsToCmd(cmd)
@@ -239,6 +378,68 @@ $ %s tx marker new 1000hotdogcoin --%s=false --%s=false --from=mykey
 }
 
 // GetCmdMint implements the mint additional supply for marker command.
+// GetCmdDepositAndMint implements the deposit reserve and mint basket coin command.
+func GetCmdDepositAndMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-and-mint [coin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deposit the reserve for an amount of basket coin and mint it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposits the reserve coins required for the given amount of an active basket marker's
+coin into the marker's escrow, then mints that amount of basket coin and sends it to the caller.
+
+Example:
+$ %s tx marker deposit-and-mint 10fruitbasket --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[0])
+			}
+			msg := types.NewMsgDepositAndMintRequest(clientCtx.GetFromAddress(), coin)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBurnAndRedeem implements the burn basket coin and redeem reserve command.
+func GetCmdBurnAndRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-and-redeem [coin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn an amount of basket coin and redeem its reserve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burns the given amount of an active basket marker's coin held by the caller and returns
+the reserve coins held in the marker's escrow for that amount to the caller.
+
+Example:
+$ %s tx marker burn-and-redeem 10fruitbasket --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[0])
+			}
+			msg := types.NewMsgBurnAndRedeemRequest(clientCtx.GetFromAddress(), coin)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdMint() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "mint [coin]",
@@ -265,7 +466,7 @@ $ %s tx marker mint 1000hotdogcoin --from mykey
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgMintRequest(callerAddr, coin)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -300,7 +501,7 @@ $ %s tx marker burn 1000hotdogcoin --from mykey
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgBurnRequest(callerAddr, coin)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -330,7 +531,7 @@ $ %s tx marker finalize hotdogcoin --from mykey
 
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgFinalizeRequest(args[0], callerAddr)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -360,7 +561,7 @@ $ %s tx marker activate hotdogcoin --from mykey
 
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgActivateRequest(args[0], callerAddr)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -382,7 +583,7 @@ func GetCmdCancel() *cobra.Command {
 
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgCancelRequest(args[0], callerAddr)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -404,7 +605,7 @@ func GetCmdDelete() *cobra.Command {
 
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgDeleteRequest(args[0], callerAddr)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -414,17 +615,20 @@ func GetCmdDelete() *cobra.Command {
 // GetCmdAddAccess implements the delegate access to a marker command.
 func GetCmdAddAccess() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "grant [address] [denom] [permission]",
+		Use:     "grant [address] [denom] [permission|@role]",
 		Aliases: []string{"g"},
 		Args:    cobra.ExactArgs(3),
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer].  The permissions of an
+access role defined in the marker module params are granted by giving the role name prefixed
+with @, e.g. @issuer.
 
 Example:
-$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom @issuer --from mykey
 `, version.AppName)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -436,13 +640,21 @@ $ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --
 			if err != nil {
 				return sdkErrors.Wrapf(err, "grant for invalid address %s", args[0])
 			}
+			callerAddr := clientCtx.GetFromAddress()
+			if strings.HasPrefix(args[2], types.AccessRolePrefix) {
+				roleGrant := types.NewAccessRoleGrant(targetAddr, args[2])
+				if err = roleGrant.Validate(); err != nil {
+					return sdkErrors.Wrapf(err, "invalid access role grant: %s", args[2])
+				}
+				msg := types.NewMsgAddAccessRoleRequest(args[1], callerAddr, roleGrant)
+				return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
 			grant := types.NewAccessGrant(targetAddr, types.AccessListByNames(args[2]))
 			if err = grant.Validate(); err != nil {
 				return sdkErrors.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
-			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgAddAccessRequest(args[1], callerAddr, *grant)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -475,7 +687,7 @@ $ %s tx marker revoke pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom --from
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewDeleteAccessRequest(args[1], callerAddr, targetAddr)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -510,7 +722,499 @@ func GetCmdWithdrawCoins() *cobra.Command {
 				}
 			}
 			msg := types.NewMsgWithdrawRequest(callerAddr, recipientAddr, denom, coins)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDistributeEscrow implements the distribute escrow command
+func GetCmdDistributeEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute [marker-denom] [coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Distribute coins from the marker escrow to the holders of the marker",
+		Long: "Distribute coins from the marker escrow account to the current holders of the marker denom, pro-rata to " +
+			"their holdings.  Must be called by a user with withdraw permission.  The holders are paid at the end of " +
+			"this and following blocks, with any amount left over from rounding returned to the marker escrow.",
+		Example: fmt.Sprintf(`$ %s tx marker distribute bondcoin 5000usdcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coins %s", args[1])
+			}
+			msg := types.NewMsgDistributeEscrowRequest(args[0], clientCtx.GetFromAddress(), coins)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSweepEscrow implements the sweep escrow command
+func GetCmdSweepEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-escrow [marker-denom] [(optional) recipient address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Withdraw all coins other than the marker's own from the marker escrow",
+		Long: "Withdraw every coin held by the marker escrow account other than the marker's own coin, e.g. dust " +
+			"accidentally sent to the marker, in a single withdrawal.  Must be called by a user with withdraw " +
+			"permission.  Denoms given with --exclude are left in escrow.  If the recipient is not provided then the " +
+			"coins are deposited in the caller's account.",
+		Example: fmt.Sprintf(`$ %s tx marker sweep-escrow hotdogcoin pb1... --exclude usdcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipientAddr := sdk.AccAddress{}
+			if len(args) == 2 {
+				recipientAddr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return sdkErrors.Wrapf(err, "invalid recipient address %s", args[1])
+				}
+			}
+			exclude, err := cmd.Flags().GetStringSlice(FlagExclude)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSweepEscrowRequest(args[0], clientCtx.GetFromAddress(), recipientAddr, exclude)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagExclude, nil, "comma separated denoms of the coins to leave in the marker escrow")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddEmissionSchedule implements the add emission schedule command
+func GetCmdAddEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-emission [coin] [interval] [emissions]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Mint marker coin every interval of blocks while the marker is active",
+		Long: "Schedule a number of emissions of the marker coin, one every interval of blocks, minted at the start of " +
+			"the block while the marker is active.  Must be called by a user with mint permission.  The coin is sent " +
+			"to the recipient, or left in the marker escrow when no recipient is given.",
+		Example: fmt.Sprintf(`$ %s tx marker add-emission 100rewardcoin 10 52 --recipient pb1... --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[0])
+			}
+			interval, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid interval %s: %w", args[1], err)
+			}
+			emissions, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid emissions %s: %w", args[2], err)
+			}
+			recipient := sdk.AccAddress{}
+			if r, _ := cmd.Flags().GetString(FlagRecipient); len(r) > 0 {
+				if recipient, err = sdk.AccAddressFromBech32(r); err != nil {
+					return sdkErrors.Wrapf(err, "invalid recipient address %s", r)
+				}
+			}
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAddEmissionScheduleRequest(coin, clientCtx.GetFromAddress(), recipient, interval, emissions, startHeight)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagRecipient, "", "The address the emitted coin is sent to, the marker escrow when empty")
+	cmd.Flags().Int64(FlagStartHeight, 0, "The height of the first emission, the next block when zero")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelEmissionSchedule implements the cancel emission schedule command
+func GetCmdCancelEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-emission [schedule-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Cancel the remaining emissions of an emission schedule",
+		Long:    "Cancel the remaining emissions of an emission schedule.  Must be called by a user with mint permission.",
+		Example: fmt.Sprintf(`$ %s tx marker cancel-emission 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgCancelEmissionScheduleRequest(id, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduleTransfer implements the schedule transfer command
+func GetCmdScheduleTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-transfer [from] [to] [coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Transfer restricted coin from one account to another at a future height or time",
+		Long: "Schedule a transfer of restricted coin that is held in escrow by the marker module until the release " +
+			"height and/or time is reached, and then sent to the recipient at the start of the block.  Must be called " +
+			"by a user with transfer permission, the same as an immediate transfer.  Until it is released the transfer " +
+			"can be cancelled by the administrator, returning the coin to the sender.",
+		Example: fmt.Sprintf(`$ %s tx marker schedule-transfer pb1... pb1... 100restrictedcoin --release-time 2030-01-01T00:00:00Z --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid from address %s", args[0])
+			}
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid recipient address %s", args[1])
+			}
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[2])
+			}
+			releaseHeight, err := cmd.Flags().GetInt64(FlagReleaseHeight)
+			if err != nil {
+				return err
+			}
+			var releaseTime *time.Time
+			if t, _ := cmd.Flags().GetString(FlagReleaseTime); len(t) > 0 {
+				parsed, err := time.Parse(time.RFC3339, t)
+				if err != nil {
+					return fmt.Errorf("invalid release time %s: %w", t, err)
+				}
+				releaseTime = &parsed
+			}
+			msg := types.NewMsgScheduleTransferRequest(coin, clientCtx.GetFromAddress(), from, to, releaseHeight, releaseTime)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Int64(FlagReleaseHeight, 0, "The height of the block at the start of which the transfer is released")
+	cmd.Flags().String(FlagReleaseTime, "", "The block time (RFC3339) from which the transfer is released")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelScheduledTransfer implements the cancel scheduled transfer command
+func GetCmdCancelScheduledTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-transfer [transfer-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Return the coin of a scheduled transfer to its sender",
+		Long: "Cancel a scheduled transfer before it is released, returning the coin to its sender.  Must be called by " +
+			"the administrator that scheduled it or a user with transfer permission.",
+		Example: fmt.Sprintf(`$ %s tx marker cancel-scheduled-transfer 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid transfer id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgCancelScheduledTransferRequest(id, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimScheduledTransfer implements the claim scheduled transfer command
+func GetCmdClaimScheduledTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-scheduled-transfer [transfer-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Receive a scheduled transfer that is due without waiting for it to be released",
+		Long: "Claim a scheduled transfer that has reached its release height and time, ahead of the transfers " +
+			"released at the start of each block.  Must be called by the recipient.",
+		Example: fmt.Sprintf(`$ %s tx marker claim-scheduled-transfer 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid transfer id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgClaimScheduledTransferRequest(id, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPauseMarker implements the pause marker command
+func GetCmdPauseMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Block all transfers, mints and burns of an active marker's coin",
+		Long: "Pause an active marker, blocking all transfers, mints and burns of its coin without changing its " +
+			"status until it is resumed.  Must be called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %s tx marker pause hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPauseMarkerRequest(args[0], clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdResumeMarker implements the resume marker command
+func GetCmdResumeMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resume [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Lift the pause of a marker",
+		Long:    "Resume a paused marker, allowing transfers, mints and burns of its coin again.  Must be called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %s tx marker resume hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgResumeMarkerRequest(args[0], clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetDepositControl implements the deposit control command
+func GetCmdSetDepositControl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-control [denom] [true|false]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Restrict who may send coins to a marker account",
+		Long: "Set whether coins can only be sent to a marker account by addresses with deposit permission on the " +
+			"marker.  Must be called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %s tx marker deposit-control hotdogcoin true --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			restrictDeposits, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid restrict deposits value %q: %w", args[1], err)
+			}
+			msg := types.NewMsgSetDepositControlRequest(args[0], restrictDeposits, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferMemoRequirement implements the transfer memo requirement command
+func GetCmdSetTransferMemoRequirement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-memo-requirement [denom] [pattern]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Require the memo of transfers of a restricted coin to match a pattern",
+		Long: "Set the regular expression the whole memo of a transaction transferring a restricted marker's coin must " +
+			"match, e.g. to carry a travel rule reference id.  An empty pattern removes the requirement.  Must be " +
+			"called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %[1]s tx marker transfer-memo-requirement hotdogcoin 'TR-[0-9]{6}' --from mykey
+$ %[1]s tx marker transfer-memo-requirement hotdogcoin "" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetTransferMemoRequirementRequest(args[0], args[1], clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetWithdrawApprovalPolicy implements the withdraw approval policy command
+func GetCmdSetWithdrawApprovalPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-approval-policy [denom] [threshold] [approvals-required] [approval-period]",
+		Args:  cobra.RangeArgs(2, 4),
+		Short: "Require withdrawals from a marker above a threshold to be approved by other administrators",
+		Long: "Set the amount of each denom above which a withdrawal from the marker is held as a pending withdrawal " +
+			"until it is approved by the given number of administrators other than the one that requested it.  A " +
+			"pending withdrawal that is not approved within the approval period expires.  An empty threshold removes " +
+			"the policy.  Must be called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %[1]s tx marker withdraw-approval-policy hotdogcoin 1000000hotdogcoin 2 72h --from mykey
+$ %[1]s tx marker withdraw-approval-policy hotdogcoin "" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			threshold, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid threshold %s", args[1])
+			}
+			var approvals uint64
+			var period time.Duration
+			if !threshold.Empty() {
+				if len(args) != 4 {
+					return fmt.Errorf("the approvals required and approval period are required with a threshold")
+				}
+				if approvals, err = strconv.ParseUint(args[2], 10, 32); err != nil {
+					return fmt.Errorf("invalid approvals required %s: %w", args[2], err)
+				}
+				if period, err = time.ParseDuration(args[3]); err != nil {
+					return fmt.Errorf("invalid approval period %s: %w", args[3], err)
+				}
+			}
+			msg := types.NewMsgSetWithdrawApprovalPolicyRequest(args[0], threshold, uint32(approvals), period,
+				clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveWithdraw implements the approve withdraw command
+func GetCmdApproveWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-withdraw [withdrawal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Approve a pending withdrawal from a marker",
+		Long: "Approve a withdrawal above the threshold of a marker's withdraw approval policy.  The withdrawal is made " +
+			"once it has the required number of approvals.  Must be called by a user with admin permission other than " +
+			"the one that requested the withdrawal.",
+		Example: fmt.Sprintf(`$ %s tx marker approve-withdraw 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid withdrawal id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgApproveWithdrawRequest(id, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetFaucet implements the set faucet command
+func GetCmdSetFaucet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-faucet [denom] [drip-amount] [period]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Let any address claim an amount of a marker's coin once per period",
+		Long: "Set the faucet of a marker that sends the drip amount of its coin to any address that claims it, at most " +
+			"once per period for each address.  The coin is withdrawn from the marker, or minted first with the --mint " +
+			"flag, using the access of the caller.  Faucets must be enabled by the marker module params.  A zero drip " +
+			"amount removes the faucet.  Must be called by a user with admin permission.",
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-faucet hotdogcoin 100 24h --from mykey
+$ %[1]s tx marker set-faucet hotdogcoin 100 1h --mint --from mykey
+$ %[1]s tx marker set-faucet hotdogcoin 0 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			drip, ok := sdk.NewIntFromString(args[1])
+			if !ok || drip.IsNegative() {
+				return fmt.Errorf("invalid drip amount %s", args[1])
+			}
+			var period time.Duration
+			if !drip.IsZero() {
+				if len(args) != 3 {
+					return fmt.Errorf("the period is required with a drip amount")
+				}
+				if period, err = time.ParseDuration(args[2]); err != nil {
+					return fmt.Errorf("invalid period %s: %w", args[2], err)
+				}
+			}
+			mint, err := cmd.Flags().GetBool(FlagMint)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetFaucetRequest(args[0], drip, period, mint, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagMint, false, "mint the coin sent for each claim instead of withdrawing it from the marker")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimFaucet implements the claim faucet command
+func GetCmdClaimFaucet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-faucet [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the drip amount of a marker's faucet",
+		Long: "Claim the drip amount of a marker's coin from its faucet.  Each address can claim again once the " +
+			"period of the faucet has passed since its last claim.",
+		Example: fmt.Sprintf(`$ %s tx marker claim-faucet hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgClaimFaucetRequest(args[0], clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetFeeConversionRate implements the set fee conversion rate command
+func GetCmdSetFeeConversionRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-conversion-rate [denom] [rate]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update the rate of a marker's fee conversion",
+		Long: "Update the amount of the fee denom given for one unit of a marker's coin when it is used to pay the " +
+			"fees of a tx.  Only the oracle of the fee conversion can update its rate.",
+		Example: fmt.Sprintf(`$ %s tx marker set-fee-conversion-rate hotdogcoin 0.25 --from oraclekey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			rate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid rate %s: %w", args[1], err)
+			}
+			msg := types.NewMsgSetFeeConversionRateRequest(args[0], rate, clientCtx.GetFromAddress())
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -546,7 +1250,7 @@ func GetNewTransferCmd() *cobra.Command {
 				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[2])
 			}
 			msg := types.NewMsgTransferRequest(clientCtx.GetFromAddress(), from, to, coins[0])
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -610,7 +1314,7 @@ Examples:
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
@@ -656,7 +1360,7 @@ Examples:
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
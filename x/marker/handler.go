@@ -57,6 +57,63 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSetDenomMetadataRequest:
 			res, err := msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateMarkerFlagsRequest:
+			res, err := msgServer.UpdateFlags(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositAndMintRequest:
+			res, err := msgServer.DepositAndMint(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBurnAndRedeemRequest:
+			res, err := msgServer.BurnAndRedeem(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDistributeEscrowRequest:
+			res, err := msgServer.DistributeEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddEmissionScheduleRequest:
+			res, err := msgServer.AddEmissionSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelEmissionScheduleRequest:
+			res, err := msgServer.CancelEmissionSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgScheduleTransferRequest:
+			res, err := msgServer.ScheduleTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelScheduledTransferRequest:
+			res, err := msgServer.CancelScheduledTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimScheduledTransferRequest:
+			res, err := msgServer.ClaimScheduledTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPauseMarkerRequest:
+			res, err := msgServer.PauseMarker(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgResumeMarkerRequest:
+			res, err := msgServer.ResumeMarker(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetDepositControlRequest:
+			res, err := msgServer.SetDepositControl(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetTransferMemoRequirementRequest:
+			res, err := msgServer.SetTransferMemoRequirement(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetWithdrawApprovalPolicyRequest:
+			res, err := msgServer.SetWithdrawApprovalPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgApproveWithdrawRequest:
+			res, err := msgServer.ApproveWithdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetFaucetRequest:
+			res, err := msgServer.SetFaucet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimFaucetRequest:
+			res, err := msgServer.ClaimFaucet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetFeeConversionRateRequest:
+			res, err := msgServer.SetFeeConversionRate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSweepEscrowRequest:
+			res, err := msgServer.SweepEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -83,6 +140,14 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleWithdrawEscrowProposal(ctx, k, c)
 		case *types.SetDenomMetadataProposal:
 			return keeper.HandleSetDenomMetadataProposal(ctx, k, c)
+		case *types.UpdateMarkerFlagsProposal:
+			return keeper.HandleUpdateMarkerFlagsProposal(ctx, k, c)
+		case *types.PauseMarkerProposal:
+			return keeper.HandlePauseMarkerProposal(ctx, k, c)
+		case *types.AdoptDenomProposal:
+			return keeper.HandleAdoptDenomProposal(ctx, k, c)
+		case *types.SetFeeConversionProposal:
+			return keeper.HandleSetFeeConversionProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized marker proposal content type: %T", c)
 		}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
@@ -212,6 +214,27 @@ func (s HandlerTestSuite) TestMsgAddAccessRequest() {
 			fmt.Sprintf("updates to pending marker hotdog can only be made by %s: unauthorized", s.user1),
 			nil,
 		},
+		{
+			"should successfully grant access role to marker",
+			types.NewMsgAddAccessRoleRequest("hotdog", s.user1Addr, types.NewAccessRoleGrant(s.user2Addr, "@registrar")),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerAddAccess(&types.AccessGrant{Address: s.user2, Permissions: types.AccessListByNames("TRANSFER")}, "hotdog", s.user1),
+		},
+		{
+			"should fail to ADD access role to marker, role not defined",
+			types.NewMsgAddAccessRoleRequest("hotdog", s.user1Addr, types.NewAccessRoleGrant(s.user2Addr, "auditor")),
+			[]string{s.user1},
+			"access role auditor is not defined: invalid request",
+			nil,
+		},
+		{
+			"should fail to ADD access role to marker, validate basic fails",
+			types.NewMsgAddAccessRoleRequest("hotdog", s.user1Addr, types.NewAccessRoleGrant(s.user2Addr, "Bad Role")),
+			[]string{s.user1},
+			"invalid access role name \"Bad Role\": invalid request",
+			nil,
+		},
 	}
 
 	s.runTests(cases)
@@ -599,3 +622,1331 @@ func (s HandlerTestSuite) TestMsgSetDenomMetadataRequest() {
 	}
 	s.runTests(cases)
 }
+
+func (s HandlerTestSuite) TestMsgUpdateMarkerFlagsRequest() {
+
+	hotdogDenom := "hotdog"
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to update flags without admin access",
+			types.NewMsgUpdateMarkerFlagsRequest(hotdogDenom, false, false, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s is not allowed to update the flags of %s marker", s.user2, hotdogDenom),
+			nil,
+		},
+		{
+			"should successfully update flags of proposed marker",
+			types.NewMsgUpdateMarkerFlagsRequest(hotdogDenom, false, true, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerUpdateFlags(hotdogDenom, false, true, s.user1),
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should successfully update flags of finalized marker",
+			types.NewMsgUpdateMarkerFlagsRequest(hotdogDenom, true, false, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerUpdateFlags(hotdogDenom, true, false, s.user1),
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to update flags of active marker",
+			types.NewMsgUpdateMarkerFlagsRequest(hotdogDenom, false, true, s.user1Addr),
+			[]string{s.user1},
+			"can only update the flags of markeraccounts in the Proposed or Finalized status",
+			nil,
+		},
+	}
+	s.runTests(cases)
+}
+
+func (s HandlerTestSuite) TestMsgBasketRequests() {
+	basketDenom := "fruitbasket"
+	reservePerUnit := sdk.NewCoins(sdk.NewInt64Coin("apple", 2), sdk.NewInt64Coin("banana", 1))
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("banana", 10))))
+
+	addBasket := types.NewMsgAddMarkerRequest(basketDenom, sdk.ZeroInt(), s.user1Addr, s.user1Addr, types.MarkerType_Basket, false, true)
+	addBasket.BasketReservePerUnit = reservePerUnit
+
+	cases := []CommonTest{
+		{
+			"setup new basket marker for test",
+			addBasket,
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerAdd(basketDenom, "0", "proposed", s.user1, types.MarkerType_Basket.String()),
+		},
+		{
+			"should fail to deposit into a basket that is not active",
+			types.NewMsgDepositAndMintRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 1)),
+			[]string{s.user1},
+			fmt.Sprintf("basket marker %s is not in Active status", basketDenom),
+			nil,
+		},
+		{
+			"setup finalize basket marker",
+			types.NewMsgFinalizeRequest(basketDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate basket marker",
+			types.NewMsgActivateRequest(basketDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should successfully deposit and mint basket coin",
+			types.NewMsgDepositAndMintRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 3)),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerBasketDeposit("3", basketDenom, "6apple,3banana", s.user1),
+		},
+		{
+			"should fail to deposit without enough reserve coins",
+			types.NewMsgDepositAndMintRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 3)),
+			[]string{s.user1},
+			fmt.Sprintf("could not deposit reserve 6apple,3banana for 3%s: 4apple is smaller than 6apple: insufficient funds", basketDenom),
+			nil,
+		},
+		{
+			"should fail to mint basket coin directly",
+			types.NewMsgMintRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 1)),
+			[]string{s.user1},
+			fmt.Sprintf("cannot mint coin for basket marker %s, supply only changes with reserve deposits and redemptions: invalid request", basketDenom),
+			nil,
+		},
+		{
+			"should fail to redeem more than is held",
+			types.NewMsgBurnAndRedeemRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 4)),
+			[]string{s.user1},
+			fmt.Sprintf("could not redeem 4%s: 3%s is smaller than 4%s: insufficient funds", basketDenom, basketDenom, basketDenom),
+			nil,
+		},
+		{
+			"should successfully burn and redeem basket coin",
+			types.NewMsgBurnAndRedeemRequest(s.user1Addr, sdk.NewInt64Coin(basketDenom, 1)),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerBasketRedeem("1", basketDenom, "2apple,1banana", s.user1),
+		},
+		{
+			"should fail to deposit into a marker that is not a basket",
+			types.NewMsgDepositAndMintRequest(s.user1Addr, sdk.NewInt64Coin("apple", 1)),
+			[]string{s.user1},
+			"marker not found for apple: marker apple not found for address: " + types.MustGetMarkerAddress("apple").String(),
+			nil,
+		},
+	}
+	s.runTests(cases)
+
+	s.Require().Equal("6apple,8banana,2"+basketDenom, s.app.BankKeeper.GetAllBalances(s.ctx, s.user1Addr).String())
+	basketAddr := types.MustGetMarkerAddress(basketDenom)
+	s.Require().Equal("4apple,2banana", s.app.BankKeeper.GetAllBalances(s.ctx, basketAddr).String())
+
+	res, err := s.app.MarkerKeeper.Basket(sdk.WrapSDKContext(s.ctx), &types.QueryBasketRequest{Id: basketDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(reservePerUnit, res.Basket.ReservePerUnit)
+	s.Require().Equal(sdk.NewInt64Coin(basketDenom, 2), res.Supply)
+	s.Require().Equal("4apple,2banana", res.Reserve.String())
+
+	err = s.app.MarkerKeeper.WithdrawCoins(s.ctx, s.user1Addr, s.user1Addr, basketDenom, sdk.NewCoins(sdk.NewInt64Coin("apple", 1)))
+	s.Require().EqualError(err, fmt.Sprintf("cannot withdraw apple reserve from basket marker %s", basketDenom))
+}
+
+func (s HandlerTestSuite) TestMsgDistributeEscrowRequest() {
+	bondDenom := "bondcoin"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT,WITHDRAW"),
+	}
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.DistributionHoldersPerBlock = 1
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	bondAddr := types.MustGetMarkerAddress(bondDenom)
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(bondDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(bondDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to distribute from a marker that is not active",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))),
+			[]string{s.user1},
+			"cannot distribute escrow of a marker that is not in Active status: invalid request",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(bondDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(bondDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+	}
+	s.runTests(cases)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, bondAddr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))))
+
+	cases = []CommonTest{
+		{
+			"should fail to distribute without holders",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))),
+			[]string{s.user1},
+			"marker bondcoin has no holders to distribute to: invalid request",
+			nil,
+		},
+		{
+			"setup withdraw to first holder",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, bondDenom, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 75))),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup withdraw to second holder",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user2Addr, bondDenom, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 25))),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to distribute without withdraw access",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_WITHDRAW on bondcoin markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to distribute the marker denom",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10))),
+			[]string{s.user1},
+			"invalid distribute escrow request: cannot distribute bondcoin to its own holders: invalid request",
+			nil,
+		},
+		{
+			"should fail to distribute more than is in escrow",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 11))),
+			[]string{s.user1},
+			"could not distribute 11usdcoin from bondcoin escrow: 10usdcoin is smaller than 11usdcoin: insufficient funds: invalid request",
+			nil,
+		},
+		{
+			"should successfully distribute escrow",
+			types.NewMsgDistributeEscrowRequest(bondDenom, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerDistribute(1, "10usdcoin", bondDenom, s.user1, 2),
+		},
+	}
+	s.runTests(cases)
+
+	params.MaxDistributionHolders = 1
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	_, err := s.app.MarkerKeeper.DistributeEscrow(s.ctx, s.user1Addr, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 1)))
+	s.Require().EqualError(err, "marker bondcoin has 2 holders, more than the 1 an escrow distribution may pay")
+
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, bondAddr, "usdcoin").IsZero(), "distribution set aside from escrow")
+	marker.EndBlocker(s.ctx, s.app.MarkerKeeper)
+	s.Require().Len(s.app.MarkerKeeper.GetDistributionHolders(s.ctx, 1, 0), 1, "one holder paid per block")
+	_, found := s.app.MarkerKeeper.GetEscrowDistribution(s.ctx, 1)
+	s.Require().True(found, "distribution pending")
+
+	marker.EndBlocker(s.ctx, s.app.MarkerKeeper)
+	s.Require().Empty(s.app.MarkerKeeper.GetDistributionHolders(s.ctx, 1, 0))
+	_, found = s.app.MarkerKeeper.GetEscrowDistribution(s.ctx, 1)
+	s.Require().False(found, "distribution complete")
+
+	s.Require().Equal("7usdcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "usdcoin").String())
+	s.Require().Equal("2usdcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "usdcoin").String())
+	s.Require().Equal("1usdcoin", s.app.BankKeeper.GetBalance(s.ctx, bondAddr, "usdcoin").String(), "remainder returned to escrow")
+
+	// the holders paid in a block may span distributions, paying them in the order they were requested
+	params.MaxDistributionHolders = 2
+	params.DistributionHoldersPerBlock = 3
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, bondAddr, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 7))))
+	second, err := s.app.MarkerKeeper.DistributeEscrow(s.ctx, s.user1Addr, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 4)))
+	s.Require().NoError(err)
+	third, err := s.app.MarkerKeeper.DistributeEscrow(s.ctx, s.user1Addr, bondDenom, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 4)))
+	s.Require().NoError(err)
+
+	marker.EndBlocker(s.ctx, s.app.MarkerKeeper)
+	_, found = s.app.MarkerKeeper.GetEscrowDistribution(s.ctx, second)
+	s.Require().False(found, "first distribution complete")
+	s.Require().Len(s.app.MarkerKeeper.GetDistributionHolders(s.ctx, third, 0), 1, "remaining holders paid from the next distribution")
+
+	marker.EndBlocker(s.ctx, s.app.MarkerKeeper)
+	s.Require().Empty(s.app.MarkerKeeper.GetEscrowDistributions(s.ctx))
+	s.Require().Equal("13usdcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "usdcoin").String())
+	s.Require().Equal("4usdcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "usdcoin").String())
+}
+
+func (s HandlerTestSuite) TestMsgSweepEscrowRequest() {
+	sweepDenom := "sweepcoin"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT,WITHDRAW"),
+	}
+	sweepAddr := types.MustGetMarkerAddress(sweepDenom)
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(sweepDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(sweepDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(sweepDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(sweepDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to sweep when there is only the marker coin in escrow",
+			types.NewMsgSweepEscrowRequest(sweepDenom, s.user1Addr, nil, nil),
+			[]string{s.user1},
+			"sweepcoin markeraccount does not hold any coins to sweep: invalid request",
+			nil,
+		},
+		{
+			"should fail to sweep with an invalid excluded denom",
+			types.NewMsgSweepEscrowRequest(sweepDenom, s.user1Addr, nil, []string{"x"}),
+			[]string{s.user1},
+			"invalid sweep escrow request: excluded invalid denom: x: invalid request",
+			nil,
+		},
+	}
+	s.runTests(cases)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, sweepAddr, sdk.NewCoins(
+		sdk.NewInt64Coin("dusta", 3), sdk.NewInt64Coin("dustb", 5), sdk.NewInt64Coin("usdcoin", 10))))
+
+	cases = []CommonTest{
+		{
+			"should fail to sweep without withdraw access",
+			types.NewMsgSweepEscrowRequest(sweepDenom, s.user2Addr, nil, nil),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_WITHDRAW on sweepcoin markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should successfully sweep the dust to the recipient",
+			types.NewMsgSweepEscrowRequest(sweepDenom, s.user1Addr, s.user2Addr, []string{"usdcoin"}),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerWithdraw("3dusta,5dustb", sweepDenom, s.user1, s.user2),
+		},
+	}
+	s.runTests(cases)
+
+	s.Require().Equal("3dusta", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "dusta").String(), "swept to recipient")
+	s.Require().Equal("5dustb", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "dustb").String(), "swept to recipient")
+	s.Require().Equal("100sweepcoin,10usdcoin", s.app.BankKeeper.GetAllBalances(s.ctx, sweepAddr).String(),
+		"marker coin and excluded denom left in escrow")
+}
+
+func (s HandlerTestSuite) TestMsgEmissionScheduleRequests() {
+	rewardDenom := "rewardcoin"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT"),
+	}
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.MaxEmissionPerBlock = 10
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(10)
+	emission := sdk.NewInt64Coin(rewardDenom, 20)
+	schedule := types.NewEmissionSchedule(1, s.user1Addr, s.user2, emission, 2, 3, 11)
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(rewardDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(rewardDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(rewardDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(rewardDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to add a schedule without mint access",
+			types.NewMsgAddEmissionScheduleRequest(emission, s.user2Addr, s.user2Addr, 2, 3, 0),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_MINT on rewardcoin markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to add a schedule above the maximum rate",
+			types.NewMsgAddEmissionScheduleRequest(sdk.NewInt64Coin(rewardDenom, 25), s.user1Addr, s.user2Addr, 2, 3, 0),
+			[]string{s.user1},
+			"emission of 25rewardcoin every 2 blocks exceeds the maximum of 10 per block: invalid request",
+			nil,
+		},
+		{
+			"should fail to add a schedule starting in the past",
+			types.NewMsgAddEmissionScheduleRequest(emission, s.user1Addr, s.user2Addr, 2, 3, 5),
+			[]string{s.user1},
+			"start height 5 must be after the current height 10: invalid request",
+			nil,
+		},
+		{
+			"should successfully add a schedule",
+			types.NewMsgAddEmissionScheduleRequest(emission, s.user1Addr, s.user2Addr, 2, 3, 0),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerEmissionScheduleAdd(schedule),
+		},
+		{
+			"should fail to add a second schedule for the marker",
+			types.NewMsgAddEmissionScheduleRequest(sdk.NewInt64Coin(rewardDenom, 5), s.user1Addr, nil, 1, 10, 0),
+			[]string{s.user1},
+			"marker rewardcoin already has emission schedule 1: invalid request",
+			nil,
+		},
+		{
+			"should fail to cancel a schedule without mint access",
+			types.NewMsgCancelEmissionScheduleRequest(1, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_MINT on rewardcoin markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to cancel an unknown schedule",
+			types.NewMsgCancelEmissionScheduleRequest(3, s.user1Addr),
+			[]string{s.user1},
+			"emission schedule 3 not found: invalid request",
+			nil,
+		},
+	}
+	s.runTests(cases)
+
+	res, err := s.app.MarkerKeeper.EmissionSchedules(sdk.WrapSDKContext(s.ctx), &types.QueryEmissionSchedulesRequest{Id: rewardDenom})
+	s.Require().NoError(err)
+	s.Require().Len(res.Schedules, 1)
+	s.Require().Equal(schedule, res.Schedules[0].Schedule)
+	s.Require().Equal("60rewardcoin", res.Schedules[0].Remaining.String())
+	s.Require().Equal(int64(15), res.Schedules[0].FinalHeight)
+
+	for height := int64(11); height <= 16; height++ {
+		marker.BeginBlocker(s.ctx.WithBlockHeight(height), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+		if height == 13 {
+			s.Require().Equal("40rewardcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, rewardDenom).String())
+		}
+	}
+	s.Require().Equal("60rewardcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, rewardDenom).String())
+	s.Require().Equal("160rewardcoin", s.app.BankKeeper.GetSupply(s.ctx, rewardDenom).String())
+	_, found := s.app.MarkerKeeper.GetEmissionSchedule(s.ctx, 1)
+	s.Require().False(found, "schedule complete")
+
+	// Lowering the maximum rate caps the emissions of existing schedules.
+	_, err = s.app.MarkerKeeper.AddEmissionSchedule(s.ctx, s.user1Addr, "", emission, 2, 1, 0)
+	s.Require().NoError(err)
+	params.MaxEmissionPerBlock = 4
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	marker.BeginBlocker(s.ctx.WithBlockHeight(11), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().Equal("108rewardcoin", s.app.BankKeeper.GetBalance(s.ctx, types.MustGetMarkerAddress(rewardDenom), rewardDenom).String())
+
+	// A completed schedule makes room for a new one.
+	s.runTests([]CommonTest{
+		{
+			"should successfully add a schedule emitting to the marker escrow",
+			types.NewMsgAddEmissionScheduleRequest(sdk.NewInt64Coin(rewardDenom, 4), s.user1Addr, nil, 1, 10, 0),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should successfully cancel a schedule",
+			types.NewMsgCancelEmissionScheduleRequest(3, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerEmissionScheduleCancel(3, rewardDenom, 10, s.user1),
+		},
+	})
+	s.Require().Empty(s.app.MarkerKeeper.GetEmissionSchedules(s.ctx))
+	marker.BeginBlocker(s.ctx.WithBlockHeight(11), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().Equal("108rewardcoin", s.app.BankKeeper.GetBalance(s.ctx, types.MustGetMarkerAddress(rewardDenom), rewardDenom).String(), "no emissions from a cancelled schedule")
+}
+
+func (s HandlerTestSuite) TestMsgScheduledTransferRequests() {
+	lockedDenom := "lockedcoin"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT,WITHDRAW,TRANSFER"),
+	}
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	s.ctx = s.ctx.WithBlockHeight(10).WithBlockTime(now)
+	amount := sdk.NewInt64Coin(lockedDenom, 100)
+	byHeight := types.NewScheduledTransfer(1, s.user1Addr, s.user1Addr, s.user2Addr, amount, 12, nil)
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(lockedDenom, sdk.NewInt(1000), s.user1Addr, s.user1Addr, types.MarkerType_RestrictedCoin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(lockedDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(lockedDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(lockedDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup withdraw coin to the administrator",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, lockedDenom, sdk.NewCoins(sdk.NewInt64Coin(lockedDenom, 300))),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to schedule a transfer released in the past",
+			types.NewMsgScheduleTransferRequest(amount, s.user1Addr, s.user1Addr, s.user2Addr, 5, nil),
+			[]string{s.user1},
+			"release height 5 must be after the current height 10: invalid request",
+			nil,
+		},
+		{
+			"should fail to schedule a transfer without transfer access",
+			types.NewMsgScheduleTransferRequest(amount, s.user2Addr, s.user2Addr, s.user1Addr, 12, nil),
+			[]string{s.user2},
+			fmt.Sprintf("NO_TRANSFER_GRANT: %s is not allowed to broker transfers: administrator does not have transfer access: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to schedule a transfer of more than the sender holds",
+			types.NewMsgScheduleTransferRequest(sdk.NewInt64Coin(lockedDenom, 500), s.user1Addr, s.user1Addr, s.user2Addr, 12, nil),
+			[]string{s.user1},
+			fmt.Sprintf("INSUFFICIENT_FUNDS: %s has 300lockedcoin spendable, 500lockedcoin required: insufficient funds: invalid request", s.user1),
+			nil,
+		},
+		{
+			"should successfully schedule a transfer released at a height",
+			types.NewMsgScheduleTransferRequest(amount, s.user1Addr, s.user1Addr, s.user2Addr, 12, nil),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerTransferScheduled(byHeight),
+		},
+		{
+			"should successfully schedule a transfer released at a time",
+			types.NewMsgScheduleTransferRequest(sdk.NewInt64Coin(lockedDenom, 50), s.user1Addr, s.user1Addr, s.user2Addr, 0, &later),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should successfully schedule a transfer to cancel",
+			types.NewMsgScheduleTransferRequest(sdk.NewInt64Coin(lockedDenom, 20), s.user1Addr, s.user1Addr, s.user2Addr, 11, nil),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to cancel a transfer without transfer access",
+			types.NewMsgCancelScheduledTransferRequest(3, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_TRANSFER on lockedcoin markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should successfully cancel a transfer",
+			types.NewMsgCancelScheduledTransferRequest(3, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerScheduledTransferCancel(
+				types.NewScheduledTransfer(3, s.user1Addr, s.user1Addr, s.user2Addr, sdk.NewInt64Coin(lockedDenom, 20), 11, nil), s.user1),
+		},
+		{
+			"should fail to cancel an unknown transfer",
+			types.NewMsgCancelScheduledTransferRequest(3, s.user1Addr),
+			[]string{s.user1},
+			"scheduled transfer 3 not found: invalid request",
+			nil,
+		},
+		{
+			"should fail to claim a transfer that is not due",
+			types.NewMsgClaimScheduledTransferRequest(1, s.user2Addr),
+			[]string{s.user2},
+			"scheduled transfer 1 has not been released: invalid request",
+			nil,
+		},
+		{
+			"should fail to claim a transfer to another account",
+			types.NewMsgClaimScheduledTransferRequest(1, s.user1Addr),
+			[]string{s.user1},
+			fmt.Sprintf("scheduled transfer 1 can only be claimed by its recipient %s: invalid request", s.user2),
+			nil,
+		},
+	}
+	s.runTests(cases)
+	s.Require().Equal("150lockedcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, lockedDenom).String(), "escrowed")
+
+	res, err := s.app.MarkerKeeper.ScheduledTransfers(sdk.WrapSDKContext(s.ctx), &types.QueryScheduledTransfersRequest{Id: lockedDenom})
+	s.Require().NoError(err)
+	s.Require().Len(res.Transfers, 2)
+	s.Require().Equal(byHeight, res.Transfers[0])
+	s.Require().Equal(later, *res.Transfers[1].ReleaseTime)
+	res, err = s.app.MarkerKeeper.ScheduledTransfers(sdk.WrapSDKContext(s.ctx),
+		&types.QueryScheduledTransfersRequest{Id: lockedDenom, Address: types.MustGetMarkerAddress(lockedDenom).String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Transfers, "other address")
+
+	marker.BeginBlocker(s.ctx.WithBlockHeight(11), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, lockedDenom).IsZero(), "nothing due")
+	marker.BeginBlocker(s.ctx.WithBlockHeight(12), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().Equal("100lockedcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, lockedDenom).String(), "released at height")
+
+	s.ctx = s.ctx.WithBlockHeight(13).WithBlockTime(later)
+	s.runTests([]CommonTest{
+		{
+			"should successfully claim a transfer that is due",
+			types.NewMsgClaimScheduledTransferRequest(2, s.user2Addr),
+			[]string{s.user2},
+			"",
+			types.NewEventMarkerScheduledTransferReleased(
+				types.NewScheduledTransfer(2, s.user1Addr, s.user1Addr, s.user2Addr, sdk.NewInt64Coin(lockedDenom, 50), 0, &later), s.user2),
+		},
+	})
+	s.Require().Equal("150lockedcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, lockedDenom).String(), "claimed")
+
+	evenLater := later.Add(time.Hour)
+	s.runTests([]CommonTest{
+		{
+			"should successfully schedule a transfer released at a height and time",
+			types.NewMsgScheduleTransferRequest(sdk.NewInt64Coin(lockedDenom, 10), s.user1Addr, s.user1Addr, s.user2Addr, 14, &evenLater),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+	})
+	marker.BeginBlocker(s.ctx.WithBlockHeight(14), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().Equal("150lockedcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, lockedDenom).String(), "time not reached")
+	marker.BeginBlocker(s.ctx.WithBlockHeight(15).WithBlockTime(evenLater), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	s.Require().Equal("160lockedcoin", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, lockedDenom).String(), "released at height and time")
+
+	s.Require().Empty(s.app.MarkerKeeper.GetScheduledTransfers(s.ctx))
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{types.ScheduledTransferHeightKeyPrefix, types.ScheduledTransferTimeKeyPrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		s.Require().False(iterator.Valid(), "index %X", prefix)
+		iterator.Close()
+	}
+}
+
+func (s HandlerTestSuite) TestMsgPauseMarkerRequests() {
+	hotdogDenom := "hotdog"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT,BURN,ADMIN,WITHDRAW"),
+	}
+
+	cases := []CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to pause a marker that is not active",
+			types.NewMsgPauseMarkerRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"can only pause markeraccounts in the Active status: invalid request",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to pause without admin access",
+			types.NewMsgPauseMarkerRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_ADMIN on hotdog markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to resume a marker that is not paused",
+			types.NewMsgResumeMarkerRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"marker hotdog is not paused: invalid request",
+			nil,
+		},
+		{
+			"should successfully pause marker",
+			types.NewMsgPauseMarkerRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerPaused(hotdogDenom, s.user1),
+		},
+		{
+			"should fail to pause a paused marker",
+			types.NewMsgPauseMarkerRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"marker hotdog is already paused: invalid request",
+			nil,
+		},
+		{
+			"should fail to mint a paused marker",
+			types.NewMsgMintRequest(s.user1Addr, sdk.NewCoin(hotdogDenom, sdk.NewInt(100))),
+			[]string{s.user1},
+			"cannot mint hotdog: marker is paused: invalid request",
+			nil,
+		},
+		{
+			"should fail to burn a paused marker",
+			types.NewMsgBurnRequest(s.user1Addr, sdk.NewCoin(hotdogDenom, sdk.NewInt(10))),
+			[]string{s.user1},
+			"cannot burn hotdog: marker is paused: invalid request",
+			nil,
+		},
+		{
+			"should fail to withdraw from a paused marker",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, hotdogDenom, sdk.NewCoins(sdk.NewInt64Coin(hotdogDenom, 10))),
+			[]string{s.user1},
+			"cannot withdraw hotdog: marker is paused: invalid request",
+			nil,
+		},
+	}
+	s.runTests(cases)
+
+	m, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, hotdogDenom)
+	s.Require().NoError(err)
+	s.Assert().True(m.IsPaused(), "marker paused")
+	s.Assert().Equal(types.StatusActive, m.GetStatus(), "marker status")
+	s.Assert().False(s.app.BankKeeper.IsSendEnabledCoin(s.ctx, sdk.NewInt64Coin(hotdogDenom, 1)), "send enabled while paused")
+
+	s.runTests([]CommonTest{
+		{
+			"should successfully resume marker",
+			types.NewMsgResumeMarkerRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerResumed(hotdogDenom, s.user1),
+		},
+		{
+			"should successfully withdraw from a resumed marker",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, hotdogDenom, sdk.NewCoins(sdk.NewInt64Coin(hotdogDenom, 10))),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerWithdraw(fmt.Sprintf("10%s", hotdogDenom), hotdogDenom, s.user1, s.user1),
+		},
+	})
+
+	m, err = s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, hotdogDenom)
+	s.Require().NoError(err)
+	s.Assert().False(m.IsPaused(), "marker paused")
+	s.Assert().True(s.app.BankKeeper.IsSendEnabledCoin(s.ctx, sdk.NewInt64Coin(hotdogDenom, 1)), "send enabled after resume")
+	s.Assert().Equal(int64(10), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, hotdogDenom).Amount.Int64(), "withdrawn balance")
+}
+
+func (s HandlerTestSuite) TestMsgSetDepositControlRequest() {
+	hotdogDenom := "hotdog"
+	access := types.AccessGrant{
+		Address:     s.user1,
+		Permissions: types.AccessListByNames("MINT,BURN,ADMIN,WITHDRAW"),
+	}
+
+	s.runTests([]CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, access),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to set deposit control without admin access",
+			types.NewMsgSetDepositControlRequest(hotdogDenom, true, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_ADMIN on hotdog markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should successfully restrict deposits",
+			types.NewMsgSetDepositControlRequest(hotdogDenom, true, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerDepositControl(hotdogDenom, true, s.user1),
+		},
+	})
+
+	m, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, hotdogDenom)
+	s.Require().NoError(err)
+	s.Assert().True(m.RestrictsDeposits(), "marker restricts deposits")
+	s.Assert().ErrorIs(s.app.MarkerKeeper.CheckDeposit(s.ctx, s.user1Addr, m.GetAddress()), types.ErrNoDepositGrant)
+	s.Assert().NoError(s.app.MarkerKeeper.CheckDeposit(s.ctx, s.user1Addr, s.user2Addr), "deposit to a non-marker account")
+
+	// the deposit is enforced when the coins are sent, without the ante handler
+	coins := sdk.NewCoins(sdk.NewInt64Coin("apple", 1))
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user1Addr, coins.Add(coins...)))
+	send := banktypes.NewMsgSend(s.user1Addr, m.GetAddress(), coins)
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(s.user1Addr, coins.Add(coins...))},
+		[]banktypes.Output{banktypes.NewOutput(s.user2Addr, coins), banktypes.NewOutput(m.GetAddress(), coins)},
+	)
+	_, err = s.app.MsgServiceRouter().Handler(send)(s.ctx, send)
+	s.Assert().ErrorIs(err, types.ErrNoDepositGrant, "bank send")
+	_, err = s.app.MsgServiceRouter().Handler(multiSend)(s.ctx, multiSend)
+	s.Assert().ErrorIs(err, types.ErrNoDepositGrant, "bank multi-send")
+
+	s.runTests([]CommonTest{
+		{
+			"setup grant deposit access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, types.AccessGrant{
+				Address:     s.user1,
+				Permissions: types.AccessListByNames("DEPOSIT"),
+			}),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+	})
+	s.Assert().NoError(s.app.MarkerKeeper.CheckDeposit(s.ctx, s.user1Addr, m.GetAddress()), "deposit with deposit access")
+	_, err = s.app.MsgServiceRouter().Handler(send)(s.ctx, send)
+	s.Assert().NoError(err, "bank send with deposit access")
+	s.Assert().Equal("1apple", s.app.BankKeeper.GetBalance(s.ctx, m.GetAddress(), "apple").String(), "deposited")
+	s.Assert().ErrorIs(s.app.MarkerKeeper.CheckDeposit(s.ctx, s.user2Addr, m.GetAddress()), types.ErrNoDepositGrant)
+
+	s.runTests([]CommonTest{
+		{
+			"should successfully lift the deposit restriction",
+			types.NewMsgSetDepositControlRequest(hotdogDenom, false, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerDepositControl(hotdogDenom, false, s.user1),
+		},
+	})
+	s.Assert().NoError(s.app.MarkerKeeper.CheckDeposit(s.ctx, s.user2Addr, m.GetAddress()), "deposit without restriction")
+}
+
+func (s HandlerTestSuite) TestMsgSetTransferMemoRequirementRequest() {
+	hotdogDenom := "hotdog"
+	restrictedDenom := "restrictedhotdog"
+	pattern := `TR-[0-9]{6}`
+
+	s.runTests([]CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup new restricted marker for test",
+			types.NewMsgAddMarkerRequest(restrictedDenom, sdk.NewInt(100), s.user1Addr, s.user1Addr, types.MarkerType_RestrictedCoin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(restrictedDenom, s.user1Addr, types.AccessGrant{
+				Address:     s.user1,
+				Permissions: types.AccessListByNames("MINT,BURN,ADMIN,WITHDRAW,TRANSFER"),
+			}),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to require a memo for a coin that is not restricted",
+			types.NewMsgSetTransferMemoRequirementRequest(hotdogDenom, pattern, s.user1Addr),
+			[]string{s.user1},
+			"cannot require a transfer memo for hotdog: marker type is not restricted_coin: invalid request",
+			nil,
+		},
+		{
+			"should fail to set an invalid pattern",
+			types.NewMsgSetTransferMemoRequirementRequest(restrictedDenom, `TR-[0-9`, s.user1Addr),
+			[]string{s.user1},
+			"invalid set transfer memo requirement request: invalid transfer memo pattern \"TR-[0-9\": error parsing regexp: missing closing ]: `[0-9`: invalid request",
+			nil,
+		},
+		{
+			"should fail to set a memo requirement without admin access",
+			types.NewMsgSetTransferMemoRequirementRequest(restrictedDenom, pattern, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_ADMIN on restrictedhotdog markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should successfully require a transfer memo",
+			types.NewMsgSetTransferMemoRequirementRequest(restrictedDenom, pattern, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerTransferMemoRequirement(restrictedDenom, pattern, s.user1),
+		},
+	})
+
+	m, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, restrictedDenom)
+	s.Require().NoError(err)
+	s.Assert().Equal(pattern, m.GetTransferMemoPattern())
+	s.Assert().NoError(s.app.MarkerKeeper.CheckTransferMemo(s.ctx, restrictedDenom, "TR-000001"))
+	s.Assert().ErrorIs(s.app.MarkerKeeper.CheckTransferMemo(s.ctx, restrictedDenom, "TR-1"), types.ErrTransferMemoRequired)
+	s.Assert().NoError(s.app.MarkerKeeper.CheckTransferMemo(s.ctx, hotdogDenom, ""), "coin without a memo requirement")
+
+	// transfers check the memo of the tx recorded on the context by the ante handler
+	transfer := types.NewMsgTransferRequest(s.user1Addr, s.user1Addr, s.user2Addr, sdk.NewInt64Coin(restrictedDenom, 1))
+	s.runTests([]CommonTest{
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(restrictedDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(restrictedDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup withdraw coin to the administrator",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, restrictedDenom, sdk.NewCoins(sdk.NewInt64Coin(restrictedDenom, 10))),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to transfer without the required memo",
+			transfer,
+			[]string{s.user1},
+			`transfers of restrictedhotdog require a memo matching "TR-[0-9]{6}", got "": transfer memo does not match the marker's memo requirement`,
+			nil,
+		},
+	})
+	s.ctx = types.WithTxMemo(s.ctx, "TR-000001")
+	s.runTests([]CommonTest{
+		{
+			"should successfully transfer with the required memo",
+			transfer,
+			[]string{s.user1},
+			"",
+			nil,
+		},
+	})
+	s.Assert().Equal("1restrictedhotdog", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, restrictedDenom).String())
+
+	res, err := s.app.MarkerKeeper.TransferMemoRequirement(sdk.WrapSDKContext(s.ctx),
+		&types.QueryTransferMemoRequirementRequest{Id: restrictedDenom})
+	s.Require().NoError(err)
+	s.Assert().Equal(&types.QueryTransferMemoRequirementResponse{
+		Denom: restrictedDenom, Required: true, TransferMemoPattern: pattern,
+	}, res)
+
+	s.runTests([]CommonTest{
+		{
+			"should successfully remove the transfer memo requirement",
+			types.NewMsgSetTransferMemoRequirementRequest(restrictedDenom, "", s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerTransferMemoRequirement(restrictedDenom, "", s.user1),
+		},
+	})
+	s.Assert().NoError(s.app.MarkerKeeper.CheckTransferMemo(s.ctx, restrictedDenom, ""), "memo requirement removed")
+}
+
+func (s HandlerTestSuite) TestMsgWithdrawApproval() {
+	hotdogDenom := "hotdog"
+	threshold := sdk.NewCoins(sdk.NewInt64Coin(hotdogDenom, 100))
+	largeWithdrawal := sdk.NewCoins(sdk.NewInt64Coin(hotdogDenom, 500))
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+
+	s.runTests([]CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(1000), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, types.AccessGrant{
+				Address:     s.user1,
+				Permissions: types.AccessListByNames("MINT,ADMIN,WITHDRAW"),
+			}),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant admin access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, types.AccessGrant{
+				Address:     s.user2,
+				Permissions: types.AccessListByNames("ADMIN"),
+			}),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to set a policy without approvals",
+			types.NewMsgSetWithdrawApprovalPolicyRequest(hotdogDenom, threshold, 0, time.Hour, s.user1Addr),
+			[]string{s.user1},
+			"invalid set withdraw approval policy request: approvals required must be between 1 and 10: invalid request",
+			nil,
+		},
+		{
+			"should successfully set a withdraw approval policy",
+			types.NewMsgSetWithdrawApprovalPolicyRequest(hotdogDenom, threshold, 1, time.Hour, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerWithdrawApprovalPolicy(hotdogDenom, types.NewWithdrawApprovalPolicy(threshold, 1, time.Hour), s.user1),
+		},
+		{
+			"should withdraw at the threshold without approval",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, hotdogDenom, threshold),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerWithdraw(threshold.String(), hotdogDenom, s.user1, s.user1),
+		},
+		{
+			"should hold a withdrawal above the threshold for approval",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, hotdogDenom, largeWithdrawal),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerWithdrawPending(types.NewPendingWithdrawal(1, hotdogDenom, largeWithdrawal, s.user1Addr, s.user1Addr, 1, expiration)),
+		},
+	})
+	s.Assert().Equal(int64(100), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, hotdogDenom).Amount.Int64(),
+		"the pending withdrawal has not been made")
+
+	res, err := s.app.MarkerKeeper.PendingWithdrawals(sdk.WrapSDKContext(s.ctx), &types.QueryPendingWithdrawalsRequest{Id: hotdogDenom})
+	s.Require().NoError(err)
+	s.Assert().Equal([]types.PendingWithdrawal{
+		types.NewPendingWithdrawal(1, hotdogDenom, largeWithdrawal, s.user1Addr, s.user1Addr, 1, expiration),
+	}, res.Withdrawals)
+
+	approved := types.NewPendingWithdrawal(1, hotdogDenom, largeWithdrawal, s.user1Addr, s.user1Addr, 1, expiration)
+	approved.Approvals = []string{s.user2}
+	s.runTests([]CommonTest{
+		{
+			"should fail to approve a withdrawal by its requester",
+			types.NewMsgApproveWithdrawRequest(1, s.user1Addr),
+			[]string{s.user1},
+			"pending withdrawal 1 cannot be approved by the administrator that requested it: invalid request",
+			nil,
+		},
+		{
+			"should fail to approve an unknown withdrawal",
+			types.NewMsgApproveWithdrawRequest(2, s.user2Addr),
+			[]string{s.user2},
+			"pending withdrawal 2 not found: invalid request",
+			nil,
+		},
+		{
+			"should make the withdrawal once approved",
+			types.NewMsgApproveWithdrawRequest(1, s.user2Addr),
+			[]string{s.user2},
+			"",
+			types.NewEventMarkerWithdrawApproved(approved, s.user2, true),
+		},
+		{
+			"should fail to approve a withdrawal that has been made",
+			types.NewMsgApproveWithdrawRequest(1, s.user2Addr),
+			[]string{s.user2},
+			"pending withdrawal 1 not found: invalid request",
+			nil,
+		},
+		{
+			"should hold another withdrawal above the threshold for approval",
+			types.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, hotdogDenom, largeWithdrawal),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+	})
+	s.Assert().Equal(int64(600), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, hotdogDenom).Amount.Int64(),
+		"the approved withdrawal has been made")
+
+	marker.BeginBlocker(s.ctx.WithBlockTime(expiration), abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	_, found := s.app.MarkerKeeper.GetPendingWithdrawal(s.ctx, 2)
+	s.Assert().True(found, "withdrawal kept until after its expiration")
+
+	expiredCtx := s.ctx.WithBlockTime(expiration.Add(time.Second))
+	marker.BeginBlocker(expiredCtx, abci.RequestBeginBlock{}, s.app.MarkerKeeper, s.app.BankKeeper)
+	_, found = s.app.MarkerKeeper.GetPendingWithdrawal(expiredCtx, 2)
+	s.Assert().False(found, "expired withdrawal removed")
+	iterator := sdk.KVStorePrefixIterator(expiredCtx.KVStore(s.app.GetKey(types.StoreKey)), types.PendingWithdrawalExpirationKeyPrefix)
+	s.Assert().False(iterator.Valid(), "expiration index emptied")
+	iterator.Close()
+	s.Assert().Equal(int64(600), s.app.BankKeeper.GetBalance(expiredCtx, s.user1Addr, hotdogDenom).Amount.Int64(),
+		"the expired withdrawal was not made")
+}
+
+func (s HandlerTestSuite) TestMsgFaucet() {
+	hotdogDenom := "hotdog"
+	drip := sdk.NewInt(10)
+	faucet := types.NewMarkerFaucet(hotdogDenom, s.user1Addr, drip, time.Hour, false)
+	next := s.ctx.BlockTime().Add(time.Hour)
+
+	s.runTests([]CommonTest{
+		{
+			"setup new marker for test",
+			types.NewMsgAddMarkerRequest(hotdogDenom, sdk.NewInt(1000), s.user1Addr, s.user1Addr, types.MarkerType_Coin, true, true),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup grant access to marker",
+			types.NewMsgAddAccessRequest(hotdogDenom, s.user1Addr, types.AccessGrant{
+				Address:     s.user1,
+				Permissions: types.AccessListByNames("MINT,ADMIN,WITHDRAW"),
+			}),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup finalize marker",
+			types.NewMsgFinalizeRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"setup activate marker",
+			types.NewMsgActivateRequest(hotdogDenom, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should fail to set a faucet while faucets are disabled",
+			types.NewMsgSetFaucetRequest(hotdogDenom, drip, time.Hour, false, s.user1Addr),
+			[]string{s.user1},
+			"marker faucets are disabled: invalid request",
+			nil,
+		},
+	})
+
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.EnableFaucet = true
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+
+	s.runTests([]CommonTest{
+		{
+			"should fail to set a faucet without admin access",
+			types.NewMsgSetFaucetRequest(hotdogDenom, drip, time.Hour, false, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s does not have ACCESS_ADMIN on hotdog markeraccount: invalid request", s.user2),
+			nil,
+		},
+		{
+			"should fail to claim before the faucet is set",
+			types.NewMsgClaimFaucetRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			"no faucet found for hotdog: invalid request",
+			nil,
+		},
+		{
+			"should successfully set a faucet",
+			types.NewMsgSetFaucetRequest(hotdogDenom, drip, time.Hour, false, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerFaucet(hotdogDenom, faucet, s.user1),
+		},
+		{
+			"should successfully claim from the faucet",
+			types.NewMsgClaimFaucetRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			"",
+			types.NewEventMarkerFaucetClaim(faucet.Drip(), s.user2, next),
+		},
+		{
+			"should fail to claim again within the period",
+			types.NewMsgClaimFaucetRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			fmt.Sprintf("%s cannot claim from the hotdog faucet again until %s: invalid request", s.user2,
+				next.UTC().Format(time.RFC3339)),
+			nil,
+		},
+	})
+	s.Assert().Equal(int64(10), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, hotdogDenom).Amount.Int64(),
+		"one drip claimed")
+
+	res, err := s.app.MarkerKeeper.Faucet(sdk.WrapSDKContext(s.ctx), &types.QueryFaucetRequest{Id: hotdogDenom, Address: s.user2})
+	s.Require().NoError(err)
+	s.Assert().Equal(*faucet, res.Faucet)
+	s.Assert().True(res.Enabled)
+	s.Require().NotNil(res.NextClaimTime)
+	s.Assert().Equal(next, *res.NextClaimTime)
+
+	s.ctx = s.ctx.WithBlockTime(next)
+	s.runTests([]CommonTest{
+		{
+			"should successfully set a minting faucet",
+			types.NewMsgSetFaucetRequest(hotdogDenom, drip, time.Hour, true, s.user1Addr),
+			[]string{s.user1},
+			"",
+			nil,
+		},
+		{
+			"should successfully claim again after the period",
+			types.NewMsgClaimFaucetRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			"",
+			nil,
+		},
+	})
+	s.Assert().Equal(int64(20), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, hotdogDenom).Amount.Int64(),
+		"two drips claimed")
+	s.Assert().Equal(int64(1010), s.app.BankKeeper.GetSupply(s.ctx, hotdogDenom).Amount.Int64(),
+		"the second drip was minted")
+
+	s.runTests([]CommonTest{
+		{
+			"should successfully remove the faucet",
+			types.NewMsgSetFaucetRequest(hotdogDenom, sdk.ZeroInt(), 0, false, s.user1Addr),
+			[]string{s.user1},
+			"",
+			types.NewEventMarkerFaucet(hotdogDenom, nil, s.user1),
+		},
+		{
+			"should fail to claim from a removed faucet",
+			types.NewMsgClaimFaucetRequest(hotdogDenom, s.user2Addr),
+			[]string{s.user2},
+			"no faucet found for hotdog: invalid request",
+			nil,
+		},
+	})
+	s.Assert().Empty(s.app.MarkerKeeper.GetFaucetClaims(s.ctx), "claims removed with the faucet")
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// updateAccessIndex replaces the index entries of the stored marker with the given address by those of the marker's
+// access list, the index is how the markers an address has been granted access to are found.  A nil marker only
+// removes the entries.
+func (k Keeper) updateAccessIndex(ctx sdk.Context, addr sdk.AccAddress, marker types.MarkerAccountI) {
+	store := ctx.KVStore(k.storeKey)
+	if previous, ok := k.authKeeper.GetAccount(ctx, addr).(types.MarkerAccountI); ok {
+		for _, grant := range previous.GetAccessList() {
+			store.Delete(types.AccessGranteeKey(grant.GetAddress(), addr))
+		}
+	}
+	if marker == nil {
+		return
+	}
+	for _, grant := range marker.GetAccessList() {
+		store.Set(types.AccessGranteeKey(grant.GetAddress(), addr), []byte(marker.GetDenom()))
+	}
+}
+
+// GetAccountPermissions returns the permissions the address has on the marker with the given address, or nil if the
+// address has not been granted access to it.
+func (k Keeper) GetAccountPermissions(ctx sdk.Context, grantee, markerAddr sdk.AccAddress) *types.MarkerPermissions {
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil || marker == nil {
+		return nil
+	}
+	for _, grant := range marker.GetAccessList() {
+		if grant.GetAddress().Equals(grantee) {
+			return &types.MarkerPermissions{
+				Denom:         marker.GetDenom(),
+				MarkerAddress: markerAddr.String(),
+				Permissions:   grant.GetAccessList(),
+				Status:        marker.GetStatus(),
+			}
+		}
+	}
+	return nil
+}
+
+// RebuildAccessIndex discards the index of the markers each address has been granted access to and builds it again
+// from the access list of every marker.
+func (k Keeper) RebuildAccessIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AccessGranteeKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.IterateMarkers(ctx, func(m types.MarkerAccountI) bool {
+		for _, grant := range m.GetAccessList() {
+			store.Set(types.AccessGranteeKey(grant.GetAddress(), m.GetAddress()), []byte(m.GetDenom()))
+		}
+		return false
+	})
+}
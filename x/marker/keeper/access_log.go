@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// accessChangesPrunedPerBlock is the number of access changes outside of the access log window removed at the start
+// of each block, the rest are removed in following blocks.
+const accessChangesPrunedPerBlock = 1000
+
+// recordAccessChange adds a grant or revocation of the permissions of the address to the access log of the marker
+// and emits the entry as an event.
+func (k Keeper) recordAccessChange(
+	ctx sdk.Context, denom string, actor, addr sdk.AccAddress, permissions types.AccessList,
+	action types.AccessChangeAction,
+) error {
+	if k.GetAccessLogWindow(ctx) == 0 {
+		return nil
+	}
+	change := types.NewAccessChange(k.nextAccessChangeID(ctx), denom, ctx.BlockHeight(), ctx.BlockTime(), actor,
+		addr, permissions, action)
+	k.setAccessChange(ctx, change)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAccessChange(change))
+}
+
+// GetAccessChanges returns the access changes of the marker with the given address within the access log window, in
+// the order they were made.
+func (k Keeper) GetAccessChanges(ctx sdk.Context, addr sdk.AccAddress) []types.AccessChange {
+	return k.getAccessChanges(ctx, types.AccessChangesKeyPrefix(addr))
+}
+
+// GetAllAccessChanges returns the access changes of all markers within the access log window.
+func (k Keeper) GetAllAccessChanges(ctx sdk.Context) []types.AccessChange {
+	return k.getAccessChanges(ctx, types.AccessChangeKeyPrefix)
+}
+
+func (k Keeper) getAccessChanges(ctx sdk.Context, prefix []byte) []types.AccessChange {
+	var changes []types.AccessChange
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.AccessChange
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// PruneAccessLog removes the access changes made before the access log window, up to a fixed number of changes each
+// block.  All of the changes are removed once the window is set to zero.
+func (k Keeper) PruneAccessLog(ctx sdk.Context) {
+	window := k.GetAccessLogWindow(ctx)
+	if window >= uint64(ctx.BlockHeight()) {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(window)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AccessChangeHeightKeyPrefix)
+	defer iterator.Close()
+	var pruned [][]byte
+	for ; iterator.Valid() && len(pruned) < accessChangesPrunedPerBlock; iterator.Next() {
+		height, _, _ := types.SplitAccessChangeHeightKey(iterator.Key())
+		if height > cutoff {
+			break
+		}
+		pruned = append(pruned, iterator.Key())
+	}
+	for _, key := range pruned {
+		_, addr, id := types.SplitAccessChangeHeightKey(key)
+		store.Delete(types.AccessChangeKey(addr, id))
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setAccessChange(ctx sdk.Context, c types.AccessChange) {
+	store := ctx.KVStore(k.storeKey)
+	addr := types.MustGetMarkerAddress(c.Denom)
+	store.Set(types.AccessChangeKey(addr, c.Id), k.cdc.MustMarshal(&c))
+	store.Set(types.AccessChangeHeightKey(c.Height, addr, c.Id), []byte{})
+}
+
+// nextAccessChangeID returns the id to use for a new access change and increments it.
+func (k Keeper) nextAccessChangeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextAccessChangeIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextAccessChangeIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextAccessChangeID sets the id to use for the next access change.
+func (k Keeper) setNextAccessChangeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextAccessChangeIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetBasket returns the reserve composition of the basket marker with the given denom.
+func (k Keeper) GetBasket(ctx sdk.Context, denom string) (types.Basket, bool) {
+	var basket types.Basket
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return basket, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.BasketStoreKey(addr))
+	if len(bz) == 0 {
+		return basket, false
+	}
+	k.cdc.MustUnmarshal(bz, &basket)
+	return basket, true
+}
+
+// SetBasket stores the reserve composition of a basket marker.
+func (k Keeper) SetBasket(ctx sdk.Context, basket types.Basket) error {
+	if err := basket.Validate(); err != nil {
+		return err
+	}
+	addr := types.MustGetMarkerAddress(basket.Denom)
+	ctx.KVStore(k.storeKey).Set(types.BasketStoreKey(addr), k.cdc.MustMarshal(&basket))
+	return nil
+}
+
+// addBasket stores the reserve composition of a new basket marker after ensuring none of the reserve denoms are
+// restricted markers, as holding them in reserve would allow them to move without a transfer grant.
+func (k Keeper) addBasket(ctx sdk.Context, basket types.Basket) error {
+	for _, coin := range basket.ReservePerUnit {
+		if m, err := k.GetMarkerByDenom(ctx, coin.Denom); err == nil && m.GetMarkerType() == types.MarkerType_RestrictedCoin {
+			return fmt.Errorf("restricted marker %s can not be held in the reserve of basket %s", coin.Denom, basket.Denom)
+		}
+	}
+	return k.SetBasket(ctx, basket)
+}
+
+// IterateBaskets iterates the reserve composition of all basket markers with the given handler function.
+func (k Keeper) IterateBaskets(ctx sdk.Context, cb func(basket types.Basket) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BasketStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var basket types.Basket
+		k.cdc.MustUnmarshal(iterator.Value(), &basket)
+		if cb(basket) {
+			break
+		}
+	}
+}
+
+// DepositAndMint moves the reserve coins for the amount of basket coin from the depositor into the basket marker's
+// escrow, then mints the basket coin and sends it to the depositor.
+func (k Keeper) DepositAndMint(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "deposit_and_mint")
+
+	m, basket, err := k.getActiveBasket(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	reserve := basket.ReserveFor(coin.Amount)
+	if err = k.bankKeeper.SendCoins(ctx, from, m.GetAddress(), reserve); err != nil {
+		return sdkerrors.Wrapf(err, "could not deposit reserve %s for %s", reserve, coin)
+	}
+	if err = k.IncreaseSupply(ctx, m, coin, from); err != nil {
+		return err
+	}
+	if err = k.bankKeeper.SendCoins(ctx, m.GetAddress(), from, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	k.updateMarkerTotals(ctx, m.GetAddress())
+
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerBasketDeposit(coin.Amount.String(), coin.Denom, reserve.String(), from.String()),
+	)
+}
+
+// BurnAndRedeem moves the amount of basket coin from the redeemer into the basket marker and burns it, then returns
+// the reserve coins held for that amount to the redeemer.
+func (k Keeper) BurnAndRedeem(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "burn_and_redeem")
+
+	m, basket, err := k.getActiveBasket(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if err = k.bankKeeper.SendCoins(ctx, from, m.GetAddress(), sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "could not redeem %s", coin)
+	}
+	if err = k.DecreaseSupply(ctx, m, coin, from); err != nil {
+		return err
+	}
+	reserve := basket.ReserveFor(coin.Amount)
+	if err = k.bankKeeper.SendCoins(ctx, m.GetAddress(), from, reserve); err != nil {
+		return sdkerrors.Wrapf(err, "could not return reserve %s for %s", reserve, coin)
+	}
+	k.updateMarkerTotals(ctx, m.GetAddress())
+
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerBasketRedeem(coin.Amount.String(), coin.Denom, reserve.String(), from.String()),
+	)
+}
+
+// getActiveBasket returns the active basket marker with the given denom and its reserve composition.
+func (k Keeper) getActiveBasket(ctx sdk.Context, denom string) (types.MarkerAccountI, types.Basket, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, types.Basket{}, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_Basket {
+		return nil, types.Basket{}, fmt.Errorf("marker %s is not a basket marker", denom)
+	}
+	if m.GetStatus() != types.StatusActive {
+		return nil, types.Basket{}, fmt.Errorf("basket marker %s is not in Active status", denom)
+	}
+	basket, found := k.GetBasket(ctx, denom)
+	if !found {
+		return nil, types.Basket{}, fmt.Errorf("no reserve defined for basket marker %s", denom)
+	}
+	return m, basket, nil
+}
@@ -449,7 +449,9 @@ func (s *DenomTestSuite) TestValidateDenomMetadataExtended() {
 
 	for _, tc := range tests {
 		s.T().Run(tc.name, func(t *testing.T) {
-			s.app.MarkerKeeper.SetParams(s.ctx, types.Params{UnrestrictedDenomRegex: tc.denomValidationExpression})
+			params := types.DefaultParams()
+			params.UnrestrictedDenomRegex = tc.denomValidationExpression
+			s.app.MarkerKeeper.SetParams(s.ctx, params)
 
 			err := s.app.MarkerKeeper.ValidateDenomMetadata(s.ctx, tc.proposed, tc.existing, tc.markerStatus)
 			if len(tc.wantInErr) > 0 {
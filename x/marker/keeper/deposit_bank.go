@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DepositControlBankKeeper wraps the bank keeper given to the modules that send coins between accounts on behalf of
+// their users (e.g. the bank msg server, ibc transfers and wasm contracts) so a send to a marker account that restricts
+// deposits fails when the sender does not hold the deposit access on the marker.  Sends from module accounts (e.g.
+// rewards and refunds) are not checked.
+type DepositControlBankKeeper struct {
+	bankkeeper.Keeper
+	markerKeeper *Keeper
+}
+
+var _ bankkeeper.Keeper = DepositControlBankKeeper{}
+
+// NewDepositControlBankKeeper creates a new DepositControlBankKeeper.  The marker keeper is passed by reference so the
+// wrapper can be created before the marker keeper that depends on the modules receiving it.
+func NewDepositControlBankKeeper(bankKeeper bankkeeper.Keeper, markerKeeper *Keeper) DepositControlBankKeeper {
+	return DepositControlBankKeeper{Keeper: bankKeeper, markerKeeper: markerKeeper}
+}
+
+// SendCoins checks the deposit into the recipient before sending the coins.
+func (k DepositControlBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.markerKeeper.CheckDeposit(ctx, fromAddr, toAddr); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins checks the deposits of every input into every output before sending the coins.
+func (k DepositControlBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, output := range outputs {
+		to, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		for _, input := range inputs {
+			from, err := sdk.AccAddressFromBech32(input.Address)
+			if err != nil {
+				return err
+			}
+			if err = k.markerKeeper.CheckDeposit(ctx, from, to); err != nil {
+				return err
+			}
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
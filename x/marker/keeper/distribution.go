@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// DistributeEscrow sets aside coins from the escrow of a marker to be paid to the current holders of the marker denom
+// pro-rata to their holdings.  The coins are held by the marker module account until the holders are paid at the end
+// of this and following blocks, see PayEscrowDistributions.
+func (k Keeper) DistributeEscrow(ctx sdk.Context, caller sdk.AccAddress, denom string, amount sdk.Coins) (uint64, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "distribute_escrow")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return 0, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Withdraw) {
+		return 0, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot distribute escrow of a marker that is not in Active status")
+	}
+	if !amount.AmountOf(denom).IsZero() {
+		return 0, fmt.Errorf("cannot distribute %s to its own holders", denom)
+	}
+	// the reserve of a basket can only leave escrow through redemptions
+	if basket, found := k.GetBasket(ctx, m.GetDenom()); found {
+		for _, coin := range amount {
+			if !basket.ReservePerUnit.AmountOf(coin.Denom).IsZero() {
+				return 0, fmt.Errorf("cannot distribute %s reserve from basket marker %s", coin.Denom, m.GetDenom())
+			}
+		}
+	}
+
+	var holders []types.Balance
+	totalHeld := sdk.ZeroInt()
+	for _, balance := range k.GetAllMarkerHolders(ctx, denom) {
+		// coins held in the marker's own escrow are not paid
+		if balance.Address == m.GetAddress().String() {
+			continue
+		}
+		holders = append(holders, balance)
+		totalHeld = totalHeld.Add(balance.Coins.AmountOf(denom))
+	}
+	if len(holders) == 0 {
+		return 0, fmt.Errorf("marker %s has no holders to distribute to", denom)
+	}
+	if max := k.GetMaxDistributionHolders(ctx); len(holders) > int(max) {
+		return 0, fmt.Errorf("marker %s has %d holders, more than the %d an escrow distribution may pay", denom, len(holders), max)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, m.GetAddress(), types.CoinPoolName, amount); err != nil {
+		return 0, sdkerrors.Wrapf(err, "could not distribute %s from %s escrow", amount, denom)
+	}
+	k.updateMarkerTotals(ctx, m.GetAddress())
+
+	id := k.nextDistributionID(ctx)
+	k.setEscrowDistribution(ctx, types.NewEscrowDistribution(id, denom, caller, amount, totalHeld))
+	for _, balance := range holders {
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return 0, err
+		}
+		k.setDistributionHolder(ctx, types.NewDistributionHolder(id, addr, balance.Coins.AmountOf(denom)))
+	}
+
+	return id, ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerDistribute(id, amount.String(), denom, caller.String(), len(holders)),
+	)
+}
+
+// PayEscrowDistributions pays the holders of the escrow distributions in the order they were requested, up to the
+// distribution holders per block param.  Once every holder of a distribution has been paid, the coins left over from
+// rounding (or from payments that failed) are returned to the escrow of the marker.
+func (k Keeper) PayEscrowDistributions(ctx sdk.Context) {
+	budget := int(k.GetDistributionHoldersPerBlock(ctx))
+	for budget > 0 {
+		// Only the oldest distribution is read, a completed distribution is removed so the next one is read after it.
+		d, found := k.nextEscrowDistribution(ctx)
+		if !found {
+			return
+		}
+		holders := k.GetDistributionHolders(ctx, d.Id, budget)
+		budget -= len(holders)
+		for _, holder := range holders {
+			k.payDistributionHolder(ctx, &d, holder)
+		}
+		if len(k.GetDistributionHolders(ctx, d.Id, 1)) > 0 {
+			k.setEscrowDistribution(ctx, d)
+			return
+		}
+		k.completeEscrowDistribution(ctx, d)
+	}
+}
+
+// payDistributionHolder sends the holder's share of the distribution and removes the holder from the distribution.
+// A payment that fails (e.g. to an address that is blocked from receiving coins) is skipped.
+func (k Keeper) payDistributionHolder(ctx sdk.Context, d *types.EscrowDistribution, holder types.DistributionHolder) {
+	addr, err := sdk.AccAddressFromBech32(holder.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.DistributionHolderKey(d.Id, addr))
+
+	payout := d.PayoutFor(holder.Amount)
+	if payout.Empty() {
+		return
+	}
+	payCtx, writeCache := ctx.CacheContext()
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(payCtx, types.CoinPoolName, addr, payout); err != nil {
+		k.Logger(ctx).Error("unable to pay escrow distribution holder",
+			"distribution", d.Id, "denom", d.Denom, "address", holder.Address, "err", err)
+		return
+	}
+	writeCache()
+	d.Remaining = d.Remaining.Sub(payout)
+	k.updateMarkerTotals(ctx, addr)
+}
+
+// completeEscrowDistribution returns the remaining coins of a distribution to the escrow of the marker and removes it.
+func (k Keeper) completeEscrowDistribution(ctx sdk.Context, d types.EscrowDistribution) {
+	markerAddr := types.MustGetMarkerAddress(d.Denom)
+	if !d.Remaining.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, markerAddr, d.Remaining); err != nil {
+			panic(err)
+		}
+		k.updateMarkerTotals(ctx, markerAddr)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.EscrowDistributionKey(d.Id))
+
+	err := ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerDistributionComplete(d.Id, d.Denom, d.Amount.Sub(d.Remaining).String(), d.Remaining.String()),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetEscrowDistribution returns the escrow distribution with the given id.
+func (k Keeper) GetEscrowDistribution(ctx sdk.Context, id uint64) (types.EscrowDistribution, bool) {
+	var d types.EscrowDistribution
+	bz := ctx.KVStore(k.storeKey).Get(types.EscrowDistributionKey(id))
+	if len(bz) == 0 {
+		return d, false
+	}
+	k.cdc.MustUnmarshal(bz, &d)
+	return d, true
+}
+
+// GetEscrowDistributions returns the escrow distributions that have not yet paid all of their holders, in id order.
+func (k Keeper) GetEscrowDistributions(ctx sdk.Context) []types.EscrowDistribution {
+	var distributions []types.EscrowDistribution
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EscrowDistributionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var d types.EscrowDistribution
+		k.cdc.MustUnmarshal(iterator.Value(), &d)
+		distributions = append(distributions, d)
+	}
+	return distributions
+}
+
+// nextEscrowDistribution returns the oldest escrow distribution that has not yet paid all of its holders.
+func (k Keeper) nextEscrowDistribution(ctx sdk.Context) (types.EscrowDistribution, bool) {
+	var d types.EscrowDistribution
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EscrowDistributionKeyPrefix)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return d, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &d)
+	return d, true
+}
+
+// GetDistributionHolders returns up to limit holders not yet paid by the escrow distribution, all when limit is zero.
+func (k Keeper) GetDistributionHolders(ctx sdk.Context, id uint64, limit int) []types.DistributionHolder {
+	var holders []types.DistributionHolder
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionHoldersKeyPrefix(id))
+	defer iterator.Close()
+	for ; iterator.Valid() && (limit <= 0 || len(holders) < limit); iterator.Next() {
+		var h types.DistributionHolder
+		k.cdc.MustUnmarshal(iterator.Value(), &h)
+		holders = append(holders, h)
+	}
+	return holders
+}
+
+func (k Keeper) setEscrowDistribution(ctx sdk.Context, d types.EscrowDistribution) {
+	ctx.KVStore(k.storeKey).Set(types.EscrowDistributionKey(d.Id), k.cdc.MustMarshal(&d))
+}
+
+func (k Keeper) setDistributionHolder(ctx sdk.Context, h types.DistributionHolder) {
+	addr, err := sdk.AccAddressFromBech32(h.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.DistributionHolderKey(h.DistributionId, addr), k.cdc.MustMarshal(&h))
+}
+
+// nextDistributionID returns the id to use for a new escrow distribution and increments it.
+func (k Keeper) nextDistributionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextDistributionIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextDistributionIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextDistributionID sets the id to use for the next escrow distribution.
+func (k Keeper) setNextDistributionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextDistributionIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// AddEmissionSchedule schedules the emission of the amount of marker coin every interval of blocks to the recipient,
+// the escrow of the marker when the recipient is empty.  The first emission is made at the start of the block at the
+// start height, or the next block when the start height is zero.
+func (k Keeper) AddEmissionSchedule(
+	ctx sdk.Context, caller sdk.AccAddress, recipient string, amount sdk.Coin, interval uint64, emissions uint64, startHeight int64,
+) (uint64, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "add_emission_schedule")
+
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err != nil {
+		return 0, fmt.Errorf("marker not found for %s: %s", amount.Denom, err)
+	}
+	if m.GetMarkerType() == types.MarkerType_Basket {
+		return 0, fmt.Errorf("cannot emit coin for basket marker %s, supply only changes with reserve deposits and redemptions", m.GetDenom())
+	}
+	if !m.AddressHasAccess(caller, types.Access_Mint) {
+		return 0, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return 0, fmt.Errorf("cannot schedule emissions for a marker in %s status", m.GetStatus())
+	}
+	maxPerBlock := k.GetMaxEmissionPerBlock(ctx)
+	if maxPerBlock == 0 {
+		return 0, fmt.Errorf("emission schedules are disabled")
+	}
+	if max := sdk.NewIntFromUint64(maxPerBlock).Mul(sdk.NewIntFromUint64(interval)); amount.Amount.GT(max) {
+		return 0, fmt.Errorf("emission of %s every %d blocks exceeds the maximum of %d per block", amount, interval, maxPerBlock)
+	}
+	if id, found := k.getEmissionScheduleIDForDenom(ctx, m.GetDenom()); found {
+		return 0, fmt.Errorf("marker %s already has emission schedule %d", m.GetDenom(), id)
+	}
+	if startHeight <= ctx.BlockHeight() {
+		if startHeight != 0 {
+			return 0, fmt.Errorf("start height %d must be after the current height %d", startHeight, ctx.BlockHeight())
+		}
+		startHeight = ctx.BlockHeight() + 1
+	}
+
+	id := k.nextEmissionScheduleID(ctx)
+	schedule := types.NewEmissionSchedule(id, caller, recipient, amount, interval, emissions, startHeight)
+	if err = schedule.Validate(); err != nil {
+		return 0, err
+	}
+	k.setEmissionSchedule(ctx, schedule)
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerEmissionScheduleAdd(schedule))
+}
+
+// CancelEmissionSchedule removes an emission schedule before its remaining emissions are made.
+func (k Keeper) CancelEmissionSchedule(ctx sdk.Context, caller sdk.AccAddress, id uint64) error {
+	schedule, found := k.GetEmissionSchedule(ctx, id)
+	if !found {
+		return fmt.Errorf("emission schedule %d not found", id)
+	}
+	m, err := k.GetMarkerByDenom(ctx, schedule.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", schedule.Denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Mint) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
+	}
+	k.deleteEmissionSchedule(ctx, schedule)
+
+	return ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerEmissionScheduleCancel(id, schedule.Denom, schedule.RemainingEmissions, caller.String()),
+	)
+}
+
+// ProcessEmissionSchedules makes the emissions due at the current height.  Emissions are only made while the marker is
+// active, an emission due while the marker is in any other status is deferred to the next interval.  Schedules of
+// markers that no longer exist are removed.
+func (k Keeper) ProcessEmissionSchedules(ctx sdk.Context) {
+	maxPerBlock := k.GetMaxEmissionPerBlock(ctx)
+	for _, schedule := range k.getDueEmissionSchedules(ctx) {
+		m, err := k.GetMarkerByDenom(ctx, schedule.Denom)
+		if err != nil {
+			k.deleteEmissionSchedule(ctx, schedule)
+			continue
+		}
+		next := schedule
+		next.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+		if m.GetStatus() != types.StatusActive {
+			k.updateEmissionSchedule(ctx, schedule, next)
+			continue
+		}
+		next.RemainingEmissions--
+		k.emit(ctx, m, next, next.EmissionFor(maxPerBlock))
+		if next.RemainingEmissions == 0 {
+			k.deleteEmissionSchedule(ctx, schedule)
+		} else {
+			k.updateEmissionSchedule(ctx, schedule, next)
+		}
+	}
+}
+
+// getDueEmissionSchedules returns the emission schedules with an emission due at or before the current height, in the
+// order they are due.
+func (k Keeper) getDueEmissionSchedules(ctx sdk.Context) []types.EmissionSchedule {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(append(append([]byte{}, types.EmissionScheduleHeightKeyPrefix...),
+		sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...))
+	iterator := store.Iterator(types.EmissionScheduleHeightKeyPrefix, end)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.SplitEmissionScheduleHeightKey(iterator.Key())
+		ids = append(ids, id)
+	}
+	iterator.Close()
+	schedules := make([]types.EmissionSchedule, 0, len(ids))
+	for _, id := range ids {
+		if schedule, found := k.GetEmissionSchedule(ctx, id); found {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules
+}
+
+// emit mints the coin and sends it to the recipient of the schedule.  An emission that fails (e.g. one that would
+// exceed the maximum total supply) is skipped.
+func (k Keeper) emit(ctx sdk.Context, m types.MarkerAccountI, schedule types.EmissionSchedule, coin sdk.Coin) {
+	if coin.IsZero() {
+		return
+	}
+	recipient := schedule.RecipientAddress()
+	// The administrator of a validated schedule is always a valid address.
+	admin, _ := sdk.AccAddressFromBech32(schedule.Administrator)
+	emitCtx, writeCache := ctx.CacheContext()
+	err := k.IncreaseSupply(emitCtx, m, coin, admin)
+	if err == nil && !recipient.Equals(m.GetAddress()) {
+		coins := sdk.NewCoins(coin)
+		err = k.bankKeeper.InputOutputCoins(emitCtx, []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
+			[]banktypes.Output{banktypes.NewOutput(recipient, coins)})
+	}
+	if err != nil {
+		k.Logger(ctx).Error("unable to make emission", "schedule", schedule.Id, "denom", schedule.Denom, "err", err)
+		return
+	}
+	writeCache()
+	k.updateMarkerTotals(ctx, m.GetAddress())
+	k.updateMarkerTotals(ctx, recipient)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		types.NewEventMarkerEmission(schedule.Id, coin.String(), schedule.Denom, recipient.String(), schedule.RemainingEmissions),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetEmissionSchedule returns the emission schedule with the given id.
+func (k Keeper) GetEmissionSchedule(ctx sdk.Context, id uint64) (types.EmissionSchedule, bool) {
+	var s types.EmissionSchedule
+	bz := ctx.KVStore(k.storeKey).Get(types.EmissionScheduleKey(id))
+	if len(bz) == 0 {
+		return s, false
+	}
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+// GetEmissionSchedules returns the emission schedules that have emissions left, in id order.
+func (k Keeper) GetEmissionSchedules(ctx sdk.Context) []types.EmissionSchedule {
+	var schedules []types.EmissionSchedule
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EmissionScheduleKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.EmissionSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		schedules = append(schedules, s)
+	}
+	return schedules
+}
+
+// setEmissionSchedule stores a new emission schedule along with its height and denom indexes.
+func (k Keeper) setEmissionSchedule(ctx sdk.Context, s types.EmissionSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EmissionScheduleKey(s.Id), k.cdc.MustMarshal(&s))
+	store.Set(types.EmissionScheduleHeightKey(s.NextHeight, s.Id), []byte{})
+	store.Set(types.EmissionScheduleDenomKey(s.Denom), sdk.Uint64ToBigEndian(s.Id))
+}
+
+// updateEmissionSchedule stores the changes to an emission schedule, moving it in the height index.
+func (k Keeper) updateEmissionSchedule(ctx sdk.Context, original types.EmissionSchedule, updated types.EmissionSchedule) {
+	ctx.KVStore(k.storeKey).Delete(types.EmissionScheduleHeightKey(original.NextHeight, original.Id))
+	k.setEmissionSchedule(ctx, updated)
+}
+
+// deleteEmissionSchedule removes an emission schedule along with its height and denom indexes.
+func (k Keeper) deleteEmissionSchedule(ctx sdk.Context, s types.EmissionSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EmissionScheduleKey(s.Id))
+	store.Delete(types.EmissionScheduleHeightKey(s.NextHeight, s.Id))
+	store.Delete(types.EmissionScheduleDenomKey(s.Denom))
+}
+
+// getEmissionScheduleIDForDenom returns the id of the emission schedule of the given marker denom.
+func (k Keeper) getEmissionScheduleIDForDenom(ctx sdk.Context, denom string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EmissionScheduleDenomKey(denom))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// nextEmissionScheduleID returns the id to use for a new emission schedule and increments it.
+func (k Keeper) nextEmissionScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextEmissionScheduleIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextEmissionScheduleIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextEmissionScheduleID sets the id to use for the next emission schedule.
+func (k Keeper) setNextEmissionScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextEmissionScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetFaucet sets the faucet of the marker letting any address claim its drip amount once per period, a nil faucet
+// removes it along with the record of its claims.  Faucets can only be set while they are enabled by the params, but
+// can always be removed.  The caller must hold the admin access on the marker, claims are then made using the access of
+// the caller.
+func (k Keeper) SetFaucet(ctx sdk.Context, caller sdk.AccAddress, denom string, faucet *types.MarkerFaucet) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "set_faucet")
+
+	if faucet != nil && !k.GetEnableFaucet(ctx) {
+		return fmt.Errorf("marker faucets are disabled")
+	}
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if faucet == nil {
+		k.removeFaucet(ctx, m.GetAddress())
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucet(m.GetDenom(), nil, caller.String()))
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return fmt.Errorf("cannot set the faucet of a markeraccount in the %s status", m.GetStatus())
+	}
+	if faucet.Mint && m.GetMarkerType() == types.MarkerType_Basket {
+		return fmt.Errorf("cannot mint coin for basket marker %s, supply only changes with reserve deposits and redemptions", m.GetDenom())
+	}
+	if err = faucet.Validate(); err != nil {
+		return fmt.Errorf("invalid faucet: %w", err)
+	}
+	if faucet.Denom != m.GetDenom() {
+		return fmt.Errorf("faucet denom %s does not match marker %s", faucet.Denom, m.GetDenom())
+	}
+	k.setFaucet(ctx, *faucet)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucet(m.GetDenom(), faucet, caller.String()))
+}
+
+// ClaimFaucet sends the drip amount of the marker's faucet to the claimer, minting it first for a minting faucet.  An
+// address can claim again once the period of the faucet has passed since its last claim.  The coin is withdrawn the
+// same as a withdrawal by the administrator that set the faucet, so claims fail while it does not have the withdraw
+// access (and mint access for a minting faucet) or the marker is not active.  The coin sent and the time from which the
+// claimer may claim again are returned.
+func (k Keeper) ClaimFaucet(ctx sdk.Context, claimer sdk.AccAddress, denom string) (sdk.Coin, time.Time, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "claim_faucet")
+
+	if !k.GetEnableFaucet(ctx) {
+		return sdk.Coin{}, time.Time{}, fmt.Errorf("marker faucets are disabled")
+	}
+	faucet, found := k.GetFaucet(ctx, denom)
+	if !found {
+		return sdk.Coin{}, time.Time{}, fmt.Errorf("no faucet found for %s", denom)
+	}
+	if claim, claimed := k.GetFaucetClaim(ctx, denom, claimer); claimed {
+		if next := claim.NextClaimTime(faucet.Period); ctx.BlockTime().Before(next) {
+			return sdk.Coin{}, time.Time{}, fmt.Errorf("%s cannot claim from the %s faucet again until %s",
+				claimer, denom, next.UTC().Format(time.RFC3339))
+		}
+	}
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, time.Time{}, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	// The administrator of a validated faucet is always a valid address.
+	admin, _ := sdk.AccAddressFromBech32(faucet.Administrator)
+	drip := faucet.Drip()
+	if err = k.checkWithdrawal(ctx, m, admin, sdk.NewCoins(drip)); err != nil {
+		return sdk.Coin{}, time.Time{}, fmt.Errorf("faucet cannot be claimed from: %w", err)
+	}
+	if faucet.Mint {
+		if !m.AddressHasAccess(admin, types.Access_Mint) {
+			return sdk.Coin{}, time.Time{}, fmt.Errorf("faucet cannot be claimed from: %s does not have %s on %s markeraccount",
+				admin, types.Access_Mint, m.GetDenom())
+		}
+		if err = k.IncreaseSupply(ctx, m, drip, admin); err != nil {
+			return sdk.Coin{}, time.Time{}, fmt.Errorf("faucet cannot be claimed from: %w", err)
+		}
+	}
+	if err = k.sendWithdrawal(ctx, m, admin, claimer, sdk.NewCoins(drip)); err != nil {
+		return sdk.Coin{}, time.Time{}, fmt.Errorf("faucet cannot be claimed from: %w", err)
+	}
+
+	claim := types.NewFaucetClaim(m.GetDenom(), claimer, ctx.BlockTime())
+	k.setFaucetClaim(ctx, claim)
+	next := claim.NextClaimTime(faucet.Period)
+
+	return drip, next, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFaucetClaim(drip, claimer.String(), next))
+}
+
+// GetFaucet returns the faucet of the marker with the given denom.
+func (k Keeper) GetFaucet(ctx sdk.Context, denom string) (types.MarkerFaucet, bool) {
+	var f types.MarkerFaucet
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return f, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.FaucetKey(addr))
+	if len(bz) == 0 {
+		return f, false
+	}
+	k.cdc.MustUnmarshal(bz, &f)
+	return f, true
+}
+
+// GetFaucets returns the faucets of all markers.
+func (k Keeper) GetFaucets(ctx sdk.Context) []types.MarkerFaucet {
+	var faucets []types.MarkerFaucet
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FaucetKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var f types.MarkerFaucet
+		k.cdc.MustUnmarshal(iterator.Value(), &f)
+		faucets = append(faucets, f)
+	}
+	return faucets
+}
+
+// GetFaucetClaim returns the last claim of the claimer from the faucet of the marker with the given denom.
+func (k Keeper) GetFaucetClaim(ctx sdk.Context, denom string, claimer sdk.AccAddress) (types.FaucetClaim, bool) {
+	var c types.FaucetClaim
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return c, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.FaucetClaimKey(addr, claimer))
+	if len(bz) == 0 {
+		return c, false
+	}
+	k.cdc.MustUnmarshal(bz, &c)
+	return c, true
+}
+
+// GetFaucetClaims returns the last claim of each address from the faucets of all markers.
+func (k Keeper) GetFaucetClaims(ctx sdk.Context) []types.FaucetClaim {
+	var claims []types.FaucetClaim
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FaucetClaimKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.FaucetClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		claims = append(claims, c)
+	}
+	return claims
+}
+
+func (k Keeper) setFaucet(ctx sdk.Context, f types.MarkerFaucet) {
+	ctx.KVStore(k.storeKey).Set(types.FaucetKey(types.MustGetMarkerAddress(f.Denom)), k.cdc.MustMarshal(&f))
+}
+
+func (k Keeper) setFaucetClaim(ctx sdk.Context, c types.FaucetClaim) {
+	claimer, err := sdk.AccAddressFromBech32(c.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.FaucetClaimKey(types.MustGetMarkerAddress(c.Denom), claimer), k.cdc.MustMarshal(&c))
+}
+
+// removeFaucet deletes the faucet of the marker with the given address and the record of its claims.
+func (k Keeper) removeFaucet(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FaucetKey(addr))
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.FaucetClaimsKeyPrefix(addr))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetFeeConversion sets the fee conversion letting the fees of a tx be paid in the marker's coin, a nil conversion
+// removes it.  Only governance can set the fee conversion of a marker, so the marker must allow governance control.
+func (k Keeper) SetFeeConversion(ctx sdk.Context, denom string, conversion *types.FeeConversion) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", denom)
+	}
+	if conversion == nil {
+		ctx.KVStore(k.storeKey).Delete(types.FeeConversionKey(m.GetAddress()))
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFeeConversion(m.GetDenom(), nil))
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return fmt.Errorf("cannot set the fee conversion of a markeraccount in the %s status", m.GetStatus())
+	}
+	if err = conversion.Validate(); err != nil {
+		return fmt.Errorf("invalid fee conversion: %w", err)
+	}
+	if conversion.Denom != m.GetDenom() {
+		return fmt.Errorf("fee conversion denom %s does not match marker %s", conversion.Denom, m.GetDenom())
+	}
+	k.setFeeConversion(ctx, *conversion)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFeeConversion(m.GetDenom(), conversion))
+}
+
+// SetFeeConversionRate updates the rate of the marker's fee conversion.  The caller must be the oracle of the
+// conversion.
+func (k Keeper) SetFeeConversionRate(ctx sdk.Context, caller sdk.AccAddress, denom string, rate sdk.Dec) error {
+	conversion, found := k.GetFeeConversion(ctx, denom)
+	if !found {
+		return fmt.Errorf("no fee conversion found for %s", denom)
+	}
+	if conversion.Oracle != caller.String() {
+		return fmt.Errorf("%s is not the oracle of the %s fee conversion", caller, denom)
+	}
+	if err := types.ValidateFeeConversionRate(rate); err != nil {
+		return fmt.Errorf("invalid fee conversion %s rate: %w", denom, err)
+	}
+	conversion.Rate = rate
+	k.setFeeConversion(ctx, conversion)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFeeConversion(denom, &conversion))
+}
+
+// ConvertedFee returns the fee with each coin that has a fee conversion replaced by the amount of the fee denom it is
+// worth, along with whether any coin was converted.  Nothing is swapped.
+func (k Keeper) ConvertedFee(ctx sdk.Context, fee sdk.Coins, feeDenom string) (sdk.Coins, bool) {
+	converted := sdk.NewCoins()
+	anyConverted := false
+	for _, coin := range fee {
+		conversion, found := k.GetFeeConversion(ctx, coin.Denom)
+		if coin.Denom == feeDenom || !found {
+			converted = converted.Add(coin)
+			continue
+		}
+		anyConverted = true
+		converted = converted.Add(sdk.NewCoin(feeDenom, conversion.Convert(coin.Amount)))
+	}
+	return converted, anyConverted
+}
+
+// ConvertFee swaps each coin of the fee that has a fee conversion for the amount of the fee denom it is worth.  The
+// payer sends the marker's coin to the conversion pool, which sends the fee denom back to the payer.  The fee in the
+// fee denom that the payer now holds is returned.
+func (k Keeper) ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, feeDenom string) (sdk.Coins, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "convert_fee")
+
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		conversion, found := k.GetFeeConversion(ctx, coin.Denom)
+		if coin.Denom == feeDenom || !found {
+			converted = converted.Add(coin)
+			continue
+		}
+		m, err := k.GetMarkerByDenom(ctx, coin.Denom)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "marker not found for %s: %s", coin.Denom, err)
+		}
+		if m.GetStatus() != types.StatusActive {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "cannot pay fees in %s, marker is not active", coin.Denom)
+		}
+		if m.IsPaused() {
+			return nil, sdkerrors.Wrapf(types.ErrMarkerPaused, "cannot pay fees in %s", coin.Denom)
+		}
+		swapped := sdk.NewCoin(feeDenom, conversion.Convert(coin.Amount))
+		if !swapped.IsPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "fee of %s is not worth any %s", coin, feeDenom)
+		}
+		// The pool of a validated fee conversion is always a valid address.
+		pool, _ := sdk.AccAddressFromBech32(conversion.Pool)
+		if err = k.bankKeeper.SendCoins(ctx, payer, pool, sdk.NewCoins(coin)); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot pay fee of %s: %s", coin, err)
+		}
+		if err = k.bankKeeper.SendCoins(ctx, pool, payer, sdk.NewCoins(swapped)); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s fee conversion pool cannot pay %s: %s",
+				coin.Denom, swapped, err)
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerFeeConverted(coin, swapped, payer.String(), conversion.Pool)); err != nil {
+			return nil, err
+		}
+		converted = converted.Add(swapped)
+	}
+	return converted, nil
+}
+
+// GetFeeConversion returns the fee conversion of the marker with the given denom.
+func (k Keeper) GetFeeConversion(ctx sdk.Context, denom string) (types.FeeConversion, bool) {
+	var c types.FeeConversion
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return c, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeConversionKey(addr))
+	if len(bz) == 0 {
+		return c, false
+	}
+	k.cdc.MustUnmarshal(bz, &c)
+	return c, true
+}
+
+// GetFeeConversions returns the fee conversions of all markers.
+func (k Keeper) GetFeeConversions(ctx sdk.Context) []types.FeeConversion {
+	var conversions []types.FeeConversion
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeeConversionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.FeeConversion
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		conversions = append(conversions, c)
+	}
+	return conversions
+}
+
+func (k Keeper) setFeeConversion(ctx sdk.Context, c types.FeeConversion) {
+	ctx.KVStore(k.storeKey).Set(types.FeeConversionKey(types.MustGetMarkerAddress(c.Denom)), k.cdc.MustMarshal(&c))
+}
@@ -42,6 +42,71 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetMarker(ctx, &data.Markers[i])
 		}
 	}
+	for _, basket := range data.Baskets {
+		if err := k.SetBasket(ctx, basket); err != nil {
+			panic(err)
+		}
+	}
+	nextDistributionID := uint64(1)
+	for _, d := range data.Distributions {
+		k.setEscrowDistribution(ctx, d)
+		if d.Id >= nextDistributionID {
+			nextDistributionID = d.Id + 1
+		}
+	}
+	k.setNextDistributionID(ctx, nextDistributionID)
+	for _, h := range data.DistributionHolders {
+		k.setDistributionHolder(ctx, h)
+	}
+	nextEmissionScheduleID := uint64(1)
+	for _, s := range data.EmissionSchedules {
+		k.setEmissionSchedule(ctx, s)
+		if s.Id >= nextEmissionScheduleID {
+			nextEmissionScheduleID = s.Id + 1
+		}
+	}
+	k.setNextEmissionScheduleID(ctx, nextEmissionScheduleID)
+	nextScheduledTransferID := uint64(1)
+	for _, t := range data.ScheduledTransfers {
+		k.setScheduledTransfer(ctx, t)
+		if t.Id >= nextScheduledTransferID {
+			nextScheduledTransferID = t.Id + 1
+		}
+	}
+	k.setNextScheduledTransferID(ctx, nextScheduledTransferID)
+	nextPendingWithdrawalID := uint64(1)
+	for _, w := range data.PendingWithdrawals {
+		k.setPendingWithdrawal(ctx, w)
+		if w.Id >= nextPendingWithdrawalID {
+			nextPendingWithdrawalID = w.Id + 1
+		}
+	}
+	k.setNextPendingWithdrawalID(ctx, nextPendingWithdrawalID)
+	nextSupplyChangeID := uint64(1)
+	for _, c := range data.SupplyChanges {
+		k.setSupplyChange(ctx, c)
+		if c.Id >= nextSupplyChangeID {
+			nextSupplyChangeID = c.Id + 1
+		}
+	}
+	k.setNextSupplyChangeID(ctx, nextSupplyChangeID)
+	for _, f := range data.Faucets {
+		k.setFaucet(ctx, f)
+	}
+	for _, c := range data.FaucetClaims {
+		k.setFaucetClaim(ctx, c)
+	}
+	nextAccessChangeID := uint64(1)
+	for _, c := range data.AccessChanges {
+		k.setAccessChange(ctx, c)
+		if c.Id >= nextAccessChangeID {
+			nextAccessChangeID = c.Id + 1
+		}
+	}
+	k.setNextAccessChangeID(ctx, nextAccessChangeID)
+	for _, c := range data.FeeConversions {
+		k.setFeeConversion(ctx, c)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -65,10 +130,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 			MarkerType:             marker.GetMarkerType(),
 			SupplyFixed:            marker.HasFixedSupply(),
 			AllowGovernanceControl: marker.HasGovernanceEnabled(),
+			WithdrawApprovalPolicy: marker.GetWithdrawApprovalPolicy(),
 		})
 		return false
 	}
 
 	k.IterateMarkers(ctx, appendToMarkers)
-	return types.NewGenesisState(params, markers)
+	genState := types.NewGenesisState(params, markers)
+	k.IterateBaskets(ctx, func(basket types.Basket) bool {
+		genState.Baskets = append(genState.Baskets, basket)
+		return false
+	})
+	for _, d := range k.GetEscrowDistributions(ctx) {
+		genState.Distributions = append(genState.Distributions, d)
+		genState.DistributionHolders = append(genState.DistributionHolders, k.GetDistributionHolders(ctx, d.Id, 0)...)
+	}
+	genState.EmissionSchedules = k.GetEmissionSchedules(ctx)
+	genState.ScheduledTransfers = k.GetScheduledTransfers(ctx)
+	genState.PendingWithdrawals = k.GetPendingWithdrawals(ctx)
+	genState.SupplyChanges = k.GetAllSupplyChanges(ctx)
+	genState.Faucets = k.GetFaucets(ctx)
+	genState.FaucetClaims = k.GetFaucetClaims(ctx)
+	genState.AccessChanges = k.GetAllAccessChanges(ctx)
+	genState.FeeConversions = k.GetFeeConversions(ctx)
+	return genState
 }
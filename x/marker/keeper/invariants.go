@@ -9,19 +9,55 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 )
 
-// The name of the marker supply invariant
-const invariantName = "required-marker-supply"
+const (
+	// The name of the marker supply invariant
+	invariantName = "required-marker-supply"
+	// The name of the invariant that every access grant address is valid bech32.
+	accessGrantInvariantName = "access-grant-address"
+	// The name of the invariant that no marker escrow balance is negative.
+	escrowInvariantName = "non-negative-escrow"
+	// The name of the invariant that every basket marker holds the reserve for its entire supply.
+	basketReserveInvariantName = "basket-reserve"
+)
+
+// invariantRoutes are all of the marker module invariants in the order they are registered and run.
+var invariantRoutes = []struct {
+	name      string
+	invariant func(Keeper, bankkeeper.Keeper) sdk.Invariant
+}{
+	{invariantName, supplyInvariant},
+	{accessGrantInvariantName, accessGrantInvariant},
+	{escrowInvariantName, escrowInvariant},
+	{basketReserveInvariantName, basketReserveInvariant},
+}
 
 // RegisterInvariants registers module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, mk Keeper, bk bankkeeper.Keeper) {
-	ir.RegisterRoute(types.ModuleName, invariantName, supplyInvariant(mk, bk))
+	for _, route := range invariantRoutes {
+		ir.RegisterRoute(types.ModuleName, route.name, route.invariant(mk, bk))
+	}
 }
 
 // AllInvariants runs all invariants of the marker module.
 func AllInvariants(k Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return supplyInvariant(k, bk)(ctx)
+		for _, route := range invariantRoutes {
+			if res, stop := route.invariant(k, bk)(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CheckInvariants runs each of the marker module invariants and returns their results without halting.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariantRoutes))
+	for _, route := range invariantRoutes {
+		msg, broken := route.invariant(k, k.bankKeeper)(ctx)
+		results = append(results, types.InvariantResult{Name: route.name, Broken: broken, Message: msg})
 	}
+	return results
 }
 
 // Checks that all of the marker supply values match the expected system totals.
@@ -58,3 +94,64 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 		return statusMessage, isBroken
 	}
 }
+
+// Checks that the address of every access grant on every marker is a valid bech32 account address.
+func accessGrantInvariant(mk Keeper, _ bankkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		mk.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+			for _, grant := range record.GetAccessList() {
+				if _, err := sdk.AccAddressFromBech32(grant.Address); err != nil {
+					count++
+					msg += fmt.Sprintf("\tmarker %s has an access grant with invalid address %q: %v\n",
+						record.GetDenom(), grant.Address, err)
+				}
+			}
+			return false
+		})
+		return formatInvariant(accessGrantInvariantName, "access grants with invalid addresses", count, msg)
+	}
+}
+
+// Checks that none of the coins held in escrow by a marker have a negative balance.
+func escrowInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		mk.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+			escrow := bk.GetAllBalances(ctx, record.GetAddress())
+			for _, coin := range escrow {
+				if coin.IsNegative() {
+					count++
+					msg += fmt.Sprintf("\tmarker %s has a negative escrow balance %s\n", record.GetDenom(), coin)
+				}
+			}
+			return false
+		})
+		return formatInvariant(escrowInvariantName, "negative marker escrow balances", count, msg)
+	}
+}
+
+// Checks that each basket marker holds at least the reserve required for the current supply of the basket coin.
+func basketReserveInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		mk.IterateBaskets(ctx, func(basket types.Basket) bool {
+			required := basket.ReserveFor(bk.GetSupply(ctx, basket.Denom).Amount)
+			escrow := bk.GetAllBalances(ctx, types.MustGetMarkerAddress(basket.Denom))
+			if !escrow.IsAllGTE(required) {
+				count++
+				msg += fmt.Sprintf("\tbasket marker %s holds reserve %s but requires %s\n", basket.Denom, escrow, required)
+			}
+			return false
+		})
+		return formatInvariant(basketReserveInvariantName, "basket markers with insufficient reserve", count, msg)
+	}
+}
+
+// formatInvariant returns the invariant message for the number of broken entries with the given details.
+func formatInvariant(name, description string, count int, details string) (string, bool) {
+	return sdk.FormatInvariant(types.ModuleName, name, fmt.Sprintf("%s found %d\n%s", description, count, details)), count > 0
+}
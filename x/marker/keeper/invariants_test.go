@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -68,4 +69,38 @@ func TestMarkerInvariant(t *testing.T) {
 	// expect pass after withdraw operation
 	_, isBroken = invariantChecks(ctx)
 	require.False(t, isBroken)
+
+	brokenInvariants := func() []string {
+		broken := []string{}
+		for _, result := range app.MarkerKeeper.CheckInvariants(ctx) {
+			if result.Broken {
+				broken = append(broken, result.Name)
+			}
+		}
+		return broken
+	}
+	require.Empty(t, brokenInvariants())
+
+	// store an access grant with an invalid address directly, bypassing marker validation
+	macc, err := app.MarkerKeeper.GetMarker(ctx, mac.GetAddress())
+	require.NoError(t, err)
+	invalid := macc.(*markertypes.MarkerAccount)
+	invalid.AccessControl[0].Address = "invalidaddress"
+	app.AccountKeeper.SetAccount(ctx, invalid)
+
+	_, isBroken = invariantChecks(ctx)
+	require.True(t, isBroken)
+	require.Equal(t, []string{"access-grant-address"}, brokenInvariants())
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	markertypes.RegisterQueryServer(queryHelper, app.MarkerKeeper)
+	res, err := markertypes.NewQueryClient(queryHelper).Invariants(ctx.Context(), &markertypes.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Broken)
+	require.Len(t, res.Invariants, 4)
+	require.False(t, res.Invariants[0].Broken)
+	require.True(t, res.Invariants[1].Broken)
+	require.Contains(t, res.Invariants[1].Message, "marker testcoin has an access grant with invalid address \"invalidaddress\"")
+	require.False(t, res.Invariants[2].Broken)
+	require.False(t, res.Invariants[3].Broken)
 }
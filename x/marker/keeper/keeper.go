@@ -9,6 +9,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 
@@ -51,11 +52,17 @@ type Keeper struct {
 	// To handle movement of coin between accounts and check total supply
 	bankKeeper bankkeeper.Keeper
 
+	// To resolve the denom trace of markers for ibc vouchers
+	ibcTransferKeeper ibctransferkeeper.Keeper
+
 	// Key to access the key-value store from sdk.Context.
 	storeKey sdk.StoreKey
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// Can veto transfers of restricted coins, nil when there is none.
+	restrictionChecker types.RestrictionChecker
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -70,19 +77,31 @@ func NewKeeper(
 	authKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	ibcTransferKeeper ibctransferkeeper.Keeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		paramSpace:  paramSpace,
-		authKeeper:  authKeeper,
-		authzKeeper: authzKeeper,
-		bankKeeper:  bankKeeper,
-		storeKey:    key,
-		cdc:         cdc,
+		paramSpace:        paramSpace,
+		authKeeper:        authKeeper,
+		authzKeeper:       authzKeeper,
+		bankKeeper:        bankKeeper,
+		ibcTransferKeeper: ibcTransferKeeper,
+		storeKey:          key,
+		cdc:               cdc,
+	}
+}
+
+// SetRestrictionChecker sets the checker that can veto transfers of restricted coins.  Use
+// types.NewRestrictionCheckers to combine the checkers of several modules.
+func (k *Keeper) SetRestrictionChecker(checker types.RestrictionChecker) *Keeper {
+	if k.restrictionChecker != nil {
+		panic("cannot set marker restriction checker twice")
 	}
+	k.restrictionChecker = checker
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -118,22 +137,28 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
+	k.updateAccessIndex(ctx, marker.GetAddress(), marker)
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
 
 	// If Set Marker is called on an Active Marker then ensure the send_enabled configuration is also correct.
+	// Sends of a paused marker's coin are disabled until it is resumed.
 	if marker.GetStatus() == types.StatusActive {
-		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.GetMarkerType() == types.MarkerType_Coin)
+		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), !marker.IsPaused() &&
+			(marker.GetMarkerType() == types.MarkerType_Coin || marker.GetMarkerType() == types.MarkerType_Basket))
 	}
+	k.updateMarkerTotals(ctx, marker.GetAddress())
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
 // likely cause an invariant constraint violation for the coin supply
 func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
+	k.updateAccessIndex(ctx, marker.GetAddress(), nil)
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	k.updateMarkerTotals(ctx, marker.GetAddress())
 }
 
 // IterateMarkers  iterates all markers with the given handler function.
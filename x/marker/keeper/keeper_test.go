@@ -3,13 +3,22 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/require"
@@ -75,7 +84,7 @@ func TestAccountUnrestrictedDenoms(t *testing.T) {
 	user := testUserAddress("test")
 
 	// Require a long unrestricted denom
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}"})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "[a-z]{12,20}", DistributionHoldersPerBlock: types.DefaultDistributionHoldersPerBlock})
 
 	_, err := server.AddMarker(sdk.WrapSDKContext(ctx), types.NewMsgAddMarkerRequest("tooshort", sdk.NewInt(30), user, user, types.MarkerType_Coin, true, true))
 	require.Error(t, err, "fails with unrestricted denom length fault")
@@ -85,7 +94,7 @@ func TestAccountUnrestrictedDenoms(t *testing.T) {
 	require.NoError(t, err, "should allow a marker with a sufficiently long denom")
 
 	// Set to an empty string (returns to default expression)
-	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: ""})
+	app.MarkerKeeper.SetParams(ctx, types.Params{UnrestrictedDenomRegex: "", DistributionHoldersPerBlock: types.DefaultDistributionHoldersPerBlock})
 	_, err = server.AddMarker(sdk.WrapSDKContext(ctx), types.NewMsgAddMarkerRequest("short", sdk.NewInt(30), user, user, types.MarkerType_Coin, true, true))
 	// succeeds now as the default unrestricted denom expression allows any valid denom (minimum length is 2)
 	require.NoError(t, err, "should allow any valid denom with a min length of two")
@@ -527,3 +536,589 @@ func testUserAddress(name string) sdk.AccAddress {
 	addr := types.MustGetMarkerAddress(name)
 	return addr
 }
+
+func TestAccountHoldingQuery(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	user := testUserAddress("test")
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// a vesting account with part of its balance locked until a day from now.
+	vestingAddr := testUserAddress("vesting")
+	vesting := authvesting.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr),
+		sdk.NewCoins(sdk.NewInt64Coin("testcoin", 40)), ctx.BlockTime().Add(24*time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vesting)
+	require.NoError(t, simapp.FundAccount(app, ctx, vestingAddr, sdk.NewCoins(sdk.NewInt64Coin("testcoin", 100))))
+	// coins held by the marker account itself are escrowed.
+	require.NoError(t, simapp.FundAccount(app, ctx, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("testcoin", 25))))
+
+	res, err := app.MarkerKeeper.AccountHolding(sdk.WrapSDKContext(ctx),
+		&types.QueryAccountHoldingRequest{Id: "testcoin", Address: vestingAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 100), res.Total)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 60), res.Spendable)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 40), res.Locked)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 0), res.Escrowed)
+
+	res, err = app.MarkerKeeper.AccountHolding(sdk.WrapSDKContext(ctx),
+		&types.QueryAccountHoldingRequest{Id: mac.GetAddress().String(), Address: mac.GetAddress().String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 25), res.Total)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 0), res.Spendable)
+	require.Equal(t, sdk.NewInt64Coin("testcoin", 25), res.Escrowed)
+
+	_, err = app.MarkerKeeper.AccountHolding(sdk.WrapSDKContext(ctx),
+		&types.QueryAccountHoldingRequest{Id: "testcoin", Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestHoldingQueryPagination(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	user := testUserAddress("test")
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	for i := 0; i < 5; i++ {
+		holder := testUserAddress(fmt.Sprintf("holder%d", i))
+		require.NoError(t, simapp.FundAccount(app, ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("testcoin", int64(i+1)))))
+	}
+
+	holders := []types.Balance{}
+	var nextKey []byte
+	for page := 0; page < 3; page++ {
+		res, err := app.MarkerKeeper.Holding(sdk.WrapSDKContext(ctx),
+			&types.QueryHoldingRequest{Id: "testcoin", Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), res.Pagination.Total)
+		holders = append(holders, res.Balances...)
+		nextKey = res.Pagination.NextKey
+	}
+	require.Empty(t, nextKey, "no next key after the last page")
+	require.Equal(t, app.MarkerKeeper.GetAllMarkerHolders(ctx, "testcoin"), holders)
+
+	// a page key of an address that no longer holds the coin starts at the next holder
+	gone := holders[2]
+	goneAddr, err := sdk.AccAddressFromBech32(gone.Address)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, goneAddr, user, gone.Coins))
+	res, err := app.MarkerKeeper.Holding(sdk.WrapSDKContext(ctx),
+		&types.QueryHoldingRequest{Id: "testcoin", Pagination: &query.PageRequest{Key: goneAddr, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, holders[3].Address, res.Balances[0].Address)
+
+	_, err = app.MarkerKeeper.Holding(sdk.WrapSDKContext(ctx),
+		&types.QueryHoldingRequest{Id: "testcoin", Pagination: &query.PageRequest{Key: goneAddr, Offset: 1}})
+	require.Error(t, err, "offset and key")
+}
+
+func TestMarkerQueryDenomTrace(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	user := testUserAddress("test")
+	denomTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	app.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+
+	for _, denom := range []string{"testcoin", denomTrace.IBCDenom()} {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{})
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	}
+
+	res, err := app.MarkerKeeper.Marker(sdk.WrapSDKContext(ctx), &types.QueryMarkerRequest{Id: denomTrace.IBCDenom()})
+	require.NoError(t, err)
+	require.Equal(t, &denomTrace, res.DenomTrace)
+
+	res, err = app.MarkerKeeper.Marker(sdk.WrapSDKContext(ctx), &types.QueryMarkerRequest{Id: "testcoin"})
+	require.NoError(t, err)
+	require.Nil(t, res.DenomTrace)
+}
+
+func TestMarkerTotals(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	user := testUserAddress("test")
+	require.Empty(t, app.MarkerKeeper.GetMarkerTotals(ctx), "initial totals")
+
+	for _, denom := range []string{"totalcoina", "totalcoinb"} {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Delete})})
+		require.NoError(t, mac.SetManager(user))
+		require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	}
+	require.Equal(t, []types.MarkerTotal{
+		types.NewMarkerTotal(types.MarkerType_Coin, types.StatusProposed, 2, nil, nil),
+	}, app.MarkerKeeper.GetMarkerTotals(ctx), "proposed totals")
+
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "totalcoina"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "totalcoina"))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("totalcoina", 500)))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "totalcoina", sdk.NewCoins(sdk.NewInt64Coin("totalcoina", 200))))
+	require.Equal(t, []types.MarkerTotal{
+		types.NewMarkerTotal(types.MarkerType_Coin, types.StatusProposed, 1, nil, nil),
+		types.NewMarkerTotal(types.MarkerType_Coin, types.StatusActive, 1,
+			sdk.NewCoins(sdk.NewInt64Coin("totalcoina", 1500)), sdk.NewCoins(sdk.NewInt64Coin("totalcoina", 1300))),
+	}, app.MarkerKeeper.GetMarkerTotals(ctx), "active totals")
+
+	// totals computed from scratch match those maintained as the markers changed
+	expected := app.MarkerKeeper.GetMarkerTotals(ctx)
+	app.MarkerKeeper.RebuildMarkerTotals(ctx)
+	require.Equal(t, expected, app.MarkerKeeper.GetMarkerTotals(ctx), "rebuilt totals")
+
+	require.NoError(t, app.MarkerKeeper.CancelMarker(ctx, user, "totalcoinb"))
+	require.NoError(t, app.MarkerKeeper.DeleteMarker(ctx, user, "totalcoinb"))
+	res, err := app.MarkerKeeper.Totals(sdk.WrapSDKContext(ctx), &types.QueryTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MarkerTotal{
+		types.NewMarkerTotal(types.MarkerType_Coin, types.StatusActive, 1,
+			sdk.NewCoins(sdk.NewInt64Coin("totalcoina", 1500)), sdk.NewCoins(sdk.NewInt64Coin("totalcoina", 1300))),
+		types.NewMarkerTotal(types.MarkerType_Coin, types.StatusDestroyed, 1, nil, nil),
+	}, res.Totals, "query totals")
+}
+
+func TestTransferDenials(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	other := testUserAddress("other")
+
+	mac := types.NewEmptyMarkerAccount("denycoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(admin))
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("denycoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "denycoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "denycoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "denycoin", sdk.NewCoins(sdk.NewInt64Coin("denycoin", 100))))
+
+	canSend := func(from, to, administrator sdk.AccAddress, amount string) *types.QueryCanSendResponse {
+		res, err := app.MarkerKeeper.CanSend(sdk.WrapSDKContext(ctx), &types.QueryCanSendRequest{
+			FromAddress: from.String(), ToAddress: to.String(), Amount: amount, Administrator: administrator.String(),
+		})
+		require.NoError(t, err)
+		return res
+	}
+	reasons := func(res *types.QueryCanSendResponse) []types.TransferDenyReason {
+		var reasons []types.TransferDenyReason
+		for _, denial := range res.Denials {
+			reasons = append(reasons, denial.Reason)
+		}
+		return reasons
+	}
+
+	require.Equal(t, []types.TransferDenyReason{types.TransferDenyReason_MarkerNotFound},
+		reasons(canSend(holder, other, admin, "10unknowncoin")))
+	require.Equal(t, []types.TransferDenyReason{types.TransferDenyReason_NoAuthorization},
+		reasons(canSend(holder, other, admin, "10denycoin")))
+	require.Equal(t, []types.TransferDenyReason{types.TransferDenyReason_NoTransferGrant, types.TransferDenyReason_InsufficientFunds},
+		reasons(canSend(other, holder, other, "10denycoin")))
+
+	// the holder authorizes the admin to transfer up to 50 of its coins
+	expiration := ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, admin, holder,
+		&types.MarkerTransferAuthorization{TransferLimit: sdk.NewCoins(sdk.NewInt64Coin("denycoin", 50))}, expiration))
+	require.True(t, canSend(holder, other, admin, "10denycoin").Allowed)
+	require.Equal(t, []types.TransferDenyReason{types.TransferDenyReason_AuthorizationLimit},
+		reasons(canSend(holder, other, admin, "60denycoin")))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, []types.TransferDenyReason{types.TransferDenyReason_OnDenyList},
+		reasons(canSend(holder, feeCollector, admin, "10denycoin")))
+
+	// the transfer fails with the error of the first denial
+	err := app.MarkerKeeper.TransferCoin(ctx, holder, other, admin, sdk.NewInt64Coin("denycoin", 60))
+	require.True(t, types.ErrTransferLimitExceeded.Is(err), "transfer limit error: %v", err)
+	require.Contains(t, err.Error(), "AUTHORIZATION_LIMIT: 60denycoin is more than")
+	err = app.MarkerKeeper.TransferCoin(ctx, other, holder, other, sdk.NewInt64Coin("denycoin", 10))
+	require.True(t, types.ErrNoTransferGrant.Is(err), "no transfer grant error: %v", err)
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, holder, other, admin, sdk.NewInt64Coin("denycoin", 10)))
+	require.Equal(t, sdk.NewInt64Coin("denycoin", 10), app.BankKeeper.GetBalance(ctx, other, "denycoin"))
+}
+
+// sanctionsChecker vetoes transfers to or from any of its sanctioned addresses.
+type sanctionsChecker []sdk.AccAddress
+
+func (c sanctionsChecker) CheckTransferRestriction(
+	_ sdk.Context, _ types.MarkerAccountI, from, to, _ sdk.AccAddress, _ sdk.Coin,
+) error {
+	for _, addr := range c {
+		if addr.Equals(from) || addr.Equals(to) {
+			return fmt.Errorf("%s is sanctioned", addr)
+		}
+	}
+	return nil
+}
+
+func TestRestrictionChecker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	sanctioned := testUserAddress("sanctioned")
+
+	k := markerkeeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.TransferKeeper)
+	k.SetRestrictionChecker(types.NewRestrictionCheckers(sanctionsChecker{}, sanctionsChecker{sanctioned}))
+	require.Panics(t, func() { k.SetRestrictionChecker(sanctionsChecker{}) }, "setting the checker twice")
+	k.SetParams(ctx, types.DefaultParams())
+
+	mac := types.NewEmptyMarkerAccount("vetocoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetManager(admin))
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("vetocoin", 1000)))
+	require.NoError(t, k.AddMarkerAccount(ctx, mac))
+	require.NoError(t, k.FinalizeMarker(ctx, admin, "vetocoin"))
+	require.NoError(t, k.ActivateMarker(ctx, admin, "vetocoin"))
+	require.NoError(t, k.WithdrawCoins(ctx, admin, admin, "vetocoin", sdk.NewCoins(sdk.NewInt64Coin("vetocoin", 100))))
+
+	amount := sdk.NewInt64Coin("vetocoin", 10)
+	require.Empty(t, k.CheckTransfer(ctx, admin, holder, admin, amount))
+	denials := k.CheckTransfer(ctx, admin, sanctioned, admin, amount)
+	require.Equal(t, []types.TransferDenial{{Reason: types.TransferDenyReason_Vetoed,
+		Detail: fmt.Sprintf("%s is sanctioned", sanctioned)}}, denials)
+
+	err := k.TransferCoin(ctx, admin, sanctioned, admin, amount)
+	require.True(t, types.ErrTransferVetoed.Is(err), "vetoed transfer error: %v", err)
+	require.NoError(t, k.TransferCoin(ctx, admin, holder, admin, amount))
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, holder, "vetocoin"))
+}
+
+func TestSupplyHistory(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: blockTime})
+	params := types.DefaultParams()
+	params.SupplyHistoryWindow = 5
+	app.MarkerKeeper.SetParams(ctx, params)
+	user := testUserAddress("test")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	mac := types.NewEmptyMarkerAccount("historycoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Burn})})
+	mac.AllowGovernanceControl = true
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("historycoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	// supply changes of markers that are not active do not change the coin in circulation
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("historycoin", 100)))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "historycoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "historycoin"))
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin("historycoin", 300)))
+	require.NoError(t, markerkeeper.HandleSupplyIncreaseProposal(ctx, app.MarkerKeeper,
+		types.NewSupplyIncreaseProposal("title", "description", sdk.NewInt64Coin("historycoin", 50), "")))
+
+	res, err := app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx), &types.QuerySupplyHistoryRequest{Id: "historycoin"})
+	require.NoError(t, err)
+	require.Equal(t, []types.SupplyChange{
+		types.NewSupplyChange(1, "historycoin", 10, blockTime, sdk.NewInt(1100), sdk.NewInt(1100), user, types.SupplyChangeReason_Mint),
+		types.NewSupplyChange(2, "historycoin", 12, blockTime, sdk.NewInt(-300), sdk.NewInt(800), user, types.SupplyChangeReason_Burn),
+		types.NewSupplyChange(3, "historycoin", 12, blockTime, sdk.NewInt(50), sdk.NewInt(850), govAddr, types.SupplyChangeReason_Governance),
+	}, res.Changes, "supply history")
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx),
+		&types.QuerySupplyHistoryRequest{Id: "historycoin", Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, uint64(2), res.Changes[0].Id, "paginated supply history")
+
+	_, err = app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx), &types.QuerySupplyHistoryRequest{Id: "nocoin"})
+	require.Error(t, err)
+
+	// changes are kept for the window of blocks after the height they were made at
+	app.MarkerKeeper.PruneSupplyHistory(ctx.WithBlockHeight(14))
+	require.Len(t, app.MarkerKeeper.GetSupplyChanges(ctx, mac.GetAddress()), 3)
+	app.MarkerKeeper.PruneSupplyHistory(ctx.WithBlockHeight(15))
+	require.Len(t, app.MarkerKeeper.GetSupplyChanges(ctx, mac.GetAddress()), 2)
+
+	// the changes within the window are exported
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.SupplyChanges, 2)
+
+	// no history is kept once the window is set to zero
+	params.SupplyHistoryWindow = 0
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin("historycoin", 100)))
+	app.MarkerKeeper.PruneSupplyHistory(ctx)
+	require.Empty(t, app.MarkerKeeper.GetSupplyChanges(ctx, mac.GetAddress()))
+}
+
+func TestAccessLog(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: blockTime})
+	params := types.DefaultParams()
+	params.AccessLogWindow = 5
+	app.MarkerKeeper.SetParams(ctx, params)
+	user := testUserAddress("test")
+	other := testUserAddress("other")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	mac := types.NewEmptyMarkerAccount("auditcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	mac.AllowGovernanceControl = true
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("auditcoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "auditcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "auditcoin"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "auditcoin",
+		types.NewAccessGrant(other, []types.Access{types.Access_Burn})))
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		typedEvent, _ := sdk.ParseTypedEvent(event)
+		events = append(events, typedEvent)
+	}
+	require.Contains(t, events, &types.EventMarkerAccessChange{
+		ChangeId:    "1",
+		Denom:       "auditcoin",
+		Height:      "10",
+		Actor:       user.String(),
+		Address:     other.String(),
+		Permissions: []string{"ACCESS_BURN"},
+		Action:      "ACCESS_CHANGE_ACTION_GRANT",
+	}, "access change event")
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, markerkeeper.HandleSetAdministratorProposal(ctx, app.MarkerKeeper,
+		types.NewSetAdministratorProposal("title", "description", "auditcoin",
+			[]types.AccessGrant{*types.NewAccessGrant(other, []types.Access{types.Access_Deposit})})))
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user, "auditcoin", other))
+
+	res, err := app.MarkerKeeper.AccessLog(sdk.WrapSDKContext(ctx), &types.QueryAccessLogRequest{Id: "auditcoin"})
+	require.NoError(t, err)
+	require.Equal(t, []types.AccessChange{
+		types.NewAccessChange(1, "auditcoin", 10, blockTime, user, other, types.AccessList{types.Access_Burn}, types.AccessChangeAction_Grant),
+		types.NewAccessChange(2, "auditcoin", 12, blockTime, govAddr, other, types.AccessList{types.Access_Deposit}, types.AccessChangeAction_Grant),
+		types.NewAccessChange(3, "auditcoin", 12, blockTime, user, other, types.AccessList{types.Access_Deposit, types.Access_Burn}, types.AccessChangeAction_Revoke),
+	}, res.Changes, "access log")
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = app.MarkerKeeper.AccessLog(sdk.WrapSDKContext(ctx),
+		&types.QueryAccessLogRequest{Id: "auditcoin", Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, uint64(2), res.Changes[0].Id, "paginated access log")
+
+	_, err = app.MarkerKeeper.AccessLog(sdk.WrapSDKContext(ctx), &types.QueryAccessLogRequest{Id: "nocoin"})
+	require.Error(t, err)
+
+	// changes are kept for the window of blocks after the height they were made at
+	app.MarkerKeeper.PruneAccessLog(ctx.WithBlockHeight(14))
+	require.Len(t, app.MarkerKeeper.GetAccessChanges(ctx, mac.GetAddress()), 3)
+	app.MarkerKeeper.PruneAccessLog(ctx.WithBlockHeight(15))
+	require.Len(t, app.MarkerKeeper.GetAccessChanges(ctx, mac.GetAddress()), 2)
+
+	// the changes within the window are exported
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.AccessChanges, 2)
+
+	// no log is kept once the window is set to zero
+	params.AccessLogWindow = 0
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "auditcoin",
+		types.NewAccessGrant(other, []types.Access{types.Access_Burn})))
+	app.MarkerKeeper.PruneAccessLog(ctx)
+	require.Empty(t, app.MarkerKeeper.GetAccessChanges(ctx, mac.GetAddress()))
+}
+
+func TestAccountPermissions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	other := testUserAddress("other")
+
+	newMarker := func(denom string) {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Admin, types.Access_Delete})})
+		require.NoError(t, mac.SetManager(user))
+		require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	}
+	newMarker("permcoin")
+	newMarker("othercoin")
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, user, "permcoin",
+		types.NewAccessGrant(other, []types.Access{types.Access_Burn, types.Access_Deposit})))
+
+	permissionsOf := func(addr sdk.AccAddress, page *query.PageRequest) *types.QueryAccountPermissionsResponse {
+		res, err := app.MarkerKeeper.AccountPermissions(sdk.WrapSDKContext(ctx),
+			&types.QueryAccountPermissionsRequest{Address: addr.String(), Pagination: page})
+		require.NoError(t, err)
+		return res
+	}
+	permAddr := types.MustGetMarkerAddress("permcoin")
+	require.Equal(t, []types.MarkerPermissions{{
+		Denom:         "permcoin",
+		MarkerAddress: permAddr.String(),
+		Permissions:   []types.Access{types.Access_Burn, types.Access_Deposit},
+		Status:        types.StatusProposed,
+	}}, permissionsOf(other, nil).Permissions)
+
+	res := permissionsOf(user, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.Len(t, res.Permissions, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Equal(t, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Delete}, res.Permissions[0].Permissions)
+	next := permissionsOf(user, &query.PageRequest{Key: res.Pagination.NextKey})
+	require.Len(t, next.Permissions, 1)
+	require.NotEqual(t, res.Permissions[0].Denom, next.Permissions[0].Denom, "second page")
+
+	// the index follows revocations and removed markers
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user, "permcoin", other))
+	require.Empty(t, permissionsOf(other, nil).Permissions)
+	require.NoError(t, app.MarkerKeeper.CancelMarker(ctx, user, "othercoin"))
+	require.NoError(t, app.MarkerKeeper.DeleteMarker(ctx, user, "othercoin"))
+	require.Equal(t, types.StatusDestroyed, permissionsOf(user, nil).Permissions[1].Status)
+	destroyed, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "othercoin")
+	require.NoError(t, err)
+	app.MarkerKeeper.RemoveMarker(ctx, destroyed)
+	require.Len(t, permissionsOf(user, nil).Permissions, 1)
+
+	// the index can be rebuilt from the markers
+	app.MarkerKeeper.RebuildAccessIndex(ctx)
+	require.Equal(t, "permcoin", permissionsOf(user, nil).Permissions[0].Denom)
+
+	_, err = app.MarkerKeeper.AccountPermissions(sdk.WrapSDKContext(ctx),
+		&types.QueryAccountPermissionsRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestFeeConversion(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	pool := testUserAddress("pool")
+	oracle := testUserAddress("oracle")
+
+	mac := types.NewEmptyMarkerAccount("gascoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.AllowGovernanceControl = false
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("gascoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "gascoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "gascoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "gascoin", sdk.NewCoins(sdk.NewInt64Coin("gascoin", 100))))
+	require.NoError(t, simapp.FundAccount(app, ctx, pool, sdk.NewCoins(sdk.NewInt64Coin("nhash", 30))))
+
+	prop := types.NewSetFeeConversionProposal("title", "description", "gascoin", true, pool, sdk.MustNewDecFromStr("2.5"), oracle)
+	require.EqualError(t, markerkeeper.HandleSetFeeConversionProposal(ctx, app.MarkerKeeper, prop),
+		"gascoin marker does not allow governance control")
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "gascoin")
+	require.NoError(t, err)
+	m.(*types.MarkerAccount).AllowGovernanceControl = true
+	app.MarkerKeeper.SetMarker(ctx, m)
+	require.NoError(t, markerkeeper.HandleSetFeeConversionProposal(ctx, app.MarkerKeeper, prop))
+
+	conversion, found := app.MarkerKeeper.GetFeeConversion(ctx, "gascoin")
+	require.True(t, found)
+	require.Equal(t, prop.FeeConversion(), conversion)
+
+	// only the oracle can update the rate
+	require.EqualError(t, app.MarkerKeeper.SetFeeConversionRate(ctx, user, "gascoin", sdk.OneDec()),
+		fmt.Sprintf("%s is not the oracle of the gascoin fee conversion", user))
+	require.EqualError(t, app.MarkerKeeper.SetFeeConversionRate(ctx, oracle, "othercoin", sdk.OneDec()),
+		"no fee conversion found for othercoin")
+	require.NoError(t, app.MarkerKeeper.SetFeeConversionRate(ctx, oracle, "gascoin", sdk.MustNewDecFromStr("1.5")))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("gascoin", 10), sdk.NewInt64Coin("nhash", 5), sdk.NewInt64Coin("othercoin", 1))
+	converted, ok := app.MarkerKeeper.ConvertedFee(ctx, fee, "nhash")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 20), sdk.NewInt64Coin("othercoin", 1)), converted)
+	_, ok = app.MarkerKeeper.ConvertedFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)), "nhash")
+	require.False(t, ok)
+
+	converted, err = app.MarkerKeeper.ConvertFee(ctx, user, sdk.NewCoins(sdk.NewInt64Coin("gascoin", 10)), "nhash")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 15)), converted)
+	require.Equal(t, "90gascoin,15nhash", app.BankKeeper.GetAllBalances(ctx, user).String())
+	require.Equal(t, "10gascoin,15nhash", app.BankKeeper.GetAllBalances(ctx, pool).String())
+
+	_, err = app.MarkerKeeper.ConvertFee(ctx, user, sdk.NewCoins(sdk.NewInt64Coin("gascoin", 20)), "nhash")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "gascoin fee conversion pool cannot pay 30nhash")
+
+	conversion.Rate = sdk.MustNewDecFromStr("1.5")
+	require.Equal(t, []types.FeeConversion{conversion}, app.MarkerKeeper.ExportGenesis(ctx).FeeConversions)
+
+	// governance can stop accepting the coin for fees
+	require.NoError(t, markerkeeper.HandleSetFeeConversionProposal(ctx, app.MarkerKeeper,
+		types.NewSetFeeConversionProposal("title", "description", "gascoin", false, nil, sdk.Dec{}, nil)))
+	_, found = app.MarkerKeeper.GetFeeConversion(ctx, "gascoin")
+	require.False(t, found)
+}
+
+func TestActivateDerivesDenomMetadata(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+
+	activate := func(denom string, displayExponent uint32) {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Admin})})
+		mac.DisplayExponent = displayExponent
+		require.NoError(t, mac.SetManager(user))
+		require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, denom))
+	}
+
+	// Without a display exponent on the marker or in the params, no metadata is set.
+	activate("plaincoin", 0)
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, "plaincoin")
+	require.False(t, found)
+
+	activate("nderived", 9)
+	md, found := app.BankKeeper.GetDenomMetaData(ctx, "nderived")
+	require.True(t, found)
+	require.Equal(t, "derived", md.Display)
+	require.Equal(t, uint32(9), md.DenomUnits[1].Exponent)
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.DefaultDisplayExponent = 3
+	app.MarkerKeeper.SetParams(ctx, params)
+	activate("defaultcoin", 0)
+	md, found = app.BankKeeper.GetDenomMetaData(ctx, "defaultcoin")
+	require.True(t, found)
+	require.Equal(t, "kdefaultcoin", md.Display)
+
+	// Existing metadata is left as it is.
+	existing := banktypes.Metadata{
+		Base:       "metacoin",
+		Display:    "metacoin",
+		Name:       "Meta",
+		Symbol:     "META",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "metacoin", Exponent: 0}},
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, existing)
+	activate("metacoin", 6)
+	md, _ = app.BankKeeper.GetDenomMetaData(ctx, "metacoin")
+	require.Equal(t, existing, md)
+}
+
+func TestParamsQueryWithUnsetParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// Params added by an upgrade are not in the param store until they are set.
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.ParamStoreKeyDefaultDisplayExponent)
+	paramStore.Delete(types.ParamStoreKeyExpeditedQuorum)
+
+	res, err := app.MarkerKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultDefaultDisplayExponent, res.Params.DefaultDisplayExponent)
+	require.Equal(t, app.MarkerKeeper.GetParams(ctx), res.Params)
+}
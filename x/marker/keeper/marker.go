@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -88,6 +89,69 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 	return nil
 }
 
+// IssueSingleCoin creates an active coin marker for the denom with a fixed supply of one and no access grants, then
+// sends that single coin from the marker to the recipient.
+func (k Keeper) IssueSingleCoin(ctx sdk.Context, denom string, recipient sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "issue_single_coin")
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return fmt.Errorf("%s is not allowed to receive funds", recipient)
+	}
+
+	coin := sdk.NewCoin(denom, sdk.OneInt())
+	marker := types.NewEmptyMarkerAccount(denom, "", nil)
+	marker.AllowGovernanceControl = false
+	if err := marker.SetSupply(coin); err != nil {
+		return err
+	}
+	if err := marker.SetStatus(types.StatusActive); err != nil {
+		return err
+	}
+	if err := k.AddMarkerAccount(ctx, marker); err != nil {
+		return err
+	}
+
+	if err := k.AdjustCirculation(ctx, marker, coin, nil); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, marker.GetAddress(), recipient, sdk.NewCoins(coin))
+}
+
+// HoldsSingleCoin returns true if the denom is the coin of a marker with a supply of one and the holder holds it.
+func (k Keeper) HoldsSingleCoin(ctx sdk.Context, denom string, holder sdk.AccAddress) bool {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return false
+	}
+	coin := sdk.NewCoin(denom, sdk.OneInt())
+	return m.GetSupply().IsEqual(coin) && k.bankKeeper.GetBalance(ctx, holder, denom).IsEqual(coin)
+}
+
+// BurnSingleCoin takes the single coin of the denom back from its holder, burns it, and removes its marker so a new
+// coin of the denom can be issued.
+func (k Keeper) BurnSingleCoin(ctx sdk.Context, denom string, holder sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "burn_single_coin")
+
+	if !k.HoldsSingleCoin(ctx, denom, holder) {
+		return fmt.Errorf("%s does not hold the single %s coin", holder, denom)
+	}
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+	coin := sdk.NewCoin(denom, sdk.OneInt())
+	if err = k.bankKeeper.SendCoins(ctx, holder, m.GetAddress(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	if err = k.DecreaseSupply(ctx, m, coin, holder); err != nil {
+		return err
+	}
+	k.RemoveMarker(ctx, m)
+
+	markerBurnEvent := types.NewEventMarkerBurn(coin.Amount.String(), coin.Denom, holder.String())
+	return ctx.EventManager().EmitTypedEvent(markerBurnEvent)
+}
+
 // AddAccess adds the provided AccessGrant to the marker of the caller is allowed to make changes
 func (k Keeper) AddAccess(
 	ctx sdk.Context, caller sdk.AccAddress, denom string, grant types.AccessGrantI,
@@ -127,7 +191,7 @@ func (k Keeper) AddAccess(
 		return err
 	}
 
-	return nil
+	return k.recordAccessChange(ctx, denom, caller, grant.GetAddress(), grant.GetAccessList(), types.AccessChangeAction_Grant)
 }
 
 // RemoveAccess delete the AccessGrant for the specified user from the marker if the caller is allowed to make changes
@@ -139,6 +203,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", denom, err)
 	}
+	var revoked types.AccessList
 	switch m.GetStatus() {
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
@@ -153,6 +218,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr.String())
 		}
+		revoked = types.GrantsForAddress(remove, m.GetAccessList()...).GetAccessList()
 		if err = m.RevokeAccess(remove); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -167,7 +233,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		return err
 	}
 
-	return nil
+	return k.recordAccessChange(ctx, denom, caller, remove, revoked, types.AccessChangeAction_Revoke)
 }
 
 // WithdrawCoins removes the specified coins from the MarkerAccount (both marker denominated coins and coins as assets
@@ -182,6 +248,73 @@ func (k Keeper) WithdrawCoins(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", denom, err)
 	}
+	if err = k.checkWithdrawal(ctx, m, caller, coins); err != nil {
+		return err
+	}
+
+	if recipient.Empty() {
+		recipient = caller
+	}
+	return k.sendWithdrawal(ctx, m, caller, recipient, coins)
+}
+
+// SweepEscrow withdraws every coin held by the marker account other than the marker's own coin, the reserve of a
+// basket marker and the excluded denoms, sending them to the recipient (the caller when empty) in a single withdrawal.
+// Coins the marker's withdraw approval policy would hold for approval must be withdrawn with a withdraw request.
+func (k Keeper) SweepEscrow(
+	ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, excludeDenoms []string,
+) (sdk.Coins, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "sweep_escrow")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	excluded := map[string]bool{m.GetDenom(): true}
+	for _, d := range excludeDenoms {
+		excluded[d] = true
+	}
+	if basket, found := k.GetBasket(ctx, m.GetDenom()); found {
+		for _, reserve := range basket.ReservePerUnit {
+			excluded[reserve.Denom] = true
+		}
+	}
+	coins := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, m.GetAddress()) {
+		if !excluded[coin.Denom] {
+			coins = coins.Add(coin)
+		}
+	}
+	if coins.Empty() {
+		return nil, fmt.Errorf("%s markeraccount does not hold any coins to sweep", m.GetDenom())
+	}
+	if err = k.checkWithdrawal(ctx, m, caller, coins); err != nil {
+		return nil, err
+	}
+	if policy := m.GetWithdrawApprovalPolicy(); policy != nil && policy.RequiresApproval(coins) {
+		return nil, fmt.Errorf("sweeping %s from %s markeraccount requires approval, use a withdraw request", coins,
+			m.GetDenom())
+	}
+
+	if recipient.Empty() {
+		recipient = caller
+	}
+	if err = k.sendWithdrawal(ctx, m, caller, recipient, coins); err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
+// checkWithdrawal returns an error if the caller cannot withdraw the coins from the marker.
+func (k Keeper) checkWithdrawal(ctx sdk.Context, m types.MarkerAccountI, caller sdk.AccAddress, coins sdk.Coins) error {
+	// the reserve of a basket can only leave escrow through redemptions
+	if basket, found := k.GetBasket(ctx, m.GetDenom()); found {
+		for _, coin := range coins {
+			if !basket.ReservePerUnit.AmountOf(coin.Denom).IsZero() {
+				return fmt.Errorf("cannot withdraw %s reserve from basket marker %s", coin.Denom, m.GetDenom())
+			}
+		}
+	}
 	if !m.AddressHasAccess(caller, types.Access_Withdraw) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
 	}
@@ -190,17 +323,24 @@ func (k Keeper) WithdrawCoins(
 	if m.GetStatus() != types.StatusActive {
 		return fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
 	}
-
-	if recipient.Empty() {
-		recipient = caller
+	if m.IsPaused() && !coins.AmountOf(m.GetDenom()).IsZero() {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "cannot withdraw %s", m.GetDenom())
 	}
+	return nil
+}
 
+// sendWithdrawal sends the coins withdrawn by the caller from the marker to the recipient.
+func (k Keeper) sendWithdrawal(
+	ctx sdk.Context, m types.MarkerAccountI, caller sdk.AccAddress, recipient sdk.AccAddress, coins sdk.Coins,
+) error {
 	if err := k.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
 		[]banktypes.Output{banktypes.NewOutput(recipient, coins)}); err != nil {
 		return err
 	}
+	k.updateMarkerTotals(ctx, m.GetAddress())
+	k.updateMarkerTotals(ctx, recipient)
 
-	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), denom, caller.String(), recipient.String())
+	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), m.GetDenom(), caller.String(), recipient.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerWithdrawEvent); err != nil {
 		return err
 	}
@@ -219,6 +359,9 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
+	if m.GetMarkerType() == types.MarkerType_Basket {
+		return fmt.Errorf("cannot mint coin for basket marker %s, supply only changes with reserve deposits and redemptions", m.GetDenom())
+	}
 	if !m.AddressHasAccess(caller, types.Access_Mint) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
 	}
@@ -236,7 +379,7 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		return fmt.Errorf("cannot mint coin for a marker that is not in Active status")
 	default:
 		// Increase the tracked supply value for the marker.
-		err = k.IncreaseSupply(ctx, m, coin)
+		err = k.IncreaseSupply(ctx, m, coin, caller)
 		if err != nil {
 			return err
 		}
@@ -259,6 +402,9 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
+	if m.GetMarkerType() == types.MarkerType_Basket {
+		return fmt.Errorf("cannot burn coin for basket marker %s, supply only changes with reserve deposits and redemptions", m.GetDenom())
+	}
 	if !m.AddressHasAccess(caller, types.Access_Burn) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Burn, m.GetDenom())
 	}
@@ -275,7 +421,7 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	case m.GetStatus() != types.StatusActive:
 		return fmt.Errorf("cannot mint coin for a marker that is not in Active status")
 	default:
-		err = k.DecreaseSupply(ctx, m, coin)
+		err = k.DecreaseSupply(ctx, m, coin, caller)
 		if err != nil {
 			return err
 		}
@@ -299,8 +445,11 @@ func (k Keeper) CurrentEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
 }
 
-// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation
-func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI, desiredSupply sdk.Coin) error {
+// AdjustCirculation will mint/burn coin if required to ensure desired supply matches amount in circulation, the change
+// is recorded in the supply history of the marker as made by the actor (empty for changes made by the chain itself)
+func (k Keeper) AdjustCirculation(
+	ctx sdk.Context, marker types.MarkerAccountI, desiredSupply sdk.Coin, actor sdk.AccAddress,
+) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "adjust_circulation")
 
 	currentSupply := k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
@@ -336,13 +485,19 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 			return fmt.Errorf("could not burn coin %v %w", offset, err)
 		}
 	}
+	k.recordSupplyChange(ctx, marker, desiredSupply.Amount.Sub(currentSupply), actor)
+	k.updateMarkerTotals(ctx, marker.GetAddress())
 	return nil
 }
 
 // IncreaseSupply will mint coins to the marker module coin pool account, then send these to the marker account
-func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
+func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin, actor sdk.AccAddress) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "increase_supply")
 
+	if marker.IsPaused() {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "cannot mint %s", marker.GetDenom())
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 	total := inCirculation.Add(coin)
 	maxAllowed := k.GetParams(ctx).MaxTotalSupply
@@ -359,13 +514,17 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		k.SetMarker(ctx, marker)
 	}
 
-	return k.AdjustCirculation(ctx, marker, total)
+	return k.AdjustCirculation(ctx, marker, total, actor)
 }
 
 // DecreaseSupply will move a given amount of coin from the marker to the markermodule coin pool account then burn it.
-func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
+func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin, actor sdk.AccAddress) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "decrease_supply")
 
+	if marker.IsPaused() {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "cannot burn %s", marker.GetDenom())
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 
 	// Ensure the request will not send the total supply below zero
@@ -388,7 +547,7 @@ func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 	}
 
 	// Adjust circulation to match configured supply.
-	if err := k.AdjustCirculation(ctx, marker, inCirculation); err != nil {
+	if err := k.AdjustCirculation(ctx, marker, inCirculation, actor); err != nil {
 		panic(err)
 	}
 
@@ -448,6 +607,32 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	return nil
 }
 
+// deriveDenomMetadata sets denom metadata with a display unit for an activated marker whose denom has none.  The
+// display exponent of the marker is used, or the default display exponent param when it is zero.  Nothing is set when
+// both are zero or no valid metadata can be derived for the denom.
+func (k Keeper) deriveDenomMetadata(ctx sdk.Context, m types.MarkerAccountI, caller sdk.Address) error {
+	if existing, _ := k.bankKeeper.GetDenomMetaData(ctx, m.GetDenom()); len(existing.Base) > 0 {
+		return nil
+	}
+	exponent := m.GetDisplayExponent()
+	if exponent == 0 {
+		exponent = k.GetDefaultDisplayExponent(ctx)
+	}
+	if exponent == 0 {
+		return nil
+	}
+	metadata, err := types.DeriveDenomMetadata(m.GetDenom(), exponent)
+	if err == nil {
+		err = k.ValidateDenomMetadata(ctx, metadata, nil, m.GetStatus())
+	}
+	if err != nil {
+		ctx.Logger().Info("no denom metadata derived for marker", "denom", m.GetDenom(), "exponent", exponent, "err", err)
+		return nil
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetDenomMetadata(metadata, caller.String()))
+}
+
 // ActivateMarker transistions a marker into the active status, enforcing permissions, supply constraints, and minting
 // any supply as required.
 func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string) error {
@@ -471,6 +656,11 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	switch m.GetMarkerType() {
 	case types.MarkerType_Coin:
 		k.ensureSendEnabledStatus(ctx, denom, true)
+	case types.MarkerType_Basket:
+		if _, found := k.GetBasket(ctx, denom); !found {
+			return fmt.Errorf("no reserve defined for basket marker %s", denom)
+		}
+		k.ensureSendEnabledStatus(ctx, denom, true)
 	case types.MarkerType_RestrictedCoin:
 		k.ensureSendEnabledStatus(ctx, denom, false)
 	default:
@@ -490,7 +680,7 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	}
 
 	// Ensure the supply amount requested is minted and placed in the marker's account
-	if err = k.AdjustCirculation(ctx, m, supplyRequest); err != nil {
+	if err = k.AdjustCirculation(ctx, m, supplyRequest, sdk.AccAddress(caller.Bytes())); err != nil {
 		return err
 	}
 
@@ -503,6 +693,10 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// record status as active
 	k.SetMarker(ctx, m)
 
+	if err = k.deriveDenomMetadata(ctx, m, caller); err != nil {
+		return err
+	}
+
 	markerActivateEvent := types.NewEventMarkerActivate(denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerActivateEvent); err != nil {
 		return err
@@ -586,7 +780,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 			" ensure marker account holds the entire supply of %s", inCirculation, totalSupply, denom)
 	}
 
-	err = k.DecreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply))
+	err = k.DecreaseSupply(ctx, m, sdk.NewCoin(denom, totalSupply), caller)
 	if err != nil {
 		return fmt.Errorf("could not decrease marker supply %s: %s", denom, err)
 	}
@@ -619,30 +813,24 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "transfer_coin")
 
-	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
-	if err != nil {
-		return fmt.Errorf("marker not found for %s: %s", amount.Denom, err)
+	if denials := k.CheckTransfer(ctx, from, to, admin, amount); len(denials) > 0 {
+		return denials[0].Err()
 	}
-	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
-		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
-	}
-	if !m.AddressHasAccess(admin, types.Access_Transfer) {
-		return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
+	if err := k.CheckTransferMemo(ctx, amount.Denom, types.TxMemo(ctx)); err != nil {
+		return err
 	}
 	if !admin.Equals(from) {
-		err = k.authzHandler(ctx, admin, from, amount)
-		if err != nil {
+		if err := k.authzHandler(ctx, admin, from, amount); err != nil {
 			return err
 		}
 	}
-	if k.bankKeeper.BlockedAddr(to) {
-		return fmt.Errorf("%s is not allowed to receive funds", to)
-	}
 
 	// send the coins between accounts (does not check send_enabled on coin denom)
-	if err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
 		return err
 	}
+	k.updateMarkerTotals(ctx, from)
+	k.updateMarkerTotals(ctx, to)
 
 	markerTransferEvent := types.NewEventMarkerTransfer(
 		amount.Amount.String(),
@@ -658,6 +846,59 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	return nil
 }
 
+// CheckTransfer evaluates the rules of a transfer of restricted coins brokered by the administrator without changing
+// any state and returns each reason the transfer would be denied.
+func (k Keeper) CheckTransfer(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) []types.TransferDenial {
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err != nil {
+		return []types.TransferDenial{types.NewTransferDenial(types.TransferDenyReason_MarkerNotFound,
+			"marker not found for %s: %s", amount.Denom, err)}
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return []types.TransferDenial{types.NewTransferDenial(types.TransferDenyReason_NotRestricted,
+			"marker %s is not a restricted coin, brokered transfer not supported", amount.Denom)}
+	}
+
+	var denials []types.TransferDenial
+	if m.IsPaused() {
+		denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_Paused,
+			"marker %s is paused", amount.Denom))
+	}
+	if !m.AddressHasAccess(admin, types.Access_Transfer) {
+		denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_NoTransferGrant,
+			"%s is not allowed to broker transfers", admin))
+	}
+	if !admin.Equals(from) {
+		markerAuth := types.MarkerTransferAuthorization{}
+		authorization, _ := k.authzKeeper.GetCleanAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
+		if authorization == nil {
+			denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_NoAuthorization,
+				"%s account has not been granted authority to withdraw from %s account", admin, from))
+		} else if _, err = authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount}); err != nil {
+			denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_AuthorizationLimit,
+				"%s is more than %s is authorized to transfer from %s", amount, admin, from))
+		}
+	}
+	if err = k.CheckDeposit(ctx, admin, to); err != nil {
+		denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_NoDepositGrant,
+			"%s is not allowed to deposit into %s", admin, to))
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_OnDenyList,
+			"%s is not allowed to receive funds", to))
+	}
+	if k.restrictionChecker != nil {
+		if err = k.restrictionChecker.CheckTransferRestriction(ctx, m, from, to, admin, amount); err != nil {
+			denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_Vetoed, "%s", err))
+		}
+	}
+	if spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(amount.Denom); spendable.LT(amount.Amount) {
+		denials = append(denials, types.NewTransferDenial(types.TransferDenyReason_InsufficientFunds,
+			"%s has %s%s spendable, %s required", from, spendable, amount.Denom, amount))
+	}
+	return denials
+}
+
 func (k Keeper) authzHandler(ctx sdk.Context, admin sdk.AccAddress, from sdk.AccAddress, amount sdk.Coin) error {
 	markerAuth := types.MarkerTransferAuthorization{}
 	authorization, expireTime := k.authzKeeper.GetCleanAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
@@ -716,6 +957,182 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	return nil
 }
 
+// UpdateMarkerFlags changes the supply fixed and governance control flags of a marker that has not been activated yet.
+func (k Keeper) UpdateMarkerFlags(
+	ctx sdk.Context, caller sdk.AccAddress, denom string, supplyFixed bool, allowGovernanceControl bool,
+) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "update_flags")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	// once active the flags can only be changed through governance
+	if m.GetStatus() != types.StatusProposed && m.GetStatus() != types.StatusFinalized {
+		return fmt.Errorf("can only update the flags of markeraccounts in the Proposed or Finalized status")
+	}
+	if !m.GetManager().Equals(caller) && !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s is not allowed to update the flags of %s marker", caller, denom)
+	}
+
+	return k.setMarkerFlags(ctx, m, supplyFixed, allowGovernanceControl, caller.String())
+}
+
+// setMarkerFlags records the supply fixed and governance control flags on the marker and emits an update event.
+func (k Keeper) setMarkerFlags(
+	ctx sdk.Context, m types.MarkerAccountI, supplyFixed bool, allowGovernanceControl bool, admin string,
+) error {
+	m.SetFixedSupply(supplyFixed)
+	m.SetGovernanceEnabled(allowGovernanceControl)
+
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	k.SetMarker(ctx, m)
+
+	markerUpdateFlagsEvent := types.NewEventMarkerUpdateFlags(m.GetDenom(), supplyFixed, allowGovernanceControl, admin)
+	return ctx.EventManager().EmitTypedEvent(markerUpdateFlagsEvent)
+}
+
+// PauseMarker blocks all transfers, mints and burns of an active marker's coin until it is resumed, without changing
+// its status.  The caller must hold the admin access on the marker.
+func (k Keeper) PauseMarker(ctx sdk.Context, caller sdk.AccAddress, denom string) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "pause")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	return k.setMarkerPaused(ctx, m, true, caller.String())
+}
+
+// ResumeMarker lifts the pause of a marker.  The caller must hold the admin access on the marker.
+func (k Keeper) ResumeMarker(ctx sdk.Context, caller sdk.AccAddress, denom string) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "resume")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	return k.setMarkerPaused(ctx, m, false, caller.String())
+}
+
+// setMarkerPaused records the paused flag on the marker and emits a paused or resumed event.
+func (k Keeper) setMarkerPaused(ctx sdk.Context, m types.MarkerAccountI, paused bool, admin string) error {
+	if paused {
+		if m.GetStatus() != types.StatusActive {
+			return fmt.Errorf("can only pause markeraccounts in the Active status")
+		}
+		if m.IsPaused() {
+			return fmt.Errorf("marker %s is already paused", m.GetDenom())
+		}
+	} else if !m.IsPaused() {
+		return fmt.Errorf("marker %s is not paused", m.GetDenom())
+	}
+
+	m.SetPaused(paused)
+	k.SetMarker(ctx, m)
+
+	if paused {
+		return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerPaused(m.GetDenom(), admin))
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerResumed(m.GetDenom(), admin))
+}
+
+// isDenomPaused returns true if the denom is the coin of a paused marker.
+func (k Keeper) isDenomPaused(ctx sdk.Context, denom string) bool {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	return err == nil && m.IsPaused()
+}
+
+// SetDepositControl sets whether coins can only be sent to the marker account by addresses with the deposit access on
+// the marker.  The caller must hold the admin access on the marker.
+func (k Keeper) SetDepositControl(ctx sdk.Context, caller sdk.AccAddress, denom string, restrictDeposits bool) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "deposit_control")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return fmt.Errorf("cannot set the deposit control of a markeraccount in the %s status", m.GetStatus())
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+
+	m.SetRestrictDeposits(restrictDeposits)
+	k.SetMarker(ctx, m)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerDepositControl(m.GetDenom(), restrictDeposits, caller.String()))
+}
+
+// CheckDeposit returns an error if the recipient is a marker account that restricts deposits and the sender does not
+// hold the deposit access on it.  Sends to any other account are always allowed.
+func (k Keeper) CheckDeposit(ctx sdk.Context, from, to sdk.AccAddress) error {
+	m, err := k.GetMarker(ctx, to)
+	if err != nil || m == nil || !m.RestrictsDeposits() {
+		return nil
+	}
+	if !m.AddressHasAccess(from, types.Access_Deposit) {
+		return sdkerrors.Wrapf(types.ErrNoDepositGrant, "%s cannot send coins to %s markeraccount", from, m.GetDenom())
+	}
+	return nil
+}
+
+// SetTransferMemoRequirement sets the pattern the whole memo of a transaction transferring the coin of a restricted
+// marker must match, an empty pattern removes the requirement.  The caller must hold the admin access on the marker.
+func (k Keeper) SetTransferMemoRequirement(ctx sdk.Context, caller sdk.AccAddress, denom string, pattern string) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "transfer_memo_requirement")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("cannot require a transfer memo for %s: %w", denom, types.ErrNotRestrictedCoin)
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return fmt.Errorf("cannot set the transfer memo requirement of a markeraccount in the %s status", m.GetStatus())
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if err = types.ValidateTransferMemoPattern(pattern); err != nil {
+		return err
+	}
+
+	m.SetTransferMemoPattern(pattern)
+	k.SetMarker(ctx, m)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferMemoRequirement(m.GetDenom(), pattern, caller.String()))
+}
+
+// CheckTransferMemo returns an error if the denom is the coin of a restricted marker that requires a transfer memo and
+// the memo does not match its pattern.  Transfers of any other coin do not require a memo.
+func (k Keeper) CheckTransferMemo(ctx sdk.Context, denom string, memo string) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil || m.GetMarkerType() != types.MarkerType_RestrictedCoin || len(m.GetTransferMemoPattern()) == 0 {
+		return nil
+	}
+	matches, err := types.TransferMemoMatches(m.GetTransferMemoPattern(), memo)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrTransferMemoRequired, "invalid memo pattern on %s markeraccount: %s", denom, err)
+	}
+	if !matches {
+		return sdkerrors.Wrapf(types.ErrTransferMemoRequired, "transfers of %s require a memo matching %q, got %q",
+			denom, m.GetTransferMemoPattern(), memo)
+	}
+	return nil
+}
+
 // accountControlsAllSupply return true if the caller account address possess 100% of the total supply of a marker.
 // This check is used to determine if an account should be allowed to perform defacto admin operations on a marker.
 func (k Keeper) accountControlsAllSupply(ctx sdk.Context, caller sdk.AccAddress, m types.MarkerAccountI) bool {
@@ -19,3 +19,15 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v042.MigrateMarkerAddressKeys(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by computing the marker totals from the existing markers.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.RebuildMarkerTotals(ctx)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4 by indexing the markers each address has been granted access to.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.RebuildAccessIndex(ctx)
+	return nil
+}
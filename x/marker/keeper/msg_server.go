@@ -62,6 +62,7 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		msg.Status,
 		msg.MarkerType)
 	ma.SupplyFixed = msg.SupplyFixed
+	ma.DisplayExponent = msg.DisplayExponent
 
 	if k.GetEnableGovernance(ctx) {
 		ma.AllowGovernanceControl = true
@@ -73,6 +74,11 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		ctx.Logger().Error("unable to add marker", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if msg.MarkerType == types.MarkerType_Basket {
+		if err := k.addBasket(ctx, types.NewBasket(msg.Amount.Denom, msg.BasketReservePerUnit)); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -93,8 +99,17 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	for i := range msg.Access {
-		access := msg.Access[i]
+	grants := append([]types.AccessGrant{}, msg.Access...)
+	for _, roleGrant := range msg.Roles {
+		role, found := k.Keeper.GetAccessRole(ctx, roleGrant.Role)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "access role %s is not defined", roleGrant.Role)
+		}
+		grants = append(grants, roleGrant.AsAccessGrant(role))
+	}
+
+	for i := range grants {
+		access := grants[i]
 		if err := k.Keeper.AddAccess(ctx, msg.GetSigners()[0], msg.Denom, &access); err != nil {
 			ctx.Logger().Error("unable to add access grant to marker", "err", err)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
@@ -321,7 +336,8 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 		return nil, err
 	}
 
-	if err := k.Keeper.WithdrawCoins(ctx, msg.GetSigners()[0], to, msg.Denom, msg.Amount); err != nil {
+	pendingID, err := k.Keeper.RequestWithdrawal(ctx, msg.GetSigners()[0], to, msg.Denom, msg.Amount)
+	if err != nil {
 		ctx.Logger().Error("unable to withdraw coins from marker", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -354,7 +370,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 		}
 	}()
 
-	return &types.MsgWithdrawResponse{}, nil
+	return &types.MsgWithdrawResponse{PendingWithdrawalId: pendingID}, nil
 }
 
 // Transfer handles a message to send coins from one account to another (used with restricted coins that are not
@@ -446,3 +462,607 @@ func (k msgServer) SetDenomMetadata(
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+// UpdateFlags handles a message changing the supply fixed and governance control flags of a marker.
+func (k msgServer) UpdateFlags(
+	goCtx context.Context,
+	msg *types.MsgUpdateMarkerFlagsRequest,
+) (*types.MsgUpdateMarkerFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, addrErr := sdk.AccAddressFromBech32(msg.Administrator)
+	if addrErr != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, addrErr.Error())
+	}
+
+	err := k.UpdateMarkerFlags(ctx, admin, msg.Denom, msg.SupplyFixed, msg.AllowGovernanceControl)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUpdateMarkerFlagsResponse{}, nil
+}
+
+// DepositAndMint handles a message depositing reserve coins into a basket marker to mint basket coin.
+func (k msgServer) DepositAndMint(
+	goCtx context.Context,
+	msg *types.MsgDepositAndMintRequest,
+) (*types.MsgDepositAndMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.DepositAndMint(ctx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDepositAndMintResponse{}, nil
+}
+
+// BurnAndRedeem handles a message burning basket coin to redeem the reserve coins held for it.
+func (k msgServer) BurnAndRedeem(
+	goCtx context.Context,
+	msg *types.MsgBurnAndRedeemRequest,
+) (*types.MsgBurnAndRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.BurnAndRedeem(ctx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgBurnAndRedeemResponse{}, nil
+}
+
+// DistributeEscrow handles a message paying coins from the escrow of a marker to the holders of its denom.
+func (k msgServer) DistributeEscrow(
+	goCtx context.Context,
+	msg *types.MsgDistributeEscrowRequest,
+) (*types.MsgDistributeEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id, err := k.Keeper.DistributeEscrow(ctx, admin, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDistributeEscrowResponse{DistributionId: id}, nil
+}
+
+// AddEmissionSchedule handles a message scheduling the emission of marker coin every interval of blocks.
+func (k msgServer) AddEmissionSchedule(
+	goCtx context.Context,
+	msg *types.MsgAddEmissionScheduleRequest,
+) (*types.MsgAddEmissionScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id, err := k.Keeper.AddEmissionSchedule(ctx, admin, msg.Recipient, msg.Amount, msg.Interval, msg.Emissions, msg.StartHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgAddEmissionScheduleResponse{ScheduleId: id}, nil
+}
+
+// CancelEmissionSchedule handles a message removing an emission schedule before it completes.
+func (k msgServer) CancelEmissionSchedule(
+	goCtx context.Context,
+	msg *types.MsgCancelEmissionScheduleRequest,
+) (*types.MsgCancelEmissionScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.CancelEmissionSchedule(ctx, admin, msg.ScheduleId); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgCancelEmissionScheduleResponse{}, nil
+}
+
+// ScheduleTransfer handles a message escrowing a restricted coin transfer that is released at a future height or time.
+func (k msgServer) ScheduleTransfer(
+	goCtx context.Context,
+	msg *types.MsgScheduleTransferRequest,
+) (*types.MsgScheduleTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id, err := k.Keeper.ScheduleTransfer(ctx, admin, from, to, msg.Amount, msg.ReleaseHeight, msg.ReleaseTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgScheduleTransferResponse{TransferId: id}, nil
+}
+
+// CancelScheduledTransfer handles a message returning the coin of a scheduled transfer to its sender.
+func (k msgServer) CancelScheduledTransfer(
+	goCtx context.Context,
+	msg *types.MsgCancelScheduledTransferRequest,
+) (*types.MsgCancelScheduledTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.CancelScheduledTransfer(ctx, admin, msg.TransferId); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgCancelScheduledTransferResponse{}, nil
+}
+
+// ClaimScheduledTransfer handles a message from the recipient releasing a scheduled transfer that is due.
+func (k msgServer) ClaimScheduledTransfer(
+	goCtx context.Context,
+	msg *types.MsgClaimScheduledTransferRequest,
+) (*types.MsgClaimScheduledTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.ClaimScheduledTransfer(ctx, to, msg.TransferId); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgClaimScheduledTransferResponse{}, nil
+}
+
+// PauseMarker handles a message blocking all transfers, mints and burns of a marker's coin.
+func (k msgServer) PauseMarker(goCtx context.Context, msg *types.MsgPauseMarkerRequest) (*types.MsgPauseMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.PauseMarker(ctx, admin, msg.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgPauseMarkerResponse{}, nil
+}
+
+// ResumeMarker handles a message lifting the pause of a marker.
+func (k msgServer) ResumeMarker(goCtx context.Context, msg *types.MsgResumeMarkerRequest) (*types.MsgResumeMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.ResumeMarker(ctx, admin, msg.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgResumeMarkerResponse{}, nil
+}
+
+// SetDepositControl handles a message setting whether coins can only be sent to a marker by addresses with the deposit
+// access on it.
+func (k msgServer) SetDepositControl(
+	goCtx context.Context,
+	msg *types.MsgSetDepositControlRequest,
+) (*types.MsgSetDepositControlResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.SetDepositControl(ctx, admin, msg.Denom, msg.RestrictDeposits); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetDepositControlResponse{}, nil
+}
+
+// SetTransferMemoRequirement handles a message setting the pattern the memo of a transfer of a restricted marker's coin
+// must match.
+func (k msgServer) SetTransferMemoRequirement(
+	goCtx context.Context,
+	msg *types.MsgSetTransferMemoRequirementRequest,
+) (*types.MsgSetTransferMemoRequirementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.SetTransferMemoRequirement(ctx, admin, msg.Denom, msg.TransferMemoPattern); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetTransferMemoRequirementResponse{}, nil
+}
+
+// SetWithdrawApprovalPolicy handles a message setting the amount above which a withdrawal from a marker requires
+// additional approvals.
+func (k msgServer) SetWithdrawApprovalPolicy(
+	goCtx context.Context,
+	msg *types.MsgSetWithdrawApprovalPolicyRequest,
+) (*types.MsgSetWithdrawApprovalPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var policy *types.WithdrawApprovalPolicy
+	if !msg.Threshold.Empty() {
+		policy = types.NewWithdrawApprovalPolicy(msg.Threshold, msg.ApprovalsRequired, msg.ApprovalPeriod)
+	}
+	if err = k.Keeper.SetWithdrawApprovalPolicy(ctx, admin, msg.Denom, policy); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetWithdrawApprovalPolicyResponse{}, nil
+}
+
+// ApproveWithdraw handles a message from an administrator approving a pending withdrawal.
+func (k msgServer) ApproveWithdraw(
+	goCtx context.Context,
+	msg *types.MsgApproveWithdrawRequest,
+) (*types.MsgApproveWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	executed, err := k.Keeper.ApproveWithdrawal(ctx, admin, msg.WithdrawalId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgApproveWithdrawResponse{Executed: executed}, nil
+}
+
+// SetFaucet handles a message setting the amount of a marker's coin any address may claim once per period.
+func (k msgServer) SetFaucet(goCtx context.Context, msg *types.MsgSetFaucetRequest) (*types.MsgSetFaucetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var faucet *types.MarkerFaucet
+	if !msg.DripAmount.IsZero() {
+		faucet = types.NewMarkerFaucet(msg.Denom, admin, msg.DripAmount, msg.Period, msg.Mint)
+	}
+	if err = k.Keeper.SetFaucet(ctx, admin, msg.Denom, faucet); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetFaucetResponse{}, nil
+}
+
+// ClaimFaucet handles a message claiming the drip amount of a marker's faucet.
+func (k msgServer) ClaimFaucet(goCtx context.Context, msg *types.MsgClaimFaucetRequest) (*types.MsgClaimFaucetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	coin, next, err := k.Keeper.ClaimFaucet(ctx, claimer, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgClaimFaucetResponse{Amount: coin, NextClaimTime: next}, nil
+}
+
+// SetFeeConversionRate updates the rate of a marker's fee conversion, signed by its oracle
+func (k msgServer) SetFeeConversionRate(goCtx context.Context, msg *types.MsgSetFeeConversionRateRequest) (*types.MsgSetFeeConversionRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	oracle, err := sdk.AccAddressFromBech32(msg.Oracle)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err = k.Keeper.SetFeeConversionRate(ctx, oracle, msg.Denom, msg.Rate); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetFeeConversionRateResponse{}, nil
+}
+
+// SweepEscrow handles a message withdrawing all coins other than the marker's own from the marker account.
+func (k msgServer) SweepEscrow(goCtx context.Context, msg *types.MsgSweepEscrowRequest) (*types.MsgSweepEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	var to sdk.AccAddress
+	if len(msg.ToAddress) > 0 {
+		if to, err = sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	swept, err := k.Keeper.SweepEscrow(ctx, admin, to, msg.Denom, msg.ExcludeDenoms)
+	if err != nil {
+		ctx.Logger().Error("unable to sweep escrow of marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSweepEscrowResponse{Amount: swept}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,9 +13,19 @@ import (
 // GetParams returns the total set of distribution parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		MaxTotalSupply:         k.GetMaxTotalSupply(ctx),
-		EnableGovernance:       k.GetEnableGovernance(ctx),
-		UnrestrictedDenomRegex: k.GetUnrestrictedDenomRegex(ctx),
+		MaxTotalSupply:              k.GetMaxTotalSupply(ctx),
+		EnableGovernance:            k.GetEnableGovernance(ctx),
+		UnrestrictedDenomRegex:      k.GetUnrestrictedDenomRegex(ctx),
+		ExpeditedVotingPeriod:       k.GetExpeditedVotingPeriod(ctx),
+		ExpeditedQuorum:             k.GetExpeditedQuorum(ctx),
+		AccessRoles:                 k.GetAccessRoles(ctx),
+		MaxDistributionHolders:      k.GetMaxDistributionHolders(ctx),
+		DistributionHoldersPerBlock: k.GetDistributionHoldersPerBlock(ctx),
+		MaxEmissionPerBlock:         k.GetMaxEmissionPerBlock(ctx),
+		SupplyHistoryWindow:         k.GetSupplyHistoryWindow(ctx),
+		EnableFaucet:                k.GetEnableFaucet(ctx),
+		AccessLogWindow:             k.GetAccessLogWindow(ctx),
+		DefaultDisplayExponent:      k.GetDefaultDisplayExponent(ctx),
 	}
 }
 
@@ -54,6 +65,108 @@ func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	return
 }
 
+// GetExpeditedVotingPeriod returns the current parameter value for the expedited proposal voting period (or default if unset)
+func (k Keeper) GetExpeditedVotingPeriod(ctx sdk.Context) (period time.Duration) {
+	period = types.DefaultExpeditedVotingPeriod
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyExpeditedVotingPeriod) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyExpeditedVotingPeriod, &period)
+	}
+	return
+}
+
+// GetExpeditedQuorum returns the current parameter value for the expedited proposal quorum (or default if unset)
+func (k Keeper) GetExpeditedQuorum(ctx sdk.Context) (quorum sdk.Dec) {
+	quorum = types.DefaultExpeditedQuorum
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyExpeditedQuorum) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyExpeditedQuorum, &quorum)
+	}
+	return
+}
+
+// GetAccessRoles returns the current parameter value for the named access roles (or default if unset)
+func (k Keeper) GetAccessRoles(ctx sdk.Context) (roles []types.AccessRole) {
+	roles = types.DefaultAccessRoles
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyAccessRoles) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyAccessRoles, &roles)
+	}
+	return
+}
+
+// GetAccessRole returns the access role with the given name from the module params.
+func (k Keeper) GetAccessRole(ctx sdk.Context, name string) (types.AccessRole, bool) {
+	return types.FindAccessRole(k.GetAccessRoles(ctx), name)
+}
+
+// GetMaxDistributionHolders returns the current parameter value for the maximum number of holders an escrow
+// distribution may pay (or default if unset)
+func (k Keeper) GetMaxDistributionHolders(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxDistributionHolders
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxDistributionHolders) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxDistributionHolders, &max)
+	}
+	return
+}
+
+// GetDistributionHoldersPerBlock returns the current parameter value for the number of holders paid by escrow
+// distributions in each block (or default if unset)
+func (k Keeper) GetDistributionHoldersPerBlock(ctx sdk.Context) (holders uint32) {
+	holders = types.DefaultDistributionHoldersPerBlock
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDistributionHoldersPerBlock) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDistributionHoldersPerBlock, &holders)
+	}
+	return
+}
+
+// GetMaxEmissionPerBlock returns the current parameter value for the maximum amount an emission schedule may mint per
+// block (or default if unset)
+func (k Keeper) GetMaxEmissionPerBlock(ctx sdk.Context) (max uint64) {
+	max = types.DefaultMaxEmissionPerBlock
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxEmissionPerBlock) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxEmissionPerBlock, &max)
+	}
+	return
+}
+
+// GetSupplyHistoryWindow returns the current parameter value for the number of blocks the supply changes of the
+// markers are kept for (or default if unset)
+func (k Keeper) GetSupplyHistoryWindow(ctx sdk.Context) (window uint64) {
+	window = types.DefaultSupplyHistoryWindow
+	if k.paramSpace.Has(ctx, types.ParamStoreKeySupplyHistoryWindow) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeySupplyHistoryWindow, &window)
+	}
+	return
+}
+
+// GetEnableFaucet returns the current parameter value for whether marker faucets may be set and claimed from (or
+// default if unset)
+func (k Keeper) GetEnableFaucet(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultEnableFaucet
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyEnableFaucet) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyEnableFaucet, &enabled)
+	}
+	return
+}
+
+// GetAccessLogWindow returns the current parameter value for the number of blocks the access changes of the markers
+// are kept for (or default if unset)
+func (k Keeper) GetAccessLogWindow(ctx sdk.Context) (window uint64) {
+	window = types.DefaultAccessLogWindow
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyAccessLogWindow) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyAccessLogWindow, &window)
+	}
+	return
+}
+
+// GetDefaultDisplayExponent returns the current parameter value for the display unit exponent of the denom metadata
+// derived for markers activated without any (or default if unset)
+func (k Keeper) GetDefaultDisplayExponent(ctx sdk.Context) (exponent uint32) {
+	exponent = types.DefaultDefaultDisplayExponent
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDefaultDisplayExponent) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDefaultDisplayExponent, &exponent)
+	}
+	return
+}
+
 // ValidateUnrestictedDenom checks if the supplied denom is valid based on the module params
 func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	// Anchors are enforced on the denom validation expression.  Similar to how the SDK does hits.
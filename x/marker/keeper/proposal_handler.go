@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -45,7 +48,7 @@ func HandleAddMarkerProposal(ctx sdk.Context, k Keeper, c *types.AddMarkerPropos
 
 	// active markers should have supply set.
 	if newMarker.Status == types.StatusActive {
-		if err := k.AdjustCirculation(ctx, newMarker, c.Amount); err != nil {
+		if err := k.AdjustCirculation(ctx, newMarker, c.Amount, authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
 			return err
 		}
 	}
@@ -86,7 +89,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 		return fmt.Errorf("cannot mint coin for a marker that is not in Active status")
 	}
 
-	if err := k.IncreaseSupply(ctx, m, c.Amount); err != nil {
+	if err := k.IncreaseSupply(ctx, m, c.Amount, authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
 		return err
 	}
 
@@ -128,7 +131,7 @@ func HandleSupplyDecreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyDecr
 		return fmt.Errorf("%s marker does not allow governance control", c.Amount.Denom)
 	}
 
-	if err := k.DecreaseSupply(ctx, m, c.Amount); err != nil {
+	if err := k.DecreaseSupply(ctx, m, c.Amount, authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
 		return err
 	}
 
@@ -167,6 +170,13 @@ func HandleSetAdministratorProposal(ctx sdk.Context, k Keeper, c *types.SetAdmin
 	}
 
 	k.SetMarker(ctx, m)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	for _, a := range c.Access {
+		if err := k.recordAccessChange(ctx, c.Denom, govAddr, a.GetAddress(), a.Permissions, types.AccessChangeAction_Grant); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -186,11 +196,13 @@ func HandleRemoveAdministratorProposal(ctx sdk.Context, k Keeper, c *types.Remov
 	if !m.HasGovernanceEnabled() {
 		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
 	}
+	revoked := make([]types.AccessGrant, 0, len(c.RemovedAddress))
 	for _, a := range c.RemovedAddress {
 		addr, err := sdk.AccAddressFromBech32(a)
 		if err != nil {
 			return err
 		}
+		revoked = append(revoked, types.GrantsForAddress(addr, m.GetAccessList()...))
 		if err = m.RevokeAccess(addr); err != nil {
 			return err
 		}
@@ -202,6 +214,13 @@ func HandleRemoveAdministratorProposal(ctx sdk.Context, k Keeper, c *types.Remov
 
 	k.SetMarker(ctx, m)
 
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	for _, g := range revoked {
+		if err := k.recordAccessChange(ctx, c.Denom, govAddr, g.GetAddress(), g.Permissions, types.AccessChangeAction_Revoke); err != nil {
+			return err
+		}
+	}
+
 	logger := k.Logger(ctx)
 	logger.Info("marker access revoked", "marker", c.Denom, "administrator", c.RemovedAddress)
 
@@ -233,7 +252,7 @@ func HandleChangeStatusProposal(ctx sdk.Context, k Keeper, c *types.ChangeStatus
 
 	// activate (must be pending, finalized currently)
 	if c.NewStatus == types.StatusActive {
-		if err = k.AdjustCirculation(ctx, m, m.GetSupply()); err != nil {
+		if err = k.AdjustCirculation(ctx, m, m.GetSupply(), authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
 			return fmt.Errorf("could not create marker supply: %w", err)
 		}
 	}
@@ -243,7 +262,7 @@ func HandleChangeStatusProposal(ctx sdk.Context, k Keeper, c *types.ChangeStatus
 		if m.GetStatus() != types.StatusCancelled {
 			return fmt.Errorf("only cancelled markers can be deleted")
 		}
-		if err = k.AdjustCirculation(ctx, m, sdk.NewCoin(c.Denom, sdk.ZeroInt())); err != nil {
+		if err = k.AdjustCirculation(ctx, m, sdk.NewCoin(c.Denom, sdk.ZeroInt()), authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
 			return fmt.Errorf("could not dispose of marker supply: %w", err)
 		}
 	}
@@ -281,6 +300,10 @@ func HandleWithdrawEscrowProposal(ctx sdk.Context, k Keeper, c *types.WithdrawEs
 		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
 	}
 
+	if m.IsPaused() && !c.Amount.AmountOf(m.GetDenom()).IsZero() {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "cannot withdraw %s", m.GetDenom())
+	}
+
 	recipient, err := sdk.AccAddressFromBech32(c.TargetAddress)
 	if err != nil {
 		return err
@@ -319,3 +342,134 @@ func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, c *types.SetDenom
 	k.Logger(ctx).Info("denom metadata set for marker", "marker", c.Metadata.Base, "denom metadata", c.Metadata.String())
 	return nil
 }
+
+// HandleUpdateMarkerFlagsProposal handles an Update Marker Flags governance proposal request
+func HandleUpdateMarkerFlagsProposal(ctx sdk.Context, k Keeper, c *types.UpdateMarkerFlagsProposal) error {
+	addr, err := types.MarkerAddress(c.Denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", c.Denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
+	}
+	if m.GetStatus() > types.StatusActive {
+		return fmt.Errorf("can not update the flags of a marker in the %s status", m.GetStatus())
+	}
+
+	// fixing the supply of an active marker would halt the chain through the supply invariant if it is not already met
+	if c.SupplyFixed && m.GetStatus() == types.StatusActive {
+		if current := k.bankKeeper.GetSupply(ctx, c.Denom); !m.GetSupply().IsEqual(current) {
+			return fmt.Errorf("can not fix the supply of %s marker: required (%s) does not match current (%s)",
+				c.Denom, m.GetSupply(), current)
+		}
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if err := k.setMarkerFlags(ctx, m, c.SupplyFixed, c.AllowGovernanceControl, govAddr.String()); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("updated marker flags", "marker", c.Denom,
+		"supply fixed", c.SupplyFixed, "allow governance control", c.AllowGovernanceControl)
+
+	return nil
+}
+
+// HandlePauseMarkerProposal handles a Pause Marker governance proposal request
+func HandlePauseMarkerProposal(ctx sdk.Context, k Keeper, c *types.PauseMarkerProposal) error {
+	addr, err := types.MarkerAddress(c.Denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", c.Denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if err := k.setMarkerPaused(ctx, m, c.Paused, govAddr.String()); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("updated marker pause", "marker", c.Denom, "paused", c.Paused)
+
+	return nil
+}
+
+// HandleAdoptDenomProposal handles an Adopt Denom governance proposal request
+func HandleAdoptDenomProposal(ctx sdk.Context, k Keeper, c *types.AdoptDenomProposal) error {
+	addr, err := types.MarkerAddress(c.Denom)
+	if err != nil {
+		return err
+	}
+	existing, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("%s marker already exists", c.Denom)
+	}
+	supply := k.bankKeeper.GetSupply(ctx, c.Denom)
+	if supply.IsZero() {
+		return fmt.Errorf("%s has no supply to adopt", c.Denom)
+	}
+
+	// the coin is already in circulation so the marker starts out active with a supply that matches it.
+	newMarker := types.NewEmptyMarkerAccount(c.Denom, c.Manager, c.AccessList)
+	newMarker.MarkerType = c.MarkerType
+	newMarker.AllowGovernanceControl = c.AllowGovernanceControl
+	newMarker.SupplyFixed = c.SupplyFixed
+
+	if err := newMarker.SetSupply(supply); err != nil {
+		return err
+	}
+
+	if err := newMarker.SetStatus(types.StatusActive); err != nil {
+		return err
+	}
+
+	if err := k.AddMarkerAccount(ctx, newMarker); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("an existing denom was adopted by a new marker", "marker", c.Denom, "supply", supply.String())
+
+	return nil
+}
+
+// HandleSetFeeConversionProposal handles a Set Fee Conversion governance proposal request
+func HandleSetFeeConversionProposal(ctx sdk.Context, k Keeper, c *types.SetFeeConversionProposal) error {
+	var conversion *types.FeeConversion
+	if c.Enabled {
+		fc := c.FeeConversion()
+		conversion = &fc
+	}
+	if err := k.SetFeeConversion(ctx, c.Denom, conversion); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	if conversion != nil {
+		logger.Info("set marker fee conversion", "marker", c.Denom, "pool", c.Pool, "rate", c.Rate.String(), "oracle", c.Oracle)
+	} else {
+		logger.Info("removed marker fee conversion", "marker", c.Denom)
+	}
+
+	return nil
+}
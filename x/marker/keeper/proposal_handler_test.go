@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -34,7 +35,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(false)
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper)
+	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper, s.app.TransferKeeper)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -304,6 +305,67 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 			),
 			nil,
 		},
+
+		// UPDATE MARKER FLAGS PROPOSALS
+		{
+			"update marker flags - invalid marker",
+			markertypes.NewUpdateMarkerFlagsProposal("title", "description", "test", true, true),
+			errors.New("test marker does not exist"),
+		},
+		{
+			"update marker flags - invalid no governance",
+			markertypes.NewUpdateMarkerFlagsProposal("title", "description", "testnogov", true, true),
+			errors.New("testnogov marker does not allow governance control"),
+		},
+		{
+			"update marker flags - valid active",
+			markertypes.NewUpdateMarkerFlagsProposal("title", "description", "test1", true, true),
+			nil,
+		},
+
+		// PAUSE MARKER PROPOSALS
+		{
+			"pause marker - invalid marker",
+			markertypes.NewPauseMarkerProposal("title", "description", "test", true),
+			errors.New("test marker does not exist"),
+		},
+		{
+			"pause marker - invalid no governance",
+			markertypes.NewPauseMarkerProposal("title", "description", "testnogov", true),
+			errors.New("testnogov marker does not allow governance control"),
+		},
+		{
+			"pause marker - valid active",
+			markertypes.NewPauseMarkerProposal("title", "description", "test1", true),
+			nil,
+		},
+		{
+			"increase supply - paused",
+			markertypes.NewSupplyIncreaseProposal("title", "description", sdk.NewCoin("test1", sdk.NewInt(100)), ""),
+			errors.New("cannot mint test1: marker is paused"),
+		},
+		{
+			"resume marker - valid",
+			markertypes.NewPauseMarkerProposal("title", "description", "test1", false),
+			nil,
+		},
+		{
+			"resume marker - not paused",
+			markertypes.NewPauseMarkerProposal("title", "description", "test1", false),
+			errors.New("marker test1 is not paused"),
+		},
+
+		// ADOPT DENOM PROPOSALS
+		{
+			"adopt denom - marker already exists",
+			markertypes.NewAdoptDenomProposal("title", "description", "test1", sdk.AccAddress{}, markertypes.MarkerType_Coin, []markertypes.AccessGrant{}, true, true),
+			errors.New("test1 marker already exists"),
+		},
+		{
+			"adopt denom - no supply",
+			markertypes.NewAdoptDenomProposal("title", "description", "nosupply", sdk.AccAddress{}, markertypes.MarkerType_Coin, []markertypes.AccessGrant{}, true, true),
+			errors.New("nosupply has no supply to adopt"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -329,6 +391,12 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 				err = markerkeeper.HandleWithdrawEscrowProposal(s.ctx, s.k, c)
 			case *markertypes.SetDenomMetadataProposal:
 				err = markerkeeper.HandleSetDenomMetadataProposal(s.ctx, s.k, c)
+			case *markertypes.UpdateMarkerFlagsProposal:
+				err = markerkeeper.HandleUpdateMarkerFlagsProposal(s.ctx, s.k, c)
+			case *markertypes.PauseMarkerProposal:
+				err = markerkeeper.HandlePauseMarkerProposal(s.ctx, s.k, c)
+			case *markertypes.AdoptDenomProposal:
+				err = markerkeeper.HandleAdoptDenomProposal(s.ctx, s.k, c)
 			default:
 				panic("invalid proposal type")
 			}
@@ -344,6 +412,30 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 
 }
 
+func (s *IntegrationTestSuite) TestAdoptDenomProposal() {
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	legacy := sdk.NewCoins(sdk.NewInt64Coin("legacycoin", 1000))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, legacy))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, holder, legacy))
+
+	access := []markertypes.AccessGrant{*markertypes.NewAccessGrant(s.accountAddr, []markertypes.Access{markertypes.Access_Transfer, markertypes.Access_Admin})}
+	prop := markertypes.NewAdoptDenomProposal("title", "description", "legacycoin", sdk.AccAddress{}, markertypes.MarkerType_RestrictedCoin, access, true, true)
+	s.Require().NoError(prop.ValidateBasic())
+	s.Require().NoError(markerkeeper.HandleAdoptDenomProposal(s.ctx, s.k, prop))
+
+	m, err := s.k.GetMarkerByDenom(s.ctx, "legacycoin")
+	s.Require().NoError(err)
+	s.Require().Equal(markertypes.StatusActive, m.GetStatus())
+	s.Require().Equal(markertypes.MarkerType_RestrictedCoin, m.GetMarkerType())
+	s.Require().Equal(sdk.NewInt64Coin("legacycoin", 1000), m.GetSupply())
+	s.Require().True(m.AddressHasAccess(s.accountAddr, markertypes.Access_Transfer))
+	s.Require().Equal(legacy, s.app.BankKeeper.GetAllBalances(s.ctx, holder), "holders keep their coin")
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, "legacycoin").IsEqual(m.GetSupply()), "no coin minted or burned")
+	s.Require().False(s.app.BankKeeper.IsSendEnabledCoin(s.ctx, legacy[0]), "restricted coin sends are disabled")
+
+	s.Require().EqualError(markerkeeper.HandleAdoptDenomProposal(s.ctx, s.k, prop), "legacycoin marker already exists")
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,7 +13,9 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -22,10 +27,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
-
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
 
 // AllMarkers returns a list of all markers on the blockchain
@@ -68,7 +70,24 @@ func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	return &types.QueryMarkerResponse{Marker: any}, nil
+	return &types.QueryMarkerResponse{Marker: any, DenomTrace: k.getDenomTrace(ctx, marker.GetDenom())}, nil
+}
+
+// getDenomTrace returns the denom trace of the ibc voucher with the given denom (or nil if the denom is not an
+// ibc voucher or its trace is unknown).
+func (k Keeper) getDenomTrace(ctx sdk.Context, denom string) *ibctransfertypes.DenomTrace {
+	if !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return nil
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil
+	}
+	denomTrace, found := k.ibcTransferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil
+	}
+	return &denomTrace
 }
 
 // Holding query for all accounts holding the given marker coins
@@ -87,29 +106,89 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 		pageRequest = &query.PageRequest{}
 	}
 
-	// TODO: Refactor Holding for full pagination support. https://github.com/provenance-io/provenance/issues/400
+	if pageRequest.Offset > 0 && len(pageRequest.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
 	balances := k.GetAllMarkerHolders(ctx, marker.GetDenom())
 	limit := pageRequest.Limit
 	if limit == 0 {
 		limit = defaultLimit
 	}
-	end := pageRequest.Offset + limit
 	totalResults := uint64(len(balances))
+	start := pageRequest.Offset
+	if len(pageRequest.Key) > 0 {
+		// The holders are in the store order of their addresses, so the page starts at the first holder at or after
+		// the key even if the holder it was made from no longer holds any of the coin.
+		start = uint64(sort.Search(len(balances), func(i int) bool {
+			return bytes.Compare(holderStoreKey(balances[i]), address.MustLengthPrefix(pageRequest.Key)) >= 0
+		}))
+	}
 
-	if pageRequest.Offset > totalResults {
+	if start > totalResults {
 		return nil, fmt.Errorf("invalid offset")
 	}
 
+	end := start + limit
 	if end > totalResults {
 		end = totalResults
 	}
 
+	pageResponse := &query.PageResponse{Total: totalResults}
+	if end < totalResults {
+		pageResponse.NextKey = holderAddress(balances[end])
+	}
 	return &types.QueryHoldingResponse{
-		Balances:   balances[pageRequest.Offset:end],
-		Pagination: &query.PageResponse{Total: totalResults},
+		Balances:   balances[start:end],
+		Pagination: pageResponse,
 	}, nil
 }
 
+// holderAddress returns the address of a holder, which is always valid since it comes from the bank store.
+func holderAddress(balance types.Balance) sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(balance.Address)
+	return addr
+}
+
+// holderStoreKey returns the length prefixed address of a holder, which orders the holders the same as the balances
+// in the bank store.
+func holderStoreKey(balance types.Balance) []byte {
+	return address.MustLengthPrefix(holderAddress(balance))
+}
+
+// AccountHolding query for the spendable, locked, and escrowed amounts of marker coins held by an account
+func (k Keeper) AccountHolding(c context.Context, req *types.QueryAccountHoldingRequest) (*types.QueryAccountHoldingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid address: %s", err))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	denom := marker.GetDenom()
+	zero := sdk.NewCoin(denom, sdk.ZeroInt())
+	res := &types.QueryAccountHoldingResponse{
+		Total:     k.bankKeeper.GetBalance(ctx, addr, denom),
+		Spendable: zero,
+		Locked:    zero,
+		Escrowed:  zero,
+	}
+	// Coins held by a marker account are in escrow and can only be moved through the marker module.
+	if holder, _ := k.GetMarker(ctx, addr); holder != nil {
+		res.Escrowed = res.Total
+		return res, nil
+	}
+	// The locked amount is derived from the spendable amount so the two always add up to the total balance.
+	res.Spendable = sdk.NewCoin(denom, k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(denom))
+	res.Locked = res.Total.Sub(res.Spendable)
+	return res, nil
+}
+
 // Supply query for supply of coin on a marker account
 func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
 	if req == nil {
@@ -167,3 +246,317 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 
 	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
 }
+
+// Invariants query for the results of each of the marker module invariants
+func (k Keeper) Invariants(c context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryInvariantsResponse{Invariants: k.CheckInvariants(ctx)}
+	for _, result := range res.Invariants {
+		res.Broken = res.Broken || result.Broken
+	}
+	return res, nil
+}
+
+// Basket query for the reserve composition and reserve holdings of a basket marker
+func (k Keeper) Basket(c context.Context, req *types.QueryBasketRequest) (*types.QueryBasketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	basket, found := k.GetBasket(ctx, marker.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "marker %s is not a basket marker", marker.GetDenom())
+	}
+	escrow := k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
+	reserve := sdk.NewCoins()
+	for _, coin := range basket.ReservePerUnit {
+		reserve = reserve.Add(sdk.NewCoin(coin.Denom, escrow.AmountOf(coin.Denom)))
+	}
+	return &types.QueryBasketResponse{
+		Basket:  basket,
+		Supply:  k.bankKeeper.GetSupply(ctx, marker.GetDenom()),
+		Reserve: reserve,
+	}, nil
+}
+
+// Totals returns the number, supply, and escrow of markers grouped by type and status
+func (k Keeper) Totals(c context.Context, req *types.QueryTotalsRequest) (*types.QueryTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalsResponse{Totals: k.GetMarkerTotals(ctx)}, nil
+}
+
+// EmissionSchedules returns the emission schedules of a marker and the amount each has left to emit
+func (k Keeper) EmissionSchedules(c context.Context, req *types.QueryEmissionSchedulesRequest) (*types.QueryEmissionSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	schedules := []types.EmissionScheduleStatus{}
+	for _, s := range k.GetEmissionSchedules(ctx) {
+		if s.Denom != marker.GetDenom() {
+			continue
+		}
+		schedules = append(schedules, types.EmissionScheduleStatus{
+			Schedule:    s,
+			Remaining:   s.Remaining(),
+			FinalHeight: s.FinalHeight(),
+		})
+	}
+	return &types.QueryEmissionSchedulesResponse{Schedules: schedules}, nil
+}
+
+// ScheduledTransfers returns the scheduled transfers of a marker that have not been released
+func (k Keeper) ScheduledTransfers(c context.Context, req *types.QueryScheduledTransfersRequest) (*types.QueryScheduledTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	transfers := []types.ScheduledTransfer{}
+	for _, t := range k.GetScheduledTransfers(ctx) {
+		if t.Amount.Denom != marker.GetDenom() {
+			continue
+		}
+		if len(req.Address) > 0 && t.FromAddress != req.Address && t.ToAddress != req.Address {
+			continue
+		}
+		transfers = append(transfers, t)
+	}
+	return &types.QueryScheduledTransfersResponse{Transfers: transfers}, nil
+}
+
+// CanSend evaluates whether a transfer of a restricted coin would be allowed without changing any state
+func (k Keeper) CanSend(c context.Context, req *types.QueryCanSendRequest) (*types.QueryCanSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	from, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %s", err)
+	}
+	admin := from
+	if len(req.Administrator) > 0 {
+		if admin, err = sdk.AccAddressFromBech32(req.Administrator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid administrator address: %s", err)
+		}
+	}
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denials := k.CheckTransfer(ctx, from, to, admin, amount)
+	return &types.QueryCanSendResponse{Allowed: len(denials) == 0, Denials: denials}, nil
+}
+
+// SupplyHistory query for the changes to the supply of a marker's coin within the supply history window
+func (k Keeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	changes := []types.SupplyChange{}
+	changeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyChangesKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(key []byte, value []byte) error {
+		var change types.SupplyChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QuerySupplyHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// TransferMemoRequirement query for the memo required for transfers of a restricted marker's coin
+func (k Keeper) TransferMemoRequirement(
+	c context.Context,
+	req *types.QueryTransferMemoRequirementRequest,
+) (*types.QueryTransferMemoRequirementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransferMemoRequirementResponse{
+		Denom:               marker.GetDenom(),
+		Required:            marker.GetMarkerType() == types.MarkerType_RestrictedCoin && len(marker.GetTransferMemoPattern()) > 0,
+		TransferMemoPattern: marker.GetTransferMemoPattern(),
+	}, nil
+}
+
+// PendingWithdrawals returns the withdrawals from a marker that are waiting for approval
+func (k Keeper) PendingWithdrawals(
+	c context.Context,
+	req *types.QueryPendingWithdrawalsRequest,
+) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	withdrawals := []types.PendingWithdrawal{}
+	for _, w := range k.GetPendingWithdrawals(ctx) {
+		if w.Denom == marker.GetDenom() {
+			withdrawals = append(withdrawals, w)
+		}
+	}
+	return &types.QueryPendingWithdrawalsResponse{Withdrawals: withdrawals}, nil
+}
+
+// Faucet returns the faucet of a marker and when an address may next claim from it
+func (k Keeper) Faucet(c context.Context, req *types.QueryFaucetRequest) (*types.QueryFaucetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	faucet, found := k.GetFaucet(ctx, marker.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no faucet found for %s", marker.GetDenom())
+	}
+	resp := &types.QueryFaucetResponse{Faucet: faucet, Enabled: k.GetEnableFaucet(ctx)}
+	if len(req.Address) > 0 {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+		if claim, claimed := k.GetFaucetClaim(ctx, marker.GetDenom(), addr); claimed {
+			if next := claim.NextClaimTime(faucet.Period); ctx.BlockTime().Before(next) {
+				resp.NextClaimTime = &next
+			}
+		}
+	}
+	return resp, nil
+}
+
+// AccessLog query for the grants and revocations of access to a marker within the access log window
+func (k Keeper) AccessLog(c context.Context, req *types.QueryAccessLogRequest) (*types.QueryAccessLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	changes := []types.AccessChange{}
+	changeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccessChangesKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(key []byte, value []byte) error {
+		var change types.AccessChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAccessLogResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// FeeConversion query for the fee conversion letting the fees of a tx be paid in a marker's coin
+func (k Keeper) FeeConversion(c context.Context, req *types.QueryFeeConversionRequest) (*types.QueryFeeConversionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	conversion, found := k.GetFeeConversion(ctx, marker.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee conversion found for %s", marker.GetDenom())
+	}
+	return &types.QueryFeeConversionResponse{FeeConversion: conversion}, nil
+}
+
+// AccountPermissions query for every marker an address has been granted access to and the permissions it has on each
+func (k Keeper) AccountPermissions(c context.Context, req *types.QueryAccountPermissionsRequest) (*types.QueryAccountPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %q: %v", req.Address, err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	permissions := []types.MarkerPermissions{}
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccessGranteeKeysPrefix(grantee))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		// the keys of the grantee's index entries are the length prefixed marker addresses.
+		markerAddr := sdk.AccAddress(key[1 : 1+int(key[0])])
+		if p := k.GetAccountPermissions(ctx, grantee, markerAddr); p != nil {
+			permissions = append(permissions, *p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAccountPermissionsResponse{Permissions: permissions, Pagination: pageRes}, nil
+}
+
+// DisplayAmount query for amounts of base denoms converted to the display denoms of their bank metadata
+func (k Keeper) DisplayAmount(c context.Context, req *types.QueryDisplayAmountRequest) (*types.QueryDisplayAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	coins, err := sdk.ParseCoinsNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	amounts := make([]types.DisplayAmount, 0, len(coins))
+	for _, coin := range coins {
+		metadata, _ := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom)
+		amounts = append(amounts, types.NewDisplayAmount(coin, metadata))
+	}
+	return &types.QueryDisplayAmountResponse{Amounts: amounts}, nil
+}
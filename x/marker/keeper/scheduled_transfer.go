@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// scheduledTransfersPerBlock is the number of due scheduled transfers released at the start of each block, the rest
+// are released in following blocks unless their recipients claim them first.
+const scheduledTransfersPerBlock = 100
+
+// ScheduleTransfer takes the amount of restricted coin from the sender, brokered by the administrator the same as an
+// immediate transfer, and holds it in the marker module account until it is released to the recipient at the start
+// of the first block that has reached the release height and/or time.
+func (k Keeper) ScheduleTransfer(
+	ctx sdk.Context, admin, from, to sdk.AccAddress, amount sdk.Coin, releaseHeight int64, releaseTime *time.Time,
+) (uint64, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "schedule_transfer")
+
+	if releaseHeight == 0 && releaseTime == nil {
+		return 0, fmt.Errorf("a release height or time is required")
+	}
+	if releaseHeight != 0 && releaseHeight <= ctx.BlockHeight() {
+		return 0, fmt.Errorf("release height %d must be after the current height %d", releaseHeight, ctx.BlockHeight())
+	}
+	if releaseTime != nil && !releaseTime.After(ctx.BlockTime()) {
+		return 0, fmt.Errorf("release time %s must be after the current block time %s",
+			releaseTime.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	if denials := k.CheckTransfer(ctx, from, to, admin, amount); len(denials) > 0 {
+		return 0, denials[0].Err()
+	}
+	if err := k.CheckTransferMemo(ctx, amount.Denom, types.TxMemo(ctx)); err != nil {
+		return 0, err
+	}
+	if !admin.Equals(from) {
+		if err := k.authzHandler(ctx, admin, from, amount); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.CoinPoolName, sdk.NewCoins(amount)); err != nil {
+		return 0, sdkerrors.Wrapf(err, "could not escrow %s from %s", amount, from)
+	}
+	k.updateMarkerTotals(ctx, from)
+
+	id := k.nextScheduledTransferID(ctx)
+	transfer := types.NewScheduledTransfer(id, admin, from, to, amount, releaseHeight, releaseTime)
+	if err := transfer.Validate(); err != nil {
+		return 0, err
+	}
+	k.setScheduledTransfer(ctx, transfer)
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferScheduled(transfer))
+}
+
+// CancelScheduledTransfer returns the coin of a scheduled transfer to its sender before it is released.
+func (k Keeper) CancelScheduledTransfer(ctx sdk.Context, caller sdk.AccAddress, id uint64) error {
+	transfer, found := k.GetScheduledTransfer(ctx, id)
+	if !found {
+		return fmt.Errorf("scheduled transfer %d not found", id)
+	}
+	// The administrator that scheduled the transfer may cancel it after losing the transfer access.
+	if caller.String() != transfer.Administrator {
+		m, err := k.GetMarkerByDenom(ctx, transfer.Amount.Denom)
+		if err != nil {
+			return fmt.Errorf("marker not found for %s: %s", transfer.Amount.Denom, err)
+		}
+		if !m.AddressHasAccess(caller, types.Access_Transfer) {
+			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Transfer, m.GetDenom())
+		}
+	}
+
+	if err := k.returnScheduledTransfer(ctx, transfer); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerScheduledTransferCancel(transfer, caller.String()))
+}
+
+// ClaimScheduledTransfer releases a due scheduled transfer to its recipient ahead of the transfers released at the
+// start of each block.
+func (k Keeper) ClaimScheduledTransfer(ctx sdk.Context, caller sdk.AccAddress, id uint64) error {
+	transfer, found := k.GetScheduledTransfer(ctx, id)
+	if !found {
+		return fmt.Errorf("scheduled transfer %d not found", id)
+	}
+	if caller.String() != transfer.ToAddress {
+		return fmt.Errorf("scheduled transfer %d can only be claimed by its recipient %s", id, transfer.ToAddress)
+	}
+	if !transfer.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+		return fmt.Errorf("scheduled transfer %d has not been released", id)
+	}
+	if k.isDenomPaused(ctx, transfer.Amount.Denom) {
+		return sdkerrors.Wrapf(types.ErrMarkerPaused, "scheduled transfer %d cannot be claimed", id)
+	}
+	if err := k.releaseScheduledTransfer(ctx, transfer); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerScheduledTransferReleased(transfer, caller.String()))
+}
+
+// ReleaseScheduledTransfers sends the coin of the scheduled transfers that are due to their recipients in the order
+// they became due, up to a fixed number of transfers each block.  A transfer that cannot be sent (e.g. to an address
+// that has since been blocked from receiving coins) is returned to its sender, and one that cannot be returned either
+// is left to be tried again in the next block.  Transfers of the coin of a paused marker are held until the marker is
+// resumed.
+func (k Keeper) ReleaseScheduledTransfers(ctx sdk.Context) {
+	due, waiting := k.getDueScheduledTransfers(ctx)
+	// A transfer that has reached its release height but not its release time waits in the time index instead.
+	store := ctx.KVStore(k.storeKey)
+	for _, transfer := range waiting {
+		store.Delete(types.ScheduledTransferHeightKey(transfer.ReleaseHeight, transfer.Id))
+		store.Set(types.ScheduledTransferTimeKey(*transfer.ReleaseTime, transfer.Id), []byte{})
+	}
+	for _, transfer := range due {
+		var event proto.Message = types.NewEventMarkerScheduledTransferReleased(transfer, "")
+		releaseCtx, writeCache := ctx.CacheContext()
+		if err := k.releaseScheduledTransfer(releaseCtx, transfer); err != nil {
+			k.Logger(ctx).Error("unable to release scheduled transfer, returning it to the sender",
+				"transfer", transfer.Id, "denom", transfer.Amount.Denom, "to", transfer.ToAddress, "err", err)
+			releaseCtx, writeCache = ctx.CacheContext()
+			if err = k.returnScheduledTransfer(releaseCtx, transfer); err != nil {
+				k.Logger(ctx).Error("unable to return scheduled transfer, skipping it",
+					"transfer", transfer.Id, "denom", transfer.Amount.Denom, "from", transfer.FromAddress, "err", err)
+				continue
+			}
+			event = types.NewEventMarkerScheduledTransferCancel(transfer, "")
+		}
+		writeCache()
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// getDueScheduledTransfers returns up to a block's worth of the scheduled transfers that are due, leaving out those of
+// paused markers, followed by those that have reached their release height but not their release time.  Only the
+// transfers indexed at or before the current height and block time are read.
+func (k Keeper) getDueScheduledTransfers(ctx sdk.Context) (due, waiting []types.ScheduledTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	heightEnd := sdk.PrefixEndBytes(append(append([]byte{}, types.ScheduledTransferHeightKeyPrefix...),
+		sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...))
+	timeEnd := sdk.PrefixEndBytes(append(append([]byte{}, types.ScheduledTransferTimeKeyPrefix...),
+		sdk.FormatTimeBytes(ctx.BlockTime())...))
+	for _, iterator := range []sdk.Iterator{
+		store.Iterator(types.ScheduledTransferHeightKeyPrefix, heightEnd),
+		store.Iterator(types.ScheduledTransferTimeKeyPrefix, timeEnd),
+	} {
+		for ; iterator.Valid() && len(due) < scheduledTransfersPerBlock; iterator.Next() {
+			transfer, found := k.GetScheduledTransfer(ctx, types.ScheduledTransferIndexKeyID(iterator.Key()))
+			switch {
+			case !found:
+				continue
+			case !transfer.IsDue(ctx.BlockHeight(), ctx.BlockTime()):
+				waiting = append(waiting, transfer)
+			case !k.isDenomPaused(ctx, transfer.Amount.Denom):
+				due = append(due, transfer)
+			}
+		}
+		iterator.Close()
+	}
+	return due, waiting
+}
+
+// releaseScheduledTransfer sends the coin of the transfer to its recipient and removes the transfer.
+func (k Keeper) releaseScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) error {
+	to := transfer.RecipientAddress()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, to, sdk.NewCoins(transfer.Amount)); err != nil {
+		return sdkerrors.Wrapf(err, "could not release %s to %s", transfer.Amount, to)
+	}
+	k.updateMarkerTotals(ctx, to)
+	k.deleteScheduledTransfer(ctx, transfer)
+	return nil
+}
+
+// returnScheduledTransfer sends the coin of the transfer back to its sender and removes the transfer.
+func (k Keeper) returnScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) error {
+	from := transfer.SenderAddress()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, from, sdk.NewCoins(transfer.Amount)); err != nil {
+		return sdkerrors.Wrapf(err, "could not return %s to %s", transfer.Amount, from)
+	}
+	k.updateMarkerTotals(ctx, from)
+	k.deleteScheduledTransfer(ctx, transfer)
+	return nil
+}
+
+// GetScheduledTransfer returns the scheduled transfer with the given id.
+func (k Keeper) GetScheduledTransfer(ctx sdk.Context, id uint64) (types.ScheduledTransfer, bool) {
+	var t types.ScheduledTransfer
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduledTransferKey(id))
+	if len(bz) == 0 {
+		return t, false
+	}
+	k.cdc.MustUnmarshal(bz, &t)
+	return t, true
+}
+
+// GetScheduledTransfers returns the scheduled transfers that have not been released, in id order.
+func (k Keeper) GetScheduledTransfers(ctx sdk.Context) []types.ScheduledTransfer {
+	var transfers []types.ScheduledTransfer
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScheduledTransferKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.ScheduledTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &t)
+		transfers = append(transfers, t)
+	}
+	return transfers
+}
+
+// setScheduledTransfer stores the transfer and indexes it by its release height, or by its release time if it has no
+// release height.
+func (k Keeper) setScheduledTransfer(ctx sdk.Context, t types.ScheduledTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduledTransferKey(t.Id), k.cdc.MustMarshal(&t))
+	if t.ReleaseHeight > 0 {
+		store.Set(types.ScheduledTransferHeightKey(t.ReleaseHeight, t.Id), []byte{})
+	} else {
+		store.Set(types.ScheduledTransferTimeKey(*t.ReleaseTime, t.Id), []byte{})
+	}
+}
+
+// deleteScheduledTransfer removes the transfer and its index entry.
+func (k Keeper) deleteScheduledTransfer(ctx sdk.Context, t types.ScheduledTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduledTransferKey(t.Id))
+	if t.ReleaseHeight > 0 {
+		store.Delete(types.ScheduledTransferHeightKey(t.ReleaseHeight, t.Id))
+	}
+	if t.ReleaseTime != nil {
+		store.Delete(types.ScheduledTransferTimeKey(*t.ReleaseTime, t.Id))
+	}
+}
+
+// nextScheduledTransferID returns the id to use for a new scheduled transfer and increments it.
+func (k Keeper) nextScheduledTransferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextScheduledTransferIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextScheduledTransferIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextScheduledTransferID sets the id to use for the next scheduled transfer.
+func (k Keeper) setNextScheduledTransferID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextScheduledTransferIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// supplyChangesPrunedPerBlock is the number of supply changes outside of the supply history window removed at the
+// start of each block, the rest are removed in following blocks.
+const supplyChangesPrunedPerBlock = 1000
+
+// recordSupplyChange adds a change of the supply of the marker's coin by the delta to the supply history.  Changes
+// made by the governance module account are recorded as governance changes, the rest as mints or burns.
+func (k Keeper) recordSupplyChange(ctx sdk.Context, marker types.MarkerAccountI, delta sdk.Int, actor sdk.AccAddress) {
+	if delta.IsZero() || k.GetSupplyHistoryWindow(ctx) == 0 {
+		return
+	}
+	reason := types.SupplyChangeReason_Mint
+	switch {
+	case actor.Equals(authtypes.NewModuleAddress(govtypes.ModuleName)):
+		reason = types.SupplyChangeReason_Governance
+	case delta.IsNegative():
+		reason = types.SupplyChangeReason_Burn
+	}
+	supply := k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
+	change := types.NewSupplyChange(k.nextSupplyChangeID(ctx), marker.GetDenom(), ctx.BlockHeight(), ctx.BlockTime(),
+		delta, supply, actor, reason)
+	k.setSupplyChange(ctx, change)
+}
+
+// GetSupplyChanges returns the supply changes of the marker with the given address within the supply history window,
+// in the order they were made.
+func (k Keeper) GetSupplyChanges(ctx sdk.Context, addr sdk.AccAddress) []types.SupplyChange {
+	return k.getSupplyChanges(ctx, types.SupplyChangesKeyPrefix(addr))
+}
+
+// GetAllSupplyChanges returns the supply changes of all markers within the supply history window.
+func (k Keeper) GetAllSupplyChanges(ctx sdk.Context) []types.SupplyChange {
+	return k.getSupplyChanges(ctx, types.SupplyChangeKeyPrefix)
+}
+
+func (k Keeper) getSupplyChanges(ctx sdk.Context, prefix []byte) []types.SupplyChange {
+	var changes []types.SupplyChange
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.SupplyChange
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// PruneSupplyHistory removes the supply changes made before the supply history window, up to a fixed number of
+// changes each block.  All of the changes are removed once the window is set to zero.
+func (k Keeper) PruneSupplyHistory(ctx sdk.Context) {
+	window := k.GetSupplyHistoryWindow(ctx)
+	if window >= uint64(ctx.BlockHeight()) {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(window)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyChangeHeightKeyPrefix)
+	defer iterator.Close()
+	var pruned [][]byte
+	for ; iterator.Valid() && len(pruned) < supplyChangesPrunedPerBlock; iterator.Next() {
+		height, _, _ := types.SplitSupplyChangeHeightKey(iterator.Key())
+		if height > cutoff {
+			break
+		}
+		pruned = append(pruned, iterator.Key())
+	}
+	for _, key := range pruned {
+		_, addr, id := types.SplitSupplyChangeHeightKey(key)
+		store.Delete(types.SupplyChangeKey(addr, id))
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setSupplyChange(ctx sdk.Context, c types.SupplyChange) {
+	store := ctx.KVStore(k.storeKey)
+	addr := types.MustGetMarkerAddress(c.Denom)
+	store.Set(types.SupplyChangeKey(addr, c.Id), k.cdc.MustMarshal(&c))
+	store.Set(types.SupplyChangeHeightKey(c.Height, addr, c.Id), []byte{})
+}
+
+// nextSupplyChangeID returns the id to use for a new supply change and increments it.
+func (k Keeper) nextSupplyChangeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextSupplyChangeIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextSupplyChangeIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextSupplyChangeID sets the id to use for the next supply change.
+func (k Keeper) setNextSupplyChangeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextSupplyChangeIDKey, sdk.Uint64ToBigEndian(id))
+}
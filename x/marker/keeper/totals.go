@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetMarkerTotals returns the number, supply, and escrow of markers for each type and status that has markers.
+func (k Keeper) GetMarkerTotals(ctx sdk.Context) []types.MarkerTotal {
+	totals := []types.MarkerTotal{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerTotalsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var total types.MarkerTotal
+		k.cdc.MustUnmarshal(iterator.Value(), &total)
+		totals = append(totals, total)
+	}
+	return totals
+}
+
+// getMarkerTotal returns the total of markers with the given type and status.
+func (k Keeper) getMarkerTotal(ctx sdk.Context, markerType types.MarkerType, status types.MarkerStatus) types.MarkerTotal {
+	total := types.NewMarkerTotal(markerType, status, 0, sdk.NewCoins(), sdk.NewCoins())
+	bz := ctx.KVStore(k.storeKey).Get(types.MarkerTotalsKey(markerType, status))
+	if len(bz) > 0 {
+		k.cdc.MustUnmarshal(bz, &total)
+	}
+	return total
+}
+
+// setMarkerTotal stores the total of markers for its type and status, removing it once there are none.
+func (k Keeper) setMarkerTotal(ctx sdk.Context, total types.MarkerTotal) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.MarkerTotalsKey(total.MarkerType, total.Status)
+	if total.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&total))
+}
+
+// updateMarkerTotals replaces the amounts previously recorded for the marker at the given address in the marker totals
+// with its current type, status, supply, and escrow.  Addresses that are not markers are ignored.
+func (k Keeper) updateMarkerTotals(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	entryKey := types.MarkerTotalsEntryKey(addr)
+
+	if bz := store.Get(entryKey); len(bz) > 0 {
+		var previous types.MarkerTotal
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.setMarkerTotal(ctx, k.getMarkerTotal(ctx, previous.MarkerType, previous.Status).Sub(previous))
+		store.Delete(entryKey)
+	}
+
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil || m == nil || !store.Has(types.MarkerStoreKey(addr)) {
+		return
+	}
+	current := types.NewMarkerTotal(m.GetMarkerType(), m.GetStatus(), 1,
+		sdk.NewCoins(k.bankKeeper.GetSupply(ctx, m.GetDenom())), k.GetEscrow(ctx, m))
+	k.setMarkerTotal(ctx, k.getMarkerTotal(ctx, current.MarkerType, current.Status).Add(current))
+	store.Set(entryKey, k.cdc.MustMarshal(&current))
+}
+
+// RebuildMarkerTotals discards the marker totals and computes them again from the current state of every marker.
+func (k Keeper) RebuildMarkerTotals(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.MarkerTotalsKeyPrefix, types.MarkerTotalsEntryKeyPrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	k.IterateMarkers(ctx, func(m types.MarkerAccountI) bool {
+		k.updateMarkerTotals(ctx, m.GetAddress())
+		return false
+	})
+}
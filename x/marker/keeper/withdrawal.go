@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetWithdrawApprovalPolicy sets the policy requiring withdrawals from the marker of more than a threshold amount to be
+// approved by additional administrators, a nil policy removes the requirement.  Withdrawals that are already pending
+// keep the number of approvals required when they were requested.  The caller must hold the admin access on the marker.
+func (k Keeper) SetWithdrawApprovalPolicy(
+	ctx sdk.Context, caller sdk.AccAddress, denom string, policy *types.WithdrawApprovalPolicy,
+) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "withdraw_approval_policy")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if m.GetStatus() == types.StatusCancelled || m.GetStatus() == types.StatusDestroyed {
+		return fmt.Errorf("cannot set the withdraw approval policy of a markeraccount in the %s status", m.GetStatus())
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if policy != nil {
+		if err = policy.Validate(); err != nil {
+			return fmt.Errorf("invalid withdraw approval policy: %w", err)
+		}
+	}
+
+	m.SetWithdrawApprovalPolicy(policy)
+	k.SetMarker(ctx, m)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawApprovalPolicy(m.GetDenom(), policy, caller.String()))
+}
+
+// RequestWithdrawal withdraws the coins from the marker the same as WithdrawCoins unless the withdrawal is above the
+// threshold of the marker's withdraw approval policy.  A withdrawal above the threshold is instead stored as a pending
+// withdrawal, made once it has been approved by the required number of other administrators, and its id is returned.
+// The id is zero when the withdrawal was made.
+func (k Keeper) RequestWithdrawal(
+	ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, coins sdk.Coins,
+) (uint64, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return 0, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	policy := m.GetWithdrawApprovalPolicy()
+	if policy == nil || !policy.RequiresApproval(coins) {
+		return 0, k.WithdrawCoins(ctx, caller, recipient, denom, coins)
+	}
+	if err = k.checkWithdrawal(ctx, m, caller, coins); err != nil {
+		return 0, err
+	}
+
+	if recipient.Empty() {
+		recipient = caller
+	}
+	id := k.nextPendingWithdrawalID(ctx)
+	withdrawal := types.NewPendingWithdrawal(id, m.GetDenom(), coins, caller, recipient, policy.ApprovalsRequired,
+		ctx.BlockTime().Add(policy.ApprovalPeriod))
+	if err = withdrawal.Validate(); err != nil {
+		return 0, err
+	}
+	k.setPendingWithdrawal(ctx, withdrawal)
+
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawPending(withdrawal))
+}
+
+// ApproveWithdrawal records the approval of a pending withdrawal by an administrator of the marker other than the one
+// that requested it.  The withdrawal is made once it has the required number of approvals, returning true.  The
+// withdrawal is checked again when it is made so it fails if the requester no longer has the withdraw access or the
+// marker no longer holds the coins, and stays pending until it expires.
+func (k Keeper) ApproveWithdrawal(ctx sdk.Context, caller sdk.AccAddress, id uint64) (bool, error) {
+	withdrawal, found := k.GetPendingWithdrawal(ctx, id)
+	if !found || withdrawal.IsExpired(ctx.BlockTime()) {
+		return false, fmt.Errorf("pending withdrawal %d not found", id)
+	}
+	m, err := k.GetMarkerByDenom(ctx, withdrawal.Denom)
+	if err != nil {
+		return false, fmt.Errorf("marker not found for %s: %s", withdrawal.Denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin) {
+		return false, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, m.GetDenom())
+	}
+	if caller.String() == withdrawal.Administrator {
+		return false, fmt.Errorf("pending withdrawal %d cannot be approved by the administrator that requested it", id)
+	}
+	if withdrawal.HasApproval(caller.String()) {
+		return false, fmt.Errorf("pending withdrawal %d has already been approved by %s", id, caller)
+	}
+
+	withdrawal.Approvals = append(withdrawal.Approvals, caller.String())
+	executed := withdrawal.IsApproved()
+	if executed {
+		requester, err := sdk.AccAddressFromBech32(withdrawal.Administrator)
+		if err != nil {
+			return false, err
+		}
+		recipient, err := sdk.AccAddressFromBech32(withdrawal.ToAddress)
+		if err != nil {
+			return false, err
+		}
+		if err = k.checkWithdrawal(ctx, m, requester, withdrawal.Amount); err != nil {
+			return false, fmt.Errorf("pending withdrawal %d cannot be made: %w", id, err)
+		}
+		if err = k.sendWithdrawal(ctx, m, requester, recipient, withdrawal.Amount); err != nil {
+			return false, fmt.Errorf("pending withdrawal %d cannot be made: %w", id, err)
+		}
+		k.deletePendingWithdrawal(ctx, withdrawal)
+	} else {
+		k.setPendingWithdrawal(ctx, withdrawal)
+	}
+
+	return executed, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawApproved(withdrawal, caller.String(), executed))
+}
+
+// ExpirePendingWithdrawals removes the pending withdrawals that were not approved before their expiration.  Only the
+// withdrawals indexed before the block time are read.
+func (k Keeper) ExpirePendingWithdrawals(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := append(append([]byte{}, types.PendingWithdrawalExpirationKeyPrefix...), sdk.FormatTimeBytes(ctx.BlockTime())...)
+	iterator := store.Iterator(types.PendingWithdrawalExpirationKeyPrefix, end)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, types.PendingWithdrawalExpirationKeyID(iterator.Key()))
+	}
+	iterator.Close()
+	for _, id := range ids {
+		withdrawal, found := k.GetPendingWithdrawal(ctx, id)
+		if !found || !withdrawal.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		k.deletePendingWithdrawal(ctx, withdrawal)
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerWithdrawExpired(withdrawal)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetPendingWithdrawal returns the pending withdrawal with the given id.
+func (k Keeper) GetPendingWithdrawal(ctx sdk.Context, id uint64) (types.PendingWithdrawal, bool) {
+	var w types.PendingWithdrawal
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingWithdrawalKey(id))
+	if len(bz) == 0 {
+		return w, false
+	}
+	k.cdc.MustUnmarshal(bz, &w)
+	return w, true
+}
+
+// GetPendingWithdrawals returns the withdrawals waiting for approval, in id order.
+func (k Keeper) GetPendingWithdrawals(ctx sdk.Context) []types.PendingWithdrawal {
+	var withdrawals []types.PendingWithdrawal
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingWithdrawalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var w types.PendingWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &w)
+		withdrawals = append(withdrawals, w)
+	}
+	return withdrawals
+}
+
+// setPendingWithdrawal stores the withdrawal and indexes it by its expiration.
+func (k Keeper) setPendingWithdrawal(ctx sdk.Context, w types.PendingWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingWithdrawalKey(w.Id), k.cdc.MustMarshal(&w))
+	store.Set(types.PendingWithdrawalExpirationKey(w.Expiration, w.Id), []byte{})
+}
+
+// deletePendingWithdrawal removes the withdrawal and its index entry.
+func (k Keeper) deletePendingWithdrawal(ctx sdk.Context, w types.PendingWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingWithdrawalKey(w.Id))
+	store.Delete(types.PendingWithdrawalExpirationKey(w.Expiration, w.Id))
+}
+
+// nextPendingWithdrawalID returns the id to use for a new pending withdrawal and increments it.
+func (k Keeper) nextPendingWithdrawalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextPendingWithdrawalIDKey); len(bz) > 0 {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextPendingWithdrawalIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// setNextPendingWithdrawalID sets the id to use for the next pending withdrawal.
+func (k Keeper) setNextPendingWithdrawalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextPendingWithdrawalIDKey, sdk.Uint64ToBigEndian(id))
+}
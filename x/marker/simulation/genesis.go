@@ -60,9 +60,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	markerGenesis := types.GenesisState{
 		Params: types.Params{
-			MaxTotalSupply:         maxTotalSupply,
-			EnableGovernance:       enableGovernance,
-			UnrestrictedDenomRegex: unrestrictedDenomRegex,
+			MaxTotalSupply:              maxTotalSupply,
+			EnableGovernance:            enableGovernance,
+			UnrestrictedDenomRegex:      unrestrictedDenomRegex,
+			ExpeditedVotingPeriod:       types.DefaultExpeditedVotingPeriod,
+			ExpeditedQuorum:             types.DefaultExpeditedQuorum,
+			AccessRoles:                 types.DefaultAccessRoles,
+			MaxDistributionHolders:      types.DefaultMaxDistributionHolders,
+			DistributionHoldersPerBlock: types.DefaultDistributionHoldersPerBlock,
+			MaxEmissionPerBlock:         types.DefaultMaxEmissionPerBlock,
+			SupplyHistoryWindow:         types.DefaultSupplyHistoryWindow,
+			EnableFaucet:                types.DefaultEnableFaucet,
+			AccessLogWindow:             types.DefaultAccessLogWindow,
 		},
 		Markers: []types.MarkerAccount{
 			{
@@ -28,6 +28,8 @@ const (
 	OpWeightChangeStatusProposal = "op_weight_change_status_proposal"
 	// OpWeightSetDenomMetadataProposal app params key for change status proposal
 	OpWeightSetDenomMetadataProposal = "op_weight_set_denom_metadata"
+	// OpWeightUpdateMarkerFlagsProposal app params key for update marker flags proposal
+	OpWeightUpdateMarkerFlagsProposal = "op_weight_update_marker_flags_proposal"
 )
 
 // ProposalContents defines the module weighted proposals' contents
@@ -68,6 +70,11 @@ func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
 			simappparams.DefaultWeightSetDenomMetadataProposalContent,
 			SimulateSetDenomMetadataProposalContent(k),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightUpdateMarkerFlagsProposal,
+			simappparams.DefaultWeightUpdateMarkerFlagsProposalContent,
+			SimulateUpdateMarkerFlagsProposalContent(k),
+		),
 	}
 }
 
@@ -181,6 +188,24 @@ func SimulateCreateChangeStatusProposalContent(k keeper.Keeper) simtypes.Content
 	}
 }
 
+// SimulateUpdateMarkerFlagsProposalContent generates random update marker flags proposal content
+func SimulateUpdateMarkerFlagsProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m := randomMarker(r, ctx, k)
+		if m == nil || !m.HasGovernanceEnabled() || m.GetStatus() > types.StatusActive {
+			return nil
+		}
+		// governance control is left enabled so the other marker proposals can still be simulated.
+		return types.NewUpdateMarkerFlagsProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			m.GetDenom(),
+			r.Intn(2) == 0,
+			true,
+		)
+	}
+}
+
 // SimulateSetDenomMetadataProposalContent generates random set denom metadata proposal content
 func SimulateSetDenomMetadataProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccessChange creates a new change of the access of the address to a marker made by the actor.
+func NewAccessChange(
+	id uint64, denom string, height int64, blockTime time.Time, actor, addr sdk.AccAddress, permissions AccessList,
+	action AccessChangeAction,
+) AccessChange {
+	change := AccessChange{
+		Id:          id,
+		Denom:       denom,
+		Height:      height,
+		Time:        blockTime,
+		Address:     addr.String(),
+		Permissions: permissions,
+		Action:      action,
+	}
+	if !actor.Empty() {
+		change.Actor = actor.String()
+	}
+	return change
+}
+
+// Validate ensures the access change is valid.
+func (c AccessChange) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid access change %d denom: %w", c.Id, err)
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("invalid access change %d: height cannot be negative", c.Id)
+	}
+	if len(c.Actor) > 0 {
+		if _, err := sdk.AccAddressFromBech32(c.Actor); err != nil {
+			return fmt.Errorf("invalid access change %d actor: %w", c.Id, err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return fmt.Errorf("invalid access change %d address: %w", c.Id, err)
+	}
+	for _, p := range c.Permissions {
+		if _, ok := Access_name[int32(p)]; !ok || p == Access_Unknown {
+			return fmt.Errorf("invalid access change %d permission: %s", c.Id, p)
+		}
+	}
+	if _, ok := AccessChangeAction_name[int32(c.Action)]; !ok || c.Action == AccessChangeAction_Unspecified {
+		return fmt.Errorf("invalid access change %d action: %s", c.Id, c.Action)
+	}
+	return nil
+}
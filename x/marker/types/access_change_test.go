@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccessChangeValidate(t *testing.T) {
+	actor := sdk.AccAddress("actor_______________")
+	addr := sdk.AccAddress("address_____________")
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	mint := AccessList{Access_Mint}
+	cases := []struct {
+		name   string
+		change AccessChange
+		errMsg string
+	}{
+		{"valid grant", NewAccessChange(1, "testcoin", 5, blockTime, actor, addr, mint, AccessChangeAction_Grant), ""},
+		{"valid revoke", NewAccessChange(1, "testcoin", 5, blockTime, actor, addr, mint, AccessChangeAction_Revoke), ""},
+		{"valid without permissions", NewAccessChange(1, "testcoin", 5, blockTime, actor, addr, nil, AccessChangeAction_Revoke), ""},
+		{"invalid denom", NewAccessChange(2, "1", 5, blockTime, actor, addr, mint, AccessChangeAction_Grant), "invalid access change 2 denom: invalid denom: 1"},
+		{"negative height", NewAccessChange(2, "testcoin", -1, blockTime, actor, addr, mint, AccessChangeAction_Grant), "invalid access change 2: height cannot be negative"},
+		{
+			"invalid actor",
+			AccessChange{Id: 2, Denom: "testcoin", Actor: "foo", Address: addr.String(), Action: AccessChangeAction_Grant},
+			"invalid access change 2 actor: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			"invalid address",
+			AccessChange{Id: 2, Denom: "testcoin", Actor: actor.String(), Address: "foo", Action: AccessChangeAction_Grant},
+			"invalid access change 2 address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{"unknown permission", NewAccessChange(2, "testcoin", 5, blockTime, actor, addr, AccessList{Access_Unknown}, AccessChangeAction_Grant), "invalid access change 2 permission: ACCESS_UNSPECIFIED"},
+		{"unspecified action", NewAccessChange(2, "testcoin", 5, blockTime, actor, addr, mint, AccessChangeAction_Unspecified), "invalid access change 2 action: ACCESS_CHANGE_ACTION_UNSPECIFIED"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccessRolePrefix marks a permission argument as the name of an access role instead of a list of permissions.
+const AccessRolePrefix = "@"
+
+var (
+	// validAccessRoleName is the expression access role names must match.
+	validAccessRoleName = regexp.MustCompile(`^[a-z][a-z0-9_\-]{0,31}$`)
+
+	// DefaultAccessRoles are the access roles defined when the module params are initialized.
+	DefaultAccessRoles = []AccessRole{
+		NewAccessRole("issuer", AccessListByNames("mint,burn,withdraw,deposit")),
+		NewAccessRole("registrar", AccessListByNames("transfer")),
+	}
+)
+
+// NewAccessRole creates a new AccessRole object
+func NewAccessRole(name string, access AccessList) AccessRole {
+	return AccessRole{
+		Name:        name,
+		Permissions: access,
+	}
+}
+
+// Validate checks that the role name is valid and that it has a non-empty list of valid permissions.
+func (r AccessRole) Validate() error {
+	if !validAccessRoleName.MatchString(r.Name) {
+		return fmt.Errorf("invalid access role name %q, must match %s", r.Name, validAccessRoleName)
+	}
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("access role %s must have at least one permission", r.Name)
+	}
+	if err := validateAccess(r.Permissions); err != nil {
+		return fmt.Errorf("access role %s: %w", r.Name, err)
+	}
+	return nil
+}
+
+// String implements stringer
+func (r AccessRole) String() string {
+	perms := make([]string, len(r.Permissions))
+	for i, p := range r.Permissions {
+		perms[i] = strings.ToLower(strings.TrimPrefix(p.String(), "ACCESS_"))
+	}
+	return fmt.Sprintf("AccessRole: %s [%s]", r.Name, strings.Join(perms, ", "))
+}
+
+// MarshalYAML implements yaml.Marshaler so that permissions are output by name instead of number.
+func (r AccessRole) MarshalYAML() (interface{}, error) {
+	perms := make([]string, len(r.Permissions))
+	for i, p := range r.Permissions {
+		perms[i] = p.String()
+	}
+	return struct {
+		Name        string   `yaml:"name"`
+		Permissions []string `yaml:"permissions"`
+	}{r.Name, perms}, nil
+}
+
+// ValidateAccessRoles checks a collection of access roles and returns any errors encountered or nil
+func ValidateAccessRoles(roles ...AccessRole) error {
+	registered := make(map[string]bool)
+	for _, role := range roles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+		if registered[role.Name] {
+			return fmt.Errorf("duplicate access role %s", role.Name)
+		}
+		registered[role.Name] = true
+	}
+	return nil
+}
+
+// FindAccessRole returns the access role with the given name, the name may be prefixed with the AccessRolePrefix.
+func FindAccessRole(roles []AccessRole, name string) (AccessRole, bool) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), AccessRolePrefix)
+	for _, role := range roles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return AccessRole{}, false
+}
+
+// NewAccessRoleGrant creates a new AccessRoleGrant object
+func NewAccessRoleGrant(address sdk.AccAddress, role string) AccessRoleGrant { // nolint:interfacer
+	return AccessRoleGrant{
+		Address: address.String(),
+		Role:    strings.TrimPrefix(strings.TrimSpace(role), AccessRolePrefix),
+	}
+}
+
+// Validate performs checks to ensure this access role grant is properly formed.
+func (g AccessRoleGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if !validAccessRoleName.MatchString(g.Role) {
+		return fmt.Errorf("invalid access role name %q", g.Role)
+	}
+	return nil
+}
+
+// AsAccessGrant returns the access grant of the role's permissions to the address of the role grant.
+func (g AccessRoleGrant) AsAccessGrant(role AccessRole) AccessGrant {
+	return AccessGrant{
+		Address:     g.Address,
+		Permissions: append(AccessList{}, role.Permissions...),
+	}
+}
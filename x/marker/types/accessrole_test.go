@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRoles(t *testing.T) {
+	require.NoError(t, ValidateAccessRoles(DefaultAccessRoles...))
+
+	role, found := FindAccessRole(DefaultAccessRoles, "@issuer")
+	require.True(t, found)
+	require.Equal(t, "AccessRole: issuer [mint, burn, withdraw, deposit]", role.String())
+	_, found = FindAccessRole(DefaultAccessRoles, "auditor")
+	require.False(t, found)
+
+	addr := testAddress()
+	grant := NewAccessRoleGrant(addr, " @issuer")
+	require.Equal(t, "issuer", grant.Role)
+	require.NoError(t, grant.Validate())
+	accessGrant := grant.AsAccessGrant(role)
+	require.Equal(t, addr, accessGrant.GetAddress())
+	require.Equal(t, role.Permissions, accessGrant.Permissions)
+
+	require.Error(t, NewAccessRoleGrant(addr, "@").Validate())
+	require.Error(t, AccessRoleGrant{Address: "invalid", Role: "issuer"}.Validate())
+	require.Error(t, NewAccessRole("issuer", AccessList{Access_Unknown}).Validate())
+}
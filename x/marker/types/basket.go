@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBasket creates a new basket reserve composition for the basket marker denom.
+func NewBasket(denom string, reservePerUnit sdk.Coins) Basket {
+	return Basket{
+		Denom:          denom,
+		ReservePerUnit: reservePerUnit,
+	}
+}
+
+// Validate ensures the basket denom and reserve composition are valid.
+func (b Basket) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return fmt.Errorf("invalid basket denom: %w", err)
+	}
+	if b.ReservePerUnit.Empty() {
+		return fmt.Errorf("basket %s must have at least one reserve denom", b.Denom)
+	}
+	if err := b.ReservePerUnit.Validate(); err != nil {
+		return fmt.Errorf("invalid reserve for basket %s: %w", b.Denom, err)
+	}
+	if !b.ReservePerUnit.AmountOf(b.Denom).IsZero() {
+		return fmt.Errorf("basket %s can not hold itself in reserve", b.Denom)
+	}
+	return nil
+}
+
+// ReserveFor returns the reserve coins held in escrow for the given amount of basket coin.
+func (b Basket) ReserveFor(amount sdk.Int) sdk.Coins {
+	reserve := sdk.NewCoins()
+	for _, coin := range b.ReservePerUnit {
+		reserve = reserve.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(amount)))
+	}
+	return reserve
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasketValidate(t *testing.T) {
+	reserve := sdk.NewCoins(sdk.NewInt64Coin("apple", 2), sdk.NewInt64Coin("banana", 1))
+	cases := []struct {
+		name   string
+		basket Basket
+		errMsg string
+	}{
+		{"valid", NewBasket("fruitbasket", reserve), ""},
+		{"invalid denom", NewBasket("", reserve), "invalid basket denom: invalid denom: "},
+		{"empty reserve", NewBasket("fruitbasket", sdk.NewCoins()), "basket fruitbasket must have at least one reserve denom"},
+		{
+			"zero reserve amount",
+			NewBasket("fruitbasket", sdk.Coins{sdk.NewInt64Coin("apple", 0)}),
+			"invalid reserve for basket fruitbasket: coin 0apple amount is not positive",
+		},
+		{
+			"holds itself in reserve",
+			NewBasket("fruitbasket", reserve.Add(sdk.NewInt64Coin("fruitbasket", 1))),
+			"basket fruitbasket can not hold itself in reserve",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.basket.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBasketReserveFor(t *testing.T) {
+	basket := NewBasket("fruitbasket", sdk.NewCoins(sdk.NewInt64Coin("apple", 2), sdk.NewInt64Coin("banana", 1)))
+	require.Equal(t, "6apple,3banana", basket.ReserveFor(sdk.NewInt(3)).String())
+	require.True(t, basket.ReserveFor(sdk.ZeroInt()).IsZero())
+}
+
+func TestMsgAddMarkerRequestBasketReserve(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	msg := NewMsgAddMarkerRequest("fruitbasket", sdk.ZeroInt(), addr, addr, MarkerType_Basket, false, false)
+	require.EqualError(t, msg.ValidateBasic(), "invalid basket marker: basket fruitbasket must have at least one reserve denom")
+
+	msg.BasketReservePerUnit = sdk.NewCoins(sdk.NewInt64Coin("apple", 2))
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.MarkerType = MarkerType_Coin
+	require.EqualError(t, msg.ValidateBasic(), "a basket reserve can only be defined for basket markers")
+}
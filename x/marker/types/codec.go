@@ -58,6 +58,25 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawRequest{},
 		&MsgTransferRequest{},
 		&MsgSetDenomMetadataRequest{},
+		&MsgUpdateMarkerFlagsRequest{},
+		&MsgDepositAndMintRequest{},
+		&MsgBurnAndRedeemRequest{},
+		&MsgDistributeEscrowRequest{},
+		&MsgAddEmissionScheduleRequest{},
+		&MsgCancelEmissionScheduleRequest{},
+		&MsgScheduleTransferRequest{},
+		&MsgCancelScheduledTransferRequest{},
+		&MsgClaimScheduledTransferRequest{},
+		&MsgPauseMarkerRequest{},
+		&MsgResumeMarkerRequest{},
+		&MsgSetDepositControlRequest{},
+		&MsgSetTransferMemoRequirementRequest{},
+		&MsgSetWithdrawApprovalPolicyRequest{},
+		&MsgApproveWithdrawRequest{},
+		&MsgSetFaucetRequest{},
+		&MsgClaimFaucetRequest{},
+		&MsgSetFeeConversionRateRequest{},
+		&MsgSweepEscrowRequest{},
 	)
 
 	registry.RegisterImplementations(
@@ -70,6 +89,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ChangeStatusProposal{},
 		&WithdrawEscrowProposal{},
 		&SetDenomMetadataProposal{},
+		&UpdateMarkerFlagsProposal{},
+		&PauseMarkerProposal{},
+		&AdoptDenomProposal{},
+		&SetFeeConversionProposal{},
 	)
 
 	registry.RegisterImplementations(
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -161,3 +162,98 @@ func validateDenom(denom string, rootCoinName string) (SIPrefix, error) {
 	}
 	return prefix, nil
 }
+
+// NewDisplayAmount converts the coin to the display denom of its metadata.  The display amount is the coin amount when
+// the metadata is for a different denom or has no unit for its display denom.
+func NewDisplayAmount(coin sdk.Coin, md banktypes.Metadata) DisplayAmount {
+	displayDenom, exponent := coin.Denom, uint32(0)
+	if md.Base == coin.Denom {
+		for _, du := range md.DenomUnits {
+			if du.Denom == md.Display {
+				displayDenom, exponent = du.Denom, du.Exponent
+				break
+			}
+		}
+	}
+	return DisplayAmount{
+		Base:          coin,
+		DisplayAmount: FormatDisplayAmount(coin.Amount, exponent),
+		DisplayDenom:  displayDenom,
+		Exponent:      exponent,
+	}
+}
+
+// FormatDisplayAmount returns the amount divided by 10^exponent as an exact decimal without trailing zeros,
+// e.g. 1.5 for 1500000000 with exponent 9.
+func FormatDisplayAmount(amount sdk.Int, exponent uint32) string {
+	digits := amount.Abs().String()
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	}
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(exponent) {
+		digits = strings.Repeat("0", int(exponent)-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-int(exponent)], strings.TrimRight(digits[len(digits)-int(exponent):], "0")
+	if len(fraction) == 0 {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
+
+// ValidateDisplayExponent checks that the exponent of a derived display unit is zero (none) or the exponent of a
+// positive SI prefix, e.g. 3 (kilo) or 9 (giga).
+func ValidateDisplayExponent(exponent uint32) error {
+	if exponent == 0 {
+		return nil
+	}
+	if p, err := SIPrefixFromExponent(int(exponent)); err != nil || !p.IsValid() {
+		return fmt.Errorf("display exponent %d is not the exponent of an SI prefix", exponent)
+	}
+	return nil
+}
+
+// DeriveDenomMetadata returns denom metadata for the base denom with a display unit of the given exponent.
+// A base denom starting with the SI prefix of the negated exponent has that prefix removed for the display denom,
+// e.g. hash for nhash with exponent 9.  Otherwise the display denom is the base denom with the SI prefix of the
+// exponent added, e.g. kcoin for coin with exponent 3.
+func DeriveDenomMetadata(base string, exponent uint32) (banktypes.Metadata, error) {
+	if err := ValidateDisplayExponent(exponent); err != nil {
+		return banktypes.Metadata{}, err
+	}
+	if exponent == 0 {
+		return banktypes.Metadata{}, errors.New("display exponent must not be zero")
+	}
+	display := SIPrefix(exponent).GetSymbol() + base
+	if basePrefix := SIPrefix(-int32(exponent)); basePrefix.IsValid() {
+		prefixes := []string{basePrefix.GetName(), basePrefix.GetSymbol()}
+		if basePrefix == SI_PREFIX_MICRO {
+			// The µ symbol is not valid in a denom, so micro denoms use a u instead.
+			prefixes = append(prefixes, "u")
+		}
+		for _, prefix := range prefixes {
+			if root := strings.TrimPrefix(base, prefix); root != base && len(root) > 0 {
+				display = root
+				break
+			}
+		}
+	}
+	md := banktypes.Metadata{
+		Description: fmt.Sprintf("%s with display unit %s", base, display),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: display, Exponent: exponent},
+		},
+		Base:    base,
+		Display: display,
+		Name:    display,
+		Symbol:  strings.ToUpper(display),
+	}
+	if err := ValidateDenomMetadataBasic(md); err != nil {
+		return banktypes.Metadata{}, err
+	}
+	return md, nil
+}
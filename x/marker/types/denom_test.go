@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -370,3 +371,104 @@ func (s *DenomTestSuite) TestGetRootCoinName() {
 		})
 	}
 }
+
+func TestNewDisplayAmount(t *testing.T) {
+	md := banktypes.Metadata{
+		Base:    "nhash",
+		Display: "hash",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "nhash", Exponent: 0},
+			{Denom: "hash", Exponent: 9},
+		},
+	}
+	tests := []struct {
+		name     string
+		coin     sdk.Coin
+		md       banktypes.Metadata
+		expected DisplayAmount
+	}{
+		{
+			"display unit",
+			sdk.NewInt64Coin("nhash", 1500000000),
+			md,
+			DisplayAmount{Base: sdk.NewInt64Coin("nhash", 1500000000), DisplayAmount: "1.5", DisplayDenom: "hash", Exponent: 9},
+		},
+		{
+			"less than one display unit",
+			sdk.NewInt64Coin("nhash", 25),
+			md,
+			DisplayAmount{Base: sdk.NewInt64Coin("nhash", 25), DisplayAmount: "0.000000025", DisplayDenom: "hash", Exponent: 9},
+		},
+		{
+			"no metadata",
+			sdk.NewInt64Coin("nhash", 1500000000),
+			banktypes.Metadata{},
+			DisplayAmount{Base: sdk.NewInt64Coin("nhash", 1500000000), DisplayAmount: "1500000000", DisplayDenom: "nhash"},
+		},
+		{
+			"metadata of another denom",
+			sdk.NewInt64Coin("other", 10),
+			md,
+			DisplayAmount{Base: sdk.NewInt64Coin("other", 10), DisplayAmount: "10", DisplayDenom: "other"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewDisplayAmount(tc.coin, tc.md))
+		})
+	}
+}
+
+func TestDeriveDenomMetadata(t *testing.T) {
+	tests := []struct {
+		base     string
+		exponent uint32
+		display  string
+		err      string
+	}{
+		{"nhash", 9, "hash", ""},
+		{"nanohash", 9, "hash", ""},
+		{"uusd", 6, "usd", ""},
+		{"mcoin", 3, "coin", ""},
+		{"coin", 3, "kcoin", ""},
+		{"nhash", 3, "knhash", ""},
+		{"coin", 0, "", "display exponent must not be zero"},
+		{"coin", 4, "", "display exponent 4 is not the exponent of an SI prefix"},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %d", tc.base, tc.exponent), func(t *testing.T) {
+			md, err := DeriveDenomMetadata(tc.base, tc.exponent)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.base, md.Base)
+			assert.Equal(t, tc.display, md.Display)
+			assert.Equal(t, []*banktypes.DenomUnit{{Denom: tc.base}, {Denom: tc.display, Exponent: tc.exponent}}, md.DenomUnits)
+			assert.Equal(t, strings.ToUpper(tc.display), md.Symbol)
+		})
+	}
+}
+
+func TestFormatDisplayAmount(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		exponent uint32
+		expected string
+	}{
+		{0, 0, "0"},
+		{0, 9, "0"},
+		{1000, 3, "1"},
+		{1234, 2, "12.34"},
+		{1200, 4, "0.12"},
+		{5, 3, "0.005"},
+		{-1500, 3, "-1.5"},
+		{123, 0, "123"},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d/10^%d", tc.amount, tc.exponent), func(t *testing.T) {
+			assert.Equal(t, tc.expected, FormatDisplayAmount(sdk.NewInt(tc.amount), tc.exponent))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEscrowDistribution creates a new escrow distribution of the amount to the holders of the marker denom.
+func NewEscrowDistribution(id uint64, denom string, administrator sdk.AccAddress, amount sdk.Coins, totalHeld sdk.Int) EscrowDistribution {
+	return EscrowDistribution{
+		Id:            id,
+		Denom:         denom,
+		Administrator: administrator.String(),
+		Amount:        amount,
+		TotalHeld:     totalHeld,
+		Remaining:     amount,
+	}
+}
+
+// Validate ensures the escrow distribution is valid.
+func (d EscrowDistribution) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid escrow distribution %d denom: %w", d.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid escrow distribution %d administrator: %w", d.Id, err)
+	}
+	if !d.Amount.IsValid() || d.Amount.Empty() {
+		return fmt.Errorf("invalid escrow distribution %d amount: %s", d.Id, d.Amount)
+	}
+	if !d.Remaining.IsValid() || !d.Amount.IsAllGTE(d.Remaining) {
+		return fmt.Errorf("invalid escrow distribution %d remaining %s of %s", d.Id, d.Remaining, d.Amount)
+	}
+	if d.TotalHeld.IsNil() || !d.TotalHeld.IsPositive() {
+		return fmt.Errorf("invalid escrow distribution %d total held: %s", d.Id, d.TotalHeld)
+	}
+	return nil
+}
+
+// PayoutFor returns the share of the distribution amount for a holder of the given amount of the marker denom.
+func (d EscrowDistribution) PayoutFor(held sdk.Int) sdk.Coins {
+	payout := sdk.NewCoins()
+	for _, coin := range d.Amount {
+		payout = payout.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(held).Quo(d.TotalHeld)))
+	}
+	return payout
+}
+
+// NewDistributionHolder creates a holder of the given amount of the marker denom to be paid by a distribution.
+func NewDistributionHolder(distributionID uint64, addr sdk.AccAddress, amount sdk.Int) DistributionHolder {
+	return DistributionHolder{
+		DistributionId: distributionID,
+		Address:        addr.String(),
+		Amount:         amount,
+	}
+}
+
+// Validate ensures the distribution holder is valid.
+func (h DistributionHolder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return fmt.Errorf("invalid escrow distribution %d holder: %w", h.DistributionId, err)
+	}
+	if h.Amount.IsNil() || !h.Amount.IsPositive() {
+		return fmt.Errorf("invalid escrow distribution %d holder %s amount: %s", h.DistributionId, h.Address, h.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEscrowDistributionValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10))
+	overpaid := NewEscrowDistribution(3, "bondcoin", admin, amount, sdk.NewInt(100))
+	overpaid.Remaining = sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 11))
+	cases := []struct {
+		name         string
+		distribution EscrowDistribution
+		errMsg       string
+	}{
+		{"valid", NewEscrowDistribution(1, "bondcoin", admin, amount, sdk.NewInt(100)), ""},
+		{"invalid denom", NewEscrowDistribution(1, "", admin, amount, sdk.NewInt(100)), "invalid escrow distribution 1 denom: invalid denom: "},
+		{
+			"invalid administrator",
+			EscrowDistribution{Id: 2, Denom: "bondcoin", Amount: amount, Remaining: amount, TotalHeld: sdk.NewInt(100)},
+			"invalid escrow distribution 2 administrator: empty address string is not allowed",
+		},
+		{"empty amount", NewEscrowDistribution(1, "bondcoin", admin, sdk.NewCoins(), sdk.NewInt(100)), "invalid escrow distribution 1 amount: "},
+		{"remaining more than amount", overpaid, "invalid escrow distribution 3 remaining 11usdcoin of 10usdcoin"},
+		{"zero total held", NewEscrowDistribution(1, "bondcoin", admin, amount, sdk.ZeroInt()), "invalid escrow distribution 1 total held: 0"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.distribution.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEscrowDistributionPayoutFor(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10), sdk.NewInt64Coin("eurcoin", 3))
+	d := NewEscrowDistribution(1, "bondcoin", admin, amount, sdk.NewInt(4))
+	require.Equal(t, "2eurcoin,7usdcoin", d.PayoutFor(sdk.NewInt(3)).String())
+	require.Equal(t, "2usdcoin", d.PayoutFor(sdk.NewInt(1)).String(), "shares are rounded down")
+}
+
+func TestGenesisStateValidateDistributions(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	holder := sdk.AccAddress("holder______________")
+	distribution := NewEscrowDistribution(1, "bondcoin", admin, sdk.NewCoins(sdk.NewInt64Coin("usdcoin", 10)), sdk.NewInt(100))
+
+	state := DefaultGenesisState()
+	state.Distributions = []EscrowDistribution{distribution}
+	state.DistributionHolders = []DistributionHolder{NewDistributionHolder(1, holder, sdk.NewInt(100))}
+	require.NoError(t, state.Validate())
+
+	state.DistributionHolders = append(state.DistributionHolders, NewDistributionHolder(2, holder, sdk.NewInt(100)))
+	require.EqualError(t, state.Validate(), "holder "+holder.String()+" of unknown escrow distribution 2")
+
+	state.DistributionHolders = []DistributionHolder{NewDistributionHolder(1, holder, sdk.ZeroInt())}
+	require.EqualError(t, state.Validate(), "invalid escrow distribution 1 holder "+holder.String()+" amount: 0")
+
+	state.DistributionHolders = nil
+	state.Distributions = append(state.Distributions, distribution)
+	require.EqualError(t, state.Validate(), "duplicate escrow distribution 1")
+}
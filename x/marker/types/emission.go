@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEmissionSchedule creates a new schedule of emissions of the amount of marker coin every interval of blocks.
+func NewEmissionSchedule(
+	id uint64, administrator sdk.AccAddress, recipient string, amount sdk.Coin, interval uint64, emissions uint64, startHeight int64,
+) EmissionSchedule {
+	return EmissionSchedule{
+		Id:                 id,
+		Denom:              amount.Denom,
+		Administrator:      administrator.String(),
+		Recipient:          recipient,
+		Amount:             amount.Amount,
+		Interval:           interval,
+		NextHeight:         startHeight,
+		RemainingEmissions: emissions,
+	}
+}
+
+// Validate ensures the emission schedule is valid.
+func (s EmissionSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid emission schedule %d denom: %w", s.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Administrator); err != nil {
+		return fmt.Errorf("invalid emission schedule %d administrator: %w", s.Id, err)
+	}
+	if len(s.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+			return fmt.Errorf("invalid emission schedule %d recipient: %w", s.Id, err)
+		}
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("invalid emission schedule %d amount: %s", s.Id, s.Amount)
+	}
+	if s.Interval == 0 {
+		return fmt.Errorf("invalid emission schedule %d: interval must be at least one block", s.Id)
+	}
+	if s.RemainingEmissions == 0 {
+		return fmt.Errorf("invalid emission schedule %d: no emissions remaining", s.Id)
+	}
+	return nil
+}
+
+// RecipientAddress returns the address the emitted coin is sent to, the escrow of the marker when no recipient is set.
+func (s EmissionSchedule) RecipientAddress() sdk.AccAddress {
+	if len(s.Recipient) == 0 {
+		return MustGetMarkerAddress(s.Denom)
+	}
+	addr, err := sdk.AccAddressFromBech32(s.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// EmissionFor returns the coin minted by a single emission, capped at the maximum amount per block over the interval.
+func (s EmissionSchedule) EmissionFor(maxPerBlock uint64) sdk.Coin {
+	max := sdk.NewIntFromUint64(maxPerBlock).Mul(sdk.NewIntFromUint64(s.Interval))
+	if s.Amount.GT(max) {
+		return sdk.NewCoin(s.Denom, max)
+	}
+	return sdk.NewCoin(s.Denom, s.Amount)
+}
+
+// Remaining returns the total amount of the remaining emissions, before any cap is applied.
+func (s EmissionSchedule) Remaining() sdk.Coin {
+	return sdk.NewCoin(s.Denom, s.Amount.Mul(sdk.NewIntFromUint64(s.RemainingEmissions)))
+}
+
+// FinalHeight returns the height of the last remaining emission.
+func (s EmissionSchedule) FinalHeight() int64 {
+	if s.RemainingEmissions == 0 {
+		return s.NextHeight
+	}
+	return s.NextHeight + int64((s.RemainingEmissions-1)*s.Interval)
+}
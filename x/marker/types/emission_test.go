@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEmissionScheduleValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	amount := sdk.NewInt64Coin("rewardcoin", 10)
+	cases := []struct {
+		name     string
+		schedule EmissionSchedule
+		errMsg   string
+	}{
+		{"valid", NewEmissionSchedule(1, admin, admin.String(), amount, 5, 3, 10), ""},
+		{"valid without recipient", NewEmissionSchedule(1, admin, "", amount, 5, 3, 10), ""},
+		{"invalid denom", EmissionSchedule{Id: 1, Administrator: admin.String(), Amount: sdk.NewInt(10), Interval: 1, RemainingEmissions: 1}, "invalid emission schedule 1 denom: invalid denom: "},
+		{
+			"invalid administrator",
+			EmissionSchedule{Id: 2, Denom: "rewardcoin", Amount: sdk.NewInt(10), Interval: 1, RemainingEmissions: 1},
+			"invalid emission schedule 2 administrator: empty address string is not allowed",
+		},
+		{"invalid recipient", NewEmissionSchedule(1, admin, "recipient", amount, 5, 3, 10), "invalid emission schedule 1 recipient: decoding bech32 failed: invalid index of 1"},
+		{"zero amount", NewEmissionSchedule(1, admin, "", sdk.NewInt64Coin("rewardcoin", 0), 5, 3, 10), "invalid emission schedule 1 amount: 0"},
+		{"zero interval", NewEmissionSchedule(1, admin, "", amount, 0, 3, 10), "invalid emission schedule 1: interval must be at least one block"},
+		{"no emissions", NewEmissionSchedule(1, admin, "", amount, 5, 0, 10), "invalid emission schedule 1: no emissions remaining"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionSchedule(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	s := NewEmissionSchedule(1, admin, "", sdk.NewInt64Coin("rewardcoin", 10), 5, 3, 10)
+	require.Equal(t, MustGetMarkerAddress("rewardcoin"), s.RecipientAddress(), "escrow of the marker without a recipient")
+	require.Equal(t, "10rewardcoin", s.EmissionFor(2).String())
+	require.Equal(t, "5rewardcoin", s.EmissionFor(1).String(), "capped at the maximum per block over the interval")
+	require.Equal(t, "30rewardcoin", s.Remaining().String())
+	require.Equal(t, int64(20), s.FinalHeight())
+}
+
+func TestGenesisStateValidateEmissionSchedules(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	amount := sdk.NewInt64Coin("rewardcoin", 10)
+	state := DefaultGenesisState()
+	state.EmissionSchedules = []EmissionSchedule{NewEmissionSchedule(1, admin, "", amount, 5, 3, 10)}
+	require.NoError(t, state.Validate())
+
+	state.EmissionSchedules = append(state.EmissionSchedules, NewEmissionSchedule(1, admin, "", sdk.NewInt64Coin("othercoin", 10), 5, 3, 10))
+	require.EqualError(t, state.Validate(), "duplicate emission schedule 1")
+
+	state.EmissionSchedules[1].Id = 2
+	state.EmissionSchedules[1].Denom = amount.Denom
+	require.EqualError(t, state.Validate(), "duplicate emission schedule for denom rewardcoin")
+}
@@ -12,4 +12,13 @@ var (
 	ErrInvalidMarkerStatus     = sdkerrors.Register(ModuleName, 5, "invalid marker status")
 	ErrAccessTypeNotGranted    = sdkerrors.Register(ModuleName, 6, "access type not granted")
 	ErrMarkerNotFound          = sdkerrors.Register(ModuleName, 7, "marker not found")
+	ErrNotRestrictedCoin       = sdkerrors.Register(ModuleName, 8, "marker type is not restricted_coin")
+	ErrNoTransferGrant         = sdkerrors.Register(ModuleName, 9, "administrator does not have transfer access")
+	ErrNoTransferAuthorization = sdkerrors.Register(ModuleName, 10, "administrator has not been authorized to transfer from account")
+	ErrTransferLimitExceeded   = sdkerrors.Register(ModuleName, 11, "amount exceeds authorized transfer limit")
+	ErrOnDenyList              = sdkerrors.Register(ModuleName, 12, "recipient is not allowed to receive funds")
+	ErrMarkerPaused            = sdkerrors.Register(ModuleName, 13, "marker is paused")
+	ErrNoDepositGrant          = sdkerrors.Register(ModuleName, 14, "sender does not have deposit access on the marker")
+	ErrTransferVetoed          = sdkerrors.Register(ModuleName, 15, "transfer vetoed by a restriction checker")
+	ErrTransferMemoRequired    = sdkerrors.Register(ModuleName, 16, "transfer memo does not match the marker's memo requirement")
 )
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -74,6 +76,22 @@ func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrato
 	}
 }
 
+func NewEventMarkerAccessChange(c AccessChange) *EventMarkerAccessChange {
+	permissions := make([]string, len(c.Permissions))
+	for i, permission := range c.Permissions {
+		permissions[i] = permission.String()
+	}
+	return &EventMarkerAccessChange{
+		ChangeId:    fmt.Sprintf("%d", c.Id),
+		Denom:       c.Denom,
+		Height:      fmt.Sprintf("%d", c.Height),
+		Actor:       c.Actor,
+		Address:     c.Address,
+		Permissions: permissions,
+		Action:      c.Action.String(),
+	}
+}
+
 func NewEventMarkerFinalize(denom string, administrator string) *EventMarkerFinalize {
 	return &EventMarkerFinalize{
 		Denom:         denom,
@@ -88,6 +106,36 @@ func NewEventMarkerActivate(denom string, administrator string) *EventMarkerActi
 	}
 }
 
+func NewEventMarkerPaused(denom string, administrator string) *EventMarkerPaused {
+	return &EventMarkerPaused{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerResumed(denom string, administrator string) *EventMarkerResumed {
+	return &EventMarkerResumed{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerDepositControl(denom string, restrictDeposits bool, administrator string) *EventMarkerDepositControl {
+	return &EventMarkerDepositControl{
+		Denom:            denom,
+		RestrictDeposits: restrictDeposits,
+		Administrator:    administrator,
+	}
+}
+
+func NewEventMarkerTransferMemoRequirement(denom string, pattern string, administrator string) *EventMarkerTransferMemoRequirement {
+	return &EventMarkerTransferMemoRequirement{
+		Denom:               denom,
+		TransferMemoPattern: pattern,
+		Administrator:       administrator,
+	}
+}
+
 func NewEventMarkerCancel(denom string, administrator string) *EventMarkerCancel {
 	return &EventMarkerCancel{
 		Denom:         denom,
@@ -118,6 +166,94 @@ func NewEventMarkerBurn(amount string, denom string, administrator string) *Even
 	}
 }
 
+func NewEventMarkerWithdrawApprovalPolicy(denom string, policy *WithdrawApprovalPolicy, administrator string) *EventMarkerWithdrawApprovalPolicy {
+	event := &EventMarkerWithdrawApprovalPolicy{
+		Denom:             denom,
+		ApprovalsRequired: "0",
+		Administrator:     administrator,
+	}
+	if policy != nil {
+		event.Threshold = policy.Threshold.String()
+		event.ApprovalsRequired = fmt.Sprintf("%d", policy.ApprovalsRequired)
+		event.ApprovalPeriod = policy.ApprovalPeriod.String()
+	}
+	return event
+}
+
+func NewEventMarkerWithdrawPending(w PendingWithdrawal) *EventMarkerWithdrawPending {
+	return &EventMarkerWithdrawPending{
+		WithdrawalId:      fmt.Sprintf("%d", w.Id),
+		Coins:             w.Amount.String(),
+		Denom:             w.Denom,
+		Administrator:     w.Administrator,
+		ToAddress:         w.ToAddress,
+		ApprovalsRequired: fmt.Sprintf("%d", w.ApprovalsRequired),
+		Expiration:        w.Expiration.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func NewEventMarkerWithdrawApproved(w PendingWithdrawal, administrator string, executed bool) *EventMarkerWithdrawApproved {
+	return &EventMarkerWithdrawApproved{
+		WithdrawalId:  fmt.Sprintf("%d", w.Id),
+		Denom:         w.Denom,
+		Administrator: administrator,
+		Approvals:     fmt.Sprintf("%d", len(w.Approvals)),
+		Executed:      executed,
+	}
+}
+
+func NewEventMarkerFaucet(denom string, faucet *MarkerFaucet, administrator string) *EventMarkerFaucet {
+	event := &EventMarkerFaucet{
+		Denom:         denom,
+		DripAmount:    "0",
+		Administrator: administrator,
+	}
+	if faucet != nil {
+		event.DripAmount = faucet.DripAmount.String()
+		event.Period = faucet.Period.String()
+		event.Mint = faucet.Mint
+	}
+	return event
+}
+
+func NewEventMarkerFaucetClaim(coin sdk.Coin, claimer string, nextClaimTime time.Time) *EventMarkerFaucetClaim {
+	return &EventMarkerFaucetClaim{
+		Coins:         coin.String(),
+		Denom:         coin.Denom,
+		Claimer:       claimer,
+		NextClaimTime: nextClaimTime.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func NewEventMarkerFeeConversion(denom string, conversion *FeeConversion) *EventMarkerFeeConversion {
+	event := &EventMarkerFeeConversion{Denom: denom}
+	if conversion != nil {
+		event.Pool = conversion.Pool
+		event.Rate = conversion.Rate.String()
+		event.Oracle = conversion.Oracle
+	}
+	return event
+}
+
+func NewEventMarkerFeeConverted(amount sdk.Coin, converted sdk.Coin, payer string, pool string) *EventMarkerFeeConverted {
+	return &EventMarkerFeeConverted{
+		Denom:     amount.Denom,
+		Payer:     payer,
+		Amount:    amount.String(),
+		Converted: converted.String(),
+		Pool:      pool,
+	}
+}
+
+func NewEventMarkerWithdrawExpired(w PendingWithdrawal) *EventMarkerWithdrawExpired {
+	return &EventMarkerWithdrawExpired{
+		WithdrawalId: fmt.Sprintf("%d", w.Id),
+		Coins:        w.Amount.String(),
+		Denom:        w.Denom,
+		ToAddress:    w.ToAddress,
+	}
+}
+
 func NewEventMarkerWithdraw(coins string, denom string, administrator string, toAddress string) *EventMarkerWithdraw {
 	return &EventMarkerWithdraw{
 		Coins:         coins,
@@ -137,6 +273,120 @@ func NewEventMarkerTransfer(amount string, denom string, administrator string, t
 	}
 }
 
+func NewEventMarkerUpdateFlags(denom string, supplyFixed bool, allowGovernanceControl bool, administrator string) *EventMarkerUpdateFlags {
+	return &EventMarkerUpdateFlags{
+		Denom:                  denom,
+		SupplyFixed:            supplyFixed,
+		AllowGovernanceControl: allowGovernanceControl,
+		Administrator:          administrator,
+	}
+}
+
+func NewEventMarkerBasketDeposit(amount string, denom string, reserve string, fromAddress string) *EventMarkerBasketDeposit {
+	return &EventMarkerBasketDeposit{
+		Amount:      amount,
+		Denom:       denom,
+		Reserve:     reserve,
+		FromAddress: fromAddress,
+	}
+}
+
+func NewEventMarkerBasketRedeem(amount string, denom string, reserve string, fromAddress string) *EventMarkerBasketRedeem {
+	return &EventMarkerBasketRedeem{
+		Amount:      amount,
+		Denom:       denom,
+		Reserve:     reserve,
+		FromAddress: fromAddress,
+	}
+}
+
+func NewEventMarkerDistribute(distributionID uint64, amount string, denom string, administrator string, holders int) *EventMarkerDistribute {
+	return &EventMarkerDistribute{
+		DistributionId: fmt.Sprintf("%d", distributionID),
+		Amount:         amount,
+		Denom:          denom,
+		Administrator:  administrator,
+		Holders:        fmt.Sprintf("%d", holders),
+	}
+}
+
+func NewEventMarkerDistributionComplete(distributionID uint64, denom string, paid string, returned string) *EventMarkerDistributionComplete {
+	return &EventMarkerDistributionComplete{
+		DistributionId: fmt.Sprintf("%d", distributionID),
+		Denom:          denom,
+		Paid:           paid,
+		Returned:       returned,
+	}
+}
+
+func NewEventMarkerEmissionScheduleAdd(s EmissionSchedule) *EventMarkerEmissionScheduleAdd {
+	return &EventMarkerEmissionScheduleAdd{
+		ScheduleId:    fmt.Sprintf("%d", s.Id),
+		Denom:         s.Denom,
+		Amount:        s.Amount.String(),
+		Interval:      fmt.Sprintf("%d", s.Interval),
+		Emissions:     fmt.Sprintf("%d", s.RemainingEmissions),
+		Recipient:     s.RecipientAddress().String(),
+		Administrator: s.Administrator,
+	}
+}
+
+func NewEventMarkerEmission(scheduleID uint64, amount string, denom string, recipient string, remaining uint64) *EventMarkerEmission {
+	return &EventMarkerEmission{
+		ScheduleId:         fmt.Sprintf("%d", scheduleID),
+		Denom:              denom,
+		Amount:             amount,
+		Recipient:          recipient,
+		RemainingEmissions: fmt.Sprintf("%d", remaining),
+	}
+}
+
+func NewEventMarkerEmissionScheduleCancel(scheduleID uint64, denom string, remaining uint64, administrator string) *EventMarkerEmissionScheduleCancel {
+	return &EventMarkerEmissionScheduleCancel{
+		ScheduleId:         fmt.Sprintf("%d", scheduleID),
+		Denom:              denom,
+		RemainingEmissions: fmt.Sprintf("%d", remaining),
+		Administrator:      administrator,
+	}
+}
+
+func NewEventMarkerTransferScheduled(t ScheduledTransfer) *EventMarkerTransferScheduled {
+	releaseTime := ""
+	if t.ReleaseTime != nil {
+		releaseTime = t.ReleaseTime.UTC().Format(time.RFC3339Nano)
+	}
+	return &EventMarkerTransferScheduled{
+		TransferId:    fmt.Sprintf("%d", t.Id),
+		Amount:        t.Amount.Amount.String(),
+		Denom:         t.Amount.Denom,
+		Administrator: t.Administrator,
+		ToAddress:     t.ToAddress,
+		FromAddress:   t.FromAddress,
+		ReleaseHeight: fmt.Sprintf("%d", t.ReleaseHeight),
+		ReleaseTime:   releaseTime,
+	}
+}
+
+func NewEventMarkerScheduledTransferReleased(t ScheduledTransfer, claimedBy string) *EventMarkerScheduledTransferReleased {
+	return &EventMarkerScheduledTransferReleased{
+		TransferId: fmt.Sprintf("%d", t.Id),
+		Amount:     t.Amount.Amount.String(),
+		Denom:      t.Amount.Denom,
+		ToAddress:  t.ToAddress,
+		ClaimedBy:  claimedBy,
+	}
+}
+
+func NewEventMarkerScheduledTransferCancel(t ScheduledTransfer, administrator string) *EventMarkerScheduledTransferCancel {
+	return &EventMarkerScheduledTransferCancel{
+		TransferId:    fmt.Sprintf("%d", t.Id),
+		Amount:        t.Amount.Amount.String(),
+		Denom:         t.Amount.Denom,
+		FromAddress:   t.FromAddress,
+		Administrator: administrator,
+	}
+}
+
 func NewEventMarkerSetDenomMetadata(metadata banktypes.Metadata, administrator string) *EventMarkerSetDenomMetadata {
 	metadataDenomUnits := make([]*EventDenomUnit, len(metadata.DenomUnits))
 	for i, du := range metadata.DenomUnits {
@@ -19,6 +19,8 @@ type BankKeeper interface {
 	//
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	// Used to separate vesting locked coins from spendable coins in the account Holding query.
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
 	// Used in the Get all marker Holders Query function
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -53,3 +55,10 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// RestrictionChecker can veto a transfer of restricted coins, e.g. so that another module, or a smart contract called
+// by one, can apply sanctions lists or other compliance rules without them being part of the marker keeper.
+type RestrictionChecker interface {
+	// CheckTransferRestriction returns an error with the reason to deny the transfer, or nil to allow it.
+	CheckTransferRestriction(ctx sdk.Context, marker MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin) error
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarkerFaucet creates a new faucet sending the drip amount of the marker's coin to each address that claims it, at
+// most once per period.
+func NewMarkerFaucet(denom string, admin sdk.AccAddress, dripAmount sdk.Int, period time.Duration, mint bool) *MarkerFaucet {
+	return &MarkerFaucet{
+		Denom:         denom,
+		Administrator: admin.String(),
+		DripAmount:    dripAmount,
+		Period:        period,
+		Mint:          mint,
+	}
+}
+
+// Validate ensures the faucet is valid.
+func (f MarkerFaucet) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid faucet denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Administrator); err != nil {
+		return fmt.Errorf("invalid faucet %s administrator: %w", f.Denom, err)
+	}
+	if f.DripAmount.IsNil() || !f.DripAmount.IsPositive() {
+		return fmt.Errorf("invalid faucet %s drip amount: must be positive", f.Denom)
+	}
+	if f.Period <= 0 {
+		return fmt.Errorf("invalid faucet %s period: must be positive", f.Denom)
+	}
+	return nil
+}
+
+// Drip returns the coin sent for each claim from the faucet.
+func (f MarkerFaucet) Drip() sdk.Coin {
+	return sdk.NewCoin(f.Denom, f.DripAmount)
+}
+
+// NewFaucetClaim creates a new record of the last claim of an address from the faucet of a marker.
+func NewFaucetClaim(denom string, claimer sdk.AccAddress, claimTime time.Time) FaucetClaim {
+	return FaucetClaim{
+		Denom:         denom,
+		Address:       claimer.String(),
+		LastClaimTime: claimTime,
+	}
+}
+
+// Validate ensures the faucet claim is valid.
+func (c FaucetClaim) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid faucet claim denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return fmt.Errorf("invalid faucet claim %s address: %w", c.Denom, err)
+	}
+	return nil
+}
+
+// NextClaimTime returns the block time from which the address may claim from a faucet with the given period again.
+func (c FaucetClaim) NextClaimTime(period time.Duration) time.Time {
+	return c.LastClaimTime.Add(period)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarkerFaucetValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	cases := []struct {
+		name   string
+		faucet *MarkerFaucet
+		errMsg string
+	}{
+		{"valid", NewMarkerFaucet("dripcoin", admin, sdk.NewInt(10), time.Hour, false), ""},
+		{"valid minting", NewMarkerFaucet("dripcoin", admin, sdk.NewInt(10), time.Hour, true), ""},
+		{
+			"invalid denom",
+			NewMarkerFaucet("1", admin, sdk.NewInt(10), time.Hour, false),
+			"invalid faucet denom: invalid denom: 1",
+		},
+		{
+			"no administrator",
+			NewMarkerFaucet("dripcoin", sdk.AccAddress{}, sdk.NewInt(10), time.Hour, false),
+			"invalid faucet dripcoin administrator: empty address string is not allowed",
+		},
+		{
+			"zero drip amount",
+			NewMarkerFaucet("dripcoin", admin, sdk.ZeroInt(), time.Hour, false),
+			"invalid faucet dripcoin drip amount: must be positive",
+		},
+		{
+			"no period",
+			NewMarkerFaucet("dripcoin", admin, sdk.NewInt(10), 0, false),
+			"invalid faucet dripcoin period: must be positive",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.faucet.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFaucetClaim(t *testing.T) {
+	claimer := sdk.AccAddress("claimer_____________")
+	claimTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFaucetClaim("dripcoin", claimer, claimTime)
+	require.NoError(t, c.Validate())
+	require.Equal(t, claimTime.Add(time.Hour), c.NextClaimTime(time.Hour))
+
+	c.Address = ""
+	require.EqualError(t, c.Validate(), "invalid faucet claim dripcoin address: empty address string is not allowed")
+}
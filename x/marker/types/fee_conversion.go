@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeConversion creates a new fee conversion accepting the marker's coin for fees, swapped at the rate for the fee
+// denom held by the pool.
+func NewFeeConversion(denom string, pool sdk.AccAddress, rate sdk.Dec, oracle sdk.AccAddress) FeeConversion {
+	return FeeConversion{
+		Denom:  denom,
+		Pool:   pool.String(),
+		Rate:   rate,
+		Oracle: oracle.String(),
+	}
+}
+
+// Validate ensures the fee conversion is valid.
+func (c FeeConversion) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid fee conversion denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Pool); err != nil {
+		return fmt.Errorf("invalid fee conversion %s pool: %w", c.Denom, err)
+	}
+	if err := ValidateFeeConversionRate(c.Rate); err != nil {
+		return fmt.Errorf("invalid fee conversion %s rate: %w", c.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Oracle); err != nil {
+		return fmt.Errorf("invalid fee conversion %s oracle: %w", c.Denom, err)
+	}
+	return nil
+}
+
+// Convert returns the amount of the fee denom given for the amount of the marker's coin, rounded down.
+func (c FeeConversion) Convert(amount sdk.Int) sdk.Int {
+	return c.Rate.MulInt(amount).TruncateInt()
+}
+
+// ValidateFeeConversionRate ensures the rate of a fee conversion is positive.
+func ValidateFeeConversionRate(rate sdk.Dec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return fmt.Errorf("must be positive")
+	}
+	return nil
+}
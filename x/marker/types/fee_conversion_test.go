@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeConversionValidate(t *testing.T) {
+	pool := sdk.AccAddress("pool________________")
+	oracle := sdk.AccAddress("oracle______________")
+	cases := []struct {
+		name       string
+		conversion FeeConversion
+		errMsg     string
+	}{
+		{"valid", NewFeeConversion("gascoin", pool, sdk.MustNewDecFromStr("0.5"), oracle), ""},
+		{
+			"invalid denom",
+			NewFeeConversion("1", pool, sdk.MustNewDecFromStr("0.5"), oracle),
+			"invalid fee conversion denom: invalid denom: 1",
+		},
+		{
+			"no pool",
+			NewFeeConversion("gascoin", sdk.AccAddress{}, sdk.MustNewDecFromStr("0.5"), oracle),
+			"invalid fee conversion gascoin pool: empty address string is not allowed",
+		},
+		{
+			"zero rate",
+			NewFeeConversion("gascoin", pool, sdk.ZeroDec(), oracle),
+			"invalid fee conversion gascoin rate: must be positive",
+		},
+		{
+			"no rate",
+			FeeConversion{Denom: "gascoin", Pool: pool.String(), Oracle: oracle.String()},
+			"invalid fee conversion gascoin rate: must be positive",
+		},
+		{
+			"no oracle",
+			NewFeeConversion("gascoin", pool, sdk.MustNewDecFromStr("0.5"), sdk.AccAddress{}),
+			"invalid fee conversion gascoin oracle: empty address string is not allowed",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conversion.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeConversionConvert(t *testing.T) {
+	c := NewFeeConversion("gascoin", sdk.AccAddress("pool________________"), sdk.MustNewDecFromStr("2.5"),
+		sdk.AccAddress("oracle______________"))
+	require.Equal(t, sdk.NewInt(25), c.Convert(sdk.NewInt(10)))
+	require.Equal(t, sdk.NewInt(2), c.Convert(sdk.NewInt(1)), "rounded down")
+	require.Equal(t, sdk.ZeroInt(), c.Convert(sdk.ZeroInt()))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -21,6 +22,115 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, b := range state.Baskets {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+	}
+	distributions := make(map[uint64]bool)
+	for _, d := range state.Distributions {
+		if distributions[d.Id] {
+			return fmt.Errorf("duplicate escrow distribution %d", d.Id)
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		distributions[d.Id] = true
+	}
+	for _, h := range state.DistributionHolders {
+		if !distributions[h.DistributionId] {
+			return fmt.Errorf("holder %s of unknown escrow distribution %d", h.Address, h.DistributionId)
+		}
+		if err := h.Validate(); err != nil {
+			return err
+		}
+	}
+	schedules := make(map[uint64]bool)
+	scheduleDenoms := make(map[string]bool)
+	for _, s := range state.EmissionSchedules {
+		if schedules[s.Id] {
+			return fmt.Errorf("duplicate emission schedule %d", s.Id)
+		}
+		if scheduleDenoms[s.Denom] {
+			return fmt.Errorf("duplicate emission schedule for denom %s", s.Denom)
+		}
+		scheduleDenoms[s.Denom] = true
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		schedules[s.Id] = true
+	}
+	transfers := make(map[uint64]bool)
+	for _, t := range state.ScheduledTransfers {
+		if transfers[t.Id] {
+			return fmt.Errorf("duplicate scheduled transfer %d", t.Id)
+		}
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		transfers[t.Id] = true
+	}
+	changes := make(map[uint64]bool)
+	for _, c := range state.SupplyChanges {
+		if changes[c.Id] {
+			return fmt.Errorf("duplicate supply change %d", c.Id)
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		changes[c.Id] = true
+	}
+	withdrawals := make(map[uint64]bool)
+	for _, w := range state.PendingWithdrawals {
+		if withdrawals[w.Id] {
+			return fmt.Errorf("duplicate pending withdrawal %d", w.Id)
+		}
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		withdrawals[w.Id] = true
+	}
+	faucets := make(map[string]bool)
+	for _, f := range state.Faucets {
+		if faucets[f.Denom] {
+			return fmt.Errorf("duplicate faucet %s", f.Denom)
+		}
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		faucets[f.Denom] = true
+	}
+	claims := make(map[string]bool)
+	for _, c := range state.FaucetClaims {
+		key := c.Denom + "/" + c.Address
+		if claims[key] {
+			return fmt.Errorf("duplicate faucet %s claim of %s", c.Denom, c.Address)
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		claims[key] = true
+	}
+	accessChanges := make(map[uint64]bool)
+	for _, c := range state.AccessChanges {
+		if accessChanges[c.Id] {
+			return fmt.Errorf("duplicate access change %d", c.Id)
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		accessChanges[c.Id] = true
+	}
+	feeConversions := make(map[string]bool)
+	for _, c := range state.FeeConversions {
+		if feeConversions[c.Denom] {
+			return fmt.Errorf("duplicate fee conversion %s", c.Denom)
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		feeConversions[c.Denom] = true
+	}
 	return nil
 }
 
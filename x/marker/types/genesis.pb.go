@@ -29,6 +29,28 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of marker accounts to create on start
 	Markers []MarkerAccount `protobuf:"bytes,2,rep,name=markers,proto3" json:"markers"`
+	// the reserve composition of each basket marker
+	Baskets []Basket `protobuf:"bytes,3,rep,name=baskets,proto3" json:"baskets"`
+	// the escrow distributions that have not yet paid all of their holders
+	Distributions []EscrowDistribution `protobuf:"bytes,4,rep,name=distributions,proto3" json:"distributions"`
+	// the holders not yet paid by the escrow distributions
+	DistributionHolders []DistributionHolder `protobuf:"bytes,5,rep,name=distribution_holders,json=distributionHolders,proto3" json:"distribution_holders"`
+	// the emission schedules that have emissions left
+	EmissionSchedules []EmissionSchedule `protobuf:"bytes,6,rep,name=emission_schedules,json=emissionSchedules,proto3" json:"emission_schedules"`
+	// the scheduled transfers that have not been released
+	ScheduledTransfers []ScheduledTransfer `protobuf:"bytes,7,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers"`
+	// the supply changes within the supply history window
+	SupplyChanges []SupplyChange `protobuf:"bytes,8,rep,name=supply_changes,json=supplyChanges,proto3" json:"supply_changes"`
+	// the withdrawals waiting for approval
+	PendingWithdrawals []PendingWithdrawal `protobuf:"bytes,9,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals"`
+	// the faucets of the markers
+	Faucets []MarkerFaucet `protobuf:"bytes,10,rep,name=faucets,proto3" json:"faucets"`
+	// the last claim of each address from each faucet
+	FaucetClaims []FaucetClaim `protobuf:"bytes,11,rep,name=faucet_claims,json=faucetClaims,proto3" json:"faucet_claims"`
+	// the access changes within the access log window
+	AccessChanges []AccessChange `protobuf:"bytes,12,rep,name=access_changes,json=accessChanges,proto3" json:"access_changes"`
+	// the markers whose coin is accepted for fees
+	FeeConversions []FeeConversion `protobuf:"bytes,13,rep,name=fee_conversions,json=feeConversions,proto3" json:"fee_conversions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,23 +95,43 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x05, 0x56, 0xa2, 0x34, 0x93,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x72, 0xe6,
-	0x62, 0x87, 0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0xae, 0xd9, 0x17,
-	0xcc, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0x9a, 0x01, 0xd3, 0x69, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0xa7, 0xf4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0xe0, 0x12, 0xcf, 0xcc, 0xc7, 0x6a, 0x72, 0x00, 0x63, 0x94, 0x51, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x89, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf,
-	0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x55, 0x2c, 0xc0, 0x80, 0x01, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0x36, 0xd6, 0xe2, 0xb6, 0x43, 0x78, 0x95, 0x88, 0x26, 0x94, 0x6e, 0x45, 0x82,
+	0x5e, 0x48, 0xb4, 0x72, 0x9b, 0xb8, 0xac, 0x85, 0xc1, 0x01, 0x44, 0xb5, 0x4d, 0x42, 0x02, 0x89,
+	0xc8, 0x75, 0xdc, 0x34, 0x5a, 0x1b, 0x47, 0xf9, 0x9c, 0x96, 0xbd, 0x01, 0x47, 0x1e, 0x61, 0x2f,
+	0xc1, 0x3b, 0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x0b, 0x8f, 0x81, 0xe2, 0x38, 0x6d, 0x56, 0xd2,
+	0xde, 0xdc, 0xcf, 0xbf, 0xff, 0xcf, 0x5f, 0x3f, 0x39, 0x46, 0xcd, 0x30, 0xe2, 0x13, 0x16, 0x90,
+	0x80, 0x32, 0x7b, 0x4c, 0xa2, 0x4b, 0x16, 0xd9, 0x93, 0x23, 0xdb, 0x63, 0x01, 0x03, 0x1f, 0xac,
+	0x30, 0xe2, 0x82, 0xe3, 0xfa, 0x92, 0xb1, 0x52, 0xc6, 0x9a, 0x1c, 0xed, 0xd7, 0x3d, 0xee, 0x71,
+	0x09, 0xd8, 0xc9, 0x2a, 0x65, 0xf7, 0x0f, 0x0b, 0x7d, 0x2a, 0x25, 0x91, 0xe6, 0xcf, 0x32, 0xaa,
+	0xbe, 0x4d, 0x0f, 0x38, 0x17, 0x44, 0x30, 0x7c, 0x8c, 0x76, 0x42, 0x12, 0x91, 0x31, 0x18, 0xfa,
+	0x81, 0xde, 0xaa, 0xb4, 0x9f, 0x58, 0x45, 0x07, 0x5a, 0x3d, 0xc9, 0x74, 0xb6, 0x6f, 0x7e, 0x37,
+	0xb4, 0x33, 0x95, 0xc0, 0x5d, 0x54, 0x4a, 0x09, 0x30, 0xee, 0x1d, 0x6c, 0xb5, 0x2a, 0xed, 0xa7,
+	0xc5, 0xe1, 0x0f, 0x72, 0x75, 0x42, 0x29, 0x8f, 0x03, 0xa1, 0x1c, 0x59, 0x12, 0xbf, 0x42, 0xa5,
+	0x3e, 0x81, 0x4b, 0x26, 0xc0, 0xd8, 0x92, 0x92, 0x35, 0x1d, 0x74, 0x24, 0x94, 0xa5, 0x55, 0x04,
+	0x5f, 0xa0, 0x9a, 0xeb, 0x83, 0x88, 0xfc, 0x7e, 0x2c, 0x7c, 0x1e, 0x80, 0xb1, 0x2d, 0x1d, 0xad,
+	0x62, 0xc7, 0x1b, 0xa0, 0x11, 0x9f, 0xbe, 0xce, 0x05, 0x94, 0xef, 0xae, 0x04, 0x13, 0x54, 0xcf,
+	0x17, 0x9c, 0x21, 0x1f, 0xb9, 0xc9, 0xbf, 0xbc, 0xbf, 0x49, 0x9e, 0xd7, 0xbe, 0x93, 0x01, 0x25,
+	0xdf, 0x73, 0xff, 0xdb, 0x01, 0xfc, 0x05, 0x61, 0x36, 0xf6, 0x01, 0x12, 0x3d, 0xd0, 0x21, 0x73,
+	0xe3, 0x11, 0x03, 0x63, 0x47, 0x1e, 0xf0, 0x6c, 0x4d, 0xf7, 0x8a, 0x3f, 0x57, 0xb8, 0xd2, 0x3f,
+	0x62, 0x2b, 0x75, 0xc0, 0x5f, 0xd1, 0x5e, 0xe6, 0x74, 0x1d, 0x11, 0x91, 0x00, 0x06, 0x49, 0xfb,
+	0x25, 0x69, 0x7f, 0x5e, 0x6c, 0xcf, 0xd2, 0xee, 0x85, 0xe2, 0x95, 0x1e, 0xc3, 0xea, 0x06, 0xe0,
+	0x8f, 0x68, 0x17, 0xe2, 0x30, 0x1c, 0x5d, 0x39, 0x74, 0x48, 0x02, 0x8f, 0x81, 0x51, 0x96, 0xea,
+	0xe6, 0x1a, 0xb5, 0x64, 0xbb, 0x12, 0xcd, 0x06, 0x0e, 0xb9, 0x9a, 0x6c, 0x38, 0x64, 0x81, 0xeb,
+	0x07, 0x9e, 0x33, 0xf5, 0xc5, 0xd0, 0x8d, 0xc8, 0x94, 0x8c, 0xc0, 0x78, 0xb0, 0xa9, 0xe1, 0x5e,
+	0x1a, 0xf8, 0xb4, 0xe0, 0xb3, 0x86, 0xc3, 0xd5, 0x0d, 0xc0, 0x1d, 0x54, 0x1a, 0x90, 0x98, 0x26,
+	0x97, 0x0c, 0x6d, 0xea, 0x34, 0xbd, 0xa9, 0xa7, 0x12, 0xcd, 0xae, 0x9a, 0x0a, 0xe2, 0xf7, 0xa8,
+	0x96, 0x2e, 0x1d, 0x3a, 0x22, 0xfe, 0x18, 0x8c, 0x8a, 0x34, 0x1d, 0x16, 0x9b, 0x52, 0x47, 0x37,
+	0x21, 0x95, 0xa8, 0x3a, 0x58, 0x96, 0xe4, 0x08, 0x09, 0xa5, 0x0c, 0x60, 0x31, 0xc2, 0xea, 0xa6,
+	0xc6, 0x4e, 0x24, 0x7b, 0x77, 0x84, 0x24, 0x57, 0x03, 0x7c, 0x86, 0x1e, 0x0e, 0x18, 0x73, 0x28,
+	0x0f, 0x26, 0x2c, 0x02, 0xf9, 0x2d, 0xd4, 0x36, 0x7d, 0x94, 0xa7, 0x8c, 0x75, 0x17, 0xac, 0x52,
+	0xee, 0x0e, 0xf2, 0x45, 0x38, 0x2e, 0x7f, 0xbf, 0x6e, 0x68, 0x7f, 0xaf, 0x1b, 0x5a, 0xc7, 0xbb,
+	0x99, 0x99, 0xfa, 0xed, 0xcc, 0xd4, 0xff, 0xcc, 0x4c, 0xfd, 0xc7, 0xdc, 0xd4, 0x6e, 0xe7, 0xa6,
+	0xf6, 0x6b, 0x6e, 0x6a, 0xe8, 0xb1, 0xcf, 0x0b, 0x0f, 0xe8, 0xe9, 0x9f, 0xdb, 0x9e, 0x2f, 0x86,
+	0x71, 0xdf, 0xa2, 0x7c, 0x6c, 0x2f, 0x91, 0x17, 0x3e, 0xcf, 0xfd, 0xb2, 0xbf, 0x65, 0x4f, 0x95,
+	0xb8, 0x0a, 0x19, 0xf4, 0x77, 0xe4, 0x3b, 0xf5, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d,
+	0x79, 0xf1, 0x2a, 0x1c, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +154,160 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeConversions) > 0 {
+		for iNdEx := len(m.FeeConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AccessChanges) > 0 {
+		for iNdEx := len(m.AccessChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FaucetClaims) > 0 {
+		for iNdEx := len(m.FaucetClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FaucetClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Faucets) > 0 {
+		for iNdEx := len(m.Faucets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Faucets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SupplyChanges) > 0 {
+		for iNdEx := len(m.SupplyChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for iNdEx := len(m.ScheduledTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EmissionSchedules) > 0 {
+		for iNdEx := len(m.EmissionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for iNdEx := len(m.DistributionHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Baskets) > 0 {
+		for iNdEx := len(m.Baskets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Baskets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Markers) > 0 {
 		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -164,6 +360,72 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Baskets) > 0 {
+		for _, e := range m.Baskets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for _, e := range m.DistributionHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EmissionSchedules) > 0 {
+		for _, e := range m.EmissionSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for _, e := range m.ScheduledTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyChanges) > 0 {
+		for _, e := range m.SupplyChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Faucets) > 0 {
+		for _, e := range m.Faucets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FaucetClaims) > 0 {
+		for _, e := range m.FaucetClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccessChanges) > 0 {
+		for _, e := range m.AccessChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeConversions) > 0 {
+		for _, e := range m.FeeConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +531,380 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baskets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Baskets = append(m.Baskets, Basket{})
+			if err := m.Baskets[len(m.Baskets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, EscrowDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionHolders = append(m.DistributionHolders, DistributionHolder{})
+			if err := m.DistributionHolders[len(m.DistributionHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSchedules = append(m.EmissionSchedules, EmissionSchedule{})
+			if err := m.EmissionSchedules[len(m.EmissionSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTransfers = append(m.ScheduledTransfers, ScheduledTransfer{})
+			if err := m.ScheduledTransfers[len(m.ScheduledTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyChanges = append(m.SupplyChanges, SupplyChange{})
+			if err := m.SupplyChanges[len(m.SupplyChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faucets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faucets = append(m.Faucets, MarkerFaucet{})
+			if err := m.Faucets[len(m.Faucets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaucetClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaucetClaims = append(m.FaucetClaims, FaucetClaim{})
+			if err := m.FaucetClaims[len(m.FaucetClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessChanges = append(m.AccessChanges, AccessChange{})
+			if err := m.AccessChanges[len(m.AccessChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeConversions = append(m.FeeConversions, FeeConversion{})
+			if err := m.FeeConversions[len(m.FeeConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -31,6 +32,60 @@ const (
 var (
 	// MarkerStoreKeyPrefix prefix for marker-address reference (improves iterator performance over auth accounts)
 	MarkerStoreKeyPrefix = []byte{0x02}
+	// BasketStoreKeyPrefix prefix for the reserve composition of basket markers
+	BasketStoreKeyPrefix = []byte{0x03}
+	// MarkerTotalsKeyPrefix prefix for the totals of markers grouped by type and status
+	MarkerTotalsKeyPrefix = []byte{0x04}
+	// MarkerTotalsEntryKeyPrefix prefix for the amounts each marker contributes to the marker totals
+	MarkerTotalsEntryKeyPrefix = []byte{0x05}
+	// EscrowDistributionKeyPrefix prefix for the escrow distributions that have not yet paid all of their holders
+	EscrowDistributionKeyPrefix = []byte{0x06}
+	// DistributionHolderKeyPrefix prefix for the holders not yet paid by each escrow distribution
+	DistributionHolderKeyPrefix = []byte{0x07}
+	// NextDistributionIDKey is the key of the id to use for the next escrow distribution
+	NextDistributionIDKey = []byte{0x08}
+	// EmissionScheduleKeyPrefix prefix for the emission schedules that have emissions left
+	EmissionScheduleKeyPrefix = []byte{0x09}
+	// NextEmissionScheduleIDKey is the key of the id to use for the next emission schedule
+	NextEmissionScheduleIDKey = []byte{0x0A}
+	// ScheduledTransferKeyPrefix prefix for the scheduled transfers that have not been released
+	ScheduledTransferKeyPrefix = []byte{0x0B}
+	// NextScheduledTransferIDKey is the key of the id to use for the next scheduled transfer
+	NextScheduledTransferIDKey = []byte{0x0C}
+	// SupplyChangeKeyPrefix prefix for the supply changes of each marker within the supply history window
+	SupplyChangeKeyPrefix = []byte{0x0D}
+	// SupplyChangeHeightKeyPrefix prefix for the index of the supply changes by the height they were made at
+	SupplyChangeHeightKeyPrefix = []byte{0x0E}
+	// NextSupplyChangeIDKey is the key of the id to use for the next supply change
+	NextSupplyChangeIDKey = []byte{0x0F}
+	// PendingWithdrawalKeyPrefix prefix for the withdrawals waiting for approval
+	PendingWithdrawalKeyPrefix = []byte{0x10}
+	// NextPendingWithdrawalIDKey is the key of the id to use for the next pending withdrawal
+	NextPendingWithdrawalIDKey = []byte{0x11}
+	// FaucetKeyPrefix prefix for the faucets of the markers
+	FaucetKeyPrefix = []byte{0x12}
+	// FaucetClaimKeyPrefix prefix for the last claim of each address from the faucet of each marker
+	FaucetClaimKeyPrefix = []byte{0x13}
+	// AccessChangeKeyPrefix prefix for the access changes of each marker within the access log window
+	AccessChangeKeyPrefix = []byte{0x14}
+	// AccessChangeHeightKeyPrefix prefix for the index of the access changes by the height they were made at
+	AccessChangeHeightKeyPrefix = []byte{0x15}
+	// NextAccessChangeIDKey is the key of the id to use for the next access change
+	NextAccessChangeIDKey = []byte{0x16}
+	// FeeConversionKeyPrefix prefix for the fee conversions of the markers whose coin is accepted for fees
+	FeeConversionKeyPrefix = []byte{0x17}
+	// AccessGranteeKeyPrefix prefix for the index of the markers each address has been granted access to
+	AccessGranteeKeyPrefix = []byte{0x18}
+	// EmissionScheduleHeightKeyPrefix prefix for the index of the emission schedules by the height of their next emission
+	EmissionScheduleHeightKeyPrefix = []byte{0x19}
+	// EmissionScheduleDenomKeyPrefix prefix for the index of the emission schedule of each marker denom
+	EmissionScheduleDenomKeyPrefix = []byte{0x1A}
+	// ScheduledTransferHeightKeyPrefix prefix for the index of the scheduled transfers by their release height
+	ScheduledTransferHeightKeyPrefix = []byte{0x1B}
+	// ScheduledTransferTimeKeyPrefix prefix for the index of the scheduled transfers by their release time
+	ScheduledTransferTimeKeyPrefix = []byte{0x1C}
+	// PendingWithdrawalExpirationKeyPrefix prefix for the index of the pending withdrawals by their expiration
+	PendingWithdrawalExpirationKeyPrefix = []byte{0x1D}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -55,7 +110,178 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 	return append(MarkerStoreKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
 
+// BasketStoreKey turn a basket marker address to the key used to get its reserve composition from the store
+func BasketStoreKey(addr sdk.AccAddress) []byte {
+	return append(BasketStoreKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
 // SplitMarkerStoreKey returns an account address given a store key, uses the length prefix to determine length of AccAddress
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[2 : key[1]+2])
 }
+
+// MarkerTotalsKey returns the key used to store the totals of markers with the given type and status
+func MarkerTotalsKey(markerType MarkerType, status MarkerStatus) []byte {
+	return append(append([]byte{}, MarkerTotalsKeyPrefix...), byte(markerType), byte(status))
+}
+
+// MarkerTotalsEntryKey turn a marker address to the key used to get the amounts it contributes to the marker totals
+func MarkerTotalsEntryKey(addr sdk.AccAddress) []byte {
+	return append(MarkerTotalsEntryKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// EscrowDistributionKey returns the key used to store the escrow distribution with the given id
+func EscrowDistributionKey(id uint64) []byte {
+	return append(append([]byte{}, EscrowDistributionKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionHoldersKeyPrefix returns the prefix of the keys of the holders not yet paid by the given distribution
+func DistributionHoldersKeyPrefix(id uint64) []byte {
+	return append(append([]byte{}, DistributionHolderKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DistributionHolderKey returns the key used to store a holder not yet paid by the given distribution
+func DistributionHolderKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(DistributionHoldersKeyPrefix(id), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// EmissionScheduleKey returns the key used to store the emission schedule with the given id
+func EmissionScheduleKey(id uint64) []byte {
+	return append(append([]byte{}, EmissionScheduleKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EmissionScheduleHeightKey returns the key used to index the emission schedule with the given id by the height of
+// its next emission
+func EmissionScheduleHeightKey(height int64, id uint64) []byte {
+	key := append(append([]byte{}, EmissionScheduleHeightKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitEmissionScheduleHeightKey returns the height and id of an emission schedule height index key
+func SplitEmissionScheduleHeightKey(key []byte) (int64, uint64) {
+	return int64(sdk.BigEndianToUint64(key[1:9])), sdk.BigEndianToUint64(key[9:])
+}
+
+// EmissionScheduleDenomKey returns the key used to index the emission schedule of the given marker denom
+func EmissionScheduleDenomKey(denom string) []byte {
+	return append(append([]byte{}, EmissionScheduleDenomKeyPrefix...), []byte(denom)...)
+}
+
+// ScheduledTransferKey returns the key used to store the scheduled transfer with the given id
+func ScheduledTransferKey(id uint64) []byte {
+	return append(append([]byte{}, ScheduledTransferKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTransferHeightKey returns the key used to index the scheduled transfer with the given id by its release
+// height
+func ScheduledTransferHeightKey(height int64, id uint64) []byte {
+	key := append(append([]byte{}, ScheduledTransferHeightKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTransferTimeKey returns the key used to index the scheduled transfer with the given id by its release time
+func ScheduledTransferTimeKey(releaseTime time.Time, id uint64) []byte {
+	key := append(append([]byte{}, ScheduledTransferTimeKeyPrefix...), sdk.FormatTimeBytes(releaseTime)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledTransferIndexKeyID returns the id of the scheduled transfer of a height or time index key
+func ScheduledTransferIndexKeyID(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// SupplyChangesKeyPrefix returns the prefix of the keys of the supply changes of the marker with the given address
+func SupplyChangesKeyPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, SupplyChangeKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// SupplyChangeKey returns the key used to store the supply change of the marker with the given address and id
+func SupplyChangeKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(SupplyChangesKeyPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SupplyChangeHeightKey returns the key used to index the supply change of the marker with the given address and id
+// by the height it was made at
+func SupplyChangeHeightKey(height int64, addr sdk.AccAddress, id uint64) []byte {
+	key := append(append([]byte{}, SupplyChangeHeightKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(append(key, address.MustLengthPrefix(addr.Bytes())...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitSupplyChangeHeightKey returns the height, marker address, and id of a supply change height index key
+func SplitSupplyChangeHeightKey(key []byte) (int64, sdk.AccAddress, uint64) {
+	height := int64(sdk.BigEndianToUint64(key[1:9]))
+	addrLen := int(key[9])
+	addr := sdk.AccAddress(key[10 : 10+addrLen])
+	return height, addr, sdk.BigEndianToUint64(key[10+addrLen:])
+}
+
+// PendingWithdrawalKey returns the key used to store the pending withdrawal with the given id
+func PendingWithdrawalKey(id uint64) []byte {
+	return append(append([]byte{}, PendingWithdrawalKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingWithdrawalExpirationKey returns the key used to index the pending withdrawal with the given id by its
+// expiration
+func PendingWithdrawalExpirationKey(expiration time.Time, id uint64) []byte {
+	key := append(append([]byte{}, PendingWithdrawalExpirationKeyPrefix...), sdk.FormatTimeBytes(expiration)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingWithdrawalExpirationKeyID returns the id of the pending withdrawal of an expiration index key
+func PendingWithdrawalExpirationKeyID(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// FaucetKey returns the key used to store the faucet of the marker with the given address
+func FaucetKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FaucetKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// FaucetClaimsKeyPrefix returns the prefix of the keys of the claims from the faucet of the marker with the given
+// address
+func FaucetClaimsKeyPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FaucetClaimKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// FaucetClaimKey returns the key used to store the last claim of the claimer from the faucet of the marker with the
+// given address
+func FaucetClaimKey(addr sdk.AccAddress, claimer sdk.AccAddress) []byte {
+	return append(FaucetClaimsKeyPrefix(addr), address.MustLengthPrefix(claimer.Bytes())...)
+}
+
+// AccessChangesKeyPrefix returns the prefix of the keys of the access changes of the marker with the given address
+func AccessChangesKeyPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, AccessChangeKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// AccessChangeKey returns the key used to store the access change of the marker with the given address and id
+func AccessChangeKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(AccessChangesKeyPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// AccessChangeHeightKey returns the key used to index the access change of the marker with the given address and id
+// by the height it was made at
+func AccessChangeHeightKey(height int64, addr sdk.AccAddress, id uint64) []byte {
+	key := append(append([]byte{}, AccessChangeHeightKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(append(key, address.MustLengthPrefix(addr.Bytes())...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitAccessChangeHeightKey returns the height, marker address, and id of an access change height index key
+func SplitAccessChangeHeightKey(key []byte) (int64, sdk.AccAddress, uint64) {
+	return SplitSupplyChangeHeightKey(key)
+}
+
+// FeeConversionKey returns the key used to store the fee conversion of the marker with the given address
+func FeeConversionKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FeeConversionKeyPrefix...), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// AccessGranteeKeysPrefix returns the prefix of the index keys of the markers the given address has been granted access to
+func AccessGranteeKeysPrefix(grantee sdk.AccAddress) []byte {
+	return append(append([]byte{}, AccessGranteeKeyPrefix...), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// AccessGranteeKey returns the key used to index the access granted to an address on the marker with the given address
+func AccessGranteeKey(grantee, markerAddr sdk.AccAddress) []byte {
+	return append(AccessGranteeKeysPrefix(grantee), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
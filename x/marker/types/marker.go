@@ -36,6 +36,7 @@ type MarkerAccountI interface {
 	GetSupply() sdk.Coin
 	SetSupply(sdk.Coin) error
 	HasFixedSupply() bool
+	SetFixedSupply(bool)
 
 	GrantAccess(AccessGrantI) error
 	RevokeAccess(sdk.AccAddress) error
@@ -45,6 +46,22 @@ type MarkerAccountI interface {
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+	SetGovernanceEnabled(bool)
+
+	IsPaused() bool
+	SetPaused(bool)
+
+	RestrictsDeposits() bool
+	SetRestrictDeposits(bool)
+
+	GetTransferMemoPattern() string
+	SetTransferMemoPattern(string)
+
+	GetWithdrawApprovalPolicy() *WithdrawApprovalPolicy
+	SetWithdrawApprovalPolicy(*WithdrawApprovalPolicy)
+
+	GetDisplayExponent() uint32
+	SetDisplayExponent(uint32)
 }
 
 // NewEmptyMarkerAccount creates a new empty marker account in a Proposed state
@@ -101,9 +118,50 @@ func (ma MarkerAccount) GetDenom() string { return ma.Denom }
 // invariant check
 func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 
+// SetFixedSupply sets whether the total supply for the marker is "fixed" and controlled with an invariant check
+func (ma *MarkerAccount) SetFixedSupply(fixed bool) { ma.SupplyFixed = fixed }
+
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
+// SetGovernanceEnabled sets whether this marker allows governance proposals to control this marker
+func (ma *MarkerAccount) SetGovernanceEnabled(enabled bool) { ma.AllowGovernanceControl = enabled }
+
+// IsPaused returns true if all transfers, mints and burns of this marker's coin are blocked
+func (ma MarkerAccount) IsPaused() bool { return ma.Paused }
+
+// SetPaused sets whether all transfers, mints and burns of this marker's coin are blocked
+func (ma *MarkerAccount) SetPaused(paused bool) { ma.Paused = paused }
+
+// RestrictsDeposits returns true if coins can only be sent to this marker by addresses with the deposit access
+func (ma MarkerAccount) RestrictsDeposits() bool { return ma.RestrictDeposits }
+
+// SetRestrictDeposits sets whether coins can only be sent to this marker by addresses with the deposit access
+func (ma *MarkerAccount) SetRestrictDeposits(restrict bool) { ma.RestrictDeposits = restrict }
+
+// GetTransferMemoPattern returns the pattern the memo of a transfer of this marker's coin must match, empty if none
+func (ma MarkerAccount) GetTransferMemoPattern() string { return ma.TransferMemoPattern }
+
+// SetTransferMemoPattern sets the pattern the memo of a transfer of this marker's coin must match
+func (ma *MarkerAccount) SetTransferMemoPattern(pattern string) { ma.TransferMemoPattern = pattern }
+
+// GetWithdrawApprovalPolicy returns the policy requiring approval of large withdrawals from this marker, nil if none
+func (ma MarkerAccount) GetWithdrawApprovalPolicy() *WithdrawApprovalPolicy {
+	return ma.WithdrawApprovalPolicy
+}
+
+// SetWithdrawApprovalPolicy sets the policy requiring approval of large withdrawals from this marker
+func (ma *MarkerAccount) SetWithdrawApprovalPolicy(policy *WithdrawApprovalPolicy) {
+	ma.WithdrawApprovalPolicy = policy
+}
+
+// GetDisplayExponent returns the exponent of the display unit of the denom metadata derived on activation, zero if the
+// module default is used
+func (ma MarkerAccount) GetDisplayExponent() uint32 { return ma.DisplayExponent }
+
+// SetDisplayExponent sets the exponent of the display unit of the denom metadata derived on activation
+func (ma *MarkerAccount) SetDisplayExponent(exponent uint32) { ma.DisplayExponent = exponent }
+
 // AddressHasAccess returns true if the provided address has been assigned the provided
 // role within the current MarkerAccount AccessControl
 func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool {
@@ -140,9 +198,14 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
 		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
 	}
-	if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
+	if ma.Status == StatusFinalized && ma.MarkerType != MarkerType_Basket &&
+		len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
 		return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
 	}
+	// basket supply is only created by reserve deposits
+	if ma.MarkerType == MarkerType_Basket && (ma.SupplyFixed || !ma.Supply.IsZero()) {
+		return fmt.Errorf("a basket marker must have a zero total supply that is not fixed")
+	}
 	// unlikely as this is set using a Coin which prohibits this value.
 	if strings.TrimSpace(ma.Denom) == "" {
 		return fmt.Errorf("marker denom cannot be empty")
@@ -164,6 +227,14 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Manager == ma.GetAddress().String() {
 		return fmt.Errorf("marker can not be self managed")
 	}
+	if ma.WithdrawApprovalPolicy != nil {
+		if err := ma.WithdrawApprovalPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid withdraw approval policy: %w", err)
+		}
+	}
+	if err := ValidateDisplayExponent(ma.DisplayExponent); err != nil {
+		return err
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -309,6 +380,8 @@ func MarkerTypeFromString(str string) (MarkerType, error) {
 		fallthrough
 	case "restrictedcoin":
 		return MarkerType_RestrictedCoin, nil
+	case "basket":
+		return MarkerType_Basket, nil
 
 	default:
 		if val, ok := MarkerType_value[str]; ok {
@@ -6,19 +6,25 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,18 +42,23 @@ const (
 	MarkerType_Coin MarkerType = 1
 	// MARKER_TYPE_RESTRICTED is a marker that represents a denom with send_enabled = false.
 	MarkerType_RestrictedCoin MarkerType = 2
+	// MARKER_TYPE_BASKET is a marker whose supply is only minted and burned against a reserve of other denoms held in
+	// escrow by the marker at a fixed ratio.
+	MarkerType_Basket MarkerType = 3
 )
 
 var MarkerType_name = map[int32]string{
 	0: "MARKER_TYPE_UNSPECIFIED",
 	1: "MARKER_TYPE_COIN",
 	2: "MARKER_TYPE_RESTRICTED",
+	3: "MARKER_TYPE_BASKET",
 }
 
 var MarkerType_value = map[string]int32{
 	"MARKER_TYPE_UNSPECIFIED": 0,
 	"MARKER_TYPE_COIN":        1,
 	"MARKER_TYPE_RESTRICTED":  2,
+	"MARKER_TYPE_BASKET":      3,
 }
 
 func (x MarkerType) String() string {
@@ -99,6 +110,140 @@ func (MarkerStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{1}
 }
 
+// TransferDenyReason is the reason a transfer of a restricted coin is not allowed.
+type TransferDenyReason int32
+
+const (
+	// TRANSFER_DENY_REASON_UNSPECIFIED is an invalid/unknown reason.
+	TransferDenyReason_Unspecified TransferDenyReason = 0
+	// TRANSFER_DENY_REASON_MARKER_NOT_FOUND - there is no marker for the denom of the coin.
+	TransferDenyReason_MarkerNotFound TransferDenyReason = 1
+	// TRANSFER_DENY_REASON_NOT_RESTRICTED - the marker is not a restricted coin so it is sent with the bank module.
+	TransferDenyReason_NotRestricted TransferDenyReason = 2
+	// TRANSFER_DENY_REASON_NO_TRANSFER_GRANT - the administrator does not hold the transfer access on the marker.
+	TransferDenyReason_NoTransferGrant TransferDenyReason = 3
+	// TRANSFER_DENY_REASON_NO_AUTHORIZATION - the sender has not authorized the administrator to transfer its coins.
+	TransferDenyReason_NoAuthorization TransferDenyReason = 4
+	// TRANSFER_DENY_REASON_AUTHORIZATION_LIMIT - the amount is more than the transfer limit authorized by the sender.
+	TransferDenyReason_AuthorizationLimit TransferDenyReason = 5
+	// TRANSFER_DENY_REASON_ON_DENY_LIST - the recipient is a blocked address that is not allowed to receive funds.
+	TransferDenyReason_OnDenyList TransferDenyReason = 6
+	// TRANSFER_DENY_REASON_INSUFFICIENT_FUNDS - the sender does not have enough spendable coins.
+	TransferDenyReason_InsufficientFunds TransferDenyReason = 7
+	// TRANSFER_DENY_REASON_PAUSED - the marker has been paused and its coin cannot be transferred until it is resumed.
+	TransferDenyReason_Paused TransferDenyReason = 8
+	// TRANSFER_DENY_REASON_NO_DEPOSIT_GRANT - the recipient is a marker that restricts deposits and the administrator
+	// does not hold the deposit access on it.
+	TransferDenyReason_NoDepositGrant TransferDenyReason = 9
+	// TRANSFER_DENY_REASON_VETOED - a restriction checker registered with the marker keeper, e.g. the compliance rules of
+	// another module, vetoed the transfer.
+	TransferDenyReason_Vetoed TransferDenyReason = 10
+)
+
+var TransferDenyReason_name = map[int32]string{
+	0:  "TRANSFER_DENY_REASON_UNSPECIFIED",
+	1:  "TRANSFER_DENY_REASON_MARKER_NOT_FOUND",
+	2:  "TRANSFER_DENY_REASON_NOT_RESTRICTED",
+	3:  "TRANSFER_DENY_REASON_NO_TRANSFER_GRANT",
+	4:  "TRANSFER_DENY_REASON_NO_AUTHORIZATION",
+	5:  "TRANSFER_DENY_REASON_AUTHORIZATION_LIMIT",
+	6:  "TRANSFER_DENY_REASON_ON_DENY_LIST",
+	7:  "TRANSFER_DENY_REASON_INSUFFICIENT_FUNDS",
+	8:  "TRANSFER_DENY_REASON_PAUSED",
+	9:  "TRANSFER_DENY_REASON_NO_DEPOSIT_GRANT",
+	10: "TRANSFER_DENY_REASON_VETOED",
+}
+
+var TransferDenyReason_value = map[string]int32{
+	"TRANSFER_DENY_REASON_UNSPECIFIED":         0,
+	"TRANSFER_DENY_REASON_MARKER_NOT_FOUND":    1,
+	"TRANSFER_DENY_REASON_NOT_RESTRICTED":      2,
+	"TRANSFER_DENY_REASON_NO_TRANSFER_GRANT":   3,
+	"TRANSFER_DENY_REASON_NO_AUTHORIZATION":    4,
+	"TRANSFER_DENY_REASON_AUTHORIZATION_LIMIT": 5,
+	"TRANSFER_DENY_REASON_ON_DENY_LIST":        6,
+	"TRANSFER_DENY_REASON_INSUFFICIENT_FUNDS":  7,
+	"TRANSFER_DENY_REASON_PAUSED":              8,
+	"TRANSFER_DENY_REASON_NO_DEPOSIT_GRANT":    9,
+	"TRANSFER_DENY_REASON_VETOED":              10,
+}
+
+func (x TransferDenyReason) String() string {
+	return proto.EnumName(TransferDenyReason_name, int32(x))
+}
+
+func (TransferDenyReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+}
+
+// SupplyChangeReason is the reason the supply of a marker's coin was changed.
+type SupplyChangeReason int32
+
+const (
+	// SUPPLY_CHANGE_REASON_UNSPECIFIED is an invalid/unknown reason.
+	SupplyChangeReason_Unspecified SupplyChangeReason = 0
+	// SUPPLY_CHANGE_REASON_MINT - coin was minted, e.g. by an address with mint access or an emission schedule.
+	SupplyChangeReason_Mint SupplyChangeReason = 1
+	// SUPPLY_CHANGE_REASON_BURN - coin was burned, e.g. by an address with burn access or a basket redemption.
+	SupplyChangeReason_Burn SupplyChangeReason = 2
+	// SUPPLY_CHANGE_REASON_GOVERNANCE - coin was minted or burned by a governance proposal.
+	SupplyChangeReason_Governance SupplyChangeReason = 3
+)
+
+var SupplyChangeReason_name = map[int32]string{
+	0: "SUPPLY_CHANGE_REASON_UNSPECIFIED",
+	1: "SUPPLY_CHANGE_REASON_MINT",
+	2: "SUPPLY_CHANGE_REASON_BURN",
+	3: "SUPPLY_CHANGE_REASON_GOVERNANCE",
+}
+
+var SupplyChangeReason_value = map[string]int32{
+	"SUPPLY_CHANGE_REASON_UNSPECIFIED": 0,
+	"SUPPLY_CHANGE_REASON_MINT":        1,
+	"SUPPLY_CHANGE_REASON_BURN":        2,
+	"SUPPLY_CHANGE_REASON_GOVERNANCE":  3,
+}
+
+func (x SupplyChangeReason) String() string {
+	return proto.EnumName(SupplyChangeReason_name, int32(x))
+}
+
+func (SupplyChangeReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+}
+
+// AccessChangeAction is the kind of change made to the access of an address to a marker.
+type AccessChangeAction int32
+
+const (
+	// ACCESS_CHANGE_ACTION_UNSPECIFIED is an invalid/unknown action.
+	AccessChangeAction_Unspecified AccessChangeAction = 0
+	// ACCESS_CHANGE_ACTION_GRANT - permissions were granted to the address.
+	AccessChangeAction_Grant AccessChangeAction = 1
+	// ACCESS_CHANGE_ACTION_REVOKE - all permissions of the address were revoked.
+	AccessChangeAction_Revoke AccessChangeAction = 2
+)
+
+var AccessChangeAction_name = map[int32]string{
+	0: "ACCESS_CHANGE_ACTION_UNSPECIFIED",
+	1: "ACCESS_CHANGE_ACTION_GRANT",
+	2: "ACCESS_CHANGE_ACTION_REVOKE",
+}
+
+var AccessChangeAction_value = map[string]int32{
+	"ACCESS_CHANGE_ACTION_UNSPECIFIED": 0,
+	"ACCESS_CHANGE_ACTION_GRANT":       1,
+	"ACCESS_CHANGE_ACTION_REVOKE":      2,
+}
+
+func (x AccessChangeAction) String() string {
+	return proto.EnumName(AccessChangeAction_name, int32(x))
+}
+
+func (AccessChangeAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+}
+
 // Params defines the set of params for the account module.
 type Params struct {
 	// maximum amount of supply to allow a marker to be created with
@@ -108,6 +253,29 @@ type Params struct {
 	// a regular expression used to validate marker denom values from normal create requests (governance
 	// requests are only subject to platform coin validation denom expression)
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
+	// the voting period after which a marker status change proposal may pass early on the expedited track, a zero
+	// value disables the expedited track
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,4,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period"`
+	// the minimum portion of bonded stake that must have voted for a proposal to pass on the expedited track
+	ExpeditedQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=expedited_quorum,json=expeditedQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_quorum"`
+	// named bundles of permissions that may be granted together by referencing the role name in an access grant
+	AccessRoles []AccessRole `protobuf:"bytes,6,rep,name=access_roles,json=accessRoles,proto3" json:"access_roles"`
+	// the maximum number of holders an escrow distribution may pay
+	MaxDistributionHolders uint32 `protobuf:"varint,7,opt,name=max_distribution_holders,json=maxDistributionHolders,proto3" json:"max_distribution_holders,omitempty"`
+	// the number of holders paid by escrow distributions at the end of each block
+	DistributionHoldersPerBlock uint32 `protobuf:"varint,8,opt,name=distribution_holders_per_block,json=distributionHoldersPerBlock,proto3" json:"distribution_holders_per_block,omitempty"`
+	// the maximum amount of a marker's coin an emission schedule may mint per block, averaged over its interval, a zero
+	// value disables emission schedules
+	MaxEmissionPerBlock uint64 `protobuf:"varint,9,opt,name=max_emission_per_block,json=maxEmissionPerBlock,proto3" json:"max_emission_per_block,omitempty"`
+	// the number of blocks the supply changes of the markers are kept for, a zero value keeps no supply history
+	SupplyHistoryWindow uint64 `protobuf:"varint,10,opt,name=supply_history_window,json=supplyHistoryWindow,proto3" json:"supply_history_window,omitempty"`
+	// indicates if marker faucets may be set and claimed from, this should only be enabled on test networks
+	EnableFaucet bool `protobuf:"varint,11,opt,name=enable_faucet,json=enableFaucet,proto3" json:"enable_faucet,omitempty"`
+	// the number of blocks the access changes of the markers are kept for, a zero value keeps no access log
+	AccessLogWindow uint64 `protobuf:"varint,12,opt,name=access_log_window,json=accessLogWindow,proto3" json:"access_log_window,omitempty"`
+	// the exponent of the display unit of the bank denom metadata derived for markers activated without any, a zero
+	// value only derives denom metadata for markers created with a display exponent
+	DefaultDisplayExponent uint32 `protobuf:"varint,13,opt,name=default_display_exponent,json=defaultDisplayExponent,proto3" json:"default_display_exponent,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -156,6 +324,123 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetExpeditedVotingPeriod() time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAccessRoles() []AccessRole {
+	if m != nil {
+		return m.AccessRoles
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDistributionHolders() uint32 {
+	if m != nil {
+		return m.MaxDistributionHolders
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionHoldersPerBlock() uint32 {
+	if m != nil {
+		return m.DistributionHoldersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEmissionPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEmissionPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetSupplyHistoryWindow() uint64 {
+	if m != nil {
+		return m.SupplyHistoryWindow
+	}
+	return 0
+}
+
+func (m *Params) GetEnableFaucet() bool {
+	if m != nil {
+		return m.EnableFaucet
+	}
+	return false
+}
+
+func (m *Params) GetAccessLogWindow() uint64 {
+	if m != nil {
+		return m.AccessLogWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultDisplayExponent() uint32 {
+	if m != nil {
+		return m.DefaultDisplayExponent
+	}
+	return 0
+}
+
+// AccessRole is a named bundle of marker permissions, e.g. an issuer that may mint, burn, deposit, and withdraw.
+type AccessRole struct {
+	// the name used to reference the role, e.g. issuer
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the permissions given to an address granted the role
+	Permissions []Access `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access" json:"permissions,omitempty"`
+}
+
+func (m *AccessRole) Reset()      { *m = AccessRole{} }
+func (*AccessRole) ProtoMessage() {}
+func (*AccessRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{1}
+}
+func (m *AccessRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRole.Merge(m, src)
+}
+func (m *AccessRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRole proto.InternalMessageInfo
+
+func (m *AccessRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccessRole) GetPermissions() []Access {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -178,12 +463,26 @@ type MarkerAccount struct {
 	SupplyFixed bool `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	// indicates that governance based control is allowed for this marker
 	AllowGovernanceControl bool `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// indicates that all transfers, mints and burns of the marker coin are blocked until the marker is resumed.
+	Paused bool `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	// indicates that coins can only be sent to the marker account by addresses with the deposit access on the marker.
+	RestrictDeposits bool `protobuf:"varint,11,opt,name=restrict_deposits,json=restrictDeposits,proto3" json:"restrict_deposits,omitempty"`
+	// a regular expression the whole memo of a transaction transferring the restricted coin must match, e.g. to carry a
+	// travel rule reference id, including transfers executed through authz or wasm.  Transfers do not require a memo when
+	// empty.
+	TransferMemoPattern string `protobuf:"bytes,12,opt,name=transfer_memo_pattern,json=transferMemoPattern,proto3" json:"transfer_memo_pattern,omitempty"`
+	// requires withdrawals of more than a threshold amount to be approved by other administrators before they are made.
+	// Withdrawals are made immediately when not set.
+	WithdrawApprovalPolicy *WithdrawApprovalPolicy `protobuf:"bytes,13,opt,name=withdraw_approval_policy,json=withdrawApprovalPolicy,proto3" json:"withdraw_approval_policy,omitempty"`
+	// the exponent of the display unit of the bank denom metadata derived when the marker is activated without any.
+	// The default_display_exponent param is used when zero.
+	DisplayExponent uint32 `protobuf:"varint,14,opt,name=display_exponent,json=displayExponent,proto3" json:"display_exponent,omitempty"`
 }
 
 func (m *MarkerAccount) Reset()      { *m = MarkerAccount{} }
 func (*MarkerAccount) ProtoMessage() {}
 func (*MarkerAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{1}
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
 }
 func (m *MarkerAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,27 +511,30 @@ func (m *MarkerAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerAccount proto.InternalMessageInfo
 
-// EventMarkerAdd event emitted when marker is added
-type EventMarkerAdd struct {
-	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
-	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
+// WithdrawApprovalPolicy requires withdrawals from a marker of more than a threshold amount to be approved by
+// additional administrators of the marker before they are made.
+type WithdrawApprovalPolicy struct {
+	// the amount of each denom above which a withdrawal requires approval, withdrawals of denoms that are not listed do
+	// not require approval
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	// the number of administrators, other than the one requesting the withdrawal, that must approve it
+	ApprovalsRequired uint32 `protobuf:"varint,2,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	// how long a withdrawal waits for its approvals before it expires
+	ApprovalPeriod time.Duration `protobuf:"bytes,3,opt,name=approval_period,json=approvalPeriod,proto3,stdduration" json:"approval_period"`
 }
 
-func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
-func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAdd) ProtoMessage()    {}
-func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+func (m *WithdrawApprovalPolicy) Reset()         { *m = WithdrawApprovalPolicy{} }
+func (m *WithdrawApprovalPolicy) String() string { return proto.CompactTextString(m) }
+func (*WithdrawApprovalPolicy) ProtoMessage()    {}
+func (*WithdrawApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
 }
-func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
+func (m *WithdrawApprovalPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WithdrawApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WithdrawApprovalPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -242,72 +544,59 @@ func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
+func (m *WithdrawApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawApprovalPolicy.Merge(m, src)
 }
-func (m *EventMarkerAdd) XXX_Size() int {
+func (m *WithdrawApprovalPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAdd) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
-
-func (m *EventMarkerAdd) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
+func (m *WithdrawApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawApprovalPolicy.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
+var xxx_messageInfo_WithdrawApprovalPolicy proto.InternalMessageInfo
 
-func (m *EventMarkerAdd) GetStatus() string {
+func (m *WithdrawApprovalPolicy) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Status
+		return m.Threshold
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMarkerAdd) GetManager() string {
+func (m *WithdrawApprovalPolicy) GetApprovalsRequired() uint32 {
 	if m != nil {
-		return m.Manager
+		return m.ApprovalsRequired
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerAdd) GetMarkerType() string {
+func (m *WithdrawApprovalPolicy) GetApprovalPeriod() time.Duration {
 	if m != nil {
-		return m.MarkerType
+		return m.ApprovalPeriod
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerAddAccess event emitted when marker access is added
-type EventMarkerAddAccess struct {
-	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
-	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// Basket defines the reserve composition of a basket marker.
+type Basket struct {
+	// the denom of the basket marker
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount of each reserve denom held in escrow by the marker for every unit of basket supply
+	ReservePerUnit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reserve_per_unit,json=reservePerUnit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_per_unit"`
 }
 
-func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
-func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAddAccess) ProtoMessage()    {}
-func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+func (m *Basket) Reset()         { *m = Basket{} }
+func (m *Basket) String() string { return proto.CompactTextString(m) }
+func (*Basket) ProtoMessage()    {}
+func (*Basket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{4}
 }
-func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
+func (m *Basket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Basket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Basket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -317,57 +606,58 @@ func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
+func (m *Basket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Basket.Merge(m, src)
 }
-func (m *EventMarkerAddAccess) XXX_Size() int {
+func (m *Basket) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
+func (m *Basket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Basket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
-
-func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
-	if m != nil {
-		return m.Access
-	}
-	return EventMarkerAccess{}
-}
+var xxx_messageInfo_Basket proto.InternalMessageInfo
 
-func (m *EventMarkerAddAccess) GetDenom() string {
+func (m *Basket) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerAddAccess) GetAdministrator() string {
+func (m *Basket) GetReservePerUnit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Administrator
+		return m.ReservePerUnit
 	}
-	return ""
+	return nil
 }
 
-// EventMarkerAccess event access permissions for address
-type EventMarkerAccess struct {
-	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+// MarkerTotal is the number of markers of a type and status along with their combined supply and escrow.
+type MarkerTotal struct {
+	// the type of the markers
+	MarkerType MarkerType `protobuf:"varint,1,opt,name=marker_type,json=markerType,proto3,enum=provenance.marker.v1.MarkerType" json:"marker_type,omitempty"`
+	// the status of the markers
+	Status MarkerStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.marker.v1.MarkerStatus" json:"status,omitempty"`
+	// the number of markers of this type and status
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// the total supply of each of the marker denoms
+	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// the coins held in escrow by the markers
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
 }
 
-func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
-func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAccess) ProtoMessage()    {}
-func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+func (m *MarkerTotal) Reset()         { *m = MarkerTotal{} }
+func (m *MarkerTotal) String() string { return proto.CompactTextString(m) }
+func (*MarkerTotal) ProtoMessage()    {}
+func (*MarkerTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{5}
 }
-func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
+func (m *MarkerTotal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MarkerTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MarkerTotal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -377,51 +667,73 @@ func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
+func (m *MarkerTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerTotal.Merge(m, src)
 }
-func (m *EventMarkerAccess) XXX_Size() int {
+func (m *MarkerTotal) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
+func (m *MarkerTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerTotal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
+var xxx_messageInfo_MarkerTotal proto.InternalMessageInfo
 
-func (m *EventMarkerAccess) GetAddress() string {
+func (m *MarkerTotal) GetMarkerType() MarkerType {
 	if m != nil {
-		return m.Address
+		return m.MarkerType
 	}
-	return ""
+	return MarkerType_Unknown
 }
 
-func (m *EventMarkerAccess) GetPermissions() []string {
+func (m *MarkerTotal) GetStatus() MarkerStatus {
 	if m != nil {
-		return m.Permissions
+		return m.Status
+	}
+	return StatusUndefined
+}
+
+func (m *MarkerTotal) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MarkerTotal) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Supply
 	}
 	return nil
 }
 
-// EventMarkerDeleteAccess event emitted when marker access is revoked
-type EventMarkerDeleteAccess struct {
-	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *MarkerTotal) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
 }
 
-func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
-func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDeleteAccess) ProtoMessage()    {}
-func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{5}
+// TransferDenial is a reason a transfer of a restricted coin is not allowed.
+type TransferDenial struct {
+	// the reason code of the denial
+	Reason TransferDenyReason `protobuf:"varint,1,opt,name=reason,proto3,enum=provenance.marker.v1.TransferDenyReason" json:"reason,omitempty"`
+	// a description of the denial
+	Detail string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
 }
-func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
+
+func (m *TransferDenial) Reset()         { *m = TransferDenial{} }
+func (m *TransferDenial) String() string { return proto.CompactTextString(m) }
+func (*TransferDenial) ProtoMessage()    {}
+func (*TransferDenial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+}
+func (m *TransferDenial) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferDenial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferDenial.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,57 +743,61 @@ func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
+func (m *TransferDenial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferDenial.Merge(m, src)
 }
-func (m *EventMarkerDeleteAccess) XXX_Size() int {
+func (m *TransferDenial) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
+func (m *TransferDenial) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferDenial.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
+var xxx_messageInfo_TransferDenial proto.InternalMessageInfo
 
-func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
+func (m *TransferDenial) GetReason() TransferDenyReason {
 	if m != nil {
-		return m.RemoveAddress
+		return m.Reason
 	}
-	return ""
+	return TransferDenyReason_Unspecified
 }
 
-func (m *EventMarkerDeleteAccess) GetDenom() string {
+func (m *TransferDenial) GetDetail() string {
 	if m != nil {
-		return m.Denom
+		return m.Detail
 	}
 	return ""
 }
 
-func (m *EventMarkerDeleteAccess) GetAdministrator() string {
-	if m != nil {
-		return m.Administrator
-	}
-	return ""
-}
-
-// EventMarkerFinalize event emitted when marker is finalized
-type EventMarkerFinalize struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// EscrowDistribution is a payout of coins from the escrow of a marker to the holders of its denom.  The holders are
+// paid pro-rata to their holdings when the distribution was requested, over as many blocks as needed.
+type EscrowDistribution struct {
+	// the id of the distribution
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the denom of the marker whose holders are paid
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the address that requested the distribution
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the coins distributed to the holders
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the amount of the marker denom held by all of the holders when the distribution was requested
+	TotalHeld github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_held,json=totalHeld,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_held"`
+	// the coins not yet paid, the rounding remainder is returned to the escrow of the marker once all holders are paid
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
 }
 
-func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
-func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerFinalize) ProtoMessage()    {}
-func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+func (m *EscrowDistribution) Reset()         { *m = EscrowDistribution{} }
+func (m *EscrowDistribution) String() string { return proto.CompactTextString(m) }
+func (*EscrowDistribution) ProtoMessage()    {}
+func (*EscrowDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{7}
 }
-func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
+func (m *EscrowDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscrowDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EscrowDistribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -491,50 +807,75 @@ func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
+func (m *EscrowDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowDistribution.Merge(m, src)
 }
-func (m *EventMarkerFinalize) XXX_Size() int {
+func (m *EscrowDistribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
+func (m *EscrowDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowDistribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
+var xxx_messageInfo_EscrowDistribution proto.InternalMessageInfo
 
-func (m *EventMarkerFinalize) GetDenom() string {
+func (m *EscrowDistribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EscrowDistribution) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerFinalize) GetAdministrator() string {
+func (m *EscrowDistribution) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerActivate event emitted when marker is activated
-type EventMarkerActivate struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *EscrowDistribution) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
-func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerActivate) ProtoMessage()    {}
-func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+func (m *EscrowDistribution) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
 }
-func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
+
+// DistributionHolder is a holder of the marker denom that has not yet been paid by an escrow distribution.
+type DistributionHolder struct {
+	// the id of the distribution
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	// the address of the holder
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the amount of the marker denom held when the distribution was requested
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *DistributionHolder) Reset()         { *m = DistributionHolder{} }
+func (m *DistributionHolder) String() string { return proto.CompactTextString(m) }
+func (*DistributionHolder) ProtoMessage()    {}
+func (*DistributionHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+}
+func (m *DistributionHolder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionHolder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -544,50 +885,65 @@ func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
+func (m *DistributionHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionHolder.Merge(m, src)
 }
-func (m *EventMarkerActivate) XXX_Size() int {
+func (m *DistributionHolder) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerActivate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
+func (m *DistributionHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionHolder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
+var xxx_messageInfo_DistributionHolder proto.InternalMessageInfo
 
-func (m *EventMarkerActivate) GetDenom() string {
+func (m *DistributionHolder) GetDistributionId() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.DistributionId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerActivate) GetAdministrator() string {
+func (m *DistributionHolder) GetAddress() string {
 	if m != nil {
-		return m.Administrator
+		return m.Address
 	}
 	return ""
 }
 
-// EventMarkerCancel event emitted when marker is cancelled
-type EventMarkerCancel struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// EmissionSchedule mints an amount of a marker's coin every interval of blocks while the marker is active and sends it
+// to a recipient, e.g. for issuing reward tokens without an off-chain process.
+type EmissionSchedule struct {
+	// the id of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the denom of the marker whose coin is emitted
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the address that created the schedule
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the address the emitted coin is sent to, the escrow of the marker when empty
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the amount of the marker denom minted by each emission
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// the number of blocks between emissions
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// the height of the block at the start of which the next emission is made
+	NextHeight int64 `protobuf:"varint,7,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// the number of emissions left, the schedule is removed once this reaches zero
+	RemainingEmissions uint64 `protobuf:"varint,8,opt,name=remaining_emissions,json=remainingEmissions,proto3" json:"remaining_emissions,omitempty"`
 }
 
-func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
-func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerCancel) ProtoMessage()    {}
-func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+func (m *EmissionSchedule) Reset()         { *m = EmissionSchedule{} }
+func (m *EmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*EmissionSchedule) ProtoMessage()    {}
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
-func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
+func (m *EmissionSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EmissionSchedule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,104 +953,98 @@ func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+func (m *EmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSchedule.Merge(m, src)
 }
-func (m *EventMarkerCancel) XXX_Size() int {
+func (m *EmissionSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerCancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
+func (m *EmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+var xxx_messageInfo_EmissionSchedule proto.InternalMessageInfo
 
-func (m *EventMarkerCancel) GetDenom() string {
+func (m *EmissionSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EmissionSchedule) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerCancel) GetAdministrator() string {
+func (m *EmissionSchedule) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerDelete event emitted when marker is deleted
-type EventMarkerDelete struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *EmissionSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
-func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDelete) ProtoMessage()    {}
-func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{9}
-}
-func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EmissionSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
+	return 0
 }
-func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
-}
-func (m *EventMarkerDelete) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventMarkerDelete) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
 
-func (m *EventMarkerDelete) GetDenom() string {
+func (m *EmissionSchedule) GetNextHeight() int64 {
 	if m != nil {
-		return m.Denom
+		return m.NextHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerDelete) GetAdministrator() string {
+func (m *EmissionSchedule) GetRemainingEmissions() uint64 {
 	if m != nil {
-		return m.Administrator
+		return m.RemainingEmissions
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerMint event emitted when additional marker supply is minted
-type EventMarkerMint struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// ScheduledTransfer is a transfer of restricted coin brokered by an administrator, held in escrow by the marker module
+// until its release height and/or time and then sent to the recipient.
+type ScheduledTransfer struct {
+	// the id of the scheduled transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address that scheduled the transfer
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the address the coin was taken from, it is returned here if the transfer is cancelled
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// the address the coin is sent to once the transfer is released
+	ToAddress string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the coin held in escrow for the transfer
+	Amount types1.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// the height of the block at the start of which the transfer is released, no height is required when zero
+	ReleaseHeight int64 `protobuf:"varint,6,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// the block time from which the transfer is released, no time is required when not set
+	ReleaseTime *time.Time `protobuf:"bytes,7,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time,omitempty"`
 }
 
-func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
-func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerMint) ProtoMessage()    {}
-func (*EventMarkerMint) Descriptor() ([]byte, []int) {
+func (m *ScheduledTransfer) Reset()         { *m = ScheduledTransfer{} }
+func (m *ScheduledTransfer) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTransfer) ProtoMessage()    {}
+func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
-func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+func (m *ScheduledTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduledTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduledTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -704,58 +1054,100 @@ func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerMint.Merge(m, src)
+func (m *ScheduledTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTransfer.Merge(m, src)
 }
-func (m *EventMarkerMint) XXX_Size() int {
+func (m *ScheduledTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerMint) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
+func (m *ScheduledTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
+var xxx_messageInfo_ScheduledTransfer proto.InternalMessageInfo
 
-func (m *EventMarkerMint) GetAmount() string {
+func (m *ScheduledTransfer) GetId() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
 	return ""
 }
 
-func (m *EventMarkerMint) GetDenom() string {
+func (m *ScheduledTransfer) GetFromAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.FromAddress
 	}
 	return ""
 }
 
-func (m *EventMarkerMint) GetAdministrator() string {
+func (m *ScheduledTransfer) GetToAddress() string {
 	if m != nil {
-		return m.Administrator
+		return m.ToAddress
 	}
 	return ""
 }
 
-// EventMarkerBurn event emitted when coin is burned from marker
-type EventMarkerBurn struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *ScheduledTransfer) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
-func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerBurn) ProtoMessage()    {}
-func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
+func (m *ScheduledTransfer) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *ScheduledTransfer) GetReleaseTime() *time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return nil
+}
+
+// PendingWithdrawal is a withdrawal from a marker above the threshold of its withdraw approval policy, made once it has
+// been approved by the required number of additional administrators or removed when it expires.
+type PendingWithdrawal struct {
+	// the id of the pending withdrawal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the denom of the marker the coins are withdrawn from
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the coins withdrawn from the marker
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the address with withdraw access that requested the withdrawal
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the address the coins are sent to
+	ToAddress string `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the number of approvals required by the policy of the marker when the withdrawal was requested
+	ApprovalsRequired uint32 `protobuf:"varint,6,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	// the administrators that have approved the withdrawal
+	Approvals []string `protobuf:"bytes,7,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// the block time after which the withdrawal expires if it has not been approved
+	Expiration time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *PendingWithdrawal) Reset()         { *m = PendingWithdrawal{} }
+func (m *PendingWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PendingWithdrawal) ProtoMessage()    {}
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{11}
 }
-func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
+func (m *PendingWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -765,59 +1157,103 @@ func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
+func (m *PendingWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWithdrawal.Merge(m, src)
 }
-func (m *EventMarkerBurn) XXX_Size() int {
+func (m *PendingWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerBurn) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
+func (m *PendingWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
+var xxx_messageInfo_PendingWithdrawal proto.InternalMessageInfo
 
-func (m *EventMarkerBurn) GetAmount() string {
+func (m *PendingWithdrawal) GetId() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerBurn) GetDenom() string {
+func (m *PendingWithdrawal) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerBurn) GetAdministrator() string {
+func (m *PendingWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingWithdrawal) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerWithdraw event emitted when coins are withdrew from marker
-type EventMarkerWithdraw struct {
-	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+func (m *PendingWithdrawal) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
-func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerWithdraw) ProtoMessage()    {}
-func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
+func (m *PendingWithdrawal) GetApprovalsRequired() uint32 {
+	if m != nil {
+		return m.ApprovalsRequired
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingWithdrawal) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// MarkerFaucet drips an amount of a marker's coin to any address that claims it, at most once per period for each
+// address.  The coin is withdrawn from the marker escrow, after minting it when mint is set, using the access of the
+// administrator that set the faucet.
+type MarkerFaucet struct {
+	// the denom of the marker the faucet drips
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the address with admin access that set the faucet, it must have withdraw access, and mint access for a minting
+	// faucet, for claims to be made
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the amount of the marker's coin sent for each claim
+	DripAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=drip_amount,json=dripAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"drip_amount"`
+	// how long an address must wait after a claim before claiming again
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// mint the coin for each claim instead of withdrawing it from the coin already held in the marker escrow
+	Mint bool `protobuf:"varint,5,opt,name=mint,proto3" json:"mint,omitempty"`
+}
+
+func (m *MarkerFaucet) Reset()         { *m = MarkerFaucet{} }
+func (m *MarkerFaucet) String() string { return proto.CompactTextString(m) }
+func (*MarkerFaucet) ProtoMessage()    {}
+func (*MarkerFaucet) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{12}
 }
-func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *MarkerFaucet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MarkerFaucet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MarkerFaucet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -827,67 +1263,68 @@ func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+func (m *MarkerFaucet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerFaucet.Merge(m, src)
 }
-func (m *EventMarkerWithdraw) XXX_Size() int {
+func (m *MarkerFaucet) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
+func (m *MarkerFaucet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerFaucet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_MarkerFaucet proto.InternalMessageInfo
 
-func (m *EventMarkerWithdraw) GetCoins() string {
+func (m *MarkerFaucet) GetDenom() string {
 	if m != nil {
-		return m.Coins
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerWithdraw) GetDenom() string {
+func (m *MarkerFaucet) GetAdministrator() string {
 	if m != nil {
-		return m.Denom
+		return m.Administrator
 	}
 	return ""
 }
 
-func (m *EventMarkerWithdraw) GetAdministrator() string {
+func (m *MarkerFaucet) GetPeriod() time.Duration {
 	if m != nil {
-		return m.Administrator
+		return m.Period
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerWithdraw) GetToAddress() string {
+func (m *MarkerFaucet) GetMint() bool {
 	if m != nil {
-		return m.ToAddress
+		return m.Mint
 	}
-	return ""
+	return false
 }
 
-// EventMarkerTransfer event emitted when coins are transfered to from account to another
-type EventMarkerTransfer struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+// FaucetClaim is the last claim an address made from the faucet of a marker.
+type FaucetClaim struct {
+	// the denom of the marker faucet
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the address that claimed from the faucet
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the block time of the last claim
+	LastClaimTime time.Time `protobuf:"bytes,3,opt,name=last_claim_time,json=lastClaimTime,proto3,stdtime" json:"last_claim_time"`
 }
 
-func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
-func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerTransfer) ProtoMessage()    {}
-func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
+func (m *FaucetClaim) Reset()         { *m = FaucetClaim{} }
+func (m *FaucetClaim) String() string { return proto.CompactTextString(m) }
+func (*FaucetClaim) ProtoMessage()    {}
+func (*FaucetClaim) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
-func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+func (m *FaucetClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FaucetClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FaucetClaim.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -897,76 +1334,138 @@ func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+func (m *FaucetClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaucetClaim.Merge(m, src)
 }
-func (m *EventMarkerTransfer) XXX_Size() int {
+func (m *FaucetClaim) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+func (m *FaucetClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaucetClaim.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+var xxx_messageInfo_FaucetClaim proto.InternalMessageInfo
 
-func (m *EventMarkerTransfer) GetAmount() string {
+func (m *FaucetClaim) GetDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetDenom() string {
+func (m *FaucetClaim) GetAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetAdministrator() string {
+func (m *FaucetClaim) GetLastClaimTime() time.Time {
 	if m != nil {
-		return m.Administrator
+		return m.LastClaimTime
+	}
+	return time.Time{}
+}
+
+// FeeConversion lets the fees of a tx be paid in a marker's coin.  The coin is swapped at the rate for the fee denom
+// held by the conversion pool, the swapped coin is then charged as the fee.
+type FeeConversion struct {
+	// the denom of the marker whose coin is accepted for fees
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the account that receives the marker's coin and pays out the fee denom it holds in exchange
+	Pool string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// the amount of the fee denom given for one unit of the marker's coin
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// the address that may update the rate
+	Oracle string `protobuf:"bytes,4,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *FeeConversion) Reset()         { *m = FeeConversion{} }
+func (m *FeeConversion) String() string { return proto.CompactTextString(m) }
+func (*FeeConversion) ProtoMessage()    {}
+func (*FeeConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+}
+func (m *FeeConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConversion.Merge(m, src)
+}
+func (m *FeeConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConversion proto.InternalMessageInfo
+
+func (m *FeeConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetToAddress() string {
+func (m *FeeConversion) GetPool() string {
 	if m != nil {
-		return m.ToAddress
+		return m.Pool
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetFromAddress() string {
+func (m *FeeConversion) GetOracle() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Oracle
 	}
 	return ""
 }
 
-// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
-type EventMarkerSetDenomMetadata struct {
-	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
-	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
-	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
-	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
-	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
-	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+// SupplyChange is a change to the supply of a marker's coin, kept for the supply history window of the params.
+type SupplyChange struct {
+	// the id of the supply change
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the denom of the marker whose supply changed
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the height of the block the supply changed in
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the time of the block the supply changed in
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// the amount the supply changed by, negative when coin was burned
+	Delta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delta"`
+	// the supply of the coin after the change
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// the address that made the change, empty for changes made by the chain itself (e.g. supply corrections)
+	Actor string `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	// the reason the supply changed
+	Reason SupplyChangeReason `protobuf:"varint,8,opt,name=reason,proto3,enum=provenance.marker.v1.SupplyChangeReason" json:"reason,omitempty"`
 }
 
-func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
-func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
-func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+func (m *SupplyChange) Reset()         { *m = SupplyChange{} }
+func (m *SupplyChange) String() string { return proto.CompactTextString(m) }
+func (*SupplyChange) ProtoMessage()    {}
+func (*SupplyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+func (m *SupplyChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SupplyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SupplyChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -976,86 +1475,92 @@ func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+func (m *SupplyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyChange.Merge(m, src)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+func (m *SupplyChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
+func (m *SupplyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
-
-func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
-	if m != nil {
-		return m.MetadataBase
-	}
-	return ""
-}
+var xxx_messageInfo_SupplyChange proto.InternalMessageInfo
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+func (m *SupplyChange) GetId() uint64 {
 	if m != nil {
-		return m.MetadataDescription
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
+func (m *SupplyChange) GetDenom() string {
 	if m != nil {
-		return m.MetadataDisplay
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
+func (m *SupplyChange) GetHeight() int64 {
 	if m != nil {
-		return m.MetadataDenomUnits
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+func (m *SupplyChange) GetTime() time.Time {
 	if m != nil {
-		return m.Administrator
+		return m.Time
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+func (m *SupplyChange) GetActor() string {
 	if m != nil {
-		return m.MetadataName
+		return m.Actor
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+func (m *SupplyChange) GetReason() SupplyChangeReason {
 	if m != nil {
-		return m.MetadataSymbol
+		return m.Reason
 	}
-	return ""
+	return SupplyChangeReason_Unspecified
 }
 
-// EventDenomUnit denom units for set denom metadata event
-type EventDenomUnit struct {
-	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+// AccessChange is an entry of the access log of a marker, kept for the access log window of the params.
+type AccessChange struct {
+	// the id of the access change
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the denom of the marker whose access changed
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the height of the block the access changed in
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the time of the block the access changed in
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// the address that made the change, the governance module account for changes made by proposals
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// the address whose access changed
+	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	// the permissions granted, or the permissions the address had before they were revoked
+	Permissions []Access `protobuf:"varint,7,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access" json:"permissions,omitempty"`
+	// whether the permissions were granted or revoked
+	Action AccessChangeAction `protobuf:"varint,8,opt,name=action,proto3,enum=provenance.marker.v1.AccessChangeAction" json:"action,omitempty"`
 }
 
-func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
-func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
-func (*EventDenomUnit) ProtoMessage()    {}
-func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+func (m *AccessChange) Reset()         { *m = AccessChange{} }
+func (m *AccessChange) String() string { return proto.CompactTextString(m) }
+func (*AccessChange) ProtoMessage()    {}
+func (*AccessChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
 }
-func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+func (m *AccessChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccessChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccessChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1065,1351 +1570,15576 @@ func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+func (m *AccessChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessChange.Merge(m, src)
 }
-func (m *EventDenomUnit) XXX_Size() int {
+func (m *AccessChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDenomUnit) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
+func (m *AccessChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+var xxx_messageInfo_AccessChange proto.InternalMessageInfo
 
-func (m *EventDenomUnit) GetDenom() string {
+func (m *AccessChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccessChange) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventDenomUnit) GetExponent() string {
+func (m *AccessChange) GetHeight() int64 {
 	if m != nil {
-		return m.Exponent
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *EventDenomUnit) GetAliases() []string {
+func (m *AccessChange) GetTime() time.Time {
 	if m != nil {
-		return m.Aliases
+		return m.Time
 	}
-	return nil
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
-	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
-	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
-	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
-	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
-	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
-	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
-	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
-	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
-	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
-	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
-	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
-	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
-	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
-	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
-	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
-	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
-	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+func (m *AccessChange) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+func (m *AccessChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xf7, 0x3a, 0x89, 0x89, 0xc7, 0x89, 0x31, 0x93, 0x28, 0x18, 0xc3, 0x6b, 0x2f, 0xfb, 0xf2,
-	0x82, 0x5f, 0xde, 0x17, 0xbb, 0x49, 0x2b, 0x84, 0x72, 0xf3, 0x57, 0x90, 0x55, 0x92, 0xb8, 0x6b,
-	0x87, 0x0a, 0x54, 0x69, 0x3b, 0xf6, 0x4e, 0xcc, 0x96, 0xdd, 0x19, 0x77, 0x77, 0x6c, 0xe2, 0xaa,
-	0x67, 0x84, 0x72, 0x6a, 0x6f, 0xad, 0xd4, 0x48, 0x48, 0xed, 0xa1, 0x52, 0x8f, 0xed, 0xb9, 0x67,
-	0x8e, 0xa8, 0xa7, 0xaa, 0x07, 0xab, 0x82, 0x4b, 0x0f, 0x3d, 0xe5, 0x2f, 0xa8, 0x76, 0x66, 0xbc,
-	0x5e, 0x37, 0x06, 0x0e, 0x29, 0x27, 0xef, 0x3c, 0xcf, 0xef, 0xf9, 0xfa, 0x3d, 0xcf, 0x7c, 0x18,
-	0x5c, 0xee, 0xb9, 0x74, 0x80, 0x09, 0x22, 0x1d, 0x5c, 0x74, 0x90, 0xfb, 0x10, 0xbb, 0xc5, 0xc1,
-	0xba, 0xfc, 0x2a, 0xf4, 0x5c, 0xca, 0x28, 0x5c, 0x9d, 0x40, 0x0a, 0x52, 0x31, 0x58, 0xcf, 0xac,
-	0x76, 0x69, 0x97, 0x72, 0x40, 0xd1, 0xff, 0x12, 0xd8, 0x4c, 0xb6, 0x43, 0x3d, 0x87, 0x7a, 0x45,
-	0xd4, 0x67, 0x0f, 0x8a, 0x83, 0xf5, 0x36, 0x66, 0x68, 0x9d, 0x2f, 0xa4, 0xfe, 0x82, 0xd0, 0x1b,
-	0xc2, 0x50, 0x2c, 0xa4, 0xea, 0xea, 0xcc, 0x4c, 0x50, 0xa7, 0x83, 0x3d, 0xaf, 0xeb, 0x22, 0xc2,
-	0x04, 0x4e, 0xfb, 0x51, 0x01, 0xb1, 0x06, 0x72, 0x91, 0xe3, 0xc1, 0x5b, 0x20, 0xe5, 0xa0, 0x03,
-	0x83, 0x51, 0x86, 0x6c, 0xc3, 0xeb, 0xf7, 0x7a, 0xf6, 0x30, 0xad, 0xa8, 0x4a, 0x7e, 0xbe, 0x9c,
-	0x7c, 0x36, 0xca, 0x45, 0x7e, 0x1b, 0xe5, 0x62, 0x7d, 0x8b, 0xb0, 0x9b, 0xef, 0xe9, 0x49, 0x07,
-	0x1d, 0xb4, 0x7c, 0x58, 0x93, 0xa3, 0xe0, 0xff, 0xc0, 0x39, 0x4c, 0x50, 0xdb, 0xc6, 0x46, 0x97,
-	0x0e, 0xb0, 0xcb, 0xa3, 0xa6, 0xa3, 0xaa, 0x92, 0x5f, 0xd4, 0x53, 0x42, 0x71, 0x3b, 0x90, 0xc3,
-	0x5b, 0x20, 0xdd, 0x27, 0x2e, 0xf6, 0x98, 0x6b, 0x75, 0x18, 0x36, 0x0d, 0x13, 0x13, 0xea, 0x18,
-	0x2e, 0xee, 0xe2, 0x83, 0xf4, 0x9c, 0xaa, 0xe4, 0xe3, 0xfa, 0x5a, 0x58, 0x5f, 0xf5, 0xd5, 0xba,
-	0xaf, 0xdd, 0x5c, 0xfc, 0xea, 0x69, 0x2e, 0xf2, 0xc7, 0xd3, 0x5c, 0x44, 0xfb, 0x66, 0x01, 0x2c,
-	0x6f, 0xf3, 0xaa, 0x4a, 0x9d, 0x0e, 0xed, 0x13, 0x06, 0x3f, 0x06, 0x4b, 0x6d, 0xe4, 0x61, 0x03,
-	0x89, 0x35, 0x4f, 0x3c, 0xb1, 0xa1, 0x16, 0x24, 0x29, 0x9c, 0x34, 0xc9, 0x60, 0xa1, 0x8c, 0x3c,
-	0x2c, 0xed, 0xca, 0x17, 0x9f, 0x8f, 0x72, 0xca, 0xf1, 0x28, 0xb7, 0x32, 0x44, 0x8e, 0xbd, 0xa9,
-	0x85, 0x7d, 0x68, 0x7a, 0xa2, 0x3d, 0x41, 0xc2, 0x9b, 0xe0, 0x8c, 0x83, 0x08, 0xea, 0x62, 0x97,
-	0x97, 0x16, 0x2f, 0x5f, 0x3a, 0x1e, 0xe5, 0xd2, 0x9f, 0x78, 0x94, 0x6c, 0x6a, 0x52, 0xf1, 0x7f,
-	0xea, 0x58, 0x0c, 0x3b, 0x3d, 0x36, 0xd4, 0xf4, 0x31, 0x18, 0xee, 0x80, 0xa4, 0xa0, 0xdd, 0xe8,
-	0x50, 0xc2, 0x5c, 0x6a, 0xa7, 0xe7, 0xd4, 0xb9, 0x7c, 0x62, 0xe3, 0x72, 0x61, 0xd6, 0x24, 0x14,
-	0x4a, 0x1c, 0x7b, 0xdb, 0x6f, 0x51, 0x79, 0xde, 0xe7, 0x5d, 0x5f, 0x16, 0xe6, 0x15, 0x61, 0x0d,
-	0x37, 0x41, 0xcc, 0x63, 0x88, 0xf5, 0xbd, 0xf4, 0xbc, 0xaa, 0xe4, 0x93, 0x1b, 0xda, 0x6c, 0x3f,
-	0x82, 0x9e, 0x26, 0x47, 0xea, 0xd2, 0x02, 0xae, 0x82, 0x05, 0x4e, 0x77, 0x7a, 0x81, 0x13, 0x2d,
-	0x16, 0xf0, 0x53, 0x10, 0x93, 0xed, 0x8e, 0xf1, 0xc2, 0xee, 0xc9, 0x76, 0x5f, 0xed, 0x5a, 0xec,
-	0x41, 0xbf, 0x5d, 0xe8, 0x50, 0x47, 0x0e, 0x97, 0xfc, 0xb9, 0xe1, 0x99, 0x0f, 0x8b, 0x6c, 0xd8,
-	0xc3, 0x5e, 0xa1, 0x4e, 0xd8, 0xf1, 0x28, 0x77, 0x4d, 0xd0, 0x10, 0x1e, 0x1d, 0x4d, 0x15, 0x8c,
-	0x4e, 0xc9, 0x74, 0x19, 0x08, 0x76, 0x40, 0x42, 0xa4, 0x6a, 0xf8, 0x6e, 0xd2, 0x67, 0x78, 0x25,
-	0xea, 0xeb, 0x2a, 0x69, 0x0d, 0x7b, 0xb8, 0xac, 0x1e, 0x8f, 0x72, 0x97, 0xc6, 0x94, 0x07, 0xe6,
-	0x61, 0xda, 0x81, 0x13, 0xa0, 0xe1, 0x65, 0xb0, 0x24, 0xc2, 0x19, 0xfb, 0xd6, 0x01, 0x36, 0xd3,
-	0x8b, 0x7c, 0x22, 0x13, 0x42, 0xb6, 0xe5, 0x8b, 0xfc, 0x61, 0x44, 0xb6, 0x4d, 0x1f, 0x85, 0x06,
-	0x37, 0x68, 0x53, 0x9c, 0xc3, 0xd7, 0xb8, 0x7e, 0x32, 0xbf, 0xb2, 0x0d, 0x9b, 0x99, 0x27, 0x4f,
-	0x73, 0x11, 0x7f, 0x20, 0x7f, 0xf9, 0xe9, 0x46, 0x72, 0x6a, 0x16, 0xeb, 0xda, 0x97, 0x0a, 0x48,
-	0xd6, 0x06, 0x98, 0x30, 0x29, 0x37, 0xcd, 0x09, 0xf3, 0x4a, 0x98, 0xf9, 0x35, 0x10, 0x43, 0x0e,
-	0x9f, 0x57, 0x3e, 0x52, 0xba, 0x5c, 0xf9, 0x72, 0xd9, 0x63, 0xb1, 0x23, 0xc6, 0xfd, 0x4b, 0x4f,
-	0x66, 0x70, 0x9e, 0x2b, 0x82, 0x29, 0xcb, 0x4d, 0x13, 0x2a, 0xfa, 0x1b, 0x22, 0x43, 0xfb, 0x5a,
-	0x01, 0xab, 0xd3, 0x39, 0x89, 0x49, 0x83, 0x35, 0x10, 0x13, 0x03, 0x26, 0xf7, 0xcc, 0xb5, 0xd9,
-	0x5d, 0x08, 0xdb, 0x72, 0xb8, 0x9c, 0x4e, 0x69, 0x3c, 0x29, 0x30, 0x1a, 0x2e, 0xf0, 0x0a, 0x58,
-	0x46, 0xa6, 0x63, 0x11, 0xcb, 0x63, 0x2e, 0x62, 0xd4, 0x95, 0xf5, 0x4c, 0x0b, 0xb5, 0x5d, 0x70,
-	0xee, 0x84, 0x7b, 0xbf, 0x56, 0x64, 0x9a, 0xee, 0x38, 0xb1, 0xb8, 0x3e, 0x5e, 0x42, 0x15, 0x24,
-	0x7a, 0xd8, 0x75, 0x2c, 0xcf, 0xb3, 0x28, 0xf1, 0xd2, 0x51, 0x75, 0x2e, 0x1f, 0xd7, 0xc3, 0x22,
-	0xed, 0x73, 0x70, 0x3e, 0xe4, 0xb0, 0x8a, 0x6d, 0xcc, 0xb0, 0x74, 0xfb, 0x1f, 0x90, 0x74, 0xb1,
-	0x43, 0x07, 0xd8, 0x98, 0xf6, 0xbe, 0x2c, 0xa4, 0x25, 0x19, 0xe3, 0x34, 0xe5, 0x7c, 0x00, 0x56,
-	0x42, 0xd1, 0xb7, 0x2c, 0x82, 0x6c, 0xeb, 0x33, 0xfc, 0x8a, 0x11, 0x38, 0xe1, 0x32, 0xfa, 0x66,
-	0x97, 0xa5, 0x0e, 0xb3, 0x06, 0x88, 0x9d, 0xce, 0xe5, 0x34, 0xe9, 0x15, 0xbf, 0xdd, 0xf6, 0x3f,
-	0xe8, 0x50, 0x90, 0x7e, 0x2a, 0x87, 0x18, 0x9c, 0x0d, 0x39, 0xdc, 0xb6, 0xc4, 0xc6, 0x90, 0x1b,
-	0x46, 0x99, 0xda, 0x30, 0xa7, 0x69, 0xd7, 0x74, 0x98, 0x72, 0xdf, 0x25, 0x6f, 0x25, 0xcc, 0x63,
-	0x65, 0xaa, 0x87, 0x1f, 0x5a, 0xec, 0x81, 0xe9, 0xa2, 0x47, 0xbe, 0xcf, 0x0e, 0xb5, 0xc8, 0x78,
-	0x0e, 0xc5, 0xe2, 0x34, 0x91, 0xe0, 0xbf, 0x00, 0x60, 0x34, 0x18, 0x6f, 0x71, 0x50, 0xc4, 0x19,
-	0x95, 0xa3, 0xad, 0xfd, 0x30, 0x9d, 0x48, 0xcb, 0x45, 0xc4, 0xdb, 0xc7, 0xee, 0xdb, 0x28, 0xfa,
-	0x0d, 0xa9, 0xf8, 0x27, 0xf4, 0xbe, 0x4b, 0x9d, 0x00, 0x20, 0x8e, 0xad, 0x84, 0x2f, 0x1b, 0x67,
-	0xfb, 0x67, 0x14, 0x5c, 0x0c, 0x65, 0xdb, 0xc4, 0x8c, 0xbf, 0x08, 0xb6, 0x31, 0x43, 0x26, 0x62,
-	0x08, 0xfe, 0x1b, 0x2c, 0x3b, 0xf2, 0xdb, 0xf0, 0xaf, 0x6b, 0x99, 0xfc, 0xd2, 0x58, 0xe8, 0x5f,
-	0xf6, 0x70, 0x1d, 0xac, 0x06, 0x20, 0x13, 0x7b, 0x1d, 0xd7, 0xea, 0x31, 0x8b, 0x12, 0x59, 0xd1,
-	0xca, 0x58, 0x57, 0x9d, 0xa8, 0xe0, 0x7f, 0x41, 0x6a, 0x62, 0x62, 0x79, 0x3d, 0x1b, 0x0d, 0x65,
-	0x89, 0x67, 0x03, 0xb8, 0x10, 0xc3, 0xbb, 0x53, 0xde, 0xfd, 0xd7, 0x4c, 0x9f, 0x58, 0xcc, 0x2f,
-	0xd7, 0xbf, 0xe7, 0xaf, 0xbc, 0xe6, 0x3c, 0xe5, 0xa5, 0xec, 0x11, 0x8b, 0xe9, 0x70, 0x92, 0x83,
-	0x14, 0x79, 0x27, 0x29, 0x5e, 0x98, 0x45, 0x71, 0x98, 0x00, 0x82, 0x1c, 0x2c, 0x2e, 0xf1, 0x09,
-	0x01, 0x3b, 0xc8, 0xc1, 0xf0, 0x1a, 0x08, 0xb2, 0x36, 0xbc, 0xa1, 0xd3, 0xa6, 0x36, 0xbf, 0x73,
-	0xe3, 0x7a, 0x72, 0x2c, 0x6e, 0x72, 0xa9, 0xf6, 0x91, 0xbc, 0xb9, 0x82, 0x34, 0x5e, 0xb1, 0x83,
-	0x33, 0x60, 0x11, 0x1f, 0xf4, 0x28, 0xc1, 0xc1, 0xdd, 0x15, 0xac, 0xf9, 0xc9, 0x6d, 0x5b, 0xc8,
-	0xc3, 0x1e, 0x7f, 0xea, 0xf8, 0x27, 0xb7, 0x58, 0x5e, 0x7f, 0xac, 0x00, 0x30, 0xb9, 0xce, 0x61,
-	0x1e, 0x9c, 0xdf, 0x2e, 0xe9, 0xef, 0xd7, 0x74, 0xa3, 0x75, 0xaf, 0x51, 0x33, 0xf6, 0x76, 0x9a,
-	0x8d, 0x5a, 0xa5, 0xbe, 0x55, 0xaf, 0x55, 0x53, 0x91, 0x4c, 0xe2, 0xf0, 0x48, 0x3d, 0xb3, 0x47,
-	0x1e, 0x12, 0xfa, 0x88, 0xc0, 0x2c, 0x48, 0x85, 0x91, 0x95, 0xdd, 0xfa, 0x4e, 0x4a, 0xc9, 0x2c,
-	0x1e, 0x1e, 0xa9, 0xf3, 0x15, 0x6a, 0x11, 0x58, 0x00, 0x6b, 0x61, 0xbd, 0x5e, 0x6b, 0xb6, 0xf4,
-	0x7a, 0xa5, 0x55, 0xab, 0xa6, 0xa2, 0x19, 0x78, 0x78, 0xa4, 0x26, 0xf5, 0xe0, 0x41, 0xe9, 0xe3,
-	0xaf, 0xff, 0x1c, 0x05, 0x4b, 0xe1, 0x17, 0x12, 0xdc, 0x00, 0x17, 0xa4, 0x83, 0x66, 0xab, 0xd4,
-	0xda, 0x6b, 0xfe, 0x2d, 0x99, 0x95, 0xc3, 0x23, 0xf5, 0xac, 0x80, 0xee, 0x11, 0x13, 0xef, 0x5b,
-	0x04, 0x9b, 0xa1, 0xa0, 0xd2, 0xa6, 0xa1, 0xef, 0x36, 0x76, 0x9b, 0xb5, 0x6a, 0x4a, 0x11, 0x41,
-	0x85, 0x41, 0xc3, 0xa5, 0x3d, 0xea, 0x61, 0x13, 0xbe, 0x13, 0x94, 0x2b, 0xf1, 0x5b, 0xf5, 0x9d,
-	0xd2, 0x9d, 0xfa, 0x7d, 0x9e, 0x65, 0x28, 0xc2, 0xf8, 0xc6, 0x30, 0xe1, 0x75, 0xb0, 0x3a, 0x6d,
-	0x51, 0xaa, 0xb4, 0xea, 0x77, 0x6b, 0xa9, 0xb9, 0x4c, 0xea, 0xf0, 0x48, 0x5d, 0x12, 0x70, 0x7e,
-	0x1b, 0xe0, 0x93, 0xde, 0x2b, 0xa5, 0x9d, 0x4a, 0xed, 0xce, 0x9d, 0x5a, 0x35, 0x35, 0x1f, 0xf6,
-	0x2e, 0x4e, 0x7a, 0x7b, 0x56, 0x3e, 0x55, 0x9f, 0xb6, 0xdd, 0x7b, 0xb5, 0x6a, 0x6a, 0x21, 0x6c,
-	0x51, 0xf5, 0xb9, 0xa3, 0x43, 0x6c, 0x66, 0x16, 0x9f, 0x7c, 0x9b, 0x8d, 0x7c, 0xff, 0x5d, 0x36,
-	0x52, 0xee, 0x3e, 0x7b, 0x91, 0x55, 0x9e, 0xbf, 0xc8, 0x2a, 0xbf, 0xbf, 0xc8, 0x2a, 0x5f, 0xbc,
-	0xcc, 0x46, 0x9e, 0xbf, 0xcc, 0x46, 0x7e, 0x7d, 0x99, 0x8d, 0x80, 0xf3, 0x16, 0x9d, 0x39, 0xf1,
-	0x0d, 0xe5, 0xfe, 0x46, 0xe8, 0x41, 0x39, 0x81, 0xdc, 0xb0, 0x68, 0x68, 0x55, 0x3c, 0x18, 0xff,
-	0x5f, 0xe1, 0x0f, 0xcc, 0x76, 0x8c, 0xff, 0x4f, 0x79, 0xf7, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x7d, 0xe6, 0x72, 0x5b, 0x0d, 0x00, 0x00,
+func (m *AccessChange) GetPermissions() []Access {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccessChange) GetAction() AccessChangeAction {
+	if m != nil {
+		return m.Action
 	}
-	return dAtA[:n], nil
+	return AccessChangeAction_Unspecified
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerAdd event emitted when marker is added
+type EventMarkerAdd struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
+	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EnableGovernance {
-		i--
-		if m.EnableGovernance {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
+func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAdd) ProtoMessage()    {}
+func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{17}
+}
+func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.MaxTotalSupply != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
 }
-
-func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAdd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
 }
 
-func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Status != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.AccessControl) > 0 {
-		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
+
+func (m *EventMarkerAdd) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAdd) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAdd) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarkerType) > 0 {
-		i -= len(m.MarkerType)
-		copy(dAtA[i:], m.MarkerType)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerAdd) GetManager() string {
+	if m != nil {
+		return m.Manager
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAdd) GetMarkerType() string {
+	if m != nil {
+		return m.MarkerType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerAddAccess event emitted when marker access is added
+type EventMarkerAddAccess struct {
+	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
+	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
+func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAddAccess) ProtoMessage()    {}
+func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+}
+func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
+}
+func (m *EventMarkerAddAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
+
+func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
+	if m != nil {
+		return m.Access
 	}
-	return dAtA[:n], nil
+	return EventMarkerAccess{}
 }
 
-func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAddAccess) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *EventMarkerAddAccess) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerAccess event access permissions for address
+type EventMarkerAccess struct {
+	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
+func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccess) ProtoMessage()    {}
+func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{19}
+}
+func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
+}
+func (m *EventMarkerAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
+
+func (m *EventMarkerAccess) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAccess) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDeleteAccess event emitted when marker access is revoked
+type EventMarkerDeleteAccess struct {
+	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
+func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDeleteAccess) ProtoMessage()    {}
+func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{20}
+}
+func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
+}
+func (m *EventMarkerDeleteAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
+
+func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
+	if m != nil {
+		return m.RemoveAddress
 	}
-	if len(m.RemoveAddress) > 0 {
-		i -= len(m.RemoveAddress)
-		copy(dAtA[i:], m.RemoveAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerDeleteAccess) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerDeleteAccess) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerAccessChange event emitted when an entry is added to the access log of a marker
+type EventMarkerAccessChange struct {
+	ChangeId    string   `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Denom       string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height      string   `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	Actor       string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Address     string   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []string `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Action      string   `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerAccessChange) Reset()         { *m = EventMarkerAccessChange{} }
+func (m *EventMarkerAccessChange) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccessChange) ProtoMessage()    {}
+func (*EventMarkerAccessChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{21}
+}
+func (m *EventMarkerAccessChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAccessChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAccessChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerAccessChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccessChange.Merge(m, src)
+}
+func (m *EventMarkerAccessChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAccessChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccessChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAccessChange proto.InternalMessageInfo
+
+func (m *EventMarkerAccessChange) GetChangeId() string {
+	if m != nil {
+		return m.ChangeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAccessChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAccessChange) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerAccessChange) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerAccessChange) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAccessChange) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAccessChange) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+// EventMarkerFinalize event emitted when marker is finalized
+type EventMarkerFinalize struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
+func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFinalize) ProtoMessage()    {}
+func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{22}
+}
+func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
+}
+func (m *EventMarkerFinalize) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
+
+func (m *EventMarkerFinalize) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerFinalize) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerActivate event emitted when marker is activated
+type EventMarkerActivate struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
+func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerActivate) ProtoMessage()    {}
+func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{23}
+}
+func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
+}
+func (m *EventMarkerActivate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerActivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
+
+func (m *EventMarkerActivate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerActivate) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerUpdateFlags event emitted when the supply fixed or governance control flags of a marker are changed
+type EventMarkerUpdateFlags struct {
+	Denom                  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	SupplyFixed            bool   `protobuf:"varint,2,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
+	AllowGovernanceControl bool   `protobuf:"varint,3,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	Administrator          string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerUpdateFlags) Reset()         { *m = EventMarkerUpdateFlags{} }
+func (m *EventMarkerUpdateFlags) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerUpdateFlags) ProtoMessage()    {}
+func (*EventMarkerUpdateFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{24}
+}
+func (m *EventMarkerUpdateFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerUpdateFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerUpdateFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerUpdateFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerUpdateFlags.Merge(m, src)
+}
+func (m *EventMarkerUpdateFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerUpdateFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerUpdateFlags.DiscardUnknown(m)
 }
 
-func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerUpdateFlags proto.InternalMessageInfo
+
+func (m *EventMarkerUpdateFlags) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerUpdateFlags) GetSupplyFixed() bool {
+	if m != nil {
+		return m.SupplyFixed
+	}
+	return false
 }
 
-func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerUpdateFlags) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerUpdateFlags) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerPaused event emitted when all transfers, mints and burns of a marker are paused
+type EventMarkerPaused struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerPaused) Reset()         { *m = EventMarkerPaused{} }
+func (m *EventMarkerPaused) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerPaused) ProtoMessage()    {}
+func (*EventMarkerPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{25}
+}
+func (m *EventMarkerPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Coins) > 0 {
-		i -= len(m.Coins)
-		copy(dAtA[i:], m.Coins)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerPaused.Merge(m, src)
+}
+func (m *EventMarkerPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerPaused proto.InternalMessageInfo
+
+func (m *EventMarkerPaused) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerPaused) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerResumed event emitted when a paused marker is resumed
+type EventMarkerResumed struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerResumed) Reset()         { *m = EventMarkerResumed{} }
+func (m *EventMarkerResumed) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerResumed) ProtoMessage()    {}
+func (*EventMarkerResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{26}
+}
+func (m *EventMarkerResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerResumed.Merge(m, src)
+}
+func (m *EventMarkerResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerResumed proto.InternalMessageInfo
+
+func (m *EventMarkerResumed) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerResumed) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerDepositControl event emitted when the restriction of deposits into a marker account is changed
+type EventMarkerDepositControl struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RestrictDeposits bool   `protobuf:"varint,2,opt,name=restrict_deposits,json=restrictDeposits,proto3" json:"restrict_deposits,omitempty"`
+	Administrator    string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataSymbol) > 0 {
-		i -= len(m.MetadataSymbol)
-		copy(dAtA[i:], m.MetadataSymbol)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
-		i--
-		dAtA[i] = 0x3a
+func (m *EventMarkerDepositControl) Reset()         { *m = EventMarkerDepositControl{} }
+func (m *EventMarkerDepositControl) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDepositControl) ProtoMessage()    {}
+func (*EventMarkerDepositControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{27}
+}
+func (m *EventMarkerDepositControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDepositControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDepositControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MetadataName) > 0 {
-		i -= len(m.MetadataName)
-		copy(dAtA[i:], m.MetadataName)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *EventMarkerDepositControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDepositControl.Merge(m, src)
+}
+func (m *EventMarkerDepositControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDepositControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDepositControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDepositControl proto.InternalMessageInfo
+
+func (m *EventMarkerDepositControl) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventMarkerDepositControl) GetRestrictDeposits() bool {
+	if m != nil {
+		return m.RestrictDeposits
 	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return false
+}
+
+func (m *EventMarkerDepositControl) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.MetadataDisplay) > 0 {
-		i -= len(m.MetadataDisplay)
-		copy(dAtA[i:], m.MetadataDisplay)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// EventMarkerTransferMemoRequirement event emitted when the memo required for transfers of a marker's coin is changed
+type EventMarkerTransferMemoRequirement struct {
+	Denom               string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TransferMemoPattern string `protobuf:"bytes,2,opt,name=transfer_memo_pattern,json=transferMemoPattern,proto3" json:"transfer_memo_pattern,omitempty"`
+	Administrator       string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerTransferMemoRequirement) Reset()         { *m = EventMarkerTransferMemoRequirement{} }
+func (m *EventMarkerTransferMemoRequirement) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransferMemoRequirement) ProtoMessage()    {}
+func (*EventMarkerTransferMemoRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{28}
+}
+func (m *EventMarkerTransferMemoRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransferMemoRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransferMemoRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MetadataDescription) > 0 {
-		i -= len(m.MetadataDescription)
-		copy(dAtA[i:], m.MetadataDescription)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerTransferMemoRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransferMemoRequirement.Merge(m, src)
+}
+func (m *EventMarkerTransferMemoRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransferMemoRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransferMemoRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransferMemoRequirement proto.InternalMessageInfo
+
+func (m *EventMarkerTransferMemoRequirement) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.MetadataBase) > 0 {
-		i -= len(m.MetadataBase)
-		copy(dAtA[i:], m.MetadataBase)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerTransferMemoRequirement) GetTransferMemoPattern() string {
+	if m != nil {
+		return m.TransferMemoPattern
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransferMemoRequirement) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerWithdrawApprovalPolicy event emitted when the withdraw approval policy of a marker is changed
+type EventMarkerWithdrawApprovalPolicy struct {
+	Denom             string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Threshold         string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ApprovalsRequired string `protobuf:"bytes,3,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	ApprovalPeriod    string `protobuf:"bytes,4,opt,name=approval_period,json=approvalPeriod,proto3" json:"approval_period,omitempty"`
+	Administrator     string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *EventMarkerWithdrawApprovalPolicy) Reset()         { *m = EventMarkerWithdrawApprovalPolicy{} }
+func (m *EventMarkerWithdrawApprovalPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdrawApprovalPolicy) ProtoMessage()    {}
+func (*EventMarkerWithdrawApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{29}
+}
+func (m *EventMarkerWithdrawApprovalPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdrawApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdrawApprovalPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Exponent) > 0 {
-		i -= len(m.Exponent)
-		copy(dAtA[i:], m.Exponent)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerWithdrawApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdrawApprovalPolicy.Merge(m, src)
+}
+func (m *EventMarkerWithdrawApprovalPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdrawApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdrawApprovalPolicy.DiscardUnknown(m)
 }
 
-func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventMarkerWithdrawApprovalPolicy proto.InternalMessageInfo
+
+func (m *EventMarkerWithdrawApprovalPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxTotalSupply != 0 {
-		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
-	}
-	if m.EnableGovernance {
-		n += 2
-	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+
+func (m *EventMarkerWithdrawApprovalPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	return n
+	return ""
 }
 
-func (m *MarkerAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerWithdrawApprovalPolicy) GetApprovalsRequired() string {
+	if m != nil {
+		return m.ApprovalsRequired
 	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) GetApprovalPeriod() string {
+	if m != nil {
+		return m.ApprovalPeriod
 	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.AccessControl) > 0 {
-		for _, e := range m.AccessControl {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerWithdrawPending event emitted when a withdrawal above the approval threshold is waiting for approvals
+type EventMarkerWithdrawPending struct {
+	WithdrawalId      string `protobuf:"bytes,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Coins             string `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom             string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator     string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress         string `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	ApprovalsRequired string `protobuf:"bytes,6,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	Expiration        string `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventMarkerWithdrawPending) Reset()         { *m = EventMarkerWithdrawPending{} }
+func (m *EventMarkerWithdrawPending) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdrawPending) ProtoMessage()    {}
+func (*EventMarkerWithdrawPending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{30}
+}
+func (m *EventMarkerWithdrawPending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdrawPending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdrawPending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovMarker(uint64(m.Status))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = m.Supply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.MarkerType != 0 {
-		n += 1 + sovMarker(uint64(m.MarkerType))
-	}
-	if m.SupplyFixed {
-		n += 2
-	}
-	if m.AllowGovernanceControl {
-		n += 2
-	}
-	return n
+}
+func (m *EventMarkerWithdrawPending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdrawPending.Merge(m, src)
+}
+func (m *EventMarkerWithdrawPending) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdrawPending) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdrawPending.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarkerWithdrawPending proto.InternalMessageInfo
+
+func (m *EventMarkerWithdrawPending) GetWithdrawalId() string {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawPending) GetCoins() string {
+	if m != nil {
+		return m.Coins
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawPending) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawPending) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawPending) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	l = len(m.MarkerType)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawPending) GetApprovalsRequired() string {
+	if m != nil {
+		return m.ApprovalsRequired
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerWithdrawPending) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	var l int
-	_ = l
-	l = m.Access.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerWithdrawApproved event emitted when an administrator approves a pending withdrawal
+type EventMarkerWithdrawApproved struct {
+	WithdrawalId  string `protobuf:"bytes,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the number of approvals the withdrawal has, including this one
+	Approvals string `protobuf:"bytes,4,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	// true if this approval completed the withdrawal
+	Executed bool `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *EventMarkerWithdrawApproved) Reset()         { *m = EventMarkerWithdrawApproved{} }
+func (m *EventMarkerWithdrawApproved) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdrawApproved) ProtoMessage()    {}
+func (*EventMarkerWithdrawApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{31}
+}
+func (m *EventMarkerWithdrawApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdrawApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdrawApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerWithdrawApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdrawApproved.Merge(m, src)
+}
+func (m *EventMarkerWithdrawApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdrawApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdrawApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerWithdrawApproved proto.InternalMessageInfo
+
+func (m *EventMarkerWithdrawApproved) GetWithdrawalId() string {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerWithdrawApproved) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawApproved) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
-		}
+	return ""
+}
+
+func (m *EventMarkerWithdrawApproved) GetApprovals() string {
+	if m != nil {
+		return m.Approvals
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerWithdrawApproved) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	var l int
-	_ = l
-	l = len(m.RemoveAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+	return false
 }
 
-func (m *EventMarkerFinalize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+// EventMarkerWithdrawExpired event emitted when a pending withdrawal is removed without being approved
+type EventMarkerWithdrawExpired struct {
+	WithdrawalId string `protobuf:"bytes,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Coins        string `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom        string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ToAddress    string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 }
 
-func (m *EventMarkerActivate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerWithdrawExpired) Reset()         { *m = EventMarkerWithdrawExpired{} }
+func (m *EventMarkerWithdrawExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdrawExpired) ProtoMessage()    {}
+func (*EventMarkerWithdrawExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{32}
+}
+func (m *EventMarkerWithdrawExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdrawExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdrawExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerWithdrawExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdrawExpired.Merge(m, src)
+}
+func (m *EventMarkerWithdrawExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdrawExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdrawExpired.DiscardUnknown(m)
 }
 
-func (m *EventMarkerCancel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerWithdrawExpired proto.InternalMessageInfo
+
+func (m *EventMarkerWithdrawExpired) GetWithdrawalId() string {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawExpired) GetCoins() string {
+	if m != nil {
+		return m.Coins
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDelete) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerWithdrawExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerWithdrawExpired) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerFaucet event emitted when the faucet of a marker is set or removed
+type EventMarkerFaucet struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount sent for each claim, zero when the faucet is removed
+	DripAmount    string `protobuf:"bytes,2,opt,name=drip_amount,json=dripAmount,proto3" json:"drip_amount,omitempty"`
+	Period        string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Mint          bool   `protobuf:"varint,4,opt,name=mint,proto3" json:"mint,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerFaucet) Reset()         { *m = EventMarkerFaucet{} }
+func (m *EventMarkerFaucet) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFaucet) ProtoMessage()    {}
+func (*EventMarkerFaucet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{33}
+}
+func (m *EventMarkerFaucet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFaucet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFaucet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerFaucet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFaucet.Merge(m, src)
+}
+func (m *EventMarkerFaucet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFaucet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFaucet.DiscardUnknown(m)
 }
 
-func (m *EventMarkerMint) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarkerFaucet proto.InternalMessageInfo
+
+func (m *EventMarkerFaucet) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFaucet) GetDripAmount() string {
+	if m != nil {
+		return m.DripAmount
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFaucet) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFaucet) GetMint() bool {
+	if m != nil {
+		return m.Mint
 	}
-	return n
+	return false
 }
 
-func (m *EventMarkerBurn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerFaucet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerFaucetClaim event emitted when coin is claimed from the faucet of a marker
+type EventMarkerFaucetClaim struct {
+	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Claimer       string `protobuf:"bytes,3,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	NextClaimTime string `protobuf:"bytes,4,opt,name=next_claim_time,json=nextClaimTime,proto3" json:"next_claim_time,omitempty"`
+}
+
+func (m *EventMarkerFaucetClaim) Reset()         { *m = EventMarkerFaucetClaim{} }
+func (m *EventMarkerFaucetClaim) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFaucetClaim) ProtoMessage()    {}
+func (*EventMarkerFaucetClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{34}
+}
+func (m *EventMarkerFaucetClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFaucetClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFaucetClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerFaucetClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFaucetClaim.Merge(m, src)
+}
+func (m *EventMarkerFaucetClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFaucetClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFaucetClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerFaucetClaim proto.InternalMessageInfo
+
+func (m *EventMarkerFaucetClaim) GetCoins() string {
+	if m != nil {
+		return m.Coins
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFaucetClaim) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerFaucetClaim) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
 	}
-	var l int
-	_ = l
-	l = len(m.Coins)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFaucetClaim) GetNextClaimTime() string {
+	if m != nil {
+		return m.NextClaimTime
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerFeeConversion event emitted when the fee conversion of a marker is set, its rate is updated, or it is
+// removed
+type EventMarkerFeeConversion struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pool  string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// the rate of the conversion, empty when it is removed
+	Rate   string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Oracle string `protobuf:"bytes,4,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *EventMarkerFeeConversion) Reset()         { *m = EventMarkerFeeConversion{} }
+func (m *EventMarkerFeeConversion) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFeeConversion) ProtoMessage()    {}
+func (*EventMarkerFeeConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{35}
+}
+func (m *EventMarkerFeeConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFeeConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFeeConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerFeeConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFeeConversion.Merge(m, src)
+}
+func (m *EventMarkerFeeConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFeeConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFeeConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerFeeConversion proto.InternalMessageInfo
+
+func (m *EventMarkerFeeConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFeeConversion) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerFeeConversion) GetRate() string {
+	if m != nil {
+		return m.Rate
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFeeConversion) GetOracle() string {
+	if m != nil {
+		return m.Oracle
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerFeeConverted event emitted when the fee of a tx paid in a marker's coin is swapped for the fee denom
+type EventMarkerFeeConverted struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Payer     string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Converted string `protobuf:"bytes,4,opt,name=converted,proto3" json:"converted,omitempty"`
+	Pool      string `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *EventMarkerFeeConverted) Reset()         { *m = EventMarkerFeeConverted{} }
+func (m *EventMarkerFeeConverted) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFeeConverted) ProtoMessage()    {}
+func (*EventMarkerFeeConverted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{36}
+}
+func (m *EventMarkerFeeConverted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerFeeConverted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerFeeConverted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerFeeConverted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFeeConverted.Merge(m, src)
+}
+func (m *EventMarkerFeeConverted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerFeeConverted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFeeConverted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerFeeConverted proto.InternalMessageInfo
+
+func (m *EventMarkerFeeConverted) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFeeConverted) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFeeConverted) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarkerFeeConverted) GetConverted() string {
+	if m != nil {
+		return m.Converted
 	}
-	var l int
-	_ = l
-	l = len(m.MetadataBase)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerFeeConverted) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	l = len(m.MetadataDescription)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerCancel event emitted when marker is cancelled
+type EventMarkerCancel struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
+func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerCancel) ProtoMessage()    {}
+func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{37}
+}
+func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MetadataDisplay)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+}
+func (m *EventMarkerCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+
+func (m *EventMarkerCancel) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for _, e := range m.MetadataDenomUnits {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerCancel) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerDelete event emitted when marker is deleted
+type EventMarkerDelete struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
+func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDelete) ProtoMessage()    {}
+func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{38}
+}
+func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+}
+func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
+}
+func (m *EventMarkerDelete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
+
+func (m *EventMarkerDelete) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.MetadataName)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerDelete) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	l = len(m.MetadataSymbol)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerMint event emitted when additional marker supply is minted
+type EventMarkerMint struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
+func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerMint) ProtoMessage()    {}
+func (*EventMarkerMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{39}
+}
+func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerMint.Merge(m, src)
+}
+func (m *EventMarkerMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
 }
 
-func (m *EventDenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
+
+func (m *EventMarkerMint) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerMint) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Exponent)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerMint) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerBurn event emitted when coin is burned from marker
+type EventMarkerBurn struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
+func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBurn) ProtoMessage()    {}
+func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{40}
+}
+func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
+}
+func (m *EventMarkerBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
+
+func (m *EventMarkerBurn) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerBurn) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerBurn) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerWithdraw event emitted when coins are withdrew from marker
+type EventMarkerWithdraw struct {
+	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+}
+
+func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
+func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdraw) ProtoMessage()    {}
+func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{41}
+}
+func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+}
+func (m *EventMarkerWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+
+func (m *EventMarkerWithdraw) GetCoins() string {
+	if m != nil {
+		return m.Coins
+	}
+	return ""
+}
+
+func (m *EventMarkerWithdraw) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerWithdraw) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerWithdraw) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// EventMarkerDistribute event emitted when coins from the escrow of a marker are set aside for its holders
+type EventMarkerDistribute struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom          string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator  string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	Holders        string `protobuf:"bytes,5,opt,name=holders,proto3" json:"holders,omitempty"`
+}
+
+func (m *EventMarkerDistribute) Reset()         { *m = EventMarkerDistribute{} }
+func (m *EventMarkerDistribute) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistribute) ProtoMessage()    {}
+func (*EventMarkerDistribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{42}
+}
+func (m *EventMarkerDistribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerDistribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistribute.Merge(m, src)
+}
+func (m *EventMarkerDistribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistribute proto.InternalMessageInfo
+
+func (m *EventMarkerDistribute) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
+	}
+	return ""
+}
+
+func (m *EventMarkerDistribute) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerDistribute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerDistribute) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerDistribute) GetHolders() string {
+	if m != nil {
+		return m.Holders
+	}
+	return ""
+}
+
+// EventMarkerEmissionScheduleAdd event emitted when an emission schedule is added to a marker
+type EventMarkerEmissionScheduleAdd struct {
+	ScheduleId    string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Interval      string `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Emissions     string `protobuf:"bytes,5,opt,name=emissions,proto3" json:"emissions,omitempty"`
+	Recipient     string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Administrator string `protobuf:"bytes,7,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerEmissionScheduleAdd) Reset()         { *m = EventMarkerEmissionScheduleAdd{} }
+func (m *EventMarkerEmissionScheduleAdd) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerEmissionScheduleAdd) ProtoMessage()    {}
+func (*EventMarkerEmissionScheduleAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{43}
+}
+func (m *EventMarkerEmissionScheduleAdd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerEmissionScheduleAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerEmissionScheduleAdd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerEmissionScheduleAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerEmissionScheduleAdd.Merge(m, src)
+}
+func (m *EventMarkerEmissionScheduleAdd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerEmissionScheduleAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerEmissionScheduleAdd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerEmissionScheduleAdd proto.InternalMessageInfo
+
+func (m *EventMarkerEmissionScheduleAdd) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetEmissions() string {
+	if m != nil {
+		return m.Emissions
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleAdd) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerEmission event emitted when an emission schedule mints coin for its recipient
+type EventMarkerEmission struct {
+	ScheduleId         string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Denom              string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount             string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient          string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	RemainingEmissions string `protobuf:"bytes,5,opt,name=remaining_emissions,json=remainingEmissions,proto3" json:"remaining_emissions,omitempty"`
+}
+
+func (m *EventMarkerEmission) Reset()         { *m = EventMarkerEmission{} }
+func (m *EventMarkerEmission) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerEmission) ProtoMessage()    {}
+func (*EventMarkerEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{44}
+}
+func (m *EventMarkerEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerEmission.Merge(m, src)
+}
+func (m *EventMarkerEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerEmission proto.InternalMessageInfo
+
+func (m *EventMarkerEmission) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *EventMarkerEmission) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerEmission) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerEmission) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventMarkerEmission) GetRemainingEmissions() string {
+	if m != nil {
+		return m.RemainingEmissions
+	}
+	return ""
+}
+
+// EventMarkerEmissionScheduleCancel event emitted when an emission schedule is cancelled before it completes
+type EventMarkerEmissionScheduleCancel struct {
+	ScheduleId         string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Denom              string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	RemainingEmissions string `protobuf:"bytes,3,opt,name=remaining_emissions,json=remainingEmissions,proto3" json:"remaining_emissions,omitempty"`
+	Administrator      string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerEmissionScheduleCancel) Reset()         { *m = EventMarkerEmissionScheduleCancel{} }
+func (m *EventMarkerEmissionScheduleCancel) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerEmissionScheduleCancel) ProtoMessage()    {}
+func (*EventMarkerEmissionScheduleCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{45}
+}
+func (m *EventMarkerEmissionScheduleCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerEmissionScheduleCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerEmissionScheduleCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerEmissionScheduleCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerEmissionScheduleCancel.Merge(m, src)
+}
+func (m *EventMarkerEmissionScheduleCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerEmissionScheduleCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerEmissionScheduleCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerEmissionScheduleCancel proto.InternalMessageInfo
+
+func (m *EventMarkerEmissionScheduleCancel) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleCancel) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleCancel) GetRemainingEmissions() string {
+	if m != nil {
+		return m.RemainingEmissions
+	}
+	return ""
+}
+
+func (m *EventMarkerEmissionScheduleCancel) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerTransferScheduled event emitted when a restricted coin transfer is scheduled
+type EventMarkerTransferScheduled struct {
+	TransferId    string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	FromAddress   string `protobuf:"bytes,6,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ReleaseHeight string `protobuf:"bytes,7,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	ReleaseTime   string `protobuf:"bytes,8,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+}
+
+func (m *EventMarkerTransferScheduled) Reset()         { *m = EventMarkerTransferScheduled{} }
+func (m *EventMarkerTransferScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransferScheduled) ProtoMessage()    {}
+func (*EventMarkerTransferScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{46}
+}
+func (m *EventMarkerTransferScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransferScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransferScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerTransferScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransferScheduled.Merge(m, src)
+}
+func (m *EventMarkerTransferScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransferScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransferScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransferScheduled proto.InternalMessageInfo
+
+func (m *EventMarkerTransferScheduled) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetReleaseHeight() string {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return ""
+}
+
+func (m *EventMarkerTransferScheduled) GetReleaseTime() string {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return ""
+}
+
+// EventMarkerScheduledTransferReleased event emitted when a scheduled transfer is sent to its recipient
+type EventMarkerScheduledTransferReleased struct {
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ToAddress  string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the recipient when the transfer was claimed, empty when it was released at the start of a block
+	ClaimedBy string `protobuf:"bytes,5,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+}
+
+func (m *EventMarkerScheduledTransferReleased) Reset()         { *m = EventMarkerScheduledTransferReleased{} }
+func (m *EventMarkerScheduledTransferReleased) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerScheduledTransferReleased) ProtoMessage()    {}
+func (*EventMarkerScheduledTransferReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{47}
+}
+func (m *EventMarkerScheduledTransferReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerScheduledTransferReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerScheduledTransferReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerScheduledTransferReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerScheduledTransferReleased.Merge(m, src)
+}
+func (m *EventMarkerScheduledTransferReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerScheduledTransferReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerScheduledTransferReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerScheduledTransferReleased proto.InternalMessageInfo
+
+func (m *EventMarkerScheduledTransferReleased) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferReleased) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferReleased) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferReleased) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferReleased) GetClaimedBy() string {
+	if m != nil {
+		return m.ClaimedBy
+	}
+	return ""
+}
+
+// EventMarkerScheduledTransferCancel event emitted when a scheduled transfer is returned to its sender before release
+type EventMarkerScheduledTransferCancel struct {
+	TransferId    string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromAddress   string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerScheduledTransferCancel) Reset()         { *m = EventMarkerScheduledTransferCancel{} }
+func (m *EventMarkerScheduledTransferCancel) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerScheduledTransferCancel) ProtoMessage()    {}
+func (*EventMarkerScheduledTransferCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{48}
+}
+func (m *EventMarkerScheduledTransferCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerScheduledTransferCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerScheduledTransferCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerScheduledTransferCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerScheduledTransferCancel.Merge(m, src)
+}
+func (m *EventMarkerScheduledTransferCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerScheduledTransferCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerScheduledTransferCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerScheduledTransferCancel proto.InternalMessageInfo
+
+func (m *EventMarkerScheduledTransferCancel) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferCancel) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferCancel) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferCancel) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerScheduledTransferCancel) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventMarkerDistributionComplete event emitted when every holder has been paid by an escrow distribution
+type EventMarkerDistributionComplete struct {
+	DistributionId string `protobuf:"bytes,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Paid           string `protobuf:"bytes,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Returned       string `protobuf:"bytes,4,opt,name=returned,proto3" json:"returned,omitempty"`
+}
+
+func (m *EventMarkerDistributionComplete) Reset()         { *m = EventMarkerDistributionComplete{} }
+func (m *EventMarkerDistributionComplete) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDistributionComplete) ProtoMessage()    {}
+func (*EventMarkerDistributionComplete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{49}
+}
+func (m *EventMarkerDistributionComplete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerDistributionComplete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerDistributionComplete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerDistributionComplete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDistributionComplete.Merge(m, src)
+}
+func (m *EventMarkerDistributionComplete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerDistributionComplete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDistributionComplete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerDistributionComplete proto.InternalMessageInfo
+
+func (m *EventMarkerDistributionComplete) GetDistributionId() string {
+	if m != nil {
+		return m.DistributionId
+	}
+	return ""
+}
+
+func (m *EventMarkerDistributionComplete) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerDistributionComplete) GetPaid() string {
+	if m != nil {
+		return m.Paid
+	}
+	return ""
+}
+
+func (m *EventMarkerDistributionComplete) GetReturned() string {
+	if m != nil {
+		return m.Returned
+	}
+	return ""
+}
+
+// EventMarkerBasketDeposit event emitted when reserve coins are deposited into a basket marker to mint basket coin
+type EventMarkerBasketDeposit struct {
+	Amount      string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Reserve     string `protobuf:"bytes,3,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	FromAddress string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *EventMarkerBasketDeposit) Reset()         { *m = EventMarkerBasketDeposit{} }
+func (m *EventMarkerBasketDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBasketDeposit) ProtoMessage()    {}
+func (*EventMarkerBasketDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{50}
+}
+func (m *EventMarkerBasketDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerBasketDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerBasketDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerBasketDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBasketDeposit.Merge(m, src)
+}
+func (m *EventMarkerBasketDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerBasketDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBasketDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerBasketDeposit proto.InternalMessageInfo
+
+func (m *EventMarkerBasketDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketDeposit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketDeposit) GetReserve() string {
+	if m != nil {
+		return m.Reserve
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketDeposit) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+// EventMarkerBasketRedeem event emitted when basket coin is burned to redeem reserve coins from a basket marker
+type EventMarkerBasketRedeem struct {
+	Amount      string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Reserve     string `protobuf:"bytes,3,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	FromAddress string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *EventMarkerBasketRedeem) Reset()         { *m = EventMarkerBasketRedeem{} }
+func (m *EventMarkerBasketRedeem) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBasketRedeem) ProtoMessage()    {}
+func (*EventMarkerBasketRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{51}
+}
+func (m *EventMarkerBasketRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerBasketRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerBasketRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerBasketRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBasketRedeem.Merge(m, src)
+}
+func (m *EventMarkerBasketRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerBasketRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBasketRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerBasketRedeem proto.InternalMessageInfo
+
+func (m *EventMarkerBasketRedeem) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketRedeem) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketRedeem) GetReserve() string {
+	if m != nil {
+		return m.Reserve
+	}
+	return ""
+}
+
+func (m *EventMarkerBasketRedeem) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+// EventMarkerTransfer event emitted when coins are transfered to from account to another
+type EventMarkerTransfer struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
+func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransfer) ProtoMessage()    {}
+func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{52}
+}
+func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+}
+func (m *EventMarkerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+
+func (m *EventMarkerTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
+type EventMarkerSetDenomMetadata struct {
+	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
+	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
+	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
+	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
+	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
+	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+}
+
+func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
+func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
+func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{53}
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
+	if m != nil {
+		return m.MetadataBase
+	}
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+	if m != nil {
+		return m.MetadataDescription
+	}
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
+	if m != nil {
+		return m.MetadataDisplay
+	}
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
+	if m != nil {
+		return m.MetadataDenomUnits
+	}
+	return nil
+}
+
+func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+	if m != nil {
+		return m.MetadataName
+	}
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+	if m != nil {
+		return m.MetadataSymbol
+	}
+	return ""
+}
+
+// EventDenomUnit denom units for set denom metadata event
+type EventDenomUnit struct {
+	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
+func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
+func (*EventDenomUnit) ProtoMessage()    {}
+func (*EventDenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{54}
+}
+func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+}
+func (m *EventDenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+
+func (m *EventDenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventDenomUnit) GetExponent() string {
+	if m != nil {
+		return m.Exponent
+	}
+	return ""
+}
+
+func (m *EventDenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
+	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
+	proto.RegisterEnum("provenance.marker.v1.TransferDenyReason", TransferDenyReason_name, TransferDenyReason_value)
+	proto.RegisterEnum("provenance.marker.v1.SupplyChangeReason", SupplyChangeReason_name, SupplyChangeReason_value)
+	proto.RegisterEnum("provenance.marker.v1.AccessChangeAction", AccessChangeAction_name, AccessChangeAction_value)
+	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
+	proto.RegisterType((*AccessRole)(nil), "provenance.marker.v1.AccessRole")
+	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*WithdrawApprovalPolicy)(nil), "provenance.marker.v1.WithdrawApprovalPolicy")
+	proto.RegisterType((*Basket)(nil), "provenance.marker.v1.Basket")
+	proto.RegisterType((*MarkerTotal)(nil), "provenance.marker.v1.MarkerTotal")
+	proto.RegisterType((*TransferDenial)(nil), "provenance.marker.v1.TransferDenial")
+	proto.RegisterType((*EscrowDistribution)(nil), "provenance.marker.v1.EscrowDistribution")
+	proto.RegisterType((*DistributionHolder)(nil), "provenance.marker.v1.DistributionHolder")
+	proto.RegisterType((*EmissionSchedule)(nil), "provenance.marker.v1.EmissionSchedule")
+	proto.RegisterType((*ScheduledTransfer)(nil), "provenance.marker.v1.ScheduledTransfer")
+	proto.RegisterType((*PendingWithdrawal)(nil), "provenance.marker.v1.PendingWithdrawal")
+	proto.RegisterType((*MarkerFaucet)(nil), "provenance.marker.v1.MarkerFaucet")
+	proto.RegisterType((*FaucetClaim)(nil), "provenance.marker.v1.FaucetClaim")
+	proto.RegisterType((*FeeConversion)(nil), "provenance.marker.v1.FeeConversion")
+	proto.RegisterType((*SupplyChange)(nil), "provenance.marker.v1.SupplyChange")
+	proto.RegisterType((*AccessChange)(nil), "provenance.marker.v1.AccessChange")
+	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
+	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
+	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
+	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
+	proto.RegisterType((*EventMarkerAccessChange)(nil), "provenance.marker.v1.EventMarkerAccessChange")
+	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
+	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
+	proto.RegisterType((*EventMarkerUpdateFlags)(nil), "provenance.marker.v1.EventMarkerUpdateFlags")
+	proto.RegisterType((*EventMarkerPaused)(nil), "provenance.marker.v1.EventMarkerPaused")
+	proto.RegisterType((*EventMarkerResumed)(nil), "provenance.marker.v1.EventMarkerResumed")
+	proto.RegisterType((*EventMarkerDepositControl)(nil), "provenance.marker.v1.EventMarkerDepositControl")
+	proto.RegisterType((*EventMarkerTransferMemoRequirement)(nil), "provenance.marker.v1.EventMarkerTransferMemoRequirement")
+	proto.RegisterType((*EventMarkerWithdrawApprovalPolicy)(nil), "provenance.marker.v1.EventMarkerWithdrawApprovalPolicy")
+	proto.RegisterType((*EventMarkerWithdrawPending)(nil), "provenance.marker.v1.EventMarkerWithdrawPending")
+	proto.RegisterType((*EventMarkerWithdrawApproved)(nil), "provenance.marker.v1.EventMarkerWithdrawApproved")
+	proto.RegisterType((*EventMarkerWithdrawExpired)(nil), "provenance.marker.v1.EventMarkerWithdrawExpired")
+	proto.RegisterType((*EventMarkerFaucet)(nil), "provenance.marker.v1.EventMarkerFaucet")
+	proto.RegisterType((*EventMarkerFaucetClaim)(nil), "provenance.marker.v1.EventMarkerFaucetClaim")
+	proto.RegisterType((*EventMarkerFeeConversion)(nil), "provenance.marker.v1.EventMarkerFeeConversion")
+	proto.RegisterType((*EventMarkerFeeConverted)(nil), "provenance.marker.v1.EventMarkerFeeConverted")
+	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
+	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
+	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
+	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
+	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
+	proto.RegisterType((*EventMarkerDistribute)(nil), "provenance.marker.v1.EventMarkerDistribute")
+	proto.RegisterType((*EventMarkerEmissionScheduleAdd)(nil), "provenance.marker.v1.EventMarkerEmissionScheduleAdd")
+	proto.RegisterType((*EventMarkerEmission)(nil), "provenance.marker.v1.EventMarkerEmission")
+	proto.RegisterType((*EventMarkerEmissionScheduleCancel)(nil), "provenance.marker.v1.EventMarkerEmissionScheduleCancel")
+	proto.RegisterType((*EventMarkerTransferScheduled)(nil), "provenance.marker.v1.EventMarkerTransferScheduled")
+	proto.RegisterType((*EventMarkerScheduledTransferReleased)(nil), "provenance.marker.v1.EventMarkerScheduledTransferReleased")
+	proto.RegisterType((*EventMarkerScheduledTransferCancel)(nil), "provenance.marker.v1.EventMarkerScheduledTransferCancel")
+	proto.RegisterType((*EventMarkerDistributionComplete)(nil), "provenance.marker.v1.EventMarkerDistributionComplete")
+	proto.RegisterType((*EventMarkerBasketDeposit)(nil), "provenance.marker.v1.EventMarkerBasketDeposit")
+	proto.RegisterType((*EventMarkerBasketRedeem)(nil), "provenance.marker.v1.EventMarkerBasketRedeem")
+	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
+	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
+	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+
+var fileDescriptor_f7e2c25c71db7f99 = []byte{
+	// 3822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x4d, 0x6c, 0x23, 0xc9,
+	0x75, 0x56, 0x93, 0x14, 0x25, 0x3e, 0x4a, 0x14, 0xa7, 0x66, 0x56, 0xc3, 0xe1, 0xc8, 0x22, 0xa7,
+	0xbd, 0xde, 0x91, 0xc7, 0x5e, 0xc9, 0xa3, 0x8d, 0x9d, 0xc5, 0x04, 0x76, 0xc2, 0x3f, 0xcd, 0x10,
+	0x2b, 0x91, 0x74, 0x93, 0x1a, 0x63, 0x36, 0x01, 0x3a, 0x2d, 0x76, 0x89, 0x6a, 0x4f, 0xff, 0x70,
+	0xbb, 0x9b, 0xfa, 0x31, 0x72, 0x48, 0x00, 0xc7, 0x30, 0x94, 0x00, 0xd9, 0x00, 0x31, 0xe0, 0x00,
+	0x11, 0xbc, 0x81, 0x73, 0x70, 0x1c, 0x20, 0x88, 0xe3, 0x1c, 0x7c, 0x08, 0x72, 0xf6, 0x29, 0x58,
+	0x18, 0x08, 0x10, 0xe4, 0x30, 0x1b, 0xec, 0x5e, 0x72, 0xd8, 0x5c, 0xf6, 0x90, 0x6b, 0x82, 0xfa,
+	0xe9, 0x66, 0x37, 0xd9, 0x94, 0xa8, 0x91, 0x26, 0x3e, 0x89, 0xf5, 0xf3, 0x5e, 0x55, 0x7d, 0xef,
+	0xa7, 0xde, 0x7b, 0x5d, 0x82, 0x7b, 0x7d, 0xdb, 0x3a, 0xc4, 0xa6, 0x62, 0x76, 0xf1, 0x86, 0xa1,
+	0xd8, 0xcf, 0xb1, 0xbd, 0x71, 0xf8, 0x90, 0xff, 0x5a, 0xef, 0xdb, 0x96, 0x6b, 0xa1, 0x5b, 0xc3,
+	0x29, 0xeb, 0x7c, 0xe0, 0xf0, 0x61, 0xfe, 0x56, 0xcf, 0xea, 0x59, 0x74, 0xc2, 0x06, 0xf9, 0xc5,
+	0xe6, 0xe6, 0x57, 0x7b, 0x96, 0xd5, 0xd3, 0xf1, 0x06, 0x6d, 0xed, 0x0d, 0xf6, 0x37, 0xd4, 0x81,
+	0xad, 0xb8, 0x9a, 0x65, 0xf2, 0xf1, 0xc2, 0xe8, 0xb8, 0xab, 0x19, 0xd8, 0x71, 0x15, 0xa3, 0xef,
+	0x31, 0xe8, 0x5a, 0x8e, 0x61, 0x39, 0x1b, 0xca, 0xc0, 0x3d, 0xd8, 0x38, 0x7c, 0xb8, 0x87, 0x5d,
+	0xe5, 0x21, 0x6d, 0xf0, 0xf1, 0x3b, 0x6c, 0x5c, 0x66, 0x2b, 0xb3, 0xc6, 0x08, 0xe9, 0x9e, 0xe2,
+	0x60, 0x9f, 0xb4, 0x6b, 0x69, 0xde, 0xda, 0x6f, 0x44, 0x1e, 0x55, 0xe9, 0x76, 0xb1, 0xe3, 0xf4,
+	0x6c, 0xc5, 0x74, 0xd9, 0x3c, 0xf1, 0x6f, 0x93, 0x90, 0x6c, 0x29, 0xb6, 0x62, 0x38, 0xe8, 0x6d,
+	0xc8, 0x1a, 0xca, 0xb1, 0xec, 0x5a, 0xae, 0xa2, 0xcb, 0xce, 0xa0, 0xdf, 0xd7, 0x4f, 0x72, 0x42,
+	0x51, 0x58, 0x4b, 0x94, 0x33, 0xbf, 0x7c, 0x51, 0x98, 0xf9, 0x8f, 0x17, 0x85, 0xe4, 0x40, 0x33,
+	0xdd, 0xaf, 0xfd, 0x86, 0x94, 0x31, 0x94, 0xe3, 0x0e, 0x99, 0xd6, 0xa6, 0xb3, 0xd0, 0x97, 0xe0,
+	0x06, 0x36, 0x95, 0x3d, 0x1d, 0xcb, 0x3d, 0xeb, 0x10, 0xdb, 0x74, 0xd5, 0x5c, 0xac, 0x28, 0xac,
+	0xcd, 0x4b, 0x59, 0x36, 0xf0, 0xd8, 0xef, 0x47, 0x6f, 0x43, 0x6e, 0x60, 0xda, 0xd8, 0x71, 0x6d,
+	0xad, 0xeb, 0x62, 0x55, 0x56, 0xb1, 0x69, 0x19, 0xb2, 0x8d, 0x7b, 0xf8, 0x38, 0x17, 0x2f, 0x0a,
+	0x6b, 0x29, 0x69, 0x39, 0x38, 0x5e, 0x25, 0xc3, 0x12, 0x19, 0x45, 0xbf, 0x0b, 0xb7, 0xf1, 0x71,
+	0x1f, 0xab, 0x1a, 0x21, 0x3b, 0xb4, 0x5c, 0xcd, 0xec, 0xc9, 0x7d, 0x6c, 0x6b, 0x96, 0x9a, 0x4b,
+	0x14, 0x85, 0xb5, 0xf4, 0xe6, 0x9d, 0x75, 0x86, 0xf8, 0xba, 0x87, 0xf8, 0x7a, 0x95, 0x4b, 0xa4,
+	0x3c, 0x4f, 0x8e, 0xf0, 0xc3, 0x8f, 0x0a, 0x82, 0xf4, 0x9a, 0xcf, 0xe3, 0x29, 0x65, 0xd1, 0xa2,
+	0x1c, 0xd0, 0x33, 0xc8, 0x0e, 0x99, 0xbf, 0x37, 0xb0, 0xec, 0x81, 0x91, 0x9b, 0x25, 0xdb, 0x29,
+	0xaf, 0xf3, 0xd3, 0xbf, 0xd1, 0xd3, 0xdc, 0x83, 0xc1, 0xde, 0x7a, 0xd7, 0x32, 0xb8, 0x2c, 0xf8,
+	0x9f, 0x37, 0x1d, 0xf5, 0xf9, 0x86, 0x7b, 0xd2, 0xc7, 0xce, 0x7a, 0x15, 0x77, 0xa5, 0x25, 0x9f,
+	0xcf, 0x37, 0x29, 0x1b, 0x54, 0x87, 0x05, 0x06, 0xbc, 0x6c, 0x5b, 0x3a, 0x76, 0x72, 0xc9, 0x62,
+	0x7c, 0x2d, 0xbd, 0x59, 0x5c, 0x8f, 0x52, 0xb5, 0xf5, 0x12, 0x9d, 0x29, 0x59, 0x3a, 0x2e, 0x27,
+	0xc8, 0xc2, 0x52, 0x5a, 0xf1, 0x7b, 0x88, 0x8c, 0x72, 0x44, 0x46, 0xaa, 0x46, 0xe0, 0xd9, 0x1b,
+	0x90, 0xa3, 0xc9, 0x07, 0x96, 0xae, 0x62, 0xdb, 0xc9, 0xcd, 0x15, 0x85, 0xb5, 0x45, 0x69, 0xd9,
+	0x50, 0x8e, 0xab, 0x81, 0xe1, 0x27, 0x6c, 0x14, 0x55, 0x60, 0x35, 0x8a, 0x8a, 0x00, 0x28, 0xef,
+	0xe9, 0x56, 0xf7, 0x79, 0x6e, 0x9e, 0xd2, 0xdf, 0x55, 0xc7, 0x89, 0x5b, 0xd8, 0x2e, 0x93, 0x29,
+	0xe8, 0x2d, 0x20, 0xec, 0x65, 0x6c, 0x68, 0x8e, 0x43, 0x98, 0x0c, 0x89, 0x53, 0x44, 0x51, 0xa4,
+	0x9b, 0x86, 0x72, 0x5c, 0xe3, 0x83, 0x3e, 0xd1, 0x26, 0xbc, 0xc6, 0xb4, 0x49, 0x3e, 0xd0, 0x1c,
+	0xd7, 0xb2, 0x4f, 0xe4, 0x23, 0xcd, 0x54, 0xad, 0xa3, 0x1c, 0x30, 0x1a, 0x36, 0xf8, 0x84, 0x8d,
+	0x7d, 0x8b, 0x0e, 0xa1, 0xcf, 0xc3, 0x22, 0xd7, 0xa8, 0x7d, 0x65, 0xd0, 0xc5, 0x6e, 0x2e, 0x4d,
+	0xb5, 0x69, 0x81, 0x75, 0x6e, 0xd1, 0x3e, 0xf4, 0x00, 0x6e, 0x70, 0x5c, 0x75, 0xab, 0xe7, 0x31,
+	0x5d, 0xa0, 0x4c, 0x97, 0xd8, 0xc0, 0xb6, 0xd5, 0xe3, 0x0c, 0xdf, 0x86, 0x9c, 0x8a, 0xf7, 0x95,
+	0x81, 0xee, 0x12, 0xf0, 0xfa, 0xba, 0x72, 0x22, 0xe3, 0xe3, 0xbe, 0x65, 0x62, 0xd3, 0xcd, 0x2d,
+	0x32, 0xe0, 0xf8, 0x78, 0x95, 0x0d, 0xd7, 0xf8, 0xe8, 0xa3, 0xf9, 0x1f, 0x7e, 0x50, 0x98, 0xf9,
+	0xaf, 0x0f, 0x0a, 0x33, 0xe2, 0x3e, 0xc0, 0x50, 0x3a, 0x08, 0x41, 0xc2, 0x54, 0x0c, 0x4c, 0x4d,
+	0x24, 0x25, 0xd1, 0xdf, 0xe8, 0x1b, 0x90, 0xee, 0x63, 0x9b, 0x03, 0xe0, 0xe4, 0x62, 0xc5, 0xf8,
+	0x5a, 0x66, 0x73, 0xe5, 0x5c, 0x41, 0x07, 0x09, 0x1e, 0x25, 0xc8, 0x5a, 0xe2, 0x4f, 0xe6, 0x60,
+	0x71, 0x87, 0xce, 0x2b, 0x75, 0xbb, 0xd6, 0xc0, 0x74, 0xd1, 0xef, 0xc3, 0x02, 0x31, 0x74, 0x59,
+	0x61, 0x6d, 0xba, 0x26, 0xd1, 0x20, 0xee, 0x12, 0xa8, 0xcb, 0xe0, 0x4e, 0x60, 0xbd, 0xac, 0x38,
+	0x98, 0xd3, 0x95, 0xef, 0x7e, 0xf8, 0xa2, 0x20, 0x7c, 0xf6, 0xa2, 0x70, 0xf3, 0x44, 0x31, 0xf4,
+	0x47, 0x62, 0x90, 0x87, 0x28, 0xa5, 0xf7, 0x86, 0x33, 0xd1, 0xd7, 0x60, 0xce, 0x50, 0x4c, 0xa5,
+	0x87, 0x6d, 0x6a, 0xb8, 0xa9, 0xf2, 0xca, 0x67, 0x2f, 0x0a, 0xb9, 0x6f, 0x3b, 0x96, 0xf9, 0x48,
+	0xe4, 0x03, 0x5f, 0xb6, 0x0c, 0xcd, 0xc5, 0x46, 0xdf, 0x3d, 0x11, 0x25, 0x6f, 0x32, 0x6a, 0x40,
+	0x86, 0xcb, 0xa0, 0x6b, 0x99, 0xae, 0x6d, 0xe9, 0xb9, 0x38, 0xd5, 0xee, 0x7b, 0xe7, 0x1d, 0xfa,
+	0x31, 0x71, 0x40, 0x5c, 0xbd, 0x17, 0x19, 0x79, 0x85, 0x51, 0xa3, 0x47, 0x90, 0x74, 0x5c, 0xc5,
+	0x1d, 0x38, 0xd4, 0xa4, 0x33, 0x9b, 0x62, 0x34, 0x1f, 0x06, 0x4f, 0x9b, 0xce, 0x94, 0x38, 0x05,
+	0xba, 0x05, 0xb3, 0xd4, 0x99, 0x30, 0xbb, 0x95, 0x58, 0x03, 0xbd, 0x07, 0x49, 0xee, 0xcc, 0x92,
+	0xf4, 0x60, 0xcf, 0x2e, 0x61, 0xce, 0x75, 0xd3, 0xfd, 0xec, 0x45, 0xe1, 0x3e, 0x83, 0x21, 0xe8,
+	0x18, 0xc5, 0x22, 0x43, 0x34, 0xd4, 0x27, 0xf1, 0x85, 0x50, 0x17, 0xd2, 0x6c, 0xab, 0x32, 0x61,
+	0x43, 0x0d, 0x33, 0xb3, 0x59, 0x3c, 0xef, 0x24, 0x9d, 0x93, 0x3e, 0x2e, 0x17, 0x3f, 0x7b, 0x51,
+	0x58, 0xf1, 0x20, 0xf7, 0xc9, 0x83, 0xb0, 0x83, 0xe1, 0xcf, 0x46, 0xf7, 0x60, 0x81, 0x9b, 0xd5,
+	0xbe, 0x76, 0x8c, 0x55, 0x6a, 0xbe, 0xf3, 0x52, 0x9a, 0xf5, 0x6d, 0x91, 0x2e, 0xa2, 0xf4, 0x8a,
+	0xae, 0x5b, 0x47, 0x01, 0xb7, 0xec, 0x8b, 0x29, 0x45, 0xa7, 0x2f, 0xd3, 0xf1, 0xa1, 0x77, 0xf6,
+	0xc4, 0xb0, 0x0c, 0xc9, 0xbe, 0x32, 0x70, 0xb0, 0x4a, 0x8d, 0x74, 0x5e, 0xe2, 0x2d, 0xe2, 0xe9,
+	0x3d, 0xd7, 0x2c, 0xab, 0xb8, 0x6f, 0x39, 0x9a, 0xeb, 0x70, 0xdb, 0xcc, 0x7a, 0x03, 0x55, 0xde,
+	0x4f, 0x0c, 0xdf, 0xb5, 0x15, 0xd3, 0xd9, 0xc7, 0xb6, 0x6c, 0x60, 0xc3, 0x92, 0xfb, 0x8a, 0xeb,
+	0x62, 0xdb, 0xa4, 0x36, 0x9a, 0x92, 0x6e, 0x7a, 0x83, 0x3b, 0xd8, 0xb0, 0x5a, 0x6c, 0x08, 0xed,
+	0x43, 0xee, 0x48, 0x73, 0x0f, 0x54, 0x5b, 0x39, 0x92, 0x95, 0x3e, 0x41, 0x4c, 0xd1, 0xe5, 0xbe,
+	0xa5, 0x6b, 0xdd, 0x13, 0x6a, 0xa7, 0xe9, 0xcd, 0x2f, 0x47, 0xe3, 0xf8, 0x2d, 0x4e, 0x55, 0xe2,
+	0x44, 0x2d, 0x4a, 0x23, 0x2d, 0x1f, 0x45, 0xf6, 0xa3, 0x2f, 0x42, 0x76, 0xcc, 0x0f, 0x64, 0xa8,
+	0x1f, 0x58, 0x52, 0x47, 0x1c, 0x40, 0xfe, 0xfb, 0x1f, 0x14, 0x66, 0x88, 0x61, 0xfe, 0xea, 0x9f,
+	0xde, 0xcc, 0x84, 0xec, 0xb2, 0x2e, 0xfe, 0x61, 0x0c, 0x96, 0xa3, 0x57, 0x46, 0x1a, 0xa4, 0xdc,
+	0x03, 0x1b, 0x3b, 0xc4, 0xd1, 0xe6, 0x04, 0x6a, 0x14, 0x77, 0x3c, 0x83, 0x25, 0x96, 0xe7, 0x1b,
+	0x6c, 0xc5, 0xd2, 0xcc, 0xf2, 0x57, 0x88, 0x56, 0xfe, 0xf4, 0xa3, 0xc2, 0xda, 0x14, 0x5a, 0x49,
+	0x08, 0x1c, 0x69, 0xc8, 0x1d, 0xbd, 0x09, 0xc8, 0xc3, 0xca, 0x91, 0x6d, 0xfc, 0xde, 0x40, 0xb3,
+	0xb1, 0x4a, 0xed, 0x78, 0x51, 0xba, 0xe1, 0x8f, 0x48, 0x7c, 0x00, 0x6d, 0xc3, 0xd2, 0x10, 0x5a,
+	0x76, 0x7f, 0xc6, 0xa7, 0xbf, 0x3f, 0x33, 0x1e, 0x2d, 0xbb, 0x38, 0xc5, 0x1f, 0x08, 0x90, 0x2c,
+	0x2b, 0xce, 0x73, 0xec, 0x0e, 0x0d, 0x50, 0x08, 0x1a, 0xe0, 0x00, 0x88, 0x6a, 0x60, 0xfb, 0x10,
+	0xd3, 0xfb, 0x62, 0x60, 0x6a, 0x2e, 0xf5, 0x8c, 0xd7, 0x8c, 0x47, 0x86, 0x2f, 0xd2, 0xc2, 0xf6,
+	0xae, 0xa9, 0xb9, 0xe2, 0xa7, 0x31, 0x48, 0x73, 0xe3, 0x22, 0x46, 0x8a, 0x4a, 0x61, 0xa3, 0x14,
+	0xa6, 0x33, 0xca, 0x90, 0xc9, 0x0d, 0x9d, 0x53, 0xec, 0x65, 0x9c, 0x13, 0xf3, 0xdd, 0x71, 0x7a,
+	0x41, 0xb1, 0x06, 0xea, 0xfa, 0xce, 0x29, 0x71, 0xfd, 0x88, 0x0c, 0xdd, 0x51, 0x12, 0x3b, 0x5d,
+	0xdb, 0x3a, 0xca, 0xcd, 0xbe, 0x82, 0x45, 0x18, 0x6b, 0xf1, 0xdb, 0x90, 0xe9, 0x70, 0x7b, 0xae,
+	0x62, 0x53, 0x53, 0x74, 0xf4, 0x3b, 0x90, 0xb4, 0xb1, 0xe2, 0x58, 0x26, 0xc7, 0x7a, 0x2d, 0x1a,
+	0xad, 0x00, 0xd5, 0x89, 0x44, 0xe7, 0x4b, 0x9c, 0x8e, 0x78, 0x21, 0x15, 0xbb, 0x8a, 0xa6, 0xb3,
+	0x3b, 0x49, 0xe2, 0x2d, 0xf1, 0x7f, 0x63, 0x80, 0x6a, 0x74, 0xd9, 0x60, 0xa4, 0x83, 0x32, 0x10,
+	0xd3, 0x54, 0x16, 0xb2, 0x4a, 0x31, 0x4d, 0x1d, 0xaa, 0x63, 0x2c, 0xa8, 0x8e, 0xaf, 0xc3, 0xa2,
+	0xa2, 0x1a, 0x9a, 0x49, 0x28, 0x15, 0xd7, 0xb2, 0x79, 0xd0, 0x19, 0xee, 0x24, 0x98, 0x29, 0x06,
+	0x95, 0xd7, 0xab, 0x10, 0x0c, 0x63, 0x8d, 0x76, 0x00, 0xd8, 0x05, 0x72, 0x80, 0x75, 0xf5, 0x25,
+	0xa2, 0xcd, 0xba, 0xe9, 0x4a, 0x29, 0xca, 0xe1, 0x09, 0xd6, 0x55, 0xe2, 0x71, 0x6c, 0x6c, 0x28,
+	0x9a, 0xa9, 0x99, 0xbd, 0x5c, 0xf2, 0xfa, 0xb7, 0x3d, 0xe4, 0x2e, 0xfe, 0x48, 0x00, 0x34, 0x1e,
+	0x65, 0xa2, 0xfb, 0xb0, 0x14, 0x0a, 0x32, 0x7d, 0x71, 0x64, 0x82, 0xdd, 0x75, 0x15, 0xe5, 0x60,
+	0x4e, 0x51, 0x55, 0x1b, 0x3b, 0x0e, 0x17, 0x8e, 0xd7, 0x44, 0x5b, 0x3e, 0xf0, 0xf1, 0x97, 0xc2,
+	0x83, 0x53, 0x8b, 0xff, 0x18, 0x83, 0xac, 0x17, 0x8a, 0xb6, 0xbb, 0x07, 0x58, 0x1d, 0xe8, 0xf8,
+	0x5a, 0x35, 0x64, 0x85, 0xa0, 0xdd, 0xd5, 0xfa, 0x1a, 0xa6, 0x4a, 0x42, 0x66, 0x0c, 0x3b, 0x02,
+	0xc7, 0x98, 0xbd, 0xca, 0x31, 0x50, 0x1e, 0xe6, 0x35, 0xd3, 0xc5, 0xf6, 0xa1, 0xa2, 0xd3, 0xf8,
+	0x25, 0x21, 0xf9, 0x6d, 0x54, 0x80, 0xb4, 0x89, 0x8f, 0x5d, 0xf9, 0x00, 0x6b, 0xbd, 0x03, 0x97,
+	0x86, 0x19, 0x71, 0x09, 0x48, 0xd7, 0x13, 0xda, 0x83, 0x36, 0xe0, 0xa6, 0x2f, 0x32, 0x3f, 0x68,
+	0x77, 0x68, 0xa4, 0x90, 0x90, 0x90, 0x3f, 0xe4, 0xc1, 0xe4, 0x88, 0xff, 0x10, 0x83, 0x1b, 0x1e,
+	0x58, 0xaa, 0x67, 0x98, 0x63, 0xa8, 0x8d, 0xe1, 0x13, 0x8b, 0xc2, 0xe7, 0x1e, 0x2c, 0xec, 0xdb,
+	0x96, 0x21, 0x7b, 0x72, 0x66, 0x20, 0xa6, 0x49, 0x5f, 0x89, 0xcb, 0xfa, 0x73, 0x44, 0xff, 0xfd,
+	0x09, 0x1c, 0x43, 0xd7, 0xf2, 0x86, 0x7f, 0x33, 0x84, 0xe1, 0xb9, 0xca, 0xcc, 0x62, 0x49, 0x0f,
+	0xb4, 0x2f, 0x40, 0xc6, 0xc6, 0x3a, 0x26, 0xd1, 0x2e, 0xc7, 0x26, 0x49, 0xb1, 0x59, 0xe4, 0xbd,
+	0x1c, 0x9e, 0x0a, 0x2c, 0x78, 0xd3, 0x48, 0x66, 0x4e, 0x01, 0x4c, 0x6f, 0xe6, 0xc7, 0x2e, 0xc1,
+	0x8e, 0x97, 0xb6, 0x97, 0x13, 0xef, 0x93, 0x1b, 0x30, 0xcd, 0xa9, 0x48, 0xbf, 0xf8, 0x3f, 0x31,
+	0xb8, 0xd1, 0xc2, 0xa6, 0xaa, 0x99, 0x3d, 0x2f, 0x10, 0x50, 0xf4, 0x29, 0x15, 0xad, 0x1b, 0xd0,
+	0xf5, 0x57, 0xe6, 0x64, 0xc6, 0xa4, 0x95, 0x88, 0x92, 0x56, 0x58, 0x14, 0xb3, 0xa3, 0xa2, 0x88,
+	0x8e, 0x30, 0x92, 0x93, 0x22, 0x8c, 0x15, 0x48, 0xf9, 0x9d, 0xb9, 0xb9, 0x62, 0x9c, 0x30, 0xf3,
+	0x3b, 0x50, 0x15, 0x00, 0x1f, 0xf7, 0x35, 0x16, 0x59, 0xe4, 0xe6, 0x2f, 0x44, 0x9d, 0xc6, 0x1e,
+	0x14, 0xf9, 0x00, 0x9d, 0xf8, 0xa9, 0x00, 0x0b, 0xec, 0xa6, 0xe5, 0xe9, 0x60, 0x74, 0xf4, 0x31,
+	0x9d, 0xb2, 0x36, 0x21, 0xad, 0xda, 0x5a, 0x5f, 0xbe, 0x92, 0xeb, 0x01, 0xc2, 0xa2, 0xc4, 0x50,
+	0xff, 0x2d, 0x48, 0x5e, 0xbe, 0x34, 0xc1, 0x49, 0x48, 0x6a, 0x69, 0x68, 0x5c, 0xed, 0xe7, 0x25,
+	0xfa, 0x5b, 0xfc, 0x13, 0x01, 0xd2, 0xec, 0xa0, 0x15, 0x5d, 0xd1, 0x8c, 0x09, 0xa7, 0x9d, 0xec,
+	0x57, 0xb7, 0x61, 0x49, 0x57, 0x1c, 0x57, 0xee, 0x12, 0x6a, 0xa6, 0xef, 0xf1, 0x4b, 0x20, 0xbf,
+	0x48, 0x88, 0xe9, 0xca, 0x54, 0xeb, 0x7f, 0x20, 0xc0, 0xe2, 0x16, 0x26, 0xe9, 0xc2, 0x21, 0xb6,
+	0x89, 0xef, 0x98, 0xb0, 0x1f, 0x04, 0x89, 0xbe, 0x65, 0x79, 0xf7, 0x37, 0xfd, 0x8d, 0xca, 0x90,
+	0xb0, 0x15, 0x17, 0xe7, 0xe2, 0x2f, 0x55, 0x5d, 0xa1, 0xb4, 0x24, 0x32, 0xb0, 0x6c, 0xa5, 0xab,
+	0x63, 0xae, 0xcd, 0xbc, 0x45, 0x82, 0xbe, 0x05, 0x56, 0x94, 0xaa, 0x1c, 0x28, 0x66, 0x6f, 0x5a,
+	0x8f, 0xbf, 0x0c, 0x49, 0xee, 0x28, 0xe2, 0xd4, 0x51, 0xf0, 0x16, 0x7a, 0x1b, 0x12, 0x14, 0xa9,
+	0xc4, 0x25, 0x90, 0xa2, 0x14, 0xa8, 0x4a, 0xd6, 0xd1, 0x5d, 0xe5, 0x25, 0xdd, 0x3f, 0x23, 0x26,
+	0xb7, 0x48, 0x28, 0x77, 0xbd, 0xf4, 0x2d, 0xc2, 0xa8, 0xc9, 0xa9, 0x95, 0x2e, 0x51, 0xfe, 0x39,
+	0x76, 0x6a, 0xda, 0x08, 0x04, 0x68, 0xf3, 0xe7, 0x05, 0x68, 0x41, 0x3c, 0xc3, 0x01, 0x9a, 0xf8,
+	0x8b, 0x18, 0x2c, 0xb0, 0x94, 0xfe, 0xd7, 0x0c, 0xb7, 0x7f, 0xc0, 0xd9, 0xe0, 0x01, 0x03, 0xd6,
+	0x90, 0x0c, 0x5b, 0xc3, 0x48, 0xa1, 0x66, 0xee, 0x92, 0x85, 0x1a, 0x02, 0x9d, 0xd2, 0x75, 0xb5,
+	0x8b, 0xa0, 0x0b, 0x62, 0x53, 0xa2, 0xf3, 0x25, 0x4e, 0x27, 0xfe, 0xb9, 0x00, 0x99, 0xda, 0x21,
+	0x36, 0x5d, 0x9e, 0x51, 0xaa, 0xea, 0x04, 0x13, 0x5a, 0xf6, 0x2f, 0x09, 0x1e, 0x04, 0xb3, 0x16,
+	0xe9, 0xe7, 0xc9, 0x08, 0xbb, 0x59, 0x79, 0x8b, 0x1c, 0xda, 0xab, 0xe4, 0x30, 0xdb, 0xf0, 0x9a,
+	0x24, 0x5e, 0x08, 0x66, 0x40, 0x0c, 0xaa, 0x40, 0x7e, 0x23, 0xfe, 0xa5, 0x00, 0xb7, 0xc2, 0x7b,
+	0x62, 0x07, 0x40, 0x35, 0x72, 0x5c, 0xf2, 0x8b, 0x57, 0x9e, 0xee, 0x47, 0x1f, 0x37, 0x48, 0x4b,
+	0xa7, 0xfb, 0xf7, 0x32, 0x63, 0x73, 0x85, 0x70, 0x4b, 0x6c, 0xc2, 0x8d, 0x31, 0xf6, 0x41, 0x01,
+	0x0b, 0x61, 0x01, 0x17, 0xc7, 0x2b, 0x71, 0xa9, 0x90, 0x08, 0xc5, 0x3f, 0x80, 0xdb, 0x01, 0x86,
+	0x55, 0xac, 0x63, 0x17, 0x73, 0xb6, 0x34, 0x7e, 0x30, 0xac, 0x43, 0x2c, 0x87, 0xb9, 0x2f, 0xb2,
+	0x5e, 0xef, 0x52, 0xbc, 0xca, 0x71, 0xfe, 0x55, 0x08, 0x2d, 0x1f, 0x32, 0xa2, 0xbb, 0x90, 0xea,
+	0xd2, 0x5f, 0x5e, 0xfc, 0x9c, 0x92, 0xe6, 0x59, 0x47, 0x7d, 0x3a, 0x8b, 0x4a, 0xf9, 0x16, 0xe5,
+	0xdb, 0x45, 0x62, 0x82, 0x5d, 0xcc, 0x9e, 0x0b, 0x5b, 0x72, 0x0c, 0x36, 0xaa, 0x8e, 0x4c, 0xf3,
+	0xe7, 0xb8, 0x3a, 0x32, 0x7d, 0xfe, 0x26, 0xdc, 0x0c, 0x9c, 0x67, 0x4b, 0x33, 0x15, 0x5d, 0xfb,
+	0x0e, 0xbe, 0xca, 0xa5, 0x3c, 0xc2, 0x92, 0xd8, 0xcf, 0xa1, 0xe2, 0x5e, 0x8d, 0xe5, 0xcf, 0x04,
+	0x58, 0x0e, 0xf0, 0xdc, 0xed, 0xab, 0x8a, 0x8b, 0xb7, 0x74, 0xa5, 0xe7, 0x4c, 0x60, 0x3b, 0x5a,
+	0x65, 0x8b, 0x5d, 0xae, 0xca, 0x16, 0x3f, 0xb7, 0xca, 0x36, 0x55, 0x68, 0x36, 0xa2, 0xf9, 0x2d,
+	0x56, 0x88, 0xbb, 0x0a, 0x08, 0x2d, 0x40, 0x01, 0x86, 0x12, 0x76, 0x06, 0xc6, 0x15, 0x39, 0x7e,
+	0x57, 0x80, 0x3b, 0x21, 0x63, 0xa2, 0x15, 0x40, 0xef, 0x98, 0xd1, 0x9c, 0x23, 0x4b, 0x89, 0xb1,
+	0x09, 0xa5, 0xc4, 0xe9, 0x8c, 0xea, 0x2f, 0x04, 0x10, 0x03, 0xdb, 0xe8, 0x04, 0xea, 0x8b, 0x3c,
+	0x34, 0x35, 0x48, 0x6e, 0x16, 0xbd, 0x9f, 0x89, 0xd5, 0xca, 0xd8, 0xe4, 0x6a, 0xe5, 0x74, 0xdb,
+	0xfa, 0x37, 0x01, 0xee, 0x05, 0xb6, 0x35, 0xa1, 0x5e, 0x18, 0xbd, 0xab, 0x95, 0x60, 0x15, 0x31,
+	0xc6, 0xc3, 0xf2, 0x0b, 0x0a, 0x7f, 0x6c, 0x13, 0x11, 0x61, 0xf9, 0xfd, 0xf1, 0xc2, 0x1f, 0xd3,
+	0xb8, 0x91, 0x9a, 0xde, 0xf8, 0xb9, 0x66, 0xa3, 0xce, 0xf5, 0x47, 0x31, 0xc8, 0x47, 0x9c, 0x8b,
+	0x67, 0x43, 0xe4, 0x1b, 0xce, 0x91, 0x9f, 0x11, 0x0d, 0x5d, 0xd9, 0xc2, 0xb0, 0x93, 0xb9, 0x33,
+	0xf2, 0xd5, 0xd2, 0x0b, 0x57, 0x59, 0x63, 0x88, 0x45, 0xfc, 0x5c, 0x5d, 0xbc, 0xde, 0x4c, 0x26,
+	0x12, 0xb2, 0xd5, 0x50, 0xae, 0xc2, 0x5c, 0x5e, 0x30, 0x0b, 0xf9, 0x85, 0x00, 0x77, 0x27, 0xca,
+	0x16, 0xab, 0x53, 0x83, 0x70, 0x95, 0x32, 0xc4, 0x30, 0xd5, 0xe2, 0x29, 0xb4, 0xdf, 0x41, 0xca,
+	0x07, 0xf8, 0x18, 0x77, 0x07, 0x2e, 0x56, 0x79, 0x36, 0xe1, 0xb7, 0xc5, 0x3f, 0x15, 0x22, 0xc5,
+	0x57, 0x23, 0x87, 0xbb, 0xc4, 0xce, 0xa7, 0x16, 0xdf, 0xf9, 0xd9, 0xbe, 0xf8, 0x57, 0x42, 0xc8,
+	0xcf, 0x9d, 0x9b, 0xd4, 0x15, 0xc2, 0xe9, 0x1a, 0x5b, 0x3c, 0x98, 0x7e, 0x2d, 0xfb, 0xe9, 0x17,
+	0xbf, 0x0f, 0x47, 0x32, 0xab, 0xc4, 0x30, 0xb3, 0x9a, 0x52, 0xd9, 0xbf, 0x17, 0xbe, 0x39, 0x46,
+	0x52, 0x31, 0x06, 0x82, 0x10, 0x09, 0x42, 0x6c, 0x24, 0x41, 0xa3, 0x19, 0x18, 0xf6, 0xc4, 0xe9,
+	0x35, 0xd1, 0x1b, 0xb0, 0x44, 0xab, 0x39, 0x81, 0x04, 0x8d, 0xeb, 0x37, 0xe9, 0x1e, 0xa6, 0x5e,
+	0x7d, 0xc8, 0x05, 0xf7, 0xf1, 0x92, 0x49, 0x18, 0x0a, 0x26, 0x61, 0x17, 0x24, 0x55, 0x7f, 0x16,
+	0x8e, 0x55, 0xfc, 0x25, 0xdd, 0x89, 0xb7, 0xc6, 0x2d, 0x98, 0xed, 0x2b, 0x27, 0xde, 0xb7, 0x44,
+	0x89, 0x35, 0x02, 0x91, 0x6c, 0x3c, 0x14, 0xc9, 0xae, 0x40, 0xaa, 0xeb, 0x31, 0xf4, 0xf4, 0xc2,
+	0xef, 0xf0, 0x77, 0x3f, 0x3b, 0xdc, 0xfd, 0xc8, 0x95, 0x58, 0x21, 0x77, 0xaa, 0x7e, 0xa5, 0x0b,
+	0x2c, 0xcc, 0x90, 0x05, 0x83, 0x57, 0x62, 0x88, 0x61, 0x29, 0xc0, 0x70, 0x47, 0x63, 0x3a, 0xc9,
+	0x8f, 0x2f, 0x84, 0x8e, 0x7f, 0x95, 0x30, 0x32, 0xbc, 0x4c, 0x79, 0x60, 0x9b, 0xaf, 0x64, 0x99,
+	0xef, 0x09, 0x70, 0x33, 0xc2, 0x55, 0x5c, 0x4a, 0xf3, 0xa7, 0x73, 0x67, 0x17, 0x38, 0x89, 0xbf,
+	0x17, 0xe0, 0xb5, 0xa0, 0xa0, 0xbc, 0xaa, 0x32, 0x9e, 0x54, 0x7a, 0x4e, 0x8d, 0x95, 0x9e, 0x27,
+	0xe5, 0x53, 0x57, 0xb9, 0x73, 0x72, 0x30, 0xe7, 0xbd, 0xc2, 0xe0, 0x01, 0x35, 0x6f, 0x8a, 0xff,
+	0x2d, 0xc0, 0x6a, 0x60, 0xc3, 0xa3, 0x15, 0x69, 0x92, 0xf6, 0x15, 0x20, 0xed, 0xf0, 0xe6, 0x70,
+	0xd7, 0xe0, 0x75, 0x9d, 0x17, 0xf2, 0x47, 0x5a, 0x53, 0xb0, 0x62, 0xcc, 0x36, 0xeb, 0xb7, 0x89,
+	0xa5, 0x0d, 0xcb, 0xc0, 0xfc, 0x6a, 0xf4, 0x3b, 0xc2, 0x15, 0xed, 0xe4, 0x68, 0x45, 0x7b, 0x0c,
+	0x89, 0xb9, 0x28, 0x4d, 0xf9, 0x79, 0x58, 0x53, 0xbc, 0xf3, 0x5e, 0xf7, 0x21, 0xcf, 0x2f, 0xbe,
+	0x4f, 0xa8, 0x7b, 0xb3, 0x03, 0x47, 0xd5, 0xbd, 0x7f, 0x16, 0x8e, 0xd0, 0x46, 0xa5, 0xc4, 0x1d,
+	0xcc, 0x4b, 0x9e, 0x61, 0xc2, 0x6e, 0xe2, 0x93, 0x76, 0x33, 0x65, 0x5a, 0xf0, 0xa3, 0x18, 0xac,
+	0x44, 0x04, 0xbb, 0xde, 0x9e, 0xa9, 0x5e, 0xf9, 0x01, 0xed, 0x70, 0xbb, 0x5e, 0xd7, 0x2b, 0xb2,
+	0x84, 0x0b, 0xa2, 0xaf, 0xd1, 0x8f, 0x02, 0xc9, 0xf1, 0x8f, 0x02, 0xe3, 0xc5, 0xfb, 0x39, 0x2f,
+	0xf9, 0x0e, 0x16, 0xef, 0xef, 0x8d, 0x14, 0xef, 0xe7, 0x19, 0xa7, 0x60, 0x69, 0xfe, 0xe7, 0x02,
+	0xbc, 0x1e, 0x40, 0x68, 0xec, 0xc3, 0x86, 0xc4, 0xe6, 0x5e, 0x3b, 0x52, 0x17, 0x7c, 0xd6, 0xf8,
+	0x1c, 0x00, 0xbb, 0xf3, 0x55, 0x79, 0xef, 0xc4, 0x83, 0x88, 0xf7, 0x94, 0x4f, 0xc4, 0x7f, 0x0e,
+	0x27, 0x31, 0x63, 0xbb, 0x1e, 0x2a, 0xe3, 0x75, 0xee, 0x79, 0x54, 0x30, 0x89, 0x71, 0xc1, 0x4c,
+	0x17, 0x27, 0xbd, 0x2f, 0x40, 0x21, 0xca, 0x43, 0x6b, 0x96, 0x59, 0xb1, 0x8c, 0xbe, 0x8e, 0x2f,
+	0xe3, 0xab, 0xa3, 0x0d, 0x8a, 0x44, 0x04, 0x8a, 0xe6, 0x85, 0x76, 0xf4, 0x37, 0xf1, 0x7a, 0x36,
+	0x76, 0x07, 0xb6, 0xe9, 0x87, 0x10, 0x7e, 0x5b, 0xfc, 0x63, 0x21, 0x14, 0x32, 0xb1, 0xc7, 0x0a,
+	0x3c, 0xb5, 0xbc, 0xe4, 0x75, 0x99, 0x83, 0x39, 0xfe, 0xcc, 0xc0, 0x0b, 0xdf, 0x78, 0x73, 0x0a,
+	0x00, 0xc5, 0xef, 0x86, 0xe3, 0x28, 0xb6, 0x0f, 0x09, 0xab, 0x18, 0x1b, 0xff, 0x9f, 0xdb, 0xf8,
+	0xbb, 0xb0, 0x8b, 0xf6, 0x3f, 0xf3, 0xbd, 0x82, 0xc0, 0xe1, 0x22, 0x53, 0x18, 0xdd, 0xed, 0xec,
+	0xf8, 0x6e, 0x3f, 0x8d, 0x85, 0x12, 0xac, 0x36, 0x91, 0x9c, 0x69, 0x19, 0x3b, 0xd8, 0x55, 0x54,
+	0xc5, 0x55, 0x48, 0x9a, 0x62, 0xf0, 0xdf, 0x32, 0xf9, 0x6c, 0xe6, 0xa5, 0x29, 0x5e, 0x27, 0x79,
+	0x0e, 0x87, 0x1e, 0xc2, 0x2d, 0x7f, 0x92, 0x8a, 0x9d, 0xae, 0xad, 0xf5, 0x69, 0x3e, 0xc7, 0x53,
+	0x7b, 0x6f, 0xac, 0x3a, 0x1c, 0x22, 0x0f, 0x84, 0x86, 0x24, 0xec, 0x45, 0x10, 0x3f, 0xe2, 0x92,
+	0x3f, 0x9d, 0x75, 0xa3, 0xa7, 0x21, 0xee, 0xe4, 0x35, 0x2b, 0x79, 0xe3, 0xe2, 0xf0, 0x97, 0x03,
+	0xaf, 0x9f, 0x53, 0x2b, 0xa5, 0x47, 0x21, 0xaf, 0x55, 0x24, 0x34, 0xdc, 0x03, 0xef, 0x9a, 0xd2,
+	0xe0, 0x42, 0x00, 0xd0, 0x07, 0x89, 0xc9, 0x30, 0x00, 0x0d, 0xc5, 0xa0, 0x16, 0xe7, 0x4f, 0x72,
+	0x4e, 0x8c, 0x3d, 0x4b, 0xe7, 0x5e, 0x35, 0xe3, 0x75, 0xb7, 0x69, 0xaf, 0xf8, 0x7b, 0xbc, 0x2a,
+	0xed, 0x6f, 0x63, 0x42, 0x14, 0x4c, 0x13, 0x4b, 0xfe, 0x6e, 0x8a, 0xa1, 0xe8, 0xb7, 0x69, 0x79,
+	0x51, 0xd7, 0x14, 0x07, 0x3b, 0xf4, 0xbb, 0x66, 0x4a, 0xf2, 0x9a, 0x0f, 0x7e, 0x2a, 0x00, 0x0c,
+	0xdf, 0xd6, 0xa0, 0x35, 0xb8, 0xbd, 0x53, 0x92, 0xde, 0xa9, 0x49, 0x72, 0xe7, 0x59, 0xab, 0x26,
+	0xef, 0x36, 0xda, 0xad, 0x5a, 0xa5, 0xbe, 0x55, 0xaf, 0x55, 0xb3, 0x33, 0xf9, 0xf4, 0xe9, 0x59,
+	0x71, 0x6e, 0xd7, 0x7c, 0x6e, 0x5a, 0x47, 0x26, 0x5a, 0x85, 0x6c, 0x70, 0x66, 0xa5, 0x59, 0x6f,
+	0x64, 0x85, 0xfc, 0xfc, 0xe9, 0x59, 0x31, 0x41, 0xbe, 0x76, 0xa2, 0x75, 0x58, 0x0e, 0x8e, 0x4b,
+	0xb5, 0x76, 0x47, 0xaa, 0x57, 0x3a, 0xb5, 0x6a, 0x36, 0x96, 0x47, 0xa7, 0x67, 0xc5, 0x8c, 0xe4,
+	0x3f, 0x28, 0xa6, 0xf3, 0x45, 0x40, 0xc1, 0xf9, 0xe5, 0x52, 0xfb, 0x9d, 0x5a, 0x27, 0x1b, 0xcf,
+	0xc3, 0xe9, 0x59, 0x91, 0xbf, 0x66, 0x7a, 0xf0, 0x2f, 0x31, 0x58, 0x08, 0x3e, 0xe5, 0x41, 0x9b,
+	0x70, 0x87, 0x13, 0xb5, 0x3b, 0xa5, 0xce, 0x6e, 0x7b, 0x64, 0xc3, 0x37, 0x4f, 0xcf, 0x8a, 0x4b,
+	0x6c, 0xea, 0xae, 0xa9, 0xe2, 0x7d, 0xcd, 0xc4, 0x6a, 0x60, 0x63, 0x9c, 0xa6, 0x25, 0x35, 0x5b,
+	0xcd, 0x76, 0xad, 0x9a, 0x15, 0xd8, 0xc6, 0x18, 0x41, 0xcb, 0xb6, 0xfa, 0x16, 0xb9, 0x8a, 0xbe,
+	0x02, 0xb7, 0xc3, 0xf3, 0xb7, 0xea, 0x8d, 0xd2, 0x76, 0xfd, 0x5d, 0x7a, 0x92, 0xc0, 0x0a, 0x5e,
+	0x81, 0x55, 0x45, 0x0f, 0xe0, 0x56, 0x98, 0xa2, 0x54, 0xe9, 0xd4, 0x9f, 0xd6, 0xb2, 0xf1, 0x7c,
+	0xf6, 0xf4, 0xac, 0xb8, 0xc0, 0xa6, 0xd3, 0xe2, 0x29, 0x1e, 0xe7, 0x5e, 0x29, 0x35, 0x2a, 0xb5,
+	0xed, 0xed, 0x5a, 0x35, 0x9b, 0x08, 0x72, 0x67, 0x77, 0x8c, 0x1e, 0xb5, 0x9f, 0x2a, 0x81, 0xb6,
+	0xf9, 0xac, 0x56, 0xcd, 0xce, 0x06, 0x29, 0xaa, 0x04, 0x5f, 0xeb, 0x04, 0xab, 0xf9, 0xf9, 0xef,
+	0xff, 0x78, 0x75, 0xe6, 0x27, 0x7f, 0xb3, 0x3a, 0xf3, 0xe0, 0xaf, 0x67, 0x01, 0x8d, 0xbf, 0xee,
+	0x41, 0x5f, 0x85, 0x62, 0x47, 0x2a, 0x35, 0xda, 0x5b, 0x35, 0x49, 0xae, 0xd6, 0x1a, 0xcf, 0x64,
+	0xa9, 0x56, 0x6a, 0x37, 0x1b, 0x23, 0x68, 0x2e, 0x9d, 0x9e, 0x15, 0xd3, 0xbb, 0xa6, 0xd3, 0xc7,
+	0x5d, 0x6d, 0x5f, 0xc3, 0x2a, 0xfa, 0x3a, 0x7c, 0x21, 0x92, 0x8c, 0x6f, 0xaf, 0xd1, 0xec, 0xc8,
+	0x5b, 0xcd, 0xdd, 0x86, 0x0f, 0x2c, 0x13, 0x5d, 0xc3, 0x72, 0xb7, 0xac, 0x81, 0xa9, 0xa2, 0x47,
+	0xf0, 0xf9, 0x48, 0x72, 0x42, 0x17, 0x52, 0x97, 0x1b, 0xa7, 0x67, 0xc5, 0xc5, 0x86, 0xe5, 0x0e,
+	0x35, 0x06, 0xfd, 0x36, 0xbc, 0x31, 0x81, 0x56, 0xf6, 0xfb, 0x1f, 0x4b, 0xa5, 0x06, 0xd1, 0x20,
+	0x8a, 0x49, 0xc3, 0xf2, 0xce, 0x4d, 0x9f, 0xba, 0xa2, 0x6f, 0x4c, 0xd8, 0x7b, 0xa3, 0x29, 0x97,
+	0x76, 0x3b, 0x4f, 0x9a, 0x52, 0xfd, 0xdd, 0x52, 0xa7, 0xde, 0x6c, 0x78, 0x52, 0x68, 0x58, 0xa5,
+	0x81, 0x7b, 0x60, 0xd9, 0xda, 0x77, 0x68, 0x95, 0x09, 0x55, 0x61, 0x2d, 0x92, 0x3e, 0x44, 0x2c,
+	0x6f, 0xd7, 0x77, 0xea, 0x9d, 0xec, 0x6c, 0x7e, 0xf9, 0xf4, 0xac, 0x88, 0x42, 0x0c, 0xb6, 0x35,
+	0x43, 0x73, 0xd1, 0x57, 0xe1, 0x5e, 0x24, 0x97, 0x66, 0x83, 0x35, 0xb7, 0xeb, 0xed, 0x4e, 0x36,
+	0x99, 0xcf, 0x9c, 0x9e, 0x15, 0xa1, 0x69, 0x12, 0x89, 0x6d, 0x6b, 0x8e, 0x8b, 0xca, 0x70, 0x3f,
+	0x92, 0xac, 0xde, 0x68, 0xef, 0x6e, 0x6d, 0xd5, 0x2b, 0xf5, 0x5a, 0xa3, 0x23, 0x6f, 0xed, 0x36,
+	0xaa, 0xed, 0xec, 0x5c, 0xfe, 0xb5, 0xd3, 0xb3, 0xe2, 0x8d, 0xba, 0xe9, 0x0c, 0xf6, 0xf7, 0xb5,
+	0x2e, 0x09, 0xc6, 0xb7, 0x06, 0xa6, 0xea, 0xa0, 0x2f, 0xc1, 0xdd, 0x48, 0x1e, 0xad, 0xd2, 0x2e,
+	0xb1, 0x85, 0x79, 0x66, 0x78, 0xbc, 0xb6, 0xfd, 0xf5, 0xc9, 0x68, 0x55, 0x6b, 0xad, 0x66, 0xbb,
+	0xde, 0xe1, 0x68, 0xa7, 0x98, 0xa4, 0x1b, 0x16, 0xbf, 0xcc, 0x19, 0xd8, 0x93, 0xd6, 0x7a, 0x5a,
+	0xeb, 0x34, 0x6b, 0xd5, 0x2c, 0xb0, 0xb5, 0x9e, 0x62, 0xd7, 0xc2, 0xea, 0x83, 0x5f, 0x09, 0x80,
+	0xc6, 0x3f, 0x70, 0x12, 0x1d, 0x6d, 0xef, 0xb6, 0x5a, 0xdb, 0xcf, 0xe4, 0xca, 0x93, 0x52, 0xe3,
+	0x71, 0x6d, 0x4a, 0x1d, 0xbd, 0x0f, 0x77, 0x22, 0xc9, 0x76, 0xea, 0x8d, 0x8e, 0xe7, 0xaf, 0x68,
+	0x2d, 0x60, 0xd2, 0xc4, 0xf2, 0xae, 0xd4, 0xc8, 0xc6, 0xd8, 0x44, 0x9a, 0xcd, 0xbf, 0x05, 0x85,
+	0xc8, 0x89, 0x8f, 0x9b, 0x4f, 0x6b, 0x52, 0x83, 0x58, 0x6f, 0x36, 0xce, 0x24, 0x36, 0xfc, 0xbc,
+	0xf0, 0xe0, 0xc7, 0x02, 0xa0, 0xf1, 0x4f, 0x8f, 0xe4, 0x50, 0xa5, 0x4a, 0xa5, 0xd6, 0x6e, 0x7b,
+	0xbc, 0x88, 0xa7, 0xb8, 0xf8, 0x50, 0x5f, 0x84, 0x7c, 0x24, 0x19, 0x93, 0x81, 0x90, 0x4f, 0x9d,
+	0x9e, 0x15, 0x67, 0x7d, 0xe8, 0x23, 0xa7, 0x4a, 0xb5, 0xa7, 0xcd, 0x77, 0x6a, 0xd9, 0x18, 0x83,
+	0x5e, 0xc2, 0x87, 0xd6, 0x73, 0x5c, 0xee, 0xfd, 0xf2, 0xe3, 0x55, 0xe1, 0xc3, 0x8f, 0x57, 0x85,
+	0xff, 0xfc, 0x78, 0x55, 0x78, 0xff, 0x93, 0xd5, 0x99, 0x0f, 0x3f, 0x59, 0x9d, 0xf9, 0xf7, 0x4f,
+	0x56, 0x67, 0xe0, 0xb6, 0x66, 0x45, 0x5e, 0x9a, 0x2d, 0xe1, 0xdd, 0xcd, 0xc0, 0x97, 0xef, 0xe1,
+	0x94, 0x37, 0x35, 0x2b, 0xd0, 0xda, 0x38, 0xf6, 0xfe, 0xe5, 0x85, 0x7e, 0x09, 0xdf, 0x4b, 0xd2,
+	0x0f, 0xc8, 0x6f, 0xfd, 0xdf, 0x00, 0x4c, 0xca, 0x14, 0xb8, 0xff, 0x33, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefaultDisplayExponent != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.DefaultDisplayExponent))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AccessLogWindow != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.AccessLogWindow))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EnableFaucet {
+		i--
+		if m.EnableFaucet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SupplyHistoryWindow != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.SupplyHistoryWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxEmissionPerBlock != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxEmissionPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DistributionHoldersPerBlock != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.DistributionHoldersPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxDistributionHolders != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxDistributionHolders))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AccessRoles) > 0 {
+		for iNdEx := len(m.AccessRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.ExpeditedQuorum.Size()
+		i -= size
+		if _, err := m.ExpeditedQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMarker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EnableGovernance {
+		i--
+		if m.EnableGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintMarker(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisplayExponent != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.DisplayExponent))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.WithdrawApprovalPolicy != nil {
+		{
+			size, err := m.WithdrawApprovalPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.TransferMemoPattern) > 0 {
+		i -= len(m.TransferMemoPattern)
+		copy(dAtA[i:], m.TransferMemoPattern)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TransferMemoPattern)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RestrictDeposits {
+		i--
+		if m.RestrictDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccessControl) > 0 {
+		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ApprovalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ApprovalPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMarker(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.ApprovalsRequired != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ApprovalsRequired))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Basket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Basket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Basket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservePerUnit) > 0 {
+		for iNdEx := len(m.ReservePerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservePerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferDenial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferDenial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferDenial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TotalHeld.Size()
+		i -= size
+		if _, err := m.TotalHeld.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingEmissions != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.RemainingEmissions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleaseTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMarker(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMarker(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ApprovalsRequired != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ApprovalsRequired))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerFaucet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerFaucet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerFaucet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMarker(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DripAmount.Size()
+		i -= size
+		if _, err := m.DripAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FaucetClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FaucetClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FaucetClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintMarker(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintMarker(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Permissions) > 0 {
+		dAtA14 := make([]byte, len(m.Permissions)*10)
+		var j13 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintMarker(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMarker(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarkerType) > 0 {
+		i -= len(m.MarkerType)
+		copy(dAtA[i:], m.MarkerType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddress) > 0 {
+		i -= len(m.RemoveAddress)
+		copy(dAtA[i:], m.RemoveAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccessChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccessChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccessChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChangeId) > 0 {
+		i -= len(m.ChangeId)
+		copy(dAtA[i:], m.ChangeId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ChangeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerUpdateFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerUpdateFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerUpdateFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDepositControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDepositControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDepositControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestrictDeposits {
+		i--
+		if m.RestrictDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransferMemoRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransferMemoRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransferMemoRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TransferMemoPattern) > 0 {
+		i -= len(m.TransferMemoPattern)
+		copy(dAtA[i:], m.TransferMemoPattern)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TransferMemoPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApprovalPeriod) > 0 {
+		i -= len(m.ApprovalPeriod)
+		copy(dAtA[i:], m.ApprovalPeriod)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ApprovalPeriod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApprovalsRequired) > 0 {
+		i -= len(m.ApprovalsRequired)
+		copy(dAtA[i:], m.ApprovalsRequired)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ApprovalsRequired)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdrawPending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdrawPending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdrawPending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApprovalsRequired) > 0 {
+		i -= len(m.ApprovalsRequired)
+		copy(dAtA[i:], m.ApprovalsRequired)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ApprovalsRequired)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawalId) > 0 {
+		i -= len(m.WithdrawalId)
+		copy(dAtA[i:], m.WithdrawalId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.WithdrawalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdrawApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdrawApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdrawApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		i -= len(m.Approvals)
+		copy(dAtA[i:], m.Approvals)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Approvals)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawalId) > 0 {
+		i -= len(m.WithdrawalId)
+		copy(dAtA[i:], m.WithdrawalId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.WithdrawalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdrawExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdrawExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdrawExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawalId) > 0 {
+		i -= len(m.WithdrawalId)
+		copy(dAtA[i:], m.WithdrawalId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.WithdrawalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFaucet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFaucet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFaucet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DripAmount) > 0 {
+		i -= len(m.DripAmount)
+		copy(dAtA[i:], m.DripAmount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DripAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFaucetClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFaucetClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFaucetClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextClaimTime) > 0 {
+		i -= len(m.NextClaimTime)
+		copy(dAtA[i:], m.NextClaimTime)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.NextClaimTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFeeConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFeeConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFeeConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFeeConverted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFeeConverted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFeeConverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Converted) > 0 {
+		i -= len(m.Converted)
+		copy(dAtA[i:], m.Converted)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Converted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		i -= len(m.Holders)
+		copy(dAtA[i:], m.Holders)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Holders)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerEmissionScheduleAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerEmissionScheduleAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerEmissionScheduleAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Emissions) > 0 {
+		i -= len(m.Emissions)
+		copy(dAtA[i:], m.Emissions)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Emissions)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingEmissions) > 0 {
+		i -= len(m.RemainingEmissions)
+		copy(dAtA[i:], m.RemainingEmissions)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemainingEmissions)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerEmissionScheduleCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerEmissionScheduleCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerEmissionScheduleCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemainingEmissions) > 0 {
+		i -= len(m.RemainingEmissions)
+		copy(dAtA[i:], m.RemainingEmissions)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemainingEmissions)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransferScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransferScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransferScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseTime) > 0 {
+		i -= len(m.ReleaseTime)
+		copy(dAtA[i:], m.ReleaseTime)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ReleaseTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReleaseHeight) > 0 {
+		i -= len(m.ReleaseHeight)
+		copy(dAtA[i:], m.ReleaseHeight)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ReleaseHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferId) > 0 {
+		i -= len(m.TransferId)
+		copy(dAtA[i:], m.TransferId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TransferId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerScheduledTransferReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerScheduledTransferReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerScheduledTransferReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedBy) > 0 {
+		i -= len(m.ClaimedBy)
+		copy(dAtA[i:], m.ClaimedBy)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ClaimedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferId) > 0 {
+		i -= len(m.TransferId)
+		copy(dAtA[i:], m.TransferId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TransferId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerScheduledTransferCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerScheduledTransferCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerScheduledTransferCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferId) > 0 {
+		i -= len(m.TransferId)
+		copy(dAtA[i:], m.TransferId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TransferId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDistributionComplete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDistributionComplete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDistributionComplete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Returned) > 0 {
+		i -= len(m.Returned)
+		copy(dAtA[i:], m.Returned)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Returned)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Paid) > 0 {
+		i -= len(m.Paid)
+		copy(dAtA[i:], m.Paid)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Paid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBasketDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBasketDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBasketDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reserve) > 0 {
+		i -= len(m.Reserve)
+		copy(dAtA[i:], m.Reserve)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reserve)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBasketRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBasketRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBasketRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reserve) > 0 {
+		i -= len(m.Reserve)
+		copy(dAtA[i:], m.Reserve)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Reserve)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataSymbol) > 0 {
+		i -= len(m.MetadataSymbol)
+		copy(dAtA[i:], m.MetadataSymbol)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MetadataName) > 0 {
+		i -= len(m.MetadataName)
+		copy(dAtA[i:], m.MetadataName)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MetadataDisplay) > 0 {
+		i -= len(m.MetadataDisplay)
+		copy(dAtA[i:], m.MetadataDisplay)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetadataDescription) > 0 {
+		i -= len(m.MetadataDescription)
+		copy(dAtA[i:], m.MetadataDescription)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataBase) > 0 {
+		i -= len(m.MetadataBase)
+		copy(dAtA[i:], m.MetadataBase)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Exponent) > 0 {
+		i -= len(m.Exponent)
+		copy(dAtA[i:], m.Exponent)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
+	}
+	if m.EnableGovernance {
+		n += 2
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.ExpeditedQuorum.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if len(m.AccessRoles) > 0 {
+		for _, e := range m.AccessRoles {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.MaxDistributionHolders != 0 {
+		n += 1 + sovMarker(uint64(m.MaxDistributionHolders))
+	}
+	if m.DistributionHoldersPerBlock != 0 {
+		n += 1 + sovMarker(uint64(m.DistributionHoldersPerBlock))
+	}
+	if m.MaxEmissionPerBlock != 0 {
+		n += 1 + sovMarker(uint64(m.MaxEmissionPerBlock))
+	}
+	if m.SupplyHistoryWindow != 0 {
+		n += 1 + sovMarker(uint64(m.SupplyHistoryWindow))
+	}
+	if m.EnableFaucet {
+		n += 2
+	}
+	if m.AccessLogWindow != 0 {
+		n += 1 + sovMarker(uint64(m.AccessLogWindow))
+	}
+	if m.DefaultDisplayExponent != 0 {
+		n += 1 + sovMarker(uint64(m.DefaultDisplayExponent))
+	}
+	return n
+}
+
+func (m *AccessRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovMarker(uint64(e))
+		}
+		n += 1 + sovMarker(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MarkerAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AccessControl) > 0 {
+		for _, e := range m.AccessControl {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.MarkerType != 0 {
+		n += 1 + sovMarker(uint64(m.MarkerType))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.RestrictDeposits {
+		n += 2
+	}
+	l = len(m.TransferMemoPattern)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.WithdrawApprovalPolicy != nil {
+		l = m.WithdrawApprovalPolicy.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.DisplayExponent != 0 {
+		n += 1 + sovMarker(uint64(m.DisplayExponent))
+	}
+	return n
+}
+
+func (m *WithdrawApprovalPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.ApprovalsRequired != 0 {
+		n += 1 + sovMarker(uint64(m.ApprovalsRequired))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ApprovalPeriod)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *Basket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.ReservePerUnit) > 0 {
+		for _, e := range m.ReservePerUnit {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkerTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarkerType != 0 {
+		n += 1 + sovMarker(uint64(m.MarkerType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMarker(uint64(m.Count))
+	}
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferDenial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovMarker(uint64(m.Reason))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EscrowDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = m.TotalHeld.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovMarker(uint64(m.DistributionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *EmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovMarker(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovMarker(uint64(m.NextHeight))
+	}
+	if m.RemainingEmissions != 0 {
+		n += 1 + sovMarker(uint64(m.RemainingEmissions))
+	}
+	return n
+}
+
+func (m *ScheduledTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovMarker(uint64(m.ReleaseHeight))
+	}
+	if m.ReleaseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleaseTime)
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.ApprovalsRequired != 0 {
+		n += 1 + sovMarker(uint64(m.ApprovalsRequired))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *MarkerFaucet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.DripAmount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Mint {
+		n += 2
+	}
+	return n
+}
+
+func (m *FaucetClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *FeeConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarker(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMarker(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *AccessChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarker(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarker(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovMarker(uint64(e))
+		}
+		n += 1 + sovMarker(uint64(l)) + l
+	}
+	if m.Action != 0 {
+		n += 1 + sovMarker(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *EventMarkerAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAddAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Access.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerDeleteAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoveAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccessChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChangeId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerUpdateFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDepositControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.RestrictDeposits {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransferMemoRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.TransferMemoPattern)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdrawApprovalPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ApprovalsRequired)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ApprovalPeriod)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdrawPending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawalId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ApprovalsRequired)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdrawApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawalId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Approvals)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMarkerWithdrawExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawalId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFaucet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.DripAmount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Mint {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFaucetClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.NextClaimTime)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFeeConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFeeConverted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Converted)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Holders)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerEmissionScheduleAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Emissions)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.RemainingEmissions)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerEmissionScheduleCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.RemainingEmissions)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransferScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransferId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ReleaseHeight)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ReleaseTime)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerScheduledTransferReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransferId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ClaimedBy)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerScheduledTransferCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransferId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDistributionComplete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Paid)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Returned)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBasketDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reserve)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBasketRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Reserve)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataBase)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDescription)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDisplay)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for _, e := range m.MetadataDenomUnits {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataName)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataSymbol)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Exponent)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMarker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarker(x uint64) (n int) {
+	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovernance = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessRoles = append(m.AccessRoles, AccessRole{})
+			if err := m.AccessRoles[len(m.AccessRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDistributionHolders", wireType)
+			}
+			m.MaxDistributionHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDistributionHolders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHoldersPerBlock", wireType)
+			}
+			m.DistributionHoldersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionHoldersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEmissionPerBlock", wireType)
+			}
+			m.MaxEmissionPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEmissionPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryWindow", wireType)
+			}
+			m.SupplyHistoryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableFaucet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableFaucet = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLogWindow", wireType)
+			}
+			m.AccessLogWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLogWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDisplayExponent", wireType)
+			}
+			m.DefaultDisplayExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultDisplayExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Access
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMarker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Access(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMarker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMarker
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMarker
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Access, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Access
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMarker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Access(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControl = append(m.AccessControl, AccessGrant{})
+			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictDeposits = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMemoPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMemoPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawApprovalPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawApprovalPolicy == nil {
+				m.WithdrawApprovalPolicy = &WithdrawApprovalPolicy{}
+			}
+			if err := m.WithdrawApprovalPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayExponent", wireType)
+			}
+			m.DisplayExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisplayExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawApprovalPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawApprovalPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types1.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalsRequired", wireType)
+			}
+			m.ApprovalsRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalsRequired |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ApprovalPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Basket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Basket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Basket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservePerUnit = append(m.ReservePerUnit, types1.Coin{})
+			if err := m.ReservePerUnit[len(m.ReservePerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types1.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types1.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferDenial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferDenial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferDenial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= TransferDenyReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHeld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHeld.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types1.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEmissions", wireType)
+			}
+			m.RemainingEmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingEmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleaseTime == nil {
+				m.ReleaseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalsRequired", wireType)
+			}
+			m.ApprovalsRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalsRequired |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerFaucet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerFaucet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerFaucet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DripAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DripAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mint = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FaucetClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FaucetClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FaucetClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SupplyChangeReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v Access
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMarker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Access(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMarker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMarker
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMarker
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Access, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Access
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMarker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Access(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= AccessChangeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccessChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccessChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccessChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerUpdateFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerUpdateFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerUpdateFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDepositControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDepositControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDepositControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictDeposits = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerTransferMemoRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerTransferMemoRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerTransferMemoRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMemoPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMemoPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdrawApprovalPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdrawApprovalPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdrawApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalsRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalsRequired = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdrawPending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdrawPending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdrawPending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalsRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalsRequired = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdrawApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdrawApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdrawApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdrawExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdrawExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdrawExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFaucet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFaucet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFaucet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DripAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DripAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mint = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFaucetClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFaucetClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFaucetClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextClaimTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFeeConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFeeConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFeeConverted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFeeConverted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFeeConverted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMarker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarker(x uint64) (n int) {
-	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerDistribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2432,17 +17162,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerDistribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerDistribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			m.MaxTotalSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2452,16 +17182,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2471,15 +17214,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovernance = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,7 +17262,71 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2530,7 +17349,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerEmissionScheduleAdd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2553,17 +17372,17 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerEmissionScheduleAdd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerEmissionScheduleAdd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2573,31 +17392,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2625,13 +17440,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2641,48 +17456,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControl = append(m.AccessControl, AccessGrant{})
-			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2710,11 +17504,11 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2742,15 +17536,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Emissions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.MarkerType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2760,36 +17552,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2799,12 +17584,24 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -2826,7 +17623,7 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerEmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2849,15 +17646,15 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerEmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerEmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2885,11 +17682,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2917,11 +17714,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2949,11 +17746,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2981,11 +17778,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEmissions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3013,7 +17810,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			m.RemainingEmissions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3036,7 +17833,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerEmissionScheduleCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3059,17 +17856,17 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerEmissionScheduleCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerEmissionScheduleCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3079,24 +17876,23 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3131,6 +17927,38 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEmissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingEmissions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -3183,7 +18011,7 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerTransferScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3206,15 +18034,15 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerTransferScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerTransferScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3242,11 +18070,11 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TransferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3274,61 +18102,107 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3356,11 +18230,11 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3388,11 +18262,11 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ReleaseHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3420,7 +18294,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.ReleaseTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3443,7 +18317,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerScheduledTransferReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,15 +18340,47 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerScheduledTransferReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerScheduledTransferReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3502,11 +18408,11 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3534,61 +18440,11 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3616,11 +18472,11 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3648,7 +18504,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.ClaimedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3671,7 +18527,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerScheduledTransferCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3694,15 +18550,15 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerScheduledTransferCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerScheduledTransferCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3730,11 +18586,11 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TransferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3762,61 +18618,43 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3844,9 +18682,9 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -3899,7 +18737,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerDistributionComplete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,15 +18760,15 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerDistributionComplete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerDistributionComplete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3958,7 +18796,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3994,7 +18832,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4022,7 +18860,39 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Paid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returned = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4045,7 +18915,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerBasketDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4068,10 +18938,10 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerBasketDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerBasketDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4140,7 +19010,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4168,7 +19038,39 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Reserve = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4191,7 +19093,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerBasketRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4214,15 +19116,15 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerBasketRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerBasketRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4250,7 +19152,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4286,7 +19188,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4314,11 +19216,11 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Reserve = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4346,7 +19248,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,18 +11,37 @@ import (
 )
 
 const (
-	TypeAddMarkerRequest    = "addmarker"
-	TypeAddAccessRequest    = "addaccess"
-	TypeDeleteAccessRequest = "deleteaccess"
-	TypeFinalizeRequest     = "finalize"
-	TypeActivateRequest     = "activate"
-	TypeCancelRequest       = "cancel"
-	TypeDeleteRequest       = "delete"
-	TypeMintRequest         = "mint"
-	TypeBurnRequest         = "burn"
-	TypeWithdrawRequest     = "withdraw"
-	TypeTransferRequest     = "transfer"
-	TypeSetMetadataRequest  = "setmetadata"
+	TypeAddMarkerRequest           = "addmarker"
+	TypeAddAccessRequest           = "addaccess"
+	TypeDeleteAccessRequest        = "deleteaccess"
+	TypeFinalizeRequest            = "finalize"
+	TypeActivateRequest            = "activate"
+	TypeCancelRequest              = "cancel"
+	TypeDeleteRequest              = "delete"
+	TypeMintRequest                = "mint"
+	TypeBurnRequest                = "burn"
+	TypeWithdrawRequest            = "withdraw"
+	TypeTransferRequest            = "transfer"
+	TypeSetMetadataRequest         = "setmetadata"
+	TypeUpdateFlagsRequest         = "updateflags"
+	TypeDepositAndMintRequest      = "depositandmint"
+	TypeBurnAndRedeemRequest       = "burnandredeem"
+	TypeDistributeEscrow           = "distributeescrow"
+	TypeAddEmissionSchedule        = "addemissionschedule"
+	TypeCancelEmissionSchedule     = "cancelemissionschedule"
+	TypeScheduleTransfer           = "scheduletransfer"
+	TypeCancelScheduledTransfer    = "cancelscheduledtransfer"
+	TypeClaimScheduledTransfer     = "claimscheduledtransfer"
+	TypePauseMarker                = "pausemarker"
+	TypeResumeMarker               = "resumemarker"
+	TypeSetDepositControl          = "setdepositcontrol"
+	TypeSetTransferMemoRequirement = "settransfermemorequirement"
+	TypeSetWithdrawApprovalPolicy  = "setwithdrawapprovalpolicy"
+	TypeApproveWithdraw            = "approvewithdraw"
+	TypeSetFaucet                  = "setfaucet"
+	TypeClaimFaucet                = "claimfaucet"
+	TypeSetFeeConversionRate       = "setfeeconversionrate"
+	TypeSweepEscrow                = "sweepescrow"
 )
 
 // Compile time interface check.
@@ -37,6 +57,26 @@ var (
 	_ sdk.Msg = &MsgBurnRequest{}
 	_ sdk.Msg = &MsgWithdrawRequest{}
 	_ sdk.Msg = &MsgTransferRequest{}
+	_ sdk.Msg = &MsgSetDenomMetadataRequest{}
+	_ sdk.Msg = &MsgUpdateMarkerFlagsRequest{}
+	_ sdk.Msg = &MsgDepositAndMintRequest{}
+	_ sdk.Msg = &MsgBurnAndRedeemRequest{}
+	_ sdk.Msg = &MsgDistributeEscrowRequest{}
+	_ sdk.Msg = &MsgAddEmissionScheduleRequest{}
+	_ sdk.Msg = &MsgCancelEmissionScheduleRequest{}
+	_ sdk.Msg = &MsgScheduleTransferRequest{}
+	_ sdk.Msg = &MsgCancelScheduledTransferRequest{}
+	_ sdk.Msg = &MsgClaimScheduledTransferRequest{}
+	_ sdk.Msg = &MsgPauseMarkerRequest{}
+	_ sdk.Msg = &MsgResumeMarkerRequest{}
+	_ sdk.Msg = &MsgSetDepositControlRequest{}
+	_ sdk.Msg = &MsgSetTransferMemoRequirementRequest{}
+	_ sdk.Msg = &MsgSetWithdrawApprovalPolicyRequest{}
+	_ sdk.Msg = &MsgApproveWithdrawRequest{}
+	_ sdk.Msg = &MsgSetFaucetRequest{}
+	_ sdk.Msg = &MsgClaimFaucetRequest{}
+	_ sdk.Msg = &MsgSetFeeConversionRateRequest{}
+	_ sdk.Msg = &MsgSweepEscrowRequest{}
 )
 
 // Type returns the message action.
@@ -75,6 +115,63 @@ func (msg MsgTransferRequest) Type() string { return TypeTransferRequest }
 // Type returns the message action.
 func (msg MsgSetDenomMetadataRequest) Type() string { return TypeSetMetadataRequest }
 
+// Type returns the message action.
+func (msg MsgUpdateMarkerFlagsRequest) Type() string { return TypeUpdateFlagsRequest }
+
+// Type returns the message action.
+func (msg MsgDepositAndMintRequest) Type() string { return TypeDepositAndMintRequest }
+
+// Type returns the message action.
+func (msg MsgBurnAndRedeemRequest) Type() string { return TypeBurnAndRedeemRequest }
+
+// Type returns the message action.
+func (msg MsgDistributeEscrowRequest) Type() string { return TypeDistributeEscrow }
+
+// Type returns the message action.
+func (msg MsgAddEmissionScheduleRequest) Type() string { return TypeAddEmissionSchedule }
+
+// Type returns the message action.
+func (msg MsgCancelEmissionScheduleRequest) Type() string { return TypeCancelEmissionSchedule }
+
+// Type returns the message action.
+func (msg MsgScheduleTransferRequest) Type() string { return TypeScheduleTransfer }
+
+// Type returns the message action.
+func (msg MsgCancelScheduledTransferRequest) Type() string { return TypeCancelScheduledTransfer }
+
+// Type returns the message action.
+func (msg MsgClaimScheduledTransferRequest) Type() string { return TypeClaimScheduledTransfer }
+
+// Type returns the message action.
+func (msg MsgPauseMarkerRequest) Type() string { return TypePauseMarker }
+
+// Type returns the message action.
+func (msg MsgResumeMarkerRequest) Type() string { return TypeResumeMarker }
+
+// Type returns the message action.
+func (msg MsgSetDepositControlRequest) Type() string { return TypeSetDepositControl }
+
+// Type returns the message action.
+func (msg MsgSetTransferMemoRequirementRequest) Type() string { return TypeSetTransferMemoRequirement }
+
+// Type returns the message action.
+func (msg MsgSetWithdrawApprovalPolicyRequest) Type() string { return TypeSetWithdrawApprovalPolicy }
+
+// Type returns the message action.
+func (msg MsgApproveWithdrawRequest) Type() string { return TypeApproveWithdraw }
+
+// Type returns the message action.
+func (msg MsgSetFaucetRequest) Type() string { return TypeSetFaucet }
+
+// Type returns the message action.
+func (msg MsgClaimFaucetRequest) Type() string { return TypeClaimFaucet }
+
+// Type returns the message action.
+func (msg MsgSetFeeConversionRateRequest) Type() string { return TypeSetFeeConversionRate }
+
+// Type returns the message action.
+func (msg MsgSweepEscrowRequest) Type() string { return TypeSweepEscrow }
+
 // NewMsgAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
 func NewMsgAddMarkerRequest(
 	denom string, totalSupply sdk.Int, fromAddress sdk.AccAddress, manager sdk.AccAddress, markerType MarkerType, supplyFixed bool, allowGovernanceControl bool, // nolint:interfacer
@@ -112,6 +209,18 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if msg.MarkerType == MarkerType_Basket {
+		if err := NewBasket(msg.Amount.Denom, msg.BasketReservePerUnit).Validate(); err != nil {
+			return fmt.Errorf("invalid basket marker: %w", err)
+		}
+	} else if len(msg.BasketReservePerUnit) > 0 {
+		return fmt.Errorf("a basket reserve can only be defined for basket markers")
+	}
+	if msg.DisplayExponent > 0 {
+		if _, err := DeriveDenomMetadata(msg.Amount.Denom, msg.DisplayExponent); err != nil {
+			return fmt.Errorf("invalid display exponent: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -140,6 +249,15 @@ func NewMsgAddAccessRequest(denom string, admin sdk.AccAddress, access AccessGra
 	}
 }
 
+// NewMsgAddAccessRoleRequest creates a new msg to grant the permissions of a named access role to an address.
+func NewMsgAddAccessRoleRequest(denom string, admin sdk.AccAddress, role AccessRoleGrant) *MsgAddAccessRequest { //nolint:interfacer
+	return &MsgAddAccessRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Roles:         []AccessRoleGrant{role},
+	}
+}
+
 // Route returns the name of the module.
 func (msg MsgAddAccessRequest) Route() string { return ModuleName }
 
@@ -151,6 +269,11 @@ func (msg MsgAddAccessRequest) ValidateBasic() error {
 	if err := ValidateGrants(msg.Access...); err != nil {
 		return fmt.Errorf(err.Error())
 	}
+	for _, role := range msg.Roles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -546,3 +669,816 @@ func (msg MsgSetDenomMetadataRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgUpdateMarkerFlagsRequest creates a request to change the supply fixed and governance control flags of a marker
+func NewMsgUpdateMarkerFlagsRequest(
+	denom string, supplyFixed bool, allowGovernanceControl bool, admin sdk.AccAddress, // nolint:interfacer
+) *MsgUpdateMarkerFlagsRequest {
+	return &MsgUpdateMarkerFlagsRequest{
+		Denom:                  denom,
+		SupplyFixed:            supplyFixed,
+		AllowGovernanceControl: allowGovernanceControl,
+		Administrator:          admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgUpdateMarkerFlagsRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdateMarkerFlagsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf("invalid update marker flags request: %w", err)
+	}
+	if len(msg.Administrator) == 0 {
+		return errors.New("invalid update marker flags request: administrator cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid update marker flags request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgUpdateMarkerFlagsRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgUpdateMarkerFlagsRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDepositAndMintRequest creates a request to deposit the reserve for an amount of basket coin and mint it
+func NewMsgDepositAndMintRequest(fromAddress sdk.AccAddress, amount sdk.Coin) *MsgDepositAndMintRequest { // nolint:interfacer
+	return &MsgDepositAndMintRequest{
+		Amount:      amount,
+		FromAddress: fromAddress.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgDepositAndMintRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDepositAndMintRequest) ValidateBasic() error {
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return fmt.Errorf("invalid deposit and mint amount %s: %w", msg.Amount, sdkerrors.ErrInvalidCoins)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid deposit and mint request: from address must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgDepositAndMintRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgDepositAndMintRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgBurnAndRedeemRequest creates a request to burn an amount of basket coin and redeem its reserve
+func NewMsgBurnAndRedeemRequest(fromAddress sdk.AccAddress, amount sdk.Coin) *MsgBurnAndRedeemRequest { // nolint:interfacer
+	return &MsgBurnAndRedeemRequest{
+		Amount:      amount,
+		FromAddress: fromAddress.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgBurnAndRedeemRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgBurnAndRedeemRequest) ValidateBasic() error {
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return fmt.Errorf("invalid burn and redeem amount %s: %w", msg.Amount, sdkerrors.ErrInvalidCoins)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid burn and redeem request: from address must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgBurnAndRedeemRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgBurnAndRedeemRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDistributeEscrowRequest creates a request to pay coins from the escrow of a marker to the holders of its denom
+func NewMsgDistributeEscrowRequest(denom string, admin sdk.AccAddress, amount sdk.Coins) *MsgDistributeEscrowRequest { // nolint:interfacer
+	return &MsgDistributeEscrowRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgDistributeEscrowRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDistributeEscrowRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf("invalid distribute escrow request: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid distribute escrow request: administrator must be a bech32 address string: %w", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return fmt.Errorf("invalid distribute escrow amount %s: %w", msg.Amount, sdkerrors.ErrInvalidCoins)
+	}
+	if !msg.Amount.AmountOf(msg.Denom).IsZero() {
+		return fmt.Errorf("invalid distribute escrow request: cannot distribute %s to its own holders", msg.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgDistributeEscrowRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgDistributeEscrowRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddEmissionScheduleRequest creates a request to mint the amount of marker coin every interval of blocks for the
+// recipient, the escrow of the marker when the recipient is empty
+func NewMsgAddEmissionScheduleRequest(
+	amount sdk.Coin, admin sdk.AccAddress, recipient sdk.AccAddress, interval uint64, emissions uint64, startHeight int64, // nolint:interfacer
+) *MsgAddEmissionScheduleRequest {
+	msg := &MsgAddEmissionScheduleRequest{
+		Amount:        amount,
+		Administrator: admin.String(),
+		Interval:      interval,
+		Emissions:     emissions,
+		StartHeight:   startHeight,
+	}
+	if !recipient.Empty() {
+		msg.Recipient = recipient.String()
+	}
+	return msg
+}
+
+// Route returns the name of the module.
+func (msg MsgAddEmissionScheduleRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAddEmissionScheduleRequest) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid emission schedule amount %s: %w", msg.Amount, sdkerrors.ErrInvalidCoins)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid emission schedule request: administrator must be a bech32 address string: %w", err)
+	}
+	if len(msg.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return fmt.Errorf("invalid emission schedule request: recipient must be a bech32 address string: %w", err)
+		}
+	}
+	if msg.Interval == 0 {
+		return fmt.Errorf("invalid emission schedule request: interval must be at least one block")
+	}
+	if msg.Emissions == 0 {
+		return fmt.Errorf("invalid emission schedule request: emissions must be at least one")
+	}
+	if msg.StartHeight < 0 {
+		return fmt.Errorf("invalid emission schedule request: start height cannot be negative")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgAddEmissionScheduleRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgAddEmissionScheduleRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelEmissionScheduleRequest creates a request to remove an emission schedule before it completes
+func NewMsgCancelEmissionScheduleRequest(scheduleID uint64, admin sdk.AccAddress) *MsgCancelEmissionScheduleRequest { // nolint:interfacer
+	return &MsgCancelEmissionScheduleRequest{
+		ScheduleId:    scheduleID,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgCancelEmissionScheduleRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgCancelEmissionScheduleRequest) ValidateBasic() error {
+	if msg.ScheduleId == 0 {
+		return fmt.Errorf("invalid cancel emission schedule request: schedule id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid cancel emission schedule request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgCancelEmissionScheduleRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgCancelEmissionScheduleRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgScheduleTransferRequest creates a request to transfer restricted coin from one account to another once the
+// release height and/or time is reached, the coin is held in escrow until then
+func NewMsgScheduleTransferRequest(
+	amount sdk.Coin, admin, from, to sdk.AccAddress, releaseHeight int64, releaseTime *time.Time, // nolint:interfacer
+) *MsgScheduleTransferRequest {
+	return &MsgScheduleTransferRequest{
+		Amount:        amount,
+		Administrator: admin.String(),
+		FromAddress:   from.String(),
+		ToAddress:     to.String(),
+		ReleaseHeight: releaseHeight,
+		ReleaseTime:   releaseTime,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgScheduleTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgScheduleTransferRequest) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid scheduled transfer amount %s: %w", msg.Amount, sdkerrors.ErrInvalidCoins)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid schedule transfer request: administrator must be a bech32 address string: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid schedule transfer request: from address must be a bech32 address string: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return fmt.Errorf("invalid schedule transfer request: to address must be a bech32 address string: %w", err)
+	}
+	if msg.ReleaseHeight < 0 {
+		return fmt.Errorf("invalid schedule transfer request: release height cannot be negative")
+	}
+	if msg.ReleaseHeight == 0 && msg.ReleaseTime == nil {
+		return fmt.Errorf("invalid schedule transfer request: a release height or time is required")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgScheduleTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgScheduleTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelScheduledTransferRequest creates a request to return the coin of a scheduled transfer to its sender
+func NewMsgCancelScheduledTransferRequest(transferID uint64, admin sdk.AccAddress) *MsgCancelScheduledTransferRequest { // nolint:interfacer
+	return &MsgCancelScheduledTransferRequest{
+		TransferId:    transferID,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgCancelScheduledTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgCancelScheduledTransferRequest) ValidateBasic() error {
+	if msg.TransferId == 0 {
+		return fmt.Errorf("invalid cancel scheduled transfer request: transfer id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid cancel scheduled transfer request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgCancelScheduledTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgCancelScheduledTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimScheduledTransferRequest creates a request for the recipient to release a scheduled transfer once it is due
+func NewMsgClaimScheduledTransferRequest(transferID uint64, to sdk.AccAddress) *MsgClaimScheduledTransferRequest { // nolint:interfacer
+	return &MsgClaimScheduledTransferRequest{
+		TransferId: transferID,
+		ToAddress:  to.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgClaimScheduledTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgClaimScheduledTransferRequest) ValidateBasic() error {
+	if msg.TransferId == 0 {
+		return fmt.Errorf("invalid claim scheduled transfer request: transfer id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return fmt.Errorf("invalid claim scheduled transfer request: to address must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgClaimScheduledTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgClaimScheduledTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgPauseMarkerRequest creates a request to pause all transfers, mints and burns of a marker
+func NewMsgPauseMarkerRequest(denom string, admin sdk.AccAddress) *MsgPauseMarkerRequest { // nolint:interfacer
+	return &MsgPauseMarkerRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgPauseMarkerRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgPauseMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid pause marker request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgPauseMarkerRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgPauseMarkerRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgResumeMarkerRequest creates a request to lift the pause of a marker
+func NewMsgResumeMarkerRequest(denom string, admin sdk.AccAddress) *MsgResumeMarkerRequest { // nolint:interfacer
+	return &MsgResumeMarkerRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgResumeMarkerRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgResumeMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid resume marker request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgResumeMarkerRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgResumeMarkerRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDepositControlRequest creates a request to set whether coins can only be sent to a marker by addresses with
+// the deposit access on it
+func NewMsgSetDepositControlRequest(denom string, restrictDeposits bool, admin sdk.AccAddress) *MsgSetDepositControlRequest { // nolint:interfacer
+	return &MsgSetDepositControlRequest{
+		Denom:            denom,
+		RestrictDeposits: restrictDeposits,
+		Administrator:    admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetDepositControlRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetDepositControlRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid set deposit control request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetDepositControlRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetDepositControlRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTransferMemoRequirementRequest creates a request to set the pattern the memo of a transfer of a restricted
+// marker's coin must match
+func NewMsgSetTransferMemoRequirementRequest(denom string, pattern string, admin sdk.AccAddress) *MsgSetTransferMemoRequirementRequest { // nolint:interfacer
+	return &MsgSetTransferMemoRequirementRequest{
+		Denom:               denom,
+		TransferMemoPattern: pattern,
+		Administrator:       admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetTransferMemoRequirementRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetTransferMemoRequirementRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if err := ValidateTransferMemoPattern(msg.TransferMemoPattern); err != nil {
+		return fmt.Errorf("invalid set transfer memo requirement request: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid set transfer memo requirement request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetTransferMemoRequirementRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetTransferMemoRequirementRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetWithdrawApprovalPolicyRequest creates a request to require withdrawals from a marker of more than the
+// threshold to be approved by the given number of additional administrators within the approval period
+func NewMsgSetWithdrawApprovalPolicyRequest(
+	denom string, threshold sdk.Coins, approvalsRequired uint32, approvalPeriod time.Duration, admin sdk.AccAddress, // nolint:interfacer
+) *MsgSetWithdrawApprovalPolicyRequest {
+	return &MsgSetWithdrawApprovalPolicyRequest{
+		Denom:             denom,
+		Threshold:         threshold,
+		ApprovalsRequired: approvalsRequired,
+		ApprovalPeriod:    approvalPeriod,
+		Administrator:     admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetWithdrawApprovalPolicyRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetWithdrawApprovalPolicyRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !msg.Threshold.Empty() {
+		policy := NewWithdrawApprovalPolicy(msg.Threshold, msg.ApprovalsRequired, msg.ApprovalPeriod)
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid set withdraw approval policy request: %w", err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid set withdraw approval policy request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetWithdrawApprovalPolicyRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetWithdrawApprovalPolicyRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgApproveWithdrawRequest creates a request for an administrator to approve a pending withdrawal
+func NewMsgApproveWithdrawRequest(withdrawalID uint64, admin sdk.AccAddress) *MsgApproveWithdrawRequest { // nolint:interfacer
+	return &MsgApproveWithdrawRequest{
+		WithdrawalId:  withdrawalID,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgApproveWithdrawRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgApproveWithdrawRequest) ValidateBasic() error {
+	if msg.WithdrawalId == 0 {
+		return fmt.Errorf("invalid approve withdraw request: withdrawal id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid approve withdraw request: administrator must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgApproveWithdrawRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgApproveWithdrawRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetFaucetRequest creates a request to let any address claim the drip amount of the marker's coin once per
+// period, a zero drip amount removes the faucet
+func NewMsgSetFaucetRequest(
+	denom string, dripAmount sdk.Int, period time.Duration, mint bool, admin sdk.AccAddress, // nolint:interfacer
+) *MsgSetFaucetRequest {
+	return &MsgSetFaucetRequest{
+		Denom:         denom,
+		DripAmount:    dripAmount,
+		Period:        period,
+		Mint:          mint,
+		Administrator: admin.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetFaucetRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetFaucetRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if msg.DripAmount.IsNil() || msg.DripAmount.IsNegative() {
+		return fmt.Errorf("invalid set faucet request: drip amount must not be negative")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid set faucet request: administrator must be a bech32 address string: %w", err)
+	}
+	if !msg.DripAmount.IsZero() && msg.Period <= 0 {
+		return fmt.Errorf("invalid set faucet request: period must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetFaucetRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetFaucetRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimFaucetRequest creates a request to claim the drip amount of a marker's faucet
+func NewMsgClaimFaucetRequest(denom string, claimer sdk.AccAddress) *MsgClaimFaucetRequest { // nolint:interfacer
+	return &MsgClaimFaucetRequest{
+		Denom:   denom,
+		Claimer: claimer.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgClaimFaucetRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgClaimFaucetRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return fmt.Errorf("invalid claim faucet request: claimer must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgClaimFaucetRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgClaimFaucetRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetFeeConversionRateRequest creates a request by the oracle of a marker's fee conversion to update its rate
+func NewMsgSetFeeConversionRateRequest(denom string, rate sdk.Dec, oracle sdk.AccAddress) *MsgSetFeeConversionRateRequest { // nolint:interfacer
+	return &MsgSetFeeConversionRateRequest{
+		Denom:  denom,
+		Rate:   rate,
+		Oracle: oracle.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetFeeConversionRateRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetFeeConversionRateRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if err := ValidateFeeConversionRate(msg.Rate); err != nil {
+		return fmt.Errorf("invalid set fee conversion rate request: rate %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Oracle); err != nil {
+		return fmt.Errorf("invalid set fee conversion rate request: oracle must be a bech32 address string: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetFeeConversionRateRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetFeeConversionRateRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Oracle)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSweepEscrowRequest creates a request to withdraw all coins other than the marker's own, and the excluded
+// denoms, from the marker account to the recipient
+func NewMsgSweepEscrowRequest(
+	denom string, admin sdk.AccAddress, recipient sdk.AccAddress, excludeDenoms []string, // nolint:interfacer
+) *MsgSweepEscrowRequest {
+	msg := &MsgSweepEscrowRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		ExcludeDenoms: excludeDenoms,
+	}
+	if !recipient.Empty() {
+		msg.ToAddress = recipient.String()
+	}
+	return msg
+}
+
+// Route returns the name of the module.
+func (msg MsgSweepEscrowRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSweepEscrowRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return fmt.Errorf("invalid sweep escrow request: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid sweep escrow request: administrator must be a bech32 address string: %w", err)
+	}
+	if len(msg.ToAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+			return fmt.Errorf("invalid sweep escrow request: to address must be a bech32 address string: %w", err)
+		}
+	}
+	for _, denom := range msg.ExcludeDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid sweep escrow request: excluded %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSweepEscrowRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSweepEscrowRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -3,9 +3,11 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,6 +18,28 @@ const (
 	DefaultMaxTotalSupply = uint64(100000000000)
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,64}`
+	// DefaultExpeditedVotingPeriod is the voting period after which a proposal may pass on the expedited track.
+	DefaultExpeditedVotingPeriod = time.Hour * 24
+	// DefaultMaxDistributionHolders is the maximum number of holders an escrow distribution may pay.
+	DefaultMaxDistributionHolders = uint32(10000)
+	// DefaultDistributionHoldersPerBlock is the number of holders paid by escrow distributions in each block.
+	DefaultDistributionHoldersPerBlock = uint32(100)
+	// DefaultMaxEmissionPerBlock is the maximum amount an emission schedule may mint per block.
+	DefaultMaxEmissionPerBlock = uint64(1000000)
+	// DefaultSupplyHistoryWindow is the number of blocks the supply changes of the markers are kept for.
+	DefaultSupplyHistoryWindow = uint64(1000000)
+	// DefaultEnableFaucet (false) indicates that marker faucets are disabled, as they should be outside of test networks.
+	DefaultEnableFaucet = false
+	// DefaultAccessLogWindow is the number of blocks the access changes of the markers are kept for.
+	DefaultAccessLogWindow = uint64(1000000)
+	// DefaultDefaultDisplayExponent (0) indicates that denom metadata is only derived for markers created with a display
+	// exponent.
+	DefaultDefaultDisplayExponent = uint32(0)
+)
+
+var (
+	// DefaultExpeditedQuorum is the portion of bonded stake that must vote for a proposal to pass on the expedited track.
+	DefaultExpeditedQuorum = sdk.NewDecWithPrec(667, 3)
 )
 
 var (
@@ -25,6 +49,26 @@ var (
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
+	// ParamStoreKeyExpeditedVotingPeriod is the voting period of the expedited proposal track.
+	ParamStoreKeyExpeditedVotingPeriod = []byte("ExpeditedVotingPeriod")
+	// ParamStoreKeyExpeditedQuorum is the quorum required for proposals to pass on the expedited track.
+	ParamStoreKeyExpeditedQuorum = []byte("ExpeditedQuorum")
+	// ParamStoreKeyAccessRoles is the list of named permission bundles that may be used in access grants.
+	ParamStoreKeyAccessRoles = []byte("AccessRoles")
+	// ParamStoreKeyMaxDistributionHolders is the maximum number of holders an escrow distribution may pay.
+	ParamStoreKeyMaxDistributionHolders = []byte("MaxDistributionHolders")
+	// ParamStoreKeyDistributionHoldersPerBlock is the number of holders paid by escrow distributions in each block.
+	ParamStoreKeyDistributionHoldersPerBlock = []byte("DistributionHoldersPerBlock")
+	// ParamStoreKeyMaxEmissionPerBlock is the maximum amount an emission schedule may mint per block.
+	ParamStoreKeyMaxEmissionPerBlock = []byte("MaxEmissionPerBlock")
+	// ParamStoreKeySupplyHistoryWindow is the number of blocks the supply changes of the markers are kept for.
+	ParamStoreKeySupplyHistoryWindow = []byte("SupplyHistoryWindow")
+	// ParamStoreKeyEnableFaucet indicates if marker faucets may be set and claimed from.
+	ParamStoreKeyEnableFaucet = []byte("EnableFaucet")
+	// ParamStoreKeyAccessLogWindow is the number of blocks the access changes of the markers are kept for.
+	ParamStoreKeyAccessLogWindow = []byte("AccessLogWindow")
+	// ParamStoreKeyDefaultDisplayExponent is the display unit exponent of the denom metadata derived on activation.
+	ParamStoreKeyDefaultDisplayExponent = []byte("DefaultDisplayExponent")
 )
 
 // ParamKeyTable for marker module
@@ -37,11 +81,31 @@ func NewParams(
 	maxTotalSupply uint64,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
+	expeditedVotingPeriod time.Duration,
+	expeditedQuorum sdk.Dec,
+	accessRoles []AccessRole,
+	maxDistributionHolders uint32,
+	distributionHoldersPerBlock uint32,
+	maxEmissionPerBlock uint64,
+	supplyHistoryWindow uint64,
+	enableFaucet bool,
+	accessLogWindow uint64,
+	defaultDisplayExponent uint32,
 ) Params {
 	return Params{
-		EnableGovernance:       enableGovernance,
-		MaxTotalSupply:         maxTotalSupply,
-		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		EnableGovernance:            enableGovernance,
+		MaxTotalSupply:              maxTotalSupply,
+		UnrestrictedDenomRegex:      unrestrictedDenomRegex,
+		ExpeditedVotingPeriod:       expeditedVotingPeriod,
+		ExpeditedQuorum:             expeditedQuorum,
+		AccessRoles:                 accessRoles,
+		MaxDistributionHolders:      maxDistributionHolders,
+		DistributionHoldersPerBlock: distributionHoldersPerBlock,
+		MaxEmissionPerBlock:         maxEmissionPerBlock,
+		SupplyHistoryWindow:         supplyHistoryWindow,
+		EnableFaucet:                enableFaucet,
+		AccessLogWindow:             accessLogWindow,
+		DefaultDisplayExponent:      defaultDisplayExponent,
 	}
 }
 
@@ -51,6 +115,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyExpeditedVotingPeriod, &p.ExpeditedVotingPeriod, validateExpeditedVotingPeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyExpeditedQuorum, &p.ExpeditedQuorum, validateExpeditedQuorum),
+		paramtypes.NewParamSetPair(ParamStoreKeyAccessRoles, &p.AccessRoles, validateAccessRoles),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxDistributionHolders, &p.MaxDistributionHolders, validateMaxDistributionHolders),
+		paramtypes.NewParamSetPair(ParamStoreKeyDistributionHoldersPerBlock, &p.DistributionHoldersPerBlock, validateDistributionHoldersPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxEmissionPerBlock, &p.MaxEmissionPerBlock, validateMaxEmissionPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeySupplyHistoryWindow, &p.SupplyHistoryWindow, validateSupplyHistoryWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableFaucet, &p.EnableFaucet, validateEnableFaucet),
+		paramtypes.NewParamSetPair(ParamStoreKeyAccessLogWindow, &p.AccessLogWindow, validateAccessLogWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeyDefaultDisplayExponent, &p.DefaultDisplayExponent, validateDefaultDisplayExponent),
 	}
 }
 
@@ -60,6 +134,16 @@ func DefaultParams() Params {
 		DefaultMaxTotalSupply,
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
+		DefaultExpeditedVotingPeriod,
+		DefaultExpeditedQuorum,
+		DefaultAccessRoles,
+		DefaultMaxDistributionHolders,
+		DefaultDistributionHoldersPerBlock,
+		DefaultMaxEmissionPerBlock,
+		DefaultSupplyHistoryWindow,
+		DefaultEnableFaucet,
+		DefaultAccessLogWindow,
+		DefaultDefaultDisplayExponent,
 	)
 }
 
@@ -98,6 +182,41 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
 		return false
 	}
+	if p.ExpeditedVotingPeriod != that1.ExpeditedVotingPeriod {
+		return false
+	}
+	if !p.ExpeditedQuorum.Equal(that1.ExpeditedQuorum) {
+		return false
+	}
+	if len(p.AccessRoles) != len(that1.AccessRoles) {
+		return false
+	}
+	for i := range p.AccessRoles {
+		if p.AccessRoles[i].String() != that1.AccessRoles[i].String() {
+			return false
+		}
+	}
+	if p.MaxDistributionHolders != that1.MaxDistributionHolders {
+		return false
+	}
+	if p.DistributionHoldersPerBlock != that1.DistributionHoldersPerBlock {
+		return false
+	}
+	if p.MaxEmissionPerBlock != that1.MaxEmissionPerBlock {
+		return false
+	}
+	if p.SupplyHistoryWindow != that1.SupplyHistoryWindow {
+		return false
+	}
+	if p.EnableFaucet != that1.EnableFaucet {
+		return false
+	}
+	if p.AccessLogWindow != that1.AccessLogWindow {
+		return false
+	}
+	if p.DefaultDisplayExponent != that1.DefaultDisplayExponent {
+		return false
+	}
 	return true
 }
 
@@ -129,3 +248,94 @@ func validateRegexParam(i interface{}) error {
 	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
 	return err
 }
+
+func validateExpeditedVotingPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("expedited voting period must not be negative: %s", period)
+	}
+	return nil
+}
+
+func validateExpeditedQuorum(i interface{}) error {
+	quorum, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// An unset quorum is stored as zero.
+	if !quorum.IsNil() && (quorum.IsNegative() || quorum.GT(sdk.OneDec())) {
+		return fmt.Errorf("expedited quorum must be between 0 and 1: %s", quorum)
+	}
+	return nil
+}
+
+func validateAccessRoles(i interface{}) error {
+	roles, ok := i.([]AccessRole)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateAccessRoles(roles...)
+}
+
+func validateMaxDistributionHolders(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDistributionHoldersPerBlock(i interface{}) error {
+	holders, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// escrow distributions would never pay anyone without at least one holder per block.
+	if holders == 0 {
+		return fmt.Errorf("distribution holders per block must be greater than zero")
+	}
+	return nil
+}
+
+func validateMaxEmissionPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSupplyHistoryWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEnableFaucet(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAccessLogWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDefaultDisplayExponent(i interface{}) error {
+	exponent, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateDisplayExponent(exponent)
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"regexp"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,10 +19,22 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, uint64(DefaultMaxTotalSupply), p.MaxTotalSupply)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z")))
+	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z", DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, time.Hour, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, sdk.OneDec(), DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, nil, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum,
+		[]AccessRole{NewAccessRole("issuer", AccessListByNames("mint")), NewAccessRole("registrar", AccessListByNames("transfer"))}, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, 10, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, 10, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, 10, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, 10, DefaultEnableFaucet, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, true, DefaultAccessLogWindow, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, 10, DefaultDefaultDisplayExponent)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultExpeditedVotingPeriod, DefaultExpeditedQuorum, DefaultAccessRoles, DefaultMaxDistributionHolders, DefaultDistributionHoldersPerBlock, DefaultMaxEmissionPerBlock, DefaultSupplyHistoryWindow, DefaultEnableFaucet, DefaultAccessLogWindow, 9)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,13 +53,32 @@ func TestParamString(t *testing.T) {
 	require.Equal(t, `maxtotalsupply: 100000000000
 enablegovernance: true
 unrestricteddenomregex: '[a-zA-Z][a-zA-Z0-9\-\.]{2,64}'
+expeditedvotingperiod: 24h0m0s
+expeditedquorum: "0.667000000000000000"
+accessroles:
+- name: issuer
+  permissions:
+  - ACCESS_MINT
+  - ACCESS_BURN
+  - ACCESS_WITHDRAW
+  - ACCESS_DEPOSIT
+- name: registrar
+  permissions:
+  - ACCESS_TRANSFER
+maxdistributionholders: 10000
+distributionholdersperblock: 100
+maxemissionperblock: 1000000
+supplyhistorywindow: 1000000
+enablefaucet: false
+accesslogwindow: 1000000
+defaultdisplayexponent: 0
 `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 3, len(pairs))
+	require.Equal(t, 13, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -69,6 +102,60 @@ func TestParamSetPairs(t *testing.T) {
 			// If the expression contains the anchors but they are not at the end of the expression that is allowed (however unrealistic)
 			require.NoError(t, pairs[i].ValidatorFn("[a-z].*$."))
 			require.NoError(t, pairs[i].ValidatorFn(".^[a-z].*$."))
+		case string(ParamStoreKeyExpeditedVotingPeriod):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(-time.Hour))
+			require.NoError(t, pairs[i].ValidatorFn(time.Duration(0)))
+			require.NoError(t, pairs[i].ValidatorFn(time.Hour))
+		case string(ParamStoreKeyExpeditedQuorum):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(sdk.NewDec(-1)))
+			require.Error(t, pairs[i].ValidatorFn(sdk.NewDec(2)))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewDecWithPrec(5, 1)))
+		case string(ParamStoreKeyAccessRoles):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn([]AccessRole{NewAccessRole("Issuer", AccessListByNames("mint"))}))
+			require.Error(t, pairs[i].ValidatorFn([]AccessRole{NewAccessRole("issuer", AccessList{})}))
+			require.Error(t, pairs[i].ValidatorFn([]AccessRole{NewAccessRole("issuer", AccessListByNames("mint,mint"))}))
+			require.Error(t, pairs[i].ValidatorFn([]AccessRole{NewAccessRole("issuer", AccessListByNames("mint")), NewAccessRole("issuer", AccessListByNames("burn"))}))
+			require.NoError(t, pairs[i].ValidatorFn([]AccessRole{}))
+			require.NoError(t, pairs[i].ValidatorFn(DefaultAccessRoles))
+		case string(ParamStoreKeyMaxDistributionHolders):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(10))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(10)))
+		case string(ParamStoreKeyDistributionHoldersPerBlock):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(10))
+			require.Error(t, pairs[i].ValidatorFn(uint32(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(1)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(10)))
+		case string(ParamStoreKeyMaxEmissionPerBlock):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint32(10)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(10)))
+		case string(ParamStoreKeySupplyHistoryWindow):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint32(10)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(10)))
+		case string(ParamStoreKeyEnableFaucet):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.NoError(t, pairs[i].ValidatorFn(true))
+			require.NoError(t, pairs[i].ValidatorFn(false))
+		case string(ParamStoreKeyAccessLogWindow):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint32(10)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(10)))
+		case string(ParamStoreKeyDefaultDisplayExponent):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint64(9)))
+			require.Error(t, pairs[i].ValidatorFn(uint32(4)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(9)))
 
 		default:
 			require.Fail(t, "unexpected param set pair")
@@ -26,6 +26,14 @@ const (
 	ProposalTypeWithdrawEscrow string = "WithdrawEscrow"
 	// ProposalTypeSetDenomMetadata is a proposal to set denom metatdata.
 	ProposalTypeSetDenomMetadata string = "SetDenomMetadata"
+	// ProposalTypeUpdateMarkerFlags is a proposal to change the supply fixed and governance control flags of a marker.
+	ProposalTypeUpdateMarkerFlags string = "UpdateMarkerFlags"
+	// ProposalTypePauseMarker is a proposal to pause or resume all transfers, mints and burns of a marker.
+	ProposalTypePauseMarker string = "PauseMarker"
+	// ProposalTypeAdoptDenom is a proposal to create an active marker for an existing denom that has no marker.
+	ProposalTypeAdoptDenom string = "AdoptDenom"
+	// ProposalTypeSetFeeConversion is a proposal to accept, or stop accepting, a marker's coin for the fees of a tx.
+	ProposalTypeSetFeeConversion string = "SetFeeConversion"
 )
 
 var (
@@ -37,6 +45,10 @@ var (
 	_ govtypes.Content = &ChangeStatusProposal{}
 	_ govtypes.Content = &WithdrawEscrowProposal{}
 	_ govtypes.Content = &SetDenomMetadataProposal{}
+	_ govtypes.Content = &UpdateMarkerFlagsProposal{}
+	_ govtypes.Content = &PauseMarkerProposal{}
+	_ govtypes.Content = &AdoptDenomProposal{}
+	_ govtypes.Content = &SetFeeConversionProposal{}
 )
 
 func init() {
@@ -61,6 +73,18 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
 	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "provenance/marker/SetDenomMetadataProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeUpdateMarkerFlags)
+	govtypes.RegisterProposalTypeCodec(UpdateMarkerFlagsProposal{}, "provenance/marker/UpdateMarkerFlagsProposal")
+
+	govtypes.RegisterProposalType(ProposalTypePauseMarker)
+	govtypes.RegisterProposalTypeCodec(PauseMarkerProposal{}, "provenance/marker/PauseMarkerProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeAdoptDenom)
+	govtypes.RegisterProposalTypeCodec(AdoptDenomProposal{}, "provenance/marker/AdoptDenomProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeSetFeeConversion)
+	govtypes.RegisterProposalTypeCodec(SetFeeConversionProposal{}, "provenance/marker/SetFeeConversionProposal")
 }
 
 // NewAddMarkerProposal creates a new proposal
@@ -298,3 +322,175 @@ func (sdmdp SetDenomMetadataProposal) String() string {
   Metadata:    %s
 `, sdmdp.Metadata.Base, sdmdp.Title, sdmdp.Description, sdmdp.Metadata.String())
 }
+
+func NewUpdateMarkerFlagsProposal(title, description, denom string, supplyFixed, allowGovernanceControl bool) *UpdateMarkerFlagsProposal {
+	return &UpdateMarkerFlagsProposal{title, description, denom, supplyFixed, allowGovernanceControl}
+}
+
+// Implements Proposal Interface
+
+func (umfp UpdateMarkerFlagsProposal) ProposalRoute() string { return RouterKey }
+func (umfp UpdateMarkerFlagsProposal) ProposalType() string  { return ProposalTypeUpdateMarkerFlags }
+func (umfp UpdateMarkerFlagsProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(umfp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&umfp)
+}
+
+func (umfp UpdateMarkerFlagsProposal) String() string {
+	return fmt.Sprintf(`MarkerAccount Update Flags Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Supply Fixed: %t
+  Allow Governance Control: %t
+`, umfp.Denom, umfp.Title, umfp.Description, umfp.SupplyFixed, umfp.AllowGovernanceControl)
+}
+
+func NewPauseMarkerProposal(title, description, denom string, paused bool) *PauseMarkerProposal {
+	return &PauseMarkerProposal{title, description, denom, paused}
+}
+
+// Implements Proposal Interface
+
+func (pmp PauseMarkerProposal) ProposalRoute() string { return RouterKey }
+func (pmp PauseMarkerProposal) ProposalType() string  { return ProposalTypePauseMarker }
+func (pmp PauseMarkerProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(pmp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return govtypes.ValidateAbstract(&pmp)
+}
+
+func (pmp PauseMarkerProposal) String() string {
+	return fmt.Sprintf(`MarkerAccount Pause Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Paused:      %t
+`, pmp.Denom, pmp.Title, pmp.Description, pmp.Paused)
+}
+
+// NewAdoptDenomProposal creates a new proposal
+func NewAdoptDenomProposal(
+	title,
+	description string,
+	denom string,
+	manager sdk.AccAddress,
+	markerType MarkerType,
+	access []AccessGrant,
+	fixed bool,
+	allowGov bool, // nolint:interfacer
+) *AdoptDenomProposal {
+	var managerAddr string
+	if !manager.Empty() {
+		managerAddr = manager.String()
+	}
+	return &AdoptDenomProposal{
+		Title:                  title,
+		Description:            description,
+		Denom:                  denom,
+		Manager:                managerAddr,
+		MarkerType:             markerType,
+		AccessList:             access,
+		SupplyFixed:            fixed,
+		AllowGovernanceControl: allowGov,
+	}
+}
+
+// Implements Proposal Interface
+
+func (adp AdoptDenomProposal) ProposalRoute() string { return RouterKey }
+func (adp AdoptDenomProposal) ProposalType() string  { return ProposalTypeAdoptDenom }
+func (adp AdoptDenomProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(adp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if len(adp.Manager) > 0 {
+		if _, err := sdk.AccAddressFromBech32(adp.Manager); err != nil {
+			return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "invalid manager: %s", err)
+		}
+	}
+	// the supply of a basket is only created by reserve deposits, so existing coin cannot be adopted into one.
+	if adp.MarkerType != MarkerType_Coin && adp.MarkerType != MarkerType_RestrictedCoin {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "cannot adopt a denom as a %s marker", adp.MarkerType)
+	}
+	if err := ValidateGrants(adp.AccessList...); err != nil {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "invalid access list: %s", err)
+	}
+	return govtypes.ValidateAbstract(&adp)
+}
+
+func (adp AdoptDenomProposal) String() string {
+	return fmt.Sprintf(`Adopt Denom Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Manager:     %s
+  Type:        %s
+`, adp.Denom, adp.Title, adp.Description, adp.Manager, adp.MarkerType)
+}
+
+// NewSetFeeConversionProposal creates a new proposal
+func NewSetFeeConversionProposal(
+	title,
+	description string,
+	denom string,
+	enabled bool,
+	pool sdk.AccAddress,
+	rate sdk.Dec,
+	oracle sdk.AccAddress, // nolint:interfacer
+) *SetFeeConversionProposal {
+	prop := &SetFeeConversionProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Enabled:     enabled,
+		Rate:        rate,
+	}
+	if !pool.Empty() {
+		prop.Pool = pool.String()
+	}
+	if !oracle.Empty() {
+		prop.Oracle = oracle.String()
+	}
+	return prop
+}
+
+// FeeConversion returns the fee conversion set by the proposal.
+func (sfcp SetFeeConversionProposal) FeeConversion() FeeConversion {
+	return FeeConversion{Denom: sfcp.Denom, Pool: sfcp.Pool, Rate: sfcp.Rate, Oracle: sfcp.Oracle}
+}
+
+// Implements Proposal Interface
+
+func (sfcp SetFeeConversionProposal) ProposalRoute() string { return RouterKey }
+func (sfcp SetFeeConversionProposal) ProposalType() string  { return ProposalTypeSetFeeConversion }
+func (sfcp SetFeeConversionProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(sfcp.Denom); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	if sfcp.Enabled {
+		if err := sfcp.FeeConversion().Validate(); err != nil {
+			return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+		}
+	}
+	return govtypes.ValidateAbstract(&sfcp)
+}
+
+func (sfcp SetFeeConversionProposal) String() string {
+	rate := ""
+	if !sfcp.Rate.IsNil() {
+		rate = sfcp.Rate.String()
+	}
+	return fmt.Sprintf(`Set Fee Conversion Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Enabled:     %t
+  Pool:        %s
+  Rate:        %s
+  Oracle:      %s
+`, sfcp.Denom, sfcp.Title, sfcp.Description, sfcp.Enabled, sfcp.Pool, rate, sfcp.Oracle)
+}
@@ -579,6 +579,341 @@ func (m *SetDenomMetadataProposal) GetDescription() string {
 	return ""
 }
 
+// UpdateMarkerFlagsProposal defines a governance proposal to change the supply fixed and governance control flags of a
+// marker
+type UpdateMarkerFlagsProposal struct {
+	Title                  string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description            string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom                  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	SupplyFixed            bool   `protobuf:"varint,4,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
+	AllowGovernanceControl bool   `protobuf:"varint,5,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+}
+
+func (m *UpdateMarkerFlagsProposal) Reset()      { *m = UpdateMarkerFlagsProposal{} }
+func (*UpdateMarkerFlagsProposal) ProtoMessage() {}
+func (*UpdateMarkerFlagsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{8}
+}
+func (m *UpdateMarkerFlagsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMarkerFlagsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMarkerFlagsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateMarkerFlagsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMarkerFlagsProposal.Merge(m, src)
+}
+func (m *UpdateMarkerFlagsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMarkerFlagsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMarkerFlagsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMarkerFlagsProposal proto.InternalMessageInfo
+
+func (m *UpdateMarkerFlagsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateMarkerFlagsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateMarkerFlagsProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *UpdateMarkerFlagsProposal) GetSupplyFixed() bool {
+	if m != nil {
+		return m.SupplyFixed
+	}
+	return false
+}
+
+func (m *UpdateMarkerFlagsProposal) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
+	}
+	return false
+}
+
+// PauseMarkerProposal defines a governance proposal to pause or resume all transfers, mints and burns of a marker
+type PauseMarkerProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Paused      bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *PauseMarkerProposal) Reset()      { *m = PauseMarkerProposal{} }
+func (*PauseMarkerProposal) ProtoMessage() {}
+func (*PauseMarkerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{9}
+}
+func (m *PauseMarkerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseMarkerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseMarkerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseMarkerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseMarkerProposal.Merge(m, src)
+}
+func (m *PauseMarkerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseMarkerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseMarkerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseMarkerProposal proto.InternalMessageInfo
+
+func (m *PauseMarkerProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PauseMarkerProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PauseMarkerProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PauseMarkerProposal) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// AdoptDenomProposal defines a governance proposal to bring an existing denom that has no marker under marker
+// management by creating an active marker with a supply equal to the current supply of the denom
+type AdoptDenomProposal struct {
+	Title                  string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description            string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom                  string        `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Manager                string        `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
+	MarkerType             MarkerType    `protobuf:"varint,5,opt,name=marker_type,json=markerType,proto3,enum=provenance.marker.v1.MarkerType" json:"marker_type,omitempty"`
+	AccessList             []AccessGrant `protobuf:"bytes,6,rep,name=access_list,json=accessList,proto3" json:"access_list"`
+	SupplyFixed            bool          `protobuf:"varint,7,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
+	AllowGovernanceControl bool          `protobuf:"varint,8,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+}
+
+func (m *AdoptDenomProposal) Reset()      { *m = AdoptDenomProposal{} }
+func (*AdoptDenomProposal) ProtoMessage() {}
+func (*AdoptDenomProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{10}
+}
+func (m *AdoptDenomProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdoptDenomProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdoptDenomProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdoptDenomProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdoptDenomProposal.Merge(m, src)
+}
+func (m *AdoptDenomProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdoptDenomProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdoptDenomProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdoptDenomProposal proto.InternalMessageInfo
+
+func (m *AdoptDenomProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AdoptDenomProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AdoptDenomProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AdoptDenomProposal) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+func (m *AdoptDenomProposal) GetMarkerType() MarkerType {
+	if m != nil {
+		return m.MarkerType
+	}
+	return MarkerType_Unknown
+}
+
+func (m *AdoptDenomProposal) GetAccessList() []AccessGrant {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *AdoptDenomProposal) GetSupplyFixed() bool {
+	if m != nil {
+		return m.SupplyFixed
+	}
+	return false
+}
+
+func (m *AdoptDenomProposal) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
+	}
+	return false
+}
+
+// SetFeeConversionProposal defines a governance proposal to let the fees of a tx be paid in a marker's coin, swapped
+// for the fee denom from a conversion pool at a rate maintained by an oracle, or to stop accepting it when not enabled
+type SetFeeConversionProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Enabled     bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// the account that receives the marker's coin and pays out the fee denom it holds in exchange
+	Pool string `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+	// the amount of the fee denom given for one unit of the marker's coin
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// the address that may update the rate
+	Oracle string `protobuf:"bytes,7,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *SetFeeConversionProposal) Reset()      { *m = SetFeeConversionProposal{} }
+func (*SetFeeConversionProposal) ProtoMessage() {}
+func (*SetFeeConversionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{11}
+}
+func (m *SetFeeConversionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFeeConversionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFeeConversionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFeeConversionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeeConversionProposal.Merge(m, src)
+}
+func (m *SetFeeConversionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFeeConversionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeeConversionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeeConversionProposal proto.InternalMessageInfo
+
+func (m *SetFeeConversionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetFeeConversionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetFeeConversionProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SetFeeConversionProposal) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SetFeeConversionProposal) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *SetFeeConversionProposal) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AddMarkerProposal)(nil), "provenance.marker.v1.AddMarkerProposal")
 	proto.RegisterType((*SupplyIncreaseProposal)(nil), "provenance.marker.v1.SupplyIncreaseProposal")
@@ -588,6 +923,10 @@ func init() {
 	proto.RegisterType((*ChangeStatusProposal)(nil), "provenance.marker.v1.ChangeStatusProposal")
 	proto.RegisterType((*WithdrawEscrowProposal)(nil), "provenance.marker.v1.WithdrawEscrowProposal")
 	proto.RegisterType((*SetDenomMetadataProposal)(nil), "provenance.marker.v1.SetDenomMetadataProposal")
+	proto.RegisterType((*UpdateMarkerFlagsProposal)(nil), "provenance.marker.v1.UpdateMarkerFlagsProposal")
+	proto.RegisterType((*PauseMarkerProposal)(nil), "provenance.marker.v1.PauseMarkerProposal")
+	proto.RegisterType((*AdoptDenomProposal)(nil), "provenance.marker.v1.AdoptDenomProposal")
+	proto.RegisterType((*SetFeeConversionProposal)(nil), "provenance.marker.v1.SetFeeConversionProposal")
 }
 
 func init() {
@@ -595,54 +934,64 @@ func init() {
 }
 
 var fileDescriptor_345320af87f4ec37 = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
-	0x18, 0xce, 0x7c, 0xf9, 0xd1, 0x64, 0xf2, 0x7d, 0xfd, 0x70, 0x09, 0x75, 0xad, 0x98, 0xa4, 0x45,
-	0x6d, 0x2e, 0xdd, 0x35, 0xf1, 0x22, 0xb9, 0x48, 0xd2, 0x6a, 0x15, 0x2c, 0x94, 0xad, 0x20, 0x78,
-	0x09, 0x93, 0xdd, 0x71, 0xbb, 0x24, 0x3b, 0xb3, 0xcc, 0x4c, 0x92, 0xf6, 0xbf, 0xf0, 0xe8, 0x49,
-	0x7a, 0xf6, 0x26, 0xde, 0x3d, 0xf7, 0x66, 0x8f, 0xe2, 0xa1, 0x4a, 0x8b, 0xe0, 0xbf, 0x20, 0x78,
-	0x90, 0x9d, 0x99, 0xa4, 0x0b, 0x0d, 0xa1, 0x52, 0x2a, 0xf4, 0xb4, 0x33, 0xef, 0xfb, 0xbc, 0x3f,
-	0x9e, 0xd9, 0xe7, 0x9d, 0x81, 0xb7, 0x23, 0x46, 0x87, 0x98, 0x20, 0xe2, 0x62, 0x3b, 0x44, 0xac,
-	0x87, 0x99, 0x3d, 0xac, 0xdb, 0x11, 0xa3, 0x11, 0xe5, 0xa8, 0xcf, 0xad, 0x88, 0x51, 0x41, 0x8d,
-	0xd2, 0x29, 0xca, 0x52, 0x28, 0x6b, 0x58, 0x5f, 0x2c, 0xf9, 0xd4, 0xa7, 0x12, 0x60, 0xc7, 0x2b,
-	0x85, 0x5d, 0x2c, 0xbb, 0x94, 0x87, 0x94, 0xdb, 0x5d, 0x44, 0x7a, 0xf6, 0xb0, 0xde, 0xc5, 0x02,
-	0xd5, 0xe5, 0xe6, 0x8c, 0x9f, 0xe3, 0x89, 0xdf, 0xa5, 0x01, 0xd1, 0xfe, 0xa5, 0xa9, 0x1d, 0xe9,
-	0xaa, 0x0a, 0x72, 0x77, 0x2a, 0x04, 0xb9, 0x2e, 0xe6, 0xdc, 0x67, 0x88, 0x08, 0x85, 0x5b, 0xfe,
-	0x99, 0x86, 0xd7, 0x5a, 0x9e, 0xb7, 0x29, 0x21, 0x5b, 0x9a, 0x93, 0x51, 0x82, 0x59, 0x11, 0x88,
-	0x3e, 0x36, 0x41, 0x15, 0xd4, 0x0a, 0x8e, 0xda, 0x18, 0x55, 0x58, 0xf4, 0x30, 0x77, 0x59, 0x10,
-	0x89, 0x80, 0x12, 0xf3, 0x1f, 0xe9, 0x4b, 0x9a, 0x8c, 0x2e, 0xcc, 0xa1, 0x90, 0x0e, 0x88, 0x30,
-	0xd3, 0x55, 0x50, 0x2b, 0x36, 0x6e, 0x58, 0x8a, 0x89, 0x15, 0x33, 0xb1, 0x34, 0x13, 0x6b, 0x8d,
-	0x06, 0xa4, 0x6d, 0x1f, 0x1c, 0x55, 0x52, 0x5f, 0x8e, 0x2a, 0x2b, 0x7e, 0x20, 0x76, 0x06, 0x5d,
-	0xcb, 0xa5, 0xa1, 0xad, 0x69, 0xab, 0xcf, 0x2a, 0xf7, 0x7a, 0xb6, 0xd8, 0x8b, 0x30, 0x97, 0x01,
-	0x8e, 0xce, 0x6c, 0x98, 0x70, 0x2e, 0x44, 0x04, 0xf9, 0x98, 0x99, 0x19, 0xd9, 0xc1, 0x78, 0x6b,
-	0x34, 0x61, 0x8e, 0x0b, 0x24, 0x06, 0xdc, 0xcc, 0x56, 0x41, 0x6d, 0xbe, 0xb1, 0x6c, 0x4d, 0xfb,
-	0x27, 0x96, 0xe2, 0xba, 0x2d, 0x91, 0x8e, 0x8e, 0x30, 0x5a, 0xb0, 0xa8, 0x10, 0x9d, 0xb8, 0xa4,
-	0x99, 0x93, 0x09, 0xaa, 0xb3, 0x12, 0x3c, 0xdf, 0x8b, 0xb0, 0x03, 0xc3, 0xc9, 0xda, 0x78, 0x02,
-	0x8b, 0xea, 0x7c, 0x3b, 0xfd, 0x80, 0x0b, 0x73, 0xae, 0x9a, 0xae, 0x15, 0x1b, 0x4b, 0xd3, 0x53,
-	0xb4, 0x24, 0x70, 0x23, 0xfe, 0x11, 0xed, 0x4c, 0x7c, 0x12, 0x0e, 0x54, 0xb1, 0xcf, 0x02, 0x2e,
-	0x8c, 0x25, 0xf8, 0x2f, 0x1f, 0x44, 0x51, 0x7f, 0xaf, 0xf3, 0x2a, 0xd8, 0xc5, 0x9e, 0x99, 0xaf,
-	0x82, 0x5a, 0xde, 0x29, 0x2a, 0xdb, 0xe3, 0xd8, 0x64, 0x3c, 0x80, 0x26, 0xea, 0xf7, 0xe9, 0xa8,
-	0xe3, 0xd3, 0x21, 0x66, 0x32, 0x7d, 0xc7, 0xa5, 0x44, 0x30, 0xda, 0x37, 0x0b, 0x12, 0xbe, 0x20,
-	0xfd, 0x1b, 0x13, 0xf7, 0x9a, 0xf2, 0x36, 0xf3, 0x6f, 0xf6, 0x2b, 0xa9, 0x1f, 0xfb, 0x15, 0xb0,
-	0xfc, 0x1d, 0xc0, 0x85, 0x6d, 0x99, 0xf3, 0x29, 0x71, 0x19, 0x46, 0x1c, 0x5f, 0x09, 0x01, 0xdc,
-	0x81, 0xf3, 0x02, 0x31, 0x1f, 0x8b, 0x0e, 0xf2, 0x3c, 0x86, 0x39, 0xd7, 0x3a, 0xf8, 0x4f, 0x59,
-	0x5b, 0xca, 0x98, 0xe0, 0xf9, 0x71, 0xc2, 0x73, 0x1d, 0x5f, 0x1d, 0x9e, 0x09, 0x02, 0x1f, 0x00,
-	0x34, 0xb7, 0x63, 0x66, 0x61, 0x40, 0x02, 0x2e, 0x18, 0x12, 0xf4, 0xe2, 0xb3, 0x5a, 0x82, 0x59,
-	0x0f, 0x13, 0x1a, 0x4a, 0x06, 0x05, 0x47, 0x6d, 0x8c, 0x87, 0x30, 0xa7, 0x84, 0x68, 0x66, 0xfe,
-	0x4c, 0xbf, 0x3a, 0x2c, 0xd1, 0xf5, 0x5b, 0x00, 0x6f, 0x3a, 0x38, 0xa4, 0x43, 0xfc, 0x37, 0x1a,
-	0x5f, 0x81, 0xff, 0x33, 0x59, 0xcc, 0x4b, 0xc8, 0x22, 0x5d, 0x2b, 0x38, 0xf3, 0xda, 0x7c, 0x56,
-	0x17, 0xef, 0x01, 0x2c, 0xad, 0xed, 0x20, 0xe2, 0x63, 0x75, 0x19, 0x5c, 0x52, 0x67, 0x2d, 0x08,
-	0x09, 0x1e, 0x75, 0xf4, 0xd5, 0x94, 0x39, 0xf7, 0xd5, 0x54, 0x20, 0x78, 0xa4, 0x96, 0x89, 0x9e,
-	0x7f, 0x01, 0xb8, 0xf0, 0x22, 0x10, 0x3b, 0x1e, 0x43, 0xa3, 0x47, 0xdc, 0x65, 0x74, 0x74, 0x49,
-	0x5d, 0xbb, 0x13, 0x85, 0x2b, 0x21, 0xcc, 0x50, 0xf8, 0xbd, 0x58, 0x00, 0xef, 0xbe, 0x56, 0x6a,
-	0xe7, 0x54, 0x38, 0x9f, 0x31, 0xca, 0xd9, 0xd9, 0xa3, 0xfc, 0x49, 0x4d, 0xc2, 0x7a, 0xdc, 0xe2,
-	0x26, 0x16, 0xc8, 0x43, 0x02, 0x5d, 0xf8, 0x00, 0x06, 0x30, 0x1f, 0xea, 0x5c, 0x7a, 0x9c, 0x6f,
-	0x9d, 0x92, 0x25, 0xbd, 0x09, 0xd9, 0x71, 0xc1, 0x76, 0x53, 0x8f, 0x74, 0x63, 0x26, 0xe1, 0x5d,
-	0xf5, 0xbe, 0x2b, 0xde, 0xe3, 0x58, 0x67, 0x52, 0xaa, 0x99, 0x89, 0x59, 0xb5, 0xfd, 0x83, 0xe3,
-	0x32, 0x38, 0x3c, 0x2e, 0x83, 0x6f, 0xc7, 0x65, 0xf0, 0xfa, 0xa4, 0x9c, 0x3a, 0x3c, 0x29, 0xa7,
-	0x3e, 0x9f, 0x94, 0x53, 0xf0, 0x7a, 0x40, 0xa7, 0xaa, 0x64, 0x0b, 0xbc, 0x4c, 0x16, 0x3e, 0x85,
-	0xac, 0x06, 0x34, 0xb1, 0xb3, 0x77, 0xc7, 0x0f, 0xbf, 0xec, 0xa0, 0x9b, 0x93, 0x0f, 0xfe, 0xfd,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x41, 0xe2, 0xdb, 0x22, 0xcf, 0x08, 0x00, 0x00,
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x31, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0x5c, 0x6c, 0xc7, 0x1e, 0x43, 0x10, 0x8b, 0x15, 0xf6, 0x0e, 0x61, 0x3b, 0x11, 0xdc,
+	0xb9, 0xb9, 0x5d, 0x12, 0x1a, 0x94, 0x06, 0xd9, 0x09, 0x39, 0x90, 0x38, 0x29, 0xda, 0x80, 0x90,
+	0x68, 0xac, 0xf1, 0xee, 0x63, 0xb3, 0xca, 0xee, 0xcc, 0x6a, 0x66, 0x6c, 0x27, 0x3f, 0x80, 0x9e,
+	0x92, 0x0a, 0x5d, 0x4d, 0x87, 0xe8, 0xa9, 0xaf, 0x23, 0x25, 0xa2, 0x38, 0x50, 0x22, 0x24, 0x4a,
+	0x5a, 0xa4, 0x2b, 0xd0, 0xce, 0x8c, 0xd7, 0xab, 0x3b, 0x63, 0x39, 0xca, 0xf9, 0xa4, 0xab, 0x76,
+	0xdf, 0xbc, 0x6f, 0xde, 0xbc, 0x6f, 0xe6, 0x7b, 0x33, 0x0f, 0xbf, 0x97, 0x72, 0x36, 0x06, 0x4a,
+	0xa8, 0x0f, 0x6e, 0x42, 0xf8, 0x29, 0x70, 0x77, 0xbc, 0xe3, 0xa6, 0x9c, 0xa5, 0x4c, 0x90, 0x58,
+	0x38, 0x29, 0x67, 0x92, 0x59, 0xcd, 0x19, 0xca, 0xd1, 0x28, 0x67, 0xbc, 0x73, 0xa7, 0x19, 0xb2,
+	0x90, 0x29, 0x80, 0x9b, 0xfd, 0x69, 0xec, 0x9d, 0x96, 0xcf, 0x44, 0xc2, 0x84, 0x3b, 0x24, 0xf4,
+	0xd4, 0x1d, 0xef, 0x0c, 0x41, 0x92, 0x1d, 0x65, 0x3c, 0xe7, 0x17, 0x90, 0xfb, 0x7d, 0x16, 0x51,
+	0xe3, 0xdf, 0x9a, 0x9b, 0x91, 0x59, 0x55, 0x43, 0xee, 0xce, 0x85, 0x10, 0xdf, 0x07, 0x21, 0x42,
+	0x4e, 0xa8, 0xd4, 0xb8, 0xed, 0x7f, 0xd7, 0xf0, 0x9b, 0xbd, 0x20, 0x78, 0xa8, 0x20, 0x47, 0x86,
+	0x93, 0xd5, 0xc4, 0x15, 0x19, 0xc9, 0x18, 0x6c, 0xd4, 0x41, 0xdd, 0xba, 0xa7, 0x0d, 0xab, 0x83,
+	0x1b, 0x01, 0x08, 0x9f, 0x47, 0xa9, 0x8c, 0x18, 0xb5, 0x6f, 0x29, 0x5f, 0x71, 0xc8, 0x1a, 0xe2,
+	0x2a, 0x49, 0xd8, 0x88, 0x4a, 0x7b, 0xad, 0x83, 0xba, 0x8d, 0xdd, 0xdb, 0x8e, 0x66, 0xe2, 0x64,
+	0x4c, 0x1c, 0xc3, 0xc4, 0xd9, 0x67, 0x11, 0xed, 0xbb, 0x8f, 0x9f, 0xb4, 0x4b, 0xbf, 0x3f, 0x69,
+	0xdf, 0x0b, 0x23, 0x79, 0x32, 0x1a, 0x3a, 0x3e, 0x4b, 0x5c, 0x43, 0x5b, 0x7f, 0xee, 0x8b, 0xe0,
+	0xd4, 0x95, 0xe7, 0x29, 0x08, 0x35, 0xc1, 0x33, 0x91, 0x2d, 0x1b, 0xaf, 0x27, 0x84, 0x92, 0x10,
+	0xb8, 0x5d, 0x56, 0x19, 0x4c, 0x4d, 0x6b, 0x0f, 0x57, 0x85, 0x24, 0x72, 0x24, 0xec, 0x4a, 0x07,
+	0x75, 0x37, 0x76, 0xb7, 0x9d, 0x79, 0x67, 0xe2, 0x68, 0xae, 0xc7, 0x0a, 0xe9, 0x99, 0x19, 0x56,
+	0x0f, 0x37, 0x34, 0x62, 0x90, 0x2d, 0x69, 0x57, 0x55, 0x80, 0xce, 0xa2, 0x00, 0x5f, 0x9c, 0xa7,
+	0xe0, 0xe1, 0x24, 0xff, 0xb7, 0x3e, 0xc5, 0x0d, 0xbd, 0xbf, 0x83, 0x38, 0x12, 0xd2, 0x5e, 0xef,
+	0xac, 0x75, 0x1b, 0xbb, 0x5b, 0xf3, 0x43, 0xf4, 0x14, 0xf0, 0x41, 0x76, 0x10, 0xfd, 0x72, 0xb6,
+	0x13, 0x1e, 0xd6, 0x73, 0x3f, 0x8f, 0x84, 0xb4, 0xb6, 0xf0, 0x6b, 0x62, 0x94, 0xa6, 0xf1, 0xf9,
+	0xe0, 0x9b, 0xe8, 0x0c, 0x02, 0xbb, 0xd6, 0x41, 0xdd, 0x9a, 0xd7, 0xd0, 0x63, 0x87, 0xd9, 0x90,
+	0xf5, 0x11, 0xb6, 0x49, 0x1c, 0xb3, 0xc9, 0x20, 0x64, 0x63, 0xe0, 0x2a, 0xfc, 0xc0, 0x67, 0x54,
+	0x72, 0x16, 0xdb, 0x75, 0x05, 0xdf, 0x54, 0xfe, 0x07, 0xb9, 0x7b, 0x5f, 0x7b, 0xf7, 0x6a, 0xdf,
+	0x3f, 0x6a, 0x97, 0xfe, 0x7e, 0xd4, 0x46, 0xdb, 0x7f, 0x21, 0xbc, 0x79, 0xac, 0x62, 0x7e, 0x46,
+	0x7d, 0x0e, 0x44, 0xc0, 0x2b, 0x21, 0x80, 0xf7, 0xf1, 0x86, 0x24, 0x3c, 0x04, 0x39, 0x20, 0x41,
+	0xc0, 0x41, 0x08, 0xa3, 0x83, 0xd7, 0xf5, 0x68, 0x4f, 0x0f, 0x16, 0x78, 0xfe, 0x92, 0xf3, 0x3c,
+	0x80, 0x57, 0x87, 0x67, 0x81, 0xc0, 0xcf, 0x08, 0xdb, 0xc7, 0x19, 0xb3, 0x24, 0xa2, 0x91, 0x90,
+	0x9c, 0x48, 0x76, 0xf3, 0x5a, 0x6d, 0xe2, 0x4a, 0x00, 0x94, 0x25, 0x8a, 0x41, 0xdd, 0xd3, 0x86,
+	0xf5, 0x31, 0xae, 0x6a, 0x21, 0xda, 0xe5, 0xeb, 0xe9, 0xd7, 0x4c, 0x2b, 0x64, 0xfd, 0x03, 0xc2,
+	0xef, 0x78, 0x90, 0xb0, 0x31, 0xbc, 0x8c, 0xc4, 0xef, 0xe1, 0x37, 0xb8, 0x5a, 0x2c, 0x28, 0xc8,
+	0x62, 0xad, 0x5b, 0xf7, 0x36, 0xcc, 0xf0, 0xf3, 0xba, 0xf8, 0x09, 0xe1, 0xe6, 0xfe, 0x09, 0xa1,
+	0x21, 0xe8, 0xcb, 0x60, 0x45, 0x99, 0xf5, 0x30, 0xa6, 0x30, 0x19, 0x98, 0xab, 0xa9, 0xbc, 0xf4,
+	0xd5, 0x54, 0xa7, 0x30, 0xd1, 0xbf, 0x85, 0x9c, 0x9f, 0x22, 0xbc, 0xf9, 0x55, 0x24, 0x4f, 0x02,
+	0x4e, 0x26, 0x9f, 0x08, 0x9f, 0xb3, 0xc9, 0x8a, 0xb2, 0xf6, 0x73, 0x85, 0x6b, 0x21, 0x2c, 0x50,
+	0xf8, 0x07, 0x99, 0x00, 0x7e, 0xfc, 0xa3, 0xdd, 0x5d, 0x52, 0xe1, 0x62, 0x41, 0x29, 0x57, 0x16,
+	0x97, 0xf2, 0xaf, 0xba, 0x12, 0x0e, 0xb2, 0x14, 0x1f, 0x82, 0x24, 0x01, 0x91, 0xe4, 0xc6, 0x1b,
+	0x30, 0xc2, 0xb5, 0xc4, 0xc4, 0x32, 0xe5, 0xfc, 0xee, 0x8c, 0x2c, 0x3d, 0xcd, 0xc9, 0x4e, 0x17,
+	0xec, 0xef, 0x99, 0x92, 0xde, 0x5d, 0x48, 0xf8, 0x4c, 0xbf, 0xef, 0x9a, 0xf7, 0x74, 0xae, 0x97,
+	0x2f, 0xb5, 0x57, 0xce, 0x58, 0x6d, 0x5f, 0x20, 0x7c, 0xfb, 0xcb, 0x34, 0x20, 0x12, 0xf4, 0xe1,
+	0x1f, 0xc6, 0x24, 0x5c, 0x95, 0x12, 0x9f, 0x7d, 0x57, 0xca, 0xd7, 0x7b, 0x57, 0x2a, 0x4b, 0xbe,
+	0x2b, 0xdf, 0x22, 0xfc, 0xd6, 0x11, 0x19, 0x09, 0x78, 0x41, 0x5d, 0xc5, 0x7c, 0x32, 0x9b, 0xb8,
+	0x9a, 0x66, 0x8b, 0x4c, 0x69, 0x18, 0xab, 0x90, 0xc7, 0x3f, 0xb7, 0xb0, 0xd5, 0x0b, 0x58, 0xaa,
+	0xe5, 0xb2, 0xa2, 0x34, 0xfe, 0xbf, 0x1d, 0x79, 0xa6, 0xa5, 0xa8, 0xdc, 0xbc, 0xa5, 0xa8, 0xbe,
+	0xb8, 0x96, 0x62, 0xfd, 0x7a, 0x47, 0x5f, 0x5b, 0xf2, 0xe8, 0x9f, 0xea, 0xfa, 0x3c, 0x84, 0xcc,
+	0x37, 0x06, 0x2e, 0x22, 0x46, 0x57, 0xb7, 0xf1, 0x40, 0xc9, 0x30, 0xce, 0x05, 0x30, 0x35, 0x2d,
+	0x0b, 0x97, 0x53, 0x66, 0xf4, 0x5a, 0xf7, 0xd4, 0xbf, 0xd5, 0xc7, 0x65, 0x4e, 0xa4, 0x6e, 0xec,
+	0xea, 0x7d, 0xc7, 0x14, 0xf0, 0xdd, 0x25, 0x6e, 0xac, 0x03, 0xf0, 0x3d, 0x35, 0x37, 0x53, 0x1c,
+	0xe3, 0xc4, 0x8f, 0x41, 0xed, 0x5e, 0xdd, 0x33, 0xd6, 0x8c, 0x7e, 0x3f, 0x7c, 0x7c, 0xd9, 0x42,
+	0x17, 0x97, 0x2d, 0xf4, 0xe7, 0x65, 0x0b, 0x7d, 0x77, 0xd5, 0x2a, 0x5d, 0x5c, 0xb5, 0x4a, 0xbf,
+	0x5d, 0xb5, 0x4a, 0xf8, 0xed, 0x88, 0xcd, 0x3d, 0xb6, 0x23, 0xf4, 0x75, 0xf1, 0x16, 0x99, 0x41,
+	0xee, 0x47, 0xac, 0x60, 0xb9, 0x67, 0xd3, 0x2e, 0x5e, 0x25, 0x35, 0xac, 0xaa, 0xee, 0xfd, 0xc3,
+	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x66, 0x36, 0x2b, 0x9c, 0x0c, 0x00, 0x00,
 }
 
 func (this *AddMarkerProposal) Equal(that interface{}) bool {
@@ -911,43 +1260,204 @@ func (this *WithdrawEscrowProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UpdateMarkerFlagsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddMarkerProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AddMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
+	that1, ok := that.(*UpdateMarkerFlagsProposal)
+	if !ok {
+		that2, ok := that.(UpdateMarkerFlagsProposal)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x48
 	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.SupplyFixed != that1.SupplyFixed {
+		return false
+	}
+	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
+		return false
+	}
+	return true
+}
+func (this *PauseMarkerProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseMarkerProposal)
+	if !ok {
+		that2, ok := that.(PauseMarkerProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
+}
+func (this *AdoptDenomProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdoptDenomProposal)
+	if !ok {
+		that2, ok := that.(AdoptDenomProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Manager != that1.Manager {
+		return false
+	}
+	if this.MarkerType != that1.MarkerType {
+		return false
+	}
+	if len(this.AccessList) != len(that1.AccessList) {
+		return false
+	}
+	for i := range this.AccessList {
+		if !this.AccessList[i].Equal(&that1.AccessList[i]) {
+			return false
+		}
+	}
+	if this.SupplyFixed != that1.SupplyFixed {
+		return false
+	}
+	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
+		return false
+	}
+	return true
+}
+func (this *SetFeeConversionProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetFeeConversionProposal)
+	if !ok {
+		that2, ok := that.(SetFeeConversionProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Pool != that1.Pool {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.Oracle != that1.Oracle {
+		return false
+	}
+	return true
+}
+func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMarkerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x40
 	}
@@ -1382,155 +1892,304 @@ func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposals(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateMarkerFlagsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AddMarkerProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpdateMarkerFlagsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMarkerFlagsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovProposals(uint64(m.Status))
-	}
-	if m.MarkerType != 0 {
-		n += 1 + sovProposals(uint64(m.MarkerType))
-	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.SupplyFixed {
-		n += 2
-	}
-	if m.AllowGovernanceControl {
-		n += 2
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *SupplyIncreaseProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SupplyDecreaseProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseMarkerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseMarkerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetAdministratorProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdoptDenomProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AdoptDenomProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdoptDenomProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovProposals(uint64(l))
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	return n
+	if m.MarkerType != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveAdministratorProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetFeeConversionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFeeConversionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFeeConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.RemovedAddress) > 0 {
-		for _, s := range m.RemovedAddress {
-			l = len(s)
-			n += 1 + l + sovProposals(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeStatusProposal) Size() (n int) {
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddMarkerProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1544,9 +2203,146 @@ func (m *ChangeStatusProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProposals(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovProposals(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	return n
+}
+
+func (m *SupplyIncreaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyDecreaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *SetAdministratorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveAdministratorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.RemovedAddress) > 0 {
+		for _, s := range m.RemovedAddress {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChangeStatusProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
 	}
 	if m.NewStatus != 0 {
 		n += 1 + sovProposals(uint64(m.NewStatus))
@@ -1604,6 +2400,131 @@ func (m *SetDenomMetadataProposal) Size() (n int) {
 	return n
 }
 
+func (m *UpdateMarkerFlagsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	return n
+}
+
+func (m *PauseMarkerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdoptDenomProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovProposals(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetFeeConversionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1705,9 +2626,806 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyIncreaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyIncreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyDecreaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAdministratorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAdministratorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1717,28 +3435,27 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1766,32 +3483,63 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeStatusProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1801,16 +3549,29 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1820,31 +3581,29 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposals
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposals
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1854,17 +3613,29 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
-			var v int
+			m.NewStatus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1874,12 +3645,11 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NewStatus |= MarkerStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1901,7 +3671,7 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
+func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1924,10 +3694,10 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyIncreaseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawEscrowProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyIncreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawEscrowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1995,6 +3765,38 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2023,11 +3825,12 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
@@ -2080,7 +3883,7 @@ func (m *SupplyIncreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
+func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +3906,10 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyDecreaseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyDecreaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2175,7 +3978,7 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2202,7 +4005,7 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2227,7 +4030,7 @@ func (m *SupplyDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateMarkerFlagsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,10 +4053,10 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetAdministratorProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMarkerFlagsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMarkerFlagsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2353,10 +4156,10 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2366,26 +4169,32 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SupplyFixed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
 			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.AllowGovernanceControl = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2407,7 +4216,7 @@ func (m *SetAdministratorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
+func (m *PauseMarkerProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2430,10 +4239,10 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveAdministratorProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseMarkerProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveAdministratorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseMarkerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2533,10 +4342,10 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2546,24 +4355,12 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovedAddress = append(m.RemovedAddress, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2585,7 +4382,7 @@ func (m *RemoveAdministratorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
+func (m *AdoptDenomProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,10 +4405,10 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeStatusProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdoptDenomProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdoptDenomProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2711,10 +4508,42 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
 			}
-			m.NewStatus = 0
+			m.MarkerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2724,11 +4553,85 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewStatus |= MarkerStatus(b&0x7F) << shift
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AllowGovernanceControl = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -2750,7 +4653,7 @@ func (m *ChangeStatusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
+func (m *SetFeeConversionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2773,10 +4676,10 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawEscrowProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetFeeConversionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawEscrowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetFeeConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2876,10 +4779,10 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -2889,29 +4792,15 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2939,61 +4828,11 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProposals(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProposals
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3021,11 +4860,13 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3053,40 +4894,7 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
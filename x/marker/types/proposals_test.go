@@ -197,3 +197,76 @@ func TestProposalTypeSetDenomMetadataProposal_Format(t *testing.T) {
   Metadata:    %s
 `, m.Metadata.String()), m.String())
 }
+
+func TestProposalTypeUpdateMarkerFlags_Format(t *testing.T) {
+	m := NewUpdateMarkerFlagsProposal("title", "description", "test", true, false)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeUpdateMarkerFlags, m.ProposalType())
+
+	err := m.ValidateBasic()
+	require.NoError(t, err)
+	require.Equal(t, `MarkerAccount Update Flags Proposal:
+  Marker:      test
+  Title:       title
+  Description: description
+  Supply Fixed: true
+  Allow Governance Control: false
+`, m.String())
+}
+
+func TestProposalTypeAdoptDenom_Format(t *testing.T) {
+	manager := sdk.AccAddress("manager_____________")
+	m := NewAdoptDenomProposal("title", "description", "legacy", manager, MarkerType_RestrictedCoin, nil, true, true)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeAdoptDenom, m.ProposalType())
+
+	err := m.ValidateBasic()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`Adopt Denom Proposal:
+  Marker:      legacy
+  Title:       title
+  Description: description
+  Manager:     %s
+  Type:        MARKER_TYPE_RESTRICTED
+`, manager), m.String())
+
+	m.MarkerType = MarkerType_Basket
+	require.EqualError(t, m.ValidateBasic(), "cannot adopt a denom as a MARKER_TYPE_BASKET marker: invalid proposal content")
+	m.MarkerType = MarkerType_Coin
+	m.Manager = "invalid"
+	require.EqualError(t, m.ValidateBasic(), "invalid manager: decoding bech32 failed: invalid bech32 string length 7: invalid proposal content")
+}
+
+func TestProposalTypeSetFeeConversion_Format(t *testing.T) {
+	pool := sdk.AccAddress("pool________________")
+	oracle := sdk.AccAddress("oracle______________")
+	m := NewSetFeeConversionProposal("title", "description", "gascoin", true, pool, sdk.MustNewDecFromStr("0.5"), oracle)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeSetFeeConversion, m.ProposalType())
+
+	require.NoError(t, m.ValidateBasic())
+	require.Equal(t, fmt.Sprintf(`Set Fee Conversion Proposal:
+  Marker:      gascoin
+  Title:       title
+  Description: description
+  Enabled:     true
+  Pool:        %s
+  Rate:        0.500000000000000000
+  Oracle:      %s
+`, pool, oracle), m.String())
+
+	m.Oracle = ""
+	require.EqualError(t, m.ValidateBasic(),
+		"invalid fee conversion gascoin oracle: empty address string is not allowed: invalid proposal content")
+
+	// the conversion is only required when it is being enabled
+	disable := NewSetFeeConversionProposal("title", "description", "gascoin", false, nil, sdk.Dec{}, nil)
+	require.NoError(t, disable.ValidateBasic())
+	require.Contains(t, disable.String(), "Enabled:     false")
+}
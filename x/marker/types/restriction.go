@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ RestrictionChecker = RestrictionCheckers{}
+
+// RestrictionCheckers runs each of its restriction checkers in order.
+type RestrictionCheckers []RestrictionChecker
+
+// NewRestrictionCheckers combines the checkers into one that runs each of them in the order given.
+func NewRestrictionCheckers(checkers ...RestrictionChecker) RestrictionCheckers {
+	return checkers
+}
+
+// CheckTransferRestriction runs the CheckTransferRestriction of each of the checkers and returns the first error.
+func (c RestrictionCheckers) CheckTransferRestriction(
+	ctx sdk.Context, marker MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin,
+) error {
+	for i := range c {
+		if err := c[i].CheckTransferRestriction(ctx, marker, from, to, admin, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewScheduledTransfer creates a new transfer of the amount of restricted coin released at the height and/or time.
+func NewScheduledTransfer(
+	id uint64, administrator, from, to sdk.AccAddress, amount sdk.Coin, releaseHeight int64, releaseTime *time.Time,
+) ScheduledTransfer {
+	return ScheduledTransfer{
+		Id:            id,
+		Administrator: administrator.String(),
+		FromAddress:   from.String(),
+		ToAddress:     to.String(),
+		Amount:        amount,
+		ReleaseHeight: releaseHeight,
+		ReleaseTime:   releaseTime,
+	}
+}
+
+// Validate ensures the scheduled transfer is valid.
+func (t ScheduledTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Administrator); err != nil {
+		return fmt.Errorf("invalid scheduled transfer %d administrator: %w", t.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.FromAddress); err != nil {
+		return fmt.Errorf("invalid scheduled transfer %d from address: %w", t.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.ToAddress); err != nil {
+		return fmt.Errorf("invalid scheduled transfer %d to address: %w", t.Id, err)
+	}
+	if !t.Amount.IsValid() || !t.Amount.IsPositive() {
+		return fmt.Errorf("invalid scheduled transfer %d amount: %s", t.Id, t.Amount)
+	}
+	if t.ReleaseHeight < 0 {
+		return fmt.Errorf("invalid scheduled transfer %d: release height cannot be negative", t.Id)
+	}
+	if t.ReleaseHeight == 0 && t.ReleaseTime == nil {
+		return fmt.Errorf("invalid scheduled transfer %d: a release height or time is required", t.Id)
+	}
+	return nil
+}
+
+// IsDue returns true once the block has reached both the release height and the release time that are set.
+func (t ScheduledTransfer) IsDue(height int64, blockTime time.Time) bool {
+	if t.ReleaseHeight > 0 && height < t.ReleaseHeight {
+		return false
+	}
+	if t.ReleaseTime != nil && blockTime.Before(*t.ReleaseTime) {
+		return false
+	}
+	return true
+}
+
+// SenderAddress returns the address the coin of the transfer was taken from.
+func (t ScheduledTransfer) SenderAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(t.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// RecipientAddress returns the address the coin of the transfer is sent to.
+func (t ScheduledTransfer) RecipientAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(t.ToAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
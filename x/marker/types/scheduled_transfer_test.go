@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScheduledTransferValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	to := sdk.AccAddress("to__________________")
+	amount := sdk.NewInt64Coin("lockedcoin", 10)
+	releaseTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		transfer ScheduledTransfer
+		errMsg   string
+	}{
+		{"valid release height", NewScheduledTransfer(1, admin, admin, to, amount, 10, nil), ""},
+		{"valid release time", NewScheduledTransfer(1, admin, admin, to, amount, 0, &releaseTime), ""},
+		{"valid release height and time", NewScheduledTransfer(1, admin, admin, to, amount, 10, &releaseTime), ""},
+		{
+			"invalid administrator",
+			ScheduledTransfer{Id: 2, FromAddress: admin.String(), ToAddress: to.String(), Amount: amount, ReleaseHeight: 1},
+			"invalid scheduled transfer 2 administrator: empty address string is not allowed",
+		},
+		{
+			"invalid from address",
+			ScheduledTransfer{Id: 2, Administrator: admin.String(), ToAddress: to.String(), Amount: amount, ReleaseHeight: 1},
+			"invalid scheduled transfer 2 from address: empty address string is not allowed",
+		},
+		{
+			"invalid to address",
+			ScheduledTransfer{Id: 2, Administrator: admin.String(), FromAddress: admin.String(), Amount: amount, ReleaseHeight: 1},
+			"invalid scheduled transfer 2 to address: empty address string is not allowed",
+		},
+		{"zero amount", NewScheduledTransfer(1, admin, admin, to, sdk.NewInt64Coin("lockedcoin", 0), 10, nil), "invalid scheduled transfer 1 amount: 0lockedcoin"},
+		{"negative release height", NewScheduledTransfer(1, admin, admin, to, amount, -1, nil), "invalid scheduled transfer 1: release height cannot be negative"},
+		{"no release", NewScheduledTransfer(1, admin, admin, to, amount, 0, nil), "invalid scheduled transfer 1: a release height or time is required"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.transfer.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduledTransferIsDue(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	amount := sdk.NewInt64Coin("lockedcoin", 10)
+	releaseTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := releaseTime.Add(-time.Second)
+
+	byHeight := NewScheduledTransfer(1, admin, admin, admin, amount, 10, nil)
+	require.False(t, byHeight.IsDue(9, releaseTime))
+	require.True(t, byHeight.IsDue(10, before))
+
+	byTime := NewScheduledTransfer(1, admin, admin, admin, amount, 0, &releaseTime)
+	require.False(t, byTime.IsDue(100, before))
+	require.True(t, byTime.IsDue(1, releaseTime))
+
+	both := NewScheduledTransfer(1, admin, admin, admin, amount, 10, &releaseTime)
+	require.False(t, both.IsDue(10, before), "time not reached")
+	require.False(t, both.IsDue(9, releaseTime), "height not reached")
+	require.True(t, both.IsDue(10, releaseTime))
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplyChange creates a new change of the supply of a marker's coin by the delta, leaving the supply.
+func NewSupplyChange(
+	id uint64, denom string, height int64, blockTime time.Time, delta, supply sdk.Int, actor sdk.AccAddress,
+	reason SupplyChangeReason,
+) SupplyChange {
+	change := SupplyChange{
+		Id:     id,
+		Denom:  denom,
+		Height: height,
+		Time:   blockTime,
+		Delta:  delta,
+		Supply: supply,
+		Reason: reason,
+	}
+	if !actor.Empty() {
+		change.Actor = actor.String()
+	}
+	return change
+}
+
+// Validate ensures the supply change is valid.
+func (c SupplyChange) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid supply change %d denom: %w", c.Id, err)
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("invalid supply change %d: height cannot be negative", c.Id)
+	}
+	if c.Delta.IsNil() || c.Delta.IsZero() {
+		return fmt.Errorf("invalid supply change %d: delta cannot be zero", c.Id)
+	}
+	if c.Supply.IsNil() || c.Supply.IsNegative() {
+		return fmt.Errorf("invalid supply change %d: supply cannot be negative", c.Id)
+	}
+	if len(c.Actor) > 0 {
+		if _, err := sdk.AccAddressFromBech32(c.Actor); err != nil {
+			return fmt.Errorf("invalid supply change %d actor: %w", c.Id, err)
+		}
+	}
+	if _, ok := SupplyChangeReason_name[int32(c.Reason)]; !ok || c.Reason == SupplyChangeReason_Unspecified {
+		return fmt.Errorf("invalid supply change %d reason: %s", c.Id, c.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSupplyChangeValidate(t *testing.T) {
+	actor := sdk.AccAddress("actor_______________")
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name   string
+		change SupplyChange
+		errMsg string
+	}{
+		{"valid mint", NewSupplyChange(1, "testcoin", 5, blockTime, sdk.NewInt(10), sdk.NewInt(110), actor, SupplyChangeReason_Mint), ""},
+		{"valid burn", NewSupplyChange(1, "testcoin", 5, blockTime, sdk.NewInt(-10), sdk.ZeroInt(), actor, SupplyChangeReason_Burn), ""},
+		{"valid without actor", NewSupplyChange(1, "testcoin", 5, blockTime, sdk.NewInt(10), sdk.NewInt(10), nil, SupplyChangeReason_Mint), ""},
+		{"invalid denom", NewSupplyChange(2, "1", 5, blockTime, sdk.NewInt(10), sdk.NewInt(10), actor, SupplyChangeReason_Mint), "invalid supply change 2 denom: invalid denom: 1"},
+		{"negative height", NewSupplyChange(2, "testcoin", -1, blockTime, sdk.NewInt(10), sdk.NewInt(10), actor, SupplyChangeReason_Mint), "invalid supply change 2: height cannot be negative"},
+		{"zero delta", NewSupplyChange(2, "testcoin", 5, blockTime, sdk.ZeroInt(), sdk.NewInt(10), actor, SupplyChangeReason_Mint), "invalid supply change 2: delta cannot be zero"},
+		{"negative supply", NewSupplyChange(2, "testcoin", 5, blockTime, sdk.NewInt(10), sdk.NewInt(-1), actor, SupplyChangeReason_Mint), "invalid supply change 2: supply cannot be negative"},
+		{
+			"invalid actor",
+			SupplyChange{Id: 2, Denom: "testcoin", Delta: sdk.NewInt(10), Supply: sdk.NewInt(10), Actor: "foo", Reason: SupplyChangeReason_Mint},
+			"invalid supply change 2 actor: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{"unspecified reason", NewSupplyChange(2, "testcoin", 5, blockTime, sdk.NewInt(10), sdk.NewInt(10), actor, SupplyChangeReason_Unspecified), "invalid supply change 2 reason: SUPPLY_CHANGE_REASON_UNSPECIFIED"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSupplyChangeHeightKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := SupplyChangeHeightKey(12, addr, 3)
+	height, keyAddr, id := SplitSupplyChangeHeightKey(key)
+	require.Equal(t, int64(12), height)
+	require.Equal(t, addr, keyAddr)
+	require.Equal(t, uint64(3), id)
+}
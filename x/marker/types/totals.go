@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarkerTotal creates a new marker total for the markers of the given type and status.
+func NewMarkerTotal(markerType MarkerType, status MarkerStatus, count uint64, supply sdk.Coins, escrow sdk.Coins) MarkerTotal {
+	return MarkerTotal{
+		MarkerType: markerType,
+		Status:     status,
+		Count:      count,
+		Supply:     supply,
+		Escrow:     escrow,
+	}
+}
+
+// Add returns the total with the count, supply, and escrow of the other total added to it.
+func (t MarkerTotal) Add(other MarkerTotal) MarkerTotal {
+	return NewMarkerTotal(t.MarkerType, t.Status, t.Count+other.Count, t.Supply.Add(other.Supply...), t.Escrow.Add(other.Escrow...))
+}
+
+// Sub returns the total with the count, supply, and escrow of the other total removed from it.  Amounts that would
+// go below zero are dropped.
+func (t MarkerTotal) Sub(other MarkerTotal) MarkerTotal {
+	count := uint64(0)
+	if t.Count > other.Count {
+		count = t.Count - other.Count
+	}
+	return NewMarkerTotal(t.MarkerType, t.Status, count, subCoins(t.Supply, other.Supply), subCoins(t.Escrow, other.Escrow))
+}
+
+// IsEmpty returns true if the total does not include any markers.
+func (t MarkerTotal) IsEmpty() bool {
+	return t.Count == 0 && t.Supply.IsZero() && t.Escrow.IsZero()
+}
+
+// subCoins returns the coins minus the amounts of the other coins, dropping any that would be negative.
+func subCoins(coins sdk.Coins, other sdk.Coins) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		if amount := coin.Amount.Sub(other.AmountOf(coin.Denom)); amount.IsPositive() {
+			result = result.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return result
+}
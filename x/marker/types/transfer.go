@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// transferDenyErrors are the registered errors returned for each of the reasons a transfer is denied.
+var transferDenyErrors = map[TransferDenyReason]*sdkerrors.Error{
+	TransferDenyReason_MarkerNotFound:     ErrMarkerNotFound,
+	TransferDenyReason_NotRestricted:      ErrNotRestrictedCoin,
+	TransferDenyReason_NoTransferGrant:    ErrNoTransferGrant,
+	TransferDenyReason_NoAuthorization:    ErrNoTransferAuthorization,
+	TransferDenyReason_AuthorizationLimit: ErrTransferLimitExceeded,
+	TransferDenyReason_OnDenyList:         ErrOnDenyList,
+	TransferDenyReason_InsufficientFunds:  sdkerrors.ErrInsufficientFunds,
+	TransferDenyReason_Paused:             ErrMarkerPaused,
+	TransferDenyReason_NoDepositGrant:     ErrNoDepositGrant,
+	TransferDenyReason_Vetoed:             ErrTransferVetoed,
+}
+
+// MaxTransferMemoPatternLength is the longest pattern a marker can require the memo of its transfers to match.
+const MaxTransferMemoPatternLength = 256
+
+// ValidateTransferMemoPattern returns an error if the pattern is too long or is not a valid regular expression.  An
+// empty pattern, requiring no memo, is valid.
+func ValidateTransferMemoPattern(pattern string) error {
+	if len(pattern) > MaxTransferMemoPatternLength {
+		return fmt.Errorf("transfer memo pattern length %d exceeds maximum length of %d", len(pattern), MaxTransferMemoPatternLength)
+	}
+	if _, err := compileTransferMemoPattern(pattern); err != nil {
+		return fmt.Errorf("invalid transfer memo pattern %q: %w", pattern, err)
+	}
+	return nil
+}
+
+// TransferMemoMatches returns true if the whole memo matches the pattern, or the pattern is empty.
+func TransferMemoMatches(pattern string, memo string) (bool, error) {
+	if len(pattern) == 0 {
+		return true, nil
+	}
+	re, err := compileTransferMemoPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(memo), nil
+}
+
+// txMemoKey is the context key of the memo of the tx being executed.
+type txMemoKey struct{}
+
+// WithTxMemo returns the context carrying the memo of the tx being executed, set by the ante handler so the transfers
+// made by the tx are checked against the transfer memo requirement of their marker.
+func WithTxMemo(ctx sdk.Context, memo string) sdk.Context {
+	return ctx.WithValue(txMemoKey{}, memo)
+}
+
+// TxMemo returns the memo of the tx being executed, empty when the context does not carry one.
+func TxMemo(ctx sdk.Context) string {
+	memo, _ := ctx.Value(txMemoKey{}).(string)
+	return memo
+}
+
+// compileTransferMemoPattern compiles the pattern anchored so that it has to match the whole memo.
+func compileTransferMemoPattern(pattern string) (*regexp.Regexp, error) {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, err
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// NewTransferDenial creates a new TransferDenial with the detail formatted from the arguments.
+func NewTransferDenial(reason TransferDenyReason, format string, args ...interface{}) TransferDenial {
+	return TransferDenial{Reason: reason, Detail: fmt.Sprintf(format, args...)}
+}
+
+// Err returns the registered error of the denial reason wrapped with the reason code and detail of the denial.
+func (d TransferDenial) Err() error {
+	err, found := transferDenyErrors[d.Reason]
+	if !found {
+		err = sdkerrors.ErrUnauthorized
+	}
+	return sdkerrors.Wrapf(err, "%s: %s", d.Reason.ShortName(), d.Detail)
+}
+
+// ShortName returns the reason without its TRANSFER_DENY_REASON_ prefix, e.g. NO_TRANSFER_GRANT.
+func (r TransferDenyReason) ShortName() string {
+	return strings.TrimPrefix(r.String(), "TRANSFER_DENY_REASON_")
+}
@@ -13,18 +13,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -42,6 +47,11 @@ type MsgAddMarkerRequest struct {
 	AccessList             []AccessGrant                           `protobuf:"bytes,7,rep,name=access_list,json=accessList,proto3" json:"access_list"`
 	SupplyFixed            bool                                    `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	AllowGovernanceControl bool                                    `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// the amount of each reserve denom held for every unit of supply, required for basket markers only
+	BasketReservePerUnit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=basket_reserve_per_unit,json=basketReservePerUnit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"basket_reserve_per_unit"`
+	// the exponent of the display unit of the bank denom metadata derived when the marker is activated without any,
+	// zero uses the default_display_exponent param
+	DisplayExponent uint32 `protobuf:"varint,11,opt,name=display_exponent,json=displayExponent,proto3" json:"display_exponent,omitempty"`
 }
 
 func (m *MsgAddMarkerRequest) Reset()         { *m = MsgAddMarkerRequest{} }
@@ -126,6 +136,20 @@ func (m *MsgAddMarkerRequest) GetAllowGovernanceControl() bool {
 	return false
 }
 
+func (m *MsgAddMarkerRequest) GetBasketReservePerUnit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BasketReservePerUnit
+	}
+	return nil
+}
+
+func (m *MsgAddMarkerRequest) GetDisplayExponent() uint32 {
+	if m != nil {
+		return m.DisplayExponent
+	}
+	return 0
+}
+
 // MsgAddMarkerResponse defines the Msg/AddMarker response type
 type MsgAddMarkerResponse struct {
 }
@@ -168,6 +192,8 @@ type MsgAddAccessRequest struct {
 	Denom         string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Administrator string        `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 	Access        []AccessGrant `protobuf:"bytes,3,rep,name=access,proto3" json:"access"`
+	// roles grants the permissions of access roles defined in the module params to addresses
+	Roles []AccessRoleGrant `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles"`
 }
 
 func (m *MsgAddAccessRequest) Reset()         { *m = MsgAddAccessRequest{} }
@@ -224,6 +250,66 @@ func (m *MsgAddAccessRequest) GetAccess() []AccessGrant {
 	return nil
 }
 
+func (m *MsgAddAccessRequest) GetRoles() []AccessRoleGrant {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// AccessRoleGrant grants the permissions of the named access role to an address.
+type AccessRoleGrant struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *AccessRoleGrant) Reset()         { *m = AccessRoleGrant{} }
+func (m *AccessRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*AccessRoleGrant) ProtoMessage()    {}
+func (*AccessRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{3}
+}
+func (m *AccessRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRoleGrant.Merge(m, src)
+}
+func (m *AccessRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRoleGrant proto.InternalMessageInfo
+
+func (m *AccessRoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccessRoleGrant) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 // MsgAddAccessResponse defines the Msg/AddAccess response type
 type MsgAddAccessResponse struct {
 }
@@ -232,7 +318,7 @@ func (m *MsgAddAccessResponse) Reset()         { *m = MsgAddAccessResponse{} }
 func (m *MsgAddAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAccessResponse) ProtoMessage()    {}
 func (*MsgAddAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{3}
+	return fileDescriptor_bcb203fb73175ed3, []int{4}
 }
 func (m *MsgAddAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +358,7 @@ func (m *MsgDeleteAccessRequest) Reset()         { *m = MsgDeleteAccessRequest{}
 func (m *MsgDeleteAccessRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteAccessRequest) ProtoMessage()    {}
 func (*MsgDeleteAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{4}
+	return fileDescriptor_bcb203fb73175ed3, []int{5}
 }
 func (m *MsgDeleteAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +416,7 @@ func (m *MsgDeleteAccessResponse) Reset()         { *m = MsgDeleteAccessResponse
 func (m *MsgDeleteAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteAccessResponse) ProtoMessage()    {}
 func (*MsgDeleteAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{5}
+	return fileDescriptor_bcb203fb73175ed3, []int{6}
 }
 func (m *MsgDeleteAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +455,7 @@ func (m *MsgFinalizeRequest) Reset()         { *m = MsgFinalizeRequest{} }
 func (m *MsgFinalizeRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeRequest) ProtoMessage()    {}
 func (*MsgFinalizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{6}
+	return fileDescriptor_bcb203fb73175ed3, []int{7}
 }
 func (m *MsgFinalizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +506,7 @@ func (m *MsgFinalizeResponse) Reset()         { *m = MsgFinalizeResponse{} }
 func (m *MsgFinalizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFinalizeResponse) ProtoMessage()    {}
 func (*MsgFinalizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{7}
+	return fileDescriptor_bcb203fb73175ed3, []int{8}
 }
 func (m *MsgFinalizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +545,7 @@ func (m *MsgActivateRequest) Reset()         { *m = MsgActivateRequest{} }
 func (m *MsgActivateRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgActivateRequest) ProtoMessage()    {}
 func (*MsgActivateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{8}
+	return fileDescriptor_bcb203fb73175ed3, []int{9}
 }
 func (m *MsgActivateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +596,7 @@ func (m *MsgActivateResponse) Reset()         { *m = MsgActivateResponse{} }
 func (m *MsgActivateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgActivateResponse) ProtoMessage()    {}
 func (*MsgActivateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{9}
+	return fileDescriptor_bcb203fb73175ed3, []int{10}
 }
 func (m *MsgActivateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +635,7 @@ func (m *MsgCancelRequest) Reset()         { *m = MsgCancelRequest{} }
 func (m *MsgCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRequest) ProtoMessage()    {}
 func (*MsgCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{10}
+	return fileDescriptor_bcb203fb73175ed3, []int{11}
 }
 func (m *MsgCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +686,7 @@ func (m *MsgCancelResponse) Reset()         { *m = MsgCancelResponse{} }
 func (m *MsgCancelResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelResponse) ProtoMessage()    {}
 func (*MsgCancelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{11}
+	return fileDescriptor_bcb203fb73175ed3, []int{12}
 }
 func (m *MsgCancelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +725,7 @@ func (m *MsgDeleteRequest) Reset()         { *m = MsgDeleteRequest{} }
 func (m *MsgDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRequest) ProtoMessage()    {}
 func (*MsgDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{12}
+	return fileDescriptor_bcb203fb73175ed3, []int{13}
 }
 func (m *MsgDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +776,7 @@ func (m *MsgDeleteResponse) Reset()         { *m = MsgDeleteResponse{} }
 func (m *MsgDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteResponse) ProtoMessage()    {}
 func (*MsgDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{13}
+	return fileDescriptor_bcb203fb73175ed3, []int{14}
 }
 func (m *MsgDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,7 +815,7 @@ func (m *MsgMintRequest) Reset()         { *m = MsgMintRequest{} }
 func (m *MsgMintRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMintRequest) ProtoMessage()    {}
 func (*MsgMintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{14}
+	return fileDescriptor_bcb203fb73175ed3, []int{15}
 }
 func (m *MsgMintRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +859,7 @@ func (m *MsgMintResponse) Reset()         { *m = MsgMintResponse{} }
 func (m *MsgMintResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintResponse) ProtoMessage()    {}
 func (*MsgMintResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{15}
+	return fileDescriptor_bcb203fb73175ed3, []int{16}
 }
 func (m *MsgMintResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +898,7 @@ func (m *MsgBurnRequest) Reset()         { *m = MsgBurnRequest{} }
 func (m *MsgBurnRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnRequest) ProtoMessage()    {}
 func (*MsgBurnRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{16}
+	return fileDescriptor_bcb203fb73175ed3, []int{17}
 }
 func (m *MsgBurnRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +942,7 @@ func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
 func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnResponse) ProtoMessage()    {}
 func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{17}
+	return fileDescriptor_bcb203fb73175ed3, []int{18}
 }
 func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +983,7 @@ func (m *MsgWithdrawRequest) Reset()         { *m = MsgWithdrawRequest{} }
 func (m *MsgWithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawRequest) ProtoMessage()    {}
 func (*MsgWithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{18}
+	return fileDescriptor_bcb203fb73175ed3, []int{19}
 }
 func (m *MsgWithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,13 +1042,15 @@ func (m *MsgWithdrawRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin
 
 // MsgWithdrawResponse defines the Msg/Withdraw response type
 type MsgWithdrawResponse struct {
+	// the id of the pending withdrawal when the withdrawal requires approval, zero when the withdrawal was made
+	PendingWithdrawalId uint64 `protobuf:"varint,1,opt,name=pending_withdrawal_id,json=pendingWithdrawalId,proto3" json:"pending_withdrawal_id,omitempty"`
 }
 
 func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{19}
+	return fileDescriptor_bcb203fb73175ed3, []int{20}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,6 +1079,13 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawResponse) GetPendingWithdrawalId() uint64 {
+	if m != nil {
+		return m.PendingWithdrawalId
+	}
+	return 0
+}
+
 // MsgTransferRequest defines the Msg/Transfer request type
 type MsgTransferRequest struct {
 	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
@@ -1003,7 +1098,7 @@ func (m *MsgTransferRequest) Reset()         { *m = MsgTransferRequest{} }
 func (m *MsgTransferRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferRequest) ProtoMessage()    {}
 func (*MsgTransferRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{20}
+	return fileDescriptor_bcb203fb73175ed3, []int{21}
 }
 func (m *MsgTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1156,7 @@ func (m *MsgTransferResponse) Reset()         { *m = MsgTransferResponse{} }
 func (m *MsgTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferResponse) ProtoMessage()    {}
 func (*MsgTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{21}
+	return fileDescriptor_bcb203fb73175ed3, []int{22}
 }
 func (m *MsgTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,7 +1195,7 @@ func (m *MsgSetDenomMetadataRequest) Reset()         { *m = MsgSetDenomMetadataR
 func (m *MsgSetDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomMetadataRequest) ProtoMessage()    {}
 func (*MsgSetDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{22}
+	return fileDescriptor_bcb203fb73175ed3, []int{23}
 }
 func (m *MsgSetDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1239,7 @@ func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadata
 func (m *MsgSetDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomMetadataResponse) ProtoMessage()    {}
 func (*MsgSetDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcb203fb73175ed3, []int{23}
+	return fileDescriptor_bcb203fb73175ed3, []int{24}
 }
 func (m *MsgSetDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,1816 +1268,10387 @@ func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
-	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
-	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
-	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
-	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
-	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
-	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
-	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
-	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
-	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
-	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
-	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
-	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
-	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
-	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
-	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
-	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
-	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
-	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
-	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
+// MsgUpdateMarkerFlagsRequest defines the Msg/UpdateFlags request type
+type MsgUpdateMarkerFlagsRequest struct {
+	Denom                  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	SupplyFixed            bool   `protobuf:"varint,2,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
+	AllowGovernanceControl bool   `protobuf:"varint,3,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	Administrator          string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
-
-var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 1030 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x35, 0x2b, 0x5b, 0xb1, 0x46, 0xa9, 0x93, 0x6c, 0x5c, 0x87, 0x51, 0x61, 0x59, 0x16, 0xd2,
-	0x58, 0x2d, 0x6a, 0x32, 0x52, 0x2f, 0x45, 0x2e, 0x85, 0xe4, 0x20, 0xe9, 0xa1, 0x2c, 0x02, 0x39,
-	0x40, 0xd1, 0x5e, 0x84, 0x15, 0xb9, 0x66, 0x08, 0x89, 0x5c, 0x95, 0xbb, 0x92, 0xed, 0x02, 0xfd,
-	0x87, 0xa2, 0x97, 0x02, 0xfd, 0x84, 0xfe, 0x41, 0xff, 0x20, 0xc7, 0x1c, 0x7a, 0x28, 0x8a, 0x22,
-	0x0d, 0xec, 0x1f, 0x29, 0xc8, 0x5d, 0x92, 0x22, 0x25, 0xd1, 0x0a, 0x20, 0x18, 0x39, 0xd9, 0xdc,
-	0x99, 0x9d, 0xf7, 0xe6, 0xcd, 0xf2, 0x69, 0x09, 0xbb, 0x23, 0x9f, 0x4e, 0x88, 0x87, 0x3d, 0x93,
-	0xe8, 0x2e, 0xf6, 0x07, 0xc4, 0xd7, 0x27, 0x4d, 0x9d, 0x9f, 0x69, 0x23, 0x9f, 0x72, 0x8a, 0xb6,
-	0x93, 0xb0, 0x26, 0xc2, 0xda, 0xa4, 0x59, 0xd9, 0xb6, 0xa9, 0x4d, 0xc3, 0x04, 0x3d, 0xf8, 0x4f,
-	0xe4, 0x56, 0xaa, 0x26, 0x65, 0x2e, 0x65, 0x7a, 0x1f, 0x33, 0xa2, 0x4f, 0x9a, 0x7d, 0xc2, 0x71,
-	0x53, 0x37, 0xa9, 0xe3, 0xcd, 0xc4, 0xbd, 0x41, 0x1c, 0x0f, 0x1e, 0x64, 0x7c, 0x7f, 0x2e, 0x15,
-	0x89, 0x2a, 0x52, 0x1e, 0xce, 0x4d, 0xc1, 0xa6, 0x49, 0x18, 0xb3, 0x7d, 0xec, 0x71, 0x91, 0x57,
-	0xff, 0xb7, 0x00, 0x77, 0x0d, 0x66, 0xb7, 0x2d, 0xcb, 0x08, 0xb3, 0xba, 0xe4, 0xc7, 0x31, 0x61,
-	0x1c, 0xf5, 0xa1, 0x88, 0x5d, 0x3a, 0xf6, 0xb8, 0xaa, 0xd4, 0x94, 0x46, 0xb9, 0x75, 0x5f, 0x13,
-	0x9c, 0xb4, 0x80, 0xb3, 0x26, 0x39, 0x69, 0x47, 0xd4, 0xf1, 0x3a, 0xfa, 0xab, 0x37, 0x7b, 0x6b,
-	0xff, 0xbc, 0xd9, 0x3b, 0xb0, 0x1d, 0xfe, 0x72, 0xdc, 0xd7, 0x4c, 0xea, 0xea, 0xb2, 0x01, 0xf1,
-	0xe7, 0x90, 0x59, 0x03, 0x9d, 0x9f, 0x8f, 0x08, 0x0b, 0x37, 0x74, 0x65, 0x65, 0xa4, 0xc2, 0x0d,
-	0x17, 0x7b, 0xd8, 0x26, 0xbe, 0x5a, 0xa8, 0x29, 0x8d, 0x52, 0x37, 0x7a, 0x44, 0xfb, 0x70, 0xf3,
-	0xc4, 0xa7, 0x6e, 0x0f, 0x5b, 0x96, 0x4f, 0x18, 0x53, 0xd7, 0xc3, 0x70, 0x39, 0x58, 0x6b, 0x8b,
-	0x25, 0xf4, 0x18, 0x8a, 0x8c, 0x63, 0x3e, 0x66, 0xea, 0x46, 0x4d, 0x69, 0x6c, 0xb5, 0xea, 0xda,
-	0xbc, 0x01, 0x68, 0xa2, 0xab, 0xe3, 0x30, 0xb3, 0x2b, 0x77, 0xa0, 0x36, 0x94, 0x45, 0x46, 0x2f,
-	0x60, 0xa5, 0x16, 0xc3, 0x02, 0xb5, 0xbc, 0x02, 0x2f, 0xce, 0x47, 0xa4, 0x0b, 0x6e, 0xfc, 0x3f,
-	0xfa, 0x1a, 0xca, 0x42, 0xcc, 0xde, 0xd0, 0x61, 0x5c, 0xbd, 0x51, 0x2b, 0x34, 0xca, 0xad, 0xfd,
-	0xf9, 0x25, 0xda, 0x61, 0xe2, 0xb3, 0x40, 0xf5, 0xce, 0x7a, 0x20, 0x56, 0x17, 0xc4, 0xde, 0x6f,
-	0x1c, 0xc6, 0x83, 0x5e, 0xd9, 0x78, 0x34, 0x1a, 0x9e, 0xf7, 0x4e, 0x9c, 0x33, 0x62, 0xa9, 0x9b,
-	0x35, 0xa5, 0xb1, 0xd9, 0x2d, 0x8b, 0xb5, 0xa7, 0xc1, 0x12, 0xfa, 0x12, 0x54, 0x3c, 0x1c, 0xd2,
-	0xd3, 0x9e, 0x4d, 0x27, 0xc4, 0x0f, 0xcb, 0xf7, 0x4c, 0xea, 0x71, 0x9f, 0x0e, 0xd5, 0x52, 0x98,
-	0xbe, 0x13, 0xc6, 0x9f, 0xc5, 0xe1, 0x23, 0x11, 0xad, 0xef, 0xc0, 0x76, 0x7a, 0xba, 0x6c, 0x44,
-	0x3d, 0x46, 0xea, 0xbf, 0x2a, 0xd1, 0xd8, 0x05, 0xb9, 0x68, 0xec, 0xdb, 0xb0, 0x61, 0x11, 0x8f,
-	0xba, 0xe1, 0xd4, 0x4b, 0x5d, 0xf1, 0x80, 0x1e, 0xc0, 0x87, 0xd8, 0x72, 0x1d, 0xcf, 0x61, 0xdc,
-	0xc7, 0x9c, 0xfa, 0xea, 0x07, 0x61, 0x34, 0xbd, 0x88, 0xbe, 0x82, 0xa2, 0x68, 0x4b, 0x2d, 0xbc,
-	0x9b, 0x1a, 0x72, 0x5b, 0x42, 0x36, 0xe2, 0x24, 0xc9, 0xfe, 0x0c, 0x3b, 0x06, 0xb3, 0x9f, 0x90,
-	0x21, 0xe1, 0x64, 0x75, 0x74, 0x0f, 0xe0, 0x96, 0x4f, 0x5c, 0x3a, 0x21, 0x56, 0x7c, 0xcc, 0xc4,
-	0x29, 0xdc, 0x92, 0xcb, 0xf2, 0xa4, 0xd5, 0xef, 0xc3, 0xbd, 0x19, 0x78, 0xc9, 0xec, 0x39, 0x20,
-	0x83, 0xd9, 0x4f, 0x1d, 0x0f, 0x0f, 0x9d, 0x9f, 0xc8, 0x0a, 0x58, 0xd5, 0x3f, 0x0a, 0xe7, 0x92,
-	0x54, 0x4c, 0x01, 0xb5, 0x4d, 0xee, 0x4c, 0x30, 0x5f, 0x21, 0x50, 0x52, 0x51, 0x02, 0x7d, 0x0b,
-	0xb7, 0x0d, 0x66, 0x1f, 0x05, 0x33, 0x1b, 0xae, 0x02, 0xe6, 0x2e, 0xdc, 0x99, 0xaa, 0x97, 0x02,
-	0x11, 0x8a, 0xae, 0x0e, 0x24, 0xaa, 0x27, 0x41, 0x7e, 0x57, 0x60, 0xcb, 0x60, 0xb6, 0xe1, 0x78,
-	0xfc, 0x3a, 0x4d, 0x6d, 0x39, 0xc6, 0x77, 0xe0, 0x56, 0xcc, 0x2d, 0xcd, 0xb7, 0x33, 0xf6, 0xbd,
-	0xf7, 0x95, 0xaf, 0xe0, 0x26, 0xf9, 0xfe, 0xa5, 0x84, 0x67, 0xf2, 0x3b, 0x87, 0xbf, 0xb4, 0x7c,
-	0x7c, 0xba, 0x8a, 0x57, 0x72, 0x17, 0x80, 0xd3, 0xcc, 0xdb, 0x58, 0xe2, 0x34, 0xb2, 0x7c, 0x33,
-	0x96, 0x63, 0x3d, 0x34, 0x98, 0x1c, 0x39, 0x1e, 0x05, 0x72, 0xfc, 0xf1, 0xdf, 0x5e, 0x63, 0x49,
-	0x39, 0x58, 0xa4, 0x87, 0x7c, 0x2f, 0x92, 0xae, 0x64, 0xb7, 0x6f, 0x45, 0xb7, 0x2f, 0x7c, 0xec,
-	0xb1, 0x93, 0xeb, 0xfd, 0x99, 0x9c, 0xd1, 0xae, 0x30, 0x4f, 0xbb, 0x25, 0x7e, 0x32, 0xd3, 0xf2,
-	0x6e, 0x64, 0xe4, 0x95, 0x9d, 0x27, 0x1d, 0xca, 0xce, 0xff, 0x54, 0xa0, 0x62, 0x30, 0xfb, 0x98,
-	0xf0, 0x27, 0xc1, 0x28, 0x0d, 0xc2, 0xb1, 0x85, 0x39, 0x8e, 0x14, 0x18, 0xc3, 0xa6, 0x2b, 0x97,
-	0xa4, 0x06, 0xbb, 0x89, 0x06, 0xde, 0x20, 0xd6, 0x20, 0xda, 0xd7, 0x79, 0x2c, 0x75, 0x68, 0xe5,
-	0xea, 0x70, 0x26, 0x2e, 0x3f, 0x42, 0x8e, 0x18, 0x33, 0x86, 0x5a, 0xf2, 0xd8, 0xee, 0xc2, 0xc7,
-	0x73, 0xa9, 0x8b, 0xd6, 0x5a, 0xbf, 0x95, 0xa0, 0x60, 0x30, 0x1b, 0xf5, 0x60, 0x33, 0x72, 0x5c,
-	0xd4, 0x58, 0x70, 0x0d, 0x98, 0xb1, 0xf9, 0xca, 0xa7, 0x4b, 0x64, 0x0a, 0xa0, 0x00, 0x20, 0x72,
-	0xda, 0x1c, 0x80, 0x8c, 0xbd, 0xe7, 0x00, 0x64, 0x6d, 0x1b, 0x7d, 0x0f, 0x45, 0xe1, 0xb1, 0xe8,
-	0xe1, 0xc2, 0x4d, 0x29, 0x53, 0xaf, 0x1c, 0x5c, 0x99, 0x97, 0x94, 0x16, 0xce, 0x9a, 0x53, 0x3a,
-	0x65, 0xe5, 0x39, 0xa5, 0xd3, 0x16, 0x8d, 0x8e, 0x61, 0x3d, 0xb0, 0x40, 0xf4, 0x60, 0xe1, 0x86,
-	0x29, 0xf7, 0xae, 0x7c, 0x72, 0x45, 0x56, 0x52, 0x34, 0xf0, 0xa9, 0x9c, 0xa2, 0x53, 0x16, 0x9b,
-	0x53, 0x74, 0xda, 0xec, 0x50, 0x1f, 0x4a, 0xf1, 0xbd, 0x04, 0xe5, 0xcc, 0x25, 0x73, 0x9f, 0xaa,
-	0x7c, 0xb6, 0x4c, 0xaa, 0xc4, 0x18, 0xc0, 0xcd, 0xe9, 0x4b, 0x06, 0xfa, 0xfc, 0x0a, 0x19, 0xd3,
-	0x48, 0x87, 0x4b, 0x66, 0x27, 0x27, 0x32, 0xf2, 0xb8, 0x9c, 0x13, 0x99, 0x31, 0xf7, 0x9c, 0x13,
-	0x99, 0x35, 0x4c, 0xa9, 0x98, 0xb8, 0x76, 0xe6, 0x2b, 0x96, 0xfa, 0xf0, 0xc8, 0x57, 0x2c, 0x7d,
-	0x8b, 0x0d, 0x9a, 0x88, 0xec, 0x2a, 0xa7, 0x89, 0x8c, 0x67, 0xe7, 0x34, 0x91, 0xf5, 0x3e, 0x74,
-	0x0a, 0xb7, 0xb3, 0xe6, 0x81, 0x1e, 0x2d, 0xdc, 0xbe, 0xc0, 0x22, 0x2b, 0xcd, 0x77, 0xd8, 0x21,
-	0x80, 0x3b, 0xf6, 0xab, 0x8b, 0xaa, 0xf2, 0xfa, 0xa2, 0xaa, 0xbc, 0xbd, 0xa8, 0x2a, 0xbf, 0x5c,
-	0x56, 0xd7, 0x5e, 0x5f, 0x56, 0xd7, 0xfe, 0xbe, 0xac, 0xae, 0xc1, 0x3d, 0x87, 0xce, 0x2d, 0xf7,
-	0x5c, 0xf9, 0x61, 0xda, 0x51, 0x93, 0x94, 0x43, 0x87, 0x4e, 0x3d, 0xe9, 0x67, 0xd1, 0xe7, 0x60,
-	0x68, 0xad, 0xfd, 0x62, 0xf8, 0x19, 0xf8, 0xc5, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x35, 0x1c,
-	0x20, 0x39, 0xde, 0x0e, 0x00, 0x00,
+func (m *MsgUpdateMarkerFlagsRequest) Reset()         { *m = MsgUpdateMarkerFlagsRequest{} }
+func (m *MsgUpdateMarkerFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMarkerFlagsRequest) ProtoMessage()    {}
+func (*MsgUpdateMarkerFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{25}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Finalize
-	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
-	// Delete
-	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	// Burn
-	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+func (m *MsgUpdateMarkerFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateMarkerFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMarkerFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateMarkerFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMarkerFlagsRequest.Merge(m, src)
 }
-
-func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
-	out := new(MsgFinalizeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateMarkerFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMarkerFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMarkerFlagsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
-	out := new(MsgActivateResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateMarkerFlagsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateMarkerFlagsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
-	out := new(MsgCancelResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMarkerFlagsRequest) GetSupplyFixed() bool {
+	if m != nil {
+		return m.SupplyFixed
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
-	out := new(MsgDeleteResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMarkerFlagsRequest) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMarkerFlagsRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateMarkerFlagsResponse defines the Msg/UpdateFlags response type
+type MsgUpdateMarkerFlagsResponse struct {
 }
 
-func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
-	out := new(MsgAddAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMarkerFlagsResponse) Reset()         { *m = MsgUpdateMarkerFlagsResponse{} }
+func (m *MsgUpdateMarkerFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMarkerFlagsResponse) ProtoMessage()    {}
+func (*MsgUpdateMarkerFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{26}
+}
+func (m *MsgUpdateMarkerFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMarkerFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMarkerFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateMarkerFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMarkerFlagsResponse.Merge(m, src)
+}
+func (m *MsgUpdateMarkerFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMarkerFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMarkerFlagsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
-	out := new(MsgDeleteAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateMarkerFlagsResponse proto.InternalMessageInfo
+
+// MsgDepositAndMintRequest defines the Msg/DepositAndMint request type
+type MsgDepositAndMintRequest struct {
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	FromAddress string                                  `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositAndMintRequest) Reset()         { *m = MsgDepositAndMintRequest{} }
+func (m *MsgDepositAndMintRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAndMintRequest) ProtoMessage()    {}
+func (*MsgDepositAndMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{27}
+}
+func (m *MsgDepositAndMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositAndMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositAndMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDepositAndMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAndMintRequest.Merge(m, src)
+}
+func (m *MsgDepositAndMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositAndMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAndMintRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
-	out := new(MsgAddMarkerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositAndMintRequest proto.InternalMessageInfo
+
+func (m *MsgDepositAndMintRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
-	out := new(MsgTransferResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDepositAndMintResponse defines the Msg/DepositAndMint response type
+type MsgDepositAndMintResponse struct {
 }
 
-func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
-	out := new(MsgSetDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositAndMintResponse) Reset()         { *m = MsgDepositAndMintResponse{} }
+func (m *MsgDepositAndMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAndMintResponse) ProtoMessage()    {}
+func (*MsgDepositAndMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{28}
+}
+func (m *MsgDepositAndMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositAndMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositAndMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Finalize
-	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
-	// Activate
-	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
-	// Cancel
-	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
-	// Delete
-	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
-	// Mint
-	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
-	// Burn
-	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
-	// AddAccess
-	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
-	// DeleteAccess
-	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
-	// Withdraw
-	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
-	// AddMarker
-	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
-	// Transfer marker denominated coin between accounts
-	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
-	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
-	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
+func (m *MsgDepositAndMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAndMintResponse.Merge(m, src)
+}
+func (m *MsgDepositAndMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositAndMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAndMintResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgDepositAndMintResponse proto.InternalMessageInfo
+
+// MsgBurnAndRedeemRequest defines the Msg/BurnAndRedeem request type
+type MsgBurnAndRedeemRequest struct {
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	FromAddress string                                  `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+func (m *MsgBurnAndRedeemRequest) Reset()         { *m = MsgBurnAndRedeemRequest{} }
+func (m *MsgBurnAndRedeemRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnAndRedeemRequest) ProtoMessage()    {}
+func (*MsgBurnAndRedeemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{29}
 }
-func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+func (m *MsgBurnAndRedeemRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+func (m *MsgBurnAndRedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnAndRedeemRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *MsgBurnAndRedeemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnAndRedeemRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+func (m *MsgBurnAndRedeemRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (m *MsgBurnAndRedeemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnAndRedeemRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+
+var xxx_messageInfo_MsgBurnAndRedeemRequest proto.InternalMessageInfo
+
+func (m *MsgBurnAndRedeemRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+
+// MsgBurnAndRedeemResponse defines the Msg/BurnAndRedeem response type
+type MsgBurnAndRedeemResponse struct {
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+
+func (m *MsgBurnAndRedeemResponse) Reset()         { *m = MsgBurnAndRedeemResponse{} }
+func (m *MsgBurnAndRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnAndRedeemResponse) ProtoMessage()    {}
+func (*MsgBurnAndRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{30}
 }
-func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+func (m *MsgBurnAndRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+func (m *MsgBurnAndRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnAndRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+func (m *MsgBurnAndRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnAndRedeemResponse.Merge(m, src)
+}
+func (m *MsgBurnAndRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnAndRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnAndRedeemResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgBurnAndRedeemResponse proto.InternalMessageInfo
+
+// MsgDistributeEscrowRequest defines the Msg/DistributeEscrow request type
+type MsgDistributeEscrowRequest struct {
+	Denom         string                                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string                                   `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Finalize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Finalize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+func (m *MsgDistributeEscrowRequest) Reset()         { *m = MsgDistributeEscrowRequest{} }
+func (m *MsgDistributeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeEscrowRequest) ProtoMessage()    {}
+func (*MsgDistributeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{31}
+}
+func (m *MsgDistributeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDistributeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeEscrowRequest.Merge(m, src)
+}
+func (m *MsgDistributeEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeEscrowRequest.DiscardUnknown(m)
 }
 
-func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Activate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Activate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+var xxx_messageInfo_MsgDistributeEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgDistributeEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Cancel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Cancel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+func (m *MsgDistributeEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+func (m *MsgDistributeEscrowRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDistributeEscrowResponse defines the Msg/DistributeEscrow response type
+type MsgDistributeEscrowResponse struct {
+	// the id of the distribution, the holders are paid at the end of this and following blocks
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+func (m *MsgDistributeEscrowResponse) Reset()         { *m = MsgDistributeEscrowResponse{} }
+func (m *MsgDistributeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeEscrowResponse) ProtoMessage()    {}
+func (*MsgDistributeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{32}
+}
+func (m *MsgDistributeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDistributeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeEscrowResponse.Merge(m, src)
+}
+func (m *MsgDistributeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeEscrowResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+var xxx_messageInfo_MsgDistributeEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgDistributeEscrowResponse) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgAddEmissionScheduleRequest defines the Msg/AddEmissionSchedule request type
+type MsgAddEmissionScheduleRequest struct {
+	// the coin minted by each emission
+	Amount        types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	Administrator string     `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the address the emitted coin is sent to, the escrow of the marker when empty
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the number of blocks between emissions
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// the number of emissions to make
+	Emissions uint64 `protobuf:"varint,5,opt,name=emissions,proto3" json:"emissions,omitempty"`
+	// the height of the first emission, the next block when zero
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *MsgAddEmissionScheduleRequest) Reset()         { *m = MsgAddEmissionScheduleRequest{} }
+func (m *MsgAddEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEmissionScheduleRequest) ProtoMessage()    {}
+func (*MsgAddEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{33}
+}
+func (m *MsgAddEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAccess(ctx, in)
+}
+func (m *MsgAddEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEmissionScheduleRequest.Merge(m, src)
+}
+func (m *MsgAddEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *MsgAddEmissionScheduleRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
+	return types.Coin{}
+}
+
+func (m *MsgAddEmissionScheduleRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+	return ""
+}
+
+func (m *MsgAddEmissionScheduleRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgAddEmissionScheduleRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
+	return 0
+}
+
+func (m *MsgAddEmissionScheduleRequest) GetEmissions() uint64 {
+	if m != nil {
+		return m.Emissions
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
+	return 0
+}
+
+func (m *MsgAddEmissionScheduleRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+	return 0
+}
+
+// MsgAddEmissionScheduleResponse defines the Msg/AddEmissionSchedule response type
+type MsgAddEmissionScheduleResponse struct {
+	// the id of the emission schedule
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+}
+
+func (m *MsgAddEmissionScheduleResponse) Reset()         { *m = MsgAddEmissionScheduleResponse{} }
+func (m *MsgAddEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgAddEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{34}
+}
+func (m *MsgAddEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgAddEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEmissionScheduleResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMarkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgAddEmissionScheduleResponse) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMarker(ctx, in)
+	return 0
+}
+
+// MsgCancelEmissionScheduleRequest defines the Msg/CancelEmissionSchedule request type
+type MsgCancelEmissionScheduleRequest struct {
+	ScheduleId    uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgCancelEmissionScheduleRequest) Reset()         { *m = MsgCancelEmissionScheduleRequest{} }
+func (m *MsgCancelEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEmissionScheduleRequest) ProtoMessage()    {}
+func (*MsgCancelEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{35}
+}
+func (m *MsgCancelEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
+}
+func (m *MsgCancelEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEmissionScheduleRequest.Merge(m, src)
+}
+func (m *MsgCancelEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *MsgCancelEmissionScheduleRequest) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+	return 0
+}
+
+func (m *MsgCancelEmissionScheduleRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgCancelEmissionScheduleResponse defines the Msg/CancelEmissionSchedule response type
+type MsgCancelEmissionScheduleResponse struct {
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Reset()         { *m = MsgCancelEmissionScheduleResponse{} }
+func (m *MsgCancelEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{36}
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Transfer(ctx, in)
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEmissionScheduleResponse proto.InternalMessageInfo
+
+// MsgScheduleTransferRequest defines the Msg/ScheduleTransfer request type
+type MsgScheduleTransferRequest struct {
+	Amount        types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	Administrator string     `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	FromAddress   string     `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string     `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the height of the block at the start of which the transfer is released, no height is required when zero
+	ReleaseHeight int64 `protobuf:"varint,5,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// the block time from which the transfer is released, no time is required when not set
+	ReleaseTime *time.Time `protobuf:"bytes,6,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time,omitempty"`
+}
+
+func (m *MsgScheduleTransferRequest) Reset()         { *m = MsgScheduleTransferRequest{} }
+func (m *MsgScheduleTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTransferRequest) ProtoMessage()    {}
+func (*MsgScheduleTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{37}
+}
+func (m *MsgScheduleTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/Transfer",
+}
+func (m *MsgScheduleTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTransferRequest.Merge(m, src)
+}
+func (m *MsgScheduleTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTransferRequest proto.InternalMessageInfo
+
+func (m *MsgScheduleTransferRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+	return types.Coin{}
+}
+
+func (m *MsgScheduleTransferRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleTransferRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	return ""
+}
+
+func (m *MsgScheduleTransferRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
+	return ""
+}
+
+func (m *MsgScheduleTransferRequest) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+	return 0
+}
+
+func (m *MsgScheduleTransferRequest) GetReleaseTime() *time.Time {
+	if m != nil {
+		return m.ReleaseTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.marker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Finalize",
-			Handler:    _Msg_Finalize_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Msg_Activate_Handler,
-		},
-		{
-			MethodName: "Cancel",
-			Handler:    _Msg_Cancel_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Msg_Delete_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "AddAccess",
-			Handler:    _Msg_AddAccess_Handler,
-		},
-		{
-			MethodName: "DeleteAccess",
-			Handler:    _Msg_DeleteAccess_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "AddMarker",
-			Handler:    _Msg_AddMarker_Handler,
-		},
-		{
-			MethodName: "Transfer",
-			Handler:    _Msg_Transfer_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadata",
-			Handler:    _Msg_SetDenomMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/marker/v1/tx.proto",
+// MsgScheduleTransferResponse defines the Msg/ScheduleTransfer response type
+type MsgScheduleTransferResponse struct {
+	// the id of the scheduled transfer
+	TransferId uint64 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
 }
 
-func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgScheduleTransferResponse) Reset()         { *m = MsgScheduleTransferResponse{} }
+func (m *MsgScheduleTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTransferResponse) ProtoMessage()    {}
+func (*MsgScheduleTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{38}
 }
-
-func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgScheduleTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgScheduleTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTransferResponse.Merge(m, src)
 }
-
-func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleTransferResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleTransferResponse) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelScheduledTransferRequest defines the Msg/CancelScheduledTransfer request type
+type MsgCancelScheduledTransferRequest struct {
+	TransferId    uint64 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Access) > 0 {
-		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgCancelScheduledTransferRequest) Reset()         { *m = MsgCancelScheduledTransferRequest{} }
+func (m *MsgCancelScheduledTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTransferRequest) ProtoMessage()    {}
+func (*MsgCancelScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{39}
+}
+func (m *MsgCancelScheduledTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelScheduledTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTransferRequest.Merge(m, src)
 }
-
-func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTransferRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelScheduledTransferRequest proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledTransferRequest) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
+	}
+	return 0
 }
 
-func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledTransferRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelScheduledTransferResponse defines the Msg/CancelScheduledTransfer response type
+type MsgCancelScheduledTransferResponse struct {
 }
 
-func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemovedAddress) > 0 {
-		i -= len(m.RemovedAddress)
-		copy(dAtA[i:], m.RemovedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgCancelScheduledTransferResponse) Reset()         { *m = MsgCancelScheduledTransferResponse{} }
+func (m *MsgCancelScheduledTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTransferResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{40}
 }
-
-func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelScheduledTransferResponse proto.InternalMessageInfo
+
+// MsgClaimScheduledTransferRequest defines the Msg/ClaimScheduledTransfer request type
+type MsgClaimScheduledTransferRequest struct {
+	TransferId uint64 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// the recipient of the scheduled transfer
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 }
 
-func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimScheduledTransferRequest) Reset()         { *m = MsgClaimScheduledTransferRequest{} }
+func (m *MsgClaimScheduledTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimScheduledTransferRequest) ProtoMessage()    {}
+func (*MsgClaimScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{41}
+}
+func (m *MsgClaimScheduledTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimScheduledTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimScheduledTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimScheduledTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimScheduledTransferRequest.Merge(m, src)
+}
+func (m *MsgClaimScheduledTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimScheduledTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimScheduledTransferRequest.DiscardUnknown(m)
 }
 
-func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgClaimScheduledTransferRequest proto.InternalMessageInfo
+
+func (m *MsgClaimScheduledTransferRequest) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimScheduledTransferRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimScheduledTransferResponse defines the Msg/ClaimScheduledTransfer response type
+type MsgClaimScheduledTransferResponse struct {
 }
 
-func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimScheduledTransferResponse) Reset()         { *m = MsgClaimScheduledTransferResponse{} }
+func (m *MsgClaimScheduledTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimScheduledTransferResponse) ProtoMessage()    {}
+func (*MsgClaimScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{42}
 }
-
-func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimScheduledTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimScheduledTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimScheduledTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimScheduledTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimScheduledTransferResponse.Merge(m, src)
 }
-
-func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgClaimScheduledTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimScheduledTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimScheduledTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgClaimScheduledTransferResponse proto.InternalMessageInfo
 
-func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPauseMarkerRequest defines the Msg/PauseMarker request type
+type MsgPauseMarkerRequest struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseMarkerRequest) Reset()         { *m = MsgPauseMarkerRequest{} }
+func (m *MsgPauseMarkerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMarkerRequest) ProtoMessage()    {}
+func (*MsgPauseMarkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{43}
+}
+func (m *MsgPauseMarkerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMarkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMarkerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseMarkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMarkerRequest.Merge(m, src)
+}
+func (m *MsgPauseMarkerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMarkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMarkerRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgPauseMarkerRequest proto.InternalMessageInfo
+
+func (m *MsgPauseMarkerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseMarkerRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPauseMarkerResponse defines the Msg/PauseMarker response type
+type MsgPauseMarkerResponse struct {
 }
 
-func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseMarkerResponse) Reset()         { *m = MsgPauseMarkerResponse{} }
+func (m *MsgPauseMarkerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMarkerResponse) ProtoMessage()    {}
+func (*MsgPauseMarkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{44}
 }
-
-func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseMarkerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMarkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMarkerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPauseMarkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMarkerResponse.Merge(m, src)
+}
+func (m *MsgPauseMarkerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMarkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMarkerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPauseMarkerResponse proto.InternalMessageInfo
+
+// MsgResumeMarkerRequest defines the Msg/ResumeMarker request type
+type MsgResumeMarkerRequest struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgResumeMarkerRequest) Reset()         { *m = MsgResumeMarkerRequest{} }
+func (m *MsgResumeMarkerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeMarkerRequest) ProtoMessage()    {}
+func (*MsgResumeMarkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{45}
+}
+func (m *MsgResumeMarkerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeMarkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeMarkerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumeMarkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeMarkerRequest.Merge(m, src)
+}
+func (m *MsgResumeMarkerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeMarkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeMarkerRequest.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumeMarkerRequest proto.InternalMessageInfo
+
+func (m *MsgResumeMarkerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumeMarkerRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgResumeMarkerResponse defines the Msg/ResumeMarker response type
+type MsgResumeMarkerResponse struct {
 }
 
-func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResumeMarkerResponse) Reset()         { *m = MsgResumeMarkerResponse{} }
+func (m *MsgResumeMarkerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeMarkerResponse) ProtoMessage()    {}
+func (*MsgResumeMarkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{46}
+}
+func (m *MsgResumeMarkerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeMarkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeMarkerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgResumeMarkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeMarkerResponse.Merge(m, src)
+}
+func (m *MsgResumeMarkerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeMarkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeMarkerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResumeMarkerResponse proto.InternalMessageInfo
+
+// MsgSetDepositControlRequest defines the Msg/SetDepositControl request type
+type MsgSetDepositControlRequest struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RestrictDeposits bool   `protobuf:"varint,2,opt,name=restrict_deposits,json=restrictDeposits,proto3" json:"restrict_deposits,omitempty"`
+	Administrator    string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetDepositControlRequest) Reset()         { *m = MsgSetDepositControlRequest{} }
+func (m *MsgSetDepositControlRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDepositControlRequest) ProtoMessage()    {}
+func (*MsgSetDepositControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{47}
+}
+func (m *MsgSetDepositControlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDepositControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDepositControlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetDepositControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDepositControlRequest.Merge(m, src)
+}
+func (m *MsgSetDepositControlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDepositControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDepositControlRequest.DiscardUnknown(m)
 }
 
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDepositControlRequest proto.InternalMessageInfo
+
+func (m *MsgSetDepositControlRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDepositControlRequest) GetRestrictDeposits() bool {
+	if m != nil {
+		return m.RestrictDeposits
+	}
+	return false
 }
 
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetDepositControlRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgSetDepositControlResponse defines the Msg/SetDepositControl response type
+type MsgSetDepositControlResponse struct {
+}
+
+func (m *MsgSetDepositControlResponse) Reset()         { *m = MsgSetDepositControlResponse{} }
+func (m *MsgSetDepositControlResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDepositControlResponse) ProtoMessage()    {}
+func (*MsgSetDepositControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{48}
+}
+func (m *MsgSetDepositControlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDepositControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDepositControlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetDepositControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDepositControlResponse.Merge(m, src)
+}
+func (m *MsgSetDepositControlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDepositControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDepositControlResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetDepositControlResponse proto.InternalMessageInfo
+
+// MsgSetTransferMemoRequirementRequest defines the Msg/SetTransferMemoRequirement request type
+type MsgSetTransferMemoRequirementRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the regular expression the whole memo of a transfer must match, an empty pattern removes the requirement
+	TransferMemoPattern string `protobuf:"bytes,2,opt,name=transfer_memo_pattern,json=transferMemoPattern,proto3" json:"transfer_memo_pattern,omitempty"`
+	Administrator       string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetTransferMemoRequirementRequest) Reset()         { *m = MsgSetTransferMemoRequirementRequest{} }
+func (m *MsgSetTransferMemoRequirementRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferMemoRequirementRequest) ProtoMessage()    {}
+func (*MsgSetTransferMemoRequirementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{49}
+}
+func (m *MsgSetTransferMemoRequirementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferMemoRequirementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferMemoRequirementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetTransferMemoRequirementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferMemoRequirementRequest.Merge(m, src)
+}
+func (m *MsgSetTransferMemoRequirementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferMemoRequirementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferMemoRequirementRequest.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTransferMemoRequirementRequest proto.InternalMessageInfo
+
+func (m *MsgSetTransferMemoRequirementRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTransferMemoRequirementRequest) GetTransferMemoPattern() string {
+	if m != nil {
+		return m.TransferMemoPattern
+	}
+	return ""
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetTransferMemoRequirementRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgSetTransferMemoRequirementResponse defines the Msg/SetTransferMemoRequirement response type
+type MsgSetTransferMemoRequirementResponse struct {
+}
+
+func (m *MsgSetTransferMemoRequirementResponse) Reset()         { *m = MsgSetTransferMemoRequirementResponse{} }
+func (m *MsgSetTransferMemoRequirementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferMemoRequirementResponse) ProtoMessage()    {}
+func (*MsgSetTransferMemoRequirementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{50}
+}
+func (m *MsgSetTransferMemoRequirementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferMemoRequirementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferMemoRequirementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetTransferMemoRequirementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferMemoRequirementResponse.Merge(m, src)
+}
+func (m *MsgSetTransferMemoRequirementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferMemoRequirementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferMemoRequirementResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTransferMemoRequirementResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawApprovalPolicyRequest defines the Msg/SetWithdrawApprovalPolicy request type
+type MsgSetWithdrawApprovalPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount of each denom above which a withdrawal requires approval, an empty threshold removes the policy
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	// the number of administrators, other than the one requesting the withdrawal, that must approve it
+	ApprovalsRequired uint32 `protobuf:"varint,3,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	// how long a withdrawal waits for its approvals before it expires
+	ApprovalPeriod time.Duration `protobuf:"bytes,4,opt,name=approval_period,json=approvalPeriod,proto3,stdduration" json:"approval_period"`
+	Administrator  string        `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgSetWithdrawApprovalPolicyRequest) Reset()         { *m = MsgSetWithdrawApprovalPolicyRequest{} }
+func (m *MsgSetWithdrawApprovalPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawApprovalPolicyRequest) ProtoMessage()    {}
+func (*MsgSetWithdrawApprovalPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{51}
+}
+func (m *MsgSetWithdrawApprovalPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawApprovalPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawApprovalPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetWithdrawApprovalPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawApprovalPolicyRequest.Merge(m, src)
+}
+func (m *MsgSetWithdrawApprovalPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawApprovalPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawApprovalPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawApprovalPolicyRequest proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawApprovalPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetWithdrawApprovalPolicyRequest) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetWithdrawApprovalPolicyRequest) GetApprovalsRequired() uint32 {
+	if m != nil {
+		return m.ApprovalsRequired
+	}
+	return 0
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetWithdrawApprovalPolicyRequest) GetApprovalPeriod() time.Duration {
+	if m != nil {
+		return m.ApprovalPeriod
+	}
+	return 0
 }
 
-func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetWithdrawApprovalPolicyRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetWithdrawApprovalPolicyResponse defines the Msg/SetWithdrawApprovalPolicy response type
+type MsgSetWithdrawApprovalPolicyResponse struct {
 }
 
-func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetWithdrawApprovalPolicyResponse) Reset()         { *m = MsgSetWithdrawApprovalPolicyResponse{} }
+func (m *MsgSetWithdrawApprovalPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawApprovalPolicyResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawApprovalPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{52}
+}
+func (m *MsgSetWithdrawApprovalPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawApprovalPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawApprovalPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetWithdrawApprovalPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawApprovalPolicyResponse.Merge(m, src)
 }
-
-func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetWithdrawApprovalPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawApprovalPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawApprovalPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetWithdrawApprovalPolicyResponse proto.InternalMessageInfo
+
+// MsgApproveWithdrawRequest defines the Msg/ApproveWithdraw request type
+type MsgApproveWithdrawRequest struct {
+	WithdrawalId  uint64 `protobuf:"varint,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveWithdrawRequest) Reset()         { *m = MsgApproveWithdrawRequest{} }
+func (m *MsgApproveWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveWithdrawRequest) ProtoMessage()    {}
+func (*MsgApproveWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{53}
+}
+func (m *MsgApproveWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveWithdrawRequest.Merge(m, src)
+}
+func (m *MsgApproveWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveWithdrawRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_MsgApproveWithdrawRequest proto.InternalMessageInfo
+
+func (m *MsgApproveWithdrawRequest) GetWithdrawalId() uint64 {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveWithdrawRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgApproveWithdrawResponse defines the Msg/ApproveWithdraw response type
+type MsgApproveWithdrawResponse struct {
+	// true if the approval completed the withdrawal
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgApproveWithdrawResponse) Reset()         { *m = MsgApproveWithdrawResponse{} }
+func (m *MsgApproveWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveWithdrawResponse) ProtoMessage()    {}
+func (*MsgApproveWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{54}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgApproveWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgAddMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.MarkerType != 0 {
-		n += 1 + sovTx(uint64(m.MarkerType))
-	}
-	if len(m.AccessList) > 0 {
-		for _, e := range m.AccessList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgApproveWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SupplyFixed {
-		n += 2
-	}
-	if m.AllowGovernanceControl {
-		n += 2
-	}
-	return n
+}
+func (m *MsgApproveWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveWithdrawResponse.Merge(m, src)
+}
+func (m *MsgApproveWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveWithdrawResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgApproveWithdrawResponse proto.InternalMessageInfo
+
+func (m *MsgApproveWithdrawResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *MsgAddAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Access) > 0 {
-		for _, e := range m.Access {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+// MsgSetFaucetRequest defines the Msg/SetFaucet request type
+type MsgSetFaucetRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount of the marker's coin sent for each claim, a zero amount removes the faucet
+	DripAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=drip_amount,json=dripAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"drip_amount"`
+	// how long an address must wait after a claim before claiming again
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// mint the coin for each claim instead of withdrawing it from the coin already held in the marker escrow
+	Mint          bool   `protobuf:"varint,4,opt,name=mint,proto3" json:"mint,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MsgSetFaucetRequest) Reset()         { *m = MsgSetFaucetRequest{} }
+func (m *MsgSetFaucetRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFaucetRequest) ProtoMessage()    {}
+func (*MsgSetFaucetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{55}
+}
+func (m *MsgSetFaucetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFaucetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFaucetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetFaucetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFaucetRequest.Merge(m, src)
+}
+func (m *MsgSetFaucetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFaucetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFaucetRequest.DiscardUnknown(m)
 }
 
-func (m *MsgAddAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetFaucetRequest proto.InternalMessageInfo
+
+func (m *MsgSetFaucetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDeleteAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RemovedAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetFaucetRequest) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	return n
+	return 0
 }
 
-func (m *MsgDeleteAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFaucetRequest) GetMint() bool {
+	if m != nil {
+		return m.Mint
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *MsgFinalizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetFaucetRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgFinalizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgSetFaucetResponse defines the Msg/SetFaucet response type
+type MsgSetFaucetResponse struct {
 }
 
-func (m *MsgActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetFaucetResponse) Reset()         { *m = MsgSetFaucetResponse{} }
+func (m *MsgSetFaucetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFaucetResponse) ProtoMessage()    {}
+func (*MsgSetFaucetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{56}
+}
+func (m *MsgSetFaucetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFaucetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFaucetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetFaucetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFaucetResponse.Merge(m, src)
+}
+func (m *MsgSetFaucetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFaucetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFaucetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetFaucetResponse proto.InternalMessageInfo
+
+// MsgClaimFaucetRequest defines the Msg/ClaimFaucet request type
+type MsgClaimFaucetRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
 }
 
-func (m *MsgCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimFaucetRequest) Reset()         { *m = MsgClaimFaucetRequest{} }
+func (m *MsgClaimFaucetRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFaucetRequest) ProtoMessage()    {}
+func (*MsgClaimFaucetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{57}
+}
+func (m *MsgClaimFaucetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFaucetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFaucetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgClaimFaucetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFaucetRequest.Merge(m, src)
+}
+func (m *MsgClaimFaucetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFaucetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFaucetRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCancelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgClaimFaucetRequest proto.InternalMessageInfo
+
+func (m *MsgClaimFaucetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimFaucetRequest) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgClaimFaucetResponse defines the Msg/ClaimFaucet response type
+type MsgClaimFaucetResponse struct {
+	// the coin sent to the claimer
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// the block time from which the claimer may claim again
+	NextClaimTime time.Time `protobuf:"bytes,2,opt,name=next_claim_time,json=nextClaimTime,proto3,stdtime" json:"next_claim_time"`
+}
+
+func (m *MsgClaimFaucetResponse) Reset()         { *m = MsgClaimFaucetResponse{} }
+func (m *MsgClaimFaucetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimFaucetResponse) ProtoMessage()    {}
+func (*MsgClaimFaucetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{58}
+}
+func (m *MsgClaimFaucetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimFaucetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimFaucetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgClaimFaucetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimFaucetResponse.Merge(m, src)
+}
+func (m *MsgClaimFaucetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimFaucetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimFaucetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgClaimFaucetResponse proto.InternalMessageInfo
+
+func (m *MsgClaimFaucetResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgMintRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimFaucetResponse) GetNextClaimTime() time.Time {
+	if m != nil {
+		return m.NextClaimTime
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return time.Time{}
+}
+
+// MsgSetFeeConversionRateRequest defines the Msg/SetFeeConversionRate request type
+type MsgSetFeeConversionRateRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount of the fee denom given for one unit of the marker's coin
+	Rate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	Oracle string                                 `protobuf:"bytes,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *MsgSetFeeConversionRateRequest) Reset()         { *m = MsgSetFeeConversionRateRequest{} }
+func (m *MsgSetFeeConversionRateRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeConversionRateRequest) ProtoMessage()    {}
+func (*MsgSetFeeConversionRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{59}
+}
+func (m *MsgSetFeeConversionRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeConversionRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeConversionRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetFeeConversionRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeConversionRateRequest.Merge(m, src)
+}
+func (m *MsgSetFeeConversionRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeConversionRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeConversionRateRequest.DiscardUnknown(m)
 }
 
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetFeeConversionRateRequest proto.InternalMessageInfo
+
+func (m *MsgSetFeeConversionRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgBurnRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeConversionRateRequest) GetOracle() string {
+	if m != nil {
+		return m.Oracle
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgSetFeeConversionRateResponse defines the Msg/SetFeeConversionRate response type
+type MsgSetFeeConversionRateResponse struct {
+}
+
+func (m *MsgSetFeeConversionRateResponse) Reset()         { *m = MsgSetFeeConversionRateResponse{} }
+func (m *MsgSetFeeConversionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeConversionRateResponse) ProtoMessage()    {}
+func (*MsgSetFeeConversionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{60}
+}
+func (m *MsgSetFeeConversionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeConversionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeConversionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetFeeConversionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeConversionRateResponse.Merge(m, src)
+}
+func (m *MsgSetFeeConversionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeConversionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeConversionRateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetFeeConversionRateResponse proto.InternalMessageInfo
+
+// MsgSweepEscrowRequest defines the Msg/SweepEscrow request type
+type MsgSweepEscrowRequest struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	// the address the coins are sent to, the administrator when empty
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the denoms of the coins left in the marker account
+	ExcludeDenoms []string `protobuf:"bytes,4,rep,name=exclude_denoms,json=excludeDenoms,proto3" json:"exclude_denoms,omitempty"`
+}
+
+func (m *MsgSweepEscrowRequest) Reset()         { *m = MsgSweepEscrowRequest{} }
+func (m *MsgSweepEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepEscrowRequest) ProtoMessage()    {}
+func (*MsgSweepEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{61}
+}
+func (m *MsgSweepEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSweepEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepEscrowRequest.Merge(m, src)
+}
+func (m *MsgSweepEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepEscrowRequest.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSweepEscrowRequest proto.InternalMessageInfo
+
+func (m *MsgSweepEscrowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSweepEscrowRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSweepEscrowRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSweepEscrowRequest) GetExcludeDenoms() []string {
+	if m != nil {
+		return m.ExcludeDenoms
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgSweepEscrowResponse defines the Msg/SweepEscrow response type
+type MsgSweepEscrowResponse struct {
+	// the coins withdrawn from the marker account
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSweepEscrowResponse) Reset()         { *m = MsgSweepEscrowResponse{} }
+func (m *MsgSweepEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepEscrowResponse) ProtoMessage()    {}
+func (*MsgSweepEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{62}
+}
+func (m *MsgSweepEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSweepEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepEscrowResponse.Merge(m, src)
+}
+func (m *MsgSweepEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepEscrowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSweepEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgSweepEscrowResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func init() {
+	proto.RegisterType((*MsgAddMarkerRequest)(nil), "provenance.marker.v1.MsgAddMarkerRequest")
+	proto.RegisterType((*MsgAddMarkerResponse)(nil), "provenance.marker.v1.MsgAddMarkerResponse")
+	proto.RegisterType((*MsgAddAccessRequest)(nil), "provenance.marker.v1.MsgAddAccessRequest")
+	proto.RegisterType((*AccessRoleGrant)(nil), "provenance.marker.v1.AccessRoleGrant")
+	proto.RegisterType((*MsgAddAccessResponse)(nil), "provenance.marker.v1.MsgAddAccessResponse")
+	proto.RegisterType((*MsgDeleteAccessRequest)(nil), "provenance.marker.v1.MsgDeleteAccessRequest")
+	proto.RegisterType((*MsgDeleteAccessResponse)(nil), "provenance.marker.v1.MsgDeleteAccessResponse")
+	proto.RegisterType((*MsgFinalizeRequest)(nil), "provenance.marker.v1.MsgFinalizeRequest")
+	proto.RegisterType((*MsgFinalizeResponse)(nil), "provenance.marker.v1.MsgFinalizeResponse")
+	proto.RegisterType((*MsgActivateRequest)(nil), "provenance.marker.v1.MsgActivateRequest")
+	proto.RegisterType((*MsgActivateResponse)(nil), "provenance.marker.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCancelRequest)(nil), "provenance.marker.v1.MsgCancelRequest")
+	proto.RegisterType((*MsgCancelResponse)(nil), "provenance.marker.v1.MsgCancelResponse")
+	proto.RegisterType((*MsgDeleteRequest)(nil), "provenance.marker.v1.MsgDeleteRequest")
+	proto.RegisterType((*MsgDeleteResponse)(nil), "provenance.marker.v1.MsgDeleteResponse")
+	proto.RegisterType((*MsgMintRequest)(nil), "provenance.marker.v1.MsgMintRequest")
+	proto.RegisterType((*MsgMintResponse)(nil), "provenance.marker.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurnRequest)(nil), "provenance.marker.v1.MsgBurnRequest")
+	proto.RegisterType((*MsgBurnResponse)(nil), "provenance.marker.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgWithdrawRequest)(nil), "provenance.marker.v1.MsgWithdrawRequest")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "provenance.marker.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgTransferRequest)(nil), "provenance.marker.v1.MsgTransferRequest")
+	proto.RegisterType((*MsgTransferResponse)(nil), "provenance.marker.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgSetDenomMetadataRequest)(nil), "provenance.marker.v1.MsgSetDenomMetadataRequest")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgUpdateMarkerFlagsRequest)(nil), "provenance.marker.v1.MsgUpdateMarkerFlagsRequest")
+	proto.RegisterType((*MsgUpdateMarkerFlagsResponse)(nil), "provenance.marker.v1.MsgUpdateMarkerFlagsResponse")
+	proto.RegisterType((*MsgDepositAndMintRequest)(nil), "provenance.marker.v1.MsgDepositAndMintRequest")
+	proto.RegisterType((*MsgDepositAndMintResponse)(nil), "provenance.marker.v1.MsgDepositAndMintResponse")
+	proto.RegisterType((*MsgBurnAndRedeemRequest)(nil), "provenance.marker.v1.MsgBurnAndRedeemRequest")
+	proto.RegisterType((*MsgBurnAndRedeemResponse)(nil), "provenance.marker.v1.MsgBurnAndRedeemResponse")
+	proto.RegisterType((*MsgDistributeEscrowRequest)(nil), "provenance.marker.v1.MsgDistributeEscrowRequest")
+	proto.RegisterType((*MsgDistributeEscrowResponse)(nil), "provenance.marker.v1.MsgDistributeEscrowResponse")
+	proto.RegisterType((*MsgAddEmissionScheduleRequest)(nil), "provenance.marker.v1.MsgAddEmissionScheduleRequest")
+	proto.RegisterType((*MsgAddEmissionScheduleResponse)(nil), "provenance.marker.v1.MsgAddEmissionScheduleResponse")
+	proto.RegisterType((*MsgCancelEmissionScheduleRequest)(nil), "provenance.marker.v1.MsgCancelEmissionScheduleRequest")
+	proto.RegisterType((*MsgCancelEmissionScheduleResponse)(nil), "provenance.marker.v1.MsgCancelEmissionScheduleResponse")
+	proto.RegisterType((*MsgScheduleTransferRequest)(nil), "provenance.marker.v1.MsgScheduleTransferRequest")
+	proto.RegisterType((*MsgScheduleTransferResponse)(nil), "provenance.marker.v1.MsgScheduleTransferResponse")
+	proto.RegisterType((*MsgCancelScheduledTransferRequest)(nil), "provenance.marker.v1.MsgCancelScheduledTransferRequest")
+	proto.RegisterType((*MsgCancelScheduledTransferResponse)(nil), "provenance.marker.v1.MsgCancelScheduledTransferResponse")
+	proto.RegisterType((*MsgClaimScheduledTransferRequest)(nil), "provenance.marker.v1.MsgClaimScheduledTransferRequest")
+	proto.RegisterType((*MsgClaimScheduledTransferResponse)(nil), "provenance.marker.v1.MsgClaimScheduledTransferResponse")
+	proto.RegisterType((*MsgPauseMarkerRequest)(nil), "provenance.marker.v1.MsgPauseMarkerRequest")
+	proto.RegisterType((*MsgPauseMarkerResponse)(nil), "provenance.marker.v1.MsgPauseMarkerResponse")
+	proto.RegisterType((*MsgResumeMarkerRequest)(nil), "provenance.marker.v1.MsgResumeMarkerRequest")
+	proto.RegisterType((*MsgResumeMarkerResponse)(nil), "provenance.marker.v1.MsgResumeMarkerResponse")
+	proto.RegisterType((*MsgSetDepositControlRequest)(nil), "provenance.marker.v1.MsgSetDepositControlRequest")
+	proto.RegisterType((*MsgSetDepositControlResponse)(nil), "provenance.marker.v1.MsgSetDepositControlResponse")
+	proto.RegisterType((*MsgSetTransferMemoRequirementRequest)(nil), "provenance.marker.v1.MsgSetTransferMemoRequirementRequest")
+	proto.RegisterType((*MsgSetTransferMemoRequirementResponse)(nil), "provenance.marker.v1.MsgSetTransferMemoRequirementResponse")
+	proto.RegisterType((*MsgSetWithdrawApprovalPolicyRequest)(nil), "provenance.marker.v1.MsgSetWithdrawApprovalPolicyRequest")
+	proto.RegisterType((*MsgSetWithdrawApprovalPolicyResponse)(nil), "provenance.marker.v1.MsgSetWithdrawApprovalPolicyResponse")
+	proto.RegisterType((*MsgApproveWithdrawRequest)(nil), "provenance.marker.v1.MsgApproveWithdrawRequest")
+	proto.RegisterType((*MsgApproveWithdrawResponse)(nil), "provenance.marker.v1.MsgApproveWithdrawResponse")
+	proto.RegisterType((*MsgSetFaucetRequest)(nil), "provenance.marker.v1.MsgSetFaucetRequest")
+	proto.RegisterType((*MsgSetFaucetResponse)(nil), "provenance.marker.v1.MsgSetFaucetResponse")
+	proto.RegisterType((*MsgClaimFaucetRequest)(nil), "provenance.marker.v1.MsgClaimFaucetRequest")
+	proto.RegisterType((*MsgClaimFaucetResponse)(nil), "provenance.marker.v1.MsgClaimFaucetResponse")
+	proto.RegisterType((*MsgSetFeeConversionRateRequest)(nil), "provenance.marker.v1.MsgSetFeeConversionRateRequest")
+	proto.RegisterType((*MsgSetFeeConversionRateResponse)(nil), "provenance.marker.v1.MsgSetFeeConversionRateResponse")
+	proto.RegisterType((*MsgSweepEscrowRequest)(nil), "provenance.marker.v1.MsgSweepEscrowRequest")
+	proto.RegisterType((*MsgSweepEscrowResponse)(nil), "provenance.marker.v1.MsgSweepEscrowResponse")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
+
+var fileDescriptor_bcb203fb73175ed3 = []byte{
+	// 2444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0x94, 0x22, 0x3d, 0x5a, 0x92, 0x3d, 0x92, 0x65, 0x7a, 0x1d, 0x4b, 0x32, 0x6d,
+	0x59, 0xb2, 0x63, 0x91, 0x96, 0x92, 0xd6, 0xae, 0x03, 0x34, 0xa0, 0xfc, 0x15, 0x01, 0x66, 0x2b,
+	0xac, 0x1c, 0x04, 0xed, 0x85, 0x58, 0xed, 0x8e, 0xa9, 0xad, 0xf6, 0x83, 0xd9, 0x19, 0x4a, 0x72,
+	0x80, 0x02, 0x45, 0x0b, 0x14, 0x28, 0x90, 0x43, 0x10, 0xa0, 0x45, 0xdb, 0x4b, 0xdb, 0x63, 0xfb,
+	0x07, 0x14, 0xe8, 0xa1, 0x40, 0x8f, 0xe9, 0x2d, 0x87, 0x16, 0x28, 0x7a, 0x48, 0x02, 0xfb, 0x4f,
+	0xe8, 0xa9, 0xb7, 0x62, 0x67, 0xde, 0x72, 0xc9, 0xe5, 0xee, 0x72, 0xe9, 0xd2, 0x46, 0x4e, 0xd6,
+	0xce, 0xbc, 0xef, 0x37, 0x33, 0xef, 0xbd, 0x1f, 0x0d, 0x97, 0x5a, 0xbe, 0x77, 0x44, 0x5d, 0xdd,
+	0x35, 0x68, 0xd5, 0xd1, 0xfd, 0x43, 0xea, 0x57, 0x8f, 0x36, 0xab, 0xfc, 0xa4, 0xd2, 0xf2, 0x3d,
+	0xee, 0x91, 0x85, 0x68, 0xbb, 0x22, 0xb7, 0x2b, 0x47, 0x9b, 0xea, 0x42, 0xd3, 0x6b, 0x7a, 0x82,
+	0xa0, 0x1a, 0xfc, 0x25, 0x69, 0xd5, 0x25, 0xc3, 0x63, 0x8e, 0xc7, 0xaa, 0xfb, 0x3a, 0xa3, 0xd5,
+	0xa3, 0xcd, 0x7d, 0xca, 0xf5, 0xcd, 0xaa, 0xe1, 0x59, 0x6e, 0xdf, 0xbe, 0x7b, 0xd8, 0xd9, 0x0f,
+	0x3e, 0xc2, 0xfd, 0xa6, 0xe7, 0x35, 0x6d, 0x5a, 0x15, 0x5f, 0xfb, 0xed, 0xa7, 0x55, 0xb3, 0xed,
+	0xeb, 0xdc, 0xf2, 0x42, 0xfe, 0xe5, 0xf8, 0x3e, 0xb7, 0x1c, 0xca, 0xb8, 0xee, 0xb4, 0x90, 0xe0,
+	0x72, 0xa2, 0x2f, 0x68, 0xb6, 0x24, 0xb9, 0x96, 0x48, 0xa2, 0x1b, 0x06, 0x65, 0xac, 0xe9, 0xeb,
+	0x2e, 0x97, 0x74, 0xe5, 0x4f, 0x26, 0x60, 0xbe, 0xce, 0x9a, 0x35, 0xd3, 0xac, 0x0b, 0x2a, 0x8d,
+	0x7e, 0xd4, 0xa6, 0x8c, 0x93, 0x7d, 0x98, 0xd4, 0x1d, 0xaf, 0xed, 0xf2, 0x92, 0xb2, 0xa2, 0xac,
+	0x17, 0xb7, 0x2e, 0x54, 0xa4, 0x53, 0x95, 0xc0, 0xe9, 0x0a, 0x3a, 0x55, 0xb9, 0xe7, 0x59, 0xee,
+	0x76, 0xf5, 0xf3, 0x2f, 0x97, 0x4f, 0xfd, 0xfb, 0xcb, 0xe5, 0xb5, 0xa6, 0xc5, 0x0f, 0xda, 0xfb,
+	0x15, 0xc3, 0x73, 0xaa, 0x18, 0x01, 0xf9, 0xcf, 0x06, 0x33, 0x0f, 0xab, 0xfc, 0x59, 0x8b, 0x32,
+	0xc1, 0xa0, 0xa1, 0x64, 0x52, 0x82, 0x37, 0x1c, 0xdd, 0xd5, 0x9b, 0xd4, 0x2f, 0x8d, 0xaf, 0x28,
+	0xeb, 0xd3, 0x5a, 0xf8, 0x49, 0x2e, 0xc3, 0xe9, 0xa7, 0xbe, 0xe7, 0x34, 0x74, 0xd3, 0xf4, 0x29,
+	0x63, 0xa5, 0x82, 0xd8, 0x2e, 0x06, 0x6b, 0x35, 0xb9, 0x44, 0xee, 0xc2, 0x24, 0xe3, 0x3a, 0x6f,
+	0xb3, 0xd2, 0xc4, 0x8a, 0xb2, 0x3e, 0xbb, 0x55, 0xae, 0x24, 0x65, 0xb0, 0x22, 0xbd, 0xda, 0x13,
+	0x94, 0x1a, 0x72, 0x90, 0x1a, 0x14, 0x25, 0x45, 0x23, 0xb0, 0xaa, 0x34, 0x29, 0x04, 0xac, 0x64,
+	0x09, 0x78, 0xf2, 0xac, 0x45, 0x35, 0x70, 0x3a, 0x7f, 0x93, 0xf7, 0xa1, 0x28, 0x83, 0xd9, 0xb0,
+	0x2d, 0xc6, 0x4b, 0x6f, 0xac, 0x8c, 0xaf, 0x17, 0xb7, 0x2e, 0x27, 0x8b, 0xa8, 0x09, 0xc2, 0x47,
+	0x41, 0xd4, 0xb7, 0x0b, 0x41, 0xb0, 0x34, 0x90, 0xbc, 0x8f, 0x2d, 0xc6, 0x03, 0x5f, 0x59, 0xbb,
+	0xd5, 0xb2, 0x9f, 0x35, 0x9e, 0x5a, 0x27, 0xd4, 0x2c, 0x4d, 0xad, 0x28, 0xeb, 0x53, 0x5a, 0x51,
+	0xae, 0x3d, 0x0c, 0x96, 0xc8, 0x1d, 0x28, 0xe9, 0xb6, 0xed, 0x1d, 0x37, 0x9a, 0xde, 0x11, 0xf5,
+	0x85, 0xf8, 0x86, 0xe1, 0xb9, 0xdc, 0xf7, 0xec, 0xd2, 0xb4, 0x20, 0x5f, 0x14, 0xfb, 0x8f, 0x3a,
+	0xdb, 0xf7, 0xe4, 0x2e, 0xf9, 0xa9, 0x02, 0xe7, 0xf7, 0x75, 0x76, 0x48, 0x79, 0xc3, 0xa7, 0x8c,
+	0xfa, 0x47, 0xb4, 0xd1, 0xa2, 0x7e, 0xa3, 0xed, 0x5a, 0xbc, 0x04, 0x2b, 0xe3, 0xd9, 0x89, 0xbd,
+	0x15, 0xd8, 0xfa, 0xa7, 0xaf, 0x96, 0xd7, 0x73, 0x26, 0x96, 0x69, 0x0b, 0x52, 0x97, 0x26, 0x55,
+	0xed, 0x52, 0xff, 0x03, 0xd7, 0xe2, 0xe4, 0x3a, 0x9c, 0x31, 0x2d, 0xd6, 0xb2, 0xf5, 0x67, 0x0d,
+	0x7a, 0xd2, 0xf2, 0x5c, 0xea, 0xf2, 0x52, 0x71, 0x45, 0x59, 0x9f, 0xd1, 0xe6, 0x70, 0xfd, 0x01,
+	0x2e, 0x97, 0x17, 0x61, 0xa1, 0xf7, 0x34, 0xb2, 0x96, 0xe7, 0x32, 0x5a, 0xfe, 0xa7, 0x12, 0x1e,
+	0x53, 0x19, 0xcc, 0xf0, 0x98, 0x2e, 0xc0, 0x84, 0x49, 0x5d, 0xcf, 0x11, 0xa7, 0x74, 0x5a, 0x93,
+	0x1f, 0xe4, 0x2a, 0xcc, 0xe8, 0xa6, 0x63, 0xb9, 0x16, 0xe3, 0xbe, 0xce, 0x3d, 0xbf, 0x34, 0x26,
+	0x76, 0x7b, 0x17, 0xc9, 0x7b, 0x30, 0x29, 0xd3, 0x50, 0x1a, 0x1f, 0x2e, 0x7b, 0xc8, 0x46, 0x6a,
+	0x30, 0xe1, 0x7b, 0x36, 0x0d, 0x8e, 0x67, 0xc0, 0xbf, 0x9a, 0xc5, 0xaf, 0x79, 0x36, 0xed, 0x96,
+	0x21, 0x39, 0xcb, 0xef, 0xc1, 0x5c, 0x6c, 0x3f, 0xb8, 0x15, 0xe1, 0xb1, 0x97, 0x4e, 0x85, 0x9f,
+	0x84, 0x40, 0x21, 0xe0, 0x42, 0x6f, 0xc4, 0xdf, 0x51, 0xc0, 0xc2, 0xb8, 0x60, 0xc0, 0x7e, 0x0c,
+	0x8b, 0x75, 0xd6, 0xbc, 0x4f, 0x6d, 0xca, 0xe9, 0xe8, 0x42, 0xb6, 0x06, 0x73, 0x3e, 0x75, 0xbc,
+	0x23, 0x6a, 0x76, 0xae, 0xa6, 0xbc, 0xb9, 0xb3, 0xb8, 0x8c, 0xb7, 0xb3, 0x7c, 0x01, 0xce, 0xf7,
+	0xa9, 0x47, 0xcb, 0x76, 0x81, 0xd4, 0x59, 0xf3, 0xa1, 0xe5, 0xea, 0xb6, 0xf5, 0x31, 0x1d, 0x81,
+	0x55, 0xe5, 0x73, 0x30, 0xdf, 0x23, 0xb1, 0x47, 0x51, 0xcd, 0xe0, 0xd6, 0x91, 0xce, 0x47, 0xa8,
+	0x28, 0x92, 0x88, 0x8a, 0xbe, 0x07, 0x67, 0xea, 0xac, 0x79, 0x2f, 0xc8, 0xbb, 0x3d, 0x0a, 0x35,
+	0xf3, 0x70, 0xb6, 0x4b, 0x5e, 0x8f, 0x12, 0x19, 0xd1, 0xd1, 0x29, 0x09, 0xe5, 0xa1, 0x92, 0xdf,
+	0x2a, 0x30, 0x5b, 0x67, 0xcd, 0xba, 0xe5, 0xf2, 0xd7, 0x59, 0x08, 0xf2, 0x59, 0x7c, 0x16, 0xe6,
+	0x3a, 0xb6, 0xf5, 0xda, 0xbb, 0xdd, 0xf6, 0xdd, 0x6f, 0xaa, 0xbd, 0xd2, 0x36, 0xb4, 0xf7, 0x1f,
+	0x8a, 0x38, 0x93, 0x1f, 0x5a, 0xfc, 0xc0, 0xf4, 0xf5, 0xe3, 0x51, 0x5c, 0xc9, 0x4b, 0x00, 0xdc,
+	0x8b, 0xdd, 0xc6, 0x69, 0xee, 0x85, 0x65, 0xd2, 0xe8, 0x84, 0xa3, 0x30, 0xfa, 0xe7, 0x1e, 0x45,
+	0x97, 0x77, 0x60, 0xbe, 0xc7, 0x2b, 0xe9, 0x2d, 0xd9, 0x82, 0x73, 0x2d, 0xea, 0x9a, 0x96, 0xdb,
+	0x6c, 0x1c, 0xe3, 0x9e, 0x6e, 0x37, 0x2c, 0x53, 0xb8, 0x59, 0xd0, 0xe6, 0x71, 0xf3, 0xc3, 0xce,
+	0xde, 0x8e, 0x59, 0xfe, 0x5a, 0x46, 0xe8, 0x89, 0xaf, 0xbb, 0xec, 0xe9, 0xeb, 0x6d, 0x47, 0xfa,
+	0xe2, 0x3d, 0x9e, 0x14, 0xef, 0x1c, 0xad, 0x49, 0x6f, 0x4a, 0x26, 0x62, 0x29, 0xc1, 0x57, 0x24,
+	0xf2, 0x10, 0xcf, 0xc6, 0x5f, 0x14, 0x50, 0xeb, 0xac, 0xb9, 0x47, 0xf9, 0xfd, 0x20, 0xfd, 0x75,
+	0xca, 0x75, 0x53, 0xe7, 0x7a, 0x18, 0x81, 0x36, 0x4c, 0x39, 0xb8, 0x84, 0x31, 0xb8, 0x14, 0xc5,
+	0xc0, 0x3d, 0xec, 0xc4, 0x20, 0xe4, 0xdb, 0xbe, 0x8b, 0x71, 0xd8, 0xca, 0x8c, 0xc3, 0x89, 0xec,
+	0x52, 0x65, 0x38, 0x3a, 0x3a, 0x3b, 0xaa, 0x72, 0x1e, 0xf5, 0x4b, 0x70, 0x31, 0xd1, 0x74, 0x74,
+	0xed, 0xcf, 0x8a, 0xd8, 0xff, 0xa0, 0x65, 0xea, 0x9c, 0xca, 0xca, 0xfe, 0xd0, 0xd6, 0x9b, 0x03,
+	0x4a, 0x52, 0xbc, 0x31, 0x1a, 0x1b, 0xae, 0x31, 0x1a, 0xcf, 0x6c, 0x8c, 0xfa, 0xfc, 0x2a, 0x24,
+	0xf9, 0xb5, 0x04, 0x6f, 0x26, 0xdb, 0x8d, 0x8e, 0xfd, 0x41, 0x81, 0x92, 0x78, 0x45, 0x5b, 0x1e,
+	0xb3, 0x78, 0xcd, 0x35, 0x5f, 0xf7, 0xcb, 0x19, 0x3f, 0x8d, 0x63, 0x7d, 0xa7, 0xb1, 0x7c, 0x11,
+	0x2e, 0x24, 0x98, 0x88, 0x0e, 0xfc, 0x5e, 0x81, 0xf3, 0xf8, 0x48, 0xd5, 0x5c, 0x53, 0xa3, 0x26,
+	0xa5, 0xce, 0x37, 0xcc, 0x7e, 0x15, 0x4a, 0xfd, 0x16, 0xa2, 0xf9, 0x7f, 0x95, 0x77, 0xe6, 0x7e,
+	0x90, 0x30, 0x6b, 0xbf, 0xcd, 0xe9, 0x03, 0x66, 0xf8, 0xde, 0x48, 0xde, 0xd5, 0xe8, 0xe1, 0x1c,
+	0x7f, 0x75, 0x0f, 0xe7, 0x43, 0xb8, 0x98, 0x68, 0x3e, 0x3e, 0xa0, 0x6b, 0x30, 0x67, 0x86, 0x7b,
+	0x96, 0xe7, 0x46, 0x4f, 0xe7, 0x6c, 0xf7, 0xf2, 0x8e, 0x59, 0xfe, 0xaf, 0x02, 0x97, 0x64, 0x1b,
+	0xf8, 0xc0, 0xb1, 0x18, 0xb3, 0x3c, 0x77, 0xcf, 0x38, 0xa0, 0x66, 0xdb, 0xee, 0xb4, 0x0a, 0xb7,
+	0xf3, 0x27, 0x33, 0x6c, 0x72, 0x87, 0xa8, 0x75, 0xe4, 0x4d, 0x98, 0xf6, 0xa9, 0x61, 0xb5, 0x2c,
+	0x2a, 0x02, 0x26, 0x5e, 0xbc, 0xce, 0x02, 0x51, 0x61, 0xca, 0x72, 0x39, 0xf5, 0x8f, 0x74, 0x5b,
+	0xdc, 0xb3, 0x82, 0xd6, 0xf9, 0x0e, 0x38, 0x29, 0xda, 0x2c, 0xdf, 0xca, 0x82, 0x16, 0x2d, 0x88,
+	0x37, 0x80, 0xeb, 0x3e, 0x6f, 0x1c, 0x50, 0xab, 0x79, 0xc0, 0xc5, 0xa8, 0x36, 0xae, 0x15, 0xc5,
+	0xda, 0xfb, 0x62, 0xa9, 0x5c, 0x83, 0xa5, 0x34, 0xd7, 0x31, 0x8c, 0xcb, 0x50, 0x64, 0xb8, 0x16,
+	0x85, 0x10, 0xc2, 0xa5, 0x1d, 0xb3, 0x6c, 0xc1, 0x4a, 0xa7, 0xe1, 0x4a, 0x0b, 0xe0, 0x20, 0x21,
+	0x39, 0x5f, 0xca, 0x2b, 0x70, 0x39, 0x43, 0x15, 0x1e, 0xeb, 0x3f, 0x8e, 0xc9, 0x52, 0x80, 0xeb,
+	0xf1, 0x62, 0xf8, 0x8a, 0x73, 0x19, 0xbf, 0x93, 0xe3, 0x83, 0x2a, 0x5c, 0x21, 0xde, 0x74, 0xac,
+	0xc2, 0xac, 0x4f, 0x6d, 0xaa, 0x33, 0x1a, 0xe6, 0x6d, 0x42, 0xe4, 0x6d, 0x06, 0x57, 0x65, 0xe6,
+	0xc8, 0x3d, 0x38, 0x1d, 0x92, 0x71, 0xcb, 0x91, 0x73, 0x78, 0x71, 0x4b, 0xad, 0x48, 0xf8, 0xa3,
+	0x12, 0xc2, 0x1f, 0x95, 0x27, 0x21, 0xfc, 0xb1, 0x5d, 0xf8, 0xf4, 0xab, 0x65, 0x45, 0x2b, 0x22,
+	0x57, 0xb0, 0x5e, 0xfe, 0x2e, 0x5c, 0x4c, 0x0c, 0x55, 0x94, 0x7b, 0x8e, 0x6b, 0x5d, 0x69, 0x0b,
+	0x97, 0x76, 0xcc, 0xf2, 0x8f, 0xba, 0x12, 0x12, 0x4a, 0x31, 0xe3, 0x11, 0x1f, 0x24, 0x25, 0x67,
+	0xf2, 0xaf, 0x42, 0x39, 0x4b, 0x17, 0x66, 0x7f, 0x5f, 0x9e, 0x46, 0x5b, 0xb7, 0x9c, 0x97, 0x37,
+	0xa8, 0x37, 0x43, 0x63, 0xf1, 0x1e, 0x04, 0x8f, 0x61, 0x8a, 0x0e, 0x34, 0x64, 0x0f, 0xce, 0xd5,
+	0x59, 0x73, 0x57, 0x6f, 0x33, 0xda, 0x0b, 0x0e, 0xfd, 0x3f, 0x73, 0x47, 0x09, 0x16, 0xe3, 0x42,
+	0x51, 0xdd, 0x13, 0xb1, 0xa3, 0x51, 0xd6, 0x76, 0x46, 0xa8, 0x4f, 0x4e, 0xa2, 0xbd, 0x52, 0x51,
+	0xe1, 0xcf, 0x95, 0xa8, 0x6d, 0x11, 0xd5, 0x11, 0x9b, 0x83, 0x6c, 0xb5, 0x6f, 0xc1, 0x59, 0x9f,
+	0x06, 0xaf, 0xaf, 0xc1, 0x1b, 0xa6, 0xe4, 0x63, 0xd8, 0x9b, 0x9c, 0x09, 0x37, 0x50, 0x1e, 0xcb,
+	0xd7, 0x53, 0x62, 0x9b, 0x91, 0x60, 0x07, 0x1a, 0xfa, 0x2b, 0x05, 0xae, 0x4a, 0x82, 0x30, 0x47,
+	0x75, 0xea, 0x78, 0x81, 0x99, 0x96, 0x4f, 0x1d, 0xea, 0xf2, 0x6c, 0x8b, 0xb7, 0xe0, 0x5c, 0xe7,
+	0xb0, 0x38, 0xd4, 0xf1, 0x1a, 0x2d, 0x9d, 0x73, 0xea, 0xbb, 0x18, 0xb0, 0x79, 0xde, 0x25, 0x74,
+	0x57, 0x6e, 0xe5, 0x34, 0x7c, 0x0d, 0x56, 0x07, 0xd8, 0x85, 0x1e, 0xfc, 0x7d, 0x0c, 0xae, 0x48,
+	0xca, 0xb0, 0xdb, 0xaf, 0xb5, 0x02, 0xb4, 0x44, 0xb7, 0x77, 0x3d, 0xdb, 0x32, 0x9e, 0x65, 0x3b,
+	0x60, 0xc1, 0x34, 0x3f, 0xf0, 0x29, 0x3b, 0xf0, 0xec, 0xa0, 0x0d, 0x1c, 0x79, 0x39, 0x8e, 0xa4,
+	0x93, 0x0d, 0x20, 0x3a, 0x5a, 0xc6, 0x1a, 0xbe, 0xf4, 0xc4, 0x14, 0xce, 0xcf, 0x68, 0x67, 0x3b,
+	0x3b, 0xe8, 0xa2, 0x49, 0x1e, 0xc3, 0x5c, 0xb8, 0x18, 0x00, 0x6b, 0x96, 0x67, 0x8a, 0xd7, 0x30,
+	0xb0, 0x2f, 0xfe, 0x8a, 0xdd, 0x47, 0x90, 0x77, 0x7b, 0x2a, 0xb0, 0xef, 0xd7, 0xc1, 0x43, 0x36,
+	0x1b, 0xf2, 0xee, 0x0a, 0xd6, 0xfe, 0xa0, 0x4f, 0x24, 0x05, 0xfd, 0x1a, 0x5c, 0xcd, 0x0e, 0x25,
+	0xc6, 0xfc, 0xa9, 0x68, 0xfc, 0xe4, 0x26, 0x8d, 0x8f, 0x9c, 0x57, 0x60, 0x26, 0x69, 0x26, 0x3b,
+	0x7d, 0xdc, 0x35, 0x8c, 0xe5, 0xbc, 0x61, 0x77, 0x40, 0x4d, 0xd2, 0x83, 0x0f, 0xb0, 0x0a, 0x53,
+	0xf4, 0x84, 0x1a, 0x6d, 0x4e, 0xa5, 0x8e, 0x29, 0xad, 0xf3, 0x5d, 0xfe, 0x8f, 0x44, 0xf5, 0xf6,
+	0x28, 0x7f, 0xa8, 0xb7, 0x0d, 0x3a, 0xe0, 0x18, 0x7f, 0x1f, 0x8a, 0xa6, 0x6f, 0xb5, 0x1a, 0x58,
+	0xfb, 0x84, 0x2d, 0xdb, 0x15, 0xec, 0x3c, 0xaf, 0xe5, 0x48, 0xf6, 0x8e, 0xcb, 0x35, 0x08, 0x44,
+	0xd4, 0x64, 0x39, 0x7c, 0x17, 0x26, 0x31, 0x67, 0xe3, 0xf9, 0x73, 0x86, 0x2c, 0x01, 0x18, 0xe7,
+	0x58, 0x62, 0xac, 0x0e, 0x7c, 0x12, 0x7f, 0xe7, 0xcc, 0x9f, 0x84, 0xec, 0xba, 0x9c, 0xc6, 0x7c,
+	0x3d, 0x82, 0x73, 0xe1, 0x9b, 0x9c, 0x27, 0x1c, 0x25, 0x78, 0xc3, 0x08, 0x68, 0x69, 0x98, 0x96,
+	0xf0, 0xb3, 0xfc, 0x3b, 0x05, 0x16, 0xe3, 0x92, 0x30, 0x1b, 0x2f, 0xdd, 0x3a, 0x3c, 0x86, 0x39,
+	0x97, 0x9e, 0xf0, 0x86, 0xd0, 0x21, 0xcb, 0xf5, 0xd8, 0xc0, 0x72, 0x2d, 0xa2, 0x26, 0x4a, 0xf6,
+	0x4c, 0xc0, 0x2c, 0x0c, 0x12, 0x45, 0xfb, 0x33, 0x05, 0x96, 0x30, 0x06, 0x34, 0x18, 0xc9, 0x8e,
+	0xa8, 0xcf, 0x2c, 0xcf, 0xd5, 0x06, 0xe2, 0x74, 0xdb, 0x50, 0xf0, 0x75, 0x4e, 0x5f, 0x22, 0xf9,
+	0xf7, 0xa9, 0xa1, 0x09, 0x5e, 0xb2, 0x08, 0x93, 0x9e, 0xaf, 0x1b, 0x36, 0xc5, 0x37, 0x0d, 0xbf,
+	0xca, 0x97, 0x61, 0x39, 0xd5, 0x26, 0x4c, 0xd1, 0x6f, 0x14, 0x91, 0xa3, 0xbd, 0x63, 0x4a, 0x5b,
+	0xa3, 0x1b, 0x35, 0x06, 0x40, 0x38, 0xab, 0x30, 0x4b, 0x4f, 0x0c, 0xbb, 0x6d, 0xd2, 0x86, 0x90,
+	0x2a, 0xf1, 0xe6, 0x69, 0x6d, 0x06, 0x57, 0xc5, 0xcc, 0xcd, 0x10, 0xf1, 0xed, 0x31, 0x0d, 0x93,
+	0x6e, 0x74, 0x25, 0xfd, 0x55, 0x8d, 0x32, 0x5b, 0x7f, 0xbb, 0x08, 0xe3, 0x75, 0xd6, 0x24, 0x0d,
+	0x98, 0x0a, 0x91, 0x58, 0xb2, 0x9e, 0xf2, 0x93, 0x4a, 0x1f, 0xfc, 0xab, 0x5e, 0xcf, 0x41, 0x89,
+	0xde, 0x34, 0x60, 0x2a, 0x44, 0x60, 0x33, 0x14, 0xc4, 0x60, 0x5f, 0xf5, 0x7a, 0x0e, 0x4a, 0x54,
+	0xf0, 0x03, 0x98, 0x94, 0x2d, 0x1a, 0xb9, 0x96, 0xca, 0xd4, 0x03, 0xf6, 0xaa, 0x6b, 0x03, 0xe9,
+	0x22, 0xd1, 0x12, 0x71, 0xcd, 0x10, 0xdd, 0x03, 0xf1, 0xaa, 0x6b, 0x03, 0xe9, 0x50, 0xf4, 0x1e,
+	0x14, 0xea, 0xe2, 0x0d, 0x4a, 0x65, 0xe8, 0xc2, 0x26, 0xd4, 0xd5, 0x01, 0x54, 0x91, 0xd0, 0x60,
+	0xf0, 0xce, 0x10, 0xda, 0x05, 0xbd, 0xaa, 0xab, 0x03, 0xa8, 0x50, 0xe8, 0x3e, 0x4c, 0x77, 0x7e,
+	0xaf, 0x20, 0x19, 0x79, 0x89, 0xfd, 0xd6, 0xa3, 0xde, 0xc8, 0x43, 0x8a, 0x3a, 0x0e, 0xe1, 0x74,
+	0xf7, 0x8f, 0x0f, 0xe4, 0xe6, 0x80, 0x30, 0xf6, 0x6a, 0xda, 0xc8, 0x49, 0x1d, 0x9d, 0xc8, 0xb0,
+	0xec, 0x65, 0x9c, 0xc8, 0x58, 0x05, 0x56, 0xaf, 0xe7, 0xa0, 0xec, 0x89, 0x98, 0xec, 0x5e, 0xb3,
+	0x23, 0xd6, 0xd3, 0x37, 0xab, 0x37, 0xf2, 0x90, 0x46, 0x4e, 0x84, 0x4d, 0x5c, 0x86, 0x13, 0xb1,
+	0x39, 0x44, 0xbd, 0x9e, 0x83, 0x12, 0x15, 0x1c, 0xc3, 0x99, 0x38, 0x40, 0x48, 0x6e, 0xa5, 0xb2,
+	0xa7, 0xc0, 0xa0, 0xea, 0xe6, 0x10, 0x1c, 0xa8, 0x98, 0x43, 0x51, 0x22, 0x78, 0x02, 0xbb, 0x23,
+	0xe9, 0x12, 0xd2, 0xf0, 0x49, 0x75, 0x6b, 0x18, 0x16, 0xd4, 0xfa, 0x11, 0xcc, 0xf6, 0x62, 0x6e,
+	0xa4, 0x92, 0x71, 0xaa, 0x12, 0xf0, 0x43, 0xb5, 0x9a, 0x9b, 0x1e, 0x55, 0xba, 0x30, 0xd3, 0x03,
+	0x93, 0x91, 0x8d, 0xcc, 0x0b, 0x19, 0x07, 0xfc, 0xd4, 0x4a, 0x5e, 0xf2, 0x28, 0xa3, 0x71, 0xe8,
+	0x2a, 0x23, 0xa3, 0x29, 0x20, 0x9d, 0xba, 0x39, 0x04, 0x07, 0x2a, 0xfe, 0x89, 0x02, 0xf3, 0x09,
+	0x80, 0x0f, 0x79, 0x3b, 0xeb, 0xbc, 0xa7, 0x00, 0x3b, 0xea, 0x3b, 0xc3, 0x31, 0xa1, 0x09, 0xbf,
+	0x50, 0x60, 0x31, 0x19, 0xc5, 0x21, 0xdf, 0x1e, 0x50, 0x0d, 0xd2, 0x0c, 0xb9, 0x3d, 0x34, 0x5f,
+	0xd7, 0xcd, 0x8a, 0xe1, 0x1f, 0x59, 0x37, 0x2b, 0x19, 0x55, 0x52, 0x37, 0x87, 0xe0, 0x40, 0xc5,
+	0x9f, 0x28, 0x70, 0x3e, 0x05, 0xcd, 0x20, 0x83, 0xbc, 0x49, 0x83, 0x36, 0xd4, 0x3b, 0xc3, 0x33,
+	0x76, 0xe7, 0x24, 0x11, 0xd2, 0xc8, 0xca, 0x49, 0x16, 0xce, 0xa2, 0xde, 0x1e, 0x9a, 0x0f, 0x6d,
+	0x39, 0x80, 0x62, 0x17, 0xc6, 0x41, 0xde, 0x4a, 0x95, 0xd3, 0x0f, 0xaf, 0xa8, 0x37, 0xf3, 0x11,
+	0x47, 0xa5, 0xae, 0x1b, 0xdd, 0xc8, 0x28, 0x75, 0x09, 0xd0, 0x8a, 0xba, 0x91, 0x93, 0x1a, 0x95,
+	0x7d, 0x0c, 0x67, 0xfb, 0x60, 0x0a, 0x32, 0xe0, 0x4d, 0x4e, 0x80, 0x56, 0xd4, 0xad, 0x61, 0x58,
+	0x50, 0xf7, 0x2f, 0x15, 0x50, 0xd3, 0xa1, 0x06, 0x72, 0x37, 0x4b, 0x64, 0x36, 0x6e, 0xa2, 0xbe,
+	0xfb, 0x52, 0xbc, 0x68, 0xd7, 0x67, 0x0a, 0x5c, 0x48, 0x9d, 0xc6, 0xc9, 0x77, 0xb2, 0x44, 0x67,
+	0x82, 0x21, 0xea, 0xdd, 0x97, 0x61, 0xed, 0x14, 0xbd, 0xb9, 0xd8, 0x44, 0x4e, 0xd2, 0xeb, 0x49,
+	0x32, 0x46, 0xa0, 0xde, 0xca, 0xcf, 0x10, 0x35, 0x2a, 0x9d, 0xb9, 0x36, 0xa3, 0x51, 0x89, 0x0f,
+	0xfc, 0xea, 0x8d, 0x3c, 0xa4, 0xd1, 0xcd, 0xea, 0x9a, 0x6c, 0x33, 0x6e, 0x56, 0xff, 0x24, 0xad,
+	0xde, 0xcc, 0x47, 0x8c, 0x9a, 0x7e, 0xa6, 0xc0, 0x42, 0xd2, 0x38, 0x48, 0xde, 0xc9, 0x34, 0x37,
+	0x65, 0xa2, 0x55, 0xbf, 0x35, 0x24, 0x57, 0xe4, 0x6f, 0xd7, 0x50, 0x97, 0xe1, 0x6f, 0xff, 0x54,
+	0xaa, 0xde, 0xcc, 0x47, 0x2c, 0x35, 0x6d, 0x37, 0x3f, 0x7f, 0xbe, 0xa4, 0x7c, 0xf1, 0x7c, 0x49,
+	0xf9, 0xfa, 0xf9, 0x92, 0xf2, 0xe9, 0x8b, 0xa5, 0x53, 0x5f, 0xbc, 0x58, 0x3a, 0xf5, 0xaf, 0x17,
+	0x4b, 0xa7, 0xe0, 0xbc, 0xe5, 0x25, 0x4a, 0xda, 0x55, 0x7e, 0xd8, 0xfd, 0xf3, 0x72, 0x44, 0xb2,
+	0x61, 0x79, 0x5d, 0x5f, 0xd5, 0x93, 0xf0, 0xff, 0x20, 0x8a, 0xc9, 0x71, 0x7f, 0x52, 0x60, 0x05,
+	0x6f, 0xff, 0x6f, 0x00, 0x86, 0xbb, 0xb3, 0x37, 0x94, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Finalize
+	Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// Delete
+	Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn
+	Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// UpdateFlags changes the supply fixed and governance control flags of a Proposed or Finalized marker
+	UpdateFlags(ctx context.Context, in *MsgUpdateMarkerFlagsRequest, opts ...grpc.CallOption) (*MsgUpdateMarkerFlagsResponse, error)
+	// DepositAndMint deposits the reserve coins for an amount of basket coin into a basket marker and mints that amount
+	DepositAndMint(ctx context.Context, in *MsgDepositAndMintRequest, opts ...grpc.CallOption) (*MsgDepositAndMintResponse, error)
+	// BurnAndRedeem burns an amount of basket coin and returns the reserve coins held for that amount by the marker
+	BurnAndRedeem(ctx context.Context, in *MsgBurnAndRedeemRequest, opts ...grpc.CallOption) (*MsgBurnAndRedeemResponse, error)
+	// DistributeEscrow pays coins held in the escrow of a marker to the holders of the marker denom pro-rata
+	DistributeEscrow(ctx context.Context, in *MsgDistributeEscrowRequest, opts ...grpc.CallOption) (*MsgDistributeEscrowResponse, error)
+	// AddEmissionSchedule mints an amount of marker coin every interval of blocks for a recipient while the marker is active
+	AddEmissionSchedule(ctx context.Context, in *MsgAddEmissionScheduleRequest, opts ...grpc.CallOption) (*MsgAddEmissionScheduleResponse, error)
+	// CancelEmissionSchedule removes an emission schedule before its remaining emissions are made
+	CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionScheduleRequest, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error)
+	// ScheduleTransfer escrows a restricted coin transfer that is released to the recipient at a future height or time
+	ScheduleTransfer(ctx context.Context, in *MsgScheduleTransferRequest, opts ...grpc.CallOption) (*MsgScheduleTransferResponse, error)
+	// CancelScheduledTransfer returns the coin of a scheduled transfer to its sender before it is released
+	CancelScheduledTransfer(ctx context.Context, in *MsgCancelScheduledTransferRequest, opts ...grpc.CallOption) (*MsgCancelScheduledTransferResponse, error)
+	// ClaimScheduledTransfer releases a scheduled transfer to its recipient once it is due
+	ClaimScheduledTransfer(ctx context.Context, in *MsgClaimScheduledTransferRequest, opts ...grpc.CallOption) (*MsgClaimScheduledTransferResponse, error)
+	// PauseMarker blocks all transfers, mints and burns of an active marker's coin without changing its status
+	PauseMarker(ctx context.Context, in *MsgPauseMarkerRequest, opts ...grpc.CallOption) (*MsgPauseMarkerResponse, error)
+	// ResumeMarker lifts the pause of a marker
+	ResumeMarker(ctx context.Context, in *MsgResumeMarkerRequest, opts ...grpc.CallOption) (*MsgResumeMarkerResponse, error)
+	// SetDepositControl sets whether coins can only be sent to a marker account by addresses with the deposit access
+	SetDepositControl(ctx context.Context, in *MsgSetDepositControlRequest, opts ...grpc.CallOption) (*MsgSetDepositControlResponse, error)
+	// SetTransferMemoRequirement sets the pattern the memo of a transfer of a restricted marker's coin must match
+	SetTransferMemoRequirement(ctx context.Context, in *MsgSetTransferMemoRequirementRequest, opts ...grpc.CallOption) (*MsgSetTransferMemoRequirementResponse, error)
+	// SetWithdrawApprovalPolicy sets the amount above which a withdrawal from a marker requires additional approvals
+	SetWithdrawApprovalPolicy(ctx context.Context, in *MsgSetWithdrawApprovalPolicyRequest, opts ...grpc.CallOption) (*MsgSetWithdrawApprovalPolicyResponse, error)
+	// ApproveWithdraw approves a pending withdrawal, making it once it has the required number of approvals
+	ApproveWithdraw(ctx context.Context, in *MsgApproveWithdrawRequest, opts ...grpc.CallOption) (*MsgApproveWithdrawResponse, error)
+	// SetFaucet sets the amount of a marker's coin any address may claim once per period, when faucets are enabled
+	SetFaucet(ctx context.Context, in *MsgSetFaucetRequest, opts ...grpc.CallOption) (*MsgSetFaucetResponse, error)
+	// ClaimFaucet sends the drip amount of a marker's faucet to the claimer
+	ClaimFaucet(ctx context.Context, in *MsgClaimFaucetRequest, opts ...grpc.CallOption) (*MsgClaimFaucetResponse, error)
+	// SetFeeConversionRate updates the rate of a marker's fee conversion, signed by its oracle
+	SetFeeConversionRate(ctx context.Context, in *MsgSetFeeConversionRateRequest, opts ...grpc.CallOption) (*MsgSetFeeConversionRateResponse, error)
+	// SweepEscrow withdraws all coins other than the marker's own held by the marker account
+	SweepEscrow(ctx context.Context, in *MsgSweepEscrowRequest, opts ...grpc.CallOption) (*MsgSweepEscrowResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Finalize(ctx context.Context, in *MsgFinalizeRequest, opts ...grpc.CallOption) (*MsgFinalizeResponse, error) {
+	out := new(MsgFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Finalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Activate(ctx context.Context, in *MsgActivateRequest, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
+	out := new(MsgActivateResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Activate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Cancel(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelResponse, error) {
+	out := new(MsgCancelResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Delete(ctx context.Context, in *MsgDeleteRequest, opts ...grpc.CallOption) (*MsgDeleteResponse, error) {
+	out := new(MsgDeleteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMintRequest, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurnRequest, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAccess(ctx context.Context, in *MsgAddAccessRequest, opts ...grpc.CallOption) (*MsgAddAccessResponse, error) {
+	out := new(MsgAddAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAccess(ctx context.Context, in *MsgDeleteAccessRequest, opts ...grpc.CallOption) (*MsgDeleteAccessResponse, error) {
+	out := new(MsgDeleteAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DeleteAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdrawRequest, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMarker(ctx context.Context, in *MsgAddMarkerRequest, opts ...grpc.CallOption) (*MsgAddMarkerResponse, error) {
+	out := new(MsgAddMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransferRequest, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFlags(ctx context.Context, in *MsgUpdateMarkerFlagsRequest, opts ...grpc.CallOption) (*MsgUpdateMarkerFlagsResponse, error) {
+	out := new(MsgUpdateMarkerFlagsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositAndMint(ctx context.Context, in *MsgDepositAndMintRequest, opts ...grpc.CallOption) (*MsgDepositAndMintResponse, error) {
+	out := new(MsgDepositAndMintResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DepositAndMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnAndRedeem(ctx context.Context, in *MsgBurnAndRedeemRequest, opts ...grpc.CallOption) (*MsgBurnAndRedeemResponse, error) {
+	out := new(MsgBurnAndRedeemResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/BurnAndRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DistributeEscrow(ctx context.Context, in *MsgDistributeEscrowRequest, opts ...grpc.CallOption) (*MsgDistributeEscrowResponse, error) {
+	out := new(MsgDistributeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/DistributeEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddEmissionSchedule(ctx context.Context, in *MsgAddEmissionScheduleRequest, opts ...grpc.CallOption) (*MsgAddEmissionScheduleResponse, error) {
+	out := new(MsgAddEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/AddEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionScheduleRequest, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error) {
+	out := new(MsgCancelEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleTransfer(ctx context.Context, in *MsgScheduleTransferRequest, opts ...grpc.CallOption) (*MsgScheduleTransferResponse, error) {
+	out := new(MsgScheduleTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ScheduleTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledTransfer(ctx context.Context, in *MsgCancelScheduledTransferRequest, opts ...grpc.CallOption) (*MsgCancelScheduledTransferResponse, error) {
+	out := new(MsgCancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/CancelScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimScheduledTransfer(ctx context.Context, in *MsgClaimScheduledTransferRequest, opts ...grpc.CallOption) (*MsgClaimScheduledTransferResponse, error) {
+	out := new(MsgClaimScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ClaimScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseMarker(ctx context.Context, in *MsgPauseMarkerRequest, opts ...grpc.CallOption) (*MsgPauseMarkerResponse, error) {
+	out := new(MsgPauseMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/PauseMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeMarker(ctx context.Context, in *MsgResumeMarkerRequest, opts ...grpc.CallOption) (*MsgResumeMarkerResponse, error) {
+	out := new(MsgResumeMarkerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ResumeMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDepositControl(ctx context.Context, in *MsgSetDepositControlRequest, opts ...grpc.CallOption) (*MsgSetDepositControlResponse, error) {
+	out := new(MsgSetDepositControlResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDepositControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferMemoRequirement(ctx context.Context, in *MsgSetTransferMemoRequirementRequest, opts ...grpc.CallOption) (*MsgSetTransferMemoRequirementResponse, error) {
+	out := new(MsgSetTransferMemoRequirementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetTransferMemoRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawApprovalPolicy(ctx context.Context, in *MsgSetWithdrawApprovalPolicyRequest, opts ...grpc.CallOption) (*MsgSetWithdrawApprovalPolicyResponse, error) {
+	out := new(MsgSetWithdrawApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetWithdrawApprovalPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveWithdraw(ctx context.Context, in *MsgApproveWithdrawRequest, opts ...grpc.CallOption) (*MsgApproveWithdrawResponse, error) {
+	out := new(MsgApproveWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ApproveWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFaucet(ctx context.Context, in *MsgSetFaucetRequest, opts ...grpc.CallOption) (*MsgSetFaucetResponse, error) {
+	out := new(MsgSetFaucetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetFaucet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimFaucet(ctx context.Context, in *MsgClaimFaucetRequest, opts ...grpc.CallOption) (*MsgClaimFaucetResponse, error) {
+	out := new(MsgClaimFaucetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/ClaimFaucet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeConversionRate(ctx context.Context, in *MsgSetFeeConversionRateRequest, opts ...grpc.CallOption) (*MsgSetFeeConversionRateResponse, error) {
+	out := new(MsgSetFeeConversionRateResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetFeeConversionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepEscrow(ctx context.Context, in *MsgSweepEscrowRequest, opts ...grpc.CallOption) (*MsgSweepEscrowResponse, error) {
+	out := new(MsgSweepEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SweepEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Finalize
+	Finalize(context.Context, *MsgFinalizeRequest) (*MsgFinalizeResponse, error)
+	// Activate
+	Activate(context.Context, *MsgActivateRequest) (*MsgActivateResponse, error)
+	// Cancel
+	Cancel(context.Context, *MsgCancelRequest) (*MsgCancelResponse, error)
+	// Delete
+	Delete(context.Context, *MsgDeleteRequest) (*MsgDeleteResponse, error)
+	// Mint
+	Mint(context.Context, *MsgMintRequest) (*MsgMintResponse, error)
+	// Burn
+	Burn(context.Context, *MsgBurnRequest) (*MsgBurnResponse, error)
+	// AddAccess
+	AddAccess(context.Context, *MsgAddAccessRequest) (*MsgAddAccessResponse, error)
+	// DeleteAccess
+	DeleteAccess(context.Context, *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error)
+	// Withdraw
+	Withdraw(context.Context, *MsgWithdrawRequest) (*MsgWithdrawResponse, error)
+	// AddMarker
+	AddMarker(context.Context, *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error)
+	// Transfer marker denominated coin between accounts
+	Transfer(context.Context, *MsgTransferRequest) (*MsgTransferResponse, error)
+	// Allows Denom Metadata (see bank module) to be set for the Marker's Denom
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error)
+	// UpdateFlags changes the supply fixed and governance control flags of a Proposed or Finalized marker
+	UpdateFlags(context.Context, *MsgUpdateMarkerFlagsRequest) (*MsgUpdateMarkerFlagsResponse, error)
+	// DepositAndMint deposits the reserve coins for an amount of basket coin into a basket marker and mints that amount
+	DepositAndMint(context.Context, *MsgDepositAndMintRequest) (*MsgDepositAndMintResponse, error)
+	// BurnAndRedeem burns an amount of basket coin and returns the reserve coins held for that amount by the marker
+	BurnAndRedeem(context.Context, *MsgBurnAndRedeemRequest) (*MsgBurnAndRedeemResponse, error)
+	// DistributeEscrow pays coins held in the escrow of a marker to the holders of the marker denom pro-rata
+	DistributeEscrow(context.Context, *MsgDistributeEscrowRequest) (*MsgDistributeEscrowResponse, error)
+	// AddEmissionSchedule mints an amount of marker coin every interval of blocks for a recipient while the marker is active
+	AddEmissionSchedule(context.Context, *MsgAddEmissionScheduleRequest) (*MsgAddEmissionScheduleResponse, error)
+	// CancelEmissionSchedule removes an emission schedule before its remaining emissions are made
+	CancelEmissionSchedule(context.Context, *MsgCancelEmissionScheduleRequest) (*MsgCancelEmissionScheduleResponse, error)
+	// ScheduleTransfer escrows a restricted coin transfer that is released to the recipient at a future height or time
+	ScheduleTransfer(context.Context, *MsgScheduleTransferRequest) (*MsgScheduleTransferResponse, error)
+	// CancelScheduledTransfer returns the coin of a scheduled transfer to its sender before it is released
+	CancelScheduledTransfer(context.Context, *MsgCancelScheduledTransferRequest) (*MsgCancelScheduledTransferResponse, error)
+	// ClaimScheduledTransfer releases a scheduled transfer to its recipient once it is due
+	ClaimScheduledTransfer(context.Context, *MsgClaimScheduledTransferRequest) (*MsgClaimScheduledTransferResponse, error)
+	// PauseMarker blocks all transfers, mints and burns of an active marker's coin without changing its status
+	PauseMarker(context.Context, *MsgPauseMarkerRequest) (*MsgPauseMarkerResponse, error)
+	// ResumeMarker lifts the pause of a marker
+	ResumeMarker(context.Context, *MsgResumeMarkerRequest) (*MsgResumeMarkerResponse, error)
+	// SetDepositControl sets whether coins can only be sent to a marker account by addresses with the deposit access
+	SetDepositControl(context.Context, *MsgSetDepositControlRequest) (*MsgSetDepositControlResponse, error)
+	// SetTransferMemoRequirement sets the pattern the memo of a transfer of a restricted marker's coin must match
+	SetTransferMemoRequirement(context.Context, *MsgSetTransferMemoRequirementRequest) (*MsgSetTransferMemoRequirementResponse, error)
+	// SetWithdrawApprovalPolicy sets the amount above which a withdrawal from a marker requires additional approvals
+	SetWithdrawApprovalPolicy(context.Context, *MsgSetWithdrawApprovalPolicyRequest) (*MsgSetWithdrawApprovalPolicyResponse, error)
+	// ApproveWithdraw approves a pending withdrawal, making it once it has the required number of approvals
+	ApproveWithdraw(context.Context, *MsgApproveWithdrawRequest) (*MsgApproveWithdrawResponse, error)
+	// SetFaucet sets the amount of a marker's coin any address may claim once per period, when faucets are enabled
+	SetFaucet(context.Context, *MsgSetFaucetRequest) (*MsgSetFaucetResponse, error)
+	// ClaimFaucet sends the drip amount of a marker's faucet to the claimer
+	ClaimFaucet(context.Context, *MsgClaimFaucetRequest) (*MsgClaimFaucetResponse, error)
+	// SetFeeConversionRate updates the rate of a marker's fee conversion, signed by its oracle
+	SetFeeConversionRate(context.Context, *MsgSetFeeConversionRateRequest) (*MsgSetFeeConversionRateResponse, error)
+	// SweepEscrow withdraws all coins other than the marker's own held by the marker account
+	SweepEscrow(context.Context, *MsgSweepEscrowRequest) (*MsgSweepEscrowResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Finalize(ctx context.Context, req *MsgFinalizeRequest) (*MsgFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+}
+func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivateRequest) (*MsgActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+}
+func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancelRequest) (*MsgCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (*UnimplementedMsgServer) Delete(ctx context.Context, req *MsgDeleteRequest) (*MsgDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMintRequest) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurnRequest) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) AddAccess(ctx context.Context, req *MsgAddAccessRequest) (*MsgAddAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccess not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAccess(ctx context.Context, req *MsgDeleteAccessRequest) (*MsgDeleteAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccess not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdrawRequest) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) AddMarker(ctx context.Context, req *MsgAddMarkerRequest) (*MsgAddMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarker not implemented")
+}
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransferRequest) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadataRequest) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFlags(ctx context.Context, req *MsgUpdateMarkerFlagsRequest) (*MsgUpdateMarkerFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlags not implemented")
+}
+func (*UnimplementedMsgServer) DepositAndMint(ctx context.Context, req *MsgDepositAndMintRequest) (*MsgDepositAndMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAndMint not implemented")
+}
+func (*UnimplementedMsgServer) BurnAndRedeem(ctx context.Context, req *MsgBurnAndRedeemRequest) (*MsgBurnAndRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAndRedeem not implemented")
+}
+func (*UnimplementedMsgServer) DistributeEscrow(ctx context.Context, req *MsgDistributeEscrowRequest) (*MsgDistributeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeEscrow not implemented")
+}
+func (*UnimplementedMsgServer) AddEmissionSchedule(ctx context.Context, req *MsgAddEmissionScheduleRequest) (*MsgAddEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEmissionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelEmissionSchedule(ctx context.Context, req *MsgCancelEmissionScheduleRequest) (*MsgCancelEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEmissionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleTransfer(ctx context.Context, req *MsgScheduleTransferRequest) (*MsgScheduleTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledTransfer(ctx context.Context, req *MsgCancelScheduledTransferRequest) (*MsgCancelScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ClaimScheduledTransfer(ctx context.Context, req *MsgClaimScheduledTransferRequest) (*MsgClaimScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimScheduledTransfer not implemented")
+}
+func (*UnimplementedMsgServer) PauseMarker(ctx context.Context, req *MsgPauseMarkerRequest) (*MsgPauseMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMarker not implemented")
+}
+func (*UnimplementedMsgServer) ResumeMarker(ctx context.Context, req *MsgResumeMarkerRequest) (*MsgResumeMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMarker not implemented")
+}
+func (*UnimplementedMsgServer) SetDepositControl(ctx context.Context, req *MsgSetDepositControlRequest) (*MsgSetDepositControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDepositControl not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferMemoRequirement(ctx context.Context, req *MsgSetTransferMemoRequirementRequest) (*MsgSetTransferMemoRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferMemoRequirement not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawApprovalPolicy(ctx context.Context, req *MsgSetWithdrawApprovalPolicyRequest) (*MsgSetWithdrawApprovalPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawApprovalPolicy not implemented")
+}
+func (*UnimplementedMsgServer) ApproveWithdraw(ctx context.Context, req *MsgApproveWithdrawRequest) (*MsgApproveWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) SetFaucet(ctx context.Context, req *MsgSetFaucetRequest) (*MsgSetFaucetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFaucet not implemented")
+}
+func (*UnimplementedMsgServer) ClaimFaucet(ctx context.Context, req *MsgClaimFaucetRequest) (*MsgClaimFaucetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFaucet not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeConversionRate(ctx context.Context, req *MsgSetFeeConversionRateRequest) (*MsgSetFeeConversionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeConversionRate not implemented")
+}
+func (*UnimplementedMsgServer) SweepEscrow(ctx context.Context, req *MsgSweepEscrowRequest) (*MsgSweepEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepEscrow not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Finalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Finalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Finalize(ctx, req.(*MsgFinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Activate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Activate(ctx, req.(*MsgActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delete(ctx, req.(*MsgDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccess(ctx, req.(*MsgAddAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DeleteAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAccess(ctx, req.(*MsgDeleteAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMarker(ctx, req.(*MsgAddMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarkerFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFlags(ctx, req.(*MsgUpdateMarkerFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositAndMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositAndMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositAndMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DepositAndMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositAndMint(ctx, req.(*MsgDepositAndMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnAndRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnAndRedeemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnAndRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/BurnAndRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnAndRedeem(ctx, req.(*MsgBurnAndRedeemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DistributeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/DistributeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeEscrow(ctx, req.(*MsgDistributeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/AddEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEmissionSchedule(ctx, req.(*MsgAddEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, req.(*MsgCancelEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ScheduleTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleTransfer(ctx, req.(*MsgScheduleTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/CancelScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledTransfer(ctx, req.(*MsgCancelScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ClaimScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimScheduledTransfer(ctx, req.(*MsgClaimScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/PauseMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseMarker(ctx, req.(*MsgPauseMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ResumeMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeMarker(ctx, req.(*MsgResumeMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDepositControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDepositControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDepositControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDepositControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDepositControl(ctx, req.(*MsgSetDepositControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferMemoRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferMemoRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferMemoRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetTransferMemoRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferMemoRequirement(ctx, req.(*MsgSetTransferMemoRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawApprovalPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetWithdrawApprovalPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawApprovalPolicy(ctx, req.(*MsgSetWithdrawApprovalPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ApproveWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveWithdraw(ctx, req.(*MsgApproveWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFaucet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFaucetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFaucet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetFaucet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFaucet(ctx, req.(*MsgSetFaucetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimFaucet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimFaucetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimFaucet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/ClaimFaucet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimFaucet(ctx, req.(*MsgClaimFaucetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeConversionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeConversionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeConversionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetFeeConversionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeConversionRate(ctx, req.(*MsgSetFeeConversionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SweepEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepEscrow(ctx, req.(*MsgSweepEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.marker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Finalize",
+			Handler:    _Msg_Finalize_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Msg_Activate_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Msg_Cancel_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Msg_Delete_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "AddAccess",
+			Handler:    _Msg_AddAccess_Handler,
+		},
+		{
+			MethodName: "DeleteAccess",
+			Handler:    _Msg_DeleteAccess_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "AddMarker",
+			Handler:    _Msg_AddMarker_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateFlags",
+			Handler:    _Msg_UpdateFlags_Handler,
+		},
+		{
+			MethodName: "DepositAndMint",
+			Handler:    _Msg_DepositAndMint_Handler,
+		},
+		{
+			MethodName: "BurnAndRedeem",
+			Handler:    _Msg_BurnAndRedeem_Handler,
+		},
+		{
+			MethodName: "DistributeEscrow",
+			Handler:    _Msg_DistributeEscrow_Handler,
+		},
+		{
+			MethodName: "AddEmissionSchedule",
+			Handler:    _Msg_AddEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "CancelEmissionSchedule",
+			Handler:    _Msg_CancelEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "ScheduleTransfer",
+			Handler:    _Msg_ScheduleTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _Msg_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ClaimScheduledTransfer",
+			Handler:    _Msg_ClaimScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "PauseMarker",
+			Handler:    _Msg_PauseMarker_Handler,
+		},
+		{
+			MethodName: "ResumeMarker",
+			Handler:    _Msg_ResumeMarker_Handler,
+		},
+		{
+			MethodName: "SetDepositControl",
+			Handler:    _Msg_SetDepositControl_Handler,
+		},
+		{
+			MethodName: "SetTransferMemoRequirement",
+			Handler:    _Msg_SetTransferMemoRequirement_Handler,
+		},
+		{
+			MethodName: "SetWithdrawApprovalPolicy",
+			Handler:    _Msg_SetWithdrawApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "ApproveWithdraw",
+			Handler:    _Msg_ApproveWithdraw_Handler,
+		},
+		{
+			MethodName: "SetFaucet",
+			Handler:    _Msg_SetFaucet_Handler,
+		},
+		{
+			MethodName: "ClaimFaucet",
+			Handler:    _Msg_ClaimFaucet_Handler,
+		},
+		{
+			MethodName: "SetFeeConversionRate",
+			Handler:    _Msg_SetFeeConversionRate_Handler,
+		},
+		{
+			MethodName: "SweepEscrow",
+			Handler:    _Msg_SweepEscrow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/marker/v1/tx.proto",
+}
+
+func (m *MsgAddMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisplayExponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisplayExponent))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.BasketReservePerUnit) > 0 {
+		for iNdEx := len(m.BasketReservePerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketReservePerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Access) > 0 {
+		for iNdEx := len(m.Access) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Access[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedAddress) > 0 {
+		i -= len(m.RemovedAddress)
+		copy(dAtA[i:], m.RemovedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingWithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingWithdrawalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkerFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkerFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkerFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkerFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkerFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkerFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnAndRedeemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnAndRedeemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnAndRedeemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnAndRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnAndRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnAndRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Emissions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Emissions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleaseTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimScheduledTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimScheduledTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimScheduledTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimScheduledTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimScheduledTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimScheduledTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeMarkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeMarkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeMarkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeMarkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDepositControlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDepositControlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDepositControlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestrictDeposits {
+		i--
+		if m.RestrictDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDepositControlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDepositControlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDepositControlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferMemoRequirementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferMemoRequirementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferMemoRequirementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TransferMemoPattern) > 0 {
+		i -= len(m.TransferMemoPattern)
+		copy(dAtA[i:], m.TransferMemoPattern)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferMemoPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferMemoRequirementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferMemoRequirementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferMemoRequirementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawApprovalPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawApprovalPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawApprovalPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ApprovalPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ApprovalPeriod):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if m.ApprovalsRequired != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApprovalsRequired))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawApprovalPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawApprovalPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawApprovalPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFaucetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFaucetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFaucetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DripAmount.Size()
+		i -= size
+		if _, err := m.DripAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFaucetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFaucetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFaucetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFaucetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFaucetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFaucetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimFaucetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimFaucetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimFaucetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextClaimTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTx(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeConversionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeConversionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeConversionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeConversionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeConversionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeConversionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExcludeDenoms) > 0 {
+		for iNdEx := len(m.ExcludeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeDenoms[iNdEx])
+			copy(dAtA[i:], m.ExcludeDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExcludeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MarkerType != 0 {
+		n += 1 + sovTx(uint64(m.MarkerType))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if len(m.BasketReservePerUnit) > 0 {
+		for _, e := range m.BasketReservePerUnit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DisplayExponent != 0 {
+		n += 1 + sovTx(uint64(m.DisplayExponent))
+	}
+	return n
+}
+
+func (m *MsgAddMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Access) > 0 {
+		for _, e := range m.Access {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RemovedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingWithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.PendingWithdrawalId))
+	}
+	return n
+}
+
+func (m *MsgTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMarkerFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMarkerFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositAndMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositAndMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnAndRedeemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnAndRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDistributeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDistributeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	return n
+}
+
+func (m *MsgAddEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Emissions != 0 {
+		n += 1 + sovTx(uint64(m.Emissions))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *MsgAddEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	return n
+}
+
+func (m *MsgCancelEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	if m.ReleaseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleaseTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimScheduledTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferId != 0 {
+		n += 1 + sovTx(uint64(m.TransferId))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimScheduledTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeMarkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeMarkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDepositControlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RestrictDeposits {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDepositControlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferMemoRequirementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferMemoPattern)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTransferMemoRequirementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawApprovalPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ApprovalsRequired != 0 {
+		n += 1 + sovTx(uint64(m.ApprovalsRequired))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ApprovalPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawApprovalPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalId))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFaucetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DripAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Mint {
+		n += 2
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFaucetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimFaucetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimFaucetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextClaimTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeeConversionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeConversionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExcludeDenoms) > 0 {
+		for _, s := range m.ExcludeDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSweepEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessGrant{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketReservePerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketReservePerUnit = append(m.BasketReservePerUnit, types.Coin{})
+			if err := m.BasketReservePerUnit[len(m.BasketReservePerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayExponent", wireType)
+			}
+			m.DisplayExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisplayExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = append(m.Access, AccessGrant{})
+			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, AccessRoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawalId", wireType)
+			}
+			m.PendingWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkerFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkerFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkerFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkerFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkerFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkerFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositAndMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositAndMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositAndMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositAndMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositAndMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositAndMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnAndRedeemRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnAndRedeemRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnAndRedeemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnAndRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnAndRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnAndRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDistributeEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDistributeEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
+			}
+			m.Emissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Emissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3005,10 +11671,10 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3044,9 +11710,9 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3074,9 +11740,9 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
@@ -3108,11 +11774,11 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3122,16 +11788,29 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			m.MarkerType = 0
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3141,14 +11820,14 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
+				m.ReleaseHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3175,16 +11854,68 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessList = append(m.AccessList, AccessGrant{})
-			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReleaseTime == nil {
+				m.ReleaseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var v int
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3194,17 +11925,66 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var v int
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3214,12 +11994,43 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3241,7 +12052,7 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3264,10 +12075,10 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3291,7 +12102,7 @@ func (m *MsgAddMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimScheduledTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3314,17 +12125,17 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimScheduledTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimScheduledTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferId", wireType)
 			}
-			var stringLen uint64
+			m.TransferId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3334,27 +12145,14 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TransferId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,41 +12180,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Access = append(m.Access, AccessGrant{})
-			if err := m.Access[len(m.Access)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3439,7 +12203,7 @@ func (m *MsgAddAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimScheduledTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,10 +12226,10 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimScheduledTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimScheduledTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3489,7 +12253,7 @@ func (m *MsgAddAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseMarkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3512,10 +12276,10 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseMarkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3582,38 +12346,6 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovedAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3635,7 +12367,7 @@ func (m *MsgDeleteAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseMarkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,10 +12390,10 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseMarkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3685,7 +12417,7 @@ func (m *MsgDeleteAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeMarkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3708,10 +12440,10 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeMarkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3799,7 +12531,7 @@ func (m *MsgFinalizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeMarkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3822,10 +12554,10 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeMarkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3849,7 +12581,7 @@ func (m *MsgFinalizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDepositControlRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3872,10 +12604,10 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDepositControlRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDepositControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3911,6 +12643,26 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictDeposits = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -3963,7 +12715,7 @@ func (m *MsgActivateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDepositControlResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,10 +12738,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDepositControlResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDepositControlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4013,7 +12765,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferMemoRequirementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4036,10 +12788,10 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferMemoRequirementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferMemoRequirementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4075,6 +12827,38 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMemoPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMemoPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4127,7 +12911,7 @@ func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferMemoRequirementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4150,10 +12934,10 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferMemoRequirementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferMemoRequirementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4177,7 +12961,7 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawApprovalPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4190,27 +12974,112 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawApprovalPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawApprovalPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalsRequired", wireType)
+			}
+			m.ApprovalsRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalsRequired |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4220,25 +13089,26 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ApprovalPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4291,7 +13161,7 @@ func (m *MsgDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawApprovalPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4314,10 +13184,10 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawApprovalPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawApprovalPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4341,7 +13211,7 @@ func (m *MsgDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveWithdrawRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4364,17 +13234,17 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveWithdrawRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
 			}
-			var msglen int
+			m.WithdrawalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4384,25 +13254,11 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WithdrawalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
@@ -4456,7 +13312,7 @@ func (m *MsgMintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4479,12 +13335,32 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4506,7 +13382,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFaucetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4529,15 +13405,81 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFaucetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFaucetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DripAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DripAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4564,11 +13506,31 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mint = bool(v != 0)
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4621,7 +13583,7 @@ func (m *MsgBurnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFaucetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4644,10 +13606,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFaucetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFaucetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4671,7 +13633,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimFaucetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4694,79 +13656,15 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimFaucetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimFaucetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4794,13 +13692,13 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4810,25 +13708,23 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4851,7 +13747,7 @@ func (m *MsgWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimFaucetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4874,12 +13770,78 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimFaucetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimFaucetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4901,7 +13863,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeConversionRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4924,48 +13886,15 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeConversionRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeConversionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4993,11 +13922,11 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5025,11 +13954,13 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5057,7 +13988,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5080,7 +14011,7 @@ func (m *MsgTransferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeConversionRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5103,10 +14034,10 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeConversionRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeConversionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5130,7 +14061,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5153,17 +14084,17 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5173,24 +14104,23 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5224,6 +14154,70 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeDenoms = append(m.ExcludeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5245,7 +14239,7 @@ func (m *MsgSetDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5268,12 +14262,46 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
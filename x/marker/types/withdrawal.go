@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxWithdrawApprovalsRequired is the most additional approvals a withdraw approval policy may require.
+const MaxWithdrawApprovalsRequired = 10
+
+// NewWithdrawApprovalPolicy creates a new policy requiring withdrawals of more than the threshold to be approved by the
+// given number of additional administrators within the approval period.
+func NewWithdrawApprovalPolicy(threshold sdk.Coins, approvalsRequired uint32, approvalPeriod time.Duration) *WithdrawApprovalPolicy {
+	return &WithdrawApprovalPolicy{
+		Threshold:         threshold,
+		ApprovalsRequired: approvalsRequired,
+		ApprovalPeriod:    approvalPeriod,
+	}
+}
+
+// Validate ensures the withdraw approval policy is valid.
+func (p WithdrawApprovalPolicy) Validate() error {
+	if p.Threshold.Empty() {
+		return fmt.Errorf("a threshold is required")
+	}
+	if err := p.Threshold.Validate(); err != nil {
+		return fmt.Errorf("invalid threshold %s: %w", p.Threshold, err)
+	}
+	if p.ApprovalsRequired == 0 || p.ApprovalsRequired > MaxWithdrawApprovalsRequired {
+		return fmt.Errorf("approvals required must be between 1 and %d", MaxWithdrawApprovalsRequired)
+	}
+	if p.ApprovalPeriod <= 0 {
+		return fmt.Errorf("approval period must be positive")
+	}
+	return nil
+}
+
+// RequiresApproval returns true if the amount of any of the coins is more than the threshold amount of its denom.
+func (p WithdrawApprovalPolicy) RequiresApproval(coins sdk.Coins) bool {
+	return coins.IsAnyGT(p.Threshold)
+}
+
+// NewPendingWithdrawal creates a new withdrawal waiting for the approvals required by the policy until its expiration.
+func NewPendingWithdrawal(
+	id uint64, denom string, amount sdk.Coins, admin, to sdk.AccAddress, approvalsRequired uint32, expiration time.Time,
+) PendingWithdrawal {
+	return PendingWithdrawal{
+		Id:                id,
+		Denom:             denom,
+		Amount:            amount,
+		Administrator:     admin.String(),
+		ToAddress:         to.String(),
+		ApprovalsRequired: approvalsRequired,
+		Expiration:        expiration,
+	}
+}
+
+// Validate ensures the pending withdrawal is valid.
+func (w PendingWithdrawal) Validate() error {
+	if err := sdk.ValidateDenom(w.Denom); err != nil {
+		return fmt.Errorf("invalid pending withdrawal %d denom: %w", w.Id, err)
+	}
+	if w.Amount.Empty() || !w.Amount.IsValid() {
+		return fmt.Errorf("invalid pending withdrawal %d amount: %s", w.Id, w.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(w.Administrator); err != nil {
+		return fmt.Errorf("invalid pending withdrawal %d administrator: %w", w.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(w.ToAddress); err != nil {
+		return fmt.Errorf("invalid pending withdrawal %d to address: %w", w.Id, err)
+	}
+	if w.ApprovalsRequired == 0 {
+		return fmt.Errorf("invalid pending withdrawal %d: approvals required must be positive", w.Id)
+	}
+	for _, approver := range w.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return fmt.Errorf("invalid pending withdrawal %d approver: %w", w.Id, err)
+		}
+	}
+	return nil
+}
+
+// HasApproval returns true if the address has approved the withdrawal.
+func (w PendingWithdrawal) HasApproval(addr string) bool {
+	for _, approver := range w.Approvals {
+		if approver == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApproved returns true once the withdrawal has the required number of approvals.
+func (w PendingWithdrawal) IsApproved() bool {
+	return uint32(len(w.Approvals)) >= w.ApprovalsRequired
+}
+
+// IsExpired returns true once the block time is after the expiration of the withdrawal.
+func (w PendingWithdrawal) IsExpired(blockTime time.Time) bool {
+	return blockTime.After(w.Expiration)
+}
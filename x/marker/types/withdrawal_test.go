@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWithdrawApprovalPolicyValidate(t *testing.T) {
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 1000))
+	cases := []struct {
+		name   string
+		policy *WithdrawApprovalPolicy
+		errMsg string
+	}{
+		{"valid", NewWithdrawApprovalPolicy(threshold, 2, time.Hour), ""},
+		{"no threshold", NewWithdrawApprovalPolicy(sdk.Coins{}, 2, time.Hour), "a threshold is required"},
+		{
+			"invalid threshold",
+			NewWithdrawApprovalPolicy(sdk.Coins{sdk.Coin{Denom: "bigcoin", Amount: sdk.NewInt(-1)}}, 2, time.Hour),
+			"invalid threshold -1bigcoin: coin -1bigcoin amount is not positive",
+		},
+		{"no approvals", NewWithdrawApprovalPolicy(threshold, 0, time.Hour), "approvals required must be between 1 and 10"},
+		{"too many approvals", NewWithdrawApprovalPolicy(threshold, 11, time.Hour), "approvals required must be between 1 and 10"},
+		{"no approval period", NewWithdrawApprovalPolicy(threshold, 1, 0), "approval period must be positive"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if len(tc.errMsg) > 0 {
+				require.EqualError(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawApprovalPolicyRequiresApproval(t *testing.T) {
+	policy := NewWithdrawApprovalPolicy(sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 1000)), 1, time.Hour)
+	require.False(t, policy.RequiresApproval(sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 1000))), "at the threshold")
+	require.True(t, policy.RequiresApproval(sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 1001))), "above the threshold")
+	require.False(t, policy.RequiresApproval(sdk.NewCoins(sdk.NewInt64Coin("othercoin", 5000))), "denom without a threshold")
+	require.True(t, policy.RequiresApproval(sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 1001), sdk.NewInt64Coin("othercoin", 1))),
+		"any coin above its threshold")
+}
+
+func TestPendingWithdrawal(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	approver := sdk.AccAddress("approver____________")
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := NewPendingWithdrawal(1, "bigcoin", sdk.NewCoins(sdk.NewInt64Coin("bigcoin", 5000)), admin, admin, 1, expiration)
+	require.NoError(t, w.Validate())
+	require.False(t, w.IsApproved())
+	require.False(t, w.HasApproval(approver.String()))
+
+	w.Approvals = append(w.Approvals, approver.String())
+	require.True(t, w.IsApproved())
+	require.True(t, w.HasApproval(approver.String()))
+
+	require.False(t, w.IsExpired(expiration))
+	require.True(t, w.IsExpired(expiration.Add(time.Second)))
+
+	w.Approvals = append(w.Approvals, "")
+	require.EqualError(t, w.Validate(), "invalid pending withdrawal 1 approver: empty address string is not allowed")
+	require.EqualError(t, PendingWithdrawal{Id: 2, Denom: "bigcoin"}.Validate(), "invalid pending withdrawal 2 amount: ")
+}
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -27,6 +29,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/testutil"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/metadata/client/cli"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -273,7 +276,7 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 		[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
 	)
 
-	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\"}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\"}",
+	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\"}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\",\"version\":\"0\"}",
 		s.scopeID,
 		s.scopeSpecID,
 		s.user1AddrStr,
@@ -287,7 +290,8 @@ owners:
   role: PARTY_TYPE_OWNER
 scope_id: %s
 specification_id: %s
-value_owner_address: %s`,
+value_owner_address: %s
+version: "0"`,
 		s.user1AddrStr,
 		s.user1AddrStr,
 		s.scopeID,
@@ -321,11 +325,12 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}],\"specification_id\":\"%s\"}",
+	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\",\"redacted_hash\":\"\"}],\"specification_id\":\"%s\",\"data_access\":[],\"redaction\":null}",
 		s.sessionID,
 		s.recordSpecID,
 	)
-	s.recordAsText = fmt.Sprintf(`inputs:
+	s.recordAsText = fmt.Sprintf(`data_access: []
+inputs:
 - hash: notarealrecordinputhash
   name: inputname
   status: RECORD_INPUT_STATUS_RECORD
@@ -333,18 +338,20 @@ specification_id: %s`,
 name: recordname
 outputs:
 - hash: notarealrecordoutputhash
+  redacted_hash: ""
   status: RESULT_STATUS_PASS
 process:
   hash: notarealprocesshash
   method: myMethod
   name: record process
+redaction: null
 session_id: %s
 specification_id: %s`,
 		s.sessionID,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"strict\":false,\"value_owner_constraint\":null}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -356,7 +363,9 @@ owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
-specification_id: %s`,
+specification_id: %s
+strict: false
+value_owner_constraint: null`,
 		s.contractSpecID,
 		s.user1AddrStr,
 		s.scopeSpecID,
@@ -401,18 +410,24 @@ type_name: recordtypename`,
 			eKey = "\"\""
 		}
 		return fmt.Sprintf(`encryption_key: %s
+last_seen: "%d"
 locator_uri: %s
-owner: %s`,
+owner: %s
+status: %s`,
 			eKey,
+			loc.LastSeen,
 			loc.LocatorUri,
 			loc.Owner,
+			loc.Status,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"last_seen\":\"%d\",\"status\":\"%s\"}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
+			loc.LastSeen,
+			loc.Status,
 		)
 	}
 	s.ownerAddr1 = s.user1Addr
@@ -429,6 +444,14 @@ owner: %s`,
 	s.objectLocator2AsText = locAsText(s.objectLocator2)
 	s.objectLocator2AsJson = locAsJson(s.objectLocator2)
 
+	var attributeData attributetypes.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[attributetypes.ModuleName], &attributeData))
+	attributeData.Attributes = append(attributeData.Attributes,
+		attributetypes.NewAttribute("displayname", s.user1Addr, attributetypes.AttributeType_String, []byte("Unit Test User")))
+	attributeDataBz, err := cfg.Codec.MarshalJSON(&attributeData)
+	s.Require().NoError(err)
+	genesisState[attributetypes.ModuleName] = attributeDataBz
+
 	var metadataData metadatatypes.GenesisState
 	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[metadatatypes.ModuleName], &metadataData))
 	metadataData.Scopes = append(metadataData.Scopes, s.scope)
@@ -591,13 +614,13 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output",
 			[]string{s.asJson},
 			"",
-			[]string{"\"params\":{}"},
+			[]string{"\"params\":{\"storage_fee_per_byte\":[],\"spec_deprecation_grace_period\":\"0s\"}"},
 		},
 		{
 			"get params as text output",
 			[]string{s.asText},
 			"",
-			[]string{"params: {}"},
+			[]string{"params:\n  spec_deprecation_grace_period: 0s\n  storage_fee_per_byte: []"},
 		},
 		{
 			"get params - invalid args",
@@ -609,7 +632,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output including request",
 			[]string{s.asJson, s.includeRequest},
 			"",
-			[]string{"\"params\":{}", "\"request\":{}"},
+			[]string{"\"params\":{\"storage_fee_per_byte\":[],\"spec_deprecation_grace_period\":\"0s\"}", "\"request\":{}"},
 		},
 		{
 			"get locator params as json",
@@ -1004,6 +1027,60 @@ func (s *IntegrationCLITestSuite) TestGetMetadataScopeCmd() {
 			"accepts 1 arg(s), received 0",
 			[]string{},
 		},
+		{
+			"get scope verbose",
+			[]string{s.scopeID.String(), "--verbose"},
+			"",
+			[]string{
+				fmt.Sprintf("Scope: %s\n  UUID: %s\n  Specification: %s\n", s.scopeID, s.scopeUUID, s.scopeSpecID),
+				fmt.Sprintf("Value Owner: %s\n", s.user2AddrStr),
+				fmt.Sprintf("Owners:\n    - PARTY_TYPE_OWNER: %s (Unit Test User)\n", s.user1AddrStr),
+				fmt.Sprintf("- %s \"unit test session\"\n      Contract Specification: %s \"contractclassname\"\n", s.sessionID, s.contractSpecID),
+				fmt.Sprintf("Records:\n        - %s \"recordname\"\n          Specification: %s \"recordname: recordtypename\"\n", s.recordID, s.recordSpecID),
+				`Process: "record process" method myMethod with hash notarealprocesshash`,
+				"- inputname (inputtypename) RECORD_INPUT_STATUS_RECORD: notarealrecordinputhash",
+				"- RESULT_STATUS_PASS: notarealrecordoutputhash",
+			},
+		},
+		{
+			"get scope verbose by record id with other display name attribute",
+			[]string{s.recordID.String(), "--verbose", "--display-name-attribute", "nickname"},
+			"",
+			[]string{fmt.Sprintf("- PARTY_TYPE_OWNER: %s\n", s.user1AddrStr)},
+		},
+		{
+			"get scope verbose - does not exist",
+			[]string{"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", "--verbose"},
+			"scope not found",
+			[]string{},
+		},
+		{
+			"get all scopes verbose",
+			[]string{"all", "--verbose"},
+			"--verbose can not be used when querying all scopes",
+			[]string{},
+		},
+		{
+			"get scope with field mask",
+			[]string{s.scopeID.String(), "--fields", "scope.scope.value_owner_address,scope.scope_id_info.scope_addr", s.asText},
+			"",
+			[]string{
+				fmt.Sprintf("  scope:\n    data_access: []\n    owners: []\n    scope_id: \"\"\n    specification_id: \"\"\n    value_owner_address: %s\n", s.user2AddrStr),
+				fmt.Sprintf("scope_addr: %s\n", s.scopeID),
+			},
+		},
+		{
+			"get scope with unknown field in field mask",
+			[]string{s.scopeID.String(), "--fields", "scope.nope"},
+			"rpc error: code = InvalidArgument desc = invalid field mask: unknown field scope.nope: invalid request",
+			[]string{},
+		},
+		{
+			"get scope verbose with field mask",
+			[]string{s.scopeID.String(), "--verbose", "--fields", "scope"},
+			"--verbose can not be used with --fields",
+			[]string{},
+		},
 	}
 
 	runQueryCmdTestCases(s, cmd, testCases)
@@ -2521,6 +2598,70 @@ func (s *IntegrationCLITestSuite) TestContractSpecificationTxCommands() {
 	runTxCmdTestCases(s, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestWriteP8eContractSpecCmd() {
+	specFile := func(contents string) string {
+		file := filepath.Join(s.T().TempDir(), "spec.json")
+		s.Require().NoError(ioutil.WriteFile(file, []byte(contents), 0644), "writing p8e spec file")
+		return file
+	}
+	validFile := specFile(`{
+  "definition": {"name": "LoanContract", "resourceLocation": {"classname": "io.p8e.contracts.LoanContract"}, "type": "PROPOSED"},
+  "partiesInvolved": ["OWNER"],
+  "considerationSpecs": [{
+    "funcName": "recordLoan",
+    "responsibleParty": "OWNER",
+    "inputSpecs": [{"name": "loan", "resourceLocation": {"classname": "io.p8e.proto.Loan", "ref": {"hash": "loanhash"}}, "type": "PROPOSED"}],
+    "outputSpec": {"spec": {"name": "loan", "resourceLocation": {"classname": "io.p8e.proto.Loan"}, "type": "PROPOSED"}}
+  }]
+}`)
+	noDefinitionFile := specFile(`{"partiesInvolved": ["OWNER"]}`)
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	s.T().Run("dry run prints the converted specifications", func(t *testing.T) {
+		clientCtx := s.getClientCtx()
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.WriteContractSpecificationCmd(), []string{
+			fmt.Sprintf("--%s=%s", cli.FlagP8eFile, validFile),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+			fmt.Sprintf("--%s", flags.FlagDryRun),
+		})
+		require.NoError(t, err)
+		var bundle metadatatypes.SpecificationBundle
+		require.NoError(t, clientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), &bundle), "unmarshal bundle")
+		assert.Equal(t, "io.p8e.contracts.LoanContract", bundle.ContractSpecification.ClassName, "class name")
+		assert.Equal(t, []string{s.accountAddrStr}, bundle.ContractSpecification.OwnerAddresses, "owners")
+		require.Len(t, bundle.RecordSpecifications, 1, "record specifications")
+		assert.Equal(t, "loan", bundle.RecordSpecifications[0].Name, "record specification name")
+	})
+
+	testCases := []txCmdTestCase{
+		{
+			"should successfully write converted p8e contract specification",
+			cli.WriteContractSpecificationCmd(),
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagP8eFile, validFile)}, txFlags...),
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail on p8e contract specification without definition",
+			cli.WriteContractSpecificationCmd(),
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagP8eFile, noDefinitionFile)}, txFlags...),
+			true, "invalid p8e contract specification: missing definition resource location", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail with both p8e file and arguments",
+			cli.WriteContractSpecificationCmd(),
+			append([]string{metadatatypes.ContractSpecMetadataAddress(uuid.New()).String(), fmt.Sprintf("--%s=%s", cli.FlagP8eFile, validFile)}, txFlags...),
+			true, "", &sdk.TxResponse{}, 0,
+		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestContractSpecificationScopeSpecAddRemoveTxCommands() {
 	addCommand := cli.AddContractSpecToScopeSpecCmd()
 	removeCommand := cli.RemoveContractSpecFromScopeSpecCmd()
@@ -3397,3 +3538,55 @@ func (s *IntegrationCLITestSuite) TestWriteSessionCmd() {
 
 	runTxCmdTestCases(s, testCases)
 }
+
+func (s *IntegrationCLITestSuite) TestMetadataProposalCmd() {
+	proposalFile := func(contents string) string {
+		file := filepath.Join(s.T().TempDir(), "proposal.json")
+		s.Require().NoError(ioutil.WriteFile(file, []byte(contents), 0644), "writing proposal file")
+		return file
+	}
+	validFile := proposalFile(fmt.Sprintf(`{
+  "title": "Transfer scope",
+  "description": "The owner lost their keys",
+  "scope_id": "%s",
+  "value_owner_address": "%s",
+  "evidence": "signed affidavit"
+}`, s.scopeID, s.user2AddrStr))
+	noEvidenceFile := proposalFile(fmt.Sprintf(`{
+  "title": "Transfer scope",
+  "description": "The owner lost their keys",
+  "scope_id": "%s",
+  "owners": [{"address":"%s","role":"PARTY_TYPE_OWNER"}]
+}`, metadatatypes.ScopeMetadataAddress(uuid.New()), s.user2AddrStr))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	deposit := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()
+
+	testCases := []txCmdTestCase{
+		{
+			"unknown proposal type",
+			cli.MetadataProposalCmd(),
+			append([]string{"Unknown", validFile, deposit}, txFlags...),
+			true, "unknown proposal type Unknown", &sdk.TxResponse{}, 0,
+		},
+		{
+			"missing evidence",
+			cli.MetadataProposalCmd(),
+			append([]string{metadatatypes.ProposalTypeTransferScopeOwnership, noEvidenceFile, deposit}, txFlags...),
+			true, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully submit transfer scope ownership proposal",
+			cli.MetadataProposalCmd(),
+			append([]string{metadatatypes.ProposalTypeTransferScopeOwnership, validFile, deposit}, txFlags...),
+			false, "", &sdk.TxResponse{}, 0,
+		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+}
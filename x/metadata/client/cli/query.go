@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -28,7 +30,14 @@ var (
 	includeSessions    bool
 	includeRecords     bool
 	includeRecordSpecs bool
+	includeScopeSpecs  bool
 	includeRequest     bool
+	healthyOnly        bool
+	maxReportAge       time.Duration
+	verbose            bool
+	displayNameAttr    string
+	fieldMask          []string
+	lineageDepth       uint32
 )
 
 const all = "all"
@@ -53,9 +62,16 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataScopeSpecCmd(),
 		GetMetadataContractSpecCmd(),
 		GetMetadataRecordSpecCmd(),
+		GetMetadataSpecBundleCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
 		GetOSLocatorCmd(),
+		GetOSLocatorByNameCmd(),
+		GetInvariantsCmd(),
+		GetRecordConformanceCmd(),
+		GetRecordConsumersCmd(),
+		GetRecordLineageCmd(),
+		GetContractSpecSessionsCmd(),
 	)
 	return queryCmd
 }
@@ -192,6 +208,7 @@ func GetMetadataGetAllCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
+	addLocatorHealthFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "entries")
 
@@ -208,35 +225,52 @@ func GetMetadataScopeCmd() *cobra.Command {
 %[1]s scope {scope_uuid} - gets the scope with the given uuid.
 %[1]s scope {session_id} - gets the scope containing the given session.
 %[1]s scope {record_id} - gets the scope containing the given record.
-%[1]s scope all - gets all scopes.`, cmdStart),
+%[1]s scope all - gets all scopes.
+
+With --verbose, the scope is printed as an indented summary along with all of its sessions and records, the names of
+the specifications used, and the display name of each party address that has a --display-name-attribute attribute.`, cmdStart),
 		Args: cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s scope session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s scope record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s scope all`, cmdStart),
+%[1]s scope all
+%[1]s scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --verbose
+%[1]s scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --fields scope.scope.owners,scope.scope.specification_id`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
+				if verbose {
+					return fmt.Errorf("--verbose can not be used when querying all scopes")
+				}
 				return outputScopesAll(cmd)
 			}
+			output := outputScope
+			if verbose && len(fieldMask) > 0 {
+				return fmt.Errorf("--verbose can not be used with --fields")
+			}
+			if verbose {
+				output = outputScopeVerbose
+			}
 			id, idErr := types.MetadataAddressFromBech32(arg0)
 			if idErr == nil {
 				switch {
 				case id.IsScopeAddress():
-					return outputScope(cmd, id.String(), "", "")
+					return output(cmd, id.String(), "", "")
 				case id.IsSessionAddress():
-					return outputScope(cmd, "", id.String(), "")
+					return output(cmd, "", id.String(), "")
 				case id.IsRecordAddress():
-					return outputScope(cmd, "", "", id.String())
+					return output(cmd, "", "", id.String())
 				}
 			}
-			return outputScope(cmd, arg0, "", "")
+			return output(cmd, arg0, "", "")
 		},
 	}
 
+	addVerboseFlags(cmd)
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
+	addFieldMaskFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes (all)")
@@ -268,7 +302,8 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
 %[1]s session record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s session all`, cmdStart),
+%[1]s session all
+%[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --fields sessions.session.specification_id`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
@@ -298,6 +333,7 @@ func GetMetadataSessionCmd() *cobra.Command {
 
 	addIncludeScopeFlag(cmd)
 	addIncludeRecordsFlag(cmd)
+	addFieldMaskFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sessions (all)")
@@ -464,6 +500,33 @@ func GetMetadataRecordSpecCmd() *cobra.Command {
 	return cmd
 }
 
+// GetMetadataSpecBundleCmd returns the command handler for exporting a metadata specification bundle.
+func GetMetadataSpecBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "specbundle {contract_spec_id|contract_spec_uuid|record_spec_id}",
+		Aliases: []string{"sb", "bundle", "specificationbundle"},
+		Short:   "Export a contract specification and its record specifications as a bundle",
+		Long: fmt.Sprintf(`%[1]s specbundle {contract_spec_id} - gets the specification bundle for a given contract spec id.
+%[1]s specbundle {contract_spec_uuid} - gets the specification bundle for a given contract spec uuid.
+%[1]s specbundle {record_spec_id} - gets the specification bundle for the contract spec associated with that record spec id.
+
+The output can be written to a file and provided to the write-specification-bundle tx command.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s specbundle contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn
+%[1]s specbundle def6bc0a-c9dd-4874-948f-5206e6060a84 --include-scope-specs
+%[1]s specbundle recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return outputSpecBundle(cmd, strings.TrimSpace(args[0]))
+		},
+	}
+
+	addIncludeScopeSpecsFlag(cmd)
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetOwnershipCmd returns the command handler for metadata entry querying by owner address
 func GetOwnershipCmd() *cobra.Command {
 	// Note: Once we get queries for ownership of things other than scopes,
@@ -520,22 +583,29 @@ func GetValueOwnershipCmd() *cobra.Command {
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"}",
+		Use:     "locator {owner|scope_id|scope_uuid|contract_spec_id|uri|\"params\"|\"all\"}",
 		Aliases: []string{"l", "locators"},
 		Short:   "Query the current metadata for object store locators",
 		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locator for that owner.
 %[1]s locator {scope_id} - gets object store locators for all the owners of that scope.
 %[1]s locator {scope_uuid} - gets object store locators for all the owners of that scope.
+%[1]s locator {contract_spec_id} - gets the source hash of that contract spec and the object store locators it can be
+    downloaded from.
 %[1]s locator {uri} - gets object store locators with that uri.
 %[1]s locator params - gets the object store locator params.
-%[1]s locator all - gets all object store locators.`, cmdStart),
+%[1]s locator all - gets all object store locators.
+
+Locators for a scope, contract spec, uri, or all locators can be limited to those most recently reported healthy by their owners
+with --healthy-only.`, cmdStart),
 		Args: cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s locator cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck
 %[1]s locator scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s locator 91978ba2-5f35-459a-86a7-feca1b0512e0
+%[1]s locator contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn
 %[1]s locator https://provenance.io/
 %[1]s locator params
-%[1]s locator all`, cmdStart),
+%[1]s locator all
+%[1]s locator all --healthy-only --max-report-age 1h`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			// First check if it's just the string "params".
@@ -547,8 +617,11 @@ func GetOSLocatorCmd() *cobra.Command {
 				return outputOSLocatorsAll(cmd)
 			}
 			// Now look to see if it's a metadata address.
-			_, idErr := types.MetadataAddressFromBech32(arg0)
+			id, idErr := types.MetadataAddressFromBech32(arg0)
 			if idErr == nil {
+				if id.IsContractSpecificationAddress() {
+					return outputOSLocatorsByContractSpec(cmd, arg0)
+				}
 				return outputOSLocatorsByScope(cmd, arg0)
 			}
 			// Okay... maybe check for a generic bech32.
@@ -567,12 +640,33 @@ func GetOSLocatorCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
+	addLocatorHealthFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
 
 	return cmd
 }
 
+// GetInvariantsCmd returns the command handler for running the metadata invariants without halting the chain.
+func GetInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the metadata module invariants against the current state",
+		Long: `Check the metadata module invariants against the current state.
+This is a dry run of the invariants checked by the crisis module: broken invariants are reported but do not halt the chain.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s invariants", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return outputInvariants(cmd)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -608,6 +702,7 @@ func outputScope(cmd *cobra.Command, scopeID string, sessionAddr string, recordA
 		RecordAddr:      recordAddr,
 		IncludeSessions: includeSessions,
 		IncludeRecords:  includeRecords,
+		FieldMask:       fieldMask,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -623,6 +718,39 @@ func outputScope(cmd *cobra.Command, scopeID string, sessionAddr string, recordA
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopeVerbose calls the Scope query with all sessions and records, looks up the names of the specifications
+// and parties involved, and outputs a human readable summary of the scope.
+func outputScopeVerbose(cmd *cobra.Command, scopeID string, sessionAddr string, recordAddr string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := types.ScopeRequest{
+		ScopeId:         scopeID,
+		SessionAddr:     sessionAddr,
+		RecordAddr:      recordAddr,
+		IncludeSessions: true,
+		IncludeRecords:  true,
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Scope(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+	if res.Scope == nil || res.Scope.Scope == nil {
+		return fmt.Errorf("scope not found")
+	}
+
+	d := scopeDumper{
+		queryClient: queryClient,
+		attrClient:  attributetypes.NewQueryClient(clientCtx),
+		names:       make(map[string]string),
+	}
+	return clientCtx.PrintString(d.dump(res))
+}
+
 // outputScopesAll calls the ScopesAllRequest query and outputs the response.
 func outputScopesAll(cmd *cobra.Command) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -663,6 +791,7 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 		RecordName:     recordName,
 		IncludeScope:   includeScope,
 		IncludeRecords: includeRecords,
+		FieldMask:      fieldMask,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -670,7 +799,7 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 	if err != nil {
 		return err
 	}
-	if res == nil || res.Sessions == nil || len(res.Sessions) == 0 {
+	if res == nil || (len(res.Sessions) == 0 && types.FieldMaskIncludes(fieldMask, "sessions")) {
 		return errors.New("no sessions found")
 	}
 
@@ -964,6 +1093,31 @@ func outputRecordSpecsForContractSpec(cmd *cobra.Command, specificationID string
 	return clientCtx.PrintProto(res)
 }
 
+// outputSpecBundle calls the SpecificationBundle query and outputs the response.
+func outputSpecBundle(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	req := types.SpecificationBundleRequest{
+		SpecificationId:   specificationID,
+		IncludeScopeSpecs: includeScopeSpecs,
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SpecificationBundle(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputRecordSpecsAll calls the RecordSpecificationsAll query and outputs the response.
 func outputRecordSpecsAll(cmd *cobra.Command) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1047,7 +1201,12 @@ func outputOSLocatorsByURI(cmd *cobra.Command, uri string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.OSLocatorsByURI(
 		context.Background(),
-		&types.OSLocatorsByURIRequest{Uri: uri, Pagination: pageReq},
+		&types.OSLocatorsByURIRequest{
+			Uri:                 uri,
+			HealthyOnly:         healthyOnly,
+			MaxReportAgeSeconds: uint64(maxReportAge.Seconds()),
+			Pagination:          pageReq,
+		},
 	)
 	if err != nil {
 		return err
@@ -1069,7 +1228,37 @@ func outputOSLocatorsByScope(cmd *cobra.Command, scopeID string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.OSLocatorsByScope(
 		context.Background(),
-		&types.OSLocatorsByScopeRequest{ScopeId: scopeID},
+		&types.OSLocatorsByScopeRequest{
+			ScopeId:             scopeID,
+			HealthyOnly:         healthyOnly,
+			MaxReportAgeSeconds: uint64(maxReportAge.Seconds()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputOSLocatorsByContractSpec calls the OSLocatorsByContractSpec query and outputs the response.
+func outputOSLocatorsByContractSpec(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.OSLocatorsByContractSpec(
+		context.Background(),
+		&types.OSLocatorsByContractSpecRequest{
+			SpecificationId:     specificationID,
+			HealthyOnly:         healthyOnly,
+			MaxReportAgeSeconds: uint64(maxReportAge.Seconds()),
+		},
 	)
 	if err != nil {
 		return err
@@ -1095,7 +1284,11 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.OSAllLocators(
 		context.Background(),
-		&types.OSAllLocatorsRequest{Pagination: pageReq},
+		&types.OSAllLocatorsRequest{
+			HealthyOnly:         healthyOnly,
+			MaxReportAgeSeconds: uint64(maxReportAge.Seconds()),
+			Pagination:          pageReq,
+		},
 	)
 	if err != nil {
 		return err
@@ -1108,8 +1301,240 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// GetRecordConformanceCmd returns the command handler for checking the records of a scope against their specifications.
+func GetRecordConformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "conformance {scope_id}",
+		Aliases: []string{"conform"},
+		Short:   "Check each record of a scope against its record specification",
+		Long: `Check each record of a scope against its record specification and report every way in which a record does not conform.
+The {scope_id} can either be a scope address or scope uuid.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s conformance scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordConformance(
+				context.Background(),
+				&types.RecordConformanceRequest{ScopeId: strings.TrimSpace(args[0])},
+			)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetOSLocatorByNameCmd returns the command handler for querying the object store locator of the address a name is
+// bound to.
+func GetOSLocatorByNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "locator-by-name {name}",
+		Aliases: []string{"ln"},
+		Short:   "Query the object store locator of the address a name is bound to",
+		Long: `Resolve a name to the address it is bound to and get the object store locator of that address.
+The owner address is returned along with the locator.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s locator-by-name objectstore.pb", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OSLocatorByName(
+				context.Background(),
+				&types.OSLocatorByNameRequest{Name: strings.TrimSpace(args[0])},
+			)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRecordConsumersCmd returns the command handler for querying the records with an input that references a record.
+func GetRecordConsumersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consumers {record_addr}",
+		Aliases: []string{"downstream"},
+		Short:   "Query the records with an input that references a record",
+		Long: `Get the downstream records, in any scope, with an input that uses the given record as its source.
+The {record_addr} must be a record address.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s consumers record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordConsumers(
+				context.Background(),
+				&types.RecordConsumersRequest{RecordAddr: strings.TrimSpace(args[0]), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumers")
+
+	return cmd
+}
+
+// GetRecordLineageCmd returns the command handler for querying the records upstream of a record.
+func GetRecordLineageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lineage {record_addr}",
+		Aliases: []string{"upstream"},
+		Short:   "Query the records referenced by the inputs of a record, recursively",
+		Long: fmt.Sprintf(`Get the upstream records, in any scope, that the given record was derived from by following the inputs
+that reference other records, along with the sessions the records were written in.
+The {record_addr} must be a record address.
+The --depth is the number of input references to follow, it defaults to %d and cannot be more than %d.`,
+			types.DefaultRecordLineageDepth, types.MaxRecordLineageDepth),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s lineage record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --depth 3", cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordLineage(
+				context.Background(),
+				&types.RecordLineageRequest{RecordAddr: strings.TrimSpace(args[0]), Depth: lineageDepth},
+			)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32Var(&lineageDepth, "depth", 0, "the number of input references to follow (0 for the default)")
+
+	return cmd
+}
+
+// GetContractSpecSessionsCmd returns the command handler for querying the sessions written under a contract
+// specification.
+func GetContractSpecSessionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contractspec-sessions {contract_spec_id|contract_spec_uuid}",
+		Aliases: []string{"cs-sessions"},
+		Short:   "Query the sessions written under a contract specification",
+		Long: `Get the sessions, in any scope, written under the given contract specification.
+The number of sessions can be counted with --count-total.`,
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s contractspec-sessions contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn
+%[1]s contractspec-sessions def6bc0a-c9dd-4874-948f-5206e6060a84 --count-total --limit 1`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SessionsByContractSpec(
+				context.Background(),
+				&types.SessionsByContractSpecRequest{SpecificationId: strings.TrimSpace(args[0]), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			if !includeRequest {
+				res.Request = nil
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sessions")
+
+	return cmd
+}
+
 // ------------ private generic helper functions ------------
 
+// outputInvariants calls the Invariants query and outputs the response.
+func outputInvariants(cmd *cobra.Command) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Invariants(context.Background(), &types.InvariantsRequest{})
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
 // then joins them using the provided sep string,
 // then lastly trims any left over leading and trailing whitespace from that result.
@@ -1139,18 +1564,44 @@ func addIncludeRecordsFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRecords, "include-records", false, "include records in the output")
 }
 
+// addFieldMaskFlag sets up a command to look for a --fields flag.
+// The flag value is tied to the fieldMask variable.
+func addFieldMaskFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&fieldMask, "fields", nil,
+		"comma separated paths of the response fields to output, e.g. scope.scope.owners (default all fields)")
+}
+
 // addIncludeRecordSpecsFlag sets up a command to look for an --include-record-specs.
 // The flag value is tied to the includeRecordSpecs variable.
 func addIncludeRecordSpecsFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRecordSpecs, "include-record-specs", false, "include record specs in the output")
 }
 
+// addIncludeScopeSpecsFlag sets up a command to look for an --include-scope-specs.
+// The flag value is tied to the includeScopeSpecs variable.
+func addIncludeScopeSpecsFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&includeScopeSpecs, "include-scope-specs", false, "include scope specs in the output")
+}
+
 // addIncludeRequestFlag sets up a command to look for an --include-request.
 // The flag value is tied to the includeRequest variable.
 func addIncludeRequestFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRequest, "include-request", false, "include the query request in the output")
 }
 
+// addVerboseFlags sets up a command to look for the --verbose and --display-name-attribute flags.
+// The flag values are tied to the verbose and displayNameAttr variables.
+func addVerboseFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&verbose, "verbose", false, "output a human readable summary of the scope with its sessions, records, and specification names")
+	cmd.Flags().StringVar(&displayNameAttr, "display-name-attribute", "displayname", "the account attribute holding the display name of a party (with --verbose)")
+}
+
+// addLocatorHealthFlags adds the flags for filtering object store locators by their reported health.
+func addLocatorHealthFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&healthyOnly, "healthy-only", false, "only include locators most recently reported as healthy")
+	cmd.Flags().DurationVar(&maxReportAge, "max-report-age", 0, "the maximum age of the health report of a healthy locator (0 for no maximum)")
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -0,0 +1,247 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// scopeDumper builds the human readable summary of a scope output by the scope --verbose command.
+type scopeDumper struct {
+	queryClient types.QueryClient
+	attrClient  attributetypes.QueryClient
+
+	// names caches the looked up display names of addresses and specifications.
+	names map[string]string
+
+	sb strings.Builder
+}
+
+// dump returns the human readable summary of the scope, sessions, and records in the scope query response.
+func (d *scopeDumper) dump(res *types.ScopeResponse) string {
+	scope := res.Scope.Scope
+	d.line(0, "Scope: %s", scope.ScopeId)
+	if res.Scope.ScopeIdInfo != nil {
+		d.line(1, "UUID: %s", res.Scope.ScopeIdInfo.ScopeUuid)
+	}
+	d.line(1, "Specification: %s", d.withName(scope.SpecificationId.String(), d.scopeSpecName(scope.SpecificationId)))
+	if len(scope.ValueOwnerAddress) > 0 {
+		d.line(1, "Value Owner: %s", d.party(scope.ValueOwnerAddress))
+	}
+	d.parties(1, "Owners", scope.Owners)
+	if len(scope.DataAccess) > 0 {
+		d.line(1, "Data Access:")
+		for _, addr := range scope.DataAccess {
+			d.line(2, "- %s", d.party(addr))
+		}
+	}
+
+	// Records are listed under the session that created them.
+	recordsBySession := make(map[string][]*types.RecordWrapper)
+	for _, r := range res.Records {
+		if r.Record == nil {
+			continue
+		}
+		sessionID := r.Record.SessionId.String()
+		recordsBySession[sessionID] = append(recordsBySession[sessionID], r)
+	}
+	if len(res.Sessions) > 0 {
+		d.line(1, "Sessions:")
+	}
+	for _, s := range res.Sessions {
+		if s.Session == nil {
+			continue
+		}
+		session := s.Session
+		d.line(2, "- %s", d.withName(session.SessionId.String(), session.Name))
+		d.line(3, "Contract Specification: %s", d.withName(session.SpecificationId.String(), d.contractSpecName(session.SpecificationId)))
+		d.parties(3, "Parties", session.Parties)
+		if session.Audit != nil {
+			d.line(3, "Created: %s by %s", session.Audit.CreatedDate.UTC().Format("2006-01-02 15:04:05"), d.party(session.Audit.CreatedBy))
+		}
+		records := recordsBySession[session.SessionId.String()]
+		delete(recordsBySession, session.SessionId.String())
+		if len(records) > 0 {
+			d.line(3, "Records:")
+			for _, r := range records {
+				d.record(4, r.Record)
+			}
+		}
+	}
+	// Records from sessions that were not returned can only show up here if the state is inconsistent.
+	var orphans []*types.RecordWrapper
+	for _, r := range res.Records {
+		if r.Record != nil && len(recordsBySession[r.Record.SessionId.String()]) > 0 {
+			orphans = append(orphans, r)
+		}
+	}
+	if len(orphans) > 0 {
+		d.line(1, "Records without a session:")
+		for _, r := range orphans {
+			d.record(2, r.Record)
+		}
+	}
+
+	return strings.TrimSuffix(d.sb.String(), "\n")
+}
+
+// record adds the summary of a record to the output at the given indentation level.
+func (d *scopeDumper) record(level int, record *types.Record) {
+	id, err := record.SessionId.AsRecordAddress(record.Name)
+	if err != nil {
+		d.line(level, "- %q", record.Name)
+	} else {
+		d.line(level, "- %s", d.withName(id.String(), record.Name))
+	}
+	d.line(level+1, "Specification: %s", d.withName(record.SpecificationId.String(), d.recordSpecName(record.SpecificationId)))
+	process := fmt.Sprintf("%q", record.Process.Name)
+	if len(record.Process.Method) > 0 {
+		process += " method " + record.Process.Method
+	}
+	switch {
+	case len(record.Process.GetAddress()) > 0:
+		process += " at " + d.party(record.Process.GetAddress())
+	case len(record.Process.GetHash()) > 0:
+		process += " with hash " + record.Process.GetHash()
+	}
+	d.line(level+1, "Process: %s", process)
+	if len(record.Inputs) > 0 {
+		d.line(level+1, "Inputs:")
+		for _, input := range record.Inputs {
+			source := input.GetHash()
+			if recordID, ok := input.Source.(*types.RecordInput_RecordId); ok {
+				source = recordID.RecordId.String()
+			}
+			d.line(level+2, "- %s (%s) %s: %s", input.Name, input.TypeName, input.Status, source)
+		}
+	}
+	if len(record.Outputs) > 0 {
+		d.line(level+1, "Outputs:")
+		for _, output := range record.Outputs {
+			d.line(level+2, "- %s: %s", output.Status, output.Hash)
+		}
+	}
+	if len(record.DataAccess) > 0 {
+		d.line(level+1, "Data Access:")
+		for _, addr := range record.DataAccess {
+			d.line(level+2, "- %s", d.party(addr))
+		}
+	}
+}
+
+// parties adds a titled list of parties to the output at the given indentation level.
+func (d *scopeDumper) parties(level int, title string, parties []types.Party) {
+	if len(parties) == 0 {
+		return
+	}
+	d.line(level, "%s:", title)
+	for _, p := range parties {
+		d.line(level+1, "- %s: %s", p.Role, d.party(p.Address))
+	}
+}
+
+// line adds a line to the output indented two spaces for each level.
+func (d *scopeDumper) line(level int, format string, args ...interface{}) {
+	d.sb.WriteString(strings.Repeat("  ", level))
+	d.sb.WriteString(fmt.Sprintf(format, args...))
+	d.sb.WriteString("\n")
+}
+
+// withName returns the id followed by the quoted name if there is one.
+func (d *scopeDumper) withName(id string, name string) string {
+	if len(name) == 0 {
+		return id
+	}
+	return fmt.Sprintf("%s %q", id, name)
+}
+
+// party returns the address followed by its display name if the account has one.
+func (d *scopeDumper) party(addr string) string {
+	name, found := d.names[addr]
+	if !found {
+		name = d.displayName(addr)
+		d.names[addr] = name
+	}
+	if len(name) == 0 {
+		return addr
+	}
+	return fmt.Sprintf("%s (%s)", addr, name)
+}
+
+// displayName returns the first string value of the display name attribute on the account, or "" if there isn't one.
+func (d *scopeDumper) displayName(addr string) string {
+	if len(displayNameAttr) == 0 {
+		return ""
+	}
+	res, err := d.attrClient.Attribute(context.Background(), &attributetypes.QueryAttributeRequest{Account: addr, Name: displayNameAttr})
+	if err != nil {
+		return ""
+	}
+	for _, attr := range res.Attributes {
+		if attr.AttributeType == attributetypes.AttributeType_String {
+			return string(attr.Value)
+		}
+	}
+	return ""
+}
+
+// scopeSpecName returns the name from the description of the scope specification, or "" if it can't be found.
+func (d *scopeDumper) scopeSpecName(id types.MetadataAddress) string {
+	if id.Empty() {
+		return ""
+	}
+	res, err := d.queryClient.ScopeSpecification(context.Background(), &types.ScopeSpecificationRequest{SpecificationId: id.String()})
+	if err != nil || res.ScopeSpecification == nil || res.ScopeSpecification.Specification == nil {
+		return ""
+	}
+	if desc := res.ScopeSpecification.Specification.Description; desc != nil {
+		return desc.Name
+	}
+	return ""
+}
+
+// contractSpecName returns the name from the description of the contract specification, or its class name if it has
+// no description, or "" if it can't be found.
+func (d *scopeDumper) contractSpecName(id types.MetadataAddress) string {
+	if id.Empty() {
+		return ""
+	}
+	if name, found := d.names[id.String()]; found {
+		return name
+	}
+	var name string
+	res, err := d.queryClient.ContractSpecification(context.Background(), &types.ContractSpecificationRequest{SpecificationId: id.String()})
+	if err == nil && res.ContractSpecification != nil && res.ContractSpecification.Specification != nil {
+		spec := res.ContractSpecification.Specification
+		name = spec.ClassName
+		if spec.Description != nil && len(spec.Description.Name) > 0 {
+			name = spec.Description.Name
+		}
+	}
+	d.names[id.String()] = name
+	return name
+}
+
+// recordSpecName returns the name and type name of the record specification, or "" if it can't be found.
+func (d *scopeDumper) recordSpecName(id types.MetadataAddress) string {
+	if id.Empty() {
+		return ""
+	}
+	if name, found := d.names[id.String()]; found {
+		return name
+	}
+	var name string
+	res, err := d.queryClient.RecordSpecification(context.Background(), &types.RecordSpecificationRequest{SpecificationId: id.String()})
+	if err == nil && res.RecordSpecification != nil && res.RecordSpecification.Specification != nil {
+		spec := res.RecordSpecification.Specification
+		name = spec.Name
+		if len(spec.TypeName) > 0 {
+			name = fmt.Sprintf("%s: %s", spec.Name, spec.TypeName)
+		}
+	}
+	d.names[id.String()] = name
+	return name
+}
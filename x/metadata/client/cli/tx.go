@@ -1,27 +1,46 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
+	"time"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/provenance-io/provenance/internal/txbroadcast"
 	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/types/p8e"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	FlagSigners  = "signers"
-	AddSwitch    = "add"
-	RemoveSwitch = "remove"
+	FlagSigners              = "signers"
+	FlagValueOwnerAsCoin     = "value-owner-as-coin"
+	FlagExpectedVersion      = "expected-version"
+	FlagIdempotencyKey       = "idempotency-key"
+	FlagAllowedURIs          = "allowed-uris"
+	FlagMaxUses              = "max-uses"
+	FlagExpiration           = "expiration"
+	FlagP8eFile              = "p8e-file"
+	FlagStrict               = "strict"
+	FlagValueOwnerMarkers    = "value-owner-markers"
+	FlagValueOwnerAttributes = "value-owner-attributes"
+	FlagValueOwnerAddresses  = "value-owner-addresses"
+	AddSwitch                = "add"
+	RemoveSwitch             = "remove"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -40,16 +59,21 @@ func NewTxCmd() *cobra.Command {
 		RemoveScopeCmd(),
 		AddRemoveScopeDataAccessCmd(),
 		AddRemoveScopeOwnersCmd(),
+		ArchiveScopeCmd(),
+		RestoreScopeCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
 		ModifyOsLocatorCmd(),
+		ReportOsLocatorStatusCmd(),
+		GrantOsLocatorAuthorizationCmd(),
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
 
 		WriteContractSpecificationCmd(),
 		RemoveContractSpecificationCmd(),
+		AddRemoveContractSpecSourceLocatorCmd(),
 
 		AddContractSpecToScopeSpecCmd(),
 		RemoveContractSpecFromScopeSpecCmd(),
@@ -57,12 +81,21 @@ func NewTxCmd() *cobra.Command {
 		WriteRecordSpecificationCmd(),
 		RemoveRecordSpecificationCmd(),
 
+		WriteSpecificationBundleCmd(),
+		DeprecateSpecificationCmd(),
+
 		WriteSessionCmd(),
 
 		WriteRecordCmd(),
+		WriteSessionAndRecordsCmd(),
 		RemoveRecordCmd(),
+		AddRemoveRecordDataAccessCmd(),
+		RedactRecordCmd(),
+
+		MetadataProposalCmd(),
 	)
 
+	txbroadcast.AddFlags(txCmd.PersistentFlags())
 	return txCmd
 }
 
@@ -111,16 +144,31 @@ func WriteScopeCmd() *cobra.Command {
 				valueOwnerAddress)
 
 			msg := types.NewMsgWriteScopeRequest(scope, signers)
+			msg.ValueOwnerAsCoin, err = cmd.Flags().GetBool(FlagValueOwnerAsCoin)
+			if err != nil {
+				return err
+			}
+			msg.ExpectedVersion, err = cmd.Flags().GetUint64(FlagExpectedVersion)
+			if err != nil {
+				return err
+			}
+			msg.IdempotencyKey, err = cmd.Flags().GetString(FlagIdempotencyKey)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().Bool(FlagValueOwnerAsCoin, false, "represent value ownership of the scope with a coin sent to the value owner")
+	cmd.Flags().Uint64(FlagExpectedVersion, 0, "fail unless the scope is currently at this version (0 to skip the check)")
+	addIdempotencyKeyFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -155,7 +203,98 @@ func RemoveScopeCmd() *cobra.Command {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ArchiveScopeCmd creates a command to archive the sessions and records of a scope
+func ArchiveScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-scope scope-id locator-uri",
+		Short: "Archive the sessions and records of a metadata scope on the provenance blockchain",
+		Long: `Archive the sessions and records of a metadata scope on the provenance blockchain.
+The sessions and records are removed from the chain, leaving a stub with the sha256 hash of their content and the
+object store uri (locator-uri) that the content has been stored at.  The content can be restored with restore-scope.
+All the scope owners and the scope value owner must sign.`,
+		Example: fmt.Sprintf(`%[1]s tx metadata archive-scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel https://archive.example.com/scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveScopeRequest(scopeID, args[1], signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RestoreScopeCmd creates a command to restore the archived sessions and records of a scope
+func RestoreScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore-scope content-file",
+		Short: "Restore the archived sessions and records of a metadata scope on the provenance blockchain",
+		Long: `Restore the archived sessions and records of a metadata scope on the provenance blockchain.
+
+content-file - path to a JSON file containing the archived content of the scope: its scope_id, sessions and records.
+               The content must match the content hash of the archived scope.
+
+All the scope owners and the scope value owner must sign.`,
+		Example: fmt.Sprintf(`%[1]s tx metadata restore-scope scope-archive.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content types.ScopeArchiveContent
+			if err = clientCtx.JSONCodec.UnmarshalJSON(contents, &content); err != nil {
+				return fmt.Errorf("invalid scope archive content: %w", err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRestoreScopeRequest(content, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -207,7 +346,7 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -264,7 +403,7 @@ func AddRemoveScopeOwnersCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -296,7 +435,7 @@ func BindOsLocatorCmd() *cobra.Command {
 			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
 		},
 	}
 
@@ -327,7 +466,7 @@ func RemoveOsLocatorCmd() *cobra.Command {
 			}
 
 			deleteOSLocator := *types.NewMsgDeleteOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &deleteOSLocator)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &deleteOSLocator)
 		},
 	}
 
@@ -362,11 +501,109 @@ func ModifyOsLocatorCmd() *cobra.Command {
 			}
 
 			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReportOsLocatorStatusCmd creates a command to report the current status of the object store locator for an owner.
+func ReportOsLocatorStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "report-locator-status owner {healthy|degraded|unavailable}",
+		Aliases: []string{"rls"},
+		Short:   "Report the current status of the object store locator endpoint for an owner on the provenance blockchain",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+			status, found := types.OSLocatorStatus_value["OS_LOCATOR_STATUS_"+strings.ToUpper(strings.TrimSpace(args[1]))]
+			if !found {
+				return fmt.Errorf("invalid os locator status: %s; expected healthy|degraded|unavailable", args[1])
+			}
+
+			msg := types.NewMsgReportOSLocatorStatusRequest(args[0], types.OSLocatorStatus(status))
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GrantOsLocatorAuthorizationCmd creates a command to authorize a service account to bind or modify the object store
+// locator of the from address.
+func GrantOsLocatorAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant-locator-authz grantee {bind|modify}",
+		Aliases: []string{"gla"},
+		Short:   "Authorize an address to bind or modify the object store locator of the from address",
+		Long: fmt.Sprintf(`Authorize an address to bind or modify the object store locator of the from address.
+
+The grantee executes the bind-locator or modify-locator msg of the owner with "%[1]s tx authz exec".  With --%[2]s
+the grantee may only set one of the given uris, and with --%[3]s the authorization is removed after that many uses.
+The authorization is revoked with "%[1]s tx authz revoke".`, version.AppName, FlagAllowedURIs, FlagMaxUses),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata grant-locator-authz pb1skjw.. modify --%[2]s=https://a.example.com,https://b.example.com --%[3]s=10 --from mykey`,
+			version.AppName, FlagAllowedURIs, FlagMaxUses),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+			var msgTypeURL string
+			switch strings.ToLower(strings.TrimSpace(args[1])) {
+			case "bind":
+				msgTypeURL = sdk.MsgTypeURL(&types.MsgBindOSLocatorRequest{})
+			case "modify":
+				msgTypeURL = sdk.MsgTypeURL(&types.MsgModifyOSLocatorRequest{})
+			default:
+				return fmt.Errorf("invalid authorization type %s; expected bind|modify", args[1])
+			}
+			allowedURIs, err := cmd.Flags().GetStringSlice(FlagAllowedURIs)
+			if err != nil {
+				return err
+			}
+			maxUses, err := cmd.Flags().GetUint32(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewOSLocatorAuthorization(msgTypeURL, allowedURIs, maxUses)
+			if err = authorization.ValidateBasic(); err != nil {
+				return err
+			}
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAllowedURIs, nil, "The uris the grantee may set (default any uri)")
+	cmd.Flags().Uint32(FlagMaxUses, 0, "The number of times the grantee may use the authorization (default unlimited)")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 
 	return cmd
 }
@@ -409,6 +646,14 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				PartiesInvolved: parsePartyTypes(args[2]),
 				ContractSpecIds: contractSpecIds,
 			}
+			scopeSpec.Strict, err = cmd.Flags().GetBool(FlagStrict)
+			if err != nil {
+				return err
+			}
+			scopeSpec.ValueOwnerConstraint, err = parseValueOwnerConstraint(cmd)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
 			err = msg.ValidateBasic()
@@ -416,10 +661,14 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagStrict, false, "hold the records of scopes using the specification strictly to their record specifications")
+	cmd.Flags().Bool(FlagValueOwnerMarkers, false, "allow marker accounts as value owners of scopes using the specification")
+	cmd.Flags().StringSlice(FlagValueOwnerAttributes, nil, "allow accounts holding any of these attributes as value owners of scopes using the specification")
+	cmd.Flags().StringSlice(FlagValueOwnerAddresses, nil, "allow these addresses as value owners of scopes using the specification")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -431,7 +680,7 @@ func WriteContractSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "write-contract-specification contractspec-id owners parties-involved source-value classname [description-name [description [website-url [icon-url]]]]",
 		Short: "Add/Update metadata contract specification on the provenance blockchain",
-		Long: `Add/Update metadata contract specification on the provenance blockchain
+		Long: fmt.Sprintf(`Add/Update metadata contract specification on the provenance blockchain
 contractspec-id    - contract specification metaaddress
 owners             - comma delimited list of bech32 owner addresses
 parties-involved   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
@@ -440,13 +689,27 @@ classname          - name of contract specification
 description-name   - description name identifier (optional)
 description        - description text (optional, can only be provided with a description-name)
 website-url        - address of website (optional, can only be provided with a description)
-icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
-		Args: cobra.RangeArgs(5, 9),
+icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)
+
+With --%[1]s, no arguments are given and the contract specification and its record specifications
+are converted from a legacy p8e contract specification JSON file instead.  The signers become the owners
+of the converted contract specification.  Combined with --%[2]s, the converted specifications are
+printed instead of being submitted.`, FlagP8eFile, flags.FlagDryRun),
+		Example: fmt.Sprintf(`%[1]s tx metadata write-contract-specification --%[2]s spec.json --from mykey`, version.AppName, FlagP8eFile),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(FlagP8eFile) {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.RangeArgs(5, 9)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			if cmd.Flags().Changed(FlagP8eFile) {
+				return writeP8eContractSpec(cmd, clientCtx)
+			}
 			specificationID, err := types.MetadataAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -483,15 +746,177 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagP8eFile, "", "path to a legacy p8e contract specification JSON file to convert and write")
 
 	return cmd
 }
 
+// writeP8eContractSpec converts the p8e contract specification in the file of the p8e-file flag and writes it along
+// with its record specifications in a single specification bundle, or prints them on a dry run.
+func writeP8eContractSpec(cmd *cobra.Command, clientCtx client.Context) error {
+	file, err := cmd.Flags().GetString(FlagP8eFile)
+	if err != nil {
+		return err
+	}
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	p8eSpec, err := parseP8eContractSpec(contents)
+	if err != nil {
+		return err
+	}
+
+	signers, err := parseSigners(cmd, &clientCtx)
+	if err != nil {
+		return err
+	}
+	contractSpec, recordSpecs, err := types.ConvertP8eContractSpec(p8eSpec, signers)
+	if err != nil {
+		return fmt.Errorf("could not convert p8e contract specification: %w", err)
+	}
+	bundle := types.NewSpecificationBundle(contractSpec, recordSpecs, nil)
+	if clientCtx.Simulate {
+		return clientCtx.PrintProto(bundle)
+	}
+
+	msg := types.NewMsgWriteSpecificationBundleRequest(*bundle, signers)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// p8eEnumPrefixes are the prefixes of the enum value names of the p8e contract specification fields, the p8e tooling
+// writes the enum values without them.
+var p8eEnumPrefixes = map[string]string{
+	"type":              "DEFINITION_SPEC_TYPE_",
+	"partiesInvolved":   "PARTY_TYPE_",
+	"parties_involved":  "PARTY_TYPE_",
+	"responsibleParty":  "PARTY_TYPE_",
+	"responsible_party": "PARTY_TYPE_",
+}
+
+// parseP8eContractSpec reads a p8e contract specification from its JSON as written by the p8e tooling.
+func parseP8eContractSpec(contents []byte) (*p8e.ContractSpec, error) {
+	var raw interface{}
+	if err := json.Unmarshal(contents, &raw); err != nil {
+		return nil, fmt.Errorf("invalid p8e contract specification: %w", err)
+	}
+	normalized, err := json.Marshal(prefixP8eEnums(raw, ""))
+	if err != nil {
+		return nil, err
+	}
+	var spec p8e.ContractSpec
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(bytes.NewReader(normalized), &spec); err != nil {
+		return nil, fmt.Errorf("invalid p8e contract specification: %w", err)
+	}
+	if spec.Definition == nil || spec.Definition.ResourceLocation == nil {
+		return nil, fmt.Errorf("invalid p8e contract specification: missing definition resource location")
+	}
+	for i, consideration := range spec.ConsiderationSpecs {
+		if consideration.OutputSpec == nil || consideration.OutputSpec.Spec == nil ||
+			consideration.OutputSpec.Spec.ResourceLocation == nil {
+			return nil, fmt.Errorf("invalid p8e contract specification: consideration %d is missing an output spec", i)
+		}
+		for j, input := range consideration.InputSpecs {
+			if input.ResourceLocation == nil || input.ResourceLocation.Ref == nil {
+				return nil, fmt.Errorf("invalid p8e contract specification: input %d of consideration %d is missing a reference", j, i)
+			}
+		}
+	}
+	return &spec, nil
+}
+
+// prefixP8eEnums adds the missing enum name prefixes to the string values of the enum fields in the decoded JSON value
+// of the field with the given name.
+func prefixP8eEnums(value interface{}, field string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			v[name] = prefixP8eEnums(fieldValue, name)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = prefixP8eEnums(v[i], field)
+		}
+	case string:
+		if prefix, ok := p8eEnumPrefixes[field]; ok && !strings.HasPrefix(v, prefix) {
+			return prefix + v
+		}
+	}
+	return value
+}
+
+// WriteSpecificationBundleCmd creates a command to add/update a contract specification along with its record
+// specifications (and any scope specifications) from a bundle file.
+func WriteSpecificationBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "write-specification-bundle bundle-file",
+		Aliases: []string{"wsb"},
+		Short:   "Add/Update a bundle of metadata specifications on the provenance blockchain",
+		Long: fmt.Sprintf(`Add/Update a contract specification, its record specifications, and any scope specifications
+contained in a specification bundle file in a single transaction.
+
+bundle-file - path to a JSON file containing a specification bundle.  The output of the
+              "%[1]s query metadata specbundle" command can be used as is.`, version.AppName),
+		Example: fmt.Sprintf(`%[1]s tx metadata write-specification-bundle bundle.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			bundle, err := parseSpecificationBundle(clientCtx, contents)
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWriteSpecificationBundleRequest(*bundle, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSpecificationBundle reads a specification bundle from either the JSON of a Query/SpecificationBundle response
+// or the JSON of the bundle itself.
+func parseSpecificationBundle(clientCtx client.Context, contents []byte) (*types.SpecificationBundle, error) {
+	var res types.SpecificationBundleResponse
+	if err := clientCtx.JSONCodec.UnmarshalJSON(contents, &res); err == nil && res.Bundle != nil {
+		return res.Bundle, nil
+	}
+	var bundle types.SpecificationBundle
+	if err := clientCtx.JSONCodec.UnmarshalJSON(contents, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid specification bundle: %w", err)
+	}
+	return &bundle, nil
+}
+
 // AddContractSpecToScopeSpecCmd creates an add contract spec to scope spec command
 func AddContractSpecToScopeSpecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -523,7 +948,7 @@ func AddContractSpecToScopeSpecCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	addSignerFlagCmd(cmd)
@@ -655,7 +1080,7 @@ ChFIRUxMTyBQUk9WRU5BTkNFIQ==`, version.AppName),
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), writeSessionMsg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), writeSessionMsg)
 		},
 	}
 
@@ -740,6 +1165,10 @@ $ %[1]s tx metadata write-record recspec1qh... recordname myprocessname,myhashva
 			if err != nil {
 				return err
 			}
+			idempotencyKey, err := cmd.Flags().GetString(FlagIdempotencyKey)
+			if err != nil {
+				return err
+			}
 			var sessionID types.MetadataAddress
 			var writeSessionMsg *types.MsgWriteSessionRequest
 			switch {
@@ -747,7 +1176,12 @@ $ %[1]s tx metadata write-record recspec1qh... recordname myprocessname,myhashva
 				record.SessionId = contractOrSessionID
 			case contractOrSessionID.IsContractSpecificationAddress():
 				scopeUUID, _ := scopeID.ScopeUUID()
-				sessionID = types.SessionMetadataAddress(scopeUUID, uuid.New())
+				sessionUUID := uuid.New()
+				if len(idempotencyKey) > 0 {
+					// A retry with the same key writes the same session again instead of creating another one.
+					sessionUUID = uuid.NewSHA1(scopeUUID, []byte(idempotencyKey))
+				}
+				sessionID = types.SessionMetadataAddress(scopeUUID, sessionUUID)
 				record.SessionId = sessionID
 				session := types.Session{
 					SessionId:       sessionID,
@@ -763,18 +1197,25 @@ $ %[1]s tx metadata write-record recspec1qh... recordname myprocessname,myhashva
 				return fmt.Errorf("id must be a contract or session id: %s", contractOrSessionID.String())
 			}
 			msg := *types.NewMsgWriteRecordRequest(record, nil, "", signers, parties)
+			msg.ExpectedScopeVersion, err = cmd.Flags().GetUint64(FlagExpectedVersion)
+			if err != nil {
+				return err
+			}
+			msg.IdempotencyKey = idempotencyKey
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 			if writeSessionMsg != nil {
-				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), writeSessionMsg, &msg)
+				return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), writeSessionMsg, &msg)
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().Uint64(FlagExpectedVersion, 0, "fail unless the record's scope is currently at this version (0 to skip the check)")
+	addIdempotencyKeyFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -912,7 +1353,7 @@ responsible-parties   - comma delimited list of party types.  Accepted values: o
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
@@ -950,6 +1391,11 @@ func addSignerFlagCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSigners, "", "comma delimited list of bech32 addresses")
 }
 
+// addIdempotencyKeyFlagCmd adds the idempotency key flag to a write command
+func addIdempotencyKeyFlagCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagIdempotencyKey, "", "a key that makes a retry of the same write within an hour succeed without writing again")
+}
+
 // parseSigners checks signers flag for signers, else uses the from address
 func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error) {
 	flagSet := cmd.Flags()
@@ -1028,7 +1474,7 @@ func RemoveScopeSpecificationCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
@@ -1066,7 +1512,104 @@ func RemoveContractSpecificationCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AddRemoveContractSpecSourceLocatorCmd creates a command for adding or removing an object store locator the source
+// of a contract specification can be downloaded from.
+func AddRemoveContractSpecSourceLocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-spec-source-locator {add|remove} specification-id locator-owner",
+		Short: "Add or remove an object store locator the source of a contract specification can be downloaded from",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			removeOrAdd := strings.ToLower(args[0])
+			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
+				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
+			}
+
+			var specificationID types.MetadataAddress
+			specificationID, err = types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			if removeOrAdd == AddSwitch {
+				msg = types.NewMsgAddContractSpecSourceLocatorRequest(specificationID, args[2], signers)
+			} else {
+				msg = types.NewMsgDeleteContractSpecSourceLocatorRequest(specificationID, args[2], signers)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// DeprecateSpecificationCmd creates a command for deprecating a scope or contract specification.
+func DeprecateSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-specification specification-id [successor-id]",
+		Short: "Deprecate a scope or contract specification, optionally pointing to the specification that replaces it",
+		Long: strings.TrimSpace(`Deprecate a scope or contract specification, optionally pointing to the specification of the same kind
+that replaces it. New sessions are rejected against the specification once the deprecation grace period has passed.
+Deprecating a specification again changes its successor.`),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata deprecate-specification contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn contractspec1qd9kutvudu6553u69dh4c0gwr2pqgr29yw`, version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			specificationID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			successorID := types.MetadataAddress{}
+			if len(args) > 1 {
+				successorID, err = types.MetadataAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeprecateSpecificationRequest(specificationID, successorID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -1107,7 +1650,53 @@ func RemoveContractSpecFromScopeSpecCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteSessionAndRecordsCmd creates a command to add/update a session and its records from a file.
+func WriteSessionAndRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "write-session-and-records request-file",
+		Aliases: []string{"wsar"},
+		Short:   "Add/Update a session and its records on the provenance blockchain",
+		Long: `Add/Update a session and records in that session in a single transaction.
+
+request-file - path to a JSON file containing a session, its records, and optionally the
+               session_id_components and spec_uuid fields of a WriteSessionAndRecords request.
+               Any signers in the file are replaced by the --signers (or --from) addresses.`,
+		Example: fmt.Sprintf(`%[1]s tx metadata write-session-and-records session.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg types.MsgWriteSessionAndRecordsRequest
+			if err = clientCtx.JSONCodec.UnmarshalJSON(contents, &msg); err != nil {
+				return fmt.Errorf("invalid session and records request: %w", err)
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 	addSignerFlagCmd(cmd)
@@ -1140,7 +1729,105 @@ func RemoveRecordCmd() *cobra.Command {
 			}
 			msg := *types.NewMsgDeleteRecordRequest(recordID, signers)
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RedactRecordCmd creates a command to redact the output values of a record
+func RedactRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redact-record record-id reason",
+		Short: "Redact the output values of a metadata record on the provenance blockchain",
+		Long: `Redact the output values of a metadata record on the provenance blockchain.
+The hash of each output is replaced with a redaction marker, keeping a sha256 hash of the original hash.
+All the scope owners and the scope value owner must sign, and a redacted record can no longer be updated.`,
+		Example: fmt.Sprintf(`%[1]s tx metadata redact-record record1qtxap7yn... "data erasure request"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var recordID types.MetadataAddress
+			recordID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedactRecordRequest(recordID, args[1], signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AddRemoveRecordDataAccessCmd creates a command to add or remove data access on a record
+func AddRemoveRecordDataAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-data-access {add|remove} record-id data-access",
+		Short: "Add or remove a metadata record data access on to the provenance blockchain",
+		Long: `Add or remove a metadata record data access on to the provenance blockchain.
+When a record has data access addresses, access to its data is restricted to those addresses and the scope owners.
+Each address must be an owner or have data access on the scope of the record.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			removeOrAdd := strings.ToLower(args[0])
+			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
+				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
+			}
+
+			var recordID types.MetadataAddress
+			recordID, err = types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			if !recordID.IsRecordAddress() {
+				return fmt.Errorf("meta address is not a record: %s", recordID.String())
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			dataAccess := strings.Split(args[2], ",")
+			var msg sdk.Msg
+			if removeOrAdd == AddSwitch {
+				msg = types.NewMsgAddRecordDataAccessRequest(recordID, dataAccess, signers)
+			} else {
+				msg = types.NewMsgDeleteRecordDataAccessRequest(recordID, dataAccess, signers)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -1177,7 +1864,7 @@ func RemoveRecordSpecificationCmd() *cobra.Command {
 			}
 			msg := *types.NewMsgDeleteRecordSpecificationRequest(specificationID, signers)
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
@@ -1186,3 +1873,111 @@ func RemoveRecordSpecificationCmd() *cobra.Command {
 
 	return cmd
 }
+
+// MetadataProposalCmd creates a command for submitting metadata governance proposals.
+func MetadataProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [type] [proposal-file] [deposit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a metadata proposal along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a metadata proposal along with an initial deposit.
+Proposal title, description, and metadata proposal params must be set in a provided JSON file.
+
+Example:
+$ %[1]s tx metadata proposal TransferScopeOwnership "path/to/proposal.json" 1000%[2]s --from mykey
+
+Where proposal.json contains:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  // additional properties based on type here
+}
+
+
+Valid Proposal Types (and associated parameters):
+
+- TransferScopeOwnership
+	"scope_id": "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel",
+	"owners": [{"address":"pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk", "role":"PARTY_TYPE_OWNER"}], // optional
+	"value_owner_address": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk", // optional
+	"evidence": "why the existing owners cannot make the change themselves"
+
+- SetScopeSpecificationStrict
+	"specification_id": "scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m",
+	"strict": true
+`,
+				version.AppName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var proposal govtypes.Content
+			switch args[0] {
+			case types.ProposalTypeTransferScopeOwnership:
+				p := &types.TransferScopeOwnershipProposal{}
+				if err = clientCtx.Codec.UnmarshalJSON(contents, p); err != nil {
+					return err
+				}
+				proposal = p
+			case types.ProposalTypeSetScopeSpecificationStrict:
+				p := &types.SetScopeSpecificationStrictProposal{}
+				if err = clientCtx.Codec.UnmarshalJSON(contents, p); err != nil {
+					return err
+				}
+				proposal = p
+			default:
+				return fmt.Errorf("unknown proposal type %s", args[0])
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid governance proposal. Error: %s", err)
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseValueOwnerConstraint returns the value owner constraint set with the value owner flags, nil if none are set.
+func parseValueOwnerConstraint(cmd *cobra.Command) (*types.ValueOwnerConstraint, error) {
+	markers, err := cmd.Flags().GetBool(FlagValueOwnerMarkers)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := cmd.Flags().GetStringSlice(FlagValueOwnerAttributes)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := cmd.Flags().GetStringSlice(FlagValueOwnerAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if !markers && len(attributes) == 0 && len(addresses) == 0 {
+		return nil, nil
+	}
+	return &types.ValueOwnerConstraint{
+		AllowMarkers:      markers,
+		AllowedAttributes: attributes,
+		AllowedAddresses:  addresses,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for metadata messages.
@@ -38,9 +39,27 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgWriteRecordRequest:
 			res, err := msgServer.WriteRecord(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWriteSessionAndRecordsRequest:
+			res, err := msgServer.WriteSessionAndRecords(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgDeleteRecordRequest:
 			res, err := msgServer.DeleteRecord(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddRecordDataAccessRequest:
+			res, err := msgServer.AddRecordDataAccess(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteRecordDataAccessRequest:
+			res, err := msgServer.DeleteRecordDataAccess(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRedactRecordRequest:
+			res, err := msgServer.RedactRecord(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgArchiveScopeRequest:
+			res, err := msgServer.ArchiveScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRestoreScopeRequest:
+			res, err := msgServer.RestoreScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgWriteSessionRequest:
 			res, err := msgServer.WriteSession(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -69,6 +88,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteRecordSpecificationRequest:
 			res, err := msgServer.DeleteRecordSpecification(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWriteSpecificationBundleRequest:
+			res, err := msgServer.WriteSpecificationBundle(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgWriteP8EContractSpecRequest:
 			res, err := msgServer.WriteP8EContractSpec(sdk.WrapSDKContext(ctx), msg)
@@ -87,8 +109,37 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.ModifyOSLocator(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgReportOSLocatorStatusRequest:
+			res, err := msgServer.ReportOSLocatorStatus(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddContractSpecSourceLocatorRequest:
+			res, err := msgServer.AddContractSpecSourceLocator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteContractSpecSourceLocatorRequest:
+			res, err := msgServer.DeleteContractSpecSourceLocator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeprecateSpecificationRequest:
+			res, err := msgServer.DeprecateSpecification(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler returns a handler for metadata governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.TransferScopeOwnershipProposal:
+			return keeper.HandleTransferScopeOwnershipProposal(ctx, k, c)
+		case *types.SetScopeSpecificationStrictProposal:
+			return keeper.HandleSetScopeSpecificationStrictProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized metadata proposal content type: %T", c)
+		}
+	}
+}
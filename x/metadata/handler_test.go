@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -18,7 +20,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/metadata/types/p8e"
 )
@@ -175,6 +179,381 @@ func (s MetadataHandlerTestSuite) TestWriteSession() {
 	}
 }
 
+func (s MetadataHandlerTestSuite) TestWriteSessionAndRecords() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	for _, name := range []string{"recorda", "recordb"} {
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+			types.RecordSpecMetadataAddress(cSpecUUID, name),
+			name,
+			[]*types.InputSpecification{},
+			"recordtype",
+			types.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		))
+	}
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+
+	scopeUUID := uuid.New()
+	scope := types.Scope{
+		ScopeId:         types.ScopeMetadataAddress(scopeUUID),
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+
+	newRecord := func(name string) types.Record {
+		process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+		return *types.NewRecord(name, nil, *process, []types.RecordInput{},
+			[]types.RecordOutput{{Hash: "output", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	}
+	sessionUUID := uuid.New()
+	session := types.Session{
+		Parties: scope.Owners,
+		Name:    "someclass",
+	}
+	components := &types.SessionIdComponents{
+		ScopeIdentifier: &types.SessionIdComponents_ScopeUuid{ScopeUuid: scopeUUID.String()},
+		SessionUuid:     sessionUUID.String(),
+	}
+
+	cases := []struct {
+		name     string
+		records  []types.Record
+		signers  []string
+		errorMsg string
+	}{
+		{
+			"should fail when a signer is not a scope owner",
+			[]types.Record{newRecord("recorda"), newRecord("recordb")},
+			[]string{s.user2},
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should fail when a record has no specification",
+			[]types.Record{newRecord("recorda"), newRecord("recordc")},
+			[]string{s.user1},
+			fmt.Sprintf("record specification not found for record specification id %s (contract spec uuid %s and record name recordc)",
+				types.RecordSpecMetadataAddress(cSpecUUID, "recordc"), cSpecUUID),
+		},
+		{
+			"should successfully write a session and its records",
+			[]types.Record{newRecord("recorda"), newRecord("recordb")},
+			[]string{s.user1},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWriteSessionAndRecordsRequest(session, tc.records, tc.signers)
+			msg.SessionIdComponents = components
+			msg.SpecUuid = cSpecUUID.String()
+			require.NoError(t, msg.ValidateBasic())
+			res, err := s.handler(s.ctx, msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			}
+		})
+	}
+
+	sessionID := types.SessionMetadataAddress(scopeUUID, sessionUUID)
+	_, found := s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+	assert.True(s.T(), found, "session should have been written")
+	for _, name := range []string{"recorda", "recordb"} {
+		record, found := s.app.MetadataKeeper.GetRecord(s.ctx, types.RecordMetadataAddress(scopeUUID, name))
+		assert.True(s.T(), found, "record %s should have been written", name)
+		assert.Equal(s.T(), sessionID, record.SessionId, "record %s session id", name)
+	}
+
+	s.T().Run("each record written increments the scope version", func(t *testing.T) {
+		before, _ := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		msg := types.NewMsgWriteSessionAndRecordsRequest(session, []types.Record{newRecord("recorda"), newRecord("recordb")}, []string{s.user1})
+		msg.SessionIdComponents = components
+		msg.SpecUuid = cSpecUUID.String()
+		_, err := s.handler(s.ctx, msg)
+		require.NoError(t, err)
+		after, _ := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		assert.Equal(t, before.Version+2, after.Version, "scope version")
+	})
+
+	s.T().Run("records must belong to the session", func(t *testing.T) {
+		record := newRecord("recorda")
+		record.SessionId = types.SessionMetadataAddress(scopeUUID, uuid.New())
+		msg := types.NewMsgWriteSessionAndRecordsRequest(session, []types.Record{record}, []string{s.user1})
+		msg.SessionIdComponents = components
+		assert.EqualError(t, msg.ValidateBasic(), fmt.Sprintf(
+			"msg.Records[0].SessionId [%s] is different from msg.Session.SessionId [%s]", record.SessionId, sessionID))
+	})
+
+	s.T().Run("record names must be unique", func(t *testing.T) {
+		msg := types.NewMsgWriteSessionAndRecordsRequest(session, []types.Record{newRecord("recorda"), newRecord("recorda")}, []string{s.user1})
+		msg.SessionIdComponents = components
+		msg.SpecUuid = cSpecUUID.String()
+		assert.EqualError(t, msg.ValidateBasic(), "duplicate record name [recorda]")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestScopeVersion() {
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.Scope{
+		ScopeId:         scopeID,
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+
+	cases := []struct {
+		name            string
+		msg             sdk.Msg
+		errorMsg        string
+		expectedVersion uint64
+	}{
+		{
+			"new scope starts at version 1",
+			types.NewMsgWriteScopeRequest(scope, []string{s.user1}),
+			"",
+			1,
+		},
+		{
+			"write with the wrong expected version",
+			&types.MsgWriteScopeRequest{Scope: scope, Signers: []string{s.user1}, ExpectedVersion: 3},
+			fmt.Sprintf("scope %s is at version 1, expected version 3: scope version conflict", scopeID),
+			1,
+		},
+		{
+			"write with the current expected version",
+			&types.MsgWriteScopeRequest{Scope: scope, Signers: []string{s.user1}, ExpectedVersion: 1},
+			"",
+			2,
+		},
+		{
+			"write without an expected version",
+			types.NewMsgWriteScopeRequest(scope, []string{s.user1}),
+			"",
+			3,
+		},
+		{
+			"adding data access increments the version",
+			types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}),
+			"",
+			4,
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			stored, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+			require.True(t, found, "scope should exist")
+			assert.Equal(t, tc.expectedVersion, stored.Version, "scope version")
+		})
+	}
+}
+
+func (s MetadataHandlerTestSuite) TestIdempotencyKey() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		types.RecordSpecMetadataAddress(cSpecUUID, "recorda"),
+		"recorda",
+		[]*types.InputSpecification{},
+		"recordtype",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.Scope{
+		ScopeId:         scopeID,
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties:         scope.Owners,
+		Name:            "someclass",
+	}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := *types.NewRecord("recorda", session.SessionId, *process, []types.RecordInput{},
+		[]types.RecordOutput{{Hash: "output", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		types.RecordSpecMetadataAddress(cSpecUUID, "recorda"))
+
+	writeScope := func(key string, scope types.Scope) *types.MsgWriteScopeRequest {
+		msg := types.NewMsgWriteScopeRequest(scope, []string{s.user1})
+		msg.IdempotencyKey = key
+		return msg
+	}
+	writeRecord := func(key string) *types.MsgWriteRecordRequest {
+		msg := types.NewMsgWriteRecordRequest(record, nil, "", []string{s.user1}, scope.Owners)
+		msg.IdempotencyKey = key
+		return msg
+	}
+	changedScope := scope
+	changedScope.DataAccess = []string{s.user2}
+	expired := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(keeper.IdempotencyKeyLifetime))
+
+	cases := []struct {
+		name            string
+		ctx             sdk.Context
+		msg             sdk.Msg
+		errorMsg        string
+		expectedVersion uint64
+	}{
+		{"first write with a key", s.ctx, writeScope("key1", scope), "", 1},
+		{"replay of the write is a no-op", s.ctx, writeScope("key1", scope), "", 1},
+		{"key used for a different write", s.ctx, writeScope("key1", changedScope), "idempotency key already used for a different request", 1},
+		{"write with another key", s.ctx, writeScope("key2", scope), "", 2},
+		{"write without a key", s.ctx, writeScope("", scope), "", 3},
+		{"first record write with a key", s.ctx, writeRecord("key1"), "", 4},
+		{"replay of the record write is a no-op", s.ctx, writeRecord("key1"), "", 4},
+		{"replay after the key expired writes again", expired, writeScope("key1", scope), "", 5},
+	}
+
+	s.app.MetadataKeeper.SetSession(s.ctx, session)
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			metadata.BeginBlocker(tc.ctx, abci.RequestBeginBlock{}, s.app.MetadataKeeper)
+			_, err := s.handler(tc.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			stored, found := s.app.MetadataKeeper.GetScope(tc.ctx, scopeID)
+			require.True(t, found, "scope should exist")
+			assert.Equal(t, tc.expectedVersion, stored.Version, "scope version")
+		})
+	}
+}
+
+func (s MetadataHandlerTestSuite) TestStorageFee() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		types.RecordSpecMetadataAddress(cSpecUUID, "recorda"),
+		"recorda",
+		[]*types.InputSpecification{},
+		"recordtype",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.Scope{
+		ScopeId:         scopeID,
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties:         scope.Owners,
+		Name:            "someclass",
+	}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := *types.NewRecord("recorda", session.SessionId, *process, []types.RecordInput{},
+		[]types.RecordOutput{{Hash: "output", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		types.RecordSpecMetadataAddress(cSpecUUID, "recorda"))
+	recordID := record.GetRecordAddress()
+
+	_, err := s.handler(s.ctx, types.NewMsgWriteScopeRequest(scope, []string{s.user1}))
+	s.Require().NoError(err)
+	s.Assert().Zero(s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "a scope without sessions or records")
+
+	// Without a fee, the size is tracked but nothing is charged.
+	_, err = s.handler(s.ctx, types.NewMsgWriteSessionRequest(session, []string{s.user1}))
+	s.Require().NoError(err)
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	sessionSize := uint64(len(session.SessionId) + len(store.Get(session.SessionId)))
+	s.Assert().Equal(sessionSize, s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "size after writing the session")
+
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("nhash", 2)), 0))
+	writeRecord := types.NewMsgWriteRecordRequest(record, nil, "", []string{s.user1}, scope.Owners)
+	// A failed tx is not committed, so the write is attempted in a throw-away context.
+	failedCtx, _ := s.ctx.CacheContext()
+	_, err = s.handler(failedCtx, writeRecord)
+	s.Require().Error(err, "writing a record without the funds for the fee")
+	s.Assert().ErrorIs(err, types.ErrStorageFee)
+
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10000))))
+	poolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf("nhash")
+	_, err = s.handler(s.ctx, writeRecord)
+	s.Require().NoError(err)
+	recordSize := uint64(len(recordID) + len(store.Get(recordID)))
+	s.Assert().Equal(sessionSize+recordSize, s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "size after writing the record")
+	s.Assert().Equal(int64(10000-2*recordSize), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "nhash").Amount.Int64(), "balance of the signer")
+	poolAfter := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf("nhash")
+	s.Assert().Equal(sdk.NewDec(int64(2*recordSize)), poolAfter.Sub(poolBefore), "community pool growth")
+
+	// Rewriting the record without growing the scope is free.
+	_, err = s.handler(s.ctx, writeRecord)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(10000-2*recordSize), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "nhash").Amount.Int64(), "balance after rewrite")
+
+	s.Require().NoError(s.app.MetadataKeeper.RebuildScopeSizes(s.ctx))
+	s.Assert().Equal(sessionSize+recordSize, s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "size after rebuilding")
+
+	_, err = s.handler(s.ctx, types.NewMsgDeleteRecordRequest(recordID, []string{s.user1}))
+	s.Require().NoError(err)
+	s.Assert().Zero(s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "size after deleting the record and its session")
+}
+
 func (s MetadataHandlerTestSuite) TestAddContractSpecToScopeSpec() {
 	cSpec := types.ContractSpecification{
 		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
@@ -346,6 +725,266 @@ func (s MetadataHandlerTestSuite) TestDeleteContractSpecFromScopeSpec() {
 	}
 }
 
+func (s MetadataHandlerTestSuite) TestAddAndDeleteContractSpecSourceLocator() {
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	resourceSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceResourceID(types.ScopeMetadataAddress(uuid.New())),
+		ClassName:       "someclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, resourceSpec)
+	s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, s.user2Addr, sdk.AccAddress{}, "https://example.com/sources"))
+
+	unknownContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"fail to add locator, cannot find contract spec",
+			types.NewMsgAddContractSpecSourceLocatorRequest(unknownContractSpecID, s.user2, []string{s.user1}),
+			fmt.Sprintf("contract specification not found with id %s", unknownContractSpecID),
+		},
+		{
+			"fail to add locator, not signed by the contract spec owner",
+			types.NewMsgAddContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user2}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1),
+		},
+		{
+			"fail to add locator, contract spec source is not a hash",
+			types.NewMsgAddContractSpecSourceLocatorRequest(resourceSpec.SpecificationId, s.user2, []string{s.user1}),
+			fmt.Sprintf("contract specification with id %s does not have a source hash: invalid request", resourceSpec.SpecificationId),
+		},
+		{
+			"fail to add locator, locator owner has no locator",
+			types.NewMsgAddContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user1, []string{s.user1}),
+			"no locator bound to address: invalid request",
+		},
+		{
+			"should succeed to add locator",
+			types.NewMsgAddContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user1}),
+			"",
+		},
+		{
+			"fail to add locator, already added",
+			types.NewMsgAddContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user1}),
+			fmt.Sprintf("locator of %s is already a source locator of contract specification %s: invalid request", s.user2, cSpec.SpecificationId),
+		},
+		{
+			"should succeed to delete locator",
+			types.NewMsgDeleteContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user1}),
+			"",
+		},
+		{
+			"fail to delete locator, already deleted",
+			types.NewMsgDeleteContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user1}),
+			fmt.Sprintf("locator of %s is not a source locator of contract specification %s: invalid request", s.user2, cSpec.SpecificationId),
+		},
+		{
+			"should succeed to add locator again",
+			types.NewMsgAddContractSpecSourceLocatorRequest(cSpec.SpecificationId, s.user2, []string{s.user1}),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	res, err := s.app.MetadataKeeper.OSLocatorsByContractSpec(sdk.WrapSDKContext(s.ctx),
+		&types.OSLocatorsByContractSpecRequest{SpecificationId: cSpec.SpecificationId.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal("somesource", res.SourceHash)
+	s.Require().Len(res.Locators, 1)
+	s.Assert().Equal("https://example.com/sources", res.Locators[0].LocatorUri)
+
+	res, err = s.app.MetadataKeeper.OSLocatorsByContractSpec(sdk.WrapSDKContext(s.ctx),
+		&types.OSLocatorsByContractSpecRequest{SpecificationId: cSpec.SpecificationId.String(), HealthyOnly: true})
+	s.Require().NoError(err)
+	s.Assert().Empty(res.Locators, "locators never reported healthy")
+
+	_, err = s.app.MetadataKeeper.OSLocatorsByContractSpec(sdk.WrapSDKContext(s.ctx),
+		&types.OSLocatorsByContractSpecRequest{SpecificationId: resourceSpec.SpecificationId.String()})
+	s.Assert().Error(err, "contract spec without a source hash")
+
+	exported := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal([]types.ContractSpecSourceLocator{{SpecificationId: cSpec.SpecificationId, LocatorOwner: s.user2}}, exported.ContractSpecSourceLocators)
+
+	s.Require().NoError(s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, cSpec.SpecificationId))
+	s.Assert().Empty(s.app.MetadataKeeper.GetContractSpecSourceLocatorOwners(s.ctx, cSpec.SpecificationId), "locators of removed contract spec")
+}
+
+func (s MetadataHandlerTestSuite) TestDeprecateSpecification() {
+	newContractSpec := func() types.ContractSpecification {
+		cSpec := types.ContractSpecification{
+			SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			Source:          types.NewContractSpecificationSourceHash("somesource"),
+			ClassName:       "someclass",
+		}
+		s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+		return cSpec
+	}
+	cSpec := newContractSpec()
+	cSpecV2 := newContractSpec()
+	cSpecV3 := newContractSpec()
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId, cSpecV2.SpecificationId, cSpecV3.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	scopeUUID := uuid.New()
+	scope := types.Scope{
+		ScopeId:         types.ScopeMetadataAddress(scopeUUID),
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+	newSession := func(cSpecID types.MetadataAddress) *types.MsgWriteSessionRequest {
+		return types.NewMsgWriteSessionRequest(types.Session{
+			SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+			SpecificationId: cSpecID,
+			Parties:         scope.Owners,
+			Name:            "someclass",
+		}, []string{s.user1})
+	}
+
+	s.Assert().EqualError(types.NewMsgDeprecateSpecificationRequest(cSpec.SpecificationId, sSpec.SpecificationId, []string{s.user1}).ValidateBasic(),
+		fmt.Sprintf("successor %s is not the same kind of specification as %s", sSpec.SpecificationId, cSpec.SpecificationId))
+	s.Assert().EqualError(types.NewMsgDeprecateSpecificationRequest(cSpec.SpecificationId, cSpec.SpecificationId, []string{s.user1}).ValidateBasic(),
+		fmt.Sprintf("specification %s cannot be its own successor", cSpec.SpecificationId))
+
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(nil, time.Hour))
+	deprecatedTime := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(deprecatedTime)
+	unknownContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"fail to deprecate, cannot find contract spec",
+			types.NewMsgDeprecateSpecificationRequest(unknownContractSpecID, types.MetadataAddress{}, []string{s.user1}),
+			fmt.Sprintf("contract specification not found with id %s", unknownContractSpecID),
+		},
+		{
+			"fail to deprecate, not signed by the contract spec owner",
+			types.NewMsgDeprecateSpecificationRequest(cSpec.SpecificationId, cSpecV2.SpecificationId, []string{s.user2}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1),
+		},
+		{
+			"fail to deprecate, cannot find successor",
+			types.NewMsgDeprecateSpecificationRequest(cSpec.SpecificationId, unknownContractSpecID, []string{s.user1}),
+			fmt.Sprintf("successor specification with id %s not found: invalid request", unknownContractSpecID),
+		},
+		{
+			"should succeed to deprecate contract spec",
+			types.NewMsgDeprecateSpecificationRequest(cSpec.SpecificationId, cSpecV2.SpecificationId, []string{s.user1}),
+			"",
+		},
+		{
+			"should succeed to deprecate successor",
+			types.NewMsgDeprecateSpecificationRequest(cSpecV2.SpecificationId, cSpecV3.SpecificationId, []string{s.user1}),
+			"",
+		},
+		{
+			"fail to deprecate, cycle of successors",
+			types.NewMsgDeprecateSpecificationRequest(cSpecV3.SpecificationId, cSpec.SpecificationId, []string{s.user1}),
+			fmt.Sprintf("successor %s would make a cycle of deprecations with %s: invalid request", cSpec.SpecificationId, cSpecV3.SpecificationId),
+		},
+		{
+			"should succeed to write a session during the grace period",
+			newSession(cSpec.SpecificationId),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	deprecation, found := s.app.MetadataKeeper.GetSpecificationDeprecation(s.ctx, cSpec.SpecificationId)
+	s.Require().True(found, "contract spec deprecation")
+	s.Assert().Equal(*types.NewSpecificationDeprecation(cSpec.SpecificationId, cSpecV2.SpecificationId, deprecatedTime, time.Hour), deprecation)
+
+	// After the grace period, new sessions are rejected but the existing ones can still be updated.
+	s.ctx = s.ctx.WithBlockTime(deprecatedTime.Add(2 * time.Hour))
+	existing := newSession(cSpecV2.SpecificationId)
+	existing.Session.SpecificationId = cSpec.SpecificationId
+	s.app.MetadataKeeper.SetSession(s.ctx, existing.Session)
+	_, err := s.handler(s.ctx, newSession(cSpec.SpecificationId))
+	s.Assert().EqualError(err, fmt.Sprintf("specification %s is deprecated, use %s instead", cSpec.SpecificationId, cSpecV3.SpecificationId))
+	_, err = s.handler(s.ctx, existing)
+	s.Assert().NoError(err, "updating a session against a deprecated spec")
+	_, err = s.handler(s.ctx, newSession(cSpecV3.SpecificationId))
+	s.Assert().NoError(err, "writing a session against the latest successor")
+
+	res, err := s.app.MetadataKeeper.ContractSpecification(sdk.WrapSDKContext(s.ctx),
+		&types.ContractSpecificationRequest{SpecificationId: cSpec.SpecificationId.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal(&deprecation, res.ContractSpecification.Deprecation)
+	s.Assert().Equal(cSpecV3.SpecificationId.String(), res.ContractSpecification.LatestSuccessorAddr)
+	res, err = s.app.MetadataKeeper.ContractSpecification(sdk.WrapSDKContext(s.ctx),
+		&types.ContractSpecificationRequest{SpecificationId: cSpecV3.SpecificationId.String()})
+	s.Require().NoError(err)
+	s.Assert().Nil(res.ContractSpecification.Deprecation, "successor that is not deprecated")
+	s.Assert().Empty(res.ContractSpecification.LatestSuccessorAddr)
+
+	// Deprecating the scope spec without a successor rejects new sessions against any of its contract specs.
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(nil, 0))
+	_, err = s.handler(s.ctx, types.NewMsgDeprecateSpecificationRequest(sSpec.SpecificationId, types.MetadataAddress{}, []string{s.user1}))
+	s.Require().NoError(err)
+	_, err = s.handler(s.ctx, newSession(cSpecV3.SpecificationId))
+	s.Assert().EqualError(err, fmt.Sprintf("specification %s is deprecated", sSpec.SpecificationId))
+	sRes, err := s.app.MetadataKeeper.ScopeSpecification(sdk.WrapSDKContext(s.ctx),
+		&types.ScopeSpecificationRequest{SpecificationId: sSpec.SpecificationId.String()})
+	s.Require().NoError(err)
+	s.Require().NotNil(sRes.ScopeSpecification.Deprecation)
+	s.Assert().Empty(sRes.ScopeSpecification.LatestSuccessorAddr)
+
+	exported := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Len(exported.SpecificationDeprecations, 3)
+	s.Require().NoError(exported.Validate())
+
+	sSpec.ContractSpecIds = []types.MetadataAddress{cSpec.SpecificationId, cSpecV2.SpecificationId}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	s.Require().NoError(s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, cSpecV3.SpecificationId))
+	_, found = s.app.MetadataKeeper.GetSpecificationDeprecation(s.ctx, cSpecV3.SpecificationId)
+	s.Assert().False(found, "deprecation of removed contract spec")
+	s.Assert().Equal(cSpecV2.SpecificationId, s.app.MetadataKeeper.GetLatestSuccessor(s.ctx, cSpec.SpecificationId),
+		"latest successor that still exists")
+}
+
 // TODO: AddRecord tests
 // TODO: DeleteRecord tests
 // TODO: AddScopeSpecification tests
@@ -403,6 +1042,94 @@ func (s MetadataHandlerTestSuite) TestAddP8EContractSpec() {
 	}
 }
 
+func (s MetadataHandlerTestSuite) TestWriteSpecificationBundle() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	rSpec := types.RecordSpecification{
+		SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, "recspec"),
+		Name:               "recspec",
+		Inputs:             []*types.InputSpecification{},
+		TypeName:           "recspectype",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+
+	cases := []struct {
+		name     string
+		bundle   *types.SpecificationBundle
+		signers  []string
+		errorMsg string
+	}{
+		{
+			"should successfully write a bundle",
+			types.NewSpecificationBundle(cSpec, []types.RecordSpecification{rSpec}, []types.ScopeSpecification{sSpec}),
+			[]string{s.user1},
+			"",
+		},
+		{
+			"should successfully update a bundle",
+			types.NewSpecificationBundle(cSpec, []types.RecordSpecification{rSpec}, nil),
+			[]string{s.user1},
+			"",
+		},
+		{
+			"should fail to update due to invalid signers",
+			types.NewSpecificationBundle(cSpec, []types.RecordSpecification{rSpec}, nil),
+			[]string{s.user2},
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWriteSpecificationBundleRequest(*tc.bundle, tc.signers)
+			res, err := s.handler(s.ctx, msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			}
+		})
+	}
+
+	s.T().Run("exported bundle matches the written bundle", func(t *testing.T) {
+		res, err := s.app.MetadataKeeper.SpecificationBundle(sdk.WrapSDKContext(s.ctx), &types.SpecificationBundleRequest{
+			SpecificationId:   cSpecUUID.String(),
+			IncludeScopeSpecs: true,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.Bundle)
+		assert.Equal(t, uint32(types.SpecificationBundleVersion), res.Bundle.Version)
+		assert.Equal(t, cSpec.SpecificationId, res.Bundle.ContractSpecification.SpecificationId)
+		require.Len(t, res.Bundle.RecordSpecifications, 1)
+		assert.Equal(t, rSpec.SpecificationId, res.Bundle.RecordSpecifications[0].SpecificationId)
+		require.Len(t, res.Bundle.ScopeSpecifications, 1)
+		assert.Equal(t, sSpec.SpecificationId, res.Bundle.ScopeSpecifications[0].SpecificationId)
+	})
+
+	s.T().Run("exported bundle excludes scope specs by default", func(t *testing.T) {
+		res, err := s.app.MetadataKeeper.SpecificationBundle(sdk.WrapSDKContext(s.ctx), &types.SpecificationBundleRequest{
+			SpecificationId: cSpec.SpecificationId.String(),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.Bundle)
+		assert.Len(t, res.Bundle.ScopeSpecifications, 0)
+	})
+}
+
 // TODO: P8EMemorializeContract tests
 // TODO: BindOSLocatorRequest tests
 // TODO: DeleteOSLocatorRequest tests
@@ -740,6 +1467,349 @@ func (s MetadataHandlerTestSuite) TestAddAndDeleteScopeDataAccess() {
 	})
 }
 
+func (s MetadataHandlerTestSuite) TestAddAndDeleteRecordDataAccess() {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{s.user2}, "")
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	record := types.NewRecord("recordname", sessionID, *types.NewProcess("processname", &types.Process_Hash{Hash: "processhash"}, "method"),
+		[]types.RecordInput{}, []types.RecordOutput{}, types.RecordSpecMetadataAddress(uuid.New(), "recordname"))
+	recordID := record.GetRecordAddress()
+	dneRecordID := types.RecordMetadataAddress(uuid.New(), "recordname")
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to ADD address to record data access, record not found",
+			types.NewMsgAddRecordDataAccessRequest(dneRecordID, []string{s.user2}, []string{s.user1}),
+			fmt.Sprintf("record not found with id %s", dneRecordID),
+		},
+		{
+			"should fail to ADD address to record data access, empty list",
+			types.NewMsgAddRecordDataAccessRequest(recordID, []string{}, []string{s.user1}),
+			"data access list cannot be empty",
+		},
+		{
+			"should fail to ADD address to record data access, no access to scope",
+			types.NewMsgAddRecordDataAccessRequest(recordID, []string{user3}, []string{s.user1}),
+			fmt.Sprintf("address %s must be an owner or have data access on scope %s", user3, scopeID),
+		},
+		{
+			"should fail to ADD address to record data access, missing scope owner signature",
+			types.NewMsgAddRecordDataAccessRequest(recordID, []string{s.user2}, []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should successfully ADD address to record data access",
+			types.NewMsgAddRecordDataAccessRequest(recordID, []string{s.user2}, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to ADD address to record data access, already exists",
+			types.NewMsgAddRecordDataAccessRequest(recordID, []string{s.user2}, []string{s.user1}),
+			fmt.Sprintf("address already exists for data access %s", s.user2),
+		},
+		{
+			"should fail to DELETE address from scope data access while a record grants it",
+			types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}),
+			fmt.Sprintf("address %s still has data access on record %s", s.user2, recordID),
+		},
+		{
+			"should fail to DELETE address from record data access, not in list",
+			types.NewMsgDeleteRecordDataAccessRequest(recordID, []string{user3}, []string{s.user1}),
+			fmt.Sprintf("address does not exist in record data access: %s", user3),
+		},
+		{
+			"should successfully DELETE address from record data access",
+			types.NewMsgDeleteRecordDataAccessRequest(recordID, []string{s.user2}, []string{s.user1}),
+			"",
+		},
+		{
+			"should successfully DELETE address from scope data access once no record grants it",
+			types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	s.T().Run("record data access actually added", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgAddRecordDataAccessRequest(recordID, []string{s.user1}, []string{s.user1}))
+		require.NoError(t, err, "AddRecordDataAccess for a scope owner")
+		updated, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		require.True(t, found, "record found")
+		assert.Equal(t, []string{s.user1}, updated.DataAccess, "record DataAccess")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestRedactRecord() {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{}, s.user2)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	outputs := []types.RecordOutput{
+		{Hash: "outputhash", Status: types.ResultStatus_RESULT_STATUS_PASS},
+		{Status: types.ResultStatus_RESULT_STATUS_SKIP},
+	}
+	record := types.NewRecord("recordname", sessionID, *types.NewProcess("processname", &types.Process_Hash{Hash: "processhash"}, "method"),
+		[]types.RecordInput{}, outputs, types.RecordSpecMetadataAddress(uuid.New(), "recordname"))
+	recordID := record.GetRecordAddress()
+	dneRecordID := types.RecordMetadataAddress(uuid.New(), "recordname")
+
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to redact record, record not found",
+			types.NewMsgRedactRecordRequest(dneRecordID, "erasure request", []string{s.user1, s.user2}),
+			fmt.Sprintf("record not found with id %s", dneRecordID),
+		},
+		{
+			"should fail to redact record, missing scope owner signature",
+			types.NewMsgRedactRecordRequest(recordID, "erasure request", []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should fail to redact record, missing value owner signature",
+			types.NewMsgRedactRecordRequest(recordID, "erasure request", []string{s.user1}),
+			fmt.Sprintf("missing signature from scope value owner %s", s.user2),
+		},
+		{
+			"should successfully redact record",
+			types.NewMsgRedactRecordRequest(recordID, "erasure request", []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to redact record, already redacted",
+			types.NewMsgRedactRecordRequest(recordID, "erasure request", []string{s.user1, s.user2}),
+			fmt.Sprintf("record %s has already been redacted", recordID),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	s.T().Run("record outputs actually redacted", func(t *testing.T) {
+		redacted, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		require.True(t, found, "record found")
+		require.True(t, redacted.IsRedacted(), "record IsRedacted")
+		assert.Equal(t, "erasure request", redacted.Redaction.Reason, "redaction Reason")
+		assert.Equal(t, []string{s.user1, s.user2}, redacted.Redaction.RedactedBy, "redaction RedactedBy")
+		assert.Equal(t, types.RedactedOutputHash, redacted.Outputs[0].Hash, "redacted output Hash")
+		assert.Equal(t, "FxoL0XNl3jpJx0eyvQJ7rVOQ0RlhdCBzipzFRQjht78=", redacted.Outputs[0].RedactedHash, "redacted output RedactedHash")
+		assert.Empty(t, redacted.Outputs[1].Hash, "skipped output Hash")
+		assert.Empty(t, redacted.Outputs[1].RedactedHash, "skipped output RedactedHash")
+		assert.True(t, types.WrapRecord(&redacted).Redacted, "wrapped record Redacted")
+
+		err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, &redacted, record, []string{s.user1}, ownerPartyList(s.user1))
+		assert.EqualError(t, err, fmt.Sprintf("record %s has been redacted and cannot be updated", recordID), "ValidateRecordUpdate")
+	})
+
+	s.T().Run("record of a scope with a value owner coin is redacted by the coin holder", func(t *testing.T) {
+		coinScopeUUID := uuid.New()
+		coinScope := *types.NewScope(types.ScopeMetadataAddress(coinScopeUUID), types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{}, s.user2)
+		require.NoError(t, s.app.MetadataKeeper.IssueValueOwnerCoin(s.ctx, types.Scope{}, &coinScope, nil), "IssueValueOwnerCoin")
+		coinRecord := types.NewRecord("recordname", types.SessionMetadataAddress(coinScopeUUID, uuid.New()),
+			*types.NewProcess("processname", &types.Process_Hash{Hash: "processhash"}, "method"),
+			[]types.RecordInput{}, outputs, types.RecordSpecMetadataAddress(uuid.New(), "recordname"))
+		coinRecordID := coinRecord.GetRecordAddress()
+		s.app.MetadataKeeper.SetScope(s.ctx, coinScope)
+		s.app.MetadataKeeper.SetRecord(s.ctx, *coinRecord)
+
+		_, err := s.handler(s.ctx, types.NewMsgRedactRecordRequest(coinRecordID, "erasure request", []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature for %s with authority to withdraw/remove existing value owner", coinScope.ValueOwnerAddress))
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(coinScope.ValueOwnerCoinDenom(), 1))
+		require.NoError(t, s.app.BankKeeper.SendCoins(s.ctx, s.user2Addr, s.user1Addr, coins), "SendCoins")
+		_, err = s.handler(s.ctx, types.NewMsgRedactRecordRequest(coinRecordID, "erasure request", []string{s.user1}))
+		require.NoError(t, err)
+		redacted, found := s.app.MetadataKeeper.GetRecord(s.ctx, coinRecordID)
+		require.True(t, found, "record found")
+		assert.True(t, redacted.IsRedacted(), "record IsRedacted")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestArchiveAndRestoreScope() {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := types.NewScope(scopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{}, s.user2)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.NewSession("sessionname", sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil)
+	emptySessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	emptySession := types.NewSession("emptysession", emptySessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil)
+	outputs := []types.RecordOutput{{Hash: "outputhash", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	record := types.NewRecord("recordname", sessionID, *types.NewProcess("processname", &types.Process_Hash{Hash: "processhash"}, "method"),
+		[]types.RecordInput{}, outputs, types.RecordSpecMetadataAddress(uuid.New(), "recordname"))
+	recordID := record.GetRecordAddress()
+	locatorURI := "https://archive.example.com/scope"
+
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	s.app.MetadataKeeper.SetSession(s.ctx, *session)
+	s.app.MetadataKeeper.SetSession(s.ctx, *emptySession)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+	sizeBefore := s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID)
+
+	content, err := s.app.MetadataKeeper.GetScopeArchiveContent(s.ctx, scopeID)
+	require.NoError(s.T(), err, "GetScopeArchiveContent")
+	require.Len(s.T(), content.Sessions, 2, "archive content sessions")
+	require.Len(s.T(), content.Records, 1, "archive content records")
+	contentHash := content.Hash()
+
+	tampered := types.NewScopeArchiveContent(scopeID, content.Sessions, []types.Record{*record})
+	tampered.Records[0].Outputs = []types.RecordOutput{{Hash: "otherhash", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to restore scope, not archived",
+			types.NewMsgRestoreScopeRequest(*content, []string{s.user1, s.user2}),
+			fmt.Sprintf("scope %s has not been archived", scopeID),
+		},
+		{
+			"should fail to archive scope, invalid locator uri",
+			types.NewMsgArchiveScopeRequest(scopeID, "not-a-uri", []string{s.user1, s.user2}),
+			fmt.Sprintf("invalid locator uri %q: %s", "not-a-uri", types.ErrOSLocatorURIInvalid),
+		},
+		{
+			"should fail to archive scope, missing value owner signature",
+			types.NewMsgArchiveScopeRequest(scopeID, locatorURI, []string{s.user1}),
+			fmt.Sprintf("missing signature from scope value owner %s", s.user2),
+		},
+		{
+			"should successfully archive scope",
+			types.NewMsgArchiveScopeRequest(scopeID, locatorURI, []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to archive scope, already archived",
+			types.NewMsgArchiveScopeRequest(scopeID, locatorURI, []string{s.user1, s.user2}),
+			fmt.Sprintf("scope %s has already been archived", scopeID),
+		},
+		{
+			"should fail to write session, scope archived",
+			types.NewMsgWriteSessionRequest(*session, []string{s.user1}),
+			fmt.Sprintf("scope %s is archived and must be restored before its sessions can be written", scopeID),
+		},
+		{
+			"should fail to restore scope, content does not match",
+			types.NewMsgRestoreScopeRequest(*tampered, []string{s.user1, s.user2}),
+			fmt.Sprintf("content hash %s does not match archived content hash %s of scope %s", tampered.Hash(), contentHash, scopeID),
+		},
+		{
+			"should fail to restore scope, missing scope owner signature",
+			types.NewMsgRestoreScopeRequest(*content, []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	s.T().Run("scope archived", func(t *testing.T) {
+		archive, found := s.app.MetadataKeeper.GetScopeArchive(s.ctx, scopeID)
+		require.True(t, found, "archive found")
+		assert.Equal(t, contentHash, archive.ContentHash, "archive ContentHash")
+		assert.Equal(t, locatorURI, archive.LocatorUri, "archive LocatorUri")
+		assert.Equal(t, uint32(2), archive.SessionCount, "archive SessionCount")
+		assert.Equal(t, uint32(1), archive.RecordCount, "archive RecordCount")
+		assert.Equal(t, []string{s.user1, s.user2}, archive.ArchivedBy, "archive ArchivedBy")
+		_, found = s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+		assert.False(t, found, "session found")
+		_, found = s.app.MetadataKeeper.GetSession(s.ctx, emptySessionID)
+		assert.False(t, found, "session without records found")
+		_, found = s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		assert.False(t, found, "record found")
+		assert.Equal(t, uint64(0), s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "scope size")
+	})
+
+	s.T().Run("scope restored", func(t *testing.T) {
+		unsorted := types.ScopeArchiveContent{
+			ScopeId:  scopeID,
+			Sessions: []types.Session{content.Sessions[1], content.Sessions[0]},
+			Records:  content.Records,
+		}
+		_, err := s.handler(s.ctx, types.NewMsgRestoreScopeRequest(unsorted, []string{s.user1, s.user2}))
+		require.NoError(t, err, "RestoreScope")
+		assert.False(t, s.app.MetadataKeeper.IsScopeArchived(s.ctx, scopeID), "IsScopeArchived")
+		restored, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		require.True(t, found, "record found")
+		assert.Equal(t, content.Records[0], restored, "restored record")
+		_, found = s.app.MetadataKeeper.GetSession(s.ctx, emptySessionID)
+		assert.True(t, found, "session without records found")
+		assert.Equal(t, sizeBefore, s.app.MetadataKeeper.GetScopeSize(s.ctx, scopeID), "scope size")
+	})
+
+	s.T().Run("scope with a value owner coin is archived and restored by the coin holder", func(t *testing.T) {
+		coinScopeUUID := uuid.New()
+		coinScopeID := types.ScopeMetadataAddress(coinScopeUUID)
+		coinScope := *types.NewScope(coinScopeID, types.ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), []string{}, s.user2)
+		require.NoError(t, s.app.MetadataKeeper.IssueValueOwnerCoin(s.ctx, types.Scope{}, &coinScope, nil), "IssueValueOwnerCoin")
+		coinRecord := types.NewRecord("recordname", types.SessionMetadataAddress(coinScopeUUID, uuid.New()),
+			*types.NewProcess("processname", &types.Process_Hash{Hash: "processhash"}, "method"),
+			[]types.RecordInput{}, outputs, types.RecordSpecMetadataAddress(uuid.New(), "recordname"))
+		s.app.MetadataKeeper.SetScope(s.ctx, coinScope)
+		s.app.MetadataKeeper.SetRecord(s.ctx, *coinRecord)
+		coinContent, err := s.app.MetadataKeeper.GetScopeArchiveContent(s.ctx, coinScopeID)
+		require.NoError(t, err, "GetScopeArchiveContent")
+		missingHolder := fmt.Sprintf("missing signature for %s with authority to withdraw/remove existing value owner", coinScope.ValueOwnerAddress)
+
+		_, err = s.handler(s.ctx, types.NewMsgArchiveScopeRequest(coinScopeID, locatorURI, []string{s.user1}))
+		assert.EqualError(t, err, missingHolder, "ArchiveScope without the coin holder")
+		_, err = s.handler(s.ctx, types.NewMsgArchiveScopeRequest(coinScopeID, locatorURI, []string{s.user1, s.user2}))
+		require.NoError(t, err, "ArchiveScope")
+		assert.True(t, s.app.MetadataKeeper.IsScopeArchived(s.ctx, coinScopeID), "IsScopeArchived")
+
+		newHolder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		coins := sdk.NewCoins(sdk.NewInt64Coin(coinScope.ValueOwnerCoinDenom(), 1))
+		require.NoError(t, s.app.BankKeeper.SendCoins(s.ctx, s.user2Addr, newHolder, coins), "SendCoins")
+		_, err = s.handler(s.ctx, types.NewMsgRestoreScopeRequest(*coinContent, []string{s.user1, s.user2}))
+		assert.EqualError(t, err, missingHolder, "RestoreScope by the previous coin holder")
+		_, err = s.handler(s.ctx, types.NewMsgRestoreScopeRequest(*coinContent, []string{s.user1, newHolder.String()}))
+		require.NoError(t, err, "RestoreScope")
+		assert.False(t, s.app.MetadataKeeper.IsScopeArchived(s.ctx, coinScopeID), "IsScopeArchived")
+	})
+}
+
 func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 	ownerAddress := "cosmos1vz99nyd2er8myeugsr4xm5duwhulhp5ae4dvpa"
 	specIDStr := "scopespec1qjkyp28sldx5r9ueaxqc5adrc5wszy6nsh"
@@ -822,3 +1892,182 @@ func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 		assert.NotNil(t, 0, res)
 	})
 }
+
+func (s MetadataHandlerTestSuite) TestWriteScopeValueOwnerAsCoin() {
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+
+	newScope := func(valueOwner string) types.Scope {
+		return *types.NewScope(types.ScopeMetadataAddress(uuid.New()), sSpec.SpecificationId, ownerPartyList(s.user1), nil, valueOwner)
+	}
+	writeScope := func(scope types.Scope, asCoin bool, signers ...string) error {
+		msg := types.NewMsgWriteScopeRequest(scope, signers)
+		msg.ValueOwnerAsCoin = asCoin
+		_, err := s.handler(s.ctx, msg)
+		return err
+	}
+
+	scope := newScope(s.user1)
+	denom := scope.ValueOwnerCoinDenom()
+
+	s.T().Run("new scope with value owner as coin", func(t *testing.T) {
+		require.NoError(t, writeScope(scope, true, s.user1))
+
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, sdk.NewInt64Coin(denom, 1), marker.GetSupply(), "marker supply")
+		assert.True(t, marker.HasFixedSupply(), "marker fixed supply")
+		assert.Empty(t, marker.GetAccessList(), "marker access list")
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, denom).Amount.Int64(), "value owner balance")
+
+		stored, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		require.True(t, found, "scope found")
+		assert.Equal(t, marker.GetAddress().String(), stored.ValueOwnerAddress, "scope value owner")
+		assert.True(t, s.app.MetadataKeeper.IsValueOwnerCoinScope(stored), "IsValueOwnerCoinScope")
+	})
+
+	s.T().Run("value owner cannot be changed while represented by a coin", func(t *testing.T) {
+		updated := scope
+		updated.ValueOwnerAddress = s.user2
+		err := writeScope(updated, false, s.user1, s.user2)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can only be changed by transferring that coin")
+	})
+
+	s.T().Run("coin can be sent to transfer value ownership", func(t *testing.T) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1))
+		require.NoError(t, s.app.BankKeeper.SendCoins(s.ctx, s.user1Addr, s.user2Addr, coins))
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, denom).Amount.Int64(), "new value owner balance")
+	})
+
+	s.T().Run("only the coin holder has value owner authority", func(t *testing.T) {
+		stored, _ := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		assert.True(t, s.app.MetadataKeeper.HasSignerWithMarkerValueAuthority(s.ctx, stored.ValueOwnerAddress,
+			[]string{s.user2}, markertypes.Access_Withdraw), "new holder authority")
+		assert.False(t, s.app.MetadataKeeper.HasSignerWithMarkerValueAuthority(s.ctx, stored.ValueOwnerAddress,
+			[]string{s.user1}, markertypes.Access_Withdraw), "old holder authority")
+
+		_, err := s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("missing signature from the holder of the %s value owner coin", denom))
+
+		_, err = s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scope.ScopeId, []string{s.user1, s.user2}))
+		require.NoError(t, err)
+		_, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		assert.False(t, found, "scope found after delete")
+		_, err = s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		assert.Error(t, err, "GetMarkerByDenom after delete")
+		assert.True(t, s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, denom).IsZero(), "holder balance after delete")
+		assert.True(t, s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply after delete")
+	})
+
+	s.T().Run("deleted scope can be re-created with value owner as coin", func(t *testing.T) {
+		require.NoError(t, writeScope(scope, true, s.user1))
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, denom).Amount.Int64(), "value owner balance")
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64(), "supply")
+	})
+
+	s.T().Run("value owner cannot be set to the coin marker directly", func(t *testing.T) {
+		direct := newScope("")
+		markerAddr, err := markertypes.MarkerAddress(direct.ValueOwnerCoinDenom())
+		require.NoError(t, err, "MarkerAddress")
+		direct.ValueOwnerAddress = markerAddr.String()
+		err = writeScope(direct, false, s.user1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be set to its value owner coin marker directly")
+	})
+
+	s.T().Run("existing scope conversion requires the value owner signature", func(t *testing.T) {
+		existing := newScope(s.user2)
+		require.NoError(t, writeScope(existing, false, s.user1))
+
+		err := writeScope(existing, true, s.user1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("missing signature from existing owner %s", s.user2))
+
+		require.NoError(t, writeScope(existing, true, s.user1, s.user2))
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, existing.ValueOwnerCoinDenom()).Amount.Int64(), "value owner balance")
+	})
+
+	s.T().Run("value owner as coin requires a value owner", func(t *testing.T) {
+		err := writeScope(newScope(""), true, s.user1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "a value owner address is required to represent value ownership as a coin")
+	})
+
+	s.T().Run("value owner as coin not allowed with a value owner constraint", func(t *testing.T) {
+		constrained := sSpec
+		constrained.SpecificationId = types.ScopeSpecMetadataAddress(uuid.New())
+		constrained.ValueOwnerConstraint = &types.ValueOwnerConstraint{AllowedAddresses: []string{s.user1}}
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, constrained)
+		expected := func(scopeID types.MetadataAddress) string {
+			return fmt.Sprintf("value ownership of scope %s cannot be represented by a coin: scope specification %s has a value owner constraint",
+				scopeID, constrained.SpecificationId)
+		}
+
+		constrainedScope := newScope(s.user1)
+		constrainedScope.SpecificationId = constrained.SpecificationId
+		err := writeScope(constrainedScope, true, s.user1)
+		assert.EqualError(t, err, expected(constrainedScope.ScopeId), "new scope")
+		_, err = s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, constrainedScope.ValueOwnerCoinDenom())
+		assert.Error(t, err, "GetMarkerByDenom")
+
+		moved, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+		require.True(t, found, "coin scope found")
+		moved.SpecificationId = constrained.SpecificationId
+		err = writeScope(moved, false, s.user1)
+		assert.EqualError(t, err, expected(moved.ScopeId), "coin scope moved to the specification")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestOSLocatorAuthorization() {
+	bindURL := sdk.MsgTypeURL(&types.MsgBindOSLocatorRequest{})
+	modifyURL := sdk.MsgTypeURL(&types.MsgModifyOSLocatorRequest{})
+	expiration := s.ctx.BlockTime().AddDate(1, 0, 0)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, s.user2Addr, s.user1Addr,
+		types.NewOSLocatorAuthorization(bindURL, []string{"https://one.example.com"}, 1), expiration))
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, s.user2Addr, s.user1Addr,
+		types.NewOSLocatorAuthorization(modifyURL, nil, 2), expiration))
+
+	bind := func(uri string) sdk.Msg {
+		return types.NewMsgBindOSLocatorRequest(types.ObjectStoreLocator{Owner: s.user1, LocatorUri: uri})
+	}
+	modify := func(uri string) sdk.Msg {
+		return types.NewMsgModifyOSLocatorRequest(types.ObjectStoreLocator{Owner: s.user1, LocatorUri: uri})
+	}
+
+	s.T().Run("uri not allowed", func(t *testing.T) {
+		_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, s.user2Addr, []sdk.Msg{bind("https://other.example.com")})
+		assert.EqualError(t, err, "locator uri https://other.example.com is not allowed: unauthorized")
+	})
+
+	s.T().Run("bind allowed uri", func(t *testing.T) {
+		_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, s.user2Addr, []sdk.Msg{bind("https://one.example.com")})
+		require.NoError(t, err)
+		locator, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		require.True(t, found, "locator bound")
+		assert.Equal(t, "https://one.example.com", locator.LocatorUri)
+		auth, _ := s.app.AuthzKeeper.GetCleanAuthorization(s.ctx, s.user2Addr, s.user1Addr, bindURL)
+		assert.Nil(t, auth, "bind authorization used up")
+	})
+
+	s.T().Run("modify any uri", func(t *testing.T) {
+		_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, s.user2Addr, []sdk.Msg{modify("https://two.example.com")})
+		require.NoError(t, err)
+		locator, _ := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		assert.Equal(t, "https://two.example.com", locator.LocatorUri)
+		auth, _ := s.app.AuthzKeeper.GetCleanAuthorization(s.ctx, s.user2Addr, s.user1Addr, modifyURL)
+		assert.Equal(t, types.NewOSLocatorAuthorization(modifyURL, nil, 1), auth, "modify authorization uses left")
+	})
+
+	s.T().Run("no authorization", func(t *testing.T) {
+		_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, s.user1Addr, []sdk.Msg{
+			types.NewMsgModifyOSLocatorRequest(types.ObjectStoreLocator{Owner: s.user2, LocatorUri: "https://two.example.com"}),
+		})
+		assert.EqualError(t, err, "authorization not found: unauthorized")
+	})
+}
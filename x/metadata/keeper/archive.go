@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeArchive returns the archive stub of a scope whose sessions and records have been archived.
+func (k Keeper) GetScopeArchive(ctx sdk.Context, scopeID types.MetadataAddress) (archive types.ScopeArchive, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScopeArchiveKey(scopeID))
+	if len(bz) == 0 {
+		return archive, false
+	}
+	k.cdc.MustUnmarshal(bz, &archive)
+	return archive, true
+}
+
+// IsScopeArchived returns true if the sessions and records of the scope have been archived.
+func (k Keeper) IsScopeArchived(ctx sdk.Context, scopeID types.MetadataAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetScopeArchiveKey(scopeID))
+}
+
+// IterateScopeArchives processes all stored scope archive stubs with the given handler.
+func (k Keeper) IterateScopeArchives(ctx sdk.Context, handler func(types.ScopeArchive) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeArchiveKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var archive types.ScopeArchive
+		if err := k.cdc.Unmarshal(it.Value(), &archive); err != nil {
+			k.Logger(ctx).Error("could not unmarshal scope archive", "key", it.Key(), "error", err)
+		} else if handler(archive) {
+			break
+		}
+	}
+}
+
+// wrapScope wraps a scope for a query response along with its size and archive stub.
+func (k Keeper) wrapScope(ctx sdk.Context, scope *types.Scope) *types.ScopeWrapper {
+	wrapper := types.WrapScope(scope)
+	wrapper.SizeBytes = k.GetScopeSize(ctx, scope.ScopeId)
+	if archive, found := k.GetScopeArchive(ctx, scope.ScopeId); found {
+		wrapper.Archive = &archive
+	}
+	return wrapper
+}
+
+func (k Keeper) setScopeArchive(ctx sdk.Context, archive types.ScopeArchive) {
+	ctx.KVStore(k.storeKey).Set(types.GetScopeArchiveKey(archive.ScopeId), k.cdc.MustMarshal(&archive))
+}
+
+// GetScopeArchiveContent returns the sessions and records of a scope as the content to archive.
+func (k Keeper) GetScopeArchiveContent(ctx sdk.Context, scopeID types.MetadataAddress) (*types.ScopeArchiveContent, error) {
+	var sessions []types.Session
+	err := k.IterateSessions(ctx, scopeID, func(session types.Session) (stop bool) {
+		sessions = append(sessions, session)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	var records []types.Record
+	err = k.IterateRecords(ctx, scopeID, func(record types.Record) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewScopeArchiveContent(scopeID, sessions, records), nil
+}
+
+// ValidateScopeArchive checks that the scope has not already been archived, that the locator uri is valid, and that all
+// the scope owners, and the value owner of the scope (or a signer with withdraw authority when it is a marker, or the
+// holder of the coin representing value ownership), are signers.
+func (k Keeper) ValidateScopeArchive(ctx sdk.Context, scope types.Scope, locatorURI string, signers []string) error {
+	if k.IsScopeArchived(ctx, scope.ScopeId) {
+		return fmt.Errorf("scope %s has already been archived", scope.ScopeId)
+	}
+	if _, err := k.checkValidURI(locatorURI, ctx); err != nil {
+		return fmt.Errorf("invalid locator uri %q: %w", locatorURI, err)
+	}
+	return k.validateScopeOwnersAndValueOwnerAreSigners(ctx, scope, signers)
+}
+
+// ArchiveScope removes the sessions and records of a scope from the store, replacing them with a stub holding the
+// hash of their content and the object store uri they can be retrieved from.
+func (k Keeper) ArchiveScope(
+	ctx sdk.Context,
+	scopeID types.MetadataAddress,
+	locatorURI string,
+	signers []string,
+) (types.ScopeArchive, error) {
+	content, err := k.GetScopeArchiveContent(ctx, scopeID)
+	if err != nil {
+		return types.ScopeArchive{}, err
+	}
+	if len(content.Sessions) == 0 && len(content.Records) == 0 {
+		return types.ScopeArchive{}, fmt.Errorf("scope %s has no sessions or records to archive", scopeID)
+	}
+	archive := types.NewScopeArchive(*content, locatorURI, signers, ctx.BlockHeight(), ctx.BlockTime())
+
+	for _, record := range content.Records {
+		k.RemoveRecord(ctx, record.GetRecordAddress())
+	}
+	// Sessions with records were removed with their last record, this removes any without records.
+	for _, session := range content.Sessions {
+		k.RemoveSession(ctx, session.SessionId)
+	}
+	k.setScopeArchive(ctx, *archive)
+	k.incrementScopeVersion(ctx, scopeID)
+
+	k.EmitEvent(ctx, types.NewEventScopeArchived(scopeID, archive.ContentHash, archive.LocatorUri))
+	return *archive, nil
+}
+
+// ValidateScopeRestore checks that the scope has been archived, that the content matches the archived content hash,
+// and that all the scope owners, and the value owner of the scope (or a signer with withdraw authority when it is a
+// marker, or the holder of the coin representing value ownership), are signers.
+func (k Keeper) ValidateScopeRestore(ctx sdk.Context, scope types.Scope, content types.ScopeArchiveContent, signers []string) error {
+	archive, found := k.GetScopeArchive(ctx, scope.ScopeId)
+	if !found {
+		return fmt.Errorf("scope %s has not been archived", scope.ScopeId)
+	}
+	content.Sort()
+	if hash := content.Hash(); hash != archive.ContentHash {
+		return fmt.Errorf("content hash %s does not match archived content hash %s of scope %s",
+			hash, archive.ContentHash, scope.ScopeId)
+	}
+	return k.validateScopeOwnersAndValueOwnerAreSigners(ctx, scope, signers)
+}
+
+// RestoreScope stores the archived sessions and records of a scope again and removes its archive stub.
+// The content should already be validated against the archive with ValidateScopeRestore.
+func (k Keeper) RestoreScope(ctx sdk.Context, content types.ScopeArchiveContent) {
+	archive, _ := k.GetScopeArchive(ctx, content.ScopeId)
+	for _, session := range content.Sessions {
+		k.SetSession(ctx, session)
+	}
+	for _, record := range content.Records {
+		k.SetRecord(ctx, record)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetScopeArchiveKey(content.ScopeId))
+	k.incrementScopeVersion(ctx, content.ScopeId)
+
+	k.EmitEvent(ctx, types.NewEventScopeRestored(content.ScopeId, archive.ContentHash))
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// CheckRecordConformance checks a record against its record specification the same way a record is checked when it
+// is written, but reports every way in which it does not conform instead of stopping at the first one.
+func (k Keeper) CheckRecordConformance(ctx sdk.Context, record types.Record) types.RecordConformance {
+	result := types.RecordConformance{
+		RecordAddr: record.GetRecordAddress().String(),
+		Name:       record.Name,
+	}
+	addProblem := func(format string, args ...interface{}) {
+		result.Problems = append(result.Problems, fmt.Sprintf(format, args...))
+	}
+
+	// The record specification comes from the contract specification of the session, falling back to the one stored
+	// with the record when the session cannot be found.
+	specID := record.SpecificationId
+	session, sessionFound := k.GetSession(ctx, record.SessionId)
+	if !sessionFound {
+		addProblem("session %s not found", record.SessionId)
+	} else if contractSpecUUID, err := session.SpecificationId.ContractSpecUUID(); err != nil {
+		addProblem("session %s has an invalid specification id %s", session.SessionId, session.SpecificationId)
+	} else {
+		expectedID := types.RecordSpecMetadataAddress(contractSpecUUID, record.Name)
+		if !specID.Empty() && !specID.Equals(expectedID) {
+			addProblem("specification id %s does not match expected specification id %s", specID, expectedID)
+		}
+		specID = expectedID
+	}
+	if specID.Empty() {
+		result.Conforms = len(result.Problems) == 0
+		return result
+	}
+	result.SpecificationId = specID.String()
+
+	recSpec, found := k.GetRecordSpecification(ctx, specID)
+	if !found {
+		addProblem("record specification %s not found", specID)
+		return result
+	}
+
+	// The inputs must match the input specifications by name, type and source.
+	inputMap := make(map[string]types.RecordInput, len(record.Inputs))
+	for _, input := range record.Inputs {
+		if _, found := inputMap[input.Name]; found {
+			addProblem("input name %s provided twice", input.Name)
+		}
+		inputMap[input.Name] = input
+	}
+	inputSpecMap := make(map[string]bool, len(recSpec.Inputs))
+	for _, inputSpec := range recSpec.Inputs {
+		inputSpecMap[inputSpec.Name] = true
+		input, found := inputMap[inputSpec.Name]
+		if !found {
+			addProblem("missing input %s", inputSpec.Name)
+			continue
+		}
+		if input.TypeName != inputSpec.TypeName {
+			addProblem("input %s has TypeName %s but spec calls for %s", input.Name, input.TypeName, inputSpec.TypeName)
+		}
+		switch source := input.Source.(type) {
+		case *types.RecordInput_RecordId:
+			specSource, ok := inputSpec.Source.(*types.InputSpecification_RecordId)
+			switch {
+			case !ok:
+				addProblem("input %s has source type %s but spec calls for %s", input.Name, sourceTypeRecord, sourceTypeHash)
+			case !source.RecordId.Equals(specSource.RecordId):
+				addProblem("input %s has source value %s but spec calls for %s", input.Name, source.RecordId, specSource.RecordId)
+			}
+			if _, found := k.GetRecord(ctx, source.RecordId); !found {
+				addProblem("input %s source record id %s not found", input.Name, source.RecordId)
+			}
+		case *types.RecordInput_Hash:
+			if _, ok := inputSpec.Source.(*types.InputSpecification_Hash); !ok {
+				addProblem("input %s has source type %s but spec calls for %s", input.Name, sourceTypeHash, sourceTypeRecord)
+			}
+		default:
+			addProblem("input %s has an unknown source type", input.Name)
+		}
+	}
+	for _, input := range record.Inputs {
+		if !inputSpecMap[input.Name] {
+			addProblem("extra input %s", input.Name)
+		}
+	}
+
+	// The number of outputs must match the result type.
+	switch recSpec.ResultType {
+	case types.DefinitionType_DEFINITION_TYPE_RECORD:
+		if len(record.Outputs) != 1 {
+			addProblem("invalid output count (expected: 1, got: %d)", len(record.Outputs))
+		}
+	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
+		if len(record.Outputs) == 0 {
+			addProblem("invalid output count (expected > 0, got: 0)")
+		}
+	}
+
+	// The session that wrote the record must include the responsible parties.
+	if sessionFound {
+		if err := k.ValidatePartiesInvolved(session.Parties, recSpec.ResponsibleParties); err != nil {
+			addProblem("%s", err)
+		}
+	}
+
+	// Records in scopes with a strict specification are held to the strict checks too.
+	if scopeID, err := record.SessionId.AsScopeAddress(); err == nil {
+		if scope, found := k.GetScope(ctx, scopeID); found {
+			if scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId); found && scopeSpec.Strict {
+				for _, problem := range strictRecordProblems(record, recSpec) {
+					addProblem("%s", problem)
+				}
+			}
+		}
+	}
+
+	result.Conforms = len(result.Problems) == 0
+	return result
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// DeprecateSpecification marks a scope or contract specification as deprecated, pointing to the specification that
+// replaces it (if any).  New sessions are allowed against the specification until the current grace period has passed.
+// Deprecating a specification again only changes its successor.
+func (k Keeper) DeprecateSpecification(
+	ctx sdk.Context,
+	specID types.MetadataAddress,
+	successorID types.MetadataAddress,
+) (types.SpecificationDeprecation, error) {
+	if !k.specificationExists(ctx, specID) {
+		return types.SpecificationDeprecation{}, fmt.Errorf("specification with id %s not found", specID)
+	}
+	if !successorID.Empty() {
+		if !k.specificationExists(ctx, successorID) {
+			return types.SpecificationDeprecation{}, fmt.Errorf("successor specification with id %s not found", successorID)
+		}
+		for next := successorID; !next.Empty(); {
+			if next.Equals(specID) {
+				return types.SpecificationDeprecation{}, fmt.Errorf("successor %s would make a cycle of deprecations with %s",
+					successorID, specID)
+			}
+			d, found := k.GetSpecificationDeprecation(ctx, next)
+			if !found {
+				break
+			}
+			next = d.SuccessorId
+		}
+	}
+
+	deprecation, found := k.GetSpecificationDeprecation(ctx, specID)
+	if found {
+		deprecation.SuccessorId = successorID
+	} else {
+		deprecation = *types.NewSpecificationDeprecation(specID, successorID, ctx.BlockTime(), k.GetSpecDeprecationGracePeriod(ctx))
+	}
+	if err := deprecation.ValidateBasic(); err != nil {
+		return types.SpecificationDeprecation{}, err
+	}
+	k.setSpecificationDeprecation(ctx, deprecation)
+	k.EmitEvent(ctx, types.NewEventSpecificationDeprecated(specID, successorID))
+	return deprecation, nil
+}
+
+// GetSpecificationDeprecation returns the deprecation of a scope or contract specification.
+func (k Keeper) GetSpecificationDeprecation(ctx sdk.Context, specID types.MetadataAddress) (deprecation types.SpecificationDeprecation, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpecificationDeprecationKey(specID))
+	if len(bz) == 0 {
+		return deprecation, false
+	}
+	k.cdc.MustUnmarshal(bz, &deprecation)
+	return deprecation, true
+}
+
+// GetLatestSuccessor follows the successors of a deprecated specification and returns the last one that exists, the
+// specification clients should move to.  An empty address is returned if the specification is not deprecated or has
+// no successor.
+func (k Keeper) GetLatestSuccessor(ctx sdk.Context, specID types.MetadataAddress) types.MetadataAddress {
+	latest := types.MetadataAddress{}
+	seen := map[string]bool{specID.String(): true}
+	for next := specID; ; {
+		deprecation, found := k.GetSpecificationDeprecation(ctx, next)
+		if !found || deprecation.SuccessorId.Empty() || seen[deprecation.SuccessorId.String()] ||
+			!k.specificationExists(ctx, deprecation.SuccessorId) {
+			return latest
+		}
+		next = deprecation.SuccessorId
+		seen[next.String()] = true
+		latest = next
+	}
+}
+
+// ValidateSpecificationAllowsSessions returns an error if the specification is deprecated and its grace period for new
+// sessions has passed.
+func (k Keeper) ValidateSpecificationAllowsSessions(ctx sdk.Context, specID types.MetadataAddress) error {
+	deprecation, found := k.GetSpecificationDeprecation(ctx, specID)
+	if !found || deprecation.AllowsSessions(ctx.BlockTime()) {
+		return nil
+	}
+	if successor := k.GetLatestSuccessor(ctx, specID); !successor.Empty() {
+		return fmt.Errorf("specification %s is deprecated, use %s instead", specID, successor)
+	}
+	return fmt.Errorf("specification %s is deprecated", specID)
+}
+
+// wrapScopeSpec wraps a scope specification for a query response along with its deprecation and latest successor.
+func (k Keeper) wrapScopeSpec(ctx sdk.Context, spec *types.ScopeSpecification) *types.ScopeSpecificationWrapper {
+	wrapper := types.WrapScopeSpec(spec)
+	wrapper.Deprecation, wrapper.LatestSuccessorAddr = k.getDeprecationInfo(ctx, spec.SpecificationId)
+	return wrapper
+}
+
+// wrapContractSpec wraps a contract specification for a query response along with its deprecation and latest
+// successor.
+func (k Keeper) wrapContractSpec(ctx sdk.Context, spec *types.ContractSpecification) *types.ContractSpecificationWrapper {
+	wrapper := types.WrapContractSpec(spec)
+	wrapper.Deprecation, wrapper.LatestSuccessorAddr = k.getDeprecationInfo(ctx, spec.SpecificationId)
+	return wrapper
+}
+
+// getDeprecationInfo returns the deprecation of a specification and the bech32 address of its latest successor.
+func (k Keeper) getDeprecationInfo(ctx sdk.Context, specID types.MetadataAddress) (*types.SpecificationDeprecation, string) {
+	deprecation, found := k.GetSpecificationDeprecation(ctx, specID)
+	if !found {
+		return nil, ""
+	}
+	latest := k.GetLatestSuccessor(ctx, specID)
+	if latest.Empty() {
+		return &deprecation, ""
+	}
+	return &deprecation, latest.String()
+}
+
+// IterateSpecificationDeprecations processes all specification deprecations with the given handler.
+func (k Keeper) IterateSpecificationDeprecations(ctx sdk.Context, handler func(deprecation types.SpecificationDeprecation) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SpecificationDeprecationKeyPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var deprecation types.SpecificationDeprecation
+		k.cdc.MustUnmarshal(it.Value(), &deprecation)
+		if handler(deprecation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setSpecificationDeprecation(ctx sdk.Context, deprecation types.SpecificationDeprecation) {
+	ctx.KVStore(k.storeKey).Set(types.GetSpecificationDeprecationKey(deprecation.SpecificationId), k.cdc.MustMarshal(&deprecation))
+}
+
+// clearSpecificationDeprecation removes the deprecation of a scope or contract specification.
+func (k Keeper) clearSpecificationDeprecation(ctx sdk.Context, specID types.MetadataAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSpecificationDeprecationKey(specID))
+}
+
+// specificationExists returns true if the scope or contract specification exists.
+func (k Keeper) specificationExists(ctx sdk.Context, specID types.MetadataAddress) bool {
+	if specID.IsScopeSpecificationAddress() {
+		_, found := k.GetScopeSpecification(ctx, specID)
+		return found
+	}
+	if specID.IsContractSpecificationAddress() {
+		_, found := k.GetContractSpecification(ctx, specID)
+		return found
+	}
+	return false
+}
@@ -59,7 +59,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			if err != nil {
 				panic(err)
 			}
+			if s.LastSeen != 0 || s.Status != types.OSLocatorStatus_OS_LOCATOR_STATUS_UNSPECIFIED {
+				if _, err = k.setOSLocatorStatus(ctx, addr, s.Status, s.LastSeen); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+	for _, l := range data.ContractSpecSourceLocators {
+		addr, err := sdk.AccAddressFromBech32(l.LocatorOwner)
+		if err != nil {
+			panic(err)
 		}
+		ctx.KVStore(k.storeKey).Set(types.GetContractSpecSourceLocatorKey(l.SpecificationId, addr), []byte{0x01})
+	}
+	for _, d := range data.SpecificationDeprecations {
+		k.setSpecificationDeprecation(ctx, d)
+	}
+	for _, a := range data.ScopeArchives {
+		k.setScopeArchive(ctx, a)
 	}
 }
 
@@ -74,6 +92,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	contractSpecSourceLocators := make([]types.ContractSpecSourceLocator, 0)
+	specificationDeprecations := make([]types.SpecificationDeprecation, 0)
+	scopeArchives := make([]types.ScopeArchive, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -134,5 +155,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	k.IterateContractSpecSourceLocators(ctx, func(locator types.ContractSpecSourceLocator) bool {
+		contractSpecSourceLocators = append(contractSpecSourceLocators, locator)
+		return false
+	})
+
+	k.IterateSpecificationDeprecations(ctx, func(deprecation types.SpecificationDeprecation) bool {
+		specificationDeprecations = append(specificationDeprecations, deprecation)
+		return false
+	})
+
+	k.IterateScopeArchives(ctx, func(archive types.ScopeArchive) bool {
+		scopeArchives = append(scopeArchives, archive)
+		return false
+	})
+
+	data = types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	data.ContractSpecSourceLocators = contractSpecSourceLocators
+	data.SpecificationDeprecations = specificationDeprecations
+	data.ScopeArchives = scopeArchives
+	return data
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// IdempotencyKeyLifetime is how long the result of a write made with an idempotency key is kept for replays.
+const IdempotencyKeyLifetime = time.Hour
+
+// GetIdempotentResult looks up the result of an earlier write made with the same idempotency key.  The id written by
+// that request is returned if the request content is the same, and an error if the key was used for a different
+// request.
+func (k Keeper) GetIdempotentResult(ctx sdk.Context, storeKey []byte, requestHash []byte) (types.MetadataAddress, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(storeKey)
+	if len(bz) == 0 {
+		return nil, false, nil
+	}
+	if !bytes.Equal(bz[:sha256.Size], requestHash) {
+		return nil, false, types.ErrIdempotencyKeyReused
+	}
+	return types.MetadataAddress(bz[sha256.Size:]), true, nil
+}
+
+// SetIdempotentResult stores the id written by a request made with an idempotency key until the key expires.
+func (k Keeper) SetIdempotentResult(ctx sdk.Context, storeKey []byte, requestHash []byte, id types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(storeKey, append(append([]byte{}, requestHash...), id...))
+	store.Set(types.GetIdempotencyExpirationKey(ctx.BlockTime().Add(IdempotencyKeyLifetime), storeKey), []byte{0x01})
+}
+
+// RemoveExpiredIdempotencyKeys removes the results of all writes with idempotency keys that have expired as of the
+// current block time.
+func (k Keeper) RemoveExpiredIdempotencyKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(types.IdempotencyExpirationKeyPrefix,
+		sdk.PrefixEndBytes(types.GetIdempotencyExpirationKeyPrefix(ctx.BlockTime())))
+	var expired [][]byte
+	for ; it.Valid(); it.Next() {
+		expired = append(expired, it.Key())
+	}
+	it.Close()
+	for _, key := range expired {
+		store.Delete(key)
+		store.Delete(key[len(key)-len(types.IdempotencyKeyPrefix)-sha256.Size:])
+	}
+}
+
+// hashRequest returns the hash used to recognize a replay of a write made with an idempotency key.  The request is
+// hashed as received, before any of its optional fields are converted.
+func hashRequest(msg codec.ProtoMarshaler) ([]byte, error) {
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// The name of the invariant that every session belongs to an existing scope.
+	sessionScopeInvariantName = "session-scope"
+	// The name of the invariant that every record belongs to an existing session and record specification.
+	recordSessionSpecInvariantName = "record-session-spec"
+	// The name of the invariant that every marker value owner of a scope is a marker that has not been destroyed.
+	valueOwnerMarkerInvariantName = "value-owner-marker"
+)
+
+// invariantRoutes are all of the metadata module invariants in the order they are registered and run.
+var invariantRoutes = []struct {
+	name      string
+	invariant func(Keeper) sdk.Invariant
+}{
+	{sessionScopeInvariantName, sessionScopeInvariant},
+	{recordSessionSpecInvariantName, recordSessionSpecInvariant},
+	{valueOwnerMarkerInvariantName, valueOwnerMarkerInvariant},
+}
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, route := range invariantRoutes {
+		ir.RegisterRoute(types.ModuleName, route.name, route.invariant(k))
+	}
+}
+
+// AllInvariants runs all invariants of the metadata module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, route := range invariantRoutes {
+			if res, stop := route.invariant(k)(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CheckInvariants runs each of the metadata module invariants and returns their results without halting.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariantRoutes))
+	for _, route := range invariantRoutes {
+		msg, broken := route.invariant(k)(ctx)
+		results = append(results, types.InvariantResult{Name: route.name, Broken: broken, Message: msg})
+	}
+	return results
+}
+
+// Checks that every session belongs to a scope that exists.
+func sessionScopeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		err := k.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) bool {
+			scopeID, err := session.SessionId.AsScopeAddress()
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tsession %s has an invalid id: %v\n", session.SessionId, err)
+				return false
+			}
+			if _, found := k.GetScope(ctx, scopeID); !found {
+				count++
+				msg += fmt.Sprintf("\tsession %s references scope %s that does not exist\n", session.SessionId, scopeID)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\tcould not iterate sessions: %v\n", err)
+		}
+		return formatInvariant(sessionScopeInvariantName, "sessions without a scope", count, msg)
+	}
+}
+
+// Checks that every record belongs to a session that exists and references a record specification that exists.
+func recordSessionSpecInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		err := k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+			recordID := record.GetRecordAddress()
+			if _, found := k.GetSession(ctx, record.SessionId); !found {
+				count++
+				msg += fmt.Sprintf("\trecord %s references session %s that does not exist\n", recordID, record.SessionId)
+			}
+			if !record.SpecificationId.Empty() {
+				if _, found := k.GetRecordSpecification(ctx, record.SpecificationId); !found {
+					count++
+					msg += fmt.Sprintf("\trecord %s references record specification %s that does not exist\n",
+						recordID, record.SpecificationId)
+				}
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\tcould not iterate records: %v\n", err)
+		}
+		return formatInvariant(recordSessionSpecInvariantName, "records with missing references", count, msg)
+	}
+}
+
+// Checks that every scope with a marker value owner references a marker that has not been destroyed.
+func valueOwnerMarkerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		err := k.IterateScopes(ctx, func(scope types.Scope) bool {
+			if len(scope.ValueOwnerAddress) == 0 {
+				return false
+			}
+			addr, err := sdk.AccAddressFromBech32(scope.ValueOwnerAddress)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tscope %s has an invalid value owner %s: %v\n", scope.ScopeId, scope.ValueOwnerAddress, err)
+				return false
+			}
+			marker, isMarker := k.authKeeper.GetAccount(ctx, addr).(*markertypes.MarkerAccount)
+			if isMarker && marker.GetStatus() == markertypes.StatusDestroyed {
+				count++
+				msg += fmt.Sprintf("\tscope %s value owner references destroyed marker %s (%s)\n",
+					scope.ScopeId, marker.GetDenom(), scope.ValueOwnerAddress)
+			}
+			return false
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\tcould not iterate scopes: %v\n", err)
+		}
+		return formatInvariant(valueOwnerMarkerInvariantName, "scopes with invalid marker value owners", count, msg)
+	}
+}
+
+// formatInvariant returns the invariant message for the number of broken entries with the given details.
+func formatInvariant(name, description string, count int, details string) (string, bool) {
+	return sdk.FormatInvariant(types.ModuleName, name, fmt.Sprintf("%s found %d\n%s", description, count, details)), count > 0
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMetadataInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MetadataKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	owner := sdk.AccAddress("invariant_owner_____").String()
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	contractSpecUUID := uuid.New()
+	recordSpecID := types.RecordSpecMetadataAddress(contractSpecUUID, "record")
+
+	brokenInvariants := func() []string {
+		broken := []string{}
+		for _, result := range app.MetadataKeeper.CheckInvariants(ctx) {
+			if result.Broken {
+				broken = append(broken, result.Name)
+			}
+		}
+		_, isBroken := keeper.AllInvariants(app.MetadataKeeper)(ctx)
+		require.Equal(t, len(broken) > 0, isBroken, "AllInvariants broken")
+		return broken
+	}
+	require.Empty(t, brokenInvariants())
+
+	session := types.NewSession("session", sessionID, types.ContractSpecMetadataAddress(contractSpecUUID),
+		ownerPartyList(owner), nil)
+	app.MetadataKeeper.SetSession(ctx, *session)
+	require.Equal(t, []string{"session-scope"}, brokenInvariants())
+
+	record := types.NewRecord("record", sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "hash"}, "method"),
+		[]types.RecordInput{}, []types.RecordOutput{}, recordSpecID)
+	app.MetadataKeeper.SetRecord(ctx, *record)
+	require.Equal(t, []string{"session-scope", "record-session-spec"}, brokenInvariants())
+
+	recordSpec := types.NewRecordSpecification(recordSpecID, "record", []*types.InputSpecification{}, "type",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	app.MetadataKeeper.SetRecordSpecification(ctx, *recordSpec)
+	require.Equal(t, []string{"session-scope"}, brokenInvariants())
+
+	marker := markertypes.NewEmptyMarkerAccount("invariantcoin", owner, []markertypes.AccessGrant{})
+	app.MarkerKeeper.SetMarker(ctx, marker)
+	scope := types.NewScope(scopeID, nil, ownerPartyList(owner), []string{}, marker.GetAddress().String())
+	app.MetadataKeeper.SetScope(ctx, *scope)
+	require.Empty(t, brokenInvariants())
+
+	require.NoError(t, marker.SetStatus(markertypes.StatusDestroyed))
+	app.MarkerKeeper.SetMarker(ctx, marker)
+	require.Equal(t, []string{"value-owner-marker"}, brokenInvariants())
+
+	res, err := queryClient.Invariants(ctx.Context(), &types.InvariantsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Broken)
+	require.Len(t, res.Invariants, 3)
+	require.False(t, res.Invariants[0].Broken)
+	require.True(t, res.Invariants[2].Broken)
+	require.Contains(t, res.Invariants[2].Message, "references destroyed marker invariantcoin")
+}
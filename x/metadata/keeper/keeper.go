@@ -114,22 +114,39 @@ type Keeper struct {
 
 	// To check if accounts exist and set public keys.
 	authKeeper authkeeper.AccountKeeper
+
+	// To issue the coins that represent value ownership of scopes.
+	markerKeeper types.MarkerKeeper
+
+	// To resolve names to the owners of object store locators.
+	nameKeeper types.NameKeeper
+
+	// To send storage fees to the community pool.
+	distrKeeper types.DistrKeeper
+
+	// To check the attributes of value owners against the constraints of scope specifications.
+	attrKeeper types.AttributeKeeper
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	authKeeper authkeeper.AccountKeeper,
+	authKeeper authkeeper.AccountKeeper, markerKeeper types.MarkerKeeper, nameKeeper types.NameKeeper,
+	distrKeeper types.DistrKeeper, attrKeeper types.AttributeKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable().RegisterParamSet(&types.OSLocatorParams{}))
 	}
 	return Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		authKeeper:   authKeeper,
+		markerKeeper: markerKeeper,
+		nameKeeper:   nameKeeper,
+		distrKeeper:  distrKeeper,
+		attrKeeper:   attrKeeper,
 	}
 }
 
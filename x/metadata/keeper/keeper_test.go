@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
@@ -542,6 +543,50 @@ func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	})
 }
 
+func (s *KeeperTestSuite) TestReportOSLocatorStatus() {
+	now := time.Unix(1630000000, 0).UTC()
+	ctx := s.ctx.WithBlockTime(now)
+
+	s.Run("report os locator status", func() {
+		r, err := s.app.MetadataKeeper.ReportOSLocatorStatus(ctx, s.user1Addr, metadatatypes.OSLocatorStatus_OS_LOCATOR_STATUS_HEALTHY)
+		s.Require().NoError(err)
+		s.Require().Equal(now.Unix(), r.LastSeen)
+		stored, found := s.app.MetadataKeeper.GetOsLocatorRecord(ctx, s.user1Addr)
+		s.Require().True(found)
+		s.Require().Equal(r, stored)
+		s.Require().Equal(s.uri, stored.LocatorUri)
+	})
+	s.Run("report os locator status unbound address", func() {
+		_, err := s.app.MetadataKeeper.ReportOSLocatorStatus(ctx, s.user3Addr, metadatatypes.OSLocatorStatus_OS_LOCATOR_STATUS_HEALTHY)
+		s.Require().Equal(metadatatypes.ErrAddressNotBound, err)
+	})
+	s.Run("healthy only locator queries", func() {
+		res, err := s.app.MetadataKeeper.OSAllLocators(sdk.WrapSDKContext(ctx), &metadatatypes.OSAllLocatorsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Locators, 2)
+
+		res, err = s.app.MetadataKeeper.OSAllLocators(sdk.WrapSDKContext(ctx), &metadatatypes.OSAllLocatorsRequest{HealthyOnly: true})
+		s.Require().NoError(err)
+		s.Require().Len(res.Locators, 1)
+		s.Require().Equal(s.user1, res.Locators[0].Owner)
+
+		later := ctx.WithBlockTime(now.Add(2 * time.Hour))
+		res, err = s.app.MetadataKeeper.OSAllLocators(sdk.WrapSDKContext(later), &metadatatypes.OSAllLocatorsRequest{HealthyOnly: true, MaxReportAgeSeconds: 7200})
+		s.Require().NoError(err)
+		s.Require().Len(res.Locators, 1)
+		_, err = s.app.MetadataKeeper.OSAllLocators(sdk.WrapSDKContext(later), &metadatatypes.OSAllLocatorsRequest{HealthyOnly: true, MaxReportAgeSeconds: 3600})
+		s.Require().Equal(metadatatypes.ErrNoRecordsFound, err)
+
+		_, err = s.app.MetadataKeeper.ReportOSLocatorStatus(ctx, s.user1Addr, metadatatypes.OSLocatorStatus_OS_LOCATOR_STATUS_UNAVAILABLE)
+		s.Require().NoError(err)
+		uriRes, err := s.app.MetadataKeeper.OSLocatorsByURI(sdk.WrapSDKContext(ctx), &metadatatypes.OSLocatorsByURIRequest{Uri: s.uri})
+		s.Require().NoError(err)
+		s.Require().Len(uriRes.Locators, 1)
+		_, err = s.app.MetadataKeeper.OSLocatorsByURI(sdk.WrapSDKContext(ctx), &metadatatypes.OSLocatorsByURIRequest{Uri: s.uri, HealthyOnly: true})
+		s.Require().Equal(metadatatypes.ErrNoRecordsFound, err)
+	})
+}
+
 func (s *KeeperTestSuite) TestUnionDistinct() {
 	tests := []struct {
 		name   string
@@ -19,3 +19,24 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v042.MigrateAddresses(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by indexing the records referenced by the inputs of the existing records.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.RebuildRecordConsumerIndex(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4 by calculating the sizes of the existing scopes.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.RebuildScopeSizes(ctx)
+}
+
+// Migrate4to5 migrates from version 4 to 5 by indexing the existing sessions by their contract specification.
+func (m *Migrator) Migrate4to5(ctx sdk.Context) error {
+	return m.keeper.RebuildContractSpecSessionIndex(ctx)
+}
+
+// Migrate5to6 migrates from version 5 to 6 by making every existing scope specification lenient, keeping the record
+// checks they had before strict enforcement was added.
+func (m *Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.SetScopeSpecificationsLenient(ctx)
+}
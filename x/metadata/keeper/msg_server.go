@@ -33,15 +33,44 @@ func (k msgServer) WriteScope(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteScope")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	var idempotencyStoreKey, requestHash []byte
+	if len(msg.IdempotencyKey) > 0 {
+		var err error
+		if requestHash, err = hashRequest(msg); err != nil {
+			return nil, err
+		}
+		idempotencyStoreKey = types.GetIdempotencyKey(msg.Type(), msg.Signers, msg.IdempotencyKey)
+		scopeID, found, err := k.GetIdempotentResult(ctx, idempotencyStoreKey, requestHash)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return types.NewMsgWriteScopeResponse(scopeID), nil
+		}
+	}
+
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
 	existing, _ := k.GetScope(ctx, msg.Scope.ScopeId)
+	if err := k.ValidateScopeVersion(msg.Scope.ScopeId, existing.Version, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
 	if err := k.ValidateScopeUpdate(ctx, existing, msg.Scope, msg.Signers); err != nil {
 		return nil, err
 	}
 
+	if msg.ValueOwnerAsCoin {
+		if err := k.IssueValueOwnerCoin(ctx, existing, &msg.Scope, msg.Signers); err != nil {
+			return nil, err
+		}
+	}
+
+	msg.Scope.Version = existing.Version + 1
 	k.SetScope(ctx, msg.Scope)
+	if idempotencyStoreKey != nil {
+		k.SetIdempotentResult(ctx, idempotencyStoreKey, requestHash, msg.Scope.ScopeId)
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScope, msg.GetSigners()))
 	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
@@ -62,6 +91,9 @@ func (k msgServer) DeleteScope(
 	if err := k.ValidateScopeRemove(ctx, existing, types.Scope{ScopeId: msg.ScopeId}, msg.Signers); err != nil {
 		return nil, err
 	}
+	if err := k.BurnValueOwnerCoin(ctx, existing, msg.Signers); err != nil {
+		return nil, err
+	}
 
 	k.RemoveScope(ctx, msg.ScopeId)
 
@@ -86,6 +118,7 @@ func (k msgServer) AddScopeDataAccess(
 	}
 
 	existing.AddDataAccess(msg.DataAccess)
+	existing.Version++
 
 	k.SetScope(ctx, existing)
 
@@ -110,6 +143,7 @@ func (k msgServer) DeleteScopeDataAccess(
 	}
 
 	existing.RemoveDataAccess(msg.DataAccess)
+	existing.Version++
 
 	k.SetScope(ctx, existing)
 
@@ -143,6 +177,7 @@ func (k msgServer) AddScopeOwner(
 		return nil, err
 	}
 
+	proposed.Version++
 	k.SetScope(ctx, proposed)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeOwner, msg.GetSigners()))
@@ -175,6 +210,7 @@ func (k msgServer) DeleteScopeOwner(
 		return nil, err
 	}
 
+	proposed.Version++
 	k.SetScope(ctx, proposed)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScopeOwner, msg.GetSigners()))
@@ -203,7 +239,12 @@ func (k msgServer) WriteSession(
 
 	msg.Session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
 
+	scopeID := msg.Session.SessionId.MustGetAsScopeAddress()
+	sizeBefore := k.GetScopeSize(ctx, scopeID)
 	k.SetSession(ctx, msg.Session)
+	if err := k.ChargeStorageFee(ctx, scopeID, sizeBefore, msg.GetSigners()[0]); err != nil {
+		return nil, err
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSigners()))
 	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
@@ -216,6 +257,22 @@ func (k msgServer) WriteRecord(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteRecord")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	var idempotencyStoreKey, requestHash []byte
+	if len(msg.IdempotencyKey) > 0 {
+		var err error
+		if requestHash, err = hashRequest(msg); err != nil {
+			return nil, err
+		}
+		idempotencyStoreKey = types.GetIdempotencyKey(msg.Type(), msg.Signers, msg.IdempotencyKey)
+		recordID, found, err := k.GetIdempotentResult(ctx, idempotencyStoreKey, requestHash)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return types.NewMsgWriteRecordResponse(recordID), nil
+		}
+	}
+
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
@@ -225,6 +282,12 @@ func (k msgServer) WriteRecord(
 	}
 
 	recordID := types.RecordMetadataAddress(scopeUUID, msg.Record.Name)
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+
+	scope, _ := k.GetScope(ctx, scopeID)
+	if err := k.ValidateScopeVersion(scopeID, scope.Version, msg.ExpectedScopeVersion); err != nil {
+		return nil, err
+	}
 
 	var existing *types.Record = nil
 	if e, found := k.GetRecord(ctx, recordID); found {
@@ -234,12 +297,56 @@ func (k msgServer) WriteRecord(
 		return nil, err
 	}
 
+	sizeBefore := k.GetScopeSize(ctx, scopeID)
 	k.SetRecord(ctx, msg.Record)
+	if err := k.ChargeStorageFee(ctx, scopeID, sizeBefore, msg.GetSigners()[0]); err != nil {
+		return nil, err
+	}
+	k.incrementScopeVersion(ctx, scopeID)
+	if idempotencyStoreKey != nil {
+		k.SetIdempotentResult(ctx, idempotencyStoreKey, requestHash, recordID)
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecord, msg.GetSigners()))
 	return types.NewMsgWriteRecordResponse(recordID), nil
 }
 
+func (k msgServer) WriteSessionAndRecords(
+	goCtx context.Context,
+	msg *types.MsgWriteSessionAndRecordsRequest,
+) (*types.MsgWriteSessionAndRecordsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteSessionAndRecords")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
+	msg.ConvertOptionalFields()
+
+	// The session has to be written first since the records require it.
+	sessionResp, err := k.WriteSession(goCtx, &types.MsgWriteSessionRequest{
+		Session: msg.Session,
+		Signers: msg.Signers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordIDInfos := make([]*types.RecordIdInfo, len(msg.Records))
+	for i, record := range msg.Records {
+		recordResp, err := k.WriteRecord(goCtx, &types.MsgWriteRecordRequest{
+			Record:  record,
+			Signers: msg.Signers,
+			Parties: msg.Session.Parties,
+		})
+		if err != nil {
+			return nil, err
+		}
+		recordIDInfos[i] = recordResp.RecordIdInfo
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSessionAndRecords, msg.GetSigners()))
+	return types.NewMsgWriteSessionAndRecordsResponse(sessionResp.SessionIdInfo, recordIDInfos), nil
+}
+
 func (k msgServer) DeleteRecord(
 	goCtx context.Context,
 	msg *types.MsgDeleteRecordRequest,
@@ -253,11 +360,135 @@ func (k msgServer) DeleteRecord(
 	}
 
 	k.RemoveRecord(ctx, msg.RecordId)
+	k.incrementScopeVersion(ctx, msg.RecordId.MustGetAsScopeAddress())
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecord, msg.GetSigners()))
 	return types.NewMsgDeleteRecordResponse(), nil
 }
 
+func (k msgServer) AddRecordDataAccess(
+	goCtx context.Context,
+	msg *types.MsgAddRecordDataAccessRequest,
+) (*types.MsgAddRecordDataAccessResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddRecordDataAccess")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetRecord(ctx, msg.RecordId)
+	if !found {
+		return nil, fmt.Errorf("record not found with id %s", msg.RecordId)
+	}
+
+	if err := k.ValidateRecordAddDataAccess(ctx, msg.DataAccess, existing, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	existing.AddDataAccess(msg.DataAccess)
+
+	k.SetRecord(ctx, existing)
+	k.incrementScopeVersion(ctx, existing.SessionId.MustGetAsScopeAddress())
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddRecordDataAccess, msg.GetSigners()))
+	return types.NewMsgAddRecordDataAccessResponse(), nil
+}
+
+func (k msgServer) DeleteRecordDataAccess(
+	goCtx context.Context,
+	msg *types.MsgDeleteRecordDataAccessRequest,
+) (*types.MsgDeleteRecordDataAccessResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteRecordDataAccess")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetRecord(ctx, msg.RecordId)
+	if !found {
+		return nil, fmt.Errorf("record not found with id %s", msg.RecordId)
+	}
+
+	if err := k.ValidateRecordDeleteDataAccess(ctx, msg.DataAccess, existing, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	existing.RemoveDataAccess(msg.DataAccess)
+
+	k.SetRecord(ctx, existing)
+	k.incrementScopeVersion(ctx, existing.SessionId.MustGetAsScopeAddress())
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecordDataAccess, msg.GetSigners()))
+	return types.NewMsgDeleteRecordDataAccessResponse(), nil
+}
+
+func (k msgServer) RedactRecord(
+	goCtx context.Context,
+	msg *types.MsgRedactRecordRequest,
+) (*types.MsgRedactRecordResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "RedactRecord")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetRecord(ctx, msg.RecordId)
+	if !found {
+		return nil, fmt.Errorf("record not found with id %s", msg.RecordId)
+	}
+
+	if err := k.ValidateRecordRedact(ctx, existing, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	existing.Redact(msg.Reason, msg.Signers, ctx.BlockHeight(), ctx.BlockTime())
+
+	k.SetRecord(ctx, existing)
+	k.incrementScopeVersion(ctx, existing.SessionId.MustGetAsScopeAddress())
+
+	k.EmitEvent(ctx, types.NewEventRecordRedacted(msg.RecordId, msg.Reason))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RedactRecord, msg.GetSigners()))
+	return types.NewMsgRedactRecordResponse(), nil
+}
+
+func (k msgServer) ArchiveScope(
+	goCtx context.Context,
+	msg *types.MsgArchiveScopeRequest,
+) (*types.MsgArchiveScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ArchiveScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeArchive(ctx, existing, msg.LocatorUri, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	archive, err := k.Keeper.ArchiveScope(ctx, msg.ScopeId, msg.LocatorUri, msg.Signers)
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ArchiveScope, msg.GetSigners()))
+	return types.NewMsgArchiveScopeResponse(archive.ContentHash), nil
+}
+
+func (k msgServer) RestoreScope(
+	goCtx context.Context,
+	msg *types.MsgRestoreScopeRequest,
+) (*types.MsgRestoreScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "RestoreScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.Content.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.Content.ScopeId)
+	}
+
+	if err := k.ValidateScopeRestore(ctx, existing, msg.Content, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.RestoreScope(ctx, msg.Content)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RestoreScope, msg.GetSigners()))
+	return types.NewMsgRestoreScopeResponse(), nil
+}
+
 func (k msgServer) WriteScopeSpecification(
 	goCtx context.Context,
 	msg *types.MsgWriteScopeSpecificationRequest,
@@ -525,6 +756,50 @@ func (k msgServer) DeleteRecordSpecification(
 	return types.NewMsgDeleteRecordSpecificationResponse(), nil
 }
 
+func (k msgServer) WriteSpecificationBundle(
+	goCtx context.Context,
+	msg *types.MsgWriteSpecificationBundleRequest,
+) (*types.MsgWriteSpecificationBundleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteSpecificationBundle")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The contract specification has to be written first since the record specifications require it.
+	contractSpecResp, err := k.WriteContractSpecification(goCtx, &types.MsgWriteContractSpecificationRequest{
+		Specification: msg.Bundle.ContractSpecification,
+		Signers:       msg.Signers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordSpecIDInfos := make([]*types.RecordSpecIdInfo, len(msg.Bundle.RecordSpecifications))
+	for i, recordSpec := range msg.Bundle.RecordSpecifications {
+		recordSpecResp, err := k.WriteRecordSpecification(goCtx, &types.MsgWriteRecordSpecificationRequest{
+			Specification: recordSpec,
+			Signers:       msg.Signers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		recordSpecIDInfos[i] = recordSpecResp.RecordSpecIdInfo
+	}
+
+	scopeSpecIDInfos := make([]*types.ScopeSpecIdInfo, len(msg.Bundle.ScopeSpecifications))
+	for i, scopeSpec := range msg.Bundle.ScopeSpecifications {
+		scopeSpecResp, err := k.WriteScopeSpecification(goCtx, &types.MsgWriteScopeSpecificationRequest{
+			Specification: scopeSpec,
+			Signers:       msg.Signers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		scopeSpecIDInfos[i] = scopeSpecResp.ScopeSpecIdInfo
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSpecificationBundle, msg.GetSigners()))
+	return types.NewMsgWriteSpecificationBundleResponse(contractSpecResp.ContractSpecIdInfo, recordSpecIDInfos, scopeSpecIDInfos), nil
+}
+
 func (k msgServer) WriteP8EContractSpec(
 	goCtx context.Context,
 	msg *types.MsgWriteP8EContractSpecRequest,
@@ -729,3 +1004,112 @@ func (k msgServer) ModifyOSLocator(
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ModifyOSLocator, msg.GetSigners()))
 	return types.NewMsgModifyOSLocatorResponse(msg.Locator), nil
 }
+
+func (k msgServer) ReportOSLocatorStatus(
+	goCtx context.Context,
+	msg *types.MsgReportOSLocatorStatusRequest,
+) (*types.MsgReportOSLocatorStatusResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ReportOSLocatorStatus")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+
+	locator, err := k.Keeper.ReportOSLocatorStatus(ctx, ownerAddr, msg.Status)
+	if err != nil {
+		ctx.Logger().Error("error reporting os locator status", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ReportOSLocatorStatus, msg.GetSigners()))
+	return &types.MsgReportOSLocatorStatusResponse{Locator: locator}, nil
+}
+
+func (k msgServer) AddContractSpecSourceLocator(
+	goCtx context.Context,
+	msg *types.MsgAddContractSpecSourceLocatorRequest,
+) (*types.MsgAddContractSpecSourceLocatorResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddContractSpecSourceLocator")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetContractSpecification(ctx, msg.SpecificationId)
+	if !found {
+		return nil, fmt.Errorf("contract specification not found with id %s", msg.SpecificationId)
+	}
+	if err := k.ValidateAllOwnersAreSigners(existing.OwnerAddresses, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.LocatorOwner)
+	if err := k.Keeper.AddContractSpecSourceLocator(ctx, msg.SpecificationId, ownerAddr); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddContractSpecSourceLocator, msg.GetSigners()))
+	return &types.MsgAddContractSpecSourceLocatorResponse{}, nil
+}
+
+func (k msgServer) DeleteContractSpecSourceLocator(
+	goCtx context.Context,
+	msg *types.MsgDeleteContractSpecSourceLocatorRequest,
+) (*types.MsgDeleteContractSpecSourceLocatorResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteContractSpecSourceLocator")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetContractSpecification(ctx, msg.SpecificationId)
+	if !found {
+		return nil, fmt.Errorf("contract specification not found with id %s", msg.SpecificationId)
+	}
+	if err := k.ValidateAllOwnersAreSigners(existing.OwnerAddresses, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.LocatorOwner)
+	if err := k.Keeper.RemoveContractSpecSourceLocator(ctx, msg.SpecificationId, ownerAddr); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteContractSpecSourceLocator, msg.GetSigners()))
+	return &types.MsgDeleteContractSpecSourceLocatorResponse{}, nil
+}
+
+func (k msgServer) DeprecateSpecification(
+	goCtx context.Context,
+	msg *types.MsgDeprecateSpecificationRequest,
+) (*types.MsgDeprecateSpecificationResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeprecateSpecification")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var owners []string
+	if msg.SpecificationId.IsScopeSpecificationAddress() {
+		existing, found := k.GetScopeSpecification(ctx, msg.SpecificationId)
+		if !found {
+			return nil, fmt.Errorf("scope specification not found with id %s", msg.SpecificationId)
+		}
+		owners = existing.OwnerAddresses
+	} else {
+		existing, found := k.GetContractSpecification(ctx, msg.SpecificationId)
+		if !found {
+			return nil, fmt.Errorf("contract specification not found with id %s", msg.SpecificationId)
+		}
+		owners = existing.OwnerAddresses
+	}
+	if err := k.ValidateAllOwnersAreSigners(owners, msg.Signers); err != nil {
+		return nil, err
+	}
+
+	deprecation, err := k.Keeper.DeprecateSpecification(ctx, msg.SpecificationId, msg.SuccessorId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeprecateSpecification, msg.GetSigners()))
+	return &types.MsgDeprecateSpecificationResponse{Deprecation: deprecation}, nil
+}
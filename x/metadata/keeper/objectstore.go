@@ -151,6 +151,34 @@ func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.Ac
 	return nil
 }
 
+// ReportOSLocatorStatus records the status of an existing os locator endpoint, as reported by its owner, as last seen at
+// the current block time.  An error is returned if the locator doesn't exist.
+func (k Keeper) ReportOSLocatorStatus(ctx sdk.Context, ownerAddr sdk.AccAddress, status types.OSLocatorStatus) (types.ObjectStoreLocator, error) {
+	record, err := k.setOSLocatorStatus(ctx, ownerAddr, status, ctx.BlockTime().Unix())
+	if err != nil {
+		return record, err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Updated)
+	return record, nil
+}
+
+// setOSLocatorStatus updates the status and last seen time of an existing os locator entry in the kvstore.
+func (k Keeper) setOSLocatorStatus(ctx sdk.Context, ownerAddr sdk.AccAddress, status types.OSLocatorStatus, lastSeen int64) (types.ObjectStoreLocator, error) {
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return record, types.ErrAddressNotBound
+	}
+	record.Status = status
+	record.LastSeen = lastSeen
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return record, err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr), bz)
+	return record, nil
+}
+
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
@@ -171,3 +199,78 @@ func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Created)
 	return nil
 }
+
+// AddContractSpecSourceLocator records that the source of a contract specification, identified by its hash, can be
+// downloaded from the object store locator of the given owner.
+func (k Keeper) AddContractSpecSourceLocator(ctx sdk.Context, contractSpecID types.MetadataAddress, ownerAddr sdk.AccAddress) error {
+	contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+	if !found {
+		return fmt.Errorf("contract specification with id %s not found", contractSpecID)
+	}
+	if len(contractSpec.GetHash()) == 0 {
+		return fmt.Errorf("contract specification with id %s does not have a source hash", contractSpecID)
+	}
+	if !k.OSLocatorExists(ctx, ownerAddr) {
+		return types.ErrAddressNotBound
+	}
+	key := types.GetContractSpecSourceLocatorKey(contractSpecID, ownerAddr)
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		return fmt.Errorf("locator of %s is already a source locator of contract specification %s", ownerAddr, contractSpecID)
+	}
+	store.Set(key, []byte{0x01})
+	k.EmitEvent(ctx, types.NewEventContractSpecSourceLocatorAdded(contractSpecID, ownerAddr.String()))
+	return nil
+}
+
+// RemoveContractSpecSourceLocator removes the object store locator of the given owner from the source locators of a
+// contract specification.
+func (k Keeper) RemoveContractSpecSourceLocator(ctx sdk.Context, contractSpecID types.MetadataAddress, ownerAddr sdk.AccAddress) error {
+	key := types.GetContractSpecSourceLocatorKey(contractSpecID, ownerAddr)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return fmt.Errorf("locator of %s is not a source locator of contract specification %s", ownerAddr, contractSpecID)
+	}
+	store.Delete(key)
+	k.EmitEvent(ctx, types.NewEventContractSpecSourceLocatorDeleted(contractSpecID, ownerAddr.String()))
+	return nil
+}
+
+// GetContractSpecSourceLocatorOwners returns the owners of the object store locators the source of a contract
+// specification can be downloaded from.
+func (k Keeper) GetContractSpecSourceLocatorOwners(ctx sdk.Context, contractSpecID types.MetadataAddress) []sdk.AccAddress {
+	prefix := types.GetContractSpecSourceLocatorIteratorPrefix(contractSpecID)
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+
+	var owners []sdk.AccAddress
+	for ; it.Valid(); it.Next() {
+		// The rest of the key is the length prefixed owner address.
+		owners = append(owners, sdk.AccAddress(it.Key()[len(prefix)+1:]))
+	}
+	return owners
+}
+
+// IterateContractSpecSourceLocators processes all contract spec source locators with the given handler.
+func (k Keeper) IterateContractSpecSourceLocators(ctx sdk.Context, cb func(locator types.ContractSpecSourceLocator) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractSpecSourceLocatorKeyPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()[len(types.ContractSpecSourceLocatorKeyPrefix):]
+		// Contract spec ids are a type byte followed by a uuid.
+		specID := types.MetadataAddress(key[:17])
+		owner := sdk.AccAddress(key[18:])
+		if cb(types.ContractSpecSourceLocator{SpecificationId: specID, LocatorOwner: owner.String()}) {
+			break
+		}
+	}
+}
+
+// clearContractSpecSourceLocators removes all of the source locators of a contract specification.
+func (k Keeper) clearContractSpecSourceLocators(ctx sdk.Context, contractSpecID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, owner := range k.GetContractSpecSourceLocatorOwners(ctx, contractSpecID) {
+		store.Delete(types.GetContractSpecSourceLocatorKey(contractSpecID, owner))
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -8,8 +10,32 @@ import (
 
 // GetParams returns the total set of metadata parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+	return types.Params{
+		StorageFeePerByte:          k.GetStorageFeePerByte(ctx),
+		SpecDeprecationGracePeriod: k.GetSpecDeprecationGracePeriod(ctx),
+	}
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetStorageFeePerByte gets the configured fee charged per byte of scope growth (or the default if unset)
+func (k Keeper) GetStorageFeePerByte(ctx sdk.Context) (fee sdk.Coins) {
+	fee = types.DefaultParams().StorageFeePerByte
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyStorageFeePerByte) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyStorageFeePerByte, &fee)
+	}
+	return
+}
+
+// GetSpecDeprecationGracePeriod gets the configured time new sessions are still allowed against a deprecated
+// specification (or the default if unset)
+func (k Keeper) GetSpecDeprecationGracePeriod(ctx sdk.Context) (period time.Duration) {
+	period = types.DefaultParams().SpecDeprecationGracePeriod
+	if k.paramSpace.Has(ctx, types.ParamStoreKeySpecDeprecationGracePeriod) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeySpecDeprecationGracePeriod, &period)
+	}
+	return
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// HandleTransferScopeOwnershipProposal is a handler for executing a passed transfer scope ownership proposal.  The
+// owners and/or value owner of the scope are replaced without the signatures of the existing owners.
+func HandleTransferScopeOwnershipProposal(ctx sdk.Context, k Keeper, p *types.TransferScopeOwnershipProposal) error {
+	scopeID, err := types.MetadataAddressFromBech32(p.ScopeId)
+	if err != nil {
+		return err
+	}
+	existing, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	scope := existing
+	if len(p.Owners) > 0 {
+		scope.Owners = p.Owners
+		scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		if !found {
+			return fmt.Errorf("scope specification %s not found", scope.SpecificationId)
+		}
+		if err = k.ValidateScopeOwners(scope.Owners, scopeSpec); err != nil {
+			return err
+		}
+	}
+	if len(p.ValueOwnerAddress) > 0 && p.ValueOwnerAddress != existing.ValueOwnerAddress {
+		// value ownership represented by a coin belongs to whoever holds the coin, not the scope.
+		if k.IsValueOwnerCoinScope(existing) {
+			return fmt.Errorf("value owner of scope %s is the %s coin and can only be changed by transferring that coin",
+				existing.ScopeId, existing.ValueOwnerCoinDenom())
+		}
+		if coinMarkerAddr, err := valueOwnerCoinMarkerAddress(scope); err == nil && p.ValueOwnerAddress == coinMarkerAddr.String() {
+			return fmt.Errorf("value owner of scope %s cannot be set to its value owner coin marker directly", scope.ScopeId)
+		}
+		scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		if !found {
+			return fmt.Errorf("scope specification %s not found", scope.SpecificationId)
+		}
+		if err = k.ValidateScopeValueOwner(ctx, p.ValueOwnerAddress, scopeSpec); err != nil {
+			return err
+		}
+		scope.ValueOwnerAddress = p.ValueOwnerAddress
+	}
+	if err = scope.ValidateBasic(); err != nil {
+		return err
+	}
+
+	scope.Version++
+	k.SetScope(ctx, scope)
+	k.EmitEvent(ctx, types.NewEventScopeOwnershipTransferred(existing, scope, p.Evidence, p.Title))
+	k.Logger(ctx).Info(fmt.Sprintf("transfer scope ownership proposal: transferred scope %s to owners %v and value owner %q",
+		scope.ScopeId, scope.Owners, scope.ValueOwnerAddress))
+	return nil
+}
+
+// HandleSetScopeSpecificationStrictProposal is a handler for executing a passed set scope specification strict
+// proposal.  Strict enforcement of the scope specification is turned on or off without the signatures of its owners.
+func HandleSetScopeSpecificationStrictProposal(ctx sdk.Context, k Keeper, p *types.SetScopeSpecificationStrictProposal) error {
+	specID, err := types.MetadataAddressFromBech32(p.SpecificationId)
+	if err != nil {
+		return err
+	}
+	spec, found := k.GetScopeSpecification(ctx, specID)
+	if !found {
+		return fmt.Errorf("scope specification not found with id %s", specID)
+	}
+	if spec.Strict == p.Strict {
+		return fmt.Errorf("scope specification %s already has strict %t", specID, p.Strict)
+	}
+
+	spec.Strict = p.Strict
+	k.SetScopeSpecification(ctx, spec)
+	k.Logger(ctx).Info(fmt.Sprintf("set scope specification strict proposal: set strict %t on scope specification %s",
+		spec.Strict, spec.SpecificationId))
+	return nil
+}
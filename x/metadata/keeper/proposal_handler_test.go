@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+
+	app *simapp.App
+	ctx sdk.Context
+
+	user1 string
+	user2 string
+	user3 string
+
+	scopeID     types.MetadataAddress
+	scopeSpecID types.MetadataAddress
+}
+
+func (s *ProposalHandlerTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+
+	s.user1 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	s.user2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	s.user3 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	s.scopeID = types.ScopeMetadataAddress(uuid.New())
+	s.scopeSpecID = types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(s.scopeSpecID, nil,
+		[]string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
+}
+
+func TestProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (s *ProposalHandlerTestSuite) TestTransferScopeOwnershipProposal() {
+	handler := metadata.NewProposalHandler(s.app.MetadataKeeper)
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+
+	cases := []struct {
+		name       string
+		prop       *types.TransferScopeOwnershipProposal
+		owners     []types.Party
+		valueOwner string
+		errMsg     string
+	}{
+		{
+			"scope not found",
+			types.NewTransferScopeOwnershipProposal("title", "description", types.ScopeMetadataAddress(uuid.New()), ownerPartyList(s.user2), "", "lost keys"),
+			nil,
+			"",
+			"scope not found with id",
+		},
+		{
+			"owners missing party type required by spec",
+			types.NewTransferScopeOwnershipProposal("title", "description", s.scopeID,
+				[]types.Party{{Address: s.user2, Role: types.PartyType_PARTY_TYPE_AFFILIATE}}, "", "lost keys"),
+			nil,
+			"",
+			"missing party type required by spec: [OWNER]",
+		},
+		{
+			"owners replaced",
+			types.NewTransferScopeOwnershipProposal("title", "description", s.scopeID, ownerPartyList(s.user2), "", "lost keys"),
+			ownerPartyList(s.user2),
+			s.user1,
+			"",
+		},
+		{
+			"value owner replaced",
+			types.NewTransferScopeOwnershipProposal("title", "description", s.scopeID, nil, s.user3, "lost keys"),
+			ownerPartyList(s.user2),
+			s.user3,
+			"",
+		},
+		{
+			"owners and value owner replaced",
+			types.NewTransferScopeOwnershipProposal("title", "description", s.scopeID, ownerPartyList(s.user1, s.user3), s.user1, "lost keys"),
+			ownerPartyList(s.user1, s.user3),
+			s.user1,
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			err := handler(ctx, tc.prop)
+			if len(tc.errMsg) > 0 {
+				s.Require().Error(err)
+				s.Assert().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			updated, found := s.app.MetadataKeeper.GetScope(ctx, s.scopeID)
+			s.Require().True(found)
+			s.Assert().Equal(tc.owners, updated.Owners, "owners")
+			s.Assert().Equal(tc.valueOwner, updated.ValueOwnerAddress, "value owner")
+			s.Assert().Equal([]string{s.user1}, updated.DataAccess, "data access")
+
+			var transferred *sdk.Event
+			events := ctx.EventManager().Events()
+			for i := range events {
+				if events[i].Type == "provenance.metadata.v1.EventScopeOwnershipTransferred" {
+					transferred = &events[i]
+				}
+			}
+			s.Require().NotNil(transferred, "transferred event")
+			attrs := make(map[string]string)
+			for _, attr := range transferred.Attributes {
+				attrs[string(attr.Key)] = string(attr.Value)
+			}
+			s.Assert().Equal(`"`+s.scopeID.String()+`"`, attrs["scope_addr"])
+			s.Assert().Equal(`"lost keys"`, attrs["evidence"])
+			s.Assert().Equal(`"title"`, attrs["proposal_title"])
+		})
+	}
+}
+
+func (s *ProposalHandlerTestSuite) TestSetScopeSpecificationStrictProposal() {
+	handler := metadata.NewProposalHandler(s.app.MetadataKeeper)
+
+	cases := []struct {
+		name   string
+		prop   *types.SetScopeSpecificationStrictProposal
+		errMsg string
+	}{
+		{
+			"scope specification not found",
+			types.NewSetScopeSpecificationStrictProposal("title", "description", types.ScopeSpecMetadataAddress(uuid.New()), true),
+			"scope specification not found with id",
+		},
+		{
+			"already lenient",
+			types.NewSetScopeSpecificationStrictProposal("title", "description", s.scopeSpecID, false),
+			"already has strict false",
+		},
+		{
+			"set strict",
+			types.NewSetScopeSpecificationStrictProposal("title", "description", s.scopeSpecID, true),
+			"",
+		},
+		{
+			"already strict",
+			types.NewSetScopeSpecificationStrictProposal("title", "description", s.scopeSpecID, true),
+			"already has strict true",
+		},
+		{
+			"set lenient",
+			types.NewSetScopeSpecificationStrictProposal("title", "description", s.scopeSpecID, false),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			err := handler(s.ctx, tc.prop)
+			if len(tc.errMsg) > 0 {
+				s.Require().Error(err)
+				s.Assert().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			spec, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, s.scopeSpecID)
+			s.Require().True(found)
+			s.Assert().Equal(tc.prop.Strict, spec.Strict, "strict")
+			s.Assert().Equal([]string{s.user1}, spec.OwnerAddresses, "owners")
+		})
+	}
+}
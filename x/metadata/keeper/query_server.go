@@ -28,8 +28,7 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params, Request: req}, nil
 }
@@ -42,6 +41,9 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 	}
 
 	retval := types.ScopeResponse{Request: req}
+	if err := types.ValidateFieldMask(&retval, req.FieldMask); err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	var scopeAddr, sessionAddr types.MetadataAddress
 	if len(req.ScopeId) > 0 {
@@ -93,14 +95,14 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 	ctx := sdk.UnwrapSDKContext(c)
 	scope, found := k.GetScope(ctx, scopeAddr)
 	if found {
-		retval.Scope = types.WrapScope(&scope)
+		retval.Scope = k.wrapScope(ctx, &scope)
 	} else {
 		retval.Scope = types.WrapScopeNotFound(scopeAddr)
 	}
 
 	var sessErr, recErr error
 
-	if req.IncludeSessions {
+	if req.IncludeSessions && types.FieldMaskIncludes(req.FieldMask, "sessions") {
 		err := k.IterateSessions(ctx, scopeAddr, func(session types.Session) (stop bool) {
 			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
 			return false
@@ -110,7 +112,7 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 		}
 	}
 
-	if req.IncludeRecords {
+	if req.IncludeRecords && types.FieldMaskIncludes(req.FieldMask, "records") {
 		err := k.IterateRecords(ctx, scopeAddr, func(record types.Record) (stop bool) {
 			retval.Records = append(retval.Records, types.WrapRecord(&record))
 			return false
@@ -134,7 +136,7 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 		return &retval, status.Error(codes.Unavailable, err.Error())
 	}
 
-	return &retval, nil
+	return &retval, types.ApplyFieldMask(&retval, req.FieldMask)
 }
 
 // ScopesAll returns all scopes (limited by pagination).
@@ -152,7 +154,7 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 		var scope types.Scope
 		vErr := scope.Unmarshal(value)
 		if vErr == nil {
-			retval.Scopes = append(retval.Scopes, types.WrapScope(&scope))
+			retval.Scopes = append(retval.Scopes, k.wrapScope(ctx, &scope))
 			return nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
@@ -184,6 +186,9 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 	}
 
 	retval := types.SessionsResponse{Request: req}
+	if err := types.ValidateFieldMask(&retval, req.FieldMask); err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -286,7 +291,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 		return &retval, status.Error(codes.InvalidArgument, "empty request parameters")
 	}
 
-	if req.IncludeScope {
+	if req.IncludeScope && types.FieldMaskIncludes(req.FieldMask, "scope") {
 		scope, found := k.GetScope(ctx, scopeAddr)
 		if found {
 			retval.Scope = types.WrapScope(&scope)
@@ -295,7 +300,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 		}
 	}
 
-	if req.IncludeRecords {
+	if req.IncludeRecords && types.FieldMaskIncludes(req.FieldMask, "records") {
 		// Get all the session ids
 		sessionAddrs := []types.MetadataAddress{}
 		for _, s := range retval.Sessions {
@@ -322,7 +327,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 		}
 	}
 
-	return &retval, nil
+	return &retval, types.ApplyFieldMask(&retval, req.FieldMask)
 }
 
 // SessionsAll returns all sessions (limited by pagination).
@@ -364,6 +369,41 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	return &retval, nil
 }
 
+// SessionsByContractSpec returns the sessions written under the given contract specification (limited by pagination).
+func (k Keeper) SessionsByContractSpec(c context.Context, req *types.SessionsByContractSpecRequest) (*types.SessionsByContractSpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "SessionsByContractSpec")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.SessionsByContractSpecResponse{Request: req}
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+	specAddr, err := ParseContractSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid specification id: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sessionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractSpecSessionCacheIteratorPrefix(specAddr))
+	pageRes, err := query.Paginate(sessionStore, getPageRequest(req), func(key, _ []byte) error {
+		sessionID := types.MetadataAddress(key)
+		if session, found := k.GetSession(ctx, sessionID); found {
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
+		} else {
+			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(sessionID))
+		}
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // Records returns records based on the provided request.
 func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.RecordsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Records")
@@ -540,6 +580,81 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordConsumers returns the records with an input referencing the given record (limited by pagination).
+func (k Keeper) RecordConsumers(c context.Context, req *types.RecordConsumersRequest) (*types.RecordConsumersResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordConsumers")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordConsumersResponse{Request: req}
+	if len(req.RecordAddr) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "empty record address")
+	}
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	consumerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordConsumerCacheIteratorPrefix(recordAddr))
+	pageRes, err := query.Paginate(consumerStore, getPageRequest(req), func(key, _ []byte) error {
+		consumerID := types.MetadataAddress(key)
+		if record, found := k.GetRecord(ctx, consumerID); found {
+			retval.Records = append(retval.Records, types.WrapRecord(&record))
+		} else {
+			retval.Records = append(retval.Records, types.WrapRecordNotFound(consumerID))
+		}
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
+// RecordLineage returns the records referenced by the inputs of the given record, recursively, up to the requested depth.
+func (k Keeper) RecordLineage(c context.Context, req *types.RecordLineageRequest) (*types.RecordLineageResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordLineage")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordLineageResponse{Request: req}
+	if len(req.RecordAddr) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "empty record address")
+	}
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+	depth := req.Depth
+	if depth == 0 {
+		depth = types.DefaultRecordLineageDepth
+	}
+	if depth > types.MaxRecordLineageDepth {
+		return &retval, status.Errorf(codes.InvalidArgument, "depth %d exceeds the maximum of %d", depth, types.MaxRecordLineageDepth)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetRecord(ctx, recordAddr); !found {
+		return &retval, status.Errorf(codes.NotFound, "record %s not found", recordAddr)
+	}
+	var sessionIDs []types.MetadataAddress
+	retval.Records, sessionIDs, retval.Truncated = k.GetRecordLineage(ctx, recordAddr, depth)
+	for _, sessionID := range sessionIDs {
+		if session, found := k.GetSession(ctx, sessionID); found {
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
+		} else {
+			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(sessionID))
+		}
+	}
+
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Ownership")
@@ -644,7 +759,7 @@ func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificat
 	ctx := sdk.UnwrapSDKContext(c)
 	spec, found := k.GetScopeSpecification(ctx, specAddr)
 	if found {
-		retval.ScopeSpecification = types.WrapScopeSpec(&spec)
+		retval.ScopeSpecification = k.wrapScopeSpec(ctx, &spec)
 	} else {
 		retval.ScopeSpecification = types.WrapScopeSpecNotFound(specAddr)
 	}
@@ -667,7 +782,7 @@ func (k Keeper) ScopeSpecificationsAll(c context.Context, req *types.ScopeSpecif
 		var scopeSpec types.ScopeSpecification
 		vErr := scopeSpec.Unmarshal(value)
 		if vErr == nil {
-			retval.ScopeSpecifications = append(retval.ScopeSpecifications, types.WrapScopeSpec(&scopeSpec))
+			retval.ScopeSpecifications = append(retval.ScopeSpecifications, k.wrapScopeSpec(ctx, &scopeSpec))
 			return nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
@@ -712,7 +827,7 @@ func (k Keeper) ContractSpecification(c context.Context, req *types.ContractSpec
 	ctx := sdk.UnwrapSDKContext(c)
 	spec, found := k.GetContractSpecification(ctx, specAddr)
 	if found {
-		retval.ContractSpecification = types.WrapContractSpec(&spec)
+		retval.ContractSpecification = k.wrapContractSpec(ctx, &spec)
 	} else {
 		retval.ContractSpecification = types.WrapContractSpecNotFound(specAddr)
 	}
@@ -744,7 +859,7 @@ func (k Keeper) ContractSpecificationsAll(c context.Context, req *types.Contract
 		var contractSpec types.ContractSpecification
 		vErr := contractSpec.Unmarshal(value)
 		if vErr == nil {
-			retval.ContractSpecifications = append(retval.ContractSpecifications, types.WrapContractSpec(&contractSpec))
+			retval.ContractSpecifications = append(retval.ContractSpecifications, k.wrapContractSpec(ctx, &contractSpec))
 			return nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
@@ -875,6 +990,59 @@ func (k Keeper) RecordSpecificationsAll(c context.Context, req *types.RecordSpec
 	return &retval, nil
 }
 
+// SpecificationBundle returns a contract specification along with its record specifications (and optionally the scope
+// specifications that reference it) as a single bundle.
+func (k Keeper) SpecificationBundle(c context.Context, req *types.SpecificationBundleRequest) (*types.SpecificationBundleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "SpecificationBundle")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.SpecificationBundleResponse{Request: req}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+
+	specAddr, addrErr := ParseContractSpecID(req.SpecificationId)
+	if addrErr != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid specification id: %s", addrErr.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	spec, found := k.GetContractSpecification(ctx, specAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "contract specification not found with id %s", specAddr)
+	}
+
+	recSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, specAddr)
+	if err != nil {
+		return &retval, status.Errorf(codes.Unavailable, "error getting record specifications for contract spec %s: %s",
+			specAddr, err.Error())
+	}
+	recordSpecs := make([]types.RecordSpecification, len(recSpecs))
+	for i, recSpec := range recSpecs {
+		recordSpecs[i] = *recSpec
+	}
+
+	var scopeSpecs []types.ScopeSpecification
+	if req.IncludeScopeSpecs {
+		itErr := k.IterateScopeSpecsForContractSpec(ctx, specAddr, func(scopeSpecID types.MetadataAddress) (stop bool) {
+			if scopeSpec, found := k.GetScopeSpecification(ctx, scopeSpecID); found {
+				scopeSpecs = append(scopeSpecs, scopeSpec)
+			}
+			return false
+		})
+		if itErr != nil {
+			return &retval, status.Errorf(codes.Unavailable, "error getting scope specifications for contract spec %s: %s",
+				specAddr, itErr.Error())
+		}
+	}
+
+	retval.Bundle = types.NewSpecificationBundle(spec, recordSpecs, scopeSpecs)
+	return &retval, nil
+}
+
 func (k Keeper) OSLocatorParams(c context.Context, request *types.OSLocatorParamsRequest) (*types.OSLocatorParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorParams")
 	ctx := sdk.UnwrapSDKContext(c)
@@ -907,6 +1075,38 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 	return &retval, nil
 }
 
+func (k Keeper) OSLocatorByName(c context.Context, request *types.OSLocatorByNameRequest) (*types.OSLocatorByNameResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorByName")
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.OSLocatorByNameResponse{Request: request}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid name %s: %s", request.Name, err)
+	}
+	record, err := k.nameKeeper.GetRecordByName(ctx, name)
+	if err != nil || record == nil {
+		return &retval, status.Errorf(codes.NotFound, "name %s is not bound to an address", name)
+	}
+	retval.Owner = record.Address
+
+	accAddr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return &retval, types.ErrInvalidAddress
+	}
+	locator, exists := k.GetOsLocatorRecord(ctx, accAddr)
+	if !exists {
+		return &retval, types.ErrAddressNotBound
+	}
+	retval.Locator = &locator
+
+	return &retval, nil
+}
+
 func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsByURIRequest) (*types.OSLocatorsByURIResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorsByURI")
 	if request == nil {
@@ -927,11 +1127,12 @@ func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsBy
 	if err != nil {
 		return &retval, err
 	}
+	include := locatorHealthFilter(ctxSDK, request.HealthyOnly, request.MaxReportAgeSeconds)
 	// Return value data structure.
 	var records []types.ObjectStoreLocator
 	// Handler that adds records if account address matches.
 	appendToRecords := func(record types.ObjectStoreLocator) bool {
-		if record.LocatorUri == uri.String() {
+		if record.LocatorUri == uri.String() && include(record) {
 			records = append(records, record)
 			// have to get all the uri associated with an address..imo..check
 		}
@@ -991,7 +1192,52 @@ func (k Keeper) OSLocatorsByScope(ctx context.Context, request *types.OSLocators
 	if err != nil {
 		return &retval, status.Error(codes.InvalidArgument, err.Error())
 	}
-	retval.Locators = locators
+	include := locatorHealthFilter(ctxSDK, request.HealthyOnly, request.MaxReportAgeSeconds)
+	retval.Locators = make([]types.ObjectStoreLocator, 0, len(locators))
+	for _, locator := range locators {
+		if include(locator) {
+			retval.Locators = append(retval.Locators, locator)
+		}
+	}
+
+	return &retval, nil
+}
+
+func (k Keeper) OSLocatorsByContractSpec(ctx context.Context, request *types.OSLocatorsByContractSpecRequest) (*types.OSLocatorsByContractSpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorsByContractSpec")
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.OSLocatorsByContractSpecResponse{Request: request}
+
+	if len(request.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+	specAddr, addrErr := ParseContractSpecID(request.SpecificationId)
+	if addrErr != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid specification id: %s", addrErr.Error())
+	}
+
+	ctxSDK := sdk.UnwrapSDKContext(ctx)
+	spec, found := k.GetContractSpecification(ctxSDK, specAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "contract specification %s not found", specAddr)
+	}
+	retval.SourceHash = spec.GetHash()
+	if len(retval.SourceHash) == 0 {
+		return &retval, status.Errorf(codes.InvalidArgument, "contract specification %s does not have a source hash", specAddr)
+	}
+
+	include := locatorHealthFilter(ctxSDK, request.HealthyOnly, request.MaxReportAgeSeconds)
+	retval.Locators = []types.ObjectStoreLocator{}
+	for _, owner := range k.GetContractSpecSourceLocatorOwners(ctxSDK, specAddr) {
+		// the locator may have been removed by its owner since it was added to the contract spec
+		locator, found := k.GetOsLocatorRecord(ctxSDK, owner)
+		if found && include(locator) {
+			retval.Locators = append(retval.Locators, locator)
+		}
+	}
 
 	return &retval, nil
 }
@@ -1006,11 +1252,14 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 
 	ctxSDK := sdk.UnwrapSDKContext(ctx)
 
+	include := locatorHealthFilter(ctxSDK, request.HealthyOnly, request.MaxReportAgeSeconds)
 	// Return value data structure.
 	var records []types.ObjectStoreLocator
 	// Handler that adds records if account address matches.
 	appendToRecords := func(record types.ObjectStoreLocator) bool {
-		records = append(records, record)
+		if include(record) {
+			records = append(records, record)
+		}
 		// have to get all the uri associated with an address..imo..check
 		return false
 	}
@@ -1223,3 +1472,64 @@ func getPageRequest(req hasPageRequest) *query.PageRequest {
 	}
 	return pageRequest
 }
+
+// locatorHealthFilter returns a function that indicates whether a locator passes the health filter of a request.
+func locatorHealthFilter(ctx sdk.Context, healthyOnly bool, maxReportAgeSeconds uint64) func(types.ObjectStoreLocator) bool {
+	if !healthyOnly {
+		return func(types.ObjectStoreLocator) bool { return true }
+	}
+	maxAge := time.Duration(maxReportAgeSeconds) * time.Second
+	return func(locator types.ObjectStoreLocator) bool {
+		return locator.IsHealthy(ctx.BlockTime(), maxAge)
+	}
+}
+
+// Invariants runs all of the metadata module invariants and returns their results.
+func (k Keeper) Invariants(c context.Context, req *types.InvariantsRequest) (*types.InvariantsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Invariants")
+	ctx := sdk.UnwrapSDKContext(c)
+
+	retval := types.InvariantsResponse{Request: req}
+	retval.Invariants = k.CheckInvariants(ctx)
+	for _, result := range retval.Invariants {
+		retval.Broken = retval.Broken || result.Broken
+	}
+
+	return &retval, nil
+}
+
+// RecordConformance checks each record of a scope against its record specification and returns the results.
+func (k Keeper) RecordConformance(c context.Context, req *types.RecordConformanceRequest) (*types.RecordConformanceResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordConformance")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordConformanceResponse{Request: req}
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+	retval.ScopeIdInfo = types.GetScopeIDInfo(scopeAddr)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetScope(ctx, scopeAddr); !found {
+		return &retval, status.Errorf(codes.NotFound, "scope %s not found", scopeAddr)
+	}
+
+	retval.Conforms = true
+	err = k.IterateRecords(ctx, scopeAddr, func(r types.Record) (stop bool) {
+		result := k.CheckRecordConformance(ctx, r)
+		retval.Records = append(retval.Records, result)
+		retval.Conforms = retval.Conforms && result.Conforms
+		return false
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &retval, nil
+}
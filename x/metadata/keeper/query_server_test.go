@@ -152,6 +152,23 @@ func (s *QueryServerTestSuite) TestScopeQuery() {
 	s.Equal(1, len(scopeResponse.Sessions), "session count")
 	s.Equal(session0Name, scopeResponse.Sessions[0].Session.Name, "session name")
 
+	maskedReq0 := fullReq0
+	maskedReq0.FieldMask = []string{"scope.scope.owners", "sessions.session.name"}
+	maskedResponse, err := queryClient.Scope(gocontext.Background(), &maskedReq0)
+	s.NoError(err, "field mask request error")
+	s.Equal(ownerPartyList(user1), maskedResponse.Scope.Scope.Owners, "field mask scope owners")
+	s.Empty(maskedResponse.Scope.Scope.ScopeId, "field mask scope id")
+	s.Nil(maskedResponse.Scope.ScopeIdInfo, "field mask scope id info")
+	s.Equal(1, len(maskedResponse.Sessions), "field mask session count")
+	s.Equal(session0Name, maskedResponse.Sessions[0].Session.Name, "field mask session name")
+	s.Empty(maskedResponse.Sessions[0].Session.SessionId, "field mask session id")
+	s.Empty(maskedResponse.Records, "field mask records")
+	s.Equal(maskedReq0.FieldMask, maskedResponse.Request.FieldMask, "field mask request")
+
+	maskedReq0.FieldMask = []string{"scope.owners"}
+	_, err = queryClient.Scope(gocontext.Background(), &maskedReq0)
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid field mask: unknown field scope.owners", "invalid field mask error")
+
 	// only one scope has value owner set (user2)
 	valueResponse, err := queryClient.ValueOwnership(gocontext.Background(), &types.ValueOwnershipRequest{Address: user2})
 	s.NoError(err)
@@ -770,6 +787,25 @@ func (s *QueryServerTestSuite) TestSessionsQuery() {
 		assert.Equal(t, s.sessionID, sr.Records[0].Record.SessionId, "session id")
 		assert.Equal(t, s.recordName, sr.Records[0].Record.Name, "record name")
 	})
+	s.T().Run("field mask", func(t *testing.T) {
+		req := types.SessionsRequest{SessionId: s.sessionID.String(), IncludeScope: true, IncludeRecords: true,
+			FieldMask: []string{"sessions.session.specification_id", "scope.scope.owners"}}
+		sr, err := queryClient.Sessions(gocontext.Background(), &req)
+		require.NoErrorf(t, err, "unexpected error: %s", err)
+		require.NotNil(t, sr, "result of Sessions query")
+		require.Equal(t, 1, len(sr.Sessions), "number of sessions")
+		assert.Equal(t, s.cSpecID, sr.Sessions[0].Session.SpecificationId, "session specification id")
+		assert.Empty(t, sr.Sessions[0].Session.Name, "session name")
+		assert.Nil(t, sr.Sessions[0].SessionIdInfo, "session id info")
+		require.NotNil(t, sr.Scope, "scope wrapper")
+		assert.Equal(t, ownerPartyList(s.user1), sr.Scope.Scope.Owners, "scope owners")
+		assert.Empty(t, sr.Records, "records not in the field mask")
+	})
+	s.T().Run("field mask unknown field", func(t *testing.T) {
+		req := types.SessionsRequest{SessionId: s.sessionID.String(), FieldMask: []string{"sessions.name"}}
+		_, err := queryClient.Sessions(gocontext.Background(), &req)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid field mask: unknown field sessions.name")
+	})
 }
 
 // TODO: SessionsAll tests
@@ -823,6 +859,99 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 	s.Equal(recordNames[0], rsID.Records[0].Record.Name)
 }
 
+func (s *QueryServerTestSuite) TestRecordConformanceQuery() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, ""))
+	app.MetadataKeeper.SetSession(ctx, *types.NewSession(s.sessionName, s.sessionID, s.cSpecID, ownerPartyList(s.user1), nil))
+	app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(s.recSpecID, s.recordName,
+		[]*types.InputSpecification{types.NewInputSpecification("in", "string", types.NewInputSpecificationSourceHash("inhash"))},
+		"string", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}))
+	otherSpecID := types.RecordSpecMetadataAddress(s.cSpecUUID, "other")
+	app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(otherSpecID, "other",
+		[]*types.InputSpecification{types.NewInputSpecification("in", "string", types.NewInputSpecificationSourceHash("inhash"))},
+		"string", types.DefinitionType_DEFINITION_TYPE_RECORD_LIST, []types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE}))
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	hashInput := *types.NewRecordInput("in", &types.RecordInput_Hash{Hash: "inhash"}, "string", types.RecordInputStatus_Proposed)
+	output := *types.NewRecordOutput("outhash", types.ResultStatus_RESULT_STATUS_PASS)
+	app.MetadataKeeper.SetRecord(ctx, *types.NewRecord(s.recordName, s.sessionID, *process,
+		[]types.RecordInput{hashInput}, []types.RecordOutput{output}, s.recSpecID))
+	app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("other", s.sessionID, *process,
+		[]types.RecordInput{
+			*types.NewRecordInput("in", &types.RecordInput_Hash{Hash: "inhash"}, "int", types.RecordInputStatus_Proposed),
+			*types.NewRecordInput("extra", &types.RecordInput_Hash{Hash: "extrahash"}, "string", types.RecordInputStatus_Proposed),
+		}, []types.RecordOutput{}, types.MetadataAddress{}))
+
+	_, err := queryClient.RecordConformance(gocontext.Background(), &types.RecordConformanceRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = empty scope id")
+
+	missingScope := types.ScopeMetadataAddress(uuid.New())
+	_, err = queryClient.RecordConformance(gocontext.Background(), &types.RecordConformanceRequest{ScopeId: missingScope.String()})
+	s.EqualError(err, fmt.Sprintf("rpc error: code = NotFound desc = scope %s not found", missingScope))
+
+	res, err := queryClient.RecordConformance(gocontext.Background(), &types.RecordConformanceRequest{ScopeId: s.scopeUUID.String()})
+	s.Require().NoError(err)
+	s.Equal(s.scopeID.String(), res.ScopeIdInfo.ScopeAddr)
+	s.False(res.Conforms)
+	s.Require().Len(res.Records, 2)
+	for _, record := range res.Records {
+		switch record.Name {
+		case s.recordName:
+			s.Equal(types.RecordConformance{
+				RecordAddr:      s.recordID.String(),
+				Name:            s.recordName,
+				SpecificationId: s.recSpecID.String(),
+				Conforms:        true,
+			}, record)
+		case "other":
+			s.Equal(otherSpecID.String(), record.SpecificationId, "spec id from the session contract spec")
+			s.False(record.Conforms)
+			s.Equal([]string{
+				"input in has TypeName int but spec calls for string",
+				"extra input extra",
+				"invalid output count (expected > 0, got: 0)",
+				"missing required party type [PARTY_TYPE_AFFILIATE] from parties",
+			}, record.Problems)
+		default:
+			s.Fail("unexpected record", record.Name)
+		}
+	}
+
+	// Fixing the record makes the whole scope conform.
+	app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("other", s.sessionID, *process,
+		[]types.RecordInput{hashInput}, []types.RecordOutput{output}, otherSpecID))
+	app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(otherSpecID, "other",
+		[]*types.InputSpecification{types.NewInputSpecification("in", "string", types.NewInputSpecificationSourceHash("inhash"))},
+		"string", types.DefinitionType_DEFINITION_TYPE_RECORD_LIST, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}))
+	res, err = queryClient.RecordConformance(gocontext.Background(), &types.RecordConformanceRequest{ScopeId: s.scopeID.String()})
+	s.Require().NoError(err)
+	s.True(res.Conforms)
+}
+
+func (s *QueryServerTestSuite) TestOSLocatorByNameQuery() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	s.Require().NoError(app.NameKeeper.SetNameRecord(ctx, "locator", s.user1Addr, false))
+	s.Require().NoError(app.NameKeeper.SetNameRecord(ctx, "nolocator", s.user2Addr, false))
+	s.Require().NoError(app.MetadataKeeper.SetOSLocator(ctx, s.user1Addr, sdk.AccAddress{}, "https://example.com/objects"))
+
+	res, err := queryClient.OSLocatorByName(gocontext.Background(), &types.OSLocatorByNameRequest{Name: "Locator"})
+	s.Require().NoError(err)
+	s.Equal(s.user1, res.Owner)
+	s.Require().NotNil(res.Locator)
+	s.Equal("https://example.com/objects", res.Locator.LocatorUri)
+
+	_, err = queryClient.OSLocatorByName(gocontext.Background(), &types.OSLocatorByNameRequest{Name: "nolocator"})
+	s.Require().EqualError(err, "no locator bound to address")
+
+	_, err = queryClient.OSLocatorByName(gocontext.Background(), &types.OSLocatorByNameRequest{Name: "unbound"})
+	s.Require().EqualError(err, "rpc error: code = NotFound desc = name unbound is not bound to an address")
+
+	_, err = queryClient.OSLocatorByName(gocontext.Background(), &types.OSLocatorByNameRequest{Name: "a..b"})
+	s.Require().Error(err)
+}
+
 // TODO: RecordsAll tests
 // TODO: Ownership tests
 // TODO: ValueOwnership tests
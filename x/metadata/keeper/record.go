@@ -63,12 +63,15 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
-	if store.Has(recordID) {
+	if existing, found := k.GetRecord(ctx, recordID); found {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		k.clearRecordInputIndex(ctx, existing)
 	}
 
+	k.updateScopeSize(ctx, recordID, store.Get(recordID), b)
 	store.Set(recordID, b)
+	k.indexRecordInputs(ctx, record)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
@@ -83,7 +86,9 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 	store := ctx.KVStore(k.storeKey)
+	k.updateScopeSize(ctx, id, store.Get(id), nil)
 	store.Delete(id)
+	k.clearRecordInputIndex(ctx, record)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
 
@@ -91,6 +96,109 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	k.RemoveSession(ctx, record.SessionId)
 }
 
+// indexRecordInputs creates a cache entry for each record referenced by an input of the given record.
+func (k Keeper) indexRecordInputs(ctx sdk.Context, record types.Record) {
+	store := ctx.KVStore(k.storeKey)
+	recordID := record.GetRecordAddress()
+	for _, input := range record.Inputs {
+		if source, ok := input.Source.(*types.RecordInput_RecordId); ok && source.RecordId.IsRecordAddress() {
+			store.Set(types.GetRecordConsumerCacheKey(source.RecordId, recordID), []byte{0x01})
+		}
+	}
+}
+
+// clearRecordInputIndex deletes the cache entries for each record referenced by an input of the given record.
+func (k Keeper) clearRecordInputIndex(ctx sdk.Context, record types.Record) {
+	store := ctx.KVStore(k.storeKey)
+	recordID := record.GetRecordAddress()
+	for _, input := range record.Inputs {
+		if source, ok := input.Source.(*types.RecordInput_RecordId); ok && source.RecordId.IsRecordAddress() {
+			store.Delete(types.GetRecordConsumerCacheKey(source.RecordId, recordID))
+		}
+	}
+}
+
+// GetRecordConsumers returns the ids of the records with an input referencing the given record.
+func (k Keeper) GetRecordConsumers(ctx sdk.Context, recordID types.MetadataAddress) []types.MetadataAddress {
+	prefix := types.GetRecordConsumerCacheIteratorPrefix(recordID)
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+
+	var consumers []types.MetadataAddress
+	for ; it.Valid(); it.Next() {
+		consumers = append(consumers, types.MetadataAddress(it.Key()[len(prefix):]))
+	}
+	return consumers
+}
+
+// GetRecordLineage walks the inputs of the given record that reference other records, breadth-first, following at
+// most depth references.  Each record is returned once, with the ids of the sessions the records were written in.
+// The returned truncated flag is true if a record at the given depth references records that are not returned.
+func (k Keeper) GetRecordLineage(
+	ctx sdk.Context,
+	recordID types.MetadataAddress,
+	depth uint32,
+) (nodes []types.RecordLineageNode, sessionIDs []types.MetadataAddress, truncated bool) {
+	type queued struct {
+		id    types.MetadataAddress
+		depth uint32
+	}
+	seen := map[string]bool{recordID.String(): true}
+	seenSessions := make(map[string]bool)
+	queue := []queued{{recordID, 0}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		record, found := k.GetRecord(ctx, cur.id)
+		if !found {
+			nodes = append(nodes, types.RecordLineageNode{Record: types.WrapRecordNotFound(cur.id), Depth: cur.depth})
+			continue
+		}
+		node := types.RecordLineageNode{Record: types.WrapRecord(&record), Depth: cur.depth}
+		if !seenSessions[record.SessionId.String()] {
+			seenSessions[record.SessionId.String()] = true
+			sessionIDs = append(sessionIDs, record.SessionId)
+		}
+		for _, input := range record.Inputs {
+			source, ok := input.Source.(*types.RecordInput_RecordId)
+			if !ok || !source.RecordId.IsRecordAddress() {
+				continue
+			}
+			node.SourceRecordAddrs = append(node.SourceRecordAddrs, source.RecordId.String())
+			if seen[source.RecordId.String()] {
+				continue
+			}
+			if cur.depth >= depth {
+				truncated = true
+				continue
+			}
+			seen[source.RecordId.String()] = true
+			queue = append(queue, queued{source.RecordId, cur.depth + 1})
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, sessionIDs, truncated
+}
+
+// RebuildRecordConsumerIndex replaces the cache of the records referenced by record inputs with one built from the
+// stored records.
+func (k Keeper) RebuildRecordConsumerIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RecordConsumerCacheKeyPrefix)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) (stop bool) {
+		k.indexRecordInputs(ctx, record)
+		return false
+	})
+}
+
 // IterateRecords processes stored records with the given handler.
 // If the scopeID is an empty MetadataAddress, all records will be processed.
 // Otherwise, just the records for the given scopeID will be processed.
@@ -132,6 +240,9 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 
 	if existing != nil {
+		if existing.IsRedacted() {
+			return fmt.Errorf("record %s has been redacted and cannot be updated", existing.GetRecordAddress())
+		}
 		if existing.Name != proposed.Name {
 			return fmt.Errorf("the Name field of records cannot be changed")
 		}
@@ -166,9 +277,13 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 
 	// Make sure the scope exists.
-	if _, found := k.GetScope(ctx, scopeID); !found {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
 		return fmt.Errorf("scope not found with id %s", scopeID)
 	}
+	if k.IsScopeArchived(ctx, scopeID) {
+		return fmt.Errorf("scope %s is archived and must be restored before its records can be written", scopeID)
+	}
 
 	// Get the session.
 	session, found := k.GetSession(ctx, proposed.SessionId)
@@ -181,6 +296,20 @@ func (k Keeper) ValidateRecordUpdate(
 		return signErr
 	}
 
+	// Setting or changing the record's data access is up to the scope owners.
+	var existingDataAccess []string
+	if existing != nil {
+		existingDataAccess = existing.DataAccess
+	}
+	if len(FindMissing(proposed.DataAccess, existingDataAccess)) > 0 || len(FindMissing(existingDataAccess, proposed.DataAccess)) > 0 {
+		if err := validateDataAccessInScope(scope, proposed.DataAccess); err != nil {
+			return err
+		}
+		if err := k.ValidateAllPartiesAreSigners(scope.Owners, signers); err != nil {
+			return fmt.Errorf("missing signer for record data access: %w", err)
+		}
+	}
+
 	// Get the record specification
 	contractSpecUUID, cSpecUUIDErr := session.SpecificationId.ContractSpecUUID()
 	if cSpecUUIDErr != nil {
@@ -256,9 +385,13 @@ func (k Keeper) ValidateRecordUpdate(
 		inputSourceValue := ""
 		switch source := input.Source.(type) {
 		case *types.RecordInput_RecordId:
-			if _, found := k.GetRecord(ctx, source.RecordId); !found {
+			sourceRecord, found := k.GetRecord(ctx, source.RecordId)
+			if !found {
 				return fmt.Errorf("input %s source record id %s not found", input.Name, source.RecordId)
 			}
+			if err := k.validateSourceRecordAccess(ctx, scopeID, sourceRecord, signers); err != nil {
+				return fmt.Errorf("input %s: %w", input.Name, err)
+			}
 			inputSourceType = sourceTypeRecord
 			inputSourceValue = source.RecordId.String()
 		case *types.RecordInput_Hash:
@@ -279,6 +412,14 @@ func (k Keeper) ValidateRecordUpdate(
 		}
 	}
 
+	// Scopes with a strict specification also hold the hash inputs and the outputs to the record specification.
+	scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+	if found && scopeSpec.Strict {
+		if problems := strictRecordProblems(*proposed, recSpec); len(problems) > 0 {
+			return fmt.Errorf("scope specification %s is strict: %s", scopeSpec.SpecificationId, problems[0])
+		}
+	}
+
 	// Validate the output count
 	switch recSpec.ResultType {
 	case types.DefinitionType_DEFINITION_TYPE_RECORD:
@@ -296,6 +437,36 @@ func (k Keeper) ValidateRecordUpdate(
 	return nil
 }
 
+// strictRecordProblems returns the ways in which a record does not conform to its record specification that are only
+// enforced for scopes with a strict scope specification.
+func strictRecordProblems(record types.Record, recSpec types.RecordSpecification) []string {
+	var problems []string
+	for _, inputSpec := range recSpec.Inputs {
+		specSource, ok := inputSpec.Source.(*types.InputSpecification_Hash)
+		if !ok {
+			continue
+		}
+		for _, input := range record.Inputs {
+			if input.Name != inputSpec.Name {
+				continue
+			}
+			if source, ok := input.Source.(*types.RecordInput_Hash); ok && source.Hash != specSource.Hash {
+				problems = append(problems, fmt.Sprintf("input %s has source value %s but spec calls for %s",
+					input.Name, source.Hash, specSource.Hash))
+			}
+		}
+	}
+	switch recSpec.ResultType {
+	case types.DefinitionType_DEFINITION_TYPE_PROPOSED:
+		if len(record.Outputs) != 1 {
+			problems = append(problems, fmt.Sprintf("invalid output count (expected: 1, got: %d)", len(record.Outputs)))
+		}
+	case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED:
+		problems = append(problems, fmt.Sprintf("record specification %s has an unspecified result type", recSpec.SpecificationId))
+	}
+	return problems
+}
+
 // ValidateRecordRemove checks the current record and the proposed removal scope to determine if the the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateRecordRemove(ctx sdk.Context, existing types.Record, proposedID types.MetadataAddress, signers []string) error {
@@ -319,3 +490,131 @@ func (k Keeper) ValidateRecordRemove(ctx sdk.Context, existing types.Record, pro
 
 	return nil
 }
+
+// ValidateRecordRedact checks that the record has not already been redacted and that all the scope owners, and the
+// value owner of the scope (or a signer with withdraw authority when it is a marker, or the holder of the coin
+// representing value ownership), are signers.
+func (k Keeper) ValidateRecordRedact(ctx sdk.Context, existing types.Record, signers []string) error {
+	if existing.IsRedacted() {
+		return fmt.Errorf("record %s has already been redacted", existing.GetRecordAddress())
+	}
+	scope, err := k.getRecordScope(ctx, existing)
+	if err != nil {
+		return err
+	}
+	return k.validateScopeOwnersAndValueOwnerAreSigners(ctx, scope, signers)
+}
+
+// ValidateRecordAddDataAccess checks the current record data access and the proposed added data access
+func (k Keeper) ValidateRecordAddDataAccess(ctx sdk.Context, dataAccessAddrs []string, existing types.Record, signers []string) error {
+	if len(dataAccessAddrs) < 1 {
+		return fmt.Errorf("data access list cannot be empty")
+	}
+	scope, err := k.getRecordScope(ctx, existing)
+	if err != nil {
+		return err
+	}
+
+	for _, da := range dataAccessAddrs {
+		for _, pda := range existing.DataAccess {
+			if da == pda {
+				return fmt.Errorf("address already exists for data access %s", pda)
+			}
+		}
+	}
+	if err = validateDataAccessInScope(scope, dataAccessAddrs); err != nil {
+		return err
+	}
+
+	return k.ValidateAllPartiesAreSigners(scope.Owners, signers)
+}
+
+// ValidateRecordDeleteDataAccess checks the current record data access and the proposed removed items
+func (k Keeper) ValidateRecordDeleteDataAccess(ctx sdk.Context, dataAccessAddrs []string, existing types.Record, signers []string) error {
+	if len(dataAccessAddrs) < 1 {
+		return fmt.Errorf("data access list cannot be empty")
+	}
+	scope, err := k.getRecordScope(ctx, existing)
+	if err != nil {
+		return err
+	}
+
+	for _, da := range dataAccessAddrs {
+		found := false
+		for _, pda := range existing.DataAccess {
+			if da == pda {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("address does not exist in record data access: %s", da)
+		}
+	}
+
+	return k.ValidateAllPartiesAreSigners(scope.Owners, signers)
+}
+
+// getRecordScope returns the scope that contains the record.
+func (k Keeper) getRecordScope(ctx sdk.Context, record types.Record) (types.Scope, error) {
+	scopeID, err := record.SessionId.AsScopeAddress()
+	if err != nil {
+		return types.Scope{}, err
+	}
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return types.Scope{}, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	return scope, nil
+}
+
+// validateSourceRecordAccess makes sure that a source record from a scope other than the one being written to can be
+// read by one of the signers, i.e. one of them is an owner or has data access on the scope of the source record.
+func (k Keeper) validateSourceRecordAccess(ctx sdk.Context, scopeID types.MetadataAddress, sourceRecord types.Record, signers []string) error {
+	sourceScopeID, err := sourceRecord.SessionId.AsScopeAddress()
+	if err != nil {
+		return err
+	}
+	if sourceScopeID.Equals(scopeID) {
+		return nil
+	}
+	sourceScope, found := k.GetScope(ctx, sourceScopeID)
+	if !found {
+		return fmt.Errorf("scope %s of source record %s not found", sourceScopeID, sourceRecord.GetRecordAddress())
+	}
+	allowed := make(map[string]bool)
+	for _, owner := range sourceScope.Owners {
+		allowed[owner.Address] = true
+	}
+	for _, da := range sourceScope.DataAccess {
+		allowed[da] = true
+	}
+	for _, signer := range signers {
+		if allowed[signer] {
+			return nil
+		}
+	}
+	return fmt.Errorf("no signer is an owner or has data access on scope %s of source record %s",
+		sourceScopeID, sourceRecord.GetRecordAddress())
+}
+
+// validateDataAccessInScope makes sure each of the record data access addresses is an owner or has data access on the
+// scope so that a record is never more accessible than the scope that contains it.
+func validateDataAccessInScope(scope types.Scope, dataAccessAddrs []string) error {
+	allowed := make(map[string]bool)
+	for _, owner := range scope.Owners {
+		allowed[owner.Address] = true
+	}
+	for _, da := range scope.DataAccess {
+		allowed[da] = true
+	}
+	for _, da := range dataAccessAddrs {
+		if _, err := sdk.AccAddressFromBech32(da); err != nil {
+			return fmt.Errorf("failed to decode data access address %s : %v", da, err.Error())
+		}
+		if !allowed[da] {
+			return fmt.Errorf("address %s must be an owner or have data access on scope %s", da, scope.ScopeId)
+		}
+	}
+	return nil
+}
@@ -578,3 +578,198 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateRecordUpdateStrict() {
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{s.contractSpecID})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	session := types.NewSession(s.sessionName, s.sessionID, s.contractSpecID, ownerPartyList(s.user1), nil)
+	s.app.MetadataKeeper.SetSession(s.ctx, *session)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+	inputSpec := types.NewInputSpecification("TestInput", "TestInputType", types.NewInputSpecificationSourceHash("inputspecsourcehash"))
+	recordSpec := types.NewRecordSpecification(s.recordSpecID, s.recordName, []*types.InputSpecification{inputSpec},
+		"TestRecordTypeName", types.DefinitionType_DEFINITION_TYPE_PROPOSED, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recordSpec)
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	newRecord := func(inputHash string, outputs int) *types.Record {
+		input := types.NewRecordInput(inputSpec.Name, &types.RecordInput_Hash{Hash: inputHash}, inputSpec.TypeName, types.RecordInputStatus_Proposed)
+		recordOutputs := make([]types.RecordOutput, outputs)
+		for i := range recordOutputs {
+			recordOutputs[i] = types.RecordOutput{Hash: fmt.Sprintf("output%d", i), Status: types.ResultStatus_RESULT_STATUS_PASS}
+		}
+		return types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{*input}, recordOutputs, s.recordSpecID)
+	}
+
+	cases := []struct {
+		name       string
+		strict     bool
+		resultType types.DefinitionType
+		record     *types.Record
+		errorMsg   string
+	}{
+		{"lenient - other hash", false, types.DefinitionType_DEFINITION_TYPE_PROPOSED, newRecord("otherhash", 1), ""},
+		{"lenient - two proposed outputs", false, types.DefinitionType_DEFINITION_TYPE_PROPOSED, newRecord("inputspecsourcehash", 2), ""},
+		{"lenient - unspecified result type", false, types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED, newRecord("inputspecsourcehash", 0), ""},
+		{"strict - valid", true, types.DefinitionType_DEFINITION_TYPE_PROPOSED, newRecord("inputspecsourcehash", 1), ""},
+		{
+			"strict - other hash", true, types.DefinitionType_DEFINITION_TYPE_PROPOSED, newRecord("otherhash", 1),
+			fmt.Sprintf("scope specification %s is strict: input TestInput has source value otherhash but spec calls for inputspecsourcehash", s.scopeSpecID),
+		},
+		{
+			"strict - two proposed outputs", true, types.DefinitionType_DEFINITION_TYPE_PROPOSED, newRecord("inputspecsourcehash", 2),
+			fmt.Sprintf("scope specification %s is strict: invalid output count (expected: 1, got: 2)", s.scopeSpecID),
+		},
+		{
+			"strict - unspecified result type", true, types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED, newRecord("inputspecsourcehash", 0),
+			fmt.Sprintf("scope specification %s is strict: record specification %s has an unspecified result type", s.scopeSpecID, s.recordSpecID),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			scopeSpec.Strict = tc.strict
+			s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+			recordSpec.ResultType = tc.resultType
+			s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recordSpec)
+
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, tc.record, []string{s.user1}, ownerPartyList(s.user1))
+			conformance := s.app.MetadataKeeper.CheckRecordConformance(s.ctx, *tc.record)
+			if len(tc.errorMsg) != 0 {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate expected error")
+				assert.False(t, conformance.Conforms, "CheckRecordConformance conforms")
+			} else {
+				assert.NoError(t, err, "ValidateRecordUpdate unexpected error")
+				assert.True(t, conformance.Conforms, "CheckRecordConformance conforms: %v", conformance.Problems)
+			}
+		})
+	}
+
+	scopeSpec.Strict = true
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	s.Require().NoError(s.app.MetadataKeeper.SetScopeSpecificationsLenient(s.ctx))
+	migrated, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, s.scopeSpecID)
+	s.Require().True(found)
+	s.Assert().False(migrated.Strict, "strict after SetScopeSpecificationsLenient")
+}
+
+func (s *RecordKeeperTestSuite) TestRecordInputFromAnotherScope() {
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := []types.RecordOutput{{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+
+	// The source record is in a scope owned by user2.
+	sourceScopeUUID := uuid.New()
+	sourceScopeID := types.ScopeMetadataAddress(sourceScopeUUID)
+	sourceScope := types.NewScope(sourceScopeID, s.scopeSpecID, ownerPartyList(s.user2), []string{}, s.user2)
+	s.app.MetadataKeeper.SetScope(s.ctx, *sourceScope)
+	sourceSessionID := types.SessionMetadataAddress(sourceScopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, sourceSessionID, s.contractSpecID, ownerPartyList(s.user2), nil))
+	sourceRecord := types.NewRecord("source", sourceSessionID, *process, []types.RecordInput{}, outputs,
+		types.RecordSpecMetadataAddress(s.contractSpecUUID, "source"))
+	s.app.MetadataKeeper.SetRecord(s.ctx, *sourceRecord)
+	sourceRecordID := sourceRecord.GetRecordAddress()
+
+	// The consuming record is in a scope owned by user1.
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, s.sessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+	inputSpec := types.NewInputSpecification("source", "TestInputType", types.NewInputSpecificationSourceRecordID(sourceRecordID))
+	recordSpec := types.NewRecordSpecification(s.recordSpecID, s.recordName, []*types.InputSpecification{inputSpec},
+		"TestRecordTypeName", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recordSpec)
+	input := types.NewRecordInput(inputSpec.Name, &types.RecordInput_RecordId{RecordId: sourceRecordID}, inputSpec.TypeName, types.RecordInputStatus_Record)
+	record := types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{*input}, outputs, s.recordSpecID)
+
+	err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, record, []string{s.user1}, ownerPartyList(s.user1))
+	s.EqualError(err, fmt.Sprintf("input source: no signer is an owner or has data access on scope %s of source record %s",
+		sourceScopeID, sourceRecordID), "signer without access to the source scope")
+
+	sourceScope.DataAccess = []string{s.user1}
+	s.app.MetadataKeeper.SetScope(s.ctx, *sourceScope)
+	err = s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, record, []string{s.user1}, ownerPartyList(s.user1))
+	s.NoError(err, "signer with data access on the source scope")
+
+	s.Empty(s.app.MetadataKeeper.GetRecordConsumers(s.ctx, sourceRecordID))
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+	s.Equal([]types.MetadataAddress{s.recordID}, s.app.MetadataKeeper.GetRecordConsumers(s.ctx, sourceRecordID))
+
+	res, err := s.queryClient.RecordConsumers(s.ctx.Context(), &types.RecordConsumersRequest{RecordAddr: sourceRecordID.String()})
+	s.NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Equal(s.recordID.String(), res.Records[0].RecordIdInfo.RecordAddr)
+	_, err = s.queryClient.RecordConsumers(s.ctx.Context(), &types.RecordConsumersRequest{RecordAddr: sourceScopeID.String()})
+	s.Error(err, "scope address")
+
+	s.Require().NoError(s.app.MetadataKeeper.RebuildRecordConsumerIndex(s.ctx))
+	s.Equal([]types.MetadataAddress{s.recordID}, s.app.MetadataKeeper.GetRecordConsumers(s.ctx, sourceRecordID), "rebuilt index")
+
+	updated := *record
+	updated.Inputs = []types.RecordInput{{Name: input.Name, Source: &types.RecordInput_Hash{Hash: "inputhash"}, TypeName: input.TypeName, Status: types.RecordInputStatus_Proposed}}
+	s.app.MetadataKeeper.SetRecord(s.ctx, updated)
+	s.Empty(s.app.MetadataKeeper.GetRecordConsumers(s.ctx, sourceRecordID), "record no longer referencing the source")
+
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, s.recordID)
+	s.Empty(s.app.MetadataKeeper.GetRecordConsumers(s.ctx, sourceRecordID), "removed record")
+}
+
+func (s *RecordKeeperTestSuite) TestRecordLineage() {
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := []types.RecordOutput{{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, s.sessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+	newRecord := func(name string, sources ...types.MetadataAddress) types.MetadataAddress {
+		inputs := []types.RecordInput{}
+		for i, source := range sources {
+			inputs = append(inputs, *types.NewRecordInput(fmt.Sprintf("input%d", i), &types.RecordInput_RecordId{RecordId: source},
+				"TestInputType", types.RecordInputStatus_Record))
+		}
+		record := types.NewRecord(name, s.sessionID, *process, inputs, outputs, types.RecordSpecMetadataAddress(s.contractSpecUUID, name))
+		s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+		return record.GetRecordAddress()
+	}
+	rootID := newRecord("root")
+	midID := newRecord("mid", rootID)
+	topID := newRecord("top", midID, rootID)
+
+	res, err := s.queryClient.RecordLineage(s.ctx.Context(), &types.RecordLineageRequest{RecordAddr: topID.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 3)
+	s.Equal(topID.String(), res.Records[0].Record.RecordIdInfo.RecordAddr)
+	s.Equal(uint32(0), res.Records[0].Depth)
+	s.Equal([]string{midID.String(), rootID.String()}, res.Records[0].SourceRecordAddrs)
+	s.Equal(midID.String(), res.Records[1].Record.RecordIdInfo.RecordAddr)
+	s.Equal(uint32(1), res.Records[1].Depth)
+	s.Equal([]string{rootID.String()}, res.Records[1].SourceRecordAddrs)
+	s.Equal(rootID.String(), res.Records[2].Record.RecordIdInfo.RecordAddr)
+	s.Equal(uint32(1), res.Records[2].Depth, "shortest path to a record referenced twice")
+	s.Empty(res.Records[2].SourceRecordAddrs)
+	s.Require().Len(res.Sessions, 1)
+	s.Equal(s.sessionID.String(), res.Sessions[0].SessionIdInfo.SessionAddr)
+	s.False(res.Truncated)
+
+	deepID := newRecord("deep", topID)
+	res, err = s.queryClient.RecordLineage(s.ctx.Context(), &types.RecordLineageRequest{RecordAddr: deepID.String(), Depth: 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 2)
+	s.Equal(topID.String(), res.Records[1].Record.RecordIdInfo.RecordAddr)
+	s.True(res.Truncated, "records referenced by top are beyond the depth")
+
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, midID)
+	res, err = s.queryClient.RecordLineage(s.ctx.Context(), &types.RecordLineageRequest{RecordAddr: topID.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 3)
+	s.Nil(res.Records[1].Record.Record, "removed record")
+	s.Equal(midID.String(), res.Records[1].Record.RecordIdInfo.RecordAddr)
+
+	_, err = s.queryClient.RecordLineage(s.ctx.Context(), &types.RecordLineageRequest{RecordAddr: midID.String()})
+	s.Error(err, "record not found")
+	_, err = s.queryClient.RecordLineage(s.ctx.Context(), &types.RecordLineageRequest{RecordAddr: topID.String(), Depth: types.MaxRecordLineageDepth + 1})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = depth 101 exceeds the maximum of 100")
+}
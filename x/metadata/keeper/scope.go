@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -101,6 +103,27 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
 
+// incrementScopeVersion bumps the version of a scope after one of its records has been written.
+// Nothing is indexed on the version, so the scope is stored without re-indexing it.
+func (k Keeper) incrementScopeVersion(ctx sdk.Context, scopeID types.MetadataAddress) {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return
+	}
+	scope.Version++
+	ctx.KVStore(k.storeKey).Set(scope.ScopeId, k.cdc.MustMarshal(&scope))
+}
+
+// ValidateScopeVersion returns a scope version conflict error if an expected version was given (greater than zero)
+// and the scope is not currently at that version.
+func (k Keeper) ValidateScopeVersion(scopeID types.MetadataAddress, current, expected uint64) error {
+	if expected == 0 || expected == current {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrScopeVersionConflict, "scope %s is at version %d, expected version %d",
+		scopeID, current, expected)
+}
+
 // RemoveScope removes a scope from the module kv store along with all its records and sessions.
 func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 	if !id.IsScopeAddress() {
@@ -128,6 +151,8 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 	// Sessions will be removed as the last record in each is deleted.
 
 	k.clearScopeIndex(ctx, scope)
+	store.Delete(types.GetScopeSizeKey(id))
+	store.Delete(types.GetScopeArchiveKey(id))
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
@@ -235,12 +260,33 @@ func (k Keeper) ValidateScopeUpdate(ctx sdk.Context, existing, proposed types.Sc
 	if err := k.ValidateScopeOwners(proposed.Owners, scopeSpec); err != nil {
 		return err
 	}
+	// The value owner of a coin scope is the marker of its coin, which can be sent to any account, so a coin scope can
+	// only use a specification without a value owner constraint.
+	valueOwnerChanging := existing.ValueOwnerAddress != proposed.ValueOwnerAddress ||
+		!existing.SpecificationId.Equals(proposed.SpecificationId)
+	if valueOwnerChanging {
+		if k.IsValueOwnerCoinScope(proposed) {
+			if err := validateValueOwnerCoinSpec(proposed, scopeSpec); err != nil {
+				return err
+			}
+		} else if err := k.ValidateScopeValueOwner(ctx, proposed.ValueOwnerAddress, scopeSpec); err != nil {
+			return err
+		}
+	}
 
 	// capture a list of required signatures for proposed changes.
 	requiredSignatures := []string{}
 
 	// Validate any changes to the ValueOwner property (value owner changes do not count as a typical scope change).
 	if existing.ValueOwnerAddress != proposed.ValueOwnerAddress {
+		// value ownership represented by a coin only changes hands with that coin.
+		if k.IsValueOwnerCoinScope(existing) {
+			return fmt.Errorf("value owner of scope %s is the %s coin and can only be changed by transferring that coin",
+				existing.ScopeId, existing.ValueOwnerCoinDenom())
+		}
+		if coinMarkerAddr, err := valueOwnerCoinMarkerAddress(proposed); err == nil && proposed.ValueOwnerAddress == coinMarkerAddr.String() {
+			return fmt.Errorf("value owner of scope %s cannot be set to its value owner coin marker directly", proposed.ScopeId)
+		}
 		// existing value is being changed,
 		if len(existing.ValueOwnerAddress) > 0 {
 			if k.AccountIsMarker(ctx, existing.ValueOwnerAddress) {
@@ -279,6 +325,71 @@ func (k Keeper) ValidateScopeUpdate(ctx sdk.Context, existing, proposed types.Sc
 	return nil
 }
 
+// valueOwnerCoinMarkerAddress returns the address of the marker for the coin representing value ownership of a scope.
+func valueOwnerCoinMarkerAddress(scope types.Scope) (sdk.AccAddress, error) {
+	return markertypes.MarkerAddress(scope.ValueOwnerCoinDenom())
+}
+
+// IsValueOwnerCoinScope returns true if value ownership of the scope is represented by a coin.
+func (k Keeper) IsValueOwnerCoinScope(scope types.Scope) bool {
+	if len(scope.ValueOwnerAddress) == 0 {
+		return false
+	}
+	markerAddr, err := valueOwnerCoinMarkerAddress(scope)
+	return err == nil && scope.ValueOwnerAddress == markerAddr.String()
+}
+
+// IssueValueOwnerCoin issues the single coin representing value ownership of the proposed scope to its value owner,
+// then sets the proposed scope's value owner to the marker for that coin. If the value owner is not changing, it must
+// be one of the signers. Nothing is done if the existing scope's value ownership is already represented by a coin.
+func (k Keeper) IssueValueOwnerCoin(ctx sdk.Context, existing types.Scope, proposed *types.Scope, signers []string) error {
+	if k.IsValueOwnerCoinScope(existing) && existing.ValueOwnerAddress == proposed.ValueOwnerAddress {
+		return nil
+	}
+	if len(proposed.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("a value owner address is required to represent value ownership as a coin")
+	}
+	if k.AccountIsMarker(ctx, proposed.ValueOwnerAddress) {
+		return fmt.Errorf("value owner coin for scope %s cannot be issued to marker %s",
+			proposed.ScopeId, proposed.ValueOwnerAddress)
+	}
+	if spec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId); found {
+		if err := validateValueOwnerCoinSpec(*proposed, spec); err != nil {
+			return err
+		}
+	}
+	// Changes to the value owner were already checked, but an unchanged value owner must also approve the conversion.
+	if existing.ValueOwnerAddress == proposed.ValueOwnerAddress {
+		if err := k.ValidateAllOwnersAreSigners([]string{existing.ValueOwnerAddress}, signers); err != nil {
+			return err
+		}
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(proposed.ValueOwnerAddress)
+	if err != nil {
+		return fmt.Errorf("invalid value owner address on scope: %w", err)
+	}
+	markerAddr, err := valueOwnerCoinMarkerAddress(*proposed)
+	if err != nil {
+		return fmt.Errorf("invalid value owner coin denom %s: %w", proposed.ValueOwnerCoinDenom(), err)
+	}
+	if err = k.markerKeeper.IssueSingleCoin(ctx, proposed.ValueOwnerCoinDenom(), recipient); err != nil {
+		return fmt.Errorf("could not issue value owner coin for scope %s: %w", proposed.ScopeId, err)
+	}
+	proposed.ValueOwnerAddress = markerAddr.String()
+	return nil
+}
+
+// validateValueOwnerCoinSpec checks that the scope specification has no value owner constraint. The constraint cannot
+// be enforced on a coin scope since the bank module lets the holder of its coin send it to any account.
+func validateValueOwnerCoinSpec(scope types.Scope, spec types.ScopeSpecification) error {
+	if spec.ValueOwnerConstraint != nil {
+		return fmt.Errorf("value ownership of scope %s cannot be represented by a coin: scope specification %s has a value owner constraint",
+			scope.ScopeId, spec.SpecificationId)
+	}
+	return nil
+}
+
 // ValidateScopeRemove checks the current scope and the proposed removal scope to determine if the the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateScopeRemove(ctx sdk.Context, existing, proposed types.Scope, signers []string) error {
@@ -291,10 +402,66 @@ func (k Keeper) ValidateScopeRemove(ctx sdk.Context, existing, proposed types.Sc
 	if err := k.ValidateAllPartiesAreSigners(existing.Owners, signers); err != nil {
 		return err
 	}
+	// The value owner coin is burned with the scope, so its holder must approve the removal.
+	if k.IsValueOwnerCoinScope(existing) {
+		if _, err := k.valueOwnerCoinHolder(ctx, existing, signers); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// BurnValueOwnerCoin takes the coin representing value ownership of the scope back from the signer holding it, then
+// burns it and removes its marker so a coin can be issued again for the scope id. Nothing is done if value ownership
+// of the scope is not represented by a coin.
+func (k Keeper) BurnValueOwnerCoin(ctx sdk.Context, scope types.Scope, signers []string) error {
+	if !k.IsValueOwnerCoinScope(scope) {
+		return nil
+	}
+	holder, err := k.valueOwnerCoinHolder(ctx, scope, signers)
+	if err != nil {
+		return err
+	}
+	if err = k.markerKeeper.BurnSingleCoin(ctx, scope.ValueOwnerCoinDenom(), holder); err != nil {
+		return fmt.Errorf("could not burn value owner coin for scope %s: %w", scope.ScopeId, err)
+	}
+	return nil
+}
+
+// valueOwnerCoinHolder returns the signer holding the coin that represents value ownership of the scope.
+func (k Keeper) valueOwnerCoinHolder(ctx sdk.Context, scope types.Scope, signers []string) (sdk.AccAddress, error) {
+	for _, signer := range signers {
+		address, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			continue // invalid address, loop to next.
+		}
+		if k.markerKeeper.HoldsSingleCoin(ctx, scope.ValueOwnerCoinDenom(), address) {
+			return address, nil
+		}
+	}
+	return nil, fmt.Errorf("missing signature from the holder of the %s value owner coin", scope.ValueOwnerCoinDenom())
+}
+
+// validateScopeOwnersAndValueOwnerAreSigners checks that all the scope owners, and the value owner of the scope (or a
+// signer with withdraw authority when it is a marker, or the holder of the coin representing value ownership), are
+// signers.
+func (k Keeper) validateScopeOwnersAndValueOwnerAreSigners(ctx sdk.Context, scope types.Scope, signers []string) error {
+	if err := k.ValidateAllPartiesAreSigners(scope.Owners, signers); err != nil {
+		return err
+	}
+	if len(scope.ValueOwnerAddress) > 0 {
+		if k.AccountIsMarker(ctx, scope.ValueOwnerAddress) {
+			if !k.HasSignerWithMarkerValueAuthority(ctx, scope.ValueOwnerAddress, signers, markertypes.Access_Withdraw) {
+				return fmt.Errorf("missing signature for %s with authority to withdraw/remove existing value owner", scope.ValueOwnerAddress)
+			}
+		} else if len(FindMissing([]string{scope.ValueOwnerAddress}, signers)) > 0 {
+			return fmt.Errorf("missing signature from scope value owner %s", scope.ValueOwnerAddress)
+		}
+	}
+	return nil
+}
+
 // ValidateScopeAddDataAccess checks the current scope and the proposed
 func (k Keeper) ValidateScopeAddDataAccess(ctx sdk.Context, dataAccessAddrs []string, existing types.Scope, signers []string) error {
 	if len(dataAccessAddrs) < 1 {
@@ -342,6 +509,29 @@ func (k Keeper) ValidateScopeDeleteDataAccess(ctx sdk.Context, dataAccessAddrs [
 		}
 	}
 
+	// Records can only grant data access to those with access to the scope.
+	var recordErr error
+	err := k.IterateRecords(ctx, existing.ScopeId, func(record types.Record) (stop bool) {
+		for _, da := range dataAccessAddrs {
+			if _, isOwner := existing.GetOwnerIndexWithAddress(da); isOwner {
+				continue
+			}
+			for _, rda := range record.DataAccess {
+				if da == rda {
+					recordErr = fmt.Errorf("address %s still has data access on record %s", da, record.GetRecordAddress())
+					return true
+				}
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if recordErr != nil {
+		return recordErr
+	}
+
 	if err := k.ValidateAllPartiesAreSigners(existing.Owners, signers); err != nil {
 		return err
 	}
@@ -392,3 +582,45 @@ func (k Keeper) ValidateScopeOwners(owners []types.Party, spec types.ScopeSpecif
 	}
 	return nil
 }
+
+// ValidateScopeValueOwner is stateful validation for a scope value owner against the value owner constraint of a scope
+// specification.  An empty value owner, or any value owner of a specification without a constraint, is allowed.
+func (k Keeper) ValidateScopeValueOwner(ctx sdk.Context, valueOwner string, spec types.ScopeSpecification) error {
+	constraint := spec.ValueOwnerConstraint
+	if constraint == nil || len(valueOwner) == 0 {
+		return nil
+	}
+	var allowed []string
+	if constraint.AllowMarkers {
+		if k.AccountIsMarker(ctx, valueOwner) {
+			return nil
+		}
+		allowed = append(allowed, "a marker")
+	}
+	if len(constraint.AllowedAttributes) > 0 {
+		addr, err := sdk.AccAddressFromBech32(valueOwner)
+		if err != nil {
+			return fmt.Errorf("invalid value owner address %s: %w", valueOwner, err)
+		}
+		for _, name := range constraint.AllowedAttributes {
+			attrs, err := k.attrKeeper.GetAttributes(ctx, addr, name)
+			if err != nil {
+				return err
+			}
+			if len(attrs) > 0 {
+				return nil
+			}
+		}
+		allowed = append(allowed, fmt.Sprintf("an account with attribute %s", strings.Join(constraint.AllowedAttributes, " or ")))
+	}
+	if len(constraint.AllowedAddresses) > 0 {
+		for _, address := range constraint.AllowedAddresses {
+			if address == valueOwner {
+				return nil
+			}
+		}
+		allowed = append(allowed, "an allowed address")
+	}
+	return fmt.Errorf("value owner %s is not allowed by scope specification %s: must be %s",
+		valueOwner, spec.SpecificationId, strings.Join(allowed, ", or "))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	simapp "github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 
@@ -542,3 +543,92 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeUpdateOwners() {
 		})
 	}
 }
+
+func (s *ScopeKeeperTestSuite) TestValidateScopeValueOwnerConstraint() {
+	markerAddr := markertypes.MustGetMarkerAddress("custodycoin").String()
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, &markertypes.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{Address: markerAddr},
+		AccessControl: []markertypes.AccessGrant{
+			{Address: s.user1, Permissions: markertypes.AccessListByNames("deposit,withdraw")},
+		},
+		Denom:      "custodycoin",
+		Supply:     sdk.NewInt(1000),
+		MarkerType: markertypes.MarkerType_Coin,
+		Status:     markertypes.StatusActive,
+	}))
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "custodian.regulated", s.user1Addr, false))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx,
+		attrtypes.NewAttribute("custodian.regulated", s.user2Addr, attrtypes.AttributeType_String, []byte("yes")), s.user1Addr))
+
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeSpec.ValueOwnerConstraint = &types.ValueOwnerConstraint{
+		AllowMarkers:      true,
+		AllowedAttributes: []string{"custodian.regulated"},
+		AllowedAddresses:  []string{s.user3},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	openSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	openSpec := types.NewScopeSpecification(openSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *openSpec)
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	notAllowed := fmt.Sprintf("value owner %s is not allowed by scope specification %s: must be a marker, or an account "+
+		"with attribute custodian.regulated, or an allowed address", s.user1, scopeSpecID)
+
+	cases := []struct {
+		name     string
+		existing types.Scope
+		proposed types.Scope
+		errorMsg string
+	}{
+		{
+			name:     "no value owner",
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, ""),
+		},
+		{
+			name:     "value owner without the attribute",
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			errorMsg: notAllowed,
+		},
+		{
+			name:     "value owner with the attribute",
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
+		},
+		{
+			name:     "allowed value owner address",
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user3),
+		},
+		{
+			name:     "marker value owner",
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, markerAddr),
+		},
+		{
+			name:     "specification without a constraint",
+			proposed: *types.NewScope(scopeID, openSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+		},
+		{
+			name:     "changing to a specification with a constraint",
+			existing: *types.NewScope(scopeID, openSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			errorMsg: notAllowed,
+		},
+		{
+			name:     "unchanged value owner",
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := s.app.MetadataKeeper.ValidateScopeUpdate(s.ctx, tc.existing, tc.proposed, []string{s.user1})
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -36,7 +36,12 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 		action = types.TLAction_Updated
 	}
 
+	if existing, found := k.GetSession(ctx, session.SessionId); found {
+		store.Delete(types.GetContractSpecSessionCacheKey(existing.SpecificationId, existing.SessionId))
+	}
+	k.updateScopeSize(ctx, session.SessionId, store.Get(session.SessionId), b)
 	store.Set(session.SessionId, b)
+	store.Set(types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId), []byte{0x01})
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Session, action)
 }
@@ -52,6 +57,10 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 
+	if session, found := k.GetSession(ctx, id); found {
+		store.Delete(types.GetContractSpecSessionCacheKey(session.SpecificationId, id))
+	}
+	k.updateScopeSize(ctx, id, store.Get(id), nil)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Session, types.TLAction_Deleted)
@@ -79,6 +88,38 @@ func (k Keeper) sessionHasRecords(ctx sdk.Context, id types.MetadataAddress) boo
 	return hasRecords
 }
 
+// GetContractSpecSessions returns the ids of the sessions written under the given contract specification.
+func (k Keeper) GetContractSpecSessions(ctx sdk.Context, contractSpecID types.MetadataAddress) []types.MetadataAddress {
+	prefix := types.GetContractSpecSessionCacheIteratorPrefix(contractSpecID)
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+
+	var sessions []types.MetadataAddress
+	for ; it.Valid(); it.Next() {
+		sessions = append(sessions, types.MetadataAddress(it.Key()[len(prefix):]))
+	}
+	return sessions
+}
+
+// RebuildContractSpecSessionIndex replaces the cache of the sessions by contract specification with one built from the
+// stored sessions.
+func (k Keeper) RebuildContractSpecSessionIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ContractSpecSessionCacheKeyPrefix)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return k.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		store.Set(types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId), []byte{0x01})
+		return false
+	})
+}
+
 // IterateSessions processes stored sessions with the given handler.
 // If the scopeID is an empty MetadataAddress, all sessions will be processed.
 // Otherwise, just the sessions for the given scopeID will be processed.
@@ -132,6 +173,9 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types
 	if !found {
 		return fmt.Errorf("scope not found for scope id %s", scopeID)
 	}
+	if k.IsScopeArchived(ctx, scopeID) {
+		return fmt.Errorf("scope %s is archived and must be restored before its sessions can be written", scopeID)
+	}
 
 	contractSpec, found := k.GetContractSpecification(ctx, proposed.SpecificationId)
 	if !found {
@@ -153,6 +197,15 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types
 		return fmt.Errorf("contract spec %s not listed in scope spec %s", proposed.SpecificationId, scopeSpec.SpecificationId)
 	}
 
+	if existing == nil {
+		if err = k.ValidateSpecificationAllowsSessions(ctx, scope.SpecificationId); err != nil {
+			return err
+		}
+		if err = k.ValidateSpecificationAllowsSessions(ctx, proposed.SpecificationId); err != nil {
+			return err
+		}
+	}
+
 	if len(proposed.GetName()) == 0 && existing == nil {
 		proposed.Name = contractSpec.ClassName
 	}
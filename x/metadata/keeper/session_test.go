@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/suite"
@@ -149,6 +150,45 @@ func (s *SessionKeeperTestSuite) TestMetadataSessionIterator() {
 	s.Equal(10, count, "iterator should return a full list of sessions")
 }
 
+func (s *SessionKeeperTestSuite) TestSessionsByContractSpec() {
+	otherSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	var sessionIDs []types.MetadataAddress
+	for i := 0; i < 3; i++ {
+		scopeUUID := uuid.New()
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		sessionIDs = append(sessionIDs, sessionID)
+		s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("name", sessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+	}
+	otherSessionID := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("name", otherSessionID, otherSpecID, ownerPartyList(s.user1), nil))
+
+	s.ElementsMatch(sessionIDs, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, s.contractSpecID))
+	s.Equal([]types.MetadataAddress{otherSessionID}, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, otherSpecID))
+
+	res, err := s.queryClient.SessionsByContractSpec(s.ctx.Context(), &types.SessionsByContractSpecRequest{
+		SpecificationId: s.contractSpecUUID.String(),
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Sessions, 1)
+	s.Equal(uint64(3), res.Pagination.Total, "sessions counted")
+	s.Contains(sessionIDs, res.Sessions[0].Session.SessionId)
+	_, err = s.queryClient.SessionsByContractSpec(s.ctx.Context(), &types.SessionsByContractSpecRequest{SpecificationId: s.scopeID.String()})
+	s.Error(err, "scope address")
+
+	moved := types.NewSession("name", sessionIDs[0], otherSpecID, ownerPartyList(s.user1), nil)
+	s.app.MetadataKeeper.SetSession(s.ctx, *moved)
+	s.ElementsMatch(sessionIDs[1:], s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, s.contractSpecID), "session updated to another spec")
+	s.ElementsMatch([]types.MetadataAddress{otherSessionID, sessionIDs[0]}, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, otherSpecID))
+
+	s.app.MetadataKeeper.RemoveSession(s.ctx, sessionIDs[1])
+	s.Equal([]types.MetadataAddress{sessionIDs[2]}, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, s.contractSpecID), "removed session")
+
+	s.Require().NoError(s.app.MetadataKeeper.RebuildContractSpecSessionIndex(s.ctx))
+	s.Equal([]types.MetadataAddress{sessionIDs[2]}, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, s.contractSpecID), "rebuilt index")
+	s.ElementsMatch([]types.MetadataAddress{otherSessionID, sessionIDs[0]}, s.app.MetadataKeeper.GetContractSpecSessions(s.ctx, otherSpecID), "rebuilt index")
+}
+
 func (s *SessionKeeperTestSuite) TestMetadataValidateSessionUpdate() {
 	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
 	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // AccountIsMarker determines if account is marker
@@ -32,7 +33,8 @@ func (k Keeper) AccountIsMarker(ctx sdk.Context, address string) bool {
 	return isMarker
 }
 
-// HasSignerWithMarkerValueAuthority checks the list of signers for any that have the requested role.
+// HasSignerWithMarkerValueAuthority checks the list of signers for any that have the requested role. When the marker is
+// for the coin representing value ownership of a scope, the signer holding that coin has every role.
 func (k Keeper) HasSignerWithMarkerValueAuthority(ctx sdk.Context, valueOwner string, signers []string, role markertypes.Access) bool {
 	valueOwnerAddr, err := sdk.AccAddressFromBech32(valueOwner)
 	// if the value owner is invalid then it is not possible to have any authority for it. e.g. value owner is empty.
@@ -48,6 +50,9 @@ func (k Keeper) HasSignerWithMarkerValueAuthority(ctx sdk.Context, valueOwner st
 	// Convert over to the actual underlying marker type, or not.
 	macc, isMarker := mac.(*markertypes.MarkerAccount)
 	if isMarker {
+		denom := macc.GetDenom()
+		scopeID, err := types.MetadataAddressFromBech32(denom)
+		isScopeCoin := err == nil && scopeID.IsScopeAddress()
 		for _, signer := range signers {
 			address, err := sdk.AccAddressFromBech32(signer)
 			if err != nil {
@@ -57,6 +62,9 @@ func (k Keeper) HasSignerWithMarkerValueAuthority(ctx sdk.Context, valueOwner st
 			if macc.AddressHasAccess(address, role) {
 				return true
 			}
+			if isScopeCoin && k.markerKeeper.HoldsSingleCoin(ctx, denom, address) {
+				return true
+			}
 		}
 	}
 	return false
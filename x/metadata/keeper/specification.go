@@ -251,6 +251,8 @@ func (k Keeper) RemoveContractSpecification(ctx sdk.Context, contractSpecID type
 	}
 
 	k.clearContractSpecificationIndex(ctx, contractSpec)
+	k.clearContractSpecSourceLocators(ctx, contractSpecID)
+	k.clearSpecificationDeprecation(ctx, contractSpecID)
 	store.Delete(contractSpecID)
 	k.EmitEvent(ctx, types.NewEventContractSpecificationDeleted(contractSpecID))
 	defer types.GetIncObjFunc(types.TLType_ContractSpec, types.TLAction_Deleted)
@@ -379,6 +381,26 @@ func (k Keeper) IterateScopeSpecsForContractSpec(ctx sdk.Context, contractSpecID
 	return nil
 }
 
+// SetScopeSpecificationsLenient turns strict enforcement off on every stored scope specification.
+func (k Keeper) SetScopeSpecificationsLenient(ctx sdk.Context) error {
+	var strict []types.ScopeSpecification
+	err := k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) (stop bool) {
+		if spec.Strict {
+			strict = append(strict, spec)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, spec := range strict {
+		spec.Strict = false
+		k.SetScopeSpecification(ctx, spec)
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("set %d scope specifications lenient", len(strict)))
+	return nil
+}
+
 // GetScopeSpecification returns the scope specification with the given id.
 func (k Keeper) GetScopeSpecification(ctx sdk.Context, scopeSpecID types.MetadataAddress) (spec types.ScopeSpecification, found bool) {
 	if !scopeSpecID.IsScopeSpecificationAddress() {
@@ -431,6 +453,7 @@ func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.Meta
 	}
 
 	k.clearScopeSpecificationIndex(ctx, scopeSpec)
+	k.clearSpecificationDeprecation(ctx, scopeSpecID)
 	store.Delete(scopeSpecID)
 	k.EmitEvent(ctx, types.NewEventScopeSpecificationDeleted(scopeSpecID))
 	defer types.GetIncObjFunc(types.TLType_ScopeSpec, types.TLAction_Deleted)
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeSize returns the total number of bytes stored for the sessions and records of a scope.
+func (k Keeper) GetScopeSize(ctx sdk.Context, scopeID types.MetadataAddress) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.GetScopeSizeKey(scopeID))
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// setScopeSize stores the total number of bytes stored for the sessions and records of a scope.
+func (k Keeper) setScopeSize(ctx sdk.Context, scopeID types.MetadataAddress, size uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if size == 0 {
+		store.Delete(types.GetScopeSizeKey(scopeID))
+		return
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, size)
+	store.Set(types.GetScopeSizeKey(scopeID), b)
+}
+
+// updateScopeSize accounts for an entry of a scope being changed from the old value to the new value, where a nil
+// value means the entry is not stored.
+func (k Keeper) updateScopeSize(ctx sdk.Context, id types.MetadataAddress, oldValue, newValue []byte) {
+	scopeID, err := id.AsScopeAddress()
+	if err != nil {
+		return
+	}
+	size := k.GetScopeSize(ctx, scopeID) + storedSize(id, newValue)
+	if oldSize := storedSize(id, oldValue); oldSize < size {
+		size -= oldSize
+	} else {
+		size = 0
+	}
+	k.setScopeSize(ctx, scopeID, size)
+}
+
+// storedSize returns the number of bytes used to store a value under a key, which is zero when there is no value.
+func storedSize(key, value []byte) uint64 {
+	if value == nil {
+		return 0
+	}
+	return uint64(len(key) + len(value))
+}
+
+// ChargeStorageFee charges the payer the storage fee for the bytes a scope has grown by since it was the given size.
+// The fee is sent to the community pool.  Nothing is charged when the scope did not grow or there is no fee.
+func (k Keeper) ChargeStorageFee(ctx sdk.Context, scopeID types.MetadataAddress, sizeBefore uint64, payer sdk.AccAddress) error {
+	sizeAfter := k.GetScopeSize(ctx, scopeID)
+	if sizeAfter <= sizeBefore {
+		return nil
+	}
+	fee := k.GetParams(ctx).StorageFee(sizeAfter - sizeBefore)
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, fee, payer); err != nil {
+		return sdkerrors.Wrapf(types.ErrStorageFee, "could not charge %s for %d bytes written to scope %s: %v",
+			fee, sizeAfter-sizeBefore, scopeID, err)
+	}
+	return nil
+}
+
+// RebuildScopeSizes replaces the sizes of all scopes with ones calculated from the stored sessions and records.
+func (k Keeper) RebuildScopeSizes(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeSizeKeyPrefix)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	var scopeIDs []types.MetadataAddress
+	err := k.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+		scopeIDs = append(scopeIDs, scope.ScopeId)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, scopeID := range scopeIDs {
+		var size uint64
+		for _, getPrefix := range []func() ([]byte, error){scopeID.ScopeSessionIteratorPrefix, scopeID.ScopeRecordIteratorPrefix} {
+			prefix, err := getPrefix()
+			if err != nil {
+				return err
+			}
+			entries := sdk.KVStorePrefixIterator(store, prefix)
+			for ; entries.Valid(); entries.Next() {
+				size += storedSize(entries.Key(), entries.Value())
+			}
+			entries.Close()
+		}
+		k.setScopeSize(ctx, scopeID, size)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// NewScopeArchive creates a new scope archive stub for the given content.
+func NewScopeArchive(
+	content ScopeArchiveContent,
+	locatorURI string,
+	archivedBy []string,
+	height int64,
+	archivedTime time.Time,
+) *ScopeArchive {
+	return &ScopeArchive{
+		ScopeId:      content.ScopeId,
+		ContentHash:  content.Hash(),
+		LocatorUri:   locatorURI,
+		SessionCount: uint32(len(content.Sessions)),
+		RecordCount:  uint32(len(content.Records)),
+		ArchivedBy:   archivedBy,
+		Height:       height,
+		ArchivedTime: archivedTime,
+	}
+}
+
+// ValidateBasic performs basic format checking of a scope archive stub.
+func (a ScopeArchive) ValidateBasic() error {
+	if !a.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid scope archive scope id: %s", a.ScopeId)
+	}
+	if hash, err := base64.StdEncoding.DecodeString(a.ContentHash); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("invalid scope archive %s content hash: %q", a.ScopeId, a.ContentHash)
+	}
+	if len(strings.TrimSpace(a.LocatorUri)) == 0 {
+		return fmt.Errorf("scope archive %s locator uri cannot be empty", a.ScopeId)
+	}
+	return nil
+}
+
+// NewScopeArchiveContent creates the archive content of a scope, ordering the sessions and records by id.
+func NewScopeArchiveContent(scopeID MetadataAddress, sessions []Session, records []Record) *ScopeArchiveContent {
+	content := &ScopeArchiveContent{
+		ScopeId:  scopeID,
+		Sessions: sessions,
+		Records:  records,
+	}
+	content.Sort()
+	return content
+}
+
+// Sort orders the sessions and records of the content by id, the order the content hash is calculated in.
+func (c *ScopeArchiveContent) Sort() {
+	sort.SliceStable(c.Sessions, func(i, j int) bool {
+		return bytes.Compare(c.Sessions[i].SessionId, c.Sessions[j].SessionId) < 0
+	})
+	sort.SliceStable(c.Records, func(i, j int) bool {
+		return bytes.Compare(c.Records[i].GetRecordAddress(), c.Records[j].GetRecordAddress()) < 0
+	})
+}
+
+// Hash returns the base64 encoded sha256 hash of the encoded content.  The content should be sorted first.
+func (c ScopeArchiveContent) Hash() string {
+	bz, err := c.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	sum := sha256.Sum256(bz)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ValidateBasic checks that the content has a scope id and that each of its sessions and records belongs to it.
+func (c ScopeArchiveContent) ValidateBasic() error {
+	if !c.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", c.ScopeId.String())
+	}
+	if len(c.Sessions) == 0 && len(c.Records) == 0 {
+		return errors.New("scope archive content must have at least one session or record")
+	}
+	for _, s := range c.Sessions {
+		if scopeID, err := s.SessionId.AsScopeAddress(); err != nil || !s.SessionId.IsSessionAddress() || !scopeID.Equals(c.ScopeId) {
+			return fmt.Errorf("session %s is not part of scope %s", s.SessionId, c.ScopeId)
+		}
+	}
+	for _, r := range c.Records {
+		if scopeID, err := r.SessionId.AsScopeAddress(); err != nil || !r.SessionId.IsSessionAddress() || !scopeID.Equals(c.ScopeId) {
+			return fmt.Errorf("record %s is not part of scope %s", r.Name, c.ScopeId)
+		}
+	}
+	return nil
+}
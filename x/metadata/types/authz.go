@@ -0,0 +1,88 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &OSLocatorAuthorization{}
+)
+
+// NewOSLocatorAuthorization creates a new OSLocatorAuthorization for the bind or modify os locator msg type url,
+// limited to the allowed uris (any uri when empty) and the number of uses (unlimited when zero).
+func NewOSLocatorAuthorization(msgTypeURL string, allowedURIs []string, remainingUses uint32) *OSLocatorAuthorization {
+	return &OSLocatorAuthorization{
+		MsgTypeUrl:    msgTypeURL,
+		AllowedUris:   allowedURIs,
+		RemainingUses: remainingUses,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a OSLocatorAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+func (a OSLocatorAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var locator ObjectStoreLocator
+	switch msg := msg.(type) {
+	case *MsgBindOSLocatorRequest:
+		locator = msg.Locator
+	case *MsgModifyOSLocatorRequest:
+		locator = msg.Locator
+	default:
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if !a.AllowsURI(locator.LocatorUri) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "locator uri %s is not allowed", locator.LocatorUri)
+	}
+	switch a.RemainingUses {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		return authz.AcceptResponse{
+			Accept:  true,
+			Updated: NewOSLocatorAuthorization(a.MsgTypeUrl, a.AllowedUris, a.RemainingUses-1),
+		}, nil
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a OSLocatorAuthorization) ValidateBasic() error {
+	if a.MsgTypeUrl != sdk.MsgTypeURL(&MsgBindOSLocatorRequest{}) && a.MsgTypeUrl != sdk.MsgTypeURL(&MsgModifyOSLocatorRequest{}) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "os locator authorization cannot be granted for %s", a.MsgTypeUrl)
+	}
+	for _, uri := range a.AllowedUris {
+		if strings.TrimSpace(uri) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowed uri cannot be empty")
+		}
+		if _, err := url.Parse(uri); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid allowed uri: %s", uri)
+		}
+	}
+	return nil
+}
+
+// AllowsURI returns true if the grantee may set the locator uri.
+func (a OSLocatorAuthorization) AllowsURI(uri string) bool {
+	if len(a.AllowedUris) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedUris {
+		if allowed == uri {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func TestOSLocatorAuthorizationValidateBasic(t *testing.T) {
+	bindURL := sdk.MsgTypeURL(&MsgBindOSLocatorRequest{})
+	tests := []struct {
+		name  string
+		auth  *OSLocatorAuthorization
+		error string
+	}{
+		{"bind any uri", NewOSLocatorAuthorization(bindURL, nil, 0), ""},
+		{"modify allowed uris", NewOSLocatorAuthorization(sdk.MsgTypeURL(&MsgModifyOSLocatorRequest{}), []string{"https://example.com"}, 3), ""},
+		{"delete msg", NewOSLocatorAuthorization(sdk.MsgTypeURL(&MsgDeleteOSLocatorRequest{}), nil, 0),
+			"os locator authorization cannot be granted for /provenance.metadata.v1.MsgDeleteOSLocatorRequest: invalid type"},
+		{"empty allowed uri", NewOSLocatorAuthorization(bindURL, []string{" "}, 0), "allowed uri cannot be empty: invalid request"},
+		{"invalid allowed uri", NewOSLocatorAuthorization(bindURL, []string{"%zz"}, 0), "invalid allowed uri: %zz: invalid request"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if len(tc.error) > 0 {
+				require.EqualError(t, err, tc.error)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOSLocatorAuthorizationAccept(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	bind := NewMsgBindOSLocatorRequest(ObjectStoreLocator{Owner: owner, LocatorUri: "https://a.example.com"})
+	modify := NewMsgModifyOSLocatorRequest(ObjectStoreLocator{Owner: owner, LocatorUri: "https://b.example.com"})
+	bindURL := sdk.MsgTypeURL(bind)
+
+	tests := []struct {
+		name     string
+		auth     *OSLocatorAuthorization
+		msg      sdk.Msg
+		response authz.AcceptResponse
+		error    string
+	}{
+		{"unlimited", NewOSLocatorAuthorization(bindURL, nil, 0), bind, authz.AcceptResponse{Accept: true}, ""},
+		{"last use", NewOSLocatorAuthorization(bindURL, nil, 1), bind, authz.AcceptResponse{Accept: true, Delete: true}, ""},
+		{"uses left", NewOSLocatorAuthorization(bindURL, []string{"https://a.example.com"}, 3), bind,
+			authz.AcceptResponse{Accept: true, Updated: NewOSLocatorAuthorization(bindURL, []string{"https://a.example.com"}, 2)}, ""},
+		{"uri not allowed", NewOSLocatorAuthorization(bindURL, []string{"https://c.example.com"}, 0), bind,
+			authz.AcceptResponse{}, "locator uri https://a.example.com is not allowed: unauthorized"},
+		{"other locator msg", NewOSLocatorAuthorization(bindURL, nil, 0), modify, authz.AcceptResponse{}, "type mismatch: invalid type"},
+		{"other msg", NewOSLocatorAuthorization(bindURL, nil, 0), &MsgDeleteOSLocatorRequest{}, authz.AcceptResponse{}, "type mismatch: invalid type"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := tc.auth.Accept(sdk.Context{}, tc.msg)
+			if len(tc.error) > 0 {
+				require.EqualError(t, err, tc.error)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
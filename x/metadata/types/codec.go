@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on the Amino codec
@@ -19,7 +21,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgWriteSessionRequest{}, "provenance/metadata/WriteSessionRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordRequest{}, "provenance/metadata/WriteRecordRequest", nil)
+	cdc.RegisterConcrete(&MsgWriteSessionAndRecordsRequest{}, "provenance/metadata/WriteSessionAndRecordsRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordRequest{}, "provenance/metadata/DeleteRecordRequest", nil)
+	cdc.RegisterConcrete(&MsgAddRecordDataAccessRequest{}, "provenance/metadata/AddRecordDataAccessRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleteRecordDataAccessRequest{}, "provenance/metadata/DeleteRecordDataAccessRequest", nil)
+	cdc.RegisterConcrete(&MsgRedactRecordRequest{}, "provenance/metadata/RedactRecordRequest", nil)
+	cdc.RegisterConcrete(&MsgArchiveScopeRequest{}, "provenance/metadata/ArchiveScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgRestoreScopeRequest{}, "provenance/metadata/RestoreScopeRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteScopeSpecificationRequest{}, "provenance/metadata/WriteScopeSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeSpecificationRequest{}, "provenance/metadata/DeleteScopeSpecificationRequest", nil)
@@ -29,6 +37,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteContractSpecFromScopeSpecRequest{}, "provenance/metadata/DeleteContractSpecFromScopeSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordSpecificationRequest{}, "provenance/metadata/WriteRecordSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordSpecificationRequest{}, "provenance/metadata/DeleteRecordSpecificationRequest", nil)
+	cdc.RegisterConcrete(&MsgWriteSpecificationBundleRequest{}, "provenance/metadata/WriteSpecificationBundleRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteP8EContractSpecRequest{}, "provenance/metadata/WriteP8EContractSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgP8EMemorializeContractRequest{}, "provenance/metadata/P8EMemorializeContractRequest", nil)
@@ -36,6 +45,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBindOSLocatorRequest{}, "provenance/metadata/BindOSLocatorRequest", nil)
 	cdc.RegisterConcrete(&MsgModifyOSLocatorRequest{}, "provenance/metadata/ModifyOSLocatorRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteOSLocatorRequest{}, "provenance/metadata/DeleteOSLocatorRequest", nil)
+	cdc.RegisterConcrete(&MsgReportOSLocatorStatusRequest{}, "provenance/metadata/ReportOSLocatorStatusRequest", nil)
+	cdc.RegisterConcrete(&MsgAddContractSpecSourceLocatorRequest{}, "provenance/metadata/AddContractSpecSourceLocatorRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleteContractSpecSourceLocatorRequest{}, "provenance/metadata/DeleteContractSpecSourceLocatorRequest", nil)
+	cdc.RegisterConcrete(&MsgDeprecateSpecificationRequest{}, "provenance/metadata/DeprecateSpecificationRequest", nil)
+
+	cdc.RegisterConcrete(&TransferScopeOwnershipProposal{}, "provenance/metadata/TransferScopeOwnershipProposal", nil)
+	cdc.RegisterConcrete(&SetScopeSpecificationStrictProposal{}, "provenance/metadata/SetScopeSpecificationStrictProposal", nil)
 }
 
 // RegisterInterfaces registers implementations for the tx messages
@@ -49,7 +65,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteScopeOwnerRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
+		&MsgWriteSessionAndRecordsRequest{},
 		&MsgDeleteRecordRequest{},
+		&MsgAddRecordDataAccessRequest{},
+		&MsgDeleteRecordDataAccessRequest{},
+		&MsgRedactRecordRequest{},
+		&MsgArchiveScopeRequest{},
+		&MsgRestoreScopeRequest{},
 
 		&MsgWriteScopeSpecificationRequest{},
 		&MsgDeleteScopeSpecificationRequest{},
@@ -59,6 +81,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteContractSpecFromScopeSpecRequest{},
 		&MsgWriteRecordSpecificationRequest{},
 		&MsgDeleteRecordSpecificationRequest{},
+		&MsgWriteSpecificationBundleRequest{},
 
 		&MsgWriteP8EContractSpecRequest{},
 		&MsgP8EMemorializeContractRequest{},
@@ -66,6 +89,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBindOSLocatorRequest{},
 		&MsgModifyOSLocatorRequest{},
 		&MsgDeleteOSLocatorRequest{},
+		&MsgReportOSLocatorStatusRequest{},
+		&MsgAddContractSpecSourceLocatorRequest{},
+		&MsgDeleteContractSpecSourceLocatorRequest{},
+		&MsgDeprecateSpecificationRequest{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&TransferScopeOwnershipProposal{},
+		&SetScopeSpecificationStrictProposal{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&OSLocatorAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -14,4 +14,10 @@ var (
 	ErrOSLocatorURIToolong = sdkerrors.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = sdkerrors.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = sdkerrors.Register(ModuleName, 7, "uri is invalid")
+	// ErrScopeVersionConflict occurs when a write expects a scope to be at a version other than its current version.
+	ErrScopeVersionConflict = sdkerrors.Register(ModuleName, 8, "scope version conflict")
+	// ErrIdempotencyKeyReused occurs when an idempotency key is used again for a write with different content.
+	ErrIdempotencyKeyReused = sdkerrors.Register(ModuleName, 9, "idempotency key already used for a different request")
+	// ErrStorageFee occurs when the storage fee for a write could not be paid.
+	ErrStorageFee = sdkerrors.Register(ModuleName, 10, "storage fee could not be paid")
 )
@@ -99,11 +99,18 @@ const (
 	TxEndpoint_DeleteScopeDataAccess TxEndpoint = "DeleteScopeDataAccess"
 	TxEndpoint_AddScopeOwner         TxEndpoint = "AddScopeOwner"
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
+	TxEndpoint_ArchiveScope          TxEndpoint = "ArchiveScope"
+	TxEndpoint_RestoreScope          TxEndpoint = "RestoreScope"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
-	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
-	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
+	TxEndpoint_WriteRecord            TxEndpoint = "WriteRecord"
+	TxEndpoint_DeleteRecord           TxEndpoint = "DeleteRecord"
+	TxEndpoint_AddRecordDataAccess    TxEndpoint = "AddRecordDataAccess"
+	TxEndpoint_DeleteRecordDataAccess TxEndpoint = "DeleteRecordDataAccess"
+	TxEndpoint_RedactRecord           TxEndpoint = "RedactRecord"
+
+	TxEndpoint_WriteSessionAndRecords TxEndpoint = "WriteSessionAndRecords"
 
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
@@ -117,12 +124,21 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
+	TxEndpoint_WriteSpecificationBundle TxEndpoint = "WriteSpecificationBundle"
+
 	TxEndpoint_WriteP8eContractSpec   TxEndpoint = "WriteP8eContractSpec"
 	TxEndpoint_P8eMemorializeContract TxEndpoint = "P8eMemorializeContract"
 
 	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
 	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
 	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+
+	TxEndpoint_ReportOSLocatorStatus TxEndpoint = "ReportOSLocatorStatus"
+
+	TxEndpoint_AddContractSpecSourceLocator    TxEndpoint = "AddContractSpecSourceLocator"
+	TxEndpoint_DeleteContractSpecSourceLocator TxEndpoint = "DeleteContractSpecSourceLocator"
+
+	TxEndpoint_DeprecateSpecification TxEndpoint = "DeprecateSpecification"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []sdk.AccAddress) *EventTxCompleted {
@@ -155,6 +171,18 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeOwnershipTransferred(existing, scope Scope, evidence, proposalTitle string) *EventScopeOwnershipTransferred {
+	return &EventScopeOwnershipTransferred{
+		ScopeAddr:                 scope.ScopeId.String(),
+		PreviousOwners:            existing.Owners,
+		Owners:                    scope.Owners,
+		PreviousValueOwnerAddress: existing.ValueOwnerAddress,
+		ValueOwnerAddress:         scope.ValueOwnerAddress,
+		Evidence:                  evidence,
+		ProposalTitle:             proposalTitle,
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -199,6 +227,29 @@ func NewEventRecordDeleted(recordID MetadataAddress) *EventRecordDeleted {
 	}
 }
 
+func NewEventRecordRedacted(recordID MetadataAddress, reason string) *EventRecordRedacted {
+	return &EventRecordRedacted{
+		RecordAddr: recordID.String(),
+		ScopeAddr:  recordID.MustGetAsScopeAddress().String(),
+		Reason:     reason,
+	}
+}
+
+func NewEventScopeArchived(scopeID MetadataAddress, contentHash, locatorURI string) *EventScopeArchived {
+	return &EventScopeArchived{
+		ScopeAddr:   scopeID.String(),
+		ContentHash: contentHash,
+		LocatorUri:  locatorURI,
+	}
+}
+
+func NewEventScopeRestored(scopeID MetadataAddress, contentHash string) *EventScopeRestored {
+	return &EventScopeRestored{
+		ScopeAddr:   scopeID.String(),
+		ContentHash: contentHash,
+	}
+}
+
 func NewEventScopeSpecificationCreated(scopeSpecificationID MetadataAddress) *EventScopeSpecificationCreated {
 	return &EventScopeSpecificationCreated{
 		ScopeSpecificationAddr: scopeSpecificationID.String(),
@@ -273,3 +324,27 @@ func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
 		Owner: owner,
 	}
 }
+
+func NewEventContractSpecSourceLocatorAdded(contractSpecID MetadataAddress, owner string) *EventContractSpecSourceLocatorAdded {
+	return &EventContractSpecSourceLocatorAdded{
+		ContractSpecificationAddr: contractSpecID.String(),
+		LocatorOwner:              owner,
+	}
+}
+
+func NewEventContractSpecSourceLocatorDeleted(contractSpecID MetadataAddress, owner string) *EventContractSpecSourceLocatorDeleted {
+	return &EventContractSpecSourceLocatorDeleted{
+		ContractSpecificationAddr: contractSpecID.String(),
+		LocatorOwner:              owner,
+	}
+}
+
+func NewEventSpecificationDeprecated(specID, successorID MetadataAddress) *EventSpecificationDeprecated {
+	retval := &EventSpecificationDeprecated{
+		SpecificationAddr: specID.String(),
+	}
+	if !successorID.Empty() {
+		retval.SuccessorAddr = successorID.String()
+	}
+	return retval
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -224,6 +225,107 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventScopeOwnershipTransferred is an event message indicating the owners of a scope have been replaced by a
+// governance proposal.
+type EventScopeOwnershipTransferred struct {
+	// scope_addr is the bech32 address string of the scope id that was transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// previous_owners are the owners of the scope before the transfer.
+	PreviousOwners []Party `protobuf:"bytes,2,rep,name=previous_owners,json=previousOwners,proto3" json:"previous_owners"`
+	// owners are the owners of the scope after the transfer.
+	Owners []Party `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners"`
+	// previous_value_owner_address is the value owner of the scope before the transfer.
+	PreviousValueOwnerAddress string `protobuf:"bytes,4,opt,name=previous_value_owner_address,json=previousValueOwnerAddress,proto3" json:"previous_value_owner_address,omitempty"`
+	// value_owner_address is the value owner of the scope after the transfer.
+	ValueOwnerAddress string `protobuf:"bytes,5,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty"`
+	// evidence is the reason given in the proposal for why the existing owners could not make the change.
+	Evidence string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// proposal_title is the title of the governance proposal that made the transfer.
+	ProposalTitle string `protobuf:"bytes,7,opt,name=proposal_title,json=proposalTitle,proto3" json:"proposal_title,omitempty"`
+}
+
+func (m *EventScopeOwnershipTransferred) Reset()         { *m = EventScopeOwnershipTransferred{} }
+func (m *EventScopeOwnershipTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventScopeOwnershipTransferred) ProtoMessage()    {}
+func (*EventScopeOwnershipTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{4}
+}
+func (m *EventScopeOwnershipTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeOwnershipTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeOwnershipTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeOwnershipTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeOwnershipTransferred.Merge(m, src)
+}
+func (m *EventScopeOwnershipTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeOwnershipTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeOwnershipTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeOwnershipTransferred proto.InternalMessageInfo
+
+func (m *EventScopeOwnershipTransferred) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeOwnershipTransferred) GetPreviousOwners() []Party {
+	if m != nil {
+		return m.PreviousOwners
+	}
+	return nil
+}
+
+func (m *EventScopeOwnershipTransferred) GetOwners() []Party {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *EventScopeOwnershipTransferred) GetPreviousValueOwnerAddress() string {
+	if m != nil {
+		return m.PreviousValueOwnerAddress
+	}
+	return ""
+}
+
+func (m *EventScopeOwnershipTransferred) GetValueOwnerAddress() string {
+	if m != nil {
+		return m.ValueOwnerAddress
+	}
+	return ""
+}
+
+func (m *EventScopeOwnershipTransferred) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *EventScopeOwnershipTransferred) GetProposalTitle() string {
+	if m != nil {
+		return m.ProposalTitle
+	}
+	return ""
+}
+
 // EventSessionCreated is an event message indicating a session has been created.
 type EventSessionCreated struct {
 	// session_addr is the bech32 address string of the session id that was created.
@@ -236,7 +338,7 @@ func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
 func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionCreated) ProtoMessage()    {}
 func (*EventSessionCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{4}
+	return fileDescriptor_476cf6cf9459cf25, []int{5}
 }
 func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +393,7 @@ func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
 func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionUpdated) ProtoMessage()    {}
 func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{5}
+	return fileDescriptor_476cf6cf9459cf25, []int{6}
 }
 func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +448,7 @@ func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
 func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventSessionDeleted) ProtoMessage()    {}
 func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{6}
+	return fileDescriptor_476cf6cf9459cf25, []int{7}
 }
 func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +505,7 @@ func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
 func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordCreated) ProtoMessage()    {}
 func (*EventRecordCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{7}
+	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
 func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +569,7 @@ func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
 func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordUpdated) ProtoMessage()    {}
 func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{8}
+	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
 func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +631,7 @@ func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
 func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordDeleted) ProtoMessage()    {}
 func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{9}
+	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
 func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,6 +674,189 @@ func (m *EventRecordDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventRecordRedacted is an event message indicating the output values of a record have been redacted.
+type EventRecordRedacted struct {
+	// record_addr is the bech32 address string of the record id that was redacted.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// reason the record was redacted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRecordRedacted) Reset()         { *m = EventRecordRedacted{} }
+func (m *EventRecordRedacted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordRedacted) ProtoMessage()    {}
+func (*EventRecordRedacted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
+}
+func (m *EventRecordRedacted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordRedacted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordRedacted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordRedacted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordRedacted.Merge(m, src)
+}
+func (m *EventRecordRedacted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordRedacted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordRedacted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordRedacted proto.InternalMessageInfo
+
+func (m *EventRecordRedacted) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *EventRecordRedacted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventRecordRedacted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventScopeArchived is an event message indicating the sessions and records of a scope have been archived.
+type EventScopeArchived struct {
+	// scope_addr is the bech32 address string of the scope id that was archived.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// content_hash is the base64 encoded sha256 hash of the archived content.
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// locator_uri is the object store uri the archived content is stored at.
+	LocatorUri string `protobuf:"bytes,3,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
+}
+
+func (m *EventScopeArchived) Reset()         { *m = EventScopeArchived{} }
+func (m *EventScopeArchived) String() string { return proto.CompactTextString(m) }
+func (*EventScopeArchived) ProtoMessage()    {}
+func (*EventScopeArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
+}
+func (m *EventScopeArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeArchived.Merge(m, src)
+}
+func (m *EventScopeArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeArchived proto.InternalMessageInfo
+
+func (m *EventScopeArchived) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeArchived) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *EventScopeArchived) GetLocatorUri() string {
+	if m != nil {
+		return m.LocatorUri
+	}
+	return ""
+}
+
+// EventScopeRestored is an event message indicating the archived sessions and records of a scope have been restored.
+type EventScopeRestored struct {
+	// scope_addr is the bech32 address string of the scope id that was restored.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// content_hash is the base64 encoded sha256 hash of the restored content.
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (m *EventScopeRestored) Reset()         { *m = EventScopeRestored{} }
+func (m *EventScopeRestored) String() string { return proto.CompactTextString(m) }
+func (*EventScopeRestored) ProtoMessage()    {}
+func (*EventScopeRestored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
+}
+func (m *EventScopeRestored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeRestored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeRestored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeRestored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeRestored.Merge(m, src)
+}
+func (m *EventScopeRestored) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeRestored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeRestored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeRestored proto.InternalMessageInfo
+
+func (m *EventScopeRestored) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeRestored) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 // EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
 type EventScopeSpecificationCreated struct {
 	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
@@ -583,7 +868,7 @@ func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationCreated) ProtoMessage()    {}
 func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{10}
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
 func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +915,7 @@ func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
 func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
 func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +962,7 @@ func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
 func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
 func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +1009,7 @@ func (m *EventContractSpecificationCreated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationCreated) ProtoMessage()    {}
 func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
 func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1056,7 @@ func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationUpdated) ProtoMessage()    {}
 func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
 func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1103,7 @@ func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContract
 func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationDeleted) ProtoMessage()    {}
 func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
 func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1153,7 @@ func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationCreated) ProtoMessage()    {}
 func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
 func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1210,7 @@ func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
 func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
 func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1267,7 @@ func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
 func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
 func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1320,7 @@ func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
 func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorCreated) ProtoMessage()    {}
 func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
 func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1366,7 @@ func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
 func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorUpdated) ProtoMessage()    {}
 func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
 func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1412,7 @@ func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
 func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorDeleted) ProtoMessage()    {}
 func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
 }
 func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,84 +1448,279 @@ func (m *EventOSLocatorDeleted) GetOwner() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
-	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
-	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
-	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
-	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
-	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
-	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
-	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
-	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
-	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
-	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
-	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
-	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
-	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
-	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
-	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
-	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
-	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
-	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
-	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
-	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
-	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+// EventContractSpecSourceLocatorAdded is an event message indicating an object store locator has been added to the
+// source locators of a contract specification.
+type EventContractSpecSourceLocatorAdded struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+	// locator_owner is the owner of the object store locator that was added.
+	LocatorOwner string `protobuf:"bytes,2,opt,name=locator_owner,json=locatorOwner,proto3" json:"locator_owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+func (m *EventContractSpecSourceLocatorAdded) Reset()         { *m = EventContractSpecSourceLocatorAdded{} }
+func (m *EventContractSpecSourceLocatorAdded) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecSourceLocatorAdded) ProtoMessage()    {}
+func (*EventContractSpecSourceLocatorAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
 }
-
-var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x44, 0x14, 0x32, 0xe5, 0x00, 0x06, 0x82, 0x03, 0xc2, 0xfd, 0xc3, 0xa5, 0x97,
-	0xda, 0x2a, 0x70, 0x40, 0x1c, 0x90, 0x20, 0x70, 0x43, 0x02, 0x25, 0x45, 0x48, 0xbd, 0xc0, 0x76,
-	0x77, 0x28, 0x16, 0xcd, 0xae, 0xb5, 0xbb, 0x4d, 0xcb, 0x5b, 0xf0, 0x02, 0xbc, 0x0f, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x50, 0xd6, 0x1e, 0xec, 0x36, 0x2e, 0x06, 0x42, 0x80, 0xe3, 0xcc, 0xce,
-	0x7c, 0xbf, 0xd9, 0x6f, 0xe7, 0xb0, 0x70, 0x3b, 0xd5, 0x6a, 0x84, 0x92, 0x49, 0x8e, 0xf1, 0x10,
-	0x2d, 0x13, 0xcc, 0xb2, 0x78, 0xb4, 0x15, 0xe3, 0x08, 0xa5, 0x35, 0x51, 0xaa, 0x95, 0x55, 0x7e,
-	0xa7, 0x28, 0x8a, 0xa8, 0x28, 0x1a, 0x6d, 0xad, 0xbf, 0x81, 0x4b, 0x4f, 0xa7, 0x75, 0xdb, 0x47,
-	0x3d, 0x35, 0x4c, 0xf7, 0xd1, 0xa2, 0xf0, 0x3b, 0xb0, 0x34, 0x54, 0xe2, 0x60, 0x1f, 0x03, 0x6f,
-	0xd5, 0xdb, 0x68, 0xf7, 0xf3, 0xc8, 0xbf, 0x01, 0x17, 0x50, 0x8a, 0x54, 0x25, 0xd2, 0x06, 0x4d,
-	0x77, 0xf2, 0x3d, 0xf6, 0x03, 0x38, 0x6f, 0x92, 0x3d, 0x89, 0xda, 0x04, 0xad, 0xd5, 0xd6, 0x46,
-	0xbb, 0x4f, 0xe1, 0xfa, 0x1d, 0xb8, 0xec, 0x08, 0x03, 0xae, 0x52, 0xec, 0x69, 0x64, 0x53, 0xc4,
-	0x2d, 0x00, 0x33, 0x8d, 0x5f, 0x33, 0x21, 0x74, 0x8e, 0x69, 0xbb, 0xcc, 0x23, 0x21, 0xf4, 0xc9,
-	0x9e, 0x97, 0xa9, 0xf8, 0xe5, 0x9e, 0x27, 0x98, 0x5d, 0xa5, 0xa6, 0xe7, 0x15, 0x5c, 0xc9, 0x7a,
-	0xd0, 0x98, 0x44, 0x49, 0x9a, 0x6e, 0x0d, 0x2e, 0x9a, 0x2c, 0x53, 0xee, 0x5b, 0xce, 0x73, 0xd3,
-	0xce, 0x53, 0xc2, 0xcd, 0x1a, 0x61, 0xba, 0xc2, 0x1f, 0x17, 0xa6, 0x7b, 0xce, 0x2f, 0x7c, 0x08,
-	0xbe, 0x13, 0xee, 0x23, 0x57, 0x5a, 0x90, 0x13, 0x2b, 0xb0, 0xac, 0x5d, 0xa2, 0x2c, 0x0b, 0x59,
-	0xca, 0xa9, 0x9e, 0x06, 0x37, 0xeb, 0xc0, 0xad, 0x1f, 0x83, 0xc9, 0xa9, 0xbf, 0x00, 0xde, 0x3e,
-	0x01, 0x26, 0x27, 0x6b, 0xc1, 0x35, 0xaa, 0x3b, 0x10, 0x16, 0x6b, 0x38, 0x48, 0x91, 0x27, 0x6f,
-	0x13, 0xce, 0x6c, 0x69, 0xbb, 0xee, 0x43, 0x90, 0x09, 0x98, 0xf2, 0x69, 0x19, 0xd7, 0x31, 0x33,
-	0xcd, 0x35, 0xda, 0x64, 0xdb, 0x22, 0xb4, 0xc9, 0x99, 0xdf, 0xd7, 0xe6, 0xb0, 0xe6, 0xb4, 0x7b,
-	0x4a, 0x5a, 0xcd, 0xb8, 0xad, 0xb4, 0xe5, 0x21, 0xdc, 0xe4, 0xf9, 0xf9, 0xd9, 0x84, 0x2e, 0xaf,
-	0x92, 0xa8, 0x87, 0x90, 0x3f, 0x0b, 0x85, 0x90, 0x51, 0xf3, 0x42, 0x3e, 0x79, 0xb0, 0x52, 0xda,
-	0xcc, 0x4a, 0xb7, 0x1e, 0x40, 0x37, 0x5f, 0xd3, 0x33, 0x09, 0xd7, 0xf5, 0x6c, 0xbb, 0xdb, 0xe0,
-	0x9a, 0xf9, 0x9a, 0xf3, 0xcc, 0x47, 0x46, 0xff, 0xaf, 0xf3, 0xd1, 0x1b, 0xfd, 0xcb, 0xf9, 0x36,
-	0xe1, 0x9a, 0x1b, 0xef, 0xf9, 0xe0, 0x99, 0xe2, 0xcc, 0x2a, 0x4d, 0x8f, 0x7a, 0x15, 0xce, 0xa9,
-	0x43, 0x89, 0x34, 0x40, 0x16, 0xcc, 0x96, 0x93, 0xc7, 0x3f, 0x59, 0x4e, 0x57, 0xae, 0x2c, 0x7f,
-	0xfc, 0xfe, 0xf3, 0x38, 0xf4, 0x8e, 0xc7, 0xa1, 0xf7, 0x75, 0x1c, 0x7a, 0x1f, 0x27, 0x61, 0xe3,
-	0x78, 0x12, 0x36, 0xbe, 0x4c, 0xc2, 0x06, 0x74, 0x13, 0x15, 0x55, 0xff, 0x1a, 0x5e, 0x78, 0x3b,
-	0xf7, 0xf6, 0x12, 0xfb, 0xee, 0x60, 0x37, 0xe2, 0x6a, 0x18, 0x17, 0x45, 0x9b, 0x89, 0x2a, 0x45,
-	0xf1, 0x51, 0xf1, 0x1f, 0xb1, 0x1f, 0x52, 0x34, 0xbb, 0x4b, 0xee, 0x33, 0x72, 0xf7, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x3a, 0xe4, 0x3b, 0xb3, 0x08, 0x00, 0x00,
+func (m *EventContractSpecSourceLocatorAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecSourceLocatorAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecSourceLocatorAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecSourceLocatorAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecSourceLocatorAdded.Merge(m, src)
+}
+func (m *EventContractSpecSourceLocatorAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecSourceLocatorAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecSourceLocatorAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractSpecSourceLocatorAdded proto.InternalMessageInfo
+
+func (m *EventContractSpecSourceLocatorAdded) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventContractSpecSourceLocatorAdded) GetLocatorOwner() string {
+	if m != nil {
+		return m.LocatorOwner
+	}
+	return ""
+}
+
+// EventContractSpecSourceLocatorDeleted is an event message indicating an object store locator has been removed from
+// the source locators of a contract specification.
+type EventContractSpecSourceLocatorDeleted struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+	// locator_owner is the owner of the object store locator that was removed.
+	LocatorOwner string `protobuf:"bytes,2,opt,name=locator_owner,json=locatorOwner,proto3" json:"locator_owner,omitempty"`
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) Reset()         { *m = EventContractSpecSourceLocatorDeleted{} }
+func (m *EventContractSpecSourceLocatorDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecSourceLocatorDeleted) ProtoMessage()    {}
+func (*EventContractSpecSourceLocatorDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
+}
+func (m *EventContractSpecSourceLocatorDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSpecSourceLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecSourceLocatorDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContractSpecSourceLocatorDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecSourceLocatorDeleted.Merge(m, src)
+}
+func (m *EventContractSpecSourceLocatorDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecSourceLocatorDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecSourceLocatorDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractSpecSourceLocatorDeleted proto.InternalMessageInfo
+
+func (m *EventContractSpecSourceLocatorDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) GetLocatorOwner() string {
+	if m != nil {
+		return m.LocatorOwner
+	}
+	return ""
+}
+
+// EventSpecificationDeprecated is an event message indicating a scope or contract specification has been deprecated.
+type EventSpecificationDeprecated struct {
+	// specification_addr is the bech32 address string of the specification id of the deprecated specification.
+	SpecificationAddr string `protobuf:"bytes,1,opt,name=specification_addr,json=specificationAddr,proto3" json:"specification_addr,omitempty"`
+	// successor_addr is the bech32 address string of the specification that replaces it, empty if there is none.
+	SuccessorAddr string `protobuf:"bytes,2,opt,name=successor_addr,json=successorAddr,proto3" json:"successor_addr,omitempty"`
+}
+
+func (m *EventSpecificationDeprecated) Reset()         { *m = EventSpecificationDeprecated{} }
+func (m *EventSpecificationDeprecated) String() string { return proto.CompactTextString(m) }
+func (*EventSpecificationDeprecated) ProtoMessage()    {}
+func (*EventSpecificationDeprecated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
+}
+func (m *EventSpecificationDeprecated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpecificationDeprecated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpecificationDeprecated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpecificationDeprecated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpecificationDeprecated.Merge(m, src)
+}
+func (m *EventSpecificationDeprecated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpecificationDeprecated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpecificationDeprecated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpecificationDeprecated proto.InternalMessageInfo
+
+func (m *EventSpecificationDeprecated) GetSpecificationAddr() string {
+	if m != nil {
+		return m.SpecificationAddr
+	}
+	return ""
+}
+
+func (m *EventSpecificationDeprecated) GetSuccessorAddr() string {
+	if m != nil {
+		return m.SuccessorAddr
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
+	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
+	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
+	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeOwnershipTransferred)(nil), "provenance.metadata.v1.EventScopeOwnershipTransferred")
+	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
+	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
+	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
+	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
+	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
+	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventRecordRedacted)(nil), "provenance.metadata.v1.EventRecordRedacted")
+	proto.RegisterType((*EventScopeArchived)(nil), "provenance.metadata.v1.EventScopeArchived")
+	proto.RegisterType((*EventScopeRestored)(nil), "provenance.metadata.v1.EventScopeRestored")
+	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
+	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
+	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
+	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
+	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
+	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
+	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
+	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
+	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
+	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+	proto.RegisterType((*EventContractSpecSourceLocatorAdded)(nil), "provenance.metadata.v1.EventContractSpecSourceLocatorAdded")
+	proto.RegisterType((*EventContractSpecSourceLocatorDeleted)(nil), "provenance.metadata.v1.EventContractSpecSourceLocatorDeleted")
+	proto.RegisterType((*EventSpecificationDeprecated)(nil), "provenance.metadata.v1.EventSpecificationDeprecated")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+}
+
+var fileDescriptor_476cf6cf9459cf25 = []byte{
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x4e, 0x03, 0x45,
+	0x14, 0xee, 0xb6, 0x50, 0xe4, 0xf0, 0xa3, 0x2c, 0x88, 0x5b, 0x84, 0x02, 0x25, 0x24, 0xdc, 0xd0,
+	0x06, 0xf4, 0xc2, 0x68, 0xa2, 0x29, 0x68, 0xe2, 0x05, 0x09, 0xa4, 0x2d, 0x98, 0x70, 0x53, 0x87,
+	0xd9, 0xa1, 0xdd, 0xd8, 0xee, 0x6c, 0x66, 0xa6, 0x0b, 0xbc, 0x82, 0xf1, 0xc2, 0x17, 0xf0, 0x7d,
+	0xb8, 0xe4, 0xd2, 0x2b, 0x63, 0xe0, 0x3d, 0x8c, 0xd9, 0xf9, 0x69, 0xb7, 0x7f, 0x2c, 0x52, 0x50,
+	0xef, 0x3a, 0x67, 0xcf, 0xf9, 0xbe, 0xaf, 0xdf, 0x39, 0x73, 0xb2, 0x0b, 0x3b, 0x01, 0xa3, 0x21,
+	0xf1, 0x91, 0x8f, 0x49, 0xa9, 0x4d, 0x04, 0x72, 0x91, 0x40, 0xa5, 0xf0, 0xa0, 0x44, 0x42, 0xe2,
+	0x0b, 0x5e, 0x0c, 0x18, 0x15, 0xd4, 0x5e, 0xed, 0x25, 0x15, 0x4d, 0x52, 0x31, 0x3c, 0x58, 0x5b,
+	0x69, 0xd0, 0x06, 0x95, 0x29, 0xa5, 0xe8, 0x97, 0xca, 0x5e, 0x2b, 0x8c, 0x81, 0xe4, 0x98, 0x06,
+	0x44, 0xe5, 0x14, 0x7e, 0x84, 0x8f, 0xbe, 0x8b, 0x18, 0x6a, 0xb7, 0xc7, 0xb4, 0x1d, 0xb4, 0x88,
+	0x20, 0xae, 0xbd, 0x0a, 0xd9, 0x36, 0x75, 0x3b, 0x2d, 0xe2, 0x58, 0x5b, 0xd6, 0xde, 0x6c, 0x45,
+	0x9f, 0xec, 0x35, 0xf8, 0x80, 0xf8, 0x6e, 0x40, 0x3d, 0x5f, 0x38, 0x69, 0xf9, 0xa4, 0x7b, 0xb6,
+	0x1d, 0x98, 0xe1, 0x5e, 0xc3, 0x27, 0x8c, 0x3b, 0x99, 0xad, 0xcc, 0xde, 0x6c, 0xc5, 0x1c, 0x0b,
+	0x87, 0xb0, 0x24, 0x19, 0xaa, 0x11, 0xeb, 0x31, 0x23, 0x28, 0xa2, 0xd8, 0x00, 0x90, 0x2a, 0xea,
+	0xc8, 0x75, 0x99, 0xa6, 0x99, 0x95, 0x91, 0xb2, 0xeb, 0xb2, 0xfe, 0x9a, 0xf3, 0xc0, 0xfd, 0xc7,
+	0x35, 0xdf, 0x12, 0xf5, 0x57, 0x12, 0x6a, 0xfe, 0x4a, 0x43, 0xbe, 0x57, 0x74, 0x7a, 0x13, 0x09,
+	0x6e, 0x7a, 0x41, 0x8d, 0x21, 0x9f, 0x5f, 0x13, 0xc6, 0x12, 0x11, 0xec, 0x13, 0xf8, 0x30, 0x60,
+	0x24, 0xf4, 0x68, 0x87, 0xd7, 0xa9, 0xac, 0x77, 0xd2, 0x5b, 0x99, 0xbd, 0xb9, 0xc3, 0x8d, 0xe2,
+	0xe8, 0x5e, 0x15, 0xcf, 0x10, 0x13, 0x77, 0x47, 0x53, 0xf7, 0x7f, 0x6c, 0xa6, 0x2a, 0x8b, 0xa6,
+	0x56, 0x51, 0xdb, 0x5f, 0x41, 0x56, 0x83, 0x64, 0x5e, 0x0e, 0xa2, 0x4b, 0xec, 0x6f, 0x60, 0xbd,
+	0x2b, 0x25, 0x44, 0xad, 0x0e, 0x51, 0x82, 0xa4, 0x70, 0xc2, 0xb9, 0x33, 0x25, 0xb5, 0xe7, 0x4c,
+	0xce, 0x45, 0x94, 0x22, 0x79, 0xcb, 0x2a, 0xc1, 0x2e, 0xc2, 0xf2, 0xa8, 0xba, 0x69, 0x59, 0xb7,
+	0x14, 0x0e, 0xe5, 0x47, 0xf3, 0x10, 0x7a, 0x2e, 0xf1, 0x31, 0x71, 0xb2, 0x7a, 0x1e, 0xf4, 0xd9,
+	0xde, 0x85, 0xc5, 0x80, 0xd1, 0x80, 0x72, 0xd4, 0xaa, 0x0b, 0x4f, 0xb4, 0x88, 0x33, 0x23, 0x33,
+	0x16, 0x4c, 0xb4, 0x16, 0x05, 0x0b, 0x3f, 0xc0, 0xb2, 0xf2, 0x9f, 0x70, 0xee, 0x51, 0xdf, 0x8c,
+	0xc7, 0x36, 0xcc, 0x73, 0x15, 0x89, 0xdb, 0x3e, 0xa7, 0x63, 0xd2, 0xf8, 0xfe, 0xbe, 0xa4, 0x07,
+	0x3b, 0x3b, 0x00, 0x6c, 0x66, 0xe8, 0xcd, 0x81, 0xcd, 0xa0, 0x4d, 0x0e, 0x7c, 0x03, 0xb6, 0x04,
+	0xae, 0x10, 0x4c, 0x99, 0x6b, 0x9c, 0xd8, 0x84, 0x39, 0x26, 0x03, 0x71, 0x58, 0x50, 0x21, 0x89,
+	0x3a, 0x48, 0x9c, 0x4e, 0x22, 0xce, 0x3c, 0x4f, 0x6c, 0x9c, 0xfa, 0x17, 0x88, 0x6b, 0x7d, 0xc4,
+	0xc6, 0xc9, 0x44, 0xe2, 0x04, 0xd4, 0xb6, 0x6e, 0x90, 0x42, 0xad, 0x10, 0x17, 0xe1, 0x57, 0xc0,
+	0x0e, 0xb6, 0x27, 0x5a, 0x8a, 0x8c, 0x20, 0x4e, 0x7d, 0xcd, 0xa8, 0x4f, 0x5d, 0xf7, 0xe4, 0x06,
+	0x29, 0x33, 0xdc, 0xf4, 0xc2, 0xe4, 0xad, 0xb1, 0x0d, 0xf3, 0x98, 0xfa, 0x82, 0xf8, 0xa2, 0xde,
+	0x44, 0xbc, 0x69, 0xbc, 0xd3, 0xb1, 0xef, 0x11, 0x6f, 0x46, 0x7a, 0x5b, 0x14, 0x23, 0x41, 0x59,
+	0xbd, 0xc3, 0x3c, 0x4d, 0x0a, 0x3a, 0x74, 0xce, 0xbc, 0xc2, 0x45, 0x9c, 0xb8, 0x42, 0xb8, 0xa0,
+	0xec, 0x2d, 0x88, 0x0b, 0x97, 0xf1, 0x95, 0x58, 0x0d, 0x08, 0xf6, 0xae, 0x3d, 0x8c, 0x44, 0xec,
+	0x76, 0x7e, 0x01, 0x8e, 0xe2, 0xe0, 0xf1, 0xa7, 0x71, 0xc6, 0x55, 0x3e, 0x54, 0x2c, 0x7b, 0x33,
+	0x1e, 0xdb, 0x8c, 0xdd, 0x7b, 0x60, 0x9b, 0xc9, 0x7a, 0x3d, 0x36, 0x86, 0x6d, 0x89, 0x7d, 0x4c,
+	0x7d, 0xc1, 0x10, 0x16, 0x23, 0x6d, 0xf9, 0x1a, 0x3e, 0xc5, 0xfa, 0xf9, 0x78, 0x86, 0x1c, 0x1e,
+	0x05, 0x91, 0x4c, 0x62, 0xfc, 0x79, 0x57, 0x12, 0x63, 0xd4, 0xa4, 0x24, 0xbf, 0x59, 0xb0, 0x19,
+	0xbb, 0x83, 0x23, 0xdd, 0xfa, 0x12, 0x72, 0xfa, 0x3e, 0x8e, 0x65, 0xf8, 0x84, 0x0d, 0x97, 0xcb,
+	0x29, 0x4e, 0xd0, 0x97, 0x9e, 0x44, 0x9f, 0x31, 0xfa, 0xff, 0xaa, 0xcf, 0xf4, 0xe8, 0xbf, 0xd4,
+	0xb7, 0x0f, 0x1f, 0x4b, 0x79, 0xa7, 0xd5, 0x13, 0xb5, 0x8f, 0x4c, 0x53, 0x57, 0x60, 0x5a, 0xbe,
+	0x3b, 0x68, 0x01, 0xea, 0x30, 0x9c, 0x6e, 0x3c, 0x7e, 0x61, 0xba, 0xf9, 0xcb, 0xa3, 0xd3, 0x7f,
+	0xb6, 0x60, 0x67, 0x68, 0xa4, 0xab, 0xb4, 0xc3, 0x30, 0xd1, 0xf5, 0x65, 0xd7, 0x9d, 0x7c, 0xa8,
+	0xed, 0x1d, 0x58, 0x30, 0x0b, 0x59, 0xa9, 0x50, 0x36, 0xcd, 0xeb, 0xa0, 0x7c, 0x33, 0x2a, 0xfc,
+	0x62, 0xc1, 0xee, 0xf3, 0x62, 0xde, 0xe8, 0x8e, 0xbd, 0x4c, 0x8e, 0x80, 0x75, 0xb5, 0x13, 0xfb,
+	0x27, 0x28, 0x60, 0x04, 0xcb, 0x06, 0xec, 0x83, 0x3d, 0x96, 0x7b, 0x89, 0x0f, 0x71, 0xee, 0xc2,
+	0x22, 0xef, 0x60, 0x4c, 0x38, 0xa7, 0x2c, 0x3e, 0x2a, 0x0b, 0xdd, 0x68, 0x94, 0x76, 0xf4, 0xd3,
+	0xfd, 0x63, 0xde, 0x7a, 0x78, 0xcc, 0x5b, 0x7f, 0x3e, 0xe6, 0xad, 0x5f, 0x9f, 0xf2, 0xa9, 0x87,
+	0xa7, 0x7c, 0xea, 0xf7, 0xa7, 0x7c, 0x0a, 0x72, 0x1e, 0x1d, 0xf3, 0x46, 0x7b, 0x66, 0x5d, 0x7e,
+	0xde, 0xf0, 0x44, 0xb3, 0x73, 0x55, 0xc4, 0xb4, 0x5d, 0xea, 0x25, 0xed, 0x7b, 0x34, 0x76, 0x2a,
+	0xdd, 0xf6, 0xbe, 0x64, 0xc4, 0x5d, 0x40, 0xf8, 0x55, 0x56, 0x7e, 0xc7, 0x7c, 0xf6, 0x77, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x63, 0xc7, 0x22, 0xbc, 0x40, 0x0d, 0x00, 0x00,
+}
+
+func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -1364,6 +1844,92 @@ func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScopeOwnershipTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeOwnershipTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeOwnershipTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalTitle) > 0 {
+		i -= len(m.ProposalTitle)
+		copy(dAtA[i:], m.ProposalTitle)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposalTitle)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PreviousValueOwnerAddress) > 0 {
+		i -= len(m.PreviousValueOwnerAddress)
+		copy(dAtA[i:], m.PreviousValueOwnerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PreviousOwners) > 0 {
+		for iNdEx := len(m.PreviousOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1600,7 +2166,7 @@ func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordRedacted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1610,27 +2176,41 @@ func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordRedacted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordRedacted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeArchived) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1640,27 +2220,41 @@ func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeArchived) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.LocatorUri) > 0 {
+		i -= len(m.LocatorUri)
+		copy(dAtA[i:], m.LocatorUri)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LocatorUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeRestored) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1670,27 +2264,34 @@ func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeRestored) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeRestored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1700,27 +2301,27 @@ func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1730,27 +2331,27 @@ func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1760,18 +2361,108 @@ func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
 		copy(dAtA[i:], m.ContractSpecificationAddr)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
 		i--
@@ -1981,6 +2672,117 @@ func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventContractSpecSourceLocatorAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecSourceLocatorAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecSourceLocatorAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocatorOwner) > 0 {
+		i -= len(m.LocatorOwner)
+		copy(dAtA[i:], m.LocatorOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LocatorOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocatorOwner) > 0 {
+		i -= len(m.LocatorOwner)
+		copy(dAtA[i:], m.LocatorOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LocatorOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSpecificationDeprecated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpecificationDeprecated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpecificationDeprecated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuccessorAddr) > 0 {
+		i -= len(m.SuccessorAddr)
+		copy(dAtA[i:], m.SuccessorAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SuccessorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecificationAddr) > 0 {
+		i -= len(m.SpecificationAddr)
+		copy(dAtA[i:], m.SpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -2054,6 +2856,47 @@ func (m *EventScopeDeleted) Size() (n int) {
 	return n
 }
 
+func (m *EventScopeOwnershipTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PreviousOwners) > 0 {
+		for _, e := range m.PreviousOwners {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.PreviousValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProposalTitle)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSessionCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2164,94 +3007,110 @@ func (m *EventRecordDeleted) Size() (n int) {
 	return n
 }
 
-func (m *EventScopeSpecificationCreated) Size() (n int) {
+func (m *EventRecordRedacted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
+func (m *EventScopeArchived) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.LocatorUri)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
+func (m *EventScopeRestored) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContentHash)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
+func (m *EventScopeSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventRecordSpecificationCreated) Size() (n int) {
+func (m *EventContractSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -2259,17 +3118,60 @@ func (m *EventRecordSpecificationCreated) Size() (n int) {
 	return n
 }
 
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
+func (m *EventContractSpecificationUpdated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
+	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContractSpecificationAddr)
+	return n
+}
+
+func (m *EventContractSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -2332,6 +3234,57 @@ func (m *EventOSLocatorDeleted) Size() (n int) {
 	return n
 }
 
+func (m *EventContractSpecSourceLocatorAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.LocatorOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecSourceLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.LocatorOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSpecificationDeprecated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SuccessorAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2369,7 +3322,1019 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeOwnershipTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeOwnershipTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeOwnershipTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwners = append(m.PreviousOwners, Party{})
+			if err := m.PreviousOwners[len(m.PreviousOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2397,11 +4362,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2429,11 +4394,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2461,7 +4426,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2484,7 +4449,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2507,15 +4472,15 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2543,61 +4508,11 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2625,59 +4540,9 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2730,7 +4595,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2753,15 +4618,15 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2789,9 +4654,9 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2844,7 +4709,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordRedacted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,15 +4732,15 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordRedacted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordRedacted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2903,7 +4768,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2937,6 +4802,38 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2958,7 +4855,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeArchived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,15 +4878,15 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeArchived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeArchived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3017,11 +4914,11 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3049,7 +4946,39 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocatorUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3072,7 +5001,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeRestored) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3095,47 +5024,15 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeRestored: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeRestored: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3163,11 +5060,11 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3195,7 +5092,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3218,7 +5115,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,15 +5138,15 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3277,43 +5174,61 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3341,7 +5256,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3364,7 +5279,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3387,15 +5302,15 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3423,11 +5338,61 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3455,7 +5420,7 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3478,7 +5443,7 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3501,15 +5466,15 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3537,7 +5502,7 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3560,7 +5525,7 @@ func (m *EventScopeSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3583,15 +5548,15 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3619,7 +5584,7 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3642,7 +5607,7 @@ func (m *EventScopeSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3665,15 +5630,15 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3701,7 +5666,39 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3724,7 +5721,7 @@ func (m *EventScopeSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3743,17 +5740,49 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
@@ -3806,7 +5835,7 @@ func (m *EventContractSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3829,13 +5858,45 @@ func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
@@ -3888,7 +5949,7 @@ func (m *EventContractSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3911,15 +5972,15 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOSLocatorCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOSLocatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3947,7 +6008,7 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3970,7 +6031,7 @@ func (m *EventContractSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3993,47 +6054,15 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOSLocatorUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOSLocatorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4061,7 +6090,7 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4084,7 +6113,7 @@ func (m *EventRecordSpecificationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4107,47 +6136,15 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOSLocatorDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOSLocatorDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4175,7 +6172,7 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4198,7 +6195,7 @@ func (m *EventRecordSpecificationUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecSourceLocatorAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4221,15 +6218,15 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecSourceLocatorAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordSpecificationDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecSourceLocatorAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4257,11 +6254,11 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4289,7 +6286,7 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			m.LocatorOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4312,7 +6309,7 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
+func (m *EventContractSpecSourceLocatorDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4335,15 +6332,15 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractSpecSourceLocatorDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractSpecSourceLocatorDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4371,61 +6368,11 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4453,7 +6400,7 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.LocatorOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4476,7 +6423,7 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventSpecificationDeprecated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4499,15 +6446,15 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOSLocatorDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSpecificationDeprecated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOSLocatorDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSpecificationDeprecated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4535,7 +6482,39 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.SpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// MarkerKeeper defines the expected marker keeper used to represent value ownership of a scope as a coin (noalias)
+type MarkerKeeper interface {
+	IssueSingleCoin(ctx sdk.Context, denom string, recipient sdk.AccAddress) error
+	HoldsSingleCoin(ctx sdk.Context, denom string, holder sdk.AccAddress) bool
+	BurnSingleCoin(ctx sdk.Context, denom string, holder sdk.AccAddress) error
+}
+
+// NameKeeper defines the expected name keeper used to resolve names to the owners of object store locators (noalias)
+type NameKeeper interface {
+	Normalize(ctx sdk.Context, name string) (string, error)
+	GetRecordByName(ctx sdk.Context, name string) (*nametypes.NameRecord, error)
+}
+
+// AttributeKeeper defines the expected attribute keeper used to check the attributes of scope value owners (noalias)
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, acc sdk.AccAddress, name string) ([]attributetypes.Attribute, error)
+}
+
+// DistrKeeper defines the expected distribution keeper used to send storage fees to the community pool (noalias)
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
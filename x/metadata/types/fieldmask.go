@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// requestField is the response field holding a copy of the request, it is always returned.
+const requestField = "request"
+
+// fieldMask is the tree of the paths of a field mask keyed by field name. A nil sub-tree selects the whole field.
+type fieldMask map[string]fieldMask
+
+// newFieldMask builds the tree of the dot separated field paths.
+func newFieldMask(paths []string) fieldMask {
+	mask := fieldMask{}
+	for _, path := range paths {
+		node := mask
+		names := strings.Split(strings.TrimSpace(path), ".")
+		for i, name := range names {
+			sub, found := node[name]
+			if found && sub == nil {
+				// A shorter path already selects the whole field.
+				break
+			}
+			if i == len(names)-1 {
+				node[name] = nil
+				break
+			}
+			if !found {
+				sub = fieldMask{}
+				node[name] = sub
+			}
+			node = sub
+		}
+	}
+	return mask
+}
+
+// FieldMaskIncludes returns true if the field mask paths are empty or select any part of the top level field.
+func FieldMaskIncludes(paths []string, field string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	_, found := newFieldMask(paths)[field]
+	return found
+}
+
+// ValidateFieldMask returns an error if any of the field mask paths does not name a field of the message.
+func ValidateFieldMask(msg interface{}, paths []string) error {
+	for _, path := range paths {
+		if len(strings.TrimSpace(path)) == 0 {
+			return fmt.Errorf("invalid field mask: empty path")
+		}
+	}
+	return newFieldMask(paths).validate(reflect.TypeOf(msg), "")
+}
+
+// ApplyFieldMask clears the fields of the message, a pointer to a generated proto struct, that are not selected by the
+// field mask paths. Each path is the dot separated proto names of nested fields, e.g. "scope.scope.owners", and the
+// rest of a path is applied to each entry of a repeated field. The request field of a response is always kept. An
+// empty field mask leaves the message unchanged.
+func ApplyFieldMask(msg interface{}, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	if err := ValidateFieldMask(msg, paths); err != nil {
+		return err
+	}
+	mask := newFieldMask(paths)
+	if _, found := mask[requestField]; !found {
+		mask[requestField] = nil
+	}
+	mask.apply(reflect.ValueOf(msg))
+	return nil
+}
+
+// validate returns an error if the mask names a field the type does not have.
+func (m fieldMask) validate(t reflect.Type, prefix string) error {
+	t = messageType(t)
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("invalid field mask: %s has no fields", strings.TrimSuffix(prefix, "."))
+	}
+	fields := protoFields(t)
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		i, found := fields[name]
+		if !found {
+			return fmt.Errorf("invalid field mask: unknown field %s%s", prefix, name)
+		}
+		if sub := m[name]; sub != nil {
+			if err := sub.validate(t.Field(i).Type, prefix+name+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply clears the fields of the value that are not in the mask.
+func (m fieldMask) apply(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			m.apply(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			m.apply(v.Index(i))
+		}
+	case reflect.Struct:
+		for name, i := range protoFields(v.Type()) {
+			sub, found := m[name]
+			switch {
+			case !found:
+				v.Field(i).Set(reflect.Zero(v.Field(i).Type()))
+			case sub != nil:
+				sub.apply(v.Field(i))
+			}
+		}
+	}
+}
+
+// messageType returns the struct type of the entries of a (repeated) message field.
+func messageType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
+
+// protoFields returns the index of each proto field of a generated struct keyed by its proto name. A oneof field is
+// keyed by the name of the oneof.
+func protoFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if name, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+			fields[name] = i
+			continue
+		}
+		for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(part, "name=") {
+				fields[strings.TrimPrefix(part, "name=")] = i
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFieldMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		err   string
+	}{
+		{"empty", nil, ""},
+		{"top level field", []string{"sessions"}, ""},
+		{"nested fields", []string{"scope.scope.owners", "sessions.session.specification_id"}, ""},
+		{"oneof", []string{"records.record.process.process_id"}, ""},
+		{"request", []string{"request.scope_id"}, ""},
+		{"empty path", []string{"scope", " "}, "invalid field mask: empty path"},
+		{"unknown field", []string{"scope.owners"}, "invalid field mask: unknown field scope.owners"},
+		{"unknown nested field", []string{"scope.scope.owners.nope"}, "invalid field mask: unknown field scope.scope.owners.nope"},
+		{"not a message", []string{"scope.scope.value_owner_address.length"}, "invalid field mask: scope.scope.value_owner_address has no fields"},
+		{"go field name", []string{"Scope"}, "invalid field mask: unknown field Scope"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateFieldMask(&ScopeResponse{}, tc.paths)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFieldMaskIncludes(t *testing.T) {
+	assert.True(t, FieldMaskIncludes(nil, "records"), "empty field mask")
+	assert.True(t, FieldMaskIncludes([]string{"records"}, "records"), "whole field")
+	assert.True(t, FieldMaskIncludes([]string{"scope", "records.record.name"}, "records"), "nested field")
+	assert.False(t, FieldMaskIncludes([]string{"scope.scope.owners"}, "records"), "other field")
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	owners := []Party{{Address: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", Role: PartyType_PARTY_TYPE_OWNER}}
+	newResponse := func() *ScopeResponse {
+		return &ScopeResponse{
+			Scope: WrapScope(NewScope(scopeID, scopeSpecID, owners, nil, "")),
+			Sessions: []*SessionWrapper{
+				WrapSession(NewSession("session", sessionID, contractSpecID, owners, nil)),
+			},
+			Records: []*RecordWrapper{
+				WrapRecord(NewRecord("record", sessionID, *NewProcess("proc", &Process_Hash{Hash: "HASH"}, "method"), nil, nil, nil)),
+			},
+			Request: &ScopeRequest{ScopeId: scopeID.String()},
+		}
+	}
+
+	res := newResponse()
+	require.NoError(t, ApplyFieldMask(res, nil))
+	require.Equal(t, newResponse(), res, "empty field mask")
+
+	res = newResponse()
+	require.NoError(t, ApplyFieldMask(res, []string{"scope.scope.owners", "sessions.session.specification_id"}))
+	expected := newResponse()
+	assert.Equal(t, &ScopeResponse{
+		Scope:    &ScopeWrapper{Scope: &Scope{Owners: owners}},
+		Sessions: []*SessionWrapper{{Session: &Session{SpecificationId: expected.Sessions[0].Session.SpecificationId}}},
+		Request:  expected.Request,
+	}, res, "owners and session specs")
+
+	res = newResponse()
+	require.NoError(t, ApplyFieldMask(res, []string{"records.record.process", "records.record", "scope.scope_id_info"}))
+	assert.Nil(t, res.Scope.Scope, "scope not in field mask")
+	assert.Equal(t, expected.Scope.ScopeIdInfo, res.Scope.ScopeIdInfo, "scope id info")
+	assert.Nil(t, res.Sessions, "sessions")
+	assert.Equal(t, expected.Records[0].Record, res.Records[0].Record, "whole record selected by the shorter path")
+	assert.Nil(t, res.Records[0].RecordIdInfo, "record id info")
+
+	res = newResponse()
+	require.EqualError(t, ApplyFieldMask(res, []string{"owners"}), "invalid field mask: unknown field owners")
+	require.Equal(t, newResponse(), res, "invalid field mask")
+}
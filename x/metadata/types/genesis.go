@@ -1,7 +1,22 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
+	for i, d := range state.SpecificationDeprecations {
+		if err := d.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid specification deprecation at index %d: %w", i, err)
+		}
+	}
+	for i, a := range state.ScopeArchives {
+		if err := a.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope archive at index %d: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -28,14 +28,17 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of metadata scopes and specs to create on start
-	Scopes                 []Scope                 `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes"`
-	Sessions               []Session               `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions"`
-	Records                []Record                `protobuf:"bytes,4,rep,name=records,proto3" json:"records"`
-	ScopeSpecifications    []ScopeSpecification    `protobuf:"bytes,5,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications"`
-	ContractSpecifications []ContractSpecification `protobuf:"bytes,6,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications"`
-	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
-	OSLocatorParams        OSLocatorParams         `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
-	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	Scopes                     []Scope                     `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes"`
+	Sessions                   []Session                   `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions"`
+	Records                    []Record                    `protobuf:"bytes,4,rep,name=records,proto3" json:"records"`
+	ScopeSpecifications        []ScopeSpecification        `protobuf:"bytes,5,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications"`
+	ContractSpecifications     []ContractSpecification     `protobuf:"bytes,6,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications"`
+	RecordSpecifications       []RecordSpecification       `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
+	OSLocatorParams            OSLocatorParams             `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
+	ObjectStoreLocators        []ObjectStoreLocator        `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	ContractSpecSourceLocators []ContractSpecSourceLocator `protobuf:"bytes,10,rep,name=contract_spec_source_locators,json=contractSpecSourceLocators,proto3" json:"contract_spec_source_locators"`
+	SpecificationDeprecations  []SpecificationDeprecation  `protobuf:"bytes,11,rep,name=specification_deprecations,json=specificationDeprecations,proto3" json:"specification_deprecations"`
+	ScopeArchives              []ScopeArchive              `protobuf:"bytes,12,rep,name=scope_archives,json=scopeArchives,proto3" json:"scope_archives"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,37 +83,42 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0x77, 0x69, 0xd9, 0x06, 0x17, 0x09, 0xc9, 0xa4, 0x65, 0xa9, 0xc4, 0xa6, 0xaa, 0x40,
-	0x44, 0x45, 0xdd, 0x55, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x17, 0xa4, 0x56, 0xdd, 0x5b, 0x2f,
-	0x2b, 0xc7, 0x71, 0x83, 0xa1, 0xd9, 0x59, 0x79, 0x4c, 0x04, 0x6f, 0xc0, 0x11, 0x89, 0x17, 0xe8,
-	0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x51, 0xc5, 0xf6, 0x36, 0xcd, 0x1f, 0xe7,
-	0x96, 0xec, 0xfc, 0xbe, 0xef, 0x9b, 0xb1, 0xc7, 0xe4, 0x69, 0xa5, 0x60, 0x20, 0x4a, 0x56, 0x72,
-	0x91, 0xf5, 0x85, 0x66, 0x5d, 0xa6, 0x59, 0x36, 0xd8, 0xcf, 0x7a, 0xa2, 0x14, 0x28, 0x31, 0xad,
-	0x14, 0x68, 0xa0, 0x9b, 0x13, 0x2a, 0xad, 0xa9, 0x74, 0xb0, 0xbf, 0xd5, 0xec, 0x41, 0x0f, 0x0c,
-	0x92, 0x8d, 0x7f, 0x59, 0x7a, 0xeb, 0x99, 0xc7, 0xf3, 0x46, 0x69, 0xb1, 0x1d, 0x0f, 0x86, 0x1c,
-	0x2a, 0xe1, 0x98, 0x5d, 0x1f, 0x53, 0x09, 0x2e, 0xcf, 0x24, 0x67, 0x5a, 0x42, 0xe9, 0xd8, 0xb6,
-	0x87, 0x85, 0xce, 0x17, 0xc1, 0x35, 0x6a, 0x50, 0xce, 0x75, 0xe7, 0x77, 0x44, 0xee, 0x7f, 0xb4,
-	0x03, 0xe6, 0x9a, 0x69, 0x41, 0xdf, 0x92, 0xa8, 0x62, 0x8a, 0xf5, 0x31, 0x0e, 0xb7, 0xc3, 0xf6,
-	0xfa, 0x41, 0x92, 0x2e, 0x1e, 0x38, 0x3d, 0x36, 0xd4, 0xe1, 0xea, 0xe5, 0xdf, 0x56, 0x70, 0xe2,
-	0x34, 0xf4, 0x0d, 0x89, 0x4c, 0xcf, 0x18, 0xdf, 0xd9, 0x5e, 0x69, 0xaf, 0x1f, 0x3c, 0xf1, 0xa9,
-	0xf3, 0x31, 0x55, 0x8b, 0xad, 0x84, 0xbe, 0x27, 0x0d, 0x14, 0x88, 0x12, 0x4a, 0x8c, 0x57, 0x8c,
-	0xbc, 0xe5, 0x95, 0x5b, 0xce, 0x19, 0xdc, 0xc8, 0xe8, 0x3b, 0xb2, 0xa6, 0x04, 0x07, 0xd5, 0xc5,
-	0x78, 0xd5, 0x38, 0x78, 0xdb, 0x3f, 0x31, 0x98, 0x33, 0xa8, 0x45, 0x94, 0x93, 0xa6, 0x69, 0xa6,
-	0x98, 0x3a, 0x55, 0x8c, 0xef, 0x1a, 0xb3, 0xdd, 0xa5, 0xd3, 0xe4, 0xb7, 0x25, 0xce, 0xf8, 0x21,
-	0xce, 0x55, 0x90, 0x9e, 0x93, 0x47, 0x1c, 0x4a, 0xad, 0x18, 0xd7, 0xb3, 0x39, 0x91, 0xc9, 0xd9,
-	0xf3, 0xe5, 0x7c, 0x70, 0xb2, 0x45, 0x51, 0x9b, 0x7c, 0x51, 0x11, 0xe9, 0x19, 0xd9, 0xb0, 0xd3,
-	0xcd, 0x66, 0xad, 0x99, 0xac, 0x17, 0xcb, 0x0f, 0x68, 0x51, 0x52, 0x53, 0xcd, 0x97, 0x90, 0x9e,
-	0x12, 0x0a, 0x05, 0x16, 0xe7, 0xc0, 0x99, 0x06, 0x55, 0xb8, 0x25, 0x6a, 0x98, 0x25, 0x7a, 0xee,
-	0x0b, 0x39, 0xca, 0x3f, 0x59, 0x7e, 0x6a, 0x9b, 0x1e, 0xc0, 0xf4, 0x67, 0xda, 0x25, 0x1b, 0x76,
-	0x75, 0x0b, 0xb3, 0xbb, 0x75, 0x08, 0xc6, 0xf7, 0x96, 0xdf, 0xcb, 0x91, 0x11, 0xe5, 0x63, 0x8d,
-	0x33, 0xac, 0xef, 0x05, 0xe6, 0x2a, 0xf8, 0xba, 0xf1, 0xf3, 0xa2, 0x15, 0xfc, 0xbf, 0x68, 0x05,
-	0x87, 0x5f, 0x2f, 0x87, 0x49, 0x78, 0x35, 0x4c, 0xc2, 0x7f, 0xc3, 0x24, 0xfc, 0x35, 0x4a, 0x82,
-	0xab, 0x51, 0x12, 0xfc, 0x19, 0x25, 0x01, 0x79, 0x2c, 0xc1, 0x13, 0x76, 0x1c, 0x9e, 0xbe, 0xea,
-	0x49, 0xfd, 0xf9, 0x5b, 0x27, 0xe5, 0xd0, 0xcf, 0x26, 0xd0, 0x9e, 0x84, 0x5b, 0xff, 0xb2, 0xef,
-	0x93, 0x17, 0xa9, 0x7f, 0x54, 0x02, 0x3b, 0x91, 0x79, 0x89, 0x2f, 0xaf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xcc, 0x7d, 0x59, 0x80, 0x04, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xed, 0xbf, 0xfd, 0xd3, 0x30, 0x2d, 0x20, 0x0d, 0x6d, 0x71, 0x23, 0xd5, 0xa9, 0xaa,
+	0x22, 0xa2, 0xa2, 0xda, 0xa4, 0xb0, 0x02, 0x84, 0xd4, 0x82, 0xc4, 0x06, 0xa9, 0xa5, 0xde, 0x75,
+	0x63, 0x4d, 0x26, 0xd3, 0xd4, 0xd0, 0xf8, 0x5a, 0x73, 0x27, 0x11, 0xf0, 0x04, 0x2c, 0x79, 0x84,
+	0x3e, 0x4e, 0x97, 0x5d, 0xc2, 0x06, 0xa1, 0x64, 0xc3, 0x63, 0xa0, 0x8c, 0xc7, 0x4d, 0xdc, 0x64,
+	0x22, 0x76, 0x89, 0xef, 0x77, 0xce, 0x99, 0xf1, 0x3d, 0x32, 0xd9, 0xc9, 0x24, 0xf4, 0x45, 0xca,
+	0x52, 0x2e, 0xc2, 0xae, 0x50, 0xac, 0xcd, 0x14, 0x0b, 0xfb, 0xcd, 0xb0, 0x23, 0x52, 0x81, 0x09,
+	0x06, 0x99, 0x04, 0x05, 0x74, 0x7d, 0x4c, 0x05, 0x05, 0x15, 0xf4, 0x9b, 0xb5, 0xd5, 0x0e, 0x74,
+	0x40, 0x23, 0xe1, 0xe8, 0x57, 0x4e, 0xd7, 0x1e, 0x59, 0x3c, 0x6f, 0x94, 0x39, 0xb6, 0x6d, 0xc1,
+	0x90, 0x43, 0x26, 0x0c, 0xb3, 0x6b, 0x63, 0x32, 0xc1, 0x93, 0xb3, 0x84, 0x33, 0x95, 0x40, 0x6a,
+	0xd8, 0x86, 0x85, 0x85, 0xd6, 0x47, 0xc1, 0x15, 0x2a, 0x90, 0xc6, 0x75, 0xfb, 0x67, 0x95, 0xac,
+	0xbc, 0xcb, 0x2f, 0x18, 0x29, 0xa6, 0x04, 0x7d, 0x45, 0x2a, 0x19, 0x93, 0xac, 0x8b, 0x9e, 0xbb,
+	0xe5, 0x36, 0x96, 0xf7, 0xfd, 0x60, 0xf6, 0x85, 0x83, 0x63, 0x4d, 0x1d, 0x2e, 0x5e, 0xfd, 0xaa,
+	0x3b, 0x27, 0x46, 0x43, 0x5f, 0x92, 0x8a, 0x3e, 0x33, 0x7a, 0xff, 0x6d, 0x2d, 0x34, 0x96, 0xf7,
+	0x37, 0x6d, 0xea, 0x68, 0x44, 0x15, 0xe2, 0x5c, 0x42, 0x0f, 0x48, 0x15, 0x05, 0x62, 0x02, 0x29,
+	0x7a, 0x0b, 0x5a, 0x5e, 0xb7, 0xca, 0x73, 0xce, 0x18, 0xdc, 0xc8, 0xe8, 0x6b, 0xb2, 0x24, 0x05,
+	0x07, 0xd9, 0x46, 0x6f, 0x51, 0x3b, 0x58, 0x8f, 0x7f, 0xa2, 0x31, 0x63, 0x50, 0x88, 0x28, 0x27,
+	0xab, 0xfa, 0x30, 0x71, 0xe9, 0xad, 0xa2, 0xf7, 0xbf, 0x36, 0xdb, 0x9d, 0x7b, 0x9b, 0x68, 0x52,
+	0x62, 0x8c, 0x1f, 0xe0, 0xd4, 0x04, 0xe9, 0x05, 0x79, 0xc8, 0x21, 0x55, 0x92, 0x71, 0x75, 0x3b,
+	0xa7, 0xa2, 0x73, 0xf6, 0x6c, 0x39, 0x6f, 0x8c, 0x6c, 0x56, 0xd4, 0x3a, 0x9f, 0x35, 0x44, 0x7a,
+	0x46, 0xd6, 0xf2, 0xdb, 0xdd, 0xce, 0x5a, 0xd2, 0x59, 0x4f, 0xe6, 0xbf, 0xa0, 0x59, 0x49, 0xab,
+	0x72, 0x7a, 0x84, 0xf4, 0x94, 0x50, 0x88, 0x31, 0xbe, 0x00, 0xce, 0x14, 0xc8, 0xd8, 0x94, 0xa8,
+	0xaa, 0x4b, 0xf4, 0xd8, 0x16, 0x72, 0x14, 0xbd, 0xcf, 0xf9, 0x52, 0x9b, 0xee, 0x43, 0xf9, 0x31,
+	0x6d, 0x93, 0xb5, 0xbc, 0xba, 0xb1, 0xee, 0x6e, 0x11, 0x82, 0xde, 0x9d, 0xf9, 0x7b, 0x39, 0xd2,
+	0xa2, 0x68, 0xa4, 0x31, 0x86, 0xc5, 0x5e, 0x60, 0x6a, 0x82, 0xf4, 0x2b, 0xd9, 0x2c, 0xed, 0x25,
+	0x46, 0xe8, 0x49, 0x3e, 0x91, 0x46, 0x74, 0x5a, 0xf3, 0x5f, 0xb6, 0x13, 0x69, 0x69, 0x39, 0xb4,
+	0xc6, 0x6d, 0x00, 0xd2, 0x1e, 0xa9, 0x95, 0xd6, 0x13, 0xb7, 0x45, 0x26, 0x45, 0xb1, 0xaa, 0x65,
+	0x1d, 0xfc, 0xd4, 0x5a, 0xbf, 0x49, 0xe5, 0xdb, 0xb1, 0xd0, 0xe4, 0x6e, 0xa0, 0x65, 0x8e, 0xf4,
+	0x03, 0xb9, 0x97, 0xf7, 0x9d, 0x49, 0x7e, 0x9e, 0xf4, 0x05, 0x7a, 0x2b, 0x3a, 0x6a, 0x67, 0x6e,
+	0xd3, 0x0f, 0x72, 0xd8, 0xd8, 0xdf, 0xc5, 0x89, 0x67, 0xf8, 0xa2, 0xfa, 0xed, 0xb2, 0xee, 0xfc,
+	0xb9, 0xac, 0x3b, 0x87, 0x9f, 0xae, 0x06, 0xbe, 0x7b, 0x3d, 0xf0, 0xdd, 0xdf, 0x03, 0xdf, 0xfd,
+	0x3e, 0xf4, 0x9d, 0xeb, 0xa1, 0xef, 0xfc, 0x18, 0xfa, 0x0e, 0xd9, 0x48, 0xc0, 0x12, 0x70, 0xec,
+	0x9e, 0x3e, 0xef, 0x24, 0xea, 0xbc, 0xd7, 0x0a, 0x38, 0x74, 0xc3, 0x31, 0xb4, 0x97, 0xc0, 0xc4,
+	0xbf, 0xf0, 0xf3, 0xf8, 0xbb, 0xa6, 0xbe, 0x64, 0x02, 0x5b, 0x15, 0xfd, 0x3d, 0x7b, 0xf6, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0x6b, 0xa7, 0x0f, 0xd9, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +141,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeArchives) > 0 {
+		for iNdEx := len(m.ScopeArchives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeArchives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SpecificationDeprecations) > 0 {
+		for iNdEx := len(m.SpecificationDeprecations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecificationDeprecations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ContractSpecSourceLocators) > 0 {
+		for iNdEx := len(m.ContractSpecSourceLocators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecSourceLocators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ObjectStoreLocators) > 0 {
 		for iNdEx := len(m.ObjectStoreLocators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -317,6 +367,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractSpecSourceLocators) > 0 {
+		for _, e := range m.ContractSpecSourceLocators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpecificationDeprecations) > 0 {
+		for _, e := range m.SpecificationDeprecations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeArchives) > 0 {
+		for _, e := range m.ScopeArchives {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -659,6 +727,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecSourceLocators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecSourceLocators = append(m.ContractSpecSourceLocators, ContractSpecSourceLocator{})
+			if err := m.ContractSpecSourceLocators[len(m.ContractSpecSourceLocators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationDeprecations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationDeprecations = append(m.SpecificationDeprecations, SpecificationDeprecation{})
+			if err := m.SpecificationDeprecations[len(m.SpecificationDeprecations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeArchives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeArchives = append(m.ScopeArchives, ScopeArchive{})
+			if err := m.ScopeArchives[len(m.ScopeArchives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+	"sort"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -58,6 +62,16 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x15<owner_address><contract_spec_id>: 0x01
+//
+// - 0x24<sha256(msg_type, idempotency_key, signers)>: <request_hash><result_id>
+//
+// - 0x25<expiration_time><idempotency_store_key>: 0x01
+//
+// - 0x27<specification_id>: SpecificationDeprecation
+//
+// - 0x28<contract_spec_id><session_id>: 0x01
+//
+// - 0x29<scope_id>: ScopeArchive
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -88,6 +102,22 @@ var (
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+	// ContractSpecSourceLocatorKeyPrefix is the key for the object store locators of a contract spec source
+	ContractSpecSourceLocatorKeyPrefix = []byte{0x22}
+	// RecordConsumerCacheKeyPrefix for record lookup by the record referenced as one of its inputs
+	RecordConsumerCacheKeyPrefix = []byte{0x23}
+	// IdempotencyKeyPrefix is the key for the results of writes made with an idempotency key
+	IdempotencyKeyPrefix = []byte{0x24}
+	// IdempotencyExpirationKeyPrefix is a prefix for indexing idempotency keys by expiration time
+	IdempotencyExpirationKeyPrefix = []byte{0x25}
+	// ScopeSizeKeyPrefix is the key for the total size of the sessions and records of a scope
+	ScopeSizeKeyPrefix = []byte{0x26}
+	// SpecificationDeprecationKeyPrefix is the key for the deprecations of scope and contract specifications
+	SpecificationDeprecationKeyPrefix = []byte{0x27}
+	// ContractSpecSessionCacheKeyPrefix for session lookup by contract specification
+	ContractSpecSessionCacheKeyPrefix = []byte{0x28}
+	// ScopeArchiveKeyPrefix is the key for the stubs of scopes whose sessions and records have been archived
+	ScopeArchiveKeyPrefix = []byte{0x29}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -154,3 +184,73 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetContractSpecSourceLocatorIteratorPrefix returns an iterator prefix for all source locators of a given contract spec
+func GetContractSpecSourceLocatorIteratorPrefix(contractSpecID MetadataAddress) []byte {
+	return append(ContractSpecSourceLocatorKeyPrefix, contractSpecID.Bytes()...)
+}
+
+// GetContractSpecSourceLocatorKey returns the store key for a contract spec + object store locator owner entry
+func GetContractSpecSourceLocatorKey(contractSpecID MetadataAddress, owner sdk.AccAddress) []byte {
+	return append(GetContractSpecSourceLocatorIteratorPrefix(contractSpecID), address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// GetRecordConsumerCacheIteratorPrefix returns an iterator prefix for all cache entries of the records with an input
+// referencing the given record
+func GetRecordConsumerCacheIteratorPrefix(recordID MetadataAddress) []byte {
+	return append(RecordConsumerCacheKeyPrefix, recordID.Bytes()...)
+}
+
+// GetRecordConsumerCacheKey returns the store key for a record with an input referencing another record
+func GetRecordConsumerCacheKey(recordID MetadataAddress, consumerID MetadataAddress) []byte {
+	return append(GetRecordConsumerCacheIteratorPrefix(recordID), consumerID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheIteratorPrefix returns an iterator prefix for all session cache entries assigned to a given
+// contract spec
+func GetContractSpecSessionCacheIteratorPrefix(contractSpecID MetadataAddress) []byte {
+	return append(ContractSpecSessionCacheKeyPrefix, contractSpecID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheKey returns the store key for a contract spec + session cache entry
+func GetContractSpecSessionCacheKey(contractSpecID MetadataAddress, sessionID MetadataAddress) []byte {
+	return append(GetContractSpecSessionCacheIteratorPrefix(contractSpecID), sessionID.Bytes()...)
+}
+
+// GetIdempotencyKey returns the store key for the result of a write of the given msg type made by the given signers
+// with an idempotency key.  The parts are hashed so that keys of any length have a fixed size store key.
+func GetIdempotencyKey(msgType string, signers []string, idempotencyKey string) []byte {
+	sorted := make([]string, len(signers))
+	copy(sorted, signers)
+	sort.Strings(sorted)
+	hasher := sha256.New()
+	for _, part := range append([]string{msgType, idempotencyKey}, sorted...) {
+		hasher.Write(address.MustLengthPrefix([]byte(part)))
+	}
+	return append(IdempotencyKeyPrefix, hasher.Sum(nil)...)
+}
+
+// GetIdempotencyExpirationKeyPrefix returns a store key prefix for all idempotency keys that expire at the given time.
+func GetIdempotencyExpirationKeyPrefix(expiration time.Time) []byte {
+	return append(IdempotencyExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetIdempotencyExpirationKey returns a store key for indexing an idempotency store key by its expiration time.
+func GetIdempotencyExpirationKey(expiration time.Time, idempotencyStoreKey []byte) []byte {
+	return append(GetIdempotencyExpirationKeyPrefix(expiration), idempotencyStoreKey...)
+}
+
+// GetScopeSizeKey returns the store key for the total size of the sessions and records of a scope.
+func GetScopeSizeKey(scopeID MetadataAddress) []byte {
+	return append(ScopeSizeKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetSpecificationDeprecationKey returns the store key for the deprecation of a scope or contract specification.
+func GetSpecificationDeprecationKey(specID MetadataAddress) []byte {
+	return append(SpecificationDeprecationKeyPrefix, specID.Bytes()...)
+}
+
+// GetScopeArchiveKey returns the store key for the archive stub of a scope.
+func GetScopeArchiveKey(scopeID MetadataAddress) []byte {
+	return append(ScopeArchiveKeyPrefix, scopeID.Bytes()...)
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +31,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// storage_fee_per_byte is the fee charged for each byte a scope's sessions and records grow by when written. The fee
+	// is paid by the first signer and goes to the community pool. No fee is charged when empty.
+	StorageFeePerByte github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=storage_fee_per_byte,json=storageFeePerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_fee_per_byte" yaml:"storage_fee_per_byte"`
+	// spec_deprecation_grace_period is how long new sessions can still be created against a scope or contract
+	// specification after it is deprecated. New sessions are rejected as soon as a specification is deprecated when zero.
+	SpecDeprecationGracePeriod time.Duration `protobuf:"bytes,2,opt,name=spec_deprecation_grace_period,json=specDeprecationGracePeriod,proto3,stdduration" json:"spec_deprecation_grace_period" yaml:"spec_deprecation_grace_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +71,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetStorageFeePerByte() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageFeePerByte
+	}
+	return nil
+}
+
+func (m *Params) GetSpecDeprecationGracePeriod() time.Duration {
+	if m != nil {
+		return m.SpecDeprecationGracePeriod
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,70 +586,81 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
-	0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0x8e, 0x92, 0xfc, 0xd2, 0x84, 0xf9, 0xe7, 0xa8, 0x76, 0xe2, 0xb8, 0x89, 0xe8, 0xb2, 0xbf,
+	0x02, 0x46, 0xd6, 0x4a, 0x4d, 0x57, 0x60, 0x40, 0x6e, 0x73, 0xdb, 0x2d, 0x45, 0xd1, 0xcd, 0x90,
+	0xb1, 0x01, 0x1b, 0x06, 0x18, 0xb2, 0x44, 0x3b, 0x42, 0x6b, 0xd3, 0x90, 0xe4, 0xa0, 0xc1, 0x0e,
+	0xfb, 0x06, 0xc3, 0x8e, 0x05, 0x76, 0xe9, 0x79, 0x3b, 0xed, 0x23, 0xec, 0xd6, 0x63, 0x81, 0x5d,
+	0x86, 0x1d, 0xd4, 0x2d, 0xd9, 0x61, 0x67, 0x7d, 0x82, 0x41, 0x24, 0x25, 0x52, 0xff, 0x7a, 0xda,
+	0xc9, 0x22, 0xf9, 0xbc, 0xcf, 0x43, 0xbe, 0xcf, 0xab, 0x97, 0x16, 0xb8, 0x3d, 0xf3, 0xc8, 0x39,
+	0x9e, 0x5a, 0x53, 0x1b, 0x1b, 0x13, 0x1c, 0x58, 0x8e, 0x15, 0x58, 0xc6, 0xf9, 0x71, 0xfa, 0xac,
+	0xcf, 0x3c, 0x12, 0x10, 0x75, 0x57, 0xc0, 0xf4, 0x74, 0xe9, 0xfc, 0xb8, 0x55, 0x1f, 0x93, 0x31,
+	0xa1, 0x10, 0x23, 0x7e, 0x62, 0xe8, 0x96, 0x66, 0x13, 0x7f, 0x42, 0x7c, 0x63, 0x68, 0xf9, 0xd8,
+	0x38, 0x3f, 0x1e, 0xe2, 0xc0, 0x3a, 0x36, 0x6c, 0xe2, 0x4e, 0x93, 0xf5, 0x31, 0x21, 0xe3, 0x17,
+	0xd8, 0xa0, 0xa3, 0xe1, 0x7c, 0x64, 0x38, 0x73, 0xcf, 0x0a, 0x5c, 0xc2, 0xd7, 0xd1, 0xaf, 0x8b,
+	0x60, 0xa5, 0x67, 0x79, 0xd6, 0xc4, 0x57, 0x7f, 0x54, 0x40, 0xdd, 0x0f, 0x88, 0x67, 0x8d, 0xf1,
+	0x60, 0x84, 0xf1, 0x60, 0x86, 0xbd, 0xc1, 0xf0, 0x22, 0xc0, 0x4d, 0xa5, 0xbd, 0xd4, 0x59, 0xbf,
+	0xbf, 0xaf, 0x33, 0x29, 0x3d, 0x96, 0xd2, 0xb9, 0x94, 0xfe, 0x90, 0xb8, 0xd3, 0xee, 0xe7, 0x6f,
+	0x42, 0xb8, 0x10, 0x85, 0xf0, 0xc6, 0x85, 0x35, 0x79, 0x71, 0x82, 0xca, 0x48, 0xd0, 0x4f, 0xef,
+	0x60, 0x67, 0xec, 0x06, 0x67, 0xf3, 0xa1, 0x6e, 0x93, 0x89, 0xc1, 0xb7, 0xcd, 0x7e, 0xee, 0xfa,
+	0xce, 0x73, 0x23, 0xb8, 0x98, 0x61, 0x9f, 0xf2, 0xf9, 0xe6, 0x0e, 0xa7, 0xf8, 0x04, 0xe3, 0x1e,
+	0xf6, 0xba, 0x17, 0x01, 0x56, 0xbf, 0x57, 0xc0, 0xa1, 0x3f, 0xc3, 0xf6, 0xc0, 0xc1, 0x33, 0x0f,
+	0xdb, 0xf4, 0x0c, 0x83, 0xb1, 0x67, 0xd9, 0x54, 0xc3, 0x25, 0x4e, 0x73, 0xb1, 0xad, 0xd0, 0x6d,
+	0xb2, 0x13, 0xeb, 0xc9, 0x89, 0xf5, 0x47, 0xfc, 0xc4, 0xdd, 0x7b, 0x7c, 0x9b, 0xff, 0xe7, 0xdb,
+	0x7c, 0x1f, 0x1b, 0x7a, 0xf5, 0x0e, 0x2a, 0x66, 0x2b, 0xc6, 0x3c, 0x12, 0x90, 0x4f, 0x63, 0x44,
+	0x8f, 0x02, 0x4e, 0x56, 0x5f, 0xbd, 0x86, 0x0b, 0xff, 0xbc, 0x86, 0x0a, 0xfa, 0x6d, 0x11, 0xac,
+	0xf7, 0x6d, 0x32, 0xc3, 0x4f, 0x9c, 0x27, 0xd3, 0x11, 0x51, 0x1f, 0x83, 0x55, 0x3f, 0x1e, 0x0e,
+	0x5c, 0xa7, 0xa9, 0xb4, 0x95, 0xce, 0x46, 0xf7, 0x28, 0x56, 0xfe, 0x23, 0x84, 0xdb, 0xcf, 0xb8,
+	0xa1, 0x1f, 0x3b, 0x8e, 0x87, 0x7d, 0x3f, 0x0a, 0xe1, 0x36, 0xdf, 0x0c, 0x0f, 0x40, 0xe6, 0x35,
+	0x9f, 0x51, 0xa9, 0x5d, 0xb0, 0x9d, 0xcc, 0x0e, 0x66, 0x1e, 0x1e, 0xb9, 0x2f, 0xe9, 0x11, 0x37,
+	0xba, 0xad, 0x28, 0x84, 0xbb, 0xd9, 0x30, 0x0e, 0x40, 0xe6, 0x26, 0x8f, 0xee, 0xd1, 0xb1, 0xfa,
+	0x0c, 0x5c, 0x4f, 0x21, 0xec, 0x61, 0x3e, 0x77, 0x9d, 0xe6, 0x12, 0xe5, 0xd1, 0xa2, 0x10, 0xb6,
+	0x72, 0x3c, 0x02, 0x84, 0xcc, 0x1a, 0xe7, 0xa2, 0x67, 0xfb, 0x62, 0xee, 0x3a, 0xea, 0x03, 0x00,
+	0x18, 0xc0, 0x72, 0x1c, 0xaf, 0xb9, 0xdc, 0x56, 0x3a, 0x6b, 0xdd, 0x46, 0x14, 0xc2, 0x1d, 0x99,
+	0x25, 0x5e, 0x43, 0xe6, 0x1a, 0x1d, 0xc4, 0xe7, 0x14, 0x51, 0x54, 0xfb, 0x7f, 0xe5, 0x51, 0x4c,
+	0x92, 0x45, 0xc5, 0x5a, 0xe8, 0x97, 0x65, 0xb0, 0xd9, 0xc7, 0xbe, 0xef, 0x92, 0x29, 0xcf, 0xeb,
+	0x53, 0x00, 0x7c, 0x36, 0x21, 0x32, 0x7b, 0xa7, 0x3a, 0xb3, 0x09, 0x7d, 0x1a, 0x12, 0xd3, 0x27,
+	0x84, 0xea, 0x29, 0xd8, 0x11, 0x2b, 0xd9, 0xfc, 0x1e, 0x44, 0x21, 0x6c, 0xe6, 0x83, 0xd3, 0x0c,
+	0x6f, 0xa7, 0x1c, 0x3c, 0xc7, 0x7d, 0xd0, 0x90, 0x60, 0x85, 0x2c, 0xb7, 0xa3, 0x10, 0x1e, 0x14,
+	0xd8, 0xe4, 0x43, 0xab, 0x29, 0xa3, 0xc8, 0xf4, 0x57, 0x60, 0x4f, 0x46, 0xf3, 0x47, 0x4a, 0xbb,
+	0x4c, 0x69, 0x51, 0x14, 0x42, 0xad, 0x48, 0x2b, 0x01, 0x91, 0x59, 0x17, 0xc4, 0xec, 0x81, 0x52,
+	0x9f, 0x80, 0x8d, 0x04, 0x46, 0x6d, 0x64, 0x86, 0xec, 0x45, 0x21, 0xbc, 0x9e, 0xe5, 0x63, 0x46,
+	0xae, 0xf3, 0x21, 0xb5, 0x52, 0x8a, 0xa5, 0x7b, 0x59, 0xa9, 0x8a, 0x65, 0x1b, 0x48, 0x62, 0xa9,
+	0xae, 0x05, 0x36, 0xd3, 0x32, 0x73, 0xa7, 0x23, 0xd2, 0xbc, 0x46, 0x5f, 0xd8, 0x5b, 0x7a, 0x79,
+	0xc3, 0xd3, 0xa5, 0x57, 0xaa, 0xdb, 0x8c, 0x42, 0x58, 0xcf, 0x95, 0x6a, 0xcc, 0x11, 0x4b, 0x08,
+	0x18, 0xba, 0x5c, 0x02, 0x1b, 0x26, 0xb6, 0x89, 0xe7, 0xf0, 0x92, 0x39, 0x05, 0x6b, 0x1e, 0x1d,
+	0x8b, 0x8a, 0xf9, 0xa0, 0xba, 0x62, 0x6a, 0x4c, 0x21, 0x8d, 0x40, 0xe6, 0xaa, 0xc7, 0xd9, 0xd4,
+	0xc7, 0xa0, 0x96, 0xce, 0x67, 0xcb, 0xe5, 0x46, 0x14, 0xc2, 0xbd, 0x5c, 0x64, 0x5a, 0x2d, 0x5b,
+	0x09, 0x01, 0x2f, 0x96, 0x1e, 0xa8, 0x0b, 0x50, 0xa1, 0x56, 0xa0, 0x68, 0xa2, 0x65, 0x28, 0x64,
+	0xee, 0x24, 0x74, 0xa2, 0x52, 0xfa, 0xa0, 0x21, 0xb0, 0x67, 0x96, 0x7f, 0x86, 0x9d, 0xc1, 0xd4,
+	0x9a, 0x60, 0x5e, 0x27, 0x52, 0xf9, 0x95, 0xc2, 0x90, 0xa9, 0x26, 0x9c, 0xa7, 0x74, 0xf6, 0x33,
+	0x6b, 0x82, 0xd5, 0x8f, 0xc0, 0x3a, 0x47, 0x4b, 0x25, 0xb2, 0x1b, 0x85, 0x50, 0xcd, 0x50, 0xb1,
+	0x0a, 0x01, 0x6c, 0x44, 0x0b, 0xa4, 0x60, 0xf2, 0xca, 0x7f, 0x6e, 0xf2, 0xcf, 0x4b, 0x60, 0x9b,
+	0x86, 0xf5, 0x67, 0xd8, 0xe6, 0x3e, 0xf7, 0x13, 0x59, 0xda, 0xd4, 0x53, 0xaf, 0x8d, 0x6a, 0xaf,
+	0x33, 0x42, 0x3c, 0x2a, 0x11, 0x62, 0xc4, 0xb1, 0x57, 0x99, 0xe5, 0xac, 0xed, 0x92, 0x57, 0x65,
+	0x28, 0x64, 0xee, 0x48, 0x5c, 0xdc, 0x7d, 0x17, 0x1c, 0x66, 0xb1, 0xd2, 0x48, 0x2a, 0x83, 0x8e,
+	0x74, 0x49, 0xbd, 0x0f, 0x8e, 0xcc, 0xa6, 0xa4, 0x91, 0xe6, 0x84, 0x96, 0x45, 0x7a, 0x7b, 0x50,
+	0xb4, 0xd4, 0xaf, 0x0b, 0xb7, 0x47, 0x0a, 0x48, 0x6e, 0x8f, 0x98, 0x83, 0x9a, 0x99, 0xe5, 0x90,
+	0xba, 0x77, 0x39, 0x07, 0xdb, 0x92, 0xe0, 0xa0, 0x6d, 0xfc, 0xef, 0x25, 0xa0, 0x3e, 0x24, 0xd3,
+	0xc0, 0xb3, 0xec, 0x40, 0x32, 0xec, 0x1b, 0x50, 0xb3, 0xf9, 0x6c, 0xce, 0xb3, 0xfb, 0xd5, 0x9e,
+	0xf1, 0xb7, 0x2c, 0x1f, 0x88, 0xcc, 0x2d, 0x3b, 0xa3, 0x10, 0x77, 0xcf, 0x3c, 0x28, 0x6b, 0x9e,
+	0xd4, 0x3d, 0x2b, 0x80, 0xc8, 0xac, 0x67, 0x49, 0xb9, 0x85, 0xdf, 0x82, 0x5b, 0x85, 0x88, 0xec,
+	0x84, 0x64, 0xa4, 0x1e, 0x85, 0xf0, 0xa8, 0x42, 0xa6, 0x18, 0x84, 0x4c, 0x2d, 0x2b, 0x29, 0xe7,
+	0x8d, 0x9a, 0xfa, 0x14, 0xa8, 0xd9, 0x30, 0xc9, 0xd7, 0xc3, 0x28, 0x84, 0xfb, 0x65, 0x5a, 0xcc,
+	0xda, 0x9a, 0x4c, 0x4d, 0xdd, 0x2d, 0x90, 0x49, 0x06, 0x57, 0x92, 0xf1, 0x7f, 0x06, 0x76, 0x6e,
+	0x67, 0xe8, 0xaf, 0x65, 0x50, 0x63, 0x9d, 0x57, 0x32, 0xf9, 0x4b, 0xc0, 0xdb, 0x5f, 0xce, 0xe2,
+	0x7b, 0xd5, 0x16, 0x37, 0x32, 0xfd, 0x25, 0x35, 0x78, 0xc3, 0x93, 0xb8, 0xa5, 0x96, 0x57, 0x6a,
+	0x6e, 0xb1, 0xe5, 0xe5, 0xad, 0x55, 0x65, 0x3a, 0x6e, 0xec, 0x1c, 0xdc, 0xcc, 0xa1, 0x2b, 0x6d,
+	0xbd, 0x13, 0x85, 0xb0, 0x53, 0x2a, 0x50, 0x96, 0xac, 0x03, 0x59, 0xac, 0x60, 0xa9, 0x05, 0x5a,
+	0x39, 0x8e, 0x62, 0x0f, 0xbf, 0x1d, 0x85, 0xf0, 0x66, 0xa9, 0x5e, 0xa6, 0x91, 0xef, 0xca, 0x42,
+	0x52, 0x33, 0x17, 0x57, 0x97, 0xa8, 0x19, 0x66, 0x73, 0xf1, 0xea, 0x92, 0x2a, 0x66, 0x4b, 0xd0,
+	0xd1, 0x7a, 0xf9, 0x0e, 0x34, 0x0a, 0x45, 0x2c, 0xb5, 0xf8, 0xa3, 0xaa, 0x16, 0x5f, 0x7c, 0xfb,
+	0x65, 0x87, 0x4a, 0x29, 0x91, 0xa9, 0xda, 0xc5, 0xa8, 0xe7, 0x6f, 0x2e, 0x35, 0xe5, 0xed, 0xa5,
+	0xa6, 0xfc, 0x79, 0xa9, 0x29, 0x3f, 0x5c, 0x69, 0x0b, 0x6f, 0xaf, 0xb4, 0x85, 0xdf, 0xaf, 0xb4,
+	0x05, 0xb0, 0xef, 0x92, 0x0a, 0xf5, 0x9e, 0xf2, 0xf5, 0x03, 0xe9, 0xb3, 0x43, 0x80, 0xee, 0xba,
+	0x44, 0x1a, 0x19, 0x2f, 0xc5, 0x27, 0x19, 0xfd, 0x10, 0x19, 0xae, 0xd0, 0xef, 0x87, 0x0f, 0xff,
+	0x0d, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x7d, 0x0d, 0x9f, 0xb6, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -645,6 +682,17 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.StorageFeePerByte) != len(that1.StorageFeePerByte) {
+		return false
+	}
+	for i := range this.StorageFeePerByte {
+		if !this.StorageFeePerByte[i].Equal(&that1.StorageFeePerByte[i]) {
+			return false
+		}
+	}
+	if this.SpecDeprecationGracePeriod != that1.SpecDeprecationGracePeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +715,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SpecDeprecationGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SpecDeprecationGracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMetadata(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.StorageFeePerByte) > 0 {
+		for iNdEx := len(m.StorageFeePerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageFeePerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1166,14 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.StorageFeePerByte) > 0 {
+		for _, e := range m.StorageFeePerByte {
+			l = e.Size()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SpecDeprecationGracePeriod)
+	n += 1 + l + sovMetadata(uint64(l))
 	return n
 }
 
@@ -1312,6 +1390,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFeePerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageFeePerByte = append(m.StorageFeePerByte, types.Coin{})
+			if err := m.StorageFeePerByte[len(m.StorageFeePerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecDeprecationGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SpecDeprecationGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -21,7 +21,13 @@ const (
 	TypeMsgDeleteScopeOwnerRequest                = "delete_scope_owner_request"
 	TypeMsgWriteSessionRequest                    = "write_session_request"
 	TypeMsgWriteRecordRequest                     = "write_record_request"
+	TypeMsgWriteSessionAndRecordsRequest          = "write_session_and_records_request"
 	TypeMsgDeleteRecordRequest                    = "delete_record_request"
+	TypeMsgAddRecordDataAccessRequest             = "add_record_data_access_request"
+	TypeMsgDeleteRecordDataAccessRequest          = "delete_record_data_access_request"
+	TypeMsgRedactRecordRequest                    = "redact_record_request"
+	TypeMsgArchiveScopeRequest                    = "archive_scope_request"
+	TypeMsgRestoreScopeRequest                    = "restore_scope_request"
 	TypeMsgWriteScopeSpecificationRequest         = "write_scope_specification_request"
 	TypeMsgDeleteScopeSpecificationRequest        = "delete_scope_specification_request"
 	TypeMsgWriteContractSpecificationRequest      = "write_contract_specification_request"
@@ -30,13 +36,21 @@ const (
 	TypeMsgDeleteContractSpecFromScopeSpecRequest = "delete_contract_spec_from_scope_spec_request"
 	TypeMsgWriteRecordSpecificationRequest        = "write_record_specification_request"
 	TypeMsgDeleteRecordSpecificationRequest       = "delete_record_specification_request"
+	TypeMsgWriteSpecificationBundleRequest        = "write_specification_bundle_request"
 	TypeMsgWriteP8EContractSpecRequest            = "write_p8e_contract_spec_request"
 	TypeMsgP8eMemorializeContractRequest          = "p8e_memorialize_contract_request"
 	TypeMsgBindOSLocatorRequest                   = "write_os_locator_request"
 	TypeMsgDeleteOSLocatorRequest                 = "delete_os_locator_request"
 	TypeMsgModifyOSLocatorRequest                 = "modify_os_locator_request"
+	TypeMsgReportOSLocatorStatusRequest           = "report_os_locator_status_request"
+	TypeMsgAddContractSpecSourceLocatorRequest    = "add_contract_spec_source_locator_request"
+	TypeMsgDeleteContractSpecSourceLocatorRequest = "delete_contract_spec_source_locator_request"
+	TypeMsgDeprecateSpecificationRequest          = "deprecate_specification_request"
 )
 
+// MaxIdempotencyKeyLength is the maximum length of the idempotency key of a write.
+const MaxIdempotencyKeyLength = 128
+
 // Compile time interface checks.
 var (
 	_ sdk.Msg = &MsgWriteScopeRequest{}
@@ -47,7 +61,13 @@ var (
 	_ sdk.Msg = &MsgDeleteScopeOwnerRequest{}
 	_ sdk.Msg = &MsgWriteSessionRequest{}
 	_ sdk.Msg = &MsgWriteRecordRequest{}
+	_ sdk.Msg = &MsgWriteSessionAndRecordsRequest{}
 	_ sdk.Msg = &MsgDeleteRecordRequest{}
+	_ sdk.Msg = &MsgAddRecordDataAccessRequest{}
+	_ sdk.Msg = &MsgDeleteRecordDataAccessRequest{}
+	_ sdk.Msg = &MsgRedactRecordRequest{}
+	_ sdk.Msg = &MsgArchiveScopeRequest{}
+	_ sdk.Msg = &MsgRestoreScopeRequest{}
 	_ sdk.Msg = &MsgWriteScopeSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteScopeSpecificationRequest{}
 	_ sdk.Msg = &MsgWriteContractSpecificationRequest{}
@@ -56,9 +76,14 @@ var (
 	_ sdk.Msg = &MsgDeleteContractSpecFromScopeSpecRequest{}
 	_ sdk.Msg = &MsgWriteRecordSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteRecordSpecificationRequest{}
+	_ sdk.Msg = &MsgWriteSpecificationBundleRequest{}
 	_ sdk.Msg = &MsgBindOSLocatorRequest{}
 	_ sdk.Msg = &MsgDeleteOSLocatorRequest{}
 	_ sdk.Msg = &MsgModifyOSLocatorRequest{}
+	_ sdk.Msg = &MsgReportOSLocatorStatusRequest{}
+	_ sdk.Msg = &MsgAddContractSpecSourceLocatorRequest{}
+	_ sdk.Msg = &MsgDeleteContractSpecSourceLocatorRequest{}
+	_ sdk.Msg = &MsgDeprecateSpecificationRequest{}
 	_ sdk.Msg = &MsgWriteP8EContractSpecRequest{}
 	_ sdk.Msg = &MsgP8EMemorializeContractRequest{}
 )
@@ -125,6 +150,12 @@ func (msg MsgWriteScopeRequest) ValidateBasic() error {
 	if err := msg.ConvertOptionalFields(); err != nil {
 		return err
 	}
+	if msg.ValueOwnerAsCoin && len(msg.Scope.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("a value owner address is required to represent value ownership as a coin")
+	}
+	if err := validateIdempotencyKey(msg.IdempotencyKey); err != nil {
+		return err
+	}
 	return msg.Scope.ValidateBasic()
 }
 
@@ -542,6 +573,9 @@ func (msg MsgWriteRecordRequest) ValidateBasic() error {
 	if err := msg.ConvertOptionalFields(); err != nil {
 		return err
 	}
+	if err := validateIdempotencyKey(msg.IdempotencyKey); err != nil {
+		return err
+	}
 	return msg.Record.ValidateBasic()
 }
 
@@ -582,6 +616,97 @@ func (msg *MsgWriteRecordRequest) ConvertOptionalFields() error {
 	return nil
 }
 
+// ------------------  MsgWriteSessionAndRecordsRequest  ------------------
+
+// NewMsgWriteSessionAndRecordsRequest creates a new msg instance
+func NewMsgWriteSessionAndRecordsRequest(session Session, records []Record, signers []string) *MsgWriteSessionAndRecordsRequest {
+	return &MsgWriteSessionAndRecordsRequest{Session: session, Records: records, Signers: signers}
+}
+
+func (msg MsgWriteSessionAndRecordsRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgWriteSessionAndRecordsRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgWriteSessionAndRecordsRequest) Type() string {
+	return TypeMsgWriteSessionAndRecordsRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgWriteSessionAndRecordsRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgWriteSessionAndRecordsRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgWriteSessionAndRecordsRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.Records) < 1 {
+		return fmt.Errorf("at least one record is required")
+	}
+	if err := msg.ConvertOptionalFields(); err != nil {
+		return err
+	}
+	if err := msg.Session.ValidateBasic(); err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(msg.Records))
+	for _, record := range msg.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return err
+		}
+		if names[record.Name] {
+			return fmt.Errorf("duplicate record name [%s]", record.Name)
+		}
+		names[record.Name] = true
+	}
+	return nil
+}
+
+// ConvertOptionalFields will look at the SessionIdComponents and SpecUuid fields in the message.
+// For each, if present, it will be converted to a MetadataAddress and set in the Session (and Records) appropriately.
+// Records without a session id are then assigned to the session.
+// Once used, those fields will be emptied so that calling this again has no effect.
+func (msg *MsgWriteSessionAndRecordsRequest) ConvertOptionalFields() error {
+	sessionMsg := MsgWriteSessionRequest{
+		Session:             msg.Session,
+		SessionIdComponents: msg.SessionIdComponents,
+		SpecUuid:            msg.SpecUuid,
+	}
+	if err := sessionMsg.ConvertOptionalFields(); err != nil {
+		return err
+	}
+	msg.Session = sessionMsg.Session
+	for i := range msg.Records {
+		recordMsg := MsgWriteRecordRequest{Record: msg.Records[i], ContractSpecUuid: msg.SpecUuid}
+		if err := recordMsg.ConvertOptionalFields(); err != nil {
+			return err
+		}
+		if recordMsg.Record.SessionId.Empty() {
+			recordMsg.Record.SessionId = msg.Session.SessionId
+		} else if !recordMsg.Record.SessionId.Equals(msg.Session.SessionId) {
+			return fmt.Errorf("msg.Records[%d].SessionId [%s] is different from msg.Session.SessionId [%s]",
+				i, recordMsg.Record.SessionId, msg.Session.SessionId)
+		}
+		msg.Records[i] = recordMsg.Record
+	}
+	msg.SessionIdComponents = nil
+	msg.SpecUuid = ""
+	return nil
+}
+
 // ------------------  MsgDeleteRecordRequest  ------------------
 
 // NewMsgDeleteScopeSpecificationRequest creates a new msg instance
@@ -622,6 +747,257 @@ func (msg MsgDeleteRecordRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgAddRecordDataAccessRequest  ------------------
+
+// NewMsgAddRecordDataAccessRequest creates a new msg instance
+func NewMsgAddRecordDataAccessRequest(recordID MetadataAddress, dataAccessAddrs []string, signers []string) *MsgAddRecordDataAccessRequest {
+	return &MsgAddRecordDataAccessRequest{
+		RecordId:   recordID,
+		DataAccess: dataAccessAddrs,
+		Signers:    signers,
+	}
+}
+
+func (msg MsgAddRecordDataAccessRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAddRecordDataAccessRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAddRecordDataAccessRequest) Type() string {
+	return TypeMsgAddRecordDataAccessRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAddRecordDataAccessRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAddRecordDataAccessRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAddRecordDataAccessRequest) ValidateBasic() error {
+	return validateRecordDataAccessMsg(msg.RecordId, msg.DataAccess, msg.Signers)
+}
+
+// ------------------  MsgDeleteRecordDataAccessRequest  ------------------
+
+// NewMsgDeleteRecordDataAccessRequest creates a new msg instance
+func NewMsgDeleteRecordDataAccessRequest(recordID MetadataAddress, dataAccessAddrs []string, signers []string) *MsgDeleteRecordDataAccessRequest {
+	return &MsgDeleteRecordDataAccessRequest{
+		RecordId:   recordID,
+		DataAccess: dataAccessAddrs,
+		Signers:    signers,
+	}
+}
+
+func (msg MsgDeleteRecordDataAccessRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDeleteRecordDataAccessRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDeleteRecordDataAccessRequest) Type() string {
+	return TypeMsgDeleteRecordDataAccessRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDeleteRecordDataAccessRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeleteRecordDataAccessRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDeleteRecordDataAccessRequest) ValidateBasic() error {
+	return validateRecordDataAccessMsg(msg.RecordId, msg.DataAccess, msg.Signers)
+}
+
+// validateRecordDataAccessMsg checks the fields shared by the record data access msgs
+func validateRecordDataAccessMsg(recordID MetadataAddress, dataAccess []string, signers []string) error {
+	if !recordID.IsRecordAddress() {
+		return fmt.Errorf("address is not a record id: %v", recordID.String())
+	}
+	if len(dataAccess) < 1 {
+		return fmt.Errorf("at least one data access address is required")
+	}
+	for _, da := range dataAccess {
+		_, err := sdk.AccAddressFromBech32(da)
+		if err != nil {
+			return fmt.Errorf("data access address is invalid: %s", da)
+		}
+	}
+	if len(signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgRedactRecordRequest  ------------------
+
+// NewMsgRedactRecordRequest creates a new msg instance
+func NewMsgRedactRecordRequest(recordID MetadataAddress, reason string, signers []string) *MsgRedactRecordRequest {
+	return &MsgRedactRecordRequest{
+		RecordId: recordID,
+		Reason:   reason,
+		Signers:  signers,
+	}
+}
+
+func (msg MsgRedactRecordRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgRedactRecordRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgRedactRecordRequest) Type() string {
+	return TypeMsgRedactRecordRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRedactRecordRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRedactRecordRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgRedactRecordRequest) ValidateBasic() error {
+	if !msg.RecordId.IsRecordAddress() {
+		return fmt.Errorf("address is not a record id: %v", msg.RecordId.String())
+	}
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return fmt.Errorf("a redaction reason is required")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgArchiveScopeRequest  ------------------
+
+// NewMsgArchiveScopeRequest creates a new msg instance
+func NewMsgArchiveScopeRequest(scopeID MetadataAddress, locatorURI string, signers []string) *MsgArchiveScopeRequest {
+	return &MsgArchiveScopeRequest{
+		ScopeId:    scopeID,
+		LocatorUri: locatorURI,
+		Signers:    signers,
+	}
+}
+
+func (msg MsgArchiveScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgArchiveScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgArchiveScopeRequest) Type() string {
+	return TypeMsgArchiveScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgArchiveScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgArchiveScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgArchiveScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(strings.TrimSpace(msg.LocatorUri)) == 0 {
+		return fmt.Errorf("a locator uri is required")
+	}
+	if _, err := url.Parse(msg.LocatorUri); err != nil {
+		return fmt.Errorf("invalid locator uri: %w", err)
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgRestoreScopeRequest  ------------------
+
+// NewMsgRestoreScopeRequest creates a new msg instance
+func NewMsgRestoreScopeRequest(content ScopeArchiveContent, signers []string) *MsgRestoreScopeRequest {
+	return &MsgRestoreScopeRequest{
+		Content: content,
+		Signers: signers,
+	}
+}
+
+func (msg MsgRestoreScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgRestoreScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgRestoreScopeRequest) Type() string {
+	return TypeMsgRestoreScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRestoreScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRestoreScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgRestoreScopeRequest) ValidateBasic() error {
+	if err := msg.Content.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgWriteScopeSpecificationRequest  ------------------
 
 // NewMsgAddScopeSpecificationRequest creates a new msg instance
@@ -1073,6 +1449,46 @@ func (msg MsgDeleteRecordSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgWriteSpecificationBundleRequest  ------------------
+
+// NewMsgWriteSpecificationBundleRequest creates a new msg instance
+func NewMsgWriteSpecificationBundleRequest(bundle SpecificationBundle, signers []string) *MsgWriteSpecificationBundleRequest {
+	return &MsgWriteSpecificationBundleRequest{Bundle: bundle, Signers: signers}
+}
+
+func (msg MsgWriteSpecificationBundleRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgWriteSpecificationBundleRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgWriteSpecificationBundleRequest) Type() string {
+	return TypeMsgWriteSpecificationBundleRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgWriteSpecificationBundleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgWriteSpecificationBundleRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgWriteSpecificationBundleRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return msg.Bundle.ValidateBasic()
+}
+
 // ------------------  MsgP8EMemorializeContractRequest  ------------------
 
 // NewMsgP8EMemorializeContractRequest creates a new msg instance
@@ -1243,6 +1659,172 @@ func (msg MsgModifyOSLocatorRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{stringToAccAddress(msg.Locator.Owner)}
 }
 
+// ------------------  MsgReportOSLocatorStatusRequest  ------------------
+
+func NewMsgReportOSLocatorStatusRequest(owner string, status OSLocatorStatus) *MsgReportOSLocatorStatusRequest {
+	return &MsgReportOSLocatorStatusRequest{
+		Owner:  owner,
+		Status: status,
+	}
+}
+
+func (msg MsgReportOSLocatorStatusRequest) Route() string {
+	return ModuleName
+}
+
+func (msg MsgReportOSLocatorStatusRequest) Type() string {
+	return TypeMsgReportOSLocatorStatusRequest
+}
+
+func (msg MsgReportOSLocatorStatusRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %s", msg.Owner)
+	}
+	if _, found := OSLocatorStatus_name[int32(msg.Status)]; !found || msg.Status == OSLocatorStatus_OS_LOCATOR_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid os locator status: %s", msg.Status)
+	}
+	return nil
+}
+
+func (msg MsgReportOSLocatorStatusRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReportOSLocatorStatusRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{stringToAccAddress(msg.Owner)}
+}
+
+// ------------------  MsgAddContractSpecSourceLocatorRequest  ------------------
+
+// NewMsgAddContractSpecSourceLocatorRequest creates a new msg instance
+func NewMsgAddContractSpecSourceLocatorRequest(specificationID MetadataAddress, locatorOwner string, signers []string) *MsgAddContractSpecSourceLocatorRequest {
+	return &MsgAddContractSpecSourceLocatorRequest{SpecificationId: specificationID, LocatorOwner: locatorOwner, Signers: signers}
+}
+
+func (msg MsgAddContractSpecSourceLocatorRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAddContractSpecSourceLocatorRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAddContractSpecSourceLocatorRequest) Type() string {
+	return TypeMsgAddContractSpecSourceLocatorRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAddContractSpecSourceLocatorRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAddContractSpecSourceLocatorRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAddContractSpecSourceLocatorRequest) ValidateBasic() error {
+	return validateContractSpecSourceLocator(msg.SpecificationId, msg.LocatorOwner, msg.Signers)
+}
+
+// ------------------  MsgDeleteContractSpecSourceLocatorRequest  ------------------
+
+// NewMsgDeleteContractSpecSourceLocatorRequest creates a new msg instance
+func NewMsgDeleteContractSpecSourceLocatorRequest(specificationID MetadataAddress, locatorOwner string, signers []string) *MsgDeleteContractSpecSourceLocatorRequest {
+	return &MsgDeleteContractSpecSourceLocatorRequest{SpecificationId: specificationID, LocatorOwner: locatorOwner, Signers: signers}
+}
+
+func (msg MsgDeleteContractSpecSourceLocatorRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDeleteContractSpecSourceLocatorRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDeleteContractSpecSourceLocatorRequest) Type() string {
+	return TypeMsgDeleteContractSpecSourceLocatorRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDeleteContractSpecSourceLocatorRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeleteContractSpecSourceLocatorRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDeleteContractSpecSourceLocatorRequest) ValidateBasic() error {
+	return validateContractSpecSourceLocator(msg.SpecificationId, msg.LocatorOwner, msg.Signers)
+}
+
+// validateContractSpecSourceLocator checks the fields common to the contract spec source locator msgs.
+func validateContractSpecSourceLocator(specificationID MetadataAddress, locatorOwner string, signers []string) error {
+	if !specificationID.IsContractSpecificationAddress() {
+		return fmt.Errorf("address is not a contract specification id: %s", specificationID.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(locatorOwner); err != nil {
+		return fmt.Errorf("invalid locator owner address: %s", locatorOwner)
+	}
+	if len(signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgDeprecateSpecificationRequest  ------------------
+
+// NewMsgDeprecateSpecificationRequest creates a new msg instance
+func NewMsgDeprecateSpecificationRequest(specificationID, successorID MetadataAddress, signers []string) *MsgDeprecateSpecificationRequest {
+	return &MsgDeprecateSpecificationRequest{SpecificationId: specificationID, SuccessorId: successorID, Signers: signers}
+}
+
+func (msg MsgDeprecateSpecificationRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDeprecateSpecificationRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDeprecateSpecificationRequest) Type() string {
+	return TypeMsgDeprecateSpecificationRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDeprecateSpecificationRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeprecateSpecificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDeprecateSpecificationRequest) ValidateBasic() error {
+	if err := validateSpecificationSuccessor(msg.SpecificationId, msg.SuccessorId); err != nil {
+		return err
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  SessionIdComponents  ------------------
 
 func (msg *SessionIdComponents) GetSessionAddr() (*MetadataAddress, error) {
@@ -1325,10 +1907,39 @@ func NewMsgWriteRecordResponse(recordID MetadataAddress) *MsgWriteRecordResponse
 	}
 }
 
+func NewMsgWriteSessionAndRecordsResponse(sessionIDInfo *SessionIdInfo, recordIDInfos []*RecordIdInfo) *MsgWriteSessionAndRecordsResponse {
+	return &MsgWriteSessionAndRecordsResponse{
+		SessionIdInfo: sessionIDInfo,
+		RecordIdInfos: recordIDInfos,
+	}
+}
+
 func NewMsgDeleteRecordResponse() *MsgDeleteRecordResponse {
 	return &MsgDeleteRecordResponse{}
 }
 
+func NewMsgAddRecordDataAccessResponse() *MsgAddRecordDataAccessResponse {
+	return &MsgAddRecordDataAccessResponse{}
+}
+
+func NewMsgDeleteRecordDataAccessResponse() *MsgDeleteRecordDataAccessResponse {
+	return &MsgDeleteRecordDataAccessResponse{}
+}
+
+func NewMsgRedactRecordResponse() *MsgRedactRecordResponse {
+	return &MsgRedactRecordResponse{}
+}
+
+func NewMsgArchiveScopeResponse(contentHash string) *MsgArchiveScopeResponse {
+	return &MsgArchiveScopeResponse{
+		ContentHash: contentHash,
+	}
+}
+
+func NewMsgRestoreScopeResponse() *MsgRestoreScopeResponse {
+	return &MsgRestoreScopeResponse{}
+}
+
 func NewMsgWriteScopeSpecificationResponse(scopeSpecID MetadataAddress) *MsgWriteScopeSpecificationResponse {
 	return &MsgWriteScopeSpecificationResponse{
 		ScopeSpecIdInfo: GetScopeSpecIDInfo(scopeSpecID),
@@ -1367,6 +1978,18 @@ func NewMsgDeleteRecordSpecificationResponse() *MsgDeleteRecordSpecificationResp
 	return &MsgDeleteRecordSpecificationResponse{}
 }
 
+func NewMsgWriteSpecificationBundleResponse(
+	contractSpecIDInfo *ContractSpecIdInfo,
+	recordSpecIDInfos []*RecordSpecIdInfo,
+	scopeSpecIDInfos []*ScopeSpecIdInfo,
+) *MsgWriteSpecificationBundleResponse {
+	return &MsgWriteSpecificationBundleResponse{
+		ContractSpecIdInfo: contractSpecIDInfo,
+		RecordSpecIdInfos:  recordSpecIDInfos,
+		ScopeSpecIdInfos:   scopeSpecIDInfos,
+	}
+}
+
 func NewMsgWriteP8EContractSpecResponse(
 	contractSpecID MetadataAddress,
 	recordSpecIDs ...MetadataAddress,
@@ -1410,3 +2033,11 @@ func NewMsgModifyOSLocatorResponse(objectStoreLocator ObjectStoreLocator) *MsgMo
 		Locator: objectStoreLocator,
 	}
 }
+
+// validateIdempotencyKey checks that an optional idempotency key is not too long.
+func validateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return fmt.Errorf("idempotency key length %d exceeds maximum length %d", len(key), MaxIdempotencyKeyLength)
+	}
+	return nil
+}
@@ -40,9 +40,13 @@ func TestWriteScopeRoute(t *testing.T) {
   data_access:
   - data_accessor
   value_owner_address: value_owner
+  version: 0
 signers: []
 scope_uuid: ""
 spec_uuid: ""
+value_owner_as_coin: false
+expected_version: 0
+idempotency_key: ""
 `
 	require.Equal(t, yaml, msg.String())
 	require.Equal(t, "{\"type\":\"provenance/metadata/WriteScopeRequest\",\"value\":{\"scope\":{\"data_access\":[\"data_accessor\"],\"owners\":[{\"address\":\"data_owner\",\"role\":5}],\"scope_id\":\"scope1qzxcpvj6czy5g354dews3nlruxjsahhnsp\",\"specification_id\":\"scopespec1qs30c9axgrw5669ft0kffe6h9gysfe58v3\",\"value_owner_address\":\"value_owner\"}}}", string(msg.GetSignBytes()))
@@ -93,6 +97,199 @@ func TestWriteScopeValidation(t *testing.T) {
 	require.Equal(t, sdk.AccAddress(x), requiredSigners[0])
 }
 
+func TestRecordDataAccessValidateBasic(t *testing.T) {
+	notARecordId := ScopeMetadataAddress(uuid.New())
+	actualRecordId := RecordMetadataAddress(uuid.New(), "recordname")
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      sdk.Msg
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect record id type": {
+			NewMsgAddRecordDataAccessRequest(notARecordId, []string{addr}, []string{addr}),
+			true,
+			fmt.Sprintf("address is not a record id: %v", notARecordId.String()),
+		},
+		"should fail to validate basic, requires at least one data access address": {
+			NewMsgDeleteRecordDataAccessRequest(actualRecordId, []string{}, []string{addr}),
+			true,
+			"at least one data access address is required",
+		},
+		"should fail to validate basic, incorrect data access address format": {
+			NewMsgAddRecordDataAccessRequest(actualRecordId, []string{"notabech32address"}, []string{addr}),
+			true,
+			"data access address is invalid: notabech32address",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgDeleteRecordDataAccessRequest(actualRecordId, []string{addr}, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should successfully validate basic add": {
+			NewMsgAddRecordDataAccessRequest(actualRecordId, []string{addr}, []string{addr}),
+			false,
+			"",
+		},
+		"should successfully validate basic delete": {
+			NewMsgDeleteRecordDataAccessRequest(actualRecordId, []string{addr}, []string{addr}),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRedactRecordValidateBasic(t *testing.T) {
+	notARecordId := ScopeMetadataAddress(uuid.New())
+	actualRecordId := RecordMetadataAddress(uuid.New(), "recordname")
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      *MsgRedactRecordRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect record id type": {
+			NewMsgRedactRecordRequest(notARecordId, "erasure request", []string{addr}),
+			fmt.Sprintf("address is not a record id: %v", notARecordId.String()),
+		},
+		"should fail to validate basic, requires a reason": {
+			NewMsgRedactRecordRequest(actualRecordId, " ", []string{addr}),
+			"a redaction reason is required",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgRedactRecordRequest(actualRecordId, "erasure request", []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgRedactRecordRequest(actualRecordId, "erasure request", []string{addr}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestArchiveScopeValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	actualScopeId := ScopeMetadataAddress(uuid.New())
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	uri := "https://archive.example.com/scope"
+
+	cases := map[string]struct {
+		msg      *MsgArchiveScopeRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgArchiveScopeRequest(notAScopeId, uri, []string{addr}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, requires a locator uri": {
+			NewMsgArchiveScopeRequest(actualScopeId, " ", []string{addr}),
+			"a locator uri is required",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgArchiveScopeRequest(actualScopeId, uri, []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgArchiveScopeRequest(actualScopeId, uri, []string{addr}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRestoreScopeValidateBasic(t *testing.T) {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	session := Session{SessionId: SessionMetadataAddress(scopeUUID, uuid.New())}
+	otherSession := Session{SessionId: SessionMetadataAddress(uuid.New(), uuid.New())}
+	record := Record{Name: "recordname", SessionId: session.SessionId}
+	otherRecord := Record{Name: "recordname", SessionId: otherSession.SessionId}
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      *MsgRestoreScopeRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: session.SessionId, Sessions: []Session{session}}, []string{addr}),
+			fmt.Sprintf("address is not a scope id: %v", session.SessionId.String()),
+		},
+		"should fail to validate basic, requires content": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: scopeID}, []string{addr}),
+			"scope archive content must have at least one session or record",
+		},
+		"should fail to validate basic, session of another scope": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: scopeID, Sessions: []Session{session, otherSession}}, []string{addr}),
+			fmt.Sprintf("session %s is not part of scope %s", otherSession.SessionId, scopeID),
+		},
+		"should fail to validate basic, record of another scope": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: scopeID, Records: []Record{record, otherRecord}}, []string{addr}),
+			fmt.Sprintf("record %s is not part of scope %s", otherRecord.Name, scopeID),
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: scopeID, Sessions: []Session{session}}, []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgRestoreScopeRequest(ScopeArchiveContent{ScopeId: scopeID, Sessions: []Session{session}, Records: []Record{record}}, []string{addr}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAddScopeDataAccessValidateBasic(t *testing.T) {
 	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
 	actualScopeId := ScopeMetadataAddress(uuid.New())
@@ -507,6 +704,49 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "{\"type\":\"provenance/metadata/DeleteOSLocatorRequest\",\"value\":{\"locator\":{\"locator_uri\":\"http://foo.com\",\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\"}}}", string(deleteRequest.GetSignBytes()))
 }
 
+func TestReportOSLocatorStatus(t *testing.T) {
+	var reportRequest = NewMsgReportOSLocatorStatusRequest("cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", OSLocatorStatus_OS_LOCATOR_STATUS_HEALTHY)
+
+	err := reportRequest.ValidateBasic()
+	require.NoError(t, err)
+
+	signers := reportRequest.GetSigners()
+	require.Equal(t, "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", signers[0].String())
+	require.Equal(t, ModuleName, reportRequest.Route())
+	require.Equal(t, TypeMsgReportOSLocatorStatusRequest, reportRequest.Type())
+	require.Equal(t, "{\"type\":\"provenance/metadata/ReportOSLocatorStatusRequest\",\"value\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"status\":1}}", string(reportRequest.GetSignBytes()))
+
+	reportRequest.Status = OSLocatorStatus_OS_LOCATOR_STATUS_UNSPECIFIED
+	require.EqualError(t, reportRequest.ValidateBasic(), "invalid os locator status: OS_LOCATOR_STATUS_UNSPECIFIED")
+	reportRequest.Status = OSLocatorStatus(10)
+	require.EqualError(t, reportRequest.ValidateBasic(), "invalid os locator status: 10")
+	reportRequest.Status = OSLocatorStatus_OS_LOCATOR_STATUS_DEGRADED
+	reportRequest.Owner = "vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	require.EqualError(t, reportRequest.ValidateBasic(), "invalid owner address: vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck")
+}
+
+func TestContractSpecSourceLocatorValidateBasic(t *testing.T) {
+	specID := ContractSpecMetadataAddress(uuid.New())
+	owner := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	addRequest := NewMsgAddContractSpecSourceLocatorRequest(specID, owner, []string{owner})
+	require.NoError(t, addRequest.ValidateBasic())
+	require.Equal(t, owner, addRequest.GetSigners()[0].String())
+	require.Equal(t, TypeMsgAddContractSpecSourceLocatorRequest, addRequest.Type())
+
+	deleteRequest := NewMsgDeleteContractSpecSourceLocatorRequest(specID, owner, []string{owner})
+	require.NoError(t, deleteRequest.ValidateBasic())
+	require.Equal(t, TypeMsgDeleteContractSpecSourceLocatorRequest, deleteRequest.Type())
+
+	scopeID := ScopeMetadataAddress(uuid.New())
+	addRequest.SpecificationId = scopeID
+	require.EqualError(t, addRequest.ValidateBasic(), fmt.Sprintf("address is not a contract specification id: %s", scopeID))
+	addRequest.SpecificationId = specID
+	addRequest.LocatorOwner = "vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	require.EqualError(t, addRequest.ValidateBasic(), "invalid locator owner address: vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck")
+	deleteRequest.Signers = nil
+	require.EqualError(t, deleteRequest.ValidateBasic(), "at least one signer is required")
+}
+
 func TestBindOSLocatorInvalid(t *testing.T) {
 	var bindRequestMsg = NewMsgBindOSLocatorRequest(ObjectStoreLocator{Owner: "vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", LocatorUri: "http://foo.com"})
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,3 +14,12 @@ func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) Obj
 		EncryptionKey: encryptionKey.String(),
 	}
 }
+
+// IsHealthy returns true if the owner most recently reported the locator as healthy, and (when maxAge is not zero)
+// that report is no older than maxAge as of the provided time.
+func (l ObjectStoreLocator) IsHealthy(asOf time.Time, maxAge time.Duration) bool {
+	if l.Status != OSLocatorStatus_OS_LOCATOR_STATUS_HEALTHY {
+		return false
+	}
+	return maxAge == 0 || !asOf.After(time.Unix(l.LastSeen, 0).Add(maxAge))
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OSLocatorStatus is the health of an object store locator endpoint as reported by its owner.
+type OSLocatorStatus int32
+
+const (
+	// OS_LOCATOR_STATUS_UNSPECIFIED indicates no status has been reported
+	OSLocatorStatus_OS_LOCATOR_STATUS_UNSPECIFIED OSLocatorStatus = 0
+	// OS_LOCATOR_STATUS_HEALTHY indicates the endpoint is available
+	OSLocatorStatus_OS_LOCATOR_STATUS_HEALTHY OSLocatorStatus = 1
+	// OS_LOCATOR_STATUS_DEGRADED indicates the endpoint is available but should be avoided if possible
+	OSLocatorStatus_OS_LOCATOR_STATUS_DEGRADED OSLocatorStatus = 2
+	// OS_LOCATOR_STATUS_UNAVAILABLE indicates the endpoint is not available
+	OSLocatorStatus_OS_LOCATOR_STATUS_UNAVAILABLE OSLocatorStatus = 3
+)
+
+var OSLocatorStatus_name = map[int32]string{
+	0: "OS_LOCATOR_STATUS_UNSPECIFIED",
+	1: "OS_LOCATOR_STATUS_HEALTHY",
+	2: "OS_LOCATOR_STATUS_DEGRADED",
+	3: "OS_LOCATOR_STATUS_UNAVAILABLE",
+}
+
+var OSLocatorStatus_value = map[string]int32{
+	"OS_LOCATOR_STATUS_UNSPECIFIED": 0,
+	"OS_LOCATOR_STATUS_HEALTHY":     1,
+	"OS_LOCATOR_STATUS_DEGRADED":    2,
+	"OS_LOCATOR_STATUS_UNAVAILABLE": 3,
+}
+
+func (x OSLocatorStatus) String() string {
+	return proto.EnumName(OSLocatorStatus_name, int32(x))
+}
+
+func (OSLocatorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{0}
+}
+
 // Defines an Locator object stored on chain, which represents a owner( blockchain address) associated with a endpoint
 // uri for it's associated object store.
 type ObjectStoreLocator struct {
@@ -32,6 +69,10 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// block time (in unix seconds) of the most recent status report from the owner
+	LastSeen int64 `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty" yaml:"last_seen"`
+	// status of the endpoint as most recently reported by the owner
+	Status OSLocatorStatus `protobuf:"varint,5,opt,name=status,proto3,enum=provenance.metadata.v1.OSLocatorStatus" json:"status,omitempty"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -88,6 +129,69 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *ObjectStoreLocator) GetStatus() OSLocatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OSLocatorStatus_OS_LOCATOR_STATUS_UNSPECIFIED
+}
+
+// ContractSpecSourceLocator is an object store locator verified by the owners of a contract specification as a place the
+// source of the specification, identified by its hash, can be downloaded from.
+type ContractSpecSourceLocator struct {
+	// the id of the contract specification
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// the owner of the object store locator
+	LocatorOwner string `protobuf:"bytes,2,opt,name=locator_owner,json=locatorOwner,proto3" json:"locator_owner,omitempty" yaml:"locator_owner"`
+}
+
+func (m *ContractSpecSourceLocator) Reset()         { *m = ContractSpecSourceLocator{} }
+func (m *ContractSpecSourceLocator) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecSourceLocator) ProtoMessage()    {}
+func (*ContractSpecSourceLocator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+}
+func (m *ContractSpecSourceLocator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSpecSourceLocator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSpecSourceLocator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSpecSourceLocator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecSourceLocator.Merge(m, src)
+}
+func (m *ContractSpecSourceLocator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSpecSourceLocator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecSourceLocator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSpecSourceLocator proto.InternalMessageInfo
+
+func (m *ContractSpecSourceLocator) GetLocatorOwner() string {
+	if m != nil {
+		return m.LocatorOwner
+	}
+	return ""
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length" yaml:"max_uri_length"`
@@ -97,7 +201,7 @@ func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
 func (m *OSLocatorParams) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParams) ProtoMessage()    {}
 func (*OSLocatorParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+	return fileDescriptor_3d17fc5ccfa1c263, []int{2}
 }
 func (m *OSLocatorParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,9 +230,77 @@ func (m *OSLocatorParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
+// OSLocatorAuthorization gives the grantee permission to bind or modify the object store locator of the granter's
+// account, so a service account can rotate the endpoint without holding the owner's key.
+type OSLocatorAuthorization struct {
+	// msg_type_url is the type url of the msg the grantee may execute, either Msg/BindOSLocator or Msg/ModifyOSLocator.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// allowed_uris are the locator uris the grantee may set, any uri when empty.
+	AllowedUris []string `protobuf:"bytes,2,rep,name=allowed_uris,json=allowedUris,proto3" json:"allowed_uris,omitempty" yaml:"allowed_uris"`
+	// remaining_uses is the number of times the grantee may use the authorization, unlimited when zero.
+	RemainingUses uint32 `protobuf:"varint,3,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty" yaml:"remaining_uses"`
+}
+
+func (m *OSLocatorAuthorization) Reset()         { *m = OSLocatorAuthorization{} }
+func (m *OSLocatorAuthorization) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorAuthorization) ProtoMessage()    {}
+func (*OSLocatorAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{3}
+}
+func (m *OSLocatorAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorAuthorization.Merge(m, src)
+}
+func (m *OSLocatorAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorAuthorization proto.InternalMessageInfo
+
+func (m *OSLocatorAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *OSLocatorAuthorization) GetAllowedUris() []string {
+	if m != nil {
+		return m.AllowedUris
+	}
+	return nil
+}
+
+func (m *OSLocatorAuthorization) GetRemainingUses() uint32 {
+	if m != nil {
+		return m.RemainingUses
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("provenance.metadata.v1.OSLocatorStatus", OSLocatorStatus_name, OSLocatorStatus_value)
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
+	proto.RegisterType((*ContractSpecSourceLocator)(nil), "provenance.metadata.v1.ContractSpecSourceLocator")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
+	proto.RegisterType((*OSLocatorAuthorization)(nil), "provenance.metadata.v1.OSLocatorAuthorization")
 }
 
 func init() {
@@ -136,27 +308,50 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4e, 0x32, 0x31,
-	0x1c, 0xc4, 0xb7, 0xdf, 0x17, 0x49, 0xac, 0x82, 0x49, 0x83, 0x06, 0x3d, 0x74, 0xcd, 0x26, 0x46,
-	0x2e, 0x6e, 0x83, 0x78, 0xf2, 0xc8, 0x55, 0x0c, 0x04, 0xc2, 0xc5, 0x0b, 0x96, 0xb5, 0x59, 0x2a,
-	0xb4, 0xff, 0x4d, 0x29, 0xc8, 0xbe, 0x85, 0x8f, 0xc5, 0x91, 0xa3, 0xf1, 0x40, 0x0c, 0xbc, 0x81,
-	0x4f, 0x60, 0xe8, 0x62, 0x56, 0x13, 0x6f, 0x9d, 0xe9, 0x2f, 0x33, 0xf9, 0x0f, 0xae, 0x26, 0x06,
-	0x66, 0x42, 0x73, 0x1d, 0x09, 0xa6, 0x84, 0xe5, 0x4f, 0xdc, 0x72, 0x36, 0xab, 0x31, 0x18, 0x3c,
-	0x8b, 0xc8, 0x4e, 0x2c, 0x18, 0x11, 0x26, 0x06, 0x2c, 0x90, 0x93, 0x9c, 0x0c, 0xbf, 0xc9, 0x70,
-	0x56, 0x3b, 0x2b, 0xc7, 0x10, 0x83, 0x43, 0xd8, 0xf6, 0x95, 0xd1, 0x81, 0xc1, 0xa4, 0xe5, 0x22,
-	0xba, 0xdb, 0x88, 0x26, 0x44, 0xdc, 0x82, 0x21, 0x65, 0xbc, 0x07, 0x2f, 0x5a, 0x98, 0x0a, 0x3a,
-	0x47, 0xd5, 0xfd, 0x4e, 0x26, 0x88, 0x8f, 0x0f, 0xc6, 0x19, 0xd0, 0x9f, 0x1a, 0x59, 0xf9, 0xe7,
-	0xfe, 0xf0, 0xce, 0xea, 0x19, 0x49, 0x2e, 0x70, 0x49, 0xe8, 0xc8, 0xa4, 0x89, 0x95, 0xa0, 0xfb,
-	0x23, 0x91, 0x56, 0xfe, 0x3b, 0xa6, 0x98, 0xbb, 0x77, 0x22, 0x0d, 0x1e, 0xf1, 0x51, 0xab, 0xbb,
-	0xab, 0x6a, 0x73, 0xc3, 0xd5, 0x84, 0xdc, 0xe3, 0x92, 0xe2, 0xf3, 0x6d, 0x6c, 0x7f, 0x2c, 0x74,
-	0x6c, 0x87, 0xae, 0xb9, 0xd8, 0xb8, 0x5c, 0xac, 0x7c, 0xef, 0x7d, 0xe5, 0x17, 0xa6, 0x52, 0xdb,
-	0xfa, 0xf5, 0xe7, 0xca, 0x3f, 0x4e, 0xb9, 0x1a, 0xdf, 0x06, 0xbf, 0xe9, 0xa0, 0x73, 0xa8, 0xf8,
-	0xbc, 0x67, 0x64, 0xd3, 0xc9, 0xc6, 0x68, 0xb1, 0xa6, 0x68, 0xb9, 0xa6, 0xe8, 0x63, 0x4d, 0xd1,
-	0xeb, 0x86, 0x7a, 0xcb, 0x0d, 0xf5, 0xde, 0x36, 0xd4, 0xc3, 0xa7, 0xd2, 0x5d, 0xfe, 0xc7, 0x40,
-	0x6d, 0xf4, 0x70, 0x13, 0x4b, 0x3b, 0x9c, 0x0e, 0xc2, 0x08, 0x14, 0xcb, 0xa1, 0x2b, 0x09, 0x3f,
-	0x14, 0x9b, 0xe7, 0xfb, 0xdb, 0x34, 0x11, 0x93, 0x41, 0xc1, 0x2d, 0x59, 0xff, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x0b, 0x65, 0x9a, 0xf7, 0xa3, 0x01, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xee, 0x52, 0x21, 0x32, 0xb4, 0xa5, 0x8e, 0x15, 0x5a, 0x12, 0xba, 0x75, 0x13, 0x43, 0x63,
+	0x42, 0x1b, 0xc0, 0x1b, 0x49, 0x8c, 0xee, 0xd2, 0x2a, 0x8d, 0xc5, 0x92, 0xdd, 0xd6, 0x44, 0x13,
+	0xb3, 0x0e, 0xdb, 0xb1, 0x8c, 0xec, 0xee, 0x6c, 0x66, 0x66, 0x81, 0xfa, 0x14, 0x5e, 0xf9, 0x24,
+	0xde, 0xf8, 0x06, 0x5c, 0x12, 0xaf, 0x8c, 0x17, 0x1b, 0x03, 0xc6, 0x07, 0xe8, 0x13, 0x98, 0xee,
+	0x2e, 0xfd, 0x11, 0xb9, 0x9b, 0xef, 0x7c, 0xdf, 0x9c, 0x73, 0xbe, 0x33, 0x67, 0x17, 0x94, 0x3d,
+	0x46, 0x8f, 0xb1, 0x8b, 0x5c, 0x0b, 0x57, 0x1d, 0x2c, 0x50, 0x17, 0x09, 0x54, 0x3d, 0xde, 0xa8,
+	0xd2, 0x83, 0x8f, 0xd8, 0x12, 0x5c, 0x50, 0x86, 0x2b, 0x1e, 0xa3, 0x82, 0xc2, 0xa5, 0xb1, 0xb2,
+	0x72, 0xa5, 0xac, 0x1c, 0x6f, 0xac, 0xe4, 0x7a, 0xb4, 0x47, 0x43, 0x49, 0x75, 0x78, 0x8a, 0xd4,
+	0x2b, 0x05, 0x8b, 0x72, 0x87, 0x72, 0x33, 0x22, 0x22, 0x10, 0x51, 0xca, 0x1f, 0x09, 0xc0, 0x56,
+	0x98, 0xde, 0x18, 0xa6, 0x6f, 0x52, 0x0b, 0x09, 0xca, 0x60, 0x0e, 0xcc, 0xd2, 0x13, 0x17, 0xb3,
+	0xbc, 0x54, 0x92, 0xca, 0xf3, 0x7a, 0x04, 0xa0, 0x0c, 0x16, 0xec, 0x48, 0x60, 0xfa, 0x8c, 0xe4,
+	0x67, 0x42, 0x0e, 0xc4, 0xa1, 0x0e, 0x23, 0xf0, 0x01, 0xc8, 0x60, 0xd7, 0x62, 0x7d, 0x4f, 0x10,
+	0xea, 0x9a, 0x47, 0xb8, 0x9f, 0x4f, 0x86, 0x9a, 0xf4, 0x38, 0xfa, 0x12, 0xf7, 0xe1, 0x06, 0x98,
+	0xb7, 0x11, 0x17, 0x26, 0xc7, 0xd8, 0xcd, 0xdf, 0x2a, 0x49, 0xe5, 0xa4, 0x96, 0x1b, 0x04, 0x72,
+	0xb6, 0x8f, 0x1c, 0x7b, 0x5b, 0x19, 0x51, 0x8a, 0x7e, 0x7b, 0x78, 0x36, 0x30, 0x76, 0xe1, 0x53,
+	0x30, 0xc7, 0x05, 0x12, 0x3e, 0xcf, 0xcf, 0x96, 0xa4, 0x72, 0x66, 0x73, 0xad, 0xf2, 0xff, 0x09,
+	0x54, 0x5a, 0x46, 0xec, 0xc1, 0x08, 0xe5, 0x7a, 0x7c, 0x4d, 0xf9, 0x26, 0x81, 0xc2, 0x0e, 0x75,
+	0x05, 0x43, 0x96, 0x30, 0x3c, 0x6c, 0x19, 0xd4, 0x67, 0xd6, 0xc8, 0xef, 0x3b, 0x90, 0xe5, 0x1e,
+	0xb6, 0xc8, 0x07, 0x62, 0xa1, 0xb0, 0x77, 0xd2, 0x0d, 0xad, 0xa7, 0xb4, 0xcd, 0xb3, 0x40, 0x4e,
+	0xfc, 0x0c, 0xe4, 0xc5, 0xbd, 0xb8, 0x88, 0xda, 0xed, 0x32, 0xcc, 0xf9, 0x20, 0x90, 0x97, 0xa3,
+	0x7e, 0xff, 0xbd, 0xa8, 0xe8, 0x8b, 0x53, 0xa1, 0x46, 0x17, 0x3e, 0x01, 0xe9, 0xab, 0xc1, 0x45,
+	0x63, 0x0d, 0x47, 0xa7, 0xe5, 0x07, 0x81, 0x9c, 0x8b, 0x4d, 0x4f, 0xd2, 0x8a, 0x9e, 0x8a, 0x71,
+	0x2b, 0x84, 0xef, 0xc1, 0xe2, 0xc8, 0xd6, 0x3e, 0x62, 0xc8, 0xe1, 0x70, 0x0f, 0x64, 0x1c, 0x74,
+	0x3a, 0x7c, 0x06, 0xd3, 0xc6, 0x6e, 0x4f, 0x1c, 0x86, 0xed, 0xa6, 0xb5, 0xb5, 0xb8, 0xdd, 0x39,
+	0x9f, 0xb8, 0x62, 0x6b, 0x73, 0x10, 0xc8, 0xf7, 0xa2, 0x02, 0xd3, 0x6a, 0x45, 0x4f, 0x39, 0xe8,
+	0xb4, 0xc3, 0x48, 0x33, 0x82, 0xbf, 0x25, 0xb0, 0x34, 0x2a, 0xa1, 0xfa, 0xe2, 0x90, 0x32, 0xf2,
+	0x29, 0xec, 0x1e, 0x3e, 0x06, 0x29, 0x87, 0xf7, 0x4c, 0xd1, 0xf7, 0xb0, 0xe9, 0x33, 0x3b, 0xda,
+	0x08, 0x6d, 0x79, 0x10, 0xc8, 0x77, 0xe3, 0xcc, 0x13, 0xac, 0xa2, 0x03, 0x87, 0xf7, 0xda, 0x7d,
+	0x0f, 0x77, 0x98, 0x0d, 0xb7, 0x41, 0x0a, 0xd9, 0x36, 0x3d, 0xc1, 0xdd, 0x61, 0x69, 0x9e, 0x9f,
+	0x29, 0x25, 0xa7, 0xaf, 0x4e, 0xb2, 0x8a, 0xbe, 0x10, 0xc3, 0x0e, 0x23, 0x1c, 0x3e, 0x03, 0x19,
+	0x86, 0x1d, 0x44, 0x5c, 0xe2, 0xf6, 0x4c, 0x9f, 0x63, 0x1e, 0xae, 0x52, 0x5a, 0x2b, 0x8c, 0x2d,
+	0x4d, 0xf3, 0x8a, 0x9e, 0x1e, 0x05, 0x3a, 0x1c, 0xf3, 0xed, 0x3b, 0xdf, 0xbf, 0xae, 0xa7, 0xa7,
+	0xbc, 0x3c, 0xfc, 0x22, 0x4d, 0x4c, 0x32, 0x5a, 0x10, 0x78, 0x1f, 0xac, 0xb6, 0x0c, 0xb3, 0xd9,
+	0xda, 0x51, 0xdb, 0x2d, 0xdd, 0x34, 0xda, 0x6a, 0xbb, 0x63, 0x98, 0x9d, 0x57, 0xc6, 0x7e, 0x7d,
+	0xa7, 0xf1, 0xbc, 0x51, 0xaf, 0x65, 0x13, 0x70, 0x15, 0x14, 0xae, 0x4b, 0x76, 0xeb, 0x6a, 0xb3,
+	0xbd, 0xfb, 0x26, 0x2b, 0xc1, 0x22, 0x58, 0xb9, 0x4e, 0xd7, 0xea, 0x2f, 0x74, 0xb5, 0x56, 0xaf,
+	0x65, 0x67, 0x6e, 0xaa, 0xa0, 0xbe, 0x56, 0x1b, 0x4d, 0x55, 0x6b, 0xd6, 0xb3, 0x49, 0xed, 0xe8,
+	0xec, 0xa2, 0x28, 0x9d, 0x5f, 0x14, 0xa5, 0x5f, 0x17, 0x45, 0xe9, 0xf3, 0x65, 0x31, 0x71, 0x7e,
+	0x59, 0x4c, 0xfc, 0xb8, 0x2c, 0x26, 0x40, 0x81, 0xd0, 0x1b, 0x56, 0x7d, 0x5f, 0x7a, 0xfb, 0xa8,
+	0x47, 0xc4, 0xa1, 0x7f, 0x50, 0xb1, 0xa8, 0x53, 0x1d, 0x8b, 0xd6, 0x09, 0x9d, 0x40, 0xd5, 0xd3,
+	0xf1, 0xbf, 0x64, 0xf8, 0x4c, 0xfc, 0x60, 0x2e, 0xfc, 0xf4, 0xb7, 0xfe, 0x06, 0x00, 0x00, 0xff,
+	0xff, 0x73, 0x58, 0xa8, 0x22, 0x6f, 0x04, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -179,6 +374,16 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastSeen != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.LastSeen))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -203,6 +408,46 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractSpecSourceLocator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecSourceLocator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecSourceLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocatorOwner) > 0 {
+		i -= len(m.LocatorOwner)
+		copy(dAtA[i:], m.LocatorOwner)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.LocatorOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObjectstore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OSLocatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -231,6 +476,50 @@ func (m *OSLocatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OSLocatorAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedUris) > 0 {
+		for iNdEx := len(m.AllowedUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedUris[iNdEx])
+			copy(dAtA[i:], m.AllowedUris[iNdEx])
+			i = encodeVarintObjectstore(dAtA, i, uint64(len(m.AllowedUris[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintObjectstore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovObjectstore(v)
 	base := offset
@@ -260,6 +549,27 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	if m.LastSeen != 0 {
+		n += 1 + sovObjectstore(uint64(m.LastSeen))
+	}
+	if m.Status != 0 {
+		n += 1 + sovObjectstore(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ContractSpecSourceLocator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovObjectstore(uint64(l))
+	l = len(m.LocatorOwner)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
 	return n
 }
 
@@ -275,6 +585,28 @@ func (m *OSLocatorParams) Size() (n int) {
 	return n
 }
 
+func (m *OSLocatorAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if len(m.AllowedUris) > 0 {
+		for _, s := range m.AllowedUris {
+			l = len(s)
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovObjectstore(uint64(m.RemainingUses))
+	}
+	return n
+}
+
 func sovObjectstore(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -406,6 +738,159 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+			}
+			m.LastSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeen |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OSLocatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSpecSourceLocator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSpecSourceLocator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSpecSourceLocator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocatorOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
@@ -496,6 +981,139 @@ func (m *OSLocatorParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OSLocatorAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedUris = append(m.AllowedUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipObjectstore(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -285,6 +285,7 @@ func emptyScope() *Scope {
 		Owners:            []Party{},
 		DataAccess:        []string{},
 		ValueOwnerAddress: "",
+		Version:           0,
 	}
 }
 
@@ -309,6 +310,7 @@ func emptyRecord() *Record {
 		Inputs:          []RecordInput{},
 		Outputs:         []RecordOutput{},
 		SpecificationId: MetadataAddress{},
+		DataAccess:      []string{},
 	}
 }
 
@@ -289,6 +289,13 @@ func (s *P8eTestSuite) TestEmptyScope() {
 				assert.Equal(t, "", scope.ValueOwnerAddress)
 			},
 		},
+		{
+			"Version",
+			"is zero",
+			func(scope *Scope, t *testing.T) {
+				assert.Equal(t, uint64(0), scope.Version)
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -466,6 +473,27 @@ func (s *P8eTestSuite) TestEmptyRecord() {
 				assert.True(t, record.SpecificationId.Empty(), "SpecificationId")
 			},
 		},
+		{
+			"DataAccess",
+			"is not nil",
+			func(record *Record, t *testing.T) {
+				assert.NotNil(t, record.DataAccess, "DataAccess")
+			},
+		},
+		{
+			"DataAccess",
+			"length is 0",
+			func(record *Record, t *testing.T) {
+				assert.Equal(t, 0, len(record.DataAccess), "DataAccess")
+			},
+		},
+		{
+			"Redaction",
+			"is nil",
+			func(record *Record, t *testing.T) {
+				assert.Nil(t, record.Redaction, "Redaction")
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -1,32 +1,53 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
+// Parameter store keys
+var (
+	ParamStoreKeyStorageFeePerByte          = []byte("StorageFeePerByte")
+	ParamStoreKeySpecDeprecationGracePeriod = []byte("SpecDeprecationGracePeriod")
+)
+
 // ParamKeyTable for metadata module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(storageFeePerByte sdk.Coins, specDeprecationGracePeriod time.Duration) Params {
+	return Params{StorageFeePerByte: storageFeePerByte, SpecDeprecationGracePeriod: specDeprecationGracePeriod}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageFeePerByte, &p.StorageFeePerByte, validateStorageFeePerByte),
+		paramtypes.NewParamSetPair(ParamStoreKeySpecDeprecationGracePeriod, &p.SpecDeprecationGracePeriod, validateSpecDeprecationGracePeriod),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(nil, 0)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateStorageFeePerByte(p.StorageFeePerByte); err != nil {
+		return err
+	}
+	return validateSpecDeprecationGracePeriod(p.SpecDeprecationGracePeriod)
 }
 
 // String implements stringer interface
@@ -34,3 +55,34 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// StorageFee returns the fee for storing the given number of bytes.
+func (p Params) StorageFee(bytes uint64) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, coin := range p.StorageFeePerByte {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(bytes))))
+	}
+	return fee
+}
+
+func validateStorageFeePerByte(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid storage fee per byte: %w", err)
+	}
+	return nil
+}
+
+func validateSpecDeprecationGracePeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("spec deprecation grace period cannot be negative: %s", period)
+	}
+	return nil
+}
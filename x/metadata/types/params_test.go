@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, NewParams(sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)), time.Hour).Validate())
+	require.Error(t, NewParams(sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}, 0).Validate())
+	require.Error(t, NewParams(nil, -time.Hour).Validate())
+	require.Error(t, validateStorageFeePerByte("5nhash"))
+	require.Error(t, validateSpecDeprecationGracePeriod("1h"))
+}
+
+func TestParamsStorageFee(t *testing.T) {
+	require.True(t, DefaultParams().StorageFee(100).IsZero(), "no fee by default")
+	params := NewParams(sdk.NewCoins(sdk.NewInt64Coin("nhash", 5), sdk.NewInt64Coin("usd", 1)), 0)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nhash", 500), sdk.NewInt64Coin("usd", 100)), params.StorageFee(100))
+	require.True(t, params.StorageFee(0).IsZero())
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTransferScopeOwnership is a proposal to replace the owners and/or value owner of a scope.
+	ProposalTypeTransferScopeOwnership string = "TransferScopeOwnership"
+	// ProposalTypeSetScopeSpecificationStrict is a proposal to turn strict enforcement of a scope specification on or off.
+	ProposalTypeSetScopeSpecificationStrict string = "SetScopeSpecificationStrict"
+
+	// MaxEvidenceLength is the maximum length of the evidence given in a transfer scope ownership proposal.
+	MaxEvidenceLength = govtypes.MaxDescriptionLength
+)
+
+var (
+	_ govtypes.Content = &TransferScopeOwnershipProposal{}
+	_ govtypes.Content = &SetScopeSpecificationStrictProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTransferScopeOwnership)
+	govtypes.RegisterProposalTypeCodec(&TransferScopeOwnershipProposal{}, "provenance/metadata/TransferScopeOwnershipProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetScopeSpecificationStrict)
+	govtypes.RegisterProposalTypeCodec(&SetScopeSpecificationStrictProposal{}, "provenance/metadata/SetScopeSpecificationStrictProposal")
+}
+
+// NewTransferScopeOwnershipProposal creates a new governance proposal to replace the owners and/or value owner of a scope.
+func NewTransferScopeOwnershipProposal(
+	title, description string,
+	scopeID MetadataAddress,
+	owners []Party,
+	valueOwnerAddress string,
+	evidence string,
+) *TransferScopeOwnershipProposal {
+	return &TransferScopeOwnershipProposal{
+		Title:             title,
+		Description:       description,
+		ScopeId:           scopeID.String(),
+		Owners:            owners,
+		ValueOwnerAddress: valueOwnerAddress,
+		Evidence:          evidence,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p TransferScopeOwnershipProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p TransferScopeOwnershipProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p TransferScopeOwnershipProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p TransferScopeOwnershipProposal) ProposalType() string {
+	return ProposalTypeTransferScopeOwnership
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p TransferScopeOwnershipProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	scopeID, err := MetadataAddressFromBech32(p.ScopeId)
+	if err != nil {
+		return fmt.Errorf("invalid scope id: %w", err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("invalid scope id: %s is not a scope id", p.ScopeId)
+	}
+	if len(p.Owners) == 0 && len(p.ValueOwnerAddress) == 0 {
+		return errors.New("new owners or a new value owner address is required")
+	}
+	if len(p.Owners) > 0 {
+		if err = ValidatePartiesBasic(p.Owners); err != nil {
+			return fmt.Errorf("invalid scope owners: %w", err)
+		}
+	}
+	if len(p.ValueOwnerAddress) > 0 {
+		if _, err = sdk.AccAddressFromBech32(p.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid value owner address: %w", err)
+		}
+	}
+	if len(strings.TrimSpace(p.Evidence)) == 0 {
+		return errors.New("evidence of why the owners cannot make the change is required")
+	}
+	if len(p.Evidence) > MaxEvidenceLength {
+		return fmt.Errorf("evidence is longer than max length of %d", MaxEvidenceLength)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TransferScopeOwnershipProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Transfer Scope Ownership Proposal:
+  Title:       %s
+  Description: %s
+  Scope Id:    %s
+  Owners:      %v
+  Value Owner: %s
+  Evidence:    %s
+`, p.Title, p.Description, p.ScopeId, p.Owners, p.ValueOwnerAddress, p.Evidence))
+	return b.String()
+}
+
+// NewSetScopeSpecificationStrictProposal creates a new governance proposal to turn strict enforcement of a scope
+// specification on or off.
+func NewSetScopeSpecificationStrictProposal(
+	title, description string,
+	specificationID MetadataAddress,
+	strict bool,
+) *SetScopeSpecificationStrictProposal {
+	return &SetScopeSpecificationStrictProposal{
+		Title:           title,
+		Description:     description,
+		SpecificationId: specificationID.String(),
+		Strict:          strict,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p SetScopeSpecificationStrictProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p SetScopeSpecificationStrictProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p SetScopeSpecificationStrictProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p SetScopeSpecificationStrictProposal) ProposalType() string {
+	return ProposalTypeSetScopeSpecificationStrict
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p SetScopeSpecificationStrictProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	specID, err := MetadataAddressFromBech32(p.SpecificationId)
+	if err != nil {
+		return fmt.Errorf("invalid scope specification id: %w", err)
+	}
+	if !specID.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid scope specification id: %s is not a scope specification id", p.SpecificationId)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetScopeSpecificationStrictProposal) String() string {
+	return fmt.Sprintf(`Set Scope Specification Strict Proposal:
+  Title:            %s
+  Description:      %s
+  Specification Id: %s
+  Strict:           %t
+`, p.Title, p.Description, p.SpecificationId, p.Strict)
+}
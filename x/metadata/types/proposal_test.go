@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferScopeOwnershipProposalValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	addr := sdk.AccAddress("address_____________").String()
+	owners := []Party{{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}}
+
+	cases := []struct {
+		name   string
+		prop   *TransferScopeOwnershipProposal
+		errMsg string
+	}{
+		{"valid owners", NewTransferScopeOwnershipProposal("title", "description", scopeID, owners, "", "lost keys"), ""},
+		{"valid value owner", NewTransferScopeOwnershipProposal("title", "description", scopeID, nil, addr, "lost keys"), ""},
+		{"missing title", NewTransferScopeOwnershipProposal("", "description", scopeID, owners, "", "lost keys"), "proposal title cannot be blank"},
+		{"missing scope id", NewTransferScopeOwnershipProposal("title", "description", nil, owners, "", "lost keys"), "invalid scope id"},
+		{"not a scope id", NewTransferScopeOwnershipProposal("title", "description", ScopeSpecMetadataAddress(uuid.New()), owners, "", "lost keys"), "is not a scope id"},
+		{"nothing to change", NewTransferScopeOwnershipProposal("title", "description", scopeID, nil, "", "lost keys"), "new owners or a new value owner address is required"},
+		{"invalid owner", NewTransferScopeOwnershipProposal("title", "description", scopeID, []Party{{Address: addr}}, "", "lost keys"), "invalid scope owners"},
+		{"invalid value owner", NewTransferScopeOwnershipProposal("title", "description", scopeID, nil, "invalid", "lost keys"), "invalid value owner address"},
+		{"missing evidence", NewTransferScopeOwnershipProposal("title", "description", scopeID, owners, "", " "), "evidence of why the owners cannot make the change is required"},
+		{"evidence too long", NewTransferScopeOwnershipProposal("title", "description", scopeID, owners, "", strings.Repeat("x", MaxEvidenceLength+1)), "evidence is longer than max length"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.prop.ValidateBasic()
+			if len(tc.errMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetScopeSpecificationStrictProposalValidateBasic(t *testing.T) {
+	specID := ScopeSpecMetadataAddress(uuid.New())
+
+	cases := []struct {
+		name   string
+		prop   *SetScopeSpecificationStrictProposal
+		errMsg string
+	}{
+		{"valid strict", NewSetScopeSpecificationStrictProposal("title", "description", specID, true), ""},
+		{"valid lenient", NewSetScopeSpecificationStrictProposal("title", "description", specID, false), ""},
+		{"missing title", NewSetScopeSpecificationStrictProposal("", "description", specID, true), "proposal title cannot be blank"},
+		{"missing specification id", NewSetScopeSpecificationStrictProposal("title", "description", nil, true), "invalid scope specification id"},
+		{"not a scope specification id", NewSetScopeSpecificationStrictProposal("title", "description", ScopeMetadataAddress(uuid.New()), true), "is not a scope specification id"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.prop.ValidateBasic()
+			if len(tc.errMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/proposals.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferScopeOwnershipProposal defines a governance proposal to replace the owners and/or value owner of a scope whose
+// owners can no longer sign for it, e.g. because their keys have been lost.
+type TransferScopeOwnershipProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the bech32 address string of the scope to transfer
+	ScopeId string `protobuf:"bytes,3,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// the new owners of the scope, the existing owners are kept when empty
+	Owners []Party `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
+	// the new value owner of the scope, the existing value owner is kept when empty
+	ValueOwnerAddress string `protobuf:"bytes,5,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty"`
+	// why the existing owners are unable to make the change themselves, e.g. how the keys were lost
+	Evidence string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *TransferScopeOwnershipProposal) Reset()      { *m = TransferScopeOwnershipProposal{} }
+func (*TransferScopeOwnershipProposal) ProtoMessage() {}
+func (*TransferScopeOwnershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1d3400a8fc2f64, []int{0}
+}
+func (m *TransferScopeOwnershipProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferScopeOwnershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferScopeOwnershipProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferScopeOwnershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferScopeOwnershipProposal.Merge(m, src)
+}
+func (m *TransferScopeOwnershipProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferScopeOwnershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferScopeOwnershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferScopeOwnershipProposal proto.InternalMessageInfo
+
+// SetScopeSpecificationStrictProposal defines a governance proposal to turn strict record specification enforcement on
+// or off for the scopes of a scope specification.
+type SetScopeSpecificationStrictProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the bech32 address string of the scope specification to change
+	SpecificationId string `protobuf:"bytes,3,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty"`
+	// whether records in scopes of the specification must conform strictly to their record specifications
+	Strict bool `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (m *SetScopeSpecificationStrictProposal) Reset()      { *m = SetScopeSpecificationStrictProposal{} }
+func (*SetScopeSpecificationStrictProposal) ProtoMessage() {}
+func (*SetScopeSpecificationStrictProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a1d3400a8fc2f64, []int{1}
+}
+func (m *SetScopeSpecificationStrictProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetScopeSpecificationStrictProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetScopeSpecificationStrictProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetScopeSpecificationStrictProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetScopeSpecificationStrictProposal.Merge(m, src)
+}
+func (m *SetScopeSpecificationStrictProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetScopeSpecificationStrictProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetScopeSpecificationStrictProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetScopeSpecificationStrictProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TransferScopeOwnershipProposal)(nil), "provenance.metadata.v1.TransferScopeOwnershipProposal")
+	proto.RegisterType((*SetScopeSpecificationStrictProposal)(nil), "provenance.metadata.v1.SetScopeSpecificationStrictProposal")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/proposals.proto", fileDescriptor_7a1d3400a8fc2f64)
+}
+
+var fileDescriptor_7a1d3400a8fc2f64 = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0xae, 0xd3, 0x30,
+	0x18, 0x85, 0xe3, 0x7b, 0x7b, 0x43, 0xf1, 0x45, 0x02, 0xcc, 0xd5, 0x55, 0x6e, 0x25, 0x92, 0xa8,
+	0x48, 0xa8, 0x0c, 0x24, 0x2a, 0x30, 0xc1, 0x44, 0xb7, 0x4e, 0x54, 0x0d, 0x13, 0x4b, 0xe5, 0xda,
+	0x7f, 0x5b, 0x8b, 0x36, 0xb6, 0x6c, 0x37, 0xd0, 0x37, 0x40, 0x4c, 0x8c, 0x8c, 0x7d, 0x02, 0x9e,
+	0xa3, 0x63, 0x47, 0x26, 0x84, 0xda, 0x85, 0xc7, 0x40, 0x75, 0x0a, 0x69, 0x25, 0x3a, 0xb1, 0xe5,
+	0xfc, 0xe7, 0x8b, 0x8f, 0xff, 0x23, 0xe3, 0xc7, 0x4a, 0xcb, 0x02, 0x72, 0x9a, 0x33, 0x48, 0x67,
+	0x60, 0x29, 0xa7, 0x96, 0xa6, 0x45, 0x3b, 0x55, 0x5a, 0x2a, 0x69, 0xe8, 0xd4, 0x24, 0x4a, 0x4b,
+	0x2b, 0xc9, 0x75, 0xc5, 0x25, 0x7f, 0xb8, 0xa4, 0x68, 0x37, 0xae, 0xc6, 0x72, 0x2c, 0x1d, 0x92,
+	0xee, 0xbe, 0x4a, 0xba, 0xd1, 0x3c, 0x71, 0xaa, 0x61, 0x52, 0x41, 0xc9, 0x34, 0x3f, 0x9f, 0xe1,
+	0xf0, 0xad, 0xa6, 0xb9, 0x19, 0x81, 0xce, 0x76, 0xf3, 0x37, 0x1f, 0x72, 0xd0, 0x66, 0x22, 0x54,
+	0x6f, 0x9f, 0x4d, 0xae, 0xf0, 0x85, 0x15, 0x76, 0x0a, 0x01, 0x8a, 0x51, 0xeb, 0x76, 0xbf, 0x14,
+	0x24, 0xc6, 0x97, 0x1c, 0x0c, 0xd3, 0x42, 0x59, 0x21, 0xf3, 0xe0, 0xcc, 0x79, 0x87, 0x23, 0x72,
+	0x83, 0xeb, 0x2e, 0x69, 0x20, 0x78, 0x70, 0xee, 0xec, 0x5b, 0x4e, 0x77, 0x39, 0x79, 0x85, 0x7d,
+	0xe9, 0x72, 0x82, 0x5a, 0x7c, 0xde, 0xba, 0x7c, 0xf6, 0x30, 0xf9, 0xf7, 0x62, 0x49, 0x8f, 0x6a,
+	0xbb, 0xe8, 0xd4, 0x56, 0x3f, 0x22, 0xaf, 0xbf, 0xff, 0x85, 0x24, 0xf8, 0x41, 0x41, 0xa7, 0x73,
+	0x18, 0x38, 0x3d, 0xa0, 0x9c, 0x6b, 0x30, 0x26, 0xb8, 0x70, 0x11, 0xf7, 0x9d, 0xe5, 0x96, 0x78,
+	0x5d, 0x1a, 0xa4, 0x81, 0xeb, 0x50, 0x08, 0x0e, 0x39, 0x83, 0xc0, 0x77, 0xd0, 0x5f, 0xfd, 0xf2,
+	0xce, 0xa7, 0x65, 0xe4, 0x7d, 0x5d, 0x46, 0xde, 0xaf, 0x65, 0xe4, 0x35, 0xbf, 0x21, 0xfc, 0x28,
+	0x03, 0xeb, 0x7a, 0xc8, 0x14, 0x30, 0x31, 0x12, 0x8c, 0xee, 0x76, 0xc9, 0xac, 0x16, 0xcc, 0xfe,
+	0x77, 0x23, 0x4f, 0xf0, 0x3d, 0x73, 0x78, 0x6c, 0xd5, 0xcc, 0xdd, 0xa3, 0x79, 0x97, 0x93, 0x6b,
+	0xec, 0x1b, 0x17, 0x1a, 0xd4, 0x62, 0xd4, 0xaa, 0xf7, 0xf7, 0xea, 0xf8, 0xc2, 0x9d, 0xf7, 0xab,
+	0x4d, 0x88, 0xd6, 0x9b, 0x10, 0xfd, 0xdc, 0x84, 0xe8, 0xcb, 0x36, 0xf4, 0xd6, 0xdb, 0xd0, 0xfb,
+	0xbe, 0x0d, 0x3d, 0x7c, 0x23, 0xe4, 0x89, 0x4e, 0x7b, 0xe8, 0xdd, 0x8b, 0xb1, 0xb0, 0x93, 0xf9,
+	0x30, 0x61, 0x72, 0x96, 0x56, 0xd0, 0x53, 0x21, 0x0f, 0x54, 0xfa, 0xb1, 0x7a, 0x33, 0x76, 0xa1,
+	0xc0, 0x0c, 0x7d, 0xf7, 0x62, 0x9e, 0xff, 0x1e, 0x00, 0x2e, 0x2e, 0xbb, 0x99, 0xad, 0x02, 0x00,
+	0x00,
+}
+
+func (m *TransferScopeOwnershipProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferScopeOwnershipProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferScopeOwnershipProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetScopeSpecificationStrictProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetScopeSpecificationStrictProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetScopeSpecificationStrictProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferScopeOwnershipProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *SetScopeSpecificationStrictProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.Strict {
+		n += 2
+	}
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferScopeOwnershipProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferScopeOwnershipProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferScopeOwnershipProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetScopeSpecificationStrictProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetScopeSpecificationStrictProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetScopeSpecificationStrictProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposals(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposals
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposals
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposals
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposals        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposals          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposals = fmt.Errorf("proto: unexpected end of group")
+)
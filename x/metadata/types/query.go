@@ -1,5 +1,12 @@
 package types
 
+const (
+	// DefaultRecordLineageDepth is the number of input references followed by a record lineage query without a depth.
+	DefaultRecordLineageDepth = 10
+	// MaxRecordLineageDepth is the largest number of input references a record lineage query will follow.
+	MaxRecordLineageDepth = 100
+)
+
 // -------------- ScopeWrapper --------------
 
 // WrapScope wraps a scope in a ScopeWrapper and populates the _addr and _uuid fields.
@@ -49,6 +56,7 @@ func WrapRecord(record *Record) *RecordWrapper {
 		wrapper.Record = record
 		wrapper.RecordIdInfo = GetRecordIDInfo(record.GetRecordAddress())
 		wrapper.RecordSpecIdInfo = GetRecordSpecIDInfo(record.SpecificationId)
+		wrapper.Redacted = record.IsRedacted()
 	}
 	return &wrapper
 }
@@ -136,6 +136,11 @@ type ScopeRequest struct {
 	IncludeSessions bool `protobuf:"varint,10,opt,name=include_sessions,json=includeSessions,proto3" json:"include_sessions,omitempty" yaml:"include_sessions"`
 	// include_records is a flag for whether or not the records in the scope should be included.
 	IncludeRecords bool `protobuf:"varint,11,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty" yaml:"include_records"`
+	// field_mask is the paths of the response fields to return, as in a google.protobuf.FieldMask, e.g.
+	// "scope.scope.owners". Each path is the dot separated names of nested fields, and the rest of a path is applied to
+	// each entry of a repeated field, e.g. "sessions.session.specification_id". The request is always returned. When
+	// empty, all fields are returned. Sessions and records are only looked up when requested and in the field mask.
+	FieldMask []string `protobuf:"bytes,12,rep,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty" yaml:"field_mask"`
 }
 
 func (m *ScopeRequest) Reset()         { *m = ScopeRequest{} }
@@ -206,6 +211,13 @@ func (m *ScopeRequest) GetIncludeRecords() bool {
 	return false
 }
 
+func (m *ScopeRequest) GetFieldMask() []string {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
 // ScopeResponse is the response type for the Query/Scope RPC method.
 type ScopeResponse struct {
 	// scope is the wrapped scope result.
@@ -287,6 +299,10 @@ type ScopeWrapper struct {
 	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,2,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
 	// scope_spec_id_info contains information about the id/address of the scope specification.
 	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,3,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+	// size_bytes is the total size of the stored sessions and records of the scope.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty" yaml:"size_bytes"`
+	// archive is the stub of the scope's archived sessions and records, if they have been archived.
+	Archive *ScopeArchive `protobuf:"bytes,5,opt,name=archive,proto3" json:"archive,omitempty" yaml:"archive,omitempty"`
 }
 
 func (m *ScopeWrapper) Reset()         { *m = ScopeWrapper{} }
@@ -343,6 +359,20 @@ func (m *ScopeWrapper) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
 	return nil
 }
 
+func (m *ScopeWrapper) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *ScopeWrapper) GetArchive() *ScopeArchive {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
 // ScopesAllRequest is the request type for the Query/ScopesAll RPC method.
 type ScopesAllRequest struct {
 	// pagination defines optional pagination parameters for the request.
@@ -470,6 +500,11 @@ type SessionsRequest struct {
 	IncludeScope bool `protobuf:"varint,10,opt,name=include_scope,json=includeScope,proto3" json:"include_scope,omitempty" yaml:"include_scope"`
 	// include_records is a flag for whether or not the records in these sessions should be included.
 	IncludeRecords bool `protobuf:"varint,11,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty" yaml:"include_records"`
+	// field_mask is the paths of the response fields to return, as in a google.protobuf.FieldMask, e.g.
+	// "sessions.session.specification_id". Each path is the dot separated names of nested fields, and the rest of a path
+	// is applied to each entry of a repeated field. The request is always returned. When empty, all fields are returned.
+	// The scope and records are only looked up when requested and in the field mask.
+	FieldMask []string `protobuf:"bytes,12,rep,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty" yaml:"field_mask"`
 }
 
 func (m *SessionsRequest) Reset()         { *m = SessionsRequest{} }
@@ -547,6 +582,13 @@ func (m *SessionsRequest) GetIncludeRecords() bool {
 	return false
 }
 
+func (m *SessionsRequest) GetFieldMask() []string {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
 // SessionsResponse is the response type for the Query/Sessions RPC method.
 type SessionsResponse struct {
 	// scope is the wrapped scope that holds these sessions (if requested).
@@ -794,6 +836,126 @@ func (m *SessionsAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// SessionsByContractSpecRequest is the request type for the Query/SessionsByContractSpec RPC method.
+type SessionsByContractSpecRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SessionsByContractSpecRequest) Reset()         { *m = SessionsByContractSpecRequest{} }
+func (m *SessionsByContractSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionsByContractSpecRequest) ProtoMessage()    {}
+func (*SessionsByContractSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{12}
+}
+func (m *SessionsByContractSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionsByContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionsByContractSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionsByContractSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsByContractSpecRequest.Merge(m, src)
+}
+func (m *SessionsByContractSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionsByContractSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsByContractSpecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsByContractSpecRequest proto.InternalMessageInfo
+
+func (m *SessionsByContractSpecRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
+}
+
+func (m *SessionsByContractSpecRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SessionsByContractSpecResponse is the response type for the Query/SessionsByContractSpec RPC method.
+type SessionsByContractSpecResponse struct {
+	// sessions are the wrapped sessions written under the requested contract specification.
+	Sessions []*SessionWrapper `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *SessionsByContractSpecRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SessionsByContractSpecResponse) Reset()         { *m = SessionsByContractSpecResponse{} }
+func (m *SessionsByContractSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*SessionsByContractSpecResponse) ProtoMessage()    {}
+func (*SessionsByContractSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{13}
+}
+func (m *SessionsByContractSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionsByContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionsByContractSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionsByContractSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsByContractSpecResponse.Merge(m, src)
+}
+func (m *SessionsByContractSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionsByContractSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsByContractSpecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsByContractSpecResponse proto.InternalMessageInfo
+
+func (m *SessionsByContractSpecResponse) GetSessions() []*SessionWrapper {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *SessionsByContractSpecResponse) GetRequest() *SessionsByContractSpecRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SessionsByContractSpecResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // RecordsRequest is the request type for the Query/Records RPC method.
 type RecordsRequest struct {
 	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
@@ -817,7 +979,7 @@ func (m *RecordsRequest) Reset()         { *m = RecordsRequest{} }
 func (m *RecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsRequest) ProtoMessage()    {}
 func (*RecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{12}
+	return fileDescriptor_a68790bc0b96eeb9, []int{14}
 }
 func (m *RecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +1066,7 @@ func (m *RecordsResponse) Reset()         { *m = RecordsResponse{} }
 func (m *RecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsResponse) ProtoMessage()    {}
 func (*RecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{13}
+	return fileDescriptor_a68790bc0b96eeb9, []int{15}
 }
 func (m *RecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,13 +1131,15 @@ type RecordWrapper struct {
 	RecordIdInfo *RecordIdInfo `protobuf:"bytes,2,opt,name=record_id_info,json=recordIdInfo,proto3" json:"record_id_info,omitempty" yaml:"record_id_info"`
 	// record_spec_id_info contains information about the id/address of the record specification.
 	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,3,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
+	// redacted is true if the output values of the record have been redacted.
+	Redacted bool `protobuf:"varint,4,opt,name=redacted,proto3" json:"redacted,omitempty"`
 }
 
 func (m *RecordWrapper) Reset()         { *m = RecordWrapper{} }
 func (m *RecordWrapper) String() string { return proto.CompactTextString(m) }
 func (*RecordWrapper) ProtoMessage()    {}
 func (*RecordWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{14}
+	return fileDescriptor_a68790bc0b96eeb9, []int{16}
 }
 func (m *RecordWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,6 +1189,13 @@ func (m *RecordWrapper) GetRecordSpecIdInfo() *RecordSpecIdInfo {
 	return nil
 }
 
+func (m *RecordWrapper) GetRedacted() bool {
+	if m != nil {
+		return m.Redacted
+	}
+	return false
+}
+
 // RecordsAllRequest is the request type for the Query/RecordsAll RPC method.
 type RecordsAllRequest struct {
 	// pagination defines optional pagination parameters for the request.
@@ -1035,7 +1206,7 @@ func (m *RecordsAllRequest) Reset()         { *m = RecordsAllRequest{} }
 func (m *RecordsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllRequest) ProtoMessage()    {}
 func (*RecordsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{15}
+	return fileDescriptor_a68790bc0b96eeb9, []int{17}
 }
 func (m *RecordsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1256,7 @@ func (m *RecordsAllResponse) Reset()         { *m = RecordsAllResponse{} }
 func (m *RecordsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllResponse) ProtoMessage()    {}
 func (*RecordsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{16}
+	return fileDescriptor_a68790bc0b96eeb9, []int{18}
 }
 func (m *RecordsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,25 +1306,25 @@ func (m *RecordsAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// OwnershipRequest is the request type for the Query/Ownership RPC method.
-type OwnershipRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// RecordConformanceRequest is the request type for the Query/RecordConformance RPC method.
+type RecordConformanceRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
 }
 
-func (m *OwnershipRequest) Reset()         { *m = OwnershipRequest{} }
-func (m *OwnershipRequest) String() string { return proto.CompactTextString(m) }
-func (*OwnershipRequest) ProtoMessage()    {}
-func (*OwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{17}
+func (m *RecordConformanceRequest) Reset()         { *m = RecordConformanceRequest{} }
+func (m *RecordConformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordConformanceRequest) ProtoMessage()    {}
+func (*RecordConformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{19}
 }
-func (m *OwnershipRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordConformanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordConformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OwnershipRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordConformanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1163,54 +1334,49 @@ func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *OwnershipRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OwnershipRequest.Merge(m, src)
+func (m *RecordConformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordConformanceRequest.Merge(m, src)
 }
-func (m *OwnershipRequest) XXX_Size() int {
+func (m *RecordConformanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OwnershipRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OwnershipRequest.DiscardUnknown(m)
+func (m *RecordConformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordConformanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OwnershipRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordConformanceRequest proto.InternalMessageInfo
 
-func (m *OwnershipRequest) GetAddress() string {
+func (m *RecordConformanceRequest) GetScopeId() string {
 	if m != nil {
-		return m.Address
+		return m.ScopeId
 	}
 	return ""
 }
 
-func (m *OwnershipRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// OwnershipResponse is the response type for the Query/Ownership RPC method.
-type OwnershipResponse struct {
-	// A list of scope ids (uuid) associated with the given address.
-	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
+// RecordConformanceResponse is the response type for the Query/RecordConformance RPC method.
+type RecordConformanceResponse struct {
+	// scope_id_info contains information about the scope whose records were checked.
+	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
+	// records contains the conformance of each record of the scope.
+	Records []RecordConformance `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	// conforms is true if every record of the scope conforms to its record specification.
+	Conforms bool `protobuf:"varint,3,opt,name=conforms,proto3" json:"conforms,omitempty"`
 	// request is a copy of the request that generated these results.
-	Request *OwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Request *RecordConformanceRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *OwnershipResponse) Reset()         { *m = OwnershipResponse{} }
-func (m *OwnershipResponse) String() string { return proto.CompactTextString(m) }
-func (*OwnershipResponse) ProtoMessage()    {}
-func (*OwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{18}
+func (m *RecordConformanceResponse) Reset()         { *m = RecordConformanceResponse{} }
+func (m *RecordConformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordConformanceResponse) ProtoMessage()    {}
+func (*RecordConformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{20}
 }
-func (m *OwnershipResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordConformanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordConformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OwnershipResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordConformanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,58 +1386,72 @@ func (m *OwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *OwnershipResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OwnershipResponse.Merge(m, src)
+func (m *RecordConformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordConformanceResponse.Merge(m, src)
 }
-func (m *OwnershipResponse) XXX_Size() int {
+func (m *RecordConformanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OwnershipResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OwnershipResponse.DiscardUnknown(m)
+func (m *RecordConformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordConformanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OwnershipResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordConformanceResponse proto.InternalMessageInfo
 
-func (m *OwnershipResponse) GetScopeUuids() []string {
+func (m *RecordConformanceResponse) GetScopeIdInfo() *ScopeIdInfo {
 	if m != nil {
-		return m.ScopeUuids
+		return m.ScopeIdInfo
 	}
 	return nil
 }
 
-func (m *OwnershipResponse) GetRequest() *OwnershipRequest {
+func (m *RecordConformanceResponse) GetRecords() []RecordConformance {
 	if m != nil {
-		return m.Request
+		return m.Records
 	}
 	return nil
 }
 
-func (m *OwnershipResponse) GetPagination() *query.PageResponse {
+func (m *RecordConformanceResponse) GetConforms() bool {
 	if m != nil {
-		return m.Pagination
+		return m.Conforms
 	}
-	return nil
+	return false
 }
 
-// ValueOwnershipRequest is the request type for the Query/ValueOwnership RPC method.
-type ValueOwnershipRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *RecordConformanceResponse) GetRequest() *RecordConformanceRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *ValueOwnershipRequest) Reset()         { *m = ValueOwnershipRequest{} }
-func (m *ValueOwnershipRequest) String() string { return proto.CompactTextString(m) }
-func (*ValueOwnershipRequest) ProtoMessage()    {}
-func (*ValueOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{19}
+// RecordConformance is the result of checking a single record against its record specification.
+type RecordConformance struct {
+	// record_addr is the bech32 address of the record.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty" yaml:"record_addr"`
+	// name is the name of the record.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// specification_id is the bech32 address of the record specification the record was checked against.
+	SpecificationId string `protobuf:"bytes,3,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// conforms is true if the record conforms to its record specification.
+	Conforms bool `protobuf:"varint,4,opt,name=conforms,proto3" json:"conforms,omitempty"`
+	// problems describes each way in which the record does not conform to its record specification.
+	Problems []string `protobuf:"bytes,5,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (m *RecordConformance) Reset()         { *m = RecordConformance{} }
+func (m *RecordConformance) String() string { return proto.CompactTextString(m) }
+func (*RecordConformance) ProtoMessage()    {}
+func (*RecordConformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{21}
 }
-func (m *ValueOwnershipRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordConformance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValueOwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordConformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValueOwnershipRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordConformance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1281,54 +1461,73 @@ func (m *ValueOwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ValueOwnershipRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValueOwnershipRequest.Merge(m, src)
+func (m *RecordConformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordConformance.Merge(m, src)
 }
-func (m *ValueOwnershipRequest) XXX_Size() int {
+func (m *RecordConformance) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValueOwnershipRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValueOwnershipRequest.DiscardUnknown(m)
+func (m *RecordConformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordConformance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValueOwnershipRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordConformance proto.InternalMessageInfo
 
-func (m *ValueOwnershipRequest) GetAddress() string {
+func (m *RecordConformance) GetRecordAddr() string {
 	if m != nil {
-		return m.Address
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *ValueOwnershipRequest) GetPagination() *query.PageRequest {
+func (m *RecordConformance) GetName() string {
 	if m != nil {
-		return m.Pagination
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RecordConformance) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
+}
+
+func (m *RecordConformance) GetConforms() bool {
+	if m != nil {
+		return m.Conforms
+	}
+	return false
+}
+
+func (m *RecordConformance) GetProblems() []string {
+	if m != nil {
+		return m.Problems
 	}
 	return nil
 }
 
-// ValueOwnershipResponse is the response type for the Query/ValueOwnership RPC method.
-type ValueOwnershipResponse struct {
-	// A list of scope ids (uuid) associated with the given address.
-	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
-	// request is a copy of the request that generated these results.
-	Request *ValueOwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// RecordConsumersRequest is the request type for the Query/RecordConsumers RPC method.
+type RecordConsumersRequest struct {
+	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty" yaml:"record_addr"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ValueOwnershipResponse) Reset()         { *m = ValueOwnershipResponse{} }
-func (m *ValueOwnershipResponse) String() string { return proto.CompactTextString(m) }
-func (*ValueOwnershipResponse) ProtoMessage()    {}
-func (*ValueOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{20}
+func (m *RecordConsumersRequest) Reset()         { *m = RecordConsumersRequest{} }
+func (m *RecordConsumersRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordConsumersRequest) ProtoMessage()    {}
+func (*RecordConsumersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{22}
 }
-func (m *ValueOwnershipResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordConsumersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValueOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordConsumersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValueOwnershipResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordConsumersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1338,58 +1537,116 @@ func (m *ValueOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ValueOwnershipResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValueOwnershipResponse.Merge(m, src)
+func (m *RecordConsumersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordConsumersRequest.Merge(m, src)
 }
-func (m *ValueOwnershipResponse) XXX_Size() int {
+func (m *RecordConsumersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValueOwnershipResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValueOwnershipResponse.DiscardUnknown(m)
+func (m *RecordConsumersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordConsumersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValueOwnershipResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordConsumersRequest proto.InternalMessageInfo
 
-func (m *ValueOwnershipResponse) GetScopeUuids() []string {
+func (m *RecordConsumersRequest) GetRecordAddr() string {
 	if m != nil {
-		return m.ScopeUuids
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *RecordConsumersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *ValueOwnershipResponse) GetRequest() *ValueOwnershipRequest {
+// RecordConsumersResponse is the response type for the Query/RecordConsumers RPC method.
+type RecordConsumersResponse struct {
+	// records are the wrapped records with an input that references the requested record.
+	Records []*RecordWrapper `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *RecordConsumersRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RecordConsumersResponse) Reset()         { *m = RecordConsumersResponse{} }
+func (m *RecordConsumersResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordConsumersResponse) ProtoMessage()    {}
+func (*RecordConsumersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+}
+func (m *RecordConsumersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordConsumersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordConsumersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordConsumersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordConsumersResponse.Merge(m, src)
+}
+func (m *RecordConsumersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordConsumersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordConsumersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordConsumersResponse proto.InternalMessageInfo
+
+func (m *RecordConsumersResponse) GetRecords() []*RecordWrapper {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *RecordConsumersResponse) GetRequest() *RecordConsumersRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *ValueOwnershipResponse) GetPagination() *query.PageResponse {
+func (m *RecordConsumersResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// ScopeSpecificationRequest is the request type for the Query/ScopeSpecification RPC method.
-type ScopeSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
-	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+// RecordLineageRequest is the request type for the Query/RecordLineage RPC method.
+type RecordLineageRequest struct {
+	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty" yaml:"record_addr"`
+	// depth is the number of input references to follow from the record. The default is 10 and the maximum is 100.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationRequest{} }
-func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationRequest) ProtoMessage()    {}
-func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{21}
+func (m *RecordLineageRequest) Reset()         { *m = RecordLineageRequest{} }
+func (m *RecordLineageRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordLineageRequest) ProtoMessage()    {}
+func (*RecordLineageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{24}
 }
-func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordLineageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordLineageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordLineageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1399,45 +1656,56 @@ func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationRequest.Merge(m, src)
+func (m *RecordLineageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordLineageRequest.Merge(m, src)
 }
-func (m *ScopeSpecificationRequest) XXX_Size() int {
+func (m *RecordLineageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationRequest.DiscardUnknown(m)
+func (m *RecordLineageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordLineageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordLineageRequest proto.InternalMessageInfo
 
-func (m *ScopeSpecificationRequest) GetSpecificationId() string {
+func (m *RecordLineageRequest) GetRecordAddr() string {
 	if m != nil {
-		return m.SpecificationId
+		return m.RecordAddr
 	}
 	return ""
 }
 
-// ScopeSpecificationResponse is the response type for the Query/ScopeSpecification RPC method.
-type ScopeSpecificationResponse struct {
-	// scope_specification is the wrapped scope specification.
-	ScopeSpecification *ScopeSpecificationWrapper `protobuf:"bytes,1,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
+func (m *RecordLineageRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// RecordLineageResponse is the response type for the Query/RecordLineage RPC method.
+type RecordLineageResponse struct {
+	// records are the records of the lineage in breadth-first order, starting with the requested record.
+	Records []RecordLineageNode `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// sessions are the wrapped sessions the records of the lineage were written in.
+	Sessions []*SessionWrapper `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// truncated is true if a record at the requested depth has inputs that reference records not in the lineage.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	// request is a copy of the request that generated these results.
-	Request *ScopeSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *RecordLineageRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationResponse{} }
-func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationResponse) ProtoMessage()    {}
-func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+func (m *RecordLineageResponse) Reset()         { *m = RecordLineageResponse{} }
+func (m *RecordLineageResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordLineageResponse) ProtoMessage()    {}
+func (*RecordLineageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{25}
 }
-func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordLineageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordLineageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordLineageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1447,52 +1715,68 @@ func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationResponse.Merge(m, src)
+func (m *RecordLineageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordLineageResponse.Merge(m, src)
 }
-func (m *ScopeSpecificationResponse) XXX_Size() int {
+func (m *RecordLineageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationResponse.DiscardUnknown(m)
+func (m *RecordLineageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordLineageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordLineageResponse proto.InternalMessageInfo
 
-func (m *ScopeSpecificationResponse) GetScopeSpecification() *ScopeSpecificationWrapper {
+func (m *RecordLineageResponse) GetRecords() []RecordLineageNode {
 	if m != nil {
-		return m.ScopeSpecification
+		return m.Records
 	}
 	return nil
 }
 
-func (m *ScopeSpecificationResponse) GetRequest() *ScopeSpecificationRequest {
+func (m *RecordLineageResponse) GetSessions() []*SessionWrapper {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *RecordLineageResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *RecordLineageResponse) GetRequest() *RecordLineageRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// ScopeSpecificationWrapper contains a single scope specification and some extra identifiers for it.
-type ScopeSpecificationWrapper struct {
-	// specification is the on-chain scope specification message.
-	Specification *ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// scope_spec_id_info contains information about the id/address of the scope specification.
-	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,2,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+// RecordLineageNode is a record in the lineage of another record.
+type RecordLineageNode struct {
+	// record is the wrapped record.
+	Record *RecordWrapper `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// depth is the number of input references followed to reach the record, 0 for the requested record.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// source_record_addrs are the bech32 addresses of the records referenced by the inputs of the record.
+	SourceRecordAddrs []string `protobuf:"bytes,3,rep,name=source_record_addrs,json=sourceRecordAddrs,proto3" json:"source_record_addrs,omitempty" yaml:"source_record_addrs"`
 }
 
-func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWrapper{} }
-func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationWrapper) ProtoMessage()    {}
-func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+func (m *RecordLineageNode) Reset()         { *m = RecordLineageNode{} }
+func (m *RecordLineageNode) String() string { return proto.CompactTextString(m) }
+func (*RecordLineageNode) ProtoMessage()    {}
+func (*RecordLineageNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{26}
 }
-func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *RecordLineageNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordLineageNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordLineageNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1502,50 +1786,58 @@ func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationWrapper.Merge(m, src)
+func (m *RecordLineageNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordLineageNode.Merge(m, src)
 }
-func (m *ScopeSpecificationWrapper) XXX_Size() int {
+func (m *RecordLineageNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationWrapper.DiscardUnknown(m)
+func (m *RecordLineageNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordLineageNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationWrapper proto.InternalMessageInfo
+var xxx_messageInfo_RecordLineageNode proto.InternalMessageInfo
 
-func (m *ScopeSpecificationWrapper) GetSpecification() *ScopeSpecification {
+func (m *RecordLineageNode) GetRecord() *RecordWrapper {
 	if m != nil {
-		return m.Specification
+		return m.Record
 	}
 	return nil
 }
 
-func (m *ScopeSpecificationWrapper) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
+func (m *RecordLineageNode) GetDepth() uint32 {
 	if m != nil {
-		return m.ScopeSpecIdInfo
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *RecordLineageNode) GetSourceRecordAddrs() []string {
+	if m != nil {
+		return m.SourceRecordAddrs
 	}
 	return nil
 }
 
-// ScopeSpecificationsAllRequest is the request type for the Query/ScopeSpecificationsAll RPC method.
-type ScopeSpecificationsAllRequest struct {
+// OwnershipRequest is the request type for the Query/Ownership RPC method.
+type OwnershipRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificationsAllRequest{} }
-func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
-func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{24}
+func (m *OwnershipRequest) Reset()         { *m = OwnershipRequest{} }
+func (m *OwnershipRequest) String() string { return proto.CompactTextString(m) }
+func (*OwnershipRequest) ProtoMessage()    {}
+func (*OwnershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{27}
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+func (m *OwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OwnershipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1555,47 +1847,54 @@ func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationsAllRequest.Merge(m, src)
+func (m *OwnershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipRequest.Merge(m, src)
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Size() int {
+func (m *OwnershipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationsAllRequest.DiscardUnknown(m)
+func (m *OwnershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_OwnershipRequest proto.InternalMessageInfo
 
-func (m *ScopeSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *OwnershipRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OwnershipRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// ScopeSpecificationsAllResponse is the response type for the Query/ScopeSpecificationsAll RPC method.
-type ScopeSpecificationsAllResponse struct {
-	// scope_specifications are the wrapped scope specifications.
-	ScopeSpecifications []*ScopeSpecificationWrapper `protobuf:"bytes,1,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications,omitempty" yaml:"scope_specifications"`
+// OwnershipResponse is the response type for the Query/Ownership RPC method.
+type OwnershipResponse struct {
+	// A list of scope ids (uuid) associated with the given address.
+	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
 	// request is a copy of the request that generated these results.
-	Request *ScopeSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *OwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificationsAllResponse{} }
-func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
-func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{25}
+func (m *OwnershipResponse) Reset()         { *m = OwnershipResponse{} }
+func (m *OwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*OwnershipResponse) ProtoMessage()    {}
+func (*OwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{28}
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *OwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OwnershipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1605,63 +1904,58 @@ func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationsAllResponse.Merge(m, src)
+func (m *OwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipResponse.Merge(m, src)
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Size() int {
+func (m *OwnershipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationsAllResponse.DiscardUnknown(m)
+func (m *OwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_OwnershipResponse proto.InternalMessageInfo
 
-func (m *ScopeSpecificationsAllResponse) GetScopeSpecifications() []*ScopeSpecificationWrapper {
+func (m *OwnershipResponse) GetScopeUuids() []string {
 	if m != nil {
-		return m.ScopeSpecifications
+		return m.ScopeUuids
 	}
 	return nil
 }
 
-func (m *ScopeSpecificationsAllResponse) GetRequest() *ScopeSpecificationsAllRequest {
+func (m *OwnershipResponse) GetRequest() *OwnershipRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *ScopeSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *OwnershipResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// ContractSpecificationRequest is the request type for the Query/ContractSpecification RPC method.
-type ContractSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
-	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
-	// It can also be a record specification address, e.g.
-	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
-	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
-	// include_record_specs is a flag for whether or not the record specifications in this contract specification should
-	// be included in the result.
-	IncludeRecordSpecs bool `protobuf:"varint,10,opt,name=include_record_specs,json=includeRecordSpecs,proto3" json:"include_record_specs,omitempty" yaml:"include_record_specs"`
+// ValueOwnershipRequest is the request type for the Query/ValueOwnership RPC method.
+type ValueOwnershipRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificationRequest{} }
-func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationRequest) ProtoMessage()    {}
-func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{26}
+func (m *ValueOwnershipRequest) Reset()         { *m = ValueOwnershipRequest{} }
+func (m *ValueOwnershipRequest) String() string { return proto.CompactTextString(m) }
+func (*ValueOwnershipRequest) ProtoMessage()    {}
+func (*ValueOwnershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{29}
 }
-func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ValueOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValueOwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValueOwnershipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1671,55 +1965,54 @@ func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationRequest.Merge(m, src)
+func (m *ValueOwnershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueOwnershipRequest.Merge(m, src)
 }
-func (m *ContractSpecificationRequest) XXX_Size() int {
+func (m *ValueOwnershipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationRequest.DiscardUnknown(m)
+func (m *ValueOwnershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueOwnershipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValueOwnershipRequest proto.InternalMessageInfo
 
-func (m *ContractSpecificationRequest) GetSpecificationId() string {
+func (m *ValueOwnershipRequest) GetAddress() string {
 	if m != nil {
-		return m.SpecificationId
+		return m.Address
 	}
 	return ""
 }
 
-func (m *ContractSpecificationRequest) GetIncludeRecordSpecs() bool {
+func (m *ValueOwnershipRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.IncludeRecordSpecs
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// ContractSpecificationResponse is the response type for the Query/ContractSpecification RPC method.
-type ContractSpecificationResponse struct {
-	// contract_specification is the wrapped contract specification.
-	ContractSpecification *ContractSpecificationWrapper `protobuf:"bytes,1,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification,omitempty" yaml:"contract_specification"`
-	// record_specifications is any number or wrapped record specifications associated with this contract_specification
-	// (if requested).
-	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications,omitempty"`
+// ValueOwnershipResponse is the response type for the Query/ValueOwnership RPC method.
+type ValueOwnershipResponse struct {
+	// A list of scope ids (uuid) associated with the given address.
+	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
 	// request is a copy of the request that generated these results.
-	Request *ContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ValueOwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecificationResponse{} }
-func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationResponse) ProtoMessage()    {}
-func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{27}
+func (m *ValueOwnershipResponse) Reset()         { *m = ValueOwnershipResponse{} }
+func (m *ValueOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*ValueOwnershipResponse) ProtoMessage()    {}
+func (*ValueOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{30}
 }
-func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValueOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValueOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValueOwnershipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1729,59 +2022,58 @@ func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationResponse.Merge(m, src)
+func (m *ValueOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueOwnershipResponse.Merge(m, src)
 }
-func (m *ContractSpecificationResponse) XXX_Size() int {
+func (m *ValueOwnershipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationResponse.DiscardUnknown(m)
+func (m *ValueOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueOwnershipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValueOwnershipResponse proto.InternalMessageInfo
 
-func (m *ContractSpecificationResponse) GetContractSpecification() *ContractSpecificationWrapper {
+func (m *ValueOwnershipResponse) GetScopeUuids() []string {
 	if m != nil {
-		return m.ContractSpecification
+		return m.ScopeUuids
 	}
 	return nil
 }
 
-func (m *ContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *ValueOwnershipResponse) GetRequest() *ValueOwnershipRequest {
 	if m != nil {
-		return m.RecordSpecifications
+		return m.Request
 	}
 	return nil
 }
 
-func (m *ContractSpecificationResponse) GetRequest() *ContractSpecificationRequest {
+func (m *ValueOwnershipResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Request
+		return m.Pagination
 	}
 	return nil
 }
 
-// ContractSpecificationWrapper contains a single contract specification and some extra identifiers for it.
-type ContractSpecificationWrapper struct {
-	// specification is the on-chain contract specification message.
-	Specification *ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// contract_spec_id_info contains information about the id/address of the contract specification.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,2,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+// ScopeSpecificationRequest is the request type for the Query/ScopeSpecification RPC method.
+type ScopeSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
+	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
 }
 
-func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificationWrapper{} }
-func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationWrapper) ProtoMessage()    {}
-func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{28}
+func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationRequest{} }
+func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationRequest) ProtoMessage()    {}
+func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{31}
 }
-func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1791,50 +2083,45 @@ func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationWrapper.Merge(m, src)
+func (m *ScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationRequest.Merge(m, src)
 }
-func (m *ContractSpecificationWrapper) XXX_Size() int {
+func (m *ScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationWrapper.DiscardUnknown(m)
+func (m *ScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationWrapper proto.InternalMessageInfo
-
-func (m *ContractSpecificationWrapper) GetSpecification() *ContractSpecification {
-	if m != nil {
-		return m.Specification
-	}
-	return nil
-}
+var xxx_messageInfo_ScopeSpecificationRequest proto.InternalMessageInfo
 
-func (m *ContractSpecificationWrapper) GetContractSpecIdInfo() *ContractSpecIdInfo {
+func (m *ScopeSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
-		return m.ContractSpecIdInfo
+		return m.SpecificationId
 	}
-	return nil
+	return ""
 }
 
-// ContractSpecificationsAllRequest is the request type for the Query/ContractSpecificationsAll RPC method.
-type ContractSpecificationsAllRequest struct {
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// ScopeSpecificationResponse is the response type for the Query/ScopeSpecification RPC method.
+type ScopeSpecificationResponse struct {
+	// scope_specification is the wrapped scope specification.
+	ScopeSpecification *ScopeSpecificationWrapper `protobuf:"bytes,1,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecificationsAllRequest{} }
-func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
-func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{29}
+func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationResponse{} }
+func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationResponse) ProtoMessage()    {}
+func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{32}
 }
-func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1844,47 +2131,58 @@ func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationsAllRequest.Merge(m, src)
+func (m *ScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationResponse.Merge(m, src)
 }
-func (m *ContractSpecificationsAllRequest) XXX_Size() int {
+func (m *ScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationsAllRequest.DiscardUnknown(m)
+func (m *ScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationResponse proto.InternalMessageInfo
 
-func (m *ContractSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *ScopeSpecificationResponse) GetScopeSpecification() *ScopeSpecificationWrapper {
 	if m != nil {
-		return m.Pagination
+		return m.ScopeSpecification
 	}
 	return nil
 }
 
-// ContractSpecificationsAllResponse is the response type for the Query/ContractSpecificationsAll RPC method.
-type ContractSpecificationsAllResponse struct {
-	// contract_specifications are the wrapped contract specifications.
-	ContractSpecifications []*ContractSpecificationWrapper `protobuf:"bytes,1,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications,omitempty" yaml:"contract_specifications"`
-	// request is a copy of the request that generated these results.
-	Request *ContractSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *ScopeSpecificationResponse) GetRequest() *ScopeSpecificationRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpecificationsAllResponse{} }
-func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
-func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{30}
+// ScopeSpecificationWrapper contains a single scope specification and some extra identifiers for it.
+type ScopeSpecificationWrapper struct {
+	// specification is the on-chain scope specification message.
+	Specification *ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// scope_spec_id_info contains information about the id/address of the scope specification.
+	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,2,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+	// deprecation is the deprecation of the scope specification, if it has been deprecated.
+	Deprecation *SpecificationDeprecation `protobuf:"bytes,3,opt,name=deprecation,proto3" json:"deprecation,omitempty" yaml:"deprecation,omitempty"`
+	// latest_successor_addr is the bech32 address of the last specification in the chain of successors of a deprecated
+	// scope specification, the one clients should move to.  It is empty if the specification is not deprecated or
+	// has no successor.
+	LatestSuccessorAddr string `protobuf:"bytes,4,opt,name=latest_successor_addr,json=latestSuccessorAddr,proto3" json:"latest_successor_addr,omitempty" yaml:"latest_successor_addr,omitempty"`
 }
-func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWrapper{} }
+func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationWrapper) ProtoMessage()    {}
+func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{33}
+}
+func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1894,65 +2192,64 @@ func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationsAllResponse.Merge(m, src)
+func (m *ScopeSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationWrapper.Merge(m, src)
 }
-func (m *ContractSpecificationsAllResponse) XXX_Size() int {
+func (m *ScopeSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationsAllResponse.DiscardUnknown(m)
+func (m *ScopeSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationWrapper proto.InternalMessageInfo
 
-func (m *ContractSpecificationsAllResponse) GetContractSpecifications() []*ContractSpecificationWrapper {
+func (m *ScopeSpecificationWrapper) GetSpecification() *ScopeSpecification {
 	if m != nil {
-		return m.ContractSpecifications
+		return m.Specification
 	}
 	return nil
 }
 
-func (m *ContractSpecificationsAllResponse) GetRequest() *ContractSpecificationsAllRequest {
+func (m *ScopeSpecificationWrapper) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
 	if m != nil {
-		return m.Request
+		return m.ScopeSpecIdInfo
 	}
 	return nil
 }
 
-func (m *ContractSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *ScopeSpecificationWrapper) GetDeprecation() *SpecificationDeprecation {
 	if m != nil {
-		return m.Pagination
+		return m.Deprecation
 	}
 	return nil
 }
 
-// RecordSpecificationsForContractSpecificationRequest is the request type for the
-// Query/RecordSpecificationsForContractSpecification RPC method.
-type RecordSpecificationsForContractSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
-	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
-	// It can also be a record specification address, e.g.
-	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
-	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+func (m *ScopeSpecificationWrapper) GetLatestSuccessorAddr() string {
+	if m != nil {
+		return m.LatestSuccessorAddr
+	}
+	return ""
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Reset() {
-	*m = RecordSpecificationsForContractSpecificationRequest{}
-}
-func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
-func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{31}
+// ScopeSpecificationsAllRequest is the request type for the Query/ScopeSpecificationsAll RPC method.
+type ScopeSpecificationsAllRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificationsAllRequest{} }
+func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
+func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{34}
+}
+func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationsAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1962,54 +2259,47 @@ func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []by
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Merge(m, src)
+func (m *ScopeSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationsAllRequest.Merge(m, src)
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Size() int {
+func (m *ScopeSpecificationsAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.DiscardUnknown(m)
+func (m *ScopeSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationsAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationsAllRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationsForContractSpecificationRequest) GetSpecificationId() string {
+func (m *ScopeSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.SpecificationId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// RecordSpecificationsForContractSpecificationResponse is the response type for the
-// Query/RecordSpecificationsForContractSpecification RPC method.
-type RecordSpecificationsForContractSpecificationResponse struct {
-	// record_specifications is any number of wrapped record specifications associated with this contract_specification.
-	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
-	// contract_specification_uuid is the uuid of this contract specification.
-	ContractSpecificationUuid string `protobuf:"bytes,2,opt,name=contract_specification_uuid,json=contractSpecificationUuid,proto3" json:"contract_specification_uuid,omitempty" yaml:"contract_specification_uuid"`
-	// contract_specification_addr is the contract specification address as a bech32 encoded string.
-	ContractSpecificationAddr string `protobuf:"bytes,3,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty" yaml:"contract_specification_addr"`
+// ScopeSpecificationsAllResponse is the response type for the Query/ScopeSpecificationsAll RPC method.
+type ScopeSpecificationsAllResponse struct {
+	// scope_specifications are the wrapped scope specifications.
+	ScopeSpecifications []*ScopeSpecificationWrapper `protobuf:"bytes,1,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications,omitempty" yaml:"scope_specifications"`
 	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationsForContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ScopeSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Reset() {
-	*m = RecordSpecificationsForContractSpecificationResponse{}
-}
-func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
-func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{32}
+func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificationsAllResponse{} }
+func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
+func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{35}
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2019,71 +2309,63 @@ func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []b
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Merge(m, src)
+func (m *ScopeSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationsAllResponse.Merge(m, src)
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Size() int {
+func (m *ScopeSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.DiscardUnknown(m)
+func (m *ScopeSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *ScopeSpecificationsAllResponse) GetScopeSpecifications() []*ScopeSpecificationWrapper {
 	if m != nil {
-		return m.RecordSpecifications
+		return m.ScopeSpecifications
 	}
 	return nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationUuid() string {
-	if m != nil {
-		return m.ContractSpecificationUuid
-	}
-	return ""
-}
-
-func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationAddr() string {
+func (m *ScopeSpecificationsAllResponse) GetRequest() *ScopeSpecificationsAllRequest {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.Request
 	}
-	return ""
+	return nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetRequest() *RecordSpecificationsForContractSpecificationRequest {
+func (m *ScopeSpecificationsAllResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Request
+		return m.Pagination
 	}
 	return nil
 }
 
-// RecordSpecificationRequest is the request type for the Query/RecordSpecification RPC method.
-type RecordSpecificationRequest struct {
+// ContractSpecificationRequest is the request type for the Query/ContractSpecification RPC method.
+type ContractSpecificationRequest struct {
 	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
 	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
 	// It can also be a record specification address, e.g.
 	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
 	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
-	// name is the name of the record to look up.
-	// It is required if the specification_id is a uuid or contract specification address.
-	// It is ignored if the specification_id is a record specification address.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// include_record_specs is a flag for whether or not the record specifications in this contract specification should
+	// be included in the result.
+	IncludeRecordSpecs bool `protobuf:"varint,10,opt,name=include_record_specs,json=includeRecordSpecs,proto3" json:"include_record_specs,omitempty" yaml:"include_record_specs"`
 }
 
-func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationRequest{} }
-func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationRequest) ProtoMessage()    {}
-func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{33}
+func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificationRequest{} }
+func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationRequest) ProtoMessage()    {}
+func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{36}
 }
-func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2093,52 +2375,55 @@ func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationRequest.Merge(m, src)
+func (m *ContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationRequest.Merge(m, src)
 }
-func (m *RecordSpecificationRequest) XXX_Size() int {
+func (m *ContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationRequest.DiscardUnknown(m)
+func (m *ContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationRequest) GetSpecificationId() string {
+func (m *ContractSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
 		return m.SpecificationId
 	}
 	return ""
 }
 
-func (m *RecordSpecificationRequest) GetName() string {
+func (m *ContractSpecificationRequest) GetIncludeRecordSpecs() bool {
 	if m != nil {
-		return m.Name
+		return m.IncludeRecordSpecs
 	}
-	return ""
+	return false
 }
 
-// RecordSpecificationResponse is the response type for the Query/RecordSpecification RPC method.
-type RecordSpecificationResponse struct {
-	// record_specification is the wrapped record specification.
-	RecordSpecification *RecordSpecificationWrapper `protobuf:"bytes,1,opt,name=record_specification,json=recordSpecification,proto3" json:"record_specification,omitempty" yaml:"record_specification"`
+// ContractSpecificationResponse is the response type for the Query/ContractSpecification RPC method.
+type ContractSpecificationResponse struct {
+	// contract_specification is the wrapped contract specification.
+	ContractSpecification *ContractSpecificationWrapper `protobuf:"bytes,1,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification,omitempty" yaml:"contract_specification"`
+	// record_specifications is any number or wrapped record specifications associated with this contract_specification
+	// (if requested).
+	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications,omitempty"`
 	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecificationResponse{} }
-func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationResponse) ProtoMessage()    {}
-func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{34}
+func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecificationResponse{} }
+func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationResponse) ProtoMessage()    {}
+func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{37}
 }
-func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2148,52 +2433,65 @@ func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationResponse.Merge(m, src)
+func (m *ContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationResponse.Merge(m, src)
 }
-func (m *RecordSpecificationResponse) XXX_Size() int {
+func (m *ContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationResponse.DiscardUnknown(m)
+func (m *ContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationResponse) GetRecordSpecification() *RecordSpecificationWrapper {
+func (m *ContractSpecificationResponse) GetContractSpecification() *ContractSpecificationWrapper {
 	if m != nil {
-		return m.RecordSpecification
+		return m.ContractSpecification
 	}
 	return nil
 }
 
-func (m *RecordSpecificationResponse) GetRequest() *RecordSpecificationRequest {
+func (m *ContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+	if m != nil {
+		return m.RecordSpecifications
+	}
+	return nil
+}
+
+func (m *ContractSpecificationResponse) GetRequest() *ContractSpecificationRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// RecordSpecificationWrapper contains a single record specification and some extra identifiers for it.
-type RecordSpecificationWrapper struct {
-	// specification is the on-chain record specification message.
-	Specification *RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// record_spec_id_info contains information about the id/address of the record specification.
-	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,2,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
+// ContractSpecificationWrapper contains a single contract specification and some extra identifiers for it.
+type ContractSpecificationWrapper struct {
+	// specification is the on-chain contract specification message.
+	Specification *ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// contract_spec_id_info contains information about the id/address of the contract specification.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,2,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+	// deprecation is the deprecation of the contract specification, if it has been deprecated.
+	Deprecation *SpecificationDeprecation `protobuf:"bytes,3,opt,name=deprecation,proto3" json:"deprecation,omitempty" yaml:"deprecation,omitempty"`
+	// latest_successor_addr is the bech32 address of the last specification in the chain of successors of a deprecated
+	// contract specification, the one clients should move to.  It is empty if the specification is not deprecated or
+	// has no successor.
+	LatestSuccessorAddr string `protobuf:"bytes,4,opt,name=latest_successor_addr,json=latestSuccessorAddr,proto3" json:"latest_successor_addr,omitempty" yaml:"latest_successor_addr,omitempty"`
 }
 
-func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationWrapper{} }
-func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationWrapper) ProtoMessage()    {}
-func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{35}
+func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificationWrapper{} }
+func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationWrapper) ProtoMessage()    {}
+func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{38}
 }
-func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2203,50 +2501,64 @@ func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationWrapper.Merge(m, src)
+func (m *ContractSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationWrapper.Merge(m, src)
 }
-func (m *RecordSpecificationWrapper) XXX_Size() int {
+func (m *ContractSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationWrapper.DiscardUnknown(m)
+func (m *ContractSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationWrapper proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationWrapper proto.InternalMessageInfo
 
-func (m *RecordSpecificationWrapper) GetSpecification() *RecordSpecification {
+func (m *ContractSpecificationWrapper) GetSpecification() *ContractSpecification {
 	if m != nil {
 		return m.Specification
 	}
 	return nil
 }
 
-func (m *RecordSpecificationWrapper) GetRecordSpecIdInfo() *RecordSpecIdInfo {
+func (m *ContractSpecificationWrapper) GetContractSpecIdInfo() *ContractSpecIdInfo {
 	if m != nil {
-		return m.RecordSpecIdInfo
+		return m.ContractSpecIdInfo
 	}
 	return nil
 }
 
-// RecordSpecificationsAllRequest is the request type for the Query/RecordSpecificationsAll RPC method.
-type RecordSpecificationsAllRequest struct {
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *ContractSpecificationWrapper) GetDeprecation() *SpecificationDeprecation {
+	if m != nil {
+		return m.Deprecation
+	}
+	return nil
 }
 
-func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificationsAllRequest{} }
-func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
-func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{36}
+func (m *ContractSpecificationWrapper) GetLatestSuccessorAddr() string {
+	if m != nil {
+		return m.LatestSuccessorAddr
+	}
+	return ""
 }
-func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+
+// ContractSpecificationsAllRequest is the request type for the Query/ContractSpecificationsAll RPC method.
+type ContractSpecificationsAllRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecificationsAllRequest{} }
+func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
+func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{39}
+}
+func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationsAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2256,47 +2568,47 @@ func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsAllRequest.Merge(m, src)
+func (m *ContractSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationsAllRequest.Merge(m, src)
 }
-func (m *RecordSpecificationsAllRequest) XXX_Size() int {
+func (m *ContractSpecificationsAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsAllRequest.DiscardUnknown(m)
+func (m *ContractSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationsAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationsAllRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *ContractSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// RecordSpecificationsAllResponse is the response type for the Query/RecordSpecificationsAll RPC method.
-type RecordSpecificationsAllResponse struct {
-	// record_specifications are the wrapped record specifications.
-	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
+// ContractSpecificationsAllResponse is the response type for the Query/ContractSpecificationsAll RPC method.
+type ContractSpecificationsAllResponse struct {
+	// contract_specifications are the wrapped contract specifications.
+	ContractSpecifications []*ContractSpecificationWrapper `protobuf:"bytes,1,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications,omitempty" yaml:"contract_specifications"`
 	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ContractSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecificationsAllResponse{} }
-func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
-func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{37}
+func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpecificationsAllResponse{} }
+func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
+func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{40}
 }
-func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2306,55 +2618,65 @@ func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsAllResponse.Merge(m, src)
+func (m *ContractSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationsAllResponse.Merge(m, src)
 }
-func (m *RecordSpecificationsAllResponse) XXX_Size() int {
+func (m *ContractSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsAllResponse.DiscardUnknown(m)
+func (m *ContractSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationsAllResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *ContractSpecificationsAllResponse) GetContractSpecifications() []*ContractSpecificationWrapper {
 	if m != nil {
-		return m.RecordSpecifications
+		return m.ContractSpecifications
 	}
 	return nil
 }
 
-func (m *RecordSpecificationsAllResponse) GetRequest() *RecordSpecificationsAllRequest {
+func (m *ContractSpecificationsAllResponse) GetRequest() *ContractSpecificationsAllRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *RecordSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *ContractSpecificationsAllResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSLocatorParamsRequest is the request type for the Query/OSLocatorParams RPC method.
-type OSLocatorParamsRequest struct {
+// RecordSpecificationsForContractSpecificationRequest is the request type for the
+// Query/RecordSpecificationsForContractSpecification RPC method.
+type RecordSpecificationsForContractSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	// It can also be a record specification address, e.g.
+	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
 }
 
-func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{} }
-func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorParamsRequest) ProtoMessage()    {}
-func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{38}
+func (m *RecordSpecificationsForContractSpecificationRequest) Reset() {
+	*m = RecordSpecificationsForContractSpecificationRequest{}
 }
-func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
+func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{41}
+}
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2364,38 +2686,54 @@ func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorParamsRequest.Merge(m, src)
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Merge(m, src)
 }
-func (m *OSLocatorParamsRequest) XXX_Size() int {
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorParamsRequest.DiscardUnknown(m)
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest proto.InternalMessageInfo
 
-// OSLocatorParamsResponse is the response type for the Query/OSLocatorParams RPC method.
-type OSLocatorParamsResponse struct {
-	// params defines the parameters of the module.
-	Params OSLocatorParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *RecordSpecificationsForContractSpecificationRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
+}
+
+// RecordSpecificationsForContractSpecificationResponse is the response type for the
+// Query/RecordSpecificationsForContractSpecification RPC method.
+type RecordSpecificationsForContractSpecificationResponse struct {
+	// record_specifications is any number of wrapped record specifications associated with this contract_specification.
+	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
+	// contract_specification_uuid is the uuid of this contract specification.
+	ContractSpecificationUuid string `protobuf:"bytes,2,opt,name=contract_specification_uuid,json=contractSpecificationUuid,proto3" json:"contract_specification_uuid,omitempty" yaml:"contract_specification_uuid"`
+	// contract_specification_addr is the contract specification address as a bech32 encoded string.
+	ContractSpecificationAddr string `protobuf:"bytes,3,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty" yaml:"contract_specification_addr"`
 	// request is a copy of the request that generated these results.
-	Request *OSLocatorParamsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *RecordSpecificationsForContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse{} }
-func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorParamsResponse) ProtoMessage()    {}
-func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{39}
+func (m *RecordSpecificationsForContractSpecificationResponse) Reset() {
+	*m = RecordSpecificationsForContractSpecificationResponse{}
 }
-func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
+func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{42}
+}
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2405,49 +2743,71 @@ func (m *OSLocatorParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorParamsResponse.Merge(m, src)
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Merge(m, src)
 }
-func (m *OSLocatorParamsResponse) XXX_Size() int {
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorParamsResponse.DiscardUnknown(m)
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *OSLocatorParamsResponse) GetParams() OSLocatorParams {
+func (m *RecordSpecificationsForContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
 	if m != nil {
-		return m.Params
+		return m.RecordSpecifications
 	}
-	return OSLocatorParams{}
+	return nil
 }
 
-func (m *OSLocatorParamsResponse) GetRequest() *OSLocatorParamsRequest {
+func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationUuid() string {
+	if m != nil {
+		return m.ContractSpecificationUuid
+	}
+	return ""
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
+	}
+	return ""
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) GetRequest() *RecordSpecificationsForContractSpecificationRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// OSLocatorRequest is the request type for the Query/OSLocator RPC method.
-type OSLocatorRequest struct {
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// RecordSpecificationRequest is the request type for the Query/RecordSpecification RPC method.
+type RecordSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	// It can also be a record specification address, e.g.
+	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// name is the name of the record to look up.
+	// It is required if the specification_id is a uuid or contract specification address.
+	// It is ignored if the specification_id is a record specification address.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
-func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorRequest) ProtoMessage()    {}
-func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{40}
+func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationRequest{} }
+func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationRequest) ProtoMessage()    {}
+func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{43}
 }
-func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2457,44 +2817,52 @@ func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorRequest.Merge(m, src)
+func (m *RecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationRequest.Merge(m, src)
 }
-func (m *OSLocatorRequest) XXX_Size() int {
+func (m *RecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorRequest.DiscardUnknown(m)
+func (m *RecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationRequest proto.InternalMessageInfo
 
-func (m *OSLocatorRequest) GetOwner() string {
+func (m *RecordSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
-		return m.Owner
+		return m.SpecificationId
 	}
 	return ""
 }
 
-// OSLocatorResponse is the response type for the Query/OSLocator RPC method.
-type OSLocatorResponse struct {
-	Locator *ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
-	// request is a copy of the request that generated these results.
-	Request *OSLocatorRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-}
+func (m *RecordSpecificationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
-func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorResponse) ProtoMessage()    {}
-func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{41}
+// RecordSpecificationResponse is the response type for the Query/RecordSpecification RPC method.
+type RecordSpecificationResponse struct {
+	// record_specification is the wrapped record specification.
+	RecordSpecification *RecordSpecificationWrapper `protobuf:"bytes,1,opt,name=record_specification,json=recordSpecification,proto3" json:"record_specification,omitempty" yaml:"record_specification"`
+	// request is a copy of the request that generated these results.
+	Request *RecordSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
-func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecificationResponse{} }
+func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationResponse) ProtoMessage()    {}
+func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+}
+func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2504,51 +2872,52 @@ func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorResponse.Merge(m, src)
+func (m *RecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationResponse.Merge(m, src)
 }
-func (m *OSLocatorResponse) XXX_Size() int {
+func (m *RecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorResponse.DiscardUnknown(m)
+func (m *RecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *OSLocatorResponse) GetLocator() *ObjectStoreLocator {
+func (m *RecordSpecificationResponse) GetRecordSpecification() *RecordSpecificationWrapper {
 	if m != nil {
-		return m.Locator
+		return m.RecordSpecification
 	}
 	return nil
 }
 
-func (m *OSLocatorResponse) GetRequest() *OSLocatorRequest {
+func (m *RecordSpecificationResponse) GetRequest() *RecordSpecificationRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// OSLocatorsByURIRequest is the request type for the Query/OSLocatorsByURI RPC method.
-type OSLocatorsByURIRequest struct {
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// RecordSpecificationWrapper contains a single record specification and some extra identifiers for it.
+type RecordSpecificationWrapper struct {
+	// specification is the on-chain record specification message.
+	Specification *RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// record_spec_id_info contains information about the id/address of the record specification.
+	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,2,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
 }
 
-func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{} }
-func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByURIRequest) ProtoMessage()    {}
-func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{42}
+func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationWrapper{} }
+func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationWrapper) ProtoMessage()    {}
+func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{45}
 }
-func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByURIRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2558,53 +2927,100 @@ func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByURIRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByURIRequest.Merge(m, src)
+func (m *RecordSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationWrapper.Merge(m, src)
 }
-func (m *OSLocatorsByURIRequest) XXX_Size() int {
+func (m *RecordSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByURIRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByURIRequest.DiscardUnknown(m)
+func (m *RecordSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByURIRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationWrapper proto.InternalMessageInfo
 
-func (m *OSLocatorsByURIRequest) GetUri() string {
+func (m *RecordSpecificationWrapper) GetSpecification() *RecordSpecification {
 	if m != nil {
-		return m.Uri
+		return m.Specification
 	}
-	return ""
+	return nil
 }
 
-func (m *OSLocatorsByURIRequest) GetPagination() *query.PageRequest {
+func (m *RecordSpecificationWrapper) GetRecordSpecIdInfo() *RecordSpecIdInfo {
+	if m != nil {
+		return m.RecordSpecIdInfo
+	}
+	return nil
+}
+
+// RecordSpecificationsAllRequest is the request type for the Query/RecordSpecificationsAll RPC method.
+type RecordSpecificationsAllRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificationsAllRequest{} }
+func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
+func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{46}
+}
+func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordSpecificationsAllRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsAllRequest.Merge(m, src)
+}
+func (m *RecordSpecificationsAllRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordSpecificationsAllRequest proto.InternalMessageInfo
+
+func (m *RecordSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSLocatorsByURIResponse is the response type for the Query/OSLocatorsByURI RPC method.
-type OSLocatorsByURIResponse struct {
-	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+// RecordSpecificationsAllResponse is the response type for the Query/RecordSpecificationsAll RPC method.
+type RecordSpecificationsAllResponse struct {
+	// record_specifications are the wrapped record specifications.
+	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
 	// request is a copy of the request that generated these results.
-	Request *OSLocatorsByURIRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *RecordSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse{} }
-func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByURIResponse) ProtoMessage()    {}
-func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{43}
+func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecificationsAllResponse{} }
+func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
+func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{47}
 }
-func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByURIResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2614,56 +3030,63 @@ func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByURIResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByURIResponse.Merge(m, src)
+func (m *RecordSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsAllResponse.Merge(m, src)
 }
-func (m *OSLocatorsByURIResponse) XXX_Size() int {
+func (m *RecordSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByURIResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByURIResponse.DiscardUnknown(m)
+func (m *RecordSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByURIResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *OSLocatorsByURIResponse) GetLocators() []ObjectStoreLocator {
+func (m *RecordSpecificationsAllResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
 	if m != nil {
-		return m.Locators
+		return m.RecordSpecifications
 	}
 	return nil
 }
 
-func (m *OSLocatorsByURIResponse) GetRequest() *OSLocatorsByURIRequest {
+func (m *RecordSpecificationsAllResponse) GetRequest() *RecordSpecificationsAllRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *OSLocatorsByURIResponse) GetPagination() *query.PageResponse {
+func (m *RecordSpecificationsAllResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSLocatorsByScopeRequest is the request type for the Query/OSLocatorsByScope RPC method.
-type OSLocatorsByScopeRequest struct {
-	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+// SpecificationBundleRequest is the request type for the Query/SpecificationBundle RPC method.
+type SpecificationBundleRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	// It can also be a record specification address, e.g.
+	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// include_scope_specs is a flag for whether or not the scope specifications that reference this contract
+	// specification should be included in the bundle.
+	IncludeScopeSpecs bool `protobuf:"varint,10,opt,name=include_scope_specs,json=includeScopeSpecs,proto3" json:"include_scope_specs,omitempty" yaml:"include_scope_specs"`
 }
 
-func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeRequest{} }
-func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
-func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+func (m *SpecificationBundleRequest) Reset()         { *m = SpecificationBundleRequest{} }
+func (m *SpecificationBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*SpecificationBundleRequest) ProtoMessage()    {}
+func (*SpecificationBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{48}
 }
-func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
+func (m *SpecificationBundleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpecificationBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByScopeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpecificationBundleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2673,44 +3096,52 @@ func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByScopeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByScopeRequest.Merge(m, src)
+func (m *SpecificationBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecificationBundleRequest.Merge(m, src)
 }
-func (m *OSLocatorsByScopeRequest) XXX_Size() int {
+func (m *SpecificationBundleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByScopeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByScopeRequest.DiscardUnknown(m)
+func (m *SpecificationBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecificationBundleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByScopeRequest proto.InternalMessageInfo
+var xxx_messageInfo_SpecificationBundleRequest proto.InternalMessageInfo
 
-func (m *OSLocatorsByScopeRequest) GetScopeId() string {
+func (m *SpecificationBundleRequest) GetSpecificationId() string {
 	if m != nil {
-		return m.ScopeId
+		return m.SpecificationId
 	}
 	return ""
 }
 
-// OSLocatorsByScopeResponse is the response type for the Query/OSLocatorsByScope RPC method.
-type OSLocatorsByScopeResponse struct {
-	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+func (m *SpecificationBundleRequest) GetIncludeScopeSpecs() bool {
+	if m != nil {
+		return m.IncludeScopeSpecs
+	}
+	return false
+}
+
+// SpecificationBundleResponse is the response type for the Query/SpecificationBundle RPC method.
+type SpecificationBundleResponse struct {
+	// bundle is the contract specification and its associated specifications.
+	Bundle *SpecificationBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 	// request is a copy of the request that generated these results.
-	Request *OSLocatorsByScopeRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *SpecificationBundleRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResponse{} }
-func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
-func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{45}
+func (m *SpecificationBundleResponse) Reset()         { *m = SpecificationBundleResponse{} }
+func (m *SpecificationBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*SpecificationBundleResponse) ProtoMessage()    {}
+func (*SpecificationBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{49}
 }
-func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpecificationBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpecificationBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByScopeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpecificationBundleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2720,50 +3151,48 @@ func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByScopeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByScopeResponse.Merge(m, src)
+func (m *SpecificationBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecificationBundleResponse.Merge(m, src)
 }
-func (m *OSLocatorsByScopeResponse) XXX_Size() int {
+func (m *SpecificationBundleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByScopeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByScopeResponse.DiscardUnknown(m)
+func (m *SpecificationBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecificationBundleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByScopeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpecificationBundleResponse proto.InternalMessageInfo
 
-func (m *OSLocatorsByScopeResponse) GetLocators() []ObjectStoreLocator {
+func (m *SpecificationBundleResponse) GetBundle() *SpecificationBundle {
 	if m != nil {
-		return m.Locators
+		return m.Bundle
 	}
 	return nil
 }
 
-func (m *OSLocatorsByScopeResponse) GetRequest() *OSLocatorsByScopeRequest {
+func (m *SpecificationBundleResponse) GetRequest() *SpecificationBundleRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// OSAllLocatorsRequest is the request type for the Query/OSAllLocators RPC method.
-type OSAllLocatorsRequest struct {
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// OSLocatorParamsRequest is the request type for the Query/OSLocatorParams RPC method.
+type OSLocatorParamsRequest struct {
 }
 
-func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
-func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*OSAllLocatorsRequest) ProtoMessage()    {}
-func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{46}
+func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{} }
+func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorParamsRequest) ProtoMessage()    {}
+func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{50}
 }
-func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSAllLocatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2773,46 +3202,90 @@ func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *OSAllLocatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSAllLocatorsRequest.Merge(m, src)
+func (m *OSLocatorParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorParamsRequest.Merge(m, src)
 }
-func (m *OSAllLocatorsRequest) XXX_Size() int {
+func (m *OSLocatorParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSAllLocatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSAllLocatorsRequest.DiscardUnknown(m)
+func (m *OSLocatorParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSAllLocatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorParamsRequest proto.InternalMessageInfo
 
-func (m *OSAllLocatorsRequest) GetPagination() *query.PageRequest {
+// OSLocatorParamsResponse is the response type for the Query/OSLocatorParams RPC method.
+type OSLocatorParamsResponse struct {
+	// params defines the parameters of the module.
+	Params OSLocatorParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorParamsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse{} }
+func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorParamsResponse) ProtoMessage()    {}
+func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{51}
+}
+func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorParamsResponse.Merge(m, src)
+}
+func (m *OSLocatorParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorParamsResponse proto.InternalMessageInfo
+
+func (m *OSLocatorParamsResponse) GetParams() OSLocatorParams {
 	if m != nil {
-		return m.Pagination
+		return m.Params
+	}
+	return OSLocatorParams{}
+}
+
+func (m *OSLocatorParamsResponse) GetRequest() *OSLocatorParamsRequest {
+	if m != nil {
+		return m.Request
 	}
 	return nil
 }
 
-// OSAllLocatorsResponse is the response type for the Query/OSAllLocators RPC method.
-type OSAllLocatorsResponse struct {
-	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
-	// request is a copy of the request that generated these results.
-	Request *OSAllLocatorsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// OSLocatorRequest is the request type for the Query/OSLocator RPC method.
+type OSLocatorRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
-func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*OSAllLocatorsResponse) ProtoMessage()    {}
-func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{47}
+func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
+func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorRequest) ProtoMessage()    {}
+func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{52}
 }
-func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSAllLocatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2822,2950 +3295,2535 @@ func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *OSAllLocatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSAllLocatorsResponse.Merge(m, src)
+func (m *OSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorRequest.Merge(m, src)
 }
-func (m *OSAllLocatorsResponse) XXX_Size() int {
+func (m *OSLocatorRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSAllLocatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSAllLocatorsResponse.DiscardUnknown(m)
+func (m *OSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSAllLocatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorRequest proto.InternalMessageInfo
 
-func (m *OSAllLocatorsResponse) GetLocators() []ObjectStoreLocator {
+func (m *OSLocatorRequest) GetOwner() string {
 	if m != nil {
-		return m.Locators
+		return m.Owner
 	}
-	return nil
+	return ""
 }
 
-func (m *OSAllLocatorsResponse) GetRequest() *OSAllLocatorsRequest {
+// OSLocatorResponse is the response type for the Query/OSLocator RPC method.
+type OSLocatorResponse struct {
+	Locator *ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
+func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorResponse) ProtoMessage()    {}
+func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{53}
+}
+func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorResponse.Merge(m, src)
+}
+func (m *OSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorResponse proto.InternalMessageInfo
+
+func (m *OSLocatorResponse) GetLocator() *ObjectStoreLocator {
 	if m != nil {
-		return m.Request
+		return m.Locator
 	}
 	return nil
 }
 
-func (m *OSAllLocatorsResponse) GetPagination() *query.PageResponse {
+func (m *OSLocatorResponse) GetRequest() *OSLocatorRequest {
 	if m != nil {
-		return m.Pagination
+		return m.Request
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
-	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
-	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
-	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
-	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
-	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
-	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
-	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
-	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
-	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
-	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
-	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
-	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
-	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
-	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
-	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
-	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
-	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
-	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
-	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
-	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
-	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
-	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
-	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
-	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
-	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
-	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
-	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
-	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
-	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
-	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
-	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
-	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
-	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
-	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
-	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
-	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
-	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
-	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
-	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
-	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
-	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
-	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
-	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
+// OSLocatorByNameRequest is the request type for the Query/OSLocatorByName RPC method.
+type OSLocatorByNameRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
+func (m *OSLocatorByNameRequest) Reset()         { *m = OSLocatorByNameRequest{} }
+func (m *OSLocatorByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorByNameRequest) ProtoMessage()    {}
+func (*OSLocatorByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{54}
+}
+func (m *OSLocatorByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorByNameRequest.Merge(m, src)
+}
+func (m *OSLocatorByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorByNameRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_a68790bc0b96eeb9 = []byte{
-	// 2732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
-	0x19, 0xce, 0x99, 0x4d, 0xe2, 0xe4, 0x77, 0x1c, 0x3b, 0xbf, 0x2f, 0x59, 0x4f, 0x92, 0x5d, 0x77,
-	0x9a, 0x38, 0x8e, 0x93, 0xec, 0xd6, 0x97, 0xe6, 0xa6, 0x94, 0x10, 0xa7, 0x49, 0x70, 0x13, 0x9a,
-	0x64, 0xac, 0x16, 0xc9, 0x5c, 0xac, 0xf1, 0xee, 0xc4, 0xd9, 0xb2, 0xde, 0xd9, 0xce, 0xac, 0x93,
-	0x5a, 0x96, 0x85, 0x54, 0x01, 0x12, 0x22, 0xaa, 0x5a, 0x15, 0x2a, 0x2e, 0x42, 0x48, 0x48, 0x15,
-	0xa2, 0xe2, 0x05, 0x24, 0x54, 0x55, 0xbc, 0x20, 0x10, 0x28, 0x42, 0x42, 0x44, 0x82, 0x07, 0x78,
-	0x59, 0xa1, 0x84, 0x87, 0xbe, 0xc0, 0xc3, 0x0a, 0x55, 0x82, 0x27, 0x34, 0x67, 0xce, 0x99, 0x3d,
-	0x73, 0xdb, 0x9d, 0xd9, 0x78, 0x03, 0x6f, 0xde, 0x99, 0xff, 0x7e, 0xbe, 0xf3, 0x9d, 0x39, 0xff,
-	0x39, 0x06, 0xa5, 0x6a, 0x1a, 0x77, 0xf5, 0x8a, 0x56, 0x29, 0xe8, 0xf9, 0x55, 0xbd, 0xa6, 0x15,
-	0xb5, 0x9a, 0x96, 0xbf, 0x3b, 0x95, 0x7f, 0x7d, 0x4d, 0x37, 0xd7, 0x73, 0x55, 0xd3, 0xa8, 0x19,
-	0x38, 0xd2, 0x94, 0xc9, 0x71, 0x99, 0xdc, 0xdd, 0x29, 0x79, 0x68, 0xc5, 0x58, 0x31, 0xa8, 0x48,
-	0xde, 0xfe, 0xcb, 0x91, 0x96, 0x27, 0x0b, 0x86, 0xb5, 0x6a, 0x58, 0xf9, 0x65, 0xcd, 0xd2, 0x1d,
-	0x33, 0xf9, 0xbb, 0x53, 0xcb, 0x7a, 0x4d, 0x9b, 0xca, 0x57, 0xb5, 0x95, 0x52, 0x45, 0xab, 0x95,
-	0x8c, 0x0a, 0x93, 0x3d, 0xb8, 0x62, 0x18, 0x2b, 0x65, 0x3d, 0xaf, 0x55, 0x4b, 0x79, 0xad, 0x52,
-	0x31, 0x6a, 0xf4, 0xa5, 0xc5, 0xde, 0x1e, 0x89, 0x88, 0xcd, 0x8d, 0xc1, 0x11, 0x8b, 0x4a, 0xc1,
-	0x2a, 0x18, 0x55, 0x9d, 0x07, 0x15, 0x25, 0x53, 0xd5, 0x0b, 0xa5, 0xdb, 0xa5, 0x82, 0x18, 0xd4,
-	0x44, 0x84, 0xac, 0xb1, 0xfc, 0x9a, 0x5e, 0xa8, 0x59, 0x35, 0xc3, 0x64, 0x56, 0x95, 0x21, 0xc0,
-	0x5b, 0x76, 0x82, 0x37, 0x35, 0x53, 0x5b, 0xb5, 0x54, 0xfd, 0xf5, 0x35, 0xdd, 0xaa, 0x29, 0xdf,
-	0x25, 0x30, 0xe8, 0x79, 0x6c, 0x55, 0x8d, 0x8a, 0xa5, 0xe3, 0x79, 0xd8, 0x59, 0xa5, 0x4f, 0xd2,
-	0x64, 0x8c, 0x4c, 0xf4, 0x4e, 0x67, 0x72, 0xe1, 0x75, 0xcd, 0x39, 0x7a, 0x73, 0xdb, 0x1f, 0xd4,
-	0xb3, 0xdb, 0x54, 0xa6, 0x83, 0x2f, 0x42, 0x8f, 0xe9, 0x38, 0x48, 0x2f, 0x53, 0xf5, 0xc9, 0x28,
-	0xf5, 0x60, 0x48, 0x2a, 0x57, 0x55, 0x7e, 0x25, 0xc1, 0x9e, 0x05, 0xbb, 0x2e, 0xec, 0x0d, 0xe6,
-	0x60, 0x17, 0xad, 0xd3, 0x52, 0xa9, 0x48, 0xc3, 0xda, 0x3d, 0x37, 0xd8, 0xa8, 0x67, 0xfb, 0xd7,
-	0xb5, 0xd5, 0xf2, 0x39, 0x85, 0xbf, 0x51, 0xd4, 0x1e, 0xfa, 0xe7, 0x7c, 0x11, 0xcf, 0xc1, 0x1e,
-	0x4b, 0xb7, 0xac, 0x92, 0x51, 0x59, 0xd2, 0x8a, 0x45, 0x33, 0x2d, 0x51, 0x9d, 0xfd, 0x8d, 0x7a,
-	0x76, 0x90, 0xe9, 0x08, 0x6f, 0x15, 0xb5, 0x97, 0xfd, 0xbc, 0x58, 0x2c, 0x9a, 0x78, 0x1a, 0x7a,
-	0x4d, 0xbd, 0x60, 0x98, 0x45, 0x47, 0x35, 0x45, 0x55, 0x47, 0x1a, 0xf5, 0x2c, 0x3a, 0xaa, 0xc2,
-	0x4b, 0x45, 0x05, 0xe7, 0x17, 0x55, 0xbc, 0x02, 0x03, 0xa5, 0x4a, 0xa1, 0xbc, 0x56, 0xd4, 0x97,
-	0x98, 0x3d, 0x2b, 0x0d, 0x63, 0x64, 0x62, 0xd7, 0xdc, 0x81, 0x46, 0x3d, 0xbb, 0xdf, 0xd1, 0xf6,
-	0x4b, 0x28, 0x6a, 0x3f, 0x7b, 0xb4, 0xc0, 0x9e, 0xe0, 0x25, 0xe0, 0x8f, 0x96, 0x1c, 0xeb, 0x56,
-	0xba, 0x97, 0x9a, 0x91, 0x1b, 0xf5, 0xec, 0x88, 0xd7, 0x0c, 0x13, 0x50, 0xd4, 0xbd, 0xec, 0x89,
-	0xca, 0x1e, 0xfc, 0x41, 0x82, 0x3e, 0x56, 0x42, 0x36, 0xb0, 0xe7, 0x60, 0x07, 0x2d, 0x0f, 0x1b,
-	0xd7, 0xc3, 0x51, 0x03, 0x43, 0xb5, 0x3e, 0x67, 0x6a, 0xd5, 0xaa, 0x6e, 0xaa, 0x8e, 0x0a, 0x6a,
-	0xb0, 0xcb, 0x4d, 0x49, 0x1a, 0x4b, 0x4d, 0xf4, 0x4e, 0x8f, 0x47, 0xaa, 0x3b, 0x72, 0xcc, 0xc0,
-	0xdc, 0xa1, 0x46, 0x3d, 0x3b, 0xea, 0xa9, 0xb9, 0x75, 0xc2, 0x58, 0x2d, 0xd5, 0xf4, 0xd5, 0x6a,
-	0x6d, 0x5d, 0x51, 0x5d, 0xb3, 0xf8, 0x45, 0x1b, 0x39, 0x4e, 0xb6, 0x29, 0xea, 0xe1, 0x48, 0x94,
-	0x07, 0x27, 0x45, 0xee, 0xe0, 0x60, 0xa3, 0x9e, 0x4d, 0x8b, 0x23, 0xe3, 0xb1, 0xcf, 0x6d, 0xe2,
-	0xa7, 0xfc, 0xc0, 0x6c, 0x9d, 0x7f, 0x00, 0x92, 0xdf, 0xe7, 0x90, 0x64, 0x7e, 0x71, 0xc6, 0x5b,
-	0xce, 0x43, 0xad, 0xcd, 0xb9, 0x75, 0xec, 0xe3, 0x68, 0x5d, 0x2a, 0x55, 0x6e, 0x1b, 0x14, 0x98,
-	0xbd, 0xd3, 0xcf, 0xb6, 0x54, 0x9e, 0x2f, 0xce, 0x57, 0x6e, 0x1b, 0x73, 0xe9, 0x46, 0x3d, 0x3b,
-	0xe4, 0x45, 0x3c, 0xb5, 0x61, 0xc3, 0xb7, 0x29, 0x86, 0x16, 0xa0, 0xf3, 0xda, 0x26, 0x0d, 0xd7,
-	0x4f, 0x8a, 0xfa, 0x39, 0xda, 0xd2, 0xcf, 0x42, 0x55, 0x2f, 0x30, 0x5f, 0xe2, 0xa8, 0x05, 0x8c,
-	0x29, 0x6a, 0xbf, 0xe5, 0x95, 0x57, 0x16, 0x61, 0x80, 0x9a, 0xb0, 0x2e, 0x96, 0xcb, 0x7c, 0xce,
-	0x5e, 0x01, 0x68, 0x32, 0x69, 0xba, 0x40, 0x03, 0x18, 0xcf, 0x39, 0xb4, 0x9b, 0xb3, 0x69, 0x37,
-	0xe7, 0xb0, 0x37, 0xa3, 0xdd, 0xdc, 0x4d, 0x6d, 0xc5, 0x2d, 0xbb, 0xa0, 0xa9, 0xd4, 0x09, 0xec,
-	0x13, 0x8c, 0x37, 0x69, 0x8a, 0x06, 0x61, 0xd3, 0x54, 0x2a, 0x36, 0x9c, 0x99, 0x0e, 0xce, 0xf9,
-	0xd1, 0x30, 0xd1, 0x52, 0x5d, 0x48, 0xcb, 0x45, 0x04, 0x5e, 0x0d, 0xc9, 0xef, 0x68, 0xdb, 0xfc,
-	0x9c, 0xf0, 0x3d, 0x09, 0xfe, 0x43, 0x82, 0x7e, 0x3e, 0xf9, 0x3b, 0x25, 0xbc, 0x59, 0x00, 0x4e,
-	0x69, 0xa5, 0x22, 0xa3, 0xbb, 0xe1, 0x46, 0x3d, 0xbb, 0xcf, 0x4b, 0x77, 0xb6, 0xce, 0x6e, 0xf6,
-	0x63, 0xbe, 0xd8, 0x39, 0xd5, 0x35, 0x15, 0x2b, 0xda, 0xaa, 0x9e, 0xde, 0x1e, 0xa1, 0x68, 0xbf,
-	0x74, 0x15, 0x5f, 0xd6, 0x56, 0x75, 0x7c, 0x01, 0xfa, 0x5c, 0x06, 0xa4, 0xb3, 0xc7, 0x21, 0x48,
-	0x01, 0xdb, 0x9e, 0xd7, 0x8a, 0xba, 0x87, 0xb3, 0x23, 0x9d, 0x3f, 0x5b, 0x42, 0x8d, 0x0f, 0x25,
-	0x18, 0x68, 0xd6, 0x9b, 0xe1, 0xe9, 0xd5, 0x0e, 0xd8, 0x51, 0xf4, 0x4a, 0x95, 0x45, 0xe6, 0x61,
-	0x33, 0x7e, 0xae, 0x53, 0xe6, 0x7c, 0x7a, 0xd4, 0x78, 0xd1, 0x3f, 0x19, 0x8e, 0xb6, 0x89, 0x30,
-	0xb8, 0x60, 0x7f, 0x28, 0xc1, 0x5e, 0x6f, 0xf8, 0x78, 0x16, 0x7a, 0x58, 0x02, 0xac, 0xa4, 0xd9,
-	0x36, 0x56, 0x55, 0x2e, 0x8f, 0x25, 0xe8, 0x6f, 0x02, 0x56, 0xe4, 0xc9, 0x23, 0x6d, 0x4c, 0x30,
-	0xf6, 0x12, 0x87, 0xc5, 0x6b, 0x47, 0x51, 0xfb, 0x2c, 0x51, 0x14, 0xbf, 0x02, 0xc3, 0x05, 0xa3,
-	0x52, 0x33, 0xb5, 0x42, 0x2d, 0x8c, 0x30, 0x23, 0xbf, 0x5e, 0x2e, 0x31, 0x25, 0x81, 0x33, 0xc7,
-	0x1a, 0xf5, 0xec, 0x41, 0xc7, 0x6b, 0xa8, 0x49, 0x45, 0xc5, 0x42, 0x40, 0x4b, 0xf9, 0x02, 0x20,
-	0xaf, 0x6a, 0x17, 0xb8, 0xf3, 0x63, 0x02, 0x83, 0x1e, 0xf3, 0x0c, 0xed, 0x22, 0x2a, 0x49, 0x87,
-	0xa8, 0x8c, 0xff, 0xa9, 0x17, 0x4c, 0xb0, 0x0b, 0x2c, 0xfa, 0x7b, 0x09, 0xf6, 0xb2, 0x19, 0xce,
-	0xab, 0xe8, 0xa3, 0x37, 0x12, 0x9b, 0xde, 0x44, 0xf6, 0x95, 0x12, 0xb3, 0x6f, 0x2a, 0x26, 0xfb,
-	0x22, 0x6c, 0x6f, 0xb2, 0xa7, 0x4a, 0xff, 0x7e, 0x52, 0x7e, 0x0c, 0xfb, 0x04, 0xed, 0x4d, 0xfe,
-	0x09, 0xaa, 0xfc, 0x51, 0x82, 0x7e, 0xb7, 0x98, 0x5d, 0x66, 0xc8, 0xa7, 0xf0, 0x6d, 0x79, 0xa1,
-	0x33, 0x02, 0x6d, 0x52, 0xe4, 0xa7, 0xfd, 0x58, 0x1f, 0x6f, 0x6d, 0x20, 0xc8, 0x90, 0x3f, 0x96,
-	0xa0, 0xcf, 0x63, 0x1c, 0x4f, 0xc1, 0x4e, 0xc7, 0x7c, 0xbb, 0x8d, 0x96, 0xa3, 0xa6, 0x32, 0x69,
-	0xd4, 0x61, 0x2f, 0x03, 0xae, 0x97, 0x1c, 0x0f, 0xb7, 0xd6, 0x67, 0x2c, 0x35, 0xda, 0xa8, 0x67,
-	0x87, 0x3d, 0xf0, 0x77, 0xe9, 0x69, 0x8f, 0x29, 0x08, 0xe2, 0x3d, 0x18, 0x64, 0x02, 0x21, 0xbc,
-	0x38, 0xd1, 0xda, 0x97, 0xc0, 0x8a, 0x99, 0x46, 0x3d, 0x2b, 0x7b, 0xfc, 0x79, 0x39, 0x71, 0xc0,
-	0xf4, 0x69, 0x28, 0x9f, 0x87, 0x7d, 0xac, 0x88, 0x5d, 0x20, 0xc4, 0xc7, 0x04, 0x50, 0xb4, 0xce,
-	0xb0, 0x2d, 0x00, 0x84, 0x74, 0x04, 0x90, 0x4b, 0x7e, 0x80, 0x1c, 0x6b, 0x03, 0x90, 0xae, 0x72,
-	0x61, 0x0d, 0x06, 0x6e, 0xdc, 0xab, 0xe8, 0xa6, 0x75, 0xa7, 0x54, 0xe5, 0x15, 0x4c, 0x43, 0x8f,
-	0x4d, 0x74, 0xba, 0xe5, 0x6c, 0xec, 0x77, 0xab, 0xfc, 0xe7, 0x96, 0xd5, 0xf6, 0xaf, 0x04, 0xf6,
-	0x09, 0x6e, 0x59, 0x69, 0x4f, 0x83, 0xb3, 0x3d, 0x59, 0x5a, 0x5b, 0x2b, 0xb1, 0xf2, 0x7a, 0x48,
-	0x58, 0x78, 0xa9, 0xa8, 0x40, 0x7f, 0xbd, 0x62, 0xff, 0x48, 0xf0, 0x8d, 0xee, 0xcf, 0xb5, 0x0b,
-	0x15, 0x5d, 0x87, 0xe1, 0x57, 0xb5, 0xf2, 0x9a, 0xfe, 0x3f, 0x28, 0xeb, 0x63, 0x02, 0x23, 0x7e,
-	0xdf, 0x4f, 0x5a, 0xdb, 0xab, 0xfe, 0xda, 0x9e, 0x8c, 0xaa, 0x6d, 0x68, 0xd6, 0x5d, 0x28, 0x70,
-	0x01, 0x46, 0xdd, 0x4d, 0xa8, 0xdb, 0xea, 0x6a, 0xce, 0xfe, 0x01, 0x4f, 0x0b, 0xac, 0xb9, 0x2b,
-	0x12, 0x96, 0x35, 0xbf, 0x84, 0xbd, 0x4d, 0x15, 0x1f, 0xcd, 0x17, 0x95, 0x7f, 0x12, 0x90, 0xc3,
-	0xbc, 0xb0, 0x72, 0xbe, 0x49, 0x60, 0xb0, 0xb9, 0xdd, 0x75, 0xdf, 0x33, 0x7e, 0x9e, 0x6a, 0xbb,
-	0x79, 0x76, 0x35, 0xf8, 0x02, 0x25, 0x90, 0x5f, 0x88, 0x5d, 0x45, 0x45, 0x2b, 0xa0, 0x8a, 0xd7,
-	0xfc, 0x43, 0x93, 0xc0, 0x6f, 0x60, 0xd5, 0x79, 0x44, 0xc2, 0xca, 0xca, 0x57, 0xa0, 0x9b, 0xd0,
-	0x17, 0x96, 0xe8, 0x64, 0x02, 0x87, 0x5e, 0x03, 0x11, 0xcd, 0x07, 0xa9, 0xbb, 0xcd, 0x87, 0x15,
-	0x38, 0x14, 0x8c, 0xac, 0x1b, 0x8b, 0xc7, 0xaf, 0x25, 0xc8, 0x44, 0x79, 0x62, 0x10, 0xfa, 0x1a,
-	0x81, 0xa1, 0x90, 0xa1, 0xe6, 0xcb, 0x4a, 0x07, 0x18, 0xca, 0x36, 0xea, 0xd9, 0x03, 0x91, 0x18,
-	0xb2, 0x14, 0x75, 0x30, 0x08, 0x22, 0x0b, 0x6f, 0xf8, 0x51, 0xf4, 0x7c, 0x7c, 0xcf, 0xdd, 0x5d,
-	0x9b, 0x3e, 0x22, 0x70, 0x50, 0xdc, 0x3d, 0x75, 0x6b, 0xb2, 0xe3, 0x2d, 0x18, 0xf2, 0xb6, 0x02,
-	0x68, 0xe5, 0x78, 0x4b, 0x56, 0x28, 0x6b, 0x98, 0x94, 0xa2, 0xa2, 0xa7, 0x6b, 0xb0, 0x40, 0x1f,
-	0xbe, 0x97, 0x82, 0x43, 0x11, 0xb1, 0xb3, 0xf1, 0x7f, 0x8b, 0xc0, 0x88, 0x67, 0xf7, 0xe7, 0x9f,
-	0x5c, 0xb3, 0x71, 0x76, 0x94, 0x01, 0x10, 0x3c, 0xd3, 0xa8, 0x67, 0x0f, 0x85, 0xec, 0x2d, 0x05,
-	0x2e, 0x19, 0x2e, 0x84, 0x19, 0xc0, 0x77, 0x09, 0x0c, 0x0b, 0x89, 0x09, 0x88, 0x74, 0xbe, 0x84,
-	0xa7, 0xdb, 0x7f, 0xc9, 0x05, 0xa2, 0x99, 0x6c, 0xd4, 0xb3, 0xe3, 0x81, 0x6f, 0xba, 0xa6, 0x69,
-	0xf1, 0x23, 0x7c, 0xc8, 0x0c, 0xda, 0xb1, 0xf0, 0x65, 0x3f, 0x3c, 0x93, 0x95, 0x25, 0xc0, 0x73,
-	0xff, 0x8a, 0x02, 0x15, 0xa7, 0xba, 0x85, 0x70, 0xaa, 0x3b, 0x99, 0xcc, 0xad, 0x8f, 0xed, 0x22,
-	0x9b, 0x07, 0xd2, 0x53, 0x6a, 0x1e, 0xbc, 0x06, 0x63, 0xa1, 0x81, 0x76, 0x83, 0xfc, 0xfe, 0x2c,
-	0xc1, 0x33, 0x2d, 0x9c, 0x31, 0xfc, 0xbf, 0x43, 0x60, 0x7f, 0x38, 0x42, 0x39, 0x05, 0x76, 0x36,
-	0x01, 0x94, 0x46, 0x3d, 0x9b, 0x69, 0x35, 0x01, 0x2c, 0x45, 0x1d, 0x09, 0x9d, 0x01, 0x16, 0xaa,
-	0x7e, 0xb0, 0x9d, 0x49, 0x14, 0x42, 0x77, 0xe9, 0x70, 0x13, 0x66, 0x42, 0x66, 0x9a, 0x75, 0xc5,
-	0x30, 0x9f, 0x06, 0x49, 0x2a, 0xff, 0x4e, 0xc1, 0x6c, 0x32, 0xff, 0x6c, 0xa0, 0xbf, 0x11, 0xc9,
-	0x2b, 0xa4, 0x63, 0x5e, 0x11, 0x26, 0x41, 0xa8, 0xe9, 0x28, 0x36, 0xb9, 0x0d, 0x07, 0xc2, 0x41,
-	0x41, 0x3f, 0x7d, 0x59, 0x07, 0x67, 0xbc, 0x51, 0xcf, 0x2a, 0xad, 0x10, 0x44, 0x85, 0x15, 0x75,
-	0x34, 0x14, 0x45, 0xf6, 0x67, 0x73, 0x0b, 0x3f, 0x42, 0xfb, 0xbc, 0xbd, 0x1f, 0xa7, 0xdf, 0x14,
-	0xee, 0x87, 0xb6, 0x9f, 0x74, 0x3f, 0x60, 0xaf, 0x25, 0x28, 0x66, 0x3b, 0xe8, 0x34, 0x49, 0xf3,
-	0x0d, 0x90, 0x43, 0xf4, 0xb7, 0x7a, 0x19, 0xe6, 0x5d, 0x2e, 0xa9, 0xd9, 0xe5, 0xb2, 0xe9, 0xfa,
-	0x40, 0xa8, 0x6b, 0x06, 0xae, 0xaf, 0x13, 0x18, 0x0a, 0x43, 0x00, 0x63, 0xed, 0x4e, 0xb0, 0x25,
-	0xac, 0xf7, 0x61, 0x96, 0x15, 0x75, 0x30, 0x04, 0x5a, 0x78, 0xdd, 0x3f, 0x12, 0x49, 0x5c, 0x07,
-	0x0a, 0xfe, 0x31, 0x09, 0xad, 0x38, 0x5f, 0xa3, 0x6e, 0x85, 0xaf, 0x51, 0xc7, 0x93, 0xb8, 0xf4,
-	0xad, 0x50, 0x11, 0x4d, 0x1c, 0xa9, 0xeb, 0x4d, 0x9c, 0x3b, 0x90, 0x09, 0xc3, 0x66, 0x17, 0xd6,
-	0xa5, 0x07, 0x12, 0x64, 0x23, 0x5d, 0xfd, 0x1f, 0x92, 0xd5, 0x4d, 0x3f, 0xa4, 0x4e, 0x25, 0x99,
-	0xdc, 0x5d, 0x5d, 0x8b, 0xd2, 0x30, 0x72, 0x63, 0xe1, 0xba, 0x51, 0xd0, 0x6a, 0x86, 0xe9, 0xbd,
-	0x2c, 0xf2, 0x01, 0x81, 0xfd, 0x81, 0x57, 0xac, 0xb8, 0x97, 0x7d, 0x17, 0x46, 0x22, 0xf7, 0x79,
-	0x3e, 0x03, 0xbe, 0x9b, 0x23, 0x9f, 0xf1, 0xd7, 0x25, 0x17, 0xd3, 0x4e, 0x60, 0x9a, 0x4d, 0xc0,
-	0x80, 0x2b, 0xc2, 0xd1, 0x36, 0x04, 0x3b, 0x8c, 0x7b, 0x15, 0x9d, 0x1d, 0x02, 0xa8, 0xce, 0x0f,
-	0xe5, 0x07, 0x04, 0xf6, 0x09, 0xa2, 0x2c, 0xa1, 0x17, 0xa1, 0xa7, 0xec, 0x3c, 0x6a, 0xb7, 0x21,
-	0xbe, 0x41, 0xef, 0xda, 0x2c, 0xd4, 0x0c, 0x53, 0xe7, 0x46, 0xb8, 0x6a, 0x92, 0xf6, 0x95, 0x2f,
-	0xd8, 0x66, 0x26, 0xa6, 0x30, 0x20, 0xd6, 0xdc, 0xfa, 0x2b, 0xea, 0x3c, 0xcf, 0x67, 0x00, 0x52,
-	0x6b, 0x66, 0x89, 0x65, 0x63, 0xff, 0xb9, 0x65, 0xf3, 0xe9, 0x3f, 0xe2, 0x50, 0x73, 0xa7, 0xac,
-	0x32, 0xd7, 0x61, 0x17, 0x4b, 0x8f, 0xcf, 0x9c, 0x04, 0xa5, 0x61, 0xe3, 0xed, 0x5a, 0xe8, 0x64,
-	0xc4, 0x3d, 0x45, 0xe8, 0xc2, 0x0c, 0x78, 0x09, 0xd2, 0xa2, 0xaf, 0x27, 0xb9, 0x83, 0xa4, 0xfc,
-	0x82, 0xc0, 0x68, 0x88, 0xb1, 0xae, 0x94, 0xf2, 0x25, 0x7f, 0x29, 0x9f, 0x8b, 0x53, 0xca, 0xf0,
-	0x9b, 0x2e, 0x5f, 0x82, 0xa1, 0x1b, 0x0b, 0x17, 0xcb, 0x65, 0x2e, 0xb7, 0xd5, 0x84, 0xfd, 0x09,
-	0x81, 0x61, 0x9f, 0x83, 0xae, 0xd4, 0xe4, 0x8a, 0xbf, 0x26, 0x27, 0xa2, 0x6b, 0x12, 0x4c, 0x77,
-	0xeb, 0xc1, 0x35, 0xfd, 0x3b, 0x05, 0x76, 0xd0, 0x5b, 0x6f, 0xf6, 0x7a, 0xb4, 0xd3, 0x21, 0x2f,
-	0x4c, 0x70, 0x3f, 0x4e, 0x3e, 0x1e, 0x4b, 0xd6, 0xf1, 0xac, 0x8c, 0xbf, 0xf9, 0xa7, 0xbf, 0xbf,
-	0x2b, 0x8d, 0x61, 0x26, 0x1f, 0x71, 0x51, 0x90, 0xf1, 0xee, 0x27, 0x04, 0x76, 0x38, 0x87, 0x87,
-	0xb1, 0x6e, 0x44, 0xc9, 0x47, 0xda, 0x48, 0x31, 0xf7, 0x3f, 0x24, 0xd4, 0xff, 0x77, 0x08, 0x4e,
-	0xe4, 0x5b, 0xdd, 0x7c, 0xcc, 0x6f, 0xf0, 0xa9, 0xb3, 0xb9, 0x78, 0x0a, 0x67, 0x23, 0x65, 0x9d,
-	0xa3, 0xbc, 0xfc, 0x86, 0x78, 0x71, 0x6f, 0xd3, 0x31, 0xb1, 0x38, 0x8b, 0xd3, 0x51, 0x7a, 0xce,
-	0x12, 0x9c, 0xdf, 0x10, 0x8e, 0x7a, 0x99, 0x16, 0xde, 0x27, 0xb0, 0xdb, 0xbd, 0xdd, 0x83, 0xb1,
-	0x2f, 0x00, 0xc9, 0xc7, 0x62, 0x48, 0xb2, 0x22, 0x4c, 0xd2, 0x1a, 0x1c, 0x46, 0xa5, 0x65, 0x09,
-	0xac, 0xbc, 0x56, 0x2e, 0xe3, 0xfd, 0x14, 0xec, 0x72, 0xaf, 0x00, 0xc6, 0xbd, 0x81, 0x21, 0x4f,
-	0xb4, 0x17, 0x64, 0xb1, 0xfc, 0x54, 0xa2, 0xc1, 0xbc, 0x2f, 0xe1, 0x89, 0xd8, 0x45, 0xb6, 0x07,
-	0x65, 0x06, 0xa7, 0xe2, 0x0e, 0x20, 0x37, 0x60, 0x2d, 0x5e, 0xc0, 0x17, 0x92, 0x2a, 0x79, 0xbd,
-	0xb6, 0x80, 0x42, 0xf8, 0x90, 0x3a, 0xba, 0x8b, 0x57, 0xf1, 0x72, 0x6c, 0xc7, 0x3e, 0x43, 0xf6,
-	0xce, 0xc4, 0x35, 0x84, 0xdf, 0x22, 0xd0, 0x2b, 0xdc, 0x5b, 0xc0, 0x04, 0x97, 0x1b, 0xa2, 0xe7,
-	0x69, 0xc8, 0x55, 0x0c, 0xe5, 0x04, 0x1d, 0x96, 0x71, 0x3c, 0xdc, 0x66, 0x54, 0x1c, 0x94, 0xbc,
-	0xb5, 0x1d, 0x7a, 0xd8, 0x09, 0x22, 0xc6, 0x3c, 0x83, 0x96, 0x8f, 0xb6, 0x95, 0x63, 0xa1, 0xfc,
-	0x2c, 0x45, 0x63, 0xf9, 0x20, 0x15, 0x0d, 0x91, 0xb0, 0xe2, 0x2f, 0x4e, 0xe3, 0x73, 0x09, 0x8b,
-	0x6e, 0x2d, 0x9e, 0xc1, 0x53, 0x89, 0x07, 0x8a, 0x8e, 0x50, 0xa2, 0x21, 0x0e, 0xc3, 0x96, 0x1b,
-	0xc2, 0x67, 0xf1, 0xda, 0x56, 0x18, 0xe2, 0x71, 0x25, 0x61, 0x2f, 0x31, 0x8c, 0xf3, 0x78, 0xae,
-	0x03, 0x3d, 0xe6, 0x15, 0xdf, 0x26, 0x00, 0xcd, 0x23, 0x65, 0x8c, 0x7f, 0xec, 0x2c, 0x4f, 0xc6,
-	0x11, 0x65, 0xc8, 0x38, 0x4e, 0x81, 0x71, 0x04, 0x9f, 0x6d, 0x8d, 0x0b, 0x07, 0xa3, 0xdf, 0x26,
-	0xb0, 0xdb, 0x3d, 0x31, 0xc4, 0xd8, 0xa7, 0xb6, 0xd1, 0xc4, 0x1a, 0x38, 0xf8, 0x54, 0x66, 0x68,
-	0x3c, 0x27, 0xf1, 0x78, 0x54, 0x3c, 0x06, 0x57, 0xc9, 0x6f, 0xb0, 0xf3, 0xd8, 0x4d, 0xfc, 0x09,
-	0x81, 0xbd, 0xde, 0xe3, 0x4c, 0x4c, 0x76, 0xec, 0x29, 0xe7, 0xe2, 0x8a, 0xb3, 0x30, 0xcf, 0xd0,
-	0x30, 0x5b, 0x4c, 0x8f, 0xbb, 0xb6, 0x5e, 0x58, 0xac, 0x1f, 0x11, 0xc0, 0xe0, 0xc9, 0x0c, 0x26,
-	0x3f, 0x0b, 0x94, 0xa7, 0x93, 0xa8, 0xb0, 0xb8, 0xcf, 0xd3, 0xb8, 0x5b, 0x01, 0x9a, 0xae, 0x5b,
-	0x55, 0xbd, 0x90, 0xdf, 0xf0, 0xb7, 0x80, 0x36, 0xf1, 0x43, 0x02, 0x23, 0xe1, 0xa7, 0x4a, 0xd8,
-	0xd9, 0x29, 0x94, 0x7c, 0x2a, 0xa9, 0x1a, 0xcb, 0x23, 0x47, 0xf3, 0x98, 0xc0, 0xf1, 0xb6, 0x79,
-	0x38, 0xc8, 0xfd, 0x2d, 0x81, 0xe1, 0xd0, 0xde, 0x19, 0x76, 0x74, 0x3e, 0x21, 0x3f, 0x9f, 0x50,
-	0x8b, 0x85, 0x7d, 0x81, 0x86, 0x7d, 0x16, 0x4f, 0x47, 0x85, 0xcd, 0x5b, 0x87, 0x51, 0x23, 0xf0,
-	0x1b, 0x02, 0xa3, 0x91, 0xbd, 0x6c, 0xec, 0xb8, 0xfd, 0x2d, 0x9f, 0xed, 0x40, 0x93, 0xe5, 0x34,
-	0x45, 0x73, 0x3a, 0x8e, 0xc7, 0xe2, 0xe4, 0xe4, 0x8c, 0xc6, 0x7b, 0x12, 0x9c, 0x48, 0xd2, 0xe0,
-	0xc4, 0xad, 0x6c, 0x93, 0xca, 0xd7, 0xb7, 0xc6, 0x18, 0x4b, 0xff, 0x1a, 0x4d, 0xff, 0x32, 0x5e,
-	0xea, 0x70, 0x48, 0x39, 0xc1, 0xda, 0xc5, 0xc1, 0xfb, 0x12, 0x0c, 0x86, 0x44, 0x81, 0x1d, 0x34,
-	0x27, 0xe5, 0x99, 0x44, 0x3a, 0x2c, 0x9b, 0x6f, 0x3a, 0x1f, 0xf7, 0x5f, 0x25, 0xf8, 0x7c, 0x9b,
-	0x05, 0x21, 0x3c, 0x9b, 0xc5, 0x6b, 0x38, 0xff, 0xe4, 0x85, 0xe0, 0x4b, 0xe0, 0x2f, 0x09, 0xec,
-	0x8f, 0xe8, 0x95, 0x61, 0x87, 0xcd, 0x35, 0xf9, 0x74, 0x62, 0x3d, 0x56, 0x9a, 0x3c, 0xad, 0xcc,
-	0x31, 0x3c, 0xda, 0xbe, 0x30, 0x0e, 0xca, 0x7f, 0x44, 0xa0, 0xdf, 0xd7, 0xd1, 0xc2, 0x84, 0xad,
-	0x2f, 0x39, 0x1f, 0x5b, 0x3e, 0x2e, 0x31, 0xb2, 0x5d, 0x34, 0xdf, 0x24, 0xbe, 0x63, 0x2f, 0xe9,
-	0xdc, 0x16, 0xc6, 0xee, 0x64, 0xb5, 0x58, 0xd2, 0xfd, 0x5d, 0xb7, 0xf6, 0x85, 0xe3, 0x21, 0x6d,
-	0xd0, 0xf5, 0x72, 0x13, 0xdf, 0x17, 0x0b, 0xe7, 0x34, 0x86, 0x30, 0x61, 0x07, 0x29, 0x46, 0xe1,
-	0xbc, 0x1d, 0xb0, 0xf6, 0x34, 0xc6, 0xa3, 0x5c, 0x33, 0x4b, 0xf9, 0x8d, 0x35, 0xb3, 0xb4, 0x89,
-	0x3f, 0x17, 0x9b, 0x8c, 0xbc, 0xeb, 0x82, 0x89, 0x1b, 0x34, 0xf2, 0x54, 0x02, 0x8d, 0xb8, 0xdf,
-	0x1f, 0x3c, 0x5a, 0xff, 0xf7, 0x2e, 0x7e, 0x8f, 0x40, 0x9f, 0xa7, 0x2d, 0x82, 0x89, 0xba, 0x27,
-	0xf2, 0xc9, 0x98, 0xd2, 0x71, 0x37, 0x41, 0xbc, 0xab, 0x63, 0x4f, 0x99, 0xb9, 0x2f, 0x3f, 0x78,
-	0x94, 0x21, 0x0f, 0x1f, 0x65, 0xc8, 0xdf, 0x1e, 0x65, 0xc8, 0xdb, 0x8f, 0x33, 0xdb, 0x1e, 0x3e,
-	0xce, 0x6c, 0xfb, 0xcb, 0xe3, 0xcc, 0x36, 0x18, 0x2d, 0x19, 0x11, 0x8e, 0x6f, 0x92, 0xc5, 0xd9,
-	0x95, 0x52, 0xed, 0xce, 0xda, 0x72, 0xae, 0x60, 0xac, 0x0a, 0x6e, 0x4e, 0x96, 0x0c, 0xd1, 0xe9,
-	0x1b, 0x4d, 0xb7, 0xb5, 0xf5, 0xaa, 0x6e, 0x2d, 0xef, 0xa4, 0xff, 0x44, 0x39, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0xdd, 0xa4, 0x6f, 0x83, 0x3a, 0x00, 0x00,
+var xxx_messageInfo_OSLocatorByNameRequest proto.InternalMessageInfo
+
+func (m *OSLocatorByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// OSLocatorByNameResponse is the response type for the Query/OSLocatorByName RPC method.
+type OSLocatorByNameResponse struct {
+	// owner is the address the name resolves to.
+	Owner   string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Locator *ObjectStoreLocator `protobuf:"bytes,2,opt,name=locator,proto3" json:"locator,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorByNameRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *OSLocatorByNameResponse) Reset()         { *m = OSLocatorByNameResponse{} }
+func (m *OSLocatorByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorByNameResponse) ProtoMessage()    {}
+func (*OSLocatorByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{55}
+}
+func (m *OSLocatorByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorByNameResponse.Merge(m, src)
+}
+func (m *OSLocatorByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorByNameResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/metadata module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
-	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
-}
+var xxx_messageInfo_OSLocatorByNameResponse proto.InternalMessageInfo
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorByNameResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
-	out := new(ScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorByNameResponse) GetLocator() *ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
-	out := new(ScopesAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorByNameResponse) GetRequest() *OSLocatorByNameRequest {
+	if m != nil {
+		return m.Request
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
-	out := new(SessionsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// OSLocatorsByURIRequest is the request type for the Query/OSLocatorsByURI RPC method.
+type OSLocatorsByURIRequest struct {
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// healthy_only limits the results to locators most recently reported as healthy by their owners.
+	HealthyOnly bool `protobuf:"varint,2,opt,name=healthy_only,json=healthyOnly,proto3" json:"healthy_only,omitempty" yaml:"healthy_only"`
+	// max_report_age_seconds is the maximum age of the health report of a healthy locator (0 for no maximum).
+	MaxReportAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_report_age_seconds,json=maxReportAgeSeconds,proto3" json:"max_report_age_seconds,omitempty" yaml:"max_report_age_seconds"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
-	out := new(SessionsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{} }
+func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByURIRequest) ProtoMessage()    {}
+func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{56}
 }
-
-func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
-	out := new(RecordsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
-	out := new(RecordsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByURIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OSLocatorsByURIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByURIRequest.Merge(m, src)
+}
+func (m *OSLocatorsByURIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByURIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByURIRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
-	out := new(OwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OSLocatorsByURIRequest proto.InternalMessageInfo
+
+func (m *OSLocatorsByURIRequest) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
-	out := new(ValueOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIRequest) GetHealthyOnly() bool {
+	if m != nil {
+		return m.HealthyOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
-	out := new(ScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIRequest) GetMaxReportAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxReportAgeSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
-	out := new(ScopeSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
-	out := new(ContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+// OSLocatorsByURIResponse is the response type for the Query/OSLocatorsByURI RPC method.
+type OSLocatorsByURIResponse struct {
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorsByURIRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse{} }
+func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByURIResponse) ProtoMessage()    {}
+func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{57}
+}
+func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByURIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OSLocatorsByURIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByURIResponse.Merge(m, src)
+}
+func (m *OSLocatorsByURIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByURIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByURIResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
-	out := new(ContractSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OSLocatorsByURIResponse proto.InternalMessageInfo
+
+func (m *OSLocatorsByURIResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	out := new(RecordSpecificationsForContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIResponse) GetRequest() *OSLocatorsByURIRequest {
+	if m != nil {
+		return m.Request
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
-	out := new(RecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByURIResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
-	out := new(RecordSpecificationsAllResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+// OSLocatorsByScopeRequest is the request type for the Query/OSLocatorsByScope RPC method.
+type OSLocatorsByScopeRequest struct {
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// healthy_only limits the results to locators most recently reported as healthy by their owners.
+	HealthyOnly bool `protobuf:"varint,2,opt,name=healthy_only,json=healthyOnly,proto3" json:"healthy_only,omitempty" yaml:"healthy_only"`
+	// max_report_age_seconds is the maximum age of the health report of a healthy locator (0 for no maximum).
+	MaxReportAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_report_age_seconds,json=maxReportAgeSeconds,proto3" json:"max_report_age_seconds,omitempty" yaml:"max_report_age_seconds"`
+}
+
+func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeRequest{} }
+func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
+func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{58}
+}
+func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OSLocatorsByScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByScopeRequest.Merge(m, src)
+}
+func (m *OSLocatorsByScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByScopeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
-	out := new(OSLocatorParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OSLocatorsByScopeRequest proto.InternalMessageInfo
+
+func (m *OSLocatorsByScopeRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
-	out := new(OSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByScopeRequest) GetHealthyOnly() bool {
+	if m != nil {
+		return m.HealthyOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
-	out := new(OSLocatorsByURIResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OSLocatorsByScopeRequest) GetMaxReportAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxReportAgeSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
-	out := new(OSLocatorsByScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
-	if err != nil {
-		return nil, err
+// OSLocatorsByScopeResponse is the response type for the Query/OSLocatorsByScope RPC method.
+type OSLocatorsByScopeResponse struct {
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorsByScopeRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResponse{} }
+func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
+func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{59}
+}
+func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OSLocatorsByScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByScopeResponse.Merge(m, src)
+}
+func (m *OSLocatorsByScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByScopeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
-	out := new(OSAllLocatorsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OSLocatorsByScopeResponse proto.InternalMessageInfo
+
+func (m *OSLocatorsByScopeResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/metadata module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Scope searches for a scope.
-	//
-	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
-	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
-	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, that scope is returned.
-	// * If only a session_addr is provided, the scope containing that session is returned.
-	// * If only a record_addr is provided, the scope containing that record is returned.
-	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
-	// a bad request is returned.
-	//
-	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
-	// Those parameters are only used to find the scope.
-	//
-	// By default, sessions and records are not included.
-	// Set include_sessions and/or include_records to true to include sessions and/or records.
-	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
-	// ScopesAll retrieves all scopes.
-	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
-	// Sessions searches for sessions.
-	//
-	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
-	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
-	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
-	//
-	// * If only a scope_id is provided, all sessions in that scope are returned.
-	// * If only a session_id is provided, it must be an address, and that single session is returned.
-	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
-	// is returned.
-	// * If only a record_addr is provided, the session containing that record will be returned.
-	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
-	// provided, and the session containing that record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and is provided without a scope_id or record_addr.
-	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
-	// a record_addr).
-	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
-	// scope.
-	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
-	// a record.
-	// * A record_addr and record_name are both provided, but reference different records.
-	//
-	// By default, the scope and records are not included.
-	// Set include_scope and/or include_records to true to include the scope and/or records.
-	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
-	// SessionsAll retrieves all sessions.
-	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
-	// Records searches for records.
-	//
-	// The record_addr, if provided, must be a bech32 record address, e.g.
-	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
-	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
-	// the session_id can either be a uuid or session address, e.g.
-	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
-	// interested in.
-	//
-	// * If only a record_addr is provided, that single record will be returned.
-	// * If only a scope_id is provided, all records in that scope will be returned.
-	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
-	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
-	//
-	// A bad request is returned if:
-	// * The session_id is a uuid and no scope_id is provided.
-	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
-	// * A name is provided, but not a scope_id and/or a session_id.
-	// * A name and record_addr are provided and the name doesn't match the record_addr.
-	//
-	// By default, the scope and sessions are not included.
-	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
-	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
-	// RecordsAll retrieves all records.
-	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
-	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
-	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
-	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
-	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
-	// ScopeSpecification returns a scope specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
-	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
-	// ScopeSpecificationsAll retrieves all scope specifications.
-	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
-	// ContractSpecification returns a contract specification for the given specification id.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is looked up.
-	//
-	// By default, the record specifications for this contract specification are not included.
-	// Set include_record_specs to true to include them in the result.
-	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
-	// ContractSpecificationsAll retrieves all contract specifications.
-	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
-	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
-	//
-	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
-	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
-	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
-	// address, then the contract specification that contains that record specification is used.
-	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
-	// RecordSpecification returns a record specification for the given input.
-	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
-	// RecordSpecificationsAll retrieves all record specifications.
-	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
-	// OSLocatorParams returns all parameters for the object store locator sub module.
-	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
-	// OSLocator returns an ObjectStoreLocator by its owner's address.
-	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
-	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
-	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
-	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
-	// OSAllLocators returns all ObjectStoreLocator entries.
-	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
+func (m *OSLocatorsByScopeResponse) GetRequest() *OSLocatorsByScopeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// OSLocatorsByContractSpecRequest is the request type for the Query/OSLocatorsByContractSpec RPC method.
+type OSLocatorsByContractSpecRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// healthy_only limits the results to locators most recently reported as healthy by their owners.
+	HealthyOnly bool `protobuf:"varint,2,opt,name=healthy_only,json=healthyOnly,proto3" json:"healthy_only,omitempty" yaml:"healthy_only"`
+	// max_report_age_seconds is the maximum age of the health report of a healthy locator (0 for no maximum).
+	MaxReportAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_report_age_seconds,json=maxReportAgeSeconds,proto3" json:"max_report_age_seconds,omitempty" yaml:"max_report_age_seconds"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
-}
-func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
+func (m *OSLocatorsByContractSpecRequest) Reset()         { *m = OSLocatorsByContractSpecRequest{} }
+func (m *OSLocatorsByContractSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByContractSpecRequest) ProtoMessage()    {}
+func (*OSLocatorsByContractSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{60}
 }
-func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
+func (m *OSLocatorsByContractSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
+func (m *OSLocatorsByContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByContractSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+func (m *OSLocatorsByContractSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByContractSpecRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+func (m *OSLocatorsByContractSpecRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+func (m *OSLocatorsByContractSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByContractSpecRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+
+var xxx_messageInfo_OSLocatorsByContractSpecRequest proto.InternalMessageInfo
+
+func (m *OSLocatorsByContractSpecRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+
+func (m *OSLocatorsByContractSpecRequest) GetHealthyOnly() bool {
+	if m != nil {
+		return m.HealthyOnly
+	}
+	return false
 }
-func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+
+func (m *OSLocatorsByContractSpecRequest) GetMaxReportAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxReportAgeSeconds
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+
+// OSLocatorsByContractSpecResponse is the response type for the Query/OSLocatorsByContractSpec RPC method.
+type OSLocatorsByContractSpecResponse struct {
+	// source_hash is the hash of the contract specification source to download from the locators.
+	SourceHash string               `protobuf:"bytes,1,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty" yaml:"source_hash"`
+	Locators   []ObjectStoreLocator `protobuf:"bytes,2,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorsByContractSpecRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
-func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+
+func (m *OSLocatorsByContractSpecResponse) Reset()         { *m = OSLocatorsByContractSpecResponse{} }
+func (m *OSLocatorsByContractSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByContractSpecResponse) ProtoMessage()    {}
+func (*OSLocatorsByContractSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{61}
 }
-func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
+func (m *OSLocatorsByContractSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
+func (m *OSLocatorsByContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByContractSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+func (m *OSLocatorsByContractSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByContractSpecResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+func (m *OSLocatorsByContractSpecResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+func (m *OSLocatorsByContractSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByContractSpecResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+
+var xxx_messageInfo_OSLocatorsByContractSpecResponse proto.InternalMessageInfo
+
+func (m *OSLocatorsByContractSpecResponse) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+
+func (m *OSLocatorsByContractSpecResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+
+func (m *OSLocatorsByContractSpecResponse) GetRequest() *OSLocatorsByContractSpecRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// OSAllLocatorsRequest is the request type for the Query/OSAllLocators RPC method.
+type OSAllLocatorsRequest struct {
+	// healthy_only limits the results to locators most recently reported as healthy by their owners.
+	HealthyOnly bool `protobuf:"varint,1,opt,name=healthy_only,json=healthyOnly,proto3" json:"healthy_only,omitempty" yaml:"healthy_only"`
+	// max_report_age_seconds is the maximum age of the health report of a healthy locator (0 for no maximum).
+	MaxReportAgeSeconds uint64 `protobuf:"varint,2,opt,name=max_report_age_seconds,json=maxReportAgeSeconds,proto3" json:"max_report_age_seconds,omitempty" yaml:"max_report_age_seconds"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
+func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*OSAllLocatorsRequest) ProtoMessage()    {}
+func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{62}
+}
+func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSAllLocatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *OSAllLocatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSAllLocatorsRequest.Merge(m, src)
+}
+func (m *OSAllLocatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSAllLocatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSAllLocatorsRequest.DiscardUnknown(m)
 }
 
-func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Scope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Scope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
+var xxx_messageInfo_OSAllLocatorsRequest proto.InternalMessageInfo
+
+func (m *OSAllLocatorsRequest) GetHealthyOnly() bool {
+	if m != nil {
+		return m.HealthyOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopesAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+func (m *OSAllLocatorsRequest) GetMaxReportAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxReportAgeSeconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *OSAllLocatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sessions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Sessions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SessionsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// OSAllLocatorsResponse is the response type for the Query/OSAllLocators RPC method.
+type OSAllLocatorsResponse struct {
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSAllLocatorsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Records(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Records",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
+func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*OSAllLocatorsResponse) ProtoMessage()    {}
+func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{63}
+}
+func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSAllLocatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *OSAllLocatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSAllLocatorsResponse.Merge(m, src)
+}
+func (m *OSAllLocatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSAllLocatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSAllLocatorsResponse.DiscardUnknown(m)
 }
 
-func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+var xxx_messageInfo_OSAllLocatorsResponse proto.InternalMessageInfo
+
+func (m *OSAllLocatorsResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Ownership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/Ownership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
+func (m *OSAllLocatorsResponse) GetRequest() *OSAllLocatorsRequest {
+	if m != nil {
+		return m.Request
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValueOwnershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValueOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
+func (m *OSAllLocatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// InvariantsRequest is the request type for the Query/Invariants RPC method.
+type InvariantsRequest struct {
 }
 
-func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScopeSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
+func (m *InvariantsRequest) Reset()         { *m = InvariantsRequest{} }
+func (m *InvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvariantsRequest) ProtoMessage()    {}
+func (*InvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{64}
+}
+func (m *InvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *InvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsRequest.Merge(m, src)
+}
+func (m *InvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_InvariantsRequest proto.InternalMessageInfo
+
+// InvariantsResponse is the response type for the Query/Invariants RPC method.
+type InvariantsResponse struct {
+	// invariants contains the result of each metadata module invariant.
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+	// broken is true if any of the invariants are broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *InvariantsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
+func (m *InvariantsResponse) Reset()         { *m = InvariantsResponse{} }
+func (m *InvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvariantsResponse) ProtoMessage()    {}
+func (*InvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{65}
+}
+func (m *InvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *InvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsResponse.Merge(m, src)
+}
+func (m *InvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsResponse.DiscardUnknown(m)
 }
 
-func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsForContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
+var xxx_messageInfo_InvariantsResponse proto.InternalMessageInfo
+
+func (m *InvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+	return nil
+}
+
+func (m *InvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *InvariantsResponse) GetRequest() *InvariantsRequest {
+	if m != nil {
+		return m.Request
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordSpecificationsAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// InvariantResult is the result of checking a single metadata module invariant.
+type InvariantResult struct {
+	// name is the route of the invariant, e.g. session-scope.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// broken is true if the state does not satisfy the invariant.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes each of the entries that break the invariant.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{66}
 }
-
-func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByURIRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSLocatorsByScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OSAllLocatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OSAllLocators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Scope",
-			Handler:    _Query_Scope_Handler,
-		},
-		{
-			MethodName: "ScopesAll",
-			Handler:    _Query_ScopesAll_Handler,
-		},
-		{
-			MethodName: "Sessions",
-			Handler:    _Query_Sessions_Handler,
-		},
-		{
-			MethodName: "SessionsAll",
-			Handler:    _Query_SessionsAll_Handler,
-		},
-		{
-			MethodName: "Records",
-			Handler:    _Query_Records_Handler,
-		},
-		{
-			MethodName: "RecordsAll",
-			Handler:    _Query_RecordsAll_Handler,
-		},
-		{
-			MethodName: "Ownership",
-			Handler:    _Query_Ownership_Handler,
-		},
-		{
-			MethodName: "ValueOwnership",
-			Handler:    _Query_ValueOwnership_Handler,
-		},
-		{
-			MethodName: "ScopeSpecification",
-			Handler:    _Query_ScopeSpecification_Handler,
-		},
-		{
-			MethodName: "ScopeSpecificationsAll",
-			Handler:    _Query_ScopeSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "ContractSpecification",
-			Handler:    _Query_ContractSpecification_Handler,
-		},
-		{
-			MethodName: "ContractSpecificationsAll",
-			Handler:    _Query_ContractSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsForContractSpecification",
-			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecification",
-			Handler:    _Query_RecordSpecification_Handler,
-		},
-		{
-			MethodName: "RecordSpecificationsAll",
-			Handler:    _Query_RecordSpecificationsAll_Handler,
-		},
-		{
-			MethodName: "OSLocatorParams",
-			Handler:    _Query_OSLocatorParams_Handler,
-		},
-		{
-			MethodName: "OSLocator",
-			Handler:    _Query_OSLocator_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByURI",
-			Handler:    _Query_OSLocatorsByURI_Handler,
-		},
-		{
-			MethodName: "OSLocatorsByScope",
-			Handler:    _Query_OSLocatorsByScope_Handler,
-		},
-		{
-			MethodName: "OSAllLocators",
-			Handler:    _Query_OSAllLocators_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/query.proto",
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *InvariantResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
+	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
+	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
+	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
+	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
+	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
+	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
+	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
+	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
+	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
+	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
+	proto.RegisterType((*SessionsByContractSpecRequest)(nil), "provenance.metadata.v1.SessionsByContractSpecRequest")
+	proto.RegisterType((*SessionsByContractSpecResponse)(nil), "provenance.metadata.v1.SessionsByContractSpecResponse")
+	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
+	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
+	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
+	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
+	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
+	proto.RegisterType((*RecordConformanceRequest)(nil), "provenance.metadata.v1.RecordConformanceRequest")
+	proto.RegisterType((*RecordConformanceResponse)(nil), "provenance.metadata.v1.RecordConformanceResponse")
+	proto.RegisterType((*RecordConformance)(nil), "provenance.metadata.v1.RecordConformance")
+	proto.RegisterType((*RecordConsumersRequest)(nil), "provenance.metadata.v1.RecordConsumersRequest")
+	proto.RegisterType((*RecordConsumersResponse)(nil), "provenance.metadata.v1.RecordConsumersResponse")
+	proto.RegisterType((*RecordLineageRequest)(nil), "provenance.metadata.v1.RecordLineageRequest")
+	proto.RegisterType((*RecordLineageResponse)(nil), "provenance.metadata.v1.RecordLineageResponse")
+	proto.RegisterType((*RecordLineageNode)(nil), "provenance.metadata.v1.RecordLineageNode")
+	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
+	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
+	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
+	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
+	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
+	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
+	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
+	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
+	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
+	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
+	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
+	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
+	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
+	proto.RegisterType((*ContractSpecificationsAllResponse)(nil), "provenance.metadata.v1.ContractSpecificationsAllResponse")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationsForContractSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationsForContractSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationRequest)(nil), "provenance.metadata.v1.RecordSpecificationRequest")
+	proto.RegisterType((*RecordSpecificationResponse)(nil), "provenance.metadata.v1.RecordSpecificationResponse")
+	proto.RegisterType((*RecordSpecificationWrapper)(nil), "provenance.metadata.v1.RecordSpecificationWrapper")
+	proto.RegisterType((*RecordSpecificationsAllRequest)(nil), "provenance.metadata.v1.RecordSpecificationsAllRequest")
+	proto.RegisterType((*RecordSpecificationsAllResponse)(nil), "provenance.metadata.v1.RecordSpecificationsAllResponse")
+	proto.RegisterType((*SpecificationBundleRequest)(nil), "provenance.metadata.v1.SpecificationBundleRequest")
+	proto.RegisterType((*SpecificationBundleResponse)(nil), "provenance.metadata.v1.SpecificationBundleResponse")
+	proto.RegisterType((*OSLocatorParamsRequest)(nil), "provenance.metadata.v1.OSLocatorParamsRequest")
+	proto.RegisterType((*OSLocatorParamsResponse)(nil), "provenance.metadata.v1.OSLocatorParamsResponse")
+	proto.RegisterType((*OSLocatorRequest)(nil), "provenance.metadata.v1.OSLocatorRequest")
+	proto.RegisterType((*OSLocatorResponse)(nil), "provenance.metadata.v1.OSLocatorResponse")
+	proto.RegisterType((*OSLocatorByNameRequest)(nil), "provenance.metadata.v1.OSLocatorByNameRequest")
+	proto.RegisterType((*OSLocatorByNameResponse)(nil), "provenance.metadata.v1.OSLocatorByNameResponse")
+	proto.RegisterType((*OSLocatorsByURIRequest)(nil), "provenance.metadata.v1.OSLocatorsByURIRequest")
+	proto.RegisterType((*OSLocatorsByURIResponse)(nil), "provenance.metadata.v1.OSLocatorsByURIResponse")
+	proto.RegisterType((*OSLocatorsByScopeRequest)(nil), "provenance.metadata.v1.OSLocatorsByScopeRequest")
+	proto.RegisterType((*OSLocatorsByScopeResponse)(nil), "provenance.metadata.v1.OSLocatorsByScopeResponse")
+	proto.RegisterType((*OSLocatorsByContractSpecRequest)(nil), "provenance.metadata.v1.OSLocatorsByContractSpecRequest")
+	proto.RegisterType((*OSLocatorsByContractSpecResponse)(nil), "provenance.metadata.v1.OSLocatorsByContractSpecResponse")
+	proto.RegisterType((*OSAllLocatorsRequest)(nil), "provenance.metadata.v1.OSAllLocatorsRequest")
+	proto.RegisterType((*OSAllLocatorsResponse)(nil), "provenance.metadata.v1.OSAllLocatorsResponse")
+	proto.RegisterType((*InvariantsRequest)(nil), "provenance.metadata.v1.InvariantsRequest")
+	proto.RegisterType((*InvariantsResponse)(nil), "provenance.metadata.v1.InvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "provenance.metadata.v1.InvariantResult")
 }
 
-func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/query.proto", fileDescriptor_a68790bc0b96eeb9)
 }
 
-func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_a68790bc0b96eeb9 = []byte{
+	// 3722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x5b, 0x68, 0x1c, 0xd7,
+	0xd9, 0x3e, 0xbb, 0xb2, 0x25, 0x7d, 0xb2, 0x2c, 0xe9, 0xe8, 0xe2, 0xd5, 0xd8, 0xd6, 0x2a, 0x13,
+	0x5b, 0x96, 0x6f, 0xbb, 0x91, 0xec, 0xd8, 0x8e, 0x49, 0xe2, 0x78, 0x9d, 0xd8, 0x51, 0xec, 0xf8,
+	0x32, 0x22, 0x09, 0xe8, 0xcf, 0xff, 0x8b, 0xd1, 0xee, 0x58, 0xda, 0x64, 0x77, 0x67, 0x33, 0x33,
+	0xeb, 0x58, 0xbf, 0x30, 0x85, 0xd0, 0x06, 0x4a, 0x4d, 0x9a, 0x90, 0x36, 0xb4, 0x85, 0x52, 0x68,
+	0x09, 0xa5, 0xa1, 0x90, 0x26, 0xb4, 0x4d, 0x43, 0x1f, 0x5a, 0x1a, 0x5a, 0x42, 0xa0, 0x34, 0xa5,
+	0xa5, 0xb4, 0x2f, 0x4b, 0x88, 0xfb, 0x90, 0x97, 0xf6, 0x61, 0x29, 0x81, 0x96, 0xb6, 0x94, 0x39,
+	0xe7, 0xcc, 0xcc, 0x99, 0xd9, 0x99, 0xdd, 0x99, 0xf1, 0xae, 0x63, 0xfa, 0xb6, 0x33, 0xf3, 0xdd,
+	0xaf, 0x67, 0xce, 0x77, 0x66, 0x41, 0xac, 0x6a, 0xea, 0x55, 0xa5, 0x22, 0x57, 0xf2, 0x4a, 0xb6,
+	0xac, 0x18, 0x72, 0x41, 0x36, 0xe4, 0xec, 0xd5, 0xb9, 0xec, 0x73, 0x35, 0x45, 0x5b, 0xcf, 0x54,
+	0x35, 0xd5, 0x50, 0xf1, 0x84, 0x03, 0x93, 0xb1, 0x60, 0x32, 0x57, 0xe7, 0x84, 0xb1, 0x55, 0x75,
+	0x55, 0x25, 0x20, 0x59, 0xf3, 0x17, 0x85, 0x16, 0xf6, 0xe7, 0x55, 0xbd, 0xac, 0xea, 0xd9, 0x15,
+	0x59, 0x57, 0x28, 0x99, 0xec, 0xd5, 0xb9, 0x15, 0xc5, 0x90, 0xe7, 0xb2, 0x55, 0x79, 0xb5, 0x58,
+	0x91, 0x8d, 0xa2, 0x5a, 0x61, 0xb0, 0x3b, 0x57, 0x55, 0x75, 0xb5, 0xa4, 0x64, 0xe5, 0x6a, 0x31,
+	0x2b, 0x57, 0x2a, 0xaa, 0x41, 0x1e, 0xea, 0xec, 0xe9, 0x9e, 0x00, 0xd9, 0x6c, 0x19, 0x28, 0x58,
+	0x90, 0x0a, 0x7a, 0x5e, 0xad, 0x2a, 0x96, 0x50, 0x41, 0x30, 0x55, 0x25, 0x5f, 0xbc, 0x52, 0xcc,
+	0xf3, 0x42, 0xcd, 0x06, 0xc0, 0xaa, 0x2b, 0xcf, 0x28, 0x79, 0x43, 0x37, 0x54, 0x8d, 0x51, 0x15,
+	0xc7, 0x00, 0x5f, 0x36, 0x15, 0xbc, 0x24, 0x6b, 0x72, 0x59, 0x97, 0x94, 0xe7, 0x6a, 0x8a, 0x6e,
+	0x88, 0x5f, 0x47, 0x30, 0xea, 0xba, 0xad, 0x57, 0xd5, 0x8a, 0xae, 0xe0, 0xfb, 0x61, 0x4b, 0x95,
+	0xdc, 0x49, 0xa1, 0x69, 0x34, 0x3b, 0x30, 0x3f, 0x95, 0xf1, 0xb7, 0x6b, 0x86, 0xe2, 0xe5, 0x7a,
+	0xde, 0xaf, 0xa7, 0x37, 0x49, 0x0c, 0x07, 0x3f, 0x0c, 0xbd, 0x1a, 0x65, 0x90, 0x5a, 0x21, 0xe8,
+	0xfb, 0x83, 0xd0, 0x9b, 0x45, 0x92, 0x2c, 0x54, 0xf1, 0xd3, 0x04, 0x6c, 0x5d, 0x34, 0xed, 0xc2,
+	0x9e, 0xe0, 0x0c, 0xf4, 0x11, 0x3b, 0x2d, 0x17, 0x0b, 0x44, 0xac, 0xfe, 0xdc, 0x68, 0xa3, 0x9e,
+	0x1e, 0x5a, 0x97, 0xcb, 0xa5, 0x13, 0xa2, 0xf5, 0x44, 0x94, 0x7a, 0xc9, 0xcf, 0x85, 0x02, 0x3e,
+	0x01, 0x5b, 0x75, 0x45, 0xd7, 0x8b, 0x6a, 0x65, 0x59, 0x2e, 0x14, 0xb4, 0x54, 0x82, 0xe0, 0x6c,
+	0x6f, 0xd4, 0xd3, 0xa3, 0x0c, 0x87, 0x7b, 0x2a, 0x4a, 0x03, 0xec, 0xf2, 0x54, 0xa1, 0xa0, 0xe1,
+	0x63, 0x30, 0xa0, 0x29, 0x79, 0x55, 0x2b, 0x50, 0xd4, 0x24, 0x41, 0x9d, 0x68, 0xd4, 0xd3, 0x98,
+	0xa2, 0x72, 0x0f, 0x45, 0x09, 0xe8, 0x15, 0x41, 0x3c, 0x03, 0xc3, 0xc5, 0x4a, 0xbe, 0x54, 0x2b,
+	0x28, 0xcb, 0x8c, 0x9e, 0x9e, 0x82, 0x69, 0x34, 0xdb, 0x97, 0xdb, 0xd1, 0xa8, 0xa7, 0xb7, 0x53,
+	0x6c, 0x2f, 0x84, 0x28, 0x0d, 0xb1, 0x5b, 0x8b, 0xec, 0x0e, 0x3e, 0x0d, 0xd6, 0xad, 0x65, 0x4a,
+	0x5d, 0x4f, 0x0d, 0x10, 0x32, 0x42, 0xa3, 0x9e, 0x9e, 0x70, 0x93, 0x61, 0x00, 0xa2, 0xb4, 0x8d,
+	0xdd, 0x91, 0xe8, 0x0d, 0x7c, 0x04, 0xe0, 0x4a, 0x51, 0x29, 0x15, 0x96, 0xcb, 0xb2, 0xfe, 0x6c,
+	0x6a, 0xeb, 0x74, 0x72, 0xb6, 0x3f, 0x37, 0xde, 0xa8, 0xa7, 0x47, 0x28, 0xbe, 0xf3, 0x4c, 0x94,
+	0xfa, 0xc9, 0xc5, 0xe3, 0xe6, 0xef, 0x5f, 0x27, 0x60, 0x90, 0x19, 0x9e, 0x85, 0xc3, 0x09, 0xd8,
+	0x4c, 0x8c, 0xca, 0xa2, 0x61, 0x77, 0x90, 0x3b, 0x09, 0xd6, 0x53, 0x9a, 0x5c, 0xad, 0x2a, 0x9a,
+	0x44, 0x51, 0xb0, 0x0c, 0x7d, 0xb6, 0x21, 0x12, 0xd3, 0xc9, 0xd9, 0x81, 0xf9, 0x99, 0x40, 0x74,
+	0x0a, 0xc7, 0x08, 0xe4, 0x76, 0x35, 0xea, 0xe9, 0x49, 0x97, 0xa7, 0xf4, 0x83, 0x6a, 0xb9, 0x68,
+	0x28, 0xe5, 0xaa, 0xb1, 0x2e, 0x4a, 0x36, 0x59, 0xfc, 0xbf, 0x66, 0xbc, 0x51, 0x1b, 0x25, 0x09,
+	0x87, 0x3d, 0x41, 0x1c, 0xa8, 0x61, 0x2c, 0x06, 0x3b, 0x1b, 0xf5, 0x74, 0x8a, 0xf7, 0xa7, 0x8b,
+	0xbe, 0x45, 0x13, 0x3f, 0xe8, 0x0d, 0xe7, 0xd6, 0xfa, 0x37, 0x05, 0xf2, 0x9b, 0x49, 0x16, 0xc8,
+	0x8c, 0x2f, 0x3e, 0xec, 0x36, 0xe7, 0xae, 0xd6, 0xe4, 0x6c, 0x3b, 0x0e, 0x5a, 0x31, 0xbe, 0x5c,
+	0xac, 0x5c, 0x51, 0x49, 0x38, 0x0f, 0xcc, 0xdf, 0xdd, 0x12, 0x79, 0xa1, 0xb0, 0x50, 0xb9, 0xa2,
+	0xe6, 0x52, 0x8d, 0x7a, 0x7a, 0xcc, 0x9d, 0x27, 0x84, 0x86, 0x19, 0xf4, 0x0e, 0x18, 0xd6, 0x01,
+	0xd3, 0xc7, 0x7a, 0x55, 0xc9, 0xdb, 0x7c, 0x92, 0x84, 0xcf, 0xde, 0x96, 0x7c, 0x16, 0xab, 0x4a,
+	0x9e, 0xf1, 0xe2, 0xbd, 0xd6, 0x44, 0x4c, 0x94, 0x86, 0x74, 0x37, 0xbc, 0x19, 0xa3, 0x7a, 0xf1,
+	0xff, 0x95, 0xe5, 0x95, 0x75, 0x43, 0xd1, 0x53, 0x3d, 0xd3, 0x68, 0xb6, 0x87, 0x8f, 0x51, 0xe7,
+	0x99, 0x28, 0xf5, 0x9b, 0x17, 0x39, 0xf3, 0x37, 0x7e, 0x1a, 0x7a, 0x65, 0x2d, 0xbf, 0x56, 0xbc,
+	0xaa, 0xa4, 0x36, 0x87, 0xf0, 0xc9, 0x29, 0x0a, 0xcb, 0x7b, 0x9c, 0xa1, 0xbb, 0x3c, 0xce, 0xee,
+	0x89, 0x4b, 0x30, 0x4c, 0xd0, 0xf4, 0x53, 0xa5, 0x92, 0x55, 0x7d, 0xce, 0x00, 0x38, 0x3d, 0x21,
+	0x95, 0x27, 0x4c, 0x67, 0x32, 0xb4, 0x81, 0x64, 0xcc, 0x06, 0x92, 0xa1, 0x7d, 0x88, 0x35, 0x90,
+	0xcc, 0x25, 0x79, 0xd5, 0x0e, 0x05, 0x0e, 0x53, 0xac, 0x23, 0x18, 0xe1, 0x88, 0x3b, 0x05, 0x97,
+	0x18, 0xc6, 0x2c, 0xb8, 0xc9, 0xd0, 0x29, 0xc6, 0x70, 0x70, 0xce, 0x1b, 0xa1, 0xb3, 0x2d, 0xd1,
+	0x39, 0xb5, 0xec, 0x28, 0xc5, 0x67, 0x7d, 0xf4, 0xdb, 0xdb, 0x56, 0x3f, 0x2a, 0xbe, 0x4b, 0xc1,
+	0xef, 0x24, 0x61, 0xc8, 0x2a, 0x63, 0x71, 0x4b, 0xb7, 0x19, 0x14, 0xac, 0x38, 0x17, 0x0b, 0xac,
+	0x70, 0xf3, 0x41, 0x61, 0x3f, 0x33, 0x83, 0x82, 0x5e, 0x2c, 0x14, 0xe2, 0x17, 0x6d, 0x07, 0xb1,
+	0x22, 0x97, 0x95, 0x54, 0x4f, 0x00, 0xa2, 0xf9, 0xd0, 0x46, 0xbc, 0x20, 0x97, 0x15, 0xfc, 0x00,
+	0x0c, 0xda, 0xb5, 0x9c, 0x64, 0x34, 0x2d, 0xf5, 0x5c, 0xbe, 0xb9, 0x1e, 0x8b, 0xd2, 0x56, 0xab,
+	0xce, 0x9b, 0x97, 0x9f, 0x65, 0x91, 0xff, 0x30, 0x01, 0xc3, 0x8e, 0x97, 0x58, 0x14, 0x3e, 0x19,
+	0xa3, 0xce, 0xf3, 0xb2, 0x12, 0x64, 0x3e, 0xa3, 0x58, 0xed, 0xca, 0xc5, 0xed, 0x01, 0xb7, 0xaf,
+	0xc8, 0x9f, 0xf2, 0xa6, 0xd0, 0xde, 0x36, 0x12, 0x36, 0x2f, 0x58, 0xde, 0x49, 0xc0, 0x36, 0xb7,
+	0xf8, 0xf8, 0x3e, 0xe8, 0x65, 0x0a, 0x30, 0x93, 0xa6, 0xdb, 0x50, 0x95, 0x2c, 0x78, 0x5c, 0x84,
+	0x21, 0x27, 0xcc, 0xf9, 0x8a, 0xbf, 0xa7, 0x0d, 0x09, 0x56, 0x87, 0x79, 0xb7, 0xb8, 0xe9, 0x88,
+	0xd2, 0xa0, 0xce, 0x83, 0xe2, 0xcf, 0xc1, 0x78, 0x5e, 0xad, 0x18, 0x9a, 0x9c, 0x37, 0xfc, 0x4a,
+	0x7f, 0xe0, 0xea, 0xed, 0x34, 0x43, 0xe2, 0xaa, 0xff, 0x74, 0xa3, 0x9e, 0xde, 0x49, 0xb9, 0xfa,
+	0x92, 0x14, 0x25, 0x9c, 0x6f, 0xc2, 0x12, 0x9f, 0x06, 0x6c, 0x59, 0xb5, 0x0b, 0x15, 0xf7, 0x13,
+	0x04, 0xa3, 0x2e, 0xf2, 0x2c, 0xda, 0xf9, 0xa8, 0x44, 0x31, 0xa3, 0x32, 0xfc, 0x52, 0xb7, 0x59,
+	0xc1, 0x2e, 0xd4, 0xde, 0x37, 0x11, 0xec, 0xb2, 0x18, 0xe5, 0xd6, 0x79, 0xff, 0x38, 0x46, 0x1d,
+	0x76, 0xbd, 0x48, 0x38, 0x15, 0x99, 0x5b, 0x9f, 0x7a, 0x21, 0xcc, 0xb6, 0xcd, 0xdf, 0x5a, 0x28,
+	0x74, 0xcc, 0x39, 0xff, 0x46, 0x30, 0x15, 0x24, 0x71, 0x07, 0xfd, 0x74, 0xd1, 0xeb, 0xa7, 0x7b,
+	0xdb, 0xf9, 0xc9, 0xd7, 0x7c, 0x5d, 0x70, 0xd9, 0x07, 0x09, 0xd8, 0xc6, 0x4a, 0xb9, 0xe5, 0x23,
+	0x4f, 0x1f, 0x43, 0xa1, 0xfb, 0x18, 0xdf, 0x66, 0x13, 0x91, 0xdb, 0x6c, 0x32, 0x64, 0x9b, 0xc5,
+	0xd0, 0xe3, 0xb4, 0x49, 0xa9, 0xa7, 0xd2, 0x81, 0x46, 0xe8, 0xf7, 0xd6, 0x34, 0x10, 0xfd, 0xad,
+	0x49, 0xfc, 0x4d, 0x02, 0x86, 0x6c, 0x63, 0x76, 0xb9, 0xa9, 0xdd, 0x86, 0x17, 0x9b, 0x93, 0xf1,
+	0x7a, 0x9e, 0xd3, 0xd5, 0x1e, 0xf2, 0x86, 0xfd, 0x4c, 0x6b, 0x02, 0xcd, 0x4d, 0xed, 0xbd, 0x04,
+	0x0c, 0xba, 0x88, 0xe3, 0xa3, 0xb0, 0x85, 0x92, 0x6f, 0xb7, 0x37, 0x40, 0xd1, 0x24, 0x06, 0x8d,
+	0x15, 0xd8, 0xc6, 0x02, 0xd7, 0xdd, 0xcf, 0x76, 0xb7, 0xc6, 0x67, 0x8d, 0x65, 0xb2, 0x51, 0x4f,
+	0x8f, 0xbb, 0xc2, 0xdf, 0xee, 0x28, 0x5b, 0x35, 0x0e, 0x10, 0x3f, 0x0f, 0xa3, 0x0c, 0xc0, 0xa7,
+	0x95, 0xcd, 0xb6, 0xe6, 0xc5, 0x35, 0xb2, 0xa9, 0x46, 0x3d, 0x2d, 0xb8, 0xf8, 0xb9, 0xdb, 0xd8,
+	0xb0, 0xe6, 0xc1, 0xc0, 0x02, 0xf4, 0x69, 0x4a, 0x41, 0xce, 0x1b, 0x4a, 0x81, 0xa4, 0x46, 0x9f,
+	0x64, 0x5f, 0x8b, 0xff, 0x03, 0x23, 0xcc, 0xc0, 0x5d, 0xe8, 0x6f, 0x37, 0x11, 0x60, 0x9e, 0x3a,
+	0x8b, 0x7b, 0x2e, 0x78, 0x50, 0xac, 0xe0, 0x39, 0xed, 0x0d, 0x9e, 0x7d, 0x6d, 0x82, 0xa7, 0xab,
+	0xad, 0xed, 0x31, 0x48, 0x51, 0x36, 0xa7, 0xd5, 0xca, 0x15, 0x55, 0x2b, 0x9b, 0x42, 0xc4, 0x7c,
+	0xbd, 0x10, 0xdf, 0x4a, 0xc0, 0xa4, 0x0f, 0x31, 0x66, 0xb8, 0xa6, 0x37, 0x6d, 0xd4, 0xf1, 0x37,
+	0xed, 0x05, 0xc7, 0x37, 0xb4, 0x74, 0xb4, 0x31, 0x2d, 0x27, 0x26, 0xdb, 0x6b, 0xb3, 0xbd, 0x24,
+	0x40, 0x5f, 0x9e, 0x3e, 0xd5, 0x49, 0x90, 0xf7, 0x49, 0xf6, 0x35, 0x7e, 0xcc, 0xeb, 0xc1, 0x7b,
+	0x42, 0xb3, 0x69, 0x2a, 0x04, 0x1f, 0x21, 0x18, 0x69, 0x82, 0x8a, 0xdf, 0xaa, 0xac, 0x26, 0x92,
+	0xe0, 0x9a, 0x88, 0xdf, 0xda, 0x24, 0x19, 0x63, 0x6d, 0xc2, 0x9b, 0xa4, 0xc7, 0x63, 0x12, 0x01,
+	0xfa, 0xaa, 0x9a, 0xba, 0x52, 0x52, 0xca, 0x7a, 0x6a, 0xb3, 0xf9, 0xae, 0x24, 0xd9, 0xd7, 0xe6,
+	0x6e, 0xe8, 0x84, 0xad, 0xa2, 0x5e, 0x2b, 0x2b, 0xda, 0xad, 0xb7, 0xe4, 0x4e, 0x25, 0xf9, 0x5f,
+	0x10, 0x6c, 0x6f, 0x92, 0xad, 0x53, 0x99, 0xfe, 0xa8, 0x37, 0x4e, 0x32, 0x6d, 0xe3, 0xc4, 0x65,
+	0x9e, 0x2e, 0xa4, 0xbb, 0x02, 0x63, 0x94, 0xd7, 0xf9, 0x62, 0x45, 0x71, 0x6c, 0x12, 0xdf, 0x11,
+	0x63, 0xb0, 0xb9, 0xa0, 0x54, 0x8d, 0x35, 0x12, 0x71, 0x83, 0x12, 0xbd, 0x10, 0x6f, 0x24, 0x60,
+	0xdc, 0xc3, 0x87, 0x19, 0x75, 0xc1, 0x6b, 0xd4, 0x36, 0x29, 0xca, 0xf0, 0x2f, 0xa8, 0x85, 0xa6,
+	0x14, 0xed, 0xc4, 0xeb, 0xef, 0x4e, 0xe8, 0x37, 0xb4, 0x5a, 0x25, 0x2f, 0x1b, 0x0a, 0x4d, 0x8a,
+	0x3e, 0xc9, 0xb9, 0x81, 0xcf, 0x78, 0x1d, 0x78, 0x30, 0x94, 0xb0, 0x4d, 0x49, 0xfe, 0x13, 0x3b,
+	0xc9, 0x39, 0x75, 0xf0, 0x03, 0x9e, 0x8e, 0x1f, 0x32, 0xbc, 0xac, 0xc6, 0xef, 0x6b, 0x79, 0x7c,
+	0x01, 0x46, 0x75, 0xb5, 0xa6, 0xe5, 0x95, 0x65, 0xce, 0x65, 0x74, 0x9d, 0xd3, 0xcf, 0x77, 0x5f,
+	0x1f, 0x20, 0x51, 0x1a, 0xa1, 0x77, 0x25, 0xdb, 0xbd, 0xba, 0x68, 0xc0, 0xf0, 0xc5, 0xe7, 0x2b,
+	0x8a, 0xa6, 0xaf, 0x15, 0xab, 0x56, 0xb0, 0xa4, 0xa0, 0xd7, 0x44, 0x50, 0x74, 0x3a, 0xc7, 0xe8,
+	0x97, 0xac, 0xcb, 0x8e, 0xa5, 0xe5, 0x9f, 0x10, 0x8c, 0x70, 0x6c, 0x59, 0xec, 0x1c, 0x03, 0x5a,
+	0xed, 0x97, 0x6b, 0xb5, 0x22, 0x8b, 0x1f, 0x57, 0x90, 0x72, 0x0f, 0x45, 0x09, 0xc8, 0xd5, 0x13,
+	0xe6, 0x45, 0x84, 0x8d, 0x3c, 0xaf, 0xae, 0x5d, 0x48, 0xc1, 0x75, 0x18, 0x7f, 0x52, 0x2e, 0xd5,
+	0x94, 0xcf, 0xc0, 0xac, 0x37, 0x11, 0x4c, 0x78, 0x79, 0xdf, 0xaa, 0x6d, 0xcf, 0x7a, 0x6d, 0x7b,
+	0x28, 0xc8, 0xb6, 0xbe, 0x5a, 0x77, 0xc1, 0xc0, 0x79, 0x98, 0xb4, 0x77, 0xcf, 0xed, 0xfe, 0xd5,
+	0xe1, 0x17, 0x75, 0xf1, 0xaf, 0x08, 0x04, 0x3f, 0x2e, 0xcc, 0x9c, 0x2f, 0x20, 0x18, 0x75, 0xf6,
+	0xe9, 0xed, 0xe7, 0x2c, 0xd3, 0xe7, 0xda, 0xee, 0xfa, 0xdb, 0x18, 0xd6, 0xcb, 0x0d, 0x9f, 0xba,
+	0xcd, 0x74, 0x45, 0x09, 0xeb, 0x4d, 0xa8, 0xf8, 0x9c, 0xd7, 0x35, 0x11, 0xf8, 0x36, 0xd7, 0xb0,
+	0x24, 0x4c, 0x06, 0x8a, 0x87, 0x2f, 0xc1, 0xa0, 0x9f, 0xa2, 0xfb, 0x23, 0x30, 0x74, 0x13, 0x08,
+	0x98, 0x9a, 0x24, 0xba, 0x3b, 0x35, 0x31, 0x60, 0xa0, 0xa0, 0x54, 0x35, 0x85, 0x29, 0x91, 0x6c,
+	0xbd, 0xba, 0x73, 0xc9, 0xff, 0xb0, 0x83, 0xc7, 0x6f, 0xd7, 0x71, 0xe4, 0xf8, 0x97, 0x51, 0x9e,
+	0x0d, 0xfe, 0x3f, 0x18, 0x2f, 0xc9, 0x86, 0xa2, 0x1b, 0xcb, 0x7a, 0x2d, 0x9f, 0x57, 0x74, 0x5d,
+	0xd5, 0x68, 0x1b, 0xa6, 0x3b, 0xe6, 0xfb, 0x1b, 0xf5, 0xf4, 0x0c, 0xa5, 0xe6, 0x0b, 0xc6, 0xd3,
+	0x1d, 0xa5, 0x10, 0x8b, 0x16, 0x80, 0x59, 0xc4, 0xc5, 0x55, 0xd8, 0xd5, 0x6c, 0xef, 0x6e, 0xbc,
+	0x32, 0xfd, 0x22, 0x01, 0x53, 0x41, 0x9c, 0x58, 0x62, 0x7c, 0x01, 0xc1, 0x98, 0x4f, 0x00, 0x5b,
+	0xab, 0x81, 0x18, 0x99, 0x91, 0x6e, 0xd4, 0xd3, 0x3b, 0x02, 0x33, 0x43, 0x17, 0xa5, 0xd1, 0xe6,
+	0xd4, 0x88, 0xb4, 0x73, 0xd5, 0xca, 0x74, 0x5d, 0x28, 0x5f, 0xef, 0x22, 0xd8, 0xc9, 0xef, 0x91,
+	0x75, 0xab, 0x84, 0xe1, 0xcb, 0x30, 0xe6, 0x9e, 0x82, 0x10, 0xcb, 0x59, 0x73, 0x75, 0xce, 0xac,
+	0x7e, 0x50, 0xa2, 0x84, 0x5d, 0x03, 0x93, 0x45, 0x72, 0xf3, 0xb5, 0x24, 0xec, 0x0a, 0x90, 0x9d,
+	0xf9, 0xff, 0x25, 0x04, 0x13, 0xae, 0x2d, 0x6c, 0x6f, 0xc9, 0x38, 0x12, 0x66, 0x5b, 0xbc, 0x29,
+	0x08, 0xee, 0x6a, 0xd4, 0xd3, 0xbb, 0x7c, 0x36, 0xc8, 0xb9, 0x0a, 0x39, 0x9e, 0xf7, 0x23, 0x80,
+	0x5f, 0x45, 0x30, 0xce, 0x29, 0xc6, 0x45, 0x24, 0xdd, 0x1b, 0x9a, 0x6f, 0xbf, 0xb7, 0xd1, 0x24,
+	0x0d, 0x97, 0xb1, 0xbe, 0xa4, 0xf9, 0x8c, 0x1d, 0xd3, 0x9a, 0xe9, 0xe8, 0xf8, 0x82, 0x37, 0x3c,
+	0xa3, 0x99, 0xa5, 0xa9, 0x7a, 0x7f, 0x90, 0x0c, 0x08, 0x2a, 0xab, 0x80, 0x2f, 0xfa, 0x17, 0xf0,
+	0x43, 0xd1, 0xd8, 0x7a, 0x6a, 0x78, 0xe0, 0x04, 0x24, 0x71, 0x7b, 0x26, 0x20, 0xff, 0xa5, 0xf5,
+	0xfc, 0x19, 0x98, 0xf6, 0x35, 0x7f, 0x37, 0x4a, 0xfa, 0xef, 0x13, 0x70, 0x57, 0x0b, 0x66, 0x2c,
+	0xab, 0x5f, 0x41, 0xb0, 0xdd, 0x3f, 0xef, 0xac, 0xc2, 0x1e, 0x2f, 0xad, 0xc5, 0x46, 0x3d, 0x3d,
+	0xd5, 0x2a, 0xad, 0x75, 0x51, 0x9a, 0xf0, 0xcd, 0x6b, 0x1d, 0x4b, 0xde, 0x14, 0x3a, 0x1e, 0x49,
+	0x84, 0xee, 0x16, 0xf9, 0xeb, 0x70, 0xd8, 0xa7, 0x7e, 0xe8, 0x67, 0x54, 0xed, 0x76, 0x94, 0x7e,
+	0xf1, 0xef, 0x49, 0x38, 0x12, 0x8d, 0x3f, 0x73, 0xf4, 0x17, 0x03, 0xab, 0x25, 0x8a, 0x5d, 0x2d,
+	0xb9, 0xec, 0xf2, 0x25, 0x1d, 0x54, 0x23, 0xaf, 0xc0, 0x0e, 0xff, 0xa0, 0x20, 0xaf, 0x29, 0x6c,
+	0x52, 0x33, 0xd3, 0xa8, 0xa7, 0xc5, 0x56, 0x11, 0x44, 0x80, 0x45, 0x69, 0xd2, 0x37, 0x8a, 0xcc,
+	0x57, 0x9c, 0x16, 0x7c, 0xb8, 0xf3, 0x10, 0xed, 0xf9, 0xd0, 0xbd, 0x13, 0x7f, 0x3e, 0x64, 0x2b,
+	0x45, 0xf1, 0x06, 0xec, 0xb9, 0x08, 0xc6, 0x6c, 0x17, 0x3a, 0x4e, 0x2b, 0xb8, 0x06, 0x82, 0x0f,
+	0x7e, 0xa7, 0x17, 0x17, 0x3e, 0x1b, 0x91, 0xe2, 0xdf, 0x10, 0xec, 0xf0, 0x65, 0xcd, 0x82, 0xeb,
+	0x45, 0x04, 0x63, 0x7e, 0x11, 0xc0, 0x7a, 0x51, 0x9c, 0xd8, 0xe2, 0x56, 0x31, 0x7e, 0x94, 0x45,
+	0x69, 0xd4, 0x27, 0xb4, 0xf0, 0x79, 0xaf, 0x27, 0xa2, 0xb0, 0x6e, 0x32, 0xf8, 0x27, 0x08, 0x84,
+	0x60, 0x11, 0xf1, 0x65, 0xff, 0xce, 0x7b, 0x20, 0x0a, 0x4b, 0x4f, 0xdf, 0x0d, 0x18, 0xd6, 0x24,
+	0xba, 0x3d, 0xac, 0x11, 0xd7, 0x60, 0xca, 0x2f, 0x36, 0xbb, 0xd0, 0x97, 0xde, 0x4f, 0x40, 0x3a,
+	0x90, 0xd5, 0x1d, 0x58, 0xac, 0x2e, 0x79, 0x43, 0xea, 0x68, 0x94, 0xe4, 0xee, 0x6a, 0x2f, 0xfa,
+	0xa1, 0xb9, 0x95, 0xc1, 0xb3, 0xcb, 0xd5, 0x2a, 0x85, 0x92, 0xd2, 0xe9, 0x8a, 0x70, 0x01, 0x46,
+	0x5d, 0xc3, 0x6a, 0xd7, 0xdb, 0x06, 0x17, 0x6a, 0x3e, 0x40, 0xa2, 0x34, 0xc2, 0xcf, 0xb5, 0xe9,
+	0xbb, 0xc6, 0x0f, 0x10, 0xec, 0xf0, 0x15, 0x9b, 0x79, 0xff, 0x34, 0x6c, 0x59, 0x21, 0x77, 0xda,
+	0x25, 0x94, 0x1f, 0x11, 0x86, 0x1a, 0xa1, 0x12, 0x04, 0x5b, 0xd0, 0xa9, 0x04, 0x29, 0x98, 0xb8,
+	0xb8, 0x78, 0x5e, 0xcd, 0xcb, 0x86, 0xaa, 0xb9, 0x4f, 0x8c, 0xbf, 0x81, 0x60, 0x7b, 0xd3, 0x23,
+	0xa6, 0xc8, 0x23, 0x9e, 0x53, 0xe3, 0x81, 0xbb, 0x1f, 0x1e, 0x02, 0x9e, 0xe3, 0xe3, 0xe1, 0xa7,
+	0x11, 0xfe, 0x32, 0x3a, 0x6a, 0xcc, 0xc2, 0xb0, 0x0d, 0x62, 0x45, 0xc9, 0x18, 0x6c, 0x56, 0xcd,
+	0xad, 0x3d, 0xb6, 0x75, 0x49, 0x2f, 0xc4, 0x6f, 0x9a, 0xfb, 0xb8, 0x0e, 0x28, 0x53, 0xe8, 0x61,
+	0xe8, 0x2d, 0xd1, 0x5b, 0xed, 0xb6, 0x89, 0x2e, 0x92, 0x03, 0xf7, 0x8b, 0x86, 0xaa, 0x29, 0x16,
+	0x11, 0x0b, 0x35, 0xca, 0xa6, 0xae, 0x47, 0x58, 0x47, 0x93, 0x83, 0x9c, 0x43, 0x72, 0xeb, 0xe6,
+	0xd9, 0x43, 0x4b, 0x1f, 0xab, 0x7f, 0x21, 0xae, 0x7f, 0xfd, 0x8c, 0x77, 0x92, 0x05, 0xce, 0x74,
+	0xf2, 0xd5, 0x9f, 0xd7, 0x34, 0x11, 0x5f, 0xd3, 0x18, 0x9e, 0x73, 0x29, 0xe3, 0xe8, 0xfb, 0x62,
+	0x82, 0x53, 0x58, 0xcf, 0xad, 0x3f, 0x21, 0x2d, 0x58, 0x0a, 0x0f, 0x43, 0xb2, 0xa6, 0x15, 0x99,
+	0xf8, 0xe6, 0x4f, 0xf3, 0xa0, 0xff, 0x9a, 0x22, 0x97, 0x8c, 0xb5, 0xf5, 0x65, 0xb5, 0x52, 0x5a,
+	0x27, 0x1a, 0xf4, 0xf1, 0x07, 0xfd, 0xf9, 0xa7, 0xa2, 0x34, 0xc0, 0x2e, 0x2f, 0x56, 0x4a, 0xeb,
+	0xf8, 0x49, 0x98, 0x28, 0xcb, 0xd7, 0x96, 0x35, 0xa5, 0xaa, 0x6a, 0xc6, 0xb2, 0xbc, 0xaa, 0x2c,
+	0xeb, 0x4a, 0x5e, 0xad, 0x14, 0xe8, 0x30, 0xb5, 0x87, 0x7f, 0x5f, 0xf7, 0x87, 0x13, 0xa5, 0xd1,
+	0xb2, 0x7c, 0x4d, 0x22, 0xf7, 0x4f, 0xad, 0x2a, 0x8b, 0xf4, 0x6e, 0xc7, 0xda, 0xc7, 0x3f, 0x78,
+	0x57, 0x5a, 0x86, 0x60, 0xae, 0x3c, 0x0f, 0x7d, 0xcc, 0xf2, 0x56, 0xa3, 0x88, 0xe0, 0x35, 0x96,
+	0x74, 0x36, 0x85, 0x38, 0xce, 0x73, 0x39, 0xa6, 0x0b, 0x05, 0xbf, 0x8e, 0x20, 0xc5, 0x33, 0xbb,
+	0xd5, 0xcf, 0x41, 0xee, 0xb4, 0x28, 0x11, 0x7f, 0x84, 0x60, 0xd2, 0x47, 0xc1, 0xae, 0xf8, 0x37,
+	0xfc, 0x61, 0x80, 0x20, 0x93, 0x3b, 0xe9, 0xf9, 0x2f, 0x04, 0x69, 0x1e, 0xaa, 0x9b, 0x27, 0x0d,
+	0xef, 0x44, 0xbf, 0xfd, 0x13, 0xc1, 0x74, 0xb0, 0xfe, 0xdc, 0xa4, 0x8a, 0x0e, 0x2f, 0xd7, 0x64,
+	0x7d, 0xad, 0x79, 0x54, 0xcd, 0x3d, 0x34, 0x27, 0x55, 0xe4, 0xea, 0x51, 0x59, 0x5f, 0x73, 0xf9,
+	0x3d, 0x71, 0xcb, 0x7e, 0xbf, 0xec, 0xf5, 0xfb, 0xb1, 0x30, 0x7e, 0x6f, 0x75, 0xf8, 0x51, 0x6c,
+	0x20, 0x18, 0xbb, 0xb8, 0x78, 0xaa, 0x54, 0xb2, 0xe0, 0x2d, 0x9f, 0x7b, 0x7d, 0x85, 0x3a, 0xe2,
+	0xab, 0xc4, 0x1d, 0x51, 0x89, 0x3f, 0x45, 0x30, 0xee, 0x51, 0xba, 0x2b, 0x79, 0x1a, 0x7e, 0x96,
+	0xef, 0xe7, 0x82, 0x2e, 0x54, 0xe1, 0x51, 0x18, 0x59, 0xa8, 0x5c, 0x95, 0xb5, 0xa2, 0x5c, 0x31,
+	0xec, 0x75, 0xe0, 0xcf, 0x11, 0x60, 0xfe, 0x2e, 0x33, 0xc5, 0xe3, 0x00, 0x45, 0xfb, 0x2e, 0x33,
+	0x46, 0xe0, 0x32, 0xd0, 0xc6, 0x97, 0x14, 0xbd, 0x56, 0x32, 0x98, 0x25, 0x38, 0x02, 0x78, 0x02,
+	0xb6, 0xac, 0x68, 0xea, 0xb3, 0x4a, 0x85, 0x66, 0xbd, 0xc4, 0xae, 0x22, 0x1c, 0x4d, 0x6b, 0x92,
+	0xdc, 0x89, 0xe2, 0xa7, 0x60, 0xc8, 0x23, 0x81, 0xdf, 0x62, 0x2a, 0x50, 0x86, 0x14, 0xf4, 0x96,
+	0x15, 0x5d, 0x97, 0x57, 0x15, 0xba, 0xb3, 0x22, 0x59, 0x97, 0xf3, 0xaf, 0x66, 0x60, 0x33, 0xf9,
+	0xb2, 0xd1, 0x7c, 0xb1, 0xdb, 0x42, 0xd7, 0xa6, 0x38, 0xc2, 0x37, 0x90, 0xc2, 0x81, 0x50, 0xb0,
+	0xd4, 0xe4, 0xe2, 0xcc, 0x0b, 0xbf, 0xfb, 0xf3, 0xab, 0x89, 0x69, 0x3c, 0x95, 0x0d, 0xf8, 0x18,
+	0x94, 0x2d, 0xab, 0x3f, 0x45, 0xb0, 0x99, 0x9e, 0xb6, 0x0d, 0xf5, 0xfd, 0x9a, 0xb0, 0xa7, 0x0d,
+	0x14, 0x63, 0xff, 0x2d, 0x44, 0xf8, 0x7f, 0x0d, 0xe1, 0xd9, 0x6c, 0xab, 0xaf, 0x5b, 0xb3, 0x1b,
+	0x56, 0x4f, 0xbe, 0xbe, 0x74, 0x14, 0x1f, 0x09, 0x84, 0xa5, 0x07, 0x5e, 0xb2, 0x1b, 0xfc, 0xc7,
+	0x99, 0xd7, 0x29, 0x89, 0xa5, 0x23, 0x78, 0x3e, 0x08, 0x8f, 0xbe, 0xcb, 0x66, 0x37, 0xb8, 0x73,
+	0x22, 0x0c, 0x0b, 0xdf, 0x40, 0xd0, 0x6f, 0x7f, 0xf7, 0x84, 0x43, 0x7f, 0x1a, 0x25, 0xec, 0x0b,
+	0x01, 0xc9, 0x8c, 0xb0, 0x9f, 0xd8, 0x60, 0x37, 0x16, 0x5b, 0x9a, 0x40, 0xcf, 0xca, 0xa5, 0x12,
+	0xbe, 0x91, 0x84, 0x3e, 0xfb, 0x33, 0xcf, 0xb0, 0x5f, 0x99, 0x08, 0xb3, 0xed, 0x01, 0x99, 0x2c,
+	0xdf, 0x4f, 0x10, 0x61, 0x5e, 0x4f, 0xe0, 0x83, 0xa1, 0x8d, 0x6c, 0x3a, 0xe5, 0x30, 0x9e, 0x0b,
+	0xeb, 0x40, 0x8b, 0x80, 0xbe, 0x74, 0x12, 0x3f, 0x10, 0x15, 0xc9, 0xcd, 0xb5, 0x45, 0x28, 0xf8,
+	0xbb, 0x94, 0xe2, 0x2e, 0x9d, 0xc5, 0x8f, 0x84, 0x66, 0xec, 0x21, 0x64, 0x66, 0xb5, 0x4d, 0x08,
+	0x7f, 0x05, 0xc1, 0x00, 0xf7, 0x6d, 0x06, 0x8e, 0xf0, 0x01, 0x87, 0x70, 0x20, 0x14, 0x2c, 0xf3,
+	0xcb, 0x41, 0xe2, 0x96, 0x19, 0xbc, 0xbb, 0x8d, 0x57, 0x68, 0x94, 0xfc, 0x16, 0xc1, 0x84, 0xff,
+	0xa7, 0x08, 0x38, 0xde, 0xa7, 0x0b, 0xc2, 0xd1, 0xa8, 0x68, 0x4c, 0xee, 0x47, 0x89, 0xdc, 0x39,
+	0xfc, 0x50, 0x90, 0xdc, 0xd6, 0x46, 0xb1, 0x5e, 0x55, 0xf2, 0xd9, 0x0d, 0xef, 0x8a, 0xce, 0x09,
+	0x16, 0xfc, 0x52, 0x0f, 0xf4, 0x5a, 0x5f, 0xad, 0x85, 0x3c, 0x88, 0x2e, 0xec, 0x6d, 0x0b, 0xc7,
+	0xc4, 0x7c, 0x2b, 0x49, 0xe4, 0x7c, 0x23, 0x19, 0x1c, 0xf6, 0x7e, 0x01, 0xb5, 0x34, 0x8f, 0xef,
+	0x89, 0x18, 0x48, 0xfa, 0xd2, 0x71, 0x7c, 0x34, 0x72, 0xf0, 0x91, 0xa8, 0x8b, 0x14, 0xb6, 0x7e,
+	0xf9, 0x62, 0x8b, 0xf0, 0x38, 0x3e, 0xd7, 0x09, 0x42, 0x96, 0x5c, 0x51, 0x2a, 0x32, 0x2f, 0xc6,
+	0xfd, 0xf8, 0x44, 0x0c, 0x3c, 0xc6, 0x15, 0xbf, 0x8c, 0x00, 0x9c, 0xb3, 0xe3, 0x38, 0xfc, 0xf9,
+	0x72, 0x61, 0x7f, 0x18, 0x50, 0x16, 0x19, 0x07, 0x48, 0x60, 0xec, 0xc1, 0x77, 0xb7, 0x8e, 0x0b,
+	0x9a, 0x77, 0x3f, 0xf6, 0x3d, 0xe6, 0x1c, 0xf9, 0xdc, 0xb4, 0x30, 0x17, 0x01, 0x83, 0xc9, 0x79,
+	0x3f, 0x91, 0xb3, 0x95, 0x27, 0xbc, 0x8e, 0xcd, 0x73, 0x22, 0xbe, 0x8d, 0x60, 0xc8, 0x73, 0x3a,
+	0x17, 0x47, 0x3c, 0xc6, 0x2b, 0x64, 0x43, 0xc3, 0x33, 0x91, 0x1f, 0x24, 0x22, 0xb7, 0x48, 0x07,
+	0xdf, 0x1a, 0x9e, 0xb7, 0x05, 0x7c, 0x03, 0xc1, 0xa0, 0xeb, 0xbc, 0x29, 0x8e, 0x74, 0x70, 0x55,
+	0x38, 0x14, 0x12, 0x3a, 0xac, 0x85, 0x7d, 0xc5, 0x2d, 0x31, 0xd1, 0xbe, 0x8a, 0xa0, 0xdf, 0x3e,
+	0x15, 0x88, 0x43, 0x9f, 0xcc, 0x14, 0xf6, 0x85, 0x80, 0x64, 0x02, 0x1e, 0x26, 0x02, 0x1e, 0xc2,
+	0x07, 0x82, 0x04, 0x54, 0x2d, 0x94, 0xec, 0x06, 0x3b, 0x73, 0x79, 0x1d, 0x7f, 0x0f, 0xc1, 0x36,
+	0xf7, 0x91, 0x45, 0x1c, 0xed, 0x68, 0xa3, 0x90, 0x09, 0x0b, 0xce, 0xc4, 0x3c, 0x4e, 0xc4, 0x6c,
+	0x51, 0x39, 0xaf, 0x9a, 0x78, 0x7e, 0xb2, 0xbe, 0x8b, 0x00, 0x37, 0x9f, 0x53, 0xc2, 0xd1, 0xcf,
+	0xfb, 0x09, 0xf3, 0x51, 0x50, 0x22, 0x65, 0x58, 0x40, 0x1f, 0xc3, 0xef, 0x98, 0x2d, 0xd9, 0xf7,
+	0x8c, 0x15, 0x8e, 0x77, 0x26, 0x4b, 0x38, 0x1a, 0x15, 0x8d, 0xe9, 0x91, 0x21, 0x7a, 0xcc, 0xe2,
+	0x99, 0xb6, 0x7a, 0xd0, 0xa2, 0xf6, 0x4b, 0x04, 0xe3, 0xbe, 0x33, 0x57, 0x1c, 0xeb, 0xb4, 0x8e,
+	0x70, 0x6f, 0x44, 0x2c, 0x26, 0xf6, 0x49, 0x22, 0xf6, 0x7d, 0xf8, 0x58, 0xcc, 0x95, 0x04, 0x7e,
+	0x0f, 0xc1, 0x64, 0xe0, 0x19, 0x08, 0x1c, 0xfb, 0xd8, 0x84, 0x70, 0x5f, 0x0c, 0x4c, 0xa6, 0xd3,
+	0x1c, 0xd1, 0xe9, 0x00, 0xde, 0x17, 0x46, 0x27, 0xea, 0x8d, 0xd7, 0x12, 0x70, 0x30, 0xca, 0x60,
+	0x1c, 0x77, 0x72, 0xbc, 0x2e, 0x9c, 0xef, 0x0c, 0x31, 0xa6, 0xfe, 0x39, 0xa2, 0xfe, 0x23, 0xf8,
+	0x74, 0xdc, 0xc5, 0x21, 0xeb, 0xbd, 0xa6, 0x71, 0xf0, 0x8d, 0x04, 0x8c, 0xfa, 0x48, 0x81, 0x63,
+	0x0c, 0xb5, 0x85, 0xc3, 0x91, 0x70, 0x98, 0x36, 0x5f, 0xa2, 0xef, 0xb2, 0x9f, 0x47, 0xf8, 0xde,
+	0x36, 0x6b, 0x05, 0x7f, 0x6d, 0x96, 0xce, 0xe1, 0x85, 0x5b, 0x37, 0x84, 0xb5, 0x3a, 0xfa, 0xa9,
+	0xfd, 0xc9, 0x4f, 0x73, 0xac, 0xc7, 0x1c, 0xca, 0x0a, 0xc7, 0x22, 0xe3, 0x31, 0xd3, 0x64, 0x89,
+	0x65, 0xf6, 0xe1, 0xbd, 0xed, 0x0d, 0x43, 0xa3, 0xfc, 0x57, 0xe6, 0x57, 0xf7, 0xcd, 0xa3, 0x46,
+	0x1c, 0x63, 0x2e, 0x29, 0x1c, 0x8e, 0x84, 0xc3, 0x24, 0x3e, 0x43, 0x24, 0x7e, 0x08, 0x3f, 0x18,
+	0xd7, 0x23, 0x6c, 0xb2, 0xfa, 0x6d, 0x04, 0x43, 0x9e, 0x41, 0x23, 0x8e, 0x38, 0x91, 0x14, 0xb2,
+	0xa1, 0xe1, 0xc3, 0x56, 0x78, 0xb6, 0x5d, 0x68, 0x6d, 0xee, 0xbc, 0x62, 0xae, 0x4d, 0x2c, 0x5a,
+	0x38, 0xf4, 0x80, 0x51, 0xd8, 0x17, 0x02, 0x32, 0x6c, 0x04, 0x58, 0x22, 0x6d, 0x90, 0xc6, 0x7f,
+	0x1d, 0x7f, 0x97, 0x37, 0x1c, 0x9d, 0xf3, 0xe1, 0x88, 0x03, 0x41, 0x21, 0x1b, 0x1a, 0x3e, 0xec,
+	0x0a, 0xca, 0x92, 0xd2, 0xcc, 0x31, 0x2b, 0xd3, 0x5e, 0xe7, 0x25, 0xa5, 0x43, 0x2d, 0x1c, 0x71,
+	0xfa, 0x25, 0x64, 0x43, 0xc3, 0x87, 0xed, 0x1c, 0x96, 0xa4, 0x35, 0xad, 0x98, 0xdd, 0xa8, 0x69,
+	0xc5, 0xeb, 0xf8, 0x6d, 0x7e, 0x4a, 0x6d, 0x0d, 0x67, 0x70, 0xe4, 0x39, 0x8e, 0x30, 0x17, 0x01,
+	0x23, 0xec, 0x92, 0xcf, 0x92, 0xd6, 0xfb, 0x92, 0x82, 0xff, 0xe0, 0x19, 0xe2, 0xb9, 0xf6, 0x32,
+	0xe2, 0xce, 0x22, 0x84, 0xe3, 0xd1, 0x11, 0x99, 0x26, 0x67, 0x89, 0x26, 0xa7, 0xf0, 0xc9, 0x76,
+	0x9a, 0xb4, 0x5b, 0x8d, 0x7c, 0x03, 0xc1, 0xa0, 0x6b, 0x0b, 0x1e, 0x47, 0xda, 0xa9, 0x17, 0x0e,
+	0x85, 0x84, 0x0e, 0xbb, 0x7f, 0xc4, 0xe4, 0xa6, 0xe5, 0xf7, 0xcb, 0x08, 0xc0, 0xd9, 0xfb, 0xc6,
+	0xe1, 0xf7, 0xc7, 0x85, 0xfd, 0x61, 0x40, 0xc3, 0xee, 0x7b, 0x3a, 0x7b, 0xf9, 0xb9, 0x67, 0xdf,
+	0xff, 0x78, 0x0a, 0x7d, 0xf8, 0xf1, 0x14, 0xfa, 0xe8, 0xe3, 0x29, 0xf4, 0xf2, 0xcd, 0xa9, 0x4d,
+	0x1f, 0xde, 0x9c, 0xda, 0xf4, 0xc7, 0x9b, 0x53, 0x9b, 0x60, 0xb2, 0xa8, 0x06, 0xf0, 0xbc, 0x84,
+	0x96, 0x8e, 0xac, 0x16, 0x8d, 0xb5, 0xda, 0x4a, 0x26, 0xaf, 0x96, 0x39, 0x26, 0x87, 0x8a, 0x2a,
+	0xcf, 0xf2, 0x9a, 0xc3, 0xd4, 0x58, 0xaf, 0x2a, 0xfa, 0xca, 0x16, 0xf2, 0xaf, 0x87, 0x87, 0xff,
+	0x33, 0x00, 0x83, 0x87, 0x46, 0x3f, 0x34, 0x52, 0x00, 0x00,
 }
 
-func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/metadata module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
+	// SessionsByContractSpec retrieves the sessions, in any scope, written under a contract specification.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	//
+	// The sessions are found using an index, so counting them with pagination.count_total does not scan every scope.
+	SessionsByContractSpec(ctx context.Context, in *SessionsByContractSpecRequest, opts ...grpc.CallOption) (*SessionsByContractSpecResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
+	// RecordConformance checks each record of a scope against its record specification and reports every way in which
+	// a record does not conform to it.
+	//
+	// The records are checked for the same input names, input types and sources, result type output count, and session
+	// parties with the responsible party types that are required when a record is written. Records written before these
+	// checks existed, or under an earlier version of their specification, might not conform.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	RecordConformance(ctx context.Context, in *RecordConformanceRequest, opts ...grpc.CallOption) (*RecordConformanceResponse, error)
+	// RecordConsumers returns the records with an input that references the given record as its source, i.e. the
+	// downstream records that consume it. The consuming records can be in any scope.
+	RecordConsumers(ctx context.Context, in *RecordConsumersRequest, opts ...grpc.CallOption) (*RecordConsumersResponse, error)
+	// RecordLineage returns the upstream records of the given record, i.e. the records referenced by its inputs, the
+	// records referenced by their inputs, and so on, up to the requested depth. The records can be in any scope.
+	//
+	// The records of the lineage are returned with the sessions they were written in, so the record and contract
+	// specifications of each record are available from the record and session id info.
+	RecordLineage(ctx context.Context, in *RecordLineageRequest, opts ...grpc.CallOption) (*RecordLineageResponse, error)
+	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
+	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error)
+	// SpecificationBundle returns a contract specification and its record specifications as a single bundle that can be
+	// written to another chain using the Msg/WriteSpecificationBundle endpoint.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	//
+	// By default, scope specifications are not included.
+	// Set include_scope_specs to true to include the scope specifications that reference the contract specification.
+	SpecificationBundle(ctx context.Context, in *SpecificationBundleRequest, opts ...grpc.CallOption) (*SpecificationBundleResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address.
+	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
+	// OSLocatorByName resolves a name to the address it is bound to and returns the ObjectStoreLocator of that address.
+	OSLocatorByName(ctx context.Context, in *OSLocatorByNameRequest, opts ...grpc.CallOption) (*OSLocatorByNameResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
+	// OSLocatorsByContractSpec returns the source hash of a contract specification along with the ObjectStoreLocator
+	// entries its owners have verified the source can be downloaded from.
+	OSLocatorsByContractSpec(ctx context.Context, in *OSLocatorsByContractSpecRequest, opts ...grpc.CallOption) (*OSLocatorsByContractSpecResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
+	// Invariants runs all the metadata module invariants against the current state and returns the results without
+	// halting the chain, even when an invariant is broken.
+	Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error) {
+	out := new(ScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error) {
+	out := new(ScopesAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopesAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
+	out := new(SessionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error) {
+	out := new(SessionsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SessionsByContractSpec(ctx context.Context, in *SessionsByContractSpecRequest, opts ...grpc.CallOption) (*SessionsByContractSpecResponse, error) {
+	out := new(SessionsByContractSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsByContractSpec", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
+	out := new(RecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error) {
+	out := new(RecordsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordConformance(ctx context.Context, in *RecordConformanceRequest, opts ...grpc.CallOption) (*RecordConformanceResponse, error) {
+	out := new(RecordConformanceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordConformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecordConsumers(ctx context.Context, in *RecordConsumersRequest, opts ...grpc.CallOption) (*RecordConsumersResponse, error) {
+	out := new(RecordConsumersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordConsumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRecords {
-		i--
-		if m.IncludeRecords {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+func (c *queryClient) RecordLineage(ctx context.Context, in *RecordLineageRequest, opts ...grpc.CallOption) (*RecordLineageResponse, error) {
+	out := new(RecordLineageResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RecordName) > 0 {
-		i -= len(m.RecordName)
-		copy(dAtA[i:], m.RecordName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
+	out := new(OwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
+	out := new(ValueOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
+	out := new(ScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) ScopeSpecificationsAll(ctx context.Context, in *ScopeSpecificationsAllRequest, opts ...grpc.CallOption) (*ScopeSpecificationsAllResponse, error) {
+	out := new(ScopeSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractSpecification(ctx context.Context, in *ContractSpecificationRequest, opts ...grpc.CallOption) (*ContractSpecificationResponse, error) {
+	out := new(ContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractSpecificationsAll(ctx context.Context, in *ContractSpecificationsAllRequest, opts ...grpc.CallOption) (*ContractSpecificationsAllResponse, error) {
+	out := new(ContractSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ContractSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func (c *queryClient) RecordSpecificationsForContractSpecification(ctx context.Context, in *RecordSpecificationsForContractSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	out := new(RecordSpecificationsForContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return out, nil
+}
+
+func (c *queryClient) RecordSpecification(ctx context.Context, in *RecordSpecificationRequest, opts ...grpc.CallOption) (*RecordSpecificationResponse, error) {
+	out := new(RecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return out, nil
+}
+
+func (c *queryClient) RecordSpecificationsAll(ctx context.Context, in *RecordSpecificationsAllRequest, opts ...grpc.CallOption) (*RecordSpecificationsAllResponse, error) {
+	out := new(RecordSpecificationsAllResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordSpecificationsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) SpecificationBundle(ctx context.Context, in *SpecificationBundleRequest, opts ...grpc.CallOption) (*SpecificationBundleResponse, error) {
+	out := new(SpecificationBundleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SpecificationBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorParams(ctx context.Context, in *OSLocatorParamsRequest, opts ...grpc.CallOption) (*OSLocatorParamsResponse, error) {
+	out := new(OSLocatorParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error) {
+	out := new(OSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Session != nil {
-		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorByName(ctx context.Context, in *OSLocatorByNameRequest, opts ...grpc.CallOption) (*OSLocatorByNameResponse, error) {
+	out := new(OSLocatorByNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorByName", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error) {
+	out := new(OSLocatorsByURIResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByURI", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error) {
+	out := new(OSLocatorsByScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OSLocatorsByContractSpec(ctx context.Context, in *OSLocatorsByContractSpecRequest, opts ...grpc.CallOption) (*OSLocatorsByContractSpecResponse, error) {
+	out := new(OSLocatorsByContractSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSLocatorsByContractSpec", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.IncludeScope {
-		i--
-		if m.IncludeScope {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error) {
+	out := new(OSAllLocatorsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/OSAllLocators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error) {
+	out := new(InvariantsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Invariants", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Scope != nil {
-		{
-			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/metadata module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Scope searches for a scope.
+	//
+	// The scope id, if provided, must either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address,
+	// e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The session addr, if provided, must be a bech32 session address,
+	// e.g. session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, that scope is returned.
+	// * If only a session_addr is provided, the scope containing that session is returned.
+	// * If only a record_addr is provided, the scope containing that record is returned.
+	// * If more than one of scope_id, session_addr, and record_addr are provided, and they don't refer to the same scope,
+	// a bad request is returned.
+	//
+	// Providing a session addr or record addr does not limit the sessions and records returned (if requested).
+	// Those parameters are only used to find the scope.
+	//
+	// By default, sessions and records are not included.
+	// Set include_sessions and/or include_records to true to include sessions and/or records.
+	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
+	// ScopesAll retrieves all scopes.
+	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
+	// Sessions searches for sessions.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly, the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The record_addr, if provided, must be a
+	// bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	//
+	// * If only a scope_id is provided, all sessions in that scope are returned.
+	// * If only a session_id is provided, it must be an address, and that single session is returned.
+	// * If the session_id is a uuid, then either a scope_id or record_addr must also be provided, and that single session
+	// is returned.
+	// * If only a record_addr is provided, the session containing that record will be returned.
+	// * If a record_name is provided then either a scope_id, session_id as an address, or record_addr must also be
+	// provided, and the session containing that record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and is provided without a scope_id or record_addr.
+	// * A record_name is provided without any way to identify the scope (e.g. a scope_id, a session_id as an address, or
+	// a record_addr).
+	// * Two or more of scope_id, session_id as an address, and record_addr are provided and don't all refer to the same
+	// scope.
+	// * A record_addr (or scope_id and record_name) is provided with a session_id and that session does not contain such
+	// a record.
+	// * A record_addr and record_name are both provided, but reference different records.
+	//
+	// By default, the scope and records are not included.
+	// Set include_scope and/or include_records to true to include the scope and/or records.
+	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
+	// SessionsAll retrieves all sessions.
+	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
+	// SessionsByContractSpec retrieves the sessions, in any scope, written under a contract specification.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	//
+	// The sessions are found using an index, so counting them with pagination.count_total does not scan every scope.
+	SessionsByContractSpec(context.Context, *SessionsByContractSpecRequest) (*SessionsByContractSpecResponse, error)
+	// Records searches for records.
+	//
+	// The record_addr, if provided, must be a bech32 record address, e.g.
+	// record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3. The scope-id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. Similarly,
+	// the session_id can either be a uuid or session address, e.g.
+	// session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr. The name is the name of the record you're
+	// interested in.
+	//
+	// * If only a record_addr is provided, that single record will be returned.
+	// * If only a scope_id is provided, all records in that scope will be returned.
+	// * If only a session_id (or scope_id/session_id), all records in that session will be returned.
+	// * If a name is provided with a scope_id and/or session_id, that single record will be returned.
+	//
+	// A bad request is returned if:
+	// * The session_id is a uuid and no scope_id is provided.
+	// * There are two or more of record_addr, session_id, and scope_id, and they don't all refer to the same scope.
+	// * A name is provided, but not a scope_id and/or a session_id.
+	// * A name and record_addr are provided and the name doesn't match the record_addr.
+	//
+	// By default, the scope and sessions are not included.
+	// Set include_scope and/or include_sessions to true to include the scope and/or sessions.
+	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
+	// RecordsAll retrieves all records.
+	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
+	// RecordConformance checks each record of a scope against its record specification and reports every way in which
+	// a record does not conform to it.
+	//
+	// The records are checked for the same input names, input types and sources, result type output count, and session
+	// parties with the responsible party types that are required when a record is written. Records written before these
+	// checks existed, or under an earlier version of their specification, might not conform.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	RecordConformance(context.Context, *RecordConformanceRequest) (*RecordConformanceResponse, error)
+	// RecordConsumers returns the records with an input that references the given record as its source, i.e. the
+	// downstream records that consume it. The consuming records can be in any scope.
+	RecordConsumers(context.Context, *RecordConsumersRequest) (*RecordConsumersResponse, error)
+	// RecordLineage returns the upstream records of the given record, i.e. the records referenced by its inputs, the
+	// records referenced by their inputs, and so on, up to the requested depth. The records can be in any scope.
+	//
+	// The records of the lineage are returned with the sessions they were written in, so the record and contract
+	// specifications of each record are available from the record and session id info.
+	RecordLineage(context.Context, *RecordLineageRequest) (*RecordLineageResponse, error)
+	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
+	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
+	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
+	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
+	// ScopeSpecification returns a scope specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
+	// specification address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	ScopeSpecification(context.Context, *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error)
+	// ScopeSpecificationsAll retrieves all scope specifications.
+	ScopeSpecificationsAll(context.Context, *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error)
+	// ContractSpecification returns a contract specification for the given specification id.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is looked up.
+	//
+	// By default, the record specifications for this contract specification are not included.
+	// Set include_record_specs to true to include them in the result.
+	ContractSpecification(context.Context, *ContractSpecificationRequest) (*ContractSpecificationResponse, error)
+	// ContractSpecificationsAll retrieves all contract specifications.
+	ContractSpecificationsAll(context.Context, *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error)
+	// RecordSpecificationsForContractSpecification returns the record specifications for the given input.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	RecordSpecificationsForContractSpecification(context.Context, *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error)
+	// RecordSpecification returns a record specification for the given input.
+	RecordSpecification(context.Context, *RecordSpecificationRequest) (*RecordSpecificationResponse, error)
+	// RecordSpecificationsAll retrieves all record specifications.
+	RecordSpecificationsAll(context.Context, *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error)
+	// SpecificationBundle returns a contract specification and its record specifications as a single bundle that can be
+	// written to another chain using the Msg/WriteSpecificationBundle endpoint.
+	//
+	// The specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84, a bech32 contract
+	// specification address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn, or a bech32 record specification
+	// address, e.g. recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44. If it is a record specification
+	// address, then the contract specification that contains that record specification is used.
+	//
+	// By default, scope specifications are not included.
+	// Set include_scope_specs to true to include the scope specifications that reference the contract specification.
+	SpecificationBundle(context.Context, *SpecificationBundleRequest) (*SpecificationBundleResponse, error)
+	// OSLocatorParams returns all parameters for the object store locator sub module.
+	OSLocatorParams(context.Context, *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error)
+	// OSLocator returns an ObjectStoreLocator by its owner's address.
+	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
+	// OSLocatorByName resolves a name to the address it is bound to and returns the ObjectStoreLocator of that address.
+	OSLocatorByName(context.Context, *OSLocatorByNameRequest) (*OSLocatorByNameResponse, error)
+	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
+	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
+	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
+	// OSLocatorsByContractSpec returns the source hash of a contract specification along with the ObjectStoreLocator
+	// entries its owners have verified the source can be downloaded from.
+	OSLocatorsByContractSpec(context.Context, *OSLocatorsByContractSpecRequest) (*OSLocatorsByContractSpecResponse, error)
+	// OSAllLocators returns all ObjectStoreLocator entries.
+	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
+	// Invariants runs all the metadata module invariants against the current state and returns the results without
+	// halting the chain, even when an invariant is broken.
+	Invariants(context.Context, *InvariantsRequest) (*InvariantsResponse, error)
 }
 
-func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (*ScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scope not implemented")
 }
-
-func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
 }
-
-func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
 }
-
-func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
 }
-
-func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) SessionsByContractSpec(ctx context.Context, req *SessionsByContractSpecRequest) (*SessionsByContractSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionsByContractSpec not implemented")
+}
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
+}
+func (*UnimplementedQueryServer) RecordConformance(ctx context.Context, req *RecordConformanceRequest) (*RecordConformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordConformance not implemented")
+}
+func (*UnimplementedQueryServer) RecordConsumers(ctx context.Context, req *RecordConsumersRequest) (*RecordConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordConsumers not implemented")
+}
+func (*UnimplementedQueryServer) RecordLineage(ctx context.Context, req *RecordLineageRequest) (*RecordLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLineage not implemented")
+}
+func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+}
+func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSpecificationsAll(ctx context.Context, req *ScopeSpecificationsAllRequest) (*ScopeSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecification(ctx context.Context, req *ContractSpecificationRequest) (*ContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) ContractSpecificationsAll(ctx context.Context, req *ContractSpecificationsAllRequest) (*ContractSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsForContractSpecification(ctx context.Context, req *RecordSpecificationsForContractSpecificationRequest) (*RecordSpecificationsForContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsForContractSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecification(ctx context.Context, req *RecordSpecificationRequest) (*RecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecification not implemented")
+}
+func (*UnimplementedQueryServer) RecordSpecificationsAll(ctx context.Context, req *RecordSpecificationsAllRequest) (*RecordSpecificationsAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSpecificationsAll not implemented")
+}
+func (*UnimplementedQueryServer) SpecificationBundle(ctx context.Context, req *SpecificationBundleRequest) (*SpecificationBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecificationBundle not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorParams(ctx context.Context, req *OSLocatorParamsRequest) (*OSLocatorParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorParams not implemented")
+}
+func (*UnimplementedQueryServer) OSLocator(ctx context.Context, req *OSLocatorRequest) (*OSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocator not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorByName(ctx context.Context, req *OSLocatorByNameRequest) (*OSLocatorByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorByName not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByURI(ctx context.Context, req *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByURI not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByScope(ctx context.Context, req *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByScope not implemented")
+}
+func (*UnimplementedQueryServer) OSLocatorsByContractSpec(ctx context.Context, req *OSLocatorsByContractSpecRequest) (*OSLocatorsByContractSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSLocatorsByContractSpec not implemented")
+}
+func (*UnimplementedQueryServer) OSAllLocators(ctx context.Context, req *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OSAllLocators not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *InvariantsRequest) (*InvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
 }
 
-func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Params",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Scope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Scope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Scope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scope(ctx, req.(*ScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopesAll(ctx, req.(*ScopesAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Sessions(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Sessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sessions(ctx, req.(*SessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SessionsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SessionsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionsAll(ctx, req.(*SessionsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SessionsByContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsByContractSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionsByContractSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SessionsByContractSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionsByContractSpec(ctx, req.(*SessionsByContractSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Records",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*RecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsAll(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordsAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsAll(ctx, req.(*RecordsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordConformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordConformanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).RecordConformance(ctx, in)
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordConformance",
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordConformance(ctx, req.(*RecordConformanceRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordConsumersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecordConsumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordConsumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordConsumers(ctx, req.(*RecordConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RecordLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordLineage(ctx, req.(*RecordLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Ownership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ownership(ctx, req.(*OwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueOwnershipRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ValueOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ValueOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValueOwnership(ctx, req.(*ValueOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecification(ctx, req.(*ScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+func _Query_ScopeSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSpecificationsAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ScopeSpecification != nil {
-		{
-			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSpecificationsAll(ctx, req.(*ScopeSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecification(ctx, in)
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecification(ctx, req.(*ContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ContractSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractSpecificationsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ContractSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSpecificationsAll(ctx, req.(*ContractSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordSpecificationsForContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsForContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsForContractSpecification(ctx, req.(*RecordSpecificationsForContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_RecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecification(ctx, in)
 	}
-	if len(m.ScopeSpecifications) > 0 {
-		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecification",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecification(ctx, req.(*RecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecordSpecificationsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSpecificationsAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordSpecificationsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordSpecificationsAll(ctx, req.(*RecordSpecificationsAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SpecificationBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecificationBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecificationBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SpecificationBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecificationBundle(ctx, req.(*SpecificationBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+func _Query_OSLocatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorParams(ctx, req.(*OSLocatorParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocator(ctx, in)
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocator",
 	}
-	if m.ContractSpecification != nil {
-		{
-			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocator(ctx, req.(*OSLocatorRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocatorByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorByNameRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorByName(ctx, in)
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorByName",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorByName(ctx, req.(*OSLocatorByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSLocatorsByURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByURIRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByURI(ctx, req.(*OSLocatorsByURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OSLocatorsByScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByScope(ctx, req.(*OSLocatorsByScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_OSLocatorsByContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSLocatorsByContractSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSLocatorsByContractSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSLocatorsByContractSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSLocatorsByContractSpec(ctx, req.(*OSLocatorsByContractSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OSAllLocators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OSAllLocatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OSAllLocators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/OSAllLocators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OSAllLocators(ctx, req.(*OSAllLocatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*InvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.Request != nil {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.ContractSpecifications) > 0 {
-		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Scope",
+			Handler:    _Query_Scope_Handler,
+		},
+		{
+			MethodName: "ScopesAll",
+			Handler:    _Query_ScopesAll_Handler,
+		},
+		{
+			MethodName: "Sessions",
+			Handler:    _Query_Sessions_Handler,
+		},
+		{
+			MethodName: "SessionsAll",
+			Handler:    _Query_SessionsAll_Handler,
+		},
+		{
+			MethodName: "SessionsByContractSpec",
+			Handler:    _Query_SessionsByContractSpec_Handler,
+		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
+		{
+			MethodName: "RecordsAll",
+			Handler:    _Query_RecordsAll_Handler,
+		},
+		{
+			MethodName: "RecordConformance",
+			Handler:    _Query_RecordConformance_Handler,
+		},
+		{
+			MethodName: "RecordConsumers",
+			Handler:    _Query_RecordConsumers_Handler,
+		},
+		{
+			MethodName: "RecordLineage",
+			Handler:    _Query_RecordLineage_Handler,
+		},
+		{
+			MethodName: "Ownership",
+			Handler:    _Query_Ownership_Handler,
+		},
+		{
+			MethodName: "ValueOwnership",
+			Handler:    _Query_ValueOwnership_Handler,
+		},
+		{
+			MethodName: "ScopeSpecification",
+			Handler:    _Query_ScopeSpecification_Handler,
+		},
+		{
+			MethodName: "ScopeSpecificationsAll",
+			Handler:    _Query_ScopeSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "ContractSpecification",
+			Handler:    _Query_ContractSpecification_Handler,
+		},
+		{
+			MethodName: "ContractSpecificationsAll",
+			Handler:    _Query_ContractSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsForContractSpecification",
+			Handler:    _Query_RecordSpecificationsForContractSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecification",
+			Handler:    _Query_RecordSpecification_Handler,
+		},
+		{
+			MethodName: "RecordSpecificationsAll",
+			Handler:    _Query_RecordSpecificationsAll_Handler,
+		},
+		{
+			MethodName: "SpecificationBundle",
+			Handler:    _Query_SpecificationBundle_Handler,
+		},
+		{
+			MethodName: "OSLocatorParams",
+			Handler:    _Query_OSLocatorParams_Handler,
+		},
+		{
+			MethodName: "OSLocator",
+			Handler:    _Query_OSLocator_Handler,
+		},
+		{
+			MethodName: "OSLocatorByName",
+			Handler:    _Query_OSLocatorByName_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByURI",
+			Handler:    _Query_OSLocatorsByURI_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByScope",
+			Handler:    _Query_OSLocatorsByScope_Handler,
+		},
+		{
+			MethodName: "OSLocatorsByContractSpec",
+			Handler:    _Query_OSLocatorsByContractSpec_Handler,
+		},
+		{
+			MethodName: "OSAllLocators",
+			Handler:    _Query_OSAllLocators_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/query.proto",
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5775,27 +5833,20 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5805,12 +5856,12 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA [
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5829,38 +5880,20 @@ func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuf
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContractSpecificationUuid) > 0 {
-		i -= len(m.ContractSpecificationUuid)
-		copy(dAtA[i:], m.ContractSpecificationUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5870,34 +5903,70 @@ func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+	if len(m.FieldMask) > 0 {
+		for iNdEx := len(m.FieldMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldMask[iNdEx])
+			copy(dAtA[i:], m.FieldMask[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FieldMask[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionAddr)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5907,12 +5976,12 @@ func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5931,9 +6000,37 @@ func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.RecordSpecification != nil {
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
 		{
-			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5946,7 +6043,7 @@ func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ScopeWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5956,19 +6053,48 @@ func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
+	if m.Archive != nil {
 		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Archive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5978,9 +6104,9 @@ func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Specification != nil {
+	if m.Scope != nil {
 		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5993,7 +6119,7 @@ func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopesAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6003,12 +6129,12 @@ func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopesAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopesAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6030,7 +6156,7 @@ func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopesAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6040,12 +6166,12 @@ func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6078,10 +6204,10 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6095,7 +6221,7 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6105,20 +6231,77 @@ func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FieldMask) > 0 {
+		for iNdEx := len(m.FieldMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldMask[iNdEx])
+			copy(dAtA[i:], m.FieldMask[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FieldMask[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.IncludeRecords {
+		i--
+		if m.IncludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeScope {
+		i--
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecordName) > 0 {
+		i -= len(m.RecordName)
+		copy(dAtA[i:], m.RecordName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6128,12 +6311,12 @@ func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6152,50 +6335,50 @@ func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x92
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6205,19 +6388,19 @@ func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.ContractSpecIdInfo != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6225,13 +6408,23 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1a
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x12
 	}
-	if m.Locator != nil {
+	if m.Session != nil {
 		{
-			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6244,7 +6437,7 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6254,12 +6447,12 @@ func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6278,17 +6471,10 @@ func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6298,12 +6484,12 @@ func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6336,10 +6522,10 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6353,7 +6539,7 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsByContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6363,27 +6549,41 @@ func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsByContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsByContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionsByContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6393,16 +6593,30 @@ func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsByContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsByContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -6417,10 +6631,10 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6434,7 +6648,7 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6444,34 +6658,68 @@ func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x58
+	}
+	if m.IncludeScope {
 		i--
-		dAtA[i] = 0x9a
+		if m.IncludeScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6481,30 +6729,16 @@ func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -6519,10 +6753,10 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6530,945 +6764,6690 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Redacted {
+		i--
+		if m.Redacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.IncludeSessions {
-		n += 2
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IncludeRecords {
-		n += 2
-	}
-	return n
-}
-
-func (m *ScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RecordsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopesAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopesAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
+func (m *RecordsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
 	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionWrapper) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordConformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordConformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordConformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *RecordConformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordConformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordConformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Conforms {
+		i--
+		if m.Conforms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordConformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordConformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordConformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Problems) > 0 {
+		for iNdEx := len(m.Problems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Problems[iNdEx])
+			copy(dAtA[i:], m.Problems[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Problems[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Conforms {
+		i--
+		if m.Conforms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IncludeScope {
-		n += 2
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IncludeSessions {
-		n += 2
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordConsumersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordConsumersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordConsumersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RecordConsumersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RecordConsumersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordConsumersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordLineageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordLineageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordLineageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordLineageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordLineageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordLineageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValueOwnershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *RecordLineageNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValueOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordLineageNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordLineageNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SourceRecordAddrs) > 0 {
+		for iNdEx := len(m.SourceRecordAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceRecordAddrs[iNdEx])
+			copy(dAtA[i:], m.SourceRecordAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceRecordAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecification != nil {
-		l = m.ScopeSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecifications) > 0 {
-		for _, e := range m.ScopeSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeRecordSpecs {
-		n += 2
+func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecification != nil {
-		l = m.ContractSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecifications) > 0 {
-		for _, e := range m.ContractSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.ContractSpecificationUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpecificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordSpecification != nil {
-		l = m.RecordSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ScopeSpecification != nil {
+		{
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.LatestSuccessorAddr) > 0 {
+		i -= len(m.LatestSuccessorAddr)
+		copy(dAtA[i:], m.LatestSuccessorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LatestSuccessorAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Deprecation != nil {
+		{
+			size, err := m.Deprecation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Locator != nil {
-		l = m.Locator.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if m.ContractSpecification != nil {
+		{
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LatestSuccessorAddr) > 0 {
+		i -= len(m.LatestSuccessorAddr)
+		copy(dAtA[i:], m.LatestSuccessorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LatestSuccessorAddr)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.Deprecation != nil {
+		{
+			size, err := m.Deprecation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractSpecificationUuid) > 0 {
+		i -= len(m.ContractSpecificationUuid)
+		copy(dAtA[i:], m.ContractSpecificationUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.RecordSpecification != nil {
+		{
+			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecificationBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecificationBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecificationBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeScopeSpecs {
+		i--
+		if m.IncludeScopeSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecificationBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecificationBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecificationBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Bundle != nil {
+		{
+			size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Locator != nil {
+		{
+			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Locator != nil {
+		{
+			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxReportAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HealthyOnly {
+		i--
+		if m.HealthyOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxReportAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxReportAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HealthyOnly {
+		i--
+		if m.HealthyOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxReportAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxReportAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HealthyOnly {
+		i--
+		if m.HealthyOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorsByContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorsByContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxReportAgeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HealthyOnly {
+		i--
+		if m.HealthyOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if len(m.FieldMask) > 0 {
+		for _, s := range m.FieldMask {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovQuery(uint64(m.SizeBytes))
+	}
+	if m.Archive != nil {
+		l = m.Archive.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopesAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecordName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
+	}
+	if len(m.FieldMask) > 0 {
+		for _, s := range m.FieldMask {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsByContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsByContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Redacted {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecordsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordConformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordConformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Conforms {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordConformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Conforms {
+		n += 2
+	}
+	if len(m.Problems) > 0 {
+		for _, s := range m.Problems {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordConsumersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordConsumersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordLineageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *RecordLineageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordLineageNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if len(m.SourceRecordAddrs) > 0 {
+		for _, s := range m.SourceRecordAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deprecation != nil {
+		l = m.Deprecation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LatestSuccessorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecification != nil {
+		l = m.ContractSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deprecation != nil {
+		l = m.Deprecation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LatestSuccessorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecificationUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecification != nil {
+		l = m.RecordSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpecificationBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeScopeSpecs {
+		n += 2
+	}
+	return n
+}
+
+func (m *SpecificationBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bundle != nil {
+		l = m.Bundle.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OSLocatorParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locator != nil {
+		l = m.Locator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Locator != nil {
+		l = m.Locator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HealthyOnly {
+		n += 2
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxReportAgeSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HealthyOnly {
+		n += 2
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxReportAgeSeconds))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HealthyOnly {
+		n += 2
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxReportAgeSeconds))
+	}
+	return n
+}
+
+func (m *OSLocatorsByContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HealthyOnly {
+		n += 2
+	}
+	if m.MaxReportAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxReportAgeSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Broken {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &QueryParamsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldMask = append(m.FieldMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archive == nil {
+				m.Archive = &ScopeArchive{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopesAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldMask = append(m.FieldMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsByContractSpecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsByContractSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsByContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsByContractSpecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsByContractSpecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsByContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsByContractSpecRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RecordsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *OSAllLocatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *OSAllLocatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Record{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			}
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redacted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7478,25 +13457,61 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7518,7 +13533,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7541,15 +13556,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7576,7 +13591,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7610,12 +13626,48 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &QueryParamsRequest{}
+				m.Request = &RecordsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7637,7 +13689,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordConformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7660,10 +13712,10 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordConformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordConformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7698,110 +13750,6 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeSessions = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeRecords = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7823,7 +13771,7 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordConformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7846,15 +13794,15 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordConformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordConformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7881,16 +13829,16 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7917,16 +13865,16 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, RecordConformance{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conforms", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7936,26 +13884,12 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Conforms = bool(v != 0)
 		case 98:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
@@ -7986,7 +13920,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeRequest{}
+				m.Request = &RecordConformanceRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8013,7 +13947,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordConformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8036,17 +13970,49 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordConformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordConformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8056,33 +14022,29 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &Scope{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8092,33 +14054,49 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conforms", wireType)
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+			m.Conforms = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Problems", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8128,27 +14106,23 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
-			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Problems = append(m.Problems, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8171,7 +14145,7 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordConsumersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8194,12 +14168,44 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordConsumersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordConsumersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8257,7 +14263,7 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordConsumersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8280,15 +14286,15 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordConsumersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordConsumersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8315,8 +14321,8 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &ScopeWrapper{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8350,7 +14356,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopesAllRequest{}
+				m.Request = &RecordConsumersRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8413,7 +14419,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordLineageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8436,145 +14442,17 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordLineageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordLineageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8584,17 +14462,29 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8604,12 +14494,11 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Depth |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRecords = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8631,7 +14520,7 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordLineageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8654,15 +14543,15 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordLineageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordLineageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8689,10 +14578,8 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, RecordLineageNode{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8731,10 +14618,10 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8744,26 +14631,12 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Truncated = bool(v != 0)
 		case 98:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
@@ -8794,7 +14667,7 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &SessionsRequest{}
+				m.Request = &RecordLineageRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8821,7 +14694,7 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordLineageNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8844,15 +14717,15 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordLineageNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordLineageNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8879,18 +14752,18 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &Session{}
+			if m.Record == nil {
+				m.Record = &RecordWrapper{}
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8900,33 +14773,16 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Depth |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
-			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRecordAddrs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8936,27 +14792,23 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceRecordAddrs = append(m.SourceRecordAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8979,7 +14831,7 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9002,12 +14854,44 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -9065,7 +14949,7 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9088,17 +14972,17 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9108,25 +14992,23 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -9158,7 +15040,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &SessionsAllRequest{}
+				m.Request = &OwnershipRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9221,7 +15103,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9244,15 +15126,15 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9280,11 +15162,97 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9312,13 +15280,13 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9328,29 +15296,33 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &ValueOwnershipRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9360,64 +15332,28 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IncludeSessions = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9439,7 +15375,7 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9462,17 +15398,17 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9482,65 +15418,77 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9567,8 +15515,10 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScopeSpecification == nil {
+				m.ScopeSpecification = &ScopeSpecificationWrapper{}
+			}
+			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9602,7 +15552,7 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordsRequest{}
+				m.Request = &ScopeSpecificationRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9629,7 +15579,7 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9652,15 +15602,15 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9687,16 +15637,16 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
+			if m.Specification == nil {
+				m.Specification = &ScopeSpecification{}
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9723,16 +15673,16 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
 			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9759,13 +15709,45 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			if m.Deprecation == nil {
+				m.Deprecation = &SpecificationDeprecation{}
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deprecation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSuccessorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestSuccessorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9787,7 +15769,7 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9810,10 +15792,10 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 99:
@@ -9873,7 +15855,7 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9896,15 +15878,15 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9931,8 +15913,8 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
+			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9966,7 +15948,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordsAllRequest{}
+				m.Request = &ScopeSpecificationsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10029,7 +16011,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10052,15 +16034,15 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10088,13 +16070,13 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10104,28 +16086,12 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRecordSpecs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10147,7 +16113,7 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10170,17 +16136,17 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10190,27 +16156,31 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			if m.ContractSpecification == nil {
+				m.ContractSpecification = &ContractSpecificationWrapper{}
+			}
+			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10237,16 +16207,14 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &OwnershipRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10273,10 +16241,10 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &ContractSpecificationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10301,7 +16269,7 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10324,15 +16292,123 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Specification == nil {
+				m.Specification = &ContractSpecification{}
+			}
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deprecation == nil {
+				m.Deprecation = &SpecificationDeprecation{}
+			}
+			if err := m.Deprecation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSuccessorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10360,8 +16436,58 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.LatestSuccessorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -10419,7 +16545,7 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10442,17 +16568,17 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10462,23 +16588,25 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
+			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -10510,7 +16638,7 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ValueOwnershipRequest{}
+				m.Request = &ContractSpecificationsAllRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10573,7 +16701,7 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10596,10 +16724,10 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10655,7 +16783,7 @@ func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10678,15 +16806,15 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10713,13 +16841,75 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecification == nil {
-				m.ScopeSpecification = &ScopeSpecificationWrapper{}
-			}
-			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
@@ -10750,7 +16940,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopeSpecificationRequest{}
+				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10777,7 +16967,7 @@ func (m *ScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10800,17 +16990,17 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10820,33 +17010,29 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &ScopeSpecification{}
-			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10856,27 +17042,23 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
-			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10899,7 +17081,7 @@ func (m *ScopeSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10922,15 +17104,15 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10957,10 +17139,46 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.RecordSpecification == nil {
+				m.RecordSpecification = &RecordSpecificationWrapper{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RecordSpecificationRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10985,7 +17203,7 @@ func (m *ScopeSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11008,49 +17226,15 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeSpecifications = append(m.ScopeSpecifications, &ScopeSpecificationWrapper{})
-			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11077,16 +17261,16 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ScopeSpecificationsAllRequest{}
+			if m.Specification == nil {
+				m.Specification = &RecordSpecification{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11113,10 +17297,10 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11141,7 +17325,7 @@ func (m *ScopeSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11164,17 +17348,17 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11184,44 +17368,28 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecordSpecs", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IncludeRecordSpecs = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11243,7 +17411,7 @@ func (m *ContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11266,15 +17434,15 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11301,16 +17469,14 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecification == nil {
-				m.ContractSpecification = &ContractSpecificationWrapper{}
-			}
-			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11337,14 +17503,16 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &RecordSpecificationsAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11371,10 +17539,10 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ContractSpecificationRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11399,7 +17567,7 @@ func (m *ContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
+func (m *SpecificationBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11422,17 +17590,17 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecificationBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecificationBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11442,33 +17610,29 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &ContractSpecification{}
-			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScopeSpecs", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11478,28 +17642,12 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeScopeSpecs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11521,7 +17669,7 @@ func (m *ContractSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *SpecificationBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11544,15 +17692,15 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecificationBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecificationBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11579,10 +17727,46 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Bundle == nil {
+				m.Bundle = &SpecificationBundle{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SpecificationBundleRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11607,7 +17791,7 @@ func (m *ContractSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11630,49 +17814,65 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecifications = append(m.ContractSpecifications, &ContractSpecificationWrapper{})
-			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 98:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11699,16 +17899,13 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ContractSpecificationsAllRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11735,10 +17932,10 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &OSLocatorParamsRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11763,7 +17960,7 @@ func (m *ContractSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11786,15 +17983,15 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11822,7 +18019,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11845,7 +18042,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11868,15 +18065,15 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsForContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11903,74 +18100,12 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractSpecificationUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Locator == nil {
+				m.Locator = &ObjectStoreLocator{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ContractSpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -12002,7 +18137,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordSpecificationsForContractSpecificationRequest{}
+				m.Request = &OSLocatorRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12029,7 +18164,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12052,45 +18187,13 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpecificationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -12143,7 +18246,7 @@ func (m *RecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12166,53 +18269,17 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecification", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordSpecification == nil {
-				m.RecordSpecification = &RecordSpecificationWrapper{}
-			}
-			if err := m.RecordSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12222,81 +18289,27 @@ func (m *RecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &RecordSpecificationRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12323,16 +18336,16 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Specification == nil {
-				m.Specification = &RecordSpecification{}
+			if m.Locator == nil {
+				m.Locator = &ObjectStoreLocator{}
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12359,10 +18372,10 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			if m.Request == nil {
+				m.Request = &OSLocatorByNameRequest{}
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12387,7 +18400,7 @@ func (m *RecordSpecificationWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12410,12 +18423,83 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HealthyOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAgeSeconds", wireType)
+			}
+			m.MaxReportAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReportAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -12473,7 +18557,7 @@ func (m *RecordSpecificationsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12496,15 +18580,15 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordSpecificationsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12531,8 +18615,8 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecifications = append(m.RecordSpecifications, &RecordSpecificationWrapper{})
-			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12566,7 +18650,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &RecordSpecificationsAllRequest{}
+				m.Request = &OSLocatorsByURIRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12629,7 +18713,7 @@ func (m *RecordSpecificationsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12652,12 +18736,83 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HealthyOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAgeSeconds", wireType)
+			}
+			m.MaxReportAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReportAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12679,7 +18834,7 @@ func (m *OSLocatorParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12702,15 +18857,15 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12737,7 +18892,8 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12771,7 +18927,7 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &OSLocatorParamsRequest{}
+				m.Request = &OSLocatorsByScopeRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12798,7 +18954,7 @@ func (m *OSLocatorParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByContractSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12821,15 +18977,15 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByContractSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12857,8 +19013,47 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HealthyOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAgeSeconds", wireType)
+			}
+			m.MaxReportAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReportAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12880,7 +19075,7 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *OSLocatorsByContractSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12903,15 +19098,47 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSLocatorsByContractSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSLocatorsByContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12938,10 +19165,8 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locator == nil {
-				m.Locator = &ObjectStoreLocator{}
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12975,7 +19200,7 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &OSLocatorRequest{}
+				m.Request = &OSLocatorsByContractSpecRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13002,7 +19227,7 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13025,17 +19250,17 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13045,24 +19270,31 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.HealthyOnly = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportAgeSeconds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxReportAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReportAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -13120,7 +19352,7 @@ func (m *OSLocatorsByURIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
+func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13143,10 +19375,10 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13213,7 +19445,7 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &OSLocatorsByURIRequest{}
+				m.Request = &OSAllLocatorsRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13276,7 +19508,7 @@ func (m *OSLocatorsByURIResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *InvariantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13299,44 +19531,12 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -13358,7 +19558,7 @@ func (m *OSLocatorsByScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *InvariantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13381,15 +19581,15 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSLocatorsByScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13416,16 +19616,16 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13435,81 +19635,15 @@ func (m *OSLocatorsByScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &OSLocatorsByScopeRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 99:
+			m.Broken = bool(v != 0)
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13536,10 +19670,10 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Request == nil {
+				m.Request = &InvariantsRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13564,7 +19698,7 @@ func (m *OSAllLocatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13587,17 +19721,17 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSAllLocatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13607,31 +19741,29 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators, ObjectStoreLocator{})
-			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13641,33 +19773,17 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &OSAllLocatorsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
+			m.Broken = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13677,27 +19793,23 @@ func (m *OSAllLocatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
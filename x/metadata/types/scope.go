@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +16,9 @@ import (
 const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
+
+	// RedactedOutputHash is the marker that replaces the hash of a redacted record output.
+	RedactedOutputHash = "REDACTED"
 )
 
 // NewScope creates a new instance.
@@ -67,6 +72,11 @@ func (s Scope) ValidateBasic() error {
 	return nil
 }
 
+// ValueOwnerCoinDenom returns the denom of the coin that represents value ownership of this scope when opted in.
+func (s Scope) ValueOwnerCoinDenom() string {
+	return s.ScopeId.String()
+}
+
 func (s Scope) ValidateOwnersBasic() error {
 	if err := ValidatePartiesBasic(s.Owners); err != nil {
 		return fmt.Errorf("invalid scope owners: %w", err)
@@ -299,6 +309,11 @@ func (r Record) ValidateBasic() error {
 	if err = r.Process.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid record process: %w", err)
 	}
+	for _, d := range r.DataAccess {
+		if _, err = sdk.AccAddressFromBech32(d); err != nil {
+			return fmt.Errorf("invalid address in data access on record: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -322,6 +337,63 @@ func (r Record) GetRecordAddress() MetadataAddress {
 	return MetadataAddress{}
 }
 
+// IsRedacted returns true if the output values of this record have been redacted.
+func (r Record) IsRedacted() bool {
+	return r.Redaction != nil
+}
+
+// Redact replaces the hash of each output with the redaction marker, keeping the base64 encoded sha256 hash of the
+// original hash so that a holder of the original value can still prove it was the one recorded.
+func (r *Record) Redact(reason string, redactedBy []string, height int64, redactedTime time.Time) {
+	for i, o := range r.Outputs {
+		if len(o.Hash) == 0 {
+			continue
+		}
+		sum := sha256.Sum256([]byte(o.Hash))
+		r.Outputs[i].RedactedHash = base64.StdEncoding.EncodeToString(sum[:])
+		r.Outputs[i].Hash = RedactedOutputHash
+	}
+	r.Redaction = &RecordRedaction{
+		Reason:       reason,
+		RedactedBy:   redactedBy,
+		Height:       height,
+		RedactedTime: redactedTime,
+	}
+}
+
+func (r *Record) RemoveDataAccess(addresses []string) {
+	newDataAccess := []string{}
+	for _, da := range r.DataAccess {
+		found := false
+		for _, addr := range addresses {
+			if addr == da {
+				found = true
+				break
+			}
+		}
+		if !found {
+			newDataAccess = append(newDataAccess, da)
+		}
+	}
+
+	r.DataAccess = newDataAccess
+}
+
+func (r *Record) AddDataAccess(addresses []string) {
+	for _, addr := range addresses {
+		found := false
+		for _, da := range r.DataAccess {
+			if addr == da {
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.DataAccess = append(r.DataAccess, addr)
+		}
+	}
+}
+
 // NewRecordInput creates new instance of RecordInput
 func NewRecordInput(name string, source isRecordInput_Source, typeName string, status RecordInputStatus) *RecordInput {
 	return &RecordInput{
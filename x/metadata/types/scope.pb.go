@@ -110,6 +110,9 @@ type Scope struct {
 	// An address that controls the value associated with this scope.  Standard blockchain accounts and marker accounts
 	// are supported for this value.  This attribute may only be changed by the entity indicated once it is set.
 	ValueOwnerAddress string `protobuf:"bytes,5,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty" yaml:"value_owner_address"`
+	// The number of times this scope, or one of its records, has been written.  This is maintained by the chain; any
+	// value provided when writing a scope is ignored.
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty" yaml:"version"`
 }
 
 func (m *Scope) Reset()      { *m = Scope{} }
@@ -165,12 +168,18 @@ func (m *Scope) GetValueOwnerAddress() string {
 	return ""
 }
 
+func (m *Scope) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// A Session is created for an execution context against a specific specification instance
 //
-//A Session is created for an execution context against a specific specification instance
-//
-//The context will have a specification and set of parties involved.  The Session may be updated several
-//times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
-//that reference a Session it is removed.
+// The context will have a specification and set of parties involved.  The Session may be updated several
+// times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
+// that reference a Session it is removed.
 type Session struct {
 	SessionId MetadataAddress `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" yaml:"session_id"`
 	// unique id of the contract specification that was used to create this session.
@@ -259,6 +268,12 @@ type Record struct {
 	Outputs []RecordOutput `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs"`
 	// specification_id is the id of the record specification that was used to create this record.
 	SpecificationId MetadataAddress `protobuf:"bytes,6,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// Addresses in this list are granted access to the data of this record.  When not empty, access to the record's
+	// data is restricted to these addresses and the scope owners.  Each address must be an owner or have data access
+	// on the scope.
+	DataAccess []string `protobuf:"bytes,7,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty" yaml:"data_access"`
+	// redaction is set once the output values of this record have been redacted, a redacted record cannot be updated.
+	Redaction *RecordRedaction `protobuf:"bytes,8,opt,name=redaction,proto3" json:"redaction,omitempty" yaml:"redaction,omitempty"`
 }
 
 func (m *Record) Reset()      { *m = Record{} }
@@ -321,6 +336,93 @@ func (m *Record) GetOutputs() []RecordOutput {
 	return nil
 }
 
+func (m *Record) GetDataAccess() []string {
+	if m != nil {
+		return m.DataAccess
+	}
+	return nil
+}
+
+func (m *Record) GetRedaction() *RecordRedaction {
+	if m != nil {
+		return m.Redaction
+	}
+	return nil
+}
+
+// RecordRedaction records when and why the output values of a record were redacted.
+type RecordRedaction struct {
+	// reason the record was redacted, e.g. a data erasure request.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the addresses of the accounts that signed the redaction.
+	RedactedBy []string `protobuf:"bytes,2,rep,name=redacted_by,json=redactedBy,proto3" json:"redacted_by,omitempty" yaml:"redacted_by"`
+	// the block height at which the record was redacted.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time at which the record was redacted.
+	RedactedTime time.Time `protobuf:"bytes,4,opt,name=redacted_time,json=redactedTime,proto3,stdtime" json:"redacted_time" yaml:"redacted_time"`
+}
+
+func (m *RecordRedaction) Reset()         { *m = RecordRedaction{} }
+func (m *RecordRedaction) String() string { return proto.CompactTextString(m) }
+func (*RecordRedaction) ProtoMessage()    {}
+func (*RecordRedaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
+}
+func (m *RecordRedaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordRedaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordRedaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordRedaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordRedaction.Merge(m, src)
+}
+func (m *RecordRedaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordRedaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordRedaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordRedaction proto.InternalMessageInfo
+
+func (m *RecordRedaction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RecordRedaction) GetRedactedBy() []string {
+	if m != nil {
+		return m.RedactedBy
+	}
+	return nil
+}
+
+func (m *RecordRedaction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecordRedaction) GetRedactedTime() time.Time {
+	if m != nil {
+		return m.RedactedTime
+	}
+	return time.Time{}
+}
+
 // Process contains information used to uniquely identify what was used to generate this record
 type Process struct {
 	// unique identifier for this process
@@ -338,7 +440,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{4}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +547,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{5}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,12 +641,15 @@ type RecordOutput struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	// Status of the process execution associated with this output indicating success,failure, or pending
 	Status ResultStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.metadata.v1.ResultStatus" json:"status,omitempty"`
+	// redacted_hash is the base64 encoded sha256 hash of the original hash of a redacted output, its hash is replaced
+	// with the redaction marker.
+	RedactedHash string `protobuf:"bytes,3,opt,name=redacted_hash,json=redactedHash,proto3" json:"redacted_hash,omitempty" yaml:"redacted_hash,omitempty"`
 }
 
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,6 +692,13 @@ func (m *RecordOutput) GetStatus() ResultStatus {
 	return ResultStatus_RESULT_STATUS_UNSPECIFIED
 }
 
+func (m *RecordOutput) GetRedactedHash() string {
+	if m != nil {
+		return m.RedactedHash
+	}
+	return ""
+}
+
 // A Party is an address with/in a given role associated with a contract
 type Party struct {
 	// address of the account (on chain)
@@ -598,7 +710,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +773,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,17 +844,181 @@ func (m *AuditFields) GetMessage() string {
 	return ""
 }
 
+// ScopeArchive is the stub kept for a scope whose sessions and records have been archived to an object store.  The
+// sessions and records can be restored by providing the archived content, which must match the content hash.
+type ScopeArchive struct {
+	// the id of the archived scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// content_hash is the base64 encoded sha256 hash of the archived ScopeArchiveContent.
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty" yaml:"content_hash"`
+	// locator_uri is the object store uri the archived content can be retrieved from.
+	LocatorUri string `protobuf:"bytes,3,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty" yaml:"locator_uri"`
+	// the number of sessions archived.
+	SessionCount uint32 `protobuf:"varint,4,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty" yaml:"session_count"`
+	// the number of records archived.
+	RecordCount uint32 `protobuf:"varint,5,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty" yaml:"record_count"`
+	// the addresses of the accounts that signed the archival.
+	ArchivedBy []string `protobuf:"bytes,6,rep,name=archived_by,json=archivedBy,proto3" json:"archived_by,omitempty" yaml:"archived_by"`
+	// the block height at which the scope was archived.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time at which the scope was archived.
+	ArchivedTime time.Time `protobuf:"bytes,8,opt,name=archived_time,json=archivedTime,proto3,stdtime" json:"archived_time" yaml:"archived_time"`
+}
+
+func (m *ScopeArchive) Reset()         { *m = ScopeArchive{} }
+func (m *ScopeArchive) String() string { return proto.CompactTextString(m) }
+func (*ScopeArchive) ProtoMessage()    {}
+func (*ScopeArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *ScopeArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeArchive.Merge(m, src)
+}
+func (m *ScopeArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeArchive proto.InternalMessageInfo
+
+func (m *ScopeArchive) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *ScopeArchive) GetLocatorUri() string {
+	if m != nil {
+		return m.LocatorUri
+	}
+	return ""
+}
+
+func (m *ScopeArchive) GetSessionCount() uint32 {
+	if m != nil {
+		return m.SessionCount
+	}
+	return 0
+}
+
+func (m *ScopeArchive) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *ScopeArchive) GetArchivedBy() []string {
+	if m != nil {
+		return m.ArchivedBy
+	}
+	return nil
+}
+
+func (m *ScopeArchive) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScopeArchive) GetArchivedTime() time.Time {
+	if m != nil {
+		return m.ArchivedTime
+	}
+	return time.Time{}
+}
+
+// ScopeArchiveContent is the archived content of a scope.  The content hash of a scope archive is the sha256 hash of
+// the encoded content, with the sessions and records ordered by id.
+type ScopeArchiveContent struct {
+	// the id of the archived scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// the archived sessions of the scope.
+	Sessions []Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions"`
+	// the archived records of the scope.
+	Records []Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ScopeArchiveContent) Reset()         { *m = ScopeArchiveContent{} }
+func (m *ScopeArchiveContent) String() string { return proto.CompactTextString(m) }
+func (*ScopeArchiveContent) ProtoMessage()    {}
+func (*ScopeArchiveContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *ScopeArchiveContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeArchiveContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeArchiveContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeArchiveContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeArchiveContent.Merge(m, src)
+}
+func (m *ScopeArchiveContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeArchiveContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeArchiveContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeArchiveContent proto.InternalMessageInfo
+
+func (m *ScopeArchiveContent) GetSessions() []Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *ScopeArchiveContent) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*RecordRedaction)(nil), "provenance.metadata.v1.RecordRedaction")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
+	proto.RegisterType((*ScopeArchive)(nil), "provenance.metadata.v1.ScopeArchive")
+	proto.RegisterType((*ScopeArchiveContent)(nil), "provenance.metadata.v1.ScopeArchiveContent")
 }
 
 func init() {
@@ -750,81 +1026,103 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1a, 0x57,
-	0x10, 0x67, 0x01, 0x83, 0x19, 0x68, 0x8d, 0x5f, 0x2c, 0x42, 0x68, 0xcc, 0xd2, 0x6d, 0xa5, 0xb8,
-	0x6e, 0x0a, 0xb5, 0xfb, 0x4f, 0x4a, 0xff, 0x89, 0xb5, 0xb1, 0x82, 0x92, 0xda, 0x68, 0x31, 0x97,
-	0x4a, 0x2d, 0x5a, 0x76, 0x9f, 0xf1, 0x2a, 0xc0, 0x5b, 0xed, 0x3e, 0x9c, 0xa0, 0xde, 0x2a, 0x55,
-	0x95, 0x7c, 0xca, 0x31, 0x17, 0x4b, 0xed, 0x07, 0xe8, 0xf7, 0xc8, 0x31, 0xc7, 0xaa, 0x87, 0x6d,
-	0x65, 0xdf, 0x7c, 0xe4, 0xd6, 0x5b, 0xb5, 0xef, 0xbd, 0x85, 0x25, 0x06, 0x2b, 0x55, 0x9b, 0xdb,
-	0xce, 0xcc, 0x6f, 0xe6, 0xcd, 0xfc, 0x66, 0xde, 0xbc, 0x05, 0xc5, 0x76, 0xc8, 0x09, 0x1e, 0xe8,
-	0x03, 0x03, 0x57, 0xfa, 0x98, 0xea, 0xa6, 0x4e, 0xf5, 0xca, 0xc9, 0x56, 0xc5, 0x35, 0x88, 0x8d,
-	0xcb, 0xb6, 0x43, 0x28, 0x41, 0xb9, 0x29, 0xa6, 0x1c, 0x60, 0xca, 0x27, 0x5b, 0x85, 0xb5, 0x2e,
-	0xe9, 0x12, 0x06, 0xa9, 0xf8, 0x5f, 0x1c, 0x5d, 0x90, 0xbb, 0x84, 0x74, 0x7b, 0xb8, 0xc2, 0xa4,
-	0xce, 0xf0, 0xa8, 0x42, 0xad, 0x3e, 0x76, 0xa9, 0xde, 0xb7, 0x05, 0xa0, 0xf4, 0x32, 0xc0, 0xc4,
-	0xae, 0xe1, 0x58, 0x36, 0x25, 0x8e, 0x40, 0x6c, 0x2e, 0x4a, 0xca, 0xc6, 0x86, 0x75, 0x64, 0x19,
-	0x3a, 0xb5, 0xc8, 0x80, 0x63, 0x95, 0xbf, 0xa3, 0xb0, 0xd4, 0xf4, 0x93, 0x45, 0x35, 0x58, 0x66,
-	0x59, 0xb7, 0x2d, 0x33, 0x2f, 0x95, 0xa4, 0x8d, 0x8c, 0xba, 0xf9, 0xdc, 0x93, 0x23, 0x7f, 0x78,
-	0xf2, 0xca, 0x37, 0x22, 0x48, 0xd5, 0x34, 0x1d, 0xec, 0xba, 0x63, 0x4f, 0x5e, 0x19, 0xe9, 0xfd,
-	0xde, 0x3d, 0x25, 0x70, 0x50, 0xb4, 0x24, 0xfb, 0xac, 0x9b, 0xe8, 0x3b, 0xc8, 0xce, 0x9c, 0xe3,
-	0x87, 0x8b, 0xb2, 0x70, 0xdb, 0x8b, 0xc3, 0xdd, 0x14, 0xe1, 0x5e, 0x72, 0x54, 0xb4, 0x95, 0x19,
-	0x55, 0xdd, 0x44, 0x9f, 0x43, 0x82, 0x3c, 0x1e, 0x60, 0xc7, 0xcd, 0xc7, 0x4a, 0xb1, 0x8d, 0xf4,
-	0xf6, 0x7a, 0x79, 0x3e, 0xbb, 0xe5, 0x86, 0xee, 0xd0, 0x91, 0x1a, 0xf7, 0xcf, 0xd4, 0x84, 0x0b,
-	0xfa, 0x0c, 0xd2, 0xbe, 0xb9, 0xad, 0x1b, 0x06, 0x76, 0xdd, 0x7c, 0xbc, 0x14, 0xdb, 0x48, 0xa9,
-	0xb9, 0xb1, 0x27, 0x23, 0x7e, 0x7e, 0xc8, 0xa8, 0x68, 0xc0, 0x52, 0x64, 0x02, 0xda, 0x87, 0x1b,
-	0x27, 0x7a, 0x6f, 0x88, 0xdb, 0x2c, 0x50, 0x5b, 0xe7, 0x89, 0xe7, 0x97, 0x4a, 0xd2, 0x46, 0x4a,
-	0x2d, 0x8e, 0x3d, 0xb9, 0xc0, 0x03, 0xcc, 0x01, 0x29, 0xda, 0x2a, 0xd3, 0x1e, 0xf8, 0x4a, 0x51,
-	0xf1, 0xbd, 0xf8, 0xb3, 0x5f, 0xe4, 0x88, 0xf2, 0x2c, 0x06, 0xc9, 0x26, 0x76, 0x5d, 0x8b, 0x0c,
-	0xd0, 0x03, 0x00, 0x97, 0x7f, 0x4e, 0xf9, 0xbf, 0xbb, 0x98, 0xb0, 0x55, 0x41, 0xd8, 0xc4, 0x45,
-	0xd1, 0x52, 0x42, 0x78, 0xfd, 0x3d, 0xf8, 0x12, 0x92, 0xb6, 0xee, 0x50, 0x0b, 0xff, 0xab, 0x26,
-	0x04, 0x3e, 0xe8, 0x7d, 0x88, 0x0f, 0xf4, 0x3e, 0xce, 0xc7, 0x19, 0x7b, 0x37, 0x2f, 0x3d, 0x39,
-	0x4e, 0x47, 0x36, 0x1e, 0x7b, 0x72, 0x9a, 0xa7, 0xe0, 0x4b, 0x8a, 0xc6, 0x40, 0x28, 0x0f, 0x49,
-	0x83, 0x0c, 0x28, 0x7e, 0x42, 0x19, 0xdb, 0x19, 0x2d, 0x10, 0x51, 0x0b, 0x96, 0xf4, 0xa1, 0x69,
-	0xd1, 0xbc, 0x51, 0x92, 0x36, 0xd2, 0xdb, 0xef, 0x2c, 0xca, 0xa1, 0xea, 0x83, 0xf6, 0x2c, 0xdc,
-	0x33, 0x5d, 0xb5, 0x30, 0xf6, 0xe4, 0x1c, 0x3f, 0x84, 0xf9, 0xde, 0x25, 0x7d, 0x8b, 0xe2, 0xbe,
-	0x4d, 0x47, 0x8a, 0xc6, 0xa3, 0x89, 0xd6, 0xfc, 0x16, 0x83, 0x84, 0x86, 0x0d, 0xe2, 0x98, 0xe8,
-	0x8e, 0x48, 0x57, 0x62, 0xe9, 0xde, 0xb8, 0xf4, 0xe4, 0xa8, 0x65, 0x8e, 0x3d, 0x39, 0xc5, 0xe3,
-	0xf8, 0x0c, 0xf1, 0x54, 0x67, 0x5b, 0x18, 0xfd, 0x6f, 0x2d, 0xfc, 0x1a, 0x92, 0xb6, 0x43, 0xd8,
-	0x98, 0xc6, 0x58, 0x7d, 0xf2, 0x42, 0x8e, 0x39, 0x6c, 0xc2, 0x32, 0x17, 0x51, 0x15, 0x12, 0xd6,
-	0xc0, 0x1e, 0x52, 0x3e, 0xe6, 0xd7, 0xf0, 0xc3, 0xcb, 0xac, 0xfb, 0xd8, 0xe0, 0xba, 0x70, 0x47,
-	0xb4, 0x0b, 0x49, 0x32, 0xa4, 0x2c, 0xc6, 0x12, 0x8b, 0xf1, 0xee, 0xf5, 0x31, 0x0e, 0x18, 0x38,
-	0x48, 0x44, 0xb8, 0xce, 0x1d, 0xc6, 0xc4, 0xff, 0x36, 0x8c, 0xa2, 0x5f, 0x3f, 0x40, 0x52, 0xf0,
-	0x80, 0x0a, 0x90, 0x0c, 0xee, 0x27, 0x6b, 0xd9, 0xfd, 0x88, 0x16, 0x28, 0xd0, 0x1a, 0xc4, 0x8f,
-	0x75, 0xf7, 0x98, 0x35, 0xc7, 0x37, 0x30, 0x09, 0x21, 0xd1, 0x61, 0x9f, 0xe8, 0x94, 0x68, 0x66,
-	0x0e, 0x12, 0x7d, 0x4c, 0x8f, 0x89, 0xc9, 0xc7, 0x54, 0x13, 0x12, 0x3f, 0x4e, 0xcd, 0x00, 0x08,
-	0x9e, 0xfd, 0xa4, 0x7e, 0x8a, 0x42, 0x3a, 0xc4, 0xe2, 0x24, 0x9e, 0x14, 0x8a, 0xb7, 0x07, 0x29,
-	0x87, 0x41, 0xa6, 0xb3, 0x71, 0x67, 0x7e, 0xe9, 0x59, 0x5e, 0xfa, 0x04, 0xad, 0xdc, 0x8f, 0x68,
-	0xcb, 0x5c, 0xaa, 0x9b, 0x93, 0x0a, 0x62, 0x33, 0x15, 0x6c, 0x41, 0xca, 0xbf, 0x34, 0xed, 0xd0,
-	0xbd, 0x5a, 0x9b, 0x86, 0x9a, 0x98, 0x14, 0x6d, 0xd9, 0xff, 0xde, 0xf7, 0x13, 0xaa, 0x42, 0xc2,
-	0xa5, 0x3a, 0x1d, 0xf2, 0x2d, 0xf6, 0xe6, 0xf6, 0x7b, 0xaf, 0x30, 0x1f, 0x4d, 0xe6, 0xa0, 0x09,
-	0x47, 0xc1, 0xc5, 0x32, 0x24, 0x5c, 0x32, 0x74, 0x0c, 0xac, 0x1c, 0x41, 0x26, 0x3c, 0x08, 0x3e,
-	0x0f, 0x2c, 0x57, 0xc1, 0x03, 0xcb, 0xf4, 0x8b, 0xc9, 0xb1, 0x51, 0x76, 0xec, 0x35, 0x23, 0xe5,
-	0x0e, 0x7b, 0x73, 0x4f, 0x54, 0xbe, 0x87, 0x25, 0xb6, 0x58, 0xfc, 0xe5, 0x30, 0xd3, 0xea, 0x69,
-	0xa3, 0x3f, 0x81, 0xb8, 0x43, 0x7a, 0x58, 0x1c, 0xf2, 0xf6, 0xb5, 0xfb, 0xe9, 0x70, 0x64, 0x63,
-	0x8d, 0xc1, 0x45, 0xfc, 0x9f, 0xe3, 0x90, 0x0e, 0x6d, 0x0d, 0xf4, 0xa3, 0x04, 0x19, 0xc3, 0xc1,
-	0x3a, 0xc5, 0x66, 0xdb, 0xd4, 0x29, 0x6f, 0x6c, 0x7a, 0xbb, 0x50, 0xe6, 0x2f, 0x71, 0x39, 0x78,
-	0x89, 0xcb, 0x87, 0xc1, 0x53, 0xad, 0xee, 0xf8, 0xa3, 0x7d, 0xe9, 0xc9, 0xb9, 0xb0, 0xdf, 0x74,
-	0xdb, 0x8c, 0x3d, 0x79, 0x9d, 0xf7, 0x66, 0xbe, 0x5d, 0x79, 0xfa, 0xa7, 0x2c, 0x69, 0x69, 0x61,
-	0xdc, 0xd5, 0x29, 0x46, 0x5f, 0x01, 0x04, 0xd8, 0xce, 0x88, 0x0f, 0xb0, 0x2a, 0x8f, 0x3d, 0xf9,
-	0xad, 0xd9, 0x38, 0x9d, 0x51, 0x78, 0xa7, 0xa5, 0x84, 0x5a, 0x1d, 0xb1, 0x22, 0x86, 0xb6, 0x39,
-	0x2d, 0x22, 0xf6, 0xea, 0x45, 0x84, 0xfd, 0xe6, 0x15, 0x31, 0xdf, 0x2e, 0x8a, 0x10, 0xc6, 0xa0,
-	0x88, 0x00, 0xdb, 0x19, 0x89, 0x41, 0x0d, 0x15, 0x31, 0xb5, 0xcd, 0x14, 0x21, 0xd4, 0xea, 0x08,
-	0x7d, 0x0a, 0xc9, 0x13, 0xec, 0xf8, 0x2b, 0x92, 0x4d, 0xed, 0x1b, 0xea, 0xed, 0xb1, 0x27, 0xe7,
-	0xc5, 0xdb, 0xcb, 0x0d, 0x61, 0xcf, 0x00, 0xec, 0xfb, 0xf5, 0xb1, 0xeb, 0xea, 0x5d, 0xcc, 0x56,
-	0x4f, 0x2a, 0xec, 0x27, 0x0c, 0x33, 0x7e, 0x42, 0xb7, 0xf9, 0xab, 0x04, 0xab, 0x57, 0xe6, 0x1f,
-	0x7d, 0x08, 0xb2, 0x56, 0xdb, 0x39, 0xd0, 0x76, 0xdb, 0xf5, 0xfd, 0x46, 0xeb, 0xb0, 0xdd, 0x3c,
-	0xac, 0x1e, 0xb6, 0x9a, 0xed, 0xd6, 0x7e, 0xb3, 0x51, 0xdb, 0xa9, 0xef, 0xd5, 0x6b, 0xbb, 0xd9,
-	0x48, 0x21, 0x7d, 0x7a, 0x56, 0x4a, 0xb6, 0x06, 0x8f, 0x06, 0xe4, 0xf1, 0x00, 0x95, 0xe1, 0xf6,
-	0x3c, 0x8f, 0x86, 0x76, 0xd0, 0x38, 0x68, 0xd6, 0x76, 0xb3, 0x52, 0x21, 0x73, 0x7a, 0x56, 0x5a,
-	0x6e, 0x38, 0xc4, 0x26, 0x2e, 0x36, 0xd1, 0x26, 0x14, 0xe6, 0xe1, 0xb9, 0x2e, 0x1b, 0x2d, 0xc0,
-	0xe9, 0x59, 0x49, 0xbc, 0x4f, 0x9b, 0x43, 0xff, 0xd6, 0x4d, 0xef, 0x0a, 0x5a, 0x87, 0x5b, 0x5a,
-	0xad, 0xd9, 0x7a, 0x38, 0x3f, 0x2f, 0x94, 0x03, 0x34, 0x6b, 0x6e, 0x54, 0x9b, 0xcd, 0xac, 0x74,
-	0x55, 0xdf, 0x7c, 0x50, 0x6f, 0x64, 0xa3, 0x57, 0xf5, 0x7b, 0xd5, 0xfa, 0xc3, 0x6c, 0x4c, 0x7d,
-	0xf4, 0xfc, 0xbc, 0x28, 0xbd, 0x38, 0x2f, 0x4a, 0x7f, 0x9d, 0x17, 0xa5, 0xa7, 0x17, 0xc5, 0xc8,
-	0x8b, 0x8b, 0x62, 0xe4, 0xf7, 0x8b, 0x62, 0x04, 0x6e, 0x59, 0x64, 0xc1, 0x7d, 0x6b, 0x48, 0xdf,
-	0x7e, 0xdc, 0xb5, 0xe8, 0xf1, 0xb0, 0x53, 0x36, 0x48, 0xbf, 0x32, 0x05, 0x7d, 0x60, 0x91, 0x90,
-	0x54, 0x79, 0x32, 0xfd, 0x6d, 0xf5, 0xf7, 0x95, 0xdb, 0x49, 0xb0, 0xe9, 0xfc, 0xe8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x99, 0x3c, 0x21, 0x36, 0x6f, 0x0b, 0x00, 0x00,
+	// 1524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0x1a, 0x57,
+	0x17, 0x67, 0x00, 0x03, 0x3e, 0xe0, 0x98, 0x5c, 0x5b, 0x0e, 0xe1, 0x4b, 0x18, 0xbe, 0x69, 0xa5,
+	0xb8, 0x6e, 0x0a, 0x8d, 0xfb, 0x88, 0x94, 0x36, 0xa9, 0x18, 0x3f, 0x1a, 0x94, 0xd4, 0x46, 0x17,
+	0x7b, 0x53, 0xa9, 0x45, 0xe3, 0x99, 0x1b, 0x33, 0x0a, 0x70, 0xd1, 0xcc, 0xe0, 0x04, 0x75, 0x57,
+	0xa9, 0xaa, 0x94, 0x55, 0x96, 0xd9, 0x44, 0x6a, 0x57, 0x5d, 0xf6, 0xdf, 0xc8, 0xa6, 0x52, 0x96,
+	0x55, 0xa5, 0x4e, 0xaa, 0x64, 0x97, 0x25, 0xbb, 0xee, 0xaa, 0xfb, 0x18, 0x66, 0x20, 0x18, 0x25,
+	0x6a, 0xba, 0xe3, 0x3c, 0x7e, 0x67, 0xce, 0xfb, 0x5c, 0x40, 0xeb, 0x3b, 0xf4, 0x84, 0xf4, 0x8c,
+	0x9e, 0x49, 0xaa, 0x5d, 0xe2, 0x19, 0x96, 0xe1, 0x19, 0xd5, 0x93, 0x2b, 0x55, 0xd7, 0xa4, 0x7d,
+	0x52, 0xe9, 0x3b, 0xd4, 0xa3, 0x68, 0x2d, 0xd4, 0xa9, 0x04, 0x3a, 0x95, 0x93, 0x2b, 0xc5, 0xd5,
+	0x63, 0x7a, 0x4c, 0xb9, 0x4a, 0x95, 0xfd, 0x12, 0xda, 0x45, 0xf5, 0x98, 0xd2, 0xe3, 0x0e, 0xa9,
+	0x72, 0xea, 0x68, 0x70, 0xa7, 0xea, 0xd9, 0x5d, 0xe2, 0x7a, 0x46, 0xb7, 0x2f, 0x15, 0xca, 0xd3,
+	0x0a, 0x16, 0x71, 0x4d, 0xc7, 0xee, 0x7b, 0xd4, 0x91, 0x1a, 0x1b, 0xa7, 0x39, 0xd5, 0x27, 0xa6,
+	0x7d, 0xc7, 0x36, 0x0d, 0xcf, 0xa6, 0x3d, 0xa1, 0xab, 0xfd, 0x92, 0x80, 0x85, 0x26, 0x73, 0x16,
+	0xed, 0x40, 0x86, 0x7b, 0xdd, 0xb2, 0xad, 0x82, 0x52, 0x56, 0xd6, 0x73, 0xfa, 0xc6, 0x13, 0x5f,
+	0x8d, 0xfd, 0xe1, 0xab, 0xcb, 0x5f, 0x49, 0x23, 0x35, 0xcb, 0x72, 0x88, 0xeb, 0x8e, 0x7c, 0x75,
+	0x79, 0x68, 0x74, 0x3b, 0xd7, 0xb4, 0x00, 0xa0, 0xe1, 0x34, 0xff, 0x59, 0xb7, 0xd0, 0x37, 0x90,
+	0x9f, 0xf8, 0x0e, 0x33, 0x17, 0xe7, 0xe6, 0x36, 0x4f, 0x37, 0x77, 0x4e, 0x9a, 0x9b, 0x02, 0x6a,
+	0x78, 0x79, 0x82, 0x55, 0xb7, 0xd0, 0x67, 0x90, 0xa2, 0xf7, 0x7a, 0xc4, 0x71, 0x0b, 0x89, 0x72,
+	0x62, 0x3d, 0xbb, 0x79, 0xb1, 0x32, 0x3b, 0xbb, 0x95, 0x86, 0xe1, 0x78, 0x43, 0x3d, 0xc9, 0xbe,
+	0x89, 0x25, 0x04, 0x5d, 0x85, 0x2c, 0x13, 0xb7, 0x0c, 0xd3, 0x24, 0xae, 0x5b, 0x48, 0x96, 0x13,
+	0xeb, 0x8b, 0xfa, 0xda, 0xc8, 0x57, 0x91, 0xf8, 0x7e, 0x44, 0xa8, 0x61, 0xe0, 0x2e, 0x72, 0x02,
+	0xed, 0xc1, 0xca, 0x89, 0xd1, 0x19, 0x90, 0x16, 0x37, 0xd4, 0x32, 0x84, 0xe3, 0x85, 0x85, 0xb2,
+	0xb2, 0xbe, 0xa8, 0x97, 0x46, 0xbe, 0x5a, 0x14, 0x06, 0x66, 0x28, 0x69, 0xf8, 0x2c, 0xe7, 0xee,
+	0x33, 0xa6, 0x8c, 0x18, 0x5d, 0x86, 0xf4, 0x09, 0x71, 0x5c, 0x9b, 0xf6, 0x0a, 0xa9, 0xb2, 0xb2,
+	0x9e, 0xd4, 0xd1, 0xc8, 0x57, 0xcf, 0x48, 0x1b, 0x42, 0xa0, 0xe1, 0x40, 0xe5, 0x5a, 0xf2, 0xd1,
+	0x4f, 0x6a, 0x4c, 0x7b, 0x94, 0x80, 0x74, 0x93, 0xb8, 0x8c, 0x83, 0x6e, 0x01, 0xb8, 0xe2, 0x67,
+	0x58, 0xad, 0xcb, 0xa7, 0xa7, 0xf7, 0xac, 0x4c, 0xef, 0x18, 0xa2, 0xe1, 0x45, 0x49, 0xfc, 0xf7,
+	0x15, 0xbb, 0x0e, 0xe9, 0xbe, 0xe1, 0x78, 0x36, 0x79, 0xa3, 0x92, 0x05, 0x18, 0xf4, 0x3e, 0x24,
+	0x7b, 0x46, 0x97, 0x14, 0x92, 0x3c, 0xd7, 0xe7, 0x5e, 0xfa, 0x6a, 0xd2, 0x1b, 0xf6, 0xc9, 0xc8,
+	0x57, 0xb3, 0xc2, 0x05, 0x46, 0x69, 0x98, 0x2b, 0xa1, 0x02, 0xa4, 0x4d, 0xda, 0xf3, 0xc8, 0x7d,
+	0x8f, 0xd7, 0x26, 0x87, 0x03, 0x12, 0x1d, 0xc2, 0x82, 0x31, 0xb0, 0x6c, 0xaf, 0x60, 0x96, 0x95,
+	0xf5, 0xec, 0xe6, 0x3b, 0xa7, 0xf9, 0x50, 0x63, 0x4a, 0xbb, 0x36, 0xe9, 0x58, 0xae, 0x5e, 0x1c,
+	0xf9, 0xea, 0x9a, 0xf8, 0x08, 0xc7, 0x5e, 0xa6, 0x5d, 0xdb, 0x23, 0xdd, 0xbe, 0x37, 0xd4, 0xb0,
+	0xb0, 0x26, 0x4b, 0xf3, 0x5b, 0x12, 0x52, 0x98, 0x98, 0xd4, 0xb1, 0xd0, 0x25, 0xe9, 0xae, 0xc2,
+	0xdd, 0x5d, 0x79, 0xe9, 0xab, 0x71, 0xdb, 0x1a, 0xf9, 0xea, 0xa2, 0xb0, 0xc3, 0x32, 0x24, 0x5c,
+	0x9d, 0x2c, 0x61, 0xfc, 0xdf, 0x95, 0xf0, 0x0b, 0x48, 0xf7, 0x1d, 0xca, 0x9b, 0x3a, 0xc1, 0xe3,
+	0x53, 0x4f, 0xcd, 0xb1, 0x50, 0x1b, 0x67, 0x59, 0x90, 0xa8, 0x06, 0x29, 0xbb, 0xd7, 0x1f, 0x78,
+	0x62, 0x28, 0xe6, 0xe4, 0x47, 0x84, 0x59, 0x67, 0xba, 0xc1, 0x70, 0x09, 0x20, 0xda, 0x86, 0x34,
+	0x1d, 0x78, 0xdc, 0xc6, 0x02, 0xb7, 0xf1, 0xee, 0x7c, 0x1b, 0xfb, 0x5c, 0x39, 0x70, 0x44, 0x42,
+	0x67, 0x36, 0x63, 0xea, 0xed, 0x35, 0xe3, 0xd4, 0x06, 0x48, 0xbf, 0xf6, 0x06, 0x20, 0xb0, 0xe8,
+	0x10, 0xcb, 0x30, 0x99, 0x9d, 0x42, 0x86, 0xe7, 0xf8, 0xd2, 0xfc, 0xf8, 0x70, 0xa0, 0x1e, 0x5d,
+	0x10, 0x63, 0x1b, 0xd1, 0x5e, 0x0a, 0x2d, 0xcb, 0x7e, 0xfa, 0x53, 0x81, 0xe5, 0x29, 0x23, 0x68,
+	0x0d, 0x52, 0x0e, 0x31, 0x5c, 0xda, 0x13, 0xad, 0x85, 0x25, 0xc5, 0x22, 0x12, 0x70, 0x62, 0xb5,
+	0x8e, 0x86, 0x85, 0xf8, 0x74, 0x44, 0x11, 0xa1, 0x86, 0x21, 0xa0, 0xf4, 0x21, 0x33, 0xd8, 0x26,
+	0xf6, 0x71, 0xdb, 0xe3, 0x2d, 0x93, 0xc0, 0x92, 0x42, 0x06, 0x2c, 0x8d, 0x31, 0xec, 0xf6, 0xf0,
+	0xc9, 0xcb, 0x6e, 0x16, 0x2b, 0xe2, 0xee, 0x54, 0x82, 0xbb, 0x53, 0x39, 0x08, 0x0e, 0x93, 0x5e,
+	0x66, 0xa5, 0x19, 0xf9, 0xea, 0xea, 0xd4, 0x27, 0x19, 0x5c, 0x7b, 0xf8, 0x4c, 0x55, 0x70, 0x2e,
+	0xe0, 0x31, 0x90, 0xf6, 0x1d, 0xa4, 0x65, 0x1f, 0xa2, 0x22, 0xa4, 0x83, 0x6d, 0xca, 0xe3, 0xba,
+	0x19, 0xc3, 0x01, 0x03, 0xad, 0x42, 0xb2, 0x6d, 0xb8, 0x6d, 0x3e, 0x1c, 0x4c, 0xc0, 0x29, 0x84,
+	0xe4, 0x84, 0x25, 0x78, 0x1a, 0xc4, 0x30, 0xad, 0x41, 0xaa, 0x4b, 0xbc, 0x36, 0xb5, 0xc4, 0x9a,
+	0xc0, 0x92, 0x12, 0xe9, 0xd4, 0x73, 0x00, 0xb2, 0xcf, 0x59, 0x53, 0xfc, 0x10, 0x87, 0x6c, 0xa4,
+	0x8b, 0xc7, 0xf6, 0x94, 0x88, 0xbd, 0x5d, 0x56, 0x6d, 0xa6, 0x12, 0xce, 0xe6, 0xa5, 0xd9, 0xad,
+	0x97, 0x0f, 0x42, 0x96, 0xda, 0xda, 0xcd, 0x18, 0xce, 0x08, 0xaa, 0x6e, 0x8d, 0x23, 0x48, 0x4c,
+	0x44, 0x70, 0x05, 0x16, 0xd9, 0xd2, 0x6a, 0x45, 0xf6, 0xda, 0x6a, 0x68, 0x6a, 0x2c, 0xd2, 0x70,
+	0x86, 0xfd, 0xde, 0x63, 0x0e, 0xd5, 0x20, 0xe5, 0x7a, 0x86, 0x37, 0x10, 0x37, 0xe7, 0xcc, 0xe6,
+	0x7b, 0xaf, 0x31, 0x9f, 0x4d, 0x0e, 0xc0, 0x12, 0x28, 0x73, 0x91, 0x81, 0x94, 0x4b, 0x07, 0x8e,
+	0x49, 0xb4, 0x5f, 0x15, 0xc8, 0x45, 0x27, 0x91, 0x25, 0x82, 0x3b, 0x2b, 0x13, 0xc1, 0x5d, 0xfd,
+	0x7c, 0xfc, 0xdd, 0x38, 0xff, 0xee, 0x9c, 0x99, 0x76, 0x07, 0x9d, 0xa9, 0x4f, 0xa2, 0x2f, 0x23,
+	0xad, 0x14, 0xe6, 0x41, 0xd7, 0x46, 0xbe, 0x5a, 0x9a, 0x6a, 0x15, 0x26, 0x8e, 0xce, 0xc4, 0xb8,
+	0x61, 0x6e, 0x1a, 0x6e, 0x5b, 0x8e, 0xc5, 0xb7, 0xb0, 0xc0, 0x4f, 0x04, 0x5b, 0xf3, 0x13, 0x4d,
+	0x13, 0xb6, 0xcc, 0x27, 0x90, 0x74, 0x68, 0x87, 0x48, 0x6f, 0xff, 0x3f, 0xf7, 0xd2, 0x1c, 0x0c,
+	0xfb, 0x04, 0x73, 0x75, 0x69, 0xff, 0xc7, 0x24, 0x64, 0x23, 0xfb, 0x1f, 0x7d, 0xaf, 0x40, 0xce,
+	0x74, 0x88, 0xc1, 0xfc, 0xb3, 0x0c, 0x4f, 0xb4, 0xc8, 0xfc, 0x49, 0xd8, 0x62, 0x93, 0xf0, 0xd2,
+	0x57, 0xd7, 0xa2, 0xb8, 0x30, 0xae, 0x91, 0xaf, 0x5e, 0x14, 0x81, 0xcf, 0x96, 0x8b, 0x61, 0xc9,
+	0x4a, 0xe1, 0xb6, 0xe1, 0x11, 0x74, 0x03, 0x20, 0xd0, 0xe5, 0xe3, 0xcd, 0x12, 0xa8, 0x8e, 0x7c,
+	0xf5, 0x7f, 0x93, 0x76, 0x8e, 0x86, 0x13, 0x1b, 0x45, 0xb2, 0xf5, 0x21, 0x0f, 0x62, 0xd0, 0xb7,
+	0xc2, 0x20, 0x12, 0xaf, 0x1f, 0x44, 0x14, 0x37, 0x2b, 0x88, 0xd9, 0x72, 0x19, 0x84, 0x14, 0x06,
+	0x41, 0x04, 0xba, 0x47, 0x43, 0xd9, 0xf2, 0x91, 0x20, 0x42, 0xd9, 0x44, 0x10, 0x92, 0xad, 0x0f,
+	0xd1, 0xa7, 0xe1, 0x7b, 0x89, 0xf5, 0xff, 0x92, 0x7e, 0x61, 0xe4, 0xab, 0x85, 0x89, 0xf7, 0x52,
+	0x14, 0x19, 0x28, 0x33, 0x5c, 0x97, 0xb8, 0xae, 0x71, 0x4c, 0xf8, 0x11, 0x59, 0x8c, 0xe2, 0xa4,
+	0x60, 0x02, 0x27, 0x79, 0xda, 0xdf, 0x09, 0xc8, 0xf1, 0x57, 0x71, 0xcd, 0x31, 0xdb, 0xf6, 0xc9,
+	0x5b, 0x7b, 0x1c, 0x5f, 0x83, 0x1c, 0x7f, 0x90, 0xf4, 0xbc, 0x56, 0xb8, 0xd9, 0xf4, 0x73, 0x23,
+	0x5f, 0x5d, 0x91, 0xe5, 0x8c, 0x48, 0x35, 0x9c, 0x95, 0x24, 0x9b, 0x01, 0xb6, 0xe8, 0x3b, 0xd4,
+	0x34, 0x3c, 0xea, 0xb4, 0x06, 0x8e, 0x2d, 0x47, 0x29, 0xb2, 0xe8, 0x23, 0x42, 0x0d, 0x83, 0xa4,
+	0x0e, 0x1d, 0x1b, 0x5d, 0x87, 0xa5, 0xe0, 0xd9, 0x60, 0xd2, 0x41, 0xcf, 0xe3, 0xf9, 0x5f, 0xd2,
+	0x0b, 0xe1, 0xc2, 0x9e, 0x10, 0x6b, 0x38, 0x27, 0xe9, 0x2d, 0x46, 0x32, 0x9f, 0xe5, 0x76, 0x13,
+	0x68, 0x51, 0x80, 0x88, 0xcf, 0x51, 0xa9, 0x86, 0xb3, 0x82, 0x14, 0xd8, 0xab, 0x90, 0x35, 0x44,
+	0x06, 0x79, 0xe1, 0x53, 0xd3, 0xc7, 0x29, 0x22, 0xd4, 0x30, 0x04, 0xd4, 0xc4, 0x71, 0x4a, 0x4f,
+	0x1f, 0xa7, 0x31, 0x86, 0x1f, 0xa7, 0xcc, 0x9b, 0x1e, 0xa7, 0x09, 0xb8, 0x3c, 0x4e, 0x01, 0x8f,
+	0x1f, 0xa7, 0x67, 0x0a, 0xac, 0x44, 0x6b, 0xbf, 0x25, 0x6a, 0xf0, 0xb6, 0x5a, 0xa0, 0x06, 0x19,
+	0x99, 0x5e, 0x97, 0x1f, 0xeb, 0x39, 0x6f, 0x35, 0xf9, 0xda, 0x97, 0x4f, 0xa4, 0x31, 0x0c, 0xdd,
+	0x80, 0xb4, 0x48, 0x72, 0xf0, 0xa2, 0x2e, 0xcd, 0xbf, 0x06, 0xc1, 0x1b, 0x4b, 0x82, 0x36, 0x7e,
+	0x56, 0xe0, 0xec, 0x2b, 0x77, 0x02, 0x7d, 0x08, 0x2a, 0xde, 0xd9, 0xda, 0xc7, 0xdb, 0xad, 0xfa,
+	0x5e, 0xe3, 0xf0, 0xa0, 0xd5, 0x3c, 0xa8, 0x1d, 0x1c, 0x36, 0x5b, 0x87, 0x7b, 0xcd, 0xc6, 0xce,
+	0x56, 0x7d, 0xb7, 0xbe, 0xb3, 0x9d, 0x8f, 0x15, 0xb3, 0x0f, 0x1e, 0x97, 0xd3, 0x87, 0xbd, 0xbb,
+	0x3d, 0x7a, 0xaf, 0x87, 0x2a, 0x70, 0x61, 0x16, 0xa2, 0x81, 0xf7, 0x1b, 0xfb, 0xcd, 0x9d, 0xed,
+	0xbc, 0x52, 0xcc, 0x3d, 0x78, 0x5c, 0xce, 0x34, 0x1c, 0xda, 0xa7, 0x2e, 0xb1, 0xd0, 0x06, 0x14,
+	0x67, 0xe9, 0x0b, 0x5e, 0x3e, 0x5e, 0x84, 0x07, 0x8f, 0xcb, 0xf2, 0x1d, 0xbd, 0x31, 0x60, 0xc7,
+	0x29, 0x3c, 0x29, 0xe8, 0x22, 0x9c, 0xc7, 0x3b, 0xcd, 0xc3, 0xdb, 0xb3, 0xfd, 0x42, 0x6b, 0x80,
+	0x26, 0xc5, 0x8d, 0x5a, 0xb3, 0x99, 0x57, 0x5e, 0xe5, 0x37, 0x6f, 0xd5, 0x1b, 0xf9, 0xf8, 0xab,
+	0xfc, 0xdd, 0x5a, 0xfd, 0x76, 0x3e, 0xa1, 0xdf, 0x7d, 0xf2, 0xbc, 0xa4, 0x3c, 0x7d, 0x5e, 0x52,
+	0xfe, 0x7a, 0x5e, 0x52, 0x1e, 0xbe, 0x28, 0xc5, 0x9e, 0xbe, 0x28, 0xc5, 0x7e, 0x7f, 0x51, 0x8a,
+	0xc1, 0x79, 0x9b, 0x9e, 0x92, 0xe5, 0x86, 0xf2, 0xf5, 0xc7, 0xc7, 0xb6, 0xd7, 0x1e, 0x1c, 0x55,
+	0x4c, 0xda, 0xad, 0x86, 0x4a, 0x1f, 0xd8, 0x34, 0x42, 0x55, 0xef, 0x87, 0x7f, 0xc6, 0xd9, 0x5d,
+	0x77, 0x8f, 0x52, 0xbc, 0x5b, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xec, 0x2e, 0x3c,
+	0x45, 0x10, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -847,6 +1145,11 @@ func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ValueOwnerAddress) > 0 {
 		i -= len(m.ValueOwnerAddress)
 		copy(dAtA[i:], m.ValueOwnerAddress)
@@ -1005,6 +1308,27 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Redaction != nil {
+		{
+			size, err := m.Redaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size := m.SpecificationId.Size()
 		i -= size
@@ -1073,6 +1397,58 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordRedaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRedaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRedaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RedactedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RedactedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RedactedBy) > 0 {
+		for iNdEx := len(m.RedactedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedactedBy[iNdEx])
+			copy(dAtA[i:], m.RedactedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.RedactedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1251,6 +1627,13 @@ func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RedactedHash) > 0 {
+		i -= len(m.RedactedHash)
+		copy(dAtA[i:], m.RedactedHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.RedactedHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Status != 0 {
 		i = encodeVarintScope(dAtA, i, uint64(m.Status))
 		i--
@@ -1340,12 +1723,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1355,42 +1738,182 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScopeArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Scope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScopeArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ArchivedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintScope(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ArchivedBy) > 0 {
+		for iNdEx := len(m.ArchivedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArchivedBy[iNdEx])
+			copy(dAtA[i:], m.ArchivedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ArchivedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SessionCount != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.SessionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LocatorUri) > 0 {
+		i -= len(m.LocatorUri)
+		copy(dAtA[i:], m.LocatorUri)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.LocatorUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeArchiveContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeArchiveContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeArchiveContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
 		}
 	}
 	if len(m.DataAccess) > 0 {
@@ -1403,6 +1926,9 @@ func (m *Scope) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
 	return n
 }
 
@@ -1465,6 +1991,40 @@ func (m *Record) Size() (n int) {
 	}
 	l = m.SpecificationId.Size()
 	n += 1 + l + sovScope(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Redaction != nil {
+		l = m.Redaction.Size()
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordRedaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.RedactedBy) > 0 {
+		for _, s := range m.RedactedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RedactedTime)
+	n += 1 + l + sovScope(uint64(l))
 	return n
 }
 
@@ -1564,6 +2124,10 @@ func (m *RecordOutput) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovScope(uint64(m.Status))
 	}
+	l = len(m.RedactedHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
 	return n
 }
 
@@ -1611,6 +2175,65 @@ func (m *AuditFields) Size() (n int) {
 	return n
 }
 
+func (m *ScopeArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.LocatorUri)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.SessionCount != 0 {
+		n += 1 + sovScope(uint64(m.SessionCount))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovScope(uint64(m.RecordCount))
+	}
+	if len(m.ArchivedBy) > 0 {
+		for _, s := range m.ArchivedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeArchiveContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovScope(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1810,6 +2433,25 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			}
 			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2311,59 +2953,9 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthScope
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Process) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowScope
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2391,13 +2983,13 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redaction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2407,27 +2999,81 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
+			if m.Redaction == nil {
+				m.Redaction = &RecordRedaction{}
+			}
+			if err := m.Redaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordRedaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordRedaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordRedaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2455,11 +3101,11 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2487,7 +3133,59 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.RedactedBy = append(m.RedactedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RedactedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2510,7 +3208,7 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordInput) Unmarshal(dAtA []byte) error {
+func (m *Process) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2533,15 +3231,15 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2569,13 +3267,13 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2585,31 +3283,27 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var vv MetadataAddress
-			v := &vv
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Source = &RecordInput_RecordId{*v}
+			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2637,11 +3331,11 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2669,27 +3363,8 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RecordInputStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2711,7 +3386,7 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2734,15 +3409,15 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2770,13 +3445,13 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2786,64 +3461,31 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ResultStatus(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Party) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowScope
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var vv MetadataAddress
+			v := &vv
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Party: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Source = &RecordInput_RecordId{*v}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2871,13 +3513,279 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RecordInputStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ResultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedactedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Party) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Party: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2913,7 +3821,238 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditFields) Unmarshal(dAtA []byte) error {
+func (m *AuditFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeArchive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2936,17 +4075,17 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeArchive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeArchive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2956,28 +4095,28 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3005,13 +4144,13 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorUri", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3021,28 +4160,65 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LocatorUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionCount", wireType)
+			}
+			m.SessionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3070,13 +4246,13 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ArchivedBy = append(m.ArchivedBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Version = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3086,16 +4262,16 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3105,23 +4281,175 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ArchivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeArchiveContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeArchiveContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeArchiveContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
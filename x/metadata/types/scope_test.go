@@ -328,6 +328,7 @@ owners:
   role: 5
 data_access: []
 value_owner_address: ""
+version: 0
 `,
 			scope.String())
 	})
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
@@ -28,6 +30,9 @@ const (
 	maxInputSpecificationTypeNameLength = 1000
 	// Default max url length
 	maxURLLength = 2048
+
+	// SpecificationBundleVersion is the current format version of a SpecificationBundle.
+	SpecificationBundleVersion = 1
 )
 
 var (
@@ -91,6 +96,38 @@ func (s ScopeSpecification) ValidateBasic() error {
 				i, PrefixContractSpecification, prefix)
 		}
 	}
+	if s.ValueOwnerConstraint != nil {
+		if err = s.ValueOwnerConstraint.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid value owner constraint on ScopeSpecification: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic format checking of data in a ValueOwnerConstraint
+func (c ValueOwnerConstraint) ValidateBasic() error {
+	if !c.AllowMarkers && len(c.AllowedAttributes) == 0 && len(c.AllowedAddresses) == 0 {
+		return errors.New("at least one of allow markers, allowed attributes or allowed addresses must be set")
+	}
+	seen := make(map[string]bool)
+	for i, name := range c.AllowedAttributes {
+		if len(strings.TrimSpace(name)) == 0 {
+			return fmt.Errorf("allowed attribute at index %d cannot be empty", i)
+		}
+		if seen[name] {
+			return fmt.Errorf("allowed attribute %s is duplicated", name)
+		}
+		seen[name] = true
+	}
+	for i, address := range c.AllowedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid allowed address at index %d: %w", i, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("allowed address %s is duplicated", address)
+		}
+		seen[address] = true
+	}
 	return nil
 }
 
@@ -258,6 +295,120 @@ func (s RecordSpecification) String() string {
 	return string(out)
 }
 
+// NewSpecificationBundle creates a new SpecificationBundle instance of the current version
+func NewSpecificationBundle(
+	contractSpec ContractSpecification,
+	recordSpecs []RecordSpecification,
+	scopeSpecs []ScopeSpecification,
+) *SpecificationBundle {
+	return &SpecificationBundle{
+		Version:               SpecificationBundleVersion,
+		ContractSpecification: contractSpec,
+		RecordSpecifications:  recordSpecs,
+		ScopeSpecifications:   scopeSpecs,
+	}
+}
+
+// ValidateBasic performs basic format checking of data in a SpecificationBundle
+func (b SpecificationBundle) ValidateBasic() error {
+	if b.Version != SpecificationBundleVersion {
+		return fmt.Errorf("unsupported specification bundle version (expected: %d, got %d)",
+			SpecificationBundleVersion, b.Version)
+	}
+	if err := b.ContractSpecification.ValidateBasic(); err != nil {
+		return err
+	}
+	contractSpecID := b.ContractSpecification.SpecificationId
+	names := make(map[string]bool, len(b.RecordSpecifications))
+	for i, recSpec := range b.RecordSpecifications {
+		if err := recSpec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record specification at index %d: %w", i, err)
+		}
+		recContractSpecID, err := recSpec.SpecificationId.AsContractSpecAddress()
+		if err != nil {
+			return fmt.Errorf("invalid record specification at index %d: %w", i, err)
+		}
+		if !contractSpecID.Equals(recContractSpecID) {
+			return fmt.Errorf("record specification %s at index %d does not belong to contract specification %s",
+				recSpec.SpecificationId, i, contractSpecID)
+		}
+		if names[recSpec.Name] {
+			return fmt.Errorf("record specification name %s provided twice", recSpec.Name)
+		}
+		names[recSpec.Name] = true
+	}
+	for i, scopeSpec := range b.ScopeSpecifications {
+		if err := scopeSpec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope specification at index %d: %w", i, err)
+		}
+		found := false
+		for _, id := range scopeSpec.ContractSpecIds {
+			if id.Equals(contractSpecID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("scope specification %s at index %d does not reference contract specification %s",
+				scopeSpec.SpecificationId, i, contractSpecID)
+		}
+	}
+	return nil
+}
+
+// NewSpecificationDeprecation creates a new SpecificationDeprecation instance for a specification deprecated at the
+// given time that allows new sessions for the given grace period.
+func NewSpecificationDeprecation(
+	specificationID MetadataAddress,
+	successorID MetadataAddress,
+	deprecatedTime time.Time,
+	gracePeriod time.Duration,
+) *SpecificationDeprecation {
+	return &SpecificationDeprecation{
+		SpecificationId:      specificationID,
+		SuccessorId:          successorID,
+		DeprecatedTime:       deprecatedTime,
+		SessionsAllowedUntil: deprecatedTime.Add(gracePeriod),
+	}
+}
+
+// ValidateBasic performs basic format checking of data in a SpecificationDeprecation
+func (d SpecificationDeprecation) ValidateBasic() error {
+	if err := validateSpecificationSuccessor(d.SpecificationId, d.SuccessorId); err != nil {
+		return err
+	}
+	if d.SessionsAllowedUntil.Before(d.DeprecatedTime) {
+		return fmt.Errorf("sessions allowed until %s cannot be before the deprecation time %s",
+			d.SessionsAllowedUntil, d.DeprecatedTime)
+	}
+	return nil
+}
+
+// AllowsSessions returns true if new sessions can still be created against the deprecated specification at the
+// given block time.
+func (d SpecificationDeprecation) AllowsSessions(blockTime time.Time) bool {
+	return blockTime.Before(d.SessionsAllowedUntil)
+}
+
+// validateSpecificationSuccessor checks that a specification id is a scope or contract specification id and that the
+// successor id, if provided, is a different specification of the same kind.
+func validateSpecificationSuccessor(specificationID, successorID MetadataAddress) error {
+	if !specificationID.IsScopeSpecificationAddress() && !specificationID.IsContractSpecificationAddress() {
+		return fmt.Errorf("address is not a scope or contract specification id: %s", specificationID.String())
+	}
+	if successorID.Empty() {
+		return nil
+	}
+	if successorID.Equals(specificationID) {
+		return fmt.Errorf("specification %s cannot be its own successor", specificationID)
+	}
+	if successorID.IsScopeSpecificationAddress() != specificationID.IsScopeSpecificationAddress() ||
+		successorID.IsContractSpecificationAddress() != specificationID.IsContractSpecificationAddress() {
+		return fmt.Errorf("successor %s is not the same kind of specification as %s", successorID, specificationID)
+	}
+	return nil
+}
+
 // NewInputSpecification creates a new InputSpecification instance
 func NewInputSpecification(
 	name string,
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -128,6 +132,12 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty" yaml:"parties_involved"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids" yaml:"contract_spec_ids"`
+	// When true, records in scopes of this specification must conform strictly to their record specifications: inputs
+	// sourced from a hash must have the hash of their input specification, and outputs must match a set result type.
+	Strict bool `protobuf:"varint,6,opt,name=strict,proto3" json:"strict,omitempty"`
+	// Limits the accounts that can be the value owner of scopes of this specification.  Any account can be the value
+	// owner when not set.
+	ValueOwnerConstraint *ValueOwnerConstraint `protobuf:"bytes,7,opt,name=value_owner_constraint,json=valueOwnerConstraint,proto3" json:"value_owner_constraint,omitempty" yaml:"value_owner_constraint"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -183,6 +193,85 @@ func (m *ScopeSpecification) GetPartiesInvolved() []PartyType {
 	return nil
 }
 
+func (m *ScopeSpecification) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *ScopeSpecification) GetValueOwnerConstraint() *ValueOwnerConstraint {
+	if m != nil {
+		return m.ValueOwnerConstraint
+	}
+	return nil
+}
+
+// ValueOwnerConstraint limits the accounts that can be the value owner of the scopes of a scope specification, e.g. to
+// regulated custodians.  A value owner is allowed when it meets any of the set conditions.
+type ValueOwnerConstraint struct {
+	// When true, marker accounts can be the value owner.
+	AllowMarkers bool `protobuf:"varint,1,opt,name=allow_markers,json=allowMarkers,proto3" json:"allow_markers,omitempty" yaml:"allow_markers"`
+	// Accounts holding an attribute with any of these names can be the value owner.
+	AllowedAttributes []string `protobuf:"bytes,2,rep,name=allowed_attributes,json=allowedAttributes,proto3" json:"allowed_attributes,omitempty" yaml:"allowed_attributes"`
+	// The addresses of accounts that can be the value owner.
+	AllowedAddresses []string `protobuf:"bytes,3,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty" yaml:"allowed_addresses"`
+}
+
+func (m *ValueOwnerConstraint) Reset()         { *m = ValueOwnerConstraint{} }
+func (m *ValueOwnerConstraint) String() string { return proto.CompactTextString(m) }
+func (*ValueOwnerConstraint) ProtoMessage()    {}
+func (*ValueOwnerConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{1}
+}
+func (m *ValueOwnerConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueOwnerConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueOwnerConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueOwnerConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueOwnerConstraint.Merge(m, src)
+}
+func (m *ValueOwnerConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueOwnerConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueOwnerConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueOwnerConstraint proto.InternalMessageInfo
+
+func (m *ValueOwnerConstraint) GetAllowMarkers() bool {
+	if m != nil {
+		return m.AllowMarkers
+	}
+	return false
+}
+
+func (m *ValueOwnerConstraint) GetAllowedAttributes() []string {
+	if m != nil {
+		return m.AllowedAttributes
+	}
+	return nil
+}
+
+func (m *ValueOwnerConstraint) GetAllowedAddresses() []string {
+	if m != nil {
+		return m.AllowedAddresses
+	}
+	return nil
+}
+
 // ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ContractSpecification struct {
 	// unique identifier for this specification on chain
@@ -207,7 +296,7 @@ type ContractSpecification struct {
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
 func (*ContractSpecification) ProtoMessage() {}
 func (*ContractSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{1}
+	return fileDescriptor_1e2d1042057ea889, []int{2}
 }
 func (m *ContractSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +410,7 @@ type RecordSpecification struct {
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
 func (*RecordSpecification) ProtoMessage() {}
 func (*RecordSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{2}
+	return fileDescriptor_1e2d1042057ea889, []int{3}
 }
 func (m *RecordSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +492,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +573,141 @@ func (*InputSpecification) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// SpecificationBundle is a versioned collection of a contract specification, its record specifications, and
+// (optionally) the scope specifications that reference it.  Bundles are used to export specifications from one
+// environment and write them to another in a single transaction.
+type SpecificationBundle struct {
+	// version is the format version of this bundle.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// contract_specification is the contract specification contained in this bundle.
+	ContractSpecification ContractSpecification `protobuf:"bytes,2,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification" yaml:"contract_specification"`
+	// record_specifications are the record specifications of the contract specification.
+	RecordSpecifications []RecordSpecification `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications" yaml:"record_specifications"`
+	// scope_specifications are any scope specifications that reference the contract specification.
+	ScopeSpecifications []ScopeSpecification `protobuf:"bytes,4,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications" yaml:"scope_specifications,omitempty"`
+}
+
+func (m *SpecificationBundle) Reset()         { *m = SpecificationBundle{} }
+func (m *SpecificationBundle) String() string { return proto.CompactTextString(m) }
+func (*SpecificationBundle) ProtoMessage()    {}
+func (*SpecificationBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{5}
+}
+func (m *SpecificationBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecificationBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecificationBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecificationBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecificationBundle.Merge(m, src)
+}
+func (m *SpecificationBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecificationBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecificationBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecificationBundle proto.InternalMessageInfo
+
+func (m *SpecificationBundle) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SpecificationBundle) GetContractSpecification() ContractSpecification {
+	if m != nil {
+		return m.ContractSpecification
+	}
+	return ContractSpecification{}
+}
+
+func (m *SpecificationBundle) GetRecordSpecifications() []RecordSpecification {
+	if m != nil {
+		return m.RecordSpecifications
+	}
+	return nil
+}
+
+func (m *SpecificationBundle) GetScopeSpecifications() []ScopeSpecification {
+	if m != nil {
+		return m.ScopeSpecifications
+	}
+	return nil
+}
+
+// SpecificationDeprecation records that a scope or contract specification has been deprecated by its owners, and the
+// specification that replaces it (if any) so clients can move to it.
+type SpecificationDeprecation struct {
+	// the id of the deprecated scope or contract specification
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// the id of the specification of the same kind that replaces the deprecated one, empty if there is none
+	SuccessorId MetadataAddress `protobuf:"bytes,2,opt,name=successor_id,json=successorId,proto3,customtype=MetadataAddress" json:"successor_id" yaml:"successor_id"`
+	// the block time at which the specification was deprecated
+	DeprecatedTime time.Time `protobuf:"bytes,3,opt,name=deprecated_time,json=deprecatedTime,proto3,stdtime" json:"deprecated_time" yaml:"deprecated_time"`
+	// new sessions against the specification are rejected after this time, the deprecation time plus the grace period
+	SessionsAllowedUntil time.Time `protobuf:"bytes,4,opt,name=sessions_allowed_until,json=sessionsAllowedUntil,proto3,stdtime" json:"sessions_allowed_until" yaml:"sessions_allowed_until"`
+}
+
+func (m *SpecificationDeprecation) Reset()         { *m = SpecificationDeprecation{} }
+func (m *SpecificationDeprecation) String() string { return proto.CompactTextString(m) }
+func (*SpecificationDeprecation) ProtoMessage()    {}
+func (*SpecificationDeprecation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{6}
+}
+func (m *SpecificationDeprecation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecificationDeprecation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecificationDeprecation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecificationDeprecation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecificationDeprecation.Merge(m, src)
+}
+func (m *SpecificationDeprecation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecificationDeprecation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecificationDeprecation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecificationDeprecation proto.InternalMessageInfo
+
+func (m *SpecificationDeprecation) GetDeprecatedTime() time.Time {
+	if m != nil {
+		return m.DeprecatedTime
+	}
+	return time.Time{}
+}
+
+func (m *SpecificationDeprecation) GetSessionsAllowedUntil() time.Time {
+	if m != nil {
+		return m.SessionsAllowedUntil
+	}
+	return time.Time{}
+}
+
 // Description holds general information that is handy to associate with a structure.
 type Description struct {
 	// A Name for this thing.
@@ -499,7 +723,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{7}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,9 +784,12 @@ func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
+	proto.RegisterType((*ValueOwnerConstraint)(nil), "provenance.metadata.v1.ValueOwnerConstraint")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
+	proto.RegisterType((*SpecificationBundle)(nil), "provenance.metadata.v1.SpecificationBundle")
+	proto.RegisterType((*SpecificationDeprecation)(nil), "provenance.metadata.v1.SpecificationDeprecation")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
 }
 
@@ -571,67 +798,94 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x63, 0x86, 0x81, 0x4b, 0x34, 0x38, 0x77, 0x18, 0xe2, 0x4c, 0x2a, 0x4c, 0x5d, 0xa9,
-	0xa5, 0x23, 0x15, 0x34, 0x24, 0x52, 0xa5, 0xec, 0xf8, 0x31, 0xcd, 0x95, 0x26, 0x06, 0x5d, 0x60,
-	0xaa, 0x54, 0xaa, 0x2c, 0x8f, 0x7d, 0x33, 0x63, 0x15, 0x6c, 0xcb, 0x36, 0xa4, 0xf3, 0x0e, 0x5d,
-	0x74, 0xd7, 0x2e, 0xfb, 0x0c, 0x7d, 0x8a, 0x74, 0x97, 0xee, 0xaa, 0x2c, 0x50, 0x35, 0xf3, 0x04,
-	0xe5, 0x09, 0xaa, 0x7b, 0x6d, 0xc0, 0x78, 0x40, 0xca, 0xa6, 0x5d, 0x65, 0x77, 0xcf, 0xf9, 0xbe,
-	0x73, 0x7c, 0xfc, 0x9d, 0xcf, 0x57, 0x06, 0xa7, 0xae, 0xe7, 0xcc, 0x88, 0xad, 0xdb, 0x06, 0xa9,
-	0x4f, 0x48, 0xa0, 0x9b, 0x7a, 0xa0, 0xd7, 0x67, 0x67, 0x75, 0xdf, 0x25, 0x86, 0xf5, 0xda, 0x32,
-	0xf4, 0xc0, 0x72, 0xec, 0x9a, 0xeb, 0x39, 0x81, 0x03, 0x4b, 0x6b, 0x6e, 0x6d, 0xc9, 0xad, 0xcd,
-	0xce, 0x4e, 0x8a, 0x57, 0xce, 0x95, 0xc3, 0x28, 0x75, 0x7a, 0x0a, 0xd9, 0xf2, 0x9f, 0x3c, 0x80,
-	0x03, 0xc3, 0x71, 0xc9, 0x20, 0xde, 0x0a, 0x7e, 0x0f, 0x84, 0x8d, 0xde, 0x9a, 0x65, 0x8a, 0x5c,
-	0x85, 0xab, 0x3e, 0x68, 0x35, 0xde, 0xce, 0xa5, 0xd4, 0xfb, 0xb9, 0x54, 0x78, 0x19, 0xf5, 0x6e,
-	0x9a, 0xa6, 0x47, 0x7c, 0x7f, 0x31, 0x97, 0x1e, 0xdd, 0xe8, 0x93, 0xf1, 0x73, 0x39, 0x59, 0x28,
-	0xe3, 0xc2, 0x46, 0x0a, 0x99, 0x50, 0x01, 0x79, 0x93, 0xf8, 0x86, 0x67, 0xb9, 0x34, 0x21, 0xee,
-	0x55, 0xb8, 0x6a, 0xbe, 0xf1, 0x59, 0x6d, 0xfb, 0xe4, 0xb5, 0xce, 0x9a, 0x8a, 0xe3, 0x75, 0xb0,
-	0x0d, 0x0a, 0xce, 0x1b, 0x9b, 0x78, 0x9a, 0x1e, 0xce, 0x40, 0x7c, 0x91, 0xaf, 0xf0, 0xd5, 0x5c,
-	0xeb, 0x64, 0x31, 0x97, 0x4a, 0xe1, 0x34, 0x09, 0x82, 0x8c, 0x0f, 0x59, 0xa6, 0xb9, 0x4c, 0x40,
-	0x0b, 0x08, 0xae, 0xee, 0x05, 0x16, 0xf1, 0x35, 0xcb, 0x9e, 0x39, 0xe3, 0x19, 0x31, 0xc5, 0x74,
-	0x85, 0xaf, 0x1e, 0x36, 0x3e, 0xdd, 0x35, 0x50, 0x5f, 0xf7, 0x82, 0x9b, 0xe1, 0x8d, 0x4b, 0x5a,
-	0x4f, 0xd6, 0xaf, 0x9d, 0x6c, 0x22, 0xe3, 0x42, 0x94, 0x42, 0x51, 0x06, 0x6a, 0xe0, 0xa1, 0xe1,
-	0xd8, 0x81, 0xa7, 0x1b, 0x81, 0x46, 0x25, 0xd1, 0x2c, 0xd3, 0x17, 0xf7, 0x2b, 0x7c, 0xf5, 0x41,
-	0xeb, 0xe9, 0x6e, 0x59, 0xc5, 0xb0, 0xff, 0xbd, 0x4a, 0x19, 0x17, 0x96, 0x39, 0xba, 0x3c, 0x64,
-	0xfa, 0xcf, 0xd3, 0xbf, 0xfe, 0x26, 0xa5, 0xe4, 0x5f, 0xd2, 0xe0, 0xb8, 0x1d, 0x43, 0x3e, 0xae,
-	0xf5, 0xbf, 0x5d, 0xeb, 0x39, 0xc8, 0x7b, 0xc4, 0x77, 0xa6, 0x9e, 0x41, 0xa8, 0xa0, 0xfb, 0x4c,
-	0xd0, 0x2f, 0xb7, 0x8b, 0x09, 0xc3, 0xae, 0x31, 0xbe, 0xfc, 0x22, 0x85, 0xc1, 0x32, 0x46, 0x26,
-	0x2c, 0x82, 0xf4, 0xb5, 0xee, 0x5f, 0x8b, 0x99, 0x0a, 0x57, 0xcd, 0xbd, 0x48, 0x61, 0x16, 0xc1,
-	0x67, 0x00, 0x18, 0x63, 0xdd, 0xf7, 0x35, 0x5b, 0x9f, 0x10, 0xf1, 0x80, 0x62, 0xad, 0xe3, 0xc5,
-	0x5c, 0x7a, 0x18, 0x99, 0x63, 0x85, 0xc9, 0x38, 0xc7, 0x02, 0x55, 0x9f, 0x90, 0xd0, 0x0f, 0xad,
-	0x2c, 0xc8, 0x84, 0xdd, 0xe5, 0xf7, 0x3c, 0x38, 0xc2, 0xc4, 0x70, 0x3c, 0xf3, 0x7f, 0xf5, 0x05,
-	0x04, 0x69, 0x36, 0x36, 0x35, 0x44, 0x0e, 0xb3, 0x33, 0x6c, 0x81, 0x8c, 0x65, 0xbb, 0xd3, 0x20,
-	0xdc, 0x6d, 0xbe, 0x71, 0xba, 0x6b, 0x2b, 0x88, 0xb2, 0x36, 0xc6, 0xc5, 0x51, 0x25, 0x3c, 0x03,
-	0xb9, 0xe0, 0xc6, 0x25, 0xa1, 0x26, 0x69, 0xa6, 0x49, 0x71, 0x31, 0x97, 0x84, 0x70, 0xb0, 0x15,
-	0x24, 0xe3, 0x2c, 0x3d, 0x53, 0x45, 0xa0, 0xc6, 0x76, 0x35, 0x1d, 0x07, 0x1a, 0x4d, 0xb1, 0x5d,
-	0x1d, 0x36, 0x3e, 0xdf, 0x6d, 0xd1, 0xd7, 0x96, 0x6d, 0xd1, 0x67, 0x32, 0x5b, 0x94, 0x36, 0x16,
-	0xb8, 0x6c, 0x22, 0xb3, 0xf5, 0x4d, 0xc7, 0x01, 0xe5, 0x40, 0x0f, 0x1c, 0x79, 0xc4, 0x77, 0x1d,
-	0xdb, 0xb7, 0x2e, 0xc7, 0x44, 0x8b, 0xbc, 0x22, 0x66, 0x3e, 0xd4, 0x7a, 0xe5, 0xc5, 0x5c, 0x3a,
-	0x59, 0x3d, 0x23, 0xd9, 0x47, 0xc6, 0x30, 0x96, 0xed, 0x87, 0xc9, 0xe8, 0xb3, 0xff, 0x83, 0x03,
-	0xf0, 0xbe, 0x58, 0x2b, 0xf1, 0xb9, 0x98, 0xf8, 0x1b, 0xc2, 0xed, 0x7d, 0x90, 0x70, 0x5d, 0x90,
-	0xf3, 0x98, 0x73, 0xa8, 0x37, 0x78, 0xe6, 0x8d, 0x2f, 0xb6, 0xfb, 0x42, 0x58, 0x4e, 0x1f, 0xb1,
-	0xa9, 0xc1, 0xb3, 0x61, 0x14, 0xb3, 0x77, 0x3a, 0x6e, 0xef, 0x7b, 0x46, 0xfd, 0x9d, 0x03, 0xf9,
-	0xd8, 0xfd, 0xb0, 0xf5, 0x25, 0x2a, 0x9b, 0xb7, 0x0d, 0xcf, 0xa0, 0x8d, 0x8b, 0xe4, 0x6b, 0x90,
-	0x7f, 0x43, 0x2e, 0x7d, 0x2b, 0x20, 0xda, 0xd4, 0x1b, 0x47, 0x0e, 0x89, 0x2d, 0x31, 0x06, 0xca,
-	0x18, 0x44, 0xd1, 0xc8, 0x1b, 0xc3, 0x1a, 0xc8, 0x5a, 0x86, 0x63, 0xb3, 0xaa, 0x7d, 0x56, 0x75,
-	0xb4, 0x98, 0x4b, 0x85, 0xb0, 0x6a, 0x89, 0xc8, 0xf8, 0x80, 0x1e, 0x47, 0xde, 0x38, 0x1c, 0xff,
-	0xf4, 0x27, 0x0e, 0x1c, 0x6e, 0x3a, 0x06, 0x4a, 0xe0, 0x49, 0x47, 0xe9, 0x22, 0x15, 0x0d, 0x51,
-	0x4f, 0xd5, 0x86, 0xaf, 0xfa, 0x8a, 0x36, 0x52, 0x07, 0x7d, 0xa5, 0x8d, 0xba, 0x48, 0xe9, 0x08,
-	0x29, 0xf8, 0x09, 0x10, 0x93, 0x84, 0x3e, 0xee, 0xf5, 0x7b, 0x03, 0xa5, 0x23, 0x70, 0xf0, 0x04,
-	0x94, 0x92, 0x28, 0x56, 0xda, 0x3d, 0xdc, 0x11, 0xf6, 0xb6, 0xb5, 0x0e, 0x31, 0xed, 0x1c, 0x0d,
-	0x86, 0x02, 0x7f, 0xfa, 0x0f, 0x07, 0x72, 0x2b, 0x5f, 0xd1, 0x56, 0xfd, 0x26, 0x1e, 0xbe, 0xda,
-	0x36, 0xc4, 0x63, 0x70, 0x1c, 0xc3, 0x7a, 0x18, 0x7d, 0x83, 0xd4, 0xe6, 0xb0, 0x87, 0x05, 0x0e,
-	0x3e, 0x02, 0x47, 0x31, 0x68, 0xa0, 0xe0, 0x0b, 0xd4, 0x56, 0xb0, 0xb0, 0x97, 0x00, 0x90, 0x7a,
-	0xa1, 0x0c, 0x68, 0x05, 0x0f, 0x45, 0x50, 0x8c, 0x01, 0xed, 0xd1, 0x60, 0xd8, 0xeb, 0xa0, 0xa6,
-	0x2a, 0xa4, 0x61, 0x11, 0x08, 0xf1, 0xc7, 0x7c, 0xab, 0x2a, 0x58, 0xd8, 0x4f, 0xf0, 0x9b, 0xdd,
-	0x2e, 0x3a, 0x47, 0xcd, 0xa1, 0x22, 0x64, 0x60, 0x09, 0xc0, 0x38, 0xff, 0xa5, 0x8a, 0x5a, 0xa3,
-	0x81, 0x70, 0x90, 0x18, 0xb7, 0x8f, 0x7b, 0x17, 0x8a, 0xda, 0x54, 0xdb, 0x8a, 0x90, 0x6d, 0xfd,
-	0xf0, 0xf6, 0xb6, 0xcc, 0xbd, 0xbb, 0x2d, 0x73, 0x7f, 0xdf, 0x96, 0xb9, 0x9f, 0xef, 0xca, 0xa9,
-	0x77, 0x77, 0xe5, 0xd4, 0x5f, 0x77, 0xe5, 0x14, 0x78, 0x6c, 0x39, 0x3b, 0x3e, 0xbe, 0x3e, 0xf7,
-	0xdd, 0xb3, 0x2b, 0x2b, 0xb8, 0x9e, 0x5e, 0xd6, 0x0c, 0x67, 0x52, 0x5f, 0x93, 0xbe, 0xb2, 0x9c,
-	0x58, 0x54, 0xff, 0x71, 0xfd, 0xeb, 0x45, 0xbf, 0x0a, 0xff, 0x32, 0xc3, 0x7e, 0xa1, 0x9e, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x4e, 0x99, 0x71, 0x9e, 0x09, 0x00, 0x00,
+	// 1388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x2d, 0xf9, 0x43, 0x2b, 0xc7, 0xa6, 0xd7, 0xb2, 0xc2, 0x38, 0x89, 0xe9, 0xf0, 0x7d,
+	0xd3, 0x3a, 0x6e, 0x22, 0x21, 0x4e, 0x80, 0x02, 0x01, 0x7a, 0x10, 0x25, 0xba, 0x21, 0xe0, 0x48,
+	0xea, 0x4a, 0x76, 0x91, 0x02, 0x05, 0x41, 0x93, 0x1b, 0x87, 0x08, 0x45, 0x0a, 0x5c, 0x4a, 0xa9,
+	0xd1, 0x73, 0x7b, 0x69, 0x0e, 0xb9, 0xb5, 0xc7, 0xfe, 0x86, 0xfc, 0x8a, 0xf4, 0x96, 0x4b, 0x81,
+	0x22, 0x07, 0xb5, 0x48, 0xee, 0x05, 0xa2, 0x5f, 0x50, 0xec, 0x92, 0x94, 0x48, 0x89, 0x6a, 0x73,
+	0x69, 0x4e, 0xbd, 0x71, 0xbe, 0x9e, 0x9d, 0x9d, 0x7d, 0x66, 0x76, 0x09, 0xf6, 0x7b, 0x9e, 0x3b,
+	0xc0, 0x8e, 0xee, 0x18, 0xb8, 0xd2, 0xc5, 0xbe, 0x6e, 0xea, 0xbe, 0x5e, 0x19, 0xdc, 0xae, 0x90,
+	0x1e, 0x36, 0xac, 0x47, 0x96, 0xa1, 0xfb, 0x96, 0xeb, 0x94, 0x7b, 0x9e, 0xeb, 0xbb, 0xb0, 0x34,
+	0xf1, 0x2d, 0x47, 0xbe, 0xe5, 0xc1, 0xed, 0xed, 0xe2, 0x99, 0x7b, 0xe6, 0x32, 0x97, 0x0a, 0xfd,
+	0x0a, 0xbc, 0xb7, 0xc5, 0x33, 0xd7, 0x3d, 0xb3, 0x71, 0x85, 0x49, 0xa7, 0xfd, 0x47, 0x15, 0xdf,
+	0xea, 0x62, 0xe2, 0xeb, 0xdd, 0x5e, 0xe0, 0x20, 0xbd, 0xcb, 0x01, 0xd8, 0x36, 0xdc, 0x1e, 0x6e,
+	0xc7, 0xd7, 0x82, 0x5f, 0x03, 0x3e, 0xb1, 0xb8, 0x66, 0x99, 0x02, 0xb7, 0xcb, 0xed, 0xad, 0xca,
+	0x07, 0x2f, 0x87, 0x62, 0xe6, 0xf5, 0x50, 0x5c, 0x7f, 0x10, 0x2e, 0x5e, 0x35, 0x4d, 0x0f, 0x13,
+	0x32, 0x1a, 0x8a, 0x17, 0xcf, 0xf5, 0xae, 0x7d, 0x4f, 0x9a, 0x0e, 0x94, 0xd0, 0x7a, 0x42, 0xa5,
+	0x9a, 0x50, 0x01, 0x05, 0x13, 0x13, 0xc3, 0xb3, 0x7a, 0x54, 0x21, 0x2c, 0xec, 0x72, 0x7b, 0x85,
+	0x83, 0xff, 0x95, 0xd3, 0xb7, 0x56, 0xae, 0x4f, 0x5c, 0x51, 0x3c, 0x0e, 0xd6, 0xc0, 0xba, 0xfb,
+	0xd4, 0xc1, 0x9e, 0xa6, 0x07, 0x39, 0x60, 0x22, 0x64, 0x77, 0xb3, 0x7b, 0x79, 0x79, 0x7b, 0x34,
+	0x14, 0x4b, 0x41, 0x36, 0x53, 0x0e, 0x12, 0x5a, 0x63, 0x9a, 0x6a, 0xa4, 0x80, 0x16, 0xe0, 0x7b,
+	0xba, 0xe7, 0x5b, 0x98, 0x68, 0x96, 0x33, 0x70, 0xed, 0x01, 0x36, 0x85, 0xdc, 0x6e, 0x76, 0x6f,
+	0xed, 0xe0, 0xda, 0xbc, 0x84, 0x5a, 0xba, 0xe7, 0x9f, 0x77, 0xce, 0x7b, 0x58, 0xbe, 0x3c, 0xd9,
+	0xf6, 0x34, 0x88, 0x84, 0xd6, 0x43, 0x95, 0x1a, 0x6a, 0xa0, 0x06, 0x36, 0x0c, 0xd7, 0xf1, 0x3d,
+	0xdd, 0xf0, 0x35, 0x5a, 0x12, 0xcd, 0x32, 0x89, 0xb0, 0xb8, 0x9b, 0xdd, 0x5b, 0x95, 0xef, 0xcc,
+	0x2f, 0xab, 0x10, 0xe0, 0xcf, 0x44, 0x4a, 0x68, 0x3d, 0xd2, 0xd1, 0xc3, 0x53, 0x4d, 0x02, 0x4b,
+	0x60, 0x89, 0xf8, 0x9e, 0x65, 0xf8, 0xc2, 0xd2, 0x2e, 0xb7, 0xb7, 0x82, 0x42, 0x09, 0x7e, 0xc7,
+	0x81, 0xd2, 0x40, 0xb7, 0xfb, 0x58, 0x0b, 0xca, 0x61, 0xb8, 0x0e, 0xf1, 0x3d, 0xdd, 0x72, 0x7c,
+	0x61, 0x99, 0xd5, 0xfe, 0xe6, 0xbc, 0xad, 0x9e, 0xd0, 0xa8, 0x26, 0x0d, 0xaa, 0x8d, 0x63, 0xe4,
+	0x6b, 0xa3, 0xa1, 0x78, 0x35, 0xc8, 0x2a, 0x1d, 0x55, 0x42, 0xc5, 0x41, 0x4a, 0xe0, 0xbd, 0xdc,
+	0x4f, 0x3f, 0x8b, 0x19, 0xe9, 0x4f, 0x0e, 0x14, 0xd3, 0x70, 0xe1, 0x67, 0xe0, 0x82, 0x6e, 0xdb,
+	0xee, 0x53, 0xad, 0xab, 0x7b, 0x4f, 0xb0, 0x47, 0x18, 0xe5, 0x56, 0x64, 0x61, 0x34, 0x14, 0x8b,
+	0xc1, 0x72, 0x09, 0xb3, 0x84, 0x56, 0x99, 0xfc, 0x20, 0x10, 0xe1, 0x11, 0x80, 0x4c, 0xc6, 0xa6,
+	0xa6, 0xfb, 0xbe, 0x67, 0x9d, 0xf6, 0x7d, 0x4c, 0x84, 0x05, 0xc6, 0x88, 0xab, 0xa3, 0xa1, 0x78,
+	0x29, 0x86, 0x91, 0xf0, 0x91, 0xd0, 0x46, 0xa8, 0xac, 0x8e, 0x75, 0x50, 0x05, 0x1b, 0x63, 0xcf,
+	0x29, 0x7a, 0x5d, 0x99, 0x9c, 0xca, 0x8c, 0x8b, 0x84, 0xf8, 0x08, 0x6b, 0xac, 0xfa, 0x31, 0x07,
+	0xb6, 0x6a, 0xb1, 0xa3, 0xfa, 0xaf, 0xcf, 0xfe, 0xdd, 0x3e, 0x3b, 0x02, 0x05, 0x0f, 0x13, 0xb7,
+	0xef, 0x19, 0x98, 0x16, 0x74, 0x91, 0x15, 0xf4, 0x46, 0x7a, 0x31, 0x61, 0x80, 0x1a, 0xf3, 0x97,
+	0xee, 0x67, 0x10, 0x88, 0x64, 0xd5, 0x84, 0x45, 0x90, 0x7b, 0xac, 0x93, 0xc7, 0xac, 0xa5, 0xf2,
+	0xf7, 0x33, 0x88, 0x49, 0xf0, 0x2e, 0x00, 0x86, 0xad, 0x13, 0xa2, 0x39, 0x7a, 0x17, 0xb3, 0x2e,
+	0xca, 0xcb, 0x5b, 0xa3, 0xa1, 0xb8, 0x11, 0x76, 0xeb, 0xd8, 0x26, 0xa1, 0x3c, 0x13, 0x1a, 0x7a,
+	0x17, 0x07, 0x0d, 0x20, 0xaf, 0x80, 0xa5, 0x00, 0x5d, 0x7a, 0x9d, 0x05, 0x9b, 0x08, 0x1b, 0xae,
+	0x67, 0x7e, 0x50, 0x5e, 0x40, 0x90, 0x63, 0x69, 0x53, 0x42, 0xe4, 0x11, 0xfb, 0x86, 0x32, 0x58,
+	0xb2, 0x9c, 0x5e, 0xdf, 0x0f, 0xce, 0xb6, 0x70, 0xb0, 0x3f, 0xef, 0x54, 0x54, 0xea, 0x95, 0x48,
+	0x17, 0x85, 0x91, 0xf0, 0x36, 0xc8, 0xfb, 0xe7, 0x3d, 0x1c, 0xd4, 0x24, 0xc7, 0x6a, 0x52, 0x1c,
+	0x0d, 0x45, 0x3e, 0x48, 0x6c, 0x6c, 0x92, 0xd0, 0x0a, 0xfd, 0xa6, 0x15, 0x81, 0x1a, 0x3b, 0xab,
+	0xbe, 0xed, 0x6b, 0x54, 0xc5, 0xce, 0x6a, 0xed, 0xe0, 0xa3, 0xf9, 0x14, 0x7d, 0x64, 0x39, 0x16,
+	0x5d, 0x93, 0xd1, 0xa2, 0x94, 0x38, 0xc0, 0x08, 0x44, 0x62, 0xc7, 0xd7, 0xb7, 0x7d, 0xea, 0x03,
+	0x3d, 0xb0, 0xe9, 0x61, 0xd2, 0x73, 0x1d, 0x62, 0x9d, 0xda, 0x58, 0x0b, 0xb9, 0x22, 0x2c, 0xbd,
+	0x2f, 0xf5, 0x76, 0x46, 0x43, 0x71, 0x7b, 0xbc, 0xc6, 0x34, 0x8e, 0x84, 0x60, 0x4c, 0xdb, 0x0a,
+	0x94, 0xe1, 0x9c, 0xfb, 0x85, 0x03, 0x70, 0xb6, 0x58, 0xe3, 0xe2, 0x73, 0xb1, 0xe2, 0x27, 0x0a,
+	0xb7, 0xf0, 0x5e, 0x85, 0x3b, 0x04, 0x79, 0x8f, 0x31, 0x87, 0x72, 0x23, 0xcb, 0xb8, 0xf1, 0x71,
+	0x3a, 0x2f, 0xf8, 0x28, 0xfb, 0xd0, 0x9b, 0x12, 0x7c, 0x25, 0x90, 0x62, 0xf4, 0xce, 0xc5, 0xe9,
+	0x3d, 0x43, 0xd4, 0x5f, 0xb3, 0x60, 0x33, 0xb1, 0x0d, 0xb9, 0xef, 0x98, 0x36, 0x86, 0x02, 0x58,
+	0x1e, 0x60, 0x8f, 0xd0, 0xe9, 0x42, 0xf7, 0x73, 0x01, 0x45, 0x22, 0xfc, 0x81, 0x03, 0xa5, 0xc4,
+	0x9d, 0x35, 0x0e, 0x0d, 0xe7, 0xd0, 0xad, 0x79, 0xb5, 0x4f, 0x1d, 0x95, 0xf2, 0x75, 0x4a, 0xfc,
+	0xc9, 0xc5, 0x93, 0x0e, 0x2d, 0xa1, 0x2d, 0x23, 0x75, 0xd0, 0x7e, 0xcf, 0x81, 0xad, 0xb0, 0x00,
+	0x89, 0x80, 0x88, 0xed, 0x9f, 0xcc, 0x4b, 0x26, 0xa5, 0x3b, 0xe5, 0xff, 0x87, 0xa9, 0x5c, 0x49,
+	0x14, 0x36, 0x89, 0x2b, 0xa1, 0xa2, 0x37, 0x1b, 0x4a, 0xe0, 0x33, 0x0e, 0x14, 0x09, 0x7d, 0x70,
+	0x4d, 0xe7, 0x91, 0xfb, 0xfb, 0xae, 0x9b, 0x7d, 0xa4, 0xc9, 0xb7, 0xc2, 0x34, 0xae, 0x87, 0x7d,
+	0x9f, 0x82, 0x7a, 0xd3, 0xed, 0x5a, 0x3e, 0xee, 0xf6, 0xfc, 0x73, 0x09, 0x6d, 0x92, 0x19, 0x08,
+	0x22, 0xbd, 0xc8, 0x02, 0x21, 0xa1, 0xaa, 0xe3, 0x9e, 0x87, 0x3f, 0xcc, 0x14, 0xfa, 0x02, 0xac,
+	0x92, 0xbe, 0x61, 0x60, 0x42, 0x5c, 0x8f, 0x42, 0x2f, 0x30, 0xe8, 0xf2, 0x7c, 0xe8, 0xcd, 0x10,
+	0x3a, 0x16, 0x24, 0xa1, 0xc2, 0x58, 0x54, 0x4d, 0x78, 0x06, 0xd6, 0xcd, 0x70, 0x03, 0xd8, 0xd4,
+	0xe8, 0x63, 0x97, 0xb5, 0x46, 0xe1, 0x60, 0xbb, 0x1c, 0xbc, 0x84, 0xcb, 0xd1, 0x4b, 0xb8, 0xdc,
+	0x89, 0x5e, 0xc2, 0xb2, 0x14, 0xd6, 0x31, 0xbc, 0xc9, 0xa6, 0x00, 0xa4, 0xe7, 0xbf, 0x8b, 0x1c,
+	0x5a, 0x9b, 0x68, 0x69, 0x20, 0xfc, 0x16, 0x94, 0x08, 0x26, 0x94, 0xe8, 0x44, 0x8b, 0xde, 0x00,
+	0x7d, 0xc7, 0xb7, 0x6c, 0x21, 0xf7, 0x8f, 0xeb, 0xdd, 0x48, 0x32, 0x39, 0x1d, 0x27, 0x58, 0xb6,
+	0x18, 0x19, 0xab, 0x81, 0xed, 0x98, 0x99, 0x5e, 0x70, 0xa0, 0x10, 0xbb, 0xac, 0x53, 0x27, 0xca,
+	0x6e, 0xf2, 0xea, 0xcf, 0x32, 0x53, 0x5c, 0x05, 0x3f, 0x05, 0x85, 0xa7, 0xf8, 0x94, 0x58, 0x3e,
+	0xd6, 0xfa, 0x9e, 0x1d, 0x8e, 0xeb, 0xd8, 0x44, 0x8d, 0x19, 0x25, 0x04, 0x42, 0xe9, 0xd8, 0xb3,
+	0x61, 0x19, 0xac, 0x58, 0x86, 0xeb, 0xb0, 0xa8, 0x45, 0x16, 0xb5, 0x39, 0x1a, 0x8a, 0xeb, 0x41,
+	0x54, 0x64, 0x91, 0xd0, 0x32, 0xfd, 0x3c, 0xf6, 0xec, 0x60, 0x96, 0xec, 0x3f, 0xe3, 0xc0, 0x5a,
+	0x72, 0x7c, 0x43, 0x11, 0x5c, 0xae, 0x2b, 0x87, 0x6a, 0x43, 0xed, 0xa8, 0xcd, 0x86, 0xd6, 0x79,
+	0xd8, 0x52, 0xb4, 0xe3, 0x46, 0xbb, 0xa5, 0xd4, 0xd4, 0x43, 0x55, 0xa9, 0xf3, 0x19, 0x78, 0x05,
+	0x08, 0xd3, 0x0e, 0x2d, 0xd4, 0x6c, 0x35, 0xdb, 0x4a, 0x9d, 0xe7, 0xe0, 0x36, 0x28, 0x4d, 0x5b,
+	0x91, 0x52, 0x6b, 0xa2, 0x3a, 0xbf, 0x90, 0x06, 0x1d, 0xd8, 0xb4, 0x23, 0xb5, 0xdd, 0xe1, 0xb3,
+	0xfb, 0xef, 0x38, 0x90, 0x1f, 0x0f, 0x79, 0x0a, 0xd5, 0xaa, 0xa2, 0xce, 0xc3, 0xb4, 0x24, 0x2e,
+	0x81, 0xad, 0x98, 0xad, 0x89, 0xd4, 0xcf, 0xd5, 0x46, 0xb5, 0xd3, 0x44, 0x3c, 0x07, 0x2f, 0x82,
+	0xcd, 0x98, 0xa9, 0xad, 0xa0, 0x13, 0xb5, 0xa6, 0x20, 0x7e, 0x61, 0xca, 0xa0, 0x36, 0x4e, 0x94,
+	0x36, 0x8d, 0xc8, 0x42, 0x01, 0x14, 0x63, 0x86, 0xda, 0x71, 0xbb, 0xd3, 0xac, 0xab, 0xd5, 0x06,
+	0x9f, 0x83, 0x45, 0xc0, 0xc7, 0x97, 0xf9, 0xb2, 0xa1, 0x20, 0x7e, 0x71, 0xca, 0xbf, 0x7a, 0x78,
+	0xa8, 0x1e, 0xa9, 0xd5, 0x8e, 0xc2, 0x2f, 0xc1, 0x12, 0x80, 0x71, 0xff, 0x07, 0x0d, 0x55, 0x3e,
+	0x6e, 0xf3, 0xcb, 0x53, 0xe9, 0xb6, 0x50, 0xf3, 0x44, 0x69, 0x54, 0x1b, 0x35, 0x85, 0x5f, 0x91,
+	0x9f, 0xbc, 0x7c, 0xb3, 0xc3, 0xbd, 0x7a, 0xb3, 0xc3, 0xfd, 0xf1, 0x66, 0x87, 0x7b, 0xfe, 0x76,
+	0x27, 0xf3, 0xea, 0xed, 0x4e, 0xe6, 0xb7, 0xb7, 0x3b, 0x19, 0x70, 0xc9, 0x72, 0xe7, 0x0c, 0x9e,
+	0x16, 0xf7, 0xd5, 0xdd, 0x33, 0xcb, 0x7f, 0xdc, 0x3f, 0x2d, 0x1b, 0x6e, 0xb7, 0x32, 0x71, 0xba,
+	0x65, 0xb9, 0x31, 0xa9, 0xf2, 0xcd, 0xe4, 0xcf, 0x95, 0x5e, 0x51, 0xe4, 0x74, 0x89, 0x31, 0xff,
+	0xce, 0x5f, 0x03, 0x00, 0xb7, 0xe1, 0x9d, 0xac, 0xdd, 0x0e, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -654,6 +908,28 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValueOwnerConstraint != nil {
+		{
+			size, err := m.ValueOwnerConstraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSpecification(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -669,20 +945,20 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartiesInvolved) > 0 {
-		dAtA2 := make([]byte, len(m.PartiesInvolved)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.PartiesInvolved)*10)
+		var j2 int
 		for _, num := range m.PartiesInvolved {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintSpecification(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSpecification(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -720,6 +996,57 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValueOwnerConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueOwnerConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueOwnerConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintSpecification(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedAttributes) > 0 {
+		for iNdEx := len(m.AllowedAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAttributes[iNdEx])
+			copy(dAtA[i:], m.AllowedAttributes[iNdEx])
+			i = encodeVarintSpecification(dAtA, i, uint64(len(m.AllowedAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AllowMarkers {
+		i--
+		if m.AllowMarkers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractSpecification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -757,20 +1084,20 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartiesInvolved) > 0 {
-		dAtA5 := make([]byte, len(m.PartiesInvolved)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.PartiesInvolved)*10)
+		var j5 int
 		for _, num := range m.PartiesInvolved {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintSpecification(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintSpecification(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -862,20 +1189,20 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.ResponsibleParties) > 0 {
-		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.ResponsibleParties)*10)
+		var j8 int
 		for _, num := range m.ResponsibleParties {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSpecification(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSpecification(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1004,7 +1331,7 @@ func (m *InputSpecification_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
-func (m *Description) Marshal() (dAtA []byte, err error) {
+func (m *SpecificationBundle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1014,75 +1341,200 @@ func (m *Description) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpecificationBundle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpecificationBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.IconUrl) > 0 {
-		i -= len(m.IconUrl)
-		copy(dAtA[i:], m.IconUrl)
-		i = encodeVarintSpecification(dAtA, i, uint64(len(m.IconUrl)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.WebsiteUrl) > 0 {
-		i -= len(m.WebsiteUrl)
-		copy(dAtA[i:], m.WebsiteUrl)
-		i = encodeVarintSpecification(dAtA, i, uint64(len(m.WebsiteUrl)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSpecification(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSpecification(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SpecificationDeprecation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ScopeSpecification) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SpecificationDeprecation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecificationDeprecation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovSpecification(uint64(l))
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovSpecification(uint64(l))
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SessionsAllowedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SessionsAllowedUntil):])
+	if err11 != nil {
+		return 0, err11
 	}
-	if len(m.OwnerAddresses) > 0 {
-		for _, s := range m.OwnerAddresses {
-			l = len(s)
-			n += 1 + l + sovSpecification(uint64(l))
-		}
+	i -= n11
+	i = encodeVarintSpecification(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DeprecatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DeprecatedTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintSpecification(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SuccessorId.Size()
+		i -= size
+		if _, err := m.SuccessorId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IconUrl) > 0 {
+		i -= len(m.IconUrl)
+		copy(dAtA[i:], m.IconUrl)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.IconUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WebsiteUrl) > 0 {
+		i -= len(m.WebsiteUrl)
+		copy(dAtA[i:], m.WebsiteUrl)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.WebsiteUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSpecification(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSpecification(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScopeSpecification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	if len(m.OwnerAddresses) > 0 {
+		for _, s := range m.OwnerAddresses {
+			l = len(s)
+			n += 1 + l + sovSpecification(uint64(l))
+		}
 	}
 	if len(m.PartiesInvolved) > 0 {
 		l = 0
@@ -1097,6 +1549,37 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	if m.Strict {
+		n += 2
+	}
+	if m.ValueOwnerConstraint != nil {
+		l = m.ValueOwnerConstraint.Size()
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueOwnerConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowMarkers {
+		n += 2
+	}
+	if len(m.AllowedAttributes) > 0 {
+		for _, s := range m.AllowedAttributes {
+			l = len(s)
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1230,6 +1713,49 @@ func (m *InputSpecification_Hash) Size() (n int) {
 	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
+func (m *SpecificationBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSpecification(uint64(m.Version))
+	}
+	l = m.ContractSpecification.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpecificationDeprecation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	l = m.SuccessorId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DeprecatedTime)
+	n += 1 + l + sovSpecification(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SessionsAllowedUntil)
+	n += 1 + l + sovSpecification(uint64(l))
+	return n
+}
+
 func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1495,6 +2021,62 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerConstraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueOwnerConstraint == nil {
+				m.ValueOwnerConstraint = &ValueOwnerConstraint{}
+			}
+			if err := m.ValueOwnerConstraint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -1516,7 +2098,7 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnerConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,17 +2121,17 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSpecification: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnerConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSpecification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnerConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMarkers", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSpecification
@@ -1559,30 +2141,17 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSpecification
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSpecification
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllowMarkers = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAttributes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSpecification
@@ -1592,31 +2161,27 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSpecification
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSpecification
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedAttributes = append(m.AllowedAttributes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1644,23 +2209,174 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddresses = append(m.OwnerAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v PartyType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSpecification
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= PartyType(b&0x7F) << shift
-					if b < 0x80 {
-						break
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSpecification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSpecification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddresses = append(m.OwnerAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v PartyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSpecification
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PartyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
 				m.PartiesInvolved = append(m.PartiesInvolved, v)
@@ -2287,6 +3003,358 @@ func (m *InputSpecification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpecificationBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecificationBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecificationBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecifications = append(m.RecordSpecifications, RecordSpecification{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecifications = append(m.ScopeSpecifications, ScopeSpecification{})
+			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecificationDeprecation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecificationDeprecation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecificationDeprecation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SuccessorId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DeprecatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsAllowedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SessionsAllowedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Description) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
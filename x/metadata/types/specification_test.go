@@ -39,6 +39,12 @@ func (s *SpecificationTestSuite) SetupSuite() {
 }
 
 func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
+	withConstraint := func(constraint *ValueOwnerConstraint) *ScopeSpecification {
+		spec := NewScopeSpecification(ScopeSpecMetadataAddress(uuid.New()), nil, []string{specTestBech32},
+			[]PartyType{PartyType_PARTY_TYPE_OWNER}, []MetadataAddress{})
+		spec.ValueOwnerConstraint = constraint
+		return spec
+	}
 	tests := []struct {
 		name string
 		spec *ScopeSpecification
@@ -159,6 +165,37 @@ func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
 			),
 			"invalid contract specification id prefix at index 2 (expected: contractspec, got scope)",
 		},
+		// ValueOwnerConstraint tests
+		{
+			"value owner constraint - nothing allowed",
+			withConstraint(&ValueOwnerConstraint{}),
+			"invalid value owner constraint on ScopeSpecification: at least one of allow markers, allowed attributes or allowed addresses must be set",
+		},
+		{
+			"value owner constraint - empty attribute",
+			withConstraint(&ValueOwnerConstraint{AllowedAttributes: []string{"custodian.regulated", " "}}),
+			"invalid value owner constraint on ScopeSpecification: allowed attribute at index 1 cannot be empty",
+		},
+		{
+			"value owner constraint - duplicate attribute",
+			withConstraint(&ValueOwnerConstraint{AllowedAttributes: []string{"custodian.regulated", "custodian.regulated"}}),
+			"invalid value owner constraint on ScopeSpecification: allowed attribute custodian.regulated is duplicated",
+		},
+		{
+			"value owner constraint - invalid address",
+			withConstraint(&ValueOwnerConstraint{AllowedAddresses: []string{":invalid"}}),
+			"invalid value owner constraint on ScopeSpecification: invalid allowed address at index 0: decoding bech32 failed: invalid index of 1",
+		},
+		{
+			"value owner constraint - duplicate address",
+			withConstraint(&ValueOwnerConstraint{AllowedAddresses: []string{specTestBech32, specTestBech32}}),
+			fmt.Sprintf("invalid value owner constraint on ScopeSpecification: allowed address %s is duplicated", specTestBech32),
+		},
+		{
+			"value owner constraint - valid",
+			withConstraint(&ValueOwnerConstraint{AllowMarkers: true, AllowedAttributes: []string{"custodian.regulated"}, AllowedAddresses: []string{specTestBech32}}),
+			"",
+		},
 		// Simple valid case
 		{
 			"simple valid case",
@@ -1133,6 +1170,98 @@ func (s *SpecificationTestSuite) TestDescriptionValidateBasic() {
 	}
 }
 
+func (s *SpecificationTestSuite) TestSpecificationBundleValidateBasic() {
+	contractSpecUUID := uuid.New()
+	contractSpec := ContractSpecification{
+		SpecificationId: ContractSpecMetadataAddress(contractSpecUUID),
+		OwnerAddresses:  []string{specTestBech32},
+		PartiesInvolved: []PartyType{PartyType_PARTY_TYPE_OWNER},
+		Source:          NewContractSpecificationSourceHash("somesource"),
+		ClassName:       "someclass",
+	}
+	recordSpec := func(contractSpecUUID uuid.UUID, name string) RecordSpecification {
+		return RecordSpecification{
+			SpecificationId:    RecordSpecMetadataAddress(contractSpecUUID, name),
+			Name:               name,
+			Inputs:             []*InputSpecification{},
+			TypeName:           "recspectypename",
+			ResultType:         DefinitionType_DEFINITION_TYPE_RECORD,
+			ResponsibleParties: []PartyType{PartyType_PARTY_TYPE_OWNER},
+		}
+	}
+	scopeSpec := func(contractSpecIDs ...MetadataAddress) ScopeSpecification {
+		return ScopeSpecification{
+			SpecificationId: ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{specTestBech32},
+			PartiesInvolved: []PartyType{PartyType_PARTY_TYPE_OWNER},
+			ContractSpecIds: contractSpecIDs,
+		}
+	}
+	otherContractSpecUUID := uuid.New()
+	badVersion := NewSpecificationBundle(contractSpec, nil, nil)
+	badVersion.Version = 2
+
+	tests := []struct {
+		name   string
+		bundle *SpecificationBundle
+		want   string
+	}{
+		{
+			"valid - contract spec only",
+			NewSpecificationBundle(contractSpec, nil, nil),
+			"",
+		},
+		{
+			"valid - all specs",
+			NewSpecificationBundle(contractSpec,
+				[]RecordSpecification{recordSpec(contractSpecUUID, "one"), recordSpec(contractSpecUUID, "two")},
+				[]ScopeSpecification{scopeSpec(contractSpec.SpecificationId)}),
+			"",
+		},
+		{
+			"invalid - unsupported version",
+			badVersion,
+			fmt.Sprintf("unsupported specification bundle version (expected: %d, got 2)", SpecificationBundleVersion),
+		},
+		{
+			"invalid - contract spec",
+			NewSpecificationBundle(ContractSpecification{}, nil, nil),
+			"invalid contract specification id: address is empty",
+		},
+		{
+			"invalid - record spec for another contract spec",
+			NewSpecificationBundle(contractSpec, []RecordSpecification{recordSpec(otherContractSpecUUID, "one")}, nil),
+			fmt.Sprintf("record specification %s at index 0 does not belong to contract specification %s",
+				RecordSpecMetadataAddress(otherContractSpecUUID, "one"), contractSpec.SpecificationId),
+		},
+		{
+			"invalid - duplicate record spec name",
+			NewSpecificationBundle(contractSpec,
+				[]RecordSpecification{recordSpec(contractSpecUUID, "one"), recordSpec(contractSpecUUID, "one")}, nil),
+			"record specification name one provided twice",
+		},
+		{
+			"invalid - scope spec does not reference contract spec",
+			NewSpecificationBundle(contractSpec, nil,
+				[]ScopeSpecification{scopeSpec(ContractSpecMetadataAddress(otherContractSpecUUID))}),
+			"does not reference contract specification",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		s.T().Run(tt.name, func(t *testing.T) {
+			err := tt.bundle.ValidateBasic()
+			if len(tt.want) == 0 {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.want)
+			}
+		})
+	}
+}
+
 func (s *SpecificationTestSuite) TestScopeSpecString() {
 	scopeSpecUuid := uuid.MustParse("c2074a03-6f6d-4029-bfe2-c3a5eb7e68b1")
 	contractSpecUuid := uuid.MustParse("540dadf1-3dbc-4c3f-a205-7575b7f74384")
@@ -1160,6 +1289,8 @@ parties_involved:
 - 5
 contract_spec_ids:
 - contractspec1qd2qmt038k7yc0azq46htdlhgwzqg6cr9l
+strict: false
+value_owner_constraint: null
 `
 	actual := scopeSpec.String()
 	// fmt.Printf("Actual:\n%s\n-----\n", actual)
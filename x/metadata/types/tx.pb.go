@@ -46,6 +46,20 @@ type MsgWriteScopeRequest struct {
 	// If there is a value in scope.specification_id that is different from the one created from this uuid, an error is
 	// returned.
 	SpecUuid string `protobuf:"bytes,4,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+	// value_owner_as_coin is an optional flag to represent value ownership of the scope with a coin.
+	// If true, a marker with a fixed supply of one is created using the scope id as its denom, the single coin is sent to
+	// the scope's value_owner_address, and the scope's value owner becomes that marker. From then on, value ownership is
+	// transferred by transferring the coin. An existing scope can be converted this way with a signature from its current
+	// value owner.
+	ValueOwnerAsCoin bool `protobuf:"varint,5,opt,name=value_owner_as_coin,json=valueOwnerAsCoin,proto3" json:"value_owner_as_coin,omitempty" yaml:"value_owner_as_coin"`
+	// expected_version is an optional version the existing scope must be at for the write to succeed.
+	// If greater than zero and the scope's current version differs, the write fails with a scope version conflict so that
+	// concurrent writers don't overwrite each other's changes.
+	ExpectedVersion uint64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty" yaml:"expected_version"`
+	// idempotency_key is an optional client-supplied key identifying this write.
+	// A request repeated by the same signers with the same key and content within a short time of the first succeeds
+	// without writing anything again, so that clients can safely retry a write after a timeout.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" yaml:"idempotency_key"`
 }
 
 func (m *MsgWriteScopeRequest) Reset()      { *m = MsgWriteScopeRequest{} }
@@ -736,6 +750,14 @@ type MsgWriteRecordRequest struct {
 	ContractSpecUuid string `protobuf:"bytes,4,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
 	// parties is the list of parties involved with this record.
 	Parties []Party `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties"`
+	// expected_scope_version is an optional version the record's scope must be at for the write to succeed.
+	// If greater than zero and the scope's current version differs, the write fails with a scope version conflict so that
+	// concurrent writers don't overwrite each other's changes.
+	ExpectedScopeVersion uint64 `protobuf:"varint,6,opt,name=expected_scope_version,json=expectedScopeVersion,proto3" json:"expected_scope_version,omitempty" yaml:"expected_scope_version"`
+	// idempotency_key is an optional client-supplied key identifying this write.
+	// A request repeated by the same signers with the same key and content within a short time of the first succeeds
+	// without writing anything again, so that clients can safely retry a write after a timeout.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" yaml:"idempotency_key"`
 }
 
 func (m *MsgWriteRecordRequest) Reset()      { *m = MsgWriteRecordRequest{} }
@@ -816,6 +838,118 @@ func (m *MsgWriteRecordResponse) GetRecordIdInfo() *RecordIdInfo {
 	return nil
 }
 
+// MsgWriteSessionAndRecordsRequest is the request type for the Msg/WriteSessionAndRecords RPC method.
+type MsgWriteSessionAndRecordsRequest struct {
+	// session is the Session you want added or updated.
+	Session Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session"`
+	// records are the Records you want added or updated in the session. Any record without a session_id is assigned to
+	// the session. The parties of the session are used as the parties involved with each record.
+	Records []Record `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
+	// session. If provided, it must have both a scope and session_uuid. Those components will be used to create the
+	// MetadataAddress for the session which will override the session_id in the provided session. If not provided (or
+	// all empty), nothing special happens.
+	// If there is a value in session.session_id that is different from the one created from these components, an error is
+	// returned.
+	SessionIdComponents *SessionIdComponents `protobuf:"bytes,4,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty" yaml:"session_id_components"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification of the session and,
+	// combined with each record name, the record specifications of the records. These will override the
+	// specification_id values in the provided session and records. If not provided (or it is an empty string), nothing
+	// special happens.
+	// If there is a specification_id value that is different from the one created from this uuid, an error is returned.
+	SpecUuid string `protobuf:"bytes,5,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+}
+
+func (m *MsgWriteSessionAndRecordsRequest) Reset()      { *m = MsgWriteSessionAndRecordsRequest{} }
+func (*MsgWriteSessionAndRecordsRequest) ProtoMessage() {}
+func (*MsgWriteSessionAndRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{17}
+}
+func (m *MsgWriteSessionAndRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteSessionAndRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteSessionAndRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteSessionAndRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionAndRecordsRequest.Merge(m, src)
+}
+func (m *MsgWriteSessionAndRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteSessionAndRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionAndRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteSessionAndRecordsRequest proto.InternalMessageInfo
+
+// MsgWriteSessionAndRecordsResponse is the response type for the Msg/WriteSessionAndRecords RPC method.
+type MsgWriteSessionAndRecordsResponse struct {
+	// session_id_info contains information about the id/address of the session that was added or updated.
+	SessionIdInfo *SessionIdInfo `protobuf:"bytes,1,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
+	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,2,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
+}
+
+func (m *MsgWriteSessionAndRecordsResponse) Reset()         { *m = MsgWriteSessionAndRecordsResponse{} }
+func (m *MsgWriteSessionAndRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteSessionAndRecordsResponse) ProtoMessage()    {}
+func (*MsgWriteSessionAndRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{18}
+}
+func (m *MsgWriteSessionAndRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteSessionAndRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteSessionAndRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteSessionAndRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionAndRecordsResponse.Merge(m, src)
+}
+func (m *MsgWriteSessionAndRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteSessionAndRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionAndRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteSessionAndRecordsResponse proto.InternalMessageInfo
+
+func (m *MsgWriteSessionAndRecordsResponse) GetSessionIdInfo() *SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfo
+	}
+	return nil
+}
+
+func (m *MsgWriteSessionAndRecordsResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
+	}
+	return nil
+}
+
 // MsgDeleteRecordRequest is the request type for the Msg/DeleteRecord RPC method.
 type MsgDeleteRecordRequest struct {
 	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
@@ -825,7 +959,7 @@ type MsgDeleteRecordRequest struct {
 func (m *MsgDeleteRecordRequest) Reset()      { *m = MsgDeleteRecordRequest{} }
 func (*MsgDeleteRecordRequest) ProtoMessage() {}
 func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{17}
+	return fileDescriptor_3a3a0892f91e3036, []int{19}
 }
 func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +996,7 @@ func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse
 func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{18}
+	return fileDescriptor_3a3a0892f91e3036, []int{20}
 }
 func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,32 +1025,27 @@ func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationRequest struct {
-	// specification is the ScopeSpecification you want added or updated.
-	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+// MsgAddRecordDataAccessRequest is the request to add data access AccAddress to a record
+type MsgAddRecordDataAccessRequest struct {
+	// record MetadataAddress for updating data access
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// AccAddress addresses to be added to the record
+	DataAccess []string `protobuf:"bytes,2,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty" yaml:"data_access"`
 	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
-	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Reset()      { *m = MsgWriteScopeSpecificationRequest{} }
-func (*MsgWriteScopeSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{19}
+func (m *MsgAddRecordDataAccessRequest) Reset()      { *m = MsgAddRecordDataAccessRequest{} }
+func (*MsgAddRecordDataAccessRequest) ProtoMessage() {}
+func (*MsgAddRecordDataAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{21}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddRecordDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddRecordDataAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddRecordDataAccessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -926,36 +1055,34 @@ func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
+func (m *MsgAddRecordDataAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRecordDataAccessRequest.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
+func (m *MsgAddRecordDataAccessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
+func (m *MsgAddRecordDataAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRecordDataAccessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddRecordDataAccessRequest proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationResponse struct {
-	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
-	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+// MsgAddRecordDataAccessResponse is the response for adding data access AccAddress to a record
+type MsgAddRecordDataAccessResponse struct {
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
-func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{20}
+func (m *MsgAddRecordDataAccessResponse) Reset()         { *m = MsgAddRecordDataAccessResponse{} }
+func (m *MsgAddRecordDataAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRecordDataAccessResponse) ProtoMessage()    {}
+func (*MsgAddRecordDataAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{22}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddRecordDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddRecordDataAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddRecordDataAccessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -965,43 +1092,39 @@ func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
+func (m *MsgAddRecordDataAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRecordDataAccessResponse.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
+func (m *MsgAddRecordDataAccessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *MsgAddRecordDataAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRecordDataAccessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
-
-func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
-	if m != nil {
-		return m.ScopeSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgAddRecordDataAccessResponse proto.InternalMessageInfo
 
-// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationRequest struct {
-	// MetadataAddress for the scope specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgDeleteRecordDataAccessRequest is the request to remove data access AccAddress from a record
+type MsgDeleteRecordDataAccessRequest struct {
+	// record MetadataAddress for removing data access
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// AccAddress addresses to be removed from the record
+	DataAccess []string `protobuf:"bytes,2,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty" yaml:"data_access"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Reset()      { *m = MsgDeleteScopeSpecificationRequest{} }
-func (*MsgDeleteScopeSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{21}
+func (m *MsgDeleteRecordDataAccessRequest) Reset()      { *m = MsgDeleteRecordDataAccessRequest{} }
+func (*MsgDeleteRecordDataAccessRequest) ProtoMessage() {}
+func (*MsgDeleteRecordDataAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordDataAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordDataAccessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1011,34 +1134,34 @@ func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
+func (m *MsgDeleteRecordDataAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordDataAccessRequest.Merge(m, src)
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
+func (m *MsgDeleteRecordDataAccessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordDataAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordDataAccessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordDataAccessRequest proto.InternalMessageInfo
 
-// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationResponse struct {
+// MsgDeleteRecordDataAccessResponse is the response from removing data access AccAddress from a record
+type MsgDeleteRecordDataAccessResponse struct {
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
-func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{22}
+func (m *MsgDeleteRecordDataAccessResponse) Reset()         { *m = MsgDeleteRecordDataAccessResponse{} }
+func (m *MsgDeleteRecordDataAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordDataAccessResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordDataAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordDataAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordDataAccessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1048,44 +1171,39 @@ func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
+func (m *MsgDeleteRecordDataAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordDataAccessResponse.Merge(m, src)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
+func (m *MsgDeleteRecordDataAccessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordDataAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordDataAccessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordDataAccessResponse proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationRequest struct {
-	// specification is the ContractSpecification you want added or updated.
-	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+// MsgRedactRecordRequest is the request type for the Msg/RedactRecord RPC method.
+type MsgRedactRecordRequest struct {
+	// record MetadataAddress of the record to redact
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// reason the record is being redacted.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteContractSpecificationRequest) Reset()      { *m = MsgWriteContractSpecificationRequest{} }
-func (*MsgWriteContractSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+func (m *MsgRedactRecordRequest) Reset()      { *m = MsgRedactRecordRequest{} }
+func (*MsgRedactRecordRequest) ProtoMessage() {}
+func (*MsgRedactRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedactRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedactRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedactRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1095,37 +1213,34 @@ func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
+func (m *MsgRedactRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedactRecordRequest.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
+func (m *MsgRedactRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
+func (m *MsgRedactRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedactRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedactRecordRequest proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationResponse struct {
-	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
-	// updated.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+// MsgRedactRecordResponse is the response type for the Msg/RedactRecord RPC method.
+type MsgRedactRecordResponse struct {
 }
 
-func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
-func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+func (m *MsgRedactRecordResponse) Reset()         { *m = MsgRedactRecordResponse{} }
+func (m *MsgRedactRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedactRecordResponse) ProtoMessage()    {}
+func (*MsgRedactRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedactRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedactRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedactRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1135,45 +1250,39 @@ func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
+func (m *MsgRedactRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedactRecordResponse.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgRedactRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgRedactRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedactRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
-
-func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
-	if m != nil {
-		return m.ContractSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgRedactRecordResponse proto.InternalMessageInfo
 
-// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgArchiveScopeRequest is the request type for the Msg/ArchiveScope RPC method.
+type MsgArchiveScopeRequest struct {
+	// scope MetadataAddress of the scope to archive
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// locator_uri is the object store uri the archived content is stored at.
+	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty" yaml:"locator_uri"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Reset()      { *m = MsgAddContractSpecToScopeSpecRequest{} }
-func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage() {}
-func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
+func (m *MsgArchiveScopeRequest) Reset()      { *m = MsgArchiveScopeRequest{} }
+func (*MsgArchiveScopeRequest) ProtoMessage() {}
+func (*MsgArchiveScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgArchiveScopeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgArchiveScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgArchiveScopeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1183,34 +1292,36 @@ func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
+func (m *MsgArchiveScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveScopeRequest.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
+func (m *MsgArchiveScopeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgArchiveScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveScopeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgArchiveScopeRequest proto.InternalMessageInfo
 
-// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecResponse struct {
+// MsgArchiveScopeResponse is the response type for the Msg/ArchiveScope RPC method.
+type MsgArchiveScopeResponse struct {
+	// content_hash is the base64 encoded sha256 hash of the archived content, needed to restore it.
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty" yaml:"content_hash"`
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
-func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
-func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+func (m *MsgArchiveScopeResponse) Reset()         { *m = MsgArchiveScopeResponse{} }
+func (m *MsgArchiveScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveScopeResponse) ProtoMessage()    {}
+func (*MsgArchiveScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgArchiveScopeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgArchiveScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgArchiveScopeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,40 +1331,44 @@ func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
+func (m *MsgArchiveScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveScopeResponse.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
+func (m *MsgArchiveScopeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgArchiveScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveScopeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgArchiveScopeResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
-type MsgDeleteContractSpecFromScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgArchiveScopeResponse) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
+// MsgRestoreScopeRequest is the request type for the Msg/RestoreScope RPC method.
+type MsgRestoreScopeRequest struct {
+	// content is the archived sessions and records of the scope to restore.
+	Content ScopeArchiveContent `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+
+func (m *MsgRestoreScopeRequest) Reset()      { *m = MsgRestoreScopeRequest{} }
+func (*MsgRestoreScopeRequest) ProtoMessage() {}
+func (*MsgRestoreScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgRestoreScopeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRestoreScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRestoreScopeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1263,39 +1378,34 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
+func (m *MsgRestoreScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreScopeRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
+func (m *MsgRestoreScopeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgRestoreScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreScopeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgRestoreScopeRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
-// method.
-type MsgDeleteContractSpecFromScopeSpecResponse struct {
+// MsgRestoreScopeResponse is the response type for the Msg/RestoreScope RPC method.
+type MsgRestoreScopeResponse struct {
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
-}
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+func (m *MsgRestoreScopeResponse) Reset()         { *m = MsgRestoreScopeResponse{} }
+func (m *MsgRestoreScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreScopeResponse) ProtoMessage()    {}
+func (*MsgRestoreScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRestoreScopeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRestoreScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRestoreScopeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,36 +1415,44 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
+func (m *MsgRestoreScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreScopeResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
+func (m *MsgRestoreScopeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgRestoreScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreScopeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRestoreScopeResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationRequest struct {
-	// MetadataAddress for the contract specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationRequest struct {
+	// specification is the ScopeSpecification you want added or updated.
+	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
+	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
-func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+func (m *MsgWriteScopeSpecificationRequest) Reset()      { *m = MsgWriteScopeSpecificationRequest{} }
+func (*MsgWriteScopeSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1344,36 +1462,36 @@ func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
+func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationResponse struct {
+// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationResponse struct {
+	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
+	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Reset() {
-	*m = MsgDeleteContractSpecificationResponse{}
-}
-func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
+func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1383,44 +1501,43 @@ func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
+func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationRequest struct {
-	// specification is the RecordSpecification you want added or updated.
-	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
-	// specification which will override the specification_id in the provided specification. If not provided (or it is an
-	// empty string), nothing special happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid and
-	// specification.name, an error is returned.
-	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
+func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
+	if m != nil {
+		return m.ScopeSpecIdInfo
+	}
+	return nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
-func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationRequest struct {
+	// MetadataAddress for the scope specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgDeleteScopeSpecificationRequest) Reset()      { *m = MsgDeleteScopeSpecificationRequest{} }
+func (*MsgDeleteScopeSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
+}
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1430,37 +1547,34 @@ func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationResponse struct {
-	// record_spec_id_info contains information about the id/address of the record specification that was added or
-	// updated.
-	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
+// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationResponse struct {
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
-func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
+func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1470,43 +1584,44 @@ func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
-	if m != nil {
-		return m.RecordSpecIdInfo
-	}
-	return nil
+// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationRequest struct {
+	// specification is the ContractSpecification you want added or updated.
+	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
 }
 
-// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationRequest struct {
-	// MetadataAddress for the record specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgWriteContractSpecificationRequest) Reset()      { *m = MsgWriteContractSpecificationRequest{} }
+func (*MsgWriteContractSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
-
-func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
-func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
-}
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1516,34 +1631,37 @@ func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationResponse struct {
+// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
-func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
+func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1553,36 +1671,45 @@ func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
 
-// MsgWriteP8eContractSpecRequest is the request type for the Msg/WriteP8eContractSpec RPC method.
-type MsgWriteP8EContractSpecRequest struct {
-	// ContractSpec v39 p8e ContractSpect to be converted into a v40
-	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
-	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+	if m != nil {
+		return m.ContractSpecIdInfo
+	}
+	return nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
-func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
-func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Reset()      { *m = MsgAddContractSpecToScopeSpecRequest{} }
+func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage() {}
+func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
+}
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1592,40 +1719,34 @@ func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
 
-// MsgWriteP8eContractSpecResponse is the response type for the Msg/WriteP8eContractSpec RPC method.
-type MsgWriteP8EContractSpecResponse struct {
-	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
-	// updated.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
-	// record_spec_id_infos contains information about the ids/addresses of the record specifications that were added or
-	// updated.
-	RecordSpecIdInfos []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty" yaml:"record_spec_id_infos"`
+// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecResponse struct {
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
-func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
-func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
+func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
+func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1635,61 +1756,40 @@ func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
-
-func (m *MsgWriteP8EContractSpecResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
-	if m != nil {
-		return m.ContractSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
 
-func (m *MsgWriteP8EContractSpecResponse) GetRecordSpecIdInfos() []*RecordSpecIdInfo {
-	if m != nil {
-		return m.RecordSpecIdInfos
-	}
-	return nil
+// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
+type MsgDeleteContractSpecFromScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-// MsgP8eMemorializeContractRequest is the request type for the Msg/P8eMemorializeContract RPC method.
-type MsgP8EMemorializeContractRequest struct {
-	// The scope id of the object being add or modified on blockchain.
-	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	// The uuid of the contract execution.
-	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// The scope specification id.
-	ScopeSpecificationId string `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
-	// The new recitals for the scope.  Used in leu of Contract for direct ownership changes.
-	Recitals *p8e.Recitals `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
-	// The executed contract.
-	Contract *p8e.Contract `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
-	// The contract signatures
-	Signatures *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
-	// The bech32 address of the notary (ie the broadcaster of this message).
-	Invoker string `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
 }
-
-func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
-func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
-func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1699,40 +1799,39 @@ func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
 
-// MsgP8eMemorializeContractResponse is the response type for the Msg/P8eMemorializeContract RPC method.
-type MsgP8EMemorializeContractResponse struct {
-	// scope_id_info contains information about the id/address of the scope that was added or updated.
-	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
-	// session_id_info contains information about the id/address of the session that was added or updated.
-	SessionIdInfo *SessionIdInfo `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
-	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
-	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
+// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
+// method.
+type MsgDeleteContractSpecFromScopeSpecResponse struct {
 }
 
-func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
-func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
-func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
+}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1742,57 +1841,36 @@ func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
 
-func (m *MsgP8EMemorializeContractResponse) GetScopeIdInfo() *ScopeIdInfo {
-	if m != nil {
-		return m.ScopeIdInfo
-	}
-	return nil
+// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationRequest struct {
+	// MetadataAddress for the contract specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgP8EMemorializeContractResponse) GetSessionIdInfo() *SessionIdInfo {
-	if m != nil {
-		return m.SessionIdInfo
-	}
-	return nil
-}
-
-func (m *MsgP8EMemorializeContractResponse) GetRecordIdInfos() []*RecordIdInfo {
-	if m != nil {
-		return m.RecordIdInfos
-	}
-	return nil
-}
-
-// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
-}
-
-func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
-func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
-func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
+func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1802,35 +1880,36 @@ func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationResponse struct {
 }
 
-func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
-func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
-func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+func (m *MsgDeleteContractSpecificationResponse) Reset() {
+	*m = MsgDeleteContractSpecificationResponse{}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
+}
+func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1840,43 +1919,44 @@ func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgBindOSLocatorResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
-
-func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
-}
+var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
 
-// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorRequest struct {
-	// The record being removed
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationRequest struct {
+	// specification is the RecordSpecification you want added or updated.
+	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
+	// specification which will override the specification_id in the provided specification. If not provided (or it is an
+	// empty string), nothing special happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid and
+	// specification.name, an error is returned.
+	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
 }
 
-func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
-func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
+func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1886,35 +1966,37 @@ func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
+func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationResponse struct {
+	// record_spec_id_info contains information about the id/address of the record specification that was added or
+	// updated.
+	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
 }
 
-func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
-func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
+func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1924,43 +2006,43 @@ func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
+func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
 	if m != nil {
-		return m.Locator
+		return m.RecordSpecIdInfo
 	}
-	return ObjectStoreLocator{}
+	return nil
 }
 
-// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationRequest struct {
+	// MetadataAddress for the record specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
-func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
-func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
+func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1970,35 +2052,34 @@ func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationResponse struct {
 }
 
-func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
-func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
-func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
+func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2008,2272 +2089,2493 @@ func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
+// MsgWriteSpecificationBundleRequest is the request type for the Msg/WriteSpecificationBundle RPC method.
+type MsgWriteSpecificationBundleRequest struct {
+	// bundle is the SpecificationBundle you want added or updated.
+	Bundle SpecificationBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
-	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
-	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
-	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
-	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
-	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
-	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
-	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
-	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
-	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
-	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
-	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
-	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
-	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
-	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
-	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
-	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
-	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
-	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
-	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
-	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
-	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
-	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
-	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
-	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
-	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
-	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
-	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
-	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
-	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
-	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
-	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
-	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
-	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
-	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
-	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
-	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
-	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
-	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
-	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+func (m *MsgWriteSpecificationBundleRequest) Reset()      { *m = MsgWriteSpecificationBundleRequest{} }
+func (*MsgWriteSpecificationBundleRequest) ProtoMessage() {}
+func (*MsgWriteSpecificationBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
-
-func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
-
-var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xdf, 0xbb, 0xdb, 0xc4, 0xf6, 0xb1, 0x8d, 0x9d, 0x1b, 0x7f, 0xec, 0x4e, 0x9a, 0x1d, 0x73,
-	0x9b, 0xb4, 0xae, 0xd3, 0xec, 0x12, 0x37, 0x34, 0x8e, 0x9b, 0x00, 0xd9, 0x16, 0x14, 0x43, 0xad,
-	0x44, 0x63, 0xa0, 0x02, 0x09, 0x45, 0x9b, 0x9d, 0x6b, 0x67, 0xa8, 0x3d, 0x77, 0x3b, 0x33, 0xce,
-	0x17, 0x0f, 0xa5, 0x12, 0x0f, 0x51, 0x85, 0x50, 0x01, 0xa9, 0xa2, 0x12, 0xaa, 0xf2, 0xd8, 0x07,
-	0x24, 0x3e, 0x1e, 0x11, 0x7f, 0x40, 0x85, 0x84, 0xd4, 0x17, 0x24, 0x54, 0xd0, 0xaa, 0x4a, 0x24,
-	0xc4, 0xf3, 0x3e, 0xf0, 0x8c, 0x66, 0xe6, 0xce, 0xce, 0xbd, 0xbb, 0x77, 0x3e, 0x76, 0xeb, 0x06,
-	0x23, 0xf1, 0x10, 0x29, 0x33, 0x7b, 0xbe, 0x7e, 0xe7, 0xfe, 0xee, 0x39, 0xf7, 0x9e, 0x31, 0xe8,
-	0x6d, 0x87, 0xdd, 0xa6, 0x76, 0xd3, 0x6e, 0xd1, 0xfa, 0x1e, 0xf5, 0x9a, 0x66, 0xd3, 0x6b, 0xd6,
-	0x6f, 0x9f, 0xab, 0x7b, 0x77, 0x6b, 0x6d, 0x87, 0x79, 0x0c, 0x2f, 0xc4, 0x02, 0xb5, 0x48, 0xa0,
-	0x76, 0xfb, 0x9c, 0x36, 0xb7, 0xc3, 0x76, 0x58, 0x20, 0x52, 0xf7, 0xff, 0x17, 0x4a, 0x6b, 0xa7,
-	0x13, 0xcc, 0xf5, 0x34, 0x43, 0xb1, 0xe5, 0x04, 0x31, 0x76, 0xf3, 0x87, 0xb4, 0xe5, 0xb9, 0x1e,
-	0x73, 0x28, 0x97, 0x3c, 0x95, 0x20, 0xd9, 0x5e, 0xa3, 0xfe, 0x3f, 0x2e, 0x45, 0x12, 0xa4, 0xdc,
-	0x16, 0x6b, 0x47, 0x32, 0x2b, 0x49, 0x32, 0x6d, 0xda, 0xb2, 0xb6, 0xad, 0x56, 0xd3, 0xb3, 0x98,
-	0x1d, 0xca, 0x92, 0x7f, 0x22, 0x98, 0xdb, 0x74, 0x77, 0x5e, 0x77, 0x2c, 0x8f, 0x6e, 0xf9, 0x36,
-	0x0c, 0xfa, 0xe6, 0x3e, 0x75, 0x3d, 0x7c, 0x11, 0x8e, 0x04, 0x36, 0xcb, 0x68, 0x09, 0x2d, 0x4f,
-	0xae, 0x9e, 0xac, 0xa9, 0xb3, 0x53, 0x0b, 0x94, 0x1a, 0x4f, 0x7d, 0xd4, 0xd1, 0x0b, 0x46, 0xa8,
-	0x81, 0xcb, 0x30, 0xe6, 0x5a, 0x3b, 0x36, 0x75, 0xdc, 0x72, 0x71, 0xa9, 0xb4, 0x3c, 0x61, 0x44,
-	0x8f, 0xf8, 0x3c, 0x40, 0x20, 0x72, 0x63, 0x7f, 0xdf, 0x32, 0xcb, 0xa5, 0x25, 0xb4, 0x3c, 0xd1,
-	0x98, 0xef, 0x76, 0xf4, 0x63, 0xf7, 0x9a, 0x7b, 0xbb, 0xeb, 0x24, 0xfe, 0x8d, 0x18, 0x13, 0xc1,
-	0xc3, 0x77, 0xf6, 0x2d, 0x13, 0x9f, 0x83, 0x09, 0x3f, 0xf4, 0x50, 0xe9, 0xa9, 0x40, 0x69, 0xae,
-	0xdb, 0xd1, 0x67, 0xb9, 0x52, 0xf4, 0x13, 0x31, 0xc6, 0xfd, 0xff, 0xfb, 0x2a, 0xeb, 0xb3, 0x0f,
-	0x1e, 0xea, 0x85, 0x5f, 0x3d, 0xd4, 0x0b, 0xff, 0x7a, 0xa8, 0x17, 0x7e, 0xfc, 0x8f, 0xa5, 0x02,
-	0xb9, 0x0f, 0xf3, 0x7d, 0x38, 0xdd, 0x36, 0xb3, 0x5d, 0x8a, 0x9b, 0x30, 0x1d, 0xfa, 0xb5, 0xcc,
-	0x1b, 0x96, 0xbd, 0xcd, 0x38, 0xe0, 0x67, 0x52, 0x01, 0x6f, 0x98, 0x1b, 0xf6, 0x36, 0x6b, 0x94,
-	0xbb, 0x1d, 0x7d, 0x4e, 0x8c, 0x9d, 0xdb, 0x20, 0xc6, 0xa4, 0x1b, 0x8b, 0x91, 0x77, 0x50, 0xe0,
-	0xfc, 0x55, 0xba, 0x4b, 0xfb, 0xb2, 0xfc, 0x75, 0x18, 0x8f, 0x14, 0x03, 0xbf, 0x53, 0x8d, 0x15,
-	0x3f, 0x93, 0x9f, 0x74, 0xf4, 0x99, 0x4d, 0xee, 0xf3, 0x8a, 0x69, 0x3a, 0xd4, 0x75, 0xbb, 0x1d,
-	0x7d, 0x46, 0xf6, 0x44, 0x8c, 0x31, 0xee, 0x24, 0x39, 0xe3, 0x8a, 0x44, 0x94, 0x61, 0xa1, 0x3f,
-	0x96, 0x30, 0x13, 0xe4, 0xcf, 0x08, 0x9e, 0xde, 0x74, 0x77, 0xae, 0x98, 0x66, 0xf0, 0xfe, 0x55,
-	0xdf, 0x79, 0xab, 0x45, 0x5d, 0xf7, 0x80, 0xa3, 0xbd, 0x00, 0x93, 0xbe, 0xe8, 0x8d, 0x66, 0x60,
-	0x3c, 0x8c, 0xb8, 0xb1, 0xd0, 0xed, 0xe8, 0x38, 0x54, 0x11, 0x7e, 0x24, 0x06, 0x98, 0xbd, 0x30,
-	0x44, 0x98, 0xa5, 0x2c, 0x98, 0x3a, 0x9c, 0x4c, 0xc0, 0xc2, 0xd1, 0xfe, 0x05, 0x81, 0x2e, 0x27,
-	0xe2, 0x7f, 0x1b, 0x30, 0x81, 0xa5, 0x64, 0x38, 0x1c, 0xf3, 0x27, 0x08, 0x16, 0x85, 0xac, 0x5c,
-	0xbb, 0x63, 0x53, 0xe7, 0x80, 0xb1, 0xbe, 0x06, 0x47, 0xd9, 0x9d, 0x1e, 0x13, 0x53, 0x0a, 0xc7,
-	0xf5, 0xa6, 0xe3, 0xdd, 0x6b, 0xcc, 0xfb, 0x3e, 0xba, 0x1d, 0x7d, 0x3a, 0x34, 0x18, 0xaa, 0x12,
-	0x83, 0xdb, 0x18, 0x2a, 0x01, 0x1a, 0x94, 0x07, 0xb1, 0x71, 0xe0, 0x7f, 0x44, 0xa0, 0xc9, 0xd9,
-	0xf9, 0x3c, 0xb0, 0x3f, 0x2f, 0x61, 0x9f, 0x68, 0x1c, 0x3b, 0x18, 0x60, 0x27, 0xe1, 0x84, 0x32,
-	0x76, 0x8e, 0xed, 0x4f, 0xc5, 0x60, 0x47, 0x87, 0xa5, 0x8d, 0xba, 0xae, 0xc5, 0xec, 0x08, 0xd7,
-	0x57, 0x61, 0xcc, 0x0d, 0xdf, 0xf0, 0xaa, 0xa6, 0x27, 0x56, 0xb5, 0x50, 0x8c, 0x17, 0xf2, 0x48,
-	0x2b, 0xa5, 0x94, 0xbf, 0x8d, 0x60, 0x9e, 0x4b, 0xf9, 0x55, 0xaf, 0xc5, 0xf6, 0xda, 0xcc, 0xa6,
-	0xb6, 0xe7, 0x06, 0x65, 0x7d, 0x72, 0xf5, 0x4c, 0x86, 0xa7, 0x0d, 0xf3, 0x95, 0x9e, 0x4a, 0x63,
-	0xa9, 0xdb, 0xd1, 0x9f, 0xe6, 0x69, 0x55, 0xd9, 0x24, 0xc6, 0x71, 0x77, 0x50, 0xed, 0x60, 0x1a,
-	0xc3, 0x5f, 0x11, 0x1c, 0x57, 0xc4, 0x84, 0x5f, 0x92, 0x7a, 0x15, 0x4a, 0xe9, 0x55, 0x57, 0x0b,
-	0x62, 0xb7, 0xea, 0xe9, 0x35, 0x4d, 0xd3, 0x29, 0x17, 0xd5, 0x7a, 0xfe, 0x6f, 0xb1, 0x9e, 0xcf,
-	0x2d, 0xbc, 0x0e, 0x53, 0x11, 0x76, 0xa1, 0x3b, 0x2e, 0x76, 0x3b, 0xfa, 0x71, 0x39, 0x33, 0x21,
-	0xa4, 0x49, 0xfe, 0xe8, 0xfb, 0x6c, 0x60, 0x98, 0x8d, 0xe8, 0x48, 0x6d, 0xcf, 0xda, 0xb6, 0xa8,
-	0x43, 0x7e, 0x12, 0xee, 0x75, 0x99, 0x16, 0xbc, 0xe7, 0x59, 0x30, 0x23, 0xe4, 0x59, 0xe8, 0x7a,
-	0xa7, 0x33, 0x57, 0x2d, 0xe8, 0x7b, 0x5a, 0xb7, 0xa3, 0x2f, 0x0c, 0xac, 0x57, 0xd8, 0xf9, 0xa6,
-	0x5d, 0x51, 0x94, 0xfc, 0xbc, 0x14, 0x37, 0x5e, 0x83, 0xb6, 0x98, 0x63, 0x46, 0xe4, 0xbc, 0x04,
-	0x47, 0x9d, 0xe0, 0x05, 0xf7, 0x5d, 0x4d, 0xf2, 0x1d, 0xaa, 0x71, 0x6a, 0x72, 0x9d, 0x43, 0xce,
-	0xcc, 0x6f, 0x01, 0x6e, 0x31, 0xdb, 0x73, 0x9a, 0x2d, 0xef, 0x46, 0x3f, 0x45, 0x4f, 0x76, 0x3b,
-	0x7a, 0x25, 0x34, 0x39, 0x28, 0x43, 0x8c, 0xd9, 0xe8, 0xe5, 0x16, 0xe7, 0x2c, 0xbe, 0x0c, 0x63,
-	0xed, 0xa6, 0xe3, 0x59, 0xd4, 0x2d, 0x1f, 0xc9, 0x53, 0x53, 0xf9, 0x1e, 0xe6, 0x3a, 0x0a, 0xca,
-	0xbf, 0x15, 0x17, 0x8c, 0x68, 0x49, 0x38, 0x31, 0x28, 0x7c, 0x21, 0xcc, 0x6f, 0x1f, 0x2f, 0x4e,
-	0xa5, 0xaf, 0x0d, 0xa7, 0x45, 0xa5, 0xdb, 0xd1, 0xe7, 0x43, 0x64, 0xb2, 0x15, 0x62, 0x4c, 0x39,
-	0x82, 0x20, 0xf9, 0x19, 0x12, 0x0e, 0x21, 0x32, 0x2b, 0xae, 0xc2, 0x44, 0x4f, 0x97, 0xd7, 0xe2,
-	0x33, 0xc9, 0xb5, 0x78, 0xb6, 0xcf, 0x1b, 0x31, 0xc6, 0x23, 0x47, 0x43, 0x1d, 0x8a, 0x2a, 0xc1,
-	0x5e, 0x91, 0xe3, 0x89, 0x7b, 0xe6, 0x17, 0xa5, 0x93, 0xe3, 0x96, 0x78, 0x8c, 0x8e, 0xc2, 0xfe,
-	0x2e, 0x4c, 0x4b, 0xc7, 0x6b, 0x9e, 0xb7, 0x95, 0xd4, 0x53, 0xa4, 0x64, 0x89, 0x2f, 0x9b, 0x6c,
-	0x26, 0x85, 0xe6, 0x52, 0xf1, 0x2b, 0x8d, 0x58, 0xfc, 0xde, 0x47, 0x40, 0xd2, 0xc0, 0x71, 0x5a,
-	0xb8, 0x80, 0xc3, 0xfa, 0x12, 0x98, 0x95, 0xa9, 0xf1, 0x5c, 0x26, 0x44, 0xce, 0x0e, 0x81, 0xf7,
-	0x83, 0xc6, 0x88, 0x31, 0xe3, 0xca, 0xf2, 0xe4, 0xb7, 0x61, 0x6c, 0x42, 0xdf, 0x53, 0x66, 0xfe,
-	0x07, 0x30, 0x2b, 0xa5, 0x2c, 0xe6, 0xcd, 0x6a, 0x32, 0x6f, 0x16, 0xe3, 0x2c, 0x89, 0x8a, 0x7e,
-	0x14, 0xe2, 0xab, 0x21, 0x59, 0x74, 0x1a, 0x9e, 0x49, 0x0d, 0x98, 0x33, 0xea, 0x53, 0x04, 0xa7,
-	0xa2, 0xa4, 0xbf, 0x22, 0x6c, 0xf6, 0x01, 0x68, 0xdf, 0x53, 0x93, 0xea, 0x6c, 0x52, 0xc6, 0x95,
-	0xc6, 0xfe, 0x2b, 0xbc, 0xfa, 0x10, 0xc1, 0xe9, 0x0c, 0x88, 0x9c, 0x5a, 0x6f, 0xc1, 0xbc, 0x5c,
-	0x05, 0x65, 0x76, 0xad, 0xe4, 0xc1, 0xca, 0x09, 0x26, 0xd4, 0x6a, 0xa5, 0x49, 0x62, 0xe0, 0xd6,
-	0x80, 0x16, 0xf9, 0x4d, 0x31, 0x58, 0x8d, 0x2b, 0xa6, 0x29, 0x9a, 0xfc, 0x36, 0xeb, 0x2d, 0x60,
-	0xb4, 0x1a, 0x36, 0x54, 0x24, 0xb3, 0x07, 0xc4, 0xb8, 0xc5, 0x96, 0x2a, 0x3f, 0x1b, 0x26, 0xbe,
-	0x05, 0x0b, 0xf1, 0x3e, 0x91, 0x9c, 0x15, 0x47, 0x76, 0x36, 0xe7, 0x0e, 0xd0, 0x52, 0xe6, 0x78,
-	0xe6, 0x61, 0xf4, 0xb9, 0x60, 0x61, 0xd3, 0xb2, 0xc5, 0x59, 0xfe, 0xfb, 0x22, 0x3c, 0xdf, 0xdb,
-	0x0d, 0xa2, 0xf0, 0x37, 0x1c, 0xb6, 0xf7, 0xff, 0xe4, 0x2a, 0x93, 0xfb, 0x02, 0xac, 0xe4, 0x49,
-	0x19, 0xcf, 0xf0, 0x1f, 0xc2, 0x4d, 0x36, 0x28, 0x7e, 0x98, 0x6b, 0xe4, 0x32, 0x3c, 0x9b, 0x15,
-	0x33, 0x87, 0xf7, 0x6f, 0xa1, 0x37, 0x85, 0x3d, 0x59, 0x89, 0xed, 0x75, 0x75, 0x91, 0x3c, 0x93,
-	0x7e, 0x62, 0xf9, 0x4c, 0x25, 0x52, 0x7d, 0xba, 0x2b, 0x8d, 0x74, 0xba, 0x53, 0xa4, 0xe8, 0x03,
-	0x14, 0xf4, 0x91, 0x64, 0xe0, 0xbc, 0x74, 0xde, 0x81, 0xe3, 0xfc, 0xe0, 0xa3, 0x28, 0x9c, 0xcb,
-	0xd9, 0xf8, 0x79, 0xd9, 0xac, 0x76, 0x3b, 0xba, 0x26, 0x9d, 0xa3, 0xe4, 0xa2, 0x39, 0xeb, 0xf4,
-	0x69, 0x90, 0xdf, 0x21, 0xa1, 0xd1, 0xa5, 0x2c, 0xcd, 0x21, 0xa2, 0xdd, 0xb3, 0x41, 0x91, 0x4f,
-	0x89, 0x98, 0x93, 0xee, 0x21, 0x82, 0x6a, 0x94, 0xfb, 0xeb, 0x6b, 0x12, 0x43, 0x23, 0x54, 0x06,
-	0x4c, 0x45, 0x8b, 0xe8, 0x47, 0x94, 0x95, 0xef, 0xf6, 0x1a, 0x95, 0x9a, 0x15, 0x27, 0x9b, 0x64,
-	0x63, 0x28, 0x28, 0x1f, 0x14, 0x83, 0xc1, 0x95, 0x3a, 0xc4, 0x43, 0xd2, 0x55, 0xf1, 0x7d, 0x98,
-	0x53, 0x90, 0x29, 0x1a, 0x0a, 0xe5, 0x27, 0xa7, 0xde, 0xed, 0xe8, 0x27, 0x12, 0xc9, 0xe9, 0x12,
-	0xe3, 0x58, 0x3f, 0x3b, 0x5d, 0xf2, 0xa0, 0x14, 0x8c, 0xc2, 0xae, 0xaf, 0xd1, 0x4d, 0xba, 0xc7,
-	0x1c, 0xab, 0xb9, 0x6b, 0xdd, 0xef, 0xa5, 0x29, 0x5a, 0xc5, 0x4a, 0xdf, 0xc8, 0x67, 0x22, 0x1e,
-	0xe3, 0x54, 0x60, 0x7c, 0xc7, 0x61, 0xfb, 0xed, 0xa8, 0x1b, 0x4c, 0x18, 0x63, 0xc1, 0xf3, 0x86,
-	0x89, 0xcf, 0x27, 0xb6, 0x8d, 0x60, 0xf7, 0x27, 0xb4, 0x80, 0xaf, 0x81, 0x7f, 0x2b, 0xb1, 0xbc,
-	0xe6, 0xae, 0x1b, 0xdc, 0x01, 0x53, 0xee, 0x53, 0x3e, 0x5b, 0x0c, 0x2e, 0x6b, 0xf4, 0xb4, 0x7c,
-	0x0b, 0x51, 0x92, 0xcb, 0x47, 0xb2, 0x2d, 0xf4, 0xc0, 0xf6, 0xb4, 0xf0, 0x55, 0x00, 0x9f, 0x52,
-	0x4d, 0x6f, 0xdf, 0xa1, 0x6e, 0xf9, 0x68, 0x36, 0x67, 0xb7, 0x22, 0xe9, 0x2d, 0xea, 0x19, 0x82,
-	0xae, 0xcf, 0x55, 0xcb, 0xbe, 0xcd, 0xde, 0xa0, 0x4e, 0x79, 0x2c, 0xcc, 0x0e, 0x7f, 0x54, 0x70,
-	0xf5, 0xef, 0xc5, 0xe0, 0xf2, 0x94, 0xb4, 0x14, 0x4f, 0x6c, 0x04, 0xaf, 0x9a, 0x78, 0x14, 0x3f,
-	0x9f, 0x89, 0x07, 0xbe, 0x05, 0x33, 0xf2, 0xed, 0x37, 0x6c, 0xfc, 0x79, 0x2f, 0xd1, 0x82, 0xa7,
-	0x3e, 0x33, 0xc4, 0x98, 0x16, 0x6f, 0xd1, 0x2e, 0x61, 0xc1, 0xad, 0xb5, 0x61, 0xd9, 0xe6, 0xb5,
-	0xad, 0xd7, 0x58, 0xab, 0xe9, 0xb1, 0xde, 0x44, 0xf3, 0x9b, 0x30, 0xb6, 0x1b, 0xbe, 0xc9, 0xda,
-	0xf2, 0xd7, 0x82, 0x2f, 0x51, 0x5b, 0x1e, 0x73, 0x28, 0xb7, 0x11, 0x0d, 0x10, 0xb8, 0x81, 0xf5,
-	0xf1, 0x07, 0x7c, 0x49, 0xc9, 0x76, 0x30, 0x62, 0xed, 0x73, 0xc8, 0x17, 0xf1, 0x00, 0x3d, 0x92,
-	0x37, 0xa1, 0xd2, 0xab, 0xd6, 0x4f, 0x08, 0xda, 0x2d, 0x61, 0x40, 0xfc, 0x24, 0xc0, 0x6d, 0x32,
-	0xd3, 0xda, 0xbe, 0xf7, 0x44, 0xc1, 0x0d, 0xb8, 0x3c, 0x78, 0x70, 0xab, 0xef, 0x2d, 0x42, 0x69,
-	0xd3, 0xdd, 0xc1, 0x16, 0x40, 0x3c, 0x54, 0xc0, 0x2f, 0x24, 0x19, 0x54, 0x7d, 0x7a, 0xd4, 0xce,
-	0xe6, 0x94, 0xe6, 0xe1, 0xef, 0xc2, 0xa4, 0x70, 0xe5, 0xc6, 0x69, 0xda, 0x83, 0x5f, 0xe0, 0xb4,
-	0x5a, 0x5e, 0x71, 0xee, 0xed, 0x6d, 0x04, 0x78, 0xf0, 0xab, 0x12, 0x3e, 0x9f, 0x62, 0x26, 0xf1,
-	0x83, 0x9a, 0xf6, 0xe5, 0x21, 0xb5, 0x78, 0x0c, 0xef, 0x20, 0x98, 0x57, 0x7e, 0xe8, 0xc1, 0x17,
-	0xf2, 0xa1, 0x19, 0x8c, 0x64, 0x6d, 0x78, 0x45, 0x1e, 0x8c, 0x03, 0xd3, 0xd2, 0x37, 0x17, 0x5c,
-	0xcf, 0x01, 0x4a, 0xfc, 0xfa, 0xa2, 0x7d, 0x29, 0xbf, 0x02, 0xf7, 0xf9, 0x23, 0x98, 0xed, 0xff,
-	0x1c, 0x82, 0x57, 0xf3, 0x21, 0x90, 0x3c, 0xbf, 0x38, 0x94, 0x0e, 0x77, 0xce, 0x60, 0x4a, 0x1c,
-	0xaa, 0xe3, 0x5a, 0x26, 0x5d, 0xa5, 0x8f, 0x32, 0x5a, 0x3d, 0xb7, 0x7c, 0x4c, 0x70, 0xe1, 0x2e,
-	0x80, 0x33, 0xb7, 0x87, 0x34, 0x50, 0xd5, 0x6a, 0x79, 0xc5, 0x63, 0x78, 0xe2, 0x31, 0x19, 0x67,
-	0x6f, 0x10, 0xd9, 0x5f, 0x3d, 0xb7, 0x3c, 0x77, 0xf8, 0x2e, 0x82, 0xc5, 0x84, 0x01, 0x24, 0xbe,
-	0x98, 0xab, 0x14, 0xa8, 0x2e, 0x1f, 0xda, 0xfa, 0x28, 0xaa, 0x3c, 0xa4, 0x5f, 0x22, 0x28, 0x27,
-	0x8d, 0xf1, 0xf0, 0x7a, 0x3e, 0xd2, 0x28, 0x83, 0x7a, 0x79, 0x24, 0x5d, 0x1e, 0xd5, 0xfb, 0x08,
-	0xb4, 0xe4, 0x89, 0x1a, 0xbe, 0x94, 0x05, 0x38, 0x6d, 0x44, 0xa0, 0x5d, 0x1e, 0x51, 0x9b, 0xc7,
-	0xf6, 0x6b, 0x04, 0x27, 0x52, 0x2e, 0xf5, 0xf8, 0x72, 0x26, 0xf0, 0xd4, 0xe8, 0xbe, 0x32, 0xaa,
-	0xba, 0x90, 0xba, 0xe4, 0x99, 0x55, 0x6a, 0xea, 0x32, 0x07, 0x83, 0xa9, 0xa9, 0xcb, 0x1e, 0x94,
-	0xe1, 0x0f, 0x11, 0xe8, 0x19, 0x23, 0x1f, 0x7c, 0x65, 0x28, 0xfc, 0xaa, 0x09, 0x9b, 0xd6, 0xf8,
-	0x2c, 0x26, 0x84, 0x7d, 0x91, 0x34, 0x96, 0xc0, 0xeb, 0xf9, 0x0a, 0xcd, 0xd0, 0xfb, 0x22, 0x73,
-	0x0e, 0xf2, 0x1e, 0x82, 0x4a, 0xe2, 0xcd, 0x1e, 0xbf, 0x9c, 0xb3, 0x1e, 0x29, 0xe3, 0xba, 0x34,
-	0x9a, 0x32, 0x0f, 0xec, 0xa7, 0x08, 0xe6, 0x54, 0xd7, 0x74, 0xfc, 0x52, 0x16, 0x5c, 0xf5, 0xe8,
-	0x41, 0xbb, 0x30, 0xb4, 0x1e, 0x1f, 0x6b, 0x94, 0x1e, 0x14, 0x11, 0xfe, 0x05, 0x82, 0x05, 0xf5,
-	0x4d, 0x0c, 0xa7, 0xb5, 0xff, 0xd4, 0x7b, 0xb4, 0x76, 0x71, 0x04, 0x4d, 0x31, 0x28, 0x07, 0xa6,
-	0xa5, 0xfb, 0x44, 0xea, 0xf1, 0x41, 0x75, 0xd5, 0x49, 0x3d, 0x3e, 0xa8, 0xaf, 0x2a, 0x77, 0x61,
-	0xa6, 0xef, 0xa0, 0x8f, 0xcf, 0x65, 0x2e, 0xf4, 0x80, 0xdf, 0xd5, 0x61, 0x54, 0x62, 0xcf, 0x7d,
-	0xa7, 0xf0, 0x54, 0xcf, 0xea, 0x4b, 0x42, 0xaa, 0xe7, 0x84, 0x43, 0x7e, 0xe3, 0x8d, 0x8f, 0x1e,
-	0x55, 0xd1, 0xc7, 0x8f, 0xaa, 0xe8, 0xd3, 0x47, 0x55, 0xf4, 0xee, 0xe3, 0x6a, 0xe1, 0xe3, 0xc7,
-	0xd5, 0xc2, 0xdf, 0x1e, 0x57, 0x0b, 0x50, 0xb1, 0x58, 0x82, 0xbd, 0xeb, 0xe8, 0xfb, 0xe7, 0x77,
-	0x2c, 0xef, 0xd6, 0xfe, 0xcd, 0x5a, 0x8b, 0xed, 0xd5, 0x63, 0xa1, 0xb3, 0x16, 0x13, 0x9e, 0xea,
-	0x77, 0xe3, 0x3f, 0x33, 0xf4, 0xee, 0xb5, 0xa9, 0x7b, 0xf3, 0x68, 0xf0, 0xc7, 0x85, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x30, 0x5d, 0x0c, 0x32, 0x74, 0x29, 0x00, 0x00,
+func (m *MsgWriteSpecificationBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner AccAddress to scope
-	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes data access AccAddress from scope
-	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
-	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
-	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
-	WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error)
-	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
-	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
-	P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+func (m *MsgWriteSpecificationBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteSpecificationBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWriteSpecificationBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSpecificationBundleRequest.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgWriteSpecificationBundleRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
-	out := new(MsgWriteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSpecificationBundleRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
-	out := new(MsgDeleteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgWriteSpecificationBundleRequest proto.InternalMessageInfo
 
-func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
-	out := new(MsgAddScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgWriteSpecificationBundleResponse is the response type for the Msg/WriteSpecificationBundle RPC method.
+type MsgWriteSpecificationBundleResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+	// record_spec_id_infos contains information about the ids/addresses of the record specifications that were added or
+	// updated.
+	RecordSpecIdInfos []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty" yaml:"record_spec_id_infos"`
+	// scope_spec_id_infos contains information about the ids/addresses of the scope specifications that were added or
+	// updated.
+	ScopeSpecIdInfos []*ScopeSpecIdInfo `protobuf:"bytes,3,rep,name=scope_spec_id_infos,json=scopeSpecIdInfos,proto3" json:"scope_spec_id_infos,omitempty" yaml:"scope_spec_id_infos"`
 }
 
-func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
-	out := new(MsgDeleteScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleResponse) Reset()         { *m = MsgWriteSpecificationBundleResponse{} }
+func (m *MsgWriteSpecificationBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteSpecificationBundleResponse) ProtoMessage()    {}
+func (*MsgWriteSpecificationBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
-
-func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
-	out := new(MsgAddScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
-	out := new(MsgDeleteScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteSpecificationBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteSpecificationBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
-	out := new(MsgWriteSessionResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSpecificationBundleResponse.Merge(m, src)
 }
-
-func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
-	out := new(MsgWriteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
-	out := new(MsgDeleteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteSpecificationBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSpecificationBundleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgWriteSpecificationBundleResponse proto.InternalMessageInfo
 
-func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
-	out := new(MsgDeleteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteSpecificationBundleResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+	if m != nil {
+		return m.ContractSpecIdInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
-	out := new(MsgWriteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteSpecificationBundleResponse) GetRecordSpecIdInfos() []*RecordSpecIdInfo {
+	if m != nil {
+		return m.RecordSpecIdInfos
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
-	out := new(MsgDeleteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteSpecificationBundleResponse) GetScopeSpecIdInfos() []*ScopeSpecIdInfo {
+	if m != nil {
+		return m.ScopeSpecIdInfos
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	out := new(MsgAddContractSpecToScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgWriteP8eContractSpecRequest is the request type for the Msg/WriteP8eContractSpec RPC method.
+type MsgWriteP8EContractSpecRequest struct {
+	// ContractSpec v39 p8e ContractSpect to be converted into a v40
+	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
+	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
+func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
+func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
-
-func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
-	out := new(MsgWriteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
-	out := new(MsgDeleteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// Deprecated: Do not use.
-func (c *msgClient) WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error) {
-	out := new(MsgWriteP8EContractSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteP8eContractSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
+}
+func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (c *msgClient) P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error) {
-	out := new(MsgP8EMemorializeContractResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/P8eMemorializeContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
+
+// MsgWriteP8eContractSpecResponse is the response type for the Msg/WriteP8eContractSpec RPC method.
+type MsgWriteP8EContractSpecResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+	// record_spec_id_infos contains information about the ids/addresses of the record specifications that were added or
+	// updated.
+	RecordSpecIdInfos []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty" yaml:"record_spec_id_infos"`
 }
 
-func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
-	out := new(MsgBindOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
+func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
+func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
+}
+func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
+}
+func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
-	out := new(MsgDeleteOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
+
+func (m *MsgWriteP8EContractSpecResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+	if m != nil {
+		return m.ContractSpecIdInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
-	out := new(MsgModifyOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecResponse) GetRecordSpecIdInfos() []*RecordSpecIdInfo {
+	if m != nil {
+		return m.RecordSpecIdInfos
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner AccAddress to scope
-	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes data access AccAddress from scope
-	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
-	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
-	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
-	WriteP8EContractSpec(context.Context, *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error)
-	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
-	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
-	P8EMemorializeContract(context.Context, *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+// MsgP8eMemorializeContractRequest is the request type for the Msg/P8eMemorializeContract RPC method.
+type MsgP8EMemorializeContractRequest struct {
+	// The scope id of the object being add or modified on blockchain.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// The uuid of the contract execution.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The scope specification id.
+	ScopeSpecificationId string `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
+	// The new recitals for the scope.  Used in leu of Contract for direct ownership changes.
+	Recitals *p8e.Recitals `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
+	// The executed contract.
+	Contract *p8e.Contract `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
+	// The contract signatures
+	Signatures *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	// The bech32 address of the notary (ie the broadcaster of this message).
+	Invoker string `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
+func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
+func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
 }
-
-func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+
+var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+
+// MsgP8eMemorializeContractResponse is the response type for the Msg/P8eMemorializeContract RPC method.
+type MsgP8EMemorializeContractResponse struct {
+	// scope_id_info contains information about the id/address of the scope that was added or updated.
+	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
+	// session_id_info contains information about the id/address of the session that was added or updated.
+	SessionIdInfo *SessionIdInfo `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
+	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
 }
-func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+
+func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
+func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
+func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
 }
-func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+
+var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+
+func (m *MsgP8EMemorializeContractResponse) GetScopeIdInfo() *ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfo
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+
+func (m *MsgP8EMemorializeContractResponse) GetSessionIdInfo() *SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfo
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+
+func (m *MsgP8EMemorializeContractResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+
+// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
-func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+
+func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
+func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
+func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
 }
-func (*UnimplementedMsgServer) WriteP8EContractSpec(ctx context.Context, req *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteP8EContractSpec not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) P8EMemorializeContract(ctx context.Context, req *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method P8EMemorializeContract not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
 
-func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
+func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
+func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
+}
+func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgBindOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorRequest struct {
+	// The record being removed
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
+func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
+
+// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
+func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
+func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
+func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
+
+// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
+func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
+func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{58}
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
+var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddContractSpecToScopeSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgReportOSLocatorStatusRequest is the request type for the Msg/ReportOSLocatorStatus RPC method.
+type MsgReportOSLocatorStatusRequest struct {
+	// The owner of the object store locator.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The current status of the object store locator endpoint.
+	Status OSLocatorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.metadata.v1.OSLocatorStatus" json:"status,omitempty"`
 }
 
-func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+func (m *MsgReportOSLocatorStatusRequest) Reset()         { *m = MsgReportOSLocatorStatusRequest{} }
+func (m *MsgReportOSLocatorStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOSLocatorStatusRequest) ProtoMessage()    {}
+func (*MsgReportOSLocatorStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{59}
+}
+func (m *MsgReportOSLocatorStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportOSLocatorStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOSLocatorStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReportOSLocatorStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOSLocatorStatusRequest.Merge(m, src)
+}
+func (m *MsgReportOSLocatorStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportOSLocatorStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOSLocatorStatusRequest.DiscardUnknown(m)
 }
 
-func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgReportOSLocatorStatusRequest proto.InternalMessageInfo
+
+// MsgReportOSLocatorStatusResponse is the response type for the Msg/ReportOSLocatorStatus RPC method.
+type MsgReportOSLocatorStatusResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
+func (m *MsgReportOSLocatorStatusResponse) Reset()         { *m = MsgReportOSLocatorStatusResponse{} }
+func (m *MsgReportOSLocatorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOSLocatorStatusResponse) ProtoMessage()    {}
+func (*MsgReportOSLocatorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{60}
+}
+func (m *MsgReportOSLocatorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportOSLocatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOSLocatorStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReportOSLocatorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOSLocatorStatusResponse.Merge(m, src)
+}
+func (m *MsgReportOSLocatorStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportOSLocatorStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOSLocatorStatusResponse.DiscardUnknown(m)
 }
 
-func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteP8EContractSpecRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
+var xxx_messageInfo_MsgReportOSLocatorStatusResponse proto.InternalMessageInfo
+
+func (m *MsgReportOSLocatorStatusResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgP8EMemorializeContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAddContractSpecSourceLocatorRequest is the request type for the Msg/AddContractSpecSourceLocator RPC method.
+type MsgAddContractSpecSourceLocatorRequest struct {
+	// MetadataAddress for the contract specification whose source can be downloaded from the locator.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// The owner of the object store locator the source can be downloaded from.
+	LocatorOwner string   `protobuf:"bytes,2,opt,name=locator_owner,json=locatorOwner,proto3" json:"locator_owner,omitempty" yaml:"locator_owner"`
+	Signers      []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindOSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+func (m *MsgAddContractSpecSourceLocatorRequest) Reset() {
+	*m = MsgAddContractSpecSourceLocatorRequest{}
+}
+func (*MsgAddContractSpecSourceLocatorRequest) ProtoMessage() {}
+func (*MsgAddContractSpecSourceLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{61}
+}
+func (m *MsgAddContractSpecSourceLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddContractSpecSourceLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddContractSpecSourceLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddContractSpecSourceLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecSourceLocatorRequest.Merge(m, src)
+}
+func (m *MsgAddContractSpecSourceLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddContractSpecSourceLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecSourceLocatorRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOSLocator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+var xxx_messageInfo_MsgAddContractSpecSourceLocatorRequest proto.InternalMessageInfo
+
+// MsgAddContractSpecSourceLocatorResponse is the response type for the Msg/AddContractSpecSourceLocator RPC method.
+type MsgAddContractSpecSourceLocatorResponse struct {
+}
+
+func (m *MsgAddContractSpecSourceLocatorResponse) Reset() {
+	*m = MsgAddContractSpecSourceLocatorResponse{}
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddContractSpecSourceLocatorResponse) ProtoMessage()    {}
+func (*MsgAddContractSpecSourceLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{62}
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddContractSpecSourceLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecSourceLocatorResponse.Merge(m, src)
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddContractSpecSourceLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecSourceLocatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddContractSpecSourceLocatorResponse proto.InternalMessageInfo
+
+// MsgDeleteContractSpecSourceLocatorRequest is the request type for the Msg/DeleteContractSpecSourceLocator RPC method.
+type MsgDeleteContractSpecSourceLocatorRequest struct {
+	// MetadataAddress for the contract specification to remove the locator from.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// The owner of the object store locator to remove.
+	LocatorOwner string   `protobuf:"bytes,2,opt,name=locator_owner,json=locatorOwner,proto3" json:"locator_owner,omitempty" yaml:"locator_owner"`
+	Signers      []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorRequest) Reset() {
+	*m = MsgDeleteContractSpecSourceLocatorRequest{}
+}
+func (*MsgDeleteContractSpecSourceLocatorRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecSourceLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{63}
+}
+func (m *MsgDeleteContractSpecSourceLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteContractSpecSourceLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteContractSpecSourceLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeleteContractSpecSourceLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecSourceLocatorRequest.Merge(m, src)
+}
+func (m *MsgDeleteContractSpecSourceLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteContractSpecSourceLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecSourceLocatorRequest.DiscardUnknown(m)
 }
 
-func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyOSLocatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteContractSpecSourceLocatorRequest proto.InternalMessageInfo
+
+// MsgDeleteContractSpecSourceLocatorResponse is the response type for the Msg/DeleteContractSpecSourceLocator RPC
+// method.
+type MsgDeleteContractSpecSourceLocatorResponse struct {
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorResponse) Reset() {
+	*m = MsgDeleteContractSpecSourceLocatorResponse{}
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDeleteContractSpecSourceLocatorResponse) ProtoMessage() {}
+func (*MsgDeleteContractSpecSourceLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{64}
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteContractSpecSourceLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecSourceLocatorResponse.Merge(m, src)
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteContractSpecSourceLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecSourceLocatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteContractSpecSourceLocatorResponse proto.InternalMessageInfo
+
+// MsgDeprecateSpecificationRequest is the request type for the Msg/DeprecateSpecification RPC method.
+type MsgDeprecateSpecificationRequest struct {
+	// MetadataAddress for the scope or contract specification to deprecate.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// MetadataAddress for the specification of the same kind that replaces it, empty if there is none.
+	SuccessorId MetadataAddress `protobuf:"bytes,2,opt,name=successor_id,json=successorId,proto3,customtype=MetadataAddress" json:"successor_id" yaml:"successor_id"`
+	Signers     []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgDeprecateSpecificationRequest) Reset()      { *m = MsgDeprecateSpecificationRequest{} }
+func (*MsgDeprecateSpecificationRequest) ProtoMessage() {}
+func (*MsgDeprecateSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{65}
+}
+func (m *MsgDeprecateSpecificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateSpecificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+}
+func (m *MsgDeprecateSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateSpecificationRequest.Merge(m, src)
+}
+func (m *MsgDeprecateSpecificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateSpecificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeprecateSpecificationRequest proto.InternalMessageInfo
+
+// MsgDeprecateSpecificationResponse is the response type for the Msg/DeprecateSpecification RPC method.
+type MsgDeprecateSpecificationResponse struct {
+	Deprecation SpecificationDeprecation `protobuf:"bytes,1,opt,name=deprecation,proto3" json:"deprecation"`
+}
+
+func (m *MsgDeprecateSpecificationResponse) Reset()         { *m = MsgDeprecateSpecificationResponse{} }
+func (m *MsgDeprecateSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeprecateSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{66}
+}
+func (m *MsgDeprecateSpecificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateSpecificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+}
+func (m *MsgDeprecateSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateSpecificationResponse.Merge(m, src)
+}
+func (m *MsgDeprecateSpecificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateSpecificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeprecateSpecificationResponse proto.InternalMessageInfo
+
+func (m *MsgDeprecateSpecificationResponse) GetDeprecation() SpecificationDeprecation {
+	if m != nil {
+		return m.Deprecation
 	}
-	return interceptor(ctx, in, info, handler)
+	return SpecificationDeprecation{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WriteScope",
-			Handler:    _Msg_WriteScope_Handler,
-		},
-		{
-			MethodName: "DeleteScope",
-			Handler:    _Msg_DeleteScope_Handler,
-		},
-		{
-			MethodName: "AddScopeDataAccess",
-			Handler:    _Msg_AddScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "DeleteScopeDataAccess",
-			Handler:    _Msg_DeleteScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "AddScopeOwner",
-			Handler:    _Msg_AddScopeOwner_Handler,
-		},
-		{
-			MethodName: "DeleteScopeOwner",
-			Handler:    _Msg_DeleteScopeOwner_Handler,
-		},
-		{
-			MethodName: "WriteSession",
-			Handler:    _Msg_WriteSession_Handler,
-		},
-		{
-			MethodName: "WriteRecord",
-			Handler:    _Msg_WriteRecord_Handler,
-		},
-		{
-			MethodName: "DeleteRecord",
-			Handler:    _Msg_DeleteRecord_Handler,
-		},
-		{
-			MethodName: "WriteScopeSpecification",
-			Handler:    _Msg_WriteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteScopeSpecification",
-			Handler:    _Msg_DeleteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "WriteContractSpecification",
-			Handler:    _Msg_WriteContractSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecification",
-			Handler:    _Msg_DeleteContractSpecification_Handler,
-		},
-		{
-			MethodName: "AddContractSpecToScopeSpec",
-			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecFromScopeSpec",
-			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
-		},
-		{
-			MethodName: "WriteRecordSpecification",
-			Handler:    _Msg_WriteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteRecordSpecification",
-			Handler:    _Msg_DeleteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "WriteP8eContractSpec",
-			Handler:    _Msg_WriteP8EContractSpec_Handler,
-		},
-		{
-			MethodName: "P8eMemorializeContract",
-			Handler:    _Msg_P8EMemorializeContract_Handler,
-		},
-		{
-			MethodName: "BindOSLocator",
-			Handler:    _Msg_BindOSLocator_Handler,
-		},
-		{
-			MethodName: "DeleteOSLocator",
-			Handler:    _Msg_DeleteOSLocator_Handler,
-		},
-		{
-			MethodName: "ModifyOSLocator",
-			Handler:    _Msg_ModifyOSLocator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/tx.proto",
+func init() {
+	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
+	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
+	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
+	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
+	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
+	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
+	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
+	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
+	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
+	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
+	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
+	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
+	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
+	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
+	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
+	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
+	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
+	proto.RegisterType((*MsgWriteSessionAndRecordsRequest)(nil), "provenance.metadata.v1.MsgWriteSessionAndRecordsRequest")
+	proto.RegisterType((*MsgWriteSessionAndRecordsResponse)(nil), "provenance.metadata.v1.MsgWriteSessionAndRecordsResponse")
+	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
+	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgAddRecordDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddRecordDataAccessRequest")
+	proto.RegisterType((*MsgAddRecordDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddRecordDataAccessResponse")
+	proto.RegisterType((*MsgDeleteRecordDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordDataAccessRequest")
+	proto.RegisterType((*MsgDeleteRecordDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordDataAccessResponse")
+	proto.RegisterType((*MsgRedactRecordRequest)(nil), "provenance.metadata.v1.MsgRedactRecordRequest")
+	proto.RegisterType((*MsgRedactRecordResponse)(nil), "provenance.metadata.v1.MsgRedactRecordResponse")
+	proto.RegisterType((*MsgArchiveScopeRequest)(nil), "provenance.metadata.v1.MsgArchiveScopeRequest")
+	proto.RegisterType((*MsgArchiveScopeResponse)(nil), "provenance.metadata.v1.MsgArchiveScopeResponse")
+	proto.RegisterType((*MsgRestoreScopeRequest)(nil), "provenance.metadata.v1.MsgRestoreScopeRequest")
+	proto.RegisterType((*MsgRestoreScopeResponse)(nil), "provenance.metadata.v1.MsgRestoreScopeResponse")
+	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
+	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
+	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
+	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
+	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
+	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
+	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
+	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
+	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
+	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
+	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
+	proto.RegisterType((*MsgWriteSpecificationBundleRequest)(nil), "provenance.metadata.v1.MsgWriteSpecificationBundleRequest")
+	proto.RegisterType((*MsgWriteSpecificationBundleResponse)(nil), "provenance.metadata.v1.MsgWriteSpecificationBundleResponse")
+	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
+	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
+	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
+	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
+	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
+	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
+	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
+	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
+	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
+	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+	proto.RegisterType((*MsgReportOSLocatorStatusRequest)(nil), "provenance.metadata.v1.MsgReportOSLocatorStatusRequest")
+	proto.RegisterType((*MsgReportOSLocatorStatusResponse)(nil), "provenance.metadata.v1.MsgReportOSLocatorStatusResponse")
+	proto.RegisterType((*MsgAddContractSpecSourceLocatorRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecSourceLocatorRequest")
+	proto.RegisterType((*MsgAddContractSpecSourceLocatorResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecSourceLocatorResponse")
+	proto.RegisterType((*MsgDeleteContractSpecSourceLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecSourceLocatorRequest")
+	proto.RegisterType((*MsgDeleteContractSpecSourceLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecSourceLocatorResponse")
+	proto.RegisterType((*MsgDeprecateSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeprecateSpecificationRequest")
+	proto.RegisterType((*MsgDeprecateSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeprecateSpecificationResponse")
 }
 
-func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
+
+var fileDescriptor_3a3a0892f91e3036 = []byte{
+	// 2848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x5b, 0x6c, 0x1c, 0x57,
+	0xd5, 0x63, 0x27, 0x7e, 0x1c, 0xdb, 0xb1, 0x73, 0xfd, 0x5a, 0x6f, 0x12, 0x8f, 0x33, 0x49, 0x1a,
+	0x27, 0x69, 0xec, 0xc6, 0x0d, 0x89, 0xe3, 0xe6, 0x81, 0x37, 0xa1, 0x8a, 0x49, 0xad, 0x84, 0x31,
+	0x69, 0x00, 0x09, 0x59, 0x9b, 0x99, 0x6b, 0x7b, 0x88, 0x3d, 0xb3, 0x9d, 0x99, 0x75, 0xe3, 0x20,
+	0xd4, 0x16, 0x55, 0x28, 0xaa, 0x00, 0x05, 0x90, 0x10, 0x05, 0x54, 0xf2, 0x85, 0xfa, 0x81, 0x54,
+	0xe0, 0x13, 0xf1, 0x85, 0xf8, 0xa8, 0x90, 0x40, 0xe5, 0x03, 0x09, 0x15, 0xb4, 0xaa, 0x92, 0x1f,
+	0xbe, 0x57, 0x02, 0xf1, 0x89, 0xe6, 0xde, 0x3b, 0x3b, 0x77, 0x66, 0xef, 0x3c, 0x76, 0x63, 0x3b,
+	0xa1, 0xea, 0x87, 0x25, 0xcf, 0xcc, 0x79, 0xdf, 0x73, 0xcf, 0x39, 0xf7, 0x9c, 0xbb, 0x20, 0x97,
+	0x6c, 0x6b, 0x03, 0x9b, 0x45, 0x53, 0xc3, 0x53, 0xeb, 0xd8, 0x2d, 0xea, 0x45, 0xb7, 0x38, 0xb5,
+	0x71, 0x6a, 0xca, 0xbd, 0x3b, 0x59, 0xb2, 0x2d, 0xd7, 0x42, 0xc3, 0x01, 0xc0, 0xa4, 0x0f, 0x30,
+	0xb9, 0x71, 0x2a, 0x3f, 0xb8, 0x62, 0xad, 0x58, 0x04, 0x64, 0xca, 0xfb, 0x8f, 0x42, 0xe7, 0x8f,
+	0xc4, 0x90, 0xab, 0x61, 0x52, 0xb0, 0x89, 0x18, 0x30, 0xeb, 0xf6, 0x37, 0xb0, 0xe6, 0x3a, 0xae,
+	0x65, 0x63, 0x06, 0x79, 0x38, 0x06, 0xb2, 0x34, 0x83, 0xbd, 0x3f, 0x06, 0xa5, 0xc4, 0x40, 0x39,
+	0x9a, 0x55, 0xf2, 0x61, 0x8e, 0xc7, 0xc1, 0x94, 0xb0, 0x66, 0x2c, 0x1b, 0x5a, 0xd1, 0x35, 0x2c,
+	0x93, 0xc2, 0x2a, 0x7f, 0x68, 0x83, 0xc1, 0x05, 0x67, 0xe5, 0x96, 0x6d, 0xb8, 0x78, 0xd1, 0xa3,
+	0xa1, 0xe2, 0xd7, 0xca, 0xd8, 0x71, 0xd1, 0x39, 0xd8, 0x4d, 0x68, 0xe6, 0xa4, 0x71, 0x69, 0xa2,
+	0x7b, 0xfa, 0xc0, 0xa4, 0xd8, 0x3a, 0x93, 0x04, 0xa9, 0xb0, 0xeb, 0xc3, 0x8a, 0xdc, 0xa2, 0x52,
+	0x0c, 0x94, 0x83, 0x0e, 0xc7, 0x58, 0x31, 0xb1, 0xed, 0xe4, 0x5a, 0xc7, 0xdb, 0x26, 0xba, 0x54,
+	0xff, 0x11, 0x9d, 0x06, 0x20, 0x20, 0x4b, 0xe5, 0xb2, 0xa1, 0xe7, 0xda, 0xc6, 0xa5, 0x89, 0xae,
+	0xc2, 0x50, 0xb5, 0x22, 0xef, 0xdd, 0x2c, 0xae, 0xaf, 0xcd, 0x2a, 0xc1, 0x37, 0x45, 0xed, 0x22,
+	0x0f, 0x37, 0xcb, 0x86, 0x8e, 0x4e, 0x41, 0x97, 0x27, 0x3a, 0x45, 0xda, 0x45, 0x90, 0x06, 0xab,
+	0x15, 0xb9, 0x9f, 0x21, 0xf9, 0x9f, 0x14, 0xb5, 0xd3, 0xfb, 0x9f, 0xa0, 0x2c, 0xc0, 0xc0, 0x46,
+	0x71, 0xad, 0x8c, 0x97, 0xac, 0xd7, 0x4d, 0x6c, 0x2f, 0x15, 0x9d, 0x25, 0xcd, 0x32, 0xcc, 0xdc,
+	0xee, 0x71, 0x69, 0xa2, 0xb3, 0x30, 0x56, 0xad, 0xc8, 0x79, 0x8a, 0x2c, 0x00, 0x52, 0xd4, 0x7e,
+	0xf2, 0xf6, 0xba, 0xf7, 0x72, 0xce, 0xb9, 0x6c, 0x19, 0x26, 0x7a, 0x19, 0xfa, 0xf1, 0xdd, 0x12,
+	0xd6, 0x5c, 0xac, 0x2f, 0x6d, 0x60, 0xdb, 0x31, 0x2c, 0x33, 0xd7, 0x3e, 0x2e, 0x4d, 0xec, 0x2a,
+	0xec, 0xab, 0x56, 0xe4, 0x11, 0x4a, 0x2b, 0x0a, 0xa1, 0xa8, 0x7d, 0xfe, 0xab, 0x57, 0xe9, 0x1b,
+	0x74, 0x19, 0xfa, 0x0c, 0x1d, 0xaf, 0x97, 0x2c, 0x17, 0x9b, 0xda, 0xe6, 0xd2, 0x1d, 0xbc, 0x99,
+	0xeb, 0x20, 0xfa, 0xe4, 0xab, 0x15, 0x79, 0x98, 0x92, 0x89, 0x00, 0x28, 0xea, 0x1e, 0xee, 0xcd,
+	0x35, 0xbc, 0x39, 0xdb, 0x7f, 0xff, 0xa1, 0xdc, 0xf2, 0x93, 0x87, 0x72, 0xcb, 0xbf, 0x1e, 0xca,
+	0x2d, 0x6f, 0xfe, 0x73, 0xbc, 0x45, 0xb9, 0x07, 0x43, 0x91, 0x35, 0x74, 0x4a, 0x96, 0xe9, 0x60,
+	0x54, 0x84, 0x5e, 0x6a, 0x53, 0x43, 0x5f, 0x32, 0xcc, 0x65, 0x8b, 0x2d, 0xe6, 0xa1, 0xc4, 0xc5,
+	0x9c, 0xd7, 0xe7, 0xcd, 0x65, 0xab, 0x90, 0xab, 0x56, 0xe4, 0x41, 0x7e, 0x5d, 0x18, 0x0d, 0x45,
+	0xed, 0x76, 0x02, 0x30, 0xe5, 0x1d, 0x89, 0x30, 0xbf, 0x82, 0xd7, 0x70, 0xc4, 0x83, 0xbe, 0x00,
+	0x9d, 0x3e, 0x22, 0xe1, 0xdb, 0x53, 0x38, 0xee, 0x79, 0xc9, 0xc7, 0x15, 0xb9, 0x6f, 0x81, 0xf1,
+	0x9c, 0xd3, 0x75, 0x1b, 0x3b, 0x4e, 0xb5, 0x22, 0xf7, 0x85, 0x39, 0x29, 0x6a, 0x07, 0x63, 0x12,
+	0xef, 0x4d, 0x02, 0x43, 0xe4, 0x60, 0x38, 0x2a, 0x0b, 0xb5, 0x84, 0xf2, 0x27, 0x09, 0xf6, 0x2f,
+	0x38, 0x2b, 0x73, 0xba, 0x4e, 0xde, 0x5f, 0xf1, 0x98, 0x6b, 0x1a, 0x76, 0x9c, 0x2d, 0x96, 0xf6,
+	0x2c, 0x74, 0x7b, 0xa0, 0x4b, 0x45, 0x42, 0x9c, 0x4a, 0x5c, 0x18, 0xae, 0x56, 0x64, 0x44, 0x51,
+	0xb8, 0x8f, 0x8a, 0x0a, 0x7a, 0x4d, 0x0c, 0x5e, 0xcd, 0xb6, 0x34, 0x35, 0x65, 0x38, 0x10, 0xa3,
+	0x0b, 0xd3, 0xf6, 0xcf, 0x12, 0xc8, 0x61, 0x43, 0xfc, 0x7f, 0x2b, 0xac, 0xc0, 0x78, 0xbc, 0x3a,
+	0x4c, 0xe7, 0x8f, 0x25, 0x18, 0xe1, 0xac, 0x42, 0xb6, 0xef, 0x16, 0xeb, 0xfa, 0x0a, 0xb4, 0x93,
+	0x50, 0x41, 0xd5, 0x4c, 0x08, 0x8a, 0x37, 0x8a, 0xb6, 0xbb, 0x59, 0x18, 0xf2, 0x78, 0x54, 0x2b,
+	0x72, 0x2f, 0x25, 0x48, 0x51, 0x15, 0x95, 0xd1, 0x68, 0xc8, 0x00, 0x79, 0xc8, 0xd5, 0xeb, 0xc6,
+	0x14, 0xff, 0x9d, 0x04, 0xf9, 0xb0, 0x75, 0xb6, 0x43, 0xf7, 0x63, 0x21, 0xdd, 0xbb, 0x0a, 0x7b,
+	0xb7, 0x46, 0xb1, 0x03, 0xb0, 0x4f, 0x28, 0x3b, 0xd3, 0xed, 0xf7, 0xad, 0x64, 0x47, 0xd3, 0xd0,
+	0x86, 0x1d, 0x2f, 0x88, 0xfa, 0x7a, 0x5d, 0x82, 0x0e, 0x87, 0xbe, 0x61, 0x51, 0x4d, 0x8e, 0x8d,
+	0x6a, 0x14, 0x8c, 0x25, 0x29, 0x1f, 0x2b, 0x21, 0x4d, 0xbd, 0x25, 0xc1, 0x10, 0x83, 0xf2, 0xa2,
+	0x9e, 0x66, 0xad, 0x97, 0x2c, 0x13, 0x9b, 0xae, 0x43, 0x52, 0x56, 0xf7, 0xf4, 0x89, 0x14, 0x4e,
+	0xf3, 0xfa, 0xe5, 0x1a, 0x4a, 0x61, 0xbc, 0x5a, 0x91, 0xf7, 0x33, 0xb3, 0x8a, 0x68, 0x2a, 0xea,
+	0x80, 0x53, 0x8f, 0xd6, 0x44, 0xd2, 0x13, 0x58, 0xf7, 0x6f, 0x12, 0x0c, 0x08, 0x64, 0x42, 0x67,
+	0x42, 0x79, 0x58, 0x4a, 0xc8, 0xc3, 0x57, 0x5b, 0xf8, 0x4c, 0x5c, 0xc3, 0x2b, 0xea, 0xba, 0x9d,
+	0x6b, 0x15, 0xe3, 0x79, 0xdf, 0x02, 0x3c, 0xcf, 0xb7, 0xd0, 0x2c, 0xf4, 0xf8, 0xba, 0x73, 0x99,
+	0x7f, 0xa4, 0x5a, 0x91, 0x07, 0xc2, 0x96, 0xa1, 0x2a, 0x75, 0xb3, 0x47, 0x8f, 0x67, 0x01, 0x41,
+	0xbf, 0xef, 0x8e, 0xd8, 0x74, 0x8d, 0x65, 0x03, 0xdb, 0xca, 0xdb, 0x74, 0xaf, 0x87, 0xdd, 0x82,
+	0xe5, 0x3c, 0x03, 0xfa, 0x38, 0x3b, 0x73, 0x59, 0xef, 0x48, 0xea, 0xaa, 0x91, 0xbc, 0xc7, 0xa5,
+	0xe2, 0x08, 0x1d, 0x45, 0xed, 0x75, 0x78, 0x50, 0xe5, 0x83, 0x5d, 0x41, 0xe2, 0x55, 0xb1, 0x66,
+	0xd9, 0xba, 0xef, 0x9c, 0xe7, 0xa1, 0xdd, 0x26, 0x2f, 0x18, 0xef, 0xb1, 0x38, 0xde, 0x14, 0x8d,
+	0xb9, 0x26, 0xc3, 0x79, 0xc6, 0x3d, 0xf3, 0x1a, 0x20, 0xcd, 0x32, 0x5d, 0xbb, 0xa8, 0xb9, 0x4b,
+	0x51, 0x17, 0x3d, 0x50, 0xad, 0xc8, 0xa3, 0x94, 0x64, 0x3d, 0x8c, 0xa2, 0xf6, 0xfb, 0x2f, 0x17,
+	0xfd, 0x42, 0xed, 0x02, 0x74, 0x94, 0x8a, 0xb6, 0x6b, 0x60, 0x27, 0xb7, 0x3b, 0x4b, 0x4c, 0x65,
+	0x7b, 0x98, 0xe1, 0xa0, 0x5b, 0x30, 0x5c, 0x2b, 0xbb, 0xa8, 0x97, 0x84, 0xcb, 0xb3, 0x83, 0xd5,
+	0x8a, 0x7c, 0x20, 0x52, 0x9e, 0x85, 0xe0, 0x14, 0x75, 0xd0, 0xff, 0x40, 0xc2, 0xcf, 0x36, 0x57,
+	0x6a, 0x6f, 0x04, 0xe1, 0xcc, 0x77, 0x18, 0xe6, 0xb6, 0x18, 0xf6, 0xd0, 0xd5, 0x8f, 0x78, 0xed,
+	0xe1, 0x64, 0xcf, 0x61, 0x4e, 0x3b, 0x5a, 0xad, 0xc8, 0x43, 0x54, 0xaa, 0x30, 0x15, 0x45, 0xed,
+	0xb1, 0x39, 0x40, 0xe5, 0x3b, 0x6d, 0x24, 0x95, 0xf2, 0x3b, 0x67, 0xce, 0xd4, 0x29, 0x2d, 0x67,
+	0xcb, 0x42, 0xeb, 0x45, 0xe8, 0xa0, 0x5c, 0xfd, 0x4c, 0x99, 0xcd, 0xff, 0x7d, 0xa4, 0xf8, 0x0c,
+	0x92, 0xb0, 0x01, 0x76, 0x3d, 0x9d, 0xd0, 0xbc, 0xbb, 0xc9, 0xd0, 0xfc, 0x5f, 0x09, 0x0e, 0x26,
+	0x2c, 0xc4, 0x8e, 0x07, 0x33, 0xb4, 0x0a, 0x7d, 0x61, 0xd7, 0xf1, 0xd7, 0x2e, 0x9b, 0x07, 0x72,
+	0x9c, 0x22, 0x64, 0x14, 0xb5, 0x97, 0x77, 0x41, 0x47, 0xf9, 0xbe, 0xc4, 0x95, 0xe9, 0xe1, 0xb8,
+	0x79, 0x15, 0xba, 0x6a, 0xd8, 0xac, 0x5a, 0x39, 0x11, 0x5f, 0xad, 0xf4, 0x47, 0xf8, 0x29, 0x6a,
+	0xa7, 0xcf, 0xa9, 0xa1, 0x63, 0xc3, 0x28, 0xc9, 0x26, 0x61, 0x79, 0x58, 0x01, 0xf2, 0x17, 0xc9,
+	0xaf, 0xb5, 0xe9, 0x87, 0xfa, 0x3a, 0x7a, 0xeb, 0x44, 0xde, 0x91, 0x52, 0x7a, 0x1c, 0xc6, 0xe2,
+	0xf4, 0x61, 0x2a, 0xff, 0x55, 0xe2, 0xaa, 0xed, 0x4f, 0x89, 0xd6, 0x87, 0xc8, 0x66, 0x8b, 0x53,
+	0x89, 0x29, 0xfe, 0x4b, 0xea, 0x97, 0x2a, 0xd6, 0x8b, 0x9a, 0xbb, 0x5d, 0x7e, 0x39, 0xec, 0x55,
+	0x06, 0x45, 0xc7, 0x32, 0x69, 0xf9, 0xa4, 0xb2, 0xa7, 0x86, 0xb4, 0xa1, 0xfe, 0x1a, 0x96, 0x93,
+	0xe9, 0xf0, 0x47, 0xaa, 0xc3, 0x9c, 0xad, 0xad, 0x1a, 0x1b, 0xdb, 0x72, 0x1e, 0x3f, 0x0b, 0xdd,
+	0x6b, 0x96, 0x56, 0x74, 0x2d, 0x7b, 0xa9, 0x6c, 0x1b, 0xac, 0x08, 0xe4, 0xd6, 0x8b, 0xfb, 0xa8,
+	0xa8, 0xc0, 0x9e, 0x6e, 0xda, 0x46, 0x43, 0x1a, 0xde, 0xa4, 0x67, 0xb9, 0x90, 0x16, 0x2c, 0x24,
+	0xce, 0x42, 0x8f, 0x57, 0x45, 0x60, 0xd3, 0x5d, 0x5a, 0x2d, 0x3a, 0xab, 0xac, 0x7a, 0xe5, 0x6a,
+	0x49, 0xfe, 0xab, 0xa2, 0x76, 0xb3, 0xc7, 0xab, 0xde, 0xd3, 0x0f, 0xfc, 0x15, 0x26, 0x8d, 0xb7,
+	0x90, 0x75, 0xae, 0x41, 0x07, 0x83, 0x64, 0x11, 0xf6, 0x44, 0x62, 0x93, 0x84, 0x89, 0x76, 0x99,
+	0xa2, 0xf8, 0xf9, 0x8b, 0x51, 0x68, 0x22, 0xf8, 0x84, 0x45, 0x0a, 0x8e, 0xb4, 0x07, 0x43, 0x8d,
+	0x9d, 0x45, 0xbe, 0x83, 0xe7, 0x4b, 0xfe, 0x2a, 0xf4, 0x86, 0x3a, 0x7b, 0x4c, 0xfe, 0xe3, 0x89,
+	0xf2, 0x87, 0x28, 0x31, 0xf1, 0xc3, 0x64, 0x12, 0xaa, 0xd0, 0x50, 0x02, 0x6c, 0x6b, 0x32, 0x01,
+	0xbe, 0x2b, 0x81, 0x92, 0xa4, 0x1c, 0x5b, 0x6e, 0x07, 0x10, 0x75, 0x42, 0x42, 0x36, 0x9c, 0x04,
+	0x8f, 0xa6, 0xaa, 0xc8, 0x92, 0x13, 0x57, 0x96, 0xd6, 0x13, 0x53, 0xd4, 0x3e, 0x27, 0x0c, 0xaf,
+	0x7c, 0x40, 0x65, 0xe3, 0x8e, 0xa5, 0x42, 0xcb, 0x7f, 0x1d, 0xfa, 0x43, 0x26, 0x0b, 0x76, 0xd6,
+	0x74, 0xfc, 0xce, 0x1a, 0x09, 0xac, 0xc4, 0x23, 0x7a, 0x52, 0xf0, 0xaf, 0x1a, 0x4c, 0x61, 0x47,
+	0xe0, 0x50, 0xa2, 0xc0, 0xcc, 0xa3, 0x3e, 0x91, 0xe0, 0xb0, 0x6f, 0xf4, 0xcb, 0x5c, 0x2d, 0x5e,
+	0xa7, 0xda, 0x57, 0xc5, 0x4e, 0x75, 0x32, 0xce, 0xe2, 0x42, 0x62, 0x4f, 0xc5, 0xaf, 0xde, 0x97,
+	0xe0, 0x48, 0x8a, 0x8a, 0xcc, 0xb5, 0xde, 0x80, 0xa1, 0xf0, 0x21, 0x25, 0xec, 0x5d, 0xc7, 0xb3,
+	0xe8, 0xca, 0x1c, 0x8c, 0xab, 0x24, 0x85, 0x24, 0x15, 0x15, 0x69, 0x75, 0x58, 0xca, 0xaf, 0x5a,
+	0xc9, 0x6a, 0xcc, 0xe9, 0x3a, 0x4f, 0xf2, 0xcb, 0x56, 0x6d, 0x01, 0xfd, 0xd5, 0x30, 0x61, 0x34,
+	0x44, 0x76, 0x8b, 0x3c, 0x6e, 0x44, 0x13, 0xd9, 0x67, 0x5e, 0x47, 0xab, 0x30, 0x1c, 0xec, 0x93,
+	0x10, 0xb3, 0xd6, 0xa6, 0x99, 0x0d, 0x3a, 0x75, 0x6e, 0x19, 0xf6, 0xf1, 0xd4, 0xa4, 0x70, 0x94,
+	0x2c, 0x6c, 0x92, 0xb5, 0x98, 0x97, 0xff, 0xa6, 0x15, 0x8e, 0xd5, 0x76, 0x03, 0x0f, 0xfc, 0xb2,
+	0x6d, 0xad, 0x7f, 0x66, 0x5c, 0xa1, 0x71, 0x9f, 0x87, 0xe3, 0x59, 0x4c, 0xc6, 0x2c, 0xfc, 0x5b,
+	0xba, 0xc9, 0xea, 0xc1, 0x9f, 0xe5, 0x18, 0x39, 0x01, 0xcf, 0xa5, 0xc9, 0xcc, 0xd4, 0xfb, 0x0f,
+	0x97, 0x9b, 0x68, 0x81, 0x25, 0xd4, 0xed, 0x96, 0x38, 0x48, 0x9e, 0x48, 0x3e, 0x30, 0x3d, 0x51,
+	0x88, 0x14, 0x37, 0x5f, 0xda, 0x9a, 0x6a, 0xbe, 0x08, 0x4c, 0xf4, 0x9e, 0x44, 0xf2, 0x48, 0xbc,
+	0xe2, 0x2c, 0x74, 0xbe, 0x0e, 0x03, 0xac, 0xba, 0x15, 0x04, 0xce, 0x89, 0x74, 0xfd, 0x59, 0xd8,
+	0xe4, 0x26, 0x71, 0x02, 0x72, 0x8a, 0xda, 0x6f, 0x47, 0x30, 0x94, 0x5f, 0x4b, 0x5c, 0xa2, 0x4b,
+	0x58, 0x9a, 0x67, 0xc8, 0xed, 0x9e, 0x23, 0x41, 0x3e, 0x41, 0x62, 0xe6, 0x74, 0x3f, 0xe3, 0x0b,
+	0xa2, 0x90, 0x8f, 0x94, 0x4d, 0x7d, 0xad, 0x56, 0xa8, 0xce, 0x43, 0xfb, 0x6d, 0xf2, 0x22, 0xb5,
+	0x4e, 0xad, 0xa7, 0xe1, 0xf7, 0x19, 0x29, 0x81, 0x86, 0xb4, 0xf8, 0x69, 0x5b, 0xe0, 0x19, 0x42,
+	0xe9, 0x9e, 0x91, 0xa4, 0x8a, 0xee, 0xc1, 0xa0, 0xc0, 0x97, 0xfc, 0x66, 0x46, 0x76, 0xdf, 0x94,
+	0xab, 0x15, 0x79, 0x5f, 0xac, 0x6f, 0x3a, 0x8a, 0xba, 0x37, 0xea, 0x9c, 0x0e, 0xda, 0x80, 0x81,
+	0xfa, 0xfa, 0x92, 0x06, 0xdf, 0x06, 0xaa, 0x55, 0x6e, 0x57, 0x08, 0xa8, 0x29, 0x6a, 0x7f, 0xa4,
+	0x5c, 0x75, 0x94, 0x87, 0x12, 0x39, 0xd5, 0x93, 0xc5, 0xb9, 0x31, 0x13, 0x0a, 0x6e, 0xbe, 0xdb,
+	0xa8, 0xf4, 0xd8, 0xe4, 0xbd, 0xf6, 0xc8, 0xa5, 0x6d, 0xd5, 0xd2, 0x0c, 0x0e, 0x2d, 0x09, 0xf3,
+	0x9c, 0x10, 0x8d, 0x86, 0xfc, 0xe7, 0xbd, 0x56, 0x32, 0x92, 0x14, 0x8b, 0xf8, 0x99, 0xef, 0x38,
+	0xca, 0x7d, 0xda, 0x99, 0xbd, 0x31, 0x83, 0x17, 0xf0, 0xba, 0x65, 0x1b, 0xc5, 0x35, 0xe3, 0x5e,
+	0xcd, 0x4c, 0xfe, 0x2a, 0x8e, 0x46, 0xce, 0xf0, 0x5d, 0xc1, 0xb9, 0x7c, 0x14, 0x3a, 0x57, 0x6c,
+	0xab, 0x5c, 0xf2, 0x0b, 0x89, 0x2e, 0xb5, 0x83, 0x3c, 0xcf, 0xeb, 0xe8, 0x74, 0x6c, 0xc5, 0x41,
+	0x12, 0x47, 0x4c, 0xf5, 0xf0, 0x79, 0xe8, 0xb4, 0xb1, 0x66, 0xb8, 0xc5, 0x35, 0xbf, 0xb9, 0x7a,
+	0x38, 0xc9, 0x5b, 0x54, 0x06, 0xab, 0xd6, 0xb0, 0x3c, 0x0a, 0xbe, 0x91, 0x49, 0x9f, 0x34, 0x85,
+	0x42, 0x4d, 0xd9, 0x1a, 0x16, 0xba, 0x0a, 0xe0, 0xb9, 0x54, 0xd1, 0x2d, 0xdb, 0xd8, 0x21, 0x3d,
+	0xfd, 0x14, 0x9f, 0x5d, 0xf4, 0xa1, 0x17, 0xb1, 0xab, 0x72, 0xb8, 0x9e, 0xaf, 0x1a, 0xe6, 0x86,
+	0x75, 0x07, 0xdb, 0xb4, 0x91, 0xaf, 0xfa, 0x8f, 0x02, 0x5f, 0xfd, 0x47, 0x2b, 0x39, 0x77, 0xc7,
+	0x2d, 0xc5, 0x8e, 0x5d, 0xae, 0x10, 0xb5, 0x7f, 0x5b, 0x77, 0xae, 0xfd, 0xdb, 0xb6, 0x3d, 0xed,
+	0x5f, 0x8b, 0x34, 0x3c, 0x0a, 0x86, 0xa9, 0x5f, 0x5f, 0x7c, 0x85, 0xb6, 0x87, 0x7c, 0xf7, 0xfe,
+	0x22, 0x74, 0xb0, 0x86, 0x51, 0xda, 0x96, 0xbf, 0x4e, 0xee, 0x4f, 0x2d, 0xba, 0x96, 0x8d, 0x19,
+	0x0d, 0xbf, 0x07, 0xc3, 0x08, 0xcc, 0x76, 0xde, 0x67, 0x4b, 0xaa, 0x2c, 0x93, 0xe1, 0x79, 0x84,
+	0x21, 0x5b, 0xc4, 0x2d, 0xe4, 0xa8, 0xbc, 0x06, 0xa3, 0xb5, 0x44, 0xbf, 0x43, 0xaa, 0xad, 0x72,
+	0xa3, 0xff, 0x9d, 0x50, 0x6e, 0xc1, 0xd2, 0x8d, 0xe5, 0xcd, 0x1d, 0x55, 0xae, 0x8e, 0xe5, 0x36,
+	0x28, 0xf7, 0x6d, 0x7a, 0x5f, 0x46, 0xc5, 0x25, 0xcb, 0x76, 0x6b, 0xac, 0x16, 0xdd, 0xa2, 0x5b,
+	0xae, 0x75, 0xbc, 0x07, 0x61, 0x37, 0xb9, 0xdf, 0xc0, 0xe2, 0x2e, 0x7d, 0x40, 0x97, 0xa0, 0xdd,
+	0x21, 0x60, 0x64, 0x63, 0xee, 0x89, 0x4f, 0xf2, 0x51, 0xaa, 0x0c, 0x8d, 0x53, 0xd7, 0x24, 0xf1,
+	0x3f, 0x46, 0x86, 0x6d, 0x50, 0xba, 0x2a, 0x91, 0xf3, 0x50, 0xe4, 0x3c, 0xbd, 0x68, 0x95, 0x6d,
+	0x0d, 0x47, 0xd6, 0x77, 0x9b, 0xab, 0xe9, 0x0b, 0xd0, 0xeb, 0x77, 0x8d, 0xa9, 0x89, 0x69, 0x53,
+	0x99, 0x0b, 0x92, 0xa1, 0xcf, 0x8a, 0xda, 0xc3, 0x9e, 0xc9, 0x65, 0x91, 0x86, 0x8e, 0xb9, 0xc7,
+	0xe0, 0x68, 0xaa, 0xce, 0xac, 0x1e, 0xff, 0xb7, 0x14, 0xd3, 0x45, 0xf8, 0x94, 0x9b, 0x28, 0xae,
+	0x13, 0x20, 0xb6, 0xd2, 0x9b, 0xad, 0x6c, 0x5a, 0x54, 0xb2, 0xb1, 0x56, 0x74, 0x9f, 0x4a, 0xa3,
+	0xf4, 0x4b, 0xd0, 0xe3, 0x94, 0xc9, 0x2c, 0xc7, 0xb2, 0x83, 0x3e, 0xca, 0x64, 0x3c, 0x69, 0xff,
+	0xd6, 0x09, 0x87, 0xe4, 0x65, 0x5e, 0xff, 0xb1, 0xc1, 0xd6, 0xc9, 0xb7, 0xd8, 0x70, 0x49, 0x6c,
+	0x01, 0xb6, 0x73, 0xbf, 0x02, 0xdd, 0x3a, 0x83, 0x08, 0x3a, 0x05, 0x2f, 0x64, 0x3a, 0xbb, 0x5d,
+	0x09, 0xf0, 0xd8, 0x1e, 0xe6, 0x49, 0x4d, 0x3f, 0x38, 0x04, 0x6d, 0x0b, 0xce, 0x0a, 0x32, 0x00,
+	0x82, 0x66, 0x3a, 0x7a, 0x3e, 0x8e, 0xb4, 0xe8, 0xb6, 0x6f, 0xfe, 0x64, 0x46, 0x68, 0xa6, 0xcc,
+	0x1a, 0x74, 0x73, 0xad, 0x66, 0x94, 0x84, 0x5d, 0x7f, 0x31, 0x34, 0x3f, 0x99, 0x15, 0x9c, 0x71,
+	0x7b, 0x4b, 0x02, 0x54, 0x7f, 0xd9, 0x11, 0x9d, 0x4e, 0x20, 0x13, 0x7b, 0xcf, 0x33, 0xff, 0xb9,
+	0x06, 0xb1, 0x98, 0x0c, 0xef, 0x48, 0x30, 0x24, 0xbc, 0x7f, 0x88, 0xce, 0x66, 0xd3, 0xa6, 0x5e,
+	0x92, 0x99, 0xc6, 0x11, 0x99, 0x30, 0x36, 0xf4, 0x86, 0xae, 0x02, 0xa2, 0xa9, 0x0c, 0x4a, 0xf1,
+	0x97, 0x02, 0xf3, 0x2f, 0x64, 0x47, 0x60, 0x3c, 0xbf, 0x09, 0xfd, 0xd1, 0x5b, 0x7a, 0x68, 0x3a,
+	0x9b, 0x06, 0x21, 0xce, 0x2f, 0x36, 0x84, 0xc3, 0x98, 0x5b, 0xd0, 0xc3, 0x5f, 0x94, 0x40, 0x93,
+	0xa9, 0xee, 0x1a, 0xba, 0x2b, 0x98, 0x9f, 0xca, 0x0c, 0x1f, 0x38, 0x38, 0xd7, 0x03, 0x43, 0xa9,
+	0xdb, 0x23, 0x34, 0x2d, 0xce, 0x4f, 0x66, 0x05, 0x67, 0xdc, 0xbe, 0x27, 0xc1, 0xb0, 0xf8, 0x22,
+	0x08, 0x9a, 0xc9, 0x28, 0x79, 0xdd, 0x25, 0x9e, 0xfc, 0xb9, 0x26, 0x30, 0x03, 0x73, 0xf3, 0xed,
+	0x2a, 0x94, 0xbe, 0x61, 0xc3, 0xfa, 0x4f, 0x65, 0x86, 0x67, 0x0c, 0xdf, 0x96, 0x60, 0x40, 0x70,
+	0x25, 0x01, 0xa5, 0x6c, 0xd6, 0x98, 0xcb, 0x09, 0xf9, 0x33, 0x8d, 0xa2, 0x71, 0xeb, 0x20, 0xbe,
+	0x23, 0x80, 0x66, 0x32, 0xaa, 0x54, 0x2f, 0xcc, 0xb9, 0x26, 0x30, 0x83, 0x75, 0xe0, 0x87, 0xfc,
+	0x89, 0xeb, 0x20, 0xb8, 0xb5, 0x90, 0xb8, 0x0e, 0xa2, 0xdb, 0x03, 0x1e, 0x43, 0x7e, 0xe6, 0x9e,
+	0xc8, 0x50, 0x70, 0xc5, 0x20, 0x91, 0xa1, 0x70, 0x98, 0x4f, 0x34, 0x0c, 0x26, 0xdf, 0x29, 0x1a,
+	0xd6, 0x4d, 0xed, 0x53, 0x34, 0xac, 0x1f, 0xa9, 0xa3, 0x07, 0x12, 0x8c, 0xc4, 0x8c, 0x9c, 0xd1,
+	0xb9, 0x4c, 0x49, 0x50, 0x54, 0xe0, 0xe4, 0x67, 0x9b, 0x41, 0x65, 0x22, 0xfd, 0x48, 0x82, 0x5c,
+	0xdc, 0xe0, 0x16, 0xcd, 0x66, 0x0b, 0x97, 0x42, 0xa1, 0x5e, 0x6a, 0x0a, 0x97, 0x49, 0xf5, 0xae,
+	0x04, 0xf9, 0xf8, 0x19, 0x2a, 0x3a, 0x9f, 0xa6, 0x70, 0xd2, 0x50, 0x28, 0x7f, 0xa1, 0x49, 0x6c,
+	0x26, 0xdb, 0xcf, 0x25, 0xd8, 0x97, 0x30, 0xc6, 0x41, 0x17, 0x52, 0x15, 0x4f, 0x94, 0xee, 0x62,
+	0xb3, 0xe8, 0x9c, 0xe9, 0xe2, 0xa7, 0x94, 0x89, 0xa6, 0x4b, 0x1d, 0x05, 0x27, 0x9a, 0x2e, 0x7d,
+	0x34, 0x8a, 0xde, 0x97, 0x40, 0x4e, 0x19, 0xf2, 0xa1, 0xb9, 0x86, 0xf4, 0x17, 0xcd, 0x54, 0xf3,
+	0x85, 0x27, 0x21, 0xc1, 0xed, 0x8b, 0xb8, 0x41, 0x14, 0x9a, 0xcd, 0x96, 0x62, 0x1b, 0xde, 0x17,
+	0xa9, 0x93, 0xaf, 0x1f, 0x4b, 0x30, 0x1a, 0x3b, 0xcb, 0x41, 0x2f, 0x65, 0x0c, 0xf6, 0x42, 0xb9,
+	0xce, 0x37, 0x87, 0x1c, 0x35, 0x97, 0x60, 0x3a, 0x93, 0x6e, 0xae, 0xf8, 0x81, 0x53, 0xba, 0xb9,
+	0x92, 0xc6, 0x41, 0xdf, 0x95, 0x60, 0x50, 0xd4, 0xf3, 0x47, 0x67, 0xd2, 0xa8, 0x8a, 0xe7, 0x18,
+	0xf9, 0xb3, 0x0d, 0xe3, 0xb1, 0x83, 0x6a, 0xdb, 0xfd, 0x56, 0x09, 0xfd, 0x50, 0x82, 0x61, 0x71,
+	0x5b, 0x37, 0x31, 0xc3, 0x27, 0x36, 0xe5, 0x13, 0x33, 0x7c, 0x72, 0x0f, 0x99, 0x0a, 0x65, 0x43,
+	0x6f, 0xa8, 0x39, 0x99, 0x58, 0xce, 0x8b, 0xfa, 0xa6, 0x89, 0xe5, 0xbc, 0xb8, 0xef, 0x79, 0x17,
+	0xfa, 0x22, 0x5d, 0x43, 0x74, 0x2a, 0xd5, 0xfd, 0xea, 0xf8, 0x4e, 0x37, 0x82, 0x12, 0x70, 0x8e,
+	0xb4, 0xf4, 0x12, 0x39, 0x8b, 0x3b, 0x8e, 0x89, 0x9c, 0xe3, 0x3a, 0x86, 0xde, 0x19, 0x4e, 0xd8,
+	0x5e, 0x4b, 0x3c, 0xc3, 0x25, 0x35, 0x05, 0x13, 0xcf, 0x70, 0xc9, 0x9d, 0xbc, 0x5f, 0x48, 0xb0,
+	0x3f, 0xa9, 0x0d, 0x85, 0x2e, 0x66, 0x0f, 0xf4, 0xa2, 0x86, 0x54, 0xfe, 0x52, 0xd3, 0xf8, 0x89,
+	0xa9, 0x22, 0x2c, 0x64, 0x63, 0xa9, 0x42, 0x28, 0x67, 0xe1, 0x49, 0x48, 0x84, 0x0a, 0x77, 0x51,
+	0xff, 0x25, 0xa5, 0x70, 0x4f, 0x68, 0x5a, 0xa5, 0x14, 0xee, 0x49, 0xcd, 0x9e, 0xc2, 0x9d, 0x0f,
+	0x1f, 0x8d, 0x49, 0x1f, 0x3d, 0x1a, 0x93, 0x3e, 0x79, 0x34, 0x26, 0x3d, 0x78, 0x3c, 0xd6, 0xf2,
+	0xd1, 0xe3, 0xb1, 0x96, 0xbf, 0x3f, 0x1e, 0x6b, 0x81, 0x51, 0xc3, 0x8a, 0x21, 0x7b, 0x43, 0xfa,
+	0xda, 0xe9, 0x15, 0xc3, 0x5d, 0x2d, 0xdf, 0x9e, 0xd4, 0xac, 0xf5, 0xa9, 0x00, 0xe8, 0xa4, 0x61,
+	0x71, 0x4f, 0x53, 0x77, 0x83, 0xdf, 0x74, 0xbb, 0x9b, 0x25, 0xec, 0xdc, 0x6e, 0x27, 0xbf, 0xe4,
+	0x7e, 0xf1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0xba, 0x46, 0x04, 0xe1, 0x3e, 0x00, 0x00,
 }
 
-func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner AccAddress to scope
+	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes data access AccAddress from scope
+	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
+	// WriteSessionAndRecords adds or updates a session and records in that session.
+	WriteSessionAndRecords(ctx context.Context, in *MsgWriteSessionAndRecordsRequest, opts ...grpc.CallOption) (*MsgWriteSessionAndRecordsResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// AddRecordDataAccess adds data access AccAddress to a record
+	AddRecordDataAccess(ctx context.Context, in *MsgAddRecordDataAccessRequest, opts ...grpc.CallOption) (*MsgAddRecordDataAccessResponse, error)
+	// DeleteRecordDataAccess removes data access AccAddress from a record
+	DeleteRecordDataAccess(ctx context.Context, in *MsgDeleteRecordDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteRecordDataAccessResponse, error)
+	// RedactRecord replaces the output values of a record with a redaction marker, keeping a hash of each.
+	RedactRecord(ctx context.Context, in *MsgRedactRecordRequest, opts ...grpc.CallOption) (*MsgRedactRecordResponse, error)
+	// ArchiveScope moves the sessions and records of a scope to an object store, keeping a stub with their content hash.
+	ArchiveScope(ctx context.Context, in *MsgArchiveScopeRequest, opts ...grpc.CallOption) (*MsgArchiveScopeResponse, error)
+	// RestoreScope re-imports the archived sessions and records of a scope, verifying them against its content hash.
+	RestoreScope(ctx context.Context, in *MsgRestoreScopeRequest, opts ...grpc.CallOption) (*MsgRestoreScopeResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
+	// WriteSpecificationBundle adds or updates a contract specification, its record specifications, and any included
+	// scope specifications.
+	WriteSpecificationBundle(ctx context.Context, in *MsgWriteSpecificationBundleRequest, opts ...grpc.CallOption) (*MsgWriteSpecificationBundleResponse, error)
+	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
+	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
+	WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error)
+	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
+	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
+	P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+	// ReportOSLocatorStatus records the current status of an ObjectStoreLocator endpoint as reported by its owner.
+	ReportOSLocatorStatus(ctx context.Context, in *MsgReportOSLocatorStatusRequest, opts ...grpc.CallOption) (*MsgReportOSLocatorStatusResponse, error)
+	// AddContractSpecSourceLocator adds an object store locator the source of a contract specification can be downloaded
+	// from, as verified by the owners of the contract specification.
+	AddContractSpecSourceLocator(ctx context.Context, in *MsgAddContractSpecSourceLocatorRequest, opts ...grpc.CallOption) (*MsgAddContractSpecSourceLocatorResponse, error)
+	// DeleteContractSpecSourceLocator removes an object store locator from the source locators of a contract
+	// specification.
+	DeleteContractSpecSourceLocator(ctx context.Context, in *MsgDeleteContractSpecSourceLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecSourceLocatorResponse, error)
+	// DeprecateSpecification marks a scope or contract specification as deprecated, optionally pointing to the
+	// specification that replaces it.  New sessions are rejected against it once the deprecation grace period has passed.
+	DeprecateSpecification(ctx context.Context, in *MsgDeprecateSpecificationRequest, opts ...grpc.CallOption) (*MsgDeprecateSpecificationResponse, error)
 }
 
-func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeUuid) > 0 {
-		i -= len(m.ScopeUuid)
-		copy(dAtA[i:], m.ScopeUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
+	out := new(MsgWriteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
+	out := new(MsgDeleteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
+	out := new(MsgAddScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
+	out := new(MsgDeleteScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
+	out := new(MsgAddScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
+	out := new(MsgDeleteScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
+	out := new(MsgWriteSessionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
+	out := new(MsgWriteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteSessionAndRecords(ctx context.Context, in *MsgWriteSessionAndRecordsRequest, opts ...grpc.CallOption) (*MsgWriteSessionAndRecordsResponse, error) {
+	out := new(MsgWriteSessionAndRecordsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSessionAndRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AddRecordDataAccess(ctx context.Context, in *MsgAddRecordDataAccessRequest, opts ...grpc.CallOption) (*MsgAddRecordDataAccessResponse, error) {
+	out := new(MsgAddRecordDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddRecordDataAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteRecordDataAccess(ctx context.Context, in *MsgDeleteRecordDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteRecordDataAccessResponse, error) {
+	out := new(MsgDeleteRecordDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordDataAccess", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedactRecord(ctx context.Context, in *MsgRedactRecordRequest, opts ...grpc.CallOption) (*MsgRedactRecordResponse, error) {
+	out := new(MsgRedactRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/RedactRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ArchiveScope(ctx context.Context, in *MsgArchiveScopeRequest, opts ...grpc.CallOption) (*MsgArchiveScopeResponse, error) {
+	out := new(MsgArchiveScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ArchiveScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RestoreScope(ctx context.Context, in *MsgRestoreScopeRequest, opts ...grpc.CallOption) (*MsgRestoreScopeResponse, error) {
+	out := new(MsgRestoreScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/RestoreScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
+	out := new(MsgDeleteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
+	out := new(MsgWriteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
+	out := new(MsgDeleteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	out := new(MsgAddContractSpecToScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
+	out := new(MsgWriteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
+	out := new(MsgDeleteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WriteSpecificationBundle(ctx context.Context, in *MsgWriteSpecificationBundleRequest, opts ...grpc.CallOption) (*MsgWriteSpecificationBundleResponse, error) {
+	out := new(MsgWriteSpecificationBundleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSpecificationBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Owners[iNdEx])
-			copy(dAtA[i:], m.Owners[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+// Deprecated: Do not use.
+func (c *msgClient) WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error) {
+	out := new(MsgWriteP8EContractSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteP8eContractSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *msgClient) P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error) {
+	out := new(MsgP8EMemorializeContractResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/P8eMemorializeContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
+	out := new(MsgBindOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
+	out := new(MsgDeleteOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
+	out := new(MsgModifyOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReportOSLocatorStatus(ctx context.Context, in *MsgReportOSLocatorStatusRequest, opts ...grpc.CallOption) (*MsgReportOSLocatorStatusResponse, error) {
+	out := new(MsgReportOSLocatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ReportOSLocatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) AddContractSpecSourceLocator(ctx context.Context, in *MsgAddContractSpecSourceLocatorRequest, opts ...grpc.CallOption) (*MsgAddContractSpecSourceLocatorResponse, error) {
+	out := new(MsgAddContractSpecSourceLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecSourceLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteContractSpecSourceLocator(ctx context.Context, in *MsgDeleteContractSpecSourceLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecSourceLocatorResponse, error) {
+	out := new(MsgDeleteContractSpecSourceLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecSourceLocator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeprecateSpecification(ctx context.Context, in *MsgDeprecateSpecificationRequest, opts ...grpc.CallOption) (*MsgDeprecateSpecificationResponse, error) {
+	out := new(MsgDeprecateSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeprecateSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionUuid) > 0 {
-		i -= len(m.SessionUuid)
-		copy(dAtA[i:], m.SessionUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ScopeIdentifier != nil {
-		{
-			size := m.ScopeIdentifier.Size()
-			i -= size
-			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner AccAddress to scope
+	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes data access AccAddress from scope
+	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
+	// WriteSessionAndRecords adds or updates a session and records in that session.
+	WriteSessionAndRecords(context.Context, *MsgWriteSessionAndRecordsRequest) (*MsgWriteSessionAndRecordsResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// AddRecordDataAccess adds data access AccAddress to a record
+	AddRecordDataAccess(context.Context, *MsgAddRecordDataAccessRequest) (*MsgAddRecordDataAccessResponse, error)
+	// DeleteRecordDataAccess removes data access AccAddress from a record
+	DeleteRecordDataAccess(context.Context, *MsgDeleteRecordDataAccessRequest) (*MsgDeleteRecordDataAccessResponse, error)
+	// RedactRecord replaces the output values of a record with a redaction marker, keeping a hash of each.
+	RedactRecord(context.Context, *MsgRedactRecordRequest) (*MsgRedactRecordResponse, error)
+	// ArchiveScope moves the sessions and records of a scope to an object store, keeping a stub with their content hash.
+	ArchiveScope(context.Context, *MsgArchiveScopeRequest) (*MsgArchiveScopeResponse, error)
+	// RestoreScope re-imports the archived sessions and records of a scope, verifying them against its content hash.
+	RestoreScope(context.Context, *MsgRestoreScopeRequest) (*MsgRestoreScopeResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
+	// WriteSpecificationBundle adds or updates a contract specification, its record specifications, and any included
+	// scope specifications.
+	WriteSpecificationBundle(context.Context, *MsgWriteSpecificationBundleRequest) (*MsgWriteSpecificationBundleResponse, error)
+	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
+	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
+	WriteP8EContractSpec(context.Context, *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error)
+	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
+	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
+	P8EMemorializeContract(context.Context, *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+	// ReportOSLocatorStatus records the current status of an ObjectStoreLocator endpoint as reported by its owner.
+	ReportOSLocatorStatus(context.Context, *MsgReportOSLocatorStatusRequest) (*MsgReportOSLocatorStatusResponse, error)
+	// AddContractSpecSourceLocator adds an object store locator the source of a contract specification can be downloaded
+	// from, as verified by the owners of the contract specification.
+	AddContractSpecSourceLocator(context.Context, *MsgAddContractSpecSourceLocatorRequest) (*MsgAddContractSpecSourceLocatorResponse, error)
+	// DeleteContractSpecSourceLocator removes an object store locator from the source locators of a contract
+	// specification.
+	DeleteContractSpecSourceLocator(context.Context, *MsgDeleteContractSpecSourceLocatorRequest) (*MsgDeleteContractSpecSourceLocatorResponse, error)
+	// DeprecateSpecification marks a scope or contract specification as deprecated, optionally pointing to the
+	// specification that replaces it.  New sessions are rejected against it once the deprecation grace period has passed.
+	DeprecateSpecification(context.Context, *MsgDeprecateSpecificationRequest) (*MsgDeprecateSpecificationResponse, error)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeUuid)
-	copy(dAtA[i:], m.ScopeUuid)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
 }
-func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
-
-func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeAddr)
-	copy(dAtA[i:], m.ScopeAddr)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
 }
-func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+}
+func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+}
+func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+}
+func (*UnimplementedMsgServer) WriteSessionAndRecords(ctx context.Context, req *MsgWriteSessionAndRecordsRequest) (*MsgWriteSessionAndRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSessionAndRecords not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (*UnimplementedMsgServer) AddRecordDataAccess(ctx context.Context, req *MsgAddRecordDataAccessRequest) (*MsgAddRecordDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecordDataAccess not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecordDataAccess(ctx context.Context, req *MsgDeleteRecordDataAccessRequest) (*MsgDeleteRecordDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordDataAccess not implemented")
+}
+func (*UnimplementedMsgServer) RedactRecord(ctx context.Context, req *MsgRedactRecordRequest) (*MsgRedactRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactRecord not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveScope(ctx context.Context, req *MsgArchiveScopeRequest) (*MsgArchiveScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveScope not implemented")
+}
+func (*UnimplementedMsgServer) RestoreScope(ctx context.Context, req *MsgRestoreScopeRequest) (*MsgRestoreScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreScope not implemented")
+}
+func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteSpecificationBundle(ctx context.Context, req *MsgWriteSpecificationBundleRequest) (*MsgWriteSpecificationBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSpecificationBundle not implemented")
+}
+func (*UnimplementedMsgServer) WriteP8EContractSpec(ctx context.Context, req *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteP8EContractSpec not implemented")
+}
+func (*UnimplementedMsgServer) P8EMemorializeContract(ctx context.Context, req *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method P8EMemorializeContract not implemented")
+}
+func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) ReportOSLocatorStatus(ctx context.Context, req *MsgReportOSLocatorStatusRequest) (*MsgReportOSLocatorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportOSLocatorStatus not implemented")
+}
+func (*UnimplementedMsgServer) AddContractSpecSourceLocator(ctx context.Context, req *MsgAddContractSpecSourceLocatorRequest) (*MsgAddContractSpecSourceLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecSourceLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecSourceLocator(ctx context.Context, req *MsgDeleteContractSpecSourceLocatorRequest) (*MsgDeleteContractSpecSourceLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecSourceLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateSpecification(ctx context.Context, req *MsgDeprecateSpecificationRequest) (*MsgDeprecateSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateSpecification not implemented")
 }
 
-func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
 	}
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeOwner(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.RecordId.Size()
-		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecord(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteSessionAndRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionAndRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSessionAndRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSessionAndRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSessionAndRecords(ctx, req.(*MsgWriteSessionAndRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_AddRecordDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRecordDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRecordDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddRecordDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRecordDataAccess(ctx, req.(*MsgAddRecordDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteRecordDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordDataAccessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecordDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecordDataAccess(ctx, req.(*MsgDeleteRecordDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RedactRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedactRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedactRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/RedactRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedactRecord(ctx, req.(*MsgRedactRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_ArchiveScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveScope(ctx, in)
 	}
-	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ArchiveScope",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveScope(ctx, req.(*MsgArchiveScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RestoreScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/RestoreScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreScope(ctx, req.(*MsgRestoreScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddContractSpecToScopeSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteSpecificationBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSpecificationBundleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSpecificationBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSpecificationBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSpecificationBundle(ctx, req.(*MsgWriteSpecificationBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteP8EContractSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
 	}
-	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgP8EMemorializeContractRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindOSLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).BindOSLocator(ctx, in)
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ContractSpecificationId.Size()
-		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOSLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ReportOSLocatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportOSLocatorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportOSLocatorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ReportOSLocatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportOSLocatorStatus(ctx, req.(*MsgReportOSLocatorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddContractSpecSourceLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddContractSpecSourceLocatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddContractSpecSourceLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecSourceLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddContractSpecSourceLocator(ctx, req.(*MsgAddContractSpecSourceLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteContractSpecSourceLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecSourceLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecSourceLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecSourceLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecSourceLocator(ctx, req.(*MsgDeleteContractSpecSourceLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeprecateSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateSpecification(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ContractSpecificationId.Size()
-		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeprecateSpecification",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateSpecification(ctx, req.(*MsgDeprecateSpecificationRequest))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteScope",
+			Handler:    _Msg_WriteScope_Handler,
+		},
+		{
+			MethodName: "DeleteScope",
+			Handler:    _Msg_DeleteScope_Handler,
+		},
+		{
+			MethodName: "AddScopeDataAccess",
+			Handler:    _Msg_AddScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "DeleteScopeDataAccess",
+			Handler:    _Msg_DeleteScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "AddScopeOwner",
+			Handler:    _Msg_AddScopeOwner_Handler,
+		},
+		{
+			MethodName: "DeleteScopeOwner",
+			Handler:    _Msg_DeleteScopeOwner_Handler,
+		},
+		{
+			MethodName: "WriteSession",
+			Handler:    _Msg_WriteSession_Handler,
+		},
+		{
+			MethodName: "WriteRecord",
+			Handler:    _Msg_WriteRecord_Handler,
+		},
+		{
+			MethodName: "WriteSessionAndRecords",
+			Handler:    _Msg_WriteSessionAndRecords_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "AddRecordDataAccess",
+			Handler:    _Msg_AddRecordDataAccess_Handler,
+		},
+		{
+			MethodName: "DeleteRecordDataAccess",
+			Handler:    _Msg_DeleteRecordDataAccess_Handler,
+		},
+		{
+			MethodName: "RedactRecord",
+			Handler:    _Msg_RedactRecord_Handler,
+		},
+		{
+			MethodName: "ArchiveScope",
+			Handler:    _Msg_ArchiveScope_Handler,
+		},
+		{
+			MethodName: "RestoreScope",
+			Handler:    _Msg_RestoreScope_Handler,
+		},
+		{
+			MethodName: "WriteScopeSpecification",
+			Handler:    _Msg_WriteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteScopeSpecification",
+			Handler:    _Msg_DeleteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "WriteContractSpecification",
+			Handler:    _Msg_WriteContractSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecification",
+			Handler:    _Msg_DeleteContractSpecification_Handler,
+		},
+		{
+			MethodName: "AddContractSpecToScopeSpec",
+			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecFromScopeSpec",
+			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
+		},
+		{
+			MethodName: "WriteRecordSpecification",
+			Handler:    _Msg_WriteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteRecordSpecification",
+			Handler:    _Msg_DeleteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "WriteSpecificationBundle",
+			Handler:    _Msg_WriteSpecificationBundle_Handler,
+		},
+		{
+			MethodName: "WriteP8eContractSpec",
+			Handler:    _Msg_WriteP8EContractSpec_Handler,
+		},
+		{
+			MethodName: "P8eMemorializeContract",
+			Handler:    _Msg_P8EMemorializeContract_Handler,
+		},
+		{
+			MethodName: "BindOSLocator",
+			Handler:    _Msg_BindOSLocator_Handler,
+		},
+		{
+			MethodName: "DeleteOSLocator",
+			Handler:    _Msg_DeleteOSLocator_Handler,
+		},
+		{
+			MethodName: "ModifyOSLocator",
+			Handler:    _Msg_ModifyOSLocator_Handler,
+		},
+		{
+			MethodName: "ReportOSLocatorStatus",
+			Handler:    _Msg_ReportOSLocatorStatus_Handler,
+		},
+		{
+			MethodName: "AddContractSpecSourceLocator",
+			Handler:    _Msg_AddContractSpecSourceLocator_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecSourceLocator",
+			Handler:    _Msg_DeleteContractSpecSourceLocator_Handler,
+		},
+		{
+			MethodName: "DeprecateSpecification",
+			Handler:    _Msg_DeprecateSpecification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/tx.proto",
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4283,16 +4585,52 @@ func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpectedVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpectedVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValueOwnerAsCoin {
+		i--
+		if m.ValueOwnerAsCoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeUuid) > 0 {
+		i -= len(m.ScopeUuid)
+		copy(dAtA[i:], m.ScopeUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4303,11 +4641,11 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4315,7 +4653,7 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4325,20 +4663,32 @@ func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4348,23 +4698,16 @@ func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4375,11 +4718,11 @@ func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4387,7 +4730,7 @@ func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4397,32 +4740,20 @@ func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4432,12 +4763,12 @@ func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4448,13 +4779,22 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4464,7 +4804,7 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4474,12 +4814,12 @@ func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4487,7 +4827,7 @@ func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4497,12 +4837,12 @@ func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4513,15 +4853,24 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4529,7 +4878,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4539,46 +4888,20 @@ func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecIdInfos) > 0 {
-		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4588,84 +4911,53 @@ func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Invoker) > 0 {
-		i -= len(m.Invoker)
-		copy(dAtA[i:], m.Invoker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Signatures != nil {
-		{
-			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.Recitals != nil {
-		{
-			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeSpecificationId) > 0 {
-		i -= len(m.ScopeSpecificationId)
-		copy(dAtA[i:], m.ScopeSpecificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4675,58 +4967,20 @@ func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordIdInfos) > 0 {
-		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4736,22 +4990,40 @@ func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4759,7 +5031,7 @@ func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4769,30 +5041,20 @@ func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4802,30 +5064,58 @@ func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4835,30 +5125,64 @@ func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SessionUuid) > 0 {
+		i -= len(m.SessionUuid)
+		copy(dAtA[i:], m.SessionUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdentifier != nil {
+		{
+			size := m.ScopeIdentifier.Size()
+			i -= size
+			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeUuid)
+	copy(dAtA[i:], m.ScopeUuid)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
+func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeAddr)
+	copy(dAtA[i:], m.ScopeAddr)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4868,30 +5192,32 @@ func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4901,18 +5227,72 @@ func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpectedScopeVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpectedScopeVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4924,758 +5304,5921 @@ func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteSessionAndRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgWriteSessionAndRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteSessionAndRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteSessionAndRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteSessionAndRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteSessionAndRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddRecordDataAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRecordDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRecordDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
-	}
-	l = len(m.SessionUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddRecordDataAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeUuid)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddRecordDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddRecordDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgWriteSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgDeleteRecordDataAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteRecordDataAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgDeleteRecordDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRedactRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedactRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedactRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRedactRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgRedactRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRedactRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgArchiveScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.LocatorUri) > 0 {
+		i -= len(m.LocatorUri)
+		copy(dAtA[i:], m.LocatorUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LocatorUri)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgArchiveScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgArchiveScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRestoreScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRestoreScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRestoreScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgRestoreScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRestoreScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Contractspec.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.RecordSpecIdInfos) > 0 {
-		for _, e := range m.RecordSpecIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ScopeSpecificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Recitals != nil {
-		l = m.Recitals.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Signatures != nil {
-		l = m.Signatures.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Invoker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.RecordIdInfos) > 0 {
-		for _, e := range m.RecordIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteSpecificationBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteSpecificationBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteSpecificationBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteSpecificationBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteSpecificationBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteSpecificationBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecIdInfos) > 0 {
+		for iNdEx := len(m.ScopeSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordSpecIdInfos) > 0 {
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Signatures != nil {
+		{
+			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportOSLocatorStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOSLocatorStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOSLocatorStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportOSLocatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOSLocatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOSLocatorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddContractSpecSourceLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecSourceLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecSourceLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LocatorOwner) > 0 {
+		i -= len(m.LocatorOwner)
+		copy(dAtA[i:], m.LocatorOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LocatorOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddContractSpecSourceLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecSourceLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecSourceLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LocatorOwner) > 0 {
+		i -= len(m.LocatorOwner)
+		copy(dAtA[i:], m.LocatorOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LocatorOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SuccessorId.Size()
+		i -= size
+		if _, err := m.SuccessorId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deprecation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValueOwnerAsCoin {
+		n += 2
+	}
+	if m.ExpectedVersion != 0 {
+		n += 1 + sovTx(uint64(m.ExpectedVersion))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeUuid)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgWriteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpectedScopeVersion != 0 {
+		n += 1 + sovTx(uint64(m.ExpectedScopeVersion))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteSessionAndRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteSessionAndRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddRecordDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddRecordDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRecordDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedactRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRedactRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgArchiveScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LocatorUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgArchiveScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRestoreScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Content.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRestoreScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSpecificationBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bundle.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteSpecificationBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecIdInfos) > 0 {
+		for _, e := range m.ScopeSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contractspec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Signatures != nil {
+		l = m.Signatures.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBindOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReportOSLocatorStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgReportOSLocatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddContractSpecSourceLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LocatorOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecSourceLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LocatorOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecSourceLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeprecateSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SuccessorId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeprecateSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deprecation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAsCoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValueOwnerAsCoin = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVersion", wireType)
+			}
+			m.ExpectedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedScopeVersion", wireType)
+			}
+			m.ExpectedScopeVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedScopeVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionAndRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionAndRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionAndRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteSessionAndRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteSessionAndRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteSessionAndRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRecordDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRecordDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRecordDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRecordDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRecordDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRecordDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgModifyOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordDataAccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,17 +11241,17 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordDataAccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5718,28 +11261,28 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5767,43 +11310,11 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5831,7 +11342,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5854,7 +11365,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordDataAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5877,48 +11388,12 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordDataAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
-			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5940,7 +11415,7 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRedactRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5963,15 +11438,15 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedactRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedactRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5998,11 +11473,43 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6055,7 +11562,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedactRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6078,10 +11585,10 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedactRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedactRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6105,7 +11612,7 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6128,10 +11635,10 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6169,7 +11676,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6197,7 +11704,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.LocatorUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -6252,7 +11759,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6275,12 +11782,44 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6302,7 +11841,7 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6319,56 +11858,23 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestoreScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestoreScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6378,25 +11884,26 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6449,7 +11956,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6472,10 +11979,10 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6499,7 +12006,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6522,17 +12029,17 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6542,30 +12049,30 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6575,29 +12082,27 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6625,7 +12130,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6648,7 +12153,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6671,12 +12176,48 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6698,7 +12239,7 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,15 +12262,15 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6756,43 +12297,11 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6845,7 +12354,7 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6868,10 +12377,10 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6895,7 +12404,7 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6918,15 +12427,15 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6953,7 +12462,7 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6991,9 +12500,9 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7003,33 +12512,79 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7039,23 +12594,27 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7078,7 +12637,7 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7101,17 +12660,17 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7121,29 +12680,30 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7153,27 +12713,28 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
+			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7201,7 +12762,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7224,7 +12785,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7247,48 +12808,12 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
-			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7310,7 +12835,7 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7333,17 +12858,17 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7353,62 +12878,30 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7418,31 +12911,28 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7470,41 +12960,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7527,7 +12983,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7550,48 +13006,12 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
-			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7613,7 +13033,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7636,15 +13056,15 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7671,7 +13091,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7728,7 +13148,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7751,10 +13171,10 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7778,7 +13198,7 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7801,10 +13221,10 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7874,7 +13294,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7902,7 +13322,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7925,7 +13345,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7948,15 +13368,15 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7983,10 +13403,10 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
 			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8011,7 +13431,7 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8034,10 +13454,10 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8126,7 +13546,7 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8149,10 +13569,10 @@ func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8176,7 +13596,7 @@ func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSpecificationBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8199,15 +13619,15 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSpecificationBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSpecificationBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8234,7 +13654,7 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8270,38 +13690,6 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8323,7 +13711,7 @@ func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSpecificationBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8346,10 +13734,10 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSpecificationBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSpecificationBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8388,6 +13776,74 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
+			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecIdInfos = append(m.ScopeSpecIdInfos, &ScopeSpecIdInfo{})
+			if err := m.ScopeSpecIdInfos[len(m.ScopeSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8409,7 +13865,7 @@ func (m *MsgWriteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8432,17 +13888,17 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8452,59 +13908,26 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -8557,7 +13980,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,12 +14003,82 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddContractSpecToScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
+			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8607,7 +14100,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8630,17 +14123,17 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8650,30 +14143,29 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8683,28 +14175,27 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8732,113 +14223,85 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Recitals == nil {
+				m.Recitals = &p8e.Recitals{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecFromScopeSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Contract == nil {
+				m.Contract = &p8e.Contract{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8848,28 +14311,31 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Signatures == nil {
+				m.Signatures = &p8e.SignatureSet{}
+			}
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8895,60 +14361,10 @@ func (m *MsgDeleteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invoker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8970,7 +14386,7 @@ func (m *MsgDeleteContractSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,15 +14409,15 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9028,15 +14444,18 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9046,29 +14465,33 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9078,23 +14501,25 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9117,7 +14542,7 @@ func (m *MsgWriteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9140,15 +14565,15 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9175,10 +14600,7 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
-			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9203,7 +14625,7 @@ func (m *MsgWriteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9226,17 +14648,17 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9246,57 +14668,25 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9318,7 +14708,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9341,12 +14731,45 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9368,7 +14791,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9391,15 +14814,15 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contractspec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9426,42 +14849,10 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contractspec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9483,7 +14874,7 @@ func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9506,15 +14897,15 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteP8eContractSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9541,16 +14932,63 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
-			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9577,8 +15015,7 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordSpecIdInfos = append(m.RecordSpecIdInfos, &RecordSpecIdInfo{})
-			if err := m.RecordSpecIdInfos[len(m.RecordSpecIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9603,7 +15040,7 @@ func (m *MsgWriteP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReportOSLocatorStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9626,15 +15063,15 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportOSLocatorStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportOSLocatorStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9662,77 +15099,13 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9742,67 +15115,64 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= OSLocatorStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Recitals == nil {
-				m.Recitals = &p8e.Recitals{}
-			}
-			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportOSLocatorStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &p8e.Contract{}
-			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportOSLocatorStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportOSLocatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9829,45 +15199,10 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signatures == nil {
-				m.Signatures = &p8e.SignatureSet{}
-			}
-			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Invoker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9889,7 +15224,7 @@ func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecSourceLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9912,17 +15247,17 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecSourceLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecSourceLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9932,33 +15267,30 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
-			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9968,33 +15300,29 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
-			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LocatorOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10004,25 +15332,23 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
-			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10045,7 +15371,7 @@ func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddContractSpecSourceLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10068,45 +15394,12 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddContractSpecSourceLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddContractSpecSourceLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10128,7 +15421,7 @@ func (m *MsgBindOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecSourceLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10151,17 +15444,17 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecSourceLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecSourceLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10171,25 +15464,89 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocatorOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocatorOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10211,7 +15568,7 @@ func (m *MsgBindOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteContractSpecSourceLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10234,45 +15591,12 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteContractSpecSourceLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteContractSpecSourceLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10294,7 +15618,7 @@ func (m *MsgDeleteOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10317,17 +15641,17 @@ func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10337,80 +15661,63 @@ func (m *MsgDeleteOSLocatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SuccessorId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10420,24 +15727,23 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10460,7 +15766,7 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10483,15 +15789,15 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10518,7 +15824,7 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deprecation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
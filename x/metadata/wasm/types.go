@@ -77,6 +77,7 @@ type Record struct {
 	Process         *Process        `json:"process"`
 	Inputs          []*RecordInput  `json:"inputs,omitempty"`
 	Outputs         []*RecordOutput `json:"outputs,omitempty"`
+	DataAccess      []string        `json:"data_access,omitempty"`
 }
 
 // RecordInput is an input used to produce a record.
@@ -284,6 +285,7 @@ func createRecord(baseType *types.Record) (*Record, error) {
 		Process:         process,
 		Inputs:          make([]*RecordInput, len(baseType.Inputs)),
 		Outputs:         make([]*RecordOutput, len(baseType.Outputs)),
+		DataAccess:      baseType.DataAccess,
 	}
 	for i, in := range baseType.Inputs {
 		input, err := createRecordInput(in)
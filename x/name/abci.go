@@ -0,0 +1,26 @@
+package name
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	"github.com/provenance-io/provenance/x/name/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker returns the begin blocker for the name module.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	// Release any leased names that have expired without being renewed.
+	if err := k.ReleaseExpiredRecords(ctx); err != nil {
+		k.Logger(ctx).Error("unable to release expired names", "error", err)
+	}
+	// Release any offers to transfer names that have expired without being accepted.
+	if err := k.ReleaseExpiredNameOffers(ctx); err != nil {
+		k.Logger(ctx).Error("unable to release expired name offers", "error", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -37,6 +38,9 @@ type IntegrationTestSuite struct {
 	account2Addr  sdk.AccAddress
 	account2Key   *secp256k1.PrivKey
 	acc2NameCount int
+
+	account3Addr     sdk.AccAddress
+	leasedExpiration time.Time
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -55,6 +59,11 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.account2Addr = addr2
 	s.acc2NameCount = 50
 
+	addr3, err3 := sdk.AccAddressFromHex(secp256k1.GenPrivKeyFromSecret([]byte("acc3")).PubKey().Address().String())
+	s.Require().NoError(err3)
+	s.account3Addr = addr3
+	s.leasedExpiration = time.Now().Add(time.Hour).Truncate(time.Second)
+
 	s.T().Log("setting up integration test suite")
 
 	cfg := testutil.DefaultTestNetworkConfig()
@@ -67,11 +76,16 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	nameData.Params.MaxNameLevels = 2
 	nameData.Params.MaxSegmentLength = 32
 	nameData.Params.MinSegmentLength = 1
+	nameData.Params.NameLeasePeriod = time.Hour
+	nameData.Params.NameOfferPeriod = time.Hour
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("attribute", s.accountAddr, false))
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.attribute", s.accountAddr, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewLeasedNameRecord("leased.attribute", s.account3Addr, false, s.leasedExpiration))
 	for i := 0; i < s.acc2NameCount; i++ {
 		nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(toWritten(i), s.account2Addr, false))
 	}
+	nameData.Offers = append(nameData.Offers, nametypes.NewNameOffer("example.attribute", s.accountAddr, s.account3Addr, s.leasedExpiration))
+	nameData.ReservedNames = append(nameData.ReservedNames, "reserved.attribute")
 	nameDataBz, err := cfg.Codec.MarshalJSON(&nameData)
 	s.Require().NoError(err)
 	genesisState[nametypes.ModuleName] = nameDataBz
@@ -190,7 +204,7 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"name_lease_period\":\"3600s\",\"name_renewal_fee\":[],\"name_offer_period\":\"3600s\"}",
 		},
 		{
 			"text output",
@@ -198,7 +212,10 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 			`allow_unrestricted_names: true
 max_name_levels: 2
 max_segment_length: 32
-min_segment_length: 1`,
+min_segment_length: 1
+name_lease_period: 3600s
+name_offer_period: 3600s
+name_renewal_fee: []`,
 		},
 	}
 
@@ -225,12 +242,12 @@ func (s *IntegrationTestSuite) TestResolveNameCommand() {
 		{
 			"query name, json output",
 			[]string{"attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf("{\"address\":\"%s\"}", s.accountAddr.String()),
+			fmt.Sprintf("{\"address\":\"%[1]s\",\"addresses\":[\"%[1]s\"]}", s.accountAddr.String()),
 		},
 		{
 			"query name, text output",
 			[]string{"attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			fmt.Sprintf("address: %s", s.accountAddr.String()),
+			fmt.Sprintf("address: %[1]s\naddresses:\n- %[1]s", s.accountAddr.String()),
 		},
 		{
 			"query name that does not exist, text output",
@@ -297,6 +314,110 @@ func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestExpiringNamesCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query expiring names, json output",
+			[]string{"2h", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf("{\"records\":[{\"name\":\"leased.attribute\",\"address\":\"%s\",\"restricted\":false,\"expiration\":\"%d\",\"fallback_addresses\":[]}],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+				s.account3Addr.String(), s.leasedExpiration.Unix()),
+		},
+		{
+			"query expiring names, none expiring",
+			[]string{"1s", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"records\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := namecli.ExpiringNamesCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNameOffersCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query name offers, json output",
+			[]string{s.account3Addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf("{\"offers\":[{\"name\":\"example.attribute\",\"owner\":\"%s\",\"recipient\":\"%s\",\"expiration\":\"%d\"}],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+				s.accountAddr.String(), s.account3Addr.String(), s.leasedExpiration.Unix()),
+		},
+		{
+			"query name offers, none offered",
+			[]string{s.account2Addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"offers\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := namecli.NameOffersCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestReservedNamesCommand() {
+	cmd := namecli.ReservedNamesCommand()
+	clientCtx := s.testnet.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().Equal("{\"names\":[\"reserved.attribute\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		strings.TrimSpace(out.String()))
+}
+
+func (s *IntegrationTestSuite) TestNameTreeCommand() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+
+	s.Run("tree as text", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.NameTreeCommand(), []string{"attribute", "--depth=1"})
+		s.Require().NoError(err)
+		s.Require().True(strings.HasPrefix(out.String(), fmt.Sprintf("attribute %s\n", s.accountAddr)), out.String())
+		s.Require().Contains(out.String(), fmt.Sprintf("\n  example %s\n", s.accountAddr))
+		s.Require().Contains(out.String(), fmt.Sprintf("\n  leased %s [expires %s]\n", s.account3Addr, s.leasedExpiration.UTC().Format(time.RFC3339)))
+	})
+	s.Run("sub-tree as json", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.NameTreeCommand(), []string{"example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+		s.Require().NoError(err)
+		s.Require().Equal(
+			fmt.Sprintf("{\"nodes\":[{\"depth\":0,\"record\":{\"name\":\"example.attribute\",\"address\":\"%s\",\"restricted\":false,\"expiration\":\"0\",\"fallback_addresses\":[]}}],\"pagination\":{\"next_key\":null,\"total\":\"1\"}}", s.accountAddr),
+			strings.TrimSpace(out.String()))
+	})
+	s.Run("paginated", func() {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.NameTreeCommand(), []string{"attribute", "--limit=1"})
+		s.Require().NoError(err)
+		s.Require().Contains(out.String(), fmt.Sprintf("attribute %s\n(1 of ", s.accountAddr))
+	})
+	s.Run("unbound root", func() {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, namecli.NameTreeCommand(), []string{"notbound"})
+		s.Require().EqualError(err, "failed to query the names under \"notbound\": rpc error: code = NotFound desc = rpc error: code = NotFound desc = name notbound is not bound: key not found")
+	})
+}
+
 func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 
 	testCases := []struct {
@@ -340,6 +461,17 @@ func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 			},
 			false, &sdk.TxResponse{}, 18,
 		},
+		{
+			"should fail to bind reserved name",
+			namecli.GetBindNameCmd(),
+			[]string{"reserved", s.testnet.Validators[0].Address.String(), "attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -17,6 +18,9 @@ import (
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
+// The flag for the number of levels of sub-names shown by the name tree query
+const flagDepth = "depth"
+
 // GetQueryCmd is the top-level command for name CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -31,6 +35,10 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		ExpiringNamesCommand(),
+		NameTreeCommand(),
+		NameOffersCommand(),
+		ReservedNamesCommand(),
 	)
 
 	return queryCmd
@@ -160,6 +168,237 @@ $ %s query name loopup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limi
 	return cmd
 }
 
+// ExpiringNamesCommand returns the command handler for finding all leased names that expire within a length of time.
+func ExpiringNamesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring [within]",
+		Short: "Query all leased names that expire within a length of time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all leased names that expire within a length of time from the current block time:
+
+Example:
+$ %s query name expiring 720h
+$ %s query name expiring 24h --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			within, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid length of time %s: %w", args[0], err)
+			}
+
+			var response *types.QueryExpiringNamesResponse
+			if response, err = queryClient.ExpiringNames(
+				context.Background(),
+				&types.QueryExpiringNamesRequest{Within: within, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query names expiring within %s: %v\n", within, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "get")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NameOffersCommand returns the command handler for finding the pending offers to transfer names to an address.
+func NameOffersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers [address]",
+		Short: "Query the pending offers to transfer names to a given address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending offers to transfer names to a given address, which it can accept until they expire:
+
+Example:
+$ %s query name offers pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query name offers pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+
+			var response *types.QueryNameOffersResponse
+			if response, err = queryClient.NameOffers(
+				context.Background(),
+				&types.QueryNameOffersRequest{Address: address.String(), Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query name offers to \"%s\": %v\n", address, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "get")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReservedNamesCommand returns the command handler for querying the names that can only be bound by governance proposal.
+func ReservedNamesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved",
+		Short: "Query the names that can only be bound by a governance proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reserved names, which can only be bound by a governance proposal:
+
+Example:
+$ %s query name reserved
+$ %s query name reserved --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryReservedNamesResponse
+			if response, err = queryClient.ReservedNames(
+				context.Background(),
+				&types.QueryReservedNamesRequest{Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query reserved names: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "get")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NameTreeCommand returns the command handler for showing the hierarchy of sub-names under a root name.
+func NameTreeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tree [root]",
+		Short: "Query the hierarchy of sub-names under a root name",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a root name and the hierarchy of sub-names under it, printed as an indented tree of the names with
+their owner addresses and whether they are restricted.  Use --output json for the structured response.
+
+Example:
+$ %s query name tree pb
+$ %s query name tree pb --depth 2 --limit 500
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			depth, err := cmd.Flags().GetUint32(flagDepth)
+			if err != nil {
+				return err
+			}
+
+			root := strings.ToLower(strings.TrimSpace(args[0]))
+			response, err := queryClient.NameTree(
+				context.Background(),
+				&types.QueryNameTreeRequest{Root: root, MaxDepth: depth, Pagination: pageReq},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to query the names under \"%s\": %w", root, err)
+			}
+			if clientCtx.OutputFormat != "text" {
+				return clientCtx.PrintProto(response)
+			}
+			return clientCtx.PrintString(renderNameTree(response))
+		},
+	}
+
+	cmd.Flags().Uint32(flagDepth, 0, "The number of levels of sub-names under the root to show (default all levels)")
+	flags.AddPaginationFlagsToCmd(cmd, "get")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// renderNameTree prints the names of a name tree response indented by their depth, each with its owner address and
+// flags.  Names are labeled by their first segment when their parent name is shown above them.
+func renderNameTree(response *types.QueryNameTreeResponse) string {
+	var sb strings.Builder
+	shown := make(map[string]bool, len(response.Nodes))
+	for _, node := range response.Nodes {
+		name := node.Record.Name
+		label := name
+		if i := strings.Index(name, "."); node.Depth > 0 && i > 0 && shown[name[i+1:]] {
+			label = name[:i]
+		}
+		shown[name] = true
+		sb.WriteString(strings.Repeat("  ", int(node.Depth)))
+		sb.WriteString(fmt.Sprintf("%s %s", label, node.Record.Address))
+		var markers []string
+		if node.Record.Restricted {
+			markers = append(markers, "restricted")
+		}
+		if node.Record.Expiration > 0 {
+			markers = append(markers, "expires "+node.Record.ExpirationTime().UTC().Format(time.RFC3339))
+		}
+		if len(markers) > 0 {
+			sb.WriteString(fmt.Sprintf(" [%s]", strings.Join(markers, ", ")))
+		}
+		sb.WriteString("\n")
+	}
+	if response.Pagination != nil && response.Pagination.Total > uint64(len(response.Nodes)) {
+		sb.WriteString(fmt.Sprintf("(%d of %d names shown, use --page or --offset for more)\n", len(response.Nodes), response.Pagination.Total))
+	}
+	return sb.String()
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -1,16 +1,19 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
+	"github.com/provenance-io/provenance/internal/txbroadcast"
 	"github.com/provenance-io/provenance/x/name/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -19,6 +22,12 @@ import (
 // The flag for created restricted names
 const flagRestricted = "restrict"
 
+// The flag for created leased names
+const flagLease = "lease"
+
+// The flag for the priority of a fallback address bound to a name
+const flagPriority = "priority"
+
 // NewTxCmd is the top-level command for name CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -31,7 +40,14 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetRenewNameCmd(),
+		GetAddNameBindingCmd(),
+		GetRemoveNameBindingCmd(),
+		GetOfferNameCmd(),
+		GetAcceptNameCmd(),
+		GetNameProposalCmd(),
 	)
+	txbroadcast.AddFlags(txCmd.PersistentFlags())
 	return txCmd
 }
 
@@ -44,7 +60,8 @@ func GetBindNameCmd() *cobra.Command {
 			fmt.Sprintf(`Bind a name under an existing name in the provenance blockchain:
 
 Example:
-$ %s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example
+$ %[1]s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example
+$ %[1]s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example --lease
 `,
 				version.AppName,
 			)),
@@ -70,10 +87,12 @@ $ %s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example
 					false,
 				),
 			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			msg.Lease = viper.GetBool(flagLease)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().BoolP(flagRestricted, "r", true, "Restrict creation of child names to owner only")
+	cmd.Flags().Bool(flagLease, false, "Lease the name so that it is released after the name lease period unless renewed")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -97,7 +116,235 @@ func GetDeleteNameCmd() *cobra.Command {
 					false,
 				),
 			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRenewNameCmd is the CLI command for renewing a leased name.
+func GetRenewNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [name]",
+		Short: "Renew a leased name in the provenance blockchain, paying the name renewal fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRenewNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAddNameBindingCmd is the CLI command for binding a fallback address to a name.
+func GetAddNameBindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-binding [name] [address]",
+		Short: "Bind a fallback address to a name in the provenance blockchain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bind a fallback address to a name owned by the signer.  The name resolves to the owner's address
+followed by its fallback addresses in priority order.  The new address is added to the end of the fallback addresses
+unless a priority (starting at 1) is given:
+
+Example:
+$ %[1]s tx name add-binding service.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s tx name add-binding service.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --priority 1
+`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			priority, err := cmd.Flags().GetUint32(flagPriority)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAddNameBindingRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				address,
+				priority,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint32(flagPriority, 0, "Position of the address in the fallback addresses of the name, starting at 1 (default adds it last)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRemoveNameBindingCmd is the CLI command for removing a fallback address from a name.
+func GetRemoveNameBindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-binding [name] [address]",
+		Short: "Remove a fallback address from a name in the provenance blockchain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRemoveNameBindingRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				address,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetOfferNameCmd is the CLI command for offering to transfer a name to another address.
+func GetOfferNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer [name] [recipient]",
+		Short: "Offer to transfer a name in the provenance blockchain to another address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Offer to transfer a name owned by the signer to another address.  The name is not transferred
+until the recipient accepts the offer, which expires after the name offer period.  A new offer replaces any existing
+offer of the name:
+
+Example:
+$ %[1]s tx name offer service.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgOfferNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				recipient,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAcceptNameCmd is the CLI command for accepting an offer to transfer a name.
+func GetAcceptNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept [name]",
+		Short: "Accept an offer to transfer a name in the provenance blockchain to the signer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAcceptNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+			)
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetNameProposalCmd is the CLI command for submitting name governance proposals.
+func GetNameProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [type] [proposal-file] [deposit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a name proposal along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a name proposal along with an initial deposit.
+Proposal title, description, and name proposal params must be set in a provided JSON file.
+
+Example:
+$ %[1]s tx name proposal UpdateReservedNames "path/to/proposal.json" 1000%[2]s --from mykey
+
+Where proposal.json contains:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  // additional properties based on type here
+}
+
+
+Valid Proposal Types (and associated parameters):
+
+- CreateRootName
+	"name": "example",
+	"owner": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk",
+	"restricted": true
+
+- UpdateReservedNames
+	"add": ["provenance.pb", "trademark"],
+	"remove": ["example.pb"]
+`,
+				version.AppName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var proposal govtypes.Content
+			switch args[0] {
+			case types.ProposalTypeCreateRootName:
+				proposal = &types.CreateRootNameProposal{}
+			case types.ProposalTypeUpdateReservedNames:
+				proposal = &types.UpdateReservedNamesProposal{}
+			default:
+				return fmt.Errorf("unknown proposal type %s", args[0])
+			}
+			if err = json.Unmarshal(contents, proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid governance proposal. Error: %s", err)
+			}
+			return txbroadcast.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
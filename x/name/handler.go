@@ -23,6 +23,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteNameRequest:
 			res, err := msgServer.DeleteName(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRenewNameRequest:
+			res, err := msgServer.RenewName(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddNameBindingRequest:
+			res, err := msgServer.AddNameBinding(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveNameBindingRequest:
+			res, err := msgServer.RemoveNameBinding(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOfferNameRequest:
+			res, err := msgServer.OfferName(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptNameRequest:
+			res, err := msgServer.AcceptName(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -34,6 +49,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.CreateRootNameProposal:
 			return keeper.HandleCreateRootNameProposal(ctx, k, c)
+		case *types.UpdateReservedNamesProposal:
+			return keeper.HandleUpdateReservedNamesProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized name proposal content type: %T", c)
 		}
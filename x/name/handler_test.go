@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/golang/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -91,7 +93,7 @@ func TestCreateName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.DistrKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -151,7 +153,7 @@ func TestDeleteName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.DistrKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -169,3 +171,295 @@ func TestDeleteName(t *testing.T) {
 		})
 	}
 }
+
+//  renew leased name record
+func TestRenewName(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256k1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	blockTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           sdk.Msg
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "bind leased name record",
+			msg:           nametypes.NewMsgBindLeasedNameRequest(nametypes.NewNameRecord("leased", addr1, false), nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr1.String(), "leased.example.name"),
+		},
+		{
+			name:          "renew leased name record",
+			msg:           nametypes.NewMsgRenewNameRequest("leased.example.name", addr1),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameRenewed(addr1.String(), "leased.example.name", blockTime.Add(48*time.Hour).Format(time.RFC3339)),
+		},
+		{
+			name:          "renew name record that is not leased",
+			msg:           nametypes.NewMsgRenewNameRequest("example.name", addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameNotLeased.Error()),
+		},
+		{
+			name:          "renew name record owned by another address",
+			msg:           nametypes.NewMsgRenewNameRequest("leased.example.name", addr2),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot renew name"),
+		},
+		{
+			name:          "renew name record that does not exist",
+			msg:           nametypes.NewMsgRenewNameRequest("missing.example.name", addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist"),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	acc2 := &authtypes.BaseAccount{
+		Address: addr2.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1, acc2}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.name", addr1, false))
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+	nameData.Params.NameLeasePeriod = 24 * time.Hour
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+}
+
+//  add and remove fallback addresses of a name record
+func TestNameBindings(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256k1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           sdk.Msg
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "add fallback address",
+			msg:           nametypes.NewMsgAddNameBindingRequest("example.name", addr1, addr2, 0),
+			expectedEvent: nametypes.NewEventNameBindingAdded(addr2.String(), "example.name", 1),
+		},
+		{
+			name:          "add fallback address at highest priority",
+			msg:           nametypes.NewMsgAddNameBindingRequest("example.name", addr1, addr3, 1),
+			expectedEvent: nametypes.NewEventNameBindingAdded(addr3.String(), "example.name", 1),
+		},
+		{
+			name:          "add fallback address that is already bound",
+			msg:           nametypes.NewMsgAddNameBindingRequest("example.name", addr1, addr2, 0),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameBindingExists.Error()),
+		},
+		{
+			name:          "add fallback address to name owned by another address",
+			msg:           nametypes.NewMsgAddNameBindingRequest("example.name", addr2, addr3, 0),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot bind addresses to name"),
+		},
+		{
+			name:          "add fallback address to name that does not exist",
+			msg:           nametypes.NewMsgAddNameBindingRequest("missing.name", addr1, addr2, 0),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist"),
+		},
+		{
+			name:          "remove fallback address",
+			msg:           nametypes.NewMsgRemoveNameBindingRequest("example.name", addr1, addr3),
+			expectedEvent: nametypes.NewEventNameBindingRemoved(addr3.String(), "example.name"),
+		},
+		{
+			name:          "remove fallback address that is not bound",
+			msg:           nametypes.NewMsgRemoveNameBindingRequest("example.name", addr1, addr3),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameBindingNotFound.Error()),
+		},
+		{
+			name:          "remove fallback address from name owned by another address",
+			msg:           nametypes.NewMsgRemoveNameBindingRequest("example.name", addr2, addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot remove addresses from name"),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	acc2 := &authtypes.BaseAccount{
+		Address: addr2.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1, acc2}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.name", addr1, false))
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+
+	res, err := app.NameKeeper.Resolve(sdk.WrapSDKContext(ctx), &nametypes.QueryResolveRequest{Name: "example.name"})
+	require.NoError(t, err)
+	require.Equal(t, addr1.String(), res.Address)
+	require.Equal(t, []string{addr1.String(), addr2.String()}, res.Addresses)
+
+	exported := app.NameKeeper.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	for _, record := range exported.Bindings {
+		if record.Name == "example.name" {
+			require.Equal(t, []string{addr2.String()}, record.FallbackAddresses)
+		}
+	}
+}
+
+// transfer a name by offering it to an address that accepts it
+func TestNameOffers(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256k1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	blockTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           sdk.Msg
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "offer name owned by another address",
+			msg:           nametypes.NewMsgOfferNameRequest("example.name", addr2, addr3),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot offer name"),
+		},
+		{
+			name:          "offer name",
+			msg:           nametypes.NewMsgOfferNameRequest("example.name", addr1, addr2),
+			expectedEvent: nametypes.NewEventNameOffered("example.name", addr1.String(), addr2.String(), blockTime.Add(24*time.Hour).Format(time.RFC3339)),
+		},
+		{
+			name:          "accept offer made to another address",
+			msg:           nametypes.NewMsgAcceptNameRequest("example.name", addr3),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameOfferNotFound.Error()),
+		},
+		{
+			name:          "accept offer",
+			msg:           nametypes.NewMsgAcceptNameRequest("example.name", addr2),
+			expectedEvent: nametypes.NewEventNameAccepted("example.name", addr1.String(), addr2.String()),
+		},
+		{
+			name:          "accept offer that was already accepted",
+			msg:           nametypes.NewMsgAcceptNameRequest("example.name", addr2),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameOfferNotFound.Error()),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	acc2 := &authtypes.BaseAccount{
+		Address: addr2.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1, acc2}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	example := nametypes.NewNameRecord("example.name", addr1, false)
+	example.FallbackAddresses = []string{addr3.String()}
+	nameData.Bindings = append(nameData.Bindings, example)
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+	nameData.Params.NameOfferPeriod = 24 * time.Hour
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+
+	res, err := app.NameKeeper.Resolve(sdk.WrapSDKContext(ctx), &nametypes.QueryResolveRequest{Name: "example.name"})
+	require.NoError(t, err)
+	require.Equal(t, addr2.String(), res.Address)
+	require.Equal(t, []string{addr2.String()}, res.Addresses, "fallback addresses of the previous owner are removed")
+
+	// an offer that is not accepted before it expires is released
+	_, err = handler(ctx, nametypes.NewMsgOfferNameRequest("example.name", addr2, addr1))
+	require.NoError(t, err)
+	offers, err := app.NameKeeper.NameOffers(sdk.WrapSDKContext(ctx), &nametypes.QueryNameOffersRequest{Address: addr1.String()})
+	require.NoError(t, err)
+	require.Len(t, offers.Offers, 1)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(25 * time.Hour))
+	name.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.NameKeeper)
+	offers, err = app.NameKeeper.NameOffers(sdk.WrapSDKContext(ctx), &nametypes.QueryNameOffersRequest{Address: addr1.String()})
+	require.NoError(t, err)
+	require.Empty(t, offers.Offers)
+	_, err = handler(ctx, nametypes.NewMsgAcceptNameRequest("example.name", addr1))
+	require.EqualError(t, err, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, nametypes.ErrNameOfferNotFound.Error()).Error())
+}
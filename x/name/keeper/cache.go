@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// DefaultResolveCacheSize is the number of name records kept in the resolution cache of a keeper.
+const DefaultResolveCacheSize = 4096
+
+// resolveCache is an LRU cache of name records by name key that is shared by the copies of a keeper.
+//
+// Only block execution uses the cache: check txs and simulations run against state that is not committed, and queries
+// can run against the state of past heights.  Names written in a block are read from the store for the rest of that
+// block since the tx that wrote them can still fail and be rolled back.
+//
+// The gas of reading a record from the store is charged for cached records too, so that the gas used by a tx does
+// not depend on what happens to be cached on a node.
+type resolveCache struct {
+	records *lru.Cache
+
+	mtx sync.Mutex
+	// height is the block height that written is for.
+	height int64
+	// written are the keys of the names written at height.
+	written map[string]bool
+}
+
+// cachedRecord is a name record in the resolution cache along with the length of its stored bytes.
+type cachedRecord struct {
+	record types.NameRecord
+	size   int
+}
+
+// newResolveCache returns a resolution cache holding up to size name records.
+func newResolveCache(size int) *resolveCache {
+	records, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &resolveCache{records: records, written: make(map[string]bool)}
+}
+
+// usable returns whether the cache can be used with the context, clearing the written names of past blocks.
+// The mutex must be held.
+func (c *resolveCache) usable(ctx sdk.Context) bool {
+	if ctx.IsCheckTx() {
+		return false
+	}
+	if ctx.BlockHeight() != c.height {
+		c.height = ctx.BlockHeight()
+		c.written = make(map[string]bool)
+	}
+	return true
+}
+
+// get returns the cached record with the given name key, charging the gas of reading it from the store.
+func (c *resolveCache) get(ctx sdk.Context, key []byte) (*types.NameRecord, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.usable(ctx) || c.written[string(key)] {
+		return nil, false
+	}
+	value, found := c.records.Get(string(key))
+	if !found {
+		telemetry.IncrCounter(1, types.ModuleName, "resolve-cache", "miss")
+		return nil, false
+	}
+	telemetry.IncrCounter(1, types.ModuleName, "resolve-cache", "hit")
+	cached := value.(cachedRecord)
+	gasConfig := storetypes.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.HasCost, storetypes.GasHasDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(cached.size), storetypes.GasReadPerByteDesc)
+	record := cached.record
+	return &record, true
+}
+
+// add caches a record read from the store with the given name key.
+func (c *resolveCache) add(ctx sdk.Context, key []byte, record types.NameRecord, size int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.usable(ctx) || c.written[string(key)] {
+		return
+	}
+	c.records.Add(string(key), cachedRecord{record: record, size: size})
+}
+
+// invalidate removes the record with the given name key when the name is bound, changed or unbound.
+func (c *resolveCache) invalidate(ctx sdk.Context, key []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.records.Remove(string(key))
+	if c.usable(ctx) {
+		c.written[string(key)] = true
+	}
+}
@@ -14,7 +14,31 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		if err := keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
+		if record.IsLeased() {
+			err = keeper.SetLeasedNameRecord(ctx, record.Name, addr, record.Restricted, record.ExpirationTime())
+		} else {
+			err = keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted)
+		}
+		if err != nil {
+			panic(err)
+		}
+		for _, fallback := range record.FallbackAddresses {
+			fallbackAddr, err := sdk.AccAddressFromBech32(fallback)
+			if err != nil {
+				panic(err)
+			}
+			if _, err = keeper.AddNameBinding(ctx, record.Name, fallbackAddr, 0); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, offer := range data.Offers {
+		if err := keeper.writeNameOffer(ctx, offer); err != nil {
+			panic(err)
+		}
+	}
+	for _, name := range data.ReservedNames {
+		if err := keeper.SetReservedName(ctx, name); err != nil {
 			panic(err)
 		}
 	}
@@ -34,7 +58,22 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	offers := []types.NameOffer{}
+	appendToOffers := func(offer types.NameOffer) error {
+		offers = append(offers, offer)
+		return nil
+	}
+	if err := keeper.IterateNameOffers(ctx, types.NameOfferKeyPrefix, appendToOffers); err != nil {
+		panic(err)
+	}
+	genState := types.NewGenesisState(params, records, offers)
+	if err := keeper.IterateReservedNames(ctx, func(name string) error {
+		genState.ReservedNames = append(genState.ReservedNames, name)
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return genState
 }
 
 // convert name records before 1.0.0 to the right proto encoding.
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -30,17 +32,25 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// To collect name renewal fees into the community pool.
+	distrKeeper types.DistributionKeeper
+
+	// Cache of resolved name records, shared by the copies of the keeper.
+	resolveCache *resolveCache
 }
 
 // NewKeeper returns a name keeper. It handles:
 // - managing a hierarchy of names
 // - enforcing permissions for name creation/deletion
+// - releasing leased names that have expired
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -48,9 +58,11 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		cdc:        cdc,
+		storeKey:     key,
+		paramSpace:   paramSpace,
+		cdc:          cdc,
+		distrKeeper:  distrKeeper,
+		resolveCache: newResolveCache(DefaultResolveCacheSize),
 	}
 }
 
@@ -70,6 +82,16 @@ func (keeper Keeper) ResolvesTo(ctx sdk.Context, name string, addr sdk.AccAddres
 
 // SetNameRecord binds a name to an address.
 func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+	return keeper.bindNameRecord(ctx, name, addr, restrict, 0)
+}
+
+// SetLeasedNameRecord binds a name to an address until the given expiration, when it is released unless renewed.
+func (keeper Keeper) SetLeasedNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, expiration time.Time) error {
+	return keeper.bindNameRecord(ctx, name, addr, restrict, expiration.Unix())
+}
+
+// bindNameRecord normalizes and binds a name to an address, with an optional expiration (unix seconds, zero for none).
+func (keeper Keeper) bindNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool, expiration int64) error {
 	var err error
 	if name, err = keeper.Normalize(ctx, name); err != nil {
 		return err
@@ -86,14 +108,36 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 		return types.ErrNameAlreadyBound
 	}
 	record := types.NewNameRecord(name, addr, restrict)
+	record.Expiration = expiration
 	if err = record.ValidateBasic(); err != nil {
 		return err
 	}
+	if err = keeper.writeNameRecord(ctx, key, record); err != nil {
+		return err
+	}
+
+	nameBoundEvent := types.NewEventNameBound(record.Address, name)
+
+	if err := ctx.EventManager().EmitTypedEvent(nameBoundEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// writeNameRecord stores a name record along with its address and expiration indexes.
+func (keeper Keeper) writeNameRecord(ctx sdk.Context, key []byte, record types.NameRecord) error {
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
 	bz, err := keeper.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
+	store := ctx.KVStore(keeper.storeKey)
 	store.Set(key, bz)
+	keeper.resolveCache.invalidate(ctx, key)
 	// Now index by address
 	addrPrefix, err := types.GetAddressKeyPrefix(addr)
 	if err != nil {
@@ -101,13 +145,21 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	}
 	indexKey := append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(indexKey, bz)
+	// Leased names are also indexed by expiration so they can be released once expired.
+	if record.IsLeased() {
+		store.Set(types.GetExpirationKey(record.ExpirationTime(), key), bz)
+	}
+	// And under their parent so they can be released along with it.
+	return keeper.indexSubName(ctx, key, record.Name)
+}
 
-	nameBoundEvent := types.NewEventNameBound(record.Address, name)
-
-	if err := ctx.EventManager().EmitTypedEvent(nameBoundEvent); err != nil {
+// indexSubName indexes a name record key under the parent of the name, root names are not indexed.
+func (keeper Keeper) indexSubName(ctx sdk.Context, key []byte, name string) error {
+	subNameKey, err := types.GetSubNameKey(name, key)
+	if err != nil || subNameKey == nil {
 		return err
 	}
-
+	ctx.KVStore(keeper.storeKey).Set(subNameKey, []byte(name))
 	return nil
 }
 
@@ -130,14 +182,20 @@ func (keeper Keeper) GetRecordByNameLegacy(ctx sdk.Context, name string) (record
 }
 
 func getNameRecord(ctx sdk.Context, keeper Keeper, key []byte) (record *types.NameRecord, err error) {
+	if record, found := keeper.resolveCache.get(ctx, key); found {
+		return record, nil
+	}
 	store := ctx.KVStore(keeper.storeKey)
 	if !store.Has(key) {
 		return nil, types.ErrNameNotBound
 	}
 	bz := store.Get(key)
 	record = &types.NameRecord{}
-	err = keeper.cdc.Unmarshal(bz, record)
-	return record, err
+	if err = keeper.cdc.Unmarshal(bz, record); err != nil {
+		return record, err
+	}
+	keeper.resolveCache.add(ctx, key, *record, len(bz))
+	return record, nil
 }
 
 // NameExists returns true if store contains a record for the given name.
@@ -191,6 +249,7 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	}
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(key)
+	keeper.resolveCache.invalidate(ctx, key)
 	// Delete the address index record
 	addrPrefix, err := types.GetAddressKeyPrefix(address)
 	if err != nil {
@@ -200,6 +259,22 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(indexKey) {
 		store.Delete(indexKey)
 	}
+	// Delete the expiration index record
+	if record.IsLeased() {
+		store.Delete(types.GetExpirationKey(record.ExpirationTime(), key))
+	}
+	// Delete the parent index record
+	subNameKey, err := types.GetSubNameKey(record.Name, key)
+	if err != nil {
+		return err
+	}
+	if subNameKey != nil {
+		store.Delete(subNameKey)
+	}
+	// An offer to transfer the name goes with it
+	if err = keeper.deleteNameOffer(ctx, record.Name); err != nil {
+		return err
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name)
 
@@ -210,6 +285,439 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	return nil
 }
 
+// RenewNameRecord charges the renewal fee to the owner of a leased name and extends its expiration by the name lease
+// period, starting from the current block time if the lease period is longer than what remains.
+func (keeper Keeper) RenewNameRecord(ctx sdk.Context, name string, owner sdk.AccAddress) (*types.NameRecord, error) {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !record.IsLeased() {
+		return nil, types.ErrNameNotLeased
+	}
+	period := keeper.GetNameLeasePeriod(ctx)
+	if period == 0 {
+		return nil, types.ErrNameLeaseDisabled
+	}
+	if fee := keeper.GetNameRenewalFee(ctx); !fee.IsZero() {
+		if err = keeper.distrKeeper.FundCommunityPool(ctx, fee, owner); err != nil {
+			return nil, err
+		}
+	}
+	key, err := types.GetNameKeyPrefix(record.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Clear the old expiration index before storing the record with its new expiration.
+	ctx.KVStore(keeper.storeKey).Delete(types.GetExpirationKey(record.ExpirationTime(), key))
+	start := ctx.BlockTime()
+	if record.ExpirationTime().After(start) {
+		start = record.ExpirationTime()
+	}
+	expiration := start.Add(period).UTC()
+	record.Expiration = expiration.Unix()
+	if err = keeper.writeNameRecord(ctx, key, *record); err != nil {
+		return nil, err
+	}
+
+	nameRenewedEvent := types.NewEventNameRenewed(record.Address, record.Name, expiration.Format(time.RFC3339))
+
+	if err := ctx.EventManager().EmitTypedEvent(nameRenewedEvent); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// AddNameBinding binds a fallback address to a name at the given priority, starting at 1.  A zero priority adds the
+// address to the end of the name's fallback addresses.
+func (keeper Keeper) AddNameBinding(ctx sdk.Context, name string, addr sdk.AccAddress, priority uint32) (*types.NameRecord, error) {
+	if err := types.ValidateAddress(addr); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = record.AddFallbackAddress(addr.String(), priority); err != nil {
+		return nil, err
+	}
+	key, err := types.GetNameKeyPrefix(record.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = keeper.writeNameRecord(ctx, key, *record); err != nil {
+		return nil, err
+	}
+
+	nameBindingAddedEvent := types.NewEventNameBindingAdded(addr.String(), record.Name, record.FallbackPriority(addr.String()))
+
+	if err := ctx.EventManager().EmitTypedEvent(nameBindingAddedEvent); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// RemoveNameBinding removes a fallback address from a name.
+func (keeper Keeper) RemoveNameBinding(ctx sdk.Context, name string, addr sdk.AccAddress) (*types.NameRecord, error) {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = record.RemoveFallbackAddress(addr.String()); err != nil {
+		return nil, err
+	}
+	key, err := types.GetNameKeyPrefix(record.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = keeper.writeNameRecord(ctx, key, *record); err != nil {
+		return nil, err
+	}
+
+	nameBindingRemovedEvent := types.NewEventNameBindingRemoved(addr.String(), record.Name)
+
+	if err := ctx.EventManager().EmitTypedEvent(nameBindingRemovedEvent); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// OfferName offers to transfer a name to a recipient, replacing any existing offer of the name.  The offer expires after
+// the name offer period unless it is accepted.
+func (keeper Keeper) OfferName(ctx sdk.Context, name string, recipient sdk.AccAddress) (*types.NameOffer, error) {
+	if err := types.ValidateAddress(recipient); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	period := keeper.GetNameOfferPeriod(ctx)
+	if period == 0 {
+		return nil, types.ErrNameTransferDisabled
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err = keeper.deleteNameOffer(ctx, record.Name); err != nil {
+		return nil, err
+	}
+	offer := types.NewNameOffer(record.Name, owner, recipient, ctx.BlockTime().Add(period))
+	if err = offer.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err = keeper.writeNameOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	nameOfferedEvent := types.NewEventNameOffered(offer.Name, offer.Owner, offer.Recipient, offer.ExpirationTime().Format(time.RFC3339))
+
+	if err := ctx.EventManager().EmitTypedEvent(nameOfferedEvent); err != nil {
+		return nil, err
+	}
+
+	return &offer, nil
+}
+
+// AcceptName binds a name to the recipient of a pending offer to transfer it.  The fallback addresses of the name are
+// removed since they were bound by the previous owner.
+func (keeper Keeper) AcceptName(ctx sdk.Context, name string, recipient sdk.AccAddress) (*types.NameRecord, error) {
+	offer, err := keeper.GetNameOffer(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Recipient != recipient.String() || !offer.ExpirationTime().After(ctx.BlockTime()) {
+		return nil, types.ErrNameOfferNotFound
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if record.Address != offer.Owner {
+		return nil, sdkerrors.Wrap(types.ErrNameOfferNotFound, "name is no longer bound to the address that offered it")
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return nil, err
+	}
+	key, err := types.GetNameKeyPrefix(record.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Clear the address index of the previous owner before storing the record with its new address.
+	addrPrefix, err := types.GetAddressKeyPrefix(owner)
+	if err != nil {
+		return nil, err
+	}
+	ctx.KVStore(keeper.storeKey).Delete(append(addrPrefix, key...))
+	record.Address = recipient.String()
+	record.FallbackAddresses = nil
+	if err = keeper.writeNameRecord(ctx, key, *record); err != nil {
+		return nil, err
+	}
+	if err = keeper.deleteNameOffer(ctx, record.Name); err != nil {
+		return nil, err
+	}
+
+	nameAcceptedEvent := types.NewEventNameAccepted(record.Name, offer.Owner, offer.Recipient)
+
+	if err := ctx.EventManager().EmitTypedEvent(nameAcceptedEvent); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GetNameOffer returns the pending offer to transfer a name.
+func (keeper Keeper) GetNameOffer(ctx sdk.Context, name string) (*types.NameOffer, error) {
+	key, err := types.GetNameOfferKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return nil, types.ErrNameOfferNotFound
+	}
+	offer := &types.NameOffer{}
+	err = keeper.cdc.Unmarshal(bz, offer)
+	return offer, err
+}
+
+// writeNameOffer stores a name offer along with its recipient and expiration indexes.
+func (keeper Keeper) writeNameOffer(ctx sdk.Context, offer types.NameOffer) error {
+	recipient, err := sdk.AccAddressFromBech32(offer.Recipient)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameOfferKey(offer.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(&offer)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	store.Set(types.GetNameOfferRecipientKey(recipient, key), bz)
+	store.Set(types.GetNameOfferExpirationKey(offer.ExpirationTime(), key), bz)
+	return nil
+}
+
+// deleteNameOffer removes the pending offer to transfer a name, if there is one, along with its indexes.
+func (keeper Keeper) deleteNameOffer(ctx sdk.Context, name string) error {
+	offer, err := keeper.GetNameOffer(ctx, name)
+	if errors.Is(err, types.ErrNameOfferNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	recipient, err := sdk.AccAddressFromBech32(offer.Recipient)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameOfferKey(offer.Name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(key)
+	store.Delete(types.GetNameOfferRecipientKey(recipient, key))
+	store.Delete(types.GetNameOfferExpirationKey(offer.ExpirationTime(), key))
+	return nil
+}
+
+// IterateNameOffers iterates over the name offers stored under the given prefix and passes them to a callback
+// function.
+func (keeper Keeper) IterateNameOffers(ctx sdk.Context, prefix []byte, handle func(offer types.NameOffer) error) error {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		offer := types.NameOffer{}
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &offer); err != nil {
+			return err
+		}
+		if err := handle(offer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetReservedName adds a name to the names that can only be bound by governance proposal.
+func (keeper Keeper) SetReservedName(ctx sdk.Context, name string) error {
+	key, err := types.GetReservedNameKey(name)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(keeper.storeKey).Set(key, []byte(name))
+	return nil
+}
+
+// DeleteReservedName removes a name from the names that can only be bound by governance proposal.
+func (keeper Keeper) DeleteReservedName(ctx sdk.Context, name string) error {
+	if !keeper.IsReservedName(ctx, name) {
+		return types.ErrNameNotReserved
+	}
+	key, err := types.GetReservedNameKey(name)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(keeper.storeKey).Delete(key)
+	return nil
+}
+
+// IsReservedName returns true if the name can only be bound by governance proposal.
+func (keeper Keeper) IsReservedName(ctx sdk.Context, name string) bool {
+	key, err := types.GetReservedNameKey(name)
+	if err != nil {
+		return false
+	}
+	return ctx.KVStore(keeper.storeKey).Has(key)
+}
+
+// IterateReservedNames iterates over the reserved names and passes them to a callback function.
+func (keeper Keeper) IterateReservedNames(ctx sdk.Context, handle func(name string) error) error {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.ReservedNameKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if err := handle(string(iterator.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseExpiredNameOffers removes all name offers that have expired as of the current block time.
+func (keeper Keeper) ReleaseExpiredNameOffers(ctx sdk.Context) error {
+	expired := []types.NameOffer{}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.NameOfferExpirationKeyPrefix, sdk.PrefixEndBytes(types.GetNameOfferExpirationKeyPrefix(ctx.BlockTime())))
+	for ; iterator.Valid(); iterator.Next() {
+		offer := types.NameOffer{}
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &offer); err != nil {
+			iterator.Close()
+			return err
+		}
+		expired = append(expired, offer)
+	}
+	iterator.Close()
+	for _, offer := range expired {
+		if err := keeper.deleteNameOffer(ctx, offer.Name); err != nil {
+			return err
+		}
+
+		nameOfferExpiredEvent := types.NewEventNameOfferExpired(offer.Name, offer.Owner, offer.Recipient)
+
+		if err := ctx.EventManager().EmitTypedEvent(nameOfferExpiredEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterateExpiredRecords iterates over all leased name records that expire at or before the given time.
+func (keeper Keeper) IterateExpiredRecords(ctx sdk.Context, endTime time.Time, handle Handler) error {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.ExpirationKeyPrefix, sdk.PrefixEndBytes(types.GetExpirationKeyPrefix(endTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.NameRecord{}
+		if err := keeper.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if err := handle(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseExpiredRecords removes all leased name records that have expired as of the current block time, along with
+// all the names under them.  A name that fails to be released is logged and left for the next block.
+func (keeper Keeper) ReleaseExpiredRecords(ctx sdk.Context) error {
+	expired := types.NameRecords{}
+	err := keeper.IterateExpiredRecords(ctx, ctx.BlockTime(), func(record types.NameRecord) error {
+		expired = append(expired, record)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, record := range expired {
+		// A leased name under another expired name may already be gone with it.
+		if !keeper.NameExists(ctx, record.Name) {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = keeper.releaseExpiredRecord(cacheCtx, record); err != nil {
+			keeper.Logger(ctx).Error("unable to release expired name", "name", record.Name, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return nil
+}
+
+// releaseExpiredRecord removes an expired name record and all the names under it.
+func (keeper Keeper) releaseExpiredRecord(ctx sdk.Context, record types.NameRecord) error {
+	subNames, err := keeper.getSubNames(ctx, record.Name)
+	if err != nil {
+		return err
+	}
+	// Remove the deepest names first so that no name is ever left without its parent.
+	for i := len(subNames) - 1; i >= 0; i-- {
+		if err = keeper.DeleteRecord(ctx, subNames[i]); err != nil {
+			return err
+		}
+	}
+	if err = keeper.DeleteRecord(ctx, record.Name); err != nil {
+		return err
+	}
+
+	nameExpiredEvent := types.NewEventNameExpired(record.Address, record.Name)
+
+	return ctx.EventManager().EmitTypedEvent(nameExpiredEvent)
+}
+
+// getSubNames returns all the names under the given name, each name listed before the names under it.
+func (keeper Keeper) getSubNames(ctx sdk.Context, name string) ([]string, error) {
+	var names []string
+	parents := []string{name}
+	for len(parents) > 0 {
+		prefix, err := types.GetSubNameKeyPrefix(parents[0])
+		if err != nil {
+			return nil, err
+		}
+		parents = parents[1:]
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			subName := string(iterator.Value())
+			names = append(names, subName)
+			parents = append(parents, subName)
+		}
+		iterator.Close()
+	}
+	return names, nil
+}
+
+// IndexSubNames indexes every stored name record under its parent name.
+func (keeper Keeper) IndexSubNames(ctx sdk.Context) error {
+	return keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		key, err := types.GetNameKeyPrefix(record.Name)
+		if err != nil {
+			return err
+		}
+		return keeper.indexSubName(ctx, key, record.Name)
+	})
+}
+
 // IterateRecords iterates over all the stored name records and passes them to a callback function.
 func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a name record iterator
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"gopkg.in/yaml.v2"
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/provenance-io/provenance/x/name/keeper"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -85,6 +88,9 @@ func (s *KeeperTestSuite) TestSetup() {
   minsegmentlength: 2
   maxnamelevels: 16
   allowunrestrictednames: false
+  nameleaseperiod: 0s
+  namerenewalfee: []
+  nameofferperiod: 0s
 bindings:
 - name: name
   address: %[1]s
@@ -92,6 +98,8 @@ bindings:
 - name: example.name
   address: %[1]s
   restricted: false
+offers: []
+reservednames: []
 `, s.user1Addr.String()), string(out))
 }
 
@@ -276,9 +284,176 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 
 }
 
+func (s *KeeperTestSuite) TestLeasedNames() {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.NameLeasePeriod = time.Hour
+	params.NameRenewalFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15))))
+
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	expiringNames := func(ctx sdk.Context, within time.Duration) []string {
+		res, err := s.app.NameKeeper.ExpiringNames(sdk.WrapSDKContext(ctx), &nametypes.QueryExpiringNamesRequest{Within: within})
+		s.Require().NoError(err)
+		names := []string{}
+		for _, record := range res.Records {
+			names = append(names, record.Name)
+		}
+		return names
+	}
+
+	s.Run("root names cannot be leased", func() {
+		err := s.app.NameKeeper.SetLeasedNameRecord(ctx, "leased", s.user1Addr, false, now.Add(time.Hour))
+		s.Require().ErrorIs(err, nametypes.ErrNameRootLease)
+	})
+	s.Run("set leased names", func() {
+		s.Require().NoError(s.app.NameKeeper.SetLeasedNameRecord(ctx, "soon.name", s.user1Addr, false, now.Add(time.Minute)))
+		s.Require().NoError(s.app.NameKeeper.SetLeasedNameRecord(ctx, "later.name", s.user1Addr, false, now.Add(time.Hour)))
+		record, err := s.app.NameKeeper.GetRecordByName(ctx, "soon.name")
+		s.Require().NoError(err)
+		s.Require().True(record.IsLeased())
+		s.Require().Equal(now.Add(time.Minute), record.ExpirationTime())
+	})
+	s.Run("query expiring names", func() {
+		s.Require().Equal([]string{"soon.name"}, expiringNames(ctx, 30*time.Minute))
+		s.Require().Equal([]string{"soon.name", "later.name"}, expiringNames(ctx, 2*time.Hour))
+		_, err := s.app.NameKeeper.ExpiringNames(sdk.WrapSDKContext(ctx), &nametypes.QueryExpiringNamesRequest{})
+		s.Require().Error(err)
+	})
+	s.Run("renew name that is not leased", func() {
+		_, err := s.app.NameKeeper.RenewNameRecord(ctx, "example.name", s.user1Addr)
+		s.Require().ErrorIs(err, nametypes.ErrNameNotLeased)
+	})
+	s.Run("renew leased name", func() {
+		record, err := s.app.NameKeeper.RenewNameRecord(ctx, "soon.name", s.user1Addr)
+		s.Require().NoError(err)
+		s.Require().Equal(now.Add(time.Minute+time.Hour), record.ExpirationTime())
+		s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), s.app.BankKeeper.GetBalance(ctx, s.user1Addr, sdk.DefaultBondDenom))
+		s.Require().Equal([]string{"later.name", "soon.name"}, expiringNames(ctx, 2*time.Hour))
+	})
+	s.Run("renew without funds for the fee", func() {
+		_, err := s.app.NameKeeper.RenewNameRecord(ctx, "later.name", s.user1Addr)
+		s.Require().Error(err)
+	})
+	s.Run("release expired names", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "sub.later.name", s.user1Addr, false))
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "deep.sub.later.name", s.user1Addr, false))
+		s.Require().NoError(s.app.NameKeeper.SetLeasedNameRecord(ctx, "leased.later.name", s.user1Addr, false, now.Add(time.Hour)))
+		// Names bound before the sub-name index existed are indexed by the migration.
+		subNameKey, err := nametypes.GetSubNameKey("deep.sub.later.name", mustNameKey("deep.sub.later.name"))
+		s.Require().NoError(err)
+		ctx.KVStore(s.app.GetKey(nametypes.StoreKey)).Delete(subNameKey)
+		migrator := keeper.NewMigrator(s.app.NameKeeper)
+		s.Require().NoError(migrator.Migrate2to3(ctx))
+
+		expiredCtx := ctx.WithBlockTime(now.Add(time.Hour))
+		s.Require().NoError(s.app.NameKeeper.ReleaseExpiredRecords(expiredCtx))
+		s.Require().False(s.app.NameKeeper.NameExists(expiredCtx, "later.name"))
+		s.Require().False(s.app.NameKeeper.NameExists(expiredCtx, "sub.later.name"), "sub-name of expired name")
+		s.Require().False(s.app.NameKeeper.NameExists(expiredCtx, "deep.sub.later.name"), "sub-name of sub-name of expired name")
+		s.Require().False(s.app.NameKeeper.NameExists(expiredCtx, "leased.later.name"), "leased sub-name of expired name")
+		s.Require().True(s.app.NameKeeper.NameExists(expiredCtx, "name"))
+		s.Require().True(s.app.NameKeeper.NameExists(expiredCtx, "soon.name"))
+		s.Require().Equal([]string{"soon.name"}, expiringNames(expiredCtx, 2*time.Hour))
+	})
+}
+
+func mustNameKey(name string) []byte {
+	key, err := nametypes.GetNameKeyPrefix(name)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 func (s *KeeperTestSuite) TestSecp256r1KeyAlgo() {
 	s.Run("should successfully add name for account with secp256r1 key", func() {
 		err := s.app.NameKeeper.SetNameRecord(s.ctx, "secp256r1.name", s.user2Addr, true)
 		s.NoError(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestNameTree() {
+	for _, name := range []string{"bb.example.name", "aa.example.name", "deep.aa.example.name", "other.name", "unrelated"} {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user1Addr, name == "aa.example.name"))
+	}
+	nameTree := func(req *nametypes.QueryNameTreeRequest) ([]string, *nametypes.QueryNameTreeResponse) {
+		res, err := s.app.NameKeeper.NameTree(sdk.WrapSDKContext(s.ctx), req)
+		s.Require().NoError(err)
+		names := []string{}
+		for _, node := range res.Nodes {
+			names = append(names, fmt.Sprintf("%d:%s", node.Depth, node.Record.Name))
+		}
+		return names, res
+	}
+
+	s.Run("whole tree", func() {
+		names, res := nameTree(&nametypes.QueryNameTreeRequest{Root: "name"})
+		s.Require().Equal([]string{"0:name", "1:example.name", "2:aa.example.name", "3:deep.aa.example.name", "2:bb.example.name", "1:other.name"}, names)
+		s.Require().Equal(uint64(6), res.Pagination.Total)
+		s.Require().True(res.Nodes[2].Record.Restricted)
+	})
+	s.Run("sub-tree with max depth", func() {
+		names, _ := nameTree(&nametypes.QueryNameTreeRequest{Root: " Example.Name ", MaxDepth: 1})
+		s.Require().Equal([]string{"0:example.name", "1:aa.example.name", "1:bb.example.name"}, names)
+	})
+	s.Run("paginated", func() {
+		names, res := nameTree(&nametypes.QueryNameTreeRequest{Root: "name", Pagination: &query.PageRequest{Offset: 2, Limit: 3}})
+		s.Require().Equal([]string{"2:aa.example.name", "3:deep.aa.example.name", "2:bb.example.name"}, names)
+		s.Require().Equal(uint64(6), res.Pagination.Total)
+	})
+	s.Run("errors", func() {
+		_, err := s.app.NameKeeper.NameTree(sdk.WrapSDKContext(s.ctx), &nametypes.QueryNameTreeRequest{Root: "missing"})
+		s.Require().EqualError(err, "rpc error: code = NotFound desc = name missing is not bound")
+		_, err = s.app.NameKeeper.NameTree(sdk.WrapSDKContext(s.ctx), &nametypes.QueryNameTreeRequest{Root: "name", Pagination: &query.PageRequest{Offset: 7}})
+		s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = invalid offset 7 of 6 names")
+		_, err = s.app.NameKeeper.NameTree(sdk.WrapSDKContext(s.ctx), &nametypes.QueryNameTreeRequest{Root: "name", Pagination: &query.PageRequest{Key: []byte("next")}})
+		s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = key based pagination is not supported, use an offset")
+	})
+}
+
+func (s *KeeperTestSuite) TestResolveCache() {
+	key, err := nametypes.GetNameKeyPrefix("cached.name")
+	s.Require().NoError(err)
+	atHeight := func(height int64) sdk.Context {
+		return s.ctx.WithBlockHeight(height).WithGasMeter(sdk.NewInfiniteGasMeter())
+	}
+
+	ctx := atHeight(10)
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "cached.name", s.user1Addr, false))
+	s.Require().True(s.app.NameKeeper.ResolvesTo(ctx, "cached.name", s.user1Addr), "resolved in the block it was bound")
+
+	// Names written in a block are read from the store until the block is over, in case the tx that wrote them fails.
+	cacheCtx, _ := atHeight(10).CacheContext()
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(cacheCtx, "rolled-back.name", s.user1Addr, false))
+	s.Require().True(s.app.NameKeeper.NameExists(cacheCtx, "rolled-back.name"))
+	_, err = s.app.NameKeeper.GetRecordByName(atHeight(11), "rolled-back.name")
+	s.Require().ErrorIs(err, nametypes.ErrNameNotBound, "name bound in a discarded context")
+
+	missCtx := atHeight(11)
+	record, err := s.app.NameKeeper.GetRecordByName(missCtx, "cached.name")
+	s.Require().NoError(err)
+	s.Require().Equal(s.user1, record.Address)
+
+	// Removing the record from under the keeper shows that the next read is served from the cache, for the same gas.
+	ctx.KVStore(s.app.GetKey(nametypes.StoreKey)).Delete(key)
+	hitCtx := atHeight(11)
+	record, err = s.app.NameKeeper.GetRecordByName(hitCtx, "cached.name")
+	s.Require().NoError(err)
+	s.Require().Equal(s.user1, record.Address)
+	s.Require().Equal(missCtx.GasMeter().GasConsumed(), hitCtx.GasMeter().GasConsumed(), "gas of a cached read")
+
+	// Check txs do not use the cache.
+	_, err = s.app.NameKeeper.GetRecordByName(atHeight(11).WithIsCheckTx(true), "cached.name")
+	s.Require().ErrorIs(err, nametypes.ErrNameNotBound, "check tx")
+
+	// Binding or unbinding a name removes it from the cache.
+	ctx = atHeight(12)
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, "cached.name", s.user2Addr, false))
+	s.Require().True(s.app.NameKeeper.ResolvesTo(atHeight(13), "cached.name", s.user2Addr), "resolved after rebinding")
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(atHeight(14), "cached.name"))
+	s.Require().False(s.app.NameKeeper.NameExists(atHeight(15), "cached.name"))
+	_, err = s.app.NameKeeper.GetRecordByName(atHeight(15), "cached.name")
+	s.Require().ErrorIs(err, nametypes.ErrNameNotBound, "unbound name")
+}
@@ -19,3 +19,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v042.MigrateAddressLength(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3 by indexing the existing names under their parent names.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.IndexSubNames(ctx)
+}
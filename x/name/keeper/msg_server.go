@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -62,13 +63,29 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("name already bound", "name", name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrNameAlreadyBound.Error())
 	}
+	if s.Keeper.IsReservedName(ctx, name) {
+		ctx.Logger().Error("name is reserved", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrNameReserved.Error())
+	}
 	// Bind name to address
 	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
 	if err != nil {
 		ctx.Logger().Error("invalid address", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
+	if msg.Lease {
+		// Leased names are released at the end of the lease period unless they are renewed.
+		period := s.Keeper.GetNameLeasePeriod(ctx)
+		if period == 0 {
+			ctx.Logger().Error("unable to lease name", "name", name)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrNameLeaseDisabled.Error())
+		}
+		expiration := ctx.BlockTime().Add(period).UTC()
+		if err := s.Keeper.SetLeasedNameRecord(ctx, name, address, msg.Record.Restricted, expiration); err != nil {
+			ctx.Logger().Error("unable to bind name", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	} else if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -148,3 +165,269 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 
 	return &types.MsgDeleteNameResponse{}, nil
 }
+
+// RenewName extends the expiration of a leased name
+func (s msgServer) RenewName(goCtx context.Context, msg *types.MsgRenewNameRequest) (*types.MsgRenewNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot renew name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot renew name")
+	}
+	// Renew
+	record, err := s.Keeper.RenewNameRecord(ctx, name, owner)
+	if err != nil {
+		ctx.Logger().Error("error renewing name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+renew
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "renew"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameRenewed,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+			sdk.NewAttribute(types.KeyAttributeExpiration, record.ExpirationTime().Format(time.RFC3339)),
+		),
+	)
+
+	return &types.MsgRenewNameResponse{}, nil
+}
+
+// AddNameBinding binds a fallback address to a name
+func (s msgServer) AddNameBinding(goCtx context.Context, msg *types.MsgAddNameBindingRequest) (*types.MsgAddNameBindingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	name, owner, address, err := s.normalizeNameBinding(ctx, msg.Name, msg.Owner, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot bind addresses to name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot bind addresses to name")
+	}
+	// Bind
+	record, err := s.Keeper.AddNameBinding(ctx, name, address, msg.Priority)
+	if err != nil {
+		ctx.Logger().Error("error binding address to name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+add_binding
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "add_binding"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Address)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameBindingAdded,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Address),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+			sdk.NewAttribute(types.KeyAttributePriority, fmt.Sprintf("%d", record.FallbackPriority(msg.Address))),
+		),
+	)
+
+	return &types.MsgAddNameBindingResponse{}, nil
+}
+
+// RemoveNameBinding removes a fallback address from a name
+func (s msgServer) RemoveNameBinding(goCtx context.Context, msg *types.MsgRemoveNameBindingRequest) (*types.MsgRemoveNameBindingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	name, owner, address, err := s.normalizeNameBinding(ctx, msg.Name, msg.Owner, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot remove addresses from name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot remove addresses from name")
+	}
+	// Remove
+	if _, err = s.Keeper.RemoveNameBinding(ctx, name, address); err != nil {
+		ctx.Logger().Error("error removing address from name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+remove_binding
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "remove_binding"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Address)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameBindingRemoved,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Address),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+		),
+	)
+
+	return &types.MsgRemoveNameBindingResponse{}, nil
+}
+
+// OfferName offers to transfer a name to another address
+func (s msgServer) OfferName(goCtx context.Context, msg *types.MsgOfferNameRequest) (*types.MsgOfferNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	name, owner, recipient, err := s.normalizeNameBinding(ctx, msg.Name, msg.Owner, msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot offer name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot offer name")
+	}
+	// Offer
+	offer, err := s.Keeper.OfferName(ctx, name, recipient)
+	if err != nil {
+		ctx.Logger().Error("error offering name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+offer
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "offer"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameOffered,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+			sdk.NewAttribute(types.KeyAttributeRecipient, msg.Recipient),
+			sdk.NewAttribute(types.KeyAttributeExpiration, offer.ExpirationTime().Format(time.RFC3339)),
+		),
+	)
+
+	return &types.MsgOfferNameResponse{}, nil
+}
+
+// AcceptName accepts an offer to transfer a name
+func (s msgServer) AcceptName(goCtx context.Context, msg *types.MsgAcceptNameRequest) (*types.MsgAcceptNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Accept
+	record, err := s.Keeper.AcceptName(ctx, name, recipient)
+	if err != nil {
+		ctx.Logger().Error("error accepting name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+accept
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "accept"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Recipient)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameAccepted,
+			sdk.NewAttribute(types.KeyAttributeAddress, record.Address),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+		),
+	)
+
+	return &types.MsgAcceptNameResponse{}, nil
+}
+
+// normalizeNameBinding normalizes the name of a name binding request, ensuring it exists, and parses its addresses.
+func (s msgServer) normalizeNameBinding(ctx sdk.Context, name, owner, address string) (string, sdk.AccAddress, sdk.AccAddress, error) {
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return "", nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse addresses
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return "", nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return "", nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return "", nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	return name, ownerAddr, addr, nil
+}
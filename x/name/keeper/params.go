@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -13,6 +15,9 @@ func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MinSegmentLength:       keeper.GetMinSegmentLength(ctx),
 		MaxNameLevels:          keeper.GetMaxNameLevels(ctx),
 		AllowUnrestrictedNames: keeper.GetAllowUnrestrictedNames(ctx),
+		NameLeasePeriod:        keeper.GetNameLeasePeriod(ctx),
+		NameRenewalFee:         keeper.GetNameRenewalFee(ctx),
+		NameOfferPeriod:        keeper.GetNameOfferPeriod(ctx),
 	}
 }
 
@@ -56,3 +61,31 @@ func (keeper Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) (enabled bool) {
 	}
 	return
 }
+
+// GetNameLeasePeriod returns the current length of time a leased name is bound for (or default if unset)
+func (keeper Keeper) GetNameLeasePeriod(ctx sdk.Context) (period time.Duration) {
+	period = types.DefaultNameLeasePeriod
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyNameLeasePeriod) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyNameLeasePeriod, &period)
+	}
+	return
+}
+
+// GetNameRenewalFee returns the current fee charged to renew a leased name (or default if unset)
+func (keeper Keeper) GetNameRenewalFee(ctx sdk.Context) (fee sdk.Coins) {
+	fee = types.DefaultNameRenewalFee
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyNameRenewalFee) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyNameRenewalFee, &fee)
+	}
+	return
+}
+
+// GetNameOfferPeriod returns the current length of time an offer to transfer a name can be accepted for (or default if
+// unset)
+func (keeper Keeper) GetNameOfferPeriod(ctx sdk.Context) (period time.Duration) {
+	period = types.DefaultNameOfferPeriod
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyNameOfferPeriod) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyNameOfferPeriod, &period)
+	}
+	return
+}
@@ -43,3 +43,30 @@ func HandleCreateRootNameProposal(ctx sdk.Context, k Keeper, p *types.CreateRoot
 
 	return nil
 }
+
+// HandleUpdateReservedNamesProposal is a handler for executing a passed update reserved names proposal
+func HandleUpdateReservedNamesProposal(ctx sdk.Context, k Keeper, p *types.UpdateReservedNamesProposal) error {
+	logger := k.Logger(ctx)
+	for _, n := range p.Remove {
+		name, err := k.Normalize(ctx, n)
+		if err != nil {
+			return fmt.Errorf("invalid reserved name %s: %w", n, err)
+		}
+		if err = k.DeleteReservedName(ctx, name); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		logger.Info(fmt.Sprintf("update reserved names proposal: removed %s", name))
+	}
+	for _, n := range p.Add {
+		name, err := k.Normalize(ctx, n)
+		if err != nil {
+			return fmt.Errorf("invalid reserved name %s: %w", n, err)
+		}
+		if err = k.SetReservedName(ctx, name); err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("update reserved names proposal: added %s", name))
+	}
+
+	return nil
+}
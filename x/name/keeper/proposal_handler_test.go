@@ -31,7 +31,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(false)
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = namekeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(nametypes.ModuleName), s.app.GetSubspace(nametypes.ModuleName))
+	s.k = namekeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(nametypes.ModuleName), s.app.GetSubspace(nametypes.ModuleName), s.app.DistrKeeper)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -102,6 +102,31 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 			true,
 			fmt.Errorf("segment of name is too short"),
 		},
+		// UPDATE RESERVED NAMES PROPOSALS
+		{
+			"update reserved names - valid add",
+			nametypes.NewUpdateReservedNamesProposal("title", "description", []string{"Trademark", "reserved.provenance.io"}, nil),
+			false,
+			nil,
+		},
+		{
+			"update reserved names - valid remove",
+			nametypes.NewUpdateReservedNamesProposal("title", "description", nil, []string{"reserved.provenance.io"}),
+			false,
+			nil,
+		},
+		{
+			"update reserved names - fails remove not reserved",
+			nametypes.NewUpdateReservedNamesProposal("title", "description", nil, []string{"reserved.provenance.io"}),
+			true,
+			fmt.Errorf("reserved.provenance.io: name is not reserved"),
+		},
+		{
+			"update reserved names - fails invalid name",
+			nametypes.NewUpdateReservedNamesProposal("title", "description", []string{"..bad"}, nil),
+			true,
+			fmt.Errorf("invalid reserved name ..bad: segment of name is too short"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -113,6 +138,8 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 			switch c := tc.prop.(type) {
 			case *nametypes.CreateRootNameProposal:
 				err = namekeeper.HandleCreateRootNameProposal(s.ctx, s.k, c)
+			case *nametypes.UpdateReservedNamesProposal:
+				err = namekeeper.HandleUpdateReservedNamesProposal(s.ctx, s.k, c)
 			default:
 				panic("invalid proposal type")
 			}
@@ -127,6 +154,31 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestReservedNames() {
+	s.Require().NoError(namekeeper.HandleUpdateReservedNamesProposal(s.ctx, s.k,
+		nametypes.NewUpdateReservedNamesProposal("title", "description", []string{"brand.pb"}, nil)))
+	s.Require().True(s.k.IsReservedName(s.ctx, "brand.pb"))
+
+	res, err := s.k.ReservedNames(sdk.WrapSDKContext(s.ctx), &nametypes.QueryReservedNamesRequest{})
+	s.Require().NoError(err)
+	s.Require().Contains(res.Names, "brand.pb")
+
+	// a reserved name cannot be bound by a request
+	s.Require().NoError(s.k.SetNameRecord(s.ctx, "pb", s.accountAddr, false))
+	msgServer := namekeeper.NewMsgServerImpl(s.k)
+	_, err = msgServer.BindName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord("brand", s.accountAddr, false), nametypes.NewNameRecord("pb", s.accountAddr, false)))
+	s.Require().EqualError(err, "name is reserved: invalid request")
+
+	// but it can be bound by a proposal
+	s.Require().NoError(namekeeper.HandleCreateRootNameProposal(s.ctx, s.k,
+		nametypes.NewCreateRootNameProposal("title", "description", "brand.pb", s.accountAddr, false)))
+	s.Require().True(s.k.NameExists(s.ctx, "brand.pb"))
+
+	genesis := s.k.ExportGenesis(s.ctx)
+	s.Require().Contains(genesis.ReservedNames, "brand.pb")
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -2,21 +2,27 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// defaultTreeLimit is the number of names returned by the name tree query when no limit is given.
+const defaultTreeLimit = 100
+
 // Params queries params of distribution module
 func (keeper Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	keeper.paramSpace.GetParamSet(ctx, &params)
+	params := keeper.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -35,7 +41,7 @@ func (keeper Keeper) Resolve(c context.Context, request *types.QueryResolveReque
 	if record == nil {
 		return nil, types.ErrNameNotBound
 	}
-	return &types.QueryResolveResponse{Address: record.Address}, nil
+	return &types.QueryResolveResponse{Address: record.Address, Addresses: record.Addresses()}, nil
 }
 
 // ReverseLookup gets all names bound to an address.
@@ -73,3 +79,138 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// ExpiringNames gets all leased names that expire within a length of time from the current block time.
+func (keeper Keeper) ExpiringNames(c context.Context, request *types.QueryExpiringNamesRequest) (*types.QueryExpiringNamesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.Within <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "within must be a positive length of time")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	endTime := ctx.BlockTime().Add(request.Within)
+	records := types.NameRecords{}
+	store := ctx.KVStore(keeper.storeKey)
+	expirationStore := prefix.NewStore(store, types.ExpirationKeyPrefix)
+	pageRes, err := query.FilteredPaginate(expirationStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.NameRecord
+		if err := keeper.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+		if record.ExpirationTime().After(endTime) {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpiringNamesResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// NameTree gets a root name and the names under it, up to a maximum depth, in tree order.
+func (keeper Keeper) NameTree(c context.Context, request *types.QueryNameTreeRequest) (*types.QueryNameTreeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	root, err := keeper.Normalize(ctx, request.Root)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !keeper.NameExists(ctx, root) {
+		return nil, status.Errorf(codes.NotFound, "name %s is not bound", root)
+	}
+	pageRequest := request.Pagination
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if len(pageRequest.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use an offset")
+	}
+
+	// Names are keyed by the hash of the whole name, so finding the names under the root means checking every name.
+	var nodes []types.NameTreeNode
+	err = keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		depth, under := types.NameTreeDepth(root, record.Name)
+		if under && (request.MaxDepth == 0 || depth <= request.MaxDepth) {
+			nodes = append(nodes, types.NameTreeNode{Depth: depth, Record: record})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sort.Slice(nodes, func(i, j int) bool { return types.NameTreeLess(nodes[i].Record.Name, nodes[j].Record.Name) })
+
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = defaultTreeLimit
+	}
+	total := uint64(len(nodes))
+	if pageRequest.Offset > total {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid offset %d of %d names", pageRequest.Offset, total))
+	}
+	end := pageRequest.Offset + limit
+	if end > total {
+		end = total
+	}
+	return &types.QueryNameTreeResponse{
+		Nodes:      nodes[pageRequest.Offset:end],
+		Pagination: &query.PageResponse{Total: total},
+	}, nil
+}
+
+// NameOffers gets the pending offers to transfer names to an address.
+func (keeper Keeper) NameOffers(c context.Context, request *types.QueryNameOffersRequest) (*types.QueryNameOffersResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	recipient, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	offers := []types.NameOffer{}
+	offerStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetNameOfferRecipientKeyPrefix(recipient))
+	pageRes, err := query.Paginate(offerStore, request.Pagination, func(key []byte, value []byte) error {
+		var offer types.NameOffer
+		if err := keeper.cdc.Unmarshal(value, &offer); err != nil {
+			return err
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNameOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// ReservedNames returns the names that can only be bound by a governance proposal.
+func (keeper Keeper) ReservedNames(c context.Context, request *types.QueryReservedNamesRequest) (*types.QueryReservedNamesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	names := []string{}
+	reservedStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.ReservedNameKeyPrefix)
+	pageRes, err := query.Paginate(reservedStore, request.Pagination, func(key []byte, value []byte) error {
+		names = append(names, string(value))
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReservedNamesResponse{Names: names, Pagination: pageRes}, nil
+}
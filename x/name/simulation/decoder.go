@@ -21,7 +21,7 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("%v\n%v", nameA, nameB)
-		case bytes.Equal(kvA.Key[:1], types.AddressKeyPrefix):
+		case bytes.Equal(kvA.Key[:1], types.AddressKeyPrefix), bytes.Equal(kvA.Key[:1], types.ExpirationKeyPrefix):
 			var nameA, nameB types.NameRecord
 
 			cdc.MustUnmarshal(kvA.Value, &nameA)
@@ -87,6 +87,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			MaxNameLevels:          maxNameLevels,
 			MinSegmentLength:       minValueLength,
 			AllowUnrestrictedNames: allowUnrestrictedNames,
+			NameLeasePeriod:        types.DefaultNameLeasePeriod,
+			NameRenewalFee:         types.DefaultNameRenewalFee,
 		},
 		Bindings: []types.NameRecord{
 			types.NewNameRecord(rootNameSegment, simState.Accounts[0].Address, false),
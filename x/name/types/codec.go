@@ -14,7 +14,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBindNameRequest{}, "provenance/MsgBindNameRequest", nil)
 	cdc.RegisterConcrete(MsgDeleteNameRequest{}, "provenance/MsgDeleteNameRequest", nil)
+	cdc.RegisterConcrete(MsgRenewNameRequest{}, "provenance/MsgRenewNameRequest", nil)
+	cdc.RegisterConcrete(MsgAddNameBindingRequest{}, "provenance/MsgAddNameBindingRequest", nil)
+	cdc.RegisterConcrete(MsgRemoveNameBindingRequest{}, "provenance/MsgRemoveNameBindingRequest", nil)
+	cdc.RegisterConcrete(MsgOfferNameRequest{}, "provenance/MsgOfferNameRequest", nil)
+	cdc.RegisterConcrete(MsgAcceptNameRequest{}, "provenance/MsgAcceptNameRequest", nil)
 	cdc.RegisterConcrete(CreateRootNameProposal{}, "provenance/CreateRootNameProposal", nil)
+	cdc.RegisterConcrete(UpdateReservedNamesProposal{}, "provenance/UpdateReservedNamesProposal", nil)
 }
 
 // RegisterInterfaces registers concrete implentations for the given type names
@@ -23,11 +29,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgBindNameRequest{},
 		&MsgDeleteNameRequest{},
+		&MsgRenewNameRequest{},
+		&MsgAddNameBindingRequest{},
+		&MsgRemoveNameBindingRequest{},
+		&MsgOfferNameRequest{},
+		&MsgAcceptNameRequest{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CreateRootNameProposal{},
+		&UpdateReservedNamesProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -22,4 +22,26 @@ var (
 	ErrInvalidAddress = sdkerrors.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = sdkerrors.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrNameNotLeased occurs when a renewal is requested for a name that does not expire.
+	ErrNameNotLeased = sdkerrors.Register(ModuleName, 10, "name is not leased")
+	// ErrNameLeaseDisabled occurs when a lease is requested while the name lease period is zero.
+	ErrNameLeaseDisabled = sdkerrors.Register(ModuleName, 11, "leased names are disabled")
+	// ErrNameRootLease occurs when an expiration is set on a root name.
+	ErrNameRootLease = sdkerrors.Register(ModuleName, 12, "root names cannot be leased")
+	// ErrNameBindingExists occurs when an address is already bound to a name.
+	ErrNameBindingExists = sdkerrors.Register(ModuleName, 13, "address is already bound to name")
+	// ErrNameBindingNotFound occurs when a fallback address to remove is not bound to a name.
+	ErrNameBindingNotFound = sdkerrors.Register(ModuleName, 14, "address is not a fallback address of name")
+	// ErrNameBindingPriority occurs when a fallback address priority is past the end of the list.
+	ErrNameBindingPriority = sdkerrors.Register(ModuleName, 15, "invalid name binding priority")
+	// ErrNameTooManyBindings occurs when a name would have more than the maximum number of fallback addresses.
+	ErrNameTooManyBindings = sdkerrors.Register(ModuleName, 16, "name has too many fallback addresses")
+	// ErrNameTransferDisabled occurs when a name is offered while the name offer period is zero.
+	ErrNameTransferDisabled = sdkerrors.Register(ModuleName, 17, "name transfers are disabled")
+	// ErrNameOfferNotFound occurs when there is no pending offer of a name to the address accepting it.
+	ErrNameOfferNotFound = sdkerrors.Register(ModuleName, 18, "no pending offer of name")
+	// ErrNameReserved occurs when a bind request is made against a name that can only be bound by governance proposal.
+	ErrNameReserved = sdkerrors.Register(ModuleName, 19, "name is reserved")
+	// ErrNameNotReserved occurs when a name to remove from the reserved names is not reserved.
+	ErrNameNotReserved = sdkerrors.Register(ModuleName, 20, "name is not reserved")
 )
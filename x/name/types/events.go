@@ -5,11 +5,29 @@ const (
 	EventTypeNameBound string = "name_bound"
 	// EventTypeNameUnbound is the type of event generated when a name is unbound from an address (deleted).
 	EventTypeNameUnbound string = "name_unbound"
+	// EventTypeNameRenewed is the type of event generated when a leased name is renewed.
+	EventTypeNameRenewed string = "name_renewed"
+	// EventTypeNameExpired is the type of event generated when a leased name expires and is released.
+	EventTypeNameExpired string = "name_expired"
+	// EventTypeNameBindingAdded is the type of event generated when a fallback address is bound to a name.
+	EventTypeNameBindingAdded string = "name_binding_added"
+	// EventTypeNameBindingRemoved is the type of event generated when a fallback address is removed from a name.
+	EventTypeNameBindingRemoved string = "name_binding_removed"
+	// EventTypeNameOffered is the type of event generated when the owner of a name offers to transfer it.
+	EventTypeNameOffered string = "name_offered"
+	// EventTypeNameAccepted is the type of event generated when an offer to transfer a name is accepted.
+	EventTypeNameAccepted string = "name_accepted"
 
 	// KeyAttributeName is the key for a name.
 	KeyAttributeName string = "name"
 	// KeyAttributeAddress is the key for an address.
 	KeyAttributeAddress string = "address"
+	// KeyAttributeExpiration is the key for a name expiration time.
+	KeyAttributeExpiration string = "expiration"
+	// KeyAttributePriority is the key for the priority of a fallback address.
+	KeyAttributePriority string = "priority"
+	// KeyAttributeRecipient is the key for the recipient of a name offer.
+	KeyAttributeRecipient string = "recipient"
 )
 
 func NewEventNameBound(address string, name string) *EventNameBound {
@@ -25,3 +43,58 @@ func NewEventNameUnbound(address string, name string) *EventNameUnbound {
 		Name:    name,
 	}
 }
+
+func NewEventNameRenewed(address string, name string, expiration string) *EventNameRenewed {
+	return &EventNameRenewed{
+		Address:    address,
+		Name:       name,
+		Expiration: expiration,
+	}
+}
+
+func NewEventNameExpired(address string, name string) *EventNameExpired {
+	return &EventNameExpired{
+		Address: address,
+		Name:    name,
+	}
+}
+
+func NewEventNameBindingAdded(address string, name string, priority uint32) *EventNameBindingAdded {
+	return &EventNameBindingAdded{
+		Address:  address,
+		Name:     name,
+		Priority: priority,
+	}
+}
+
+func NewEventNameBindingRemoved(address string, name string) *EventNameBindingRemoved {
+	return &EventNameBindingRemoved{
+		Address: address,
+		Name:    name,
+	}
+}
+
+func NewEventNameOffered(name string, owner string, recipient string, expiration string) *EventNameOffered {
+	return &EventNameOffered{
+		Name:       name,
+		Owner:      owner,
+		Recipient:  recipient,
+		Expiration: expiration,
+	}
+}
+
+func NewEventNameAccepted(name string, owner string, recipient string) *EventNameAccepted {
+	return &EventNameAccepted{
+		Name:      name,
+		Owner:     owner,
+		Recipient: recipient,
+	}
+}
+
+func NewEventNameOfferExpired(name string, owner string, recipient string) *EventNameOfferExpired {
+	return &EventNameOfferExpired{
+		Name:      name,
+		Owner:     owner,
+		Recipient: recipient,
+	}
+}
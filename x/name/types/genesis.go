@@ -12,10 +12,11 @@ import (
 type NameRecords []NameRecord
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, nameRecords NameRecords) *GenesisState {
+func NewGenesisState(params Params, nameRecords NameRecords, offers []NameOffer) *GenesisState {
 	return &GenesisState{
 		Params:   params,
 		Bindings: nameRecords,
+		Offers:   offers,
 	}
 }
 
@@ -49,6 +50,31 @@ func (state GenesisState) Validate() error {
 		if strings.TrimSpace(record.Address) == "" {
 			return fmt.Errorf("address cannot be empty")
 		}
+		if record.IsLeased() && !strings.Contains(record.Name, ".") {
+			return fmt.Errorf("root name %s cannot be leased", record.Name)
+		}
+		if err := record.ValidateFallbackAddresses(); err != nil {
+			return fmt.Errorf("name %s: %w", record.Name, err)
+		}
+	}
+	for _, offer := range state.Offers {
+		if err := offer.ValidateBasic(); err != nil {
+			return fmt.Errorf("offer of name %s: %w", offer.Name, err)
+		}
+		if !NameRecords(state.Bindings).Contains(offer.Name) {
+			return fmt.Errorf("offer of name %s: %w", offer.Name, ErrNameNotBound)
+		}
+	}
+	reserved := make(map[string]bool)
+	for _, name := range state.ReservedNames {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			return fmt.Errorf("reserved name cannot be empty")
+		}
+		if reserved[name] {
+			return fmt.Errorf("duplicate reserved name %s", name)
+		}
+		reserved[name] = true
 	}
 	return nil
 }
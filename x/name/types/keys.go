@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -34,6 +35,18 @@ var (
 	NameKeyPrefix = []byte{0x03}
 	// AddressKeyPrefix is a prefix added to keys for indexing name records by address.
 	AddressKeyPrefix = []byte{0x05}
+	// ExpirationKeyPrefix is a prefix added to keys for indexing leased name records by expiration time.
+	ExpirationKeyPrefix = []byte{0x06}
+	// NameOfferKeyPrefix is a prefix added to keys for pending offers to transfer names.
+	NameOfferKeyPrefix = []byte{0x07}
+	// NameOfferRecipientKeyPrefix is a prefix added to keys for indexing name offers by recipient.
+	NameOfferRecipientKeyPrefix = []byte{0x08}
+	// NameOfferExpirationKeyPrefix is a prefix added to keys for indexing name offers by expiration time.
+	NameOfferExpirationKeyPrefix = []byte{0x09}
+	// ReservedNameKeyPrefix is a prefix added to keys for the names that can only be bound by governance proposal.
+	ReservedNameKeyPrefix = []byte{0x0A}
+	// SubNameKeyPrefix is a prefix added to keys for indexing name records under their parent name.
+	SubNameKeyPrefix = []byte{0x0B}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -82,6 +95,69 @@ func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	return
 }
 
+// GetExpirationKeyPrefix returns a store key prefix for all leased names that expire at the given time.
+func GetExpirationKeyPrefix(expiration time.Time) []byte {
+	return append(ExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetExpirationKey returns a store key for indexing a name record key by its expiration time.
+func GetExpirationKey(expiration time.Time, nameKey []byte) []byte {
+	return append(GetExpirationKeyPrefix(expiration), nameKey...) // [0x06] :: [time-bytes] :: [name-key-bytes]
+}
+
+// GetNameOfferKey converts a name into the key format of its pending offer.
+func GetNameOfferKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, NameOfferKeyPrefix)
+}
+
+// GetNameOfferRecipientKeyPrefix returns a store key prefix for all name offers to the given recipient.
+func GetNameOfferRecipientKeyPrefix(recipient sdk.AccAddress) []byte {
+	return append(NameOfferRecipientKeyPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// GetNameOfferRecipientKey returns a store key for indexing a name offer key by its recipient.
+func GetNameOfferRecipientKey(recipient sdk.AccAddress, offerKey []byte) []byte {
+	return append(GetNameOfferRecipientKeyPrefix(recipient), offerKey...) // [0x08] :: [addr-bytes] :: [offer-key-bytes]
+}
+
+// GetNameOfferExpirationKeyPrefix returns a store key prefix for all name offers that expire at the given time.
+func GetNameOfferExpirationKeyPrefix(expiration time.Time) []byte {
+	return append(NameOfferExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetNameOfferExpirationKey returns a store key for indexing a name offer key by its expiration time.
+func GetNameOfferExpirationKey(expiration time.Time, offerKey []byte) []byte {
+	return append(GetNameOfferExpirationKeyPrefix(expiration), offerKey...) // [0x09] :: [time-bytes] :: [offer-key-bytes]
+}
+
+// GetReservedNameKey converts a reserved name into key format.
+func GetReservedNameKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, ReservedNameKeyPrefix)
+}
+
+// GetSubNameKeyPrefix returns a store key prefix for all names directly under the given parent name.
+func GetSubNameKeyPrefix(parent string) ([]byte, error) {
+	parentKey, err := getNamePrefixByType(parent, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, SubNameKeyPrefix...), parentKey...), nil
+}
+
+// GetSubNameKey returns a store key for indexing a name record key under its parent name, it returns nil for a root
+// name.
+func GetSubNameKey(name string, nameKey []byte) ([]byte, error) {
+	i := strings.Index(name, ".")
+	if i < 0 {
+		return nil, nil
+	}
+	prefix, err := GetSubNameKeyPrefix(name[i+1:])
+	if err != nil {
+		return nil, err
+	}
+	return append(prefix, nameKey[len(NameKeyPrefix):]...), nil // [0x0B] :: [parent-key-bytes] :: [name-key-bytes]
+}
+
 func ValidateAddress(address sdk.AccAddress) error {
 	if err := sdk.VerifyAddressFormat(address); err != nil {
 		return err
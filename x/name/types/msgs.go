@@ -11,10 +11,21 @@ import (
 const (
 	TypeMsgBindNameRequest   = "bind_name"
 	TypeMsgDeleteNameRequest = "delete_name"
+	TypeMsgRenewNameRequest  = "renew_name"
+
+	TypeMsgAddNameBindingRequest    = "add_name_binding"
+	TypeMsgRemoveNameBindingRequest = "remove_name_binding"
+
+	TypeMsgOfferNameRequest  = "offer_name"
+	TypeMsgAcceptNameRequest = "accept_name"
 )
 
 // Compile time interface checks.
-var _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}
+var (
+	_, _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}, &MsgRenewNameRequest{}
+	_, _    sdk.Msg = &MsgAddNameBindingRequest{}, &MsgRemoveNameBindingRequest{}
+	_, _    sdk.Msg = &MsgOfferNameRequest{}, &MsgAcceptNameRequest{}
+)
 
 // NewMsgBindNameRequest creates a new bind name request
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -24,6 +35,15 @@ func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
 	}
 }
 
+// NewMsgBindLeasedNameRequest creates a new bind name request for a name that expires unless it is renewed
+func NewMsgBindLeasedNameRequest(record, parent NameRecord) *MsgBindNameRequest {
+	return &MsgBindNameRequest{
+		Parent: parent,
+		Record: record,
+		Lease:  true,
+	}
+}
+
 // Route implements Msg
 func (msg MsgBindNameRequest) Route() string { return ModuleName }
 
@@ -101,3 +121,213 @@ func (msg MsgDeleteNameRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRenewNameRequest creates a new Renew Name Request
+func NewMsgRenewNameRequest(name string, owner sdk.AccAddress) *MsgRenewNameRequest { //nolint:interfacer
+	return &MsgRenewNameRequest{
+		Name:  name,
+		Owner: owner.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgRenewNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRenewNameRequest) Type() string { return TypeMsgRenewNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRenewNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Owner) == "" {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRenewNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgRenewNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddNameBindingRequest creates a new Add Name Binding Request
+func NewMsgAddNameBindingRequest(name string, owner sdk.AccAddress, address sdk.AccAddress, priority uint32) *MsgAddNameBindingRequest { //nolint:interfacer
+	return &MsgAddNameBindingRequest{
+		Name:     name,
+		Owner:    owner.String(),
+		Address:  address.String(),
+		Priority: priority,
+	}
+}
+
+// Route implements Msg
+func (msg MsgAddNameBindingRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgAddNameBindingRequest) Type() string { return TypeMsgAddNameBindingRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAddNameBindingRequest) ValidateBasic() error {
+	return validateNameBindingMsg(msg.Name, msg.Owner, msg.Address)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddNameBindingRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgAddNameBindingRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveNameBindingRequest creates a new Remove Name Binding Request
+func NewMsgRemoveNameBindingRequest(name string, owner sdk.AccAddress, address sdk.AccAddress) *MsgRemoveNameBindingRequest { //nolint:interfacer
+	return &MsgRemoveNameBindingRequest{
+		Name:    name,
+		Owner:   owner.String(),
+		Address: address.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgRemoveNameBindingRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRemoveNameBindingRequest) Type() string { return TypeMsgRemoveNameBindingRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveNameBindingRequest) ValidateBasic() error {
+	return validateNameBindingMsg(msg.Name, msg.Owner, msg.Address)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveNameBindingRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgRemoveNameBindingRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgOfferNameRequest creates a new Offer Name Request
+func NewMsgOfferNameRequest(name string, owner sdk.AccAddress, recipient sdk.AccAddress) *MsgOfferNameRequest { //nolint:interfacer
+	return &MsgOfferNameRequest{
+		Name:      name,
+		Owner:     owner.String(),
+		Recipient: recipient.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgOfferNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgOfferNameRequest) Type() string { return TypeMsgOfferNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgOfferNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Owner) == "" {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if msg.Owner == msg.Recipient {
+		return fmt.Errorf("a name cannot be offered to its owner")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgOfferNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgOfferNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAcceptNameRequest creates a new Accept Name Request
+func NewMsgAcceptNameRequest(name string, recipient sdk.AccAddress) *MsgAcceptNameRequest { //nolint:interfacer
+	return &MsgAcceptNameRequest{
+		Name:      name,
+		Recipient: recipient.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgAcceptNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgAcceptNameRequest) Type() string { return TypeMsgAcceptNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAcceptNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Recipient) == "" {
+		return fmt.Errorf("recipient cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the recipient of the offer.
+func (msg MsgAcceptNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// validateNameBindingMsg runs the stateless validation checks shared by the name binding messages.
+func validateNameBindingMsg(name, owner, address string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(owner) == "" {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if owner == address {
+		return fmt.Errorf("owner cannot be bound as a fallback address")
+	}
+	return nil
+}
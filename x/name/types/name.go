@@ -3,10 +3,14 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxFallbackAddresses is the maximum number of fallback addresses that can be bound to a name.
+const MaxFallbackAddresses = 10
+
 // NewNameRecord creates a name record binding that is restricted for child updates to the owner.
 func NewNameRecord(name string, address sdk.AccAddress, restricted bool) NameRecord { //nolint:interfacer
 	return NameRecord{
@@ -16,12 +20,85 @@ func NewNameRecord(name string, address sdk.AccAddress, restricted bool) NameRec
 	}
 }
 
+// NewLeasedNameRecord creates a name record binding that is released at the given expiration unless it is renewed.
+func NewLeasedNameRecord(name string, address sdk.AccAddress, restricted bool, expiration time.Time) NameRecord { //nolint:interfacer
+	record := NewNameRecord(name, address, restricted)
+	record.Expiration = expiration.Unix()
+	return record
+}
+
+// IsLeased returns true if the name record expires unless it is renewed.
+func (nr NameRecord) IsLeased() bool {
+	return nr.Expiration > 0
+}
+
+// ExpirationTime returns the time a leased name record is released.
+func (nr NameRecord) ExpirationTime() time.Time {
+	return time.Unix(nr.Expiration, 0).UTC()
+}
+
+// Addresses returns all addresses the name resolves to in priority order, the bound address followed by the fallback
+// addresses.
+func (nr NameRecord) Addresses() []string {
+	return append([]string{nr.Address}, nr.FallbackAddresses...)
+}
+
+// AddFallbackAddress binds a fallback address to the name at the given priority, starting at 1.  A zero priority adds
+// the address to the end of the list.
+func (nr *NameRecord) AddFallbackAddress(address string, priority uint32) error {
+	for _, addr := range nr.Addresses() {
+		if addr == address {
+			return ErrNameBindingExists
+		}
+	}
+	if len(nr.FallbackAddresses) >= MaxFallbackAddresses {
+		return ErrNameTooManyBindings
+	}
+	if priority == 0 {
+		priority = uint32(len(nr.FallbackAddresses)) + 1
+	}
+	if priority > uint32(len(nr.FallbackAddresses))+1 {
+		return fmt.Errorf("%w: %d, name has %d fallback addresses", ErrNameBindingPriority, priority, len(nr.FallbackAddresses))
+	}
+	i := priority - 1
+	nr.FallbackAddresses = append(nr.FallbackAddresses[:i], append([]string{address}, nr.FallbackAddresses[i:]...)...)
+	return nil
+}
+
+// FallbackPriority returns the priority of a fallback address of the name, starting at 1, or zero if it isn't bound.
+func (nr NameRecord) FallbackPriority(address string) uint32 {
+	for i, addr := range nr.FallbackAddresses {
+		if addr == address {
+			return uint32(i + 1)
+		}
+	}
+	return 0
+}
+
+// RemoveFallbackAddress removes a fallback address from the name, moving the lower priority addresses up.
+func (nr *NameRecord) RemoveFallbackAddress(address string) error {
+	for i, addr := range nr.FallbackAddresses {
+		if addr == address {
+			nr.FallbackAddresses = append(nr.FallbackAddresses[:i], nr.FallbackAddresses[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNameBindingNotFound
+}
+
 // implement fmt.Stringer
 func (nr NameRecord) String() string {
+	out := fmt.Sprintf(`%s: %s`, nr.Name, nr.Address)
 	if nr.Restricted {
-		return strings.TrimSpace(fmt.Sprintf(`%s: %s [restricted]`, nr.Name, nr.Address))
+		out += " [restricted]"
+	}
+	if len(nr.FallbackAddresses) > 0 {
+		out += fmt.Sprintf(" [fallback %s]", strings.Join(nr.FallbackAddresses, ", "))
 	}
-	return strings.TrimSpace(fmt.Sprintf(`%s: %s`, nr.Name, nr.Address))
+	if nr.IsLeased() {
+		out += fmt.Sprintf(" [expires %s]", nr.ExpirationTime().Format(time.RFC3339))
+	}
+	return strings.TrimSpace(out)
 }
 
 // ValidateBasic performs basic stateless validity checks.
@@ -32,5 +109,93 @@ func (nr NameRecord) ValidateBasic() error {
 	if strings.TrimSpace(nr.Name) == "" {
 		return ErrNameSegmentTooShort
 	}
+	if nr.Expiration < 0 {
+		return fmt.Errorf("invalid expiration: %d", nr.Expiration)
+	}
+	if nr.IsLeased() && !strings.Contains(nr.Name, ".") {
+		return ErrNameRootLease
+	}
+	return nr.ValidateFallbackAddresses()
+}
+
+// ValidateFallbackAddresses checks that the fallback addresses are valid and that no address is bound more than once.
+func (nr NameRecord) ValidateFallbackAddresses() error {
+	if len(nr.FallbackAddresses) > MaxFallbackAddresses {
+		return ErrNameTooManyBindings
+	}
+	bound := map[string]bool{nr.Address: true}
+	for _, addr := range nr.FallbackAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid fallback address %s: %w", addr, err)
+		}
+		if bound[addr] {
+			return fmt.Errorf("%w: %s", ErrNameBindingExists, addr)
+		}
+		bound[addr] = true
+	}
+	return nil
+}
+
+// NameTreeDepth returns the number of levels the name is under the root, zero for the root itself, and whether the name
+// is the root or one of its sub-names.
+func NameTreeDepth(root, name string) (uint32, bool) {
+	if name == root {
+		return 0, true
+	}
+	if !strings.HasSuffix(name, "."+root) {
+		return 0, false
+	}
+	return uint32(strings.Count(strings.TrimSuffix(name, "."+root), ".") + 1), true
+}
+
+// NameTreeLess returns true if the name comes before the other name in tree order, where each name is followed by its
+// sub-names and sibling names are in lexical order.
+func NameTreeLess(name, other string) bool {
+	a, b := strings.Split(name, "."), strings.Split(other, ".")
+	for i, j := len(a)-1, len(b)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if a[i] != b[j] {
+			return a[i] < b[j]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// NewNameOffer creates an offer by the owner of a name to transfer it to a recipient that expires at the given time.
+func NewNameOffer(name string, owner, recipient sdk.AccAddress, expiration time.Time) NameOffer { //nolint:interfacer
+	return NameOffer{
+		Name:       name,
+		Owner:      owner.String(),
+		Recipient:  recipient.String(),
+		Expiration: expiration.Unix(),
+	}
+}
+
+// ExpirationTime returns the time the offer expires if it has not been accepted.
+func (no NameOffer) ExpirationTime() time.Time {
+	return time.Unix(no.Expiration, 0).UTC()
+}
+
+// implement fmt.Stringer
+func (no NameOffer) String() string {
+	return fmt.Sprintf("%s: %s -> %s [expires %s]", no.Name, no.Owner, no.Recipient, no.ExpirationTime().Format(time.RFC3339))
+}
+
+// ValidateBasic performs basic stateless validity checks.
+func (no NameOffer) ValidateBasic() error {
+	if strings.TrimSpace(no.Name) == "" {
+		return ErrNameSegmentTooShort
+	}
+	if _, err := sdk.AccAddressFromBech32(no.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(no.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if no.Owner == no.Recipient {
+		return fmt.Errorf("a name cannot be offered to its owner")
+	}
+	if no.Expiration <= 0 {
+		return fmt.Errorf("invalid expiration: %d", no.Expiration)
+	}
 	return nil
 }
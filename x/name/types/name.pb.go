@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +39,12 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// length of time a leased name is bound for before it must be renewed (zero disables leased names)
+	NameLeasePeriod time.Duration `protobuf:"bytes,5,opt,name=name_lease_period,json=nameLeasePeriod,proto3,stdduration" json:"name_lease_period"`
+	// fee paid into the community pool to renew a leased name
+	NameRenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=name_renewal_fee,json=nameRenewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"name_renewal_fee"`
+	// length of time an offer to transfer a name can be accepted for (zero disables name transfers)
+	NameOfferPeriod time.Duration `protobuf:"bytes,7,opt,name=name_offer_period,json=nameOfferPeriod,proto3,stdduration" json:"name_offer_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +108,27 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetNameLeasePeriod() time.Duration {
+	if m != nil {
+		return m.NameLeasePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetNameRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NameRenewalFee
+	}
+	return nil
+}
+
+func (m *Params) GetNameOfferPeriod() time.Duration {
+	if m != nil {
+		return m.NameOfferPeriod
+	}
+	return 0
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// The bound name
@@ -104,6 +137,11 @@ type NameRecord struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// Whether owner signature is required to add sub-names.
 	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// The unix time (in seconds) a leased name is released if it has not been renewed.  Zero for names that do not expire.
+	Expiration int64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	// Additional addresses the name resolves to, in priority order, after the bound address.  Only the bound address
+	// owns the name.
+	FallbackAddresses []string `protobuf:"bytes,5,rep,name=fallback_addresses,json=fallbackAddresses,proto3" json:"fallback_addresses,omitempty" yaml:"fallback_addresses,omitempty"`
 }
 
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
@@ -159,6 +197,92 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
+func (m *NameRecord) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *NameRecord) GetFallbackAddresses() []string {
+	if m != nil {
+		return m.FallbackAddresses
+	}
+	return nil
+}
+
+// NameOffer is an offer by the owner of a name to transfer it to a recipient, who must accept it before it expires.
+type NameOffer struct {
+	// The name being offered
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to when the offer is made
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The address the name is offered to
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// The unix time (in seconds) the offer expires if it has not been accepted.
+	Expiration int64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *NameOffer) Reset()      { *m = NameOffer{} }
+func (*NameOffer) ProtoMessage() {}
+func (*NameOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{2}
+}
+func (m *NameOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameOffer.Merge(m, src)
+}
+func (m *NameOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameOffer proto.InternalMessageInfo
+
+func (m *NameOffer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameOffer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NameOffer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *NameOffer) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
 // CreateRootNameProposal details a proposal to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
 // for the sole creation of sub names.
@@ -173,7 +297,7 @@ type CreateRootNameProposal struct {
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{3}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,6 +326,47 @@ func (m *CreateRootNameProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateRootNameProposal proto.InternalMessageInfo
 
+// UpdateReservedNamesProposal details a proposal to add names to or remove names from the reserved names, which can only
+// be bound by a governance proposal.
+type UpdateReservedNamesProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Add         []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty"`
+	Remove      []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *UpdateReservedNamesProposal) Reset()      { *m = UpdateReservedNamesProposal{} }
+func (*UpdateReservedNamesProposal) ProtoMessage() {}
+func (*UpdateReservedNamesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{4}
+}
+func (m *UpdateReservedNamesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateReservedNamesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateReservedNamesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateReservedNamesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReservedNamesProposal.Merge(m, src)
+}
+func (m *UpdateReservedNamesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateReservedNamesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReservedNamesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReservedNamesProposal proto.InternalMessageInfo
+
 // Event emitted when name is bound.
 type EventNameBound struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -212,7 +377,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +430,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{6}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,399 +473,2956 @@ func (m *EventNameUnbound) GetName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
-	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
-	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
-	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
-	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+// Event emitted when a leased name is renewed.
+type EventNameRenewed struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
-
-var fileDescriptor_a314256905bb00ec = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0x86, 0x6f, 0x13, 0x3b, 0xc4, 0x03, 0x81, 0x68, 0x65, 0xa2, 0x13, 0x12, 0x17, 0xcb, 0x05,
-	0x4a, 0x01, 0x3e, 0x22, 0x1a, 0x44, 0x81, 0x50, 0x10, 0x5d, 0x84, 0xac, 0x43, 0x69, 0x68, 0xcc,
-	0xfa, 0x6e, 0x74, 0x59, 0xe9, 0x6e, 0xf7, 0xb4, 0xbb, 0xbe, 0x98, 0x37, 0xa0, 0xa4, 0xa4, 0x4c,
-	0xc9, 0x93, 0x20, 0xca, 0x94, 0x94, 0xc8, 0x6e, 0x78, 0x0c, 0xb4, 0x73, 0x71, 0x7c, 0x31, 0x15,
-	0xd5, 0xee, 0xcc, 0xff, 0xcf, 0xcc, 0x37, 0xd2, 0xc0, 0xe3, 0xca, 0xe8, 0x1a, 0x95, 0x50, 0x29,
-	0xc6, 0x4a, 0x94, 0x18, 0xd7, 0xc7, 0xf4, 0x8e, 0x2a, 0xa3, 0x9d, 0xe6, 0x7c, 0x2d, 0x8f, 0x28,
-	0x5d, 0x1f, 0x3f, 0xea, 0xe7, 0x3a, 0xd7, 0x24, 0xc7, 0xfe, 0xd7, 0x38, 0x87, 0x3f, 0x18, 0xec,
-	0x8c, 0x85, 0x11, 0xa5, 0xe5, 0x4f, 0x81, 0x97, 0x62, 0x3e, 0xb1, 0x98, 0x97, 0xa8, 0xdc, 0xa4,
-	0x40, 0x95, 0xbb, 0xf3, 0x90, 0x0d, 0xd8, 0xd1, 0x5e, 0xb2, 0x5f, 0x8a, 0xf9, 0x87, 0x46, 0x38,
-	0xa5, 0x3c, 0xb9, 0xa5, 0xda, 0x74, 0x6f, 0x5d, 0xbb, 0xa5, 0xba, 0xed, 0x7e, 0x02, 0x0f, 0x7c,
-	0x6f, 0xcf, 0x32, 0x29, 0xb0, 0xc6, 0xc2, 0x86, 0xdb, 0x64, 0xdd, 0x2b, 0xc5, 0xfc, 0xbd, 0x28,
-	0xf1, 0x94, 0x92, 0xfc, 0x25, 0x84, 0xa2, 0x28, 0xf4, 0xc5, 0x64, 0xa6, 0x0c, 0x5a, 0x67, 0x64,
-	0xea, 0x30, 0xa3, 0x32, 0x1b, 0x76, 0x06, 0xec, 0x68, 0x37, 0x39, 0x20, 0xfd, 0xac, 0x25, 0xfb,
-	0x72, 0x3b, 0xfc, 0x04, 0xe0, 0x3f, 0x09, 0xa6, 0xda, 0x64, 0x9c, 0x43, 0xc7, 0x17, 0x11, 0x7d,
-	0x2f, 0xa1, 0x3f, 0x0f, 0xe1, 0x8e, 0xc8, 0x32, 0x83, 0xd6, 0x12, 0x66, 0x2f, 0x59, 0x85, 0x3c,
-	0x02, 0x58, 0xb7, 0x23, 0xb0, 0xdd, 0xa4, 0x95, 0x79, 0xd5, 0xf9, 0x76, 0x79, 0x18, 0x0c, 0xbf,
-	0x33, 0x38, 0x78, 0x6b, 0x50, 0x38, 0x4c, 0xb4, 0x76, 0x7e, 0xd8, 0xd8, 0xe8, 0x4a, 0x5b, 0x51,
-	0xf0, 0x3e, 0x74, 0x9d, 0x74, 0xc5, 0x6a, 0x5e, 0x13, 0xf0, 0x01, 0xdc, 0xcd, 0xd0, 0xa6, 0x46,
-	0x56, 0x4e, 0x6a, 0x75, 0x3d, 0xb4, 0x9d, 0xba, 0xc1, 0xdc, 0x6e, 0x61, 0xf6, 0xa1, 0xab, 0x2f,
-	0x14, 0x1a, 0xda, 0xb7, 0x97, 0x34, 0xc1, 0x06, 0x62, 0xf7, 0x1f, 0xc4, 0x7b, 0x5f, 0x2e, 0x0f,
-	0x03, 0x8f, 0xf9, 0xc7, 0xa3, 0xbe, 0x86, 0xfb, 0xef, 0x6a, 0x54, 0x04, 0x79, 0xa2, 0x67, 0x2a,
-	0x6b, 0x2f, 0xcf, 0x6e, 0x2f, 0xbf, 0x62, 0xd8, 0x5a, 0x33, 0x0c, 0xdf, 0xc0, 0xfe, 0x4d, 0xfd,
-	0x99, 0x9a, 0xfe, 0x7f, 0x87, 0x93, 0xf4, 0xe7, 0x22, 0x62, 0x57, 0x8b, 0x88, 0xfd, 0x5e, 0x44,
-	0xec, 0xeb, 0x32, 0x0a, 0xae, 0x96, 0x51, 0xf0, 0x6b, 0x19, 0x05, 0xf0, 0x50, 0xd2, 0xed, 0x6d,
-	0x9c, 0xe7, 0x98, 0x7d, 0x7c, 0x9e, 0x4b, 0x77, 0x3e, 0x9b, 0x8e, 0x52, 0x5d, 0xc6, 0x6b, 0xc3,
-	0x33, 0xa9, 0x5b, 0x51, 0x3c, 0x6f, 0xce, 0xdd, 0x7d, 0xae, 0xd0, 0x4e, 0x77, 0xe8, 0x86, 0x5f,
-	0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x10, 0x3c, 0x3a, 0x83, 0x0e, 0x03, 0x00, 0x00,
+func (m *EventNameRenewed) Reset()         { *m = EventNameRenewed{} }
+func (m *EventNameRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventNameRenewed) ProtoMessage()    {}
+func (*EventNameRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameRenewed.Merge(m, src)
+}
+func (m *EventNameRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameRenewed.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnrestrictedNames {
-		i--
-		if m.AllowUnrestrictedNames {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxNameLevels != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MinSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_EventNameRenewed proto.InternalMessageInfo
+
+func (m *EventNameRenewed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventNameRenewed) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NameRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameRenewed) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a leased name expires and is released.
+type EventNameExpired struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameExpired) Reset()         { *m = EventNameExpired{} }
+func (m *EventNameExpired) String() string { return proto.CompactTextString(m) }
+func (*EventNameExpired) ProtoMessage()    {}
+func (*EventNameExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{8}
+}
+func (m *EventNameExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventNameExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameExpired.Merge(m, src)
+}
+func (m *EventNameExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameExpired proto.InternalMessageInfo
+
+func (m *EventNameExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameExpired) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a fallback address is bound to a name.
+type EventNameBindingAdded struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority uint32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventNameBindingAdded) Reset()         { *m = EventNameBindingAdded{} }
+func (m *EventNameBindingAdded) String() string { return proto.CompactTextString(m) }
+func (*EventNameBindingAdded) ProtoMessage()    {}
+func (*EventNameBindingAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
+}
+func (m *EventNameBindingAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameBindingAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameBindingAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNameBindingAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameBindingAdded.Merge(m, src)
+}
+func (m *EventNameBindingAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameBindingAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameBindingAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameBindingAdded proto.InternalMessageInfo
+
+func (m *EventNameBindingAdded) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameBindingAdded) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameBindingAdded) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a fallback address is removed from a name.
+type EventNameBindingRemoved struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventNameBindingRemoved) Reset()         { *m = EventNameBindingRemoved{} }
+func (m *EventNameBindingRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventNameBindingRemoved) ProtoMessage()    {}
+func (*EventNameBindingRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameBindingRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameBindingRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameBindingRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventNameBindingRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameBindingRemoved.Merge(m, src)
+}
+func (m *EventNameBindingRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameBindingRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameBindingRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameBindingRemoved proto.InternalMessageInfo
+
+func (m *EventNameBindingRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameBindingRemoved) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when the owner of a name offers to transfer it.
+type EventNameOffered struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient  string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Expiration string `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventNameOffered) Reset()         { *m = EventNameOffered{} }
+func (m *EventNameOffered) String() string { return proto.CompactTextString(m) }
+func (*EventNameOffered) ProtoMessage()    {}
+func (*EventNameOffered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventNameOffered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameOffered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameOffered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventNameOffered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameOffered.Merge(m, src)
+}
+func (m *EventNameOffered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameOffered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameOffered.DiscardUnknown(m)
 }
 
-func encodeVarintName(dAtA []byte, offset int, v uint64) int {
-	offset -= sovName(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventNameOffered proto.InternalMessageInfo
+
+func (m *EventNameOffered) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MaxSegmentLength))
-	}
-	if m.MinSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MinSegmentLength))
+
+func (m *EventNameOffered) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.MaxNameLevels != 0 {
-		n += 1 + sovName(uint64(m.MaxNameLevels))
+	return ""
+}
+
+func (m *EventNameOffered) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if m.AllowUnrestrictedNames {
-		n += 2
+	return ""
+}
+
+func (m *EventNameOffered) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return n
+	return ""
 }
 
-func (m *NameRecord) Size() (n int) {
-	if m == nil {
-		return 0
+// Event emitted when an offer to transfer a name is accepted and the name is bound to the recipient.
+type EventNameAccepted struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventNameAccepted) Reset()         { *m = EventNameAccepted{} }
+func (m *EventNameAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventNameAccepted) ProtoMessage()    {}
+func (*EventNameAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{12}
+}
+func (m *EventNameAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+}
+func (m *EventNameAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameAccepted.Merge(m, src)
+}
+func (m *EventNameAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameAccepted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameAccepted proto.InternalMessageInfo
+
+func (m *EventNameAccepted) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventNameAccepted) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.Restricted {
-		n += 2
+	return ""
+}
+
+func (m *EventNameAccepted) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return n
+	return ""
 }
 
-func (m *CreateRootNameProposal) Size() (n int) {
-	if m == nil {
-		return 0
+// Event emitted when an offer to transfer a name expires without being accepted.
+type EventNameOfferExpired struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventNameOfferExpired) Reset()         { *m = EventNameOfferExpired{} }
+func (m *EventNameOfferExpired) String() string { return proto.CompactTextString(m) }
+func (*EventNameOfferExpired) ProtoMessage()    {}
+func (*EventNameOfferExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{13}
+}
+func (m *EventNameOfferExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameOfferExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameOfferExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+}
+func (m *EventNameOfferExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameOfferExpired.Merge(m, src)
+}
+func (m *EventNameOfferExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameOfferExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameOfferExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameOfferExpired proto.InternalMessageInfo
+
+func (m *EventNameOfferExpired) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventNameOfferExpired) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func (m *EventNameOfferExpired) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
+	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*NameOffer)(nil), "provenance.name.v1.NameOffer")
+	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
+	proto.RegisterType((*UpdateReservedNamesProposal)(nil), "provenance.name.v1.UpdateReservedNamesProposal")
+	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
+	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameRenewed)(nil), "provenance.name.v1.EventNameRenewed")
+	proto.RegisterType((*EventNameExpired)(nil), "provenance.name.v1.EventNameExpired")
+	proto.RegisterType((*EventNameBindingAdded)(nil), "provenance.name.v1.EventNameBindingAdded")
+	proto.RegisterType((*EventNameBindingRemoved)(nil), "provenance.name.v1.EventNameBindingRemoved")
+	proto.RegisterType((*EventNameOffered)(nil), "provenance.name.v1.EventNameOffered")
+	proto.RegisterType((*EventNameAccepted)(nil), "provenance.name.v1.EventNameAccepted")
+	proto.RegisterType((*EventNameOfferExpired)(nil), "provenance.name.v1.EventNameOfferExpired")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+
+var fileDescriptor_a314256905bb00ec = []byte{
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x73, 0x1b, 0x45,
+	0x14, 0xd6, 0x45, 0xb2, 0x63, 0x6d, 0x48, 0x62, 0xef, 0x24, 0xe6, 0x70, 0x40, 0xd2, 0x1c, 0x33,
+	0xa0, 0x22, 0xb9, 0x8b, 0xa1, 0x61, 0x5c, 0x00, 0x56, 0x08, 0x34, 0x19, 0xc7, 0x73, 0x8c, 0x29,
+	0xa0, 0x10, 0xab, 0xbb, 0x27, 0x65, 0x27, 0x77, 0xbb, 0x37, 0xbb, 0x2b, 0x59, 0xe2, 0x2f, 0xa0,
+	0x61, 0x86, 0x32, 0x65, 0x4a, 0x86, 0xbf, 0x24, 0x65, 0x4a, 0x2a, 0x9b, 0xb1, 0x1b, 0xea, 0x74,
+	0x74, 0xcc, 0xbe, 0xbb, 0x93, 0x4e, 0x22, 0x8d, 0x20, 0xa9, 0x74, 0xef, 0xf7, 0xf7, 0x7d, 0x6f,
+	0x57, 0x77, 0xe4, 0x83, 0x4c, 0xc9, 0x09, 0x08, 0x26, 0x22, 0x08, 0x04, 0x4b, 0x21, 0x98, 0xec,
+	0xe3, 0xaf, 0x9f, 0x29, 0x69, 0x24, 0xa5, 0x8b, 0xb0, 0x8f, 0xee, 0xc9, 0xfe, 0xde, 0xad, 0x91,
+	0x1c, 0x49, 0x0c, 0x07, 0xf6, 0x29, 0xcf, 0xdc, 0x6b, 0x8d, 0xa4, 0x1c, 0x25, 0x10, 0xa0, 0x35,
+	0x18, 0x0f, 0x83, 0x78, 0xac, 0x98, 0xe1, 0x52, 0x94, 0xf1, 0x48, 0xea, 0x54, 0xea, 0x60, 0xc0,
+	0xb4, 0x1d, 0x32, 0x00, 0xc3, 0xf6, 0x83, 0x48, 0xf2, 0x22, 0xee, 0x9d, 0xd7, 0xc9, 0xe6, 0x31,
+	0x53, 0x2c, 0xd5, 0xf4, 0x2e, 0xa1, 0x29, 0x9b, 0xf6, 0x35, 0x8c, 0x52, 0x10, 0xa6, 0x9f, 0x80,
+	0x18, 0x99, 0x27, 0xae, 0xd3, 0x71, 0xba, 0xd7, 0xc3, 0xed, 0x94, 0x4d, 0xbf, 0xcd, 0x03, 0x8f,
+	0xd0, 0x8f, 0xd9, 0x5c, 0xac, 0x66, 0x5f, 0x29, 0xb2, 0xb9, 0x58, 0xce, 0xfe, 0x88, 0xdc, 0xb4,
+	0xbd, 0x2d, 0x97, 0x7e, 0x02, 0x13, 0x48, 0xb4, 0x5b, 0xc7, 0xd4, 0xeb, 0x29, 0x9b, 0x1e, 0xb1,
+	0x14, 0x1e, 0xa1, 0x93, 0x7e, 0x46, 0x5c, 0x96, 0x24, 0xf2, 0xb4, 0x3f, 0x16, 0x0a, 0xb4, 0x51,
+	0x3c, 0x32, 0x10, 0x63, 0x99, 0x76, 0x1b, 0x1d, 0xa7, 0xbb, 0x15, 0xee, 0x62, 0xfc, 0xa4, 0x12,
+	0xb6, 0xe5, 0x9a, 0x3e, 0x26, 0x3b, 0x45, 0x77, 0xa6, 0xa1, 0x9f, 0x81, 0xe2, 0x32, 0x76, 0x37,
+	0x3a, 0x4e, 0xf7, 0xda, 0x27, 0xef, 0xf9, 0xb9, 0x48, 0x7e, 0x29, 0x92, 0xff, 0x55, 0x21, 0x52,
+	0x6f, 0xeb, 0xc5, 0x59, 0xbb, 0xf6, 0xec, 0xbc, 0xed, 0x84, 0x37, 0x05, 0xa2, 0x60, 0x1a, 0x8e,
+	0xb1, 0x96, 0x8e, 0xc9, 0x36, 0x36, 0x54, 0x20, 0xe0, 0x94, 0x25, 0xfd, 0x21, 0x80, 0xbb, 0xd9,
+	0xa9, 0x63, 0xbf, 0x5c, 0x54, 0xdf, 0x8a, 0xea, 0x17, 0xa2, 0xfa, 0x0f, 0x24, 0x17, 0xbd, 0xfb,
+	0xb6, 0xdf, 0xef, 0xe7, 0xed, 0xee, 0x88, 0x9b, 0x27, 0xe3, 0x81, 0x1f, 0xc9, 0x34, 0x28, 0x36,
+	0x90, 0xff, 0xdc, 0xd3, 0xf1, 0xd3, 0xc0, 0xcc, 0x32, 0xd0, 0x58, 0xa0, 0xc3, 0x1b, 0x76, 0x48,
+	0x98, 0xcf, 0xf8, 0x1a, 0x60, 0xce, 0x43, 0x0e, 0x87, 0xa0, 0x4a, 0x1e, 0x57, 0xd7, 0xe4, 0xf1,
+	0xd8, 0x16, 0xe7, 0x3c, 0xbc, 0xbf, 0x1d, 0x42, 0x8e, 0x70, 0x46, 0x24, 0x55, 0x4c, 0x29, 0x69,
+	0xd8, 0x0c, 0xdc, 0x6b, 0x33, 0xc4, 0x67, 0xea, 0x92, 0xab, 0x2c, 0x8e, 0x15, 0x68, 0x8d, 0x0b,
+	0x6c, 0x86, 0xa5, 0x49, 0x5b, 0x84, 0x2c, 0x84, 0xc6, 0x95, 0x6d, 0x85, 0x15, 0x0f, 0xfd, 0x82,
+	0x10, 0x98, 0x66, 0x3c, 0x47, 0x81, 0x1b, 0xaa, 0xf7, 0xda, 0xaf, 0xce, 0xda, 0x77, 0x66, 0x2c,
+	0x4d, 0x0e, 0xbc, 0x45, 0xec, 0xae, 0x4c, 0xb9, 0x81, 0x34, 0x33, 0x33, 0x2f, 0xac, 0x94, 0xd0,
+	0xef, 0x08, 0x1d, 0xb2, 0x24, 0x19, 0xb0, 0xe8, 0x69, 0xbf, 0x18, 0x0a, 0xda, 0xdd, 0xe8, 0xd4,
+	0xbb, 0xcd, 0xde, 0xc7, 0xaf, 0xce, 0xda, 0x1f, 0xe6, 0x8d, 0xfe, 0x9d, 0x53, 0x6d, 0xb8, 0x53,
+	0x86, 0x0f, 0xcb, 0xe8, 0x41, 0xe3, 0xd9, 0xf3, 0x76, 0xcd, 0x9b, 0x91, 0xe6, 0x51, 0x29, 0xc7,
+	0x6b, 0x99, 0xdf, 0x22, 0x1b, 0xf2, 0x54, 0x80, 0x2a, 0x78, 0xe7, 0x06, 0x7d, 0x9f, 0x34, 0x15,
+	0x44, 0x3c, 0xe3, 0x20, 0x0c, 0x92, 0x6e, 0x86, 0x0b, 0x87, 0xd5, 0x64, 0x95, 0x73, 0x95, 0x52,
+	0x31, 0xfa, 0x37, 0x87, 0xec, 0x3e, 0x50, 0xc0, 0x0c, 0x84, 0x52, 0x1a, 0x8b, 0xe2, 0x58, 0xc9,
+	0x4c, 0x6a, 0x96, 0xd8, 0xa1, 0x86, 0x9b, 0xa4, 0x44, 0x92, 0x1b, 0xb4, 0x43, 0xae, 0xc5, 0xa0,
+	0x23, 0xc5, 0x33, 0xec, 0x9b, 0x03, 0xaa, 0xba, 0xe6, 0x04, 0xea, 0xaf, 0x23, 0xd0, 0xa8, 0x12,
+	0x58, 0x5e, 0xdb, 0xc6, 0xea, 0xda, 0x0e, 0xde, 0xf9, 0xf9, 0x79, 0xbb, 0x66, 0x61, 0xfe, 0x65,
+	0xa1, 0xfe, 0xe2, 0x90, 0x3b, 0x27, 0x59, 0x6c, 0xa1, 0x82, 0x06, 0x35, 0x29, 0xae, 0xd4, 0xff,
+	0xc6, 0xbb, 0x4d, 0xea, 0x2c, 0xb6, 0xa7, 0xa6, 0xde, 0x6d, 0x86, 0xf6, 0x91, 0xee, 0x92, 0x4d,
+	0x05, 0xa9, 0x9c, 0x80, 0xdb, 0x40, 0x67, 0x61, 0xad, 0xe0, 0xf9, 0x9c, 0xdc, 0x78, 0x38, 0x01,
+	0x81, 0xa2, 0xf5, 0xe4, 0x58, 0xc4, 0xd5, 0x03, 0xea, 0x2c, 0x1f, 0xd0, 0x52, 0x93, 0x2b, 0x0b,
+	0x4d, 0xbc, 0x2f, 0xc9, 0xf6, 0xbc, 0xfe, 0x44, 0x0c, 0xfe, 0x43, 0x87, 0x1f, 0x2b, 0x1d, 0xf0,
+	0x6e, 0xc2, 0x9a, 0x1d, 0x56, 0x0e, 0x49, 0xbe, 0xb1, 0x8a, 0x67, 0x09, 0xe3, 0x43, 0xeb, 0x5e,
+	0x77, 0x82, 0xc7, 0xc8, 0xed, 0x85, 0x4a, 0x5c, 0xc4, 0x5c, 0x8c, 0x0e, 0xe3, 0x78, 0x6d, 0xa0,
+	0x7b, 0x64, 0x2b, 0x53, 0x5c, 0x2a, 0x6e, 0x66, 0xc5, 0x5f, 0xf2, 0xdc, 0xf6, 0xbe, 0x21, 0xef,
+	0xae, 0x8e, 0x08, 0x71, 0x61, 0xeb, 0x62, 0xfd, 0xa9, 0xc2, 0x16, 0x2f, 0x23, 0xc4, 0x6f, 0xf1,
+	0x3a, 0x2e, 0x2b, 0xfd, 0x03, 0xd9, 0x99, 0xcf, 0x3e, 0x8c, 0x22, 0xc8, 0xcc, 0x9b, 0x1b, 0xee,
+	0xf5, 0x2b, 0x4b, 0x40, 0x62, 0xe5, 0x2e, 0xdf, 0xd0, 0x80, 0x5e, 0xf4, 0xe2, 0xa2, 0xe5, 0xbc,
+	0xbc, 0x68, 0x39, 0x7f, 0x5e, 0xb4, 0x9c, 0x5f, 0x2f, 0x5b, 0xb5, 0x97, 0x97, 0xad, 0xda, 0x1f,
+	0x97, 0xad, 0x1a, 0xb9, 0xcd, 0xf1, 0x1b, 0x60, 0xe5, 0x33, 0xe1, 0xd8, 0xf9, 0xfe, 0x7e, 0xe5,
+	0xdd, 0xb3, 0x48, 0xb8, 0xc7, 0x65, 0xc5, 0x0a, 0xa6, 0xf9, 0x67, 0x07, 0xbe, 0x89, 0x06, 0x9b,
+	0xf8, 0x42, 0xf9, 0xf4, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x57, 0x42, 0x02, 0x96, 0x08,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NameOfferPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NameOfferPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintName(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.NameRenewalFee) > 0 {
+		for iNdEx := len(m.NameRenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NameRenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NameLeasePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NameLeasePeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintName(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.AllowUnrestrictedNames {
+		i--
+		if m.AllowUnrestrictedNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxNameLevels != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddresses) > 0 {
+		for iNdEx := len(m.FallbackAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FallbackAddresses[iNdEx])
+			copy(dAtA[i:], m.FallbackAddresses[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.FallbackAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Restricted {
-		n += 2
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNameBound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NameOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NameOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if m.Expiration != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateReservedNamesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateReservedNamesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateReservedNamesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintName(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBindingAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBindingAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBindingAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBindingRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBindingRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBindingRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameOffered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameOffered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameOffered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameOfferExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameOfferExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameOfferExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintName(dAtA []byte, offset int, v uint64) int {
+	offset -= sovName(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if m.MinSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MinSegmentLength))
+	}
+	if m.MaxNameLevels != 0 {
+		n += 1 + sovName(uint64(m.MaxNameLevels))
+	}
+	if m.AllowUnrestrictedNames {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NameLeasePeriod)
+	n += 1 + l + sovName(uint64(l))
+	if len(m.NameRenewalFee) > 0 {
+		for _, e := range m.NameRenewalFee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NameOfferPeriod)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *NameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovName(uint64(m.Expiration))
+	}
+	if len(m.FallbackAddresses) > 0 {
+		for _, s := range m.FallbackAddresses {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NameOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovName(uint64(m.Expiration))
+	}
+	return n
+}
+
+func (m *CreateRootNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateReservedNamesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNameBound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameUnbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameBindingAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovName(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *EventNameBindingRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameOffered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameOfferExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameLeasePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NameLeasePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameRenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameRenewalFee = append(m.NameRenewalFee, types.Coin{})
+			if err := m.NameRenewalFee[len(m.NameRenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameOfferPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NameOfferPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddresses = append(m.FallbackAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateReservedNamesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateReservedNamesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateReservedNamesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameRenewed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameRenewed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventNameUnbound) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventNameBindingAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +3445,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameBindingAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameBindingAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -743,16 +3465,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MinSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -762,35 +3497,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
 			}
-			m.MaxNameLevels = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var v int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -800,12 +3529,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Priority |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -827,7 +3555,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventNameBindingRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -850,15 +3578,15 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameBindingRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameBindingRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -886,11 +3614,11 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,28 +3646,8 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -961,7 +3669,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventNameOffered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,15 +3692,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameOffered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameOffered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +3728,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,11 +3760,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,11 +3792,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,28 +3824,8 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1159,7 +3847,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameAccepted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,15 +3870,15 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameAccepted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,11 +3906,11 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1250,7 +3938,39 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1273,7 +3993,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventNameOfferExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,15 +4016,15 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameOfferExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameOfferExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1332,11 +4052,11 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1364,7 +4084,39 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -65,3 +66,54 @@ func (s *NameRecordTestSuite) TestNameRecordValidateBasic() {
 		})
 	}
 }
+
+func (s *NameRecordTestSuite) TestNameRecordFallbackAddresses() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	nr := NewNameRecord("service.example", s.addr, true)
+
+	s.Require().NoError(nr.AddFallbackAddress(addr1, 0))
+	s.Require().NoError(nr.AddFallbackAddress(addr2, 1))
+	s.Require().NoError(nr.AddFallbackAddress(addr3, 3))
+	s.Require().Equal([]string{s.addr.String(), addr2, addr1, addr3}, nr.Addresses())
+	s.Require().Equal(uint32(2), nr.FallbackPriority(addr1))
+	s.Require().Equal(uint32(0), nr.FallbackPriority(s.addr.String()))
+	s.Require().NoError(nr.ValidateBasic())
+
+	s.Require().ErrorIs(nr.AddFallbackAddress(addr1, 0), ErrNameBindingExists)
+	s.Require().ErrorIs(nr.AddFallbackAddress(s.addr.String(), 0), ErrNameBindingExists)
+	s.Require().ErrorIs(nr.AddFallbackAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 5), ErrNameBindingPriority)
+
+	s.Require().NoError(nr.RemoveFallbackAddress(addr2))
+	s.Require().Equal([]string{s.addr.String(), addr1, addr3}, nr.Addresses())
+	s.Require().ErrorIs(nr.RemoveFallbackAddress(addr2), ErrNameBindingNotFound)
+	s.Require().ErrorIs(nr.RemoveFallbackAddress(s.addr.String()), ErrNameBindingNotFound)
+
+	for len(nr.FallbackAddresses) < MaxFallbackAddresses {
+		s.Require().NoError(nr.AddFallbackAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 0))
+	}
+	s.Require().ErrorIs(nr.AddFallbackAddress(addr2, 0), ErrNameTooManyBindings)
+
+	nr.FallbackAddresses = []string{addr1, addr1}
+	s.Require().ErrorIs(nr.ValidateBasic(), ErrNameBindingExists)
+	nr.FallbackAddresses = []string{"invalid"}
+	s.Require().Error(nr.ValidateBasic())
+}
+
+func (s *NameRecordTestSuite) TestNameTreeOrder() {
+	depth, under := NameTreeDepth("pb", "pb")
+	s.Require().True(under)
+	s.Require().Equal(uint32(0), depth)
+	depth, under = NameTreeDepth("pb", "b.a.pb")
+	s.Require().True(under)
+	s.Require().Equal(uint32(2), depth)
+	_, under = NameTreeDepth("pb", "apb")
+	s.Require().False(under, "name ending with the root but not under it")
+	_, under = NameTreeDepth("a.pb", "pb")
+	s.Require().False(under, "parent of the root")
+
+	names := []string{"z.pb", "b.a.pb", "pb", "a.pb", "a.z.pb", "c.pb"}
+	sort.Slice(names, func(i, j int) bool { return NameTreeLess(names[i], names[j]) })
+	s.Require().Equal([]string{"pb", "a.pb", "b.a.pb", "c.pb", "z.pb", "a.z.pb"}, names)
+}
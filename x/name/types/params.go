@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,8 +14,13 @@ const (
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxSegments            = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	DefaultNameLeasePeriod        = time.Hour * 24 * 365
+	DefaultNameOfferPeriod        = time.Hour * 24 * 7
 )
 
+// DefaultNameRenewalFee is the fee charged to renew a leased name (none by default)
+var DefaultNameRenewalFee = sdk.Coins{}
+
 // Parameter store keys
 var (
 	// maximum length of name segment to allow
@@ -24,6 +31,12 @@ var (
 	ParamStoreKeyMaxNameLevels = []byte("MaxNameLevels")
 	// determines if unrestricted name keys are allowed or not
 	ParamStoreKeyAllowUnrestrictedNames = []byte("AllowUnrestrictedNames")
+	// length of time a leased name is bound for before it must be renewed
+	ParamStoreKeyNameLeasePeriod = []byte("NameLeasePeriod")
+	// fee paid into the community pool to renew a leased name
+	ParamStoreKeyNameRenewalFee = []byte("NameRenewalFee")
+	// length of time an offer to transfer a name can be accepted for
+	ParamStoreKeyNameOfferPeriod = []byte("NameOfferPeriod")
 )
 
 // ParamKeyTable for slashing module
@@ -37,12 +50,18 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	nameLeasePeriod time.Duration,
+	nameRenewalFee sdk.Coins,
+	nameOfferPeriod time.Duration,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		NameLeasePeriod:        nameLeasePeriod,
+		NameRenewalFee:         nameRenewalFee,
+		NameOfferPeriod:        nameOfferPeriod,
 	}
 }
 
@@ -53,6 +72,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMinSegmentLength, &p.MinSegmentLength, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxNameLevels, &p.MaxNameLevels, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowUnrestrictedNames, &p.AllowUnrestrictedNames, validateAllowUnrestrictedNames),
+		paramtypes.NewParamSetPair(ParamStoreKeyNameLeasePeriod, &p.NameLeasePeriod, validateNameLeasePeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyNameRenewalFee, &p.NameRenewalFee, validateNameRenewalFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyNameOfferPeriod, &p.NameOfferPeriod, validateNameOfferPeriod),
 	}
 }
 
@@ -63,6 +85,9 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxSegments,
 		DefaultAllowUnrestrictedNames,
+		DefaultNameLeasePeriod,
+		DefaultNameRenewalFee,
+		DefaultNameOfferPeriod,
 	)
 }
 
@@ -98,6 +123,15 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.NameLeasePeriod != that1.NameLeasePeriod {
+		return false
+	}
+	if !p.NameRenewalFee.IsEqual(that1.NameRenewalFee) {
+		return false
+	}
+	if p.NameOfferPeriod != that1.NameOfferPeriod {
+		return false
+	}
 
 	return true
 }
@@ -118,3 +152,33 @@ func validateAllowUnrestrictedNames(i interface{}) error {
 	}
 	return nil
 }
+
+func validateNameLeasePeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("name lease period cannot be negative: %s", period)
+	}
+	return nil
+}
+
+func validateNameRenewalFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return fee.Validate()
+}
+
+func validateNameOfferPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("name offer period cannot be negative: %s", period)
+	}
+	return nil
+}
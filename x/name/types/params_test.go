@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,12 +18,18 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxSegmentLength, p.MaxSegmentLength)
 	require.Equal(t, DefaultMaxSegments, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
+	require.Equal(t, DefaultNameLeasePeriod, p.NameLeasePeriod)
+	require.Equal(t, DefaultNameRenewalFee, p.NameRenewalFee)
+	require.Equal(t, DefaultNameOfferPeriod, p.NameOfferPeriod)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false)))
+	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false, DefaultNameLeasePeriod, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, time.Hour, DefaultNameRenewalFee, DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)), DefaultNameOfferPeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultNameLeasePeriod, DefaultNameRenewalFee, time.Hour)))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -30,13 +38,13 @@ func TestDefaultParams(t *testing.T) {
 
 func TestParamString(t *testing.T) {
 	p := DefaultParams()
-	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true `, p.String())
+	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true name_lease_period:<seconds:31536000 > name_offer_period:<seconds:604800 > `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 4, len(pairs))
+	require.Equal(t, 7, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -55,6 +63,21 @@ func TestParamSetPairs(t *testing.T) {
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.Error(t, pairs[i].ValidatorFn(-1000))
 			require.NoError(t, pairs[i].ValidatorFn(uint32(1000)))
+		case string(ParamStoreKeyNameLeasePeriod):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(-time.Hour))
+			require.NoError(t, pairs[i].ValidatorFn(time.Duration(0)))
+			require.NoError(t, pairs[i].ValidatorFn(time.Hour))
+		case string(ParamStoreKeyNameRenewalFee):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.Coins{}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))))
+		case string(ParamStoreKeyNameOfferPeriod):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(-time.Hour))
+			require.NoError(t, pairs[i].ValidatorFn(time.Duration(0)))
+			require.NoError(t, pairs[i].ValidatorFn(time.Hour))
 		default:
 			require.Fail(t, "unexpected param set pair")
 		}
@@ -11,14 +11,21 @@ import (
 const (
 	// ProposalTypeCreateRootName defines the type for a CreateRootNameProposal
 	ProposalTypeCreateRootName = "CreateRootName"
+	// ProposalTypeUpdateReservedNames defines the type for a UpdateReservedNamesProposal
+	ProposalTypeUpdateReservedNames = "UpdateReservedNames"
 )
 
 // Assert CreateRootNameProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &CreateRootNameProposal{}
 
+// Assert UpdateReservedNamesProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &UpdateReservedNamesProposal{}
+
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreateRootName)
 	govtypes.RegisterProposalTypeCodec(&CreateRootNameProposal{}, "provenance/CreateRootNameProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateReservedNames)
+	govtypes.RegisterProposalTypeCodec(&UpdateReservedNamesProposal{}, "provenance/UpdateReservedNamesProposal")
 }
 
 // NewCreateRootNameProposal create a new governance proposal request to create a root name
@@ -78,3 +85,62 @@ func (crnp CreateRootNameProposal) String() string {
 `, crnp.Title, crnp.Description, crnp.Owner, crnp.Name, crnp.Restricted))
 	return b.String()
 }
+
+// NewUpdateReservedNamesProposal create a new governance proposal request to add names to or remove names from the
+// reserved names
+func NewUpdateReservedNamesProposal(title, description string, add, remove []string) *UpdateReservedNamesProposal {
+	return &UpdateReservedNamesProposal{
+		Title:       title,
+		Description: description,
+		Add:         add,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of an update reserved names proposal.
+func (urnp UpdateReservedNamesProposal) GetTitle() string { return urnp.Title }
+
+// GetDescription returns the description of an update reserved names proposal.
+func (urnp UpdateReservedNamesProposal) GetDescription() string { return urnp.Description }
+
+// ProposalRoute returns the routing key of an update reserved names proposal.
+func (urnp UpdateReservedNamesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update reserved names proposal.
+func (urnp UpdateReservedNamesProposal) ProposalType() string { return ProposalTypeUpdateReservedNames }
+
+// ValidateBasic runs basic stateless validity checks
+func (urnp UpdateReservedNamesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(urnp)
+	if err != nil {
+		return err
+	}
+	if len(urnp.Add) == 0 && len(urnp.Remove) == 0 {
+		return fmt.Errorf("at least one name must be added or removed")
+	}
+	seen := make(map[string]bool)
+	for _, name := range append(append([]string{}, urnp.Add...), urnp.Remove...) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			return ErrNameInvalid
+		}
+		if seen[name] {
+			return fmt.Errorf("name %s cannot be listed more than once", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (urnp UpdateReservedNamesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Reserved Names Proposal:
+  Title:       %s
+  Description: %s
+  Add:         %s
+  Remove:      %s
+`, urnp.Title, urnp.Description, strings.Join(urnp.Add, ", "), strings.Join(urnp.Remove, ", ")))
+	return b.String()
+}
@@ -30,6 +30,29 @@ func TestParameterChangeProposal(t *testing.T) {
 `, crnp.String())
 }
 
+func TestUpdateReservedNamesProposal(t *testing.T) {
+	urnp := NewUpdateReservedNamesProposal("test title", "test description", []string{"provenance.pb", "trademark"}, []string{"example.pb"})
+
+	require.Equal(t, "test title", urnp.GetTitle())
+	require.Equal(t, "test description", urnp.GetDescription())
+	require.Equal(t, RouterKey, urnp.ProposalRoute())
+	require.Equal(t, ProposalTypeUpdateReservedNames, urnp.ProposalType())
+	require.NoError(t, urnp.ValidateBasic())
+	require.Equal(t, `Update Reserved Names Proposal:
+  Title:       test title
+  Description: test description
+  Add:         provenance.pb, trademark
+  Remove:      example.pb
+`, urnp.String())
+
+	require.EqualError(t, NewUpdateReservedNamesProposal("test title", "test description", nil, nil).ValidateBasic(),
+		"at least one name must be added or removed")
+	require.EqualError(t, NewUpdateReservedNamesProposal("test title", "test description", []string{" "}, nil).ValidateBasic(),
+		ErrNameInvalid.Error())
+	require.EqualError(t, NewUpdateReservedNamesProposal("test title", "test description", []string{"trademark"}, []string{"Trademark"}).ValidateBasic(),
+		"name trademark cannot be listed more than once")
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 }
@@ -15,6 +15,9 @@ type QueryNameResult struct {
 	Name       string `json:"name"`
 	Address    string `json:"address"`
 	Restricted bool   `json:"restricted"`
+	Expiration int64  `json:"expiration,omitempty"`
+
+	FallbackAddresses []string `json:"fallback_addresses,omitempty"`
 }
 
 // String implements fmt.Stringer
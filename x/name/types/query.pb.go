@@ -10,19 +10,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -156,6 +160,8 @@ var xxx_messageInfo_QueryResolveRequest proto.InternalMessageInfo
 type QueryResolveResponse struct {
 	// a string containing the address the name resolves to
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// all addresses the name resolves to in priority order, the bound address followed by its fallback addresses
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
 func (m *QueryResolveResponse) Reset()         { *m = QueryResolveResponse{} }
@@ -198,6 +204,13 @@ func (m *QueryResolveResponse) GetAddress() string {
 	return ""
 }
 
+func (m *QueryResolveResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 // QueryReverseLookupRequest is the request type for the Query/ReverseLookup method.
 type QueryReverseLookupRequest struct {
 	// address to find name records for
@@ -280,6 +293,389 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryExpiringNamesRequest is the request type for the Query/ExpiringNames method.
+type QueryExpiringNamesRequest struct {
+	// length of time from the current block time to find expiring names within
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringNamesRequest) Reset()         { *m = QueryExpiringNamesRequest{} }
+func (m *QueryExpiringNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringNamesRequest) ProtoMessage()    {}
+func (*QueryExpiringNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryExpiringNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringNamesRequest.Merge(m, src)
+}
+func (m *QueryExpiringNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringNamesRequest proto.InternalMessageInfo
+
+// QueryExpiringNamesResponse is the response type for the Query/ExpiringNames method.
+type QueryExpiringNamesResponse struct {
+	// the leased name records in order of expiration
+	Records []NameRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringNamesResponse) Reset()         { *m = QueryExpiringNamesResponse{} }
+func (m *QueryExpiringNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringNamesResponse) ProtoMessage()    {}
+func (*QueryExpiringNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryExpiringNamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringNamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringNamesResponse.Merge(m, src)
+}
+func (m *QueryExpiringNamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringNamesResponse proto.InternalMessageInfo
+
+// QueryNameTreeRequest is the request type for the Query/NameTree method.
+type QueryNameTreeRequest struct {
+	// root is the name whose sub-names are returned
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// max_depth is the number of levels of sub-names under the root to return, zero for all levels
+	MaxDepth uint32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// pagination defines an optional offset based pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameTreeRequest) Reset()         { *m = QueryNameTreeRequest{} }
+func (m *QueryNameTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameTreeRequest) ProtoMessage()    {}
+func (*QueryNameTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *QueryNameTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameTreeRequest.Merge(m, src)
+}
+func (m *QueryNameTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameTreeRequest proto.InternalMessageInfo
+
+// QueryNameTreeResponse is the response type for the Query/NameTree method.
+type QueryNameTreeResponse struct {
+	// the root name and its sub-names in tree order, each name followed by its sub-names
+	Nodes []NameTreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameTreeResponse) Reset()         { *m = QueryNameTreeResponse{} }
+func (m *QueryNameTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameTreeResponse) ProtoMessage()    {}
+func (*QueryNameTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryNameTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameTreeResponse.Merge(m, src)
+}
+func (m *QueryNameTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameTreeResponse proto.InternalMessageInfo
+
+// NameTreeNode is a name in the hierarchy of sub-names under a root name.
+type NameTreeNode struct {
+	// the number of levels the name is under the root, zero for the root itself
+	Depth uint32 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	// the name record
+	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *NameTreeNode) Reset()         { *m = NameTreeNode{} }
+func (m *NameTreeNode) String() string { return proto.CompactTextString(m) }
+func (*NameTreeNode) ProtoMessage()    {}
+func (*NameTreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{10}
+}
+func (m *NameTreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameTreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameTreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameTreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameTreeNode.Merge(m, src)
+}
+func (m *NameTreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameTreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameTreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameTreeNode proto.InternalMessageInfo
+
+func (m *NameTreeNode) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *NameTreeNode) GetRecord() NameRecord {
+	if m != nil {
+		return m.Record
+	}
+	return NameRecord{}
+}
+
+// QueryNameOffersRequest is the request type for the Query/NameOffers method.
+type QueryNameOffersRequest struct {
+	// address to find the pending offers to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameOffersRequest) Reset()         { *m = QueryNameOffersRequest{} }
+func (m *QueryNameOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameOffersRequest) ProtoMessage()    {}
+func (*QueryNameOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{11}
+}
+func (m *QueryNameOffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameOffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameOffersRequest.Merge(m, src)
+}
+func (m *QueryNameOffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameOffersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameOffersRequest proto.InternalMessageInfo
+
+// QueryNameOffersResponse is the response type for the Query/NameOffers method.
+type QueryNameOffersResponse struct {
+	// the pending offers to the address
+	Offers []NameOffer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameOffersResponse) Reset()         { *m = QueryNameOffersResponse{} }
+func (m *QueryNameOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameOffersResponse) ProtoMessage()    {}
+func (*QueryNameOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{12}
+}
+func (m *QueryNameOffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameOffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameOffersResponse.Merge(m, src)
+}
+func (m *QueryNameOffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameOffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameOffersResponse proto.InternalMessageInfo
+
+// QueryReservedNamesRequest is the request type for the Query/ReservedNames method.
+type QueryReservedNamesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReservedNamesRequest) Reset()         { *m = QueryReservedNamesRequest{} }
+func (m *QueryReservedNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamesRequest) ProtoMessage()    {}
+func (*QueryReservedNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{13}
+}
+func (m *QueryReservedNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamesRequest.Merge(m, src)
+}
+func (m *QueryReservedNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNamesRequest proto.InternalMessageInfo
+
+// QueryReservedNamesResponse is the response type for the Query/ReservedNames method.
+type QueryReservedNamesResponse struct {
+	// the reserved names
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReservedNamesResponse) Reset()         { *m = QueryReservedNamesResponse{} }
+func (m *QueryReservedNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservedNamesResponse) ProtoMessage()    {}
+func (*QueryReservedNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{14}
+}
+func (m *QueryReservedNamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservedNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservedNamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservedNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservedNamesResponse.Merge(m, src)
+}
+func (m *QueryReservedNamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservedNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservedNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservedNamesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -287,45 +683,79 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryExpiringNamesRequest)(nil), "provenance.name.v1.QueryExpiringNamesRequest")
+	proto.RegisterType((*QueryExpiringNamesResponse)(nil), "provenance.name.v1.QueryExpiringNamesResponse")
+	proto.RegisterType((*QueryNameTreeRequest)(nil), "provenance.name.v1.QueryNameTreeRequest")
+	proto.RegisterType((*QueryNameTreeResponse)(nil), "provenance.name.v1.QueryNameTreeResponse")
+	proto.RegisterType((*NameTreeNode)(nil), "provenance.name.v1.NameTreeNode")
+	proto.RegisterType((*QueryNameOffersRequest)(nil), "provenance.name.v1.QueryNameOffersRequest")
+	proto.RegisterType((*QueryNameOffersResponse)(nil), "provenance.name.v1.QueryNameOffersResponse")
+	proto.RegisterType((*QueryReservedNamesRequest)(nil), "provenance.name.v1.QueryReservedNamesRequest")
+	proto.RegisterType((*QueryReservedNamesResponse)(nil), "provenance.name.v1.QueryReservedNamesResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0xbd, 0x4d, 0x82, 0x03, 0x8b, 0x68, 0x06, 0x23, 0x99, 0x53, 0x38, 0xa3, 0x53, 0xe4, 0x44,
-	0x11, 0xd9, 0x8d, 0x93, 0x06, 0x51, 0xa6, 0x80, 0x06, 0x09, 0x73, 0x25, 0xdd, 0xda, 0x59, 0x1d,
-	0x27, 0xec, 0x9b, 0xcb, 0xed, 0xf9, 0x44, 0x14, 0xb9, 0x81, 0x82, 0x94, 0x48, 0xb4, 0x14, 0xf9,
-	0x04, 0x3e, 0x23, 0x65, 0x24, 0x1a, 0x2a, 0x84, 0x6c, 0x0a, 0x3e, 0x03, 0xdd, 0xee, 0x5a, 0xb6,
-	0xe5, 0x0d, 0xa6, 0x5b, 0xcf, 0xbc, 0x37, 0xef, 0x8d, 0xdf, 0x1c, 0x0d, 0xb2, 0x1c, 0x4b, 0x99,
-	0x8a, 0xb4, 0x27, 0x79, 0x2a, 0x06, 0x92, 0x97, 0x6d, 0x7e, 0x3a, 0x94, 0xf9, 0x19, 0xcb, 0x72,
-	0x2c, 0x10, 0x60, 0xd6, 0x67, 0x55, 0x9f, 0x95, 0x6d, 0x7f, 0xaf, 0x87, 0x6a, 0x80, 0x8a, 0x77,
-	0x85, 0x92, 0x06, 0xcc, 0xcb, 0x76, 0x57, 0x16, 0xa2, 0xcd, 0x33, 0x11, 0x27, 0xa9, 0x28, 0x12,
-	0x4c, 0x0d, 0xdf, 0xaf, 0xc7, 0x18, 0xa3, 0x7e, 0xf2, 0xea, 0x65, 0xab, 0x5b, 0x31, 0x62, 0xdc,
-	0x97, 0x5c, 0x64, 0x09, 0x17, 0x69, 0x8a, 0x85, 0xa6, 0x28, 0xdb, 0x7d, 0xe4, 0xf0, 0xa4, 0xb5,
-	0x75, 0x3b, 0xac, 0x53, 0x78, 0x5d, 0x89, 0x76, 0x44, 0x2e, 0x06, 0x2a, 0x92, 0xa7, 0x43, 0xa9,
-	0x8a, 0xf0, 0x15, 0xbd, 0xbf, 0x50, 0x55, 0x19, 0xa6, 0x4a, 0xc2, 0x53, 0x5a, 0xcb, 0x74, 0xa5,
-	0x41, 0x1e, 0x93, 0xdd, 0xbb, 0x87, 0x3e, 0x5b, 0x5e, 0x88, 0x19, 0xce, 0xf1, 0xc6, 0xd5, 0xcf,
-	0xa6, 0x17, 0x59, 0x7c, 0x78, 0x64, 0x07, 0x46, 0x52, 0x61, 0xbf, 0x94, 0x56, 0x07, 0x80, 0x6e,
-	0x54, 0x34, 0x3d, 0xee, 0x4e, 0xa4, 0xdf, 0xcf, 0x6e, 0x5f, 0x5c, 0x36, 0xbd, 0x3f, 0x97, 0x4d,
-	0x2f, 0x3c, 0xa0, 0xf5, 0x45, 0x92, 0xb5, 0xd1, 0xa0, 0x9b, 0xe2, 0xe4, 0x24, 0x97, 0x4a, 0x59,
-	0xe2, 0xf4, 0x67, 0xf8, 0x89, 0xd0, 0x87, 0x96, 0x52, 0xca, 0x5c, 0xc9, 0x97, 0x88, 0xef, 0x86,
-	0xd9, 0x54, 0xed, 0x46, 0x1e, 0x3c, 0xa7, 0x74, 0xf6, 0x67, 0x37, 0xd6, 0xf4, 0x72, 0x2d, 0x66,
-	0x92, 0x61, 0x55, 0x32, 0xcc, 0xc4, 0x68, 0x93, 0x61, 0x1d, 0x11, 0x4f, 0x77, 0x88, 0xe6, 0x98,
-	0x73, 0xde, 0x3f, 0x12, 0xea, 0xbb, 0x9c, 0xd8, 0x15, 0x66, 0x8b, 0xaf, 0x4f, 0x17, 0x87, 0x17,
-	0x0e, 0x13, 0x3b, 0x2b, 0x4d, 0x98, 0x81, 0x6e, 0x17, 0x87, 0xdf, 0xd6, 0xe9, 0x2d, 0xed, 0x02,
-	0x46, 0xb4, 0x66, 0x82, 0x81, 0x96, 0x2b, 0xb4, 0xe5, 0x1b, 0xf0, 0x77, 0x56, 0xe2, 0x8c, 0x74,
-	0x18, 0x7e, 0xf8, 0xfe, 0xfb, 0xcb, 0xda, 0x16, 0xf8, 0xdc, 0x71, 0x6a, 0x26, 0x7f, 0xb8, 0x20,
-	0x74, 0xd3, 0xc6, 0x08, 0x37, 0x0f, 0x5e, 0xbc, 0x0e, 0x7f, 0x77, 0x35, 0xd0, 0x5a, 0xd8, 0xd3,
-	0x16, 0xb6, 0x21, 0x74, 0x59, 0xc8, 0x0d, 0x98, 0x9f, 0x57, 0x85, 0x11, 0x7c, 0x25, 0xf4, 0xde,
-	0x42, 0x28, 0xb0, 0xff, 0x0f, 0x9d, 0xe5, 0x33, 0xf2, 0xd9, 0xff, 0xc2, 0xad, 0xb9, 0x27, 0xda,
-	0x5c, 0x0b, 0xb6, 0x5d, 0xe6, 0xfa, 0x1a, 0xcb, 0xcf, 0xed, 0x25, 0x8e, 0x8e, 0x7b, 0x57, 0xe3,
-	0x80, 0x5c, 0x8f, 0x03, 0xf2, 0x6b, 0x1c, 0x90, 0xcf, 0x93, 0xc0, 0xbb, 0x9e, 0x04, 0xde, 0x8f,
-	0x49, 0xe0, 0xd1, 0x07, 0x09, 0x3a, 0x94, 0x3b, 0xe4, 0xcd, 0x41, 0x9c, 0x14, 0x6f, 0x87, 0x5d,
-	0xd6, 0xc3, 0xc1, 0x9c, 0xc4, 0x7e, 0x82, 0xf3, 0x82, 0xef, 0x8d, 0x64, 0x71, 0x96, 0x49, 0xd5,
-	0xad, 0xe9, 0x8f, 0xff, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xb0, 0x67, 0x48, 0xb1,
-	0x04, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x24, 0x8d, 0x93, 0xbc, 0x92, 0xcb, 0xe0, 0x82, 0xbb, 0xb8, 0xeb, 0xb0, 0x8a, 0x92,
+	0x10, 0xe8, 0x2e, 0x4e, 0x2f, 0x88, 0x56, 0x1c, 0xa2, 0x02, 0x17, 0x94, 0x86, 0x15, 0x27, 0x2e,
+	0x68, 0xed, 0x9d, 0x6c, 0x56, 0x64, 0x77, 0xb6, 0x33, 0x6b, 0xe3, 0x2a, 0xf2, 0x85, 0x1f, 0xa2,
+	0x47, 0xa4, 0x72, 0x00, 0x89, 0x43, 0x8f, 0xc0, 0x85, 0x7f, 0xa3, 0xc7, 0x4a, 0x5c, 0x38, 0x01,
+	0x4a, 0x38, 0xf0, 0x5f, 0x80, 0x76, 0xe6, 0x6d, 0xed, 0xb5, 0xd7, 0x8e, 0x0f, 0xb5, 0x7a, 0xdb,
+	0x99, 0x79, 0xdf, 0xbc, 0x6f, 0xbe, 0xef, 0xf9, 0x3d, 0x83, 0x99, 0x08, 0xde, 0x63, 0xb1, 0x17,
+	0x77, 0x98, 0x13, 0x7b, 0x11, 0x73, 0x7a, 0x2d, 0xe7, 0x7e, 0x97, 0x89, 0x07, 0x76, 0x22, 0x78,
+	0xca, 0x29, 0x1d, 0x9e, 0xdb, 0xd9, 0xb9, 0xdd, 0x6b, 0x19, 0x7b, 0x1d, 0x2e, 0x23, 0x2e, 0x9d,
+	0xb6, 0x27, 0x99, 0x0e, 0x76, 0x7a, 0xad, 0x36, 0x4b, 0xbd, 0x96, 0x93, 0x78, 0x41, 0x18, 0x7b,
+	0x69, 0xc8, 0x63, 0x8d, 0x37, 0x6a, 0x01, 0x0f, 0xb8, 0xfa, 0x74, 0xb2, 0x2f, 0xdc, 0x6d, 0x04,
+	0x9c, 0x07, 0xa7, 0xcc, 0xf1, 0x92, 0xd0, 0xf1, 0xe2, 0x98, 0xa7, 0x0a, 0x22, 0xf1, 0xd4, 0xc4,
+	0x53, 0xb5, 0x6a, 0x77, 0x8f, 0x1d, 0xbf, 0x2b, 0x46, 0xef, 0xbc, 0x51, 0xc2, 0x59, 0x71, 0x53,
+	0xc7, 0x56, 0x0d, 0xe8, 0xc7, 0x19, 0xa9, 0x23, 0x4f, 0x78, 0x91, 0x74, 0xd9, 0xfd, 0x2e, 0x93,
+	0xa9, 0x75, 0x0f, 0x5e, 0x2e, 0xec, 0xca, 0x84, 0xc7, 0x92, 0xd1, 0x77, 0xa0, 0x9a, 0xa8, 0x9d,
+	0x3a, 0xd9, 0x24, 0xbb, 0x57, 0xf7, 0x0d, 0x7b, 0xf2, 0xc1, 0xb6, 0xc6, 0x1c, 0x5c, 0x79, 0xf2,
+	0x67, 0xb3, 0xe2, 0x62, 0xbc, 0x75, 0x0b, 0x2f, 0x74, 0x99, 0xe4, 0xa7, 0x3d, 0x86, 0x79, 0x28,
+	0x85, 0x2b, 0x19, 0x4c, 0x5d, 0xb7, 0xee, 0xaa, 0xef, 0x77, 0xd7, 0x1e, 0x3e, 0x6e, 0x56, 0xfe,
+	0x7d, 0xdc, 0xac, 0x58, 0x87, 0x50, 0x2b, 0x82, 0x90, 0x46, 0x1d, 0x56, 0x3d, 0xdf, 0x17, 0x4c,
+	0x4a, 0x04, 0xe6, 0x4b, 0xda, 0x80, 0x75, 0xfc, 0x64, 0xb2, 0xbe, 0xb4, 0xb9, 0xbc, 0xbb, 0xee,
+	0x0e, 0x37, 0xac, 0x6f, 0x09, 0x5c, 0xc7, 0x0b, 0x7b, 0x4c, 0x48, 0xf6, 0x11, 0xe7, 0x9f, 0x77,
+	0x93, 0x9c, 0xcb, 0xf4, 0x5b, 0x3f, 0x00, 0x18, 0x5a, 0x55, 0x5f, 0x52, 0x4f, 0xdf, 0xb6, 0xb5,
+	0xaf, 0x76, 0xe6, 0xab, 0xad, 0x8b, 0x00, 0x7d, 0xb5, 0x8f, 0xbc, 0x20, 0x7f, 0xa1, 0x3b, 0x82,
+	0x1c, 0x79, 0xd9, 0x57, 0x04, 0x8c, 0x32, 0x26, 0xf8, 0xc0, 0xa1, 0x2c, 0xcb, 0xb9, 0x2c, 0xf4,
+	0xc3, 0x12, 0x12, 0x3b, 0x97, 0x92, 0xd0, 0x17, 0x4e, 0x61, 0xf1, 0x6b, 0xae, 0xc7, 0xfb, 0xfd,
+	0x24, 0x14, 0x61, 0x1c, 0x1c, 0x7a, 0x11, 0xcb, 0x6b, 0x80, 0xde, 0x86, 0xea, 0x17, 0x61, 0x7a,
+	0x12, 0xc6, 0x68, 0xf6, 0x75, 0x5b, 0x57, 0x9a, 0x9d, 0x57, 0x9a, 0x7d, 0x17, 0x2b, 0xed, 0x60,
+	0x2d, 0xf3, 0xfa, 0x87, 0xbf, 0x9a, 0xc4, 0x45, 0xc8, 0x02, 0x24, 0xfb, 0x2d, 0x97, 0x6c, 0x8c,
+	0x2c, 0x4a, 0xf6, 0x1e, 0xac, 0x0a, 0xd6, 0xe1, 0xc2, 0x97, 0x4a, 0xb5, 0xab, 0xfb, 0x66, 0x59,
+	0x6d, 0x66, 0x18, 0x57, 0x85, 0x61, 0x7d, 0xe6, 0xa0, 0x45, 0xc8, 0xfb, 0x23, 0xc1, 0xfa, 0xcd,
+	0xb2, 0x7e, 0x22, 0xd8, 0x68, 0xd5, 0x0b, 0xce, 0xd3, 0xbc, 0xea, 0xb3, 0x6f, 0xfa, 0x1a, 0xac,
+	0x47, 0x5e, 0xff, 0x33, 0x9f, 0x25, 0xe9, 0x89, 0x4a, 0xbf, 0xe1, 0xae, 0x45, 0x5e, 0xff, 0x6e,
+	0xb6, 0x1e, 0x53, 0x73, 0xf9, 0x39, 0xa8, 0xf9, 0x33, 0x81, 0x6b, 0x63, 0xdc, 0x50, 0xc8, 0x3b,
+	0xb0, 0x12, 0x73, 0x9f, 0xe5, 0x32, 0x6e, 0x4e, 0x93, 0x31, 0x03, 0x1d, 0x72, 0x9f, 0xa1, 0x90,
+	0x1a, 0xb4, 0x08, 0x19, 0xdb, 0xf0, 0xd2, 0x68, 0x3e, 0x5a, 0x83, 0x15, 0xad, 0x12, 0x51, 0x2a,
+	0xe9, 0x05, 0xbd, 0x03, 0x55, 0x6d, 0x25, 0x26, 0x9d, 0xcf, 0x7e, 0xc4, 0x58, 0x5f, 0x13, 0x78,
+	0xe5, 0x99, 0x1c, 0xf7, 0x8e, 0x8f, 0x99, 0x90, 0x2f, 0xa2, 0x2d, 0xfc, 0x42, 0xe0, 0xd5, 0x09,
+	0x1a, 0xe8, 0xcb, 0x6d, 0xa8, 0x72, 0xb5, 0x83, 0xc6, 0xdc, 0x98, 0xf6, 0x40, 0x85, 0xcb, 0xdf,
+	0xa7, 0x21, 0x8b, 0xb0, 0x25, 0x7a, 0xd6, 0x4b, 0x25, 0x13, 0x3d, 0xe6, 0x17, 0x7a, 0x47, 0x51,
+	0x1a, 0xf2, 0x1c, 0xa4, 0xf9, 0x66, 0xd8, 0x31, 0x0b, 0xf9, 0x50, 0x9d, 0x1a, 0xac, 0x64, 0x1a,
+	0x48, 0x6c, 0x99, 0x7a, 0xb1, 0x80, 0x67, 0xef, 0xff, 0xb7, 0x0a, 0x2b, 0x8a, 0x07, 0x1d, 0x40,
+	0x55, 0x8f, 0x3a, 0xba, 0x5d, 0x66, 0xc5, 0xe4, 0x54, 0x35, 0x76, 0x2e, 0x8d, 0xd3, 0xa9, 0x2d,
+	0xeb, 0xcb, 0xdf, 0xff, 0x79, 0xb4, 0xd4, 0xa0, 0x86, 0x53, 0x32, 0xbc, 0xf5, 0x44, 0xa5, 0x0f,
+	0x09, 0xac, 0xe2, 0x60, 0xa4, 0xd3, 0x2f, 0x2e, 0xce, 0x5b, 0x63, 0xf7, 0xf2, 0x40, 0xa4, 0xb0,
+	0xa7, 0x28, 0x6c, 0x51, 0xab, 0x8c, 0x82, 0xd0, 0xc1, 0xce, 0x59, 0xb6, 0x31, 0xa0, 0x3f, 0x11,
+	0xd8, 0x28, 0x0c, 0x32, 0x7a, 0x73, 0x46, 0x9e, 0xc9, 0xd1, 0x6b, 0xd8, 0xf3, 0x86, 0x23, 0xb9,
+	0xb7, 0x14, 0xb9, 0x6d, 0xba, 0x55, 0x46, 0xee, 0x54, 0xc5, 0x3a, 0x67, 0xf8, 0x33, 0x1d, 0xd0,
+	0xef, 0x09, 0x6c, 0x14, 0x86, 0xc6, 0x0c, 0x7a, 0x65, 0x93, 0x70, 0x06, 0xbd, 0xd2, 0x59, 0x64,
+	0x6d, 0x29, 0x7a, 0x26, 0x6d, 0x94, 0xd1, 0x63, 0x08, 0xc9, 0x0c, 0x5c, 0xcb, 0x1b, 0x1b, 0x9d,
+	0x6e, 0xcc, 0xd8, 0xf0, 0x30, 0xde, 0x98, 0x23, 0x12, 0x79, 0xec, 0x28, 0x1e, 0xaf, 0xd3, 0x66,
+	0x19, 0x8f, 0x54, 0x30, 0xe6, 0x9c, 0x65, 0xb3, 0x67, 0x40, 0x1f, 0x11, 0x80, 0x61, 0xcb, 0xa1,
+	0x7b, 0x33, 0x53, 0x14, 0xda, 0xa3, 0xf1, 0xe6, 0x5c, 0xb1, 0xf3, 0xf8, 0xa6, 0x5b, 0xd5, 0x98,
+	0x6f, 0x85, 0x5f, 0xfb, 0xcc, 0xb2, 0x9a, 0xec, 0x42, 0x33, 0xcb, 0xaa, 0xa4, 0x89, 0xcc, 0xf6,
+	0x4d, 0x20, 0xe4, 0xa0, 0xf3, 0xe4, 0xdc, 0x24, 0x4f, 0xcf, 0x4d, 0xf2, 0xf7, 0xb9, 0x49, 0xbe,
+	0xbb, 0x30, 0x2b, 0x4f, 0x2f, 0xcc, 0xca, 0x1f, 0x17, 0x66, 0x05, 0xae, 0x85, 0xbc, 0x24, 0xe3,
+	0x11, 0xf9, 0xf4, 0xed, 0x20, 0x4c, 0x4f, 0xba, 0x6d, 0xbb, 0xc3, 0xa3, 0x91, 0xab, 0x6f, 0x86,
+	0x7c, 0x34, 0x51, 0x5f, 0xa7, 0x4a, 0x1f, 0x24, 0x4c, 0xb6, 0xab, 0xea, 0x4f, 0xd6, 0xad, 0xff,
+	0x03, 0x00, 0x00, 0xff, 0xff, 0x96, 0x07, 0x6e, 0x3a, 0x72, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +776,14 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// ExpiringNames queries for all leased names that expire within a given length of time
+	ExpiringNames(ctx context.Context, in *QueryExpiringNamesRequest, opts ...grpc.CallOption) (*QueryExpiringNamesResponse, error)
+	// NameTree queries for a root name and the hierarchy of sub-names under it in tree order
+	NameTree(ctx context.Context, in *QueryNameTreeRequest, opts ...grpc.CallOption) (*QueryNameTreeResponse, error)
+	// NameOffers returns the pending offers to transfer names to an address.
+	NameOffers(ctx context.Context, in *QueryNameOffersRequest, opts ...grpc.CallOption) (*QueryNameOffersResponse, error)
+	// ReservedNames returns the names that can only be bound by a governance proposal.
+	ReservedNames(ctx context.Context, in *QueryReservedNamesRequest, opts ...grpc.CallOption) (*QueryReservedNamesResponse, error)
 }
 
 type queryClient struct {
@@ -383,6 +821,42 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringNames(ctx context.Context, in *QueryExpiringNamesRequest, opts ...grpc.CallOption) (*QueryExpiringNamesResponse, error) {
+	out := new(QueryExpiringNamesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ExpiringNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NameTree(ctx context.Context, in *QueryNameTreeRequest, opts ...grpc.CallOption) (*QueryNameTreeResponse, error) {
+	out := new(QueryNameTreeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NameTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NameOffers(ctx context.Context, in *QueryNameOffersRequest, opts ...grpc.CallOption) (*QueryNameOffersResponse, error) {
+	out := new(QueryNameOffersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NameOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReservedNames(ctx context.Context, in *QueryReservedNamesRequest, opts ...grpc.CallOption) (*QueryReservedNamesResponse, error) {
+	out := new(QueryReservedNamesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ReservedNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -391,6 +865,14 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// ExpiringNames queries for all leased names that expire within a given length of time
+	ExpiringNames(context.Context, *QueryExpiringNamesRequest) (*QueryExpiringNamesResponse, error)
+	// NameTree queries for a root name and the hierarchy of sub-names under it in tree order
+	NameTree(context.Context, *QueryNameTreeRequest) (*QueryNameTreeResponse, error)
+	// NameOffers returns the pending offers to transfer names to an address.
+	NameOffers(context.Context, *QueryNameOffersRequest) (*QueryNameOffersResponse, error)
+	// ReservedNames returns the names that can only be bound by a governance proposal.
+	ReservedNames(context.Context, *QueryReservedNamesRequest) (*QueryReservedNamesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -406,6 +888,18 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringNames(ctx context.Context, req *QueryExpiringNamesRequest) (*QueryExpiringNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringNames not implemented")
+}
+func (*UnimplementedQueryServer) NameTree(ctx context.Context, req *QueryNameTreeRequest) (*QueryNameTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameTree not implemented")
+}
+func (*UnimplementedQueryServer) NameOffers(ctx context.Context, req *QueryNameOffersRequest) (*QueryNameOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameOffers not implemented")
+}
+func (*UnimplementedQueryServer) ReservedNames(ctx context.Context, req *QueryReservedNamesRequest) (*QueryReservedNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservedNames not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -465,22 +959,110 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.name.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Resolve",
-			Handler:    _Query_Resolve_Handler,
-		},
+func _Query_ExpiringNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ExpiringNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringNames(ctx, req.(*QueryExpiringNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NameTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NameTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameTree(ctx, req.(*QueryNameTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NameOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NameOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameOffers(ctx, req.(*QueryNameOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReservedNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservedNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservedNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ReservedNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservedNames(ctx, req.(*QueryReservedNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.name.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Query_Resolve_Handler,
+		},
 		{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "ExpiringNames",
+			Handler:    _Query_ExpiringNames_Handler,
+		},
+		{
+			MethodName: "NameTree",
+			Handler:    _Query_NameTree_Handler,
+		},
+		{
+			MethodName: "NameOffers",
+			Handler:    _Query_NameOffers_Handler,
+		},
+		{
+			MethodName: "ReservedNames",
+			Handler:    _Query_ReservedNames_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -592,6 +1174,15 @@ func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -688,106 +1279,1467 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExpiringNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryExpiringNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryExpiringNamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExpiringNamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringNamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringNamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNameTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.MaxDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReverseLookupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNameTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *NameTreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameTreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameTreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameOffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameOffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameOffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameOffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameOffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameOffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservedNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservedNamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservedNamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservedNamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringNamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringNamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDepth))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NameTreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNameOffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameOffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservedNamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringNamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringNamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringNamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, NameRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNameTreeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -797,25 +2749,112 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNameTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNameTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -837,7 +2876,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNameTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -860,15 +2899,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -895,7 +2934,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, NameTreeNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -920,7 +2996,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *NameTreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,17 +3019,36 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NameTreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NameTreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -963,23 +3058,24 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1002,7 +3098,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNameOffersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,10 +3121,10 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameOffersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameOffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1063,6 +3159,42 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1084,7 +3216,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNameOffersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,17 +3239,17 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameOffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameOffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1127,25 +3259,113 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Offers = append(m.Offers, NameOffer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservedNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservedNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservedNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1202,7 +3422,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReservedNamesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,15 +3445,15 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReservedNamesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReservedNamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1261,7 +3481,7 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -36,6 +36,8 @@ type MsgBindNameRequest struct {
 	Parent NameRecord `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent"`
 	// The name record to bind under the parent
 	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	// Whether the name is leased, expiring after the name lease period unless it is renewed.
+	Lease bool `protobuf:"varint,3,opt,name=lease,proto3" json:"lease,omitempty"`
 }
 
 func (m *MsgBindNameRequest) Reset()         { *m = MsgBindNameRequest{} }
@@ -185,38 +187,470 @@ func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
 
+// MsgRenewNameRequest defines an sdk.Msg type that is used to renew a leased name.  The renewal fee is paid by the
+// owner into the community pool and the expiration is extended by the name lease period.
+type MsgRenewNameRequest struct {
+	// The leased name being renewed
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to, paying the renewal fee
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRenewNameRequest) Reset()         { *m = MsgRenewNameRequest{} }
+func (m *MsgRenewNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameRequest) ProtoMessage()    {}
+func (*MsgRenewNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{4}
+}
+func (m *MsgRenewNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameRequest.Merge(m, src)
+}
+func (m *MsgRenewNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameRequest proto.InternalMessageInfo
+
+// MsgRenewNameResponse defines the Msg/RenewName response type.
+type MsgRenewNameResponse struct {
+}
+
+func (m *MsgRenewNameResponse) Reset()         { *m = MsgRenewNameResponse{} }
+func (m *MsgRenewNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameResponse) ProtoMessage()    {}
+func (*MsgRenewNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{5}
+}
+func (m *MsgRenewNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameResponse.Merge(m, src)
+}
+func (m *MsgRenewNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameResponse proto.InternalMessageInfo
+
+// MsgAddNameBindingRequest defines an sdk.Msg type that is used by the owner of a name to bind a fallback address to it.
+// The name resolves to the owner's address followed by its fallback addresses in priority order.
+type MsgAddNameBindingRequest struct {
+	// The name to bind the fallback address to
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The fallback address being bound to the name
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// The position of the address in the fallback addresses of the name, starting at 1.  Zero adds the address to the
+	// end of the list.
+	Priority uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *MsgAddNameBindingRequest) Reset()         { *m = MsgAddNameBindingRequest{} }
+func (m *MsgAddNameBindingRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNameBindingRequest) ProtoMessage()    {}
+func (*MsgAddNameBindingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{6}
+}
+func (m *MsgAddNameBindingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNameBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNameBindingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddNameBindingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNameBindingRequest.Merge(m, src)
+}
+func (m *MsgAddNameBindingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNameBindingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNameBindingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddNameBindingRequest proto.InternalMessageInfo
+
+// MsgAddNameBindingResponse defines the Msg/AddNameBinding response type.
+type MsgAddNameBindingResponse struct {
+}
+
+func (m *MsgAddNameBindingResponse) Reset()         { *m = MsgAddNameBindingResponse{} }
+func (m *MsgAddNameBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNameBindingResponse) ProtoMessage()    {}
+func (*MsgAddNameBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{7}
+}
+func (m *MsgAddNameBindingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNameBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNameBindingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddNameBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNameBindingResponse.Merge(m, src)
+}
+func (m *MsgAddNameBindingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNameBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNameBindingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddNameBindingResponse proto.InternalMessageInfo
+
+// MsgRemoveNameBindingRequest defines an sdk.Msg type that is used by the owner of a name to remove one of its fallback
+// addresses.
+type MsgRemoveNameBindingRequest struct {
+	// The name to remove the fallback address from
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The fallback address being removed from the name
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveNameBindingRequest) Reset()         { *m = MsgRemoveNameBindingRequest{} }
+func (m *MsgRemoveNameBindingRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNameBindingRequest) ProtoMessage()    {}
+func (*MsgRemoveNameBindingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{8}
+}
+func (m *MsgRemoveNameBindingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNameBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNameBindingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNameBindingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNameBindingRequest.Merge(m, src)
+}
+func (m *MsgRemoveNameBindingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNameBindingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNameBindingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNameBindingRequest proto.InternalMessageInfo
+
+// MsgRemoveNameBindingResponse defines the Msg/RemoveNameBinding response type.
+type MsgRemoveNameBindingResponse struct {
+}
+
+func (m *MsgRemoveNameBindingResponse) Reset()         { *m = MsgRemoveNameBindingResponse{} }
+func (m *MsgRemoveNameBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNameBindingResponse) ProtoMessage()    {}
+func (*MsgRemoveNameBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{9}
+}
+func (m *MsgRemoveNameBindingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNameBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNameBindingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveNameBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNameBindingResponse.Merge(m, src)
+}
+func (m *MsgRemoveNameBindingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNameBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNameBindingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNameBindingResponse proto.InternalMessageInfo
+
+// MsgOfferNameRequest defines an sdk.Msg type that is used by the owner of a name to offer to transfer it to another
+// address.  The name is not transferred until the recipient accepts the offer, which expires after the name offer
+// period.  A new offer for the name replaces any existing one.
+type MsgOfferNameRequest struct {
+	// The name being offered
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name is bound to
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The address the name is offered to
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgOfferNameRequest) Reset()         { *m = MsgOfferNameRequest{} }
+func (m *MsgOfferNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferNameRequest) ProtoMessage()    {}
+func (*MsgOfferNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{10}
+}
+func (m *MsgOfferNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOfferNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferNameRequest.Merge(m, src)
+}
+func (m *MsgOfferNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOfferNameRequest proto.InternalMessageInfo
+
+// MsgOfferNameResponse defines the Msg/OfferName response type.
+type MsgOfferNameResponse struct {
+}
+
+func (m *MsgOfferNameResponse) Reset()         { *m = MsgOfferNameResponse{} }
+func (m *MsgOfferNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferNameResponse) ProtoMessage()    {}
+func (*MsgOfferNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{11}
+}
+func (m *MsgOfferNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOfferNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOfferNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOfferNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferNameResponse.Merge(m, src)
+}
+func (m *MsgOfferNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOfferNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOfferNameResponse proto.InternalMessageInfo
+
+// MsgAcceptNameRequest defines an sdk.Msg type that is used by the recipient of an offer to transfer a name to accept
+// it.  The name is bound to the recipient and its fallback addresses are removed.
+type MsgAcceptNameRequest struct {
+	// The name being accepted
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name was offered to
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgAcceptNameRequest) Reset()         { *m = MsgAcceptNameRequest{} }
+func (m *MsgAcceptNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptNameRequest) ProtoMessage()    {}
+func (*MsgAcceptNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{12}
+}
+func (m *MsgAcceptNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptNameRequest.Merge(m, src)
+}
+func (m *MsgAcceptNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptNameRequest proto.InternalMessageInfo
+
+// MsgAcceptNameResponse defines the Msg/AcceptName response type.
+type MsgAcceptNameResponse struct {
+}
+
+func (m *MsgAcceptNameResponse) Reset()         { *m = MsgAcceptNameResponse{} }
+func (m *MsgAcceptNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptNameResponse) ProtoMessage()    {}
+func (*MsgAcceptNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{13}
+}
+func (m *MsgAcceptNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptNameResponse.Merge(m, src)
+}
+func (m *MsgAcceptNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptNameResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
 	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
 	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
 	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgRenewNameRequest)(nil), "provenance.name.v1.MsgRenewNameRequest")
+	proto.RegisterType((*MsgRenewNameResponse)(nil), "provenance.name.v1.MsgRenewNameResponse")
+	proto.RegisterType((*MsgAddNameBindingRequest)(nil), "provenance.name.v1.MsgAddNameBindingRequest")
+	proto.RegisterType((*MsgAddNameBindingResponse)(nil), "provenance.name.v1.MsgAddNameBindingResponse")
+	proto.RegisterType((*MsgRemoveNameBindingRequest)(nil), "provenance.name.v1.MsgRemoveNameBindingRequest")
+	proto.RegisterType((*MsgRemoveNameBindingResponse)(nil), "provenance.name.v1.MsgRemoveNameBindingResponse")
+	proto.RegisterType((*MsgOfferNameRequest)(nil), "provenance.name.v1.MsgOfferNameRequest")
+	proto.RegisterType((*MsgOfferNameResponse)(nil), "provenance.name.v1.MsgOfferNameResponse")
+	proto.RegisterType((*MsgAcceptNameRequest)(nil), "provenance.name.v1.MsgAcceptNameRequest")
+	proto.RegisterType((*MsgAcceptNameResponse)(nil), "provenance.name.v1.MsgAcceptNameResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
 
 var fileDescriptor_eacf6cd967218635 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x48, 0xea, 0x81, 0x24, 0xf5, 0xca,
-	0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44, 0xa5, 0x94, 0x2c,
-	0x16, 0x63, 0xc0, 0x3a, 0xc0, 0xd2, 0x4a, 0xb3, 0x18, 0xb9, 0x84, 0x7c, 0x8b, 0xd3, 0x9d, 0x32,
-	0xf3, 0x52, 0xfc, 0x12, 0x73, 0x53, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x6c, 0xb8,
-	0xd8, 0x0a, 0x12, 0x8b, 0x52, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4,
-	0x30, 0x2d, 0xd4, 0x83, 0x68, 0x48, 0xce, 0x2f, 0x4a, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x08, 0xaa, 0x07, 0xa4, 0xbb, 0x08, 0x2c, 0x2e, 0xc1, 0x44, 0x8a, 0x6e, 0x88, 0x1e, 0x2b, 0x8e,
-	0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x28, 0x89, 0x72, 0x09, 0xa3, 0xb8, 0xad, 0xb8,
-	0x20, 0x3f, 0xaf, 0x38, 0x55, 0x29, 0x8e, 0x4b, 0xc4, 0xb7, 0x38, 0xdd, 0x25, 0x35, 0x27, 0xb5,
-	0x24, 0x15, 0xcd, 0xd1, 0x50, 0x6b, 0x19, 0x29, 0xb2, 0x56, 0x9c, 0x4b, 0x14, 0xcd, 0x7c, 0x88,
-	0xc5, 0x46, 0x47, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xa2, 0xb9, 0x38, 0x60, 0x8e, 0x12,
-	0x52, 0xc3, 0x66, 0x09, 0x66, 0x88, 0x4a, 0xa9, 0x13, 0x54, 0x07, 0xb1, 0x44, 0x28, 0x91, 0x8b,
-	0x0b, 0x61, 0xb5, 0x90, 0x06, 0x0e, 0x6d, 0x18, 0xbe, 0x97, 0xd2, 0x24, 0x42, 0x25, 0xc4, 0x0a,
-	0xa7, 0xe4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0, 0x12, 0xcd, 0xcc, 0xc7,
-	0x62, 0x4c, 0x00, 0x63, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x42, 0x81, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0x92, 0xc2, 0x4a, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x09, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x80, 0x68, 0x9e, 0xb8,
-	0xc8, 0x02, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0xb6, 0x69, 0x49, 0x06, 0x81, 0xc4, 0x92, 0x80, 0x71, 0x8a, 0x13, 0xe5, 0x00, 0x41,
+	0xa2, 0x76, 0x5b, 0x6e, 0x88, 0x4b, 0x23, 0x2e, 0x1c, 0x02, 0xc8, 0x47, 0x90, 0x2a, 0xb9, 0xce,
+	0xd4, 0x58, 0x34, 0x5e, 0xb3, 0xeb, 0xa6, 0xed, 0x9d, 0x03, 0x47, 0x3e, 0xa1, 0x9f, 0xc0, 0x67,
+	0xf4, 0x46, 0x8f, 0x9c, 0x10, 0x4a, 0x2e, 0x7c, 0x06, 0xf2, 0xda, 0x8d, 0x9d, 0xd8, 0x56, 0x12,
+	0xa4, 0xde, 0x3c, 0xbb, 0xf3, 0xe6, 0xbd, 0x99, 0x7d, 0x23, 0x43, 0x33, 0xe0, 0x6c, 0x84, 0xbe,
+	0xed, 0x3b, 0x68, 0xfa, 0xf6, 0x10, 0xcd, 0xd1, 0xae, 0x19, 0x9e, 0x19, 0x01, 0x67, 0x21, 0xa3,
+	0x34, 0xbd, 0x34, 0xa2, 0x4b, 0x63, 0xb4, 0xab, 0xd5, 0x5d, 0xe6, 0x32, 0x79, 0x6d, 0x46, 0x5f,
+	0x71, 0xa6, 0xf6, 0xb8, 0xa0, 0x8c, 0x44, 0xc8, 0xeb, 0xce, 0x0f, 0x02, 0xb4, 0x2f, 0xdc, 0x9e,
+	0xe7, 0x0f, 0xde, 0xda, 0x43, 0xb4, 0xf0, 0xcb, 0x09, 0x8a, 0x90, 0xbe, 0x82, 0xcd, 0xc0, 0xe6,
+	0xe8, 0x87, 0x2a, 0x69, 0x93, 0xee, 0xed, 0x3d, 0xdd, 0xc8, 0x13, 0x1a, 0x31, 0xc0, 0x61, 0x7c,
+	0xd0, 0xab, 0x5c, 0xfe, 0x6e, 0x29, 0x56, 0x82, 0x89, 0xd0, 0x5c, 0x9e, 0xab, 0x6b, 0xab, 0xa0,
+	0x63, 0x0c, 0xad, 0xc3, 0xc6, 0x31, 0xda, 0x02, 0xd5, 0xf5, 0x36, 0xe9, 0x56, 0xad, 0x38, 0x78,
+	0x59, 0xfd, 0x76, 0xd1, 0x52, 0xfe, 0x5e, 0xb4, 0x94, 0x4e, 0x03, 0xee, 0xcf, 0x28, 0x16, 0x01,
+	0xf3, 0x05, 0x76, 0x0e, 0xa0, 0xde, 0x17, 0xee, 0x6b, 0x3c, 0xc6, 0x10, 0xe7, 0x5a, 0x49, 0xc4,
+	0x90, 0xd5, 0xc5, 0x64, 0x68, 0x1f, 0x42, 0x63, 0xae, 0x7e, 0x42, 0xfc, 0x46, 0xea, 0xb1, 0xd0,
+	0xc7, 0xd3, 0x2c, 0x2f, 0x85, 0x4a, 0x54, 0x5d, 0xb2, 0xd6, 0x2c, 0xf9, 0x1d, 0xb5, 0xc6, 0x4e,
+	0x7d, 0xe4, 0x72, 0x2e, 0x35, 0x2b, 0x0e, 0x32, 0x1c, 0x0f, 0x64, 0x0f, 0x99, 0x52, 0x09, 0xc5,
+	0x57, 0x02, 0x6a, 0x5f, 0xb8, 0xfb, 0x03, 0xd9, 0x72, 0xd4, 0xba, 0xe7, 0xbb, 0x2b, 0x13, 0x51,
+	0x15, 0x6e, 0xd9, 0x83, 0x01, 0x47, 0x21, 0xe4, 0x6c, 0x6b, 0xd6, 0x75, 0x48, 0x35, 0xa8, 0x06,
+	0xdc, 0x63, 0xdc, 0x0b, 0xcf, 0xd5, 0x4a, 0x9b, 0x74, 0xef, 0x58, 0xd3, 0x38, 0x23, 0xaf, 0x09,
+	0x8f, 0x0a, 0x54, 0x24, 0x1a, 0x3f, 0x43, 0x53, 0x6a, 0x1f, 0xb2, 0x11, 0xde, 0x8c, 0xca, 0x8c,
+	0x12, 0x1d, 0xb6, 0x8a, 0xc9, 0x12, 0x31, 0xae, 0x7c, 0x93, 0x77, 0x47, 0x47, 0xc8, 0xff, 0xeb,
+	0x4d, 0xe8, 0x16, 0xd4, 0x38, 0x3a, 0x5e, 0xe0, 0x45, 0x3b, 0x10, 0xcb, 0x48, 0x0f, 0x72, 0x2f,
+	0x96, 0x21, 0x4a, 0x04, 0x58, 0xf2, 0x7c, 0xdf, 0x71, 0x30, 0x08, 0x17, 0x29, 0x98, 0xe1, 0x5a,
+	0x2b, 0xe7, 0x8a, 0x1d, 0x98, 0xad, 0x19, 0x93, 0xed, 0xfd, 0xdc, 0x80, 0xf5, 0xbe, 0x70, 0xe9,
+	0x47, 0xa8, 0x5e, 0xaf, 0x05, 0x7d, 0x52, 0x64, 0xf3, 0xfc, 0xa6, 0x6b, 0x4f, 0x17, 0xe6, 0xc5,
+	0x24, 0xd4, 0x06, 0x48, 0xcd, 0x4f, 0xbb, 0x25, 0xb0, 0xdc, 0xfe, 0x69, 0xcf, 0x96, 0xc8, 0x4c,
+	0x28, 0x0e, 0xa0, 0x36, 0xf5, 0x3e, 0x2d, 0x13, 0x36, 0xbf, 0x68, 0x5a, 0x77, 0x71, 0x62, 0x52,
+	0x7f, 0x08, 0x77, 0x67, 0xcd, 0x4b, 0x9f, 0x97, 0x60, 0x0b, 0x37, 0x4d, 0xdb, 0x5e, 0x32, 0x3b,
+	0xa1, 0x1b, 0xc1, 0xbd, 0x9c, 0x43, 0xa9, 0x59, 0xaa, 0xb6, 0x78, 0x71, 0xb4, 0x9d, 0xe5, 0x01,
+	0xe9, 0x18, 0xa7, 0x86, 0x2c, 0x1d, 0xe3, 0xfc, 0x6e, 0x94, 0x8e, 0x31, 0xe7, 0xed, 0xc8, 0x09,
+	0xa9, 0x09, 0x4b, 0x9d, 0x90, 0xf3, 0x7e, 0xa9, 0x13, 0xf2, 0x8e, 0xee, 0x39, 0x97, 0x63, 0x9d,
+	0x5c, 0x8d, 0x75, 0xf2, 0x67, 0xac, 0x93, 0xef, 0x13, 0x5d, 0xb9, 0x9a, 0xe8, 0xca, 0xaf, 0x89,
+	0xae, 0x40, 0xc3, 0x63, 0x05, 0x65, 0xde, 0x93, 0x0f, 0x3b, 0xae, 0x17, 0x7e, 0x3a, 0x39, 0x34,
+	0x1c, 0x36, 0x34, 0xd3, 0x84, 0x6d, 0x8f, 0x65, 0x22, 0xf3, 0x2c, 0xfe, 0x07, 0x86, 0xe7, 0x01,
+	0x8a, 0xc3, 0x4d, 0xf9, 0x0b, 0x7c, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x8b, 0x30, 0xc6,
+	0x6a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +669,16 @@ type MsgClient interface {
 	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// RenewName extends the expiration of a leased name by paying the renewal fee.
+	RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error)
+	// AddNameBinding binds a fallback address to a name at a priority.
+	AddNameBinding(ctx context.Context, in *MsgAddNameBindingRequest, opts ...grpc.CallOption) (*MsgAddNameBindingResponse, error)
+	// RemoveNameBinding removes a fallback address from a name.
+	RemoveNameBinding(ctx context.Context, in *MsgRemoveNameBindingRequest, opts ...grpc.CallOption) (*MsgRemoveNameBindingResponse, error)
+	// OfferName offers to transfer a name to a recipient, who must accept it before the offer expires.
+	OfferName(ctx context.Context, in *MsgOfferNameRequest, opts ...grpc.CallOption) (*MsgOfferNameResponse, error)
+	// AcceptName accepts an offer to transfer a name, binding the name to the recipient.
+	AcceptName(ctx context.Context, in *MsgAcceptNameRequest, opts ...grpc.CallOption) (*MsgAcceptNameResponse, error)
 }
 
 type msgClient struct {
@@ -263,12 +707,67 @@ func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, op
 	return out, nil
 }
 
+func (c *msgClient) RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error) {
+	out := new(MsgRenewNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RenewName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddNameBinding(ctx context.Context, in *MsgAddNameBindingRequest, opts ...grpc.CallOption) (*MsgAddNameBindingResponse, error) {
+	out := new(MsgAddNameBindingResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/AddNameBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNameBinding(ctx context.Context, in *MsgRemoveNameBindingRequest, opts ...grpc.CallOption) (*MsgRemoveNameBindingResponse, error) {
+	out := new(MsgRemoveNameBindingResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RemoveNameBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferName(ctx context.Context, in *MsgOfferNameRequest, opts ...grpc.CallOption) (*MsgOfferNameResponse, error) {
+	out := new(MsgOfferNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/OfferName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptName(ctx context.Context, in *MsgAcceptNameRequest, opts ...grpc.CallOption) (*MsgAcceptNameResponse, error) {
+	out := new(MsgAcceptNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/AcceptName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BindName binds a name to an address under a root name.
 	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
+	// RenewName extends the expiration of a leased name by paying the renewal fee.
+	RenewName(context.Context, *MsgRenewNameRequest) (*MsgRenewNameResponse, error)
+	// AddNameBinding binds a fallback address to a name at a priority.
+	AddNameBinding(context.Context, *MsgAddNameBindingRequest) (*MsgAddNameBindingResponse, error)
+	// RemoveNameBinding removes a fallback address from a name.
+	RemoveNameBinding(context.Context, *MsgRemoveNameBindingRequest) (*MsgRemoveNameBindingResponse, error)
+	// OfferName offers to transfer a name to a recipient, who must accept it before the offer expires.
+	OfferName(context.Context, *MsgOfferNameRequest) (*MsgOfferNameResponse, error)
+	// AcceptName accepts an offer to transfer a name, binding the name to the recipient.
+	AcceptName(context.Context, *MsgAcceptNameRequest) (*MsgAcceptNameResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -281,6 +780,21 @@ func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameReq
 func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
 }
+func (*UnimplementedMsgServer) RenewName(ctx context.Context, req *MsgRenewNameRequest) (*MsgRenewNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewName not implemented")
+}
+func (*UnimplementedMsgServer) AddNameBinding(ctx context.Context, req *MsgAddNameBindingRequest) (*MsgAddNameBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNameBinding not implemented")
+}
+func (*UnimplementedMsgServer) RemoveNameBinding(ctx context.Context, req *MsgRemoveNameBindingRequest) (*MsgRemoveNameBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNameBinding not implemented")
+}
+func (*UnimplementedMsgServer) OfferName(ctx context.Context, req *MsgOfferNameRequest) (*MsgOfferNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferName not implemented")
+}
+func (*UnimplementedMsgServer) AcceptName(ctx context.Context, req *MsgAcceptNameRequest) (*MsgAcceptNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptName not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -322,17 +836,127 @@ func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.name.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BindName",
-			Handler:    _Msg_BindName_Handler,
-		},
-		{
-			MethodName: "DeleteName",
-			Handler:    _Msg_DeleteName_Handler,
+func _Msg_RenewName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RenewName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewName(ctx, req.(*MsgRenewNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddNameBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNameBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddNameBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/AddNameBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNameBinding(ctx, req.(*MsgAddNameBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNameBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNameBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNameBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RemoveNameBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNameBinding(ctx, req.(*MsgRemoveNameBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/OfferName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferName(ctx, req.(*MsgOfferNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/AcceptName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptName(ctx, req.(*MsgAcceptNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.name.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BindName",
+			Handler:    _Msg_BindName_Handler,
+		},
+		{
+			MethodName: "DeleteName",
+			Handler:    _Msg_DeleteName_Handler,
+		},
+		{
+			MethodName: "RenewName",
+			Handler:    _Msg_RenewName_Handler,
+		},
+		{
+			MethodName: "AddNameBinding",
+			Handler:    _Msg_AddNameBinding_Handler,
+		},
+		{
+			MethodName: "RemoveNameBinding",
+			Handler:    _Msg_RemoveNameBinding_Handler,
+		},
+		{
+			MethodName: "OfferName",
+			Handler:    _Msg_OfferName_Handler,
+		},
+		{
+			MethodName: "AcceptName",
+			Handler:    _Msg_AcceptName_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -359,6 +983,16 @@ func (m *MsgBindNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Lease {
+		i--
+		if m.Lease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -430,97 +1064,1465 @@ func (m *MsgDeleteNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgBindNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNameBindingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNameBindingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNameBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNameBindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNameBindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNameBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNameBindingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNameBindingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNameBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNameBindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNameBindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNameBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOfferNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOfferNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOfferNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBindNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Lease {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBindNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddNameBindingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgAddNameBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveNameBindingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveNameBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOfferNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOfferNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lease = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddNameBindingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddNameBindingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddNameBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddNameBindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddNameBindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddNameBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveNameBindingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveNameBindingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveNameBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Parent.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgBindNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveNameBindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveNameBindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveNameBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgDeleteNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -543,17 +2545,17 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -563,30 +2565,29 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -596,24 +2597,55 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -636,7 +2668,7 @@ func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -659,10 +2691,10 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBindNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBindNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -686,7 +2718,7 @@ func (m *MsgBindNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -709,17 +2741,17 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -729,24 +2761,55 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -769,7 +2832,7 @@ func (m *MsgDeleteNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -792,10 +2855,10 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: